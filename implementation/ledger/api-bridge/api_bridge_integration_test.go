@@ -25,6 +25,7 @@ type APIBridgeIntegrationTestSuite struct {
 	grpcBaseURL       string
 	httpClient        *http.Client
 	cleanup           []func()
+	mock              bool // run the bridge with --mock instead of against a node
 }
 
 func (suite *APIBridgeIntegrationTestSuite) SetupSuite() {
@@ -34,9 +35,12 @@ func (suite *APIBridgeIntegrationTestSuite) SetupSuite() {
 	suite.restBaseURL = "http://localhost:8080"
 	suite.grpcBaseURL = "localhost:9090"
 	suite.cleanup = make([]func(), 0)
+	suite.mock = os.Getenv("INTEGRATION_MOCK_CHAIN") == "true"
 
-	// Start blockchain node
-	suite.startBlockchainNode()
+	// Start blockchain node, unless the bridge serves a mock chain
+	if !suite.mock {
+		suite.startBlockchainNode()
+	}
 
 	// Start API bridge
 	suite.startAPIBridge()
@@ -89,16 +93,26 @@ func (suite *APIBridgeIntegrationTestSuite) startBlockchainNode() {
 func (suite *APIBridgeIntegrationTestSuite) startAPIBridge() {
 	suite.T().Log("Starting API bridge...")
 
-	// Build API bridge
-	buildCmd := exec.Command("go", "build", "-o", "api-bridge-test", "./cmd/api-bridge")
+	// Build API bridge; the mock chain is only compiled in with its build tag
+	buildArgs := []string{"build", "-o", "api-bridge-test"}
+	if suite.mock {
+		buildArgs = append(buildArgs, "-tags", "mockchain")
+	}
+	buildCmd := exec.Command("go", append(buildArgs, "./cmd/api-bridge")...)
 	buildCmd.Dir = "." // Current directory
 	err := buildCmd.Run()
 	require.NoError(suite.T(), err, "Failed to build API bridge")
 
 	// Start API bridge
-	cmd := exec.Command("./api-bridge-test", "--rest-port", "8080", "--grpc-port", "9090")
+	args := []string{"--rest-port", "8080", "--grpc-port", "9090"}
+	if suite.mock {
+		args = append(args, "--mock")
+	}
+	cmd := exec.Command("./api-bridge-test", args...)
 	cmd.Dir = "."
-	cmd.Env = os.Environ()
+	// The suite registers more components than the register endpoint's burst
+	// allows from one client; viper reads the override from the environment
+	cmd.Env = append(os.Environ(), "SERVER.RATE_LIMIT.ENABLED=false")
 
 	// Capture output for debugging
 	cmd.Stdout = os.Stdout
@@ -120,6 +134,9 @@ func (suite *APIBridgeIntegrationTestSuite) startAPIBridge() {
 }
 
 func (suite *APIBridgeIntegrationTestSuite) isBlockchainRunning() bool {
+	if suite.mock {
+		return true
+	}
 	// Check if blockchain REST API is responding
 	client := &http.Client{Timeout: 5 * time.Second}
 	resp, err := client.Get("http://localhost:1317/cosmos/base/tendermint/v1beta1/node_info")
@@ -173,6 +190,27 @@ func (suite *APIBridgeIntegrationTestSuite) waitForServices() {
 	}
 }
 
+// registerComponent registers a component and returns the ID the chain assigned
+func (suite *APIBridgeIntegrationTestSuite) registerComponent(creator, componentData string) string {
+	jsonData, err := json.Marshal(map[string]interface{}{
+		"creator":        creator,
+		"component_data": componentData,
+		"context":        "integration-test",
+	})
+	require.NoError(suite.T(), err)
+
+	resp, err := suite.httpClient.Post(suite.restBaseURL+"/api/v1/components/register", "application/json", bytes.NewBuffer(jsonData))
+	require.NoError(suite.T(), err)
+	defer resp.Body.Close()
+	require.Equal(suite.T(), http.StatusOK, resp.StatusCode)
+
+	var response map[string]interface{}
+	require.NoError(suite.T(), json.NewDecoder(resp.Body).Decode(&response))
+	componentID, _ := response["component_id"].(string)
+	require.NotEmpty(suite.T(), componentID)
+	return componentID
+}
+
 // Test REST API Integration
 func (suite *APIBridgeIntegrationTestSuite) TestRESTHealthEndpoint() {
 	resp, err := suite.httpClient.Get(suite.restBaseURL + "/health")
@@ -190,7 +228,7 @@ func (suite *APIBridgeIntegrationTestSuite) TestRESTHealthEndpoint() {
 }
 
 func (suite *APIBridgeIntegrationTestSuite) TestRESTGetAccounts() {
-	resp, err := suite.httpClient.Get(suite.restBaseURL + "/accounts")
+	resp, err := suite.httpClient.Get(suite.restBaseURL + "/api/v1/accounts")
 	require.NoError(suite.T(), err)
 	defer resp.Body.Close()
 
@@ -218,7 +256,7 @@ func (suite *APIBridgeIntegrationTestSuite) TestRESTComponentRegistration() {
 	require.NoError(suite.T(), err)
 
 	resp, err := suite.httpClient.Post(
-		suite.restBaseURL+"/component/register",
+		suite.restBaseURL+"/api/v1/components/register",
 		"application/json",
 		bytes.NewBuffer(jsonData),
 	)
@@ -239,7 +277,7 @@ func (suite *APIBridgeIntegrationTestSuite) TestRESTComponentRegistration() {
 
 	// Verify we can retrieve the component
 	suite.T().Run("GetRegisteredComponent", func(t *testing.T) {
-		getResp, err := suite.httpClient.Get(suite.restBaseURL + "/component/" + componentId)
+		getResp, err := suite.httpClient.Get(suite.restBaseURL + "/api/v1/components/" + componentId)
 		require.NoError(t, err)
 		defer getResp.Body.Close()
 
@@ -249,17 +287,18 @@ func (suite *APIBridgeIntegrationTestSuite) TestRESTComponentRegistration() {
 			require.NoError(t, err)
 
 			assert.Equal(t, componentId, component["component_id"])
-			assert.Equal(t, "alice", component["creator"])
+			assert.Equal(t, "active", component["status"])
 		}
 		// Note: Component retrieval might not be implemented yet
 	})
 }
 
 func (suite *APIBridgeIntegrationTestSuite) TestRESTLCTCreation() {
+	// The chain only links registered components
 	requestData := map[string]interface{}{
 		"creator":     "alice",
-		"component_a": "integration-battery-001",
-		"component_b": "integration-motor-001",
+		"component_a": suite.registerComponent("alice", "integration-battery-001"),
+		"component_b": suite.registerComponent("alice", "integration-motor-001"),
 		"context":     "integration-test-pairing",
 		"proxy_id":    "integration-proxy-001",
 	}
@@ -268,7 +307,7 @@ func (suite *APIBridgeIntegrationTestSuite) TestRESTLCTCreation() {
 	require.NoError(suite.T(), err)
 
 	resp, err := suite.httpClient.Post(
-		suite.restBaseURL+"/lct/create",
+		suite.restBaseURL+"/api/v1/lct/create",
 		"application/json",
 		bytes.NewBuffer(jsonData),
 	)
@@ -301,8 +340,8 @@ func (suite *APIBridgeIntegrationTestSuite) TestRESTPairingWorkflow() {
 	// Step 1: Initiate pairing
 	initiateData := map[string]interface{}{
 		"creator":             "alice",
-		"component_a":         "integration-battery-002",
-		"component_b":         "integration-motor-002",
+		"component_a":         suite.registerComponent("alice", "integration-battery-002"),
+		"component_b":         suite.registerComponent("alice", "integration-motor-002"),
 		"operational_context": "integration-race-operation",
 		"proxy_id":            "integration-proxy-002",
 		"force_immediate":     false,
@@ -312,7 +351,7 @@ func (suite *APIBridgeIntegrationTestSuite) TestRESTPairingWorkflow() {
 	require.NoError(suite.T(), err)
 
 	resp, err := suite.httpClient.Post(
-		suite.restBaseURL+"/pairing/initiate",
+		suite.restBaseURL+"/api/v1/pairing/initiate",
 		"application/json",
 		bytes.NewBuffer(jsonData),
 	)
@@ -344,7 +383,7 @@ func (suite *APIBridgeIntegrationTestSuite) TestRESTPairingWorkflow() {
 	require.NoError(suite.T(), err)
 
 	resp, err = suite.httpClient.Post(
-		suite.restBaseURL+"/pairing/complete",
+		suite.restBaseURL+"/api/v1/pairing/complete",
 		"application/json",
 		bytes.NewBuffer(jsonData),
 	)
@@ -358,28 +397,23 @@ func (suite *APIBridgeIntegrationTestSuite) TestRESTPairingWorkflow() {
 	require.NoError(suite.T(), err)
 
 	assert.Contains(suite.T(), completeResponse, "lct_id")
-	assert.Contains(suite.T(), completeResponse, "split_key_a")
-	assert.Contains(suite.T(), completeResponse, "split_key_b")
+	assert.Contains(suite.T(), completeResponse, "key_reference")
 	assert.Contains(suite.T(), completeResponse, "txhash")
 
-	// Security validation: ensure split keys are present but contain no sensitive data
-	splitKeyA := completeResponse["split_key_a"].(string)
-	splitKeyB := completeResponse["split_key_b"].(string)
-
-	assert.NotEmpty(suite.T(), splitKeyA)
-	assert.NotEmpty(suite.T(), splitKeyB)
-	assert.NotEqual(suite.T(), splitKeyA, splitKeyB, "Split keys should be different")
-
-	// Ensure no private key material
-	assert.False(suite.T(), strings.Contains(splitKeyA, "-----BEGIN"))
-	assert.False(suite.T(), strings.Contains(splitKeyB, "-----BEGIN"))
+	// Security validation: each key half is delivered to its component
+	// sealed, so the response carries only a reference to the split key
+	assert.NotContains(suite.T(), completeResponse, "split_key_a")
+	assert.NotContains(suite.T(), completeResponse, "split_key_b")
+	keyReference := completeResponse["key_reference"].(string)
+	assert.Len(suite.T(), keyReference, 64)
+	assert.False(suite.T(), strings.Contains(keyReference, "-----BEGIN"))
 }
 
 // Test error handling and edge cases
 func (suite *APIBridgeIntegrationTestSuite) TestRESTErrorHandling() {
 	// Test invalid JSON
 	resp, err := suite.httpClient.Post(
-		suite.restBaseURL+"/component/register",
+		suite.restBaseURL+"/api/v1/components/register",
 		"application/json",
 		bytes.NewBuffer([]byte("invalid json")),
 	)
@@ -398,7 +432,7 @@ func (suite *APIBridgeIntegrationTestSuite) TestRESTErrorHandling() {
 	require.NoError(suite.T(), err)
 
 	resp, err = suite.httpClient.Post(
-		suite.restBaseURL+"/component/register",
+		suite.restBaseURL+"/api/v1/components/register",
 		"application/json",
 		bytes.NewBuffer(jsonData),
 	)
@@ -480,7 +514,7 @@ func (suite *APIBridgeIntegrationTestSuite) TestBlockchainConnectionRecovery() {
 	require.NoError(suite.T(), err)
 
 	resp, err := suite.httpClient.Post(
-		suite.restBaseURL+"/component/register",
+		suite.restBaseURL+"/api/v1/components/register",
 		"application/json",
 		bytes.NewBuffer(jsonData),
 	)
@@ -518,7 +552,8 @@ func (suite *APIBridgeIntegrationTestSuite) TestRaceCarBatteryPackScenario() {
 		},
 	}
 
-	// Register all components
+	// Register all components, noting the ID the chain assigns each
+	registered := make(map[string]string, len(components))
 	for _, comp := range components {
 		requestData := map[string]interface{}{
 			"creator":        creator,
@@ -530,7 +565,7 @@ func (suite *APIBridgeIntegrationTestSuite) TestRaceCarBatteryPackScenario() {
 		require.NoError(suite.T(), err)
 
 		resp, err := suite.httpClient.Post(
-			suite.restBaseURL+"/component/register",
+			suite.restBaseURL+"/api/v1/components/register",
 			"application/json",
 			bytes.NewBuffer(jsonData),
 		)
@@ -543,6 +578,7 @@ func (suite *APIBridgeIntegrationTestSuite) TestRaceCarBatteryPackScenario() {
 		err = json.NewDecoder(resp.Body).Decode(&response)
 		require.NoError(suite.T(), err)
 		assert.Contains(suite.T(), response, "component_id")
+		registered[comp.id], _ = response["component_id"].(string)
 	}
 
 	// Step 2: Create LCT relationships between components
@@ -558,8 +594,8 @@ func (suite *APIBridgeIntegrationTestSuite) TestRaceCarBatteryPackScenario() {
 	for _, rel := range lctRelationships {
 		requestData := map[string]interface{}{
 			"creator":     creator,
-			"component_a": rel.componentA,
-			"component_b": rel.componentB,
+			"component_a": registered[rel.componentA],
+			"component_b": registered[rel.componentB],
 			"context":     rel.context,
 			"proxy_id":    "",
 		}
@@ -568,7 +604,7 @@ func (suite *APIBridgeIntegrationTestSuite) TestRaceCarBatteryPackScenario() {
 		require.NoError(suite.T(), err)
 
 		resp, err := suite.httpClient.Post(
-			suite.restBaseURL+"/lct/create",
+			suite.restBaseURL+"/api/v1/lct/create",
 			"application/json",
 			bytes.NewBuffer(jsonData),
 		)
@@ -588,8 +624,8 @@ func (suite *APIBridgeIntegrationTestSuite) TestRaceCarBatteryPackScenario() {
 		// Initiate pairing
 		initiateData := map[string]interface{}{
 			"creator":             creator,
-			"component_a":         "comp_main_battery_pack",
-			"component_b":         "comp_motor_controller",
+			"component_a":         registered["comp_main_battery_pack"],
+			"component_b":         registered["comp_motor_controller"],
 			"operational_context": "race_energy_delivery",
 			"proxy_id":            "",
 			"force_immediate":     false,
@@ -599,7 +635,7 @@ func (suite *APIBridgeIntegrationTestSuite) TestRaceCarBatteryPackScenario() {
 		require.NoError(t, err)
 
 		resp, err := suite.httpClient.Post(
-			suite.restBaseURL+"/pairing/initiate",
+			suite.restBaseURL+"/api/v1/pairing/initiate",
 			"application/json",
 			bytes.NewBuffer(jsonData),
 		)
@@ -628,7 +664,7 @@ func (suite *APIBridgeIntegrationTestSuite) TestRaceCarBatteryPackScenario() {
 		require.NoError(t, err)
 
 		resp, err = suite.httpClient.Post(
-			suite.restBaseURL+"/pairing/complete",
+			suite.restBaseURL+"/api/v1/pairing/complete",
 			"application/json",
 			bytes.NewBuffer(jsonData),
 		)
@@ -642,9 +678,9 @@ func (suite *APIBridgeIntegrationTestSuite) TestRaceCarBatteryPackScenario() {
 		require.NoError(t, err)
 
 		assert.Contains(t, completeResponse, "lct_id")
-		assert.Contains(t, completeResponse, "split_key_a")
-		assert.Contains(t, completeResponse, "split_key_b")
-		assert.Equal(t, "completed", completeResponse["status"])
+		assert.NotContains(t, completeResponse, "split_key_a")
+		assert.NotContains(t, completeResponse, "split_key_b")
+		assert.NotEmpty(t, completeResponse["key_reference"])
 	})
 }
 
@@ -674,7 +710,7 @@ func (suite *APIBridgeIntegrationTestSuite) BenchmarkRESTComponentRegistration(b
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
 		resp, err := suite.httpClient.Post(
-			suite.restBaseURL+"/component/register",
+			suite.restBaseURL+"/api/v1/components/register",
 			"application/json",
 			bytes.NewBuffer(jsonData),
 		)
@@ -59,8 +59,7 @@ type Pairing struct {
 	ProxyId            string `json:"proxy_id"`
 	Status             string `json:"status"`
 	LctId              string `json:"lct_id,omitempty"`
-	SplitKeyA          string `json:"split_key_a,omitempty"`
-	SplitKeyB          string `json:"split_key_b,omitempty"`
+	KeyReference       string `json:"key_reference,omitempty"`
 	TxHash             string `json:"txhash,omitempty"`
 }
 
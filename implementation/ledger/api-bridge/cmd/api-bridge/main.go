@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"os"
@@ -9,8 +10,10 @@ import (
 	"syscall"
 	"time"
 
+	"api-bridge/internal/blockchain"
 	"api-bridge/internal/config"
 	"api-bridge/internal/server"
+	"api-bridge/internal/version"
 
 	"github.com/rs/zerolog"
 	"github.com/spf13/cobra"
@@ -21,6 +24,7 @@ var (
 	restPort   int
 	grpcPort   int
 	logLevel   string
+	mock       bool
 )
 
 func main() {
@@ -35,7 +39,7 @@ func main() {
 		Long: `API Bridge service for Web4 Race Car Battery Management System.
 Provides REST and WebSocket APIs to interact with the blockchain.`,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return runServer(logger)
+			return runServer(logger, cmd.Flags().Changed("log-level"))
 		},
 	}
 
@@ -43,26 +47,73 @@ Provides REST and WebSocket APIs to interact with the blockchain.`,
 	rootCmd.Flags().StringVarP(&configFile, "config", "c", "config.yaml", "Configuration file path")
 	rootCmd.Flags().IntVarP(&restPort, "rest-port", "p", 8080, "REST server port")
 	rootCmd.Flags().IntVarP(&grpcPort, "grpc-port", "g", 9090, "gRPC server port")
-	rootCmd.Flags().StringVarP(&logLevel, "log-level", "l", "info", "Log level (debug, info, warn, error)")
+	rootCmd.Flags().StringVarP(&logLevel, "log-level", "l", "info", "Log level (debug, info, warn, error); overrides logging.level, also on reload")
+	rootCmd.Flags().BoolVar(&mock, "mock", false, "Serve from an in-memory mock chain instead of a node (overrides blockchain.mock)")
+
+	consistencyCmd := &cobra.Command{
+		Use:   "consistency-check",
+		Short: "Report cross-module references that point to missing records",
+		Long: `Verifies that every LCT points to registered components and that every
+trust tensor and energy operation points to an existing LCT. Prints the report
+as JSON and exits non-zero if any reference dangles.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runConsistencyCheck(logger)
+		},
+	}
+	consistencyCmd.Flags().StringVarP(&configFile, "config", "c", "config.yaml", "Configuration file path")
+	rootCmd.AddCommand(consistencyCmd)
 
 	if err := rootCmd.Execute(); err != nil {
 		logger.Fatal().Err(err).Msg("Failed to execute command")
 	}
 }
 
-func runServer(logger zerolog.Logger) error {
-	// Set log level
-	level, err := zerolog.ParseLevel(logLevel)
+func runConsistencyCheck(logger zerolog.Logger) error {
+	cfg, err := config.Load(configFile)
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+
+	// Keep stdout for the report
+	client, err := blockchain.NewClient(cfg.Blockchain.RESTEndpoint, logger.Output(os.Stderr).Level(zerolog.WarnLevel))
+	if err != nil {
+		return fmt.Errorf("failed to create blockchain client: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+
+	report, err := client.CheckConsistency(ctx)
 	if err != nil {
-		return fmt.Errorf("invalid log level: %w", err)
+		return fmt.Errorf("consistency check failed: %w", err)
 	}
-	logger = logger.Level(level)
 
+	out, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode report: %w", err)
+	}
+	fmt.Println(string(out))
+
+	if !report.Consistent {
+		return fmt.Errorf("found %d dangling references", len(report.Dangling))
+	}
+	return nil
+}
+
+func runServer(logger zerolog.Logger, levelFlagSet bool) error {
 	// Load configuration
-	cfg, err := config.Load(configFile)
+	cfg, err := loadConfig()
 	if err != nil {
-		return fmt.Errorf("failed to load config: %w", err)
+		return err
+	}
+
+	// Set log level globally so a reload changes it for every component's logger
+	level, err := logLevelOf(cfg, levelFlagSet)
+	if err != nil {
+		return err
 	}
+	zerolog.SetGlobalLevel(level)
+	logger.Info().Str("version", version.Version).Str("commit", version.Commit).Msg("API bridge build")
 
 	// Create server
 	srv, err := server.New(cfg, logger)
@@ -86,10 +137,19 @@ func runServer(logger zerolog.Logger) error {
 		}
 	}()
 
-	// Wait for interrupt signal
+	// Wait for interrupt signal, reloading the config on SIGHUP
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
-	<-quit
+	reload := make(chan os.Signal, 1)
+	signal.Notify(reload, syscall.SIGHUP)
+	for waiting := true; waiting; {
+		select {
+		case <-reload:
+			reloadConfig(logger, srv, cfg, levelFlagSet)
+		case <-quit:
+			waiting = false
+		}
+	}
 
 	logger.Info().Msg("Shutting down server...")
 
@@ -105,3 +165,58 @@ func runServer(logger zerolog.Logger) error {
 	logger.Info().Msg("Server exited")
 	return nil
 }
+
+// loadConfig reads the config file, applying --mock
+func loadConfig() (*config.Config, error) {
+	cfg, err := config.Load(configFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load config: %w", err)
+	}
+	if mock {
+		cfg.Blockchain.Mock = true
+	}
+	return cfg, nil
+}
+
+// logLevelOf returns the level given with --log-level, or logging.level
+// when the flag was not set
+func logLevelOf(cfg *config.Config, levelFlagSet bool) (zerolog.Level, error) {
+	name := cfg.Logging.Level
+	if levelFlagSet || name == "" {
+		name = logLevel
+	}
+	level, err := zerolog.ParseLevel(name)
+	if err != nil {
+		return zerolog.NoLevel, fmt.Errorf("invalid log level: %w", err)
+	}
+	return level, nil
+}
+
+// reloadConfig re-reads the config file and applies the log level, webhook
+// endpoints and rate limits it sets. An invalid file is rejected as a whole
+// and the running settings are kept. Changes to settings that need a restart
+// are logged and ignored; running is the config the server started with.
+func reloadConfig(logger zerolog.Logger, srv *server.Server, running *config.Config, levelFlagSet bool) {
+	logger.Info().Str("config", configFile).Msg("Reloading configuration")
+
+	next, err := loadConfig()
+	if err != nil {
+		logger.Error().Err(err).Msg("Rejected configuration reload")
+		return
+	}
+	level, err := logLevelOf(next, levelFlagSet)
+	if err != nil {
+		logger.Error().Err(err).Msg("Rejected configuration reload")
+		return
+	}
+	if err := srv.Reload(next); err != nil {
+		logger.Error().Err(err).Msg("Rejected configuration reload")
+		return
+	}
+	zerolog.SetGlobalLevel(level)
+
+	if changed := running.RestartRequired(next); len(changed) > 0 {
+		logger.Warn().Strs("settings", changed).Msg("Changed settings take effect only after a restart")
+	}
+	logger.Info().Str("log_level", level.String()).Msg("Configuration reloaded")
+}
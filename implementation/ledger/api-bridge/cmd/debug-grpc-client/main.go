@@ -2,19 +2,31 @@ package main
 
 import (
 	"context"
+	"flag"
 	"fmt"
 	"log"
 	"time"
 
+	bridgegrpc "api-bridge/internal/grpc"
 	pb "api-bridge/proto"
 
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
+)
+
+var (
+	addr     = flag.String("addr", "localhost:9091", "gRPC server address")
+	tlsFlags = bridgegrpc.RegisterClientFlags(flag.CommandLine)
 )
 
 func main() {
+	flag.Parse()
+	creds, err := tlsFlags.TransportCredentials()
+	if err != nil {
+		log.Fatalf("Failed to load TLS credentials: %v", err)
+	}
+
 	// Connect to debug gRPC server
-	conn, err := grpc.Dial("localhost:9091", grpc.WithTransportCredentials(insecure.NewCredentials()))
+	conn, err := grpc.Dial(*addr, grpc.WithTransportCredentials(creds))
 	if err != nil {
 		log.Fatalf("Failed to connect: %v", err)
 	}
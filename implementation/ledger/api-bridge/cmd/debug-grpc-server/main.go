@@ -2,9 +2,12 @@ package main
 
 import (
 	"context"
+	"flag"
 	"log"
 	"net"
 
+	"api-bridge/internal/config"
+	bridgegrpc "api-bridge/internal/grpc"
 	pb "api-bridge/proto"
 
 	"google.golang.org/grpc"
@@ -42,13 +45,27 @@ func (s *DebugServer) RegisterComponent(ctx context.Context, req *pb.RegisterCom
 	}, nil
 }
 
+var tlsConfig config.GRPCTLSConfig
+
 func main() {
+	flag.BoolVar(&tlsConfig.Insecure, "insecure", false, "serve plaintext")
+	flag.StringVar(&tlsConfig.CertFile, "cert", "", "server certificate")
+	flag.StringVar(&tlsConfig.KeyFile, "key", "", "server certificate key")
+	flag.StringVar(&tlsConfig.ClientCAFile, "client-ca", "", "CA bundle client certificates are verified against")
+	flag.BoolVar(&tlsConfig.RequireClientCert, "require-client-cert", false, "reject clients without a certificate signed by -client-ca")
+	flag.Parse()
+
+	creds, err := bridgegrpc.ServerCredentials(tlsConfig)
+	if err != nil {
+		log.Fatalf("Failed to load TLS credentials: %v", err)
+	}
+
 	lis, err := net.Listen("tcp", ":9091")
 	if err != nil {
 		log.Fatalf("Failed to listen: %v", err)
 	}
 
-	grpcServer := grpc.NewServer()
+	grpcServer := grpc.NewServer(grpc.Creds(creds))
 	pb.RegisterAPIBridgeServiceServer(grpcServer, &DebugServer{})
 
 	log.Printf("Debug gRPC server starting on port 9091")
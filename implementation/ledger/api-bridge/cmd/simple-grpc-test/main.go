@@ -2,18 +2,31 @@ package main
 
 import (
 	"context"
+	"flag"
 	"fmt"
 	"log"
 	"time"
 
+	bridgegrpc "api-bridge/internal/grpc"
+
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/health/grpc_health_v1"
 )
 
+var (
+	addr     = flag.String("addr", "localhost:9090", "gRPC server address")
+	tlsFlags = bridgegrpc.RegisterClientFlags(flag.CommandLine)
+)
+
 func main() {
+	flag.Parse()
+	creds, err := tlsFlags.TransportCredentials()
+	if err != nil {
+		log.Fatalf("Failed to load TLS credentials: %v", err)
+	}
+
 	// Connect to gRPC server
-	conn, err := grpc.Dial("localhost:9090", grpc.WithTransportCredentials(insecure.NewCredentials()))
+	conn, err := grpc.Dial(*addr, grpc.WithTransportCredentials(creds))
 	if err != nil {
 		log.Fatalf("Failed to connect: %v", err)
 	}
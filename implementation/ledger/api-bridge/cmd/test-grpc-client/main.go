@@ -2,19 +2,31 @@ package main
 
 import (
 	"context"
+	"flag"
 	"fmt"
 	"log"
 	"time"
 
+	bridgegrpc "api-bridge/internal/grpc"
 	pb "api-bridge/proto"
 
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
+)
+
+var (
+	addr     = flag.String("addr", "localhost:9092", "gRPC server address")
+	tlsFlags = bridgegrpc.RegisterClientFlags(flag.CommandLine)
 )
 
 func main() {
+	flag.Parse()
+	creds, err := tlsFlags.TransportCredentials()
+	if err != nil {
+		log.Fatalf("Failed to load TLS credentials: %v", err)
+	}
+
 	// Connect to gRPC server
-	conn, err := grpc.Dial("localhost:9092", grpc.WithTransportCredentials(insecure.NewCredentials()))
+	conn, err := grpc.Dial(*addr, grpc.WithTransportCredentials(creds))
 	if err != nil {
 		log.Fatalf("Failed to connect: %v", err)
 	}
@@ -117,8 +129,7 @@ func main() {
 		} else {
 			fmt.Printf("Pairing completed: %s\n", completeResp.LctId)
 			fmt.Printf("Transaction hash: %s\n", completeResp.Txhash)
-			fmt.Printf("Split Key A: %s\n", completeResp.SplitKeyA)
-			fmt.Printf("Split Key B: %s\n", completeResp.SplitKeyB)
+			fmt.Printf("Key reference: %s\n", completeResp.KeyReference)
 		}
 	}
 
@@ -31,7 +31,7 @@ func (g *GRPCAuthInterceptor) UnaryInterceptor(
 	handler grpc.UnaryHandler,
 ) (interface{}, error) {
 	// Skip authentication for health checks
-	if strings.HasSuffix(info.FullMethod, "/Health") {
+	if strings.HasPrefix(info.FullMethod, "/grpc.health.v1.Health/") {
 		return handler(ctx, req)
 	}
 
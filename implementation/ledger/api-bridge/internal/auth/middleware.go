@@ -1,6 +1,7 @@
 package auth
 
 import (
+	"context"
 	"net/http"
 	"strings"
 	"time"
@@ -25,47 +26,62 @@ func NewAuthMiddleware(authService *AuthService, logger zerolog.Logger) *AuthMid
 
 func (a *AuthMiddleware) RequireAPIKey() gin.HandlerFunc {
 	return func(c *gin.Context) {
-		startTime := time.Now()
-
 		// Extract API key from header
-		apiKey := a.extractAPIKey(c)
-		if apiKey == "" {
-			c.JSON(http.StatusUnauthorized, gin.H{"error": "API key required"})
-			c.Abort()
-			return
-		}
+		a.authenticate(c, a.extractAPIKey(c))
+	}
+}
 
-		// Validate API key
-		keyInfo, err := a.authService.ValidateAPIKey(c.Request.Context(), apiKey)
-		if err != nil {
-			a.logger.Error().Err(err).Msg("API key validation failed")
-			c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid API key"})
-			c.Abort()
-			return
-		}
+// authenticate validates apiKey and rate limits its user before running the
+// rest of the chain, aborting with 401 or 429 otherwise
+func (a *AuthMiddleware) authenticate(c *gin.Context, apiKey string) {
+	startTime := time.Now()
 
-		// Check rate limiting
-		if !a.rateLimiter.IsAllowed(keyInfo.UserID, keyInfo.RateLimit) {
-			c.JSON(http.StatusTooManyRequests, gin.H{"error": "Rate limit exceeded"})
-			c.Abort()
-			return
-		}
+	if apiKey == "" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "API key required"})
+		c.Abort()
+		return
+	}
 
-		// Store authentication info in context
-		c.Set("authenticated", true)
-		c.Set("user_id", keyInfo.UserID)
-		c.Set("username", keyInfo.Username)
-		c.Set("component_id", keyInfo.ComponentID)
-		c.Set("device_type", keyInfo.DeviceType)
-		c.Set("permissions", keyInfo.Permissions)
-		c.Set("roles", keyInfo.Roles)
+	// Validate API key
+	keyInfo, err := a.authService.ValidateAPIKey(c.Request.Context(), apiKey)
+	if err != nil {
+		a.logger.Error().Err(err).Msg("API key validation failed")
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid API key"})
+		c.Abort()
+		return
+	}
 
-		// Continue processing
-		c.Next()
+	// Check rate limiting
+	if !a.rateLimiter.IsAllowed(keyInfo.UserID, keyInfo.RateLimit) {
+		c.JSON(http.StatusTooManyRequests, gin.H{"error": "Rate limit exceeded"})
+		c.Abort()
+		return
+	}
+
+	// Store authentication info in context
+	c.Set("authenticated", true)
+	c.Set("user_id", keyInfo.UserID)
+	c.Set("username", keyInfo.Username)
+	c.Set("component_id", keyInfo.ComponentID)
+	c.Set("device_type", keyInfo.DeviceType)
+	c.Set("permissions", keyInfo.Permissions)
+	c.Set("roles", keyInfo.Roles)
+
+	// Continue processing
+	c.Next()
 
-		// Log API usage (async)
-		go a.logAPIUsage(c, keyInfo, startTime)
+	// Log API usage (async). gin reuses c for the next request once this
+	// handler returns, so everything is read from it before handing off.
+	usage := APIUsage{
+		APIKeyID:       keyInfo.UserID, // Use user ID as reference
+		Endpoint:       c.Request.URL.Path,
+		Method:         c.Request.Method,
+		IPAddress:      c.ClientIP(),
+		UserAgent:      c.Request.UserAgent(),
+		ResponseStatus: c.Writer.Status(),
+		ResponseTimeMs: int(time.Since(startTime).Milliseconds()),
 	}
+	go a.logAPIUsage(context.WithoutCancel(c.Request.Context()), usage)
 }
 
 func (a *AuthMiddleware) extractAPIKey(c *gin.Context) string {
@@ -83,18 +99,8 @@ func (a *AuthMiddleware) extractAPIKey(c *gin.Context) string {
 	return ""
 }
 
-func (a *AuthMiddleware) logAPIUsage(c *gin.Context, keyInfo *KeyInfo, startTime time.Time) {
-	usage := APIUsage{
-		APIKeyID:       keyInfo.UserID, // Use user ID as reference
-		Endpoint:       c.Request.URL.Path,
-		Method:         c.Request.Method,
-		IPAddress:      c.ClientIP(),
-		UserAgent:      c.Request.UserAgent(),
-		ResponseStatus: c.Writer.Status(),
-		ResponseTimeMs: int(time.Since(startTime).Milliseconds()),
-	}
-
-	if err := a.authService.LogAPIUsage(c.Request.Context(), usage); err != nil {
+func (a *AuthMiddleware) logAPIUsage(ctx context.Context, usage APIUsage) {
+	if err := a.authService.LogAPIUsage(ctx, usage); err != nil {
 		a.logger.Error().Err(err).Msg("Failed to log API usage")
 	}
 }
@@ -0,0 +1,38 @@
+package auth
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+)
+
+// WebSocketAPIKeyProtocol is the subprotocol a browser client offers ahead of
+// its API key, as in "Sec-WebSocket-Protocol: apikey, <key>", since browsers
+// cannot set other headers on a WebSocket handshake
+const WebSocketAPIKeyProtocol = "apikey"
+
+// RequireWebSocketAPIKey authenticates a WebSocket upgrade request like
+// RequireAPIKey, so an unauthorized client is refused before the handshake.
+// Besides the REST headers the key may be sent in the api_key query parameter
+// or as the subprotocol offered after WebSocketAPIKeyProtocol.
+func (a *AuthMiddleware) RequireWebSocketAPIKey() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		a.authenticate(c, a.extractWebSocketAPIKey(c))
+	}
+}
+
+func (a *AuthMiddleware) extractWebSocketAPIKey(c *gin.Context) string {
+	if apiKey := a.extractAPIKey(c); apiKey != "" {
+		return apiKey
+	}
+	if apiKey := c.Query("api_key"); apiKey != "" {
+		return apiKey
+	}
+
+	protocols := websocket.Subprotocols(c.Request)
+	for i := 0; i+1 < len(protocols); i++ {
+		if protocols[i] == WebSocketAPIKeyProtocol {
+			return protocols[i+1]
+		}
+	}
+	return ""
+}
@@ -0,0 +1,67 @@
+package auth
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequireWebSocketAPIKey(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	// The Laravel backend rejects every key it is asked about
+	laravel := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	t.Cleanup(laravel.Close)
+	authService := NewAuthService(NewLaravelClient(laravel.URL, "bridge-key", zerolog.Nop()), zerolog.Nop())
+	authService.cache.Set("good-key", &KeyInfo{UserID: 1, Username: "dashboard", RateLimit: 100})
+	middleware := NewAuthMiddleware(authService, zerolog.Nop())
+
+	upgrader := websocket.Upgrader{Subprotocols: []string{WebSocketAPIKeyProtocol}}
+	router := gin.New()
+	router.GET("/ws", middleware.RequireWebSocketAPIKey(), func(c *gin.Context) {
+		conn, err := upgrader.Upgrade(c.Writer, c.Request, nil)
+		if err == nil {
+			conn.Close()
+		}
+	})
+	server := httptest.NewServer(router)
+	t.Cleanup(server.Close)
+	url := "ws" + strings.TrimPrefix(server.URL, "http") + "/ws"
+
+	// Unauthorized upgrades are refused before the handshake
+	for _, tc := range []struct {
+		url    string
+		header http.Header
+	}{
+		{url, nil},
+		{url + "?api_key=bad-key", nil},
+		{url, http.Header{"Sec-WebSocket-Protocol": {"apikey, bad-key"}}},
+	} {
+		_, resp, err := websocket.DefaultDialer.Dial(tc.url, tc.header)
+		require.ErrorIs(t, err, websocket.ErrBadHandshake)
+		assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	}
+
+	for _, tc := range []struct {
+		url    string
+		header http.Header
+	}{
+		{url, http.Header{"X-API-Key": {"good-key"}}},
+		{url + "?api_key=good-key", nil},
+		{url, http.Header{"Sec-WebSocket-Protocol": {"apikey, good-key"}}},
+	} {
+		conn, resp, err := websocket.DefaultDialer.Dial(tc.url, tc.header)
+		require.NoError(t, err)
+		conn.Close()
+		assert.Equal(t, http.StatusSwitchingProtocols, resp.StatusCode)
+	}
+}
@@ -0,0 +1,232 @@
+package blockchain
+
+import (
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/go-bip39"
+	"golang.org/x/crypto/nacl/secretbox"
+	"golang.org/x/crypto/scrypt"
+)
+
+var (
+	// ErrInvalidMnemonic is returned for a mnemonic that is not a valid BIP39 phrase
+	ErrInvalidMnemonic = errors.New("invalid mnemonic")
+	// ErrInvalidHDPath is returned for a derivation path that cannot be parsed
+	ErrInvalidHDPath = errors.New("invalid HD path")
+	// ErrInvalidAccountName is returned for a name that cannot name a key
+	ErrInvalidAccountName = errors.New("invalid account name")
+	// ErrAccountExists is returned when importing under a name the keyring already holds
+	ErrAccountExists = errors.New("account already exists")
+	// ErrKeyringUnavailable is returned when no keyring is configured for key management
+	ErrKeyringUnavailable = errors.New("no keyring configured for account keys")
+	// ErrMnemonicExportDisabled is returned by ExportAccountMnemonic unless export is allowed
+	ErrMnemonicExportDisabled = errors.New("mnemonic export is disabled")
+	// ErrMnemonicNotFound is returned when no mnemonic was kept for an account
+	ErrMnemonicNotFound = errors.New("no mnemonic kept for account")
+	// ErrMnemonicPassphraseRequired is returned when export is allowed without
+	// a passphrase to seal the kept mnemonics with
+	ErrMnemonicPassphraseRequired = errors.New("mnemonic export needs a keyring passphrase")
+)
+
+// Sealed mnemonic files are salt || nonce || secretbox, keyed by scrypt
+const (
+	mnemonicSaltSize = 16
+	mnemonicScryptN  = 1 << 15
+)
+
+// AccountKeysConfig configures where AccountManager keeps imported keys
+type AccountKeysConfig struct {
+	Keyring keyring.Keyring
+	// Dir holds the mnemonics kept for export; only used with AllowExport
+	Dir string
+	// AllowExport keeps each imported mnemonic so ExportAccountMnemonic can
+	// return it. The keyring only stores derived keys, so without this an
+	// imported mnemonic cannot be read back.
+	AllowExport bool
+	// Passphrase seals the kept mnemonics; they are never written in the clear
+	Passphrase string
+}
+
+// UseKeyring stores account keys in cfg.Keyring from now on
+func (am *AccountManager) UseKeyring(cfg AccountKeysConfig) {
+	am.mu.Lock()
+	defer am.mu.Unlock()
+	am.keys = cfg
+}
+
+// ImportAccountFromMnemonic derives a secp256k1 key from mnemonic along
+// hdPath, the standard Cosmos path m/44'/118'/0'/0/0 when empty, stores it in
+// the keyring under name and makes it available as a signing account
+func (am *AccountManager) ImportAccountFromMnemonic(name, mnemonic, hdPath string) (*Account, error) {
+	am.mu.Lock()
+	defer am.mu.Unlock()
+
+	if am.keys.Keyring == nil {
+		return nil, ErrKeyringUnavailable
+	}
+	// An HSM never hands out private keys, so nothing can be imported into it
+	if am.keys.Keyring.Backend() == KeyringBackendHSM {
+		return nil, fmt.Errorf("%w: the %s backend cannot import keys", ErrKeyringUnavailable, KeyringBackendHSM)
+	}
+	if !validAccountName(name) {
+		return nil, fmt.Errorf("%w %q", ErrInvalidAccountName, name)
+	}
+
+	mnemonic = strings.Join(strings.Fields(mnemonic), " ")
+	// IsMnemonicValid only checks the words; decoding also verifies the checksum
+	if !bip39.IsMnemonicValid(mnemonic) {
+		return nil, fmt.Errorf("%w: expected 12 to 24 words from the BIP39 English word list", ErrInvalidMnemonic)
+	}
+	if _, err := bip39.MnemonicToByteArray(mnemonic); err != nil {
+		return nil, fmt.Errorf("%w: checksum does not match", ErrInvalidMnemonic)
+	}
+
+	if hdPath == "" {
+		hdPath = sdk.FullFundraiserPath
+	}
+	if _, err := hd.NewParamsFromPath(hdPath); err != nil {
+		return nil, fmt.Errorf("%w %q: %v", ErrInvalidHDPath, hdPath, err)
+	}
+
+	if _, err := am.keys.Keyring.Key(name); err == nil {
+		return nil, fmt.Errorf("%w: %s", ErrAccountExists, name)
+	}
+
+	record, err := am.keys.Keyring.NewAccount(name, mnemonic, keyring.DefaultBIP39Passphrase, hdPath, hd.Secp256k1)
+	if err != nil {
+		return nil, fmt.Errorf("failed to store key %q: %w", name, err)
+	}
+	address, err := record.GetAddress()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read address for key %q: %w", name, err)
+	}
+
+	if am.keys.AllowExport {
+		if err := am.saveMnemonic(name, mnemonic); err != nil {
+			_ = am.keys.Keyring.Delete(name)
+			return nil, err
+		}
+	}
+
+	account := &Account{Name: name, Address: address.String(), KeyType: "secp256k1"}
+	am.accounts[name] = account
+	am.logger.Info().Str("name", name).Str("address", account.Address).Str("hd_path", hdPath).Msg("Imported account from mnemonic")
+	return account, nil
+}
+
+// ExportAccountMnemonic returns the mnemonic an account was imported with.
+// It fails unless export was allowed when the account was imported.
+func (am *AccountManager) ExportAccountMnemonic(name string) (string, error) {
+	am.mu.RLock()
+	defer am.mu.RUnlock()
+
+	if !am.keys.AllowExport {
+		return "", ErrMnemonicExportDisabled
+	}
+	if !validAccountName(name) {
+		return "", fmt.Errorf("%w %q", ErrInvalidAccountName, name)
+	}
+
+	sealed, err := os.ReadFile(am.mnemonicPath(name))
+	if errors.Is(err, os.ErrNotExist) {
+		return "", fmt.Errorf("%w: %s", ErrMnemonicNotFound, name)
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to read mnemonic of %q: %w", name, err)
+	}
+	mnemonic, err := openMnemonic(sealed, am.keys.Passphrase)
+	if err != nil {
+		return "", fmt.Errorf("failed to open mnemonic of %q: %w", name, err)
+	}
+
+	am.logger.Warn().Str("name", name).Msg("Exported account mnemonic")
+	return mnemonic, nil
+}
+
+// validAccountName rejects names that would escape the mnemonic directory
+func validAccountName(name string) bool {
+	return name != "" && filepath.Base(name) == name && !strings.HasPrefix(name, ".")
+}
+
+// saveMnemonic keeps mnemonic sealed with the keyring passphrase and readable
+// by the bridge user only; callers hold mu
+func (am *AccountManager) saveMnemonic(name, mnemonic string) error {
+	sealed, err := sealMnemonic(mnemonic, am.keys.Passphrase)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(am.mnemonicPath(name)), 0o700); err != nil {
+		return fmt.Errorf("failed to create mnemonic directory: %w", err)
+	}
+	if err := os.WriteFile(am.mnemonicPath(name), sealed, 0o600); err != nil {
+		return fmt.Errorf("failed to keep mnemonic of %q: %w", name, err)
+	}
+	return nil
+}
+
+// sealMnemonic encrypts mnemonic under a key derived from passphrase
+func sealMnemonic(mnemonic, passphrase string) ([]byte, error) {
+	if passphrase == "" {
+		return nil, ErrMnemonicPassphraseRequired
+	}
+	var nonce [24]byte
+	salt := make([]byte, mnemonicSaltSize)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, fmt.Errorf("failed to generate mnemonic salt: %w", err)
+	}
+	if _, err := rand.Read(nonce[:]); err != nil {
+		return nil, fmt.Errorf("failed to generate mnemonic nonce: %w", err)
+	}
+	key, err := mnemonicKey(passphrase, salt)
+	if err != nil {
+		return nil, err
+	}
+
+	sealed := append(salt, nonce[:]...)
+	return secretbox.Seal(sealed, []byte(mnemonic), &nonce, key), nil
+}
+
+// openMnemonic reverses sealMnemonic
+func openMnemonic(sealed []byte, passphrase string) (string, error) {
+	if passphrase == "" {
+		return "", ErrMnemonicPassphraseRequired
+	}
+	if len(sealed) < mnemonicSaltSize+24+secretbox.Overhead {
+		return "", errors.New("sealed mnemonic is truncated")
+	}
+	salt, rest := sealed[:mnemonicSaltSize], sealed[mnemonicSaltSize:]
+	var nonce [24]byte
+	copy(nonce[:], rest[:24])
+	key, err := mnemonicKey(passphrase, salt)
+	if err != nil {
+		return "", err
+	}
+
+	mnemonic, ok := secretbox.Open(nil, rest[24:], &nonce, key)
+	if !ok {
+		return "", errors.New("sealed mnemonic does not open with the keyring passphrase")
+	}
+	return string(mnemonic), nil
+}
+
+func mnemonicKey(passphrase string, salt []byte) (*[32]byte, error) {
+	derived, err := scrypt.Key([]byte(passphrase), salt, mnemonicScryptN, 8, 1, 32)
+	if err != nil {
+		return nil, fmt.Errorf("failed to derive mnemonic key: %w", err)
+	}
+	var key [32]byte
+	copy(key[:], derived)
+	return &key, nil
+}
+
+func (am *AccountManager) mnemonicPath(name string) string {
+	return filepath.Join(am.keys.Dir, "mnemonics", name+".mnemonic")
+}
@@ -0,0 +1,107 @@
+package blockchain
+
+import (
+	"os"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testMnemonic = "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+
+func testKeyAccountManager(t *testing.T, allowExport bool) (*AccountManager, keyring.Keyring) {
+	t.Helper()
+	am := &AccountManager{logger: zerolog.Nop(), accounts: make(map[string]*Account)}
+
+	dir := t.TempDir()
+	kr, err := newKeyring(KeyringConfig{Backend: KeyringBackendTest, Dir: dir}, testEncoding(t).codec)
+	require.NoError(t, err)
+	am.UseKeyring(AccountKeysConfig{Keyring: kr, Dir: dir, AllowExport: allowExport, Passphrase: "pit-lane-passphrase"})
+	return am, kr
+}
+
+// derivedAddress derives the address for mnemonic independently of the manager
+func derivedAddress(t *testing.T, mnemonic, hdPath string) string {
+	t.Helper()
+	kr := keyring.NewInMemory(testEncoding(t).codec)
+	record, err := kr.NewAccount("expected", mnemonic, keyring.DefaultBIP39Passphrase, hdPath, hd.Secp256k1)
+	require.NoError(t, err)
+	addr, err := record.GetAddress()
+	require.NoError(t, err)
+	return addr.String()
+}
+
+func TestImportAccountFromMnemonic(t *testing.T) {
+	am, kr := testKeyAccountManager(t, false)
+
+	account, err := am.ImportAccountFromMnemonic("pit-crew", "  "+testMnemonic+"\n", "")
+	require.NoError(t, err)
+	assert.Equal(t, derivedAddress(t, testMnemonic, sdk.FullFundraiserPath), account.Address)
+
+	// Stored in the keyring and used for the creator's transactions
+	assert.Equal(t, account, am.GetAccountForCreator("pit-crew"))
+	requireSignsWith(t, kr, "pit-crew")
+
+	_, err = am.ImportAccountFromMnemonic("pit-crew", testMnemonic, "")
+	assert.ErrorIs(t, err, ErrAccountExists)
+
+	second, err := am.ImportAccountFromMnemonic("pit-crew-2", testMnemonic, "m/44'/118'/0'/0/1")
+	require.NoError(t, err)
+	assert.Equal(t, derivedAddress(t, testMnemonic, "m/44'/118'/0'/0/1"), second.Address)
+	assert.NotEqual(t, account.Address, second.Address)
+}
+
+func TestImportAccountRejectsBadInput(t *testing.T) {
+	am, _ := testKeyAccountManager(t, false)
+
+	_, err := am.ImportAccountFromMnemonic("alice", "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon", "")
+	assert.ErrorIs(t, err, ErrInvalidMnemonic, "bad checksum")
+
+	_, err = am.ImportAccountFromMnemonic("alice", "not a mnemonic", "")
+	assert.ErrorIs(t, err, ErrInvalidMnemonic)
+
+	_, err = am.ImportAccountFromMnemonic("alice", testMnemonic, "m/44'/118'/x")
+	assert.ErrorIs(t, err, ErrInvalidHDPath)
+
+	_, err = am.ImportAccountFromMnemonic("../alice", testMnemonic, "")
+	assert.ErrorIs(t, err, ErrInvalidAccountName)
+
+	noKeyring := &AccountManager{logger: zerolog.Nop(), accounts: make(map[string]*Account)}
+	_, err = noKeyring.ImportAccountFromMnemonic("alice", testMnemonic, "")
+	assert.ErrorIs(t, err, ErrKeyringUnavailable)
+}
+
+func TestExportAccountMnemonic(t *testing.T) {
+	disabled, _ := testKeyAccountManager(t, false)
+	_, err := disabled.ImportAccountFromMnemonic("alice", testMnemonic, "")
+	require.NoError(t, err)
+	_, err = disabled.ExportAccountMnemonic("alice")
+	assert.ErrorIs(t, err, ErrMnemonicExportDisabled)
+
+	allowed, _ := testKeyAccountManager(t, true)
+	_, err = allowed.ImportAccountFromMnemonic("alice", testMnemonic, "")
+	require.NoError(t, err)
+	mnemonic, err := allowed.ExportAccountMnemonic("alice")
+	require.NoError(t, err)
+	assert.Equal(t, testMnemonic, mnemonic)
+
+	_, err = allowed.ExportAccountMnemonic("bob")
+	assert.ErrorIs(t, err, ErrMnemonicNotFound)
+
+	// What is kept on disk is sealed, and only the keyring passphrase opens it
+	sealed, err := os.ReadFile(allowed.mnemonicPath("alice"))
+	require.NoError(t, err)
+	assert.NotContains(t, string(sealed), "abandon")
+	_, err = openMnemonic(sealed, "wrong-passphrase")
+	assert.Error(t, err)
+
+	// Export is refused outright rather than kept in the clear
+	unsealed := &AccountManager{logger: zerolog.Nop(), accounts: make(map[string]*Account)}
+	err = unsealed.OpenKeyring(KeyringConfig{Backend: KeyringBackendTest, Dir: t.TempDir()}, true)
+	assert.ErrorIs(t, err, ErrMnemonicPassphraseRequired)
+}
@@ -2,10 +2,14 @@ package blockchain
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net/http"
 	"strings"
 	"sync"
 
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/rs/zerolog"
 )
 
@@ -13,7 +17,14 @@ import (
 type AccountManager struct {
 	logger   zerolog.Logger
 	accounts map[string]*Account
-	mu       sync.RWMutex
+	// mu guards accounts, sequences and keys; handlers share one manager
+	mu sync.RWMutex
+
+	// Predicted next sequence per signing address, see WithSequenceLock
+	sequences map[string]*AccountSequence
+
+	// Keyring accounts are signed with and imported into, see OpenKeyring
+	keys AccountKeysConfig
 }
 
 // Account represents a blockchain account
@@ -23,6 +34,17 @@ type Account struct {
 	KeyType string `json:"key_type"`
 }
 
+// AccountDetails is an account together with its on-chain state. Balances and
+// Sequence are only filled in when the chain was queried for them.
+type AccountDetails struct {
+	Account
+	Balances sdk.Coins `json:"balances,omitempty"`
+	Sequence *uint64   `json:"sequence,omitempty"`
+
+	// Error reports why the on-chain state of this account could not be read
+	Error string `json:"error,omitempty"`
+}
+
 // NewAccountManager creates a new account manager
 func NewAccountManager(logger zerolog.Logger) *AccountManager {
 	am := &AccountManager{
@@ -127,11 +149,13 @@ func (am *AccountManager) GetDefaultAccount() *Account {
 
 // GetAccountForCreator returns the best account for a given creator
 func (am *AccountManager) GetAccountForCreator(creator string) *Account {
-	am.mu.RLock()
-	defer am.mu.RUnlock()
-
 	// If creator matches an existing account name, use it
-	if account, exists := am.accounts[creator]; exists {
+	if account, exists := am.GetAccount(creator); exists {
+		return account
+	}
+
+	// Keys added to the keyring after startup are picked up on first use
+	if account, ok := am.loadKeyringAccount(creator); ok {
 		return account
 	}
 
@@ -160,6 +184,116 @@ func (am *AccountManager) GetAccount(name string) (*Account, bool) {
 	return account, exists
 }
 
+// OpenKeyring opens the keyring selected by cfg.Backend and makes it the
+// source of signing accounts: every key it holds replaces the account of the
+// same name, and the native signer signs with the same keyring.
+func (am *AccountManager) OpenKeyring(cfg KeyringConfig, allowExport bool) error {
+	// Kept mnemonics are sealed with the passphrase, never written in the clear
+	if allowExport && cfg.Passphrase == "" {
+		return ErrMnemonicPassphraseRequired
+	}
+	enc, err := newTxEncoding()
+	if err != nil {
+		return err
+	}
+	kr, err := newKeyring(cfg, enc.codec)
+	if err != nil {
+		return err
+	}
+	dir, err := expandHome(cfg.Dir)
+	if err != nil {
+		return err
+	}
+
+	am.UseKeyring(AccountKeysConfig{Keyring: kr, Dir: dir, AllowExport: allowExport, Passphrase: cfg.Passphrase})
+	return am.loadKeyringAccounts()
+}
+
+// Keyring returns the keyring accounts are signed with, or nil when none is open
+func (am *AccountManager) Keyring() keyring.Keyring {
+	am.mu.RLock()
+	defer am.mu.RUnlock()
+	return am.keys.Keyring
+}
+
+// loadKeyringAccounts replaces the known accounts with the keys in the keyring
+func (am *AccountManager) loadKeyringAccounts() error {
+	kr := am.Keyring()
+
+	// Listing reads every key from disk; only the map update needs the write lock
+	records, err := kr.List()
+	if err != nil {
+		return fmt.Errorf("failed to list %s keyring: %w", kr.Backend(), err)
+	}
+	accounts := make([]*Account, 0, len(records))
+	for _, record := range records {
+		account, err := keyringAccount(record)
+		if err != nil {
+			am.logger.Warn().Err(err).Str("name", record.Name).Msg("Skipping unreadable keyring key")
+			continue
+		}
+		accounts = append(accounts, account)
+	}
+
+	am.mu.Lock()
+	for _, account := range accounts {
+		am.accounts[account.Name] = account
+	}
+	am.mu.Unlock()
+
+	am.logger.Info().Str("backend", kr.Backend()).Int("count", len(records)).Msg("Loaded accounts from keyring")
+	return nil
+}
+
+// loadKeyringAccount looks name up in the keyring and remembers it as an account
+func (am *AccountManager) loadKeyringAccount(name string) (*Account, bool) {
+	am.mu.RLock()
+	kr := am.keys.Keyring
+	account, exists := am.accounts[name]
+	am.mu.RUnlock()
+
+	if kr == nil || name == "" {
+		return nil, false
+	}
+	if exists {
+		return account, true
+	}
+
+	// The keyring lookup may hit disk or a device, so it runs without the lock
+	record, err := kr.Key(name)
+	if err != nil {
+		return nil, false
+	}
+	account, err = keyringAccount(record)
+	if err != nil {
+		am.logger.Warn().Err(err).Str("name", name).Msg("Failed to read keyring key")
+		return nil, false
+	}
+
+	am.mu.Lock()
+	defer am.mu.Unlock()
+
+	// Another lookup may have stored the account meanwhile
+	if existing, exists := am.accounts[name]; exists {
+		return existing, true
+	}
+	am.accounts[name] = account
+	return account, true
+}
+
+// keyringAccount describes a keyring record as a signing account
+func keyringAccount(record *keyring.Record) (*Account, error) {
+	address, err := record.GetAddress()
+	if err != nil {
+		return nil, err
+	}
+	pubKey, err := record.GetPubKey()
+	if err != nil {
+		return nil, err
+	}
+	return &Account{Name: record.Name, Address: address.String(), KeyType: pubKey.Type()}, nil
+}
+
 // LoadIgniteAccounts attempts to load real Ignite CLI accounts
 func (am *AccountManager) LoadIgniteAccounts() {
 	am.logger.Info().Msg("Attempting to load real Ignite CLI accounts")
@@ -173,3 +307,47 @@ func (am *AccountManager) LoadIgniteAccounts() {
 	// 2. Run `ignite keys show <name> --output json` to get real addresses
 	// 3. Update the account information with real data
 }
+
+// GetAccountDetails reads the balance and sequence of each account from the chain.
+// A failed lookup is reported on that account rather than failing the whole list.
+func (c *RESTClient) GetAccountDetails(ctx context.Context, accounts []*Account) []AccountDetails {
+	details := make([]AccountDetails, 0, len(accounts))
+	for _, account := range accounts {
+		detail := AccountDetails{Account: *account}
+
+		balances, err := c.queryAccountBalances(ctx, account.Address)
+		if err != nil {
+			detail.Error = err.Error()
+			details = append(details, detail)
+			continue
+		}
+		detail.Balances = balances
+
+		sequence, err := c.queryAccountSequence(ctx, account.Address)
+		var httpErr *HTTPError
+		if errors.As(err, &httpErr) && httpErr.StatusCode == http.StatusNotFound {
+			// The auth module only knows accounts that have received funds or signed a tx
+			sequence, err = 0, nil
+		}
+		if err != nil {
+			detail.Error = err.Error()
+		} else {
+			detail.Sequence = &sequence
+		}
+
+		details = append(details, detail)
+	}
+	return details
+}
+
+// queryAccountBalances reads every balance held by address from the bank module
+func (c *RESTClient) queryAccountBalances(ctx context.Context, address string) (sdk.Coins, error) {
+	var resp struct {
+		Balances sdk.Coins `json:"balances"`
+	}
+	if err := c.queryJSON(ctx, "/cosmos/bank/v1beta1/balances/"+address, &resp); err != nil {
+		return nil, fmt.Errorf("failed to query balances of %s: %w", address, err)
+	}
+
+	return resp.Balances, nil
+}
@@ -0,0 +1,53 @@
+package blockchain
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestAccountManagerConcurrentAccess drives the account map from many
+// goroutines at once, as concurrent HTTP handlers do. Run it with -race.
+func TestAccountManagerConcurrentAccess(t *testing.T) {
+	am, kr := testKeyAccountManager(t, false)
+	am.initializeDefaultAccounts()
+
+	// Keys added to the keyring after startup are loaded on first use
+	for i := 0; i < 8; i++ {
+		_, err := kr.NewAccount(fmt.Sprintf("crew-%d", i), testMnemonic, keyring.DefaultBIP39Passphrase, fmt.Sprintf("m/44'/118'/0'/0/%d", i), hd.Secp256k1)
+		require.NoError(t, err)
+	}
+
+	const workers = 32
+	created := make([]*Account, workers)
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			account, err := am.GetOrCreateAccount(context.Background(), fmt.Sprintf("driver-%d", i%8))
+			assert.NoError(t, err)
+			created[i] = account
+
+			creator := am.GetAccountForCreator(fmt.Sprintf("crew-%d", i%8))
+			assert.Equal(t, fmt.Sprintf("crew-%d", i%8), creator.Name)
+			assert.NotEmpty(t, am.ListAccounts())
+			assert.NotNil(t, am.GetDefaultAccount())
+			assert.NoError(t, am.WithSequenceLock(context.Background(), creator, func(sequence *AccountSequence) error { return nil }))
+		}(i)
+	}
+	wg.Wait()
+
+	// Every caller asking for the same name got the same account
+	for i := 8; i < workers; i++ {
+		assert.Same(t, created[i%8], created[i])
+	}
+	// alice, bob, charlie, 8 drivers and 8 keyring accounts
+	assert.Len(t, am.ListAccounts(), 19)
+}
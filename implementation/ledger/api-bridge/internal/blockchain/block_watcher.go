@@ -0,0 +1,212 @@
+package blockchain
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/rs/zerolog"
+
+	"api-bridge/internal/config"
+)
+
+// newBlockQuery selects the node's NewBlock events
+const newBlockQuery = "tm.event='NewBlock'"
+
+// Block is a block the node has committed, as reported by its NewBlock event
+type Block struct {
+	Height  int64     `json:"height"`
+	Time    time.Time `json:"time"`
+	TxCount int       `json:"tx_count"`
+}
+
+// BlockWatcher follows the blocks the node commits through its RPC
+// websocket and hands each one to onBlock. A dropped connection is redialed
+// with a doubling delay; blocks committed while disconnected are not
+// replayed, but none is reported twice.
+type BlockWatcher struct {
+	cfg     config.BlockWatcherConfig
+	onBlock func(Block)
+	logger  zerolog.Logger
+	dialer  *websocket.Dialer
+	sleep   func(ctx context.Context, d time.Duration) error
+
+	lastHeight int64 // only touched by the run goroutine
+
+	cancel context.CancelFunc
+	done   chan struct{}
+	once   sync.Once
+}
+
+// NewBlockWatcher creates a watcher of the node at cfg.Endpoint; Start
+// begins watching
+func NewBlockWatcher(cfg config.BlockWatcherConfig, onBlock func(Block), logger zerolog.Logger) *BlockWatcher {
+	if cfg.ReconnectDelay <= 0 {
+		cfg.ReconnectDelay = time.Second
+	}
+	if cfg.MaxReconnectDelay < cfg.ReconnectDelay {
+		cfg.MaxReconnectDelay = cfg.ReconnectDelay
+	}
+	return &BlockWatcher{
+		cfg:     cfg,
+		onBlock: onBlock,
+		logger:  logger.With().Str("component", "block_watcher").Logger(),
+		dialer:  websocket.DefaultDialer,
+		sleep:   sleepContext,
+		done:    make(chan struct{}),
+	}
+}
+
+// Start watches in the background until Stop is called
+func (w *BlockWatcher) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	w.cancel = cancel
+	go w.run(ctx)
+}
+
+// Stop closes the connection and waits for the watcher to exit
+func (w *BlockWatcher) Stop() {
+	w.once.Do(func() {
+		if w.cancel != nil {
+			w.cancel()
+			<-w.done
+		}
+	})
+}
+
+// run keeps a subscription open, reconnecting whenever it drops
+func (w *BlockWatcher) run(ctx context.Context) {
+	defer close(w.done)
+
+	delay := w.cfg.ReconnectDelay
+	for {
+		subscribed, err := w.watch(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		// A connection that got as far as subscribing starts the backoff over
+		if subscribed {
+			delay = w.cfg.ReconnectDelay
+		}
+		w.logger.Warn().Err(err).Dur("retry_in", delay).Msg("Block subscription dropped; reconnecting")
+		if w.sleep(ctx, delay) != nil {
+			return
+		}
+		if !subscribed {
+			delay = min(delay*2, w.cfg.MaxReconnectDelay)
+		}
+	}
+}
+
+// watch dials the node, subscribes to NewBlock and reports blocks until the
+// connection fails or ctx is done. It reports whether the subscription was
+// acknowledged.
+func (w *BlockWatcher) watch(ctx context.Context) (bool, error) {
+	conn, _, err := w.dialer.DialContext(ctx, w.cfg.Endpoint, nil)
+	if err != nil {
+		return false, fmt.Errorf("failed to dial %s: %w", w.cfg.Endpoint, err)
+	}
+	defer conn.Close()
+
+	// Closing the connection unblocks the read below once ctx is done
+	stop := context.AfterFunc(ctx, func() { conn.Close() })
+	defer stop()
+
+	w.extendDeadline(conn)
+	conn.SetPingHandler(func(data string) error {
+		w.extendDeadline(conn)
+		return conn.WriteControl(websocket.PongMessage, []byte(data), time.Now().Add(time.Second))
+	})
+
+	subscribe := map[string]interface{}{
+		"jsonrpc": "2.0",
+		"method":  "subscribe",
+		"id":      1,
+		"params":  map[string]string{"query": newBlockQuery},
+	}
+	if err := conn.WriteJSON(subscribe); err != nil {
+		return false, fmt.Errorf("failed to subscribe: %w", err)
+	}
+
+	subscribed := false
+	for {
+		_, raw, err := conn.ReadMessage()
+		if err != nil {
+			return subscribed, err
+		}
+		w.extendDeadline(conn)
+
+		block, ok, err := parseNewBlock(raw)
+		if err != nil {
+			return subscribed, err
+		}
+		// The first reply is the empty result acknowledging the subscription
+		if !subscribed {
+			subscribed = true
+			w.logger.Info().Str("endpoint", w.cfg.Endpoint).Msg("Subscribed to new blocks")
+		}
+		if !ok || block.Height <= w.lastHeight {
+			continue
+		}
+		w.lastHeight = block.Height
+		w.onBlock(block)
+	}
+}
+
+// extendDeadline gives the node another idle timeout to send something
+func (w *BlockWatcher) extendDeadline(conn *websocket.Conn) {
+	if w.cfg.IdleTimeout > 0 {
+		conn.SetReadDeadline(time.Now().Add(w.cfg.IdleTimeout))
+	}
+}
+
+// parseNewBlock decodes one JSON-RPC message of a NewBlock subscription.
+// It reports false for messages carrying no block, such as the
+// subscription's acknowledgement, and an error for JSON-RPC errors.
+func parseNewBlock(raw []byte) (Block, bool, error) {
+	var message struct {
+		Result struct {
+			Data struct {
+				Value struct {
+					Block struct {
+						Header struct {
+							Height string    `json:"height"`
+							Time   time.Time `json:"time"`
+						} `json:"header"`
+						Data struct {
+							Txs []string `json:"txs"`
+						} `json:"data"`
+					} `json:"block"`
+				} `json:"value"`
+			} `json:"data"`
+		} `json:"result"`
+		Error *struct {
+			Message string `json:"message"`
+			Data    string `json:"data"`
+		} `json:"error"`
+	}
+	if err := json.Unmarshal(raw, &message); err != nil {
+		return Block{}, false, fmt.Errorf("failed to parse block event: %w", err)
+	}
+	if message.Error != nil {
+		return Block{}, false, fmt.Errorf("node rejected the subscription: %s (%s)", message.Error.Message, message.Error.Data)
+	}
+
+	header := message.Result.Data.Value.Block.Header
+	if header.Height == "" {
+		return Block{}, false, nil
+	}
+	height, err := strconv.ParseInt(header.Height, 10, 64)
+	if err != nil {
+		return Block{}, false, fmt.Errorf("invalid block height %q: %w", header.Height, err)
+	}
+	return Block{
+		Height:  height,
+		Time:    header.Time,
+		TxCount: len(message.Result.Data.Value.Block.Data.Txs),
+	}, true, nil
+}
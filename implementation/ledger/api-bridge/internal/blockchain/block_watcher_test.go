@@ -0,0 +1,137 @@
+package blockchain
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"api-bridge/internal/config"
+)
+
+// newBlockEvent is a NewBlock message as the node's RPC websocket sends it
+func newBlockEvent(height int, txs ...string) string {
+	quoted := make([]string, len(txs))
+	for i, tx := range txs {
+		quoted[i] = fmt.Sprintf("%q", tx)
+	}
+	return fmt.Sprintf(`{"jsonrpc": "2.0", "id": 1, "result": {"query": "tm.event='NewBlock'", "data": {"type": "tendermint/event/NewBlock",
+		"value": {"block": {"header": {"height": "%d", "time": "2026-10-16T12:00:%02dZ"}, "data": {"txs": [%s]}}}}}}`,
+		height, height, strings.Join(quoted, ","))
+}
+
+func TestBlockWatcherReconnectsWithoutRepeatingBlocks(t *testing.T) {
+	// Each connection is answered with the next script, then dropped
+	scripts := [][]string{
+		{`{"jsonrpc": "2.0", "id": 1, "result": {}}`, newBlockEvent(5), newBlockEvent(6, "dHgx", "dHgy")},
+		{`{"jsonrpc": "2.0", "id": 1, "result": {}}`, newBlockEvent(6, "dHgx", "dHgy"), newBlockEvent(7)},
+	}
+	var mu sync.Mutex
+	connections := 0
+	upgrader := websocket.Upgrader{}
+	node := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if !assert.NoError(t, err) {
+			return
+		}
+		defer conn.Close()
+
+		var subscribe struct {
+			Method string            `json:"method"`
+			Params map[string]string `json:"params"`
+		}
+		if !assert.NoError(t, conn.ReadJSON(&subscribe)) {
+			return
+		}
+		assert.Equal(t, "subscribe", subscribe.Method)
+		assert.Equal(t, newBlockQuery, subscribe.Params["query"])
+
+		mu.Lock()
+		n := connections
+		connections++
+		mu.Unlock()
+		if n >= len(scripts) {
+			// Hold the connection open until the watcher stops
+			conn.ReadMessage()
+			return
+		}
+		for _, message := range scripts[n] {
+			assert.NoError(t, conn.WriteMessage(websocket.TextMessage, []byte(message)))
+		}
+	}))
+	defer node.Close()
+
+	blocks := make(chan Block, 10)
+	cfg := config.BlockWatcherConfig{Endpoint: "ws" + strings.TrimPrefix(node.URL, "http"), ReconnectDelay: time.Millisecond}
+	w := NewBlockWatcher(cfg, func(block Block) { blocks <- block }, zerolog.Nop())
+	var delays []time.Duration
+	w.sleep = func(ctx context.Context, d time.Duration) error {
+		delays = append(delays, d)
+		return ctx.Err()
+	}
+	w.Start()
+
+	var got []Block
+	for len(got) < 3 {
+		select {
+		case block := <-blocks:
+			got = append(got, block)
+		case <-time.After(5 * time.Second):
+			t.Fatalf("received %d blocks", len(got))
+		}
+	}
+	w.Stop()
+
+	assert.Equal(t, []Block{
+		{Height: 5, Time: time.Date(2026, 10, 16, 12, 0, 5, 0, time.UTC)},
+		{Height: 6, Time: time.Date(2026, 10, 16, 12, 0, 6, 0, time.UTC), TxCount: 2},
+		{Height: 7, Time: time.Date(2026, 10, 16, 12, 0, 7, 0, time.UTC)},
+	}, got)
+	assert.Empty(t, blocks)
+	// Every connection subscribed, so none of them grew the delay
+	for _, d := range delays {
+		assert.Equal(t, time.Millisecond, d)
+	}
+}
+
+func TestBlockWatcherBacksOffWhileNodeIsDown(t *testing.T) {
+	node := httptest.NewServer(http.NotFoundHandler())
+	node.Close()
+
+	cfg := config.BlockWatcherConfig{Endpoint: "ws" + strings.TrimPrefix(node.URL, "http"), ReconnectDelay: time.Second, MaxReconnectDelay: 4 * time.Second}
+	w := NewBlockWatcher(cfg, func(Block) { t.Error("no block expected") }, zerolog.Nop())
+	ctx, cancel := context.WithCancel(context.Background())
+	var delays []time.Duration
+	w.sleep = func(_ context.Context, d time.Duration) error {
+		delays = append(delays, d)
+		if len(delays) == 5 {
+			cancel()
+		}
+		return ctx.Err()
+	}
+	w.run(ctx)
+
+	assert.Equal(t, []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 4 * time.Second, 4 * time.Second}, delays)
+}
+
+func TestParseNewBlockRejectsSubscriptionErrors(t *testing.T) {
+	_, ok, err := parseNewBlock([]byte(`{"jsonrpc": "2.0", "id": 1, "error": {"code": -32603, "message": "Internal error", "data": "max_subscriptions_per_client 5 reached"}}`))
+	assert.False(t, ok)
+	assert.ErrorContains(t, err, "max_subscriptions_per_client 5 reached")
+
+	// A block without transactions reports null txs
+	block, ok, err := parseNewBlock([]byte(`{"result": {"data": {"value": {"block": {"header": {"height": "12", "time": "2026-10-16T12:00:00Z"}, "data": {"txs": null}}}}}}`))
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, int64(12), block.Height)
+	assert.Zero(t, block.TxCount)
+}
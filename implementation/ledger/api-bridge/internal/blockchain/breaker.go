@@ -0,0 +1,194 @@
+package blockchain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"api-bridge/internal/config"
+)
+
+// ErrChainUnavailable is returned without broadcasting while the broadcast
+// circuit breaker is open
+var ErrChainUnavailable = errors.New("chain unavailable")
+
+// Circuit breaker states reported by BreakerStats
+const (
+	BreakerClosed   = "closed"
+	BreakerOpen     = "open"
+	BreakerHalfOpen = "half_open" // a trial broadcast is testing whether the node is back
+)
+
+// Error fragments of broadcasts that failed because the node could not be
+// reached, as opposed to the chain rejecting the transaction
+var chainUnavailableErrors = []string{
+	"connection refused",
+	"connection reset",
+	"no such host",
+	"i/o timeout",
+	"code = unavailable",
+}
+
+// BreakerStats reports the broadcast circuit breaker's state
+type BreakerStats struct {
+	Enabled             bool       `json:"enabled"`
+	State               string     `json:"state,omitempty"`
+	ConsecutiveFailures int        `json:"consecutive_failures"`
+	Opened              uint64     `json:"opened"`          // times the breaker has opened
+	ShortCircuited      uint64     `json:"short_circuited"` // broadcasts refused while open
+	RetryAt             *time.Time `json:"retry_at,omitempty"`
+}
+
+// broadcastBreaker stops broadcasting after threshold consecutive broadcasts
+// failed to reach the node, so requests fail fast instead of each waiting
+// out the timeout. Once cooldown has passed a single trial broadcast is let
+// through: reaching the node closes the breaker, failing opens it again.
+// A nil breaker lets every broadcast through.
+type broadcastBreaker struct {
+	mu        sync.Mutex
+	threshold int
+	cooldown  time.Duration
+	logger    zerolog.Logger
+	now       func() time.Time
+
+	failures       int
+	open           bool
+	openedAt       time.Time
+	trial          bool
+	opened         uint64
+	shortCircuited uint64
+}
+
+// newBroadcastBreaker returns nil, which never trips, when the threshold is 0
+func newBroadcastBreaker(cfg config.BreakerConfig, logger zerolog.Logger) *broadcastBreaker {
+	if cfg.FailureThreshold <= 0 {
+		return nil
+	}
+	return &broadcastBreaker{
+		threshold: cfg.FailureThreshold,
+		cooldown:  cfg.Cooldown,
+		logger:    logger,
+		now:       time.Now,
+	}
+}
+
+// allow returns ErrChainUnavailable while the breaker is open. When it lets
+// a broadcast through, the caller must report the outcome with record.
+func (b *broadcastBreaker) allow() error {
+	if b == nil {
+		return nil
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if !b.open {
+		return nil
+	}
+	retryAt := b.openedAt.Add(b.cooldown)
+	if b.trial || b.now().Before(retryAt) {
+		b.shortCircuited++
+		return fmt.Errorf("%w: %d consecutive broadcasts failed to reach the node, retrying after %s",
+			ErrChainUnavailable, b.failures, retryAt.Format(time.RFC3339))
+	}
+	b.trial = true
+	return nil
+}
+
+// record reports the outcome of a broadcast allow let through. A broadcast
+// the node answered counts as a success even if the transaction failed; one
+// cancelled by its caller, or that timed out queued behind other broadcasts
+// of its account, says nothing about the node.
+func (b *broadcastBreaker) record(err error) {
+	if b == nil {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	wasTrial := b.trial
+	b.trial = false
+	switch {
+	case errors.Is(err, context.Canceled), errors.Is(err, errSequenceLockWait):
+		return
+	case !isChainUnavailable(err):
+		if b.open {
+			b.logger.Info().Msg("Broadcast reached the node, closing the circuit breaker")
+		}
+		b.failures = 0
+		b.open = false
+		return
+	}
+
+	b.failures++
+	if wasTrial || (!b.open && b.failures >= b.threshold) {
+		if !b.open {
+			b.opened++
+		}
+		b.open = true
+		b.openedAt = b.now()
+		b.logger.Warn().Err(err).Int("consecutive_failures", b.failures).Dur("cooldown", b.cooldown).
+			Msg("Broadcasts are failing to reach the node, opening the circuit breaker")
+	}
+}
+
+// stats reports the breaker's current state
+func (b *broadcastBreaker) stats() BreakerStats {
+	if b == nil {
+		return BreakerStats{}
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	stats := BreakerStats{
+		Enabled:             true,
+		State:               BreakerClosed,
+		ConsecutiveFailures: b.failures,
+		Opened:              b.opened,
+		ShortCircuited:      b.shortCircuited,
+	}
+	if b.open {
+		retryAt := b.openedAt.Add(b.cooldown)
+		stats.RetryAt = &retryAt
+		stats.State = BreakerOpen
+		if b.trial || !b.now().Before(retryAt) {
+			stats.State = BreakerHalfOpen
+		}
+	}
+	return stats
+}
+
+// isChainUnavailable reports whether a broadcast failed because the node
+// could not be reached in time
+func isChainUnavailable(err error) bool {
+	if err == nil {
+		return false
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+	var httpErr *HTTPError
+	if errors.As(err, &httpErr) {
+		switch httpErr.StatusCode {
+		case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+			return true
+		}
+	}
+	lower := strings.ToLower(err.Error())
+	for _, fragment := range chainUnavailableErrors {
+		if strings.Contains(lower, fragment) {
+			return true
+		}
+	}
+	return false
+}
+
+// BreakerStats reports the broadcast circuit breaker's state
+func (c *Client) BreakerStats() BreakerStats {
+	return c.restClient.breaker.stats()
+}
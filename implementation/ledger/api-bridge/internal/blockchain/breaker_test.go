@@ -0,0 +1,89 @@
+package blockchain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"api-bridge/internal/config"
+)
+
+// unreachableTxExecutor fails every broadcast with err while it is set
+type unreachableTxExecutor struct {
+	fakeTxExecutor
+	err   error
+	calls int
+}
+
+func (u *unreachableTxExecutor) Execute(ctx context.Context, account *Account, message map[string]interface{}, memo string, gas txGas) (map[string]interface{}, error) {
+	u.calls++
+	if u.err != nil {
+		return nil, u.err
+	}
+	return u.fakeTxExecutor.Execute(ctx, account, message, memo, gas)
+}
+
+func TestBroadcastBreakerShortCircuitsUnreachableNode(t *testing.T) {
+	c, _ := testGasClient(t, config.GasConfig{Limit: 200000}, nil)
+	executor := &unreachableTxExecutor{err: errors.New("post failed: dial tcp 127.0.0.1:1317: connect: connection refused")}
+	c.txExecutor = executor
+
+	now := time.Unix(1700000000, 0)
+	c.breaker = newBroadcastBreaker(config.BreakerConfig{FailureThreshold: 2, Cooldown: 30 * time.Second}, zerolog.Nop())
+	c.breaker.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	for range 2 {
+		_, err := c.executeTransaction(ctx, registerMessage(), "")
+		require.Error(t, err)
+		assert.NotErrorIs(t, err, ErrChainUnavailable)
+	}
+	assert.Equal(t, BreakerOpen, c.breaker.stats().State)
+
+	// Open: writes fail fast without reaching the executor
+	_, err := c.executeTransaction(ctx, registerMessage(), "")
+	require.ErrorIs(t, err, ErrChainUnavailable)
+	assert.Equal(t, 2, executor.calls)
+
+	// After the cooldown a trial broadcast that fails opens it again
+	now = now.Add(31 * time.Second)
+	assert.Equal(t, BreakerHalfOpen, c.breaker.stats().State)
+	_, err = c.executeTransaction(ctx, registerMessage(), "")
+	assert.NotErrorIs(t, err, ErrChainUnavailable)
+	assert.Equal(t, 3, executor.calls)
+	_, err = c.executeTransaction(ctx, registerMessage(), "")
+	require.ErrorIs(t, err, ErrChainUnavailable)
+
+	// A trial that reaches the node closes it
+	now = now.Add(31 * time.Second)
+	executor.err = nil
+	_, err = c.executeTransaction(ctx, registerMessage(), "")
+	require.NoError(t, err)
+	stats := c.breaker.stats()
+	assert.Equal(t, BreakerClosed, stats.State)
+	assert.Equal(t, 0, stats.ConsecutiveFailures)
+	assert.Equal(t, uint64(1), stats.Opened)
+	assert.Equal(t, uint64(2), stats.ShortCircuited)
+}
+
+func TestBroadcastBreakerIgnoresRejectedTransactions(t *testing.T) {
+	breaker := newBroadcastBreaker(config.BreakerConfig{FailureThreshold: 1, Cooldown: time.Minute}, zerolog.Nop())
+
+	// The node answered, so the chain is up
+	breaker.record(errors.New("insufficient funds: 10stake is smaller than 20stake"))
+	breaker.record(context.Canceled)
+	// Timing out queued behind the account's other broadcasts never reached it
+	breaker.record(fmt.Errorf("%w of cosmos1alice: %w", errSequenceLockWait, context.DeadlineExceeded))
+	assert.Equal(t, BreakerClosed, breaker.stats().State)
+
+	breaker.record(&HTTPError{StatusCode: 503, Body: "node is syncing"})
+	assert.Equal(t, BreakerOpen, breaker.stats().State)
+
+	assert.Nil(t, newBroadcastBreaker(config.BreakerConfig{}, zerolog.Nop()), "a zero threshold disables the breaker")
+}
@@ -0,0 +1,188 @@
+package blockchain
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Queries that can be cached; these names key blockchain.cache.ttl
+const (
+	cacheComponent     = "component"
+	cacheLCT           = "lct"
+	cacheEnergyBalance = "energy_balance"
+)
+
+// CacheStats reports how the query cache has been used since start-up
+type CacheStats struct {
+	Enabled  bool   `json:"enabled"`
+	Hits     uint64 `json:"hits"`
+	Misses   uint64 `json:"misses"`
+	Bypassed uint64 `json:"bypassed"` // reads that skipped the cache on request
+	Entries  int    `json:"entries"`
+}
+
+// queryCache keeps recent query results for a few seconds so dashboards that
+// poll the same component, LCT or balance do not each reach the node
+type queryCache struct {
+	mu      sync.Mutex
+	ttls    map[string]time.Duration
+	entries map[string]cachedQuery
+	// generation moves on every invalidation, so a read that started before
+	// a write cannot store its now stale result
+	generation uint64
+
+	hits, misses, bypassed atomic.Uint64
+}
+
+type cachedQuery struct {
+	result  map[string]interface{}
+	expires time.Time
+}
+
+// newQueryCache returns nil, which caches nothing, when no query has a TTL
+func newQueryCache(ttls map[string]time.Duration) *queryCache {
+	enabled := make(map[string]time.Duration)
+	for query, ttl := range ttls {
+		if ttl > 0 {
+			enabled[query] = ttl
+		}
+	}
+	if len(enabled) == 0 {
+		return nil
+	}
+	return &queryCache{ttls: enabled, entries: make(map[string]cachedQuery)}
+}
+
+type cacheBypassKey struct{}
+
+// WithoutCache marks ctx so reads made with it go to the node and do not
+// refresh the cache, e.g. for a request sent with Cache-Control: no-cache
+func WithoutCache(ctx context.Context) context.Context {
+	return context.WithValue(ctx, cacheBypassKey{}, true)
+}
+
+func cacheBypassed(ctx context.Context) bool {
+	bypass, _ := ctx.Value(cacheBypassKey{}).(bool)
+	return bypass
+}
+
+// cached answers a query from the cache while its entry is fresh and
+// otherwise runs it through coalesce, storing a successful result
+func (c *Client) cached(ctx context.Context, query, id string, fn func(context.Context) (map[string]interface{}, error)) (map[string]interface{}, error) {
+	key := query + ":" + id
+	cache := c.cache
+	if cache == nil || cache.ttls[query] == 0 {
+		return c.coalesce(ctx, key, fn)
+	}
+	if cacheBypassed(ctx) {
+		cache.bypassed.Add(1)
+		return c.coalesce(ctx, key, fn)
+	}
+
+	now := time.Now()
+	cache.mu.Lock()
+	entry, ok := cache.entries[key]
+	generation := cache.generation
+	cache.mu.Unlock()
+	if ok && now.Before(entry.expires) {
+		cache.hits.Add(1)
+		return copyResult(entry.result), nil
+	}
+	cache.misses.Add(1)
+
+	result, err := c.coalesce(ctx, key, fn)
+	if err != nil {
+		return nil, err
+	}
+
+	cache.mu.Lock()
+	if cache.generation == generation {
+		cache.prune(now)
+		cache.entries[key] = cachedQuery{result: copyResult(result), expires: now.Add(cache.ttls[query])}
+	}
+	cache.mu.Unlock()
+	return result, nil
+}
+
+// invalidate drops the cached result of one query
+func (c *Client) invalidate(query, id string) {
+	if c.cache == nil {
+		return
+	}
+	c.cache.mu.Lock()
+	defer c.cache.mu.Unlock()
+	c.cache.generation++
+	delete(c.cache.entries, query+":"+id)
+}
+
+// invalidateAll drops every cached result of a query, for writes that do
+// not say which entity they touched
+func (c *Client) invalidateAll(query string) {
+	if c.cache == nil {
+		return
+	}
+	c.cache.mu.Lock()
+	defer c.cache.mu.Unlock()
+	c.cache.generation++
+	for key := range c.cache.entries {
+		if strings.HasPrefix(key, query+":") {
+			delete(c.cache.entries, key)
+		}
+	}
+}
+
+// invalidating wraps a write's result and, once the write has succeeded,
+// drops the cached results it made stale: those of ids, or of every entity
+// when the write does not say which it touched
+func (c *Client) invalidating(query string, ids ...string) func(map[string]interface{}, error) (map[string]interface{}, error) {
+	return func(result map[string]interface{}, err error) (map[string]interface{}, error) {
+		if err != nil {
+			return result, err
+		}
+		if len(ids) == 0 {
+			c.invalidateAll(query)
+		}
+		for _, id := range ids {
+			c.invalidate(query, id)
+		}
+		return result, nil
+	}
+}
+
+// CacheStats reports the query cache's hit and miss counts
+func (c *Client) CacheStats() CacheStats {
+	if c.cache == nil {
+		return CacheStats{}
+	}
+	c.cache.mu.Lock()
+	entries := len(c.cache.entries)
+	c.cache.mu.Unlock()
+	return CacheStats{
+		Enabled:  true,
+		Hits:     c.cache.hits.Load(),
+		Misses:   c.cache.misses.Load(),
+		Bypassed: c.cache.bypassed.Load(),
+		Entries:  entries,
+	}
+}
+
+// prune drops expired entries; callers hold mu
+func (qc *queryCache) prune(now time.Time) {
+	for key, entry := range qc.entries {
+		if !now.Before(entry.expires) {
+			delete(qc.entries, key)
+		}
+	}
+}
+
+// copyResult gives each caller its own top-level map, as coalesce does
+func copyResult(result map[string]interface{}) map[string]interface{} {
+	copied := make(map[string]interface{}, len(result))
+	for k, v := range result {
+		copied[k] = v
+	}
+	return copied
+}
@@ -0,0 +1,135 @@
+package blockchain
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"api-bridge/internal/config"
+)
+
+func cachingClient(t *testing.T, ttl time.Duration) (*Client, *int32) {
+	t.Helper()
+	var requests int32
+	node := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		_, _ = w.Write([]byte(`{"component": {"component_id": "MODBATT-MOD-001", "status": "active"}}`))
+	}))
+	t.Cleanup(node.Close)
+
+	client, err := NewClient(node.URL, zerolog.Nop())
+	require.NoError(t, err)
+	client.cache = newQueryCache(map[string]time.Duration{cacheComponent: ttl})
+	return client, &requests
+}
+
+func TestRepeatedQueriesAreServedFromCache(t *testing.T) {
+	client, requests := cachingClient(t, time.Minute)
+	ctx := context.Background()
+
+	for i := 0; i < 3; i++ {
+		component, err := client.GetComponent(ctx, "MODBATT-MOD-001")
+		require.NoError(t, err)
+		assert.Equal(t, "MODBATT-MOD-001", component["component_id"])
+	}
+	assert.Equal(t, int32(1), atomic.LoadInt32(requests))
+
+	// Callers get their own copy of the cached result
+	component, err := client.GetComponent(ctx, "MODBATT-MOD-001")
+	require.NoError(t, err)
+	component["status"] = "tampered"
+	component, err = client.GetComponent(ctx, "MODBATT-MOD-001")
+	require.NoError(t, err)
+	assert.Equal(t, "active", component["status"])
+
+	// No-cache reads go to the node and do not count as hits or misses
+	_, err = client.GetComponent(WithoutCache(ctx), "MODBATT-MOD-001")
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), atomic.LoadInt32(requests))
+
+	assert.Equal(t, CacheStats{Enabled: true, Hits: 4, Misses: 1, Bypassed: 1, Entries: 1}, client.CacheStats())
+}
+
+func TestCachedQueriesExpire(t *testing.T) {
+	client, requests := cachingClient(t, 50*time.Millisecond)
+	ctx := context.Background()
+
+	_, err := client.GetComponent(ctx, "MODBATT-MOD-001")
+	require.NoError(t, err)
+	time.Sleep(60 * time.Millisecond)
+	_, err = client.GetComponent(ctx, "MODBATT-MOD-001")
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), atomic.LoadInt32(requests))
+}
+
+func TestSuccessfulWritesInvalidateCachedQueries(t *testing.T) {
+	client, requests := cachingClient(t, time.Minute)
+	ctx := context.Background()
+
+	_, err := client.GetComponent(ctx, "MODBATT-MOD-001")
+	require.NoError(t, err)
+
+	// A failed write leaves the cache alone
+	_, err = client.invalidating(cacheComponent, "MODBATT-MOD-001")(nil, errors.New("broadcast failed"))
+	require.Error(t, err)
+	_, err = client.GetComponent(ctx, "MODBATT-MOD-001")
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), atomic.LoadInt32(requests))
+
+	_, err = client.invalidating(cacheComponent, "MODBATT-MOD-001")(map[string]interface{}{"txhash": "ABC"}, nil)
+	require.NoError(t, err)
+	_, err = client.GetComponent(ctx, "MODBATT-MOD-001")
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), atomic.LoadInt32(requests))
+
+	// Writes that name no entity drop every entry of the query
+	_, err = client.invalidating(cacheComponent)(map[string]interface{}{}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 0, client.CacheStats().Entries)
+}
+
+func TestRotatingASplitKeyInvalidatesTheCachedLCT(t *testing.T) {
+	var requests int32
+	rest, _ := testGasClient(t, config.GasConfig{Limit: 200000}, func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		pairingNode(w, r)
+	})
+	rest.txExecutor = &pairingTxExecutor{result: `{"txhash": "ABC", "code": 0, "events": [
+	  {"type": "split_key_rotated", "attributes": [{"key": "lct_id", "value": "lct-PACK-MC-1"}, {"key": "key_reference", "value": "bb22"}]}
+	]}`}
+	client := &Client{restClient: rest, logger: zerolog.Nop(), cache: newQueryCache(map[string]time.Duration{cacheLCT: time.Minute})}
+	ctx := context.Background()
+
+	_, err := client.GetLCT(ctx, "lct-PACK-MC-1")
+	require.NoError(t, err)
+	_, err = client.GetLCT(ctx, "lct-PACK-MC-1")
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&requests))
+
+	// The LCT records its new key, so the next read reaches the node
+	_, err = client.RotateSplitKey(ctx, "alice", "lct-PACK-MC-1", "scheduled")
+	require.NoError(t, err)
+	_, err = client.GetLCT(ctx, "lct-PACK-MC-1")
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&requests))
+}
+
+func TestClientWithoutCacheAlwaysQueriesNode(t *testing.T) {
+	client, requests := cachingClient(t, 0)
+	assert.Nil(t, client.cache)
+
+	for i := 0; i < 2; i++ {
+		_, err := client.GetComponent(context.Background(), "MODBATT-MOD-001")
+		require.NoError(t, err)
+	}
+	assert.Equal(t, int32(2), atomic.LoadInt32(requests))
+	assert.Equal(t, CacheStats{}, client.CacheStats())
+}
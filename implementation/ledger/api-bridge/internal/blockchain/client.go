@@ -4,14 +4,35 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"time"
 
 	"github.com/rs/zerolog"
+	"golang.org/x/sync/singleflight"
+
+	"api-bridge/internal/config"
 )
 
 // Client represents a blockchain client
 type Client struct {
 	restClient *RESTClient
 	logger     zerolog.Logger
+
+	// queries coalesces concurrent identical reads into one node request
+	queries singleflight.Group
+	// cache holds recent results of read-heavy queries; nil caches nothing
+	cache *queryCache
+	// nodeInfo holds the node's last reported versions
+	nodeInfo nodeInfoCache
+
+	// keyringConfigured is set when a keyring backend is configured; keyringErr
+	// holds why it could not be opened
+	keyringConfigured bool
+	keyringErr        error
+
+	// schemas component_data is checked against before registration
+	schemas componentSchemas
+	// readiness holds the last Readiness result
+	readiness readinessCache
 }
 
 // NewClient creates a new blockchain client
@@ -25,6 +46,116 @@ func NewClient(endpoint string, logger zerolog.Logger) (*Client, error) {
 	}, nil
 }
 
+// mockClient creates the in-memory chain client of blockchain.mock. Only
+// mockchain builds set it, so production binaries cannot serve a fake chain.
+var mockClient func(logger zerolog.Logger) *Client
+
+// NewClientFromConfig creates a blockchain client that signs transactions
+// using the mode selected in blockchain.tx_mode, or an in-memory mock chain
+// with blockchain.mock
+func NewClientFromConfig(cfg config.BlockchainConfig, logger zerolog.Logger) (*Client, error) {
+	if cfg.Mock {
+		if mockClient == nil {
+			return nil, fmt.Errorf("blockchain.mock needs a bridge built with -tags mockchain")
+		}
+		logger.Warn().Msg("Blockchain mock mode: transactions and queries are served from memory, nothing reaches a chain")
+		return mockClient(logger), nil
+	}
+
+	client, err := NewClient(cfg.RESTEndpoint, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	httpClient, err := newHTTPClient(cfg.HTTP)
+	if err != nil {
+		return nil, err
+	}
+	client.restClient.client = httpClient
+	client.restClient.retry = cfg.Retry
+	client.restClient.chainID = cfg.ChainID
+	client.restClient.breaker = newBroadcastBreaker(cfg.Breaker, logger)
+	if cfg.Cache.Enabled {
+		client.cache = newQueryCache(cfg.Cache.TTL)
+	}
+
+	gas, err := newGasSettings(cfg.Gas)
+	if err != nil {
+		return nil, err
+	}
+	client.restClient.gas = gas
+	client.restClient.simulateTimeout = cfg.SimulateTimeoutDuration()
+	if cfg.WaitForCommit {
+		client.restClient.commitTimeout = cfg.CommitTimeoutDuration()
+	}
+
+	if cfg.KeyDelivery.Enabled {
+		componentKeys, err := parseComponentKeys(cfg.KeyDelivery.ComponentKeys)
+		if err != nil {
+			return nil, err
+		}
+		client.restClient.componentKeys = componentKeys
+	}
+
+	allowed, err := newMessageTypeAllowlist(cfg.AllowedMessageTypes)
+	if err != nil {
+		return nil, err
+	}
+	client.restClient.allowedMsgTypes = allowed
+
+	keyringCfg := KeyringConfig{
+		Backend:    cfg.KeyringBackend,
+		Dir:        cfg.KeyringDir,
+		Passphrase: os.Getenv(cfg.KeyringPassphraseEnv),
+		HSMPlugin:  cfg.HSM.Plugin,
+		HSMOptions: cfg.HSM.Options,
+	}
+	accounts := client.restClient.accountManager
+	keyringErr := accounts.OpenKeyring(keyringCfg, cfg.AllowMnemonicExport)
+	client.keyringConfigured = cfg.KeyringBackend != ""
+	client.keyringErr = keyringErr
+
+	switch cfg.TxMode {
+	case "", TxModeCLI:
+		// The REST client defaults to the CLI executor
+		if cfg.KeyringBackend == KeyringBackendHSM {
+			return nil, fmt.Errorf("keyring backend %q requires tx_mode %q", KeyringBackendHSM, TxModeNative)
+		}
+		if keyringErr != nil {
+			// The CLI opens the keyring itself; only account lookup and import need it here.
+			// Pointing the CLI at a keyring that did not open would only fail later.
+			logger.Warn().Err(keyringErr).Str("backend", cfg.KeyringBackend).Msg("Keyring unavailable, using default accounts")
+		} else {
+			client.restClient.keyring = keyringCfg
+		}
+	case TxModeNative:
+		if keyringErr != nil {
+			return nil, fmt.Errorf("failed to open keyring: %w", keyringErr)
+		}
+		executor, err := NewNativeTxExecutor(NativeTxConfig{
+			GRPCEndpoint: cfg.GRPCEndpoint,
+			ChainID:      cfg.ChainID,
+			Keybase:      accounts.Keyring(),
+		}, logger)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create native transaction signer: %w", err)
+		}
+		client.restClient.txExecutor = executor
+	default:
+		return nil, fmt.Errorf("unknown transaction mode %q (expected %q or %q)", cfg.TxMode, TxModeCLI, TxModeNative)
+	}
+
+	logger.Info().Str("tx_mode", client.restClient.txExecutor.Mode()).Msg("Blockchain transaction mode configured")
+	return client, nil
+}
+
+// SetKeyHalfDelivery sets the channel split key halves are delivered over
+// once sealed to the keys in blockchain.key_delivery. Without one, pairing
+// and rotation discard the halves once their commitment is on chain.
+func (c *Client) SetKeyHalfDelivery(delivery KeyHalfDelivery) {
+	c.restClient.keyHalves = delivery
+}
+
 // Close closes the blockchain connection
 func (c *Client) Close() error {
 	// No connection to close for REST client
@@ -32,13 +163,28 @@ func (c *Client) Close() error {
 }
 
 // RegisterComponent registers a component on the blockchain
-func (c *Client) RegisterComponent(ctx context.Context, creator, componentData, context string) (map[string]interface{}, error) {
-	return c.restClient.RegisterComponent(ctx, creator, componentData, context)
+func (c *Client) RegisterComponent(ctx context.Context, creator, componentData, context string, dedupe bool) (map[string]interface{}, error) {
+	if err := c.schemas.check(registeredComponentType, componentData); err != nil {
+		return nil, err
+	}
+	return c.restClient.RegisterComponent(ctx, creator, componentData, context, dedupe)
 }
 
 // GetComponent retrieves a component from the blockchain
 func (c *Client) GetComponent(ctx context.Context, componentID string) (map[string]interface{}, error) {
-	return c.restClient.GetComponent(ctx, componentID)
+	return c.cached(ctx, cacheComponent, componentID, func(ctx context.Context) (map[string]interface{}, error) {
+		return c.restClient.GetComponent(ctx, componentID)
+	})
+}
+
+// SearchComponents retrieves one page of anonymous components matching filter
+func (c *Client) SearchComponents(ctx context.Context, filter ComponentSearchFilter, limit uint64, key string) (map[string]interface{}, error) {
+	return c.restClient.SearchComponents(ctx, filter, limit, key)
+}
+
+// ListComponents retrieves one page of registered components
+func (c *Client) ListComponents(ctx context.Context, limit uint64, key string, countTotal bool) (map[string]interface{}, error) {
+	return c.restClient.ListComponents(ctx, limit, key, countTotal)
 }
 
 // GetComponentIdentity retrieves component identity from the blockchain
@@ -46,9 +192,39 @@ func (c *Client) GetComponentIdentity(ctx context.Context, componentID string) (
 	return c.restClient.GetComponentIdentity(ctx, componentID)
 }
 
+// GetComponentHistory retrieves the recorded metadata changes of a component
+func (c *Client) GetComponentHistory(ctx context.Context, componentID string) (map[string]interface{}, error) {
+	return c.restClient.GetComponentHistory(ctx, componentID)
+}
+
+// GetComponentMetadata retrieves a component's on-chain fields and its verification backend metadata
+func (c *Client) GetComponentMetadata(ctx context.Context, componentID string) (map[string]interface{}, error) {
+	return c.restClient.GetComponentMetadata(ctx, componentID)
+}
+
+// GetCustodyChain retrieves the owners a component has passed through, oldest first
+func (c *Client) GetCustodyChain(ctx context.Context, componentID string) ([]CustodyEvent, error) {
+	return c.restClient.GetCustodyChain(ctx, componentID)
+}
+
+// GetPairingRules retrieves one page of the pairing rules for a source component type
+func (c *Client) GetPairingRules(ctx context.Context, sourceType string, limit uint64, key string) (map[string]interface{}, error) {
+	return c.restClient.GetPairingRules(ctx, sourceType, limit, key)
+}
+
+// GetVerificationHistory retrieves one page of a component's verifications, oldest first
+func (c *Client) GetVerificationHistory(ctx context.Context, componentID string, limit uint64, key string) (map[string]interface{}, error) {
+	return c.restClient.GetVerificationHistory(ctx, componentID, limit, key)
+}
+
+// GetComponentRelationships lists the LCTs a component participates in, optionally filtered by status
+func (c *Client) GetComponentRelationships(ctx context.Context, componentID, status string) ([]LctSummary, error) {
+	return c.restClient.GetComponentRelationships(ctx, componentID, status)
+}
+
 // VerifyComponent verifies a component on the blockchain
 func (c *Client) VerifyComponent(ctx context.Context, verifier, componentID, context string) (map[string]interface{}, error) {
-	return c.restClient.VerifyComponent(ctx, verifier, componentID, context)
+	return c.invalidating(cacheComponent, componentID)(c.restClient.VerifyComponent(ctx, verifier, componentID, context))
 }
 
 // Privacy-focused methods for anonymous component operations
@@ -59,8 +235,8 @@ func (c *Client) RegisterAnonymousComponent(ctx context.Context, creator, realCo
 }
 
 // VerifyComponentPairingWithHashes verifies component pairing using hashes
-func (c *Client) VerifyComponentPairingWithHashes(ctx context.Context, verifier, componentHashA, componentHashB, context string) (map[string]interface{}, error) {
-	return c.restClient.VerifyComponentPairingWithHashes(ctx, verifier, componentHashA, componentHashB, context)
+func (c *Client) VerifyComponentPairingWithHashes(ctx context.Context, verifier, componentHashA, componentHashB, context string, attestation *PairingAttestation) (map[string]interface{}, error) {
+	return c.restClient.VerifyComponentPairingWithHashes(ctx, verifier, componentHashA, componentHashB, context, attestation)
 }
 
 // CreateAnonymousPairingAuthorization creates anonymous pairing authorization
@@ -68,6 +244,16 @@ func (c *Client) CreateAnonymousPairingAuthorization(ctx context.Context, creato
 	return c.restClient.CreateAnonymousPairingAuthorization(ctx, creator, componentHashA, componentHashB, ruleHash, trustScoreRequirement, authorizationLevel)
 }
 
+// GetComponentHealth retrieves aggregate component health and the problematic components
+func (c *Client) GetComponentHealth(ctx context.Context, staleAfter time.Duration) (map[string]interface{}, error) {
+	return c.restClient.GetComponentHealth(ctx, staleAfter)
+}
+
+// GetRevocationEvents retrieves one page of revocation events, optionally for one target and time range
+func (c *Client) GetRevocationEvents(ctx context.Context, targetHash string, from, to int64, limit uint64, key string) (map[string]interface{}, error) {
+	return c.restClient.GetRevocationEvents(ctx, targetHash, from, to, limit, key)
+}
+
 // CreateAnonymousRevocationEvent creates anonymous revocation event
 func (c *Client) CreateAnonymousRevocationEvent(ctx context.Context, creator, targetHash, revocationType, urgencyLevel, reasonCategory, context string) (map[string]interface{}, error) {
 	return c.restClient.CreateAnonymousRevocationEvent(ctx, creator, targetHash, revocationType, urgencyLevel, reasonCategory, context)
@@ -83,14 +269,15 @@ func (c *Client) InitiatePairing(ctx context.Context, creator, componentA, compo
 	return c.restClient.InitiatePairing(ctx, creator, componentA, componentB, operationalContext, proxyID, forceImmediate)
 }
 
-// CompletePairing completes a pairing between components
+// CompletePairing completes a pairing between components. The challenge does
+// not say which LCTs the pairing touches, so every cached LCT is dropped.
 func (c *Client) CompletePairing(ctx context.Context, creator, challengeID, componentAAuth, componentBAuth, sessionContext string) (map[string]interface{}, error) {
-	return c.restClient.CompletePairing(ctx, creator, challengeID, componentAAuth, componentBAuth, sessionContext)
+	return c.invalidating(cacheLCT)(c.restClient.CompletePairing(ctx, creator, challengeID, componentAAuth, componentBAuth, sessionContext))
 }
 
 // RevokePairing revokes a pairing
 func (c *Client) RevokePairing(ctx context.Context, creator, lctID, reason string, notifyOffline bool) (map[string]interface{}, error) {
-	return c.restClient.RevokePairing(ctx, creator, lctID, reason, notifyOffline)
+	return c.invalidating(cacheLCT, lctID)(c.restClient.RevokePairing(ctx, creator, lctID, reason, notifyOffline))
 }
 
 // GetPairingStatus gets the status of a pairing
@@ -105,17 +292,69 @@ func (c *Client) CreateLCT(ctx context.Context, creator, componentA, componentB,
 
 // GetLCT retrieves a Linked Context Token
 func (c *Client) GetLCT(ctx context.Context, lctID string) (map[string]interface{}, error) {
-	return c.restClient.GetLCT(ctx, lctID)
+	return c.cached(ctx, cacheLCT, lctID, func(ctx context.Context) (map[string]interface{}, error) {
+		return c.restClient.GetLCT(ctx, lctID)
+	})
+}
+
+// ListLCTs retrieves one page of the LCTs that pass filter
+func (c *Client) ListLCTs(ctx context.Context, filter LCTFilter, limit uint64, key string) (map[string]interface{}, error) {
+	return c.restClient.ListLCTs(ctx, filter, limit, key)
+}
+
+// GetLctsByProxy retrieves one page of the live LCTs a proxy component mediates
+func (c *Client) GetLctsByProxy(ctx context.Context, proxyID string, limit uint64, key string) (map[string]interface{}, error) {
+	return c.restClient.GetLctsByProxy(ctx, proxyID, limit, key)
+}
+
+// GetKeyExchange retrieves the session key exchange status of an LCT
+func (c *Client) GetKeyExchange(ctx context.Context, lctID string) (map[string]interface{}, error) {
+	return c.restClient.GetKeyExchange(ctx, lctID)
+}
+
+// GetSplitKeyStatus retrieves the split key lifecycle of an LCT
+func (c *Client) GetSplitKeyStatus(ctx context.Context, lctID string) (map[string]interface{}, error) {
+	return c.restClient.GetSplitKeyStatus(ctx, lctID)
+}
+
+// GetLctBetween retrieves the live LCT linking two components
+func (c *Client) GetLctBetween(ctx context.Context, componentA, componentB, operationalContext string) (map[string]interface{}, error) {
+	return c.restClient.GetLctBetween(ctx, componentA, componentB, operationalContext)
 }
 
 // UpdateLCTStatus updates the status of a Linked Context Token
 func (c *Client) UpdateLCTStatus(ctx context.Context, creator, lctID, status, context string) (map[string]interface{}, error) {
-	return c.restClient.UpdateLCTStatus(ctx, creator, lctID, status, context)
+	return c.invalidating(cacheLCT, lctID)(c.restClient.UpdateLCTStatus(ctx, creator, lctID, status, context))
+}
+
+// CheckConsistency reports cross-module references that point to missing records
+func (c *Client) CheckConsistency(ctx context.Context) (*ConsistencyReport, error) {
+	return c.restClient.CheckConsistency(ctx)
+}
+
+// GetRecallScope collects the components, owners, LCTs and counterparts a recall reaches
+func (c *Client) GetRecallScope(ctx context.Context, criteria RecallCriteria, includeOwners bool) (*RecallScope, error) {
+	return c.restClient.GetRecallScope(ctx, criteria, includeOwners)
 }
 
-// CreateTrustTensor creates a trust tensor
-func (c *Client) CreateTrustTensor(ctx context.Context, creator, componentA, componentB, context string, initialScore float64) (map[string]interface{}, error) {
-	return c.restClient.CreateTrustTensor(ctx, creator, componentA, componentB, context, initialScore)
+// SuspendLCT pauses a Linked Context Token without terminating it
+func (c *Client) SuspendLCT(ctx context.Context, creator, lctID, reason string) (map[string]interface{}, error) {
+	return c.invalidating(cacheLCT, lctID)(c.restClient.SuspendLCT(ctx, creator, lctID, reason))
+}
+
+// ResumeLCT returns a suspended Linked Context Token to the status it had before suspension
+func (c *Client) ResumeLCT(ctx context.Context, creator, lctID, reason string) (map[string]interface{}, error) {
+	return c.invalidating(cacheLCT, lctID)(c.restClient.ResumeLCT(ctx, creator, lctID, reason))
+}
+
+// RotateSplitKey replaces the split key of a Linked Context Token
+func (c *Client) RotateSplitKey(ctx context.Context, creator, lctID, reason string) (map[string]interface{}, error) {
+	return c.invalidating(cacheLCT, lctID)(c.restClient.RotateSplitKey(ctx, creator, lctID, reason))
+}
+
+// CreateTrustTensor creates a trust tensor from a single score or from named dimensions
+func (c *Client) CreateTrustTensor(ctx context.Context, creator, componentA, componentB, context string, initialScore float64, dimensions map[string]float64) (map[string]interface{}, error) {
+	return c.restClient.CreateTrustTensor(ctx, creator, componentA, componentB, context, initialScore, dimensions)
 }
 
 // GetTrustTensor retrieves a trust tensor
@@ -123,6 +362,11 @@ func (c *Client) GetTrustTensor(ctx context.Context, tensorID string) (map[strin
 	return c.restClient.GetTrustTensor(ctx, tensorID)
 }
 
+// GetTensorHistory retrieves the score history of a trust tensor
+func (c *Client) GetTensorHistory(ctx context.Context, tensorID string, from, to int64) (map[string]interface{}, error) {
+	return c.restClient.GetTensorHistory(ctx, tensorID, from, to)
+}
+
 // UpdateTrustScore updates the trust score
 func (c *Client) UpdateTrustScore(ctx context.Context, creator, tensorID string, score float64, context string) (map[string]interface{}, error) {
 	return c.restClient.UpdateTrustScore(ctx, creator, tensorID, score, context)
@@ -130,17 +374,34 @@ func (c *Client) UpdateTrustScore(ctx context.Context, creator, tensorID string,
 
 // CreateEnergyOperation creates an energy operation
 func (c *Client) CreateEnergyOperation(ctx context.Context, creator, componentA, componentB, operationType string, amount float64, context string) (map[string]interface{}, error) {
-	return c.restClient.CreateEnergyOperation(ctx, creator, componentA, componentB, operationType, amount, context)
+	return c.invalidating(cacheEnergyBalance, componentA, componentB)(c.restClient.CreateEnergyOperation(ctx, creator, componentA, componentB, operationType, amount, context))
 }
 
 // ExecuteEnergyTransfer executes an energy transfer
-func (c *Client) ExecuteEnergyTransfer(ctx context.Context, creator, operationID string, amount float64, context string) (map[string]interface{}, error) {
-	return c.restClient.ExecuteEnergyTransfer(ctx, creator, operationID, amount, context)
+func (c *Client) ExecuteEnergyTransfer(ctx context.Context, creator, operationID string, amount float64, energyOut, context string) (map[string]interface{}, error) {
+	return c.invalidating(cacheEnergyBalance)(c.restClient.ExecuteEnergyTransfer(ctx, creator, operationID, amount, energyOut, context))
+}
+
+// GetEnergyOperation gets an energy operation. It is not cached, since callers poll it for status changes.
+func (c *Client) GetEnergyOperation(ctx context.Context, operationID string) (map[string]interface{}, error) {
+	return c.restClient.GetEnergyOperation(ctx, operationID)
+}
+
+// GetOperationEfficiency gets the ratio of energy an operation delivered to the energy it drew
+func (c *Client) GetOperationEfficiency(ctx context.Context, operationID string) (map[string]interface{}, error) {
+	return c.restClient.GetOperationEfficiency(ctx, operationID)
 }
 
 // GetEnergyBalance gets the energy balance for a component
 func (c *Client) GetEnergyBalance(ctx context.Context, componentID string) (map[string]interface{}, error) {
-	return c.restClient.GetEnergyBalance(ctx, componentID)
+	return c.cached(ctx, cacheEnergyBalance, componentID, func(ctx context.Context) (map[string]interface{}, error) {
+		return c.restClient.GetEnergyBalance(ctx, componentID)
+	})
+}
+
+// GetNetworkEnergyBalance balances energy across the LCT network reachable from a component
+func (c *Client) GetNetworkEnergyBalance(ctx context.Context, rootComponentID string) (map[string]interface{}, error) {
+	return c.restClient.GetNetworkEnergyBalance(ctx, rootComponentID)
 }
 
 // Queue Management Methods
@@ -170,9 +431,9 @@ func (c *Client) GetQueuedRequests(ctx context.Context, componentID string) (map
 	return c.restClient.GetQueuedRequests(ctx, componentID)
 }
 
-// ListProxyQueue lists all operations for a proxy
-func (c *Client) ListProxyQueue(ctx context.Context, proxyID string) (map[string]interface{}, error) {
-	return c.restClient.ListProxyQueue(ctx, proxyID)
+// ListProxyQueue lists one page of a proxy's offline operations that pass filter
+func (c *Client) ListProxyQueue(ctx context.Context, proxyID string, filter ProxyQueueFilter, limit uint64, key string) (map[string]interface{}, error) {
+	return c.restClient.ListProxyQueue(ctx, proxyID, filter, limit, key)
 }
 
 // Authorization Management Methods
@@ -224,11 +485,21 @@ func (c *Client) GetAccountManager() *AccountManager {
 	return c.restClient.accountManager
 }
 
+// GetAccountDetails reads the on-chain balance and sequence of each account
+func (c *Client) GetAccountDetails(ctx context.Context, accounts []*Account) []AccountDetails {
+	return c.restClient.GetAccountDetails(ctx, accounts)
+}
+
 // TestIgniteCLI tests Ignite CLI availability
 func (c *Client) TestIgniteCLI(ctx context.Context) error {
 	return c.restClient.testIgniteCLI(ctx)
 }
 
+// TestBlockchainConnection checks that the node's REST API answers
+func (c *Client) TestBlockchainConnection(ctx context.Context) error {
+	return c.restClient.testBlockchainConnection(ctx)
+}
+
 // TestConnection tests the blockchain connection and returns status
 func (c *Client) TestConnection(ctx context.Context) map[string]interface{} {
 	// Test REST client connection
@@ -236,6 +507,7 @@ func (c *Client) TestConnection(ctx context.Context) map[string]interface{} {
 		"connected": false,
 		"errors":    []string{},
 		"mode":      "real_blockchain",
+		"tx_mode":   c.restClient.txExecutor.Mode(),
 	}
 
 	// Test blockchain REST API connection
@@ -243,10 +515,19 @@ func (c *Client) TestConnection(ctx context.Context) map[string]interface{} {
 		status["errors"] = append(status["errors"].([]string), fmt.Sprintf("Blockchain REST API failed: %v", err))
 	} else {
 		status["rest_api_connected"] = true
+
+		// Block height, sync state and latency tell a live node from a stuck one
+		if node, err := c.restClient.GetNodeStatus(ctx); err != nil {
+			status["errors"] = append(status["errors"].([]string), fmt.Sprintf("Node status query failed: %v", err))
+		} else {
+			status["node"] = node
+		}
 	}
 
-	// Test if Ignite CLI is accessible
-	if err := c.restClient.testIgniteCLI(ctx); err != nil {
+	// The native signer does not depend on the Ignite CLI
+	if c.restClient.txExecutor.Mode() == TxModeNative {
+		status["connected"] = status["rest_api_connected"] == true
+	} else if err := c.restClient.testIgniteCLI(ctx); err != nil {
 		status["errors"] = append(status["errors"].([]string), fmt.Sprintf("Ignite CLI test failed: %v", err))
 	} else {
 		status["ignite_available"] = true
@@ -261,9 +542,10 @@ func (c *Client) TestConnection(ctx context.Context) map[string]interface{} {
 	return status
 }
 
-// CreateTransactionFile creates a transaction file for testing
-func (c *Client) CreateTransactionFile(message map[string]interface{}, memo string) (*os.File, error) {
-	return c.restClient.createTransactionFile(message, memo)
+// WithTransactionFile writes a transaction file for testing, runs fn with its
+// path and removes the file afterwards
+func (c *Client) WithTransactionFile(message map[string]interface{}, memo string, fn func(path string) error) error {
+	return c.restClient.withTransactionFile(message, memo, fn)
 }
 
 func (c *Client) GetIgnitePath() string {
@@ -0,0 +1,43 @@
+package blockchain
+
+import (
+	"context"
+	"time"
+)
+
+// coalescedQueryTimeout bounds one shared query, which no single caller's
+// context may cut short
+const coalescedQueryTimeout = 30 * time.Second
+
+// coalesce runs fn once for all concurrent callers asking for the same key, so
+// a burst of identical dashboard queries costs the node a single request.
+// fn runs under a context detached from the first caller's cancellation, so
+// that caller going away does not fail the others; each caller still stops
+// waiting when its own context ends.
+func (c *Client) coalesce(ctx context.Context, key string, fn func(context.Context) (map[string]interface{}, error)) (map[string]interface{}, error) {
+	ch := c.queries.DoChan(key, func() (interface{}, error) {
+		queryCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), coalescedQueryTimeout)
+		defer cancel()
+		return fn(queryCtx)
+	})
+
+	select {
+	case res := <-ch:
+		if res.Err != nil {
+			return nil, res.Err
+		}
+		result := res.Val.(map[string]interface{})
+		if !res.Shared {
+			return result, nil
+		}
+		c.log(ctx).Debug().Str("query", key).Msg("Coalesced duplicate query")
+		// Each caller gets its own top-level map so one cannot change another's result
+		shared := make(map[string]interface{}, len(result))
+		for k, v := range result {
+			shared[k] = v
+		}
+		return shared, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
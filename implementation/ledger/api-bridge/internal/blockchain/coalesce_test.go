@@ -0,0 +1,123 @@
+package blockchain
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConcurrentIdenticalQueriesShareOneRequest(t *testing.T) {
+	var requests int32
+	arrived := make(chan struct{}, 1)
+	release := make(chan struct{})
+	node := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		select {
+		case arrived <- struct{}{}:
+		default:
+		}
+		<-release
+		_, _ = w.Write([]byte(`{"component": {"component_id": "MODBATT-MOD-001", "status": "active"}}`))
+	}))
+	defer node.Close()
+
+	client, err := NewClient(node.URL, zerolog.Nop())
+	require.NoError(t, err)
+
+	const callers = 20
+	results := make([]map[string]interface{}, callers)
+	var wg sync.WaitGroup
+	for i := 0; i < callers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			component, err := client.GetComponent(context.Background(), "MODBATT-MOD-001")
+			assert.NoError(t, err)
+			results[i] = component
+		}(i)
+	}
+
+	// Hold the node until every caller has had time to join the first query
+	<-arrived
+	time.Sleep(100 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	assert.Equal(t, int32(1), atomic.LoadInt32(&requests))
+	for _, component := range results {
+		assert.Equal(t, "MODBATT-MOD-001", component["component_id"])
+	}
+
+	// Once the query has finished the next one goes back to the node
+	_, err = client.GetComponent(context.Background(), "MODBATT-MOD-001")
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&requests))
+}
+
+func TestCoalescedQueryHonoursCallerContext(t *testing.T) {
+	release := make(chan struct{})
+	node := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+		_, _ = w.Write([]byte(`{"linked_context_token": {"lct_id": "lct-1"}}`))
+	}))
+	defer node.Close()
+	defer close(release)
+
+	client, err := NewClient(node.URL, zerolog.Nop())
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err = client.GetLCT(ctx, "lct-1")
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestCoalescedQuerySurvivesLeaderCancellation(t *testing.T) {
+	arrived := make(chan struct{})
+	release := make(chan struct{})
+	node := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		close(arrived)
+		<-release
+		_, _ = w.Write([]byte(`{"linked_context_token": {"lct_id": "lct-1", "component_a_id": "MODBATT-PACK-001", "component_b_id": "MODBATT-MC-001"}}`))
+	}))
+	defer node.Close()
+
+	client, err := NewClient(node.URL, zerolog.Nop())
+	require.NoError(t, err)
+
+	leaderCtx, cancelLeader := context.WithCancel(context.Background())
+	leaderErr := make(chan error, 1)
+	go func() {
+		_, err := client.GetLCT(leaderCtx, "lct-1")
+		leaderErr <- err
+	}()
+	<-arrived
+
+	type lctResult struct {
+		lct map[string]interface{}
+		err error
+	}
+	follower := make(chan lctResult, 1)
+	go func() {
+		lct, err := client.GetLCT(context.Background(), "lct-1")
+		follower <- lctResult{lct, err}
+	}()
+
+	// The leader gives up while the follower is waiting on the same query
+	time.Sleep(50 * time.Millisecond)
+	cancelLeader()
+	assert.ErrorIs(t, <-leaderErr, context.Canceled)
+	close(release)
+
+	res := <-follower
+	require.NoError(t, res.err)
+	assert.Equal(t, "lct-1", res.lct["lct_id"])
+}
@@ -0,0 +1,22 @@
+package blockchain
+
+import (
+	"context"
+	"os/exec"
+	"time"
+)
+
+// cliWaitDelay bounds how long a cancelled command's output is waited on once
+// its processes have been killed
+const cliWaitDelay = 5 * time.Second
+
+// cliCommand prepares name to run like exec.CommandContext, for the Ignite and
+// racecar-webd binaries transactions go through. Where the platform allows,
+// the command runs in its own process group and cancelling ctx kills the whole
+// group, so processes the binary started do not outlive the request.
+func cliCommand(ctx context.Context, name string, args ...string) *exec.Cmd {
+	cmd := exec.CommandContext(ctx, name, args...)
+	killProcessGroupOnCancel(cmd)
+	cmd.WaitDelay = cliWaitDelay
+	return cmd
+}
@@ -0,0 +1,9 @@
+//go:build !unix
+
+package blockchain
+
+import "os/exec"
+
+// killProcessGroupOnCancel leaves cmd as it is: without process groups only
+// cmd's own process is killed on cancellation
+func killProcessGroupOnCancel(cmd *exec.Cmd) {}
@@ -0,0 +1,97 @@
+//go:build unix
+
+package blockchain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// hangingIgnite starts a child that outlives it unless the whole process
+// group is killed, records the child's pid and waits on it
+const hangingIgnite = `#!/bin/sh
+sleep 60 &
+echo $! > %q
+wait
+`
+
+// processGone reports whether pid has exited. A killed child whose parent
+// died too may linger as a zombie until something reaps it, which counts.
+func processGone(pid int) bool {
+	if err := syscall.Kill(pid, 0); errors.Is(err, syscall.ESRCH) {
+		return true
+	}
+	stat, err := os.ReadFile(fmt.Sprintf("/proc/%d/stat", pid))
+	if err != nil {
+		return false
+	}
+	// The state follows the parenthesised command name
+	fields := strings.Fields(string(stat[strings.LastIndexByte(string(stat), ')')+1:]))
+	return len(fields) > 0 && fields[0] == "Z"
+}
+
+func TestCancelledBroadcastKillsIgniteProcessGroup(t *testing.T) {
+	bin := t.TempDir()
+	pidFile := filepath.Join(bin, "child.pid")
+	ignite := filepath.Join(bin, "ignite")
+	require.NoError(t, os.WriteFile(ignite, []byte(fmt.Sprintf(hangingIgnite, pidFile)), 0o755))
+	fallbackRan := filepath.Join(bin, "fallback-ran")
+	racecarWebd := filepath.Join(bin, "racecar-webd")
+	require.NoError(t, os.WriteFile(racecarWebd, []byte(fmt.Sprintf("#!/bin/sh\ntouch %q\n", fallbackRan)), 0o755))
+
+	c := &RESTClient{
+		logger:      zerolog.Nop(),
+		ignitePath:  ignite,
+		racecarCmd:  racecarWebd,
+		projectRoot: bin,
+	}
+	message := map[string]interface{}{
+		"@type":             "/racecarweb.componentregistry.v1.MsgRegisterComponent",
+		"creator":           "alice",
+		"component_id":      "MODBATT-MOD-001",
+		"component_type":    "module",
+		"manufacturer_data": "test-data",
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	done := make(chan error, 1)
+	go func() {
+		_, err := newCLITxExecutor(c).Execute(ctx, &Account{Name: "alice"}, message, "", txGas{})
+		done <- err
+	}()
+
+	var childPid int
+	require.Eventually(t, func() bool {
+		content, err := os.ReadFile(pidFile)
+		if err != nil {
+			return false
+		}
+		childPid, err = strconv.Atoi(strings.TrimSpace(string(content)))
+		return err == nil
+	}, 5*time.Second, 10*time.Millisecond, "ignite never started its child")
+	cancel()
+
+	select {
+	case err := <-done:
+		assert.ErrorIs(t, err, context.Canceled)
+	case <-time.After(cliWaitDelay):
+		t.Fatal("broadcast did not return after cancellation")
+	}
+
+	assert.Eventually(t, func() bool { return processGone(childPid) }, 2*time.Second, 10*time.Millisecond,
+		"ignite's child %d outlived the cancelled broadcast", childPid)
+	assert.NoFileExists(t, fallbackRan, "a cancelled broadcast must not fall back to racecar-webd")
+}
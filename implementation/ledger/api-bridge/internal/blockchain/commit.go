@@ -0,0 +1,77 @@
+package blockchain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// ErrTxNotCommitted is returned when blockchain.wait_for_commit is set and a
+// broadcast transaction was not included in a block within commit_timeout.
+// The transaction may still be committed later.
+var ErrTxNotCommitted = errors.New("transaction not committed")
+
+// defaultCommitPollInterval is how often a broadcast transaction is looked up
+// while waiting for it to be included in a block
+const defaultCommitPollInterval = time.Second
+
+// awaitCommit waits for a transaction the node accepted in BROADCAST_MODE_SYNC
+// to be included in a block, and returns the tx response of its execution in
+// place of the CheckTx one. SYNC only reports whether the transaction entered
+// the mempool, so a failure while executing it only shows up here. txResult
+// is returned unchanged when waiting is disabled or CheckTx already failed.
+func (c *RESTClient) awaitCommit(ctx context.Context, txResult map[string]interface{}) (map[string]interface{}, error) {
+	if c.commitTimeout <= 0 {
+		return txResult, nil
+	}
+	if code, ok := txResultCode(txResult); ok && code != 0 {
+		return txResult, nil
+	}
+	txhash, _ := txResult["txhash"].(string)
+	if txhash == "" {
+		return txResult, nil
+	}
+
+	interval := c.commitPollInterval
+	if interval <= 0 {
+		interval = defaultCommitPollInterval
+	}
+	waitCtx, cancel := context.WithTimeout(ctx, c.commitTimeout)
+	defer cancel()
+
+	endpoint := fmt.Sprintf("/cosmos/tx/v1beta1/txs/%s", url.PathEscape(txhash))
+	for {
+		var response struct {
+			TxResponse map[string]interface{} `json:"tx_response"`
+		}
+		err := c.queryJSON(waitCtx, endpoint, &response)
+		if err == nil && response.TxResponse != nil {
+			// The committed response carries the execution's code, log and events
+			committed := make(map[string]interface{}, len(txResult)+len(response.TxResponse))
+			for key, value := range txResult {
+				committed[key] = value
+			}
+			for key, value := range response.TxResponse {
+				committed[key] = value
+			}
+			code, _ := txResultCode(committed)
+			c.log(ctx).Info().Str("txhash", txhash).Interface("height", committed["height"]).Int("code", code).Msg("Transaction committed")
+			return committed, nil
+		}
+
+		// The node answers 404 until the transaction is in a block
+		var httpErr *HTTPError
+		if err != nil && !(errors.As(err, &httpErr) && httpErr.StatusCode == http.StatusNotFound) {
+			c.log(ctx).Warn().Err(err).Str("txhash", txhash).Msg("Failed to look up broadcast transaction")
+		}
+		if sleepContext(waitCtx, interval) != nil {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+			return nil, fmt.Errorf("%w: %s not in a block after %s", ErrTxNotCommitted, txhash, c.commitTimeout)
+		}
+	}
+}
@@ -0,0 +1,73 @@
+package blockchain
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"api-bridge/internal/config"
+	energycycletypes "racecar-web/x/energycycle/types"
+)
+
+// syncEnergyChain accepts every transfer into the mempool, as
+// BROADCAST_MODE_SYNC reports it, without executing it
+type syncEnergyChain struct {
+	fakeEnergyChain
+}
+
+func (s *syncEnergyChain) Execute(ctx context.Context, account *Account, message map[string]interface{}, memo string, gas txGas) (map[string]interface{}, error) {
+	return map[string]interface{}{"code": 0, "txhash": "TXSYNC", "raw_log": "[]"}, nil
+}
+
+func TestWaitForCommitSurfacesExecutionFailures(t *testing.T) {
+	var lookups atomic.Int32
+	c, _ := testGasClient(t, config.GasConfig{Limit: 200000}, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/cosmos/tx/v1beta1/txs/TXSYNC", r.URL.Path)
+		// Not in a block for the first two lookups
+		if lookups.Add(1) <= 2 {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"code": 5, "message": "tx not found: TXSYNC"}`))
+			return
+		}
+		_, _ = fmt.Fprintf(w, `{"tx": {}, "tx_response": {"height": "42", "txhash": "TXSYNC", "codespace": %q,
+			"code": %d, "raw_log": "insufficient energy balance: lct-PACK-MC holds 30"}}`,
+			energycycletypes.ModuleName, energycycletypes.ErrInsufficientBalance.ABCICode())
+	})
+	c.txExecutor = &syncEnergyChain{}
+	c.commitTimeout = time.Second
+	c.commitPollInterval = time.Millisecond
+
+	// SYNC accepted the transfer; only its execution shows it failed
+	_, err := c.ExecuteEnergyTransfer(context.Background(), "alice", "op-1", 40, "", "")
+	assert.ErrorIs(t, err, ErrInsufficientEnergyBalance)
+	assert.ErrorContains(t, err, "lct-PACK-MC holds 30")
+	assert.Equal(t, int32(3), lookups.Load())
+}
+
+func TestWaitForCommitTimesOut(t *testing.T) {
+	c, _ := testGasClient(t, config.GasConfig{Limit: 200000}, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"code": 5, "message": "tx not found: TXSYNC"}`))
+	})
+	c.txExecutor = &syncEnergyChain{}
+	c.commitTimeout = 20 * time.Millisecond
+	c.commitPollInterval = time.Millisecond
+
+	_, err := c.ExecuteEnergyTransfer(context.Background(), "alice", "op-1", 40, "", "")
+	assert.ErrorIs(t, err, ErrTxNotCommitted)
+	assert.ErrorContains(t, err, "TXSYNC")
+}
+
+func TestAwaitCommitIsOffByDefault(t *testing.T) {
+	c := &RESTClient{}
+	sync := map[string]interface{}{"code": 0, "txhash": "TXSYNC"}
+	result, err := c.awaitCommit(context.Background(), sync)
+	require.NoError(t, err)
+	assert.Equal(t, sync, result)
+}
@@ -0,0 +1,155 @@
+package blockchain
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"api-bridge/internal/config"
+	lctmanagerkeeper "racecar-web/x/lctmanager/keeper"
+)
+
+// pairingTxExecutor answers every broadcast with a completed pairing
+type pairingTxExecutor struct {
+	fakeTxExecutor
+	result string
+}
+
+func (p *pairingTxExecutor) Execute(ctx context.Context, account *Account, message map[string]interface{}, memo string, gas txGas) (map[string]interface{}, error) {
+	if _, err := p.fakeTxExecutor.Execute(ctx, account, message, memo, gas); err != nil {
+		return nil, err
+	}
+	var txResult map[string]interface{}
+	if err := json.Unmarshal([]byte(p.result), &txResult); err != nil {
+		return nil, err
+	}
+	return txResult, nil
+}
+
+// recordingKeyHalfDelivery keeps the halves it is handed
+type recordingKeyHalfDelivery struct {
+	halves []SealedKeyHalf
+}
+
+func (d *recordingKeyHalfDelivery) DeliverKeyHalf(ctx context.Context, half SealedKeyHalf) error {
+	d.halves = append(d.halves, half)
+	return nil
+}
+
+// pairingNode answers the lookups that tell CompletePairing which components
+// receive the split key halves
+func pairingNode(w http.ResponseWriter, r *http.Request) {
+	switch r.URL.Path {
+	case "/racecar-web/pairing/v1/get_pairing_status/challenge-1":
+		_, _ = w.Write([]byte(`{"pairing_challenge": "{\"challenge_id\":\"challenge-1\",\"lct_id\":\"lct-PACK-MC-1\"}"}`))
+	case "/racecar-web/lctmanager/v1/get_lct/lct-PACK-MC-1":
+		_, _ = w.Write([]byte(`{"linked_context_token": {"lct_id": "lct-PACK-MC-1", "component_a_id": "MODBATT-PACK-001", "component_b_id": "MODBATT-MC-001"}}`))
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+// testComponentKey returns a component's private key and the public key its
+// split key half is sealed to
+func testComponentKey(t *testing.T, seed byte) ([32]byte, [32]byte) {
+	t.Helper()
+	var private [32]byte
+	for i := range private {
+		private[i] = seed + byte(i)
+	}
+	public, err := lctmanagerkeeper.MessagePublicKey(private)
+	require.NoError(t, err)
+	return private, public
+}
+
+const completedPairingResult = `{
+  "txhash": "ABC",
+  "code": 0,
+  "events": [
+    {"type": "pairing_completed", "attributes": [
+      {"key": "challenge_id", "value": "challenge-1"},
+      {"key": "lct_id", "value": "lct-PACK-MC-1"},
+      {"key": "key_reference", "value": "5f0c8b6a0d9e4c3b2a1f0e9d8c7b6a5f4e3d2c1b0a9f8e7d6c5b4a3f2e1d0c9b"}
+    ]}
+  ]
+}`
+
+func TestCompletePairingBroadcastsOnlyTheKeyCommitment(t *testing.T) {
+	c, _ := testGasClient(t, config.GasConfig{Limit: 200000}, pairingNode)
+	executor := &pairingTxExecutor{result: completedPairingResult}
+	c.txExecutor = executor
+	privateA, publicA := testComponentKey(t, 1)
+	privateB, publicB := testComponentKey(t, 101)
+	c.componentKeys = map[string][32]byte{"MODBATT-PACK-001": publicA, "MODBATT-MC-001": publicB}
+	delivery := &recordingKeyHalfDelivery{}
+	c.keyHalves = delivery
+
+	result, err := c.CompletePairing(context.Background(), "alice", "challenge-1", "auth-a", "auth-b", "race")
+	require.NoError(t, err)
+	assert.Equal(t, "lct-PACK-MC-1", result["lct_id"])
+	assert.Equal(t, "5f0c8b6a0d9e4c3b2a1f0e9d8c7b6a5f4e3d2c1b0a9f8e7d6c5b4a3f2e1d0c9b", result["key_reference"])
+	assert.NotContains(t, result, "split_key_a")
+	assert.NotContains(t, result, "split_key_b")
+
+	// Each component can open its own half, and only the commitment to the
+	// combined key was broadcast
+	require.Len(t, delivery.halves, 2)
+	halves := make(map[string][]byte)
+	for _, half := range delivery.halves {
+		assert.Equal(t, "lct-PACK-MC-1", half.LctID)
+		sealed, err := hex.DecodeString(half.SealedHalf)
+		require.NoError(t, err)
+		private := privateA
+		if half.ComponentID == "MODBATT-MC-001" {
+			private = privateB
+		}
+		opened, err := lctmanagerkeeper.OpenMessage(private, sealed)
+		require.NoError(t, err)
+		halves[half.ComponentID] = opened
+	}
+	require.Len(t, halves, 2)
+	halfA, halfB := halves["MODBATT-PACK-001"], halves["MODBATT-MC-001"]
+	require.Len(t, executor.messages, 1)
+	message := executor.messages[0]
+	assert.Equal(t, "/racecarweb.pairing.v1.MsgCompletePairing", message["@type"])
+	commitment := splitKeyCommitment(halfA, halfB)
+	assert.Equal(t, commitment, message["key_commitment"])
+	assert.Equal(t, hex.EncodeToString(commitment), delivery.halves[0].KeyReference)
+
+	encoded, err := json.Marshal(map[string]interface{}{"message": message, "result": result})
+	require.NoError(t, err)
+	assert.NotContains(t, string(encoded), hex.EncodeToString(halfA))
+	assert.NotContains(t, string(encoded), hex.EncodeToString(halfB))
+}
+
+func TestCompletePairingWithoutAComponentKeyBroadcastsNothing(t *testing.T) {
+	c, _ := testGasClient(t, config.GasConfig{Limit: 200000}, pairingNode)
+	executor := &pairingTxExecutor{result: completedPairingResult}
+	c.txExecutor = executor
+	_, publicA := testComponentKey(t, 1)
+	c.componentKeys = map[string][32]byte{"MODBATT-PACK-001": publicA}
+	delivery := &recordingKeyHalfDelivery{}
+	c.keyHalves = delivery
+
+	_, err := c.CompletePairing(context.Background(), "alice", "challenge-1", "auth-a", "auth-b", "race")
+	require.ErrorIs(t, err, ErrNoComponentKey)
+	assert.Empty(t, executor.messages)
+	assert.Empty(t, delivery.halves)
+}
+
+func TestParseComponentKeys(t *testing.T) {
+	_, public := testComponentKey(t, 1)
+	keys, err := parseComponentKeys([]config.ComponentKeyConfig{{ComponentID: "MODBATT-PACK-001", PublicKey: hex.EncodeToString(public[:])}})
+	require.NoError(t, err)
+	assert.Equal(t, public, keys["MODBATT-PACK-001"])
+
+	_, err = parseComponentKeys([]config.ComponentKeyConfig{{ComponentID: "MODBATT-PACK-001", PublicKey: "abcd"}})
+	assert.Error(t, err)
+	_, err = parseComponentKeys([]config.ComponentKeyConfig{{PublicKey: hex.EncodeToString(public[:])}})
+	assert.Error(t, err)
+}
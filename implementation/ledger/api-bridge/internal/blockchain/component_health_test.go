@@ -0,0 +1,38 @@
+package blockchain
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetComponentHealthParsesSummary(t *testing.T) {
+	node := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/racecar-web/componentregistry/v1/component_health", r.URL.Path)
+		if r.URL.Query().Get("stale_after_seconds") == "7200" {
+			_, _ = w.Write([]byte(`{"summary": {"total": "5", "active": "4", "stale": "1", "revoked": "1", "unverified": "1", "failed_verification": "1"}, "problems": [{"component_id": "MODBATT-MOD-003", "status": "active", "issues": ["stale"]}]}`))
+			return
+		}
+		// Healthy fleets come back with zero counts omitted and no problems
+		_, _ = w.Write([]byte(`{"summary": {"total": "2", "active": "2"}}`))
+	}))
+	defer node.Close()
+
+	c := &RESTClient{baseURL: node.URL, client: node.Client(), logger: zerolog.Nop()}
+
+	health, err := c.GetComponentHealth(context.Background(), 2*time.Hour)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]uint64{"total": 5, "active": 4, "stale": 1, "revoked": 1, "unverified": 1, "failed_verification": 1}, health["summary"])
+	assert.Len(t, health["problems"], 1)
+
+	health, err = c.GetComponentHealth(context.Background(), 0)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]uint64{"total": 2, "active": 2, "stale": 0, "revoked": 0, "unverified": 0, "failed_verification": 0}, health["summary"])
+	assert.Equal(t, []map[string]interface{}{}, health["problems"])
+}
@@ -0,0 +1,49 @@
+package blockchain
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// GetComponentMetadata retrieves a component's on-chain fields together with
+// the metadata the chain's verification backend holds for it. The two are
+// kept apart under "on_chain" and "backend", and "backend_status" says whether
+// the backend answered (ok, not_configured, unavailable or error).
+func (c *RESTClient) GetComponentMetadata(ctx context.Context, componentID string) (map[string]interface{}, error) {
+	c.log(ctx).Info().Str("component_id", componentID).Msg("Getting component metadata via REST")
+
+	var response struct {
+		Component       map[string]interface{} `json:"component"`
+		BackendMetadata string                 `json:"backend_metadata"`
+		BackendStatus   string                 `json:"backend_status"`
+	}
+	if err := c.queryJSON(ctx, "/racecar-web/componentregistry/v1/component_metadata/"+url.PathEscape(componentID), &response); err != nil {
+		var httpErr *HTTPError
+		if errors.As(err, &httpErr) && httpErr.StatusCode == http.StatusNotFound {
+			return nil, fmt.Errorf("%w: %s", ErrComponentNotFound, componentID)
+		}
+		return nil, fmt.Errorf("failed to get component metadata: %w", err)
+	}
+	if response.Component == nil {
+		return nil, fmt.Errorf("invalid response format: component not found")
+	}
+
+	// The backend's metadata travels as a JSON object in a string field
+	backend := map[string]interface{}{}
+	if response.BackendMetadata != "" {
+		if err := json.Unmarshal([]byte(response.BackendMetadata), &backend); err != nil {
+			return nil, fmt.Errorf("invalid backend metadata: %w", err)
+		}
+	}
+
+	return map[string]interface{}{
+		"component_id":   componentID,
+		"on_chain":       response.Component,
+		"backend":        backend,
+		"backend_status": response.BackendStatus,
+	}, nil
+}
@@ -0,0 +1,49 @@
+package blockchain
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetComponentMetadataSeparatesSources(t *testing.T) {
+	node := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/racecar-web/componentregistry/v1/component_metadata/MODBATT-MOD-001":
+			_, _ = w.Write([]byte(`{"component": {"component_id": "MODBATT-MOD-001", "status": "active"},
+				"backend_metadata": "{\"type\":\"battery_module\",\"capacity_kwh\":\"4.2\"}", "backend_status": "ok"}`))
+		case "/racecar-web/componentregistry/v1/component_metadata/MODBATT-MOD-002":
+			_, _ = w.Write([]byte(`{"component": {"component_id": "MODBATT-MOD-002", "status": "active"}, "backend_status": "unavailable"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"code": 5, "message": "component not found"}`))
+		}
+	}))
+	defer node.Close()
+
+	c := &RESTClient{baseURL: node.URL, client: node.Client(), logger: zerolog.Nop()}
+
+	metadata, err := c.GetComponentMetadata(context.Background(), "MODBATT-MOD-001")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"component_id":   "MODBATT-MOD-001",
+		"on_chain":       map[string]interface{}{"component_id": "MODBATT-MOD-001", "status": "active"},
+		"backend":        map[string]interface{}{"type": "battery_module", "capacity_kwh": "4.2"},
+		"backend_status": "ok",
+	}, metadata)
+
+	// A backend that is down still gives the on-chain fields
+	metadata, err = c.GetComponentMetadata(context.Background(), "MODBATT-MOD-002")
+	require.NoError(t, err)
+	assert.Equal(t, "unavailable", metadata["backend_status"])
+	assert.Equal(t, map[string]interface{}{}, metadata["backend"])
+	assert.Equal(t, "active", metadata["on_chain"].(map[string]interface{})["status"])
+
+	_, err = c.GetComponentMetadata(context.Background(), "MODBATT-MOD-404")
+	assert.ErrorIs(t, err, ErrComponentNotFound)
+}
@@ -0,0 +1,54 @@
+package blockchain
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetComponentRelationshipsSummarizesPeers(t *testing.T) {
+	node := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/racecar-web/lctmanager/v1/get_component_relationships/MODBATT-MOD-001":
+			_, _ = w.Write([]byte(`{"component_relationships": "[{\"lct_id\":\"lct-1\",\"component_a_id\":\"MODBATT-PACK-001\",\"component_b_id\":\"MODBATT-MOD-001\",\"pairing_status\":\"active\",\"operational_context\":\"energy_transfer\"},{\"lct_id\":\"lct-2\",\"component_a_id\":\"MODBATT-MOD-001\",\"component_b_id\":\"MODBATT-MOD-002\",\"pairing_status\":\"terminated\",\"operational_context\":\"balancing\"}]", "lct_count": "2"}`))
+		case "/racecar-web/lctmanager/v1/get_component_relationships/MODBATT-MOD-009":
+			_, _ = w.Write([]byte(`{"component_relationships": "null", "lct_count": "0"}`))
+		default:
+			t.Errorf("unexpected request to %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer node.Close()
+
+	c := &RESTClient{baseURL: node.URL, client: node.Client(), logger: zerolog.Nop()}
+
+	all, err := c.GetComponentRelationships(context.Background(), "MODBATT-MOD-001", "")
+	require.NoError(t, err)
+	assert.Equal(t, []LctSummary{
+		{LctID: "lct-1", PeerComponentID: "MODBATT-PACK-001", PairingStatus: "active", OperationalContext: "energy_transfer"},
+		{LctID: "lct-2", PeerComponentID: "MODBATT-MOD-002", PairingStatus: "terminated", OperationalContext: "balancing"},
+	}, all)
+
+	active, err := c.GetComponentRelationships(context.Background(), "MODBATT-MOD-001", "active")
+	require.NoError(t, err)
+	require.Len(t, active, 1)
+	assert.Equal(t, "lct-1", active[0].LctID)
+
+	terminated, err := c.GetComponentRelationships(context.Background(), "MODBATT-MOD-001", "terminated")
+	require.NoError(t, err)
+	require.Len(t, terminated, 1)
+	assert.Equal(t, "lct-2", terminated[0].LctID)
+
+	// A status the lctmanager module does not define is rejected before querying
+	_, err = c.GetComponentRelationships(context.Background(), "MODBATT-MOD-001", "revoked")
+	assert.ErrorIs(t, err, ErrInvalidLCTStatus)
+
+	none, err := c.GetComponentRelationships(context.Background(), "MODBATT-MOD-009", "")
+	require.NoError(t, err)
+	assert.Equal(t, []LctSummary{}, none)
+}
@@ -0,0 +1,135 @@
+package blockchain
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/santhosh-tekuri/jsonschema/v5"
+)
+
+// ErrInvalidComponentData is returned, wrapped in a *ComponentDataError, when
+// component_data does not satisfy the schema of the type it is registered as
+var ErrInvalidComponentData = errors.New("component_data does not match the schema of its component type")
+
+// registeredComponentType is the component type every MsgRegisterComponent
+// the bridge builds carries, and so the schema registrations are checked against
+const registeredComponentType = "module"
+
+// ComponentDataError lists what the schema of ComponentType rejected
+type ComponentDataError struct {
+	ComponentType string
+	Fields        []FieldError
+}
+
+func (e *ComponentDataError) Error() string {
+	parts := make([]string, len(e.Fields))
+	for i, field := range e.Fields {
+		parts[i] = field.Message
+		if field.Field != "" {
+			parts[i] = field.Field + " " + field.Message
+		}
+	}
+	return fmt.Sprintf("%v: %s", ErrInvalidComponentData, strings.Join(parts, "; "))
+}
+
+func (e *ComponentDataError) Unwrap() error {
+	return ErrInvalidComponentData
+}
+
+// FieldError is one part of component_data its schema rejected
+type FieldError struct {
+	Field   string `json:"field"` // dotted path into component_data; empty for the document as a whole
+	Message string `json:"message"`
+}
+
+// componentSchemas holds the compiled JSON schema of each component type that
+// has one, keyed by lower-cased type
+type componentSchemas map[string]*jsonschema.Schema
+
+// loadComponentSchemas compiles the schema file configured for each component
+// type in validation.component_schemas
+func loadComponentSchemas(paths map[string]string) (componentSchemas, error) {
+	schemas := make(componentSchemas, len(paths))
+	for componentType, path := range paths {
+		schema, err := jsonschema.Compile(path)
+		if err != nil {
+			return nil, fmt.Errorf("validation.component_schemas %s: %w", componentType, err)
+		}
+		schemas[strings.ToLower(componentType)] = schema
+	}
+	return schemas, nil
+}
+
+// LoadComponentSchemas compiles the schemas of validation.component_schemas.
+// Every registration is then checked against the schema of the type it is
+// registered as, whichever path it arrives through.
+func (c *Client) LoadComponentSchemas(paths map[string]string) error {
+	schemas, err := loadComponentSchemas(paths)
+	if err != nil {
+		return err
+	}
+	c.schemas = schemas
+	return nil
+}
+
+// check returns a *ComponentDataError when data does not satisfy the schema
+// of componentType
+func (s componentSchemas) check(componentType, data string) error {
+	if fields := s.validate(componentType, data); len(fields) > 0 {
+		return &ComponentDataError{ComponentType: componentType, Fields: fields}
+	}
+	return nil
+}
+
+// validate checks data against the schema of componentType and returns what
+// it rejected. Types without a schema keep free-form component_data, so
+// nothing is checked for them.
+func (s componentSchemas) validate(componentType, data string) []FieldError {
+	schema, ok := s[strings.ToLower(componentType)]
+	if !ok {
+		return nil
+	}
+
+	decoder := json.NewDecoder(strings.NewReader(data))
+	decoder.UseNumber()
+	var document interface{}
+	if err := decoder.Decode(&document); err != nil || decoder.More() {
+		return []FieldError{{Message: fmt.Sprintf("component_data must be a JSON document for component type %s", componentType)}}
+	}
+
+	var validationErr *jsonschema.ValidationError
+	if err := schema.Validate(document); err == nil {
+		return nil
+	} else if !errors.As(err, &validationErr) {
+		return []FieldError{{Message: err.Error()}}
+	}
+	return schemaFieldErrors(validationErr)
+}
+
+// schemaFieldErrors flattens a validation error into the failures at its
+// leaves, one per missing property for a failed "required"
+func schemaFieldErrors(err *jsonschema.ValidationError) []FieldError {
+	if len(err.Causes) > 0 {
+		var fields []FieldError
+		for _, cause := range err.Causes {
+			fields = append(fields, schemaFieldErrors(cause)...)
+		}
+		return fields
+	}
+
+	field := strings.ReplaceAll(strings.TrimPrefix(err.InstanceLocation, "/"), "/", ".")
+	if missing, ok := strings.CutPrefix(err.Message, "missing properties: "); ok && strings.HasSuffix(err.KeywordLocation, "/required") {
+		var fields []FieldError
+		for _, name := range strings.Split(missing, ", ") {
+			name = strings.Trim(name, "'")
+			if field != "" {
+				name = field + "." + name
+			}
+			fields = append(fields, FieldError{Field: name, Message: "is required"})
+		}
+		return fields
+	}
+	return []FieldError{{Field: field, Message: err.Message}}
+}
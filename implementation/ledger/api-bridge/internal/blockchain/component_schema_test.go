@@ -0,0 +1,69 @@
+package blockchain
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"api-bridge/internal/config"
+)
+
+// schemaClient broadcasts through a fake executor that accepts every message
+func schemaClient(t *testing.T) *Client {
+	t.Helper()
+	rest, _ := testGasClient(t, config.GasConfig{Limit: 200000}, nil)
+	return &Client{restClient: rest, logger: zerolog.Nop()}
+}
+
+func TestRegisterComponentValidatesComponentData(t *testing.T) {
+	c := schemaClient(t)
+	require.NoError(t, c.LoadComponentSchemas(map[string]string{
+		"Module": "../../schemas/battery_module.json",
+	}))
+	ctx := context.Background()
+
+	valid := `{"manufacturer_id": "mfr-7", "model": "BM-48", "capacity_kwh": 5.2, "chemistry": "LFP"}`
+	resp, err := c.RegisterComponent(ctx, "alice", valid, "", false)
+	require.NoError(t, err)
+	assert.NotEmpty(t, resp["component_id"])
+
+	// Malformed metadata is rejected before anything is broadcast
+	invalid := `{"manufacturer_id": "mfr-7", "capacity_kwh": -2, "chemistry": "lead-acid"}`
+	_, err = c.RegisterComponent(ctx, "alice", invalid, "", false)
+	require.ErrorIs(t, err, ErrInvalidComponentData)
+	var dataErr *ComponentDataError
+	require.True(t, errors.As(err, &dataErr))
+	assert.Equal(t, registeredComponentType, dataErr.ComponentType)
+	assert.ElementsMatch(t, []FieldError{
+		{Field: "model", Message: "is required"},
+		{Field: "capacity_kwh", Message: "must be > 0 but found -2"},
+		{Field: "chemistry", Message: `value must be one of "LFP", "NMC", "NCA", "LTO"`},
+	}, dataErr.Fields)
+
+	_, err = c.RegisterComponent(ctx, "alice", "battery_pack_v1", "", false)
+	require.ErrorIs(t, err, ErrInvalidComponentData)
+	assert.ErrorContains(t, err, "must be a JSON document")
+
+	// Without a schema for the registered type, component_data stays free-form
+	free := schemaClient(t)
+	require.NoError(t, free.LoadComponentSchemas(map[string]string{
+		"motor_controller": "../../schemas/battery_module.json",
+	}))
+	_, err = free.RegisterComponent(ctx, "alice", "battery_pack_v1", "", false)
+	assert.NoError(t, err)
+}
+
+func TestLoadComponentSchemasRejectsInvalidSchema(t *testing.T) {
+	broken := filepath.Join(t.TempDir(), "broken.json")
+	require.NoError(t, os.WriteFile(broken, []byte(`{"type": "object", "required": "model"}`), 0o644))
+
+	c := schemaClient(t)
+	err := c.LoadComponentSchemas(map[string]string{"battery_module": broken})
+	assert.ErrorContains(t, err, "validation.component_schemas battery_module")
+}
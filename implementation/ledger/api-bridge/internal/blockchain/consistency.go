@@ -0,0 +1,175 @@
+package blockchain
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// consistencyPageSize is the page size used when walking the chain's list queries
+const consistencyPageSize = 200
+
+// DanglingReference is a cross-module reference whose target does not exist on chain
+type DanglingReference struct {
+	Kind    string `json:"kind"`    // record type holding the reference: lct, trust_tensor or energy_operation
+	ID      string `json:"id"`      // ID of the record holding the reference
+	Field   string `json:"field"`   // field that holds the reference
+	Missing string `json:"missing"` // referenced ID that could not be found
+}
+
+// ConsistencyReport summarizes a check of the references between modules
+type ConsistencyReport struct {
+	Consistent       bool                `json:"consistent"`
+	Components       int                 `json:"components"`
+	Lcts             int                 `json:"lcts"`
+	TrustTensors     int                 `json:"trust_tensors"`
+	EnergyOperations int                 `json:"energy_operations"`
+	Dangling         []DanglingReference `json:"dangling"`
+	CheckedAt        int64               `json:"checked_at"`
+}
+
+// CheckConsistency verifies that every LCT points to registered components and
+// that every trust tensor and energy operation points to an existing LCT.
+// The chain is read page by page, so the result reflects whichever blocks the
+// individual queries were served from.
+func (c *RESTClient) CheckConsistency(ctx context.Context) (*ConsistencyReport, error) {
+	c.log(ctx).Info().Msg("Checking cross-module reference consistency via REST")
+
+	report := &ConsistencyReport{Dangling: []DanglingReference{}}
+	dangling := func(kind, id, field, missing string) {
+		report.Dangling = append(report.Dangling, DanglingReference{Kind: kind, ID: id, Field: field, Missing: missing})
+	}
+
+	components := make(map[string]bool)
+	err := walkPages(ctx, c, "/racecar-web/componentregistry/v1/components", "components", func(component struct {
+		ComponentID string `json:"component_id"`
+	}) {
+		components[component.ComponentID] = true
+	})
+	if err != nil {
+		return nil, err
+	}
+	report.Components = len(components)
+
+	lcts := make(map[string]bool)
+	err = walkPages(ctx, c, "/racecar-web/lctmanager/v1/lcts", "lcts", func(lct struct {
+		LctID              string `json:"lct_id"`
+		ComponentAID       string `json:"component_a_id"`
+		ComponentBID       string `json:"component_b_id"`
+		ProxyComponentID   string `json:"proxy_component_id"`
+		OperationalContext string `json:"operational_context"`
+	}) {
+		lcts[lct.LctID] = true
+
+		// Minted entity LCTs hold an entity name and type instead of component IDs
+		if lct.OperationalContext == lct.ComponentBID+":"+lct.ComponentAID {
+			return
+		}
+		if !components[lct.ComponentAID] {
+			dangling("lct", lct.LctID, "component_a_id", lct.ComponentAID)
+		}
+		if !components[lct.ComponentBID] {
+			dangling("lct", lct.LctID, "component_b_id", lct.ComponentBID)
+		}
+		if lct.ProxyComponentID != "" && !components[lct.ProxyComponentID] {
+			dangling("lct", lct.LctID, "proxy_component_id", lct.ProxyComponentID)
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	report.Lcts = len(lcts)
+
+	err = walkPages(ctx, c, "/racecar-web/trusttensor/v1/tensors", "tensors", func(tensor struct {
+		TensorID string `json:"tensor_id"`
+		LctID    string `json:"lct_id"`
+	}) {
+		report.TrustTensors++
+		if !lcts[tensor.LctID] {
+			dangling("trust_tensor", tensor.TensorID, "lct_id", tensor.LctID)
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = walkPages(ctx, c, "/racecar-web/energycycle/v1/energy_operations", "operations", func(operation struct {
+		OperationID string `json:"operation_id"`
+		SourceLct   string `json:"source_lct"`
+		TargetLct   string `json:"target_lct"`
+	}) {
+		report.EnergyOperations++
+		if !lcts[operation.SourceLct] {
+			dangling("energy_operation", operation.OperationID, "source_lct", operation.SourceLct)
+		}
+		if !lcts[operation.TargetLct] {
+			dangling("energy_operation", operation.OperationID, "target_lct", operation.TargetLct)
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	report.Consistent = len(report.Dangling) == 0
+	report.CheckedAt = time.Now().Unix()
+
+	if !report.Consistent {
+		c.log(ctx).Warn().Int("dangling", len(report.Dangling)).Msg("Cross-module consistency check found dangling references")
+	}
+	return report, nil
+}
+
+// walkPages follows next_key through every page of a paginated chain list
+// query, decoding each item of the named list field and passing it to visit.
+// endpoint may already carry query parameters of its own.
+func walkPages[T any](ctx context.Context, c *RESTClient, endpoint, field string, visit func(T)) error {
+	key := ""
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		params := url.Values{}
+		params.Set("pagination.limit", strconv.Itoa(consistencyPageSize))
+		if key != "" {
+			params.Set("pagination.key", key)
+		}
+
+		var page map[string]json.RawMessage
+		separator := "?"
+		if strings.Contains(endpoint, "?") {
+			separator = "&"
+		}
+		if err := c.queryJSON(ctx, endpoint+separator+params.Encode(), &page); err != nil {
+			return fmt.Errorf("failed to list %s: %w", field, err)
+		}
+
+		// The chain omits empty repeated fields
+		if raw, ok := page[field]; ok {
+			var items []T
+			if err := json.Unmarshal(raw, &items); err != nil {
+				return fmt.Errorf("failed to parse %s: %w", field, err)
+			}
+			for _, item := range items {
+				visit(item)
+			}
+		}
+
+		var pagination struct {
+			NextKey string `json:"next_key"`
+		}
+		if raw, ok := page["pagination"]; ok {
+			if err := json.Unmarshal(raw, &pagination); err != nil {
+				return fmt.Errorf("failed to parse %s pagination: %w", field, err)
+			}
+		}
+		if pagination.NextKey == "" {
+			return nil
+		}
+		key = pagination.NextKey
+	}
+}
@@ -0,0 +1,68 @@
+package blockchain
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// consistencyNode serves the chain list queries, splitting the LCTs across two pages
+func consistencyNode(t *testing.T, tensors string) *httptest.Server {
+	t.Helper()
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/racecar-web/componentregistry/v1/components":
+			_, _ = w.Write([]byte(`{"components": [{"component_id": "MODBATT-PACK-001"}, {"component_id": "MODBATT-MOD-001"}, {"component_id": "MODBATT-MOD-002"}], "pagination": {}}`))
+		case "/racecar-web/lctmanager/v1/lcts":
+			if r.URL.Query().Get("pagination.key") == "" {
+				_, _ = w.Write([]byte(`{"lcts": [{"lct_id": "lct-1", "component_a_id": "MODBATT-PACK-001", "component_b_id": "MODBATT-MOD-001"}], "pagination": {"next_key": "bGN0LTI="}}`))
+				return
+			}
+			assert.Equal(t, "bGN0LTI=", r.URL.Query().Get("pagination.key"))
+			_, _ = w.Write([]byte(`{"lcts": [{"lct_id": "lct-2", "component_a_id": "MODBATT-PACK-001", "component_b_id": "MODBATT-MOD-002"}, {"lct_id": "lct-agent-pitbot-1", "component_a_id": "pitbot", "component_b_id": "agent", "operational_context": "agent:pitbot"}], "pagination": {"next_key": null}}`))
+		case "/racecar-web/trusttensor/v1/tensors":
+			_, _ = w.Write([]byte(tensors))
+		case "/racecar-web/energycycle/v1/energy_operations":
+			_, _ = w.Write([]byte(`{"operations": [{"operation_id": "op-1", "source_lct": "lct-1", "target_lct": "lct-2"}], "pagination": {}}`))
+		default:
+			t.Errorf("unexpected request to %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+func TestCheckConsistencyClean(t *testing.T) {
+	node := consistencyNode(t, `{"tensors": [{"tensor_id": "tensor-1", "lct_id": "lct-1"}], "pagination": {}}`)
+	defer node.Close()
+
+	c := &RESTClient{baseURL: node.URL, client: node.Client(), logger: zerolog.Nop()}
+	report, err := c.CheckConsistency(context.Background())
+	require.NoError(t, err)
+
+	assert.True(t, report.Consistent)
+	assert.Empty(t, report.Dangling)
+	assert.Equal(t, 3, report.Components)
+	assert.Equal(t, 3, report.Lcts)
+	assert.Equal(t, 1, report.TrustTensors)
+	assert.Equal(t, 1, report.EnergyOperations)
+}
+
+func TestCheckConsistencyDetectsDanglingReference(t *testing.T) {
+	// tensor-2 still references an LCT that no longer exists on chain
+	node := consistencyNode(t, `{"tensors": [{"tensor_id": "tensor-1", "lct_id": "lct-1"}, {"tensor_id": "tensor-2", "lct_id": "lct-gone"}], "pagination": {}}`)
+	defer node.Close()
+
+	c := &RESTClient{baseURL: node.URL, client: node.Client(), logger: zerolog.Nop()}
+	report, err := c.CheckConsistency(context.Background())
+	require.NoError(t, err)
+
+	assert.False(t, report.Consistent)
+	assert.Equal(t, []DanglingReference{
+		{Kind: "trust_tensor", ID: "tensor-2", Field: "lct_id", Missing: "lct-gone"},
+	}, report.Dangling)
+}
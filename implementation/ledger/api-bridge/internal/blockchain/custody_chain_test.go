@@ -0,0 +1,42 @@
+package blockchain
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetCustodyChainParsesEvents(t *testing.T) {
+	node := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/racecar-web/componentregistry/v1/custody/MODBATT-MOD-001":
+			_, _ = w.Write([]byte(`{"events": [
+				{"component_id": "MODBATT-MOD-001", "sequence": "1", "to_owner": "cosmos1factory", "reason": "registered", "transferred_at": "2025-07-14T09:21:44Z", "block_height": "10"},
+				{"component_id": "MODBATT-MOD-001", "sequence": "2", "from_owner": "cosmos1factory", "to_owner": "cosmos1fleet", "reason": "shipped", "transferred_at": "2025-07-14T10:21:44Z", "block_height": "20"}
+			]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"code": 5, "message": "component not found"}`))
+		}
+	}))
+	defer node.Close()
+
+	c := &RESTClient{baseURL: node.URL, client: node.Client(), logger: zerolog.Nop()}
+
+	chain, err := c.GetCustodyChain(context.Background(), "MODBATT-MOD-001")
+	require.NoError(t, err)
+	require.Len(t, chain, 2)
+	assert.Equal(t, CustodyEvent{Sequence: 1, ToOwner: "cosmos1factory", Reason: "registered", TransferredAt: time.Date(2025, 7, 14, 9, 21, 44, 0, time.UTC), BlockHeight: 10}, chain[0])
+	assert.Equal(t, "cosmos1factory", chain[1].FromOwner)
+	assert.Equal(t, "cosmos1fleet", chain[1].ToOwner)
+	assert.Equal(t, uint64(2), chain[1].Sequence)
+
+	_, err = c.GetCustodyChain(context.Background(), "MODBATT-MOD-404")
+	assert.ErrorIs(t, err, ErrComponentNotFound)
+}
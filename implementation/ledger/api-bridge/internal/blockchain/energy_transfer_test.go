@@ -0,0 +1,190 @@
+package blockchain
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"api-bridge/internal/config"
+	energycycletypes "racecar-web/x/energycycle/types"
+)
+
+// fakeEnergyChain executes transfers against in-memory balances, standing in
+// for the energycycle module behind a TxExecutor
+type fakeEnergyChain struct {
+	balances   map[string]float64
+	operations map[string]map[string]interface{}
+	txs        int
+}
+
+func (f *fakeEnergyChain) Mode() string { return "fake" }
+
+func (f *fakeEnergyChain) SimulationTx(ctx context.Context, account *Account, message map[string]interface{}, memo string) ([]byte, error) {
+	return []byte("sim-tx"), nil
+}
+
+func (f *fakeEnergyChain) Execute(ctx context.Context, account *Account, message map[string]interface{}, memo string, gas txGas) (map[string]interface{}, error) {
+	f.txs++
+	txhash := fmt.Sprintf("TX%d", f.txs)
+	if message["@type"] != "/racecarweb.energycycle.v1.MsgExecuteEnergyTransfer" {
+		return nil, fmt.Errorf("unexpected message %v", message["@type"])
+	}
+	failed := func(err interface{ ABCICode() uint32 }, log string) map[string]interface{} {
+		return map[string]interface{}{"code": int(err.ABCICode()), "codespace": energycycletypes.ModuleName, "txhash": txhash, "raw_log": log}
+	}
+
+	op, ok := f.operations[message["operation_id"].(string)]
+	if !ok {
+		return failed(energycycletypes.ErrOperationNotFound, "energy operation not found"), nil
+	}
+	if owner, ok := op["creator"]; ok && owner != message["creator"] {
+		return failed(energycycletypes.ErrUnauthorized, "not authorized to execute the energy operation"), nil
+	}
+	if op["status"] != "created" {
+		return failed(energycycletypes.ErrOperationNotPending, "energy operation is not pending"), nil
+	}
+	source, target, amount := op["source_lct"].(string), op["target_lct"].(string), op["amount"].(float64)
+	if f.balances[source] < amount {
+		return failed(energycycletypes.ErrInsufficientBalance, "insufficient energy balance"), nil
+	}
+	f.balances[source] -= amount
+	f.balances[target] += amount
+	op["status"] = "completed"
+
+	attribute := func(key string, value interface{}) map[string]interface{} {
+		return map[string]interface{}{"key": key, "value": fmt.Sprint(value)}
+	}
+	return map[string]interface{}{
+		"code":      0,
+		"txhash":    txhash,
+		"timestamp": "2025-07-14T09:22:00Z",
+		"events": []map[string]interface{}{{
+			"type": "energy_transferred",
+			"attributes": []map[string]interface{}{
+				attribute("operation_id", message["operation_id"]),
+				attribute("source_lct", source),
+				attribute("target_lct", target),
+				attribute("amount", strconv.FormatFloat(amount, 'f', 18, 64)),
+				attribute("source_balance", strconv.FormatFloat(f.balances[source], 'f', 18, 64)),
+				attribute("target_balance", strconv.FormatFloat(f.balances[target], 'f', 18, 64)),
+			},
+		}},
+	}, nil
+}
+
+func TestExecuteEnergyTransferMovesBalances(t *testing.T) {
+	chain := &fakeEnergyChain{
+		balances: map[string]float64{"lct-PACK-MC": 80},
+		operations: map[string]map[string]interface{}{
+			"op-1": {"source_lct": "lct-PACK-MC", "target_lct": "lct-MC-LOAD", "amount": 50.0, "status": "created"},
+			"op-2": {"source_lct": "lct-PACK-MC", "target_lct": "lct-MC-LOAD", "amount": 40.0, "status": "created"},
+			"op-3": {"source_lct": "lct-PACK-MC", "target_lct": "lct-MC-LOAD", "amount": 10.0, "status": "created", "creator": "racecar1someoneelse"},
+		},
+	}
+	c, _ := testGasClient(t, config.GasConfig{Limit: 200000}, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request to %s", r.URL.Path)
+	})
+	c.txExecutor = chain
+	ctx := context.Background()
+
+	result, err := c.ExecuteEnergyTransfer(ctx, "alice", "op-1", 50, "", "battery_management")
+	require.NoError(t, err)
+	assert.Equal(t, "completed", result["status"])
+	assert.Equal(t, "TX1", result["txhash"])
+	assert.Equal(t, 30.0, result["source_balance"])
+	assert.Equal(t, 50.0, result["target_balance"])
+	assert.Equal(t, "lct-MC-LOAD", result["target_lct"])
+	assert.Equal(t, int64(1752484920), result["timestamp"])
+
+	// 40 more than the 30 left
+	_, err = c.ExecuteEnergyTransfer(ctx, "alice", "op-2", 40, "", "")
+	assert.ErrorIs(t, err, ErrInsufficientEnergyBalance)
+	assert.Equal(t, 30.0, chain.balances["lct-PACK-MC"])
+
+	_, err = c.ExecuteEnergyTransfer(ctx, "alice", "op-1", 50, "", "")
+	assert.ErrorIs(t, err, ErrEnergyOperationNotPending)
+
+	_, err = c.ExecuteEnergyTransfer(ctx, "alice", "op-missing", 10, "", "")
+	assert.ErrorIs(t, err, ErrEnergyOperationNotFound)
+
+	// Only the operation's creator or the source's trust anchor may execute it
+	_, err = c.ExecuteEnergyTransfer(ctx, "alice", "op-3", 10, "", "")
+	assert.ErrorIs(t, err, ErrEnergyTransferUnauthorized)
+	assert.Equal(t, 30.0, chain.balances["lct-PACK-MC"])
+}
+
+func TestGetOperationEfficiency(t *testing.T) {
+	node := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/racecar-web/energycycle/v1/operation_efficiency/op-1":
+			_, _ = w.Write([]byte(`{"operation_id": "op-1", "energy_in": "40", "energy_out": "38", "efficiency": "0.950000000000000000"}`))
+		case "/racecar-web/energycycle/v1/operation_efficiency/op-idle":
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"code": 9, "message": "operation op-idle drew no energy: energy efficiency cannot be calculated"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"code": 5, "message": "energy operation not found"}`))
+		}
+	}))
+	defer node.Close()
+
+	c := &RESTClient{baseURL: node.URL, client: node.Client(), logger: zerolog.Nop()}
+	ctx := context.Background()
+
+	efficiency, err := c.GetOperationEfficiency(ctx, "op-1")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"operation_id": "op-1", "energy_in": 40.0, "energy_out": 38.0, "efficiency": 0.95}, efficiency)
+
+	_, err = c.GetOperationEfficiency(ctx, "op-idle")
+	assert.ErrorIs(t, err, ErrEnergyEfficiencyUnavailable)
+	assert.ErrorContains(t, err, "drew no energy")
+
+	_, err = c.GetOperationEfficiency(ctx, "op-missing")
+	assert.ErrorIs(t, err, ErrEnergyOperationNotFound)
+}
+
+func TestGetEnergyOperation(t *testing.T) {
+	node := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/racecar-web/energycycle/v1/energy_operation/op-1" {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"code": 5, "message": "op-missing: energy operation not found"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"operation": {"operation_id": "op-1", "source_lct": "lct-a", "target_lct": "lct-b",
+			"energy_amount": "40", "operation_type": "transfer", "status": "created", "timestamp": "1752484910",
+			"block_height": "12", "trust_score": "0.8", "atp_token_id": "", "adp_token_id": "",
+			"energy_efficiency": "", "energy_in": "40", "energy_out": "", "version": "1"}}`))
+	}))
+	defer node.Close()
+
+	c := &RESTClient{baseURL: node.URL, client: node.Client(), logger: zerolog.Nop()}
+	ctx := context.Background()
+
+	operation, err := c.GetEnergyOperation(ctx, "op-1")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"operation_id": "op-1",
+		"source_lct":   "lct-a",
+		"target_lct":   "lct-b",
+		"amount":       40.0,
+		"type":         "transfer",
+		"status":       "created",
+		"timestamp":    int64(1752484910),
+		"block_height": int64(12),
+		"atp_token_id": "",
+		"adp_token_id": "",
+		"version":      int64(1),
+		"trust_score":  0.8,
+		"energy_in":    40.0,
+	}, operation)
+
+	_, err = c.GetEnergyOperation(ctx, "op-missing")
+	assert.ErrorIs(t, err, ErrEnergyOperationNotFound)
+}
@@ -0,0 +1,173 @@
+package blockchain
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"go.opentelemetry.io/otel/attribute"
+
+	"api-bridge/internal/config"
+)
+
+// txGas is the gas limit and fee a transaction is signed with
+type txGas struct {
+	Limit uint64
+	Fee   sdk.Coins
+
+	// Simulated is the gas used by the simulation, 0 when a fixed limit is configured
+	Simulated uint64
+}
+
+// gasSettings is the parsed form of config.GasConfig
+type gasSettings struct {
+	adjustment float64
+	prices     sdk.DecCoins
+	limit      uint64
+}
+
+// newGasSettings validates the configured gas prices up front so a typo fails at startup
+func newGasSettings(cfg config.GasConfig) (gasSettings, error) {
+	prices, err := sdk.ParseDecCoins(cfg.Prices)
+	if err != nil {
+		return gasSettings{}, fmt.Errorf("invalid gas prices %q: %w", cfg.Prices, err)
+	}
+	if cfg.Adjustment < 0 {
+		return gasSettings{}, fmt.Errorf("invalid gas adjustment %v: must not be negative", cfg.Adjustment)
+	}
+
+	return gasSettings{
+		adjustment: cfg.Adjustment,
+		prices:     prices,
+		limit:      cfg.Limit,
+	}, nil
+}
+
+// adjust scales simulated gas by the configured adjustment, rounding up
+func (g gasSettings) adjust(gasUsed uint64) uint64 {
+	adjustment := g.adjustment
+	if adjustment == 0 {
+		adjustment = 1
+	}
+	return uint64(math.Ceil(float64(gasUsed) * adjustment))
+}
+
+// fee prices gasLimit at the configured gas prices, rounding each denom up
+func (g gasSettings) fee(gasLimit uint64) sdk.Coins {
+	limit := sdkmath.LegacyNewDecFromInt(sdkmath.NewIntFromUint64(gasLimit))
+
+	fees := make(sdk.Coins, 0, len(g.prices))
+	for _, price := range g.prices {
+		amount := price.Amount.Mul(limit).Ceil().RoundInt()
+		if amount.IsPositive() {
+			fees = append(fees, sdk.NewCoin(price.Denom, amount))
+		}
+	}
+	return sdk.NewCoins(fees...)
+}
+
+// estimateGas picks the gas limit and fee for a transaction. Unless a fixed
+// limit is configured the transaction is simulated first; a failed simulation
+// is returned as an error so nothing is broadcast with a guessed limit.
+func (c *RESTClient) estimateGas(ctx context.Context, account *Account, message map[string]interface{}, memo string) (txGas, error) {
+	if c.gas.limit > 0 {
+		return txGas{Limit: c.gas.limit, Fee: c.gas.fee(c.gas.limit)}, nil
+	}
+
+	txBytes, err := c.txExecutor.SimulationTx(ctx, account, message, memo)
+	if err != nil {
+		return txGas{}, fmt.Errorf("failed to build transaction for gas simulation: %w", err)
+	}
+
+	simulateCtx := ctx
+	if c.simulateTimeout > 0 {
+		var cancel context.CancelFunc
+		simulateCtx, cancel = context.WithTimeout(ctx, c.simulateTimeout)
+		defer cancel()
+	}
+	gasUsed, err := c.simulateTx(simulateCtx, txBytes)
+	if err != nil {
+		return txGas{}, err
+	}
+
+	limit := c.gas.adjust(gasUsed)
+	c.log(ctx).Debug().Uint64("gas_used", gasUsed).Uint64("gas_limit", limit).Msg("Estimated transaction gas")
+
+	return txGas{Limit: limit, Fee: c.gas.fee(limit), Simulated: gasUsed}, nil
+}
+
+// simulateTx runs txBytes through the node's simulate endpoint and returns the gas used
+func (c *RESTClient) simulateTx(ctx context.Context, txBytes []byte) (gasUsed uint64, err error) {
+	ctx, span := startSpan(ctx, "blockchain.simulate")
+	defer func() {
+		span.SetAttributes(attribute.Int64("tx.gas_used", int64(gasUsed)))
+		endSpan(span, err)
+	}()
+
+	respBody, err := c.makeRequest(ctx, "POST", "/cosmos/tx/v1beta1/simulate", map[string]string{
+		"tx_bytes": base64.StdEncoding.EncodeToString(txBytes),
+	})
+	if err != nil {
+		return 0, fmt.Errorf("transaction simulation failed: %w", err)
+	}
+
+	var resp struct {
+		GasInfo struct {
+			GasUsed string `json:"gas_used"`
+		} `json:"gas_info"`
+	}
+	if err := json.Unmarshal(respBody, &resp); err != nil {
+		return 0, fmt.Errorf("failed to parse simulation response: %w", err)
+	}
+
+	gasUsed, err = strconv.ParseUint(resp.GasInfo.GasUsed, 10, 64)
+	if err != nil || gasUsed == 0 {
+		return 0, fmt.Errorf("transaction simulation returned no gas estimate: %s", string(respBody))
+	}
+
+	return gasUsed, nil
+}
+
+// applyGas reports the gas and fee the transaction was signed with. Sync
+// broadcasts do not execute the tx, so gas_used falls back to the simulation.
+func applyGas(txResult map[string]interface{}, gas txGas) {
+	txResult["gas_wanted"] = gas.Limit
+	txResult["fee"] = gas.Fee.String()
+
+	if gas.Simulated > 0 && reportedGasUsed(txResult["gas_used"]) == 0 {
+		txResult["gas_used"] = gas.Simulated
+	}
+}
+
+// reportedGasUsed reads gas_used from a native (int64) or CLI JSON (string) response
+func reportedGasUsed(v interface{}) uint64 {
+	switch gasUsed := v.(type) {
+	case int64:
+		if gasUsed > 0 {
+			return uint64(gasUsed)
+		}
+	case uint64:
+		return gasUsed
+	case string:
+		parsed, _ := strconv.ParseUint(gasUsed, 10, 64)
+		return parsed
+	}
+	return 0
+}
+
+// gasFlags renders the gas limit and fee as CLI flags
+func gasFlags(gas txGas) []string {
+	if gas.Limit == 0 {
+		return nil
+	}
+	flags := []string{"--gas", strconv.FormatUint(gas.Limit, 10)}
+	if !gas.Fee.IsZero() {
+		flags = append(flags, "--fees", gas.Fee.String())
+	}
+	return flags
+}
@@ -0,0 +1,191 @@
+package blockchain
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"api-bridge/internal/config"
+	componentregistrytypes "racecar-web/x/componentregistry/types"
+)
+
+// fakeTxExecutor records the gas and message of each broadcast
+type fakeTxExecutor struct {
+	executed []txGas
+	messages []map[string]interface{}
+}
+
+func (f *fakeTxExecutor) Mode() string { return "fake" }
+
+func (f *fakeTxExecutor) Execute(ctx context.Context, account *Account, message map[string]interface{}, memo string, gas txGas) (map[string]interface{}, error) {
+	f.executed = append(f.executed, gas)
+	f.messages = append(f.messages, message)
+	return map[string]interface{}{"code": 0, "txhash": "ABC", "gas_used": int64(0)}, nil
+}
+
+func (f *fakeTxExecutor) SimulationTx(ctx context.Context, account *Account, message map[string]interface{}, memo string) ([]byte, error) {
+	return []byte("sim-tx"), nil
+}
+
+func testGasClient(t *testing.T, gasCfg config.GasConfig, simulate http.HandlerFunc) (*RESTClient, *fakeTxExecutor) {
+	t.Helper()
+	node := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Each broadcast first reads the signer's sequence
+		if strings.HasPrefix(r.URL.Path, "/cosmos/auth/v1beta1/accounts/") {
+			_, _ = w.Write([]byte(`{"account": {"sequence": "0"}}`))
+			return
+		}
+		simulate(w, r)
+	}))
+	t.Cleanup(node.Close)
+
+	gas, err := newGasSettings(gasCfg)
+	require.NoError(t, err)
+
+	accounts := &AccountManager{logger: zerolog.Nop(), accounts: make(map[string]*Account)}
+	accounts.initializeDefaultAccounts()
+
+	executor := &fakeTxExecutor{}
+	return &RESTClient{
+		baseURL:        node.URL,
+		client:         node.Client(),
+		logger:         zerolog.Nop(),
+		accountManager: accounts,
+		txExecutor:     executor,
+		gas:            gas,
+	}, executor
+}
+
+func registerMessage() map[string]interface{} {
+	return map[string]interface{}{
+		"@type":             "/racecarweb.componentregistry.v1.MsgRegisterComponent",
+		"creator":           "alice",
+		"component_id":      "MODBATT-MOD-001",
+		"component_type":    "module",
+		"manufacturer_data": `{"manufacturer_id": "TESLA_RACING"}`,
+	}
+}
+
+func TestGasSettingsFee(t *testing.T) {
+	gas, err := newGasSettings(config.GasConfig{Adjustment: 1.3, Prices: "0.025stake,0.001uracecar"})
+	require.NoError(t, err)
+
+	assert.Equal(t, uint64(130000), gas.adjust(100000))
+	assert.Equal(t, uint64(2), gas.adjust(1))
+	// Fees round up so the node's minimum is always met
+	assert.Equal(t, "2501stake,101uracecar", gas.fee(100001).String())
+
+	free, err := newGasSettings(config.GasConfig{})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(100000), free.adjust(100000))
+	assert.True(t, free.fee(100000).IsZero())
+
+	_, err = newGasSettings(config.GasConfig{Prices: "stake0.025"})
+	assert.Error(t, err)
+}
+
+func TestExecuteTransactionSimulatesGas(t *testing.T) {
+	var simulated []string
+	c, executor := testGasClient(t, config.GasConfig{Adjustment: 1.5, Prices: "0.025stake"}, func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/cosmos/tx/v1beta1/simulate", r.URL.Path)
+		var body map[string]string
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		decoded, _ := base64.StdEncoding.DecodeString(body["tx_bytes"])
+		simulated = append(simulated, string(decoded))
+		_, _ = w.Write([]byte(`{"gas_info": {"gas_wanted": "0", "gas_used": "80000"}, "result": {}}`))
+	})
+
+	result, err := c.executeTransaction(context.Background(), registerMessage(), "race_session_001")
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"sim-tx"}, simulated)
+	require.Len(t, executor.executed, 1)
+	assert.Equal(t, uint64(120000), executor.executed[0].Limit)
+	assert.Equal(t, "3000stake", executor.executed[0].Fee.String())
+
+	assert.Equal(t, uint64(120000), result["gas_wanted"])
+	assert.Equal(t, uint64(80000), result["gas_used"])
+	assert.Equal(t, "3000stake", result["fee"])
+}
+
+func TestExecuteTransactionFixedGasLimitSkipsSimulation(t *testing.T) {
+	c, executor := testGasClient(t, config.GasConfig{Adjustment: 1.5, Prices: "0.025stake", Limit: 200000}, func(w http.ResponseWriter, r *http.Request) {
+		t.Fatalf("unexpected request to %s", r.URL.Path)
+	})
+
+	result, err := c.executeTransaction(context.Background(), registerMessage(), "")
+	require.NoError(t, err)
+
+	require.Len(t, executor.executed, 1)
+	assert.Equal(t, uint64(200000), executor.executed[0].Limit)
+	assert.Equal(t, "5000stake", result["fee"])
+}
+
+func TestExecuteTransactionSimulationFailureDoesNotBroadcast(t *testing.T) {
+	c, executor := testGasClient(t, config.GasConfig{Adjustment: 1.3}, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"code": 3, "message": "failed to execute message; message index: 0: component already registered: invalid request"}`))
+	})
+
+	_, err := c.executeTransaction(context.Background(), registerMessage(), "")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "transaction simulation failed")
+	assert.Contains(t, err.Error(), "component already registered")
+	assert.Empty(t, executor.executed)
+}
+
+func TestExecuteTransactionSimulationStopsAtSimulateTimeout(t *testing.T) {
+	release := make(chan struct{})
+	c, executor := testGasClient(t, config.GasConfig{Adjustment: 1.3}, func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	})
+	defer close(release)
+	c.simulateTimeout = 50 * time.Millisecond
+
+	started := time.Now()
+	_, err := c.executeTransaction(context.Background(), registerMessage(), "")
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Less(t, time.Since(started), 5*time.Second)
+	assert.Empty(t, executor.executed)
+}
+
+func TestCLISimulationTxEncodesMessage(t *testing.T) {
+	c, _ := testGasClient(t, config.GasConfig{}, func(w http.ResponseWriter, r *http.Request) {
+		t.Fatalf("unexpected request to %s", r.URL.Path)
+	})
+	executor := newCLITxExecutor(c)
+
+	account := c.accountManager.GetDefaultAccount()
+	message := registerMessage()
+	message["creator"] = account.Address
+
+	txBytes, err := executor.SimulationTx(withSequenceOverride(context.Background(), 12), account, message, "race_session_001")
+	require.NoError(t, err)
+
+	decoded, err := executor.encoding.txConfig.TxDecoder()(txBytes)
+	require.NoError(t, err)
+
+	msgs := decoded.GetMsgs()
+	require.Len(t, msgs, 1)
+	register, ok := msgs[0].(*componentregistrytypes.MsgRegisterComponent)
+	require.True(t, ok)
+	assert.Equal(t, "MODBATT-MOD-001", register.ComponentId)
+
+	// The simulation carries the sequence the broadcast will sign with
+	sigTx, ok := decoded.(authsigning.SigVerifiableTx)
+	require.True(t, ok)
+	sigs, err := sigTx.GetSignaturesV2()
+	require.NoError(t, err)
+	require.Len(t, sigs, 1)
+	assert.Equal(t, uint64(12), sigs[0].Sequence)
+}
@@ -0,0 +1,55 @@
+//go:build devhsm
+
+package blockchain
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+func init() {
+	RegisterHSMPlugin(stubHSMPlugin, NewStubHSMSigner)
+}
+
+// stubHSMSigner is a software stand-in for an HSM, for development and tests.
+// Keys are derived from the "seed" option so addresses survive restarts.
+type stubHSMSigner struct {
+	seed string
+	mu   sync.Mutex
+	keys map[string]*secp256k1.PrivKey
+}
+
+// NewStubHSMSigner creates the "stub" HSM plugin. It holds keys in memory and
+// must not be used in production, so it is only compiled into devhsm builds.
+func NewStubHSMSigner(options map[string]string) (HSMSigner, error) {
+	seed := options["seed"]
+	if seed == "" {
+		return nil, fmt.Errorf("the stub HSM needs a seed option")
+	}
+	return &stubHSMSigner{seed: seed, keys: make(map[string]*secp256k1.PrivKey)}, nil
+}
+
+func (s *stubHSMSigner) key(uid string) *secp256k1.PrivKey {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	priv, ok := s.keys[uid]
+	if !ok {
+		priv = secp256k1.GenPrivKeyFromSecret([]byte(s.seed + "/" + uid))
+		s.keys[uid] = priv
+	}
+	return priv
+}
+
+// PubKey implements HSMSigner
+func (s *stubHSMSigner) PubKey(uid string) (cryptotypes.PubKey, error) {
+	return s.key(uid).PubKey(), nil
+}
+
+// Sign implements HSMSigner
+func (s *stubHSMSigner) Sign(uid string, msg []byte) ([]byte, error) {
+	return s.key(uid).Sign(msg)
+}
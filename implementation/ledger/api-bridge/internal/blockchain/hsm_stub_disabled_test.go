@@ -0,0 +1,14 @@
+//go:build !devhsm
+
+package blockchain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStubHSMPluginNeedsDevBuild(t *testing.T) {
+	_, err := newKeyring(KeyringConfig{Backend: KeyringBackendHSM, HSMPlugin: "stub", HSMOptions: map[string]string{"seed": "racecar-dev"}}, testEncoding(t).codec)
+	assert.ErrorContains(t, err, "-tags devhsm")
+}
@@ -0,0 +1,54 @@
+//go:build devhsm
+
+package blockchain
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	componentregistrytypes "racecar-web/x/componentregistry/types"
+)
+
+func TestHSMKeyringWithStubPlugin(t *testing.T) {
+	enc := testEncoding(t)
+	cfg := KeyringConfig{Backend: KeyringBackendHSM, HSMPlugin: "stub", HSMOptions: map[string]string{"seed": "racecar-dev"}}
+
+	kr, err := newKeyring(cfg, enc.codec)
+	require.NoError(t, err)
+	require.Equal(t, KeyringBackendHSM, kr.Backend())
+	alice := requireSignsWith(t, kr, "alice")
+
+	// Keys are derived from the seed, so the address is stable across restarts
+	reopened, err := newKeyring(cfg, enc.codec)
+	require.NoError(t, err)
+	assert.Equal(t, alice, requireSignsWith(t, reopened, "alice"))
+	assert.NotEqual(t, alice, requireSignsWith(t, reopened, "bob"))
+
+	// A full transaction signs through the device
+	msg := &componentregistrytypes.MsgRegisterComponent{Creator: alice.String(), ComponentId: "MODBATT-MOD-001", ComponentType: "module"}
+	txf := tx.Factory{}.
+		WithTxConfig(enc.txConfig).
+		WithKeybase(kr).
+		WithChainID("racecarweb").
+		WithAccountNumber(7).
+		WithSequence(3).
+		WithGas(200000).
+		WithSignMode(signingtypes.SignMode_SIGN_MODE_DIRECT)
+	builder, err := txf.BuildUnsignedTx(msg)
+	require.NoError(t, err)
+	require.NoError(t, tx.Sign(context.Background(), txf, "alice", builder, true))
+
+	sigs, err := builder.GetTx().GetSignaturesV2()
+	require.NoError(t, err)
+	require.Len(t, sigs, 1)
+	assert.Equal(t, alice, sdk.AccAddress(sigs[0].PubKey.Address()))
+
+	_, err = newKeyring(KeyringConfig{Backend: KeyringBackendHSM, HSMPlugin: "stub"}, enc.codec)
+	assert.Error(t, err, "stub needs a seed")
+}
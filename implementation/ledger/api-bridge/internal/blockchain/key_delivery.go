@@ -0,0 +1,131 @@
+package blockchain
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+
+	"api-bridge/internal/config"
+	lctmanagerkeeper "racecar-web/x/lctmanager/keeper"
+)
+
+// ErrNoComponentKey is returned when a split key half cannot be delivered
+// because its component has no key configured to seal it to
+var ErrNoComponentKey = errors.New("no key to seal the split key half to")
+
+// KeyHalfDelivery hands a component its sealed half of a split key over a
+// channel outside the API responses, such as the webhook event queue
+type KeyHalfDelivery interface {
+	DeliverKeyHalf(ctx context.Context, half SealedKeyHalf) error
+}
+
+// SealedKeyHalf is one component's half of a split key, sealed to the
+// component's X25519 key. Only the component can open it, with the
+// lctmanager's OpenMessage and its private key, and it matches the half to
+// its split key by the key reference.
+type SealedKeyHalf struct {
+	LctID        string `json:"lct_id"`
+	ComponentID  string `json:"component_id"`
+	KeyReference string `json:"key_reference"`
+	SealedHalf   string `json:"sealed_half"` // hex: ephemeral X25519 public key, nonce and ChaCha20-Poly1305 ciphertext
+}
+
+// parseComponentKeys decodes the X25519 public keys split key halves are
+// sealed to, by component ID
+func parseComponentKeys(keys []config.ComponentKeyConfig) (map[string][32]byte, error) {
+	parsed := make(map[string][32]byte, len(keys))
+	for _, key := range keys {
+		if key.ComponentID == "" {
+			return nil, fmt.Errorf("blockchain.key_delivery.component_keys: component_id is required")
+		}
+		raw, err := hex.DecodeString(key.PublicKey)
+		if err != nil || len(raw) != 32 {
+			return nil, fmt.Errorf("blockchain.key_delivery.component_keys %s: public_key must be 32 hex-encoded bytes", key.ComponentID)
+		}
+		parsed[key.ComponentID] = [32]byte(raw)
+	}
+	return parsed, nil
+}
+
+// sealKeyHalves seals each half of a split key issued for an LCT to the
+// component that holds it. Both halves are sealed before anything is
+// broadcast, so a key is only issued when both components can receive it.
+// Without a delivery channel there is nothing to seal for, and the halves are
+// dropped once their commitment is on chain.
+func (c *RESTClient) sealKeyHalves(ctx context.Context, lctID, splitKeyA, splitKeyB string, keyCommitment []byte) ([]SealedKeyHalf, error) {
+	if c.keyHalves == nil {
+		c.log(ctx).Warn().Str("lct_id", lctID).Msg("No key half delivery configured, split key halves are discarded")
+		return nil, nil
+	}
+
+	var lct LCTResponse
+	if err := c.queryJSON(ctx, fmt.Sprintf("/racecar-web/lctmanager/v1/get_lct/%s", url.PathEscape(lctID)), &lct); err != nil {
+		return nil, fmt.Errorf("failed to look up the components of LCT %s: %w", lctID, err)
+	}
+
+	keyReference := hex.EncodeToString(keyCommitment)
+	halves := make([]SealedKeyHalf, 0, 2)
+	for _, holder := range []struct{ componentID, splitKey string }{
+		{lct.ComponentAID, splitKeyA},
+		{lct.ComponentBID, splitKeyB},
+	} {
+		recipient, ok := c.componentKeys[holder.componentID]
+		if !ok {
+			return nil, fmt.Errorf("%w: component %s", ErrNoComponentKey, holder.componentID)
+		}
+		half, err := hex.DecodeString(holder.splitKey)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode split key half: %w", err)
+		}
+		sealed, err := lctmanagerkeeper.SealMessage(recipient, half)
+		clear(half)
+		if err != nil {
+			return nil, fmt.Errorf("failed to seal split key half for %s: %w", holder.componentID, err)
+		}
+		halves = append(halves, SealedKeyHalf{
+			LctID:        lctID,
+			ComponentID:  holder.componentID,
+			KeyReference: keyReference,
+			SealedHalf:   hex.EncodeToString(sealed),
+		})
+	}
+	return halves, nil
+}
+
+// sealPairingKeyHalves seals the halves of a pairing's split key to the
+// components of the LCT the pairing activates
+func (c *RESTClient) sealPairingKeyHalves(ctx context.Context, challengeID, splitKeyA, splitKeyB string, keyCommitment []byte) ([]SealedKeyHalf, error) {
+	if c.keyHalves == nil {
+		c.log(ctx).Warn().Str("challenge_id", challengeID).Msg("No key half delivery configured, split key halves are discarded")
+		return nil, nil
+	}
+
+	// The pairing session names its LCT from initiation on
+	var response struct {
+		PairingChallenge string `json:"pairing_challenge"`
+	}
+	if err := c.queryJSON(ctx, fmt.Sprintf("/racecar-web/pairing/v1/get_pairing_status/%s", url.PathEscape(challengeID)), &response); err != nil {
+		return nil, fmt.Errorf("failed to look up pairing %s: %w", challengeID, err)
+	}
+	var session struct {
+		LctID string `json:"lct_id"`
+	}
+	if err := json.Unmarshal([]byte(response.PairingChallenge), &session); err != nil || session.LctID == "" {
+		return nil, fmt.Errorf("pairing %s names no LCT to deliver its split key for", challengeID)
+	}
+	return c.sealKeyHalves(ctx, session.LctID, splitKeyA, splitKeyB, keyCommitment)
+}
+
+// deliverKeyHalves hands sealed halves to their components once the split key
+// is on chain
+func (c *RESTClient) deliverKeyHalves(ctx context.Context, halves []SealedKeyHalf) error {
+	for _, half := range halves {
+		if err := c.keyHalves.DeliverKeyHalf(ctx, half); err != nil {
+			return fmt.Errorf("split key %s is on chain but its half for %s was not delivered, rotate it: %w", half.KeyReference, half.ComponentID, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,60 @@
+package blockchain
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetKeyExchangeFollowsTheExchange(t *testing.T) {
+	// stage is what the chain reports for the LCT: nothing before the exchange
+	// is initiated, then the pending and the completed exchange
+	stage := ""
+	node := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/racecar-web/lctmanager/v1/key_exchange/lct-MODBATT-PACK-001-MODBATT-MC-001", r.URL.Path)
+		switch stage {
+		case "initiated":
+			_, _ = w.Write([]byte(`{"lct_id": "lct-MODBATT-PACK-001-MODBATT-MC-001", "status": "pending", "lct_status": "key_exchange_initiated",
+				"created_at": "1752484904", "last_used": "0", "component_a_id": "MODBATT-PACK-001", "component_b_id": "MODBATT-MC-001", "proxy_component_id": ""}`))
+		case "completed":
+			_, _ = w.Write([]byte(`{"lct_id": "lct-MODBATT-PACK-001-MODBATT-MC-001", "status": "active", "lct_status": "active",
+				"created_at": "1752484904", "last_used": "1752484934", "component_a_id": "MODBATT-PACK-001", "component_b_id": "MODBATT-MC-001", "proxy_component_id": "MODBATT-PC-001"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"code": 5, "message": "no key exchange has been initiated"}`))
+		}
+	}))
+	defer node.Close()
+
+	c := &RESTClient{baseURL: node.URL, client: node.Client(), logger: zerolog.Nop()}
+	ctx := context.Background()
+	lctID := "lct-MODBATT-PACK-001-MODBATT-MC-001"
+
+	_, err := c.GetKeyExchange(ctx, lctID)
+	assert.ErrorIs(t, err, ErrKeyExchangeNotFound)
+
+	stage = "initiated"
+	exchange, err := c.GetKeyExchange(ctx, lctID)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"lct_id":       lctID,
+		"status":       "pending",
+		"lct_status":   "key_exchange_initiated",
+		"initiated_at": int64(1752484904),
+		"participants": []string{"MODBATT-PACK-001", "MODBATT-MC-001"},
+	}, exchange)
+
+	stage = "completed"
+	exchange, err = c.GetKeyExchange(ctx, lctID)
+	require.NoError(t, err)
+	assert.Equal(t, "active", exchange["status"])
+	assert.Equal(t, "active", exchange["lct_status"])
+	assert.Equal(t, int64(1752484904), exchange["initiated_at"])
+	assert.Equal(t, int64(1752484934), exchange["completed_at"])
+	assert.Equal(t, []string{"MODBATT-PACK-001", "MODBATT-MC-001", "MODBATT-PC-001"}, exchange["participants"])
+}
@@ -0,0 +1,241 @@
+package blockchain
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+// Keyring backends selectable through blockchain.keyring_backend
+const (
+	KeyringBackendFile = keyring.BackendFile
+	KeyringBackendOS   = keyring.BackendOS
+	KeyringBackendTest = keyring.BackendTest
+	KeyringBackendHSM  = "hsm"
+)
+
+// KeyringConfig selects and unlocks the keyring accounts are signed with
+type KeyringConfig struct {
+	Backend    string
+	Dir        string
+	Passphrase string // unlocks the file backend
+	HSMPlugin  string
+	HSMOptions map[string]string
+}
+
+// HSMSigner is implemented by hardware-backed and KMS signers. Private keys
+// never leave the device: the bridge only asks for public keys and signatures.
+type HSMSigner interface {
+	// PubKey returns the secp256k1 public key stored under uid
+	PubKey(uid string) (cryptotypes.PubKey, error)
+
+	// Sign returns the 64-byte r||s secp256k1 signature over sha256(msg),
+	// the format produced by the software keyring
+	Sign(uid string, msg []byte) ([]byte, error)
+}
+
+// stubHSMPlugin names the software stand-in registered by devhsm builds
+const stubHSMPlugin = "stub"
+
+// HSMPlugin creates an HSMSigner from the options under blockchain.hsm.options
+type HSMPlugin func(options map[string]string) (HSMSigner, error)
+
+var (
+	hsmPluginsMu sync.RWMutex
+	hsmPlugins   = map[string]HSMPlugin{}
+)
+
+// RegisterHSMPlugin makes a signer available as blockchain.hsm.plugin. Vendor
+// integrations register themselves from an init function.
+func RegisterHSMPlugin(name string, plugin HSMPlugin) {
+	hsmPluginsMu.Lock()
+	defer hsmPluginsMu.Unlock()
+	hsmPlugins[name] = plugin
+}
+
+// newKeyring opens the keyring selected by cfg.Backend
+func newKeyring(cfg KeyringConfig, cdc codec.Codec) (keyring.Keyring, error) {
+	switch cfg.Backend {
+	case "", KeyringBackendTest, KeyringBackendOS, KeyringBackendFile:
+		backend := cfg.Backend
+		if backend == "" {
+			backend = KeyringBackendTest
+		}
+
+		dir, err := expandHome(cfg.Dir)
+		if err != nil {
+			return nil, err
+		}
+
+		var userInput io.Reader
+		if backend == KeyringBackendFile {
+			if cfg.Passphrase == "" {
+				return nil, fmt.Errorf("the file keyring backend needs a passphrase")
+			}
+			userInput = newPassphraseReader(cfg.Passphrase)
+		}
+
+		kr, err := keyring.New("racecar-web", backend, dir, userInput, cdc)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open %s keyring: %w", backend, err)
+		}
+		return kr, nil
+
+	case KeyringBackendHSM:
+		hsmPluginsMu.RLock()
+		plugin, ok := hsmPlugins[cfg.HSMPlugin]
+		hsmPluginsMu.RUnlock()
+		if !ok && cfg.HSMPlugin == stubHSMPlugin {
+			return nil, fmt.Errorf("the %q HSM plugin derives keys from a fixed seed and is only built with -tags devhsm", stubHSMPlugin)
+		}
+		if !ok {
+			return nil, fmt.Errorf("unknown HSM plugin %q (registered: %s)", cfg.HSMPlugin, strings.Join(registeredHSMPlugins(), ", "))
+		}
+
+		signer, err := plugin(cfg.HSMOptions)
+		if err != nil {
+			return nil, fmt.Errorf("failed to initialise HSM plugin %q: %w", cfg.HSMPlugin, err)
+		}
+		return newHSMKeyring(signer, cdc), nil
+
+	default:
+		return nil, fmt.Errorf("unknown keyring backend %q (expected %q, %q, %q or %q)", cfg.Backend,
+			KeyringBackendFile, KeyringBackendOS, KeyringBackendTest, KeyringBackendHSM)
+	}
+}
+
+// keyringFlags points a CLI command at the configured keyring, so the CLI
+// signs with the same keys the bridge resolves accounts from
+func keyringFlags(cfg KeyringConfig) []string {
+	if cfg.Backend == "" {
+		return nil
+	}
+	flags := []string{"--keyring-backend", cfg.Backend}
+	if dir, err := expandHome(cfg.Dir); err == nil && dir != "" {
+		flags = append(flags, "--keyring-dir", dir)
+	}
+	return flags
+}
+
+// keyringInput answers the passphrase prompt of the file backend, which would
+// otherwise block a CLI command started by the server
+func keyringInput(cfg KeyringConfig) io.Reader {
+	if cfg.Backend != KeyringBackendFile {
+		return nil
+	}
+	return strings.NewReader(cfg.Passphrase + "\n")
+}
+
+func registeredHSMPlugins() []string {
+	hsmPluginsMu.RLock()
+	defer hsmPluginsMu.RUnlock()
+
+	names := make([]string, 0, len(hsmPlugins))
+	for name := range hsmPlugins {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// expandHome resolves a leading ~/ since the keyring does not
+func expandHome(dir string) (string, error) {
+	if !strings.HasPrefix(dir, "~/") {
+		return dir, nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve home directory: %w", err)
+	}
+	return filepath.Join(home, dir[2:]), nil
+}
+
+// passphraseReader answers every passphrase prompt of the file backend,
+// including the confirmation asked when the keyring is first created
+type passphraseReader struct {
+	line    []byte
+	pending []byte
+}
+
+func newPassphraseReader(passphrase string) *passphraseReader {
+	return &passphraseReader{line: []byte(passphrase + "\n")}
+}
+
+func (r *passphraseReader) Read(p []byte) (int, error) {
+	if len(r.pending) == 0 {
+		r.pending = r.line
+	}
+	n := copy(p, r.pending)
+	r.pending = r.pending[n:]
+	return n, nil
+}
+
+// hsmKeyring adapts an HSMSigner to the SDK keyring used by the tx factory.
+// Public keys are cached as offline records in an in-memory keyring, which
+// answers every other keyring call; signing is delegated to the device.
+type hsmKeyring struct {
+	keyring.Keyring
+	signer HSMSigner
+}
+
+func newHSMKeyring(signer HSMSigner, cdc codec.Codec) *hsmKeyring {
+	return &hsmKeyring{
+		Keyring: keyring.NewInMemory(cdc),
+		signer:  signer,
+	}
+}
+
+// Backend implements keyring.Keyring
+func (k *hsmKeyring) Backend() string {
+	return KeyringBackendHSM
+}
+
+// Key implements keyring.Keyring, loading the public key from the device on first use
+func (k *hsmKeyring) Key(uid string) (*keyring.Record, error) {
+	if record, err := k.Keyring.Key(uid); err == nil {
+		return record, nil
+	}
+
+	pubKey, err := k.signer.PubKey(uid)
+	if err != nil {
+		return nil, fmt.Errorf("HSM key %q: %w", uid, err)
+	}
+	return k.Keyring.SaveOfflineKey(uid, pubKey)
+}
+
+// Sign implements keyring.Signer
+func (k *hsmKeyring) Sign(uid string, msg []byte, _ signingtypes.SignMode) ([]byte, cryptotypes.PubKey, error) {
+	record, err := k.Key(uid)
+	if err != nil {
+		return nil, nil, err
+	}
+	pubKey, err := record.GetPubKey()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sig, err := k.signer.Sign(uid, msg)
+	if err != nil {
+		return nil, nil, fmt.Errorf("HSM failed to sign with key %q: %w", uid, err)
+	}
+	return sig, pubKey, nil
+}
+
+// SignByAddress implements keyring.Signer for keys already loaded through Key
+func (k *hsmKeyring) SignByAddress(address sdk.Address, msg []byte, signMode signingtypes.SignMode) ([]byte, cryptotypes.PubKey, error) {
+	record, err := k.Keyring.KeyByAddress(address)
+	if err != nil {
+		return nil, nil, err
+	}
+	return k.Sign(record.Name, msg, signMode)
+}
@@ -0,0 +1,192 @@
+package blockchain
+
+import (
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"api-bridge/internal/config"
+)
+
+func testEncoding(t *testing.T) *txEncoding {
+	t.Helper()
+	enc, err := newTxEncoding()
+	require.NoError(t, err)
+	return enc
+}
+
+// requireSignsWith checks the keyring signs as uid and the signature verifies
+func requireSignsWith(t *testing.T, kr keyring.Keyring, uid string) sdk.AccAddress {
+	t.Helper()
+	record, err := kr.Key(uid)
+	require.NoError(t, err)
+	addr, err := record.GetAddress()
+	require.NoError(t, err)
+
+	msg := []byte("racecar sign bytes")
+	sig, pubKey, err := kr.Sign(uid, msg, signingtypes.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+	require.True(t, pubKey.VerifySignature(msg, sig))
+	require.Equal(t, addr, sdk.AccAddress(pubKey.Address()))
+	return addr
+}
+
+func TestFileKeyringBackend(t *testing.T) {
+	enc := testEncoding(t)
+	cfg := KeyringConfig{Backend: KeyringBackendFile, Dir: t.TempDir(), Passphrase: "pit-lane-passphrase"}
+
+	kr, err := newKeyring(cfg, enc.codec)
+	require.NoError(t, err)
+	require.Equal(t, KeyringBackendFile, kr.Backend())
+	_, _, err = kr.NewMnemonic("alice", keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+	created := requireSignsWith(t, kr, "alice")
+
+	// The key persists and unlocks again with the same passphrase
+	reopened, err := newKeyring(cfg, enc.codec)
+	require.NoError(t, err)
+	assert.Equal(t, created, requireSignsWith(t, reopened, "alice"))
+
+	wrong := cfg
+	wrong.Passphrase = "not-the-passphrase"
+	locked, err := newKeyring(wrong, enc.codec)
+	require.NoError(t, err)
+	_, err = locked.Key("alice")
+	assert.Error(t, err)
+
+	missing := cfg
+	missing.Passphrase = ""
+	_, err = newKeyring(missing, enc.codec)
+	assert.Error(t, err)
+}
+
+func TestTestKeyringBackend(t *testing.T) {
+	enc := testEncoding(t)
+	cfg := KeyringConfig{Backend: KeyringBackendTest, Dir: t.TempDir()}
+
+	kr, err := newKeyring(cfg, enc.codec)
+	require.NoError(t, err)
+	require.Equal(t, KeyringBackendTest, kr.Backend())
+	_, _, err = kr.NewMnemonic("bob", keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+	created := requireSignsWith(t, kr, "bob")
+
+	reopened, err := newKeyring(cfg, enc.codec)
+	require.NoError(t, err)
+	assert.Equal(t, created, requireSignsWith(t, reopened, "bob"))
+}
+
+func TestUnknownKeyringBackend(t *testing.T) {
+	_, err := newKeyring(KeyringConfig{Backend: "ledger"}, testEncoding(t).codec)
+	assert.Error(t, err)
+	_, err = newKeyring(KeyringConfig{Backend: KeyringBackendHSM, HSMPlugin: "pkcs11"}, testEncoding(t).codec)
+	assert.Error(t, err, "plugin not registered")
+}
+
+// unavailableHSM models a device that is offline
+type unavailableHSM struct{}
+
+func (unavailableHSM) PubKey(uid string) (cryptotypes.PubKey, error) {
+	return nil, fmt.Errorf("device not connected")
+}
+
+func (unavailableHSM) Sign(uid string, msg []byte) ([]byte, error) {
+	return nil, fmt.Errorf("device not connected")
+}
+
+func TestRegisterHSMPlugin(t *testing.T) {
+	RegisterHSMPlugin("offline-test", func(options map[string]string) (HSMSigner, error) {
+		return unavailableHSM{}, nil
+	})
+
+	kr, err := newKeyring(KeyringConfig{Backend: KeyringBackendHSM, HSMPlugin: "offline-test"}, testEncoding(t).codec)
+	require.NoError(t, err)
+
+	_, err = kr.Key("alice")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "device not connected")
+}
+
+func TestOpenKeyringResolvesCreatorsThroughBackend(t *testing.T) {
+	enc := testEncoding(t)
+	cfg := KeyringConfig{Backend: KeyringBackendFile, Dir: t.TempDir(), Passphrase: "pit-lane-passphrase"}
+
+	kr, err := newKeyring(cfg, enc.codec)
+	require.NoError(t, err)
+	_, _, err = kr.NewMnemonic("alice", keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+	alice := requireSignsWith(t, kr, "alice")
+
+	am := &AccountManager{logger: zerolog.Nop(), accounts: make(map[string]*Account)}
+	am.initializeDefaultAccounts()
+	require.NoError(t, am.OpenKeyring(cfg, false))
+	require.Equal(t, KeyringBackendFile, am.Keyring().Backend())
+
+	// The keyring's alice replaces the built-in development address
+	assert.Equal(t, alice.String(), am.GetAccountForCreator("alice").Address)
+
+	// A key added after startup is found on first use
+	_, _, err = am.Keyring().NewMnemonic("dave", keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+	dave := requireSignsWith(t, am.Keyring(), "dave")
+	assert.Equal(t, dave.String(), am.GetAccountForCreator("dave").Address)
+
+	// Unknown creators still fall back to the default account
+	assert.Equal(t, alice.String(), am.GetAccountForCreator("erin").Address)
+
+	// Keys the passphrase does not unlock are never used to sign
+	locked := cfg
+	locked.Passphrase = "not-the-passphrase"
+	other := &AccountManager{logger: zerolog.Nop(), accounts: make(map[string]*Account)}
+	require.NoError(t, other.OpenKeyring(locked, false))
+	_, ok := other.GetAccount("alice")
+	assert.False(t, ok)
+}
+
+func TestKeyringFlags(t *testing.T) {
+	assert.Nil(t, keyringFlags(KeyringConfig{}))
+	assert.Equal(t, []string{"--keyring-backend", "file", "--keyring-dir", "/var/lib/racecar"},
+		keyringFlags(KeyringConfig{Backend: KeyringBackendFile, Dir: "/var/lib/racecar"}))
+}
+
+func TestKeyringInputAnswersFilePassphrase(t *testing.T) {
+	assert.Nil(t, keyringInput(KeyringConfig{Backend: KeyringBackendTest}))
+
+	input := keyringInput(KeyringConfig{Backend: KeyringBackendFile, Passphrase: "pit-lane-passphrase"})
+	require.NotNil(t, input)
+	line, err := io.ReadAll(input)
+	require.NoError(t, err)
+	assert.Equal(t, "pit-lane-passphrase\n", string(line))
+}
+
+func TestCLIUsesKeyringOnlyOnceItOpens(t *testing.T) {
+	// A file keyring without its passphrase does not open, so the CLI keeps its own
+	locked, err := NewClientFromConfig(config.BlockchainConfig{
+		RESTEndpoint:         "http://localhost:1317",
+		KeyringBackend:       KeyringBackendFile,
+		KeyringDir:           t.TempDir(),
+		KeyringPassphraseEnv: "RACECAR_TEST_UNSET_PASSPHRASE",
+	}, zerolog.Nop())
+	require.NoError(t, err)
+	require.Error(t, locked.keyringErr)
+	assert.Nil(t, keyringFlags(locked.restClient.keyring))
+
+	dir := t.TempDir()
+	open, err := NewClientFromConfig(config.BlockchainConfig{
+		RESTEndpoint:   "http://localhost:1317",
+		KeyringBackend: KeyringBackendTest,
+		KeyringDir:     dir,
+	}, zerolog.Nop())
+	require.NoError(t, err)
+	require.NoError(t, open.keyringErr)
+	assert.Equal(t, []string{"--keyring-backend", "test", "--keyring-dir", dir}, keyringFlags(open.restClient.keyring))
+}
@@ -0,0 +1,104 @@
+package blockchain
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// ErrMalformedLCTResponse is returned when a get_lct response does not carry
+// a usable linked_context_token
+var ErrMalformedLCTResponse = errors.New("malformed LCT response")
+
+// LCTResponse is the body of the chain's get_lct query. The chain encodes
+// linked_context_token as a JSON string holding the LCT object; older nodes
+// and proxies that re-encode responses send the object itself. Both decode to
+// the same LCTResponse.
+type LCTResponse struct {
+	LctID        string
+	ComponentAID string
+	ComponentBID string
+
+	// Fields holds every field of the LCT as the chain sent it
+	Fields map[string]interface{}
+}
+
+// lctRequiredFields are the fields every stored LCT has
+type lctRequiredFields struct {
+	LctID        string `json:"lct_id"`
+	ComponentAID string `json:"component_a_id"`
+	ComponentBID string `json:"component_b_id"`
+}
+
+// UnmarshalJSON decodes a get_lct response, accepting linked_context_token
+// as either an object or a string containing one
+func (r *LCTResponse) UnmarshalJSON(data []byte) error {
+	var envelope struct {
+		LinkedContextToken json.RawMessage `json:"linked_context_token"`
+	}
+	if err := json.Unmarshal(data, &envelope); err != nil {
+		return fmt.Errorf("%w: %v", ErrMalformedLCTResponse, err)
+	}
+
+	raw := bytes.TrimSpace(envelope.LinkedContextToken)
+	if len(raw) == 0 || bytes.Equal(raw, []byte("null")) {
+		return fmt.Errorf("%w: linked_context_token is missing", ErrMalformedLCTResponse)
+	}
+	if raw[0] == '"' {
+		var encoded string
+		if err := json.Unmarshal(raw, &encoded); err != nil {
+			return fmt.Errorf("%w: %v", ErrMalformedLCTResponse, err)
+		}
+		raw = bytes.TrimSpace([]byte(encoded))
+		if len(raw) == 0 || raw[0] != '{' {
+			return fmt.Errorf("%w: linked_context_token is a string that does not hold a JSON object", ErrMalformedLCTResponse)
+		}
+	}
+	if raw[0] != '{' {
+		return fmt.Errorf("%w: linked_context_token must be an object or a string holding one, got %s", ErrMalformedLCTResponse, describeJSON(raw))
+	}
+
+	var fields map[string]interface{}
+	if err := json.Unmarshal(raw, &fields); err != nil {
+		return fmt.Errorf("%w: linked_context_token is not valid JSON: %v", ErrMalformedLCTResponse, err)
+	}
+	var required lctRequiredFields
+	if err := json.Unmarshal(raw, &required); err != nil {
+		return fmt.Errorf("%w: %v", ErrMalformedLCTResponse, err)
+	}
+
+	var missing []string
+	if required.LctID == "" {
+		missing = append(missing, "lct_id")
+	}
+	if required.ComponentAID == "" {
+		missing = append(missing, "component_a_id")
+	}
+	if required.ComponentBID == "" {
+		missing = append(missing, "component_b_id")
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("%w: linked_context_token is missing %v", ErrMalformedLCTResponse, missing)
+	}
+
+	*r = LCTResponse{
+		LctID:        required.LctID,
+		ComponentAID: required.ComponentAID,
+		ComponentBID: required.ComponentBID,
+		Fields:       fields,
+	}
+	return nil
+}
+
+// describeJSON names the kind of a raw JSON value for error messages
+func describeJSON(raw []byte) string {
+	switch raw[0] {
+	case '[':
+		return "an array"
+	case 't', 'f':
+		return "a boolean"
+	default:
+		return "a number"
+	}
+}
@@ -0,0 +1,94 @@
+package blockchain
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const lctObject = `{"lct_id": "lct-PACK-MOD-1", "component_a_id": "MODBATT-PACK-001", "component_b_id": "MODBATT-MOD-001", "pairing_status": "active", "created_at": 1752492851}`
+
+func TestLCTResponseDecodesStringEncodedToken(t *testing.T) {
+	encoded, err := json.Marshal(lctObject)
+	require.NoError(t, err)
+
+	var resp LCTResponse
+	require.NoError(t, json.Unmarshal([]byte(`{"linked_context_token": `+string(encoded)+`}`), &resp))
+	assert.Equal(t, "lct-PACK-MOD-1", resp.LctID)
+	assert.Equal(t, "MODBATT-PACK-001", resp.ComponentAID)
+	assert.Equal(t, "MODBATT-MOD-001", resp.ComponentBID)
+	assert.Equal(t, "active", resp.Fields["pairing_status"])
+	assert.Equal(t, float64(1752492851), resp.Fields["created_at"])
+}
+
+func TestLCTResponseDecodesObjectToken(t *testing.T) {
+	var fromObject, fromString LCTResponse
+	require.NoError(t, json.Unmarshal([]byte(`{"linked_context_token": `+lctObject+`}`), &fromObject))
+
+	encoded, err := json.Marshal(lctObject)
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal([]byte(`{"linked_context_token": `+string(encoded)+`}`), &fromString))
+
+	assert.Equal(t, fromString, fromObject)
+}
+
+func TestLCTResponseRejectsMalformedShapes(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		want string
+	}{
+		{"not JSON", `<html>bad gateway</html>`, "invalid character"},
+		{"missing token", `{"found": true}`, "linked_context_token is missing"},
+		{"null token", `{"linked_context_token": null}`, "linked_context_token is missing"},
+		{"array token", `{"linked_context_token": [1, 2]}`, "got an array"},
+		{"number token", `{"linked_context_token": 42}`, "got a number"},
+		{"string holding text", `{"linked_context_token": "not an lct"}`, "does not hold a JSON object"},
+		{"string holding an array", `{"linked_context_token": "[]"}`, "does not hold a JSON object"},
+		{"truncated object", `{"linked_context_token": "{\"lct_id\": \"lct-1\""}`, "not valid JSON"},
+		{"missing fields", `{"linked_context_token": {"lct_id": "lct-1"}}`, "missing [component_a_id component_b_id]"},
+		{"mistyped field", `{"linked_context_token": {"lct_id": 7, "component_a_id": "A", "component_b_id": "B"}}`, "cannot unmarshal number"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var resp LCTResponse
+			err := json.Unmarshal([]byte(tt.body), &resp)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.want)
+			if tt.name != "not JSON" {
+				// The envelope itself is valid JSON, so the error comes from UnmarshalJSON
+				assert.ErrorIs(t, err, ErrMalformedLCTResponse)
+			}
+		})
+	}
+}
+
+func TestGetLCTAcceptsBothEncodings(t *testing.T) {
+	encoded, err := json.Marshal(lctObject)
+	require.NoError(t, err)
+	bodies := map[string]string{
+		"/racecar-web/lctmanager/v1/get_lct/string": `{"linked_context_token": ` + string(encoded) + `}`,
+		"/racecar-web/lctmanager/v1/get_lct/object": `{"linked_context_token": ` + lctObject + `}`,
+		"/racecar-web/lctmanager/v1/get_lct/broken": `{"linked_context_token": "oops"}`,
+	}
+	node := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(bodies[r.URL.Path]))
+	}))
+	defer node.Close()
+
+	client := NewRESTClient(node.URL, zerolog.Nop())
+	for _, encoding := range []string{"string", "object"} {
+		lct, err := client.GetLCT(context.Background(), encoding)
+		require.NoError(t, err, encoding)
+		assert.Equal(t, "lct-PACK-MOD-1", lct["lct_id"], encoding)
+	}
+
+	_, err = client.GetLCT(context.Background(), "broken")
+	assert.ErrorIs(t, err, ErrMalformedLCTResponse)
+}
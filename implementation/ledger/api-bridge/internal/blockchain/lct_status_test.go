@@ -0,0 +1,105 @@
+package blockchain
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"api-bridge/internal/config"
+)
+
+// fakeLCTChain applies LCT messages to an in-memory store, standing in for
+// the lctmanager module behind a TxExecutor
+type fakeLCTChain struct {
+	mu   sync.Mutex
+	lcts map[string]map[string]interface{}
+	txs  int
+}
+
+func (f *fakeLCTChain) Mode() string { return "fake" }
+
+func (f *fakeLCTChain) SimulationTx(ctx context.Context, account *Account, message map[string]interface{}, memo string) ([]byte, error) {
+	return []byte("sim-tx"), nil
+}
+
+func (f *fakeLCTChain) Execute(ctx context.Context, account *Account, message map[string]interface{}, memo string, gas txGas) (map[string]interface{}, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.txs++
+	blockTime := time.Unix(1752492851+int64(f.txs), 0).UTC()
+	result := map[string]interface{}{"code": 0, "txhash": fmt.Sprintf("TX%d", f.txs), "timestamp": blockTime.Format(time.RFC3339)}
+
+	switch message["@type"] {
+	case "/racecarweb.lctmanager.v1.MsgCreateLctRelationship":
+		lctID := fmt.Sprintf("lct-%s-%s", message["component_a"], message["component_b"])
+		f.lcts[lctID] = map[string]interface{}{
+			"lct_id":              lctID,
+			"component_a_id":      message["component_a"],
+			"component_b_id":      message["component_b"],
+			"operational_context": message["context"],
+			"pairing_status":      "active",
+			"updated_at":          blockTime.Unix(),
+		}
+		result["events"] = []map[string]interface{}{{
+			"type":       "lct_relationship_created",
+			"attributes": []map[string]interface{}{{"key": "lct_id", "value": lctID}},
+		}}
+	case "/racecarweb.lctmanager.v1.MsgUpdateLctStatus":
+		lct, ok := f.lcts[message["lct_id"].(string)]
+		if !ok {
+			return map[string]interface{}{"code": 1103, "txhash": result["txhash"], "raw_log": "LCT not found"}, nil
+		}
+		lct["pairing_status"] = message["new_status"]
+		lct["updated_at"] = blockTime.Unix()
+	default:
+		return nil, fmt.Errorf("unexpected message %v", message["@type"])
+	}
+	return result, nil
+}
+
+func TestUpdateLCTStatusRoundTrip(t *testing.T) {
+	chain := &fakeLCTChain{lcts: make(map[string]map[string]interface{})}
+	c, _ := testGasClient(t, config.GasConfig{Limit: 200000}, func(w http.ResponseWriter, r *http.Request) {
+		lctID := strings.TrimPrefix(r.URL.Path, "/racecar-web/lctmanager/v1/get_lct/")
+		chain.mu.Lock()
+		lct, ok := chain.lcts[lctID]
+		encoded, _ := json.Marshal(lct)
+		chain.mu.Unlock()
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]string{"linked_context_token": string(encoded)})
+	})
+	c.txExecutor = chain
+	ctx := context.Background()
+
+	created, err := c.CreateLCT(ctx, "alice", "MODBATT-PACK-001", "MODBATT-MOD-001", "battery_management", "")
+	require.NoError(t, err)
+	lctID := created["lct_id"].(string)
+	require.Equal(t, "lct-MODBATT-PACK-001-MODBATT-MOD-001", lctID)
+
+	updated, err := c.UpdateLCTStatus(ctx, "alice", lctID, "suspended", "cell imbalance")
+	require.NoError(t, err)
+	assert.Equal(t, "TX2", updated["txhash"])
+	assert.Equal(t, "suspended", updated["status"])
+	assert.Equal(t, int64(1752492853), updated["updated_at"], "the block time, not the bridge's clock")
+
+	lct, err := c.GetLCT(ctx, lctID)
+	require.NoError(t, err)
+	assert.Equal(t, "suspended", lct["pairing_status"])
+	assert.Equal(t, float64(1752492853), lct["updated_at"])
+
+	// Statuses the module does not define never reach the chain
+	_, err = c.UpdateLCTStatus(ctx, "alice", lctID, "paused", "")
+	assert.ErrorIs(t, err, ErrInvalidLCTStatus)
+	assert.Equal(t, 2, chain.txs)
+}
@@ -0,0 +1,39 @@
+package blockchain
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetLctsByProxyPages(t *testing.T) {
+	node := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/racecar-web/lctmanager/v1/proxy/MODBATT-PC-001/lcts", r.URL.Path)
+		if r.URL.Query().Get("pagination.key") == "" {
+			assert.Equal(t, "1", r.URL.Query().Get("pagination.limit"))
+			_, _ = w.Write([]byte(`{"lcts": [{"lct_id": "lct-1", "proxy_component_id": "MODBATT-PC-001"}], "pagination": {"next_key": "bGN0LTI="}}`))
+			return
+		}
+		// The last page of an empty listing carries no lcts field at all
+		_, _ = w.Write([]byte(`{"pagination": {"next_key": null}}`))
+	}))
+	defer node.Close()
+
+	c := &RESTClient{baseURL: node.URL, client: node.Client(), logger: zerolog.Nop()}
+
+	page, err := c.GetLctsByProxy(context.Background(), "MODBATT-PC-001", 1, "")
+	require.NoError(t, err)
+	assert.Equal(t, 1, page["count"])
+	assert.Equal(t, "bGN0LTI=", page["next_key"])
+	assert.Equal(t, "lct-1", page["lcts"].([]map[string]interface{})[0]["lct_id"])
+
+	page, err = c.GetLctsByProxy(context.Background(), "MODBATT-PC-001", 1, "bGN0LTI=")
+	require.NoError(t, err)
+	assert.Equal(t, 0, page["count"])
+	assert.Equal(t, []map[string]interface{}{}, page["lcts"])
+}
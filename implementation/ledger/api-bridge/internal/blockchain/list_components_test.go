@@ -0,0 +1,43 @@
+package blockchain
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListComponentsForwardsPagination(t *testing.T) {
+	node := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/racecar-web/componentregistry/v1/components", r.URL.Path)
+		query := r.URL.Query()
+		assert.Equal(t, "2", query.Get("pagination.limit"))
+		assert.Equal(t, "TU9EQkFUVC1NT0QtMDAz", query.Get("pagination.key"))
+
+		if query.Get("pagination.count_total") == "true" {
+			_, _ = w.Write([]byte(`{"components": [{"component_id": "MODBATT-MOD-003"}, {"component_id": "MODBATT-MOD-004"}], "pagination": {"next_key": "TU9EQkFUVC1NT0QtMDA1", "total": "6"}}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"pagination": {"next_key": null, "total": "0"}}`))
+	}))
+	defer node.Close()
+
+	c := &RESTClient{baseURL: node.URL, client: node.Client(), logger: zerolog.Nop()}
+
+	page, err := c.ListComponents(context.Background(), 2, "TU9EQkFUVC1NT0QtMDAz", true)
+	require.NoError(t, err)
+	assert.Len(t, page["components"], 2)
+	assert.Equal(t, "TU9EQkFUVC1NT0QtMDA1", page["next_key"])
+	assert.Equal(t, uint64(6), page["total"])
+
+	// The last page has no next_key and the total is only reported on request
+	page, err = c.ListComponents(context.Background(), 2, "TU9EQkFUVC1NT0QtMDAz", false)
+	require.NoError(t, err)
+	assert.Equal(t, []map[string]interface{}{}, page["components"])
+	assert.Equal(t, "", page["next_key"])
+	assert.NotContains(t, page, "total")
+}
@@ -0,0 +1,34 @@
+package blockchain
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListLCTsSendsFilter(t *testing.T) {
+	node := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/racecar-web/lctmanager/v1/lcts", r.URL.Path)
+		query := r.URL.Query()
+		assert.Equal(t, "MODBATT-PACK-001", query.Get("component_id"))
+		assert.Equal(t, "active", query.Get("status"))
+		assert.Equal(t, "race_day", query.Get("operational_context"))
+		assert.Equal(t, "50", query.Get("pagination.limit"))
+		assert.Equal(t, "bGN0LTI=", query.Get("pagination.key"))
+		_, _ = w.Write([]byte(`{"lcts": [{"lct_id": "lct-3", "pairing_status": "active"}], "pagination": {"next_key": "bGN0LTQ="}}`))
+	}))
+	defer node.Close()
+
+	c := &RESTClient{baseURL: node.URL, client: node.Client(), logger: zerolog.Nop()}
+	filter := LCTFilter{ComponentID: "MODBATT-PACK-001", Status: "active", OperationalContext: "race_day"}
+	page, err := c.ListLCTs(context.Background(), filter, 50, "bGN0LTI=")
+	require.NoError(t, err)
+	assert.Equal(t, 1, page["count"])
+	assert.Equal(t, "bGN0LTQ=", page["next_key"])
+	assert.Equal(t, "lct-3", page["lcts"].([]map[string]interface{})[0]["lct_id"])
+}
@@ -0,0 +1,74 @@
+package blockchain
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrUnsupportedMessageType is returned for a message whose @type the bridge
+// will not broadcast
+var ErrUnsupportedMessageType = errors.New("unsupported message type")
+
+// DefaultAllowedMessageTypes are the messages the bridge builds itself. They
+// are the allowlist unless blockchain.allowed_message_types replaces it.
+var DefaultAllowedMessageTypes = []string{
+	"/racecarweb.componentregistry.v1.MsgRegisterComponent",
+	"/racecarweb.componentregistry.v1.MsgVerifyComponent",
+	"/racecarweb.componentregistry.v1.MsgRegisterAnonymousComponent",
+	"/racecarweb.componentregistry.v1.MsgVerifyComponentPairingWithHashes",
+	"/racecarweb.componentregistry.v1.MsgCreateAnonymousPairingAuthorization",
+	"/racecarweb.componentregistry.v1.MsgCreateAnonymousRevocationEvent",
+	"/racecarweb.componentregistry.v1.MsgGetAnonymousComponentMetadata",
+	"/racecarweb.componentregistry.v1.MsgCreatePairingAuthorization",
+	"/racecarweb.componentregistry.v1.MsgUpdateAuthorization",
+	"/racecarweb.componentregistry.v1.MsgRevokeAuthorization",
+	"/racecarweb.pairing.v1.MsgInitiateBidirectionalPairing",
+	"/racecarweb.pairing.v1.MsgCompletePairing",
+	"/racecarweb.pairing.v1.MsgRevokePairing",
+	"/racecarweb.lctmanager.v1.MsgCreateLctRelationship",
+	"/racecarweb.lctmanager.v1.MsgUpdateLctStatus",
+	"/racecarweb.lctmanager.v1.MsgRotateSplitKey",
+	"/racecarweb.trusttensor.v1.MsgCreateRelationshipTensor",
+	"/racecarweb.trusttensor.v1.MsgCalculateRelationshipTrust",
+	"/racecarweb.trusttensor.v1.MsgUpdateTensorScore",
+	"/racecarweb.energycycle.v1.MsgCreateRelationshipEnergyOperation",
+	"/racecarweb.energycycle.v1.MsgExecuteEnergyTransfer",
+	"/racecarweb.pairingqueue.v1.MsgQueuePairingRequest",
+	"/racecarweb.pairingqueue.v1.MsgProcessOfflineQueue",
+	"/racecarweb.pairingqueue.v1.MsgCancelRequest",
+}
+
+// newMessageTypeAllowlist builds the allowlist from configured type URLs,
+// falling back to DefaultAllowedMessageTypes when none are configured
+func newMessageTypeAllowlist(types []string) (map[string]bool, error) {
+	if len(types) == 0 {
+		types = DefaultAllowedMessageTypes
+	}
+	allowed := make(map[string]bool, len(types))
+	for _, msgType := range types {
+		if !strings.HasPrefix(msgType, "/") {
+			return nil, fmt.Errorf("allowed message type %q must be a type URL such as %q", msgType, DefaultAllowedMessageTypes[0])
+		}
+		allowed[msgType] = true
+	}
+	return allowed, nil
+}
+
+// checkMessageType rejects a message without an @type or with one that is
+// not on the allowlist
+func (c *RESTClient) checkMessageType(message map[string]interface{}) error {
+	msgType, _ := message["@type"].(string)
+	if msgType == "" {
+		return fmt.Errorf("%w: message has no @type", ErrUnsupportedMessageType)
+	}
+
+	allowed := c.allowedMsgTypes
+	if allowed == nil {
+		allowed, _ = newMessageTypeAllowlist(nil)
+	}
+	if !allowed[msgType] {
+		return fmt.Errorf("%w: %s is not in blockchain.allowed_message_types", ErrUnsupportedMessageType, msgType)
+	}
+	return nil
+}
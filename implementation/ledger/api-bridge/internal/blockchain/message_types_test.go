@@ -0,0 +1,117 @@
+package blockchain
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// recordingRacecarWebd logs its arguments to calls.log and reports success
+const recordingRacecarWebd = `#!/bin/sh
+echo "$@" >> calls.log
+echo '{"code": 0, "txhash": "ABC"}'
+`
+
+func TestUnknownMessageTypeIsNotBroadcast(t *testing.T) {
+	bin := t.TempDir()
+	racecarWebd := filepath.Join(bin, "racecar-webd")
+	require.NoError(t, os.WriteFile(racecarWebd, []byte(recordingRacecarWebd), 0o755))
+
+	c := &RESTClient{
+		logger:      zerolog.Nop(),
+		racecarCmd:  racecarWebd,
+		projectRoot: bin,
+	}
+
+	for _, message := range []map[string]interface{}{
+		{"@type": "/racecarweb.energycycle.v1.MsgExecuteEnergyTransfer", "creator": "alice", "operation_id": "op-1"},
+		{"creator": "alice"},
+	} {
+		_, err := c.tryRacecarWebdCommand(context.Background(), "alice", message, txGas{})
+		assert.ErrorIs(t, err, ErrUnsupportedMessageType)
+	}
+	_, err := os.Stat(filepath.Join(bin, "calls.log"))
+	assert.True(t, os.IsNotExist(err), "racecar-webd must not run for an unsupported message")
+
+	// A supported message still runs its own command
+	result, err := c.tryRacecarWebdCommand(context.Background(), "alice", map[string]interface{}{
+		"@type":        "/racecarweb.componentregistry.v1.MsgVerifyComponent",
+		"component_id": "MODBATT-MOD-001",
+	}, txGas{})
+	require.NoError(t, err)
+	assert.Equal(t, "ABC", result["txhash"])
+
+	calls, err := os.ReadFile(filepath.Join(bin, "calls.log"))
+	require.NoError(t, err)
+	assert.Contains(t, string(calls), "tx componentregistry verify-component MODBATT-MOD-001")
+	assert.NotContains(t, string(calls), "register-component")
+}
+
+func TestRacecarWebdSignsForConfiguredChain(t *testing.T) {
+	bin := t.TempDir()
+	racecarWebd := filepath.Join(bin, "racecar-webd")
+	require.NoError(t, os.WriteFile(racecarWebd, []byte(recordingRacecarWebd), 0o755))
+
+	message := map[string]interface{}{
+		"@type":        "/racecarweb.componentregistry.v1.MsgVerifyComponent",
+		"component_id": "MODBATT-MOD-001",
+	}
+	for _, chainID := range []string{"racecar-testnet-2", ""} {
+		c := &RESTClient{
+			logger:      zerolog.Nop(),
+			racecarCmd:  racecarWebd,
+			projectRoot: bin,
+			chainID:     chainID,
+		}
+		_, err := c.tryRacecarWebdCommand(context.Background(), "alice", message, txGas{})
+		require.NoError(t, err)
+	}
+
+	calls, err := os.ReadFile(filepath.Join(bin, "calls.log"))
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(string(calls)), "\n")
+	require.Len(t, lines, 2)
+	assert.Contains(t, lines[0], "--chain-id racecar-testnet-2 ")
+	// Without a configured chain ID the CLI signs for the default chain
+	assert.Contains(t, lines[1], "--chain-id racecarweb ")
+}
+
+func TestExecuteTransactionEnforcesAllowlist(t *testing.T) {
+	allowed, err := newMessageTypeAllowlist([]string{"/racecarweb.componentregistry.v1.MsgRegisterComponent"})
+	require.NoError(t, err)
+
+	c := &RESTClient{logger: zerolog.Nop(), allowedMsgTypes: allowed}
+	c.txExecutor = newCLITxExecutor(c)
+
+	// Allowed by default, but not by this deployment's list
+	_, err = c.executeTransaction(context.Background(), map[string]interface{}{
+		"@type":   "/racecarweb.pairing.v1.MsgRevokePairing",
+		"creator": "alice",
+	}, "")
+	assert.ErrorIs(t, err, ErrUnsupportedMessageType)
+	assert.Contains(t, err.Error(), "/racecarweb.pairing.v1.MsgRevokePairing")
+
+	_, err = newMessageTypeAllowlist([]string{"MsgRegisterComponent"})
+	assert.ErrorContains(t, err, "must be a type URL")
+}
+
+func TestDefaultAllowlistCoversRacecarWebdCommands(t *testing.T) {
+	c := &RESTClient{logger: zerolog.Nop()}
+	for _, msgType := range []string{
+		"/racecarweb.componentregistry.v1.MsgRegisterComponent",
+		"/racecarweb.componentregistry.v1.MsgVerifyComponent",
+		"/racecarweb.lctmanager.v1.MsgCreateLctRelationship",
+		"/racecarweb.pairing.v1.MsgInitiateBidirectionalPairing",
+		"/racecarweb.pairing.v1.MsgCompletePairing",
+		"/racecarweb.pairing.v1.MsgRevokePairing",
+	} {
+		assert.NoError(t, c.checkMessageType(map[string]interface{}{"@type": msgType}), msgType)
+	}
+	assert.ErrorIs(t, c.checkMessageType(map[string]interface{}{"@type": "/cosmos.bank.v1beta1.MsgSend"}), ErrUnsupportedMessageType)
+}
@@ -0,0 +1,448 @@
+//go:build mockchain
+
+package blockchain
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	lctmanagertypes "racecar-web/x/lctmanager/types"
+)
+
+// mockBaseURL is the node address requests are addressed to in mock mode;
+// they never leave the process
+const mockBaseURL = "http://mock-chain"
+
+// Compile-time checks that the mock can stand in for the node and the signer
+var (
+	_ TxExecutor        = (*mockChain)(nil)
+	_ http.RoundTripper = (*mockChain)(nil)
+)
+
+// mockChain is an in-memory chain for running the bridge without a node.
+// Transactions are applied straight to its state instead of being signed, and
+// the REST queries the bridge makes are answered from the same state, so
+// reads reflect earlier writes. Components, LCTs and trust tensors are
+// supported, and so is pairing through a challenge; other messages fail with
+// ErrUnsupportedMessageType. IDs and tx
+// hashes come from counters, so a run replaying the same requests gets the
+// same IDs.
+type mockChain struct {
+	mu         sync.Mutex
+	txs        uint64
+	sequences  map[string]uint64 // by signer address
+	components map[string]map[string]interface{}
+	lcts       map[string]map[string]interface{}
+	challenges map[string]map[string]interface{} // pairing challenges by ID
+	tensors    map[string]map[string]interface{} // get_trust_tensor responses
+	routes     *http.ServeMux
+}
+
+func newMockChain() *mockChain {
+	m := &mockChain{
+		sequences:  make(map[string]uint64),
+		components: make(map[string]map[string]interface{}),
+		lcts:       make(map[string]map[string]interface{}),
+		challenges: make(map[string]map[string]interface{}),
+		tensors:    make(map[string]map[string]interface{}),
+		routes:     http.NewServeMux(),
+	}
+	m.routes.HandleFunc("GET /cosmos/base/tendermint/v1beta1/node_info", func(w http.ResponseWriter, r *http.Request) {
+		writeMockJSON(w, map[string]interface{}{
+			"default_node_info":   map[string]string{"network": "racecarweb", "moniker": "mock", "version": "mock"},
+			"application_version": map[string]string{"app_name": "racecar-webd", "version": "mock"},
+		})
+	})
+	m.routes.HandleFunc("GET /cosmos/auth/v1beta1/accounts/{address}", func(w http.ResponseWriter, r *http.Request) {
+		writeMockJSON(w, map[string]interface{}{"account": map[string]string{
+			"address":  r.PathValue("address"),
+			"sequence": strconv.FormatUint(m.sequences[r.PathValue("address")], 10),
+		}})
+	})
+	// CreateTrustTensor posts its message without signing it
+	m.routes.HandleFunc("POST /cosmos/tx/v1beta1/txs", m.serveBroadcast)
+	m.routes.HandleFunc("GET /racecar-web/componentregistry/v1/get_component/{id}", func(w http.ResponseWriter, r *http.Request) {
+		if component, ok := m.components[r.PathValue("id")]; ok {
+			writeMockJSON(w, map[string]interface{}{"component": component})
+			return
+		}
+		writeMockNotFound(w, "component not found")
+	})
+	// The mock chain has no verification backend
+	m.routes.HandleFunc("GET /racecar-web/componentregistry/v1/component_metadata/{id}", func(w http.ResponseWriter, r *http.Request) {
+		if component, ok := m.components[r.PathValue("id")]; ok {
+			writeMockJSON(w, map[string]interface{}{"component": component, "backend_status": "not_configured"})
+			return
+		}
+		writeMockNotFound(w, "component not found")
+	})
+	m.routes.HandleFunc("GET /racecar-web/componentregistry/v1/components", func(w http.ResponseWriter, r *http.Request) {
+		writeMockJSON(w, map[string]interface{}{"components": sortedMockRecords(m.components), "pagination": map[string]string{}})
+	})
+	m.routes.HandleFunc("GET /racecar-web/lctmanager/v1/get_lct/{id}", func(w http.ResponseWriter, r *http.Request) {
+		if lct, ok := m.lcts[r.PathValue("id")]; ok {
+			writeMockJSON(w, map[string]interface{}{"linked_context_token": lct})
+			return
+		}
+		writeMockNotFound(w, "LCT not found")
+	})
+	m.routes.HandleFunc("GET /racecar-web/lctmanager/v1/lcts", func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		component := query.Get("component_id")
+		lcts := []map[string]interface{}{}
+		for _, lct := range sortedMockRecords(m.lcts) {
+			if component != "" && lct["component_a_id"] != component && lct["component_b_id"] != component {
+				continue
+			}
+			if status := query.Get("status"); status != "" && lct["pairing_status"] != status {
+				continue
+			}
+			if context := query.Get("operational_context"); context != "" && lct["operational_context"] != context {
+				continue
+			}
+			lcts = append(lcts, lct)
+		}
+		writeMockJSON(w, map[string]interface{}{"lcts": lcts, "pagination": map[string]string{}})
+	})
+	m.routes.HandleFunc("GET /racecar-web/trusttensor/v1/get_trust_tensor/{id}", func(w http.ResponseWriter, r *http.Request) {
+		if tensor, ok := m.tensors[r.PathValue("id")]; ok {
+			writeMockJSON(w, tensor)
+			return
+		}
+		writeMockNotFound(w, "trust tensor not found")
+	})
+	return m
+}
+
+func init() {
+	mockClient = NewMockClient
+}
+
+// NewMockClient creates a blockchain client backed by an in-memory chain,
+// for developing against the bridge without a running node. It is only
+// compiled into mockchain builds.
+func NewMockClient(logger zerolog.Logger) *Client {
+	chain := newMockChain()
+	accounts := &AccountManager{logger: logger, accounts: make(map[string]*Account)}
+	accounts.initializeDefaultAccounts()
+
+	allowed, _ := newMessageTypeAllowlist(nil)
+	return &Client{
+		restClient: &RESTClient{
+			baseURL:         mockBaseURL,
+			client:          &http.Client{Transport: chain},
+			logger:          logger,
+			accountManager:  accounts,
+			txExecutor:      chain,
+			gas:             gasSettings{limit: 200000}, // nothing to simulate against
+			allowedMsgTypes: allowed,
+		},
+		logger: logger,
+	}
+}
+
+// Mode implements TxExecutor
+func (m *mockChain) Mode() string {
+	return TxModeMock
+}
+
+// SimulationTx implements TxExecutor. The mock client uses a fixed gas
+// limit, so nothing is ever simulated.
+func (m *mockChain) SimulationTx(ctx context.Context, account *Account, message map[string]interface{}, memo string) ([]byte, error) {
+	return nil, errors.New("the mock chain does not simulate transactions")
+}
+
+// Execute implements TxExecutor by applying the message to the mock state
+func (m *mockChain) Execute(ctx context.Context, account *Account, message map[string]interface{}, memo string, gas txGas) (map[string]interface{}, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	result, err := m.apply(message)
+	if err != nil {
+		return nil, err
+	}
+	m.sequences[account.Address]++
+	return result, nil
+}
+
+// RoundTrip implements http.RoundTripper, answering requests to the node
+func (m *mockChain) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Body != nil {
+		defer req.Body.Close()
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	recorder := httptest.NewRecorder()
+	m.routes.ServeHTTP(recorder, req)
+	return recorder.Result(), nil
+}
+
+func (m *mockChain) serveBroadcast(w http.ResponseWriter, r *http.Request) {
+	var body struct {
+		Tx struct {
+			Messages []map[string]interface{} `json:"messages"`
+		} `json:"tx"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil || len(body.Tx.Messages) != 1 {
+		writeMockError(w, http.StatusBadRequest, map[string]interface{}{"code": 3, "message": "expected a transaction with one message"})
+		return
+	}
+	result, err := m.apply(body.Tx.Messages[0])
+	if err != nil {
+		writeMockError(w, http.StatusNotImplemented, map[string]interface{}{"code": 12, "message": err.Error()})
+		return
+	}
+	writeMockJSON(w, map[string]interface{}{"tx_response": result})
+}
+
+// apply runs a message against the state and returns its tx response. A
+// message the chain would reject gets a non-zero code, as it would on chain.
+func (m *mockChain) apply(message map[string]interface{}) (map[string]interface{}, error) {
+	msgType, _ := message["@type"].(string)
+	var fields struct {
+		Creator          string              `json:"creator"`
+		ComponentType    string              `json:"component_type"`
+		ManufacturerData string              `json:"manufacturer_data"`
+		Dedupe           bool                `json:"dedupe"`
+		ComponentA       string              `json:"component_a"`
+		ComponentB       string              `json:"component_b"`
+		Context          string              `json:"context"`
+		ProxyID          string              `json:"proxy_id"`
+		ComponentAID     string              `json:"component_a_id"`
+		ComponentBID     string              `json:"component_b_id"`
+		OperationalCtx   string              `json:"operational_context"`
+		InitialScore     string              `json:"initial_score"`
+		Dimensions       []map[string]string `json:"dimensions"`
+		ChallengeID      string              `json:"challenge_id"`
+		KeyCommitment    string              `json:"key_commitment"`
+	}
+	// Messages built in-process and decoded from a request body read the same way
+	encoded, err := json.Marshal(message)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode message: %w", err)
+	}
+	if err := json.Unmarshal(encoded, &fields); err != nil {
+		return nil, fmt.Errorf("failed to decode %s: %w", msgType, err)
+	}
+
+	now := time.Now()
+	var event map[string]interface{}
+	switch msgType {
+	case "/racecarweb.componentregistry.v1.MsgRegisterComponent":
+		if fields.ComponentType == "" {
+			return m.rejected("component_type cannot be empty"), nil
+		}
+		// The chain compares canonical JSON; the mock only matches identical data
+		if fields.Dedupe {
+			for _, existing := range sortedMockRecords(m.components) {
+				if existing["component_type"] == fields.ComponentType && existing["hardware_specs"] == fields.ManufacturerData {
+					event = mockEvent("component_deduplicated", "component_id", existing["component_id"].(string), "lct_id", existing["lct_id"].(string), "creator", fields.Creator)
+					break
+				}
+			}
+			if event != nil {
+				break
+			}
+		}
+		componentID := fmt.Sprintf("COMP-MOCK-%04d", len(m.components)+1)
+		lctID := fmt.Sprintf("lct-%s-%s-%d", fields.ComponentType, componentID, len(m.components)+1)
+		m.components[componentID] = map[string]interface{}{
+			"component_id":    componentID,
+			"manufacturer_id": "unknown",
+			"component_type":  fields.ComponentType,
+			"hardware_specs":  fields.ManufacturerData,
+			"quality_score":   "0.8",
+			"status":          "active",
+			"created_at":      now.UTC().Format(time.RFC3339),
+			"lct_id":          lctID,
+			"trust_anchor":    fields.Creator,
+		}
+		event = mockEvent("component_lct_created", "component_id", componentID, "lct_id", lctID)
+
+	case "/racecarweb.lctmanager.v1.MsgCreateLctRelationship":
+		if fields.ComponentA == "" || fields.ComponentB == "" {
+			return m.rejected("component IDs cannot be empty"), nil
+		}
+		if rejected := m.checkPairable(fields.ComponentA, fields.ComponentB); rejected != nil {
+			return rejected, nil
+		}
+		lctID := m.addLCT(fields.Creator, fields.ComponentA, fields.ComponentB, fields.Context, fields.ProxyID, "pending", now)
+		event = mockEvent("lct_relationship_created", "lct_id", lctID, "component_a", fields.ComponentA, "component_b", fields.ComponentB, "status", "pending")
+
+	case "/racecarweb.pairing.v1.MsgInitiateBidirectionalPairing":
+		if fields.ComponentA == "" || fields.ComponentB == "" {
+			return m.rejected("component IDs cannot be empty"), nil
+		}
+		if rejected := m.checkPairable(fields.ComponentA, fields.ComponentB); rejected != nil {
+			return rejected, nil
+		}
+		challengeID := fmt.Sprintf("challenge-%s-%s-%d", fields.ComponentA, fields.ComponentB, len(m.challenges)+1)
+		m.challenges[challengeID] = map[string]interface{}{
+			"challenge_id":        challengeID,
+			"component_a":         fields.ComponentA,
+			"component_b":         fields.ComponentB,
+			"operational_context": fields.OperationalCtx,
+			"proxy_id":            fields.ProxyID,
+			"status":              "pending",
+		}
+		event = mockEvent("bidirectional_pairing_initiated", "challenge_id", challengeID, "component_a", fields.ComponentA, "component_b", fields.ComponentB)
+
+	case "/racecarweb.pairing.v1.MsgCompletePairing":
+		challenge, ok := m.challenges[fields.ChallengeID]
+		if !ok {
+			return m.rejected(fmt.Sprintf("%s: pairing challenge not found", fields.ChallengeID)), nil
+		}
+		if challenge["status"] != "pending" {
+			return m.rejected(fmt.Sprintf("%s: pairing challenge already completed", fields.ChallengeID)), nil
+		}
+		challenge["status"] = "completed"
+		lctID := m.addLCT(fields.Creator, challenge["component_a"].(string), challenge["component_b"].(string),
+			challenge["operational_context"].(string), challenge["proxy_id"].(string), "active", now)
+		event = mockEvent("pairing_completed", "lct_id", lctID, "key_reference", fields.KeyCommitment)
+
+	case "/racecarweb.trusttensor.v1.MsgCreateRelationshipTensor":
+		dimensions := fields.Dimensions
+		if len(dimensions) == 0 {
+			dimensions = []map[string]string{{"name": "trust", "score": fields.InitialScore}}
+		}
+		// Dimensions are weighted equally; the chain applies its configured weights
+		var total float64
+		for _, dimension := range dimensions {
+			score, err := strconv.ParseFloat(dimension["score"], 64)
+			if err != nil {
+				return m.rejected(fmt.Sprintf("invalid score %q for dimension %s", dimension["score"], dimension["name"])), nil
+			}
+			total += score
+		}
+		tensorID := fmt.Sprintf("tensor-%s-%s-%d", fields.ComponentAID, fields.ComponentBID, len(m.tensors)+1)
+		m.tensors[tensorID] = map[string]interface{}{
+			"tensor": map[string]string{
+				"tensor_id":      tensorID,
+				"tensor_type":    "relationship",
+				"context":        fields.OperationalCtx,
+				"updated_at":     strconv.FormatInt(now.Unix(), 10),
+				"version":        "1",
+				"evidence_count": "0",
+			},
+			"composite_score": strconv.FormatFloat(total/float64(len(dimensions)), 'f', -1, 64),
+			"dimensions":      dimensions,
+		}
+		event = mockEvent("relationship_tensor_created", "tensor_id", tensorID, "tensor_type", "relationship")
+
+	default:
+		return nil, fmt.Errorf("%w: %s is not implemented in mock mode", ErrUnsupportedMessageType, msgType)
+	}
+
+	m.txs++
+	return map[string]interface{}{
+		"code":      0,
+		"txhash":    m.txHash(),
+		"height":    strconv.FormatUint(m.txs, 10),
+		"timestamp": now.UTC().Format(time.RFC3339),
+		"events":    []map[string]interface{}{event},
+	}, nil
+}
+
+// checkPairable returns the rejection for a pairing of components that are
+// not both registered and active, or nil
+func (m *mockChain) checkPairable(componentIDs ...string) map[string]interface{} {
+	for _, componentID := range componentIDs {
+		component, ok := m.components[componentID]
+		if !ok {
+			return m.rejectedWith(lctmanagertypes.ErrComponentNotFound, componentID)
+		}
+		if component["status"] != "active" {
+			return m.rejectedWith(lctmanagertypes.ErrComponentInactive, componentID)
+		}
+	}
+	return nil
+}
+
+// addLCT stores an LCT between two components and returns its ID
+func (m *mockChain) addLCT(creator, componentA, componentB, operationalContext, proxyID, status string, now time.Time) string {
+	lctID := fmt.Sprintf("lct-%s-%s-%d", componentA, componentB, len(m.lcts)+1)
+	m.lcts[lctID] = map[string]interface{}{
+		"lct_id":              lctID,
+		"component_a_id":      componentA,
+		"component_b_id":      componentB,
+		"pairing_status":      status,
+		"operational_context": operationalContext,
+		"proxy_component_id":  proxyID,
+		"trust_anchor":        creator,
+		"created_at":          strconv.FormatInt(now.Unix(), 10),
+		"updated_at":          strconv.FormatInt(now.Unix(), 10),
+	}
+	return lctID
+}
+
+// rejected is the response to a transaction the chain would fail in DeliverTx
+func (m *mockChain) rejected(log string) map[string]interface{} {
+	m.txs++
+	return map[string]interface{}{"code": 1, "codespace": "mock", "txhash": m.txHash(), "raw_log": log}
+}
+
+// rejectedWith is the response to a transaction a module fails with one of its registered errors
+func (m *mockChain) rejectedWith(err interface {
+	error
+	ABCICode() uint32
+	Codespace() string
+}, detail string) map[string]interface{} {
+	m.txs++
+	return map[string]interface{}{"code": int(err.ABCICode()), "codespace": err.Codespace(), "txhash": m.txHash(), "raw_log": fmt.Sprintf("%s: %s", detail, err.Error())}
+}
+
+// txHash derives the current transaction's hash from its number
+func (m *mockChain) txHash() string {
+	sum := sha256.Sum256([]byte(strconv.FormatUint(m.txs, 10)))
+	return fmt.Sprintf("%X", sum)
+}
+
+// mockEvent builds an event from alternating attribute keys and values
+func mockEvent(eventType string, attributes ...string) map[string]interface{} {
+	list := make([]map[string]interface{}, 0, len(attributes)/2)
+	for i := 0; i+1 < len(attributes); i += 2 {
+		list = append(list, map[string]interface{}{"key": attributes[i], "value": attributes[i+1]})
+	}
+	return map[string]interface{}{"type": eventType, "attributes": list}
+}
+
+// sortedMockRecords lists records by ID, the order the chain's store iterates in
+func sortedMockRecords(records map[string]map[string]interface{}) []map[string]interface{} {
+	ids := make([]string, 0, len(records))
+	for id := range records {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	list := make([]map[string]interface{}, 0, len(ids))
+	for _, id := range ids {
+		list = append(list, records[id])
+	}
+	return list
+}
+
+func writeMockJSON(w http.ResponseWriter, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func writeMockError(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func writeMockNotFound(w http.ResponseWriter, message string) {
+	writeMockError(w, http.StatusNotFound, map[string]interface{}{"code": 5, "message": message})
+}
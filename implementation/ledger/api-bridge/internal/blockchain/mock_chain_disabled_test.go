@@ -0,0 +1,17 @@
+//go:build !mockchain
+
+package blockchain
+
+import (
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+
+	"api-bridge/internal/config"
+)
+
+func TestMockChainNeedsMockBuild(t *testing.T) {
+	_, err := NewClientFromConfig(config.BlockchainConfig{Mock: true}, zerolog.Nop())
+	assert.ErrorContains(t, err, "-tags mockchain")
+}
@@ -0,0 +1,127 @@
+//go:build mockchain
+
+package blockchain
+
+import (
+	"context"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMockClientReadsReflectWrites(t *testing.T) {
+	c := NewMockClient(zerolog.Nop())
+	ctx := context.Background()
+
+	first, err := c.RegisterComponent(ctx, "alice", `{"manufacturer_id": "mfr-a"}`, "", false)
+	require.NoError(t, err)
+	second, err := c.RegisterComponent(ctx, "alice", `{"manufacturer_id": "mfr-a"}`, "", false)
+	require.NoError(t, err)
+	assert.Equal(t, "COMP-MOCK-0001", first["component_id"])
+	assert.Equal(t, "COMP-MOCK-0002", second["component_id"])
+	assert.NotEqual(t, first["txhash"], second["txhash"])
+
+	component, err := c.GetComponent(ctx, "COMP-MOCK-0001")
+	require.NoError(t, err)
+	assert.Equal(t, "active", component["status"])
+	assert.Equal(t, `{"manufacturer_id": "mfr-a"}`, component["hardware_specs"])
+
+	_, err = c.CreateLCT(ctx, "alice", "COMP-MOCK-0001", "COMP-MOCK-0009", "race_day", "")
+	assert.ErrorIs(t, err, ErrComponentNotFound)
+
+	lct, err := c.CreateLCT(ctx, "alice", "COMP-MOCK-0001", "COMP-MOCK-0002", "Race Day", "")
+	require.NoError(t, err)
+	assert.Equal(t, "lct-COMP-MOCK-0001-COMP-MOCK-0002-1", lct["lct_id"])
+	stored, err := c.GetLCT(ctx, "lct-COMP-MOCK-0001-COMP-MOCK-0002-1")
+	require.NoError(t, err)
+	assert.Equal(t, "race_day", stored["operational_context"])
+	assert.Equal(t, "COMP-MOCK-0002", stored["component_b_id"])
+
+	tensor, err := c.CreateTrustTensor(ctx, "alice", "COMP-MOCK-0001", "COMP-MOCK-0002", "race_day", 0, map[string]float64{"talent": 0.6, "training": 0.8})
+	require.NoError(t, err)
+	tensorID := tensor["tensor_id"].(string)
+	assert.Equal(t, "tensor-COMP-MOCK-0001-COMP-MOCK-0002-1", tensorID)
+	read, err := c.GetTrustTensor(ctx, tensorID)
+	require.NoError(t, err)
+	assert.InDelta(t, 0.7, read["score"], 1e-9)
+	assert.Equal(t, map[string]float64{"talent": 0.6, "training": 0.8}, read["dimensions"])
+
+	_, err = c.GetTrustTensor(ctx, "tensor-missing")
+	assert.ErrorIs(t, err, ErrTrustTensorNotFound)
+
+	// Writes the mock does not model fail clearly instead of pretending
+	_, err = c.SuspendLCT(ctx, "alice", "lct-COMP-MOCK-0001-COMP-MOCK-0002-1", "maintenance")
+	assert.ErrorIs(t, err, ErrUnsupportedMessageType)
+
+	ready, dependencies := c.Readiness(ctx)
+	assert.True(t, ready)
+	assert.Equal(t, DependencyUp, dependencies["blockchain"].Status)
+}
+
+func TestMockClientPairsThroughAChallenge(t *testing.T) {
+	c := NewMockClient(zerolog.Nop())
+	ctx := context.Background()
+	for i := 0; i < 2; i++ {
+		_, err := c.RegisterComponent(ctx, "alice", "data", "", false)
+		require.NoError(t, err)
+	}
+
+	_, err := c.InitiatePairing(ctx, "alice", "COMP-MOCK-0001", "COMP-MOCK-0009", "race_day", "", false)
+	assert.Error(t, err)
+
+	challenge, err := c.InitiatePairing(ctx, "alice", "COMP-MOCK-0001", "COMP-MOCK-0002", "race_day", "", false)
+	require.NoError(t, err)
+	challengeID := challenge["challenge_id"].(string)
+	assert.Equal(t, "challenge-COMP-MOCK-0001-COMP-MOCK-0002-1", challengeID)
+
+	completed, err := c.CompletePairing(ctx, "alice", challengeID, "auth-a", "auth-b", "session")
+	require.NoError(t, err)
+	assert.NotEmpty(t, completed["key_reference"])
+	lct, err := c.GetLCT(ctx, completed["lct_id"].(string))
+	require.NoError(t, err)
+	assert.Equal(t, "active", lct["pairing_status"])
+	assert.Equal(t, "race_day", lct["operational_context"])
+
+	// A challenge completes once
+	_, err = c.CompletePairing(ctx, "alice", challengeID, "auth-a", "auth-b", "session")
+	assert.Error(t, err)
+}
+
+func TestRegisterComponentDedupeReturnsTheExistingComponent(t *testing.T) {
+	c := NewMockClient(zerolog.Nop())
+	ctx := context.Background()
+
+	first, err := c.RegisterComponent(ctx, "alice", `{"serial": "SN-1"}`, "", true)
+	require.NoError(t, err)
+	assert.Equal(t, true, first["created"])
+	assert.Equal(t, "registered", first["status"])
+
+	again, err := c.RegisterComponent(ctx, "bob", `{"serial": "SN-1"}`, "", true)
+	require.NoError(t, err)
+	assert.Equal(t, false, again["created"])
+	assert.Equal(t, "already_registered", again["status"])
+	assert.Equal(t, first["component_id"], again["component_id"])
+	assert.Equal(t, first["lct_id"], again["lct_id"])
+
+	// Without dedupe the same data registers a second component
+	second, err := c.RegisterComponent(ctx, "alice", `{"serial": "SN-1"}`, "", false)
+	require.NoError(t, err)
+	assert.Equal(t, true, second["created"])
+	assert.NotEqual(t, first["component_id"], second["component_id"])
+}
+
+func TestMockClientIDsAreDeterministic(t *testing.T) {
+	register := func() []interface{} {
+		c := NewMockClient(zerolog.Nop())
+		var results []interface{}
+		for i := 0; i < 2; i++ {
+			result, err := c.RegisterComponent(context.Background(), "bob", "data", "", false)
+			require.NoError(t, err)
+			results = append(results, result["component_id"], result["txhash"])
+		}
+		return results
+	}
+	assert.Equal(t, register(), register())
+}
@@ -0,0 +1,284 @@
+package blockchain
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"cosmossdk.io/x/tx/signing"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/codec"
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/std"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/rs/zerolog"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	componentregistrytypes "racecar-web/x/componentregistry/types"
+	energycycletypes "racecar-web/x/energycycle/types"
+	lctmanagertypes "racecar-web/x/lctmanager/types"
+	pairingtypes "racecar-web/x/pairing/types"
+	pairingqueuetypes "racecar-web/x/pairingqueue/types"
+	trusttensortypes "racecar-web/x/trusttensor/types"
+)
+
+// AccountAddressPrefix is the bech32 prefix used by the racecar-web chain
+const AccountAddressPrefix = "cosmos"
+
+// NativeTxConfig holds the settings needed to sign transactions in-process
+type NativeTxConfig struct {
+	GRPCEndpoint string
+	ChainID      string
+	Keyring      KeyringConfig
+	Keybase      keyring.Keyring // already open keyring to sign with; Keyring is opened when nil
+}
+
+// nativeTxExecutor builds, signs and broadcasts transactions with the Cosmos SDK tx client over gRPC
+type nativeTxExecutor struct {
+	clientCtx client.Context
+	encoding  *txEncoding
+	conn      *grpc.ClientConn
+	logger    zerolog.Logger
+}
+
+// txEncoding bundles the codec and tx config for the racecar-web message types
+type txEncoding struct {
+	codec    *codec.ProtoCodec
+	txConfig client.TxConfig
+}
+
+// newTxEncoding registers the SDK and racecar-web interfaces and builds a direct-mode tx config
+func newTxEncoding() (*txEncoding, error) {
+	registry, err := codectypes.NewInterfaceRegistryWithOptions(codectypes.InterfaceRegistryOptions{
+		ProtoFiles: proto.HybridResolver,
+		SigningOptions: signing.Options{
+			AddressCodec:          addresscodec.NewBech32Codec(AccountAddressPrefix),
+			ValidatorAddressCodec: addresscodec.NewBech32Codec(AccountAddressPrefix + "valoper"),
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create interface registry: %w", err)
+	}
+
+	std.RegisterInterfaces(registry)
+	authtypes.RegisterInterfaces(registry)
+	componentregistrytypes.RegisterInterfaces(registry)
+	energycycletypes.RegisterInterfaces(registry)
+	lctmanagertypes.RegisterInterfaces(registry)
+	pairingtypes.RegisterInterfaces(registry)
+	pairingqueuetypes.RegisterInterfaces(registry)
+	trusttensortypes.RegisterInterfaces(registry)
+
+	cdc := codec.NewProtoCodec(registry)
+	return &txEncoding{
+		codec:    cdc,
+		txConfig: authtx.NewTxConfig(cdc, authtx.DefaultSignModes),
+	}, nil
+}
+
+// decodeMsg converts the JSON message map into a typed sdk.Msg using the registered interfaces
+func (enc *txEncoding) decodeMsg(message map[string]interface{}) (sdk.Msg, error) {
+	msgJSON, err := json.Marshal(message)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal message: %w", err)
+	}
+
+	var msg sdk.Msg
+	if err := enc.codec.UnmarshalInterfaceJSON(msgJSON, &msg); err != nil {
+		return nil, fmt.Errorf("failed to decode message %v: %w", message["@type"], err)
+	}
+
+	return msg, nil
+}
+
+// NewNativeTxExecutor creates an executor that signs with the local keyring and broadcasts over gRPC
+func NewNativeTxExecutor(cfg NativeTxConfig, logger zerolog.Logger) (TxExecutor, error) {
+	enc, err := newTxEncoding()
+	if err != nil {
+		return nil, err
+	}
+
+	kr := cfg.Keybase
+	if kr == nil {
+		kr, err = newKeyring(cfg.Keyring, enc.codec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	conn, err := grpc.NewClient(cfg.GRPCEndpoint,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithStatsHandler(otelgrpc.NewClientHandler()),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to gRPC endpoint %s: %w", cfg.GRPCEndpoint, err)
+	}
+
+	clientCtx := client.Context{}.
+		WithCodec(enc.codec).
+		WithInterfaceRegistry(enc.codec.InterfaceRegistry()).
+		WithTxConfig(enc.txConfig).
+		WithChainID(cfg.ChainID).
+		WithKeyring(kr).
+		WithGRPCClient(conn).
+		WithAccountRetriever(authtypes.AccountRetriever{}).
+		WithBroadcastMode(flags.BroadcastSync)
+
+	return &nativeTxExecutor{
+		clientCtx: clientCtx,
+		encoding:  enc,
+		conn:      conn,
+		logger:    logger,
+	}, nil
+}
+
+// Mode implements TxExecutor
+func (e *nativeTxExecutor) Mode() string {
+	return TxModeNative
+}
+
+// Execute implements TxExecutor
+func (e *nativeTxExecutor) Execute(ctx context.Context, account *Account, message map[string]interface{}, memo string, gas txGas) (map[string]interface{}, error) {
+	clientCtx, txf, msg, err := e.prepare(ctx, account, message, memo)
+	if err != nil {
+		return nil, err
+	}
+
+	if gas.Limit > 0 {
+		txf = txf.WithGas(gas.Limit)
+	}
+	if !gas.Fee.IsZero() {
+		txf = txf.WithFees(gas.Fee.String())
+	}
+
+	txBuilder, err := txf.BuildUnsignedTx(msg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build transaction: %w", err)
+	}
+
+	if err := tx.Sign(ctx, txf, account.Name, txBuilder, true); err != nil {
+		return nil, fmt.Errorf("failed to sign transaction: %w", err)
+	}
+
+	txBytes, err := clientCtx.TxConfig.TxEncoder()(txBuilder.GetTx())
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode transaction: %w", err)
+	}
+
+	// clientCtx.BroadcastTx needs a CometBFT RPC client, so broadcast through the gRPC tx service instead
+	res, err := txtypes.NewServiceClient(e.conn).BroadcastTx(ctx, &txtypes.BroadcastTxRequest{
+		TxBytes: txBytes,
+		Mode:    txtypes.BroadcastMode_BROADCAST_MODE_SYNC,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to broadcast transaction: %w", err)
+	}
+
+	e.logger.Info().Str("account", account.Name).Str("txhash", res.TxResponse.TxHash).Uint32("code", res.TxResponse.Code).Msg("Transaction broadcast via native signer")
+
+	return txResponseToMap(res.TxResponse), nil
+}
+
+// SimulationTx implements TxExecutor
+func (e *nativeTxExecutor) SimulationTx(ctx context.Context, account *Account, message map[string]interface{}, memo string) ([]byte, error) {
+	_, txf, msg, err := e.prepare(ctx, account, message, memo)
+	if err != nil {
+		return nil, err
+	}
+
+	// Simulate with the signer's real public key so signature verification gas is counted
+	txBytes, err := txf.WithSimulateAndExecute(true).BuildSimTx(msg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build simulation transaction: %w", err)
+	}
+	return txBytes, nil
+}
+
+// prepare resolves the signer from the keyring, decodes the message and returns
+// a factory with the account number and sequence filled in
+func (e *nativeTxExecutor) prepare(ctx context.Context, account *Account, message map[string]interface{}, memo string) (client.Context, tx.Factory, sdk.Msg, error) {
+	record, err := e.clientCtx.Keyring.Key(account.Name)
+	if err != nil {
+		return client.Context{}, tx.Factory{}, nil, fmt.Errorf("key %q not found in keyring: %w", account.Name, err)
+	}
+	fromAddr, err := record.GetAddress()
+	if err != nil {
+		return client.Context{}, tx.Factory{}, nil, fmt.Errorf("failed to read address for key %q: %w", account.Name, err)
+	}
+
+	// The keyring is the source of truth for the signer address
+	message["creator"] = fromAddr.String()
+
+	msg, err := e.encoding.decodeMsg(message)
+	if err != nil {
+		return client.Context{}, tx.Factory{}, nil, err
+	}
+
+	clientCtx := e.clientCtx.
+		WithCmdContext(ctx).
+		WithFromName(account.Name).
+		WithFromAddress(fromAddr)
+
+	txf := tx.Factory{}.
+		WithTxConfig(clientCtx.TxConfig).
+		WithAccountRetriever(clientCtx.AccountRetriever).
+		WithKeybase(clientCtx.Keyring).
+		WithChainID(clientCtx.ChainID).
+		WithGas(flags.DefaultGasLimit).
+		WithMemo(memo).
+		WithSignMode(signingtypes.SignMode_SIGN_MODE_DIRECT).
+		WithFromName(account.Name)
+
+	// A retry after a sequence mismatch pins the sequence the node expects
+	if sequence, ok := sequenceOverride(ctx); ok {
+		txf = txf.WithSequence(sequence)
+	}
+
+	// Fills in account number and any unset sequence from the chain
+	txf, err = txf.Prepare(clientCtx)
+	if err != nil {
+		return client.Context{}, tx.Factory{}, nil, fmt.Errorf("failed to prepare transaction: %w", err)
+	}
+
+	return clientCtx, txf, msg, nil
+}
+
+// txResponseToMap flattens a typed tx response into the map shape returned by the CLI path
+func txResponseToMap(res *sdk.TxResponse) map[string]interface{} {
+	events := make([]map[string]interface{}, 0, len(res.Events))
+	for _, event := range res.Events {
+		attributes := make([]map[string]interface{}, 0, len(event.Attributes))
+		for _, attr := range event.Attributes {
+			attributes = append(attributes, map[string]interface{}{
+				"key":   attr.Key,
+				"value": attr.Value,
+			})
+		}
+		events = append(events, map[string]interface{}{
+			"type":       event.Type,
+			"attributes": attributes,
+		})
+	}
+
+	return map[string]interface{}{
+		"txhash":     res.TxHash,
+		"code":       int(res.Code),
+		"codespace":  res.Codespace,
+		"raw_log":    res.RawLog,
+		"height":     res.Height,
+		"gas_wanted": res.GasWanted,
+		"gas_used":   res.GasUsed,
+		"events":     events,
+	}
+}
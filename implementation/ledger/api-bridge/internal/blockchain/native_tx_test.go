@@ -0,0 +1,247 @@
+package blockchain
+
+import (
+	"context"
+	"net"
+	"sync"
+	"testing"
+
+	"cosmossdk.io/math"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	componentregistrytypes "racecar-web/x/componentregistry/types"
+)
+
+const nativeTestChainID = "racecar-testnet-1"
+
+// fakeNode serves the auth account query and the tx broadcast service the
+// native executor talks to, recording every transaction broadcast
+type fakeNode struct {
+	authtypes.UnimplementedQueryServer
+	txtypes.UnimplementedServiceServer
+
+	enc           *txEncoding
+	accountNumber uint64
+	sequence      uint64
+	code          uint32 // code of every broadcast, 0 accepts it
+
+	mu          sync.Mutex
+	broadcasts  [][]byte
+	accountHits int
+}
+
+func (n *fakeNode) Account(ctx context.Context, req *authtypes.QueryAccountRequest) (*authtypes.QueryAccountResponse, error) {
+	n.mu.Lock()
+	n.accountHits++
+	n.mu.Unlock()
+
+	address, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, err
+	}
+	account, err := codectypes.NewAnyWithValue(authtypes.NewBaseAccount(address, nil, n.accountNumber, n.sequence))
+	if err != nil {
+		return nil, err
+	}
+	// The account retriever reads the height the query was answered at
+	if err := grpc.SetHeader(ctx, metadata.Pairs(grpctypes.GRPCBlockHeightHeader, "42")); err != nil {
+		return nil, err
+	}
+	return &authtypes.QueryAccountResponse{Account: account}, nil
+}
+
+func (n *fakeNode) BroadcastTx(ctx context.Context, req *txtypes.BroadcastTxRequest) (*txtypes.BroadcastTxResponse, error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.broadcasts = append(n.broadcasts, req.TxBytes)
+	return &txtypes.BroadcastTxResponse{TxResponse: &sdk.TxResponse{
+		TxHash:    "NATIVE-TX",
+		Code:      n.code,
+		Codespace: "sdk",
+		RawLog:    "raw log",
+		GasWanted: 150000,
+		GasUsed:   90000,
+	}}, nil
+}
+
+// lastBroadcast decodes the most recent transaction the node received
+func (n *fakeNode) lastBroadcast(t *testing.T) (authsigning.Tx, []byte) {
+	t.Helper()
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	require.NotEmpty(t, n.broadcasts)
+	txBytes := n.broadcasts[len(n.broadcasts)-1]
+	decoded, err := n.enc.txConfig.TxDecoder()(txBytes)
+	require.NoError(t, err)
+	signed, ok := decoded.(authsigning.Tx)
+	require.True(t, ok)
+	return signed, txBytes
+}
+
+// testNativeExecutor starts a fake node and returns an executor signing with
+// a fresh in-memory key named alice, and alice's address
+func testNativeExecutor(t *testing.T, node *fakeNode) (*nativeTxExecutor, string) {
+	t.Helper()
+	node.enc = testEncoding(t)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	server := grpc.NewServer()
+	authtypes.RegisterQueryServer(server, node)
+	txtypes.RegisterServiceServer(server, node)
+	go func() { _ = server.Serve(listener) }()
+	t.Cleanup(server.Stop)
+
+	kr := keyring.NewInMemory(node.enc.codec)
+	record, _, err := kr.NewMnemonic("alice", keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+	address, err := record.GetAddress()
+	require.NoError(t, err)
+
+	executor, err := NewNativeTxExecutor(NativeTxConfig{
+		GRPCEndpoint: listener.Addr().String(),
+		ChainID:      nativeTestChainID,
+		Keybase:      kr,
+	}, zerolog.Nop())
+	require.NoError(t, err)
+	native := executor.(*nativeTxExecutor)
+	t.Cleanup(func() { _ = native.conn.Close() })
+	return native, address.String()
+}
+
+func verifyMessage(creator string) map[string]interface{} {
+	return map[string]interface{}{
+		"@type":        "/racecarweb.componentregistry.v1.MsgVerifyComponent",
+		"creator":      creator,
+		"component_id": "MODBATT-MOD-001",
+		"context":      "race_day",
+	}
+}
+
+func TestNativeExecutorSignsAndBroadcasts(t *testing.T) {
+	node := &fakeNode{accountNumber: 7, sequence: 3}
+	executor, address := testNativeExecutor(t, node)
+	assert.Equal(t, TxModeNative, executor.Mode())
+
+	fee := sdk.NewCoins(sdk.NewCoin("stake", math.NewInt(1500)))
+	result, err := executor.Execute(context.Background(), &Account{Name: "alice"}, verifyMessage("alice"), "pit stop", txGas{Limit: 150000, Fee: fee})
+	require.NoError(t, err)
+	assert.Equal(t, "NATIVE-TX", result["txhash"])
+	assert.Equal(t, 0, result["code"])
+	assert.Equal(t, int64(90000), result["gas_used"])
+
+	signed, txBytes := node.lastBroadcast(t)
+	msgs := signed.GetMsgs()
+	require.Len(t, msgs, 1)
+	// The keyring, not the caller, names the signer
+	assert.Equal(t, &componentregistrytypes.MsgVerifyComponent{
+		Creator:     address,
+		ComponentId: "MODBATT-MOD-001",
+		Context:     "race_day",
+	}, msgs[0])
+	assert.Equal(t, "pit stop", signed.GetMemo())
+	assert.Equal(t, uint64(150000), signed.GetGas())
+	assert.Equal(t, fee, signed.GetFee())
+
+	// Signed in direct mode for the configured chain with the account's number and sequence
+	sigs, err := signed.GetSignaturesV2()
+	require.NoError(t, err)
+	require.Len(t, sigs, 1)
+	assert.Equal(t, uint64(3), sigs[0].Sequence)
+	var raw txtypes.TxRaw
+	require.NoError(t, raw.Unmarshal(txBytes))
+	signDoc := txtypes.SignDoc{
+		BodyBytes:     raw.BodyBytes,
+		AuthInfoBytes: raw.AuthInfoBytes,
+		ChainId:       nativeTestChainID,
+		AccountNumber: 7,
+	}
+	signBytes, err := signDoc.Marshal()
+	require.NoError(t, err)
+	require.Len(t, raw.Signatures, 1)
+	assert.True(t, sigs[0].PubKey.VerifySignature(signBytes, raw.Signatures[0]))
+}
+
+func TestNativeExecutorSignsWithSequenceOverride(t *testing.T) {
+	node := &fakeNode{accountNumber: 7, sequence: 3}
+	executor, _ := testNativeExecutor(t, node)
+
+	// A retry after a sequence mismatch signs with the sequence the node expects
+	ctx := withSequenceOverride(context.Background(), 11)
+	_, err := executor.Execute(ctx, &Account{Name: "alice"}, verifyMessage("alice"), "", txGas{})
+	require.NoError(t, err)
+
+	signed, _ := node.lastBroadcast(t)
+	sigs, err := signed.GetSignaturesV2()
+	require.NoError(t, err)
+	require.Len(t, sigs, 1)
+	assert.Equal(t, uint64(11), sigs[0].Sequence)
+}
+
+func TestNativeExecutorReportsRejectedTransaction(t *testing.T) {
+	node := &fakeNode{accountNumber: 7, sequence: 3, code: 32}
+	executor, _ := testNativeExecutor(t, node)
+
+	// A transaction the node rejects comes back with its code, like the CLI's output
+	result, err := executor.Execute(context.Background(), &Account{Name: "alice"}, verifyMessage("alice"), "", txGas{})
+	require.NoError(t, err)
+	assert.Equal(t, 32, result["code"])
+	assert.Equal(t, "sdk", result["codespace"])
+	assert.Equal(t, "raw log", result["raw_log"])
+}
+
+func TestNativeExecutorRejectsUnusableRequests(t *testing.T) {
+	node := &fakeNode{accountNumber: 7, sequence: 3}
+	executor, _ := testNativeExecutor(t, node)
+
+	_, err := executor.Execute(context.Background(), &Account{Name: "bob"}, verifyMessage("bob"), "", txGas{})
+	assert.ErrorContains(t, err, `key "bob" not found in keyring`)
+
+	_, err = executor.Execute(context.Background(), &Account{Name: "alice"}, map[string]interface{}{
+		"@type":   "/racecarweb.componentregistry.v1.MsgNoSuchMessage",
+		"creator": "alice",
+	}, "", txGas{})
+	assert.ErrorContains(t, err, "failed to decode message")
+
+	node.mu.Lock()
+	defer node.mu.Unlock()
+	assert.Empty(t, node.broadcasts, "nothing may be broadcast for a request that cannot be signed")
+}
+
+func TestNativeExecutorBuildsSimulationTx(t *testing.T) {
+	node := &fakeNode{accountNumber: 7, sequence: 3}
+	executor, address := testNativeExecutor(t, node)
+
+	txBytes, err := executor.SimulationTx(context.Background(), &Account{Name: "alice"}, verifyMessage("alice"), "")
+	require.NoError(t, err)
+
+	decoded, err := node.enc.txConfig.TxDecoder()(txBytes)
+	require.NoError(t, err)
+	simulated := decoded.(authsigning.Tx)
+	require.Len(t, simulated.GetMsgs(), 1)
+	assert.Equal(t, address, simulated.GetMsgs()[0].(*componentregistrytypes.MsgVerifyComponent).Creator)
+
+	// The simulation carries the signer's public key and sequence but no signature
+	sigs, err := simulated.GetSignaturesV2()
+	require.NoError(t, err)
+	require.Len(t, sigs, 1)
+	assert.Equal(t, uint64(3), sigs[0].Sequence)
+	assert.NotNil(t, sigs[0].PubKey)
+
+	node.mu.Lock()
+	defer node.mu.Unlock()
+	assert.Empty(t, node.broadcasts)
+	assert.NotZero(t, node.accountHits, "the sequence comes from the chain")
+}
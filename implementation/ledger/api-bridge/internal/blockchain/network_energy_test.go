@@ -0,0 +1,50 @@
+package blockchain
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetNetworkEnergyBalanceParsesBalance(t *testing.T) {
+	node := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/racecar-web/energycycle/v1/network_energy_balance/PACK-A":
+			_, _ = w.Write([]byte(`{"balance": {"root_component_id": "PACK-A", "component_ids": ["MOD-001", "PACK-A"], "lcts": [{"lct_id": "lct-PACK-A-MOD-001", "inflow": "100.000000000000000000", "outflow": "130.000000000000000000", "net_flow": "-30.000000000000000000", "stored": "0.000000000000000000"}], "total_inflow": "100.000000000000000000", "total_outflow": "130.000000000000000000", "internal_flow": "0.000000000000000000", "net_flow": "-30.000000000000000000", "stored": "0.000000000000000000", "imbalanced_lct_ids": ["lct-PACK-A-MOD-001"]}}`))
+		case "/racecar-web/energycycle/v1/network_energy_balance/MOD-009":
+			// A lone component comes back with empty lists omitted
+			_, _ = w.Write([]byte(`{"balance": {"root_component_id": "MOD-009", "component_ids": ["MOD-009"], "total_inflow": "0.000000000000000000", "balanced": true}}`))
+		case "/racecar-web/energycycle/v1/network_energy_balance/HUB":
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"code": 9, "message": "HUB reaches more than 1024 components: LCT network is too large to balance"}`))
+		default:
+			t.Errorf("unexpected request to %s", r.URL.Path)
+		}
+	}))
+	defer node.Close()
+
+	c := &RESTClient{baseURL: node.URL, client: node.Client(), logger: zerolog.Nop()}
+
+	balance, err := c.GetNetworkEnergyBalance(context.Background(), "PACK-A")
+	require.NoError(t, err)
+	assert.Equal(t, false, balance["balanced"])
+	assert.Equal(t, []string{"lct-PACK-A-MOD-001"}, balance["imbalanced_lct_ids"])
+	assert.Equal(t, "-30.000000000000000000", balance["net_flow"])
+	assert.Len(t, balance["lcts"], 1)
+
+	balance, err = c.GetNetworkEnergyBalance(context.Background(), "MOD-009")
+	require.NoError(t, err)
+	assert.Equal(t, true, balance["balanced"])
+	assert.Equal(t, []string{"MOD-009"}, balance["component_ids"])
+	assert.Equal(t, []map[string]interface{}{}, balance["lcts"])
+	assert.Equal(t, []string{}, balance["imbalanced_lct_ids"])
+
+	_, err = c.GetNetworkEnergyBalance(context.Background(), "HUB")
+	assert.ErrorIs(t, err, ErrEnergyNetworkTooLarge)
+	assert.ErrorContains(t, err, "more than 1024 components")
+}
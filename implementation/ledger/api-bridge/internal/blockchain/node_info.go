@@ -0,0 +1,113 @@
+package blockchain
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// nodeInfoTTL is how long the node's reported version is reused; it only
+// changes when the node is upgraded
+const nodeInfoTTL = 30 * time.Second
+
+// NodeInfo is what the node reports about itself through node_info
+type NodeInfo struct {
+	Network          string `json:"network"`
+	Moniker          string `json:"moniker,omitempty"`
+	NodeVersion      string `json:"node_version,omitempty"` // CometBFT version
+	AppName          string `json:"app_name,omitempty"`
+	AppVersion       string `json:"app_version,omitempty"`
+	GitCommit        string `json:"git_commit,omitempty"`
+	CosmosSDKVersion string `json:"cosmos_sdk_version,omitempty"`
+}
+
+// GetNodeInfo queries the node's network and versions
+func (c *RESTClient) GetNodeInfo(ctx context.Context) (*NodeInfo, error) {
+	var resp struct {
+		DefaultNodeInfo struct {
+			Network string `json:"network"`
+			Moniker string `json:"moniker"`
+			Version string `json:"version"`
+		} `json:"default_node_info"`
+		ApplicationVersion struct {
+			AppName          string `json:"app_name"`
+			Version          string `json:"version"`
+			GitCommit        string `json:"git_commit"`
+			CosmosSDKVersion string `json:"cosmos_sdk_version"`
+		} `json:"application_version"`
+	}
+	if err := c.queryJSON(ctx, "/cosmos/base/tendermint/v1beta1/node_info", &resp); err != nil {
+		return nil, fmt.Errorf("failed to query node info: %w", err)
+	}
+
+	return &NodeInfo{
+		Network:          resp.DefaultNodeInfo.Network,
+		Moniker:          resp.DefaultNodeInfo.Moniker,
+		NodeVersion:      resp.DefaultNodeInfo.Version,
+		AppName:          resp.ApplicationVersion.AppName,
+		AppVersion:       resp.ApplicationVersion.Version,
+		GitCommit:        resp.ApplicationVersion.GitCommit,
+		CosmosSDKVersion: resp.ApplicationVersion.CosmosSDKVersion,
+	}, nil
+}
+
+// nodeInfoCache keeps the last node info for nodeInfoTTL so /version can be
+// polled without each request reaching the node
+type nodeInfoCache struct {
+	mu      sync.Mutex
+	info    *NodeInfo
+	fetched time.Time
+	now     func() time.Time
+}
+
+func (n *nodeInfoCache) get() *NodeInfo {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if n.info == nil || n.clock().Sub(n.fetched) >= nodeInfoTTL {
+		return nil
+	}
+	return n.info
+}
+
+func (n *nodeInfoCache) set(info *NodeInfo) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.info = info
+	n.fetched = n.clock()
+}
+
+func (n *nodeInfoCache) clock() time.Time {
+	if n.now != nil {
+		return n.now()
+	}
+	return time.Now()
+}
+
+// NodeInfo reports the node's network and versions, reusing the last answer
+// for nodeInfoTTL. Concurrent callers share a single node request.
+func (c *Client) NodeInfo(ctx context.Context) (*NodeInfo, error) {
+	if info := c.nodeInfo.get(); info != nil {
+		copied := *info
+		return &copied, nil
+	}
+
+	ch := c.queries.DoChan("node_info", func() (interface{}, error) {
+		info, err := c.restClient.GetNodeInfo(ctx)
+		if err != nil {
+			return nil, err
+		}
+		c.nodeInfo.set(info)
+		return info, nil
+	})
+	select {
+	case res := <-ch:
+		if res.Err != nil {
+			return nil, res.Err
+		}
+		copied := *res.Val.(*NodeInfo)
+		return &copied, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
@@ -0,0 +1,83 @@
+package blockchain
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNodeInfoIsCachedBriefly(t *testing.T) {
+	var calls atomic.Int32
+	node := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		assert.Equal(t, "/cosmos/base/tendermint/v1beta1/node_info", r.URL.Path)
+		_, _ = w.Write([]byte(`{
+			"default_node_info": {"network": "racecarweb", "moniker": "val-1", "version": "0.38.17"},
+			"application_version": {"app_name": "racecar-webd", "version": "v0.3.1", "git_commit": "abc123", "cosmos_sdk_version": "v0.53.0"}
+		}`))
+	}))
+	defer node.Close()
+
+	now := time.Unix(1700000000, 0)
+	c := &Client{
+		restClient: &RESTClient{baseURL: node.URL, client: node.Client(), logger: zerolog.Nop()},
+		logger:     zerolog.Nop(),
+		nodeInfo:   nodeInfoCache{now: func() time.Time { return now }},
+	}
+	ctx := context.Background()
+
+	info, err := c.NodeInfo(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, &NodeInfo{
+		Network:          "racecarweb",
+		Moniker:          "val-1",
+		NodeVersion:      "0.38.17",
+		AppName:          "racecar-webd",
+		AppVersion:       "v0.3.1",
+		GitCommit:        "abc123",
+		CosmosSDKVersion: "v0.53.0",
+	}, info)
+
+	// Within the TTL the node is not asked again
+	now = now.Add(nodeInfoTTL - time.Second)
+	_, err = c.NodeInfo(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), calls.Load())
+
+	now = now.Add(time.Second)
+	_, err = c.NodeInfo(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), calls.Load())
+}
+
+func TestNodeInfoErrorsAreNotCached(t *testing.T) {
+	var up atomic.Bool
+	node := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !up.Load() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte(`{"default_node_info": {"network": "racecarweb"}}`))
+	}))
+	defer node.Close()
+
+	c := &Client{
+		restClient: &RESTClient{baseURL: node.URL, client: node.Client(), logger: zerolog.Nop()},
+		logger:     zerolog.Nop(),
+	}
+
+	_, err := c.NodeInfo(context.Background())
+	require.Error(t, err)
+
+	up.Store(true)
+	info, err := c.NodeInfo(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "racecarweb", info.Network)
+}
@@ -0,0 +1,58 @@
+package blockchain
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// NodeStatus is the node's view of the chain, telling a healthy node from
+// one that is stuck or still syncing
+type NodeStatus struct {
+	ChainID           string    `json:"chain_id"`
+	LatestBlockHeight int64     `json:"latest_block_height"`
+	LatestBlockTime   time.Time `json:"latest_block_time"`
+	CatchingUp        bool      `json:"catching_up"`
+	// LatencyMS is the round trip of the latest block query
+	LatencyMS int64 `json:"latency_ms"`
+}
+
+// GetNodeStatus queries the node's latest block and whether it is catching
+// up. Both requests are bound to ctx.
+func (c *RESTClient) GetNodeStatus(ctx context.Context) (*NodeStatus, error) {
+	var block struct {
+		Block struct {
+			Header struct {
+				ChainID string    `json:"chain_id"`
+				Height  string    `json:"height"`
+				Time    time.Time `json:"time"`
+			} `json:"header"`
+		} `json:"block"`
+	}
+	started := time.Now()
+	if err := c.queryJSON(ctx, "/cosmos/base/tendermint/v1beta1/blocks/latest", &block); err != nil {
+		return nil, fmt.Errorf("failed to query latest block: %w", err)
+	}
+	latency := time.Since(started)
+
+	height, err := strconv.ParseInt(block.Block.Header.Height, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid latest block height %q: %w", block.Block.Header.Height, err)
+	}
+
+	var syncing struct {
+		Syncing bool `json:"syncing"`
+	}
+	if err := c.queryJSON(ctx, "/cosmos/base/tendermint/v1beta1/syncing", &syncing); err != nil {
+		return nil, fmt.Errorf("failed to query sync status: %w", err)
+	}
+
+	return &NodeStatus{
+		ChainID:           block.Block.Header.ChainID,
+		LatestBlockHeight: height,
+		LatestBlockTime:   block.Block.Header.Time,
+		CatchingUp:        syncing.Syncing,
+		LatencyMS:         latency.Milliseconds(),
+	}, nil
+}
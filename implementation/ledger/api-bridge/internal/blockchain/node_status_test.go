@@ -0,0 +1,63 @@
+package blockchain
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetNodeStatusReportsHeightAndSync(t *testing.T) {
+	syncing := true
+	node := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/cosmos/base/tendermint/v1beta1/blocks/latest":
+			_, _ = w.Write([]byte(`{"block_id": {}, "block": {"header": {"chain_id": "racecarweb", "height": "48213", "time": "2026-10-16T09:12:04Z"}}}`))
+		case "/cosmos/base/tendermint/v1beta1/syncing":
+			if syncing {
+				_, _ = w.Write([]byte(`{"syncing": true}`))
+			} else {
+				_, _ = w.Write([]byte(`{"syncing": false}`))
+			}
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer node.Close()
+
+	c := &RESTClient{baseURL: node.URL, client: node.Client(), logger: zerolog.Nop()}
+
+	status, err := c.GetNodeStatus(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "racecarweb", status.ChainID)
+	assert.Equal(t, int64(48213), status.LatestBlockHeight)
+	assert.Equal(t, time.Date(2026, 10, 16, 9, 12, 4, 0, time.UTC), status.LatestBlockTime)
+	assert.True(t, status.CatchingUp)
+	assert.GreaterOrEqual(t, status.LatencyMS, int64(0))
+
+	syncing = false
+	status, err = c.GetNodeStatus(context.Background())
+	require.NoError(t, err)
+	assert.False(t, status.CatchingUp)
+}
+
+func TestGetNodeStatusStopsAtTheDeadline(t *testing.T) {
+	release := make(chan struct{})
+	node := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	}))
+	defer node.Close()
+	defer close(release)
+
+	c := &RESTClient{baseURL: node.URL, client: node.Client(), logger: zerolog.Nop()}
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	_, err := c.GetNodeStatus(ctx)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
@@ -0,0 +1,59 @@
+package blockchain
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"api-bridge/internal/config"
+)
+
+// contextRecorder keeps the operational context each relationship message
+// would have the chain store
+type contextRecorder struct {
+	stored map[string]interface{}
+}
+
+func (r *contextRecorder) Mode() string { return "fake" }
+
+func (r *contextRecorder) SimulationTx(ctx context.Context, account *Account, message map[string]interface{}, memo string) ([]byte, error) {
+	return []byte("sim-tx"), nil
+}
+
+func (r *contextRecorder) Execute(ctx context.Context, account *Account, message map[string]interface{}, memo string, gas txGas) (map[string]interface{}, error) {
+	switch message["@type"] {
+	case "/racecarweb.lctmanager.v1.MsgCreateLctRelationship":
+		r.stored["lct"] = message["context"]
+	case "/racecarweb.pairing.v1.MsgInitiateBidirectionalPairing":
+		r.stored["pairing"] = message["operational_context"]
+	}
+	return map[string]interface{}{"code": 0, "txhash": "TX"}, nil
+}
+
+func TestPairingAndLCTCreationSendTheSameContext(t *testing.T) {
+	recorder := &contextRecorder{stored: make(map[string]interface{})}
+	c, _ := testGasClient(t, config.GasConfig{Limit: 200000}, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request to %s", r.URL.Path)
+	})
+	c.txExecutor = recorder
+	ctx := context.Background()
+
+	created, err := c.CreateLCT(ctx, "alice", "MODBATT-PACK-001", "MODBATT-MOD-001", "Battery Management", "")
+	require.NoError(t, err)
+	paired, err := c.InitiatePairing(ctx, "alice", "MODBATT-PACK-001", "MODBATT-MOD-002", "battery-management", "", true)
+	require.NoError(t, err)
+
+	assert.Equal(t, "battery_management", recorder.stored["lct"])
+	assert.Equal(t, recorder.stored["lct"], recorder.stored["pairing"])
+	assert.Equal(t, "battery_management", created["operational_context"])
+	assert.Equal(t, created["operational_context"], created["context"], "the old field stays for one release")
+	assert.Equal(t, created["operational_context"], paired["operational_context"])
+
+	_, err = c.CreateLCT(ctx, "alice", "MODBATT-PACK-001", "MODBATT-MOD-003", "battery/management", "")
+	assert.ErrorIs(t, err, ErrInvalidOperationalContext)
+	_, err = c.InitiatePairing(ctx, "alice", "MODBATT-PACK-001", "MODBATT-MOD-003", "battery/management", "", true)
+	assert.ErrorIs(t, err, ErrInvalidOperationalContext)
+}
@@ -0,0 +1,95 @@
+package blockchain
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// componentTypes are the component types the registry accepts
+var componentTypes = []string{"module", "pack", "host_ecu", "sensor", "actuator"}
+
+// PairingRule is a registry rule allowing components of the source type to
+// pair with components of the target type. The chain stores both types
+// hashed; TargetType is filled in when the hash is of a standard type.
+type PairingRule struct {
+	SourceTypeHash string `json:"source_type_hash"`
+	TargetTypeHash string `json:"target_type_hash"`
+	TargetType     string `json:"target_type,omitempty"`
+	// MinQualityScore is the trust score both components must reach
+	MinQualityScore          string `json:"min_quality_score"`
+	MaxRelationships         uint32 `json:"max_relationships"`
+	BidirectionalRequired    bool   `json:"bidirectional_required"`
+	RequiredCapabilitiesHash string `json:"required_capabilities_hash,omitempty"`
+	ValidationRulesHash      string `json:"validation_rules_hash,omitempty"`
+}
+
+// GetPairingRules retrieves one page of the pairing rules whose source is
+// sourceType, given as the type or its hash
+func (c *RESTClient) GetPairingRules(ctx context.Context, sourceType string, limit uint64, key string) (map[string]interface{}, error) {
+	c.log(ctx).Info().Str("source_type", sourceType).Uint64("limit", limit).Msg("Getting pairing rules via REST")
+
+	params := url.Values{}
+	params.Set("source_type", sourceType)
+	if limit > 0 {
+		params.Set("pagination.limit", strconv.FormatUint(limit, 10))
+	}
+	if key != "" {
+		params.Set("pagination.key", key)
+	}
+
+	// The chain omits empty fields
+	var response struct {
+		Rules []struct {
+			SourceTypeHash           string `json:"source_type_hash"`
+			TargetTypeHash           string `json:"target_type_hash"`
+			MinQualityScore          string `json:"min_quality_score"`
+			RequiredCapabilitiesHash string `json:"required_capabilities_hash"`
+			MaxRelationships         uint32 `json:"max_relationships"`
+			BidirectionalRequired    bool   `json:"bidirectional_required"`
+			ValidationRulesHash      string `json:"validation_rules_hash"`
+		} `json:"rules"`
+		Pagination struct {
+			NextKey string `json:"next_key"`
+		} `json:"pagination"`
+	}
+	if err := c.queryJSON(ctx, "/racecar-web/componentregistry/v1/pairing_rules?"+params.Encode(), &response); err != nil {
+		return nil, fmt.Errorf("failed to get pairing rules: %w", err)
+	}
+
+	rules := make([]PairingRule, 0, len(response.Rules))
+	for _, rule := range response.Rules {
+		rules = append(rules, PairingRule{
+			SourceTypeHash:           rule.SourceTypeHash,
+			TargetTypeHash:           rule.TargetTypeHash,
+			TargetType:               componentTypeOf(rule.TargetTypeHash),
+			MinQualityScore:          rule.MinQualityScore,
+			MaxRelationships:         rule.MaxRelationships,
+			BidirectionalRequired:    rule.BidirectionalRequired,
+			RequiredCapabilitiesHash: rule.RequiredCapabilitiesHash,
+			ValidationRulesHash:      rule.ValidationRulesHash,
+		})
+	}
+
+	return map[string]interface{}{
+		"source_type": sourceType,
+		"rules":       rules,
+		"count":       len(rules),
+		"next_key":    response.Pagination.NextKey,
+	}, nil
+}
+
+// componentTypeOf returns the standard component type typeHash stands for,
+// or "" for an unknown hash. A rule stored with the plain type is returned as is.
+func componentTypeOf(typeHash string) string {
+	for _, componentType := range componentTypes {
+		hash := sha256.Sum256([]byte(componentType))
+		if typeHash == componentType || typeHash == hex.EncodeToString(hash[:]) {
+			return componentType
+		}
+	}
+	return ""
+}
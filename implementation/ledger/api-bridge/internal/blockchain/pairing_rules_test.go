@@ -0,0 +1,38 @@
+package blockchain
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetPairingRulesNamesStandardTargetTypes(t *testing.T) {
+	packHash := "4862f447f2c7f272fa2f4aaf89dadb3b1ac09105bd5864f8d1a0c9452bb0a226" // sha256 of "pack"
+	node := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/racecar-web/componentregistry/v1/pairing_rules", r.URL.Path)
+		query := r.URL.Query()
+		assert.Equal(t, "module", query.Get("source_type"))
+		assert.Equal(t, "10", query.Get("pagination.limit"))
+		assert.Equal(t, "cnVsZS0x", query.Get("pagination.key"))
+		_, _ = w.Write([]byte(`{"rules": [
+			{"source_type_hash": "aa11", "target_type_hash": "` + packHash + `", "min_quality_score": "0.75", "max_relationships": 1, "bidirectional_required": true},
+			{"source_type_hash": "aa11", "target_type_hash": "ff00", "min_quality_score": "0.9", "validation_rules_hash": "cc33"}
+		], "pagination": {"next_key": "cnVsZS0z"}}`))
+	}))
+	defer node.Close()
+
+	c := &RESTClient{baseURL: node.URL, client: node.Client(), logger: zerolog.Nop()}
+	page, err := c.GetPairingRules(context.Background(), "module", 10, "cnVsZS0x")
+	require.NoError(t, err)
+	assert.Equal(t, 2, page["count"])
+	assert.Equal(t, "cnVsZS0z", page["next_key"])
+	assert.Equal(t, []PairingRule{
+		{SourceTypeHash: "aa11", TargetTypeHash: packHash, TargetType: "pack", MinQualityScore: "0.75", MaxRelationships: 1, BidirectionalRequired: true},
+		{SourceTypeHash: "aa11", TargetTypeHash: "ff00", MinQualityScore: "0.9", ValidationRulesHash: "cc33"},
+	}, page["rules"])
+}
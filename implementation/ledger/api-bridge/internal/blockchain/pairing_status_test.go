@@ -0,0 +1,50 @@
+package blockchain
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetPairingStatusReadsChainSession(t *testing.T) {
+	node := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/racecar-web/pairing/v1/get_pairing_status/challenge-completed":
+			_, _ = w.Write([]byte(`{"pairing_challenge": "{\"challenge_id\":\"challenge-completed\",\"lct_id\":\"lct-a-b\",\"status\":\"completed\",\"created_at\":1752484844,\"expires_at\":1752485144,\"ttl_seconds\":0,\"established_at\":1752484904}"}`))
+		case "/racecar-web/pairing/v1/get_pairing_status/challenge-pending":
+			_, _ = w.Write([]byte(`{"pairing_challenge": "{\"challenge_id\":\"challenge-pending\",\"lct_id\":\"lct-e-f\",\"status\":\"pending\",\"created_at\":1752484844,\"expires_at\":1752485144,\"ttl_seconds\":240,\"established_at\":0}"}`))
+		case "/racecar-web/pairing/v1/get_pairing_status/challenge-expired":
+			_, _ = w.Write([]byte(`{"pairing_challenge": "{\"challenge_id\":\"challenge-expired\",\"lct_id\":\"lct-c-d\",\"status\":\"expired\",\"created_at\":1752484844,\"expires_at\":1752485144,\"established_at\":0}"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"code": 5, "message": "pairing session not found"}`))
+		}
+	}))
+	defer node.Close()
+
+	c := &RESTClient{baseURL: node.URL, client: node.Client(), logger: zerolog.Nop()}
+
+	status, err := c.GetPairingStatus(context.Background(), "challenge-completed")
+	require.NoError(t, err)
+	assert.Equal(t, "completed", status["status"])
+	assert.Equal(t, "lct-a-b", status["lct_id"])
+	assert.Equal(t, int64(1752484844), status["created_at"])
+
+	status, err = c.GetPairingStatus(context.Background(), "challenge-pending")
+	require.NoError(t, err)
+	assert.Equal(t, int64(240), status["ttl_seconds"])
+
+	// Unfinished pairings do not expose the LCT
+	status, err = c.GetPairingStatus(context.Background(), "challenge-expired")
+	require.NoError(t, err)
+	assert.Equal(t, "expired", status["status"])
+	assert.NotContains(t, status, "lct_id")
+
+	_, err = c.GetPairingStatus(context.Background(), "challenge-unknown")
+	assert.ErrorIs(t, err, ErrPairingChallengeNotFound)
+}
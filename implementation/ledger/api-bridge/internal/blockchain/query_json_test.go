@@ -0,0 +1,54 @@
+package blockchain
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueryJSON(t *testing.T) {
+	testCases := []struct {
+		name     string
+		status   int
+		body     string
+		expected map[string]string
+		httpCode int // status of the expected *HTTPError, 0 for none
+		errMsg   string
+	}{
+		{name: "decodes the answer", status: http.StatusOK, body: `{"status": "active"}`, expected: map[string]string{"status": "active"}},
+		{name: "not found", status: http.StatusNotFound, body: `{"code": 5, "message": "not found"}`, httpCode: http.StatusNotFound},
+		{name: "node error", status: http.StatusInternalServerError, body: `{"code": 13}`, httpCode: http.StatusInternalServerError},
+		{name: "malformed answer", status: http.StatusOK, body: `{"status": `, errMsg: "failed to parse response"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			node := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/racecar-web/lctmanager/v1/get_lct/lct-1", r.URL.Path)
+				w.WriteHeader(tc.status)
+				_, _ = w.Write([]byte(tc.body))
+			}))
+			defer node.Close()
+			c := &RESTClient{baseURL: node.URL, client: node.Client(), logger: zerolog.Nop()}
+
+			var out map[string]string
+			err := c.queryJSON(context.Background(), "/racecar-web/lctmanager/v1/get_lct/lct-1", &out)
+			switch {
+			case tc.httpCode != 0:
+				var httpErr *HTTPError
+				require.ErrorAs(t, err, &httpErr)
+				assert.Equal(t, tc.httpCode, httpErr.StatusCode)
+			case tc.errMsg != "":
+				require.ErrorContains(t, err, tc.errMsg)
+			default:
+				require.NoError(t, err)
+				assert.Equal(t, tc.expected, out)
+			}
+		})
+	}
+}
@@ -0,0 +1,151 @@
+package blockchain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"sync"
+	"time"
+)
+
+// readinessTTL is how long a readiness result answers later probes; /ready is
+// public, so probes must not reach the node and keyring every time
+const readinessTTL = 5 * time.Second
+
+// readinessTimeout bounds one readiness check
+const readinessTimeout = 10 * time.Second
+
+// Dependency states reported by Readiness
+const (
+	DependencyUp          = "up"
+	DependencyDown        = "down"
+	DependencyNotRequired = "not_required" // not used in the configured transaction mode
+)
+
+// DependencyStatus is the state of one dependency the bridge needs to serve requests
+type DependencyStatus struct {
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
+
+// readinessCache holds the last readiness result
+type readinessCache struct {
+	mu           sync.Mutex
+	checked      time.Time
+	ready        bool
+	dependencies map[string]DependencyStatus
+}
+
+// Readiness checks the dependencies requests go through: the node's REST API,
+// the Ignite CLI when transactions are signed through it, and the keyring
+// when one is configured. Broadcasts are down while the circuit breaker
+// refuses them. ready is false when any of them is down. A result answers
+// the probes of the next readinessTTL, and concurrent probes share one check.
+func (c *Client) Readiness(ctx context.Context) (bool, map[string]DependencyStatus) {
+	c.readiness.mu.Lock()
+	if !c.readiness.checked.IsZero() && time.Since(c.readiness.checked) < readinessTTL {
+		ready, dependencies := c.readiness.ready, maps.Clone(c.readiness.dependencies)
+		c.readiness.mu.Unlock()
+		return ready, dependencies
+	}
+	c.readiness.mu.Unlock()
+
+	result := <-c.queries.DoChan("readiness", func() (interface{}, error) {
+		// Shared by every waiting probe, so one going away must not cut it short
+		checkCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), readinessTimeout)
+		defer cancel()
+		ready, dependencies := c.checkReadiness(checkCtx)
+
+		c.readiness.mu.Lock()
+		defer c.readiness.mu.Unlock()
+		c.readiness.checked, c.readiness.ready, c.readiness.dependencies = time.Now(), ready, dependencies
+		return dependencies, nil
+	})
+	dependencies := result.Val.(map[string]DependencyStatus)
+	return readyWith(dependencies), maps.Clone(dependencies)
+}
+
+// checkReadiness checks every dependency concurrently
+func (c *Client) checkReadiness(ctx context.Context) (bool, map[string]DependencyStatus) {
+	checks := map[string]func(context.Context) (string, error){
+		"blockchain": func(ctx context.Context) (string, error) {
+			return DependencyUp, c.restClient.testBlockchainConnection(ctx)
+		},
+		"ignite_cli": func(ctx context.Context) (string, error) {
+			// The native signer does not depend on the Ignite CLI
+			if c.restClient.txExecutor.Mode() != TxModeCLI {
+				return DependencyNotRequired, nil
+			}
+			// Resolved once at startup; probes never start a subprocess
+			return DependencyUp, c.restClient.igniteErr
+		},
+		"keyring":    c.checkKeyring,
+		"broadcasts": c.checkBreaker,
+	}
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	dependencies := make(map[string]DependencyStatus, len(checks))
+	for name, check := range checks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			dependency := DependencyStatus{}
+			status, err := check(ctx)
+			if err != nil {
+				dependency = DependencyStatus{Status: DependencyDown, Error: err.Error()}
+			} else {
+				dependency.Status = status
+			}
+			mu.Lock()
+			dependencies[name] = dependency
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+	return readyWith(dependencies), dependencies
+}
+
+// readyWith reports whether none of dependencies is down
+func readyWith(dependencies map[string]DependencyStatus) bool {
+	for _, dependency := range dependencies {
+		if dependency.Status == DependencyDown {
+			return false
+		}
+	}
+	return true
+}
+
+// checkKeyring lists the configured keyring's keys, which needs the same
+// access signing does
+func (c *Client) checkKeyring(ctx context.Context) (string, error) {
+	if !c.keyringConfigured {
+		return DependencyNotRequired, nil
+	}
+	kr := c.restClient.accountManager.Keyring()
+	if kr == nil {
+		if c.keyringErr != nil {
+			return "", fmt.Errorf("keyring could not be opened: %w", c.keyringErr)
+		}
+		return "", errors.New("keyring is not open")
+	}
+	if _, err := kr.List(); err != nil {
+		return "", fmt.Errorf("failed to list %s keyring: %w", kr.Backend(), err)
+	}
+	return DependencyUp, nil
+}
+
+// checkBreaker reports broadcasts down while the circuit breaker is open.
+// A half-open breaker is up: the next broadcast is the trial.
+func (c *Client) checkBreaker(ctx context.Context) (string, error) {
+	stats := c.BreakerStats()
+	switch {
+	case !stats.Enabled:
+		return DependencyNotRequired, nil
+	case stats.State == BreakerOpen:
+		return "", fmt.Errorf("circuit breaker open after %d consecutive broadcasts failed to reach the node, retrying after %s",
+			stats.ConsecutiveFailures, stats.RetryAt.Format(time.RFC3339))
+	}
+	return DependencyUp, nil
+}
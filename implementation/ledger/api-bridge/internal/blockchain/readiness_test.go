@@ -0,0 +1,94 @@
+package blockchain
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"api-bridge/internal/config"
+)
+
+func TestReadinessReportsEachDependency(t *testing.T) {
+	nodeUp := true
+	node := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !nodeUp {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte(`{"default_node_info": {"network": "racecarweb"}}`))
+	}))
+	defer node.Close()
+
+	accounts := NewAccountManager(zerolog.Nop())
+	require.NoError(t, accounts.OpenKeyring(KeyringConfig{Backend: KeyringBackendTest, Dir: t.TempDir()}, false))
+	c := &Client{
+		restClient: &RESTClient{
+			baseURL:        node.URL,
+			client:         node.Client(),
+			logger:         zerolog.Nop(),
+			accountManager: accounts,
+			txExecutor:     &fakeTxExecutor{},
+		},
+		logger:            zerolog.Nop(),
+		keyringConfigured: true,
+	}
+	ctx := context.Background()
+
+	ready, dependencies := c.Readiness(ctx)
+	assert.True(t, ready)
+	assert.Equal(t, map[string]DependencyStatus{
+		"blockchain": {Status: DependencyUp},
+		"ignite_cli": {Status: DependencyNotRequired},
+		"keyring":    {Status: DependencyUp},
+		"broadcasts": {Status: DependencyNotRequired},
+	}, dependencies)
+
+	// Probes within readinessTTL get the last result without checking again
+	nodeUp = false
+	ready, _ = c.Readiness(ctx)
+	assert.True(t, ready)
+
+	recheck := func() (bool, map[string]DependencyStatus) {
+		c.readiness.checked = time.Time{}
+		return c.Readiness(ctx)
+	}
+	ready, dependencies = recheck()
+	assert.False(t, ready)
+	assert.Equal(t, DependencyDown, dependencies["blockchain"].Status)
+	assert.Contains(t, dependencies["blockchain"].Error, "status 503")
+	assert.Equal(t, DependencyUp, dependencies["keyring"].Status)
+
+	// A keyring that failed to open at startup keeps the bridge unready
+	nodeUp = true
+	c.restClient.accountManager = NewAccountManager(zerolog.Nop())
+	c.keyringErr = errors.New("the file keyring backend needs a passphrase")
+	ready, dependencies = recheck()
+	assert.False(t, ready)
+	assert.Equal(t, DependencyStatus{
+		Status: DependencyDown,
+		Error:  "keyring could not be opened: the file keyring backend needs a passphrase",
+	}, dependencies["keyring"])
+
+	c.keyringConfigured = false
+	ready, dependencies = recheck()
+	assert.True(t, ready)
+	assert.Equal(t, DependencyNotRequired, dependencies["keyring"].Status)
+
+	// Broadcasts are down while the circuit breaker is open
+	c.restClient.breaker = newBroadcastBreaker(config.BreakerConfig{FailureThreshold: 1, Cooldown: time.Minute}, zerolog.Nop())
+	ready, dependencies = recheck()
+	assert.True(t, ready)
+	assert.Equal(t, DependencyUp, dependencies["broadcasts"].Status)
+	c.restClient.breaker.record(context.DeadlineExceeded)
+	ready, dependencies = recheck()
+	assert.False(t, ready)
+	assert.Equal(t, DependencyDown, dependencies["broadcasts"].Status)
+	assert.Contains(t, dependencies["broadcasts"].Error, "circuit breaker open")
+}
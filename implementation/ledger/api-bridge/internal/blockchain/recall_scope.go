@@ -0,0 +1,230 @@
+package blockchain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"net/http"
+	"net/url"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/errgroup"
+)
+
+// RecallCriteria selects the components affected by a recall. A component is
+// affected when its hash is listed or it matches the manufacturer or category
+// hash; criteria left empty select nothing.
+type RecallCriteria struct {
+	ComponentHashes  []string `json:"component_hashes,omitempty"`
+	ManufacturerHash string   `json:"manufacturer_hash,omitempty"`
+	CategoryHash     string   `json:"category_hash,omitempty"`
+}
+
+// RecallComponent is a component in a recall scope
+type RecallComponent struct {
+	ComponentID      string `json:"component_id"`
+	ManufacturerHash string `json:"manufacturer_hash,omitempty"`
+	CategoryHash     string `json:"category_hash,omitempty"`
+	Status           string `json:"status,omitempty"`
+	Owner            string `json:"owner,omitempty"` // current owner, only when owners are included
+}
+
+// RecallLct is an LCT that pairs an affected component
+type RecallLct struct {
+	LctID            string `json:"lct_id"`
+	ComponentAID     string `json:"component_a_id"`
+	ComponentBID     string `json:"component_b_id"`
+	ProxyComponentID string `json:"proxy_component_id,omitempty"`
+	PairingStatus    string `json:"pairing_status"`
+}
+
+// RecallScope is everything on chain a recall reaches: the affected
+// components, the LCTs pairing them and the components on the other side
+type RecallScope struct {
+	Criteria          RecallCriteria    `json:"criteria"`
+	Components        []RecallComponent `json:"components"`
+	UnknownComponents []string          `json:"unknown_components"` // listed hashes that are not registered
+	Lcts              []RecallLct       `json:"lcts"`
+	Counterparts      []RecallComponent `json:"counterparts"` // unaffected components paired with affected ones
+	OwnersIncluded    bool              `json:"owners_included"`
+	GeneratedAt       int64             `json:"generated_at"`
+}
+
+// recallLookups bounds the component, LCT and custody queries a recall scope
+// has in flight at once
+const recallLookups = 8
+
+// GetRecallScope collects the recall scope for the criteria. Components are
+// found through the chain's manufacturer and category hash indexes and by
+// looking up each listed hash, and their LCTs through the component and proxy
+// indexes, so the cost grows with the recall rather than with the fleet.
+// Owners come from each affected component's custody chain and are looked up
+// only when includeOwners is set.
+func (c *RESTClient) GetRecallScope(ctx context.Context, criteria RecallCriteria, includeOwners bool) (*RecallScope, error) {
+	c.log(ctx).Info().Int("component_hashes", len(criteria.ComponentHashes)).Str("manufacturer_hash", criteria.ManufacturerHash).
+		Str("category_hash", criteria.CategoryHash).Bool("include_owners", includeOwners).Msg("Collecting recall scope via REST")
+
+	scope := &RecallScope{
+		Criteria:          criteria,
+		Components:        []RecallComponent{},
+		UnknownComponents: []string{},
+		Lcts:              []RecallLct{},
+		Counterparts:      []RecallComponent{},
+		OwnersIncluded:    includeOwners,
+	}
+
+	var mu sync.Mutex
+	affected := make(map[string]RecallComponent)
+	for param, hash := range map[string]string{"manufacturer_hash": criteria.ManufacturerHash, "category_hash": criteria.CategoryHash} {
+		if hash == "" {
+			continue
+		}
+		endpoint := "/racecar-web/componentregistry/v1/components/search?" + url.Values{param: {hash}}.Encode()
+		err := walkPages(ctx, c, endpoint, "components", func(component RecallComponent) {
+			affected[component.ComponentID] = component
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var listed []string
+	for _, hash := range criteria.ComponentHashes {
+		if _, ok := affected[hash]; !ok {
+			listed = append(listed, hash)
+		}
+	}
+	err := c.lookupEach(ctx, listed, func(ctx context.Context, hash string) error {
+		component, found, err := c.recallComponent(ctx, hash)
+		if err != nil {
+			return err
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		if found {
+			affected[hash] = component
+		} else {
+			scope.UnknownComponents = append(scope.UnknownComponents, hash)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ids := slices.Collect(maps.Keys(affected))
+
+	// An LCT reaches a component it pairs, or one it is mediated through
+	lcts := make(map[string]RecallLct)
+	err = c.lookupEach(ctx, ids, func(ctx context.Context, id string) error {
+		for _, endpoint := range []string{
+			"/racecar-web/lctmanager/v1/lcts?" + url.Values{"component_id": {id}}.Encode(),
+			"/racecar-web/lctmanager/v1/proxy/" + url.PathEscape(id) + "/lcts",
+		} {
+			err := walkPages(ctx, c, endpoint, "lcts", func(lct RecallLct) {
+				mu.Lock()
+				defer mu.Unlock()
+				lcts[lct.LctID] = lct
+			})
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	counterparts := make(map[string]bool)
+	for _, lct := range lcts {
+		scope.Lcts = append(scope.Lcts, lct)
+		for _, id := range []string{lct.ComponentAID, lct.ComponentBID, lct.ProxyComponentID} {
+			if _, ok := affected[id]; id != "" && !ok {
+				counterparts[id] = true
+			}
+		}
+	}
+	err = c.lookupEach(ctx, slices.Collect(maps.Keys(counterparts)), func(ctx context.Context, id string) error {
+		counterpart, found, err := c.recallComponent(ctx, id)
+		if err != nil {
+			return err
+		}
+		if !found {
+			counterpart = RecallComponent{ComponentID: id}
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		scope.Counterparts = append(scope.Counterparts, counterpart)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if includeOwners {
+		err := c.lookupEach(ctx, ids, func(ctx context.Context, id string) error {
+			chain, err := c.GetCustodyChain(ctx, id)
+			if errors.Is(err, ErrComponentNotFound) {
+				return nil
+			}
+			if err != nil {
+				return fmt.Errorf("failed to get owner of %s: %w", id, err)
+			}
+			if len(chain) == 0 {
+				return nil
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			component := affected[id]
+			component.Owner = chain[len(chain)-1].ToOwner
+			affected[id] = component
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	for _, component := range affected {
+		scope.Components = append(scope.Components, component)
+	}
+
+	sort.Slice(scope.Components, func(i, j int) bool { return scope.Components[i].ComponentID < scope.Components[j].ComponentID })
+	sort.Strings(scope.UnknownComponents)
+	sort.Slice(scope.Lcts, func(i, j int) bool { return scope.Lcts[i].LctID < scope.Lcts[j].LctID })
+	sort.Slice(scope.Counterparts, func(i, j int) bool { return scope.Counterparts[i].ComponentID < scope.Counterparts[j].ComponentID })
+	scope.GeneratedAt = time.Now().Unix()
+
+	return scope, nil
+}
+
+// lookupEach runs lookup for each ID with at most recallLookups in flight.
+// The first error cancels the lookups still running and is returned.
+func (c *RESTClient) lookupEach(ctx context.Context, ids []string, lookup func(context.Context, string) error) error {
+	g, ctx := errgroup.WithContext(ctx)
+	g.SetLimit(recallLookups)
+	for _, id := range ids {
+		g.Go(func() error { return lookup(ctx, id) })
+	}
+	return g.Wait()
+}
+
+// recallComponent looks up one component, reporting false when it is not
+// registered
+func (c *RESTClient) recallComponent(ctx context.Context, id string) (RecallComponent, bool, error) {
+	var response struct {
+		Component RecallComponent `json:"component"`
+	}
+	if err := c.queryJSON(ctx, "/racecar-web/componentregistry/v1/get_component/"+url.PathEscape(id), &response); err != nil {
+		var httpErr *HTTPError
+		if errors.As(err, &httpErr) && httpErr.StatusCode == http.StatusNotFound {
+			return RecallComponent{}, false, nil
+		}
+		return RecallComponent{}, false, fmt.Errorf("failed to get component %s: %w", id, err)
+	}
+	return response.Component, true, nil
+}
@@ -0,0 +1,174 @@
+package blockchain
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fleetNode serves a fleet of two manufacturers: mfr-a built a pack and two
+// modules, mfr-b a motor controller and a third module. The pack is paired
+// with both of its modules and the motor controller, which is in turn paired
+// with mfr-b's module. Only the indexed queries are served, so a recall that
+// lists every component or LCT fails.
+func fleetNode(t *testing.T) *httptest.Server {
+	t.Helper()
+	components := []RecallComponent{
+		{ComponentID: "hash-pack-1", ManufacturerHash: "mfr-a", CategoryHash: "cat-pack", Status: "active"},
+		{ComponentID: "hash-mod-1", ManufacturerHash: "mfr-a", CategoryHash: "cat-module", Status: "active"},
+		{ComponentID: "hash-mod-2", ManufacturerHash: "mfr-a", CategoryHash: "cat-module", Status: "suspended"},
+		{ComponentID: "hash-mc-1", ManufacturerHash: "mfr-b", CategoryHash: "cat-mc", Status: "active"},
+		{ComponentID: "hash-mod-3", ManufacturerHash: "mfr-b", CategoryHash: "cat-module", Status: "active"},
+	}
+	lcts := []RecallLct{
+		{LctID: "lct-pack-mod-1", ComponentAID: "hash-pack-1", ComponentBID: "hash-mod-1", PairingStatus: "active"},
+		{LctID: "lct-pack-mod-2", ComponentAID: "hash-pack-1", ComponentBID: "hash-mod-2", PairingStatus: "suspended"},
+		{LctID: "lct-pack-mc-1", ComponentAID: "hash-pack-1", ComponentBID: "hash-mc-1", ProxyComponentID: "hash-mod-3", PairingStatus: "active"},
+		{LctID: "lct-mc-mod-3", ComponentAID: "hash-mc-1", ComponentBID: "hash-mod-3", PairingStatus: "active"},
+		{LctID: "lct-agent-pitbot-1", ComponentAID: "pitbot", ComponentBID: "agent"},
+	}
+
+	// page serves items two at a time, the way walkPages follows next_key
+	page := func(w http.ResponseWriter, r *http.Request, field string, items []any) {
+		offset, _ := strconv.Atoi(r.URL.Query().Get("pagination.key"))
+		end := min(offset+2, len(items))
+		nextKey := ""
+		if end < len(items) {
+			nextKey = strconv.Itoa(end)
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{field: items[offset:end], "pagination": map[string]string{"next_key": nextKey}})
+	}
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		switch {
+		case r.URL.Path == "/racecar-web/componentregistry/v1/components/search":
+			var found []any
+			for _, component := range components {
+				if (query.Has("manufacturer_hash") && component.ManufacturerHash == query.Get("manufacturer_hash")) ||
+					(query.Has("category_hash") && component.CategoryHash == query.Get("category_hash")) {
+					found = append(found, component)
+				}
+			}
+			page(w, r, "components", found)
+		case strings.HasPrefix(r.URL.Path, "/racecar-web/componentregistry/v1/get_component/"):
+			id := strings.TrimPrefix(r.URL.Path, "/racecar-web/componentregistry/v1/get_component/")
+			for _, component := range components {
+				if component.ComponentID == id {
+					_ = json.NewEncoder(w).Encode(map[string]any{"component": component})
+					return
+				}
+			}
+			w.WriteHeader(http.StatusNotFound)
+		case r.URL.Path == "/racecar-web/lctmanager/v1/lcts" && query.Has("component_id"):
+			var found []any
+			for _, lct := range lcts {
+				if lct.ComponentAID == query.Get("component_id") || lct.ComponentBID == query.Get("component_id") {
+					found = append(found, lct)
+				}
+			}
+			page(w, r, "lcts", found)
+		case strings.HasPrefix(r.URL.Path, "/racecar-web/lctmanager/v1/proxy/"):
+			proxy := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/racecar-web/lctmanager/v1/proxy/"), "/lcts")
+			var found []any
+			for _, lct := range lcts {
+				if lct.ProxyComponentID == proxy {
+					found = append(found, lct)
+				}
+			}
+			page(w, r, "lcts", found)
+		case strings.HasPrefix(r.URL.Path, "/racecar-web/componentregistry/v1/custody/"):
+			switch strings.TrimPrefix(r.URL.Path, "/racecar-web/componentregistry/v1/custody/") {
+			case "hash-pack-1":
+				_, _ = w.Write([]byte(`{"events": [{"sequence": "1", "to_owner": "cosmos1fleet", "transferred_at": "2025-07-14T09:00:00Z"}]}`))
+			case "hash-mod-1":
+				_, _ = w.Write([]byte(`{"events": [
+					{"sequence": "1", "to_owner": "cosmos1factory", "transferred_at": "2025-07-14T09:00:00Z"},
+					{"sequence": "2", "from_owner": "cosmos1factory", "to_owner": "cosmos1fleet", "reason": "sale", "transferred_at": "2025-07-15T09:00:00Z"}
+				]}`))
+			default:
+				// Registered before custody was tracked
+				_, _ = w.Write([]byte(`{}`))
+			}
+		default:
+			t.Errorf("unexpected request to %s", r.URL)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+func TestRecallScopeForManufacturer(t *testing.T) {
+	node := fleetNode(t)
+	defer node.Close()
+
+	c := &RESTClient{baseURL: node.URL, client: node.Client(), logger: zerolog.Nop()}
+	scope, err := c.GetRecallScope(context.Background(), RecallCriteria{
+		ManufacturerHash: "mfr-a",
+		ComponentHashes:  []string{"hash-mod-1", "hash-unregistered"},
+	}, true)
+	require.NoError(t, err)
+
+	assert.True(t, scope.OwnersIncluded)
+	assert.Equal(t, []RecallComponent{
+		{ComponentID: "hash-mod-1", ManufacturerHash: "mfr-a", CategoryHash: "cat-module", Status: "active", Owner: "cosmos1fleet"},
+		{ComponentID: "hash-mod-2", ManufacturerHash: "mfr-a", CategoryHash: "cat-module", Status: "suspended"},
+		{ComponentID: "hash-pack-1", ManufacturerHash: "mfr-a", CategoryHash: "cat-pack", Status: "active", Owner: "cosmos1fleet"},
+	}, scope.Components)
+	assert.Equal(t, []string{"hash-unregistered"}, scope.UnknownComponents)
+
+	// The motor controller's own pairing is outside the recall
+	assert.Equal(t, []RecallLct{
+		{LctID: "lct-pack-mc-1", ComponentAID: "hash-pack-1", ComponentBID: "hash-mc-1", ProxyComponentID: "hash-mod-3", PairingStatus: "active"},
+		{LctID: "lct-pack-mod-1", ComponentAID: "hash-pack-1", ComponentBID: "hash-mod-1", PairingStatus: "active"},
+		{LctID: "lct-pack-mod-2", ComponentAID: "hash-pack-1", ComponentBID: "hash-mod-2", PairingStatus: "suspended"},
+	}, scope.Lcts)
+	assert.Equal(t, []RecallComponent{
+		{ComponentID: "hash-mc-1", ManufacturerHash: "mfr-b", CategoryHash: "cat-mc", Status: "active"},
+		{ComponentID: "hash-mod-3", ManufacturerHash: "mfr-b", CategoryHash: "cat-module", Status: "active"},
+	}, scope.Counterparts)
+	assert.NotZero(t, scope.GeneratedAt)
+}
+
+func TestRecallScopeWithoutOwners(t *testing.T) {
+	node := fleetNode(t)
+	defer node.Close()
+
+	c := &RESTClient{baseURL: node.URL, client: node.Client(), logger: zerolog.Nop()}
+	scope, err := c.GetRecallScope(context.Background(), RecallCriteria{CategoryHash: "cat-mc"}, false)
+	require.NoError(t, err)
+
+	assert.False(t, scope.OwnersIncluded)
+	require.Len(t, scope.Components, 1)
+	assert.Empty(t, scope.Components[0].Owner)
+	assert.Equal(t, []string{"lct-mc-mod-3", "lct-pack-mc-1"}, []string{scope.Lcts[0].LctID, scope.Lcts[1].LctID})
+	assert.Len(t, scope.Counterparts, 2)
+}
+
+func TestRecallScopeReachesProxiedLcts(t *testing.T) {
+	node := fleetNode(t)
+	defer node.Close()
+
+	// mfr-b's module only mediates the pack's pairing with the motor controller
+	c := &RESTClient{baseURL: node.URL, client: node.Client(), logger: zerolog.Nop()}
+	scope, err := c.GetRecallScope(context.Background(), RecallCriteria{ComponentHashes: []string{"hash-mod-3"}}, false)
+	require.NoError(t, err)
+
+	lctIDs := make([]string, len(scope.Lcts))
+	for i, lct := range scope.Lcts {
+		lctIDs[i] = lct.LctID
+	}
+	assert.Equal(t, []string{"lct-mc-mod-3", "lct-pack-mc-1"}, lctIDs)
+	counterpartIDs := make([]string, len(scope.Counterparts))
+	for i, counterpart := range scope.Counterparts {
+		counterpartIDs[i] = counterpart.ComponentID
+	}
+	assert.Equal(t, []string{"hash-mc-1", "hash-pack-1"}, counterpartIDs)
+}
@@ -0,0 +1,76 @@
+package blockchain
+
+import (
+	"context"
+	"sync"
+
+	"github.com/rs/zerolog"
+)
+
+// apiRequest is the API request a context serves
+type apiRequest struct {
+	id string
+
+	mu       sync.Mutex
+	txHashes []string
+}
+
+type apiRequestKey struct{}
+
+// WithRequestID marks ctx as serving the API request with the given ID. Client
+// logs written for it carry the ID as request_id, and the hashes of the
+// transactions it broadcasts can be read back with TxHashes.
+func WithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, apiRequestKey{}, &apiRequest{id: id})
+}
+
+// RequestID returns the ID WithRequestID gave ctx, or "" when it has none
+func RequestID(ctx context.Context) string {
+	if req, ok := ctx.Value(apiRequestKey{}).(*apiRequest); ok {
+		return req.id
+	}
+	return ""
+}
+
+// TxHashes returns the hashes of the transactions broadcast for the request
+// ctx serves, in the order they were broadcast
+func TxHashes(ctx context.Context) []string {
+	req, ok := ctx.Value(apiRequestKey{}).(*apiRequest)
+	if !ok {
+		return nil
+	}
+	req.mu.Lock()
+	defer req.mu.Unlock()
+	return append([]string(nil), req.txHashes...)
+}
+
+// recordTxHash notes a transaction broadcast for the request ctx serves
+func recordTxHash(ctx context.Context, txHash string) {
+	req, ok := ctx.Value(apiRequestKey{}).(*apiRequest)
+	if !ok || txHash == "" {
+		return
+	}
+	req.mu.Lock()
+	defer req.mu.Unlock()
+	req.txHashes = append(req.txHashes, txHash)
+}
+
+// requestLogger returns logger, tagged with the request ID ctx carries
+func requestLogger(ctx context.Context, logger *zerolog.Logger) *zerolog.Logger {
+	id := RequestID(ctx)
+	if id == "" {
+		return logger
+	}
+	tagged := logger.With().Str("request_id", id).Logger()
+	return &tagged
+}
+
+// log returns the client's logger for work done on behalf of ctx
+func (c *RESTClient) log(ctx context.Context) *zerolog.Logger {
+	return requestLogger(ctx, &c.logger)
+}
+
+// log returns the client's logger for work done on behalf of ctx
+func (c *Client) log(ctx context.Context) *zerolog.Logger {
+	return requestLogger(ctx, &c.logger)
+}
@@ -0,0 +1,45 @@
+package blockchain
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"api-bridge/internal/config"
+)
+
+func TestRequestIDTagsLogsAndCollectsTxHashes(t *testing.T) {
+	c, _ := testGasClient(t, config.GasConfig{Limit: 200000}, nil)
+	var logs bytes.Buffer
+	c.logger = zerolog.New(&logs)
+
+	ctx := WithRequestID(context.Background(), "req-42")
+	_, err := c.executeTransaction(ctx, registerMessage(), "")
+	require.NoError(t, err)
+	_, err = c.executeTransaction(ctx, registerMessage(), "")
+	require.NoError(t, err)
+
+	assert.Equal(t, "req-42", RequestID(ctx))
+	assert.Equal(t, []string{"ABC", "ABC"}, TxHashes(ctx))
+
+	lines := strings.Split(strings.TrimSpace(logs.String()), "\n")
+	require.NotEmpty(t, lines)
+	for _, line := range lines {
+		var entry map[string]interface{}
+		require.NoError(t, json.Unmarshal([]byte(line), &entry))
+		assert.Equal(t, "req-42", entry["request_id"], line)
+	}
+
+	// Work done outside a request carries no ID
+	logs.Reset()
+	_, err = c.executeTransaction(context.Background(), registerMessage(), "")
+	require.NoError(t, err)
+	assert.NotContains(t, logs.String(), "request_id")
+	assert.Empty(t, TxHashes(context.Background()))
+}
@@ -6,9 +6,11 @@ import (
 	"crypto/rand"
 	"crypto/sha256"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"os"
 	"os/exec"
 	"path/filepath"
@@ -17,17 +19,125 @@ import (
 	"time"
 
 	"github.com/rs/zerolog"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"api-bridge/internal/config"
+	energycycletypes "racecar-web/x/energycycle/types"
+	lctmanagertypes "racecar-web/x/lctmanager/types"
+	pairingtypes "racecar-web/x/pairing/types"
+	trusttensortypes "racecar-web/x/trusttensor/types"
 )
 
+// ErrTrustTensorNotFound is returned when the chain holds no tensor with the requested ID
+var ErrTrustTensorNotFound = errors.New("trust tensor not found")
+
+// ErrPairingChallengeNotFound is returned when the chain holds no pairing session for a challenge ID
+var ErrPairingChallengeNotFound = errors.New("pairing challenge not found")
+
+// ErrComponentNotFound is returned when the chain holds no component with the requested ID
+var ErrComponentNotFound = errors.New("component not found")
+
+// ErrComponentInactive is returned when a component exists but is not active,
+// e.g. when pairing it through a new LCT
+var ErrComponentInactive = errors.New("component is not active")
+
+// ErrKeyExchangeNotFound is returned when the LCT does not exist or has never
+// started a session key exchange
+var ErrKeyExchangeNotFound = errors.New("key exchange not found")
+
+// ErrSplitKeyNotFound is returned when the LCT does not exist or no split key
+// has been issued for it
+var ErrSplitKeyNotFound = errors.New("split key not found")
+
+// ErrInvalidLCTStatus is returned for a status the lctmanager module does not define
+var ErrInvalidLCTStatus = errors.New("invalid LCT status")
+
+// ErrEnergyOperationNotFound is returned when the chain holds no energy operation with the requested ID
+var ErrEnergyOperationNotFound = errors.New("energy operation not found")
+
+// ErrEnergyOperationNotPending is returned when an energy operation has already
+// been executed, failed or cancelled
+var ErrEnergyOperationNotPending = errors.New("energy operation is not pending")
+
+// ErrEnergyTransferUnauthorized is returned when the signer of a transfer
+// neither created the operation nor is the source LCT's trust anchor
+var ErrEnergyTransferUnauthorized = errors.New("not authorized to execute the energy operation")
+
+// ErrPairingRevocationUnauthorized is returned when the signer of a
+// revocation is neither a participant in the pairing nor the authority
+var ErrPairingRevocationUnauthorized = errors.New("not authorized to revoke the pairing")
+
+// ErrTensorUpdateUnauthorized is returned when the signer of a score update
+// neither created the tensor nor is the trust anchor of its LCT
+var ErrTensorUpdateUnauthorized = errors.New("not authorized to update the trust tensor")
+
+// ErrInsufficientEnergyBalance is returned when the source of a transfer holds
+// less energy than the operation moves
+var ErrInsufficientEnergyBalance = errors.New("insufficient energy balance")
+
+// ErrEnergyEfficiencyUnavailable is returned for an energy operation whose
+// efficiency cannot be calculated, because it drew no energy or has not
+// recorded the energy it delivered
+var ErrEnergyEfficiencyUnavailable = errors.New("energy efficiency unavailable")
+
+// ErrEnergyNetworkTooLarge is returned when the LCT network reachable from a
+// component is too deep or holds too many components for the chain to balance
+var ErrEnergyNetworkTooLarge = errors.New("energy network too large")
+
+// ErrInvalidOperationalContext is returned for an operational context the
+// lctmanager module cannot normalize
+var ErrInvalidOperationalContext = errors.New("invalid operational context")
+
+// HTTPError is returned by makeRequest when the node answers with a non-200 status
+type HTTPError struct {
+	StatusCode int
+	Body       string
+}
+
+func (e *HTTPError) Error() string {
+	return fmt.Sprintf("HTTP %d: %s", e.StatusCode, e.Body)
+}
+
 // RESTClient represents a blockchain REST client
 type RESTClient struct {
-	baseURL        string
-	client         *http.Client
-	logger         zerolog.Logger
-	accountManager *AccountManager
-	ignitePath     string
-	projectRoot    string
-	racecarCmd     string
+	baseURL         string
+	client          *http.Client
+	logger          zerolog.Logger
+	accountManager  *AccountManager
+	ignitePath      string
+	igniteErr       error // why no Ignite CLI was found at startup
+	projectRoot     string
+	racecarCmd      string
+	txExecutor      TxExecutor
+	retry           config.RetryConfig
+	breaker         *broadcastBreaker // nil broadcasts regardless of earlier failures
+	gas             gasSettings
+	simulateTimeout time.Duration // per gas simulation, 0 for only the caller's deadline
+	keyring         KeyringConfig // keyring the CLI signs with
+	chainID         string        // chain the CLI signs for, "" for defaultChainID
+
+	allowedMsgTypes map[string]bool // @type URLs that may be broadcast, see checkMessageType
+
+	// Split key halves are sealed to componentKeys and handed to keyHalves; nil discards them
+	keyHalves     KeyHalfDelivery
+	componentKeys map[string][32]byte
+
+	// Energy and trust transactions wait this long to be committed, 0 returns after CheckTx; see awaitCommit
+	commitTimeout      time.Duration
+	commitPollInterval time.Duration // 0 polls every defaultCommitPollInterval
+}
+
+// defaultChainID is the chain transactions are signed for when
+// blockchain.chain_id is not configured
+const defaultChainID = "racecarweb"
+
+// signingChainID returns the chain ID transactions are signed for
+func (c *RESTClient) signingChainID() string {
+	if c.chainID == "" {
+		return defaultChainID
+	}
+	return c.chainID
 }
 
 // NewRESTClient creates a new blockchain REST client
@@ -44,6 +154,9 @@ func NewRESTClient(baseURL string, logger zerolog.Logger) *RESTClient {
 	// Initialize paths
 	client.initializePaths()
 
+	// Sign through the CLI binaries unless a native executor is configured
+	client.txExecutor = newCLITxExecutor(client)
+
 	// Test Ignite CLI availability in background
 	go func() {
 		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
@@ -64,8 +177,8 @@ func (c *RESTClient) initializePaths() {
 	// Find the racecar-webd binary
 	c.racecarCmd = c.findRacecarWebd()
 
-	// Find Ignite CLI
-	c.ignitePath = c.findIgniteCLI()
+	// Find Ignite CLI; the path is resolved once and only read afterwards
+	c.ignitePath, c.igniteErr = c.findIgniteCLI()
 
 	c.logger.Info().
 		Str("project_root", c.projectRoot).
@@ -131,18 +244,22 @@ func (c *RESTClient) findRacecarWebd() string {
 	return "racecar-webd" // Fallback to PATH
 }
 
-// findIgniteCLI finds the Ignite CLI executable
-func (c *RESTClient) findIgniteCLI() string {
+// findIgniteCLI finds the Ignite CLI executable. When none is found it
+// returns "ignite", so commands still try PATH, and the reason.
+func (c *RESTClient) findIgniteCLI() (string, error) {
 	// Check for IGNITE_CLI_PATH environment variable first
 	if igniteEnv := os.Getenv("IGNITE_CLI_PATH"); igniteEnv != "" {
 		if _, err := os.Stat(igniteEnv); err == nil {
 			c.logger.Info().Str("ignite_path", igniteEnv).Msg("Found Ignite CLI from IGNITE_CLI_PATH env var")
-			return igniteEnv
+			return igniteEnv, nil
 		}
 	}
+	if path, err := exec.LookPath("ignite"); err == nil {
+		c.logger.Info().Str("ignite_path", path).Msg("Found Ignite CLI in PATH")
+		return path, nil
+	}
 	// Try common paths
 	paths := []string{
-		"ignite",                // In PATH
 		"/usr/local/bin/ignite", // Common Linux location
 		"/usr/bin/ignite",       // Common Linux location
 		"/snap/bin/ignite",      // Snap install location
@@ -158,15 +275,15 @@ func (c *RESTClient) findIgniteCLI() string {
 	for _, path := range paths {
 		if _, err := os.Stat(path); err == nil {
 			c.logger.Info().Str("ignite_path", path).Msg("Found Ignite CLI")
-			return path
+			return path, nil
 		}
 	}
 	c.logger.Warn().Msg("Ignite CLI not found in common paths")
-	return "ignite" // Fallback to PATH
+	return "ignite", errors.New("ignite CLI not found in PATH or common locations")
 }
 
 // makeRequest makes an HTTP request to the blockchain
-func (c *RESTClient) makeRequest(method, endpoint string, body interface{}) ([]byte, error) {
+func (c *RESTClient) makeRequest(ctx context.Context, method, endpoint string, body interface{}) (respBody []byte, err error) {
 	var reqBody io.Reader
 	if body != nil {
 		jsonBody, err := json.Marshal(body)
@@ -174,18 +291,22 @@ func (c *RESTClient) makeRequest(method, endpoint string, body interface{}) ([]b
 			return nil, fmt.Errorf("failed to marshal request body: %w", err)
 		}
 		reqBody = bytes.NewBuffer(jsonBody)
-		c.logger.Debug().Str("body", string(jsonBody)).Msg("Request body")
+		c.log(ctx).Debug().Str("body", string(jsonBody)).Msg("Request body")
 	}
 
 	url := c.baseURL + endpoint
-	req, err := http.NewRequest(method, url, reqBody)
+	// Bound by the caller's deadline, e.g. the handler's query or broadcast timeout
+	req, err := http.NewRequestWithContext(ctx, method, url, reqBody)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
 
 	req.Header.Set("Content-Type", "application/json")
 
-	c.logger.Debug().Str("method", method).Str("url", url).Msg("Making HTTP request")
+	_, span := startRESTSpan(ctx, req, endpoint)
+	defer func() { endSpan(span, err) }()
+
+	c.log(ctx).Debug().Str("method", method).Str("url", url).Msg("Making HTTP request")
 
 	resp, err := c.client.Do(req)
 	if err != nil {
@@ -193,23 +314,39 @@ func (c *RESTClient) makeRequest(method, endpoint string, body interface{}) ([]b
 	}
 	defer resp.Body.Close()
 
-	respBody, err := io.ReadAll(resp.Body)
+	respBody, err = io.ReadAll(resp.Body)
 	if err != nil {
 		return nil, fmt.Errorf("failed to read response body: %w", err)
 	}
 
-	c.logger.Debug().Int("status", resp.StatusCode).Str("response", string(respBody)).Msg("Response received")
+	c.log(ctx).Debug().Int("status", resp.StatusCode).Str("response", string(respBody)).Msg("Response received")
 
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("HTTP %d: %s", resp.StatusCode, string(respBody))
+		return nil, &HTTPError{StatusCode: resp.StatusCode, Body: string(respBody)}
 	}
 
 	return respBody, nil
 }
 
-// RegisterComponent registers a component using REST API
-func (c *RESTClient) RegisterComponent(ctx context.Context, creator, componentData, context string) (map[string]interface{}, error) {
-	c.logger.Info().Str("creator", creator).Msg("Registering component via REST")
+// queryJSON GETs endpoint from the node's REST API and decodes the answer
+// into out. A non-200 answer comes back as an *HTTPError.
+func (c *RESTClient) queryJSON(ctx context.Context, endpoint string, out interface{}) error {
+	respBody, err := c.makeRequest(ctx, "GET", endpoint, nil)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(respBody, out); err != nil {
+		c.log(ctx).Debug().Bytes("response", respBody).Str("endpoint", endpoint).Msg("Unexpected query response")
+		return fmt.Errorf("failed to parse response: %w", err)
+	}
+	return nil
+}
+
+// RegisterComponent registers a component using REST API. With dedupe, a
+// component already registered with the same data is returned instead of a
+// new one, and "created" is false.
+func (c *RESTClient) RegisterComponent(ctx context.Context, creator, componentData, context string, dedupe bool) (map[string]interface{}, error) {
+	c.log(ctx).Info().Str("creator", creator).Bool("dedupe", dedupe).Msg("Registering component via REST")
 
 	// Try to use real blockchain first, fall back to mock if it fails
 	componentID := fmt.Sprintf("COMP-%s-%d", creator, time.Now().Unix())
@@ -219,49 +356,47 @@ func (c *RESTClient) RegisterComponent(ctx context.Context, creator, componentDa
 		"@type":             "/racecarweb.componentregistry.v1.MsgRegisterComponent",
 		"creator":           creator,
 		"component_id":      componentID,
-		"component_type":    "module",
+		"component_type":    registeredComponentType,
 		"manufacturer_data": componentData,
+		"dedupe":            dedupe,
 	}
 
-	// Execute the transaction using Ignite CLI - this must succeed for the demo
-	txResult, err := c.executeTransactionWithIgnite(ctx, message, context)
+	// Execute the transaction - this must succeed for the demo
+	txResult, err := c.executeTransaction(ctx, message, context)
 	if err != nil {
-		c.logger.Error().Err(err).Msg("Ignite CLI transaction failed - this demo requires real blockchain integration")
+		c.log(ctx).Error().Err(err).Msg("Blockchain transaction failed - this demo requires real blockchain integration")
 		return nil, fmt.Errorf("blockchain transaction failed: %w", err)
 	}
 
 	// Check if transaction was successful
-	if code, ok := txResult["code"].(int); ok && code != 0 {
-		c.logger.Error().Int("code", code).Msg("Transaction failed - this demo requires real blockchain integration")
+	if code, ok := txResultCode(txResult); ok && code != 0 {
+		c.log(ctx).Error().Int("code", code).Msg("Transaction failed - this demo requires real blockchain integration")
 		return nil, fmt.Errorf("blockchain transaction failed with code %d", code)
 	}
 
-	// Success! Extract component ID from events
-	txhash := txResult["txhash"].(string)
-	if events, ok := txResult["events"].([]map[string]interface{}); ok {
-		for _, event := range events {
-			if eventType, ok := event["type"].(string); ok && eventType == "component_registered" {
-				if attributes, ok := event["attributes"].([]map[string]interface{}); ok {
-					for _, attr := range attributes {
-						if key, ok := attr["key"].(string); ok && key == "component_id" {
-							if value, ok := attr["value"].(string); ok {
-								componentID = value
-								break
-							}
-						}
-					}
-				}
-			}
-		}
+	// Success! Extract component ID from events; a deduplicated registration
+	// names the component that already existed
+	txhash, _ := txResult["txhash"].(string)
+	event, created, status := "component_lct_created", true, "registered"
+	if _, ok := extractEventAttribute(txResult, "component_deduplicated", "component_id"); ok {
+		event, created, status = "component_deduplicated", false, "already_registered"
+	}
+	if value, ok := extractEventAttribute(txResult, event, "component_id"); ok {
+		componentID = value
+	}
+	lctID, ok := extractEventAttribute(txResult, event, "lct_id")
+	if !ok {
+		lctID = fmt.Sprintf("lct_%s", componentID)
 	}
 
-	c.logger.Info().Str("component_id", componentID).Str("txhash", txhash).Msg("Component registered successfully via blockchain")
+	c.log(ctx).Info().Str("component_id", componentID).Bool("created", created).Str("txhash", txhash).Msg("Component registered successfully via blockchain")
 
 	return map[string]interface{}{
 		"component_id":       componentID,
 		"component_identity": componentID,
-		"lct_id":             fmt.Sprintf("lct_%s", componentID),
-		"status":             "registered",
+		"lct_id":             lctID,
+		"status":             status,
+		"created":            created,
 		"txhash":             txhash,
 		"creator":            creator,
 		"component_data":     componentData,
@@ -269,71 +404,454 @@ func (c *RESTClient) RegisterComponent(ctx context.Context, creator, componentDa
 	}, nil
 }
 
+// ComponentSearchFilter selects components by their anonymous hashes; at
+// least one hash is required and an empty status matches every status
+type ComponentSearchFilter struct {
+	ManufacturerHash string
+	CategoryHash     string
+	Status           string
+}
+
+// anonymousComponentFields are the fields of a privacy-focused registration;
+// searches return only these
+var anonymousComponentFields = []string{
+	"component_id", "manufacturer_hash", "category_hash", "authorization_rules_hash",
+	"status", "registered_at", "last_verified_at", "trust_anchor", "lct_hash",
+}
+
+// SearchComponents retrieves one page of the anonymously registered
+// components matching filter. With a manufacturer hash the chain reads that
+// manufacturer's index; with only a category hash it walks the registry, and a
+// page may then hold fewer than limit components while next_key is still set.
+func (c *RESTClient) SearchComponents(ctx context.Context, filter ComponentSearchFilter, limit uint64, key string) (map[string]interface{}, error) {
+	c.log(ctx).Info().Str("manufacturer_hash", filter.ManufacturerHash).Str("category_hash", filter.CategoryHash).Str("status", filter.Status).Uint64("limit", limit).Msg("Searching components via REST")
+
+	params := url.Values{}
+	if filter.ManufacturerHash != "" {
+		params.Set("manufacturer_hash", filter.ManufacturerHash)
+	}
+	if filter.CategoryHash != "" {
+		params.Set("category_hash", filter.CategoryHash)
+	}
+	if filter.Status != "" {
+		params.Set("status", filter.Status)
+	}
+	if limit > 0 {
+		params.Set("pagination.limit", strconv.FormatUint(limit, 10))
+	}
+	if key != "" {
+		params.Set("pagination.key", key)
+	}
+
+	var response struct {
+		Components []map[string]interface{} `json:"components"`
+		Pagination struct {
+			NextKey string `json:"next_key"`
+		} `json:"pagination"`
+	}
+	if err := c.queryJSON(ctx, "/racecar-web/componentregistry/v1/components/search?"+params.Encode(), &response); err != nil {
+		return nil, fmt.Errorf("failed to search components: %w", err)
+	}
+
+	components := make([]map[string]interface{}, 0, len(response.Components))
+	for _, component := range response.Components {
+		anonymized := make(map[string]interface{}, len(anonymousComponentFields))
+		for _, field := range anonymousComponentFields {
+			if value, ok := component[field]; ok {
+				anonymized[field] = value
+			}
+		}
+		components = append(components, anonymized)
+	}
+
+	return map[string]interface{}{
+		"components": components,
+		"count":      len(components),
+		"next_key":   response.Pagination.NextKey,
+	}, nil
+}
+
 // GetComponent retrieves a component using REST API
 func (c *RESTClient) GetComponent(ctx context.Context, componentID string) (map[string]interface{}, error) {
-	c.logger.Info().Str("component_id", componentID).Msg("Getting component via REST")
+	c.log(ctx).Info().Str("component_id", componentID).Msg("Getting component via REST")
 
-	// Make the request to the blockchain
-	respBody, err := c.makeRequest("GET", fmt.Sprintf("/racecar-web/componentregistry/v1/get_component/%s", componentID), nil)
-	if err != nil {
+	var response struct {
+		Component map[string]interface{} `json:"component"`
+	}
+	if err := c.queryJSON(ctx, fmt.Sprintf("/racecar-web/componentregistry/v1/get_component/%s", componentID), &response); err != nil {
 		return nil, fmt.Errorf("failed to get component: %w", err)
 	}
+	if response.Component == nil {
+		return nil, fmt.Errorf("invalid response format: component not found")
+	}
 
-	// Parse the response
-	var response map[string]interface{}
-	if err := json.Unmarshal(respBody, &response); err != nil {
-		return nil, fmt.Errorf("failed to parse response: %w", err)
+	return response.Component, nil
+}
+
+// ListComponents retrieves one page of registered components. key is the
+// base64 next_key returned with the previous page.
+func (c *RESTClient) ListComponents(ctx context.Context, limit uint64, key string, countTotal bool) (map[string]interface{}, error) {
+	c.log(ctx).Info().Uint64("limit", limit).Str("key", key).Msg("Listing components via REST")
+
+	params := url.Values{}
+	if limit > 0 {
+		params.Set("pagination.limit", strconv.FormatUint(limit, 10))
+	}
+	if key != "" {
+		params.Set("pagination.key", key)
+	}
+	if countTotal {
+		params.Set("pagination.count_total", "true")
 	}
 
-	// Extract component data
-	component, ok := response["component"].(map[string]interface{})
-	if !ok {
-		return nil, fmt.Errorf("invalid response format: component not found")
+	endpoint := "/racecar-web/componentregistry/v1/components"
+	if len(params) > 0 {
+		endpoint += "?" + params.Encode()
+	}
+
+	var response struct {
+		Components []map[string]interface{} `json:"components"`
+		Pagination struct {
+			NextKey string `json:"next_key"`
+			Total   string `json:"total"`
+		} `json:"pagination"`
+	}
+	if err := c.queryJSON(ctx, endpoint, &response); err != nil {
+		return nil, fmt.Errorf("failed to list components: %w", err)
+	}
+
+	// The chain omits empty repeated fields, so normalize to an empty list
+	if response.Components == nil {
+		response.Components = []map[string]interface{}{}
+	}
+
+	result := map[string]interface{}{
+		"components": response.Components,
+		"next_key":   response.Pagination.NextKey,
+	}
+	if countTotal {
+		total, err := strconv.ParseUint(response.Pagination.Total, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid component total %q: %w", response.Pagination.Total, err)
+		}
+		result["total"] = total
+	}
+
+	return result, nil
+}
+
+// GetComponentHealth retrieves the fleet-wide component health report. A zero
+// staleAfter uses the chain's default cutoff.
+func (c *RESTClient) GetComponentHealth(ctx context.Context, staleAfter time.Duration) (map[string]interface{}, error) {
+	c.log(ctx).Info().Dur("stale_after", staleAfter).Msg("Getting component health via REST")
+
+	endpoint := "/racecar-web/componentregistry/v1/component_health"
+	if staleAfter > 0 {
+		endpoint += "?stale_after_seconds=" + strconv.FormatInt(int64(staleAfter/time.Second), 10)
+	}
+
+	var response struct {
+		Summary  map[string]interface{}   `json:"summary"`
+		Problems []map[string]interface{} `json:"problems"`
+	}
+	if err := c.queryJSON(ctx, endpoint, &response); err != nil {
+		return nil, fmt.Errorf("failed to get component health: %w", err)
+	}
+
+	// The chain encodes uint64 counts as strings and omits empty lists
+	summary := make(map[string]uint64)
+	for _, field := range []string{"total", "active", "stale", "revoked", "unverified", "failed_verification"} {
+		raw, _ := response.Summary[field].(string)
+		if raw == "" {
+			summary[field] = 0
+			continue
+		}
+		count, err := strconv.ParseUint(raw, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s count %q: %w", field, raw, err)
+		}
+		summary[field] = count
+	}
+	if response.Problems == nil {
+		response.Problems = []map[string]interface{}{}
+	}
+
+	return map[string]interface{}{
+		"summary":  summary,
+		"problems": response.Problems,
+	}, nil
+}
+
+// GetRevocationEvents retrieves one page of revocation events. An empty
+// targetHash lists every target; from and to are inclusive unix-second bounds,
+// with zero leaving that side open.
+func (c *RESTClient) GetRevocationEvents(ctx context.Context, targetHash string, from, to int64, limit uint64, key string) (map[string]interface{}, error) {
+	c.log(ctx).Info().Str("target_hash", targetHash).Int64("from", from).Int64("to", to).Msg("Getting revocation events via REST")
+
+	params := url.Values{}
+	if targetHash != "" {
+		params.Set("target_hash", targetHash)
+	}
+	if from != 0 {
+		params.Set("from", strconv.FormatInt(from, 10))
+	}
+	if to != 0 {
+		params.Set("to", strconv.FormatInt(to, 10))
+	}
+	if limit > 0 {
+		params.Set("pagination.limit", strconv.FormatUint(limit, 10))
+	}
+	if key != "" {
+		params.Set("pagination.key", key)
 	}
 
-	return component, nil
+	endpoint := "/racecar-web/componentregistry/v1/revocations"
+	if len(params) > 0 {
+		endpoint += "?" + params.Encode()
+	}
+
+	var response struct {
+		Events     []map[string]interface{} `json:"events"`
+		Pagination struct {
+			NextKey string `json:"next_key"`
+		} `json:"pagination"`
+	}
+	if err := c.queryJSON(ctx, endpoint, &response); err != nil {
+		return nil, fmt.Errorf("failed to get revocation events: %w", err)
+	}
+
+	// The chain omits empty repeated fields, so normalize to an empty list
+	if response.Events == nil {
+		response.Events = []map[string]interface{}{}
+	}
+
+	return map[string]interface{}{
+		"target_hash": targetHash,
+		"revocations": response.Events,
+		"count":       len(response.Events),
+		"next_key":    response.Pagination.NextKey,
+	}, nil
 }
 
 // GetComponentIdentity retrieves component identity using REST API
 func (c *RESTClient) GetComponentIdentity(ctx context.Context, componentID string) (map[string]interface{}, error) {
-	c.logger.Info().Str("component_id", componentID).Msg("Getting component identity via REST")
+	c.log(ctx).Info().Str("component_id", componentID).Msg("Getting component identity via REST")
 
-	// Make the request to the blockchain
-	respBody, err := c.makeRequest("GET", fmt.Sprintf("/racecar-web/componentregistry/v1/get_component_verification/%s", componentID), nil)
-	if err != nil {
+	var response struct {
+		Verification map[string]interface{} `json:"verification"`
+	}
+	if err := c.queryJSON(ctx, fmt.Sprintf("/racecar-web/componentregistry/v1/get_component_verification/%s", componentID), &response); err != nil {
 		return nil, fmt.Errorf("failed to get component identity: %w", err)
 	}
+	verification := response.Verification
+	if verification == nil {
+		return nil, fmt.Errorf("invalid response format: verification not found")
+	}
+
+	return map[string]interface{}{
+		"component_id": componentID,
+		"identity":     componentID,
+		"verified":     verification["status"] == "VERIFICATION_STATUS_VERIFIED",
+		"verification": verification,
+	}, nil
+}
+
+// GetComponentHistory retrieves the update diffs recorded for a component
+func (c *RESTClient) GetComponentHistory(ctx context.Context, componentID string) (map[string]interface{}, error) {
+	c.log(ctx).Info().Str("component_id", componentID).Msg("Getting component history via REST")
 
-	// Parse the response
 	var response map[string]interface{}
-	if err := json.Unmarshal(respBody, &response); err != nil {
-		return nil, fmt.Errorf("failed to parse response: %w", err)
+	if err := c.queryJSON(ctx, fmt.Sprintf("/racecar-web/componentregistry/v1/get_component_history/%s", componentID), &response); err != nil {
+		return nil, fmt.Errorf("failed to get component history: %w", err)
 	}
 
-	// Extract verification data
-	verification, ok := response["verification"].(map[string]interface{})
+	// The chain omits empty repeated fields, so normalize to an empty list
+	entries, ok := response["entries"].([]interface{})
 	if !ok {
-		return nil, fmt.Errorf("invalid response format: verification not found")
+		entries = []interface{}{}
 	}
 
 	return map[string]interface{}{
 		"component_id": componentID,
-		"identity":     componentID,
-		"verified":     verification["status"] == "VERIFICATION_STATUS_VERIFIED",
-		"verification": verification,
+		"entries":      entries,
+		"count":        len(entries),
+	}, nil
+}
+
+// CustodyEvent is one change of the account holding a component
+type CustodyEvent struct {
+	Sequence      uint64    `json:"sequence"`
+	FromOwner     string    `json:"from_owner"` // empty for the registration
+	ToOwner       string    `json:"to_owner"`
+	Reason        string    `json:"reason"`
+	TransferredAt time.Time `json:"transferred_at"`
+	BlockHeight   int64     `json:"block_height"`
+}
+
+// GetCustodyChain retrieves the owners a component has passed through, oldest first
+func (c *RESTClient) GetCustodyChain(ctx context.Context, componentID string) ([]CustodyEvent, error) {
+	c.log(ctx).Info().Str("component_id", componentID).Msg("Getting custody chain via REST")
+
+	// The chain encodes 64-bit integers as strings and omits empty fields
+	var response struct {
+		Events []struct {
+			Sequence      string    `json:"sequence"`
+			FromOwner     string    `json:"from_owner"`
+			ToOwner       string    `json:"to_owner"`
+			Reason        string    `json:"reason"`
+			TransferredAt time.Time `json:"transferred_at"`
+			BlockHeight   string    `json:"block_height"`
+		} `json:"events"`
+	}
+	if err := c.queryJSON(ctx, "/racecar-web/componentregistry/v1/custody/"+url.PathEscape(componentID), &response); err != nil {
+		var httpErr *HTTPError
+		if errors.As(err, &httpErr) && httpErr.StatusCode == http.StatusNotFound {
+			return nil, fmt.Errorf("%w: %s", ErrComponentNotFound, componentID)
+		}
+		return nil, fmt.Errorf("failed to get custody chain: %w", err)
+	}
+
+	chain := make([]CustodyEvent, 0, len(response.Events))
+	for _, event := range response.Events {
+		sequence, err := strconv.ParseUint(event.Sequence, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid custody sequence %q: %w", event.Sequence, err)
+		}
+		var height int64
+		if event.BlockHeight != "" {
+			if height, err = strconv.ParseInt(event.BlockHeight, 10, 64); err != nil {
+				return nil, fmt.Errorf("invalid custody block height %q: %w", event.BlockHeight, err)
+			}
+		}
+		chain = append(chain, CustodyEvent{
+			Sequence:      sequence,
+			FromOwner:     event.FromOwner,
+			ToOwner:       event.ToOwner,
+			Reason:        event.Reason,
+			TransferredAt: event.TransferredAt,
+			BlockHeight:   height,
+		})
+	}
+
+	return chain, nil
+}
+
+// VerificationRecord is one recorded verification of a component
+type VerificationRecord struct {
+	Sequence    uint64    `json:"sequence"`
+	Verifier    string    `json:"verifier"`
+	Result      string    `json:"result"` // verified, or failed_inactive
+	Context     string    `json:"context"`
+	VerifiedAt  time.Time `json:"verified_at"`
+	BlockHeight int64     `json:"block_height"`
+}
+
+// GetVerificationHistory retrieves one page of a component's verifications,
+// oldest first
+func (c *RESTClient) GetVerificationHistory(ctx context.Context, componentID string, limit uint64, key string) (map[string]interface{}, error) {
+	c.log(ctx).Info().Str("component_id", componentID).Uint64("limit", limit).Msg("Getting verification history via REST")
+
+	params := url.Values{}
+	if limit > 0 {
+		params.Set("pagination.limit", strconv.FormatUint(limit, 10))
+	}
+	if key != "" {
+		params.Set("pagination.key", key)
+	}
+	endpoint := "/racecar-web/componentregistry/v1/verifications/" + url.PathEscape(componentID)
+	if len(params) > 0 {
+		endpoint += "?" + params.Encode()
+	}
+
+	// The chain encodes 64-bit integers as strings and omits empty fields
+	var response struct {
+		Verifications []struct {
+			Sequence    string    `json:"sequence"`
+			Verifier    string    `json:"verifier"`
+			Result      string    `json:"result"`
+			Context     string    `json:"context"`
+			VerifiedAt  time.Time `json:"verified_at"`
+			BlockHeight string    `json:"block_height"`
+		} `json:"verifications"`
+		Pagination struct {
+			NextKey string `json:"next_key"`
+		} `json:"pagination"`
+	}
+	if err := c.queryJSON(ctx, endpoint, &response); err != nil {
+		var httpErr *HTTPError
+		if errors.As(err, &httpErr) && httpErr.StatusCode == http.StatusNotFound {
+			return nil, fmt.Errorf("%w: %s", ErrComponentNotFound, componentID)
+		}
+		return nil, fmt.Errorf("failed to get verification history: %w", err)
+	}
+
+	records := make([]VerificationRecord, 0, len(response.Verifications))
+	for _, record := range response.Verifications {
+		sequence, err := strconv.ParseUint(record.Sequence, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid verification sequence %q: %w", record.Sequence, err)
+		}
+		var height int64
+		if record.BlockHeight != "" {
+			if height, err = strconv.ParseInt(record.BlockHeight, 10, 64); err != nil {
+				return nil, fmt.Errorf("invalid verification block height %q: %w", record.BlockHeight, err)
+			}
+		}
+		records = append(records, VerificationRecord{
+			Sequence:    sequence,
+			Verifier:    record.Verifier,
+			Result:      record.Result,
+			Context:     record.Context,
+			VerifiedAt:  record.VerifiedAt,
+			BlockHeight: height,
+		})
+	}
+
+	return map[string]interface{}{
+		"component_id":  componentID,
+		"verifications": records,
+		"count":         len(records),
+		"next_key":      response.Pagination.NextKey,
 	}, nil
 }
 
 // VerifyComponent verifies a component using REST API
 func (c *RESTClient) VerifyComponent(ctx context.Context, verifier, componentID, context string) (map[string]interface{}, error) {
-	c.logger.Info().Str("verifier", verifier).Str("component_id", componentID).Msg("Verifying component via REST")
+	c.log(ctx).Info().Str("verifier", verifier).Str("component_id", componentID).Msg("Verifying component via REST")
+
+	// Create the transaction message for component verification
+	message := map[string]interface{}{
+		"@type":        "/racecarweb.componentregistry.v1.MsgVerifyComponent",
+		"creator":      verifier,
+		"component_id": componentID,
+		"context":      context,
+	}
+
+	txResult, err := c.executeTransaction(ctx, message, context)
+	if err != nil {
+		c.log(ctx).Error().Err(err).Msg("Blockchain transaction failed for component verification")
+		return nil, fmt.Errorf("blockchain transaction failed: %w", err)
+	}
+
+	if code, ok := txResult["code"].(int); ok && code != 0 {
+		c.log(ctx).Error().Int("code", code).Msg("Transaction failed for component verification")
+		return nil, fmt.Errorf("blockchain transaction failed with code %d", code)
+	}
+
+	// The chain records a verification and emits the event only for components
+	// it knows; an unknown component leaves the tx without one
+	status := "unverified"
+	if value, ok := extractTypedEventAttribute(txResult, "racecarweb.componentregistry.v1.EventComponentVerified", "status"); ok {
+		status = value
+	}
 
 	return map[string]interface{}{
-		"component_id":   componentID,
-		"verifier":       verifier,
-		"verified":       true,
-		"component_data": "verified_data",
-		"timestamp":      time.Now().Unix(),
+		"component_id": componentID,
+		"verifier":     verifier,
+		"verified":     status == "verified",
+		"status":       status,
+		"txhash":       txResult["txhash"],
+		"timestamp":    time.Now().Unix(),
 	}, nil
 }
 
@@ -341,7 +859,7 @@ func (c *RESTClient) VerifyComponent(ctx context.Context, verifier, componentID,
 
 // RegisterAnonymousComponent registers a component anonymously using hashes via REST API
 func (c *RESTClient) RegisterAnonymousComponent(ctx context.Context, creator, realComponentID, manufacturerID, componentType, context string) (map[string]interface{}, error) {
-	c.logger.Info().Str("creator", creator).Str("real_component_id", realComponentID).Str("manufacturer_id", manufacturerID).Str("component_type", componentType).Msg("Registering anonymous component via REST")
+	c.log(ctx).Info().Str("creator", creator).Str("real_component_id", realComponentID).Str("manufacturer_id", manufacturerID).Str("component_type", componentType).Msg("Registering anonymous component via REST")
 
 	// Create the transaction message for anonymous component registration
 	message := map[string]interface{}{
@@ -353,16 +871,16 @@ func (c *RESTClient) RegisterAnonymousComponent(ctx context.Context, creator, re
 		"context":           context,
 	}
 
-	// Execute the transaction using Ignite CLI
-	txResult, err := c.executeTransactionWithIgnite(ctx, message, "anonymous_component_registration")
+	// Execute the transaction
+	txResult, err := c.executeTransaction(ctx, message, "anonymous_component_registration")
 	if err != nil {
-		c.logger.Error().Err(err).Msg("Ignite CLI transaction failed for anonymous component registration")
+		c.log(ctx).Error().Err(err).Msg("Blockchain transaction failed for anonymous component registration")
 		return nil, fmt.Errorf("blockchain transaction failed: %w", err)
 	}
 
 	// Check if transaction was successful
 	if code, ok := txResult["code"].(int); ok && code != 0 {
-		c.logger.Error().Int("code", code).Msg("Transaction failed for anonymous component registration")
+		c.log(ctx).Error().Int("code", code).Msg("Transaction failed for anonymous component registration")
 		return nil, fmt.Errorf("blockchain transaction failed with code %d", code)
 	}
 
@@ -372,30 +890,17 @@ func (c *RESTClient) RegisterAnonymousComponent(ctx context.Context, creator, re
 	manufacturerHash := fmt.Sprintf("hash_%x", sha256.Sum256([]byte(manufacturerID)))
 	categoryHash := fmt.Sprintf("hash_%x", sha256.Sum256([]byte(componentType)))
 
-	if events, ok := txResult["events"].([]map[string]interface{}); ok {
-		for _, event := range events {
-			if eventType, ok := event["type"].(string); ok && eventType == "anonymous_component_registered" {
-				if attributes, ok := event["attributes"].([]map[string]interface{}); ok {
-					for _, attr := range attributes {
-						if key, ok := attr["key"].(string); ok {
-							if value, ok := attr["value"].(string); ok {
-								switch key {
-								case "component_hash":
-									componentHash = value
-								case "manufacturer_hash":
-									manufacturerHash = value
-								case "category_hash":
-									categoryHash = value
-								}
-							}
-						}
-					}
-				}
-			}
-		}
+	if value, ok := extractEventAttribute(txResult, "anonymous_component_registered", "component_hash"); ok {
+		componentHash = value
+	}
+	if value, ok := extractEventAttribute(txResult, "anonymous_component_registered", "manufacturer_hash"); ok {
+		manufacturerHash = value
+	}
+	if value, ok := extractEventAttribute(txResult, "anonymous_component_registered", "category_hash"); ok {
+		categoryHash = value
 	}
 
-	c.logger.Info().Str("component_hash", componentHash).Str("txhash", txhash).Msg("Anonymous component registered successfully via blockchain")
+	c.log(ctx).Info().Str("component_hash", componentHash).Str("txhash", txhash).Msg("Anonymous component registered successfully via blockchain")
 
 	return map[string]interface{}{
 		"component_hash":    componentHash,
@@ -408,9 +913,18 @@ func (c *RESTClient) RegisterAnonymousComponent(ctx context.Context, creator, re
 	}, nil
 }
 
-// VerifyComponentPairingWithHashes verifies component pairing using hashes via REST API
-func (c *RESTClient) VerifyComponentPairingWithHashes(ctx context.Context, verifier, componentHashA, componentHashB, context string) (map[string]interface{}, error) {
-	c.logger.Info().Str("verifier", verifier).Str("component_hash_a", componentHashA).Str("component_hash_b", componentHashB).Msg("Verifying component pairing with hashes via REST")
+// PairingAttestation is a pairing verdict fetched off-chain, which the chain
+// accepts in place of its own check when the verifier is one of its
+// verification attestors
+type PairingAttestation struct {
+	CanPair bool
+	Reason  string
+}
+
+// VerifyComponentPairingWithHashes verifies component pairing using hashes via
+// REST API; a nil attestation leaves the verdict to the chain
+func (c *RESTClient) VerifyComponentPairingWithHashes(ctx context.Context, verifier, componentHashA, componentHashB, context string, attestation *PairingAttestation) (map[string]interface{}, error) {
+	c.log(ctx).Info().Str("verifier", verifier).Str("component_hash_a", componentHashA).Str("component_hash_b", componentHashB).Msg("Verifying component pairing with hashes via REST")
 
 	// Create the transaction message for pairing verification with hashes
 	message := map[string]interface{}{
@@ -420,17 +934,23 @@ func (c *RESTClient) VerifyComponentPairingWithHashes(ctx context.Context, verif
 		"component_hash_b": componentHashB,
 		"context":          context,
 	}
+	if attestation != nil {
+		message["attestation"] = map[string]interface{}{
+			"can_pair": attestation.CanPair,
+			"reason":   attestation.Reason,
+		}
+	}
 
-	// Execute the transaction using Ignite CLI
-	txResult, err := c.executeTransactionWithIgnite(ctx, message, "pairing_verification_with_hashes")
+	// Execute the transaction
+	txResult, err := c.executeTransaction(ctx, message, "pairing_verification_with_hashes")
 	if err != nil {
-		c.logger.Error().Err(err).Msg("Ignite CLI transaction failed for pairing verification with hashes")
+		c.log(ctx).Error().Err(err).Msg("Blockchain transaction failed for pairing verification with hashes")
 		return nil, fmt.Errorf("blockchain transaction failed: %w", err)
 	}
 
 	// Check if transaction was successful
 	if code, ok := txResult["code"].(int); ok && code != 0 {
-		c.logger.Error().Int("code", code).Msg("Transaction failed for pairing verification with hashes")
+		c.log(ctx).Error().Int("code", code).Msg("Transaction failed for pairing verification with hashes")
 		return nil, fmt.Errorf("blockchain transaction failed with code %d", code)
 	}
 
@@ -440,31 +960,18 @@ func (c *RESTClient) VerifyComponentPairingWithHashes(ctx context.Context, verif
 	reason := "pairing verification failed"
 	trustScore := "0.0"
 
-	if events, ok := txResult["events"].([]map[string]interface{}); ok {
-		for _, event := range events {
-			if eventType, ok := event["type"].(string); ok && eventType == "component_verified" {
-				if attributes, ok := event["attributes"].([]map[string]interface{}); ok {
-					for _, attr := range attributes {
-						if key, ok := attr["key"].(string); ok {
-							if value, ok := attr["value"].(string); ok {
-								switch key {
-								case "status":
-									if value == "pairing_verified" {
-										canPair = true
-										reason = "pairing allowed: components are compatible"
-									}
-								case "trust_score":
-									trustScore = value
-								}
-							}
-						}
-					}
-				}
-			}
-		}
+	if value, ok := extractEventAttribute(txResult, "component_verified", "status"); ok && value == "pairing_verified" {
+		canPair = true
+		reason = "pairing allowed: components are compatible"
+	}
+	if value, ok := extractEventAttribute(txResult, "component_verified", "trust_score"); ok {
+		trustScore = value
+	}
+	if attestation != nil {
+		canPair, reason = attestation.CanPair, attestation.Reason
 	}
 
-	c.logger.Info().Bool("can_pair", canPair).Str("txhash", txhash).Msg("Component pairing verification completed via blockchain")
+	c.log(ctx).Info().Bool("can_pair", canPair).Str("txhash", txhash).Msg("Component pairing verification completed via blockchain")
 
 	return map[string]interface{}{
 		"can_pair":    canPair,
@@ -477,7 +984,7 @@ func (c *RESTClient) VerifyComponentPairingWithHashes(ctx context.Context, verif
 
 // CreateAnonymousPairingAuthorization creates anonymous pairing authorization via REST API
 func (c *RESTClient) CreateAnonymousPairingAuthorization(ctx context.Context, creator, componentHashA, componentHashB, ruleHash, trustScoreRequirement, authorizationLevel string) (map[string]interface{}, error) {
-	c.logger.Info().Str("creator", creator).Str("component_hash_a", componentHashA).Str("component_hash_b", componentHashB).Msg("Creating anonymous pairing authorization via REST")
+	c.log(ctx).Info().Str("creator", creator).Str("component_hash_a", componentHashA).Str("component_hash_b", componentHashB).Msg("Creating anonymous pairing authorization via REST")
 
 	// Create the transaction message for anonymous pairing authorization
 	message := map[string]interface{}{
@@ -490,16 +997,16 @@ func (c *RESTClient) CreateAnonymousPairingAuthorization(ctx context.Context, cr
 		"authorization_level":     authorizationLevel,
 	}
 
-	// Execute the transaction using Ignite CLI
-	txResult, err := c.executeTransactionWithIgnite(ctx, message, "anonymous_pairing_authorization")
+	// Execute the transaction
+	txResult, err := c.executeTransaction(ctx, message, "anonymous_pairing_authorization")
 	if err != nil {
-		c.logger.Error().Err(err).Msg("Ignite CLI transaction failed for anonymous pairing authorization")
+		c.log(ctx).Error().Err(err).Msg("Blockchain transaction failed for anonymous pairing authorization")
 		return nil, fmt.Errorf("blockchain transaction failed: %w", err)
 	}
 
 	// Check if transaction was successful
 	if code, ok := txResult["code"].(int); ok && code != 0 {
-		c.logger.Error().Int("code", code).Msg("Transaction failed for anonymous pairing authorization")
+		c.log(ctx).Error().Int("code", code).Msg("Transaction failed for anonymous pairing authorization")
 		return nil, fmt.Errorf("blockchain transaction failed with code %d", code)
 	}
 
@@ -508,28 +1015,14 @@ func (c *RESTClient) CreateAnonymousPairingAuthorization(ctx context.Context, cr
 	authID := fmt.Sprintf("auth_%x", sha256.Sum256([]byte(fmt.Sprintf("%s-%s-%d", componentHashA, componentHashB, time.Now().Unix()))))
 	expiresAt := time.Now().AddDate(1, 0, 0).Format("2006-01-02T15:04:05Z")
 
-	if events, ok := txResult["events"].([]map[string]interface{}); ok {
-		for _, event := range events {
-			if eventType, ok := event["type"].(string); ok && eventType == "anonymous_pairing_authorized" {
-				if attributes, ok := event["attributes"].([]map[string]interface{}); ok {
-					for _, attr := range attributes {
-						if key, ok := attr["key"].(string); ok {
-							if value, ok := attr["value"].(string); ok {
-								switch key {
-								case "auth_id":
-									authID = value
-								case "expires_at":
-									expiresAt = value
-								}
-							}
-						}
-					}
-				}
-			}
-		}
+	if value, ok := extractEventAttribute(txResult, "anonymous_pairing_authorized", "auth_id"); ok {
+		authID = value
+	}
+	if value, ok := extractEventAttribute(txResult, "anonymous_pairing_authorized", "expires_at"); ok {
+		expiresAt = value
 	}
 
-	c.logger.Info().Str("auth_id", authID).Str("txhash", txhash).Msg("Anonymous pairing authorization created successfully via blockchain")
+	c.log(ctx).Info().Str("auth_id", authID).Str("txhash", txhash).Msg("Anonymous pairing authorization created successfully via blockchain")
 
 	return map[string]interface{}{
 		"auth_id":    authID,
@@ -542,7 +1035,7 @@ func (c *RESTClient) CreateAnonymousPairingAuthorization(ctx context.Context, cr
 
 // CreateAnonymousRevocationEvent creates anonymous revocation event via REST API
 func (c *RESTClient) CreateAnonymousRevocationEvent(ctx context.Context, creator, targetHash, revocationType, urgencyLevel, reasonCategory, context string) (map[string]interface{}, error) {
-	c.logger.Info().Str("creator", creator).Str("target_hash", targetHash).Str("revocation_type", revocationType).Msg("Creating anonymous revocation event via REST")
+	c.log(ctx).Info().Str("creator", creator).Str("target_hash", targetHash).Str("revocation_type", revocationType).Msg("Creating anonymous revocation event via REST")
 
 	// Create the transaction message for anonymous revocation event
 	message := map[string]interface{}{
@@ -555,16 +1048,16 @@ func (c *RESTClient) CreateAnonymousRevocationEvent(ctx context.Context, creator
 		"context":         context,
 	}
 
-	// Execute the transaction using Ignite CLI
-	txResult, err := c.executeTransactionWithIgnite(ctx, message, "anonymous_revocation_event")
+	// Execute the transaction
+	txResult, err := c.executeTransaction(ctx, message, "anonymous_revocation_event")
 	if err != nil {
-		c.logger.Error().Err(err).Msg("Ignite CLI transaction failed for anonymous revocation event")
+		c.log(ctx).Error().Err(err).Msg("Blockchain transaction failed for anonymous revocation event")
 		return nil, fmt.Errorf("blockchain transaction failed: %w", err)
 	}
 
 	// Check if transaction was successful
 	if code, ok := txResult["code"].(int); ok && code != 0 {
-		c.logger.Error().Int("code", code).Msg("Transaction failed for anonymous revocation event")
+		c.log(ctx).Error().Int("code", code).Msg("Transaction failed for anonymous revocation event")
 		return nil, fmt.Errorf("blockchain transaction failed with code %d", code)
 	}
 
@@ -573,28 +1066,14 @@ func (c *RESTClient) CreateAnonymousRevocationEvent(ctx context.Context, creator
 	revocationID := fmt.Sprintf("revoke_%x", sha256.Sum256([]byte(fmt.Sprintf("%s-%s-%d", targetHash, creator, time.Now().Unix()))))
 	effectiveAt := time.Now().Format("2006-01-02T15:04:05Z")
 
-	if events, ok := txResult["events"].([]map[string]interface{}); ok {
-		for _, event := range events {
-			if eventType, ok := event["type"].(string); ok && eventType == "anonymous_revocation_created" {
-				if attributes, ok := event["attributes"].([]map[string]interface{}); ok {
-					for _, attr := range attributes {
-						if key, ok := attr["key"].(string); ok {
-							if value, ok := attr["value"].(string); ok {
-								switch key {
-								case "revocation_id":
-									revocationID = value
-								case "effective_at":
-									effectiveAt = value
-								}
-							}
-						}
-					}
-				}
-			}
-		}
+	if value, ok := extractEventAttribute(txResult, "anonymous_revocation_created", "revocation_id"); ok {
+		revocationID = value
+	}
+	if value, ok := extractEventAttribute(txResult, "anonymous_revocation_created", "effective_at"); ok {
+		effectiveAt = value
 	}
 
-	c.logger.Info().Str("revocation_id", revocationID).Str("txhash", txhash).Msg("Anonymous revocation event created successfully via blockchain")
+	c.log(ctx).Info().Str("revocation_id", revocationID).Str("txhash", txhash).Msg("Anonymous revocation event created successfully via blockchain")
 
 	return map[string]interface{}{
 		"revocation_id": revocationID,
@@ -607,7 +1086,7 @@ func (c *RESTClient) CreateAnonymousRevocationEvent(ctx context.Context, creator
 
 // GetAnonymousComponentMetadata retrieves anonymous component metadata via REST API
 func (c *RESTClient) GetAnonymousComponentMetadata(ctx context.Context, requester, componentHash string) (map[string]interface{}, error) {
-	c.logger.Info().Str("requester", requester).Str("component_hash", componentHash).Msg("Getting anonymous component metadata via REST")
+	c.log(ctx).Info().Str("requester", requester).Str("component_hash", componentHash).Msg("Getting anonymous component metadata via REST")
 
 	// Create the transaction message for getting anonymous component metadata
 	message := map[string]interface{}{
@@ -616,16 +1095,16 @@ func (c *RESTClient) GetAnonymousComponentMetadata(ctx context.Context, requeste
 		"component_hash": componentHash,
 	}
 
-	// Execute the transaction using Ignite CLI
-	txResult, err := c.executeTransactionWithIgnite(ctx, message, "get_anonymous_component_metadata")
+	// Execute the transaction
+	txResult, err := c.executeTransaction(ctx, message, "get_anonymous_component_metadata")
 	if err != nil {
-		c.logger.Error().Err(err).Msg("Ignite CLI transaction failed for getting anonymous component metadata")
+		c.log(ctx).Error().Err(err).Msg("Blockchain transaction failed for getting anonymous component metadata")
 		return nil, fmt.Errorf("blockchain transaction failed: %w", err)
 	}
 
 	// Check if transaction was successful
 	if code, ok := txResult["code"].(int); ok && code != 0 {
-		c.logger.Error().Int("code", code).Msg("Transaction failed for getting anonymous component metadata")
+		c.log(ctx).Error().Int("code", code).Msg("Transaction failed for getting anonymous component metadata")
 		return nil, fmt.Errorf("blockchain transaction failed with code %d", code)
 	}
 
@@ -636,32 +1115,20 @@ func (c *RESTClient) GetAnonymousComponentMetadata(ctx context.Context, requeste
 	trustAnchor := "unknown"
 	lastVerified := time.Now().Format("2006-01-02T15:04:05Z")
 
-	if events, ok := txResult["events"].([]map[string]interface{}); ok {
-		for _, event := range events {
-			if eventType, ok := event["type"].(string); ok && eventType == "anonymous_component_metadata_retrieved" {
-				if attributes, ok := event["attributes"].([]map[string]interface{}); ok {
-					for _, attr := range attributes {
-						if key, ok := attr["key"].(string); ok {
-							if value, ok := attr["value"].(string); ok {
-								switch key {
-								case "type":
-									componentType = value
-								case "status":
-									status = value
-								case "trust_anchor":
-									trustAnchor = value
-								case "last_verified":
-									lastVerified = value
-								}
-							}
-						}
-					}
-				}
-			}
-		}
+	if value, ok := extractEventAttribute(txResult, "anonymous_component_metadata_retrieved", "type"); ok {
+		componentType = value
+	}
+	if value, ok := extractEventAttribute(txResult, "anonymous_component_metadata_retrieved", "status"); ok {
+		status = value
+	}
+	if value, ok := extractEventAttribute(txResult, "anonymous_component_metadata_retrieved", "trust_anchor"); ok {
+		trustAnchor = value
+	}
+	if value, ok := extractEventAttribute(txResult, "anonymous_component_metadata_retrieved", "last_verified"); ok {
+		lastVerified = value
 	}
 
-	c.logger.Info().Str("component_hash", componentHash).Str("txhash", txhash).Msg("Anonymous component metadata retrieved successfully via blockchain")
+	c.log(ctx).Info().Str("component_hash", componentHash).Str("txhash", txhash).Msg("Anonymous component metadata retrieved successfully via blockchain")
 
 	return map[string]interface{}{
 		"component_hash": componentHash,
@@ -674,9 +1141,25 @@ func (c *RESTClient) GetAnonymousComponentMetadata(ctx context.Context, requeste
 	}, nil
 }
 
+// normalizeOperationalContext puts a relationship's operational context in the
+// form the chain stores it in, so that LCTs created directly and through
+// pairing carry the same value for the same context
+func normalizeOperationalContext(context string) (string, error) {
+	normalized, err := lctmanagertypes.NormalizeOperationalContext(context)
+	if err != nil {
+		return "", fmt.Errorf("%w: %q", ErrInvalidOperationalContext, context)
+	}
+	return normalized, nil
+}
+
 // InitiatePairing initiates a pairing using REST API
 func (c *RESTClient) InitiatePairing(ctx context.Context, creator, componentA, componentB, operationalContext, proxyID string, forceImmediate bool) (map[string]interface{}, error) {
-	c.logger.Info().Str("creator", creator).Str("component_a", componentA).Str("component_b", componentB).Msg("Initiating pairing via REST")
+	c.log(ctx).Info().Str("creator", creator).Str("component_a", componentA).Str("component_b", componentB).Msg("Initiating pairing via REST")
+
+	operationalContext, err := normalizeOperationalContext(operationalContext)
+	if err != nil {
+		return nil, err
+	}
 
 	// Try to use real blockchain first, fall back to mock if it fails
 	challengeID := fmt.Sprintf("CHALLENGE-%s-%s-%d", componentA, componentB, time.Now().Unix())
@@ -692,39 +1175,26 @@ func (c *RESTClient) InitiatePairing(ctx context.Context, creator, componentA, c
 		"force_immediate":     forceImmediate,
 	}
 
-	// Execute the transaction using Ignite CLI - this must succeed for the demo
-	txResult, err := c.executeTransactionWithIgnite(ctx, message, "pairing_initiation")
+	// Execute the transaction - this must succeed for the demo
+	txResult, err := c.executeTransaction(ctx, message, "pairing_initiation")
 	if err != nil {
-		c.logger.Error().Err(err).Msg("Ignite CLI transaction failed - this demo requires real blockchain integration")
+		c.log(ctx).Error().Err(err).Msg("Blockchain transaction failed - this demo requires real blockchain integration")
 		return nil, fmt.Errorf("blockchain transaction failed: %w", err)
 	}
 
 	// Check if transaction was successful
 	if code, ok := txResult["code"].(int); ok && code != 0 {
-		c.logger.Error().Int("code", code).Msg("Transaction failed - this demo requires real blockchain integration")
+		c.log(ctx).Error().Int("code", code).Msg("Transaction failed - this demo requires real blockchain integration")
 		return nil, fmt.Errorf("blockchain transaction failed with code %d", code)
 	}
 
 	// Success! Extract challenge ID from events
 	txhash := txResult["txhash"].(string)
-	if events, ok := txResult["events"].([]map[string]interface{}); ok {
-		for _, event := range events {
-			if eventType, ok := event["type"].(string); ok && eventType == "pairing_initiated" {
-				if attributes, ok := event["attributes"].([]map[string]interface{}); ok {
-					for _, attr := range attributes {
-						if key, ok := attr["key"].(string); ok && key == "challenge_id" {
-							if value, ok := attr["value"].(string); ok {
-								challengeID = value
-								break
-							}
-						}
-					}
-				}
-			}
-		}
+	if value, ok := extractEventAttribute(txResult, "bidirectional_pairing_initiated", "challenge_id"); ok {
+		challengeID = value
 	}
 
-	c.logger.Info().Str("challenge_id", challengeID).Str("txhash", txhash).Msg("Pairing initiated successfully via blockchain")
+	c.log(ctx).Info().Str("challenge_id", challengeID).Str("txhash", txhash).Msg("Pairing initiated successfully via blockchain")
 
 	return map[string]interface{}{
 		"challenge_id":        challengeID,
@@ -742,11 +1212,26 @@ func (c *RESTClient) InitiatePairing(ctx context.Context, creator, componentA, c
 
 // CompletePairing completes a pairing using REST API
 func (c *RESTClient) CompletePairing(ctx context.Context, creator, challengeID, componentAAuth, componentBAuth, sessionContext string) (map[string]interface{}, error) {
-	c.logger.Info().Str("creator", creator).Str("challenge_id", challengeID).Msg("Completing pairing via REST")
+	c.log(ctx).Info().Str("creator", creator).Str("challenge_id", challengeID).Msg("Completing pairing via REST")
 
 	// Try to use real blockchain first, fall back to mock if it fails
 	lctID := fmt.Sprintf("lct_%s", challengeID)
 
+	// Generate the split keys for the two components and seal each half to
+	// its component before broadcasting, so a failure cannot leave a
+	// completed pairing without keys. Only the commitment to the combined key
+	// goes on chain, and the halves only reach the components.
+	splitKeyA, splitKeyB, keyCommitment, err := generateSplitKeys(rand.Reader)
+	if err != nil {
+		c.log(ctx).Error().Err(err).Msg("Failed to generate split keys")
+		return nil, err
+	}
+	keyHalves, err := c.sealPairingKeyHalves(ctx, challengeID, splitKeyA, splitKeyB, keyCommitment)
+	if err != nil {
+		c.log(ctx).Error().Err(err).Str("challenge_id", challengeID).Msg("Failed to seal split key halves")
+		return nil, err
+	}
+
 	// Create the transaction message for pairing completion
 	message := map[string]interface{}{
 		"@type":            "/racecarweb.pairing.v1.MsgCompletePairing",
@@ -755,221 +1240,639 @@ func (c *RESTClient) CompletePairing(ctx context.Context, creator, challengeID,
 		"component_a_auth": componentAAuth,
 		"component_b_auth": componentBAuth,
 		"session_context":  sessionContext,
+		"key_commitment":   keyCommitment,
 	}
 
-	// Execute the transaction using Ignite CLI - this must succeed for the demo
-	txResult, err := c.executeTransactionWithIgnite(ctx, message, "pairing_completion")
+	// Execute the transaction - this must succeed for the demo
+	txResult, err := c.executeTransaction(ctx, message, "pairing_completion")
 	if err != nil {
-		c.logger.Error().Err(err).Msg("Ignite CLI transaction failed - this demo requires real blockchain integration")
+		c.log(ctx).Error().Err(err).Msg("Blockchain transaction failed - this demo requires real blockchain integration")
 		return nil, fmt.Errorf("blockchain transaction failed: %w", err)
 	}
 
 	// Check if transaction was successful
 	if code, ok := txResult["code"].(int); ok && code != 0 {
-		c.logger.Error().Int("code", code).Msg("Transaction failed - this demo requires real blockchain integration")
+		c.log(ctx).Error().Int("code", code).Msg("Transaction failed - this demo requires real blockchain integration")
 		return nil, fmt.Errorf("blockchain transaction failed with code %d", code)
 	}
 
-	// Success! Extract data from events and generate split keys
+	// Success! Extract data from events
 	txhash := txResult["txhash"].(string)
 	sessionKeys := "session_keys_generated"
 	trustSummary := "trust_score:0.85,context:pairing_completed"
 
-	// Generate split keys for the two components
-	splitKeyA, splitKeyB := c.generateSplitKeys(challengeID)
-
-	if events, ok := txResult["events"].([]map[string]interface{}); ok {
-		for _, event := range events {
-			if eventType, ok := event["type"].(string); ok && eventType == "pairing_completed" {
-				if attributes, ok := event["attributes"].([]map[string]interface{}); ok {
-					for _, attr := range attributes {
-						if key, ok := attr["key"].(string); ok {
-							if value, ok := attr["value"].(string); ok {
-								switch key {
-								case "lct_id":
-									lctID = value
-								case "session_keys":
-									sessionKeys = value
-								case "trust_summary":
-									trustSummary = value
-								}
-							}
-						}
-					}
-				}
-			}
-		}
+	if value, ok := extractEventAttribute(txResult, "pairing_completed", "lct_id"); ok {
+		lctID = value
+	}
+	if value, ok := extractEventAttribute(txResult, "pairing_completed", "session_keys"); ok {
+		sessionKeys = value
+	}
+	if value, ok := extractEventAttribute(txResult, "pairing_completed", "trust_summary"); ok {
+		trustSummary = value
 	}
+	// The chain refers to the split key by its commitment
+	keyReference, _ := extractEventAttribute(txResult, "pairing_completed", "key_reference")
 
-	c.logger.Info().Str("lct_id", lctID).Str("txhash", txhash).Msg("Pairing completed successfully via blockchain")
+	c.log(ctx).Info().Str("lct_id", lctID).Str("txhash", txhash).Msg("Pairing completed successfully via blockchain")
+
+	if err := c.deliverKeyHalves(ctx, keyHalves); err != nil {
+		c.log(ctx).Error().Err(err).Str("lct_id", lctID).Msg("Failed to deliver split key halves")
+		return nil, err
+	}
 
 	return map[string]interface{}{
 		"lct_id":        lctID,
 		"session_keys":  sessionKeys,
 		"trust_summary": trustSummary,
 		"txhash":        txhash,
-		"split_key_a":   splitKeyA, // Half A for component A
-		"split_key_b":   splitKeyB, // Half B for component B
+		"key_reference": keyReference,
 	}, nil
 }
 
-// generateSplitKeys generates two halves of a 64-byte key for secure communication
-func (c *RESTClient) generateSplitKeys(challengeID string) (string, string) {
-	// Generate 64 bytes of random data
-	keyMaterial := make([]byte, 64)
-	_, err := rand.Read(keyMaterial)
+// RevokePairing revokes a pairing using REST API
+func (c *RESTClient) RevokePairing(ctx context.Context, creator, lctID, reason string, notifyOffline bool) (map[string]interface{}, error) {
+	c.log(ctx).Info().Str("creator", creator).Str("lct_id", lctID).Msg("Revoking pairing via REST")
+
+	// Create the transaction message for pairing revocation
+	message := map[string]interface{}{
+		"@type":          "/racecarweb.pairing.v1.MsgRevokePairing",
+		"creator":        creator,
+		"lct_id":         lctID,
+		"reason":         reason,
+		"notify_offline": notifyOffline,
+	}
+
+	// The chain terminates the LCT and queues the offline notifications
+	txResult, err := c.executeTransaction(ctx, message, "pairing_revocation")
 	if err != nil {
-		// Fallback to deterministic generation based on challenge ID
-		hash := sha256.Sum256([]byte(challengeID))
-		keyMaterial = append(hash[:], hash[:]...) // Duplicate to get 64 bytes
+		c.log(ctx).Error().Err(err).Msg("Blockchain transaction failed for pairing revocation")
+		return nil, fmt.Errorf("blockchain transaction failed: %w", err)
 	}
 
-	// Split into two 32-byte halves
-	splitKeyA := fmt.Sprintf("%x", keyMaterial[:32]) // First 32 bytes for component A
-	splitKeyB := fmt.Sprintf("%x", keyMaterial[32:]) // Second 32 bytes for component B
+	if code, ok := txResultCode(txResult); ok && code != 0 {
+		rawLog, _ := txResult["raw_log"].(string)
+		c.log(ctx).Error().Int("code", code).Str("raw_log", rawLog).Msg("Transaction failed for pairing revocation")
+		if codespace, _ := txResult["codespace"].(string); codespace == pairingtypes.ModuleName && uint32(code) == pairingtypes.ErrUnauthorized.ABCICode() {
+			return nil, fmt.Errorf("%w: %s", ErrPairingRevocationUnauthorized, rawLog)
+		}
+		return nil, fmt.Errorf("blockchain transaction failed with code %d: %s", code, rawLog)
+	}
 
-	return splitKeyA, splitKeyB
-}
-
-// RevokePairing revokes a pairing using REST API
-func (c *RESTClient) RevokePairing(ctx context.Context, creator, lctID, reason string, notifyOffline bool) (map[string]interface{}, error) {
-	c.logger.Info().Str("creator", creator).Str("lct_id", lctID).Msg("Revoking pairing via REST")
+	txhash, _ := txResult["txhash"].(string)
+	c.log(ctx).Info().Str("lct_id", lctID).Str("txhash", txhash).Msg("Pairing revoked successfully via blockchain")
 
 	return map[string]interface{}{
-		"lct_id": lctID,
-		"status": "revoked",
-		"reason": reason,
+		"lct_id":         lctID,
+		"status":         "revoked",
+		"reason":         reason,
+		"notify_offline": notifyOffline,
+		"txhash":         txhash,
 	}, nil
 }
 
 // GetPairingStatus gets the status of a pairing using REST API
 func (c *RESTClient) GetPairingStatus(ctx context.Context, challengeID string) (map[string]interface{}, error) {
-	c.logger.Info().Str("challenge_id", challengeID).Msg("Getting pairing status via REST")
+	c.log(ctx).Info().Str("challenge_id", challengeID).Msg("Getting pairing status via REST")
 
-	return map[string]interface{}{
+	// The chain returns the session as a JSON document inside a string field
+	var response struct {
+		PairingChallenge string `json:"pairing_challenge"`
+	}
+	if err := c.queryJSON(ctx, fmt.Sprintf("/racecar-web/pairing/v1/get_pairing_status/%s", url.PathEscape(challengeID)), &response); err != nil {
+		var httpErr *HTTPError
+		if errors.As(err, &httpErr) && httpErr.StatusCode == http.StatusNotFound {
+			return nil, fmt.Errorf("%w: %s", ErrPairingChallengeNotFound, challengeID)
+		}
+		return nil, fmt.Errorf("failed to get pairing status: %w", err)
+	}
+
+	var challenge struct {
+		ChallengeID   string `json:"challenge_id"`
+		LctID         string `json:"lct_id"`
+		Status        string `json:"status"`
+		CreatedAt     int64  `json:"created_at"`
+		ExpiresAt     int64  `json:"expires_at"`
+		TTLSeconds    int64  `json:"ttl_seconds"`
+		EstablishedAt int64  `json:"established_at"`
+	}
+	if err := json.Unmarshal([]byte(response.PairingChallenge), &challenge); err != nil {
+		return nil, fmt.Errorf("failed to parse pairing challenge: %w", err)
+	}
+
+	result := map[string]interface{}{
 		"challenge_id": challengeID,
-		"status":       "pending",
-		"created_at":   time.Now().Unix(),
-	}, nil
+		"status":       challenge.Status,
+		"created_at":   challenge.CreatedAt,
+		"expires_at":   challenge.ExpiresAt,
+		"ttl_seconds":  challenge.TTLSeconds,
+	}
+	// The LCT only exists for callers once the pairing has completed
+	if challenge.Status == "completed" {
+		result["lct_id"] = challenge.LctID
+		result["established_at"] = challenge.EstablishedAt
+	}
+	return result, nil
 }
 
 // CreateLCT creates a Linked Context Token using REST API
 func (c *RESTClient) CreateLCT(ctx context.Context, creator, componentA, componentB, context, proxyID string) (map[string]interface{}, error) {
-	c.logger.Info().Str("creator", creator).Str("component_a", componentA).Str("component_b", componentB).Msg("Creating LCT via REST")
+	c.log(ctx).Info().Str("creator", creator).Str("component_a", componentA).Str("component_b", componentB).Msg("Creating LCT via REST")
+
+	context, err := normalizeOperationalContext(context)
+	if err != nil {
+		return nil, err
+	}
 
 	// Try to use real blockchain first, fall back to mock if it fails
 	lctID := fmt.Sprintf("lct-%s-%s-%d", componentA, componentB, time.Now().Unix())
 
 	// Create the transaction message for LCT creation
 	message := map[string]interface{}{
-		"@type":               "/racecarweb.lctmanager.v1.MsgCreateLctRelationship",
-		"creator":             creator,
-		"component_a_id":      componentA,
-		"component_b_id":      componentB,
-		"operational_context": context,
-		"proxy_component_id":  proxyID,
+		"@type":       "/racecarweb.lctmanager.v1.MsgCreateLctRelationship",
+		"creator":     creator,
+		"component_a": componentA,
+		"component_b": componentB,
+		"context":     context,
+		"proxy_id":    proxyID,
 	}
 
-	// Execute the transaction using Ignite CLI - this must succeed for the demo
-	txResult, err := c.executeTransactionWithIgnite(ctx, message, "lct_creation")
+	// Execute the transaction - this must succeed for the demo
+	txResult, err := c.executeTransaction(ctx, message, "lct_creation")
 	if err != nil {
-		c.logger.Error().Err(err).Msg("Ignite CLI transaction failed - this demo requires real blockchain integration")
+		c.log(ctx).Error().Err(err).Msg("Blockchain transaction failed - this demo requires real blockchain integration")
 		return nil, fmt.Errorf("blockchain transaction failed: %w", err)
 	}
 
-	// Check if transaction was successful
-	if code, ok := txResult["code"].(int); ok && code != 0 {
-		c.logger.Error().Int("code", code).Msg("Transaction failed - this demo requires real blockchain integration")
-		return nil, fmt.Errorf("blockchain transaction failed with code %d", code)
+	// Check if transaction was successful. The chain only pairs components
+	// that are registered and active.
+	if code, ok := txResultCode(txResult); ok && code != 0 {
+		rawLog, _ := txResult["raw_log"].(string)
+		c.log(ctx).Error().Int("code", code).Str("raw_log", rawLog).Msg("Transaction failed - this demo requires real blockchain integration")
+		if codespace, _ := txResult["codespace"].(string); codespace == lctmanagertypes.ModuleName {
+			switch uint32(code) {
+			case lctmanagertypes.ErrComponentNotFound.ABCICode():
+				return nil, fmt.Errorf("%w: %s", ErrComponentNotFound, rawLog)
+			case lctmanagertypes.ErrComponentInactive.ABCICode():
+				return nil, fmt.Errorf("%w: %s", ErrComponentInactive, rawLog)
+			}
+		}
+		return nil, fmt.Errorf("blockchain transaction failed with code %d: %s", code, rawLog)
 	}
 
 	// Success! Extract LCT ID from events
 	txhash := txResult["txhash"].(string)
-	if events, ok := txResult["events"].([]map[string]interface{}); ok {
-		for _, event := range events {
-			if eventType, ok := event["type"].(string); ok && eventType == "lct_relationship_created" {
-				if attributes, ok := event["attributes"].([]map[string]interface{}); ok {
-					for _, attr := range attributes {
-						if key, ok := attr["key"].(string); ok && key == "lct_id" {
-							if value, ok := attr["value"].(string); ok {
-								lctID = value
-								break
-							}
-						}
-					}
-				}
-			}
-		}
+	if value, ok := extractEventAttribute(txResult, "lct_relationship_created", "lct_id"); ok {
+		lctID = value
 	}
 
-	c.logger.Info().Str("lct_id", lctID).Str("txhash", txhash).Msg("LCT created successfully via blockchain")
+	c.log(ctx).Info().Str("lct_id", lctID).Str("txhash", txhash).Msg("LCT created successfully via blockchain")
 
 	return map[string]interface{}{
-		"lct_id":          lctID,
-		"component_a":     componentA,
-		"component_b":     componentB,
-		"context":         context,
-		"proxy_id":        proxyID,
-		"status":          "active",
-		"created_at":      time.Now().Unix(),
-		"creator":         creator,
-		"txhash":          txhash,
-		"lct_key_half":    "generated_lct_key_half",    // This would be the actual key half from the blockchain
-		"device_key_half": "generated_device_key_half", // This would be the actual device key half
+		"lct_id":              lctID,
+		"component_a":         componentA,
+		"component_b":         componentB,
+		"operational_context": context,
+		"context":             context, // deprecated: operational_context's previous name, removed in the next release
+		"proxy_id":            proxyID,
+		"status":              "active",
+		"created_at":          time.Now().Unix(),
+		"creator":             creator,
+		"txhash":              txhash,
+		"lct_key_half":        "generated_lct_key_half",    // This would be the actual key half from the blockchain
+		"device_key_half":     "generated_device_key_half", // This would be the actual device key half
 	}, nil
 }
 
 // GetLCT retrieves a Linked Context Token using REST API
 func (c *RESTClient) GetLCT(ctx context.Context, lctID string) (map[string]interface{}, error) {
-	c.logger.Info().Str("lct_id", lctID).Msg("Getting LCT via REST")
+	c.log(ctx).Info().Str("lct_id", lctID).Msg("Getting LCT via REST")
 
-	// Make the request to the blockchain
-	respBody, err := c.makeRequest("GET", fmt.Sprintf("/racecar-web/lctmanager/v1/get_lct/%s", lctID), nil)
-	if err != nil {
+	// linked_context_token arrives as a JSON string or an object; LCTResponse accepts both
+	var response LCTResponse
+	if err := c.queryJSON(ctx, fmt.Sprintf("/racecar-web/lctmanager/v1/get_lct/%s", lctID), &response); err != nil {
 		return nil, fmt.Errorf("failed to get LCT: %w", err)
 	}
 
-	// Parse the response
-	var response map[string]interface{}
-	if err := json.Unmarshal(respBody, &response); err != nil {
-		return nil, fmt.Errorf("failed to parse response: %w", err)
+	return response.Fields, nil
+}
+
+// GetLctBetween retrieves the live LCT linking two components in an operational context
+func (c *RESTClient) GetLctBetween(ctx context.Context, componentA, componentB, operationalContext string) (map[string]interface{}, error) {
+	c.log(ctx).Info().Str("component_a", componentA).Str("component_b", componentB).Str("context", operationalContext).Msg("Getting LCT between components via REST")
+
+	endpoint := fmt.Sprintf("/racecar-web/lctmanager/v1/get_lct_between/%s/%s", url.PathEscape(componentA), url.PathEscape(componentB))
+	if operationalContext != "" {
+		endpoint += "?operational_context=" + url.QueryEscape(operationalContext)
 	}
 
-	// Log the response for debugging
-	c.logger.Debug().Interface("response", response).Msg("Raw LCT response")
+	var response struct {
+		LinkedContextToken string `json:"linked_context_token"`
+		Found              bool   `json:"found"`
+	}
+	if err := c.queryJSON(ctx, endpoint, &response); err != nil {
+		return nil, fmt.Errorf("failed to get LCT between components: %w", err)
+	}
 
-	// The blockchain returns the LCT data in the linked_context_token field as a JSON string
-	if lctJSON, ok := response["linked_context_token"].(string); ok {
-		// Parse the JSON string into a map
+	result := map[string]interface{}{
+		"component_a": componentA,
+		"component_b": componentB,
+		"context":     operationalContext,
+		"found":       response.Found,
+	}
+	if response.Found {
 		var lct map[string]interface{}
-		if err := json.Unmarshal([]byte(lctJSON), &lct); err != nil {
+		if err := json.Unmarshal([]byte(response.LinkedContextToken), &lct); err != nil {
+			return nil, fmt.Errorf("failed to parse LCT JSON: %w", err)
+		}
+		result["lct"] = lct
+	}
+
+	return result, nil
+}
+
+// LctSummary describes one LCT from the point of view of a participating component
+type LctSummary struct {
+	LctID              string `json:"lct_id"`
+	PeerComponentID    string `json:"peer_component_id"`
+	PairingStatus      string `json:"pairing_status"`
+	OperationalContext string `json:"operational_context"`
+}
+
+// LCTFilter narrows an LCT listing; zero values match everything
+type LCTFilter struct {
+	ComponentID        string // either side of the relationship
+	Status             string // pairing status, e.g. "active"
+	OperationalContext string
+}
+
+// ListLCTs retrieves one page of the LCTs that pass filter, in LCT ID order.
+// The chain applies the filter, so next_key may be set on a page holding
+// fewer than limit LCTs.
+func (c *RESTClient) ListLCTs(ctx context.Context, filter LCTFilter, limit uint64, key string) (map[string]interface{}, error) {
+	c.log(ctx).Info().Str("component_id", filter.ComponentID).Str("status", filter.Status).Str("operational_context", filter.OperationalContext).Uint64("limit", limit).Msg("Listing LCTs via REST")
+
+	params := url.Values{}
+	if filter.ComponentID != "" {
+		params.Set("component_id", filter.ComponentID)
+	}
+	if filter.Status != "" {
+		params.Set("status", filter.Status)
+	}
+	if filter.OperationalContext != "" {
+		params.Set("operational_context", filter.OperationalContext)
+	}
+	if limit > 0 {
+		params.Set("pagination.limit", strconv.FormatUint(limit, 10))
+	}
+	if key != "" {
+		params.Set("pagination.key", key)
+	}
+
+	endpoint := "/racecar-web/lctmanager/v1/lcts"
+	if len(params) > 0 {
+		endpoint += "?" + params.Encode()
+	}
+
+	var response struct {
+		Lcts       []map[string]interface{} `json:"lcts"`
+		Pagination struct {
+			NextKey string `json:"next_key"`
+		} `json:"pagination"`
+	}
+	if err := c.queryJSON(ctx, endpoint, &response); err != nil {
+		return nil, fmt.Errorf("failed to list LCTs: %w", err)
+	}
+
+	// The chain omits empty repeated fields, so normalize to an empty list
+	if response.Lcts == nil {
+		response.Lcts = []map[string]interface{}{}
+	}
+
+	return map[string]interface{}{
+		"lcts":     response.Lcts,
+		"count":    len(response.Lcts),
+		"next_key": response.Pagination.NextKey,
+	}, nil
+}
+
+// GetLctsByProxy retrieves one page of the non-terminated LCTs mediated by a
+// proxy component. key is the base64 next_key returned with the previous page.
+func (c *RESTClient) GetLctsByProxy(ctx context.Context, proxyID string, limit uint64, key string) (map[string]interface{}, error) {
+	c.log(ctx).Info().Str("proxy_id", proxyID).Uint64("limit", limit).Str("key", key).Msg("Getting LCTs by proxy via REST")
+
+	params := url.Values{}
+	if limit > 0 {
+		params.Set("pagination.limit", strconv.FormatUint(limit, 10))
+	}
+	if key != "" {
+		params.Set("pagination.key", key)
+	}
+
+	endpoint := fmt.Sprintf("/racecar-web/lctmanager/v1/proxy/%s/lcts", url.PathEscape(proxyID))
+	if len(params) > 0 {
+		endpoint += "?" + params.Encode()
+	}
+
+	var response struct {
+		Lcts       []map[string]interface{} `json:"lcts"`
+		Pagination struct {
+			NextKey string `json:"next_key"`
+		} `json:"pagination"`
+	}
+	if err := c.queryJSON(ctx, endpoint, &response); err != nil {
+		return nil, fmt.Errorf("failed to get LCTs by proxy: %w", err)
+	}
+
+	// The chain omits empty repeated fields, so normalize to an empty list
+	if response.Lcts == nil {
+		response.Lcts = []map[string]interface{}{}
+	}
+
+	return map[string]interface{}{
+		"proxy_id": proxyID,
+		"lcts":     response.Lcts,
+		"count":    len(response.Lcts),
+		"next_key": response.Pagination.NextKey,
+	}, nil
+}
+
+// GetSplitKeyStatus reports an LCT's split key lifecycle: the current key's
+// reference, version and status, and the keys it superseded. Keys are only
+// ever identified by reference.
+func (c *RESTClient) GetSplitKeyStatus(ctx context.Context, lctID string) (map[string]interface{}, error) {
+	c.log(ctx).Info().Str("lct_id", lctID).Msg("Getting split key status via REST")
+
+	// 64-bit fields arrive as strings from the gateway; absent when zero
+	var splitKey struct {
+		Status       string `json:"status"`
+		KeyReference string `json:"key_reference"`
+		Version      uint64 `json:"version,string"`
+		CreatedAt    int64  `json:"created_at,string"`
+		ActivatedAt  int64  `json:"activated_at,string"`
+		RevokedAt    int64  `json:"revoked_at,string"`
+		Superseded   []struct {
+			KeyReference string `json:"key_reference"`
+			Version      uint64 `json:"version,string"`
+			CreatedAt    int64  `json:"created_at,string"`
+			SupersededAt int64  `json:"superseded_at,string"`
+			SupersededBy string `json:"superseded_by"`
+		} `json:"superseded"`
+	}
+	if err := c.queryJSON(ctx, fmt.Sprintf("/racecar-web/lctmanager/v1/split_key/%s", url.PathEscape(lctID)), &splitKey); err != nil {
+		var httpErr *HTTPError
+		if errors.As(err, &httpErr) && httpErr.StatusCode == http.StatusNotFound {
+			return nil, fmt.Errorf("%w: %s", ErrSplitKeyNotFound, lctID)
+		}
+		return nil, fmt.Errorf("failed to get split key status: %w", err)
+	}
+
+	superseded := make([]map[string]interface{}, 0, len(splitKey.Superseded))
+	for _, old := range splitKey.Superseded {
+		superseded = append(superseded, map[string]interface{}{
+			"key_reference": old.KeyReference,
+			"version":       old.Version,
+			"created_at":    old.CreatedAt,
+			"superseded_at": old.SupersededAt,
+			"superseded_by": old.SupersededBy,
+		})
+	}
+	result := map[string]interface{}{
+		"lct_id":        lctID,
+		"status":        splitKey.Status,
+		"key_reference": splitKey.KeyReference,
+		"version":       splitKey.Version,
+		"created_at":    splitKey.CreatedAt,
+		"activated_at":  splitKey.ActivatedAt,
+		"superseded":    superseded,
+	}
+	if splitKey.RevokedAt != 0 {
+		result["revoked_at"] = splitKey.RevokedAt
+	}
+	return result, nil
+}
+
+// GetKeyExchange reports where an LCT's session key exchange stands: its
+// status, when it was initiated and completed, and the participating
+// components. The chain exposes no key material through this query.
+func (c *RESTClient) GetKeyExchange(ctx context.Context, lctID string) (map[string]interface{}, error) {
+	c.log(ctx).Info().Str("lct_id", lctID).Msg("Getting key exchange via REST")
+
+	// int64 fields arrive as strings from the gateway; absent when zero
+	var exchange struct {
+		Status           string `json:"status"`
+		LctStatus        string `json:"lct_status"`
+		CreatedAt        int64  `json:"created_at,string"`
+		LastUsed         int64  `json:"last_used,string"`
+		ComponentAID     string `json:"component_a_id"`
+		ComponentBID     string `json:"component_b_id"`
+		ProxyComponentID string `json:"proxy_component_id"`
+	}
+	if err := c.queryJSON(ctx, fmt.Sprintf("/racecar-web/lctmanager/v1/key_exchange/%s", url.PathEscape(lctID)), &exchange); err != nil {
+		var httpErr *HTTPError
+		if errors.As(err, &httpErr) && httpErr.StatusCode == http.StatusNotFound {
+			return nil, fmt.Errorf("%w: %s", ErrKeyExchangeNotFound, lctID)
+		}
+		return nil, fmt.Errorf("failed to get key exchange: %w", err)
+	}
+
+	participants := []string{exchange.ComponentAID, exchange.ComponentBID}
+	if exchange.ProxyComponentID != "" {
+		participants = append(participants, exchange.ProxyComponentID)
+	}
+	result := map[string]interface{}{
+		"lct_id":       lctID,
+		"status":       exchange.Status,
+		"lct_status":   exchange.LctStatus,
+		"initiated_at": exchange.CreatedAt,
+		"participants": participants,
+	}
+	// The exchange only has a completion time once it is active
+	if exchange.LastUsed != 0 {
+		result["completed_at"] = exchange.LastUsed
+	}
+	return result, nil
+}
+
+// GetComponentRelationships lists the LCTs a component participates in,
+// terminated ones included. A non-empty status keeps only LCTs in that
+// pairing status and must be one the lctmanager module defines.
+func (c *RESTClient) GetComponentRelationships(ctx context.Context, componentID, status string) ([]LctSummary, error) {
+	if status != "" && !lctmanagertypes.IsValidLCTStatus(status) {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidLCTStatus, status)
+	}
+	c.log(ctx).Info().Str("component_id", componentID).Str("status", status).Msg("Getting component relationships via REST")
+
+	var response struct {
+		ComponentRelationships string `json:"component_relationships"`
+	}
+	if err := c.queryJSON(ctx, fmt.Sprintf("/racecar-web/lctmanager/v1/get_component_relationships/%s", url.PathEscape(componentID)), &response); err != nil {
+		return nil, fmt.Errorf("failed to get component relationships: %w", err)
+	}
+
+	// The keeper serializes the LCTs as a JSON string, which is "null" when there are none
+	var lcts []struct {
+		LctID              string `json:"lct_id"`
+		ComponentAID       string `json:"component_a_id"`
+		ComponentBID       string `json:"component_b_id"`
+		PairingStatus      string `json:"pairing_status"`
+		OperationalContext string `json:"operational_context"`
+	}
+	if response.ComponentRelationships != "" {
+		if err := json.Unmarshal([]byte(response.ComponentRelationships), &lcts); err != nil {
 			return nil, fmt.Errorf("failed to parse LCT JSON: %w", err)
 		}
-		return lct, nil
 	}
 
-	// Fallback: try to extract as direct object
-	if lct, ok := response["linked_context_token"].(map[string]interface{}); ok {
-		return lct, nil
+	summaries := []LctSummary{}
+	for _, lct := range lcts {
+		if status != "" && lct.PairingStatus != status {
+			continue
+		}
+		peer := lct.ComponentBID
+		if lct.ComponentBID == componentID {
+			peer = lct.ComponentAID
+		}
+		summaries = append(summaries, LctSummary{
+			LctID:              lct.LctID,
+			PeerComponentID:    peer,
+			PairingStatus:      lct.PairingStatus,
+			OperationalContext: lct.OperationalContext,
+		})
 	}
 
-	return nil, fmt.Errorf("invalid response format: linked_context_token not found or invalid")
+	return summaries, nil
 }
 
-// UpdateLCTStatus updates the status of a Linked Context Token using REST API
+// UpdateLCTStatus moves a Linked Context Token to any valid status on chain.
+// The context is recorded as the reason in the LCT's audit trail.
 func (c *RESTClient) UpdateLCTStatus(ctx context.Context, creator, lctID, status, context string) (map[string]interface{}, error) {
-	c.logger.Info().Str("creator", creator).Str("lct_id", lctID).Str("status", status).Msg("Updating LCT status via REST")
+	if !lctmanagertypes.IsValidLCTStatus(status) {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidLCTStatus, status)
+	}
+	return c.submitLCTStatus(ctx, creator, lctID, status, context, "lct_status_update")
+}
+
+// SuspendLCT pauses a Linked Context Token on chain without terminating it
+func (c *RESTClient) SuspendLCT(ctx context.Context, creator, lctID, reason string) (map[string]interface{}, error) {
+	return c.submitLCTStatus(ctx, creator, lctID, "suspended", reason, "lct_suspension")
+}
+
+// ResumeLCT returns a suspended Linked Context Token to the status it had
+// before suspension. The chain picks that status, so none is reported back.
+func (c *RESTClient) ResumeLCT(ctx context.Context, creator, lctID, reason string) (map[string]interface{}, error) {
+	resp, err := c.submitLCTStatus(ctx, creator, lctID, "active", reason, "lct_resumption")
+	if err != nil {
+		return nil, err
+	}
+	delete(resp, "status")
+	return resp, nil
+}
+
+// submitLCTStatus broadcasts a MsgUpdateLctStatus moving an LCT to the given status
+func (c *RESTClient) submitLCTStatus(ctx context.Context, creator, lctID, status, reason, memo string) (map[string]interface{}, error) {
+	c.log(ctx).Info().Str("creator", creator).Str("lct_id", lctID).Str("status", status).Msg("Submitting LCT status change via REST")
+
+	message := map[string]interface{}{
+		"@type":      "/racecarweb.lctmanager.v1.MsgUpdateLctStatus",
+		"creator":    creator,
+		"lct_id":     lctID,
+		"new_status": status,
+		"reason":     reason,
+	}
+
+	txResult, err := c.executeTransaction(ctx, message, memo)
+	if err != nil {
+		c.log(ctx).Error().Err(err).Str("lct_id", lctID).Msg("Blockchain transaction failed for LCT status change")
+		return nil, fmt.Errorf("blockchain transaction failed: %w", err)
+	}
+
+	if code, ok := txResult["code"].(int); ok && code != 0 {
+		c.log(ctx).Error().Int("code", code).Str("lct_id", lctID).Msg("Transaction failed for LCT status change")
+		return nil, fmt.Errorf("blockchain transaction failed with code %d", code)
+	}
+
+	txhash := txResult["txhash"].(string)
+	c.log(ctx).Info().Str("lct_id", lctID).Str("status", status).Str("txhash", txhash).Msg("LCT status changed successfully via blockchain")
 
 	return map[string]interface{}{
 		"lct_id":     lctID,
 		"status":     status,
-		"updated_at": time.Now().Unix(),
+		"reason":     reason,
+		"updated_at": txTime(txResult),
+		"txhash":     txhash,
 	}, nil
 }
 
-// CreateTrustTensor creates a trust tensor using REST API
-func (c *RESTClient) CreateTrustTensor(ctx context.Context, creator, componentA, componentB, context string, initialScore float64) (map[string]interface{}, error) {
-	c.logger.Info().Str("creator", creator).Str("component_a", componentA).Str("component_b", componentB).Msg("Creating trust tensor via REST")
+// RotateSplitKey replaces an LCT's split key on chain. The new key's halves
+// are delivered to the components sealed, like a pairing's; only the new and
+// superseded key references come back.
+func (c *RESTClient) RotateSplitKey(ctx context.Context, creator, lctID, reason string) (map[string]interface{}, error) {
+	c.log(ctx).Info().Str("creator", creator).Str("lct_id", lctID).Msg("Rotating split key via REST")
+
+	// The new key is generated here, like a pairing's; the chain only
+	// records the commitment to it
+	splitKeyA, splitKeyB, keyCommitment, err := generateSplitKeys(rand.Reader)
+	if err != nil {
+		c.log(ctx).Error().Err(err).Str("lct_id", lctID).Msg("Failed to generate split keys")
+		return nil, err
+	}
+	keyHalves, err := c.sealKeyHalves(ctx, lctID, splitKeyA, splitKeyB, keyCommitment)
+	if err != nil {
+		c.log(ctx).Error().Err(err).Str("lct_id", lctID).Msg("Failed to seal split key halves")
+		return nil, err
+	}
+
+	message := map[string]interface{}{
+		"@type":          "/racecarweb.lctmanager.v1.MsgRotateSplitKey",
+		"creator":        creator,
+		"lct_id":         lctID,
+		"reason":         reason,
+		"key_commitment": keyCommitment,
+	}
+
+	txResult, err := c.executeTransaction(ctx, message, "split_key_rotation")
+	if err != nil {
+		c.log(ctx).Error().Err(err).Str("lct_id", lctID).Msg("Blockchain transaction failed for split key rotation")
+		return nil, fmt.Errorf("blockchain transaction failed: %w", err)
+	}
+
+	if code, ok := txResult["code"].(int); ok && code != 0 {
+		c.log(ctx).Error().Int("code", code).Str("lct_id", lctID).Msg("Transaction failed for split key rotation")
+		return nil, fmt.Errorf("blockchain transaction failed with code %d", code)
+	}
+
+	txhash := txResult["txhash"].(string)
+	result := map[string]interface{}{
+		"lct_id":     lctID,
+		"reason":     reason,
+		"rotated_at": txTime(txResult),
+		"txhash":     txhash,
+	}
+	for _, key := range []string{"key_reference", "previous_key_reference"} {
+		if value, ok := extractEventAttribute(txResult, "split_key_rotated", key); ok {
+			result[key] = value
+		}
+	}
+	if value, ok := extractEventAttribute(txResult, "split_key_rotated", "version"); ok {
+		if version, err := strconv.ParseUint(value, 10, 64); err == nil {
+			result["version"] = version
+		}
+	}
+
+	c.log(ctx).Info().Str("lct_id", lctID).Str("txhash", txhash).Msg("Split key rotated successfully via blockchain")
+
+	if err := c.deliverKeyHalves(ctx, keyHalves); err != nil {
+		c.log(ctx).Error().Err(err).Str("lct_id", lctID).Msg("Failed to deliver split key halves")
+		return nil, err
+	}
+	return result, nil
+}
+
+// CreateTrustTensor creates a trust tensor using REST API. With named
+// dimensions the chain stores each of them and initialScore is not sent;
+// otherwise the single score fills the chain's default dimension.
+func (c *RESTClient) CreateTrustTensor(ctx context.Context, creator, componentA, componentB, context string, initialScore float64, dimensions map[string]float64) (map[string]interface{}, error) {
+	c.log(ctx).Info().Str("creator", creator).Str("component_a", componentA).Str("component_b", componentB).Int("dimensions", len(dimensions)).Msg("Creating trust tensor via REST")
 
 	// Create the transaction message for trust tensor creation
 	message := map[string]interface{}{
@@ -978,7 +1881,19 @@ func (c *RESTClient) CreateTrustTensor(ctx context.Context, creator, componentA,
 		"component_a_id":      componentA,
 		"component_b_id":      componentB,
 		"operational_context": context,
-		"initial_score":       fmt.Sprintf("%.2f", initialScore),
+	}
+	if len(dimensions) > 0 {
+		encoded, err := formatTensorDimensions(dimensions)
+		if err != nil {
+			return nil, err
+		}
+		message["dimensions"] = encoded
+	} else {
+		score, err := FormatTrustScore(initialScore)
+		if err != nil {
+			return nil, err
+		}
+		message["initial_score"] = score
 	}
 
 	// Create the transaction body
@@ -995,7 +1910,7 @@ func (c *RESTClient) CreateTrustTensor(ctx context.Context, creator, componentA,
 	}
 
 	// Make the request to the blockchain
-	respBody, err := c.makeRequest("POST", "/cosmos/tx/v1beta1/txs", tx)
+	respBody, err := c.makeRequest(ctx, "POST", "/cosmos/tx/v1beta1/txs", tx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create trust tensor: %w", err)
 	}
@@ -1011,6 +1926,9 @@ func (c *RESTClient) CreateTrustTensor(ctx context.Context, creator, componentA,
 	if !ok {
 		return nil, fmt.Errorf("invalid response format")
 	}
+	if txResponse, err = c.awaitCommit(ctx, txResponse); err != nil {
+		return nil, fmt.Errorf("blockchain transaction failed: %w", err)
+	}
 
 	// Check if transaction was successful
 	if code, ok := txResponse["code"].(float64); ok && code != 0 {
@@ -1019,49 +1937,178 @@ func (c *RESTClient) CreateTrustTensor(ctx context.Context, creator, componentA,
 
 	// Extract tensor ID from events
 	tensorID := fmt.Sprintf("tensor_%s_%s", componentA, componentB)
-	if events, ok := txResponse["events"].([]interface{}); ok {
-		for _, event := range events {
-			if eventMap, ok := event.(map[string]interface{}); ok {
-				if eventType, ok := eventMap["type"].(string); ok && eventType == "relationship_tensor_created" {
-					if attributes, ok := eventMap["attributes"].([]interface{}); ok {
-						for _, attr := range attributes {
-							if attrMap, ok := attr.(map[string]interface{}); ok {
-								if key, ok := attrMap["key"].(string); ok && key == "tensor_id" {
-									if value, ok := attrMap["value"].(string); ok {
-										tensorID = value
-										break
-									}
-								}
-							}
-						}
-					}
-				}
-			}
-		}
+	if value, ok := extractEventAttribute(txResponse, "relationship_tensor_created", "tensor_id"); ok {
+		tensorID = value
 	}
 
-	return map[string]interface{}{
+	result := map[string]interface{}{
 		"tensor_id": tensorID,
-		"score":     initialScore,
 		"status":    "active",
 		"txhash":    txResponse["txhash"],
-	}, nil
+	}
+	// The composite of named dimensions depends on the chain's weights, so
+	// only a single score is echoed back
+	if len(dimensions) > 0 {
+		result["dimensions"] = dimensions
+	} else {
+		result["score"] = initialScore
+	}
+	return result, nil
 }
 
 // GetTrustTensor retrieves a trust tensor using REST API
 func (c *RESTClient) GetTrustTensor(ctx context.Context, tensorID string) (map[string]interface{}, error) {
-	c.logger.Info().Str("tensor_id", tensorID).Msg("Getting trust tensor via REST")
+	c.log(ctx).Info().Str("tensor_id", tensorID).Msg("Getting trust tensor via REST")
+
+	var response struct {
+		Tensor struct {
+			TensorID         string `json:"tensor_id"`
+			LctID            string `json:"lct_id"`
+			TensorType       string `json:"tensor_type"`
+			TalentScore      string `json:"talent_score"`
+			TrainingScore    string `json:"training_score"`
+			TemperamentScore string `json:"temperament_score"`
+			Context          string `json:"context"`
+			UpdatedAt        string `json:"updated_at"`
+			Version          string `json:"version"`
+			EvidenceCount    string `json:"evidence_count"`
+		} `json:"tensor"`
+		CompositeScore string `json:"composite_score"`
+		Dimensions     []struct {
+			Name  string `json:"name"`
+			Score string `json:"score"`
+		} `json:"dimensions"`
+	}
+	if err := c.queryJSON(ctx, fmt.Sprintf("/racecar-web/trusttensor/v1/get_trust_tensor/%s", url.PathEscape(tensorID)), &response); err != nil {
+		var httpErr *HTTPError
+		if errors.As(err, &httpErr) && httpErr.StatusCode == http.StatusNotFound {
+			return nil, fmt.Errorf("%w: %s", ErrTrustTensorNotFound, tensorID)
+		}
+		return nil, fmt.Errorf("failed to get trust tensor: %w", err)
+	}
+	tensor := response.Tensor
+
+	// int64 fields arrive as JSON strings and are omitted when zero
+	parseInt := func(value string) int64 {
+		parsed, _ := strconv.ParseInt(value, 10, 64)
+		return parsed
+	}
+	parseScore := func(name, value string) (float64, error) {
+		if value == "" {
+			return 0, nil
+		}
+		score, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid %s %q in tensor %s: %w", name, value, tensorID, err)
+		}
+		return score, nil
+	}
+
+	score, err := parseScore("composite score", response.CompositeScore)
+	if err != nil {
+		return nil, err
+	}
+	// Older chains report only the three T3 scores, not the named breakdown
+	named := make(map[string]string, len(response.Dimensions))
+	for _, d := range response.Dimensions {
+		named[d.Name] = d.Score
+	}
+	if len(named) == 0 {
+		named = map[string]string{
+			"talent":      tensor.TalentScore,
+			"training":    tensor.TrainingScore,
+			"temperament": tensor.TemperamentScore,
+		}
+	}
+	dimensions := make(map[string]float64, len(named))
+	for name, value := range named {
+		if dimensions[name], err = parseScore(name+" score", value); err != nil {
+			return nil, err
+		}
+	}
+
+	return map[string]interface{}{
+		"tensor_id":      tensor.TensorID,
+		"lct_id":         tensor.LctID,
+		"tensor_type":    tensor.TensorType,
+		"context":        tensor.Context,
+		"score":          score,
+		"dimensions":     dimensions,
+		"version":        parseInt(tensor.Version),
+		"evidence_count": parseInt(tensor.EvidenceCount),
+		"last_updated":   parseInt(tensor.UpdatedAt),
+	}, nil
+}
+
+// GetTensorHistory retrieves the recorded score updates of a trust tensor,
+// oldest first. from and to are inclusive unix seconds; 0 leaves that side
+// unbounded.
+func (c *RESTClient) GetTensorHistory(ctx context.Context, tensorID string, from, to int64) (map[string]interface{}, error) {
+	c.log(ctx).Info().Str("tensor_id", tensorID).Int64("from", from).Int64("to", to).Msg("Getting tensor history via REST")
+
+	params := url.Values{}
+	if from != 0 {
+		params.Set("from", strconv.FormatInt(from, 10))
+	}
+	if to != 0 {
+		params.Set("to", strconv.FormatInt(to, 10))
+	}
+	endpoint := fmt.Sprintf("/racecar-web/trusttensor/v1/get_tensor_history/%s", url.PathEscape(tensorID))
+	if len(params) > 0 {
+		endpoint += "?" + params.Encode()
+	}
+
+	// int64 and uint64 fields arrive as JSON strings
+	var response struct {
+		Entries []struct {
+			Sequence       uint64 `json:"sequence,string"`
+			Timestamp      int64  `json:"timestamp,string"`
+			Dimension      string `json:"dimension"`
+			Score          string `json:"score"`
+			CompositeScore string `json:"composite_score"`
+			Context        string `json:"context"`
+			Actor          string `json:"actor"`
+		} `json:"entries"`
+	}
+	if err := c.queryJSON(ctx, endpoint, &response); err != nil {
+		var httpErr *HTTPError
+		if errors.As(err, &httpErr) && httpErr.StatusCode == http.StatusNotFound {
+			return nil, fmt.Errorf("%w: %s", ErrTrustTensorNotFound, tensorID)
+		}
+		return nil, fmt.Errorf("failed to get tensor history: %w", err)
+	}
+
+	series := make([]map[string]interface{}, 0, len(response.Entries))
+	for _, entry := range response.Entries {
+		score, err := strconv.ParseFloat(entry.Score, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid score %q in history of tensor %s: %w", entry.Score, tensorID, err)
+		}
+		composite, err := strconv.ParseFloat(entry.CompositeScore, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid composite score %q in history of tensor %s: %w", entry.CompositeScore, tensorID, err)
+		}
+		series = append(series, map[string]interface{}{
+			"sequence":        entry.Sequence,
+			"timestamp":       entry.Timestamp,
+			"dimension":       entry.Dimension,
+			"score":           score,
+			"composite_score": composite,
+			"context":         entry.Context,
+			"actor":           entry.Actor,
+		})
+	}
 
 	return map[string]interface{}{
 		"tensor_id": tensorID,
-		"score":     0.85,
-		"status":    "active",
+		"history":   series,
+		"count":     len(series),
 	}, nil
 }
 
 // UpdateTrustScore updates the trust score using REST API
 func (c *RESTClient) UpdateTrustScore(ctx context.Context, creator, tensorID string, score float64, context string) (map[string]interface{}, error) {
-	c.logger.Info().Str("creator", creator).Str("tensor_id", tensorID).Float64("score", score).Msg("Updating trust score via REST")
+	c.log(ctx).Info().Str("creator", creator).Str("tensor_id", tensorID).Float64("score", score).Msg("Updating trust score via REST")
 
 	return map[string]interface{}{
 		"tensor_id":  tensorID,
@@ -1072,7 +2119,7 @@ func (c *RESTClient) UpdateTrustScore(ctx context.Context, creator, tensorID str
 
 // CreateEnergyOperation creates an energy operation using REST API
 func (c *RESTClient) CreateEnergyOperation(ctx context.Context, creator, componentA, componentB, operationType string, amount float64, context string) (map[string]interface{}, error) {
-	c.logger.Info().Str("creator", creator).Str("component_a", componentA).Str("component_b", componentB).Str("operation_type", operationType).Float64("amount", amount).Msg("Creating energy operation via REST")
+	c.log(ctx).Info().Str("creator", creator).Str("component_a", componentA).Str("component_b", componentB).Str("operation_type", operationType).Float64("amount", amount).Msg("Creating energy operation via REST")
 
 	// Create the transaction message for energy operation creation
 	message := map[string]interface{}{
@@ -1099,7 +2146,7 @@ func (c *RESTClient) CreateEnergyOperation(ctx context.Context, creator, compone
 	}
 
 	// Make the request to the blockchain
-	respBody, err := c.makeRequest("POST", "/cosmos/tx/v1beta1/txs", tx)
+	respBody, err := c.makeRequest(ctx, "POST", "/cosmos/tx/v1beta1/txs", tx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create energy operation: %w", err)
 	}
@@ -1115,6 +2162,9 @@ func (c *RESTClient) CreateEnergyOperation(ctx context.Context, creator, compone
 	if !ok {
 		return nil, fmt.Errorf("invalid response format")
 	}
+	if txResponse, err = c.awaitCommit(ctx, txResponse); err != nil {
+		return nil, fmt.Errorf("blockchain transaction failed: %w", err)
+	}
 
 	// Check if transaction was successful
 	if code, ok := txResponse["code"].(float64); ok && code != 0 {
@@ -1123,25 +2173,8 @@ func (c *RESTClient) CreateEnergyOperation(ctx context.Context, creator, compone
 
 	// Extract operation ID from events
 	operationID := fmt.Sprintf("op_%d", time.Now().Unix())
-	if events, ok := txResponse["events"].([]interface{}); ok {
-		for _, event := range events {
-			if eventMap, ok := event.(map[string]interface{}); ok {
-				if eventType, ok := eventMap["type"].(string); ok && eventType == "energy_operation_created" {
-					if attributes, ok := eventMap["attributes"].([]interface{}); ok {
-						for _, attr := range attributes {
-							if attrMap, ok := attr.(map[string]interface{}); ok {
-								if key, ok := attrMap["key"].(string); ok && key == "operation_id" {
-									if value, ok := attrMap["value"].(string); ok {
-										operationID = value
-										break
-									}
-								}
-							}
-						}
-					}
-				}
-			}
-		}
+	if value, ok := extractEventAttribute(txResponse, "energy_operation_created", "operation_id"); ok {
+		operationID = value
 	}
 
 	return map[string]interface{}{
@@ -1153,46 +2186,287 @@ func (c *RESTClient) CreateEnergyOperation(ctx context.Context, creator, compone
 	}, nil
 }
 
-// ExecuteEnergyTransfer executes an energy transfer using REST API
-func (c *RESTClient) ExecuteEnergyTransfer(ctx context.Context, creator, operationID string, amount float64, context string) (map[string]interface{}, error) {
-	c.logger.Info().Str("creator", creator).Str("operation_id", operationID).Float64("amount", amount).Msg("Executing energy transfer via REST")
+// ExecuteEnergyTransfer broadcasts a MsgExecuteEnergyTransfer for a pending
+// operation created by CreateEnergyOperation. The chain debits the source
+// LCT's balance, credits the target's and rejects transfers the source cannot
+// cover, which surface as ErrInsufficientEnergyBalance. energyOut is the
+// energy measured arriving at the target, empty when none was lost; the chain
+// credits it and records the operation's efficiency from it.
+func (c *RESTClient) ExecuteEnergyTransfer(ctx context.Context, creator, operationID string, amount float64, energyOut, context string) (map[string]interface{}, error) {
+	c.log(ctx).Info().Str("creator", creator).Str("operation_id", operationID).Float64("amount", amount).Str("energy_out", energyOut).Msg("Executing energy transfer via REST")
 
-	return map[string]interface{}{
+	message := map[string]interface{}{
+		"@type":         "/racecarweb.energycycle.v1.MsgExecuteEnergyTransfer",
+		"creator":       creator,
+		"operation_id":  operationID,
+		"transfer_data": context,
+	}
+	if energyOut != "" {
+		message["energy_out"] = energyOut
+	}
+
+	txResult, err := c.executeTransaction(ctx, message, "energy_transfer")
+	if err == nil {
+		txResult, err = c.awaitCommit(ctx, txResult)
+	}
+	if err != nil {
+		c.log(ctx).Error().Err(err).Str("operation_id", operationID).Msg("Blockchain transaction failed for energy transfer")
+		return nil, fmt.Errorf("blockchain transaction failed: %w", err)
+	}
+
+	if code, ok := txResultCode(txResult); ok && code != 0 {
+		rawLog, _ := txResult["raw_log"].(string)
+		c.log(ctx).Error().Int("code", code).Str("operation_id", operationID).Str("raw_log", rawLog).Msg("Transaction failed for energy transfer")
+		if codespace, _ := txResult["codespace"].(string); codespace == energycycletypes.ModuleName {
+			switch uint32(code) {
+			case energycycletypes.ErrInsufficientBalance.ABCICode():
+				return nil, fmt.Errorf("%w: %s", ErrInsufficientEnergyBalance, rawLog)
+			case energycycletypes.ErrOperationNotPending.ABCICode():
+				return nil, fmt.Errorf("%w: %s", ErrEnergyOperationNotPending, rawLog)
+			case energycycletypes.ErrOperationNotFound.ABCICode():
+				return nil, fmt.Errorf("%w: %s", ErrEnergyOperationNotFound, operationID)
+			case energycycletypes.ErrUnauthorized.ABCICode():
+				return nil, fmt.Errorf("%w: %s", ErrEnergyTransferUnauthorized, rawLog)
+			}
+		}
+		return nil, fmt.Errorf("blockchain transaction failed with code %d: %s", code, rawLog)
+	}
+
+	txhash, _ := txResult["txhash"].(string)
+	c.log(ctx).Info().Str("operation_id", operationID).Str("txhash", txhash).Msg("Energy transfer executed successfully via blockchain")
+
+	// The chain moves the amount recorded on the operation and reports the
+	// balances it left behind
+	result := map[string]interface{}{
 		"operation_id": operationID,
 		"amount":       amount,
 		"status":       "completed",
-		"timestamp":    time.Now().Unix(),
-	}, nil
+		"timestamp":    txTime(txResult),
+		"txhash":       txhash,
+	}
+	if moved, ok := extractEventAttribute(txResult, "energy_transferred", "amount"); ok {
+		if value, err := strconv.ParseFloat(moved, 64); err == nil {
+			result["amount"] = value
+		}
+	}
+	for _, key := range []string{"source_lct", "target_lct"} {
+		if value, ok := extractEventAttribute(txResult, "energy_transferred", key); ok {
+			result[key] = value
+		}
+	}
+	for _, key := range []string{"delivered", "source_balance", "target_balance"} {
+		if value, ok := extractEventAttribute(txResult, "energy_transferred", key); ok {
+			if balance, err := strconv.ParseFloat(value, 64); err == nil {
+				result[key] = balance
+			}
+		}
+	}
+	return result, nil
+}
+
+// GetEnergyOperation reads an energy operation from the chain, so a caller of
+// CreateEnergyOperation can poll until its status moves on from "created"
+func (c *RESTClient) GetEnergyOperation(ctx context.Context, operationID string) (map[string]interface{}, error) {
+	c.log(ctx).Info().Str("operation_id", operationID).Msg("Getting energy operation via REST")
+
+	// int64 fields arrive as JSON strings
+	var response struct {
+		Operation struct {
+			OperationID      string `json:"operation_id"`
+			SourceLct        string `json:"source_lct"`
+			TargetLct        string `json:"target_lct"`
+			EnergyAmount     string `json:"energy_amount"`
+			OperationType    string `json:"operation_type"`
+			Status           string `json:"status"`
+			Timestamp        int64  `json:"timestamp,string"`
+			BlockHeight      int64  `json:"block_height,string"`
+			TrustScore       string `json:"trust_score"`
+			AtpTokenID       string `json:"atp_token_id"`
+			AdpTokenID       string `json:"adp_token_id"`
+			EnergyEfficiency string `json:"energy_efficiency"`
+			EnergyIn         string `json:"energy_in"`
+			EnergyOut        string `json:"energy_out"`
+			Version          int64  `json:"version,string"`
+		} `json:"operation"`
+	}
+	if err := c.queryJSON(ctx, "/racecar-web/energycycle/v1/energy_operation/"+url.PathEscape(operationID), &response); err != nil {
+		var httpErr *HTTPError
+		if errors.As(err, &httpErr) && httpErr.StatusCode == http.StatusNotFound {
+			return nil, fmt.Errorf("%w: %s", ErrEnergyOperationNotFound, operationID)
+		}
+		return nil, fmt.Errorf("failed to get energy operation: %w", err)
+	}
+
+	operation := response.Operation
+	amount, err := strconv.ParseFloat(operation.EnergyAmount, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid energy amount %q: %w", operation.EnergyAmount, err)
+	}
+	result := map[string]interface{}{
+		"operation_id": operation.OperationID,
+		"source_lct":   operation.SourceLct,
+		"target_lct":   operation.TargetLct,
+		"amount":       amount,
+		"type":         operation.OperationType,
+		"status":       operation.Status,
+		"timestamp":    operation.Timestamp,
+		"block_height": operation.BlockHeight,
+		"atp_token_id": operation.AtpTokenID,
+		"adp_token_id": operation.AdpTokenID,
+		"version":      operation.Version,
+	}
+	// Measurements the operation has not recorded yet are left out
+	for key, value := range map[string]string{
+		"trust_score":       operation.TrustScore,
+		"energy_in":         operation.EnergyIn,
+		"energy_out":        operation.EnergyOut,
+		"energy_efficiency": operation.EnergyEfficiency,
+	} {
+		if parsed, err := strconv.ParseFloat(value, 64); err == nil {
+			result[key] = parsed
+		}
+	}
+	return result, nil
+}
+
+// GetOperationEfficiency reads the ratio of energy an operation delivered to
+// the energy it drew. ErrEnergyEfficiencyUnavailable reports an operation
+// that drew nothing or has not recorded its output.
+func (c *RESTClient) GetOperationEfficiency(ctx context.Context, operationID string) (map[string]interface{}, error) {
+	c.log(ctx).Info().Str("operation_id", operationID).Msg("Getting energy operation efficiency via REST")
+
+	var response struct {
+		OperationID string `json:"operation_id"`
+		EnergyIn    string `json:"energy_in"`
+		EnergyOut   string `json:"energy_out"`
+		Efficiency  string `json:"efficiency"`
+	}
+	if err := c.queryJSON(ctx, "/racecar-web/energycycle/v1/operation_efficiency/"+url.PathEscape(operationID), &response); err != nil {
+		var httpErr *HTTPError
+		if errors.As(err, &httpErr) {
+			switch httpErr.StatusCode {
+			case http.StatusNotFound:
+				return nil, fmt.Errorf("%w: %s", ErrEnergyOperationNotFound, operationID)
+			case http.StatusBadRequest:
+				var status struct {
+					Message string `json:"message"`
+				}
+				if json.Unmarshal([]byte(httpErr.Body), &status) == nil && status.Message != "" {
+					return nil, fmt.Errorf("%w: %s", ErrEnergyEfficiencyUnavailable, status.Message)
+				}
+			}
+		}
+		return nil, fmt.Errorf("failed to get energy operation efficiency: %w", err)
+	}
+
+	result := map[string]interface{}{"operation_id": response.OperationID}
+	for key, value := range map[string]string{"energy_in": response.EnergyIn, "energy_out": response.EnergyOut, "efficiency": response.Efficiency} {
+		parsed, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s %q: %w", key, value, err)
+		}
+		result[key] = parsed
+	}
+	return result, nil
 }
 
 // GetEnergyBalance gets the energy balance for a component
 func (c *RESTClient) GetEnergyBalance(ctx context.Context, componentID string) (map[string]interface{}, error) {
-	c.logger.Info().Str("component_id", componentID).Msg("Getting energy balance via REST")
+	c.log(ctx).Info().Str("component_id", componentID).Msg("Getting energy balance via REST")
 
-	// Make the request to the blockchain
-	respBody, err := c.makeRequest("GET", fmt.Sprintf("/racecar-web/energycycle/v1/relationship_energy_balance/%s", componentID), nil)
-	if err != nil {
+	var response struct {
+		Balance map[string]interface{} `json:"relationship_energy_balance"`
+	}
+	if err := c.queryJSON(ctx, fmt.Sprintf("/racecar-web/energycycle/v1/relationship_energy_balance/%s", componentID), &response); err != nil {
 		return nil, fmt.Errorf("failed to get energy balance: %w", err)
 	}
+	if response.Balance == nil {
+		return nil, fmt.Errorf("invalid response format: relationship_energy_balance not found")
+	}
 
-	// Parse the response
-	var response map[string]interface{}
-	if err := json.Unmarshal(respBody, &response); err != nil {
-		return nil, fmt.Errorf("failed to parse response: %w", err)
+	return response.Balance, nil
+}
+
+// GetNetworkEnergyBalance balances the settled energy flow across the LCT
+// network reachable from a component through active LCTs.
+// ErrEnergyNetworkTooLarge reports a network beyond the chain's bounds.
+func (c *RESTClient) GetNetworkEnergyBalance(ctx context.Context, rootComponentID string) (map[string]interface{}, error) {
+	c.log(ctx).Info().Str("root_component_id", rootComponentID).Msg("Getting network energy balance via REST")
+
+	endpoint := "/racecar-web/energycycle/v1/network_energy_balance/" + url.PathEscape(rootComponentID)
+	var response struct {
+		Balance struct {
+			RootComponentID  string                   `json:"root_component_id"`
+			ComponentIDs     []string                 `json:"component_ids"`
+			Lcts             []map[string]interface{} `json:"lcts"`
+			TotalInflow      string                   `json:"total_inflow"`
+			TotalOutflow     string                   `json:"total_outflow"`
+			InternalFlow     string                   `json:"internal_flow"`
+			NetFlow          string                   `json:"net_flow"`
+			Stored           string                   `json:"stored"`
+			Balanced         bool                     `json:"balanced"`
+			ImbalancedLctIDs []string                 `json:"imbalanced_lct_ids"`
+		} `json:"balance"`
+	}
+	if err := c.queryJSON(ctx, endpoint, &response); err != nil {
+		var httpErr *HTTPError
+		if errors.As(err, &httpErr) && httpErr.StatusCode == http.StatusBadRequest {
+			var status struct {
+				Message string `json:"message"`
+			}
+			if json.Unmarshal([]byte(httpErr.Body), &status) == nil && status.Message != "" {
+				return nil, fmt.Errorf("%w: %s", ErrEnergyNetworkTooLarge, status.Message)
+			}
+		}
+		return nil, fmt.Errorf("failed to get network energy balance: %w", err)
 	}
 
-	// Extract balance data
-	balance, ok := response["relationship_energy_balance"].(map[string]interface{})
-	if !ok {
-		return nil, fmt.Errorf("invalid response format: relationship_energy_balance not found")
+	// The chain omits empty lists
+	balance := response.Balance
+	if balance.ComponentIDs == nil {
+		balance.ComponentIDs = []string{}
+	}
+	if balance.Lcts == nil {
+		balance.Lcts = []map[string]interface{}{}
+	}
+	if balance.ImbalancedLctIDs == nil {
+		balance.ImbalancedLctIDs = []string{}
 	}
 
-	return balance, nil
+	return map[string]interface{}{
+		"root_component_id":  balance.RootComponentID,
+		"component_ids":      balance.ComponentIDs,
+		"lcts":               balance.Lcts,
+		"total_inflow":       balance.TotalInflow,
+		"total_outflow":      balance.TotalOutflow,
+		"internal_flow":      balance.InternalFlow,
+		"net_flow":           balance.NetFlow,
+		"stored":             balance.Stored,
+		"balanced":           balance.Balanced,
+		"imbalanced_lct_ids": balance.ImbalancedLctIDs,
+	}, nil
 }
 
-// executeTransactionWithIgnite uses Ignite CLI to sign and broadcast a transaction
-func (c *RESTClient) executeTransactionWithIgnite(ctx context.Context, message map[string]interface{}, memo string) (map[string]interface{}, error) {
-	c.logger.Info().Interface("message", message).Msg("Executing transaction with Ignite CLI")
+// executeTransaction resolves the signing account for the message creator and
+// hands the message to the configured TxExecutor (Ignite CLI or native signer)
+func (c *RESTClient) executeTransaction(ctx context.Context, message map[string]interface{}, memo string) (txResult map[string]interface{}, err error) {
+	msgType, _ := message["@type"].(string)
+	ctx, span := startSpan(ctx, "blockchain.tx", trace.WithAttributes(
+		attribute.String("tx.msg_type", msgType),
+		attribute.String("tx.mode", c.txExecutor.Mode()),
+	))
+	defer func() {
+		if txhash, ok := txResult["txhash"].(string); ok {
+			span.SetAttributes(attribute.String("tx.hash", txhash))
+		}
+		endSpan(span, err)
+	}()
+
+	c.log(ctx).Info().Interface("message", message).Str("tx_mode", c.txExecutor.Mode()).Msg("Executing transaction")
+
+	if err := c.checkMessageType(message); err != nil {
+		return nil, err
+	}
 
 	// Extract creator from message
 	creator, ok := message["creator"].(string)
@@ -1202,30 +2476,102 @@ func (c *RESTClient) executeTransactionWithIgnite(ctx context.Context, message m
 
 	// Get the best account for this creator
 	account := c.accountManager.GetAccountForCreator(creator)
-	c.logger.Info().Str("creator", creator).Str("account", account.Name).Str("address", account.Address).Msg("Using account for transaction")
+	c.log(ctx).Info().Str("creator", creator).Str("account", account.Name).Str("address", account.Address).Msg("Using account for transaction")
 
 	// Update the message to use the account address instead of name
 	message["creator"] = account.Address
 
-	// Create transaction file
-	txFile, err := c.createTransactionFile(message, memo)
-	if err != nil {
-		c.logger.Error().Err(err).Msg("Failed to create transaction file - this demo requires real blockchain integration")
-		return nil, fmt.Errorf("failed to create transaction file: %w", err)
+	if err := c.breaker.allow(); err != nil {
+		return nil, err
 	}
-	defer txFile.Close()
 
-	// Execute transaction with Ignite CLI - this must succeed for the demo
-	txResult, err := c.broadcastTransactionWithIgnite(ctx, account.Name, txFile.Name(), message)
+	// Transactions from one account are signed one at a time against its predicted sequence
+	err = c.accountManager.WithSequenceLock(ctx, account, func(sequence *AccountSequence) error {
+		txResult, err = c.broadcastInSequence(ctx, sequence, account, message, memo)
+		return err
+	})
+	c.breaker.record(err)
 	if err != nil {
-		c.logger.Error().Err(err).Msg("Failed to broadcast transaction with Ignite CLI - this demo requires real blockchain integration")
+		c.log(ctx).Error().Err(err).Str("tx_mode", c.txExecutor.Mode()).Msg("Failed to broadcast transaction - this demo requires real blockchain integration")
 		return nil, fmt.Errorf("failed to broadcast transaction: %w", err)
 	}
 
-	c.logger.Info().Str("account", account.Name).Str("txhash", txResult["txhash"].(string)).Msg("Transaction broadcast successfully")
+	txhash, _ := txResult["txhash"].(string)
+	recordTxHash(ctx, txhash)
+	c.log(ctx).Info().Str("account", account.Name).Str("txhash", txhash).Msg("Transaction broadcast successfully")
 	return txResult, nil
 }
 
+// broadcastInSequence runs the retry loop for one transaction while the
+// account's sequence lock is held. It signs with the predicted sequence,
+// reading it from the chain when there is none, and advances the prediction
+// once the node accepts the transaction. Any other outcome drops the
+// prediction so the next transaction resyncs from the chain.
+func (c *RESTClient) broadcastInSequence(ctx context.Context, sequence *AccountSequence, account *Account, message map[string]interface{}, memo string) (map[string]interface{}, error) {
+	next, known := sequence.Next()
+	if !known {
+		committed, err := c.queryAccountSequence(ctx, account.Address)
+		if err != nil {
+			// The executor falls back to reading the sequence itself
+			c.log(ctx).Warn().Err(err).Str("address", account.Address).Msg("Failed to read account sequence")
+		} else {
+			next, known = committed, true
+		}
+	}
+	if known {
+		ctx = withSequenceOverride(ctx, next)
+	}
+
+	var signedWith uint64
+	var pinned bool
+
+	// Executors may rewrite the creator, so the signer is read back after each attempt
+	retrier := newTxRetrier(c.retry, c.logger, c.queryAccountSequence)
+	result, err := retrier.run(ctx, func() string {
+		signer, _ := message["creator"].(string)
+		return signer
+	}, func(ctx context.Context) (map[string]interface{}, error) {
+		signedWith, pinned = sequenceOverride(ctx)
+
+		// Estimated per attempt so a retried broadcast simulates with its refreshed sequence
+		gas, err := c.estimateGas(ctx, account, message, memo)
+		if err != nil {
+			return nil, err
+		}
+
+		return c.broadcast(ctx, account, message, memo, gas)
+	})
+
+	if code, _ := txResultCode(result); err == nil && code == 0 && pinned {
+		sequence.Committed(signedWith)
+	} else {
+		sequence.Reset()
+	}
+	return result, err
+}
+
+// broadcast signs and broadcasts one attempt of a transaction through the
+// configured executor, tracing it with the resulting tx hash
+func (c *RESTClient) broadcast(ctx context.Context, account *Account, message map[string]interface{}, memo string, gas txGas) (result map[string]interface{}, err error) {
+	ctx, span := startSpan(ctx, "blockchain.broadcast", trace.WithAttributes(
+		attribute.String("tx.account", account.Name),
+		attribute.Int64("tx.gas_limit", int64(gas.Limit)),
+	))
+	defer func() {
+		if txhash, ok := result["txhash"].(string); ok {
+			span.SetAttributes(attribute.String("tx.hash", txhash))
+		}
+		endSpan(span, err)
+	}()
+
+	result, err = c.txExecutor.Execute(ctx, account, message, memo, gas)
+	if err != nil {
+		return nil, err
+	}
+	applyGas(result, gas)
+	return result, nil
+}
+
 // createTransactionFile creates a temporary transaction file for Ignite CLI
 func (c *RESTClient) createTransactionFile(message map[string]interface{}, memo string) (*os.File, error) {
 	// Create transaction structure
@@ -1260,24 +2606,45 @@ func (c *RESTClient) createTransactionFile(message map[string]interface{}, memo
 	return file, nil
 }
 
+// withTransactionFile writes the transaction to a temporary file and runs fn
+// with its path. The file is closed and removed once fn returns, whether it
+// succeeded, failed or panicked, so broadcasts never leave tx files behind.
+func (c *RESTClient) withTransactionFile(message map[string]interface{}, memo string, fn func(path string) error) error {
+	file, err := c.createTransactionFile(message, memo)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		file.Close()
+		if err := os.Remove(file.Name()); err != nil && !errors.Is(err, os.ErrNotExist) {
+			c.logger.Warn().Err(err).Str("file", file.Name()).Msg("Failed to remove transaction file")
+		}
+	}()
+
+	return fn(file.Name())
+}
+
 // broadcastTransactionWithIgnite broadcasts a transaction using Ignite CLI
-func (c *RESTClient) broadcastTransactionWithIgnite(ctx context.Context, accountName, txFile string, message map[string]interface{}) (map[string]interface{}, error) {
-	c.logger.Info().Str("account", accountName).Str("tx_file", txFile).Msg("Broadcasting transaction with Ignite CLI")
+func (c *RESTClient) broadcastTransactionWithIgnite(ctx context.Context, accountName, txFile string, message map[string]interface{}, gas txGas) (map[string]interface{}, error) {
+	c.log(ctx).Info().Str("account", accountName).Str("tx_file", txFile).Msg("Broadcasting transaction with Ignite CLI")
 
 	// Use the discovered Ignite CLI path
 	igniteCmd := c.ignitePath
 
 	// Log the transaction file content for debugging
 	if content, err := os.ReadFile(txFile); err == nil {
-		c.logger.Info().Str("tx_content", string(content)).Msg("Transaction file content")
+		c.log(ctx).Info().Str("tx_content", string(content)).Msg("Transaction file content")
 	}
 
 	// Try the broadcast command first
-	args := []string{"tx", "broadcast", txFile, "--from", accountName, "--chain-id", "racecarweb", "--output", "json"}
-	c.logger.Info().Str("command", igniteCmd).Strs("args", args).Msg("Executing Ignite CLI broadcast command")
+	args := []string{"tx", "broadcast", txFile, "--from", accountName, "--chain-id", c.signingChainID(), "--output", "json"}
+	args = append(args, gasFlags(gas)...)
+	args = append(args, keyringFlags(c.keyring)...)
+	c.log(ctx).Info().Str("command", igniteCmd).Strs("args", args).Msg("Executing Ignite CLI broadcast command")
 
 	// Use Ignite CLI to broadcast transaction
-	cmd := exec.CommandContext(ctx, igniteCmd, args...)
+	cmd := cliCommand(ctx, igniteCmd, args...)
+	cmd.Stdin = keyringInput(c.keyring)
 
 	// Set working directory to the blockchain project
 	cmd.Dir = c.projectRoot
@@ -1285,25 +2652,31 @@ func (c *RESTClient) broadcastTransactionWithIgnite(ctx context.Context, account
 	// Capture both stdout and stderr
 	output, err := cmd.Output()
 	if err != nil {
+		// The request is gone or out of time; the fallback would be too
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			c.log(ctx).Warn().Err(ctxErr).Str("tx_file", txFile).Msg("Broadcast cancelled")
+			return nil, fmt.Errorf("ignite broadcast cancelled: %w", ctxErr)
+		}
+
 		// Get the error output for debugging
 		if exitErr, ok := err.(*exec.ExitError); ok {
 			stderr := string(exitErr.Stderr)
-			c.logger.Warn().Err(err).Str("stderr", stderr).Str("tx_file", txFile).Str("ignite_cmd", igniteCmd).Str("dir", cmd.Dir).Msg("Broadcast command failed, trying direct module command")
+			c.log(ctx).Warn().Err(err).Str("stderr", stderr).Str("tx_file", txFile).Str("ignite_cmd", igniteCmd).Str("dir", cmd.Dir).Msg("Broadcast command failed, trying direct module command")
 		} else {
-			c.logger.Warn().Err(err).Str("tx_file", txFile).Str("ignite_cmd", igniteCmd).Str("dir", cmd.Dir).Msg("Broadcast command failed, trying direct module command")
+			c.log(ctx).Warn().Err(err).Str("tx_file", txFile).Str("ignite_cmd", igniteCmd).Str("dir", cmd.Dir).Msg("Broadcast command failed, trying direct module command")
 		}
 
 		// Try the direct module command as fallback
-		return c.tryRacecarWebdCommand(ctx, accountName, message)
+		return c.tryRacecarWebdCommand(ctx, accountName, message, gas)
 	}
 
 	// Log the successful output for debugging
-	c.logger.Info().Str("output", string(output)).Msg("Ignite CLI broadcast successful")
+	c.log(ctx).Info().Str("output", string(output)).Msg("Ignite CLI broadcast successful")
 
 	// Parse the response
 	var result map[string]interface{}
 	if err := json.Unmarshal(output, &result); err != nil {
-		c.logger.Error().Err(err).Str("output", string(output)).Msg("Failed to parse Ignite CLI response")
+		c.log(ctx).Error().Err(err).Str("output", string(output)).Msg("Failed to parse Ignite CLI response")
 		return nil, fmt.Errorf("failed to parse transaction response: %w", err)
 	}
 
@@ -1311,8 +2684,8 @@ func (c *RESTClient) broadcastTransactionWithIgnite(ctx context.Context, account
 }
 
 // tryRacecarWebdCommand tries to execute the transaction using the racecar-webd binary directly
-func (c *RESTClient) tryRacecarWebdCommand(ctx context.Context, accountName string, message map[string]interface{}) (map[string]interface{}, error) {
-	c.logger.Info().Str("account", accountName).Msg("Trying racecar-webd command")
+func (c *RESTClient) tryRacecarWebdCommand(ctx context.Context, accountName string, message map[string]interface{}, gas txGas) (map[string]interface{}, error) {
+	c.log(ctx).Info().Str("account", accountName).Msg("Trying racecar-webd command")
 
 	// Determine the correct command based on message type
 	var args []string
@@ -1327,18 +2700,26 @@ func (c *RESTClient) tryRacecarWebdCommand(ctx context.Context, accountName stri
 			args = []string{"tx", "componentregistry", "register-component",
 				componentID, componentType, manufacturerData,
 				"--from", accountName,
-				"--chain-id", "racecarweb",
+				"--chain-id", c.signingChainID(),
+				"--output", "json",
+				"--yes"}
+		case "/racecarweb.componentregistry.v1.MsgVerifyComponent":
+			componentID := message["component_id"].(string)
+			args = []string{"tx", "componentregistry", "verify-component",
+				componentID,
+				"--from", accountName,
+				"--chain-id", c.signingChainID(),
 				"--output", "json",
 				"--yes"}
 		case "/racecarweb.lctmanager.v1.MsgCreateLctRelationship":
-			componentA := message["component_a_id"].(string)
-			componentB := message["component_b_id"].(string)
-			context := message["operational_context"].(string)
-			proxyID := message["proxy_component_id"].(string)
+			componentA := message["component_a"].(string)
+			componentB := message["component_b"].(string)
+			context := message["context"].(string)
+			proxyID := message["proxy_id"].(string)
 			args = []string{"tx", "lctmanager", "create-lct-relationship",
 				componentA, componentB, context, proxyID,
 				"--from", accountName,
-				"--chain-id", "racecarweb",
+				"--chain-id", c.signingChainID(),
 				"--output", "json",
 				"--yes"}
 		case "/racecarweb.pairing.v1.MsgInitiateBidirectionalPairing":
@@ -1350,7 +2731,7 @@ func (c *RESTClient) tryRacecarWebdCommand(ctx context.Context, accountName stri
 			args = []string{"tx", "pairing", "initiate-bidirectional-pairing",
 				componentA, componentB, operationalContext, proxyID, fmt.Sprintf("%t", forceImmediate),
 				"--from", accountName,
-				"--chain-id", "racecarweb",
+				"--chain-id", c.signingChainID(),
 				"--output", "json",
 				"--yes"}
 		case "/racecarweb.pairing.v1.MsgCompletePairing":
@@ -1361,36 +2742,39 @@ func (c *RESTClient) tryRacecarWebdCommand(ctx context.Context, accountName stri
 			args = []string{"tx", "pairing", "complete-pairing",
 				challengeID, componentAAuth, componentBAuth, sessionContext,
 				"--from", accountName,
-				"--chain-id", "racecarweb",
+				"--chain-id", c.signingChainID(),
 				"--output", "json",
 				"--yes"}
-		default:
-			// Fallback to component registry for unknown message types
-			args = []string{"tx", "componentregistry", "register-component",
-				"COMP-" + accountName + "-" + fmt.Sprintf("%d", time.Now().Unix()),
-				"module",
-				"test-data",
+		case "/racecarweb.pairing.v1.MsgRevokePairing":
+			lctID := message["lct_id"].(string)
+			reason := message["reason"].(string)
+			notifyOffline := message["notify_offline"].(bool)
+			args = []string{"tx", "pairing", "revoke-pairing",
+				lctID, reason, fmt.Sprintf("%t", notifyOffline),
 				"--from", accountName,
-				"--chain-id", "racecarweb",
+				"--chain-id", c.signingChainID(),
 				"--output", "json",
 				"--yes"}
+		default:
+			// Only these messages have a racecar-webd command; never broadcast a substitute
+			return nil, fmt.Errorf("%w: no racecar-webd command for %s", ErrUnsupportedMessageType, messageType)
 		}
 	} else {
-		// Fallback for messages without @type
-		args = []string{"tx", "componentregistry", "register-component",
-			"COMP-" + accountName + "-" + fmt.Sprintf("%d", time.Now().Unix()),
-			"module",
-			"test-data",
-			"--from", accountName,
-			"--chain-id", "racecarweb",
-			"--output", "json",
-			"--yes"}
+		return nil, fmt.Errorf("%w: message has no @type", ErrUnsupportedMessageType)
+	}
+
+	// A retry after a sequence mismatch pins the sequence the node expects
+	if sequence, ok := sequenceOverride(ctx); ok {
+		args = append(args, "--sequence", strconv.FormatUint(sequence, 10))
 	}
+	args = append(args, gasFlags(gas)...)
+	args = append(args, keyringFlags(c.keyring)...)
 
-	c.logger.Info().Str("command", racecarCmd).Strs("args", args).Msg("Executing racecar-webd command")
+	c.log(ctx).Info().Str("command", racecarCmd).Strs("args", args).Msg("Executing racecar-webd command")
 
 	// Use racecar-webd to execute transaction
-	cmd := exec.CommandContext(ctx, racecarCmd, args...)
+	cmd := cliCommand(ctx, racecarCmd, args...)
+	cmd.Stdin = keyringInput(c.keyring)
 
 	// Set working directory to the blockchain project
 	cmd.Dir = c.projectRoot
@@ -1398,23 +2782,29 @@ func (c *RESTClient) tryRacecarWebdCommand(ctx context.Context, accountName stri
 	// Capture both stdout and stderr
 	output, err := cmd.Output()
 	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			c.log(ctx).Warn().Err(ctxErr).Str("racecar_cmd", racecarCmd).Msg("Racecar-webd command cancelled")
+			return nil, fmt.Errorf("racecar-webd command cancelled: %w", ctxErr)
+		}
+
 		// Get the error output for debugging
 		if exitErr, ok := err.(*exec.ExitError); ok {
 			stderr := string(exitErr.Stderr)
-			c.logger.Error().Err(err).Str("stderr", stderr).Str("racecar_cmd", racecarCmd).Str("dir", cmd.Dir).Msg("Racecar-webd command failed")
+			c.log(ctx).Error().Err(err).Str("stderr", stderr).Str("racecar_cmd", racecarCmd).Str("dir", cmd.Dir).Msg("Racecar-webd command failed")
+			return nil, fmt.Errorf("racecar-webd command failed: %w: %s", err, strings.TrimSpace(stderr))
 		} else {
-			c.logger.Error().Err(err).Str("racecar_cmd", racecarCmd).Str("dir", cmd.Dir).Msg("Racecar-webd command failed")
+			c.log(ctx).Error().Err(err).Str("racecar_cmd", racecarCmd).Str("dir", cmd.Dir).Msg("Racecar-webd command failed")
 		}
 		return nil, fmt.Errorf("racecar-webd command failed: %w", err)
 	}
 
 	// Log the successful output for debugging
-	c.logger.Info().Str("output", string(output)).Msg("Racecar-webd command successful")
+	c.log(ctx).Info().Str("output", string(output)).Msg("Racecar-webd command successful")
 
 	// Parse the response
 	var result map[string]interface{}
 	if err := json.Unmarshal(output, &result); err != nil {
-		c.logger.Error().Err(err).Str("output", string(output)).Msg("Failed to parse racecar-webd response")
+		c.log(ctx).Error().Err(err).Str("output", string(output)).Msg("Failed to parse racecar-webd response")
 		return nil, fmt.Errorf("failed to parse racecar-webd response: %w", err)
 	}
 
@@ -1423,9 +2813,13 @@ func (c *RESTClient) tryRacecarWebdCommand(ctx context.Context, accountName stri
 
 // testBlockchainConnection tests if the blockchain is accessible
 func (c *RESTClient) testBlockchainConnection(ctx context.Context) error {
-	c.logger.Info().Str("endpoint", c.baseURL).Msg("Testing blockchain connection")
+	c.log(ctx).Debug().Str("endpoint", c.baseURL).Msg("Testing blockchain connection")
 
-	resp, err := c.client.Get(c.baseURL + "/cosmos/base/tendermint/v1beta1/node_info")
+	req, err := http.NewRequestWithContext(ctx, "GET", c.baseURL+"/cosmos/base/tendermint/v1beta1/node_info", nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	resp, err := c.client.Do(req)
 	if err != nil {
 		return fmt.Errorf("failed to connect to blockchain: %w", err)
 	}
@@ -1445,53 +2839,25 @@ func (c *RESTClient) testBlockchainConnection(ctx context.Context) error {
 		return fmt.Errorf("failed to parse node info: %w", err)
 	}
 
-	c.logger.Info().Interface("node_info", nodeInfo).Msg("Blockchain connection successful")
+	c.log(ctx).Debug().Interface("node_info", nodeInfo).Msg("Blockchain connection successful")
 	return nil
 }
 
-// testIgniteCLI tests if Ignite CLI is available and working
+// testIgniteCLI tests if the Ignite CLI found at startup is working
 func (c *RESTClient) testIgniteCLI(ctx context.Context) error {
-	c.logger.Info().Msg("Testing Ignite CLI availability")
-
-	// First, check if ignite command exists
-	cmd := exec.CommandContext(ctx, "which", "ignite")
-	output, err := cmd.Output()
-	if err != nil {
-		c.logger.Error().Err(err).Msg("Ignite CLI not found in PATH")
-
-		// Try common installation paths
-		commonPaths := []string{
-			"/usr/local/bin/ignite",
-			"/usr/bin/ignite",
-			"/home/msmith/.local/bin/ignite",
-			"/home/msmith/go/bin/ignite",
-		}
-
-		for _, path := range commonPaths {
-			if _, err := os.Stat(path); err == nil {
-				c.logger.Info().Str("path", path).Msg("Found Ignite CLI at path")
-				// Update the command to use full path
-				return c.testIgniteCLIWithPath(ctx, path)
-			}
-		}
-
-		return fmt.Errorf("ignite CLI not found in PATH or common locations")
+	if c.igniteErr != nil {
+		return c.igniteErr
 	}
-
-	ignitePath := strings.TrimSpace(string(output))
-	c.logger.Info().Str("path", ignitePath).Msg("Found Ignite CLI")
-
-	return c.testIgniteCLIWithPath(ctx, ignitePath)
+	return c.testIgniteCLIWithPath(ctx, c.ignitePath)
 }
 
 // testIgniteCLIWithPath tests Ignite CLI using a specific path
 func (c *RESTClient) testIgniteCLIWithPath(ctx context.Context, ignitePath string) error {
-	c.ignitePath = ignitePath
 	// Use dynamic environment variables
 	home := os.Getenv("HOME")
 	gopath := os.Getenv("GOPATH")
 	pathEnv := os.Getenv("PATH")
-	cmd := exec.CommandContext(ctx, ignitePath, "version")
+	cmd := cliCommand(ctx, ignitePath, "version")
 	cmd.Env = append(os.Environ(),
 		"HOME="+home,
 		"GOPATH="+gopath,
@@ -1504,12 +2870,13 @@ func (c *RESTClient) testIgniteCLIWithPath(ctx context.Context, ignitePath strin
 		if exitErr, ok := err.(*exec.ExitError); ok {
 			stderr = string(exitErr.Stderr)
 		}
-		c.logger.Error().Err(err).Str("path", ignitePath).Str("stderr", stderr).Str("dir", cmd.Dir).Msg("Ignite CLI version check failed")
-		return fmt.Errorf("ignite CLI version check failed: %w (stderr: %s)", err, stderr)
+		c.log(ctx).Error().Err(err).Str("path", ignitePath).Str("stderr", stderr).Str("dir", cmd.Dir).Msg("Ignite CLI version check failed")
+		// stderr stays in the log; callers may show this error to clients
+		return fmt.Errorf("ignite CLI version check failed: %w", err)
 	}
-	c.logger.Info().Str("version", string(output)).Str("path", ignitePath).Str("dir", cmd.Dir).Msg("Ignite CLI is available")
+	c.log(ctx).Info().Str("version", string(output)).Str("path", ignitePath).Str("dir", cmd.Dir).Msg("Ignite CLI is available")
 	// Test if we can list accounts
-	cmd = exec.CommandContext(ctx, ignitePath, "keys", "list")
+	cmd = cliCommand(ctx, ignitePath, "keys", "list")
 	cmd.Env = append(os.Environ(),
 		"HOME="+home,
 		"GOPATH="+gopath,
@@ -1522,9 +2889,9 @@ func (c *RESTClient) testIgniteCLIWithPath(ctx context.Context, ignitePath strin
 		if exitErr, ok := err.(*exec.ExitError); ok {
 			stderr = string(exitErr.Stderr)
 		}
-		c.logger.Warn().Err(err).Str("path", ignitePath).Str("stderr", stderr).Str("dir", cmd.Dir).Msg("Cannot list Ignite CLI accounts")
+		c.log(ctx).Warn().Err(err).Str("path", ignitePath).Str("stderr", stderr).Str("dir", cmd.Dir).Msg("Cannot list Ignite CLI accounts")
 	} else {
-		c.logger.Info().Str("accounts", string(output)).Str("path", ignitePath).Str("dir", cmd.Dir).Msg("Available Ignite CLI accounts")
+		c.log(ctx).Info().Str("accounts", string(output)).Str("path", ignitePath).Str("dir", cmd.Dir).Msg("Available Ignite CLI accounts")
 	}
 	return nil
 }
@@ -1543,13 +2910,13 @@ func (c *RESTClient) testIgniteCLIInProject(ctx context.Context) error {
 	defer os.Chdir(originalDir)
 
 	// Test ignite version
-	cmd := exec.CommandContext(ctx, c.ignitePath, "version")
+	cmd := cliCommand(ctx, c.ignitePath, "version")
 	output, err := cmd.CombinedOutput()
 	if err != nil {
 		return fmt.Errorf("ignite version failed: %w, output: %s", err, string(output))
 	}
 
-	c.logger.Info().Str("output", string(output)).Msg("Ignite CLI test successful in project directory")
+	c.log(ctx).Info().Str("output", string(output)).Msg("Ignite CLI test successful in project directory")
 	return nil
 }
 
@@ -1565,16 +2932,16 @@ func (c *RESTClient) QueuePairingRequest(ctx context.Context, componentA, compon
 		"proxy_id":            proxyID,
 	}
 
-	// Execute the transaction using Ignite CLI
-	txResult, err := c.executeTransactionWithIgnite(ctx, message, "Queue pairing request")
+	// Execute the transaction
+	txResult, err := c.executeTransaction(ctx, message, "Queue pairing request")
 	if err != nil {
-		c.logger.Error().Err(err).Msg("Ignite CLI transaction failed for queue pairing request")
+		c.log(ctx).Error().Err(err).Msg("Blockchain transaction failed for queue pairing request")
 		return nil, fmt.Errorf("blockchain transaction failed: %w", err)
 	}
 
 	// Check if transaction was successful
 	if code, ok := txResult["code"].(int); ok && code != 0 {
-		c.logger.Error().Int("code", code).Msg("Transaction failed for queue pairing request")
+		c.log(ctx).Error().Int("code", code).Msg("Transaction failed for queue pairing request")
 		return nil, fmt.Errorf("blockchain transaction failed with code %d", code)
 	}
 
@@ -1582,24 +2949,11 @@ func (c *RESTClient) QueuePairingRequest(ctx context.Context, componentA, compon
 	txhash := txResult["txhash"].(string)
 	requestID := fmt.Sprintf("queue_%s_%s_%d", componentA, componentB, time.Now().Unix())
 
-	if events, ok := txResult["events"].([]map[string]interface{}); ok {
-		for _, event := range events {
-			if eventType, ok := event["type"].(string); ok && eventType == "pairing_request_queued" {
-				if attributes, ok := event["attributes"].([]map[string]interface{}); ok {
-					for _, attr := range attributes {
-						if key, ok := attr["key"].(string); ok && key == "request_id" {
-							if value, ok := attr["value"].(string); ok {
-								requestID = value
-								break
-							}
-						}
-					}
-				}
-			}
-		}
+	if value, ok := extractEventAttribute(txResult, "pairing_request_queued", "request_id"); ok {
+		requestID = value
 	}
 
-	c.logger.Info().Str("request_id", requestID).Str("txhash", txhash).Msg("Pairing request queued successfully via blockchain")
+	c.log(ctx).Info().Str("request_id", requestID).Str("txhash", txhash).Msg("Pairing request queued successfully via blockchain")
 
 	return map[string]interface{}{
 		"request_id":          requestID,
@@ -1617,19 +2971,13 @@ func (c *RESTClient) QueuePairingRequest(ctx context.Context, componentA, compon
 func (c *RESTClient) GetQueueStatus(ctx context.Context, componentID string) (map[string]interface{}, error) {
 	// Query via REST API
 	endpoint := fmt.Sprintf("/racecarweb/pairingqueue/v1/queue_status/%s", componentID)
-	resp, err := c.makeRequest("GET", endpoint, nil)
-	if err != nil {
-		c.logger.Error().Err(err).Msg("Failed to get queue status from blockchain")
-		return nil, fmt.Errorf("blockchain query failed: %w", err)
-	}
-
 	var result map[string]interface{}
-	if err := json.Unmarshal(resp, &result); err != nil {
-		c.logger.Error().Err(err).Msg("Failed to parse queue status response")
-		return nil, fmt.Errorf("failed to parse blockchain response: %w", err)
+	if err := c.queryJSON(ctx, endpoint, &result); err != nil {
+		c.log(ctx).Error().Err(err).Msg("Failed to get queue status from blockchain")
+		return nil, fmt.Errorf("blockchain query failed: %w", err)
 	}
 
-	c.logger.Info().Str("component_id", componentID).Msg("Queue status retrieved successfully from blockchain")
+	c.log(ctx).Info().Str("component_id", componentID).Msg("Queue status retrieved successfully from blockchain")
 	return result, nil
 }
 
@@ -1640,16 +2988,16 @@ func (c *RESTClient) ProcessOfflineQueue(ctx context.Context, componentID string
 		"component_id": componentID,
 	}
 
-	// Execute the transaction using Ignite CLI
-	txResult, err := c.executeTransactionWithIgnite(ctx, message, "Process offline queue")
+	// Execute the transaction
+	txResult, err := c.executeTransaction(ctx, message, "Process offline queue")
 	if err != nil {
-		c.logger.Error().Err(err).Msg("Ignite CLI transaction failed for processing offline queue")
+		c.log(ctx).Error().Err(err).Msg("Blockchain transaction failed for processing offline queue")
 		return nil, fmt.Errorf("blockchain transaction failed: %w", err)
 	}
 
 	// Check if transaction was successful
 	if code, ok := txResult["code"].(int); ok && code != 0 {
-		c.logger.Error().Int("code", code).Msg("Transaction failed for processing offline queue")
+		c.log(ctx).Error().Int("code", code).Msg("Transaction failed for processing offline queue")
 		return nil, fmt.Errorf("blockchain transaction failed with code %d", code)
 	}
 
@@ -1658,32 +3006,18 @@ func (c *RESTClient) ProcessOfflineQueue(ctx context.Context, componentID string
 	processedRequests := 0
 	failedRequests := 0
 
-	if events, ok := txResult["events"].([]map[string]interface{}); ok {
-		for _, event := range events {
-			if eventType, ok := event["type"].(string); ok && eventType == "offline_queue_processed" {
-				if attributes, ok := event["attributes"].([]map[string]interface{}); ok {
-					for _, attr := range attributes {
-						if key, ok := attr["key"].(string); ok {
-							if value, ok := attr["value"].(string); ok {
-								switch key {
-								case "processed_requests":
-									if count, err := strconv.Atoi(value); err == nil {
-										processedRequests = count
-									}
-								case "failed_requests":
-									if count, err := strconv.Atoi(value); err == nil {
-										failedRequests = count
-									}
-								}
-							}
-						}
-					}
-				}
-			}
+	if value, ok := extractEventAttribute(txResult, "offline_queue_processed", "processed_requests"); ok {
+		if count, err := strconv.Atoi(value); err == nil {
+			processedRequests = count
+		}
+	}
+	if value, ok := extractEventAttribute(txResult, "offline_queue_processed", "failed_requests"); ok {
+		if count, err := strconv.Atoi(value); err == nil {
+			failedRequests = count
 		}
 	}
 
-	c.logger.Info().Str("component_id", componentID).Str("txhash", txhash).Msg("Offline queue processed successfully via blockchain")
+	c.log(ctx).Info().Str("component_id", componentID).Str("txhash", txhash).Msg("Offline queue processed successfully via blockchain")
 
 	return map[string]interface{}{
 		"component_id":       componentID,
@@ -1703,23 +3037,23 @@ func (c *RESTClient) CancelRequest(ctx context.Context, requestID, reason string
 		"reason":     reason,
 	}
 
-	// Execute the transaction using Ignite CLI
-	txResult, err := c.executeTransactionWithIgnite(ctx, message, "Cancel request")
+	// Execute the transaction
+	txResult, err := c.executeTransaction(ctx, message, "Cancel request")
 	if err != nil {
-		c.logger.Error().Err(err).Msg("Ignite CLI transaction failed for canceling request")
+		c.log(ctx).Error().Err(err).Msg("Blockchain transaction failed for canceling request")
 		return nil, fmt.Errorf("blockchain transaction failed: %w", err)
 	}
 
 	// Check if transaction was successful
 	if code, ok := txResult["code"].(int); ok && code != 0 {
-		c.logger.Error().Int("code", code).Msg("Transaction failed for canceling request")
+		c.log(ctx).Error().Int("code", code).Msg("Transaction failed for canceling request")
 		return nil, fmt.Errorf("blockchain transaction failed with code %d", code)
 	}
 
 	// Success! Extract data from events
 	txhash := txResult["txhash"].(string)
 
-	c.logger.Info().Str("request_id", requestID).Str("txhash", txhash).Msg("Request cancelled successfully via blockchain")
+	c.log(ctx).Info().Str("request_id", requestID).Str("txhash", txhash).Msg("Request cancelled successfully via blockchain")
 
 	return map[string]interface{}{
 		"request_id":   requestID,
@@ -1734,40 +3068,81 @@ func (c *RESTClient) CancelRequest(ctx context.Context, requestID, reason string
 func (c *RESTClient) GetQueuedRequests(ctx context.Context, componentID string) (map[string]interface{}, error) {
 	// Query via REST API
 	endpoint := fmt.Sprintf("/racecarweb/pairingqueue/v1/queued_requests/%s", componentID)
-	resp, err := c.makeRequest("GET", endpoint, nil)
-	if err != nil {
-		c.logger.Error().Err(err).Msg("Failed to get queued requests from blockchain")
-		return nil, fmt.Errorf("blockchain query failed: %w", err)
-	}
-
 	var result map[string]interface{}
-	if err := json.Unmarshal(resp, &result); err != nil {
-		c.logger.Error().Err(err).Msg("Failed to parse queued requests response")
-		return nil, fmt.Errorf("failed to parse blockchain response: %w", err)
+	if err := c.queryJSON(ctx, endpoint, &result); err != nil {
+		c.log(ctx).Error().Err(err).Msg("Failed to get queued requests from blockchain")
+		return nil, fmt.Errorf("blockchain query failed: %w", err)
 	}
 
-	c.logger.Info().Str("component_id", componentID).Msg("Queued requests retrieved successfully from blockchain")
+	c.log(ctx).Info().Str("component_id", componentID).Msg("Queued requests retrieved successfully from blockchain")
 	return result, nil
 }
 
-// ListProxyQueue lists all operations for a proxy
-func (c *RESTClient) ListProxyQueue(ctx context.Context, proxyID string) (map[string]interface{}, error) {
-	// Query via REST API
-	endpoint := fmt.Sprintf("/racecarweb/pairingqueue/v1/proxy_queue/%s", proxyID)
-	resp, err := c.makeRequest("GET", endpoint, nil)
-	if err != nil {
-		c.logger.Error().Err(err).Msg("Failed to get proxy queue from blockchain")
+// ProxyQueueFilter narrows a proxy queue listing; zero values match everything
+type ProxyQueueFilter struct {
+	Status        string // "pending", "retrying" or "failed"
+	OperationType string
+	MinAge        time.Duration
+	MaxAge        time.Duration
+}
+
+// ListProxyQueue lists one page of the offline operations for a proxy that
+// pass filter. key is the base64 next_key returned with the previous page.
+func (c *RESTClient) ListProxyQueue(ctx context.Context, proxyID string, filter ProxyQueueFilter, limit uint64, key string) (map[string]interface{}, error) {
+	params := url.Values{}
+	if filter.Status != "" {
+		params.Set("status", filter.Status)
+	}
+	if filter.OperationType != "" {
+		params.Set("operation_type", filter.OperationType)
+	}
+	if filter.MinAge > 0 {
+		params.Set("min_age_seconds", strconv.FormatInt(int64(filter.MinAge/time.Second), 10))
+	}
+	if filter.MaxAge > 0 {
+		params.Set("max_age_seconds", strconv.FormatInt(int64(filter.MaxAge/time.Second), 10))
+	}
+	if limit > 0 {
+		params.Set("pagination.limit", strconv.FormatUint(limit, 10))
+	}
+	if key != "" {
+		params.Set("pagination.key", key)
+	}
+
+	endpoint := "/racecar-web/pairingqueue/v1/list_proxy_queue/" + url.PathEscape(proxyID)
+	if len(params) > 0 {
+		endpoint += "?" + params.Encode()
+	}
+
+	// The operations come back as a JSON encoded string
+	var response struct {
+		OfflineOperations string `json:"offline_operations"`
+		Pagination        struct {
+			NextKey string `json:"next_key"`
+		} `json:"pagination"`
+	}
+	if err := c.queryJSON(ctx, endpoint, &response); err != nil {
+		c.log(ctx).Error().Err(err).Msg("Failed to get proxy queue from blockchain")
 		return nil, fmt.Errorf("blockchain query failed: %w", err)
 	}
 
-	var result map[string]interface{}
-	if err := json.Unmarshal(resp, &result); err != nil {
-		c.logger.Error().Err(err).Msg("Failed to parse proxy queue response")
-		return nil, fmt.Errorf("failed to parse blockchain response: %w", err)
+	var operations []map[string]interface{}
+	if response.OfflineOperations != "" {
+		if err := json.Unmarshal([]byte(response.OfflineOperations), &operations); err != nil {
+			return nil, fmt.Errorf("failed to parse offline operations: %w", err)
+		}
+	}
+	if operations == nil {
+		operations = []map[string]interface{}{}
 	}
 
-	c.logger.Info().Str("proxy_id", proxyID).Msg("Proxy queue retrieved successfully from blockchain")
-	return result, nil
+	c.log(ctx).Info().Str("proxy_id", proxyID).Int("count", len(operations)).Msg("Proxy queue retrieved successfully from blockchain")
+	return map[string]interface{}{
+		"proxy_id":   proxyID,
+		"operations": operations,
+		"count":      len(operations),
+		"next_key":   response.Pagination.NextKey,
+	}, nil
 }
 
 // Authorization Management Methods
@@ -1782,16 +3157,16 @@ func (c *RESTClient) CreatePairingAuthorization(ctx context.Context, componentA,
 		"authorization_rules": authorizationRules,
 	}
 
-	// Execute the transaction using Ignite CLI
-	txResult, err := c.executeTransactionWithIgnite(ctx, message, "Create pairing authorization")
+	// Execute the transaction
+	txResult, err := c.executeTransaction(ctx, message, "Create pairing authorization")
 	if err != nil {
-		c.logger.Error().Err(err).Msg("Ignite CLI transaction failed for creating pairing authorization")
+		c.log(ctx).Error().Err(err).Msg("Blockchain transaction failed for creating pairing authorization")
 		return nil, fmt.Errorf("blockchain transaction failed: %w", err)
 	}
 
 	// Check if transaction was successful
 	if code, ok := txResult["code"].(int); ok && code != 0 {
-		c.logger.Error().Int("code", code).Msg("Transaction failed for creating pairing authorization")
+		c.log(ctx).Error().Int("code", code).Msg("Transaction failed for creating pairing authorization")
 		return nil, fmt.Errorf("blockchain transaction failed with code %d", code)
 	}
 
@@ -1799,24 +3174,11 @@ func (c *RESTClient) CreatePairingAuthorization(ctx context.Context, componentA,
 	txhash := txResult["txhash"].(string)
 	authID := fmt.Sprintf("auth_%s_%s_%d", componentA, componentB, time.Now().Unix())
 
-	if events, ok := txResult["events"].([]map[string]interface{}); ok {
-		for _, event := range events {
-			if eventType, ok := event["type"].(string); ok && eventType == "pairing_authorization_created" {
-				if attributes, ok := event["attributes"].([]map[string]interface{}); ok {
-					for _, attr := range attributes {
-						if key, ok := attr["key"].(string); ok && key == "authorization_id" {
-							if value, ok := attr["value"].(string); ok {
-								authID = value
-								break
-							}
-						}
-					}
-				}
-			}
-		}
+	if value, ok := extractEventAttribute(txResult, "pairing_authorization_created", "authorization_id"); ok {
+		authID = value
 	}
 
-	c.logger.Info().Str("auth_id", authID).Str("txhash", txhash).Msg("Pairing authorization created successfully via blockchain")
+	c.log(ctx).Info().Str("auth_id", authID).Str("txhash", txhash).Msg("Pairing authorization created successfully via blockchain")
 
 	return map[string]interface{}{
 		"authorization_id":    authID,
@@ -1834,19 +3196,13 @@ func (c *RESTClient) CreatePairingAuthorization(ctx context.Context, componentA,
 func (c *RESTClient) GetComponentAuthorizations(ctx context.Context, componentID string) (map[string]interface{}, error) {
 	// Query via REST API
 	endpoint := fmt.Sprintf("/racecarweb/componentregistry/v1/authorizations/%s", componentID)
-	resp, err := c.makeRequest("GET", endpoint, nil)
-	if err != nil {
-		c.logger.Error().Err(err).Msg("Failed to get component authorizations from blockchain")
-		return nil, fmt.Errorf("blockchain query failed: %w", err)
-	}
-
 	var result map[string]interface{}
-	if err := json.Unmarshal(resp, &result); err != nil {
-		c.logger.Error().Err(err).Msg("Failed to parse component authorizations response")
-		return nil, fmt.Errorf("failed to parse blockchain response: %w", err)
+	if err := c.queryJSON(ctx, endpoint, &result); err != nil {
+		c.log(ctx).Error().Err(err).Msg("Failed to get component authorizations from blockchain")
+		return nil, fmt.Errorf("blockchain query failed: %w", err)
 	}
 
-	c.logger.Info().Str("component_id", componentID).Msg("Component authorizations retrieved successfully from blockchain")
+	c.log(ctx).Info().Str("component_id", componentID).Msg("Component authorizations retrieved successfully from blockchain")
 	return result, nil
 }
 
@@ -1862,23 +3218,23 @@ func (c *RESTClient) UpdateAuthorization(ctx context.Context, authorizationID st
 		message[key] = value
 	}
 
-	// Execute the transaction using Ignite CLI
-	txResult, err := c.executeTransactionWithIgnite(ctx, message, "Update authorization")
+	// Execute the transaction
+	txResult, err := c.executeTransaction(ctx, message, "Update authorization")
 	if err != nil {
-		c.logger.Error().Err(err).Msg("Ignite CLI transaction failed for updating authorization")
+		c.log(ctx).Error().Err(err).Msg("Blockchain transaction failed for updating authorization")
 		return nil, fmt.Errorf("blockchain transaction failed: %w", err)
 	}
 
 	// Check if transaction was successful
 	if code, ok := txResult["code"].(int); ok && code != 0 {
-		c.logger.Error().Int("code", code).Msg("Transaction failed for updating authorization")
+		c.log(ctx).Error().Int("code", code).Msg("Transaction failed for updating authorization")
 		return nil, fmt.Errorf("blockchain transaction failed with code %d", code)
 	}
 
 	// Success! Extract data from events
 	txhash := txResult["txhash"].(string)
 
-	c.logger.Info().Str("authorization_id", authorizationID).Str("txhash", txhash).Msg("Authorization updated successfully via blockchain")
+	c.log(ctx).Info().Str("authorization_id", authorizationID).Str("txhash", txhash).Msg("Authorization updated successfully via blockchain")
 
 	result := map[string]interface{}{
 		"authorization_id": authorizationID,
@@ -1903,23 +3259,23 @@ func (c *RESTClient) RevokeAuthorization(ctx context.Context, authorizationID, r
 		"reason":           reason,
 	}
 
-	// Execute the transaction using Ignite CLI
-	txResult, err := c.executeTransactionWithIgnite(ctx, message, "Revoke authorization")
+	// Execute the transaction
+	txResult, err := c.executeTransaction(ctx, message, "Revoke authorization")
 	if err != nil {
-		c.logger.Error().Err(err).Msg("Ignite CLI transaction failed for revoking authorization")
+		c.log(ctx).Error().Err(err).Msg("Blockchain transaction failed for revoking authorization")
 		return nil, fmt.Errorf("blockchain transaction failed: %w", err)
 	}
 
 	// Check if transaction was successful
 	if code, ok := txResult["code"].(int); ok && code != 0 {
-		c.logger.Error().Int("code", code).Msg("Transaction failed for revoking authorization")
+		c.log(ctx).Error().Int("code", code).Msg("Transaction failed for revoking authorization")
 		return nil, fmt.Errorf("blockchain transaction failed with code %d", code)
 	}
 
 	// Success! Extract data from events
 	txhash := txResult["txhash"].(string)
 
-	c.logger.Info().Str("authorization_id", authorizationID).Str("txhash", txhash).Msg("Authorization revoked successfully via blockchain")
+	c.log(ctx).Info().Str("authorization_id", authorizationID).Str("txhash", txhash).Msg("Authorization revoked successfully via blockchain")
 
 	return map[string]interface{}{
 		"authorization_id": authorizationID,
@@ -1934,19 +3290,13 @@ func (c *RESTClient) RevokeAuthorization(ctx context.Context, authorizationID, r
 func (c *RESTClient) CheckPairingAuthorization(ctx context.Context, componentA, componentB, operationalContext string) (map[string]interface{}, error) {
 	// Query via REST API
 	endpoint := fmt.Sprintf("/racecarweb/componentregistry/v1/check_pairing_auth/%s/%s/%s", componentA, componentB, operationalContext)
-	resp, err := c.makeRequest("GET", endpoint, nil)
-	if err != nil {
-		c.logger.Error().Err(err).Msg("Failed to check pairing authorization from blockchain")
-		return nil, fmt.Errorf("blockchain query failed: %w", err)
-	}
-
 	var result map[string]interface{}
-	if err := json.Unmarshal(resp, &result); err != nil {
-		c.logger.Error().Err(err).Msg("Failed to parse pairing authorization response")
-		return nil, fmt.Errorf("failed to parse blockchain response: %w", err)
+	if err := c.queryJSON(ctx, endpoint, &result); err != nil {
+		c.log(ctx).Error().Err(err).Msg("Failed to check pairing authorization from blockchain")
+		return nil, fmt.Errorf("blockchain query failed: %w", err)
 	}
 
-	c.logger.Info().Str("component_a", componentA).Str("component_b", componentB).Msg("Pairing authorization checked successfully from blockchain")
+	c.log(ctx).Info().Str("component_a", componentA).Str("component_b", componentB).Msg("Pairing authorization checked successfully from blockchain")
 	return result, nil
 }
 
@@ -1961,17 +3311,21 @@ func (c *RESTClient) CalculateRelationshipTrust(ctx context.Context, componentA,
 		"operational_context": operationalContext,
 	}
 
-	// Execute the transaction using Ignite CLI
-	txResult, err := c.executeTransactionWithIgnite(ctx, message, "Calculate relationship trust")
+	// Execute the transaction
+	txResult, err := c.executeTransaction(ctx, message, "Calculate relationship trust")
+	if err == nil {
+		txResult, err = c.awaitCommit(ctx, txResult)
+	}
 	if err != nil {
-		c.logger.Error().Err(err).Msg("Ignite CLI transaction failed for calculating relationship trust")
+		c.log(ctx).Error().Err(err).Msg("Blockchain transaction failed for calculating relationship trust")
 		return nil, fmt.Errorf("blockchain transaction failed: %w", err)
 	}
 
 	// Check if transaction was successful
-	if code, ok := txResult["code"].(int); ok && code != 0 {
-		c.logger.Error().Int("code", code).Msg("Transaction failed for calculating relationship trust")
-		return nil, fmt.Errorf("blockchain transaction failed with code %d", code)
+	if code, ok := txResultCode(txResult); ok && code != 0 {
+		rawLog, _ := txResult["raw_log"].(string)
+		c.log(ctx).Error().Int("code", code).Str("raw_log", rawLog).Msg("Transaction failed for calculating relationship trust")
+		return nil, fmt.Errorf("blockchain transaction failed with code %d: %s", code, rawLog)
 	}
 
 	// Success! Extract data from events
@@ -1979,30 +3333,16 @@ func (c *RESTClient) CalculateRelationshipTrust(ctx context.Context, componentA,
 	tensorID := fmt.Sprintf("tensor_%s_%s_%d", componentA, componentB, time.Now().Unix())
 	trustScore := 0.75 // Default score
 
-	if events, ok := txResult["events"].([]map[string]interface{}); ok {
-		for _, event := range events {
-			if eventType, ok := event["type"].(string); ok && eventType == "relationship_trust_calculated" {
-				if attributes, ok := event["attributes"].([]map[string]interface{}); ok {
-					for _, attr := range attributes {
-						if key, ok := attr["key"].(string); ok {
-							if value, ok := attr["value"].(string); ok {
-								switch key {
-								case "tensor_id":
-									tensorID = value
-								case "trust_score":
-									if score, err := strconv.ParseFloat(value, 64); err == nil {
-										trustScore = score
-									}
-								}
-							}
-						}
-					}
-				}
-			}
+	if value, ok := extractEventAttribute(txResult, "relationship_trust_calculated", "tensor_id"); ok {
+		tensorID = value
+	}
+	if value, ok := extractEventAttribute(txResult, "relationship_trust_calculated", "trust_score"); ok {
+		if score, err := strconv.ParseFloat(value, 64); err == nil {
+			trustScore = score
 		}
 	}
 
-	c.logger.Info().Str("tensor_id", tensorID).Str("txhash", txhash).Msg("Relationship trust calculated successfully via blockchain")
+	c.log(ctx).Info().Str("tensor_id", tensorID).Str("txhash", txhash).Msg("Relationship trust calculated successfully via blockchain")
 
 	return map[string]interface{}{
 		"tensor_id":           tensorID,
@@ -2020,19 +3360,13 @@ func (c *RESTClient) CalculateRelationshipTrust(ctx context.Context, componentA,
 func (c *RESTClient) GetRelationshipTensor(ctx context.Context, componentA, componentB string) (map[string]interface{}, error) {
 	// Query via REST API
 	endpoint := fmt.Sprintf("/racecarweb/trusttensor/v1/relationship_tensor/%s/%s", componentA, componentB)
-	resp, err := c.makeRequest("GET", endpoint, nil)
-	if err != nil {
-		c.logger.Error().Err(err).Msg("Failed to get relationship tensor from blockchain")
-		return nil, fmt.Errorf("blockchain query failed: %w", err)
-	}
-
 	var result map[string]interface{}
-	if err := json.Unmarshal(resp, &result); err != nil {
-		c.logger.Error().Err(err).Msg("Failed to parse relationship tensor response")
-		return nil, fmt.Errorf("failed to parse blockchain response: %w", err)
+	if err := c.queryJSON(ctx, endpoint, &result); err != nil {
+		c.log(ctx).Error().Err(err).Msg("Failed to get relationship tensor from blockchain")
+		return nil, fmt.Errorf("blockchain query failed: %w", err)
 	}
 
-	c.logger.Info().Str("component_a", componentA).Str("component_b", componentB).Msg("Relationship tensor retrieved successfully from blockchain")
+	c.log(ctx).Info().Str("component_a", componentA).Str("component_b", componentB).Msg("Relationship tensor retrieved successfully from blockchain")
 	return result, nil
 }
 
@@ -2047,41 +3381,35 @@ func (c *RESTClient) UpdateTensorScore(ctx context.Context, creator, componentA,
 		"context":     context,
 	}
 
-	// Execute the transaction using Ignite CLI
-	txResult, err := c.executeTransactionWithIgnite(ctx, message, "Update tensor score")
+	// Execute the transaction
+	txResult, err := c.executeTransaction(ctx, message, "Update tensor score")
+	if err == nil {
+		txResult, err = c.awaitCommit(ctx, txResult)
+	}
 	if err != nil {
-		c.logger.Error().Err(err).Msg("Ignite CLI transaction failed for updating tensor score")
+		c.log(ctx).Error().Err(err).Msg("Blockchain transaction failed for updating tensor score")
 		return nil, fmt.Errorf("blockchain transaction failed: %w", err)
 	}
 
 	// Check if transaction was successful
-	if code, ok := txResult["code"].(int); ok && code != 0 {
-		c.logger.Error().Int("code", code).Msg("Transaction failed for updating tensor score")
-		return nil, fmt.Errorf("blockchain transaction failed with code %d", code)
+	if code, ok := txResultCode(txResult); ok && code != 0 {
+		rawLog, _ := txResult["raw_log"].(string)
+		c.log(ctx).Error().Int("code", code).Str("raw_log", rawLog).Msg("Transaction failed for updating tensor score")
+		if codespace, _ := txResult["codespace"].(string); codespace == trusttensortypes.ModuleName && uint32(code) == trusttensortypes.ErrUnauthorized.ABCICode() {
+			return nil, fmt.Errorf("%w: %s", ErrTensorUpdateUnauthorized, rawLog)
+		}
+		return nil, fmt.Errorf("blockchain transaction failed with code %d: %s", code, rawLog)
 	}
 
 	// Success! Extract data from events
 	txhash := txResult["txhash"].(string)
 	tensorID := fmt.Sprintf("tensor_%s_%s", componentA, componentB)
 
-	if events, ok := txResult["events"].([]map[string]interface{}); ok {
-		for _, event := range events {
-			if eventType, ok := event["type"].(string); ok && eventType == "tensor_score_updated" {
-				if attributes, ok := event["attributes"].([]map[string]interface{}); ok {
-					for _, attr := range attributes {
-						if key, ok := attr["key"].(string); ok && key == "tensor_id" {
-							if value, ok := attr["value"].(string); ok {
-								tensorID = value
-								break
-							}
-						}
-					}
-				}
-			}
-		}
+	if value, ok := extractEventAttribute(txResult, "tensor_score_updated", "tensor_id"); ok {
+		tensorID = value
 	}
 
-	c.logger.Info().Str("tensor_id", tensorID).Str("txhash", txhash).Msg("Tensor score updated successfully via blockchain")
+	c.log(ctx).Info().Str("tensor_id", tensorID).Str("txhash", txhash).Msg("Tensor score updated successfully via blockchain")
 
 	return map[string]interface{}{
 		"tensor_id":   tensorID,
@@ -0,0 +1,252 @@
+package blockchain
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/rand"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"api-bridge/internal/config"
+)
+
+// Cosmos SDK error codes (codespace "sdk") that indicate a transient broadcast failure
+const (
+	sdkCodeTxInMempoolCache = 19
+	sdkCodeMempoolIsFull    = 20
+	sdkCodeWrongSequence    = 32
+)
+
+// Error fragments that are never worth retrying; checked before the retryable list
+var nonRetryableTxErrors = []string{
+	"insufficient funds",
+	"insufficient fee",
+	"signature verification failed",
+	"invalid signature",
+	"unauthorized",
+}
+
+// Error fragments for failures that a later attempt can succeed on
+var retryableTxErrors = []string{
+	"account sequence mismatch",
+	"incorrect account sequence",
+	"tx already in cache",
+	"tx already exists in cache",
+	"mempool is full",
+	"connection refused",
+	"connection reset",
+	"code = unavailable",
+}
+
+var expectedSequencePattern = regexp.MustCompile(`expected (\d+), got (\d+)`)
+
+// sequenceOverrideKey carries the sequence a retried broadcast must sign with
+type sequenceOverrideKey struct{}
+
+// withSequenceOverride returns a context that instructs executors to sign with sequence
+func withSequenceOverride(ctx context.Context, sequence uint64) context.Context {
+	return context.WithValue(ctx, sequenceOverrideKey{}, sequence)
+}
+
+// sequenceOverride returns the sequence set by withSequenceOverride, if any
+func sequenceOverride(ctx context.Context) (uint64, bool) {
+	sequence, ok := ctx.Value(sequenceOverrideKey{}).(uint64)
+	return sequence, ok
+}
+
+// txFailure is a broadcast outcome classified for the retry loop
+type txFailure struct {
+	err              error
+	retryable        bool
+	sequenceMismatch bool
+	expectedSequence uint64 // parsed from the node's mismatch message, 0 if unknown
+}
+
+// classifyTxFailure inspects a broadcast error or a non-zero tx response code.
+// It returns nil when the attempt should be treated as final (success or a
+// deliberate failure surfaced to the caller through the result code).
+func classifyTxFailure(result map[string]interface{}, err error) *txFailure {
+	var message string
+	code, codespace := 0, ""
+	if err != nil {
+		message = err.Error()
+	} else {
+		code, _ = txResultCode(result)
+		if code == 0 {
+			return nil
+		}
+		codespace, _ = result["codespace"].(string)
+		rawLog, _ := result["raw_log"].(string)
+		message = fmt.Sprintf("code %d: %s", code, rawLog)
+	}
+
+	failure := &txFailure{err: err}
+	if failure.err == nil {
+		failure.err = fmt.Errorf("transaction failed with %s", message)
+	}
+
+	lower := strings.ToLower(message)
+	for _, fragment := range nonRetryableTxErrors {
+		if strings.Contains(lower, fragment) {
+			return failure
+		}
+	}
+
+	if codespace == "sdk" {
+		switch code {
+		case sdkCodeWrongSequence:
+			failure.retryable, failure.sequenceMismatch = true, true
+		case sdkCodeTxInMempoolCache, sdkCodeMempoolIsFull:
+			failure.retryable = true
+		}
+	}
+	for _, fragment := range retryableTxErrors {
+		if strings.Contains(lower, fragment) {
+			failure.retryable = true
+		}
+	}
+	if strings.Contains(lower, "sequence mismatch") || strings.Contains(lower, "incorrect account sequence") {
+		failure.sequenceMismatch = true
+	}
+
+	if failure.sequenceMismatch {
+		if match := expectedSequencePattern.FindStringSubmatch(message); match != nil {
+			failure.expectedSequence, _ = strconv.ParseUint(match[1], 10, 64)
+		}
+	}
+
+	return failure
+}
+
+// txResultCode reads the response code, which is an int from the native
+// signer and a float64 when decoded from CLI JSON output
+func txResultCode(result map[string]interface{}) (int, bool) {
+	switch code := result["code"].(type) {
+	case int:
+		return code, true
+	case float64:
+		return int(code), true
+	}
+	return 0, false
+}
+
+// txRetrier runs a broadcast attempt until it succeeds, hits a non-retryable
+// error or exhausts the configured retries
+type txRetrier struct {
+	cfg           config.RetryConfig
+	logger        zerolog.Logger
+	querySequence func(ctx context.Context, address string) (uint64, error)
+	sleep         func(ctx context.Context, d time.Duration) error
+	random        func() float64
+}
+
+func newTxRetrier(cfg config.RetryConfig, logger zerolog.Logger, querySequence func(ctx context.Context, address string) (uint64, error)) *txRetrier {
+	return &txRetrier{
+		cfg:           cfg,
+		logger:        logger,
+		querySequence: querySequence,
+		sleep:         sleepContext,
+		random:        rand.Float64,
+	}
+}
+
+// run calls attempt, retrying transient failures. signer reports the address
+// whose sequence is re-queried after a mismatch.
+func (r *txRetrier) run(ctx context.Context, signer func() string, attempt func(ctx context.Context) (map[string]interface{}, error)) (map[string]interface{}, error) {
+	attemptCtx := ctx
+	for retry := 0; ; retry++ {
+		result, err := attempt(attemptCtx)
+		failure := classifyTxFailure(result, err)
+		if failure == nil {
+			return result, nil
+		}
+
+		if !failure.retryable || retry >= r.cfg.MaxRetries {
+			if err != nil {
+				return nil, err
+			}
+			// Non-zero codes are returned as-is so callers keep reporting them
+			return result, nil
+		}
+
+		delay := r.backoff(retry)
+		r.logger.Warn().Err(failure.err).Int("retry", retry+1).Int("max_retries", r.cfg.MaxRetries).Dur("backoff", delay).Msg("Transient broadcast failure, retrying")
+
+		if err := r.sleep(ctx, delay); err != nil {
+			return nil, fmt.Errorf("retry aborted: %w (last error: %v)", err, failure.err)
+		}
+
+		attemptCtx = ctx
+		if failure.sequenceMismatch {
+			address := signer()
+			sequence, err := r.querySequence(ctx, address)
+			if err != nil {
+				r.logger.Warn().Err(err).Str("address", address).Msg("Failed to re-query account sequence")
+			}
+			// The committed sequence can lag the mempool, so never go below what the node expects
+			if failure.expectedSequence > sequence {
+				sequence = failure.expectedSequence
+			}
+			if sequence > 0 {
+				r.logger.Info().Str("address", address).Uint64("sequence", sequence).Msg("Retrying with refreshed account sequence")
+				attemptCtx = withSequenceOverride(ctx, sequence)
+			}
+		}
+	}
+}
+
+// backoff returns the delay before the given retry (0-based), with jitter applied
+func (r *txRetrier) backoff(retry int) time.Duration {
+	multiplier := r.cfg.Multiplier
+	if multiplier < 1 {
+		multiplier = 1
+	}
+
+	delay := float64(r.cfg.InitialBackoff) * math.Pow(multiplier, float64(retry))
+	if r.cfg.MaxBackoff > 0 && delay > float64(r.cfg.MaxBackoff) {
+		delay = float64(r.cfg.MaxBackoff)
+	}
+
+	if r.cfg.Jitter > 0 {
+		delay += delay * r.cfg.Jitter * (2*r.random() - 1)
+	}
+
+	return time.Duration(delay)
+}
+
+// sleepContext waits for d or until ctx is done
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// queryAccountSequence fetches the committed sequence for address from the auth module
+func (c *RESTClient) queryAccountSequence(ctx context.Context, address string) (uint64, error) {
+	var resp struct {
+		Account struct {
+			Sequence string `json:"sequence"`
+		} `json:"account"`
+	}
+	if err := c.queryJSON(ctx, "/cosmos/auth/v1beta1/accounts/"+address, &resp); err != nil {
+		return 0, fmt.Errorf("failed to query account %s: %w", address, err)
+	}
+
+	sequence, err := strconv.ParseUint(resp.Account.Sequence, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid sequence %q for account %s: %w", resp.Account.Sequence, address, err)
+	}
+
+	return sequence, nil
+}
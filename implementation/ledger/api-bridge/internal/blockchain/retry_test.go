@@ -0,0 +1,175 @@
+package blockchain
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"api-bridge/internal/config"
+)
+
+func testRetrier(maxRetries int, querySequence func(ctx context.Context, address string) (uint64, error)) (*txRetrier, *[]time.Duration) {
+	var sleeps []time.Duration
+	r := newTxRetrier(config.RetryConfig{
+		MaxRetries:     maxRetries,
+		InitialBackoff: 100 * time.Millisecond,
+		MaxBackoff:     300 * time.Millisecond,
+		Multiplier:     2,
+		Jitter:         0.2,
+	}, zerolog.Nop(), querySequence)
+	r.sleep = func(ctx context.Context, d time.Duration) error {
+		sleeps = append(sleeps, d)
+		return nil
+	}
+	r.random = func() float64 { return 0.5 } // no jitter offset
+	return r, &sleeps
+}
+
+func signer() string { return "cosmos1signer" }
+
+func TestClassifyTxFailure(t *testing.T) {
+	testCases := []struct {
+		name           string
+		result         map[string]interface{}
+		err            error
+		expFailure     bool
+		expRetryable   bool
+		expSeqMismatch bool
+		expExpectedSeq uint64
+	}{
+		{
+			name:   "success",
+			result: map[string]interface{}{"code": 0, "txhash": "ABC"},
+		},
+		{
+			name:           "sequence mismatch code from native signer",
+			result:         map[string]interface{}{"code": 32, "codespace": "sdk", "raw_log": "account sequence mismatch, expected 7, got 6: incorrect account sequence"},
+			expFailure:     true,
+			expRetryable:   true,
+			expSeqMismatch: true,
+			expExpectedSeq: 7,
+		},
+		{
+			name:         "tx already in cache from CLI json",
+			result:       map[string]interface{}{"code": float64(19), "codespace": "sdk", "raw_log": "tx already exists in cache"},
+			expFailure:   true,
+			expRetryable: true,
+		},
+		{
+			name:         "node unreachable",
+			err:          errors.New(`rpc error: code = Unavailable desc = dial tcp 127.0.0.1:9090: connect: connection refused`),
+			expFailure:   true,
+			expRetryable: true,
+		},
+		{
+			name:       "insufficient funds fails fast",
+			result:     map[string]interface{}{"code": 5, "codespace": "sdk", "raw_log": "0stake is smaller than 10stake: insufficient funds"},
+			expFailure: true,
+		},
+		{
+			name:       "invalid signature fails fast",
+			err:        errors.New("racecar-webd command failed: exit status 1: signature verification failed; please verify account number (0) and chain-id (racecarweb): unauthorized"),
+			expFailure: true,
+		},
+		{
+			name:       "unknown error fails fast",
+			err:        errors.New("failed to decode message"),
+			expFailure: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			failure := classifyTxFailure(tc.result, tc.err)
+			if !tc.expFailure {
+				require.Nil(t, failure)
+				return
+			}
+			require.NotNil(t, failure)
+			assert.Equal(t, tc.expRetryable, failure.retryable)
+			assert.Equal(t, tc.expSeqMismatch, failure.sequenceMismatch)
+			assert.Equal(t, tc.expExpectedSeq, failure.expectedSequence)
+		})
+	}
+}
+
+func TestTxRetrierRetriesTransientErrors(t *testing.T) {
+	r, sleeps := testRetrier(5, nil)
+
+	attempts := 0
+	result, err := r.run(context.Background(), signer, func(ctx context.Context) (map[string]interface{}, error) {
+		attempts++
+		if attempts < 4 {
+			return nil, errors.New("dial tcp: connection refused")
+		}
+		return map[string]interface{}{"code": 0, "txhash": "ABC"}, nil
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "ABC", result["txhash"])
+	assert.Equal(t, 4, attempts)
+	// Exponential backoff capped at MaxBackoff
+	assert.Equal(t, []time.Duration{100 * time.Millisecond, 200 * time.Millisecond, 300 * time.Millisecond}, *sleeps)
+}
+
+func TestTxRetrierFailsFastOnNonRetryable(t *testing.T) {
+	r, sleeps := testRetrier(5, nil)
+
+	attempts := 0
+	_, err := r.run(context.Background(), signer, func(ctx context.Context) (map[string]interface{}, error) {
+		attempts++
+		return nil, errors.New("insufficient funds")
+	})
+
+	require.Error(t, err)
+	assert.Equal(t, 1, attempts)
+	assert.Empty(t, *sleeps)
+}
+
+func TestTxRetrierStopsAfterMaxRetries(t *testing.T) {
+	r, sleeps := testRetrier(2, nil)
+
+	attempts := 0
+	result, err := r.run(context.Background(), signer, func(ctx context.Context) (map[string]interface{}, error) {
+		attempts++
+		return map[string]interface{}{"code": 20, "codespace": "sdk", "raw_log": "mempool is full"}, nil
+	})
+
+	// The last non-zero response is handed back for the caller to report
+	require.NoError(t, err)
+	assert.Equal(t, 20, result["code"])
+	assert.Equal(t, 3, attempts)
+	assert.Len(t, *sleeps, 2)
+}
+
+func TestTxRetrierRequeriesSequenceOnMismatch(t *testing.T) {
+	var queried []string
+	r, _ := testRetrier(3, func(ctx context.Context, address string) (uint64, error) {
+		queried = append(queried, address)
+		return 9, nil
+	})
+
+	var sequences []uint64
+	attempts := 0
+	_, err := r.run(context.Background(), signer, func(ctx context.Context) (map[string]interface{}, error) {
+		attempts++
+		seq, ok := sequenceOverride(ctx)
+		if ok {
+			sequences = append(sequences, seq)
+		}
+		if attempts == 1 {
+			return map[string]interface{}{"code": 32, "codespace": "sdk", "raw_log": "account sequence mismatch, expected 8, got 7: incorrect account sequence"}, nil
+		}
+		return map[string]interface{}{"code": 0}, nil
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"cosmos1signer"}, queried)
+	// The chain reported a newer sequence than the mismatch message, so it wins
+	assert.Equal(t, []uint64{9}, sequences)
+}
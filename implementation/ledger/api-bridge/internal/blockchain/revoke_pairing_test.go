@@ -0,0 +1,54 @@
+package blockchain
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"api-bridge/internal/config"
+	pairingtypes "racecar-web/x/pairing/types"
+)
+
+// unauthorizedRevocationChain rejects every revocation the way the chain
+// rejects one signed by a non-participant
+type unauthorizedRevocationChain struct {
+	fakeTxExecutor
+}
+
+func (u *unauthorizedRevocationChain) Execute(ctx context.Context, account *Account, message map[string]interface{}, memo string, gas txGas) (map[string]interface{}, error) {
+	return map[string]interface{}{"code": int(pairingtypes.ErrUnauthorized.ABCICode()), "codespace": pairingtypes.ModuleName,
+		"txhash": "TXDENIED", "raw_log": "mallory is not a participant in lct-PACK-MC: not authorized to revoke the pairing"}, nil
+}
+
+func TestRevokePairingBroadcastsMsgRevokePairing(t *testing.T) {
+	c, executor := testGasClient(t, config.GasConfig{Limit: 200000}, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request to %s", r.URL.Path)
+	})
+
+	result, err := c.RevokePairing(context.Background(), "alice", "lct-MODBATT-PACK-001-MODBATT-MC-001", "battery replaced", true)
+	require.NoError(t, err)
+
+	require.Len(t, executor.messages, 1)
+	msg := executor.messages[0]
+	assert.Equal(t, "/racecarweb.pairing.v1.MsgRevokePairing", msg["@type"])
+	assert.Equal(t, "lct-MODBATT-PACK-001-MODBATT-MC-001", msg["lct_id"])
+	assert.Equal(t, "battery replaced", msg["reason"])
+	assert.Equal(t, true, msg["notify_offline"])
+
+	assert.Equal(t, "revoked", result["status"])
+	assert.Equal(t, "ABC", result["txhash"])
+}
+
+func TestRevokePairingReportsUnauthorizedSigners(t *testing.T) {
+	c, _ := testGasClient(t, config.GasConfig{Limit: 200000}, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request to %s", r.URL.Path)
+	})
+	c.txExecutor = &unauthorizedRevocationChain{}
+
+	_, err := c.RevokePairing(context.Background(), "alice", "lct-PACK-MC", "", false)
+	assert.ErrorIs(t, err, ErrPairingRevocationUnauthorized)
+	assert.ErrorContains(t, err, "not a participant in lct-PACK-MC")
+}
@@ -0,0 +1,39 @@
+package blockchain
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSearchComponentsReturnsAnonymousFields(t *testing.T) {
+	node := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/racecar-web/componentregistry/v1/components/search", r.URL.Path)
+		query := r.URL.Query()
+		assert.Equal(t, "aa11", query.Get("manufacturer_hash"))
+		assert.Equal(t, "bb22", query.Get("category_hash"))
+		assert.Equal(t, "active", query.Get("status"))
+		assert.Equal(t, "10", query.Get("pagination.limit"))
+		assert.Equal(t, "Y29tcC0y", query.Get("pagination.key"))
+		_, _ = w.Write([]byte(`{"components": [{"component_id": "anon-1", "manufacturer_hash": "aa11", "category_hash": "bb22", "status": "active", "manufacturer_id": "", "component_data": ""}], "pagination": {"next_key": "Y29tcC0z"}}`))
+	}))
+	defer node.Close()
+
+	c := &RESTClient{baseURL: node.URL, client: node.Client(), logger: zerolog.Nop()}
+	filter := ComponentSearchFilter{ManufacturerHash: "aa11", CategoryHash: "bb22", Status: "active"}
+	page, err := c.SearchComponents(context.Background(), filter, 10, "Y29tcC0y")
+	require.NoError(t, err)
+	assert.Equal(t, 1, page["count"])
+	assert.Equal(t, "Y29tcC0z", page["next_key"])
+
+	component := page["components"].([]map[string]interface{})[0]
+	assert.Equal(t, "anon-1", component["component_id"])
+	assert.Equal(t, "aa11", component["manufacturer_hash"])
+	assert.NotContains(t, component, "manufacturer_id")
+	assert.NotContains(t, component, "component_data")
+}
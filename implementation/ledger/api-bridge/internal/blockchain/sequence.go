@@ -0,0 +1,74 @@
+package blockchain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// errSequenceLockWait is returned when a request's context ends while it is
+// still queued behind other transactions of the same address
+var errSequenceLockWait = errors.New("gave up waiting for the account sequence lock")
+
+// AccountSequence is the locally predicted next sequence of one signing
+// address. It is only valid inside WithSequenceLock, which serializes every
+// transaction the address signs.
+type AccountSequence struct {
+	lock  chan struct{} // holds a token while a transaction is being sent
+	next  uint64
+	known bool
+}
+
+// Next returns the sequence the next transaction should sign with, or false
+// when it must be read from the chain first
+func (s *AccountSequence) Next() (uint64, bool) {
+	return s.next, s.known
+}
+
+// Set records the sequence the next transaction signs with, as read from the chain
+func (s *AccountSequence) Set(sequence uint64) {
+	s.next, s.known = sequence, true
+}
+
+// Committed records that a transaction signed with sequence entered the
+// mempool, so the next one signs with the following sequence
+func (s *AccountSequence) Committed(sequence uint64) {
+	s.Set(sequence + 1)
+}
+
+// Reset forgets the prediction so the next transaction resyncs from the chain
+func (s *AccountSequence) Reset() {
+	s.next, s.known = 0, false
+}
+
+// WithSequenceLock runs fn while holding account's sequence lock, so
+// transactions from the same address are built and broadcast one at a time
+// and never race on the account sequence. Accounts sharing an address share
+// the lock. If ctx ends before the lock is free, fn is not run and the error
+// wraps both errSequenceLockWait and ctx.Err().
+func (am *AccountManager) WithSequenceLock(ctx context.Context, account *Account, fn func(sequence *AccountSequence) error) error {
+	sequence := am.sequenceFor(account.Address)
+	select {
+	case sequence.lock <- struct{}{}:
+	case <-ctx.Done():
+		return fmt.Errorf("%w of %s: %w", errSequenceLockWait, account.Address, ctx.Err())
+	}
+	defer func() { <-sequence.lock }()
+	return fn(sequence)
+}
+
+// sequenceFor returns the tracked sequence of address, creating it on first use
+func (am *AccountManager) sequenceFor(address string) *AccountSequence {
+	am.mu.Lock()
+	defer am.mu.Unlock()
+
+	if am.sequences == nil {
+		am.sequences = make(map[string]*AccountSequence)
+	}
+	sequence, ok := am.sequences[address]
+	if !ok {
+		sequence = &AccountSequence{lock: make(chan struct{}, 1)}
+		am.sequences[address] = sequence
+	}
+	return sequence
+}
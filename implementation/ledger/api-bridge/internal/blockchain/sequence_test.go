@@ -0,0 +1,171 @@
+package blockchain
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"api-bridge/internal/config"
+)
+
+// sequencedExecutor accepts a transaction only when it signs with the next
+// sequence of the mempool, like a node running CheckTx
+type sequencedExecutor struct {
+	mu         sync.Mutex
+	next       uint64
+	mismatches int
+	inFlight   int
+	overlapped bool
+}
+
+func (e *sequencedExecutor) Mode() string { return "fake" }
+
+func (e *sequencedExecutor) Execute(ctx context.Context, account *Account, message map[string]interface{}, memo string, gas txGas) (map[string]interface{}, error) {
+	e.mu.Lock()
+	e.inFlight++
+	if e.inFlight > 1 {
+		e.overlapped = true
+	}
+	e.mu.Unlock()
+
+	// Give racing broadcasts a chance to interleave
+	time.Sleep(time.Millisecond)
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.inFlight--
+
+	sequence, _ := sequenceOverride(ctx)
+	if sequence != e.next {
+		e.mismatches++
+		return map[string]interface{}{
+			"code":      32,
+			"codespace": "sdk",
+			"raw_log":   fmt.Sprintf("account sequence mismatch, expected %d, got %d: incorrect account sequence", e.next, sequence),
+		}, nil
+	}
+	e.next++
+	return map[string]interface{}{"code": 0, "txhash": fmt.Sprintf("TX%d", sequence)}, nil
+}
+
+func (e *sequencedExecutor) SimulationTx(ctx context.Context, account *Account, message map[string]interface{}, memo string) ([]byte, error) {
+	return []byte("sim-tx"), nil
+}
+
+// testSequenceClient serves an auth module whose committed sequence stays at
+// 0, as it does while broadcasts are still waiting in the mempool
+func testSequenceClient(t *testing.T, maxRetries int) (*RESTClient, *sequencedExecutor) {
+	t.Helper()
+	node := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"account": {"@type": "/cosmos.auth.v1beta1.BaseAccount", "address": "` + r.URL.Path + `", "account_number": "0", "sequence": "0"}}`))
+	}))
+	t.Cleanup(node.Close)
+
+	gas, err := newGasSettings(config.GasConfig{Limit: 200000})
+	require.NoError(t, err)
+
+	accounts := &AccountManager{logger: zerolog.Nop(), accounts: make(map[string]*Account)}
+	accounts.initializeDefaultAccounts()
+
+	executor := &sequencedExecutor{}
+	return &RESTClient{
+		baseURL:        node.URL,
+		client:         node.Client(),
+		logger:         zerolog.Nop(),
+		accountManager: accounts,
+		txExecutor:     executor,
+		retry:          config.RetryConfig{MaxRetries: maxRetries},
+		gas:            gas,
+	}, executor
+}
+
+func TestParallelRegistrationsFromOneAccount(t *testing.T) {
+	c, executor := testSequenceClient(t, 0)
+
+	const registrations = 20
+	var wg sync.WaitGroup
+	errs := make(chan error, registrations)
+	for i := 0; i < registrations; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			_, err := c.RegisterComponent(context.Background(), "alice", fmt.Sprintf(`{"serial": %d}`, i), "", false)
+			errs <- err
+		}(i)
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		assert.NoError(t, err)
+	}
+	assert.Zero(t, executor.mismatches, "no broadcast may sign with a stale sequence")
+	assert.False(t, executor.overlapped, "broadcasts from one account must not overlap")
+	assert.Equal(t, uint64(registrations), executor.next)
+}
+
+func TestSequenceResyncsAfterMismatch(t *testing.T) {
+	c, executor := testSequenceClient(t, 1)
+
+	_, err := c.RegisterComponent(context.Background(), "alice", `{"serial": 1}`, "", false)
+	require.NoError(t, err)
+
+	// Another client signed with the same key behind the bridge's back
+	executor.next = 5
+
+	_, err = c.RegisterComponent(context.Background(), "alice", `{"serial": 2}`, "", false)
+	require.NoError(t, err)
+	assert.Equal(t, 1, executor.mismatches)
+
+	account := c.accountManager.GetAccountForCreator("alice")
+	require.NoError(t, c.accountManager.WithSequenceLock(context.Background(), account, func(sequence *AccountSequence) error {
+		next, known := sequence.Next()
+		assert.True(t, known)
+		assert.Equal(t, uint64(6), next)
+		return nil
+	}))
+}
+
+func TestSequenceLockWaitHonorsContext(t *testing.T) {
+	c, executor := testSequenceClient(t, 0)
+	account := c.accountManager.GetAccountForCreator("alice")
+
+	held := make(chan struct{})
+	release := make(chan struct{})
+	go func() {
+		_ = c.accountManager.WithSequenceLock(context.Background(), account, func(*AccountSequence) error {
+			close(held)
+			<-release
+			return nil
+		})
+	}()
+	<-held
+	defer close(release)
+
+	// A request that times out while queued behind the broadcast holding the lock gives up
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	ran := false
+	err := c.accountManager.WithSequenceLock(ctx, account, func(*AccountSequence) error {
+		ran = true
+		return nil
+	})
+	require.ErrorIs(t, err, errSequenceLockWait)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.False(t, ran)
+
+	// A cancelled request is never broadcast
+	ctx, cancel = context.WithCancel(context.Background())
+	cancel()
+	_, err = c.RegisterComponent(ctx, "alice", `{"serial": 1}`, "", false)
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Zero(t, executor.next)
+}
@@ -0,0 +1,120 @@
+package blockchain
+
+import (
+	"context"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"api-bridge/internal/config"
+	lctmanagerkeeper "racecar-web/x/lctmanager/keeper"
+)
+
+func TestGetSplitKeyStatusReportsSupersededKeys(t *testing.T) {
+	node := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/racecar-web/lctmanager/v1/split_key/lct-PACK-MC-1" {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"code": 5, "message": "no split key has been issued"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"lct_id": "lct-PACK-MC-1", "status": "active", "key_reference": "bb22", "version": "2",
+			"created_at": "1752484960", "activated_at": "1752484960", "revoked_at": "0",
+			"superseded": [{"key_reference": "aa11", "version": "1", "created_at": "1752484904", "superseded_at": "1752484960", "superseded_by": "bb22"}]}`))
+	}))
+	defer node.Close()
+
+	c := &RESTClient{baseURL: node.URL, client: node.Client(), logger: zerolog.Nop()}
+	ctx := context.Background()
+
+	_, err := c.GetSplitKeyStatus(ctx, "lct-unknown")
+	assert.ErrorIs(t, err, ErrSplitKeyNotFound)
+
+	status, err := c.GetSplitKeyStatus(ctx, "lct-PACK-MC-1")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"lct_id":        "lct-PACK-MC-1",
+		"status":        "active",
+		"key_reference": "bb22",
+		"version":       uint64(2),
+		"created_at":    int64(1752484960),
+		"activated_at":  int64(1752484960),
+		"superseded": []map[string]interface{}{{
+			"key_reference": "aa11",
+			"version":       uint64(1),
+			"created_at":    int64(1752484904),
+			"superseded_at": int64(1752484960),
+			"superseded_by": "bb22",
+		}},
+	}, status)
+}
+
+func TestRotateSplitKeyBroadcastsOnlyTheKeyCommitment(t *testing.T) {
+	c, _ := testGasClient(t, config.GasConfig{Limit: 200000}, pairingNode)
+	executor := &pairingTxExecutor{result: `{
+	  "txhash": "ABC",
+	  "code": 0,
+	  "timestamp": "2025-07-14T09:21:44Z",
+	  "events": [
+	    {"type": "split_key_rotated", "attributes": [
+	      {"key": "lct_id", "value": "lct-PACK-MC-1"},
+	      {"key": "key_reference", "value": "bb22"},
+	      {"key": "previous_key_reference", "value": "aa11"},
+	      {"key": "version", "value": "2"},
+	      {"key": "reason", "value": "scheduled"}
+	    ]}
+	  ]
+	}`}
+	c.txExecutor = executor
+	privateA, publicA := testComponentKey(t, 1)
+	privateB, publicB := testComponentKey(t, 101)
+	c.componentKeys = map[string][32]byte{"MODBATT-PACK-001": publicA, "MODBATT-MC-001": publicB}
+	delivery := &recordingKeyHalfDelivery{}
+	c.keyHalves = delivery
+
+	result, err := c.RotateSplitKey(context.Background(), "alice", "lct-PACK-MC-1", "scheduled")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"lct_id":                 "lct-PACK-MC-1",
+		"reason":                 "scheduled",
+		"rotated_at":             int64(1752484904),
+		"txhash":                 "ABC",
+		"key_reference":          "bb22",
+		"previous_key_reference": "aa11",
+		"version":                uint64(2),
+	}, result)
+
+	require.Len(t, executor.messages, 1)
+	assert.Equal(t, "/racecarweb.lctmanager.v1.MsgRotateSplitKey", executor.messages[0]["@type"])
+	assert.Equal(t, "lct-PACK-MC-1", executor.messages[0]["lct_id"])
+	assert.Equal(t, "scheduled", executor.messages[0]["reason"])
+
+	// The new halves only reach the components, each sealed to its own key
+	require.Len(t, delivery.halves, 2)
+	assert.Equal(t, "MODBATT-PACK-001", delivery.halves[0].ComponentID)
+	assert.Equal(t, "MODBATT-MC-001", delivery.halves[1].ComponentID)
+	var opened [2][]byte
+	for i, private := range [][32]byte{privateA, privateB} {
+		sealed, err := hex.DecodeString(delivery.halves[i].SealedHalf)
+		require.NoError(t, err)
+		opened[i], err = lctmanagerkeeper.OpenMessage(private, sealed)
+		require.NoError(t, err)
+	}
+	assert.Equal(t, splitKeyCommitment(opened[0], opened[1]), executor.messages[0]["key_commitment"])
+}
+
+func TestRotateSplitKeyWithoutAComponentKeyBroadcastsNothing(t *testing.T) {
+	c, _ := testGasClient(t, config.GasConfig{Limit: 200000}, pairingNode)
+	executor := &pairingTxExecutor{result: `{"txhash": "ABC", "code": 0}`}
+	c.txExecutor = executor
+	c.componentKeys = map[string][32]byte{}
+	c.keyHalves = &recordingKeyHalfDelivery{}
+
+	_, err := c.RotateSplitKey(context.Background(), "alice", "lct-PACK-MC-1", "scheduled")
+	require.ErrorIs(t, err, ErrNoComponentKey)
+	assert.Empty(t, executor.messages)
+}
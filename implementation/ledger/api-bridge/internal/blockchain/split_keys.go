@@ -0,0 +1,58 @@
+package blockchain
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// splitKeySize is the length in bytes of each half of a pairing's split key
+const splitKeySize = 32
+
+// ErrWeakSplitKeys is returned when the random source produced key halves
+// that cannot be handed out, such as two equal halves
+var ErrWeakSplitKeys = errors.New("weak split keys")
+
+// keyCommitmentDomain must match the LCT manager keeper's, which checks
+// reconstructed keys against the commitments the bridge puts on chain
+const keyCommitmentDomain = "web4-lct-key-commitment-v1"
+
+// generateSplitKeys returns the two halves of a fresh 64-byte key, hex
+// encoded, one for each component of a pairing, and the commitment to their
+// combined key. Only the commitment is broadcast: the chain cannot generate
+// keys itself, as every validator would draw different randomness. There is
+// no fallback: if random cannot supply full entropy no keys are issued.
+func generateSplitKeys(random io.Reader) (string, string, []byte, error) {
+	keyMaterial := make([]byte, 2*splitKeySize)
+	if _, err := io.ReadFull(random, keyMaterial); err != nil {
+		return "", "", nil, fmt.Errorf("failed to read split key material: %w", err)
+	}
+	halfA, halfB := keyMaterial[:splitKeySize], keyMaterial[splitKeySize:]
+
+	if subtle.ConstantTimeCompare(halfA, halfB) == 1 {
+		return "", "", nil, fmt.Errorf("%w: both halves are equal", ErrWeakSplitKeys)
+	}
+	for _, half := range [][]byte{halfA, halfB} {
+		// A half of one repeated byte means the source is not random, e.g. all zeros
+		if bytes.Count(half, half[:1]) == len(half) {
+			return "", "", nil, fmt.Errorf("%w: a half repeats a single byte", ErrWeakSplitKeys)
+		}
+	}
+	return hex.EncodeToString(halfA), hex.EncodeToString(halfB), splitKeyCommitment(halfA, halfB), nil
+}
+
+// splitKeyCommitment commits to the key the two halves combine to, the way
+// the keeper does: SHA-256 over the domain and the XOR of the halves
+func splitKeyCommitment(halfA, halfB []byte) []byte {
+	combinedKey := make([]byte, splitKeySize)
+	subtle.XORBytes(combinedKey, halfA, halfB)
+	hash := sha256.New()
+	hash.Write([]byte(keyCommitmentDomain))
+	hash.Write(combinedKey)
+	clear(combinedKey)
+	return hash.Sum(nil)
+}
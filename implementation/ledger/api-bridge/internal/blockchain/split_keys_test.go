@@ -0,0 +1,88 @@
+package blockchain
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"math/bits"
+	"testing"
+	"testing/iotest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	lctmanagerkeeper "racecar-web/x/lctmanager/keeper"
+	lctmanagertypes "racecar-web/x/lctmanager/types"
+)
+
+func TestGenerateSplitKeysAreDistinctWithFullEntropy(t *testing.T) {
+	const rounds = 500
+	seen := make(map[string]bool, 2*rounds)
+	ones := 0
+	for range rounds {
+		keyA, keyB, commitment, err := generateSplitKeys(rand.Reader)
+		require.NoError(t, err)
+		require.NotEqual(t, keyA, keyB)
+		require.Len(t, commitment, sha256.Size)
+
+		for _, key := range []string{keyA, keyB} {
+			raw, err := hex.DecodeString(key)
+			require.NoError(t, err)
+			require.Len(t, raw, splitKeySize)
+			require.False(t, seen[key], "a split key was issued twice")
+			seen[key] = true
+			for _, b := range raw {
+				ones += bits.OnesCount8(b)
+			}
+		}
+	}
+
+	// Uniformly random bits are set half the time; 512k bits put a biased
+	// source far outside this band
+	total := rounds * 2 * splitKeySize * 8
+	assert.InDelta(t, 0.5, float64(ones)/float64(total), 0.01)
+}
+
+func TestGenerateSplitKeysFailsWithoutEntropy(t *testing.T) {
+	half := bytes.Repeat([]byte{0x5a, 0xc3}, splitKeySize/2)
+
+	cases := map[string]io.Reader{
+		"read error":        iotest.ErrReader(errors.New("entropy source unavailable")),
+		"short read":        bytes.NewReader(make([]byte, splitKeySize)),
+		"equal halves":      bytes.NewReader(append(append([]byte(nil), half...), half...)),
+		"constant source":   bytes.NewReader(make([]byte, 2*splitKeySize)),
+		"one constant half": bytes.NewReader(append(append([]byte(nil), half...), bytes.Repeat([]byte{0xff}, splitKeySize)...)),
+	}
+	for name, random := range cases {
+		t.Run(name, func(t *testing.T) {
+			keyA, keyB, commitment, err := generateSplitKeys(random)
+			require.Error(t, err)
+			assert.Empty(t, keyA)
+			assert.Empty(t, keyB)
+			assert.Empty(t, commitment)
+		})
+	}
+
+	_, _, _, err := generateSplitKeys(bytes.NewReader(make([]byte, 2*splitKeySize)))
+	assert.ErrorIs(t, err, ErrWeakSplitKeys)
+}
+
+func TestSplitKeyCommitmentMatchesTheKeeper(t *testing.T) {
+	keyA, keyB, commitment, err := generateSplitKeys(rand.Reader)
+	require.NoError(t, err)
+	halfA, err := hex.DecodeString(keyA)
+	require.NoError(t, err)
+	halfB, err := hex.DecodeString(keyB)
+	require.NoError(t, err)
+
+	// The keeper verifies keys the components reconstruct against it
+	var combinedKey [32]byte
+	for i := range combinedKey {
+		combinedKey[i] = halfA[i] ^ halfB[i]
+	}
+	assert.True(t, lctmanagerkeeper.VerifyKeyCommitment(combinedKey, commitment))
+	assert.NoError(t, lctmanagertypes.ValidateKeyCommitment(commitment))
+}
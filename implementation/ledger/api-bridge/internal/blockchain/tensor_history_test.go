@@ -0,0 +1,53 @@
+package blockchain
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetTensorHistoryParsesTheSeries(t *testing.T) {
+	var query string
+	node := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/racecar-web/trusttensor/v1/get_tensor_history/tensor-lct-PACK-MC-1" {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"code": 5, "message": "trust tensor not found"}`))
+			return
+		}
+		query = r.URL.RawQuery
+		_, _ = w.Write([]byte(`{"entries": [
+			{"tensor_id": "tensor-lct-PACK-MC-1", "sequence": "1", "timestamp": "1752484910", "dimension": "trust",
+			 "score": "0.300000000000000000", "composite_score": "0.300000000000000000", "context": "energy_transfer_failed", "actor": "cosmos1operator"},
+			{"tensor_id": "tensor-lct-PACK-MC-1", "sequence": "2", "timestamp": "1752484920", "dimension": "trust",
+			 "score": "0.600000000000000000", "composite_score": "0.600000000000000000", "context": "", "actor": "cosmos1operator"}
+		]}`))
+	}))
+	defer node.Close()
+
+	c := &RESTClient{baseURL: node.URL, client: node.Client(), logger: zerolog.Nop()}
+	ctx := context.Background()
+
+	history, err := c.GetTensorHistory(ctx, "tensor-lct-PACK-MC-1", 1752484900, 0)
+	require.NoError(t, err)
+	assert.Equal(t, "from=1752484900", query, "an unset bound is not sent")
+	assert.Equal(t, 2, history["count"])
+	series := history["history"].([]map[string]interface{})
+	assert.Equal(t, map[string]interface{}{
+		"sequence":        uint64(1),
+		"timestamp":       int64(1752484910),
+		"dimension":       "trust",
+		"score":           0.3,
+		"composite_score": 0.3,
+		"context":         "energy_transfer_failed",
+		"actor":           "cosmos1operator",
+	}, series[0])
+	assert.Equal(t, 0.6, series[1]["score"])
+
+	_, err = c.GetTensorHistory(ctx, "tensor-missing", 0, 0)
+	assert.ErrorIs(t, err, ErrTrustTensorNotFound)
+}
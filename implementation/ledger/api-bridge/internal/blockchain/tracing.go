@@ -0,0 +1,47 @@
+package blockchain
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// startSpan starts a span from the global tracer provider, looked up per call
+// so it follows whatever provider tracing.Setup installed
+func startSpan(ctx context.Context, name string, opts ...trace.SpanStartOption) (context.Context, trace.Span) {
+	return otel.Tracer("api-bridge/internal/blockchain").Start(ctx, name, opts...)
+}
+
+// startRESTSpan starts the span for one call to the node's REST API and copies
+// the trace context onto the outgoing request
+func startRESTSpan(ctx context.Context, req *http.Request, endpoint string) (context.Context, trace.Span) {
+	path, _, _ := strings.Cut(endpoint, "?")
+	ctx, span := startSpan(ctx, "blockchain.rest "+req.Method,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("http.request.method", req.Method),
+			attribute.String("url.path", path),
+		))
+	otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(req.Header))
+	return ctx, span
+}
+
+// endSpan marks the span failed when err is set and ends it
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		var httpErr *HTTPError
+		if errors.As(err, &httpErr) {
+			span.SetAttributes(attribute.Int("http.response.status_code", httpErr.StatusCode))
+		}
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
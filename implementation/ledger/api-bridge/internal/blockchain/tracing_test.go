@@ -0,0 +1,61 @@
+package blockchain
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	"api-bridge/internal/config"
+)
+
+func TestExecuteTransactionTracesSimulateAndBroadcast(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	t.Cleanup(func() { otel.SetTracerProvider(previous) })
+
+	var traceparent string
+	c, _ := testGasClient(t, config.GasConfig{Adjustment: 1.5}, func(w http.ResponseWriter, r *http.Request) {
+		traceparent = r.Header.Get("traceparent")
+		_, _ = w.Write([]byte(`{"gas_info": {"gas_used": "80000"}, "result": {}}`))
+	})
+
+	ctx, parent := otel.Tracer("test").Start(context.Background(), "POST /api/v1/components/register")
+	_, err := c.executeTransaction(ctx, registerMessage(), "")
+	require.NoError(t, err)
+	parent.End()
+
+	spans := make(map[string]sdktrace.ReadOnlySpan)
+	for _, span := range recorder.Ended() {
+		spans[span.Name()] = span
+	}
+	require.Contains(t, spans, "blockchain.tx")
+	require.Contains(t, spans, "blockchain.simulate")
+	require.Contains(t, spans, "blockchain.rest POST")
+	require.Contains(t, spans, "blockchain.broadcast")
+
+	traceID := parent.SpanContext().TraceID()
+	for name, span := range spans {
+		assert.Equal(t, traceID, span.SpanContext().TraceID(), name)
+	}
+	assert.Equal(t, parent.SpanContext().SpanID(), spans["blockchain.tx"].Parent().SpanID())
+	assert.Equal(t, spans["blockchain.tx"].SpanContext().SpanID(), spans["blockchain.simulate"].Parent().SpanID())
+	assert.Equal(t, spans["blockchain.simulate"].SpanContext().SpanID(), spans["blockchain.rest POST"].Parent().SpanID())
+	assert.Equal(t, spans["blockchain.tx"].SpanContext().SpanID(), spans["blockchain.broadcast"].Parent().SpanID())
+
+	assert.Contains(t, spans["blockchain.tx"].Attributes(), attribute.String("tx.hash", "ABC"))
+	assert.Contains(t, spans["blockchain.broadcast"].Attributes(), attribute.String("tx.hash", "ABC"))
+	assert.Contains(t, spans["blockchain.simulate"].Attributes(), attribute.Int64("tx.gas_used", 80000))
+
+	// The node sees the same trace through the traceparent header
+	assert.Contains(t, traceparent, traceID.String())
+}
@@ -0,0 +1,39 @@
+package blockchain
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	"api-bridge/internal/config"
+)
+
+// tcpKeepAlive is the keep-alive probe interval of connections to the node
+const tcpKeepAlive = 30 * time.Second
+
+// newHTTPClient builds the client requests to the node's REST API go
+// through. The transport starts from Go's default, so proxy settings, TLS
+// handshake limits and HTTP/2 are kept, and takes its pool and timeouts from
+// cfg. A zero timeout leaves that phase unbounded.
+func newHTTPClient(cfg config.HTTPClientConfig) (*http.Client, error) {
+	switch {
+	case cfg.MaxIdleConns < 0 || cfg.MaxIdleConnsPerHost < 0:
+		return nil, fmt.Errorf("blockchain.http idle connection limits cannot be negative")
+	case cfg.IdleConnTimeout < 0 || cfg.DialTimeout < 0 || cfg.ResponseHeaderTimeout < 0 || cfg.Timeout < 0:
+		return nil, fmt.Errorf("blockchain.http timeouts cannot be negative")
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.DialContext = (&net.Dialer{
+		Timeout:   cfg.DialTimeout,
+		KeepAlive: tcpKeepAlive,
+	}).DialContext
+	transport.MaxIdleConns = cfg.MaxIdleConns
+	transport.MaxIdleConnsPerHost = cfg.MaxIdleConnsPerHost
+	transport.IdleConnTimeout = cfg.IdleConnTimeout
+	transport.DisableKeepAlives = cfg.DisableKeepAlives
+	transport.ResponseHeaderTimeout = cfg.ResponseHeaderTimeout
+
+	return &http.Client{Transport: transport, Timeout: cfg.Timeout}, nil
+}
@@ -0,0 +1,119 @@
+package blockchain
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"api-bridge/internal/config"
+)
+
+// tunedHTTPConfig matches the defaults of blockchain.http
+var tunedHTTPConfig = config.HTTPClientConfig{
+	MaxIdleConns:          100,
+	MaxIdleConnsPerHost:   32,
+	IdleConnTimeout:       90 * time.Second,
+	DialTimeout:           5 * time.Second,
+	ResponseHeaderTimeout: 20 * time.Second,
+	Timeout:               30 * time.Second,
+}
+
+func TestNewHTTPClientAppliesConfig(t *testing.T) {
+	client, err := newHTTPClient(tunedHTTPConfig)
+	require.NoError(t, err)
+	assert.Equal(t, 30*time.Second, client.Timeout)
+
+	transport := client.Transport.(*http.Transport)
+	assert.Equal(t, 100, transport.MaxIdleConns)
+	assert.Equal(t, 32, transport.MaxIdleConnsPerHost)
+	assert.Equal(t, 90*time.Second, transport.IdleConnTimeout)
+	assert.Equal(t, 20*time.Second, transport.ResponseHeaderTimeout)
+	assert.False(t, transport.DisableKeepAlives)
+	assert.NotNil(t, transport.Proxy, "proxy settings from the environment are kept")
+
+	_, err = newHTTPClient(config.HTTPClientConfig{DialTimeout: -time.Second})
+	assert.Error(t, err)
+}
+
+func TestResponseHeaderTimeoutBoundsSlowNode(t *testing.T) {
+	release := make(chan struct{})
+	node := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	}))
+	defer node.Close()
+	defer close(release)
+
+	client, err := newHTTPClient(config.HTTPClientConfig{ResponseHeaderTimeout: 50 * time.Millisecond, Timeout: 10 * time.Second})
+	require.NoError(t, err)
+	c := &RESTClient{baseURL: node.URL, client: client, logger: zerolog.Nop()}
+
+	start := time.Now()
+	_, err = c.makeRequest(context.Background(), "GET", "/cosmos/base/tendermint/v1beta1/node_info", nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "timeout awaiting response headers")
+	assert.Less(t, time.Since(start), 5*time.Second)
+}
+
+// BenchmarkConcurrentQueries sends bursts of concurrent component queries,
+// as dashboards refreshing together do. Go's default transport keeps two idle
+// connections to the node, so most of each burst has to reconnect; the pool
+// configured by blockchain.http keeps them all. conns/op counts the
+// connections opened per burst.
+func BenchmarkConcurrentQueries(b *testing.B) {
+	const burst = 16
+	var conns atomic.Int64
+	node := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(time.Millisecond) // a node takes a moment to answer, so a burst's queries overlap
+		_, _ = w.Write([]byte(`{"component": {"component_id": "MODBATT-MOD-001", "status": "active"}}`))
+	}))
+	node.Config.ConnState = func(_ net.Conn, state http.ConnState) {
+		if state == http.StateNew {
+			conns.Add(1)
+		}
+	}
+	node.Start()
+	defer node.Close()
+
+	tuned, err := newHTTPClient(tunedHTTPConfig)
+	require.NoError(b, err)
+	clients := map[string]*http.Client{
+		"default_transport": {Timeout: 30 * time.Second},
+		"tuned_transport":   tuned,
+	}
+
+	for _, name := range []string{"default_transport", "tuned_transport"} {
+		b.Run(name, func(b *testing.B) {
+			client := &Client{restClient: &RESTClient{baseURL: node.URL, client: clients[name], logger: zerolog.Nop()}, logger: zerolog.Nop()}
+			conns.Store(0)
+			var next atomic.Int64
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				var wg sync.WaitGroup
+				for j := 0; j < burst; j++ {
+					wg.Add(1)
+					go func() {
+						defer wg.Done()
+						// Distinct IDs so concurrent queries are not coalesced
+						id := fmt.Sprintf("MODBATT-MOD-%d", next.Add(1))
+						if _, err := client.GetComponent(context.Background(), id); err != nil {
+							b.Error(err)
+						}
+					}()
+				}
+				wg.Wait()
+			}
+			b.ReportMetric(float64(conns.Load())/float64(b.N), "conns/op")
+		})
+	}
+}
@@ -0,0 +1,65 @@
+package blockchain
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Trust scores are fractions; the chain stores them as LegacyDec strings,
+// which carry at most 18 decimal places
+const (
+	MinTrustScore = 0.0
+	MaxTrustScore = 1.0
+
+	trustScorePrecision = 18
+)
+
+// ErrInvalidTrustScore is returned for a trust score the chain would not accept
+var ErrInvalidTrustScore = errors.New("invalid trust score")
+
+// FormatTrustScore validates score and renders it as a decimal string for the
+// chain. The shortest representation that round-trips the float is used, so
+// 0.875 stays "0.875" rather than being cut to two places; only digits past
+// LegacyDec precision are rounded away.
+func FormatTrustScore(score float64) (string, error) {
+	if math.IsNaN(score) || math.IsInf(score, 0) {
+		return "", fmt.Errorf("%w: %v is not a number", ErrInvalidTrustScore, score)
+	}
+	if score < MinTrustScore || score > MaxTrustScore {
+		return "", fmt.Errorf("%w: %v is outside [%g, %g]", ErrInvalidTrustScore, score, MinTrustScore, MaxTrustScore)
+	}
+
+	formatted := strconv.FormatFloat(score, 'f', -1, 64)
+	if dot := strings.IndexByte(formatted, '.'); dot >= 0 && len(formatted)-dot-1 > trustScorePrecision {
+		formatted = strconv.FormatFloat(score, 'f', trustScorePrecision, 64)
+		formatted = strings.TrimRight(strings.TrimRight(formatted, "0"), ".")
+	}
+	return formatted, nil
+}
+
+// formatTensorDimensions renders named dimension scores as the chain's
+// TensorDimension list, sorted by name so the message is deterministic
+func formatTensorDimensions(dimensions map[string]float64) ([]map[string]string, error) {
+	names := make([]string, 0, len(dimensions))
+	for name := range dimensions {
+		if name == "" {
+			return nil, fmt.Errorf("%w: dimension name cannot be empty", ErrInvalidTrustScore)
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	encoded := make([]map[string]string, 0, len(names))
+	for _, name := range names {
+		score, err := FormatTrustScore(dimensions[name])
+		if err != nil {
+			return nil, fmt.Errorf("dimension %s: %w", name, err)
+		}
+		encoded = append(encoded, map[string]string{"name": name, "score": score})
+	}
+	return encoded, nil
+}
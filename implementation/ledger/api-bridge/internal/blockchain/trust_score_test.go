@@ -0,0 +1,31 @@
+package blockchain
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFormatTrustScore(t *testing.T) {
+	for score, want := range map[float64]string{
+		0:        "0",
+		1:        "1",
+		0.5:      "0.5",
+		0.875:    "0.875",
+		0.123456: "0.123456",
+		// Digits beyond LegacyDec precision are rounded, not rejected
+		1e-20: "0",
+		7e-19: "0.000000000000000001",
+	} {
+		got, err := FormatTrustScore(score)
+		require.NoError(t, err, score)
+		assert.Equal(t, want, got, score)
+	}
+
+	for _, score := range []float64{-0.0001, 1.0001, 100, math.NaN(), math.Inf(1)} {
+		_, err := FormatTrustScore(score)
+		assert.ErrorIs(t, err, ErrInvalidTrustScore, score)
+	}
+}
@@ -0,0 +1,156 @@
+package blockchain
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"time"
+)
+
+// Event is a tx event with its attribute keys and values decoded
+type Event struct {
+	Type       string
+	Attributes []EventAttribute
+}
+
+// EventAttribute is one decoded attribute of an Event
+type EventAttribute struct {
+	Key   string
+	Value string
+}
+
+// Attribute returns the first value of key on the event
+func (e Event) Attribute(key string) (string, bool) {
+	for _, attr := range e.Attributes {
+		if attr.Key == key {
+			return attr.Value, true
+		}
+	}
+	return "", false
+}
+
+// txEvents returns the events of a tx response, the top-level "events"
+// before those of the legacy per-message "logs". It accepts the typed shape
+// built by the native signer ([]map[string]interface{}) as well as decoded
+// JSON ([]interface{}).
+func txEvents(txResult map[string]interface{}) []Event {
+	var events []Event
+	for _, event := range asObjectList(txResult["events"]) {
+		events = append(events, decodeEvent(event))
+	}
+	for _, log := range asObjectList(txResult["logs"]) {
+		for _, event := range asObjectList(log["events"]) {
+			events = append(events, decodeEvent(event))
+		}
+	}
+	return events
+}
+
+// decodeEvent reads an event, decoding it when it comes from an older SDK
+// that base64-encodes every attribute key and value. The encoding is decided
+// for the whole event, by every key decoding to an attribute name, so a
+// plain value that happens to be valid base64 is never decoded.
+func decodeEvent(raw map[string]interface{}) Event {
+	event := Event{}
+	event.Type, _ = raw["type"].(string)
+	for _, attr := range asObjectList(raw["attributes"]) {
+		key, _ := attr["key"].(string)
+		value, _ := attr["value"].(string)
+		event.Attributes = append(event.Attributes, EventAttribute{Key: key, Value: value})
+	}
+
+	decoded := make([]EventAttribute, 0, len(event.Attributes))
+	for _, attr := range event.Attributes {
+		key, ok := decodeBase64(attr.Key)
+		if !ok || !isAttributeName(key) {
+			return event
+		}
+		value := attr.Value
+		if decodedValue, ok := decodeBase64(value); ok {
+			value = decodedValue
+		}
+		decoded = append(decoded, EventAttribute{Key: key, Value: value})
+	}
+	event.Attributes = decoded
+	return event
+}
+
+// isAttributeName reports whether s looks like an event attribute key
+func isAttributeName(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, r := range s {
+		if !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '_' || r == '.' || r == '-') {
+			return false
+		}
+	}
+	return true
+}
+
+// extractEventAttribute returns the first value of attrKey on an event of
+// eventType in a tx response
+func extractEventAttribute(txResult map[string]interface{}, eventType, attrKey string) (string, bool) {
+	for _, event := range txEvents(txResult) {
+		if event.Type != eventType {
+			continue
+		}
+		if value, ok := event.Attribute(attrKey); ok {
+			return value, true
+		}
+	}
+	return "", false
+}
+
+// extractTypedEventAttribute is extractEventAttribute for events emitted with
+// EmitTypedEvent, whose attribute values are JSON encoded
+func extractTypedEventAttribute(txResult map[string]interface{}, eventType, attrKey string) (string, bool) {
+	value, ok := extractEventAttribute(txResult, eventType, attrKey)
+	if !ok {
+		return "", false
+	}
+	var decoded string
+	if err := json.Unmarshal([]byte(value), &decoded); err != nil {
+		return value, true
+	}
+	return decoded, true
+}
+
+// txTime returns the Unix time of the block that included the tx. Responses
+// to a sync broadcast carry no block time yet, so the broadcast time is used.
+func txTime(txResult map[string]interface{}) int64 {
+	if stamp, ok := txResult["timestamp"].(string); ok && stamp != "" {
+		if t, err := time.Parse(time.RFC3339, stamp); err == nil {
+			return t.Unix()
+		}
+	}
+	return time.Now().Unix()
+}
+
+// asObjectList normalizes []map[string]interface{} and []interface{} into a list of objects
+func asObjectList(v interface{}) []map[string]interface{} {
+	switch list := v.(type) {
+	case []map[string]interface{}:
+		return list
+	case []interface{}:
+		objects := make([]map[string]interface{}, 0, len(list))
+		for _, item := range list {
+			if object, ok := item.(map[string]interface{}); ok {
+				objects = append(objects, object)
+			}
+		}
+		return objects
+	}
+	return nil
+}
+
+// decodeBase64 decodes s if it is non-empty standard base64
+func decodeBase64(s string) (string, bool) {
+	if s == "" {
+		return "", false
+	}
+	decoded, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		return "", false
+	}
+	return string(decoded), true
+}
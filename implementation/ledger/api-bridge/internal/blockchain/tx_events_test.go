@@ -0,0 +1,242 @@
+package blockchain
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// racecar-webd tx pairing initiate-bidirectional-pairing ... --output json (SDK v0.53, block mode)
+const initiatePairingTxJSON = `{
+  "height": "1842",
+  "txhash": "9F0C3A1B2D4E5F60718293A4B5C6D7E8F9011223344556677889900AABBCCDD",
+  "codespace": "",
+  "code": 0,
+  "raw_log": "",
+  "logs": [],
+  "info": "",
+  "gas_wanted": "200000",
+  "gas_used": "98431",
+  "tx": null,
+  "timestamp": "2025-07-14T09:21:44Z",
+  "events": [
+    {"type": "tx", "attributes": [{"key": "fee", "value": "", "index": true}, {"key": "fee_payer", "value": "cosmos1cs2clgcszut5ppvecfa4zrftvv9xz59w9fqcuv", "index": true}]},
+    {"type": "tx", "attributes": [{"key": "acc_seq", "value": "cosmos1cs2clgcszut5ppvecfa4zrftvv9xz59w9fqcuv/12", "index": true}]},
+    {"type": "message", "attributes": [{"key": "action", "value": "/racecarweb.pairing.v1.MsgInitiateBidirectionalPairing", "index": true}, {"key": "sender", "value": "cosmos1cs2clgcszut5ppvecfa4zrftvv9xz59w9fqcuv", "index": true}, {"key": "module", "value": "pairing", "index": true}, {"key": "msg_index", "value": "0", "index": true}]},
+    {"type": "bidirectional_pairing_initiated", "attributes": [
+      {"key": "challenge_id", "value": "challenge-MODBATT-PACK-001-MODBATT-MC-001-1752484904", "index": true},
+      {"key": "component_a", "value": "MODBATT-PACK-001", "index": true},
+      {"key": "component_b", "value": "MODBATT-MC-001", "index": true},
+      {"key": "lct_id", "value": "lct-MODBATT-PACK-001-MODBATT-MC-001-1752484904", "index": true},
+      {"key": "status", "value": "pending", "index": true},
+      {"key": "msg_index", "value": "0", "index": true}
+    ]}
+  ]
+}`
+
+// The same kind of response from an SDK v0.46 node: top-level events are
+// base64 encoded and the per-message logs carry the plain attributes
+const legacyCreateLctTxJSON = `{
+  "height": "311",
+  "txhash": "0B7E6A4F2C1D9E8F7A6B5C4D3E2F1A0B9C8D7E6F5A4B3C2D1E0F9A8B7C6D5E4F",
+  "code": 0,
+  "raw_log": "[]",
+  "logs": [
+    {"msg_index": 0, "log": "", "events": [
+      {"type": "message", "attributes": [{"key": "action", "value": "/racecarweb.lctmanager.v1.MsgCreateLctRelationship"}]}
+    ]}
+  ],
+  "events": [
+    {"type": "message", "attributes": [{"key": "YWN0aW9u", "value": "L3JhY2VjYXJ3ZWIubGN0bWFuYWdlci52MS5Nc2dDcmVhdGVMY3RSZWxhdGlvbnNoaXA=", "index": true}]},
+    {"type": "lct_relationship_created", "attributes": [
+      {"key": "bGN0X2lk", "value": "bGN0X01PREJBVFQtUEFDSy0wMDFfTU9EQkFUVC1NT0QtMDAxXzE3NTI0ODQ5MDQ=", "index": true},
+      {"key": "c3RhdHVz", "value": "cGVuZGluZw==", "index": true}
+    ]}
+  ]
+}`
+
+func decodeTxJSON(t *testing.T, raw string) map[string]interface{} {
+	t.Helper()
+	var txResult map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(raw), &txResult))
+	return txResult
+}
+
+func TestExtractEventAttributeFromCapturedResponses(t *testing.T) {
+	pairing := decodeTxJSON(t, initiatePairingTxJSON)
+	value, ok := extractEventAttribute(pairing, "bidirectional_pairing_initiated", "challenge_id")
+	require.True(t, ok)
+	assert.Equal(t, "challenge-MODBATT-PACK-001-MODBATT-MC-001-1752484904", value)
+
+	value, ok = extractEventAttribute(pairing, "message", "action")
+	require.True(t, ok)
+	assert.Equal(t, "/racecarweb.pairing.v1.MsgInitiateBidirectionalPairing", value)
+
+	legacy := decodeTxJSON(t, legacyCreateLctTxJSON)
+	value, ok = extractEventAttribute(legacy, "lct_relationship_created", "lct_id")
+	require.True(t, ok)
+	assert.Equal(t, "lct_MODBATT-PACK-001_MODBATT-MOD-001_1752484904", value)
+
+	_, ok = extractEventAttribute(legacy, "lct_relationship_created", "component_a")
+	assert.False(t, ok)
+	_, ok = extractEventAttribute(pairing, "pairing_completed", "lct_id")
+	assert.False(t, ok)
+}
+
+func TestExtractEventAttributeFromLegacyLogs(t *testing.T) {
+	txResult := decodeTxJSON(t, `{
+	  "txhash": "ABC",
+	  "code": 0,
+	  "logs": [{"msg_index": 0, "events": [
+	    {"type": "pairing_completed", "attributes": [{"key": "lct_id", "value": "lct-PACK-MC-1"}, {"key": "status", "value": "completed"}]}
+	  ]}]
+	}`)
+
+	value, ok := extractEventAttribute(txResult, "pairing_completed", "lct_id")
+	require.True(t, ok)
+	assert.Equal(t, "lct-PACK-MC-1", value)
+}
+
+func TestExtractEventAttributeFromNativeResponse(t *testing.T) {
+	txResult := txResponseToMap(&sdk.TxResponse{
+		TxHash: "ABC",
+		Events: []abci.Event{{
+			Type: "component_lct_created",
+			Attributes: []abci.EventAttribute{
+				{Key: "component_id", Value: "MODBATT-MOD-RC001-001"},
+				{Key: "status", Value: "pending_device_pairing"},
+			},
+		}},
+	})
+
+	value, ok := extractEventAttribute(txResult, "component_lct_created", "component_id")
+	require.True(t, ok)
+	assert.Equal(t, "MODBATT-MOD-RC001-001", value)
+}
+
+// Every event the REST client reads, in both the plain and base64 encodings
+func TestExtractEventAttributeForEachEventType(t *testing.T) {
+	testCases := []struct {
+		eventType string
+		attrKey   string
+		value     string
+	}{
+		{"component_lct_created", "component_id", "MODBATT-MOD-RC001-001"},
+		{"anonymous_component_registered", "component_hash", "hash_3f2a"},
+		{"component_verified", "status", "pairing_verified"},
+		{"anonymous_pairing_authorized", "auth_id", "auth_91bc"},
+		{"anonymous_revocation_created", "revocation_id", "rev_77de"},
+		{"anonymous_component_metadata_retrieved", "trust_anchor", "cryptographic_trust_anchor"},
+		{"bidirectional_pairing_initiated", "challenge_id", "challenge-PACK-MC-1"},
+		{"pairing_completed", "lct_id", "lct-PACK-MC-1"},
+		{"lct_relationship_created", "lct_id", "lct_PACK_MOD_1"},
+		{"relationship_tensor_created", "tensor_id", "tensor_PACK_MC"},
+		{"energy_operation_created", "operation_id", "op_1752484904"},
+		{"pairing_request_queued", "request_id", "req_42"},
+		{"offline_queue_processed", "processed_requests", "3"},
+		{"pairing_authorization_created", "authorization_id", "auth_PACK_MC"},
+		{"relationship_trust_calculated", "trust_score", "0.92"},
+		{"tensor_score_updated", "tensor_id", "tensor_PACK_MC"},
+	}
+
+	encode := func(s string) string { return base64.StdEncoding.EncodeToString([]byte(s)) }
+
+	for _, tc := range testCases {
+		t.Run(tc.eventType, func(t *testing.T) {
+			plain, err := json.Marshal(map[string]interface{}{
+				"events": []map[string]interface{}{
+					{"type": "message", "attributes": []map[string]interface{}{{"key": tc.attrKey, "value": "from-other-event"}}},
+					{"type": tc.eventType, "attributes": []map[string]interface{}{{"key": tc.attrKey, "value": tc.value, "index": true}}},
+				},
+			})
+			require.NoError(t, err)
+
+			value, ok := extractEventAttribute(decodeTxJSON(t, string(plain)), tc.eventType, tc.attrKey)
+			require.True(t, ok)
+			assert.Equal(t, tc.value, value)
+
+			encoded, err := json.Marshal(map[string]interface{}{
+				"events": []map[string]interface{}{
+					{"type": tc.eventType, "attributes": []map[string]interface{}{{"key": encode(tc.attrKey), "value": encode(tc.value), "index": true}}},
+				},
+			})
+			require.NoError(t, err)
+
+			value, ok = extractEventAttribute(decodeTxJSON(t, string(encoded)), tc.eventType, tc.attrKey)
+			require.True(t, ok)
+			assert.Equal(t, tc.value, value)
+		})
+	}
+}
+
+func TestExtractTypedEventAttribute(t *testing.T) {
+	txResult := decodeTxJSON(t, `{"events": [
+		{"type": "racecarweb.componentregistry.v1.EventComponentVerified", "attributes": [
+			{"key": "component_id", "value": "\"MODBATT-MOD-001\"", "index": true},
+			{"key": "status", "value": "\"verified\"", "index": true}
+		]}
+	]}`)
+
+	value, ok := extractTypedEventAttribute(txResult, "racecarweb.componentregistry.v1.EventComponentVerified", "status")
+	require.True(t, ok)
+	assert.Equal(t, "verified", value)
+
+	_, ok = extractTypedEventAttribute(txResult, "racecarweb.componentregistry.v1.EventComponentVerified", "verifier")
+	assert.False(t, ok)
+}
+
+func TestDecodeEvent(t *testing.T) {
+	encode := func(s string) string { return base64.StdEncoding.EncodeToString([]byte(s)) }
+	attrs := func(pairs ...string) []interface{} {
+		list := make([]interface{}, 0, len(pairs)/2)
+		for i := 0; i+1 < len(pairs); i += 2 {
+			list = append(list, map[string]interface{}{"key": pairs[i], "value": pairs[i+1]})
+		}
+		return list
+	}
+
+	testCases := []struct {
+		name       string
+		attributes []interface{}
+		expected   []EventAttribute
+	}{
+		{
+			name:       "plain",
+			attributes: attrs("lct_id", "lct-PACK-MC-1", "status", "active"),
+			expected:   []EventAttribute{{"lct_id", "lct-PACK-MC-1"}, {"status", "active"}},
+		},
+		{
+			name:       "base64",
+			attributes: attrs(encode("lct_id"), encode("lct-PACK-MC-1"), encode("status"), encode("active")),
+			expected:   []EventAttribute{{"lct_id", "lct-PACK-MC-1"}, {"status", "active"}},
+		},
+		{
+			name:       "plain values that are valid base64 are kept",
+			attributes: attrs("key_reference", "dGVzdA==", "amount", "1234"),
+			expected:   []EventAttribute{{"key_reference", "dGVzdA=="}, {"amount", "1234"}},
+		},
+		{
+			name:       "plain keys that are valid base64 are kept",
+			attributes: attrs("code", "abcd", "from", "MODBATT-PACK-001"),
+			expected:   []EventAttribute{{"code", "abcd"}, {"from", "MODBATT-PACK-001"}},
+		},
+		{
+			name:       "base64 keys with an empty value",
+			attributes: attrs(encode("reason"), ""),
+			expected:   []EventAttribute{{"reason", ""}},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			event := decodeEvent(map[string]interface{}{"type": "lct_status_changed", "attributes": tc.attributes})
+			assert.Equal(t, "lct_status_changed", event.Type)
+			assert.Equal(t, tc.expected, event.Attributes)
+		})
+	}
+}
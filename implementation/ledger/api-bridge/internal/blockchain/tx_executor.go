@@ -0,0 +1,99 @@
+package blockchain
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+// Transaction modes selectable through blockchain.tx_mode
+const (
+	TxModeCLI    = "cli"
+	TxModeNative = "native"
+	TxModeMock   = "mock" // reported by the in-memory chain of blockchain.mock
+)
+
+// TxExecutor signs and broadcasts a single chain message on behalf of an account.
+// The message uses the JSON shape of the Msg ("@type" plus proto field names).
+type TxExecutor interface {
+	// Execute signs the message as account with the given gas limit and fee and
+	// broadcasts it, returning the tx response
+	Execute(ctx context.Context, account *Account, message map[string]interface{}, memo string, gas txGas) (map[string]interface{}, error)
+
+	// SimulationTx encodes the message as an unsigned transaction for the
+	// simulate endpoint, using the sequence the broadcast would sign with
+	SimulationTx(ctx context.Context, account *Account, message map[string]interface{}, memo string) ([]byte, error)
+
+	// Mode reports which transaction mode the executor implements
+	Mode() string
+}
+
+// cliTxExecutor shells out to the Ignite CLI (falling back to racecar-webd) to sign and broadcast
+type cliTxExecutor struct {
+	client *RESTClient
+
+	// The CLI signs on its own; the encoding is only needed to build simulation txs
+	encodingOnce sync.Once
+	encoding     *txEncoding
+	encodingErr  error
+}
+
+// newCLITxExecutor creates an executor that uses the CLI binaries discovered by the REST client
+func newCLITxExecutor(client *RESTClient) *cliTxExecutor {
+	return &cliTxExecutor{client: client}
+}
+
+// Mode implements TxExecutor
+func (e *cliTxExecutor) Mode() string {
+	return TxModeCLI
+}
+
+// Execute implements TxExecutor
+func (e *cliTxExecutor) Execute(ctx context.Context, account *Account, message map[string]interface{}, memo string, gas txGas) (map[string]interface{}, error) {
+	var result map[string]interface{}
+	err := e.client.withTransactionFile(message, memo, func(txFile string) error {
+		var err error
+		// Execute transaction with Ignite CLI - this must succeed for the demo
+		result, err = e.client.broadcastTransactionWithIgnite(ctx, account.Name, txFile, message, gas)
+		return err
+	})
+	return result, err
+}
+
+// SimulationTx implements TxExecutor
+func (e *cliTxExecutor) SimulationTx(ctx context.Context, account *Account, message map[string]interface{}, memo string) ([]byte, error) {
+	e.encodingOnce.Do(func() {
+		e.encoding, e.encodingErr = newTxEncoding()
+	})
+	if e.encodingErr != nil {
+		return nil, e.encodingErr
+	}
+
+	msg, err := e.encoding.decodeMsg(message)
+	if err != nil {
+		return nil, err
+	}
+
+	sequence, ok := sequenceOverride(ctx)
+	if !ok {
+		sequence, err = e.client.queryAccountSequence(ctx, account.Address)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	txBytes, err := tx.Factory{}.
+		WithTxConfig(e.encoding.txConfig).
+		WithChainID(e.client.signingChainID()). // the chain the CLI commands sign for
+		WithSequence(sequence).
+		WithMemo(memo).
+		WithSignMode(signingtypes.SignMode_SIGN_MODE_DIRECT).
+		BuildSimTx(msg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build simulation transaction: %w", err)
+	}
+	return txBytes, nil
+}
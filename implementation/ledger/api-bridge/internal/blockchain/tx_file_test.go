@@ -0,0 +1,72 @@
+package blockchain
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeIgnite broadcasts a tx file unless it names a FAIL component, and exits
+// with 2 when the file is already gone
+const fakeIgnite = `#!/bin/sh
+test -f "$3" || exit 2
+grep -q FAIL "$3" && exit 1
+echo '{"code": 0, "txhash": "ABC"}'
+`
+
+func TestBroadcastsLeaveNoTransactionFiles(t *testing.T) {
+	tmp := t.TempDir()
+	t.Setenv("TMPDIR", tmp)
+
+	bin := t.TempDir()
+	ignite := filepath.Join(bin, "ignite")
+	require.NoError(t, os.WriteFile(ignite, []byte(fakeIgnite), 0o755))
+
+	c := &RESTClient{
+		logger:      zerolog.Nop(),
+		ignitePath:  ignite,
+		racecarCmd:  filepath.Join(bin, "missing-racecar-webd"),
+		projectRoot: bin,
+	}
+	executor := newCLITxExecutor(c)
+	account := &Account{Name: "alice"}
+
+	failures := 0
+	for i := 0; i < 10; i++ {
+		componentID := fmt.Sprintf("MODBATT-MOD-%03d", i)
+		if i%3 == 0 {
+			componentID = "FAIL-" + componentID
+		}
+		message := map[string]interface{}{
+			"@type":             "/racecarweb.componentregistry.v1.MsgRegisterComponent",
+			"creator":           "alice",
+			"component_id":      componentID,
+			"component_type":    "module",
+			"manufacturer_data": "test-data",
+		}
+
+		result, err := executor.Execute(context.Background(), account, message, "", txGas{})
+		if i%3 == 0 {
+			assert.Error(t, err)
+			failures++
+			continue
+		}
+		require.NoError(t, err)
+		assert.Equal(t, "ABC", result["txhash"])
+	}
+	assert.Equal(t, 4, failures)
+
+	assert.Panics(t, func() {
+		_ = c.withTransactionFile(map[string]interface{}{}, "", func(string) error { panic("broadcast crashed") })
+	})
+
+	entries, err := os.ReadDir(tmp)
+	require.NoError(t, err)
+	assert.Empty(t, entries, "every transaction file must be removed")
+}
@@ -0,0 +1,48 @@
+package blockchain
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetVerificationHistoryParsesRecords(t *testing.T) {
+	node := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/racecar-web/componentregistry/v1/verifications/MODBATT-MOD-001":
+			assert.Equal(t, "1", r.URL.Query().Get("pagination.limit"))
+			assert.Equal(t, "AQ==", r.URL.Query().Get("pagination.key"))
+			_, _ = w.Write([]byte(`{"verifications": [
+				{"component_id": "MODBATT-MOD-001", "sequence": "2", "verifier": "cosmos1scrutineer", "result": "verified", "context": "pre-race scrutineering", "verified_at": "2025-07-14T10:21:44Z", "block_height": "20"}
+			], "pagination": {"next_key": "Ag=="}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"code": 5, "message": "component not found"}`))
+		}
+	}))
+	defer node.Close()
+
+	c := &RESTClient{baseURL: node.URL, client: node.Client(), logger: zerolog.Nop()}
+
+	page, err := c.GetVerificationHistory(context.Background(), "MODBATT-MOD-001", 1, "AQ==")
+	require.NoError(t, err)
+	assert.Equal(t, []VerificationRecord{{
+		Sequence:    2,
+		Verifier:    "cosmos1scrutineer",
+		Result:      "verified",
+		Context:     "pre-race scrutineering",
+		VerifiedAt:  time.Date(2025, 7, 14, 10, 21, 44, 0, time.UTC),
+		BlockHeight: 20,
+	}}, page["verifications"])
+	assert.Equal(t, 1, page["count"])
+	assert.Equal(t, "Ag==", page["next_key"])
+
+	_, err = c.GetVerificationHistory(context.Background(), "MODBATT-MOD-404", 0, "")
+	assert.ErrorIs(t, err, ErrComponentNotFound)
+}
@@ -2,6 +2,8 @@ package config
 
 import (
 	"fmt"
+	"os"
+	"slices"
 	"time"
 
 	"github.com/spf13/viper"
@@ -9,19 +11,141 @@ import (
 
 // Config holds the application configuration
 type Config struct {
-	Blockchain BlockchainConfig `mapstructure:"blockchain"`
-	Server     ServerConfig     `mapstructure:"server"`
-	Logging    LoggingConfig    `mapstructure:"logging"`
-	Events     EventsConfig     `mapstructure:"events"`
-	Security   SecurityConfig   `mapstructure:"security"` // New security config
+	Blockchain   BlockchainConfig   `mapstructure:"blockchain"`
+	Server       ServerConfig       `mapstructure:"server"`
+	Logging      LoggingConfig      `mapstructure:"logging"`
+	Events       EventsConfig       `mapstructure:"events"`
+	Security     SecurityConfig     `mapstructure:"security"` // New security config
+	Tracing      TracingConfig      `mapstructure:"tracing"`
+	Verification VerificationConfig `mapstructure:"verification"`
+	Validation   ValidationConfig   `mapstructure:"validation"`
 }
 
 // BlockchainConfig holds blockchain connection settings
 type BlockchainConfig struct {
-	RESTEndpoint string `mapstructure:"rest_endpoint"`
-	GRPCEndpoint string `mapstructure:"grpc_endpoint"`
-	ChainID      string `mapstructure:"chain_id"`
-	Timeout      int    `mapstructure:"timeout"`
+	RESTEndpoint string           `mapstructure:"rest_endpoint"`
+	GRPCEndpoint string           `mapstructure:"grpc_endpoint"`
+	ChainID      string           `mapstructure:"chain_id"`
+	Timeout      int              `mapstructure:"timeout"`
+	TxMode       string           `mapstructure:"tx_mode"`     // "cli" (Ignite/racecar-webd shell-out) or "native" (in-process signing)
+	Mock         bool             `mapstructure:"mock"`        // serve from an in-memory chain instead of a node, for development
+	KeyringDir   string           `mapstructure:"keyring_dir"` // keyring directory accounts are read and signed from
+	Retry        RetryConfig      `mapstructure:"retry"`
+	Breaker      BreakerConfig    `mapstructure:"circuit_breaker"`
+	HTTP         HTTPClientConfig `mapstructure:"http"`
+	Gas          GasConfig        `mapstructure:"gas"`
+	Cache        QueryCacheConfig `mapstructure:"cache"`
+
+	KeyDelivery KeyDeliveryConfig `mapstructure:"key_delivery"`
+
+	// @type URLs the bridge may broadcast; empty allows every message the bridge builds
+	AllowedMessageTypes []string `mapstructure:"allowed_message_types"`
+
+	// Keyring backend for account lookup and signing: "file", "os", "test" or "hsm"
+	KeyringBackend       string    `mapstructure:"keyring_backend"`
+	KeyringPassphraseEnv string    `mapstructure:"keyring_passphrase_env"` // env var holding the file backend passphrase
+	AllowMnemonicExport  bool      `mapstructure:"allow_mnemonic_export"`  // keep imported mnemonics, sealed with the keyring passphrase, so they can be exported again
+	HSM                  HSMConfig `mapstructure:"hsm"`
+
+	// Seconds a request may spend per operation class; 0 falls back to Timeout
+	QueryTimeout     int `mapstructure:"query_timeout"`     // reading chain state
+	BroadcastTimeout int `mapstructure:"broadcast_timeout"` // signing, simulating and broadcasting a transaction
+	SimulateTimeout  int `mapstructure:"simulate_timeout"`  // the gas simulation within a broadcast
+
+	// Energy and trust operations wait until their transaction is in a block
+	// and report how it executed, instead of returning once CheckTx passes
+	WaitForCommit bool `mapstructure:"wait_for_commit"`
+	CommitTimeout int  `mapstructure:"commit_timeout"` // seconds to wait for the block
+}
+
+// QueryTimeoutDuration bounds a request that only reads chain state
+func (c BlockchainConfig) QueryTimeoutDuration() time.Duration {
+	return c.timeoutOr(c.QueryTimeout)
+}
+
+// BroadcastTimeoutDuration bounds a request that broadcasts a transaction,
+// including the wait for it to commit when WaitForCommit is set
+func (c BlockchainConfig) BroadcastTimeoutDuration() time.Duration {
+	if c.WaitForCommit {
+		return c.timeoutOr(c.BroadcastTimeout) + c.CommitTimeoutDuration()
+	}
+	return c.timeoutOr(c.BroadcastTimeout)
+}
+
+// CommitTimeoutDuration bounds the wait for a broadcast transaction to be
+// included in a block
+func (c BlockchainConfig) CommitTimeoutDuration() time.Duration {
+	return c.timeoutOr(c.CommitTimeout)
+}
+
+// SimulateTimeoutDuration bounds the gas simulation of one broadcast attempt
+func (c BlockchainConfig) SimulateTimeoutDuration() time.Duration {
+	return c.timeoutOr(c.SimulateTimeout)
+}
+
+func (c BlockchainConfig) timeoutOr(seconds int) time.Duration {
+	if seconds <= 0 {
+		seconds = c.Timeout
+	}
+	return time.Duration(seconds) * time.Second
+}
+
+// HSMConfig selects the hardware or KMS signer used by the "hsm" keyring backend
+type HSMConfig struct {
+	Plugin  string            `mapstructure:"plugin"`
+	Options map[string]string `mapstructure:"options"` // plugin specific, e.g. slot, key label or KMS key ARN
+}
+
+// GasConfig holds gas estimation and fee settings applied to every transaction
+type GasConfig struct {
+	Adjustment float64 `mapstructure:"gas_adjustment"` // multiplier applied to the simulated gas
+	Prices     string  `mapstructure:"gas_prices"`     // e.g. "0.025stake"; empty broadcasts without fees
+	Limit      uint64  `mapstructure:"gas_limit"`      // fixed gas limit, 0 simulates each transaction
+}
+
+// QueryCacheConfig controls the short-lived cache in front of read-heavy queries
+type QueryCacheConfig struct {
+	Enabled bool                     `mapstructure:"enabled"`
+	TTL     map[string]time.Duration `mapstructure:"ttl"` // per query: "component", "lct" or "energy_balance"; others are not cached
+}
+
+// KeyDeliveryConfig hands components their split key halves, each sealed to
+// the component's X25519 key, as split_key_half events
+type KeyDeliveryConfig struct {
+	Enabled       bool                 `mapstructure:"enabled"`
+	ComponentKeys []ComponentKeyConfig `mapstructure:"component_keys"`
+}
+
+// ComponentKeyConfig is the key a component's split key halves are sealed to
+type ComponentKeyConfig struct {
+	ComponentID string `mapstructure:"component_id"`
+	PublicKey   string `mapstructure:"public_key"` // hex X25519 public key
+}
+
+// RetryConfig holds the backoff policy for retrying transient broadcast failures
+type RetryConfig struct {
+	MaxRetries     int           `mapstructure:"max_retries"`
+	InitialBackoff time.Duration `mapstructure:"initial_backoff"`
+	MaxBackoff     time.Duration `mapstructure:"max_backoff"`
+	Multiplier     float64       `mapstructure:"multiplier"`
+	Jitter         float64       `mapstructure:"jitter"` // fraction of the delay randomised in either direction
+}
+
+// BreakerConfig controls the circuit breaker that stops broadcasting while the node is unreachable
+type BreakerConfig struct {
+	FailureThreshold int           `mapstructure:"failure_threshold"` // consecutive unreachable broadcasts that open it; 0 disables it
+	Cooldown         time.Duration `mapstructure:"cooldown"`          // how long it stays open before a trial broadcast
+}
+
+// HTTPClientConfig tunes the connection pool and timeouts of requests to the node's REST API
+type HTTPClientConfig struct {
+	MaxIdleConns          int           `mapstructure:"max_idle_conns"`          // idle connections kept across all hosts; 0 is unlimited
+	MaxIdleConnsPerHost   int           `mapstructure:"max_idle_conns_per_host"` // idle connections kept to the node; 0 uses Go's default of 2
+	IdleConnTimeout       time.Duration `mapstructure:"idle_conn_timeout"`       // how long an idle connection is kept
+	DisableKeepAlives     bool          `mapstructure:"disable_keep_alives"`     // open a new connection for every request
+	DialTimeout           time.Duration `mapstructure:"dial_timeout"`            // connecting to the node
+	ResponseHeaderTimeout time.Duration `mapstructure:"response_header_timeout"` // waiting for the node to start answering
+	Timeout               time.Duration `mapstructure:"timeout"`                 // a whole request, including reading the body
 }
 
 // ServerConfig holds server settings
@@ -30,6 +154,110 @@ type ServerConfig struct {
 	Host         string `mapstructure:"host"`
 	ReadTimeout  int    `mapstructure:"read_timeout"`
 	WriteTimeout int    `mapstructure:"write_timeout"`
+
+	Compression          CompressionConfig    `mapstructure:"compression"`
+	IdempotencyTTL       int                  `mapstructure:"idempotency_ttl"`        // seconds a response is replayed for a repeated Idempotency-Key
+	OperationLogSize     int                  `mapstructure:"operation_log_size"`     // recent operations kept per creator for GET /accounts/:name/operations
+	OperationLogCreators int                  `mapstructure:"operation_log_creators"` // creators whose operations are kept; the least recently active is dropped beyond this
+	RegisterStreamBatch  int                  `mapstructure:"register_stream_batch"`  // components of POST /components/register-stream registered at a time
+	RegisterStreamLimit  int                  `mapstructure:"register_stream_limit"`  // components one register-stream request may carry
+	MaxPageSize          int                  `mapstructure:"max_page_size"`          // largest ?limit any paginated endpoint honours; larger requests are clamped
+	BatchWorkers         int                  `mapstructure:"batch_workers"`          // node queries a batch endpoint (POST /energy/balances, /pairing/status/batch) runs at a time
+	MaxBodyBytes         int64                `mapstructure:"max_body_bytes"`         // largest request body read; NDJSON streams are capped per stream instead
+	GRPCTLS              GRPCTLSConfig        `mapstructure:"grpc_tls"`
+	RateLimit            WriteRateLimitConfig `mapstructure:"rate_limit"`
+	WebSocket            WebSocketConfig      `mapstructure:"websocket"`
+	CORS                 CORSConfig           `mapstructure:"cors"`
+}
+
+// CORSConfig lets browser pages on other origins call the REST API
+type CORSConfig struct {
+	// Origins allowed to call the API, e.g. "https://dashboard.example.com".
+	// "https://*.example.com" allows every subdomain and "*" every origin;
+	// neither is allowed unless listed. Empty allows none.
+	AllowedOrigins   []string `mapstructure:"allowed_origins"`
+	AllowedMethods   []string `mapstructure:"allowed_methods"`
+	AllowedHeaders   []string `mapstructure:"allowed_headers"`
+	ExposedHeaders   []string `mapstructure:"exposed_headers"` // response headers page scripts may read
+	AllowCredentials bool     `mapstructure:"allow_credentials"`
+	MaxAge           int      `mapstructure:"max_age"` // seconds browsers may cache a preflight
+}
+
+// WebSocketConfig restricts which browser origins may open GET /ws
+type WebSocketConfig struct {
+	// Origins allowed to connect, e.g. "https://dashboard.example.com". Empty
+	// allows only same-origin requests and clients that send no Origin; "*"
+	// allows any origin.
+	AllowedOrigins []string `mapstructure:"allowed_origins"`
+}
+
+// WriteRateLimitConfig throttles write requests per authenticated user, or per
+// client IP when authentication is off, with a token bucket
+type WriteRateLimitConfig struct {
+	Enabled   bool                         `mapstructure:"enabled"`
+	Rate      float64                      `mapstructure:"rate"`      // requests per second refilled into each bucket
+	Burst     int                          `mapstructure:"burst"`     // requests allowed back to back
+	Endpoints map[string]EndpointRateLimit `mapstructure:"endpoints"` // overrides keyed by route path, e.g. "/api/v1/components/register"
+}
+
+// EndpointRateLimit overrides the write rate limit for one route
+type EndpointRateLimit struct {
+	Rate  float64 `mapstructure:"rate"`
+	Burst int     `mapstructure:"burst"`
+}
+
+// GRPCTLSConfig secures the gRPC server with TLS, or mutual TLS when a client CA is set
+type GRPCTLSConfig struct {
+	Insecure          bool   `mapstructure:"insecure"` // serve plaintext; for local development and tests only
+	CertFile          string `mapstructure:"cert_file"`
+	KeyFile           string `mapstructure:"key_file"`
+	ClientCAFile      string `mapstructure:"client_ca_file"`      // PEM bundle client certificates are verified against
+	RequireClientCert bool   `mapstructure:"require_client_cert"` // reject clients without a certificate signed by client_ca_file
+}
+
+// CompressionConfig controls gzip encoding of REST responses
+type CompressionConfig struct {
+	Enabled bool `mapstructure:"enabled"`
+	MinSize int  `mapstructure:"min_size"` // bytes; smaller responses are sent uncompressed
+}
+
+// TracingConfig controls OpenTelemetry request tracing and the OTLP exporter
+type TracingConfig struct {
+	Enabled     bool    `mapstructure:"enabled"`
+	ServiceName string  `mapstructure:"service_name"`
+	Exporter    string  `mapstructure:"exporter"`     // "otlp-grpc" or "otlp-http"
+	Endpoint    string  `mapstructure:"endpoint"`     // collector host:port, e.g. "localhost:4317"
+	Insecure    bool    `mapstructure:"insecure"`     // export without TLS
+	SampleRatio float64 `mapstructure:"sample_ratio"` // fraction of new traces kept; incoming traceparent decisions are honoured
+}
+
+// VerificationConfig controls verifying components as part of registration
+type VerificationConfig struct {
+	AutoVerify bool   `mapstructure:"auto_verify"` // verify each component right after it registers
+	Verifier   string `mapstructure:"verifier"`    // account that signs the verification; empty uses the registering creator
+
+	// Service pairings with hashes are checked against before the bridge
+	// submits its verdict; unset leaves the decision to the chain
+	Manufacturer ManufacturerVerificationConfig `mapstructure:"manufacturer"`
+}
+
+// ManufacturerVerificationConfig connects to a manufacturer verification service
+type ManufacturerVerificationConfig struct {
+	URL              string        `mapstructure:"url"`               // https base URL; empty disables the service
+	AuthToken        string        `mapstructure:"auth_token"`        // sent as a bearer token
+	Attestor         string        `mapstructure:"attestor"`          // account listed in the chain's verification_attestors that signs verdicts
+	Timeout          time.Duration `mapstructure:"timeout"`           // each attempt of a call
+	MaxRetries       int           `mapstructure:"max_retries"`       // retries after a transport error, a 429 or a 5xx
+	RetryBackoff     time.Duration `mapstructure:"retry_backoff"`     // wait before the first retry; doubles after each one
+	FailureThreshold int           `mapstructure:"failure_threshold"` // consecutive failed calls that open the circuit breaker
+	OpenTimeout      time.Duration `mapstructure:"open_timeout"`      // how long the open breaker fails calls before a trial one
+}
+
+// ValidationConfig controls checking request payloads before they are broadcast
+type ValidationConfig struct {
+	// JSON schema file component_data must satisfy, per registered component type; types
+	// without one accept free-form component_data
+	ComponentSchemas map[string]string `mapstructure:"component_schemas"`
 }
 
 // LoggingConfig holds logging settings
@@ -40,11 +268,104 @@ type LoggingConfig struct {
 
 // EventsConfig holds event queue settings
 type EventsConfig struct {
-	Enabled    bool                `mapstructure:"enabled"`
-	MaxRetries int                 `mapstructure:"max_retries"`
-	RetryDelay int                 `mapstructure:"retry_delay"`
-	QueueSize  int                 `mapstructure:"queue_size"`
-	Endpoints  map[string][]string `mapstructure:"endpoints"`
+	Enabled           bool                   `mapstructure:"enabled"`
+	MaxRetries        int                    `mapstructure:"max_retries"`
+	RetryDelay        int                    `mapstructure:"retry_delay"`
+	Retry             WebhookRetryConfig     `mapstructure:"retry"` // redelivery schedule of every endpoint without its own
+	QueueSize         int                    `mapstructure:"queue_size"`
+	DedupeWindow      int                    `mapstructure:"dedupe_window"`
+	StreamBuffer      int                    `mapstructure:"stream_buffer"`       // events buffered per WebSocket client before dropping
+	MaxAttributeBytes int                    `mapstructure:"max_attribute_bytes"` // longer event attributes are truncated; 0 disables
+	PayloadCacheSize  int                    `mapstructure:"payload_cache_size"`  // full values of truncated attributes kept for fetching
+	DataDir           string                 `mapstructure:"data_dir"`            // keeps queued and dead-lettered events across restarts; empty keeps them in memory
+	DeadLetterLimit   int                    `mapstructure:"dead_letter_limit"`   // dead letters kept; the oldest are dropped past it
+	Signing           []WebhookSigningConfig `mapstructure:"signing"`             // per-endpoint HMAC secrets for X-ACT-Signature
+	Webhooks          []WebhookConfig        `mapstructure:"webhooks"`            // endpoints with the event types they subscribe to
+	Endpoints         map[string][]string    `mapstructure:"endpoints"`           // endpoints per event type; "*" receives every type
+	BlockWatcher      BlockWatcherConfig     `mapstructure:"block_watcher"`       // emits block_produced for every block the node commits
+}
+
+// BlockWatcherConfig subscribes to the node's NewBlock events over its RPC websocket
+type BlockWatcherConfig struct {
+	Enabled           bool          `mapstructure:"enabled"`
+	Endpoint          string        `mapstructure:"endpoint"`            // the node's RPC websocket, e.g. ws://localhost:26657/websocket
+	ReconnectDelay    time.Duration `mapstructure:"reconnect_delay"`     // wait before the first reconnect after the connection drops
+	MaxReconnectDelay time.Duration `mapstructure:"max_reconnect_delay"` // the wait doubles on every failed reconnect up to this
+	IdleTimeout       time.Duration `mapstructure:"idle_timeout"`        // reconnect when the node has sent nothing for this long
+}
+
+// WebhookConfig holds one webhook endpoint and the event types it receives
+type WebhookConfig struct {
+	URL       string              `mapstructure:"url"`
+	Subscribe []string            `mapstructure:"subscribe"` // event types to deliver; empty or "*" delivers every type
+	Retry     *WebhookRetryConfig `mapstructure:"retry"`     // overrides events.retry for this endpoint
+}
+
+// WebhookRetryConfig schedules the redeliveries of a failed webhook POST
+type WebhookRetryConfig struct {
+	Policy         string        `mapstructure:"policy"`          // "exponential" (default) or "fixed"
+	InitialBackoff time.Duration `mapstructure:"initial_backoff"` // wait before the first redelivery; 0 uses events.retry_delay
+	MaxBackoff     time.Duration `mapstructure:"max_backoff"`     // caps every wait; 0 leaves it uncapped
+	Multiplier     float64       `mapstructure:"multiplier"`      // exponential growth per attempt; 0 doubles
+	Jitter         float64       `mapstructure:"jitter"`          // fraction of the delay randomised in either direction
+}
+
+// Sinks returns the webhook endpoints of each event type, merging the
+// subscriptions in webhooks into endpoints. Every event type's endpoints
+// are listed under "*".
+func (c EventsConfig) Sinks() map[string][]string {
+	sinks := make(map[string][]string, len(c.Endpoints))
+	for eventType, endpoints := range c.Endpoints {
+		if len(endpoints) > 0 {
+			sinks[eventType] = append([]string(nil), endpoints...)
+		}
+	}
+	for _, webhook := range c.Webhooks {
+		if webhook.URL == "" {
+			continue
+		}
+		subscribe := webhook.Subscribe
+		if len(subscribe) == 0 {
+			subscribe = []string{"*"}
+		}
+		for _, eventType := range subscribe {
+			if !slices.Contains(sinks[eventType], webhook.URL) {
+				sinks[eventType] = append(sinks[eventType], webhook.URL)
+			}
+		}
+	}
+	return sinks
+}
+
+// WebhookSigningConfig holds the HMAC secret deliveries to one webhook endpoint are signed with
+type WebhookSigningConfig struct {
+	Endpoint  string `mapstructure:"endpoint"`
+	SecretEnv string `mapstructure:"secret_env"` // env var holding the secret
+	Secret    string `mapstructure:"secret"`     // used when secret_env is not set; prefer secret_env outside development
+}
+
+// SigningSecrets returns the secret of each signed endpoint, reading
+// secret_env from the environment. A signed endpoint without a secret is an
+// error, since its deliveries would otherwise go out unsigned.
+func (c EventsConfig) SigningSecrets() (map[string]string, error) {
+	secrets := make(map[string]string, len(c.Signing))
+	for _, signing := range c.Signing {
+		if signing.Endpoint == "" {
+			return nil, fmt.Errorf("events.signing: an entry has no endpoint")
+		}
+		secret := signing.Secret
+		if signing.SecretEnv != "" {
+			secret = os.Getenv(signing.SecretEnv)
+			if secret == "" {
+				return nil, fmt.Errorf("events.signing %s: environment variable %s is empty or not set", signing.Endpoint, signing.SecretEnv)
+			}
+		}
+		if secret == "" {
+			return nil, fmt.Errorf("events.signing %s: secret_env or secret is required", signing.Endpoint)
+		}
+		secrets[signing.Endpoint] = secret
+	}
+	return secrets, nil
 }
 
 // SecurityConfig holds security and authentication settings
@@ -115,11 +436,68 @@ func setDefaults() {
 	viper.SetDefault("blockchain.grpc_endpoint", "localhost:9090")
 	viper.SetDefault("blockchain.chain_id", "racecarweb")
 	viper.SetDefault("blockchain.timeout", 30)
+	viper.SetDefault("blockchain.query_timeout", 0)
+	viper.SetDefault("blockchain.broadcast_timeout", 0)
+	viper.SetDefault("blockchain.simulate_timeout", 0)
+	viper.SetDefault("blockchain.wait_for_commit", false)
+	viper.SetDefault("blockchain.commit_timeout", 0)
+	viper.SetDefault("blockchain.tx_mode", "cli")
+	viper.SetDefault("blockchain.mock", false)
+	viper.SetDefault("blockchain.keyring_dir", "~/.racecar-web")
+	viper.SetDefault("blockchain.retry.max_retries", 3)
+	viper.SetDefault("blockchain.retry.initial_backoff", "500ms")
+	viper.SetDefault("blockchain.retry.max_backoff", "8s")
+	viper.SetDefault("blockchain.retry.multiplier", 2.0)
+	viper.SetDefault("blockchain.retry.jitter", 0.2)
+	viper.SetDefault("blockchain.circuit_breaker.failure_threshold", 5)
+	viper.SetDefault("blockchain.circuit_breaker.cooldown", "30s")
+	viper.SetDefault("blockchain.http.max_idle_conns", 100)
+	viper.SetDefault("blockchain.http.max_idle_conns_per_host", 32)
+	viper.SetDefault("blockchain.http.idle_conn_timeout", "90s")
+	viper.SetDefault("blockchain.http.disable_keep_alives", false)
+	viper.SetDefault("blockchain.http.dial_timeout", "5s")
+	viper.SetDefault("blockchain.http.response_header_timeout", "20s")
+	viper.SetDefault("blockchain.http.timeout", "30s")
+	viper.SetDefault("blockchain.gas.gas_adjustment", 1.3)
+	viper.SetDefault("blockchain.gas.gas_prices", "")
+	viper.SetDefault("blockchain.gas.gas_limit", 0)
+	viper.SetDefault("blockchain.keyring_backend", "test")
+	viper.SetDefault("blockchain.keyring_passphrase_env", "RACECAR_KEYRING_PASSPHRASE")
+	viper.SetDefault("blockchain.allow_mnemonic_export", false)
+	viper.SetDefault("blockchain.hsm.plugin", "")
+	viper.SetDefault("blockchain.cache.enabled", true)
+	viper.SetDefault("blockchain.cache.ttl", map[string]string{
+		"component":      "5s",
+		"lct":            "2s",
+		"energy_balance": "2s",
+	})
 
 	viper.SetDefault("server.port", 8080)
 	viper.SetDefault("server.host", "0.0.0.0")
 	viper.SetDefault("server.read_timeout", 30)
 	viper.SetDefault("server.write_timeout", 30)
+	viper.SetDefault("server.compression.enabled", true)
+	viper.SetDefault("server.compression.min_size", 1024)
+	viper.SetDefault("server.idempotency_ttl", 86400)
+	viper.SetDefault("server.operation_log_size", 500)
+	viper.SetDefault("server.operation_log_creators", 1000)
+	viper.SetDefault("server.register_stream_batch", 16)
+	viper.SetDefault("server.register_stream_limit", 1000)
+	viper.SetDefault("server.max_page_size", 100)
+	viper.SetDefault("server.batch_workers", 8)
+	viper.SetDefault("server.max_body_bytes", 1<<20)
+	viper.SetDefault("server.grpc_tls.insecure", false)
+	viper.SetDefault("server.grpc_tls.require_client_cert", false)
+	viper.SetDefault("server.rate_limit.enabled", true)
+	viper.SetDefault("server.rate_limit.rate", 5.0)
+	viper.SetDefault("server.rate_limit.burst", 20)
+	viper.SetDefault("server.websocket.allowed_origins", []string{})
+	viper.SetDefault("server.cors.allowed_origins", []string{})
+	viper.SetDefault("server.cors.allowed_methods", []string{"GET", "POST", "PUT", "DELETE"})
+	viper.SetDefault("server.cors.allowed_headers", []string{"Content-Type", "Authorization", "X-API-Key", "Idempotency-Key", "X-Request-ID"})
+	viper.SetDefault("server.cors.exposed_headers", []string{"Retry-After", "X-Page-Size", "X-Max-Page-Size", "Idempotent-Replayed", "X-Request-ID"})
+	viper.SetDefault("server.cors.allow_credentials", false)
+	viper.SetDefault("server.cors.max_age", 600)
 
 	viper.SetDefault("logging.level", "info")
 	viper.SetDefault("logging.format", "json")
@@ -128,7 +506,23 @@ func setDefaults() {
 	viper.SetDefault("events.enabled", false)
 	viper.SetDefault("events.max_retries", 3)
 	viper.SetDefault("events.retry_delay", 5)
+	viper.SetDefault("events.retry.policy", "exponential")
+	viper.SetDefault("events.retry.initial_backoff", "0s")
+	viper.SetDefault("events.retry.max_backoff", "0s")
+	viper.SetDefault("events.retry.multiplier", 2.0)
+	viper.SetDefault("events.retry.jitter", 0.0)
 	viper.SetDefault("events.queue_size", 1000)
+	viper.SetDefault("events.dedupe_window", 3600)
+	viper.SetDefault("events.stream_buffer", 64)
+	viper.SetDefault("events.max_attribute_bytes", 4096)
+	viper.SetDefault("events.payload_cache_size", 256)
+	viper.SetDefault("events.data_dir", "")
+	viper.SetDefault("events.dead_letter_limit", 10000)
+	viper.SetDefault("events.block_watcher.enabled", false)
+	viper.SetDefault("events.block_watcher.endpoint", "ws://localhost:26657/websocket")
+	viper.SetDefault("events.block_watcher.reconnect_delay", "1s")
+	viper.SetDefault("events.block_watcher.max_reconnect_delay", "30s")
+	viper.SetDefault("events.block_watcher.idle_timeout", "60s")
 	viper.SetDefault("events.endpoints", map[string][]string{
 		"component_registered": {},
 		"component_verified":   {},
@@ -151,6 +545,27 @@ func setDefaults() {
 	viper.SetDefault("security.cache.cleanup_interval", "1m")
 	viper.SetDefault("security.rate_limiting.enabled", true)
 	viper.SetDefault("security.rate_limiting.cleanup_interval", "1h")
+
+	// Tracing defaults - disabled by default
+	viper.SetDefault("tracing.enabled", false)
+	viper.SetDefault("tracing.service_name", "api-bridge")
+	viper.SetDefault("tracing.exporter", "otlp-grpc")
+	viper.SetDefault("tracing.endpoint", "localhost:4317")
+	viper.SetDefault("tracing.insecure", true)
+	viper.SetDefault("tracing.sample_ratio", 1.0)
+
+	// Verification defaults - registration does not verify by default
+	viper.SetDefault("verification.auto_verify", false)
+	viper.SetDefault("verification.verifier", "")
+	viper.SetDefault("verification.manufacturer.url", "")
+	viper.SetDefault("verification.manufacturer.timeout", "5s")
+	viper.SetDefault("verification.manufacturer.max_retries", 2)
+	viper.SetDefault("verification.manufacturer.retry_backoff", "200ms")
+	viper.SetDefault("verification.manufacturer.failure_threshold", 5)
+	viper.SetDefault("verification.manufacturer.open_timeout", "30s")
+
+	// Validation defaults - component_data is free-form unless a schema is configured
+	viper.SetDefault("validation.component_schemas", map[string]string{})
 }
 
 // Save saves configuration to file
@@ -160,6 +575,9 @@ func (c *Config) Save(configFile string) error {
 	viper.Set("logging", c.Logging)
 	viper.Set("events", c.Events)
 	viper.Set("security", c.Security)
+	viper.Set("tracing", c.Tracing)
+	viper.Set("verification", c.Verification)
+	viper.Set("validation", c.Validation)
 
 	return viper.WriteConfigAs(configFile)
 }
@@ -0,0 +1,54 @@
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOperationTimeoutsFallBackToTimeout(t *testing.T) {
+	cfg := BlockchainConfig{Timeout: 30}
+	assert.Equal(t, 30*time.Second, cfg.QueryTimeoutDuration())
+	assert.Equal(t, 30*time.Second, cfg.BroadcastTimeoutDuration())
+	assert.Equal(t, 30*time.Second, cfg.SimulateTimeoutDuration())
+
+	cfg.QueryTimeout = 5
+	cfg.BroadcastTimeout = 60
+	cfg.SimulateTimeout = 10
+	assert.Equal(t, 5*time.Second, cfg.QueryTimeoutDuration())
+	assert.Equal(t, 60*time.Second, cfg.BroadcastTimeoutDuration())
+	assert.Equal(t, 10*time.Second, cfg.SimulateTimeoutDuration())
+}
+
+func TestBroadcastTimeoutCoversCommitWait(t *testing.T) {
+	cfg := BlockchainConfig{Timeout: 30, BroadcastTimeout: 60}
+	assert.Equal(t, 30*time.Second, cfg.CommitTimeoutDuration())
+
+	cfg.WaitForCommit = true
+	cfg.CommitTimeout = 20
+	assert.Equal(t, 20*time.Second, cfg.CommitTimeoutDuration())
+	assert.Equal(t, 80*time.Second, cfg.BroadcastTimeoutDuration())
+}
+
+func TestSigningSecretsRequireASecret(t *testing.T) {
+	t.Setenv("ACT_AUDIT_WEBHOOK_SECRET", "s3cret")
+	cfg := EventsConfig{Signing: []WebhookSigningConfig{
+		{Endpoint: "http://audit-service:8080/events", SecretEnv: "ACT_AUDIT_WEBHOOK_SECRET"},
+		{Endpoint: "http://localhost:9000/hook", Secret: "dev-secret"},
+	}}
+	secrets, err := cfg.SigningSecrets()
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"http://audit-service:8080/events": "s3cret",
+		"http://localhost:9000/hook":       "dev-secret",
+	}, secrets)
+
+	// An unset or empty variable must not leave the endpoint unsigned
+	t.Setenv("ACT_AUDIT_WEBHOOK_SECRET", "")
+	_, err = cfg.SigningSecrets()
+	assert.EqualError(t, err, "events.signing http://audit-service:8080/events: environment variable ACT_AUDIT_WEBHOOK_SECRET is empty or not set")
+
+	_, err = EventsConfig{Signing: []WebhookSigningConfig{{Endpoint: "http://localhost:9000/hook"}}}.SigningSecrets()
+	assert.EqualError(t, err, "events.signing http://localhost:9000/hook: secret_env or secret is required")
+}
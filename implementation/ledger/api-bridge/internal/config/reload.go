@@ -0,0 +1,46 @@
+package config
+
+import (
+	"reflect"
+	"strings"
+)
+
+// RestartRequired lists the settings, as dotted keys, that differ in next
+// but only take effect after a restart. logging.level, the webhook
+// endpoints, signing secrets and retry schedules under events, and
+// server.rate_limit are applied on reload and never listed.
+func (c *Config) RestartRequired(next *Config) []string {
+	current, updated := reflect.ValueOf(c.restartOnly()), reflect.ValueOf(next.restartOnly())
+
+	var changed []string
+	for i := range current.NumField() {
+		section := current.Type().Field(i)
+		for j := range section.Type.NumField() {
+			if !reflect.DeepEqual(current.Field(i).Field(j).Interface(), updated.Field(i).Field(j).Interface()) {
+				changed = append(changed, settingName(section)+"."+settingName(section.Type.Field(j)))
+			}
+		}
+	}
+	return changed
+}
+
+// restartOnly returns a copy of c without the settings a reload applies
+func (c Config) restartOnly() Config {
+	c.Logging.Level = ""
+	c.Events.Endpoints = nil
+	c.Events.Webhooks = nil
+	c.Events.Signing = nil
+	c.Events.Retry = WebhookRetryConfig{}
+	c.Events.RetryDelay = 0 // the initial backoff of schedules that set none
+	c.Server.RateLimit = WriteRateLimitConfig{}
+	return c
+}
+
+// settingName returns the config file key of field
+func settingName(field reflect.StructField) string {
+	name, _, _ := strings.Cut(field.Tag.Get("mapstructure"), ",")
+	if name == "" {
+		return strings.ToLower(field.Name)
+	}
+	return name
+}
@@ -0,0 +1,27 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRestartRequiredIgnoresReloadableSettings(t *testing.T) {
+	running := &Config{
+		Server:  ServerConfig{Port: 8080, RateLimit: WriteRateLimitConfig{Enabled: true, Rate: 5}},
+		Logging: LoggingConfig{Level: "info", Format: "json"},
+		Events:  EventsConfig{Enabled: true, MaxRetries: 3},
+	}
+
+	next := *running
+	next.Logging.Level = "debug"
+	next.Server.RateLimit = WriteRateLimitConfig{Enabled: false}
+	next.Events.Webhooks = []WebhookConfig{{URL: "https://audit.example.com/events"}}
+	next.Events.Retry = WebhookRetryConfig{Policy: "fixed"}
+	assert.Empty(t, running.RestartRequired(&next))
+
+	next.Server.Port = 9000
+	next.Events.MaxRetries = 5
+	next.Blockchain.ChainID = "racecarweb-2"
+	assert.Equal(t, []string{"blockchain.chain_id", "server.port", "events.max_retries"}, running.RestartRequired(&next))
+}
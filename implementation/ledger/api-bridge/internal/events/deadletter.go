@@ -0,0 +1,358 @@
+package events
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ErrDeliveryDisabled is returned when replaying without any webhook sinks
+var ErrDeliveryDisabled = errors.New("webhook delivery is disabled")
+
+// defaultDeadLetterLimit is how many dead letters are kept when
+// events.dead_letter_limit is not set
+const defaultDeadLetterLimit = 10000
+
+// DeadLetter is an event that could not be delivered to one endpoint after
+// every retry
+type DeadLetter struct {
+	ID       string    `json:"id"`
+	Event    *Event    `json:"event"`
+	Endpoint string    `json:"endpoint"`
+	Error    string    `json:"error"`
+	Attempts int       `json:"attempts"`
+	FailedAt time.Time `json:"failed_at"`
+}
+
+// pendingEvent is a queued event as persisted until its delivery finishes
+type pendingEvent struct {
+	Event     *Event   `json:"event"`
+	Endpoints []string `json:"endpoints,omitempty"`
+}
+
+// DeadLetterStats reports how full the dead letter queue is
+type DeadLetterStats struct {
+	Count   int    `json:"count"`
+	Limit   int    `json:"limit"`
+	Evicted uint64 `json:"evicted"` // oldest dead letters dropped to stay within the limit
+}
+
+// eventStore keeps dead letters and the events still waiting for delivery.
+// With a directory both survive restarts as one JSON file per entry under
+// deadletter/ and pending/; without one dead letters are kept in memory only.
+// At most limit dead letters are kept; the oldest failures make room.
+type eventStore struct {
+	dir     string
+	mu      sync.Mutex
+	dead    map[string]*DeadLetter
+	limit   int
+	evicted uint64
+}
+
+func newMemoryEventStore(limit int) *eventStore {
+	return &eventStore{dead: make(map[string]*DeadLetter), limit: limit}
+}
+
+// openEventStore loads the dead letters and pending events kept in dir. Dead
+// letters past limit are dropped, oldest first.
+func openEventStore(dir string, limit int) (*eventStore, []*Event, error) {
+	s := &eventStore{dir: dir, dead: make(map[string]*DeadLetter), limit: limit}
+	for _, sub := range []string{"deadletter", "pending"} {
+		if err := os.MkdirAll(filepath.Join(dir, sub), 0o700); err != nil {
+			return nil, nil, fmt.Errorf("failed to create event store: %w", err)
+		}
+	}
+
+	err := readEntries(filepath.Join(dir, "deadletter"), func(id string, raw []byte) error {
+		var dl DeadLetter
+		if err := json.Unmarshal(raw, &dl); err != nil {
+			return err
+		}
+		s.dead[id] = &dl
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	s.evict()
+
+	var pending []*Event
+	err = readEntries(filepath.Join(dir, "pending"), func(id string, raw []byte) error {
+		var p pendingEvent
+		if err := json.Unmarshal(raw, &p); err != nil {
+			return err
+		}
+		p.Event.id, p.Event.endpoints = id, p.Endpoints
+		pending = append(pending, p.Event)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	sort.Slice(pending, func(i, j int) bool { return pending[i].Timestamp.Before(pending[j].Timestamp) })
+
+	return s, pending, nil
+}
+
+// readEntries calls fn with the id and content of every entry in dir
+func readEntries(dir string, fn func(id string, raw []byte) error) error {
+	files, err := os.ReadDir(dir)
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", dir, err)
+	}
+	for _, file := range files {
+		id, ok := strings.CutSuffix(file.Name(), ".json")
+		if !ok {
+			continue
+		}
+		raw, err := os.ReadFile(filepath.Join(dir, file.Name()))
+		if err != nil {
+			return err
+		}
+		if err := fn(id, raw); err != nil {
+			return fmt.Errorf("failed to decode %s: %w", filepath.Join(dir, file.Name()), err)
+		}
+	}
+	return nil
+}
+
+// savePending persists event until removePending is called for it
+func (s *eventStore) savePending(event *Event) error {
+	if s.dir == "" {
+		return nil
+	}
+	return s.write("pending", event.id, pendingEvent{Event: event, Endpoints: event.endpoints})
+}
+
+func (s *eventStore) removePending(id string) error {
+	return s.remove("pending", id)
+}
+
+// addDeadLetter keeps dl and returns how many older dead letters were
+// dropped to make room for it
+func (s *eventStore) addDeadLetter(dl *DeadLetter) (int, error) {
+	s.mu.Lock()
+	s.dead[dl.ID] = dl
+	evicted := s.evict()
+	s.mu.Unlock()
+
+	if s.dir == "" {
+		return evicted, nil
+	}
+	return evicted, s.write("deadletter", dl.ID, dl)
+}
+
+// evict drops the oldest dead letters until at most limit are left and
+// returns how many it dropped. The caller holds mu, except while the store is
+// being opened.
+func (s *eventStore) evict() int {
+	evicted := 0
+	for len(s.dead) > s.limit {
+		var oldest *DeadLetter
+		for _, dl := range s.dead {
+			if oldest == nil || dl.FailedAt.Before(oldest.FailedAt) {
+				oldest = dl
+			}
+		}
+		delete(s.dead, oldest.ID)
+		_ = s.remove("deadletter", oldest.ID)
+		evicted++
+	}
+	s.evicted += uint64(evicted)
+	return evicted
+}
+
+func (s *eventStore) stats() DeadLetterStats {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return DeadLetterStats{Count: len(s.dead), Limit: s.limit, Evicted: s.evicted}
+}
+
+// deadLetters returns every dead letter, oldest failure first
+func (s *eventStore) deadLetters() []DeadLetter {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	letters := make([]DeadLetter, 0, len(s.dead))
+	for _, dl := range s.dead {
+		letters = append(letters, *dl)
+	}
+	sort.Slice(letters, func(i, j int) bool { return letters[i].FailedAt.Before(letters[j].FailedAt) })
+	return letters
+}
+
+// takeDeadLetters removes and returns the dead letters with the given ids, or
+// all of them when ids is empty
+func (s *eventStore) takeDeadLetters(ids []string) []*DeadLetter {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(ids) == 0 {
+		for id := range s.dead {
+			ids = append(ids, id)
+		}
+	}
+
+	var taken []*DeadLetter
+	for _, id := range ids {
+		dl, ok := s.dead[id]
+		if !ok {
+			continue
+		}
+		delete(s.dead, id)
+		_ = s.remove("deadletter", id)
+		taken = append(taken, dl)
+	}
+	sort.Slice(taken, func(i, j int) bool { return taken[i].FailedAt.Before(taken[j].FailedAt) })
+	return taken
+}
+
+// write stores v atomically as kind/id.json
+func (s *eventStore) write(kind, id string, v interface{}) error {
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	path := filepath.Join(s.dir, kind, id+".json")
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, raw, 0o600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+func (s *eventStore) remove(kind, id string) error {
+	if s.dir == "" {
+		return nil
+	}
+	err := os.Remove(filepath.Join(s.dir, kind, id+".json"))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	return err
+}
+
+// Persist keeps queued events and dead letters in dir so a restart loses
+// neither. Events still pending from a previous run are queued again.
+func (eq *EventQueue) Persist(dir string) error {
+	limit := defaultDeadLetterLimit
+	if current := eq.eventStore(); current != nil {
+		limit = current.stats().Limit
+	}
+	store, pending, err := openEventStore(dir, limit)
+	if err != nil {
+		return err
+	}
+	if store.evicted > 0 {
+		eq.logger.Warn().Uint64("dropped", store.evicted).Int("limit", limit).Msg("Dropped the oldest persisted dead letters past the limit")
+	}
+
+	eq.storeMu.Lock()
+	eq.store = store
+	eq.storeMu.Unlock()
+
+	if len(pending) > 0 {
+		eq.logger.Info().Int("count", len(pending)).Msg("Requeueing events pending before restart")
+		eq.requeue(pending)
+	}
+	return nil
+}
+
+// SetDeadLetterLimit bounds how many dead letters are kept; once the limit is
+// reached the oldest failure is dropped for each new one. A limit below one
+// uses the default of 10000.
+func (eq *EventQueue) SetDeadLetterLimit(limit int) {
+	if limit < 1 {
+		limit = defaultDeadLetterLimit
+	}
+	store := eq.eventStore()
+	if store == nil {
+		return
+	}
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	store.limit = limit
+	if evicted := store.evict(); evicted > 0 {
+		eq.logger.Warn().Int("dropped", evicted).Int("limit", limit).Msg("Dropped the oldest dead letters past the limit")
+	}
+}
+
+// DeadLetterStats reports how many dead letters are kept, the limit and how
+// many were dropped to stay within it
+func (eq *EventQueue) DeadLetterStats() DeadLetterStats {
+	store := eq.eventStore()
+	if store == nil {
+		return DeadLetterStats{}
+	}
+	return store.stats()
+}
+
+// DeadLetters lists the events that exhausted their retries, oldest first
+func (eq *EventQueue) DeadLetters() []DeadLetter {
+	store := eq.eventStore()
+	if store == nil {
+		return []DeadLetter{}
+	}
+	return store.deadLetters()
+}
+
+// ReplayDeadLetters queues the dead letters with the given ids, or all of
+// them when ids is empty, for another round of delivery to their endpoint.
+// It returns how many were queued.
+func (eq *EventQueue) ReplayDeadLetters(ids []string) (int, error) {
+	if !eq.enabled {
+		return 0, ErrDeliveryDisabled
+	}
+	store := eq.eventStore()
+	if store == nil {
+		return 0, nil
+	}
+
+	letters := store.takeDeadLetters(ids)
+	replays := make([]*Event, 0, len(letters))
+	for _, dl := range letters {
+		event := *dl.Event
+		event.id = newRef()
+		event.endpoints = []string{dl.Endpoint}
+		event.Attempts = 0
+		if err := store.savePending(&event); err != nil {
+			eq.logger.Error().Err(err).Str("event", event.Type).Msg("Failed to persist replayed event")
+		}
+		replays = append(replays, &event)
+	}
+	eq.requeue(replays)
+
+	eq.logger.Info().Int("count", len(replays)).Msg("Replaying dead-lettered events")
+	return len(replays), nil
+}
+
+// requeue hands events to the worker without blocking the caller
+func (eq *EventQueue) requeue(events []*Event) {
+	if !eq.enabled || len(events) == 0 {
+		return
+	}
+	eq.wg.Add(1)
+	go func() {
+		defer eq.wg.Done()
+		for _, event := range events {
+			select {
+			case eq.queue <- event:
+			case <-eq.quit:
+				// Still pending on disk, so the next start delivers it
+				return
+			}
+		}
+	}()
+}
+
+func (eq *EventQueue) eventStore() *eventStore {
+	eq.storeMu.RLock()
+	defer eq.storeMu.RUnlock()
+	return eq.store
+}
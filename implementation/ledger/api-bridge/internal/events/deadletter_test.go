@@ -0,0 +1,157 @@
+package events
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// deadEndpoint returns the URL of a webhook server that is no longer running
+func deadEndpoint(t *testing.T) string {
+	t.Helper()
+	server := httptest.NewServer(http.NotFoundHandler())
+	server.Close()
+	return server.URL
+}
+
+func TestFailedDeliveriesLandInDeadLetterQueue(t *testing.T) {
+	dir := t.TempDir()
+	registered, audit := deadEndpoint(t), deadEndpoint(t)
+	sinks := map[string][]string{
+		"component_registered": {registered, audit},
+		"lct_created":          {audit},
+	}
+
+	eq := NewEventQueue(sinks, 2, time.Millisecond, time.Hour, zerolog.Nop())
+	require.NoError(t, eq.Persist(dir))
+
+	eq.Emit("component_registered", map[string]interface{}{"component_id": "MODBATT-MOD-001"})
+	eq.Emit("lct_created", map[string]interface{}{"lct_id": "lct-PACK-MOD-1"})
+
+	require.Eventually(t, func() bool { return len(eq.DeadLetters()) == 3 }, 2*time.Second, 5*time.Millisecond)
+	eq.Shutdown()
+
+	letters := eq.DeadLetters()
+	endpoints := map[string]int{}
+	for _, dl := range letters {
+		endpoints[dl.Endpoint]++
+		assert.Equal(t, 2, dl.Attempts)
+		assert.NotEmpty(t, dl.Error)
+		assert.NotEmpty(t, dl.Event.Type)
+	}
+	assert.Equal(t, map[string]int{registered: 1, audit: 2}, endpoints)
+
+	// Nothing is left pending, and the dead letters survive a restart
+	pending, err := os.ReadDir(filepath.Join(dir, "pending"))
+	require.NoError(t, err)
+	assert.Empty(t, pending)
+
+	restarted := NewEventQueue(sinks, 2, time.Millisecond, time.Hour, zerolog.Nop())
+	defer restarted.Shutdown()
+	require.NoError(t, restarted.Persist(dir))
+	assert.Len(t, restarted.DeadLetters(), 3)
+}
+
+func TestReplayDeadLetterDeliversToItsEndpoint(t *testing.T) {
+	var up atomic.Bool
+	var delivered atomic.Int32
+	sink := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !up.Load() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		delivered.Add(1)
+	}))
+	defer sink.Close()
+
+	eq := NewEventQueue(map[string][]string{"component_registered": {sink.URL}}, 1, time.Millisecond, time.Hour, zerolog.Nop())
+	defer eq.Shutdown()
+
+	eq.Emit("component_registered", map[string]interface{}{"component_id": "MODBATT-MOD-001"})
+	require.Eventually(t, func() bool { return len(eq.DeadLetters()) == 1 }, 2*time.Second, 5*time.Millisecond)
+	assert.Equal(t, "endpoint returned status 503", eq.DeadLetters()[0].Error)
+
+	up.Store(true)
+	replayed, err := eq.ReplayDeadLetters(nil)
+	require.NoError(t, err)
+	assert.Equal(t, 1, replayed)
+
+	require.Eventually(t, func() bool { return delivered.Load() == 1 }, 2*time.Second, 5*time.Millisecond)
+	assert.Empty(t, eq.DeadLetters())
+
+	replayed, err = eq.ReplayDeadLetters([]string{"unknown"})
+	require.NoError(t, err)
+	assert.Zero(t, replayed)
+}
+
+func TestPendingEventsAreDeliveredAfterRestart(t *testing.T) {
+	delivered := make(chan string, 1)
+	sink := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		delivered <- r.URL.Path
+	}))
+	defer sink.Close()
+
+	// An event queued when the previous process stopped
+	dir := t.TempDir()
+	store, _, err := openEventStore(dir, defaultDeadLetterLimit)
+	require.NoError(t, err)
+	require.NoError(t, store.savePending(&Event{id: "queued", Type: "pairing_completed", Timestamp: time.Now().UTC()}))
+
+	eq := NewEventQueue(map[string][]string{"pairing_completed": {sink.URL + "/pairings"}}, 1, time.Millisecond, time.Hour, zerolog.Nop())
+	defer eq.Shutdown()
+	require.NoError(t, eq.Persist(dir))
+
+	select {
+	case path := <-delivered:
+		assert.Equal(t, "/pairings", path)
+	case <-time.After(2 * time.Second):
+		t.Fatal("pending event was not delivered after restart")
+	}
+	require.Eventually(t, func() bool {
+		_, err := os.Stat(filepath.Join(dir, "pending", "queued.json"))
+		return os.IsNotExist(err)
+	}, 2*time.Second, 5*time.Millisecond)
+}
+
+func TestDeadLetterLimitDropsOldest(t *testing.T) {
+	dir := t.TempDir()
+	eq := NewEventQueue(map[string][]string{"component_registered": {deadEndpoint(t)}}, 1, time.Millisecond, time.Hour, zerolog.Nop())
+	defer eq.Shutdown()
+	eq.SetDeadLetterLimit(2)
+	require.NoError(t, eq.Persist(dir))
+
+	for _, id := range []string{"MODBATT-MOD-001", "MODBATT-MOD-002", "MODBATT-MOD-003"} {
+		eq.Emit("component_registered", map[string]interface{}{"component_id": id})
+		require.Eventually(t, func() bool {
+			letters := eq.DeadLetters()
+			return len(letters) > 0 && letters[len(letters)-1].Event.Data.(map[string]interface{})["component_id"] == id
+		}, 2*time.Second, 5*time.Millisecond)
+	}
+
+	letters := eq.DeadLetters()
+	require.Len(t, letters, 2)
+	assert.Equal(t, "MODBATT-MOD-002", letters[0].Event.Data.(map[string]interface{})["component_id"])
+	assert.Equal(t, DeadLetterStats{Count: 2, Limit: 2, Evicted: 1}, eq.DeadLetterStats())
+	files, err := os.ReadDir(filepath.Join(dir, "deadletter"))
+	require.NoError(t, err)
+	assert.Len(t, files, 2)
+
+	// A lower limit applies to what is already kept
+	eq.SetDeadLetterLimit(1)
+	assert.Equal(t, DeadLetterStats{Count: 1, Limit: 1, Evicted: 2}, eq.DeadLetterStats())
+	assert.Equal(t, "MODBATT-MOD-003", eq.DeadLetters()[0].Event.Data.(map[string]interface{})["component_id"])
+}
+
+func TestReplayWithoutSinks(t *testing.T) {
+	eq := NewEventQueue(nil, 1, time.Millisecond, time.Hour, zerolog.Nop())
+	_, err := eq.ReplayDeadLetters(nil)
+	assert.ErrorIs(t, err, ErrDeliveryDisabled)
+}
@@ -1,10 +1,12 @@
 package events
 
 import (
-	"bytes"
 	"encoding/json"
+	"fmt"
+	"math/rand"
 	"net/http"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/rs/zerolog"
@@ -15,40 +17,120 @@ import (
 // Type: event type string (e.g. "component_registered")
 // Data: event payload (should be serializable)
 type Event struct {
-	Type      string      `json:"event_type"`
-	Timestamp time.Time   `json:"timestamp"`
-	Data      interface{} `json:"data"`
-	Attempts  int         `json:"-"` // for retry logic
+	Type      string               `json:"event_type"`
+	Timestamp time.Time            `json:"timestamp"`
+	Data      interface{}          `json:"data"`
+	Truncated []TruncatedAttribute `json:"truncated,omitempty"` // attributes of Data cut short by the payload limit
+	Attempts  int                  `json:"-"`                   // for retry logic
+
+	id        string   // names the event in the persistent store
+	endpoints []string // replays deliver to these only; nil means every sink of Type
 }
 
 // EventQueue manages event emission and retries
-// Only enabled if sinks is non-nil and non-empty
-// Sinks: map of event type to list of endpoint URLs
+// Webhook delivery is only enabled if sinks is non-nil and non-empty;
+// subscribers (e.g. WebSocket clients) receive every emitted event regardless
+// Sinks: map of event type to list of endpoint URLs; endpoints under
+// AllEvents receive every event type
 // MaxRetries: max attempts per event
-// Backoff: initial backoff duration (doubles each retry)
+// Backoff: initial backoff duration (doubles each retry unless
+// SetRetryPolicies schedules otherwise)
+// DedupeWindow: how long an operation key suppresses repeat emissions
 type EventQueue struct {
-	sinks      map[string][]string
-	maxRetries int
-	backoff    time.Duration
-	queue      chan *Event
-	logger     zerolog.Logger
-	wg         sync.WaitGroup
-	quit       chan struct{}
-	enabled    bool
+	sinksMu      sync.RWMutex
+	sinks        map[string][]string // see SetSinks
+	maxRetries   int
+	backoff      time.Duration
+	queue        chan *Event
+	logger       zerolog.Logger
+	wg           sync.WaitGroup
+	quit         chan struct{}
+	enabled      bool
+	dedupeWindow time.Duration
+	emittedMu    sync.Mutex
+	emitted      map[string]time.Time // operation key + event type -> first emission
+	subsMu       sync.RWMutex
+	subs         map[*Subscription]struct{}
+
+	secretsMu sync.RWMutex
+	secrets   map[string]string // endpoint -> HMAC secret, see SetSigningSecrets
+
+	retryMu       sync.RWMutex
+	retryDefault  *RetryPolicy             // see SetRetryPolicies
+	retryPolicies map[string]RetryPolicy   // endpoint -> its own schedule
+	sleep         func(time.Duration) bool // waits between attempts, false once shut down
+	random        func() float64           // jitter source
+
+	storeMu sync.RWMutex
+	store   *eventStore // dead letters and pending events, see Persist
+
+	payloadMu         sync.RWMutex
+	maxAttributeBytes int           // see SetPayloadLimit
+	payloads          *payloadStore // full values of truncated attributes
+}
+
+// Subscription streams published events to a single consumer through a
+// bounded buffer. Events that arrive while the buffer is full are dropped so
+// a slow consumer never blocks emission.
+type Subscription struct {
+	events   chan *Event
+	filterMu sync.RWMutex
+	filter   map[string]bool // nil forwards every event type
+	dropped  atomic.Uint64
+}
+
+// Events returns the channel events are delivered on; it is closed by Unsubscribe
+func (s *Subscription) Events() <-chan *Event {
+	return s.events
+}
+
+// SetFilter limits delivery to the given event types; an empty list forwards all events
+func (s *Subscription) SetFilter(eventTypes []string) {
+	var filter map[string]bool
+	if len(eventTypes) > 0 {
+		filter = make(map[string]bool, len(eventTypes))
+		for _, eventType := range eventTypes {
+			filter[eventType] = true
+		}
+	}
+
+	s.filterMu.Lock()
+	s.filter = filter
+	s.filterMu.Unlock()
 }
 
+// Dropped returns how many events were discarded because the buffer was full
+func (s *Subscription) Dropped() uint64 {
+	return s.dropped.Load()
+}
+
+func (s *Subscription) wants(eventType string) bool {
+	s.filterMu.RLock()
+	defer s.filterMu.RUnlock()
+	return s.filter == nil || s.filter[eventType]
+}
+
+// AllEvents is the sink key, and webhook subscription, matching every event type
+const AllEvents = "*"
+
 // NewEventQueue creates a new event queue (enabled only if sinks is non-empty)
-func NewEventQueue(sinks map[string][]string, maxRetries int, backoff time.Duration, logger zerolog.Logger) *EventQueue {
+func NewEventQueue(sinks map[string][]string, maxRetries int, backoff, dedupeWindow time.Duration, logger zerolog.Logger) *EventQueue {
 	enabled := len(sinks) > 0
 	eq := &EventQueue{
-		sinks:      sinks,
-		maxRetries: maxRetries,
-		backoff:    backoff,
-		queue:      make(chan *Event, 100),
-		logger:     logger,
-		quit:       make(chan struct{}),
-		enabled:    enabled,
+		sinks:        sinks,
+		maxRetries:   maxRetries,
+		backoff:      backoff,
+		queue:        make(chan *Event, 100),
+		logger:       logger,
+		quit:         make(chan struct{}),
+		enabled:      enabled,
+		dedupeWindow: dedupeWindow,
+		emitted:      make(map[string]time.Time),
+		subs:         make(map[*Subscription]struct{}),
+		store:        newMemoryEventStore(defaultDeadLetterLimit),
+		random:       rand.Float64,
 	}
+	eq.sleep = eq.wait
 	if enabled {
 		eq.wg.Add(1)
 		go eq.worker()
@@ -59,17 +141,111 @@ func NewEventQueue(sinks map[string][]string, maxRetries int, backoff time.Durat
 	return eq
 }
 
-// Emit adds an event to the queue (no-op if not enabled)
+// Emit publishes an event to subscribers and adds it to the webhook queue if
+// enabled. Attributes over the payload limit are truncated first.
 func (eq *EventQueue) Emit(eventType string, data interface{}) {
-	if !eq.enabled {
-		return
-	}
-	eq.queue <- &Event{
+	data, truncated := eq.boundPayload(data)
+	event := &Event{
 		Type:      eventType,
 		Timestamp: time.Now().UTC(),
 		Data:      data,
+		Truncated: truncated,
 		Attempts:  0,
 	}
+
+	eq.publish(event)
+
+	if !eq.enabled || len(eq.endpointsFor(eventType)) == 0 {
+		return
+	}
+	event.id = newRef()
+	if store := eq.eventStore(); store != nil {
+		if err := store.savePending(event); err != nil {
+			eq.logger.Error().Err(err).Str("event", eventType).Msg("Failed to persist queued event")
+		}
+	}
+	eq.queue <- event
+}
+
+// Subscribe registers a consumer with a buffer of bufferSize events
+func (eq *EventQueue) Subscribe(bufferSize int) *Subscription {
+	if bufferSize < 1 {
+		bufferSize = 1
+	}
+	sub := &Subscription{events: make(chan *Event, bufferSize)}
+
+	eq.subsMu.Lock()
+	eq.subs[sub] = struct{}{}
+	eq.subsMu.Unlock()
+
+	return sub
+}
+
+// Unsubscribe stops delivery to sub and closes its channel
+func (eq *EventQueue) Unsubscribe(sub *Subscription) {
+	eq.subsMu.Lock()
+	defer eq.subsMu.Unlock()
+
+	if _, ok := eq.subs[sub]; ok {
+		delete(eq.subs, sub)
+		close(sub.events)
+	}
+}
+
+// Subscribers returns the number of active subscriptions
+func (eq *EventQueue) Subscribers() int {
+	eq.subsMu.RLock()
+	defer eq.subsMu.RUnlock()
+	return len(eq.subs)
+}
+
+// publish hands the event to every interested subscriber without blocking
+func (eq *EventQueue) publish(event *Event) {
+	eq.subsMu.RLock()
+	defer eq.subsMu.RUnlock()
+
+	for sub := range eq.subs {
+		if !sub.wants(event.Type) {
+			continue
+		}
+		select {
+		case sub.events <- event:
+		default:
+			sub.dropped.Add(1)
+		}
+	}
+}
+
+// EmitOnce emits the event unless the same event type was already emitted for
+// operationKey within the dedupe window, so a replayed request for one logical
+// operation produces a single event. An empty key always emits. Returns false
+// when the event was suppressed.
+func (eq *EventQueue) EmitOnce(operationKey, eventType string, data interface{}) bool {
+	if operationKey != "" && !eq.markEmitted(operationKey+"|"+eventType, time.Now()) {
+		eq.logger.Debug().Str("event", eventType).Str("operation", operationKey).Msg("Event already emitted for operation, skipping")
+		return false
+	}
+	eq.Emit(eventType, data)
+	return true
+}
+
+// markEmitted records key and reports whether it was not already recorded
+// within the dedupe window. Expired keys are pruned on the way.
+func (eq *EventQueue) markEmitted(key string, now time.Time) bool {
+	eq.emittedMu.Lock()
+	defer eq.emittedMu.Unlock()
+
+	for k, at := range eq.emitted {
+		if now.Sub(at) > eq.dedupeWindow {
+			delete(eq.emitted, k)
+		}
+	}
+
+	if _, seen := eq.emitted[key]; seen {
+		return false
+	}
+	eq.emitted[key] = now
+	return true
 }
 
 // worker processes the event queue
@@ -85,9 +261,28 @@ func (eq *EventQueue) worker() {
 	}
 }
 
-// processEvent POSTs the event to all sinks, with retries
+// processEvent POSTs the event to all sinks, with retries. Deliveries that
+// exhaust their retries are kept as dead letters; a shutdown during a backoff
+// leaves the event pending.
 func (eq *EventQueue) processEvent(event *Event) {
-	endpoints := eq.sinks[event.Type]
+	store := eq.eventStore()
+	interrupted := false
+	if store != nil {
+		defer func() {
+			if interrupted {
+				// Still pending on disk, so the next start delivers it
+				return
+			}
+			if err := store.removePending(event.id); err != nil {
+				eq.logger.Error().Err(err).Str("event", event.Type).Msg("Failed to clear delivered event")
+			}
+		}()
+	}
+
+	endpoints := event.endpoints
+	if endpoints == nil {
+		endpoints = eq.endpointsFor(event.Type)
+	}
 	if len(endpoints) == 0 {
 		eq.logger.Debug().Str("event", event.Type).Msg("No endpoints configured for event")
 		return
@@ -96,9 +291,15 @@ func (eq *EventQueue) processEvent(event *Event) {
 	for _, url := range endpoints {
 		success := false
 		attempts := event.Attempts
-		backoff := eq.backoff
+		policy := eq.retryPolicy(url)
+		var lastErr error
 		for attempts < eq.maxRetries {
-			resp, err := http.Post(url, "application/json", bytes.NewReader(payload))
+			req, err := eq.newDelivery(url, payload, time.Now())
+			if err != nil {
+				lastErr = err
+				break
+			}
+			resp, err := http.DefaultClient.Do(req)
 			if err == nil && resp.StatusCode >= 200 && resp.StatusCode < 300 {
 				eq.logger.Info().Str("endpoint", url).Str("event", event.Type).Msg("Event POSTed successfully")
 				resp.Body.Close()
@@ -107,20 +308,110 @@ func (eq *EventQueue) processEvent(event *Event) {
 			}
 			if resp != nil {
 				resp.Body.Close()
+				if err == nil {
+					err = fmt.Errorf("endpoint returned status %d", resp.StatusCode)
+				}
 			}
-			eq.logger.Warn().Str("endpoint", url).Str("event", event.Type).Int("attempt", attempts+1).Err(err).Msg("Failed to POST event, will retry")
-			time.Sleep(backoff)
-			backoff *= 2
+			lastErr = err
 			attempts++
+			if attempts >= eq.maxRetries {
+				break
+			}
+			wait := policy.Backoff(attempts-event.Attempts-1, eq.random)
+			eq.logger.Warn().Str("endpoint", url).Str("event", event.Type).Int("attempt", attempts).Dur("retry_in", wait).Err(err).Msg("Failed to POST event, will retry")
+			if !eq.sleep(wait) {
+				eq.logger.Warn().Str("endpoint", url).Str("event", event.Type).Msg("Shutting down, event delivery abandoned")
+				interrupted = true
+				return
+			}
 		}
 		if !success {
 			eq.logger.Error().Str("endpoint", url).Str("event", event.Type).Msg("Event delivery failed after max retries")
+			eq.deadLetter(store, event, url, attempts, lastErr)
 		}
 	}
 }
 
-// Shutdown gracefully stops the worker
+// wait sleeps between delivery attempts and reports false if Shutdown cut
+// the wait short
+func (eq *EventQueue) wait(d time.Duration) bool {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return true
+	case <-eq.quit:
+		return false
+	}
+}
+
+// SetSinks replaces the endpoints events are delivered to, e.g. when the
+// configuration is reloaded. Events already queued go to the new endpoints.
+// A queue created without sinks has no delivery worker, so it keeps
+// delivering nothing until it is recreated.
+func (eq *EventQueue) SetSinks(sinks map[string][]string) {
+	if !eq.enabled && len(sinks) > 0 {
+		eq.logger.Warn().Msg("Webhook delivery was not enabled at start-up; restart to deliver to the new endpoints")
+	}
+	eq.sinksMu.Lock()
+	defer eq.sinksMu.Unlock()
+	eq.sinks = sinks
+}
+
+// endpointsFor returns the endpoints subscribed to eventType, either by name
+// or through AllEvents, each once
+func (eq *EventQueue) endpointsFor(eventType string) []string {
+	eq.sinksMu.RLock()
+	named, all := eq.sinks[eventType], eq.sinks[AllEvents]
+	eq.sinksMu.RUnlock()
+	if len(all) == 0 || eventType == AllEvents {
+		return named
+	}
+
+	endpoints := make([]string, 0, len(named)+len(all))
+	seen := make(map[string]bool, len(named)+len(all))
+	for _, endpoint := range append(append([]string(nil), named...), all...) {
+		if !seen[endpoint] {
+			seen[endpoint] = true
+			endpoints = append(endpoints, endpoint)
+		}
+	}
+	return endpoints
+}
+
+// deadLetter keeps an event that could not be delivered to endpoint
+func (eq *EventQueue) deadLetter(store *eventStore, event *Event, endpoint string, attempts int, err error) {
+	if store == nil {
+		return
+	}
+	dl := &DeadLetter{
+		ID:       newRef(),
+		Event:    event,
+		Endpoint: endpoint,
+		Attempts: attempts,
+		FailedAt: time.Now().UTC(),
+	}
+	if err != nil {
+		dl.Error = err.Error()
+	}
+	evicted, err := store.addDeadLetter(dl)
+	if err != nil {
+		eq.logger.Error().Err(err).Str("endpoint", endpoint).Str("event", event.Type).Msg("Failed to persist dead-lettered event")
+	}
+	if evicted > 0 {
+		eq.logger.Warn().Int("dropped", evicted).Str("endpoint", endpoint).Msg("Dead letter queue full, dropped the oldest")
+	}
+}
+
+// Shutdown closes all subscriptions and gracefully stops the worker
 func (eq *EventQueue) Shutdown() {
+	eq.subsMu.Lock()
+	for sub := range eq.subs {
+		delete(eq.subs, sub)
+		close(sub.events)
+	}
+	eq.subsMu.Unlock()
+
 	if !eq.enabled {
 		return
 	}
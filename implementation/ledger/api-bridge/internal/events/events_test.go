@@ -0,0 +1,204 @@
+package events
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"api-bridge/internal/config"
+)
+
+func TestEmitOnceSuppressesRepeatedOperation(t *testing.T) {
+	eq := &EventQueue{
+		enabled:      true,
+		sinks:        map[string][]string{AllEvents: {"http://audit-service:8080/events"}},
+		queue:        make(chan *Event, 10),
+		logger:       zerolog.Nop(),
+		dedupeWindow: time.Hour,
+		emitted:      make(map[string]time.Time),
+	}
+
+	assert.True(t, eq.EmitOnce("tx:ABC", "component_registered", nil))
+	assert.False(t, eq.EmitOnce("tx:ABC", "component_registered", nil))
+	// A different event type for the same operation is still delivered
+	assert.True(t, eq.EmitOnce("tx:ABC", "lct_created", nil))
+	assert.True(t, eq.EmitOnce("tx:DEF", "component_registered", nil))
+	// Without an operation key there is nothing to de-duplicate on
+	assert.True(t, eq.EmitOnce("", "component_registered", nil))
+	assert.True(t, eq.EmitOnce("", "component_registered", nil))
+
+	assert.Len(t, eq.queue, 5)
+}
+
+func TestMarkEmittedExpiresAfterWindow(t *testing.T) {
+	eq := &EventQueue{dedupeWindow: time.Minute, emitted: make(map[string]time.Time)}
+	now := time.Now()
+
+	assert.True(t, eq.markEmitted("tx:ABC|component_registered", now))
+	assert.False(t, eq.markEmitted("tx:ABC|component_registered", now.Add(30*time.Second)))
+	assert.True(t, eq.markEmitted("tx:ABC|component_registered", now.Add(2*time.Minute)))
+}
+
+func TestEmitOnceWithoutSinksStillPublishes(t *testing.T) {
+	eq := NewEventQueue(nil, 3, time.Second, time.Hour, zerolog.Nop())
+	sub := eq.Subscribe(4)
+
+	assert.True(t, eq.EmitOnce("tx:ABC", "component_registered", nil))
+	assert.False(t, eq.EmitOnce("tx:ABC", "component_registered", nil))
+	assert.Len(t, sub.Events(), 1)
+}
+
+func TestWebhooksReceiveOnlySubscribedEvents(t *testing.T) {
+	pairings, pairingDeliveries := recordingSink(t)
+	audit, auditDeliveries := recordingSink(t)
+	sinks := config.EventsConfig{Webhooks: []config.WebhookConfig{
+		{URL: pairings.URL, Subscribe: []string{"pairing_completed", "pairing_revoked"}},
+		{URL: audit.URL, Subscribe: []string{AllEvents}},
+	}}.Sinks()
+
+	eq := NewEventQueue(sinks, 1, time.Millisecond, time.Hour, zerolog.Nop())
+	defer eq.Shutdown()
+
+	eq.Emit("energy_transfer", map[string]interface{}{"operation_id": "op-1"})
+	eq.Emit("pairing_completed", map[string]interface{}{"challenge_id": "ch-1"})
+	eq.Emit("pairing_revoked", map[string]interface{}{"lct_id": "lct-1"})
+
+	eventType := func(d delivery) string {
+		var event Event
+		require.NoError(t, json.Unmarshal(d.body, &event))
+		return event.Type
+	}
+	for _, want := range []string{"energy_transfer", "pairing_completed", "pairing_revoked"} {
+		assert.Equal(t, want, eventType(nextDelivery(t, auditDeliveries)))
+	}
+
+	// Each event reaches its subscribers before the wildcard endpoint, so the
+	// pairing endpoint has seen everything it will get
+	require.Len(t, pairingDeliveries, 2)
+	assert.Equal(t, "pairing_completed", eventType(<-pairingDeliveries))
+	assert.Equal(t, "pairing_revoked", eventType(<-pairingDeliveries))
+}
+
+func TestEmitSkipsEventsWithoutSubscribers(t *testing.T) {
+	eq := &EventQueue{
+		enabled:      true,
+		sinks:        map[string][]string{"pairing_completed": {"http://localhost:3000/webhooks/pairing-completed"}},
+		queue:        make(chan *Event, 10),
+		logger:       zerolog.Nop(),
+		dedupeWindow: time.Hour,
+		emitted:      make(map[string]time.Time),
+		subs:         make(map[*Subscription]struct{}),
+	}
+	sub := eq.Subscribe(4)
+
+	eq.Emit("energy_transfer", nil)
+	eq.Emit("pairing_completed", nil)
+
+	// WebSocket subscribers still see every event
+	assert.Len(t, sub.Events(), 2)
+	require.Len(t, eq.queue, 1)
+	assert.Equal(t, "pairing_completed", (<-eq.queue).Type)
+}
+
+func TestSubscriptionFilter(t *testing.T) {
+	eq := NewEventQueue(nil, 3, time.Second, time.Hour, zerolog.Nop())
+	all := eq.Subscribe(10)
+	pairings := eq.Subscribe(10)
+	pairings.SetFilter([]string{"pairing_completed", "lct_created"})
+
+	eq.Emit("component_registered", map[string]interface{}{"component_id": "MODBATT-MOD-001"})
+	eq.Emit("pairing_completed", map[string]interface{}{"lct_id": "lct-PACK-MC-1"})
+	eq.Emit("lct_created", map[string]interface{}{"lct_id": "lct-PACK-MOD-1"})
+
+	assert.Len(t, all.Events(), 3)
+	require.Len(t, pairings.Events(), 2)
+	assert.Equal(t, "pairing_completed", (<-pairings.Events()).Type)
+	assert.Equal(t, "lct_created", (<-pairings.Events()).Type)
+
+	// An empty filter forwards everything again
+	pairings.SetFilter(nil)
+	eq.Emit("component_registered", nil)
+	assert.Len(t, pairings.Events(), 1)
+}
+
+func TestSubscriptionDropsWhenBufferFull(t *testing.T) {
+	eq := NewEventQueue(nil, 3, time.Second, time.Hour, zerolog.Nop())
+	slow := eq.Subscribe(2)
+
+	for i := 0; i < 5; i++ {
+		eq.Emit("component_registered", i)
+	}
+
+	assert.Len(t, slow.Events(), 2)
+	assert.Equal(t, uint64(3), slow.Dropped())
+	// The oldest events are kept
+	assert.Equal(t, 0, (<-slow.Events()).Data)
+}
+
+func TestUnsubscribeClosesChannel(t *testing.T) {
+	eq := NewEventQueue(nil, 3, time.Second, time.Hour, zerolog.Nop())
+	sub := eq.Subscribe(1)
+	eq.Unsubscribe(sub)
+	eq.Unsubscribe(sub) // idempotent
+
+	_, open := <-sub.Events()
+	assert.False(t, open)
+
+	// Publishing after unsubscribe must not panic on the closed channel
+	eq.Emit("component_registered", nil)
+}
+
+func TestEmitTruncatesOversizedAttributes(t *testing.T) {
+	eq := NewEventQueue(nil, 3, time.Second, time.Hour, zerolog.Nop())
+	eq.SetPayloadLimit(16, 2)
+	sub := eq.Subscribe(4)
+
+	metadata := strings.Repeat("é", 40) // 80 bytes, two per rune
+	data := map[string]interface{}{
+		"component_id": "MODBATT-MOD-001",
+		"context":      map[string]interface{}{"metadata": metadata},
+	}
+	eq.Emit("component_registered", data)
+
+	event := <-sub.Events()
+	require.Len(t, event.Truncated, 1)
+	truncated := event.Truncated[0]
+	assert.Equal(t, "context.metadata", truncated.Path)
+	assert.Equal(t, len(metadata), truncated.Size)
+
+	bounded := event.Data.(map[string]interface{})
+	assert.Equal(t, "MODBATT-MOD-001", bounded["component_id"])
+	value := bounded["context"].(map[string]interface{})["metadata"].(string)
+	assert.Equal(t, strings.Repeat("é", 8)+TruncationMarker, value)
+	assert.True(t, utf8.ValidString(value))
+
+	full, ok := eq.Payload(truncated.Ref)
+	require.True(t, ok)
+	assert.Equal(t, metadata, full)
+	assert.Equal(t, metadata, data["context"].(map[string]interface{})["metadata"], "caller's data is left intact")
+
+	// Only the most recent full values are kept
+	eq.Emit("component_registered", map[string]interface{}{"metadata": metadata})
+	eq.Emit("component_registered", map[string]interface{}{"metadata": metadata})
+	_, ok = eq.Payload(truncated.Ref)
+	assert.False(t, ok)
+}
+
+func TestEmitWithinLimitIsUntouched(t *testing.T) {
+	eq := NewEventQueue(nil, 3, time.Second, time.Hour, zerolog.Nop())
+	eq.SetPayloadLimit(16, 2)
+	sub := eq.Subscribe(1)
+
+	data := map[string]interface{}{"component_id": "MODBATT-MOD-001"}
+	eq.Emit("component_registered", data)
+
+	event := <-sub.Events()
+	assert.Empty(t, event.Truncated)
+	assert.Equal(t, data, event.Data)
+}
@@ -0,0 +1,141 @@
+package events
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"sync"
+	"unicode/utf8"
+)
+
+// TruncationMarker ends every attribute value cut short by the payload limit
+const TruncationMarker = "...[truncated]"
+
+// TruncatedAttribute tells a consumer that an attribute was cut short and
+// where to fetch its full value
+type TruncatedAttribute struct {
+	Path string `json:"path"` // dotted path of the attribute within data
+	Size int    `json:"size"` // length in bytes of the full value
+	Ref  string `json:"ref"`  // pass to GET /api/v1/events/payloads/{ref}
+}
+
+// payloadStore keeps the full value of truncated attributes so they can be
+// fetched by reference. Only the most recent maxEntries are kept; older
+// references expire.
+type payloadStore struct {
+	mu         sync.Mutex
+	maxEntries int
+	values     map[string]string
+	order      []string // oldest first
+}
+
+func newPayloadStore(maxEntries int) *payloadStore {
+	return &payloadStore{maxEntries: maxEntries, values: make(map[string]string)}
+}
+
+// put stores value and returns its reference
+func (s *payloadStore) put(value string) string {
+	ref := newRef()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.values[ref] = value
+	s.order = append(s.order, ref)
+	for s.maxEntries > 0 && len(s.order) > s.maxEntries {
+		delete(s.values, s.order[0])
+		s.order = s.order[1:]
+	}
+	return ref
+}
+
+func (s *payloadStore) get(ref string) (string, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	value, ok := s.values[ref]
+	return value, ok
+}
+
+// newRef returns a random id for truncated payloads and queued events
+func newRef() string {
+	raw := make([]byte, 16)
+	if _, err := rand.Read(raw); err != nil {
+		panic(fmt.Sprintf("failed to generate reference: %v", err))
+	}
+	return hex.EncodeToString(raw)
+}
+
+// SetPayloadLimit bounds every string attribute of emitted events to maxBytes.
+// Longer values are cut at maxBytes, end in TruncationMarker and are listed
+// in the event's truncated attributes; the full values of the last
+// keep truncations stay available through Payload. Zero maxBytes disables
+// the limit.
+func (eq *EventQueue) SetPayloadLimit(maxBytes, keep int) {
+	eq.payloadMu.Lock()
+	defer eq.payloadMu.Unlock()
+	eq.maxAttributeBytes = maxBytes
+	eq.payloads = newPayloadStore(keep)
+}
+
+// Payload returns the full value of a truncated attribute by its reference
+func (eq *EventQueue) Payload(ref string) (string, bool) {
+	eq.payloadMu.RLock()
+	store := eq.payloads
+	eq.payloadMu.RUnlock()
+
+	if store == nil {
+		return "", false
+	}
+	return store.get(ref)
+}
+
+// boundPayload returns data with oversized string attributes truncated. The
+// caller's data is never modified; a map is copied only when something in it
+// was truncated.
+func (eq *EventQueue) boundPayload(data interface{}) (interface{}, []TruncatedAttribute) {
+	eq.payloadMu.RLock()
+	maxBytes, store := eq.maxAttributeBytes, eq.payloads
+	eq.payloadMu.RUnlock()
+
+	if maxBytes <= 0 || store == nil {
+		return data, nil
+	}
+	var truncated []TruncatedAttribute
+	bounded := boundValue(data, "", maxBytes, store, &truncated)
+	return bounded, truncated
+}
+
+func boundValue(value interface{}, path string, maxBytes int, store *payloadStore, truncated *[]TruncatedAttribute) interface{} {
+	switch v := value.(type) {
+	case string:
+		if len(v) <= maxBytes {
+			return v
+		}
+		*truncated = append(*truncated, TruncatedAttribute{Path: path, Size: len(v), Ref: store.put(v)})
+		return truncateUTF8(v, maxBytes) + TruncationMarker
+	case map[string]interface{}:
+		before := len(*truncated)
+		bounded := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			itemPath := key
+			if path != "" {
+				itemPath = path + "." + key
+			}
+			bounded[key] = boundValue(item, itemPath, maxBytes, store, truncated)
+		}
+		if len(*truncated) == before {
+			return v
+		}
+		return bounded
+	}
+	return value
+}
+
+// truncateUTF8 cuts s to at most maxBytes without splitting a rune
+func truncateUTF8(s string, maxBytes int) string {
+	cut := maxBytes
+	for cut > 0 && !utf8.RuneStart(s[cut]) {
+		cut--
+	}
+	return s[:cut]
+}
@@ -0,0 +1,101 @@
+package events
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// Retry policies a webhook endpoint can be redelivered with
+const (
+	// RetryFixed waits the same delay before every redelivery
+	RetryFixed = "fixed"
+	// RetryExponential multiplies the delay after every failed attempt
+	RetryExponential = "exponential"
+)
+
+// defaultRetryMultiplier doubles the delay, as deliveries always have
+const defaultRetryMultiplier = 2
+
+// RetryPolicy schedules the redeliveries of an event to one endpoint
+type RetryPolicy struct {
+	Kind           string        // RetryFixed or RetryExponential
+	InitialBackoff time.Duration // wait before the first redelivery
+	MaxBackoff     time.Duration // caps every wait; 0 leaves it uncapped
+	Multiplier     float64       // growth per attempt under RetryExponential
+	Jitter         float64       // fraction of each wait randomised in either direction, in [0, 1]
+}
+
+// NewRetryPolicy validates a retry schedule. An empty kind is exponential and
+// a zero multiplier doubles the delay.
+func NewRetryPolicy(kind string, initialBackoff, maxBackoff time.Duration, multiplier, jitter float64) (RetryPolicy, error) {
+	if kind == "" {
+		kind = RetryExponential
+	}
+	if kind != RetryFixed && kind != RetryExponential {
+		return RetryPolicy{}, fmt.Errorf("unknown retry policy %q, expected %q or %q", kind, RetryFixed, RetryExponential)
+	}
+	if multiplier == 0 {
+		multiplier = defaultRetryMultiplier
+	}
+	if multiplier < 1 {
+		return RetryPolicy{}, fmt.Errorf("retry multiplier must be at least 1, got %v", multiplier)
+	}
+	if jitter < 0 || jitter > 1 {
+		return RetryPolicy{}, fmt.Errorf("retry jitter must be between 0 and 1, got %v", jitter)
+	}
+	if initialBackoff < 0 || maxBackoff < 0 {
+		return RetryPolicy{}, fmt.Errorf("retry backoffs cannot be negative")
+	}
+	return RetryPolicy{Kind: kind, InitialBackoff: initialBackoff, MaxBackoff: maxBackoff, Multiplier: multiplier, Jitter: jitter}, nil
+}
+
+// Backoff returns the wait after the given failed attempt, counted from 0.
+// Jitter moves each wait up or down by up to that fraction, so endpoints
+// that failed together do not all retry at the same moment.
+func (p RetryPolicy) Backoff(attempt int, random func() float64) time.Duration {
+	wait := float64(p.InitialBackoff)
+	if p.Kind != RetryFixed && wait > 0 {
+		multiplier := p.Multiplier
+		if multiplier == 0 {
+			multiplier = defaultRetryMultiplier
+		}
+		// Keep the wait finite so jitter cannot turn it into NaN
+		wait = math.Min(wait*math.Pow(multiplier, float64(attempt)), float64(math.MaxInt64))
+	}
+	if p.MaxBackoff > 0 && wait > float64(p.MaxBackoff) {
+		wait = float64(p.MaxBackoff)
+	}
+	if p.Jitter > 0 {
+		wait += wait * p.Jitter * (2*random() - 1)
+	}
+	// Enough attempts grow the wait past what a Duration holds, and the
+	// conversion would wrap around to a negative wait
+	if wait >= float64(math.MaxInt64) {
+		return time.Duration(math.MaxInt64)
+	}
+	return time.Duration(wait)
+}
+
+// SetRetryPolicies schedules redeliveries with defaultPolicy, or with the
+// policy in endpoints for the endpoints listed there
+func (eq *EventQueue) SetRetryPolicies(defaultPolicy RetryPolicy, endpoints map[string]RetryPolicy) {
+	eq.retryMu.Lock()
+	defer eq.retryMu.Unlock()
+	eq.retryDefault = &defaultPolicy
+	eq.retryPolicies = endpoints
+}
+
+// retryPolicy returns the policy redeliveries to endpoint follow. Until
+// SetRetryPolicies is called the queue's backoff doubles after every attempt.
+func (eq *EventQueue) retryPolicy(endpoint string) RetryPolicy {
+	eq.retryMu.RLock()
+	defer eq.retryMu.RUnlock()
+	if policy, ok := eq.retryPolicies[endpoint]; ok {
+		return policy
+	}
+	if eq.retryDefault != nil {
+		return *eq.retryDefault
+	}
+	return RetryPolicy{Kind: RetryExponential, InitialBackoff: eq.backoff, Multiplier: defaultRetryMultiplier}
+}
@@ -0,0 +1,128 @@
+package events
+
+import (
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExponentialRetryWaitsLongerBetweenAttempts(t *testing.T) {
+	var attempts atomic.Int32
+	flaky := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer flaky.Close()
+	steady := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer steady.Close()
+
+	// No sinks, so no worker: deliveries run on this goroutine
+	eq := NewEventQueue(nil, 5, time.Second, time.Hour, zerolog.Nop())
+	var waits []time.Duration
+	eq.sleep = func(d time.Duration) bool {
+		waits = append(waits, d)
+		return true
+	}
+
+	exponential, err := NewRetryPolicy(RetryExponential, 100*time.Millisecond, time.Second, 3, 0)
+	require.NoError(t, err)
+	fixed, err := NewRetryPolicy(RetryFixed, 250*time.Millisecond, 0, 0, 0)
+	require.NoError(t, err)
+	eq.SetRetryPolicies(fixed, map[string]RetryPolicy{flaky.URL: exponential})
+
+	eq.processEvent(&Event{Type: "component_registered", endpoints: []string{flaky.URL}})
+	assert.Equal(t, int32(5), attempts.Load())
+	// Waits grow by the multiplier until the cap; none follows the last attempt
+	assert.Equal(t, []time.Duration{100 * time.Millisecond, 300 * time.Millisecond, 900 * time.Millisecond, time.Second}, waits)
+	for i := 1; i < len(waits); i++ {
+		assert.GreaterOrEqual(t, waits[i], waits[i-1])
+	}
+
+	// Endpoints without their own schedule follow the default
+	waits = nil
+	eq.processEvent(&Event{Type: "component_registered", endpoints: []string{steady.URL}})
+	assert.Equal(t, []time.Duration{250 * time.Millisecond, 250 * time.Millisecond, 250 * time.Millisecond, 250 * time.Millisecond}, waits)
+}
+
+func TestRetryJitterStaysWithinBounds(t *testing.T) {
+	policy, err := NewRetryPolicy(RetryExponential, time.Second, 0, 2, 0.25)
+	require.NoError(t, err)
+
+	assert.Equal(t, 3*time.Second, policy.Backoff(2, func() float64 { return 0 }))
+	assert.Equal(t, 5*time.Second, policy.Backoff(2, func() float64 { return 1 }))
+	assert.Equal(t, 4*time.Second, policy.Backoff(2, func() float64 { return 0.5 }))
+
+	// Without a schedule the queue doubles its backoff, as it always has
+	eq := NewEventQueue(nil, 3, time.Second, time.Hour, zerolog.Nop())
+	assert.Equal(t, 4*time.Second, eq.retryPolicy("http://any").Backoff(2, nil))
+}
+
+func TestRetryBackoffDoesNotOverflow(t *testing.T) {
+	policy, err := NewRetryPolicy(RetryExponential, time.Hour, 0, 10, 0.5)
+	require.NoError(t, err)
+	assert.Equal(t, time.Duration(math.MaxInt64), policy.Backoff(40, func() float64 { return 1 }))
+	assert.Equal(t, time.Duration(math.MaxInt64), policy.Backoff(1000, func() float64 { return 0.5 }))
+
+	// A zero initial backoff stays zero however many attempts have failed
+	policy, err = NewRetryPolicy(RetryExponential, 0, 0, 2, 0)
+	require.NoError(t, err)
+	assert.Equal(t, time.Duration(0), policy.Backoff(5000, nil))
+}
+
+func TestShutdownInterruptsRetryBackoff(t *testing.T) {
+	var attempts atomic.Int32
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer failing.Close()
+
+	dir := t.TempDir()
+	eq := NewEventQueue(map[string][]string{"lct_created": {failing.URL}}, 5, time.Hour, time.Hour, zerolog.Nop())
+	require.NoError(t, eq.Persist(dir))
+	eq.Emit("lct_created", map[string]interface{}{"lct_id": "lct-PACK-MOD-1"})
+	require.Eventually(t, func() bool { return attempts.Load() == 1 }, 2*time.Second, 5*time.Millisecond)
+
+	// The worker is an hour into its backoff; Shutdown does not wait it out
+	done := make(chan struct{})
+	go func() {
+		eq.Shutdown()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(2 * time.Second):
+		t.Fatal("Shutdown waited for the retry backoff")
+	}
+
+	// The event is neither dead-lettered nor forgotten
+	assert.Empty(t, eq.DeadLetters())
+	pending, err := os.ReadDir(filepath.Join(dir, "pending"))
+	require.NoError(t, err)
+	assert.Len(t, pending, 1)
+}
+
+func TestNewRetryPolicyRejectsBadSchedules(t *testing.T) {
+	_, err := NewRetryPolicy("linear", time.Second, 0, 0, 0)
+	assert.ErrorContains(t, err, "unknown retry policy")
+	_, err = NewRetryPolicy(RetryExponential, time.Second, 0, 0.5, 0)
+	assert.ErrorContains(t, err, "multiplier")
+	_, err = NewRetryPolicy(RetryExponential, time.Second, 0, 2, 1.5)
+	assert.ErrorContains(t, err, "jitter")
+
+	policy, err := NewRetryPolicy("", time.Second, 0, 0, 0)
+	require.NoError(t, err)
+	assert.Equal(t, RetryExponential, policy.Kind)
+	assert.Equal(t, float64(2), policy.Multiplier)
+}
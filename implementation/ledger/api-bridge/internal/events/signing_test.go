@@ -0,0 +1,85 @@
+package events
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// delivery is a webhook request as a sink received it
+type delivery struct {
+	body      []byte
+	timestamp string
+	signature string
+}
+
+func recordingSink(t *testing.T) (*httptest.Server, <-chan delivery) {
+	t.Helper()
+	received := make(chan delivery, 4)
+	sink := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		received <- delivery{body: body, timestamp: r.Header.Get(TimestampHeader), signature: r.Header.Get(SignatureHeader)}
+	}))
+	t.Cleanup(sink.Close)
+	return sink, received
+}
+
+func nextDelivery(t *testing.T, received <-chan delivery) delivery {
+	t.Helper()
+	select {
+	case d := <-received:
+		return d
+	case <-time.After(2 * time.Second):
+		t.Fatal("event was not delivered")
+		return delivery{}
+	}
+}
+
+func TestWebhookDeliveriesAreSignedPerEndpoint(t *testing.T) {
+	signed, signedDeliveries := recordingSink(t)
+	plain, plainDeliveries := recordingSink(t)
+
+	eq := NewEventQueue(map[string][]string{"component_registered": {signed.URL, plain.URL}}, 1, time.Millisecond, time.Hour, zerolog.Nop())
+	defer eq.Shutdown()
+	eq.SetSigningSecrets(map[string]string{signed.URL: "audit-secret"})
+
+	eq.Emit("component_registered", map[string]interface{}{"component_id": "MODBATT-MOD-001"})
+
+	d := nextDelivery(t, signedDeliveries)
+	require.NotEmpty(t, d.timestamp)
+	assert.Regexp(t, `^sha256=[0-9a-f]{64}$`, d.signature)
+	assert.NoError(t, VerifySignature("audit-secret", d.body, d.timestamp, d.signature, 5*time.Minute, time.Now()))
+	assert.ErrorIs(t, VerifySignature("wrong-secret", d.body, d.timestamp, d.signature, 5*time.Minute, time.Now()), ErrInvalidSignature)
+
+	tampered := append([]byte(nil), d.body...)
+	tampered[len(tampered)-2] = ' '
+	assert.ErrorIs(t, VerifySignature("audit-secret", tampered, d.timestamp, d.signature, 5*time.Minute, time.Now()), ErrInvalidSignature)
+
+	// A captured delivery cannot be replayed once it is older than the window
+	assert.ErrorIs(t, VerifySignature("audit-secret", d.body, d.timestamp, d.signature, 5*time.Minute, time.Now().Add(10*time.Minute)), ErrStaleSignature)
+
+	unsigned := nextDelivery(t, plainDeliveries)
+	assert.Empty(t, unsigned.timestamp)
+	assert.Empty(t, unsigned.signature)
+}
+
+func TestSignMatchesRecipe(t *testing.T) {
+	// HMAC-SHA256("secret", "1752492851.{}"), as computed by the documented openssl recipe
+	assert.Equal(t, "fb653ca8298b9b153ca6a102ced2f4ecf95f54190ea0a369f0edb1a7c75a63ea", Sign("secret", "1752492851", []byte("{}")))
+}
+
+func TestVerifySignatureRejectsMalformedHeaders(t *testing.T) {
+	now := time.Unix(1752492851, 0)
+	signature := signaturePrefix + Sign("secret", "1752492851", []byte("{}"))
+
+	assert.NoError(t, VerifySignature("secret", []byte("{}"), "1752492851", signature, time.Minute, now))
+	assert.ErrorIs(t, VerifySignature("secret", []byte("{}"), "yesterday", signature, time.Minute, now), ErrInvalidSignature)
+	assert.ErrorIs(t, VerifySignature("secret", []byte("{}"), "1752492851", signature[len(signaturePrefix):], time.Minute, now), ErrInvalidSignature)
+	assert.ErrorIs(t, VerifySignature("secret", []byte("{}"), "1752492851", "sha256=zz", time.Minute, now), ErrInvalidSignature)
+}
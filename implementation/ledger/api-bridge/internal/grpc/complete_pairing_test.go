@@ -0,0 +1,47 @@
+//go:build mockchain
+
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"api-bridge/internal/blockchain"
+	"api-bridge/internal/config"
+	pb "api-bridge/proto"
+)
+
+func TestCompletePairingReturnsNoKeyMaterial(t *testing.T) {
+	client := blockchain.NewMockClient(zerolog.Nop())
+	ctx := context.Background()
+	for i := 0; i < 2; i++ {
+		_, err := client.RegisterComponent(ctx, "alice", "data", "", false)
+		require.NoError(t, err)
+	}
+	challenge, err := client.InitiatePairing(ctx, "alice", "COMP-MOCK-0001", "COMP-MOCK-0002", "race_day", "", false)
+	require.NoError(t, err)
+
+	s := NewServer(client, &config.Config{})
+	resp, err := s.CompletePairing(ctx, &pb.CompletePairingRequest{
+		Creator:        "alice",
+		ChallengeId:    challenge["challenge_id"].(string),
+		ComponentAAuth: "auth-a",
+		ComponentBAuth: "auth-b",
+		SessionContext: "session",
+	})
+	require.NoError(t, err)
+	assert.NotEmpty(t, resp.KeyReference)
+
+	// The response carries the key's reference, never its halves
+	var fields []string
+	resp.ProtoReflect().Range(func(field protoreflect.FieldDescriptor, _ protoreflect.Value) bool {
+		fields = append(fields, string(field.Name()))
+		return true
+	})
+	assert.ElementsMatch(t, []string{"lct_id", "txhash", "key_reference", "session_keys", "trust_summary"}, fields)
+}
@@ -0,0 +1,74 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	pb "api-bridge/proto"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
+)
+
+// healthCheckInterval is how often the node is probed to keep the health
+// service's status current
+const healthCheckInterval = 10 * time.Second
+
+// healthServices are the names the health service reports on: the server as
+// a whole ("") and the bridge service
+var healthServices = []string{"", pb.APIBridgeService_ServiceDesc.ServiceName}
+
+// registerHealth registers the standard health service and server reflection
+// on grpcServer. Everything reports NOT_SERVING until the first connection
+// test passes.
+func (s *Server) registerHealth(grpcServer *grpc.Server) *health.Server {
+	healthServer := health.NewServer()
+	for _, service := range healthServices {
+		healthServer.SetServingStatus(service, grpc_health_v1.HealthCheckResponse_NOT_SERVING)
+	}
+	grpc_health_v1.RegisterHealthServer(grpcServer, healthServer)
+	reflection.Register(grpcServer)
+	return healthServer
+}
+
+// watchHealth updates the health service from the blockchain connection test
+// every interval until ctx is done
+func (s *Server) watchHealth(ctx context.Context, healthServer *health.Server, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		s.updateHealth(ctx, healthServer, interval)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// updateHealth reports SERVING while the node answers and NOT_SERVING
+// otherwise, logging when the status changes
+func (s *Server) updateHealth(ctx context.Context, healthServer *health.Server, timeout time.Duration) {
+	checkCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	status := grpc_health_v1.HealthCheckResponse_SERVING
+	err := s.blockchainClient.TestBlockchainConnection(checkCtx)
+	if err != nil {
+		status = grpc_health_v1.HealthCheckResponse_NOT_SERVING
+	}
+
+	previous, _ := healthServer.Check(ctx, &grpc_health_v1.HealthCheckRequest{})
+	if previous.GetStatus() != status {
+		if err != nil {
+			s.logger.Warn().Err(err).Msg("Blockchain unreachable, gRPC health is NOT_SERVING")
+		} else {
+			s.logger.Info().Msg("Blockchain reachable, gRPC health is SERVING")
+		}
+	}
+	for _, service := range healthServices {
+		healthServer.SetServingStatus(service, status)
+	}
+}
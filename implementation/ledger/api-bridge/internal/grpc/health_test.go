@@ -0,0 +1,89 @@
+package grpc
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"api-bridge/internal/auth"
+	"api-bridge/internal/blockchain"
+	"api-bridge/internal/config"
+	pb "api-bridge/proto"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection/grpc_reflection_v1"
+)
+
+func TestHealthFollowsBlockchainConnection(t *testing.T) {
+	nodeUp := true
+	node := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !nodeUp {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte(`{"default_node_info": {"network": "racecarweb"}}`))
+	}))
+	defer node.Close()
+
+	client, err := blockchain.NewClient(node.URL, zerolog.Nop())
+	require.NoError(t, err)
+	s := NewServer(client, &config.Config{Server: config.ServerConfig{GRPCTLS: config.GRPCTLSConfig{Insecure: true}}})
+	s.SetLogger(zerolog.Nop())
+	// Health checks must get through without an API key
+	s.SetAuthInterceptor(auth.NewGRPCAuthInterceptor(nil, zerolog.Nop()))
+
+	grpcServer, err := s.newGRPCServer()
+	require.NoError(t, err)
+	pb.RegisterAPIBridgeServiceServer(grpcServer, s)
+	healthServer := s.registerHealth(grpcServer)
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	go func() { _ = grpcServer.Serve(lis) }()
+	defer grpcServer.Stop()
+
+	conn, err := grpc.NewClient(lis.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer conn.Close()
+	healthClient := grpc_health_v1.NewHealthClient(conn)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	check := func(service string) grpc_health_v1.HealthCheckResponse_ServingStatus {
+		resp, err := healthClient.Check(ctx, &grpc_health_v1.HealthCheckRequest{Service: service})
+		require.NoError(t, err)
+		return resp.Status
+	}
+
+	assert.Equal(t, grpc_health_v1.HealthCheckResponse_NOT_SERVING, check(""), "nothing is served before the first connection test")
+
+	s.updateHealth(ctx, healthServer, time.Second)
+	assert.Equal(t, grpc_health_v1.HealthCheckResponse_SERVING, check(""))
+	assert.Equal(t, grpc_health_v1.HealthCheckResponse_SERVING, check("api_bridge.v1.APIBridgeService"))
+
+	nodeUp = false
+	s.updateHealth(ctx, healthServer, time.Second)
+	assert.Equal(t, grpc_health_v1.HealthCheckResponse_NOT_SERVING, check(""))
+
+	// Reflection lets grpcurl list the services
+	stream, err := grpc_reflection_v1.NewServerReflectionClient(conn).ServerReflectionInfo(ctx)
+	require.NoError(t, err)
+	require.NoError(t, stream.Send(&grpc_reflection_v1.ServerReflectionRequest{
+		MessageRequest: &grpc_reflection_v1.ServerReflectionRequest_ListServices{},
+	}))
+	resp, err := stream.Recv()
+	require.NoError(t, err)
+	var services []string
+	for _, service := range resp.GetListServicesResponse().GetService() {
+		services = append(services, service.Name)
+	}
+	assert.Contains(t, services, "grpc.health.v1.Health")
+	assert.Contains(t, services, "api_bridge.v1.APIBridgeService")
+}
@@ -2,6 +2,7 @@ package grpc
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"net"
@@ -13,6 +14,7 @@ import (
 	pb "api-bridge/proto"
 
 	"github.com/rs/zerolog"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -44,28 +46,53 @@ func (s *Server) SetAuthInterceptor(interceptor *auth.GRPCAuthInterceptor) {
 }
 
 func (s *Server) Start(port int) error {
-	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", port))
+	grpcServer, err := s.newGRPCServer()
 	if err != nil {
-		return fmt.Errorf("failed to listen: %v", err)
+		return err
 	}
 
-	// Create gRPC server with optional authentication interceptor
-	var grpcServer *grpc.Server
-	if s.authInterceptor != nil {
-		grpcServer = grpc.NewServer(
-			grpc.UnaryInterceptor(s.authInterceptor.UnaryInterceptor),
-		)
-	} else {
-		grpcServer = grpc.NewServer()
+	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", port))
+	if err != nil {
+		return fmt.Errorf("failed to listen: %v", err)
 	}
 
 	log.Printf("Registering APIBridgeService on port %d", port)
 	pb.RegisterAPIBridgeServiceServer(grpcServer, s)
+	healthServer := s.registerHealth(grpcServer)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go s.watchHealth(ctx, healthServer, healthCheckInterval)
 
 	log.Printf("gRPC server starting on port %d", port)
 	return grpcServer.Serve(lis)
 }
 
+// newGRPCServer builds the grpc.Server with the configured transport
+// security, tracing and optional authentication
+func (s *Server) newGRPCServer() (*grpc.Server, error) {
+	creds, err := ServerCredentials(s.config.Server.GRPCTLS)
+	if err != nil {
+		return nil, err
+	}
+	if s.config.Server.GRPCTLS.Insecure {
+		s.logger.Warn().Msg("gRPC server is serving plaintext (server.grpc_tls.insecure); do not use in production")
+	}
+
+	// Continue the caller's trace from the traceparent metadata so blockchain
+	// spans for a gRPC call join the same end-to-end trace
+	opts := []grpc.ServerOption{
+		grpc.Creds(creds),
+		grpc.StatsHandler(otelgrpc.NewServerHandler()),
+	}
+
+	// Add the optional authentication interceptor
+	if s.authInterceptor != nil {
+		opts = append(opts, grpc.UnaryInterceptor(s.authInterceptor.UnaryInterceptor))
+	}
+	return grpc.NewServer(opts...), nil
+}
+
 // Account Management
 func (s *Server) GetAccounts(ctx context.Context, req *pb.GetAccountsRequest) (*pb.GetAccountsResponse, error) {
 	accountManager := s.blockchainClient.GetAccountManager()
@@ -88,7 +115,10 @@ func (s *Server) GetAccounts(ctx context.Context, req *pb.GetAccountsRequest) (*
 
 // Component Registry
 func (s *Server) RegisterComponent(ctx context.Context, req *pb.RegisterComponentRequest) (*pb.RegisterComponentResponse, error) {
-	result, err := s.blockchainClient.RegisterComponent(ctx, req.Creator, req.ComponentData, req.Context)
+	result, err := s.blockchainClient.RegisterComponent(ctx, req.Creator, req.ComponentData, req.Context, req.Dedupe)
+	if errors.Is(err, blockchain.ErrInvalidComponentData) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to register component: %v", err)
 	}
@@ -102,6 +132,7 @@ func (s *Server) RegisterComponent(ctx context.Context, req *pb.RegisterComponen
 	lctID, _ := result["lct_id"].(string)
 	status, _ := result["status"].(string)
 	txhash, _ := result["txhash"].(string)
+	created, _ := result["created"].(bool)
 
 	return &pb.RegisterComponentResponse{
 		ComponentId:       componentID,
@@ -112,6 +143,7 @@ func (s *Server) RegisterComponent(ctx context.Context, req *pb.RegisterComponen
 		LctId:             lctID,
 		Status:            status,
 		Txhash:            txhash,
+		Created:           created,
 	}, nil
 }
 
@@ -179,6 +211,15 @@ func (s *Server) VerifyComponent(ctx context.Context, req *pb.VerifyComponentReq
 // LCT Management
 func (s *Server) CreateLCT(ctx context.Context, req *pb.CreateLCTRequest) (*pb.CreateLCTResponse, error) {
 	result, err := s.blockchainClient.CreateLCT(ctx, req.Creator, req.ComponentA, req.ComponentB, req.Context, req.ProxyId)
+	if errors.Is(err, blockchain.ErrInvalidOperationalContext) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if errors.Is(err, blockchain.ErrComponentNotFound) {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if errors.Is(err, blockchain.ErrComponentInactive) {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to create LCT: %v", err)
 	}
@@ -187,7 +228,7 @@ func (s *Server) CreateLCT(ctx context.Context, req *pb.CreateLCTRequest) (*pb.C
 	lctID, _ := result["lct_id"].(string)
 	componentA, _ := result["component_a"].(string)
 	componentB, _ := result["component_b"].(string)
-	context, _ := result["context"].(string)
+	context, _ := result["operational_context"].(string)
 	proxyID, _ := result["proxy_id"].(string)
 	status, _ := result["status"].(string)
 	createdAt, _ := result["created_at"].(int64)
@@ -247,8 +288,41 @@ func (s *Server) GetLCT(ctx context.Context, req *pb.GetLCTRequest) (*pb.GetLCTR
 	}, nil
 }
 
+func (s *Server) GetComponentRelationships(ctx context.Context, req *pb.GetComponentRelationshipsRequest) (*pb.GetComponentRelationshipsResponse, error) {
+	if req.ComponentId == "" {
+		return nil, status.Error(codes.InvalidArgument, "component_id is required")
+	}
+
+	relationships, err := s.blockchainClient.GetComponentRelationships(ctx, req.ComponentId, req.Status)
+	if errors.Is(err, blockchain.ErrInvalidLCTStatus) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get component relationships: %v", err)
+	}
+
+	summaries := make([]*pb.LCTSummary, len(relationships))
+	for i, rel := range relationships {
+		summaries[i] = &pb.LCTSummary{
+			LctId:              rel.LctID,
+			PeerComponentId:    rel.PeerComponentID,
+			PairingStatus:      rel.PairingStatus,
+			OperationalContext: rel.OperationalContext,
+		}
+	}
+
+	return &pb.GetComponentRelationshipsResponse{
+		ComponentId:   req.ComponentId,
+		Relationships: summaries,
+		Count:         int32(len(summaries)),
+	}, nil
+}
+
 func (s *Server) UpdateLCTStatus(ctx context.Context, req *pb.UpdateLCTStatusRequest) (*pb.UpdateLCTStatusResponse, error) {
 	result, err := s.blockchainClient.UpdateLCTStatus(ctx, req.Creator, req.LctId, req.Status, req.Context)
+	if errors.Is(err, blockchain.ErrInvalidLCTStatus) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to update LCT status: %v", err)
 	}
@@ -268,6 +342,9 @@ func (s *Server) UpdateLCTStatus(ctx context.Context, req *pb.UpdateLCTStatusReq
 // Pairing
 func (s *Server) InitiatePairing(ctx context.Context, req *pb.InitiatePairingRequest) (*pb.InitiatePairingResponse, error) {
 	result, err := s.blockchainClient.InitiatePairing(ctx, req.Creator, req.ComponentA, req.ComponentB, req.OperationalContext, req.ProxyId, req.ForceImmediate)
+	if errors.Is(err, blockchain.ErrInvalidOperationalContext) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to initiate pairing: %v", err)
 	}
@@ -309,21 +386,22 @@ func (s *Server) CompletePairing(ctx context.Context, req *pb.CompletePairingReq
 	sessionKeys, _ := result["session_keys"].(string)
 	trustSummary, _ := result["trust_summary"].(string)
 	txhash, _ := result["txhash"].(string)
-	splitKeyA, _ := result["split_key_a"].(string)
-	splitKeyB, _ := result["split_key_b"].(string)
+	keyReference, _ := result["key_reference"].(string)
 
 	return &pb.CompletePairingResponse{
 		LctId:        lctID,
 		SessionKeys:  sessionKeys,
 		TrustSummary: trustSummary,
 		Txhash:       txhash,
-		SplitKeyA:    splitKeyA,
-		SplitKeyB:    splitKeyB,
+		KeyReference: keyReference,
 	}, nil
 }
 
 func (s *Server) RevokePairing(ctx context.Context, req *pb.RevokePairingRequest) (*pb.RevokePairingResponse, error) {
 	result, err := s.blockchainClient.RevokePairing(ctx, req.Creator, req.LctId, req.Reason, req.NotifyOffline)
+	if errors.Is(err, blockchain.ErrPairingRevocationUnauthorized) {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to revoke pairing: %v", err)
 	}
@@ -332,16 +410,21 @@ func (s *Server) RevokePairing(ctx context.Context, req *pb.RevokePairingRequest
 	lctID, _ := result["lct_id"].(string)
 	status, _ := result["status"].(string)
 	reason, _ := result["reason"].(string)
+	txhash, _ := result["txhash"].(string)
 
 	return &pb.RevokePairingResponse{
 		LctId:  lctID,
 		Status: status,
 		Reason: reason,
+		Txhash: txhash,
 	}, nil
 }
 
 func (s *Server) GetPairingStatus(ctx context.Context, req *pb.GetPairingStatusRequest) (*pb.GetPairingStatusResponse, error) {
 	pairingStatus, err := s.blockchainClient.GetPairingStatus(ctx, req.ChallengeId)
+	if errors.Is(err, blockchain.ErrPairingChallengeNotFound) {
+		return nil, status.Errorf(codes.NotFound, "pairing challenge %s not found", req.ChallengeId)
+	}
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get pairing status: %v", err)
 	}
@@ -349,17 +432,27 @@ func (s *Server) GetPairingStatus(ctx context.Context, req *pb.GetPairingStatusR
 	// Extract values from map[string]interface{}
 	statusValue, _ := pairingStatus["status"].(string)
 	createdAt, _ := pairingStatus["created_at"].(int64)
+	lctID, _ := pairingStatus["lct_id"].(string)
+	ttlSeconds, _ := pairingStatus["ttl_seconds"].(int64)
 
 	return &pb.GetPairingStatusResponse{
 		ChallengeId: req.ChallengeId,
 		Status:      statusValue,
 		CreatedAt:   createdAt,
+		LctId:       lctID,
+		TtlSeconds:  ttlSeconds,
 	}, nil
 }
 
 // Trust Tensor
 func (s *Server) CreateTrustTensor(ctx context.Context, req *pb.CreateTrustTensorRequest) (*pb.CreateTrustTensorResponse, error) {
-	result, err := s.blockchainClient.CreateTrustTensor(ctx, req.Creator, req.ComponentA, req.ComponentB, req.Context, req.InitialScore)
+	result, err := s.blockchainClient.CreateTrustTensor(ctx, req.Creator, req.ComponentA, req.ComponentB, req.Context, req.InitialScore, nil)
+	if errors.Is(err, blockchain.ErrInvalidTrustScore) {
+		return nil, status.Errorf(codes.InvalidArgument, "initial_score: %v", err)
+	}
+	if errors.Is(err, blockchain.ErrTxNotCommitted) {
+		return nil, status.Error(codes.DeadlineExceeded, err.Error())
+	}
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to create trust tensor: %v", err)
 	}
@@ -416,6 +509,9 @@ func (s *Server) UpdateTrustScore(ctx context.Context, req *pb.UpdateTrustScoreR
 // Energy Operations
 func (s *Server) CreateEnergyOperation(ctx context.Context, req *pb.CreateEnergyOperationRequest) (*pb.CreateEnergyOperationResponse, error) {
 	result, err := s.blockchainClient.CreateEnergyOperation(ctx, req.Creator, req.ComponentA, req.ComponentB, req.OperationType, req.Amount, req.Context)
+	if errors.Is(err, blockchain.ErrTxNotCommitted) {
+		return nil, status.Error(codes.DeadlineExceeded, err.Error())
+	}
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to create energy operation: %v", err)
 	}
@@ -437,7 +533,19 @@ func (s *Server) CreateEnergyOperation(ctx context.Context, req *pb.CreateEnergy
 }
 
 func (s *Server) ExecuteEnergyTransfer(ctx context.Context, req *pb.ExecuteEnergyTransferRequest) (*pb.ExecuteEnergyTransferResponse, error) {
-	result, err := s.blockchainClient.ExecuteEnergyTransfer(ctx, req.Creator, req.OperationId, req.Amount, req.Context)
+	result, err := s.blockchainClient.ExecuteEnergyTransfer(ctx, req.Creator, req.OperationId, req.Amount, "", req.Context)
+	if errors.Is(err, blockchain.ErrEnergyOperationNotFound) {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if errors.Is(err, blockchain.ErrEnergyOperationNotPending) || errors.Is(err, blockchain.ErrInsufficientEnergyBalance) {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	if errors.Is(err, blockchain.ErrEnergyTransferUnauthorized) {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+	if errors.Is(err, blockchain.ErrTxNotCommitted) {
+		return nil, status.Error(codes.DeadlineExceeded, err.Error())
+	}
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to execute energy transfer: %v", err)
 	}
@@ -0,0 +1,117 @@
+package grpc
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+
+	"api-bridge/internal/config"
+
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// ServerCredentials builds the transport credentials for the gRPC server.
+// Plaintext is only served when cfg.Insecure is set; otherwise a certificate
+// and key are required, and a client CA turns on mutual TLS.
+func ServerCredentials(cfg config.GRPCTLSConfig) (credentials.TransportCredentials, error) {
+	if cfg.Insecure {
+		return insecure.NewCredentials(), nil
+	}
+	if cfg.CertFile == "" || cfg.KeyFile == "" {
+		return nil, errors.New("gRPC TLS needs cert_file and key_file; set server.grpc_tls.insecure to serve plaintext")
+	}
+
+	cert, err := tls.LoadX509KeyPair(cfg.CertFile, cfg.KeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load gRPC server certificate: %w", err)
+	}
+	tlsConfig := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+		ClientAuth:   tls.NoClientCert,
+	}
+
+	if cfg.ClientCAFile != "" {
+		pool, err := loadCertPool(cfg.ClientCAFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load gRPC client CA: %w", err)
+		}
+		tlsConfig.ClientCAs = pool
+		tlsConfig.ClientAuth = tls.VerifyClientCertIfGiven
+	}
+	if cfg.RequireClientCert {
+		if tlsConfig.ClientCAs == nil {
+			return nil, errors.New("gRPC require_client_cert needs client_ca_file")
+		}
+		tlsConfig.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+
+	return credentials.NewTLS(tlsConfig), nil
+}
+
+// ClientCredentials builds transport credentials for dialing the bridge. An
+// empty caFile trusts the system roots; certFile and keyFile present a client
+// certificate for mutual TLS.
+func ClientCredentials(caFile, certFile, keyFile string) (credentials.TransportCredentials, error) {
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+
+	if caFile != "" {
+		pool, err := loadCertPool(caFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load CA: %w", err)
+		}
+		tlsConfig.RootCAs = pool
+	}
+	if certFile != "" || keyFile != "" {
+		cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	return credentials.NewTLS(tlsConfig), nil
+}
+
+// ClientFlags are the connection flags the sample gRPC clients share
+type ClientFlags struct {
+	plaintext *bool
+	caFile    *string
+	certFile  *string
+	keyFile   *string
+}
+
+// RegisterClientFlags defines -insecure, -ca, -cert and -key on fs
+func RegisterClientFlags(fs *flag.FlagSet) *ClientFlags {
+	return &ClientFlags{
+		plaintext: fs.Bool("insecure", false, "connect without TLS, e.g. to a server with server.grpc_tls.insecure"),
+		caFile:    fs.String("ca", "", "CA bundle that signed the server certificate; empty uses the system roots"),
+		certFile:  fs.String("cert", "", "client certificate, for servers that require mutual TLS"),
+		keyFile:   fs.String("key", "", "client certificate key"),
+	}
+}
+
+// TransportCredentials dials in plaintext with -insecure and over TLS, see
+// ClientCredentials, otherwise
+func (f *ClientFlags) TransportCredentials() (credentials.TransportCredentials, error) {
+	if *f.plaintext {
+		return insecure.NewCredentials(), nil
+	}
+	return ClientCredentials(*f.caFile, *f.certFile, *f.keyFile)
+}
+
+func loadCertPool(file string) (*x509.CertPool, error) {
+	pem, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, fmt.Errorf("no certificates found in %s", file)
+	}
+	return pool, nil
+}
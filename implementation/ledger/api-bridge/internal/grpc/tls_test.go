@@ -0,0 +1,175 @@
+package grpc
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"flag"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"api-bridge/internal/config"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// testPKI writes a CA plus a server and a client certificate it signed
+type testPKI struct {
+	caFile, serverCert, serverKey, clientCert, clientKey string
+}
+
+func newTestPKI(t *testing.T) testPKI {
+	t.Helper()
+	dir := t.TempDir()
+
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	caTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "racecar test CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	caDER, err := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, &caKey.PublicKey, caKey)
+	require.NoError(t, err)
+	ca, err := x509.ParseCertificate(caDER)
+	require.NoError(t, err)
+
+	issue := func(name string, serial int64, usage x509.ExtKeyUsage) (string, string) {
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		require.NoError(t, err)
+		template := &x509.Certificate{
+			SerialNumber: big.NewInt(serial),
+			Subject:      pkix.Name{CommonName: name},
+			DNSNames:     []string{"localhost"},
+			IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+			NotBefore:    time.Now().Add(-time.Hour),
+			NotAfter:     time.Now().Add(time.Hour),
+			KeyUsage:     x509.KeyUsageDigitalSignature,
+			ExtKeyUsage:  []x509.ExtKeyUsage{usage},
+		}
+		der, err := x509.CreateCertificate(rand.Reader, template, ca, &key.PublicKey, caKey)
+		require.NoError(t, err)
+		keyDER, err := x509.MarshalECPrivateKey(key)
+		require.NoError(t, err)
+		return writePEM(t, dir, name+".crt", "CERTIFICATE", der), writePEM(t, dir, name+".key", "EC PRIVATE KEY", keyDER)
+	}
+
+	pki := testPKI{caFile: writePEM(t, dir, "ca.crt", "CERTIFICATE", caDER)}
+	pki.serverCert, pki.serverKey = issue("server", 2, x509.ExtKeyUsageServerAuth)
+	pki.clientCert, pki.clientKey = issue("client", 3, x509.ExtKeyUsageClientAuth)
+	return pki
+}
+
+func writePEM(t *testing.T, dir, name, blockType string, der []byte) string {
+	t.Helper()
+	path := filepath.Join(dir, name)
+	require.NoError(t, os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der}), 0o600))
+	return path
+}
+
+// serveHealth starts the bridge's gRPC server with tlsConfig, exposing only
+// the health service, and returns its address
+func serveHealth(t *testing.T, tlsConfig config.GRPCTLSConfig) string {
+	t.Helper()
+	s := &Server{config: &config.Config{Server: config.ServerConfig{GRPCTLS: tlsConfig}}, logger: zerolog.Nop()}
+	grpcServer, err := s.newGRPCServer()
+	require.NoError(t, err)
+	grpc_health_v1.RegisterHealthServer(grpcServer, health.NewServer())
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	go func() { _ = grpcServer.Serve(lis) }()
+	t.Cleanup(grpcServer.Stop)
+	return lis.Addr().String()
+}
+
+func checkHealth(t *testing.T, addr string, creds credentials.TransportCredentials) error {
+	t.Helper()
+	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(creds))
+	require.NoError(t, err)
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	_, err = grpc_health_v1.NewHealthClient(conn).Check(ctx, &grpc_health_v1.HealthCheckRequest{})
+	return err
+}
+
+func TestGRPCServerRequiresClientCertificate(t *testing.T) {
+	pki := newTestPKI(t)
+	addr := serveHealth(t, config.GRPCTLSConfig{
+		CertFile:          pki.serverCert,
+		KeyFile:           pki.serverKey,
+		ClientCAFile:      pki.caFile,
+		RequireClientCert: true,
+	})
+
+	withCert, err := ClientCredentials(pki.caFile, pki.clientCert, pki.clientKey)
+	require.NoError(t, err)
+	assert.NoError(t, checkHealth(t, addr, withCert))
+
+	withoutCert, err := ClientCredentials(pki.caFile, "", "")
+	require.NoError(t, err)
+	assert.Error(t, checkHealth(t, addr, withoutCert))
+
+	assert.Error(t, checkHealth(t, addr, insecure.NewCredentials()), "plaintext clients must be refused")
+}
+
+func TestGRPCServerTLSWithoutClientCertificate(t *testing.T) {
+	pki := newTestPKI(t)
+	addr := serveHealth(t, config.GRPCTLSConfig{CertFile: pki.serverCert, KeyFile: pki.serverKey})
+
+	creds, err := ClientCredentials(pki.caFile, "", "")
+	require.NoError(t, err)
+	assert.NoError(t, checkHealth(t, addr, creds))
+}
+
+func TestGRPCServerInsecureOnlyWhenExplicit(t *testing.T) {
+	addr := serveHealth(t, config.GRPCTLSConfig{Insecure: true})
+	assert.NoError(t, checkHealth(t, addr, insecure.NewCredentials()))
+
+	_, err := ServerCredentials(config.GRPCTLSConfig{})
+	assert.Error(t, err, "no certificate and no insecure flag")
+
+	pki := newTestPKI(t)
+	_, err = ServerCredentials(config.GRPCTLSConfig{CertFile: pki.serverCert, KeyFile: pki.serverKey, RequireClientCert: true})
+	assert.Error(t, err, "require_client_cert without a client CA")
+}
+
+func TestClientFlagsPickTransport(t *testing.T) {
+	pki := newTestPKI(t)
+	addr := serveHealth(t, config.GRPCTLSConfig{CertFile: pki.serverCert, KeyFile: pki.serverKey})
+
+	fs := flag.NewFlagSet("client", flag.ContinueOnError)
+	flags := RegisterClientFlags(fs)
+	require.NoError(t, fs.Parse([]string{"-ca", pki.caFile}))
+	creds, err := flags.TransportCredentials()
+	require.NoError(t, err)
+	assert.NoError(t, checkHealth(t, addr, creds))
+
+	fs = flag.NewFlagSet("client", flag.ContinueOnError)
+	flags = RegisterClientFlags(fs)
+	require.NoError(t, fs.Parse([]string{"-insecure"}))
+	creds, err = flags.TransportCredentials()
+	require.NoError(t, err)
+	assert.Equal(t, "insecure", creds.Info().SecurityProtocol)
+}
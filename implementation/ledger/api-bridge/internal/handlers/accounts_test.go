@@ -0,0 +1,157 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"api-bridge/internal/blockchain"
+	"api-bridge/internal/config"
+)
+
+const (
+	aliceAddress = "cosmos1cs2clgcszut5ppvecfa4zrftvv9xz59w9fqcuv"
+	bobAddress   = "cosmos18wz6nc4mgxdn5k2vce9y2nlxes3luzwz5tcurl"
+)
+
+func accountsRouter(t *testing.T, node http.Handler) *gin.Engine {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+
+	server := httptest.NewServer(node)
+	t.Cleanup(server.Close)
+
+	client, err := blockchain.NewClient(server.URL, zerolog.Nop())
+	require.NoError(t, err)
+	h := &Handler{
+		config:     &config.Config{Blockchain: config.BlockchainConfig{Timeout: 5}},
+		logger:     zerolog.Nop(),
+		blockchain: client,
+	}
+
+	router := gin.New()
+	router.GET("/api/v1/accounts", h.GetAccounts)
+	return router
+}
+
+func getAccounts(t *testing.T, router *gin.Engine, query string) map[string]map[string]interface{} {
+	t.Helper()
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/v1/accounts"+query, nil))
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+	var body struct {
+		Accounts []map[string]interface{} `json:"accounts"`
+		Count    int                      `json:"count"`
+	}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	require.Equal(t, len(body.Accounts), body.Count)
+
+	byName := make(map[string]map[string]interface{}, len(body.Accounts))
+	for _, account := range body.Accounts {
+		byName[account["name"].(string)] = account
+	}
+	return byName
+}
+
+func TestGetAccountsDetailed(t *testing.T) {
+	router := accountsRouter(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/cosmos/bank/v1beta1/balances/" + aliceAddress:
+			_, _ = w.Write([]byte(`{"balances": [{"denom": "stake", "amount": "100000000"}, {"denom": "token", "amount": "20000"}], "pagination": {"next_key": null, "total": "2"}}`))
+		case "/cosmos/auth/v1beta1/accounts/" + aliceAddress:
+			_, _ = w.Write([]byte(`{"account": {"@type": "/cosmos.auth.v1beta1.BaseAccount", "address": "` + aliceAddress + `", "account_number": "0", "sequence": "42"}}`))
+		case "/cosmos/bank/v1beta1/balances/" + bobAddress:
+			_, _ = w.Write([]byte(`{"balances": [], "pagination": {"next_key": null, "total": "0"}}`))
+		case "/cosmos/auth/v1beta1/accounts/" + bobAddress:
+			// bob has never received funds, so the auth module does not know him yet
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"code": 5, "message": "account ` + bobAddress + ` not found", "details": []}`))
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+
+	accounts := getAccounts(t, router, "?detailed=true")
+
+	alice := accounts["alice"]
+	assert.Equal(t, aliceAddress, alice["address"])
+	assert.Equal(t, "secp256k1", alice["key_type"])
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"denom": "stake", "amount": "100000000"},
+		map[string]interface{}{"denom": "token", "amount": "20000"},
+	}, alice["balances"])
+	assert.Equal(t, float64(42), alice["sequence"])
+	assert.NotContains(t, alice, "error")
+
+	bob := accounts["bob"]
+	assert.NotContains(t, bob, "balances")
+	assert.Equal(t, float64(0), bob["sequence"])
+	assert.NotContains(t, bob, "error")
+}
+
+func TestGetAccountsSkipsChainQueriesByDefault(t *testing.T) {
+	router := accountsRouter(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected query to %s", r.URL.Path)
+	}))
+
+	accounts := getAccounts(t, router, "")
+	require.Contains(t, accounts, "alice")
+	assert.NotContains(t, accounts["alice"], "balances")
+	assert.NotContains(t, accounts["alice"], "sequence")
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/v1/accounts?detailed=maybe", nil))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.True(t, strings.Contains(w.Body.String(), "detailed"))
+}
+
+func TestImportAccount(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	client, err := blockchain.NewClient("http://127.0.0.1:0", zerolog.Nop())
+	require.NoError(t, err)
+	h := &Handler{config: &config.Config{}, logger: zerolog.Nop(), blockchain: client}
+
+	router := gin.New()
+	router.POST("/api/v1/accounts/import", h.ImportAccount)
+	post := func(name, mnemonic string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/accounts/import", strings.NewReader(fmt.Sprintf(`{"name": %q, "mnemonic": %q}`, name, mnemonic)))
+		req.Header.Set("Content-Type", "application/json")
+		router.ServeHTTP(w, req)
+		return w
+	}
+	mnemonic := "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+
+	assert.Equal(t, http.StatusServiceUnavailable, post("pit-crew", mnemonic).Code, "no keyring configured")
+
+	registry := codectypes.NewInterfaceRegistry()
+	cryptocodec.RegisterInterfaces(registry)
+	client.GetAccountManager().UseKeyring(blockchain.AccountKeysConfig{Keyring: keyring.NewInMemory(codec.NewProtoCodec(registry))})
+
+	w := post("pit-crew", mnemonic)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	var account blockchain.Account
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &account))
+	assert.Equal(t, "pit-crew", account.Name)
+	assert.True(t, strings.HasPrefix(account.Address, "cosmos1"))
+
+	assert.Equal(t, http.StatusConflict, post("pit-crew", mnemonic).Code)
+
+	bad := post("pit-crew-2", "abandon abandon abandon")
+	assert.Equal(t, http.StatusBadRequest, bad.Code)
+	assert.Contains(t, bad.Body.String(), "invalid mnemonic")
+	assert.NotContains(t, bad.Body.String(), "abandon abandon", "the mnemonic must not be echoed back")
+}
@@ -0,0 +1,56 @@
+package handlers
+
+import (
+	"context"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// componentVerifier is the part of the blockchain client automatic
+// verification uses
+type componentVerifier interface {
+	VerifyComponent(ctx context.Context, verifier, componentID, context string) (map[string]interface{}, error)
+}
+
+// autoVerify verifies a freshly registered component when
+// verification.auto_verify is on and returns the outcome for the registration
+// response
+func (h *Handler) autoVerify(ctx context.Context, c *gin.Context, creator, componentID, verificationContext string) map[string]interface{} {
+	verifier := h.config.Verification.Verifier
+	if verifier == "" {
+		verifier = creator
+	}
+
+	result := verifyRegistered(ctx, h.blockchain, verifier, componentID, verificationContext)
+	if verified, _ := result["verified"].(bool); verified {
+		h.emitEvent(c, verifier, result, "component_verified", map[string]interface{}{
+			"component_id": componentID,
+			"verifier":     verifier,
+			"context":      verificationContext,
+			"timestamp":    time.Now().Unix(),
+			"tx_hash":      result["txhash"],
+		})
+	} else if err, ok := result["error"]; ok {
+		h.logger.Warn().Interface("error", err).Str("component_id", componentID).Msg("Automatic verification failed, component registered as unverified")
+	}
+	return result
+}
+
+// verifyRegistered runs the verification backend for a component that has
+// just registered. A failed verification never fails the registration: the
+// component is reported as unverified and can be verified later through
+// POST /component/:id/verify.
+func verifyRegistered(ctx context.Context, client componentVerifier, verifier, componentID, verificationContext string) map[string]interface{} {
+	result, err := client.VerifyComponent(ctx, verifier, componentID, verificationContext)
+	if err != nil {
+		return map[string]interface{}{
+			"component_id": componentID,
+			"verifier":     verifier,
+			"verified":     false,
+			"status":       "unverified",
+			"error":        err.Error(),
+		}
+	}
+	return result
+}
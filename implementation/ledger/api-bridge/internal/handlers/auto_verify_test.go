@@ -0,0 +1,52 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeVerifier answers verifications with status, or fails them when err is set
+type fakeVerifier struct {
+	status string
+	err    error
+	calls  []string
+}
+
+func (f *fakeVerifier) VerifyComponent(ctx context.Context, verifier, componentID, context string) (map[string]interface{}, error) {
+	f.calls = append(f.calls, verifier+":"+componentID)
+	if f.err != nil {
+		return nil, f.err
+	}
+	return map[string]interface{}{
+		"component_id": componentID,
+		"verifier":     verifier,
+		"verified":     f.status == "verified",
+		"status":       f.status,
+		"txhash":       "VERIFYTX",
+	}, nil
+}
+
+func TestVerifyRegisteredRecordsBackendResult(t *testing.T) {
+	backend := &fakeVerifier{status: "verified"}
+
+	result := verifyRegistered(context.Background(), backend, "cosmos1verifier", "MODBATT-MOD-001", "assembly")
+
+	assert.Equal(t, []string{"cosmos1verifier:MODBATT-MOD-001"}, backend.calls)
+	assert.Equal(t, "verified", result["status"])
+	assert.Equal(t, true, result["verified"])
+	assert.Equal(t, "VERIFYTX", result["txhash"])
+}
+
+func TestVerifyRegisteredFallsBackToUnverified(t *testing.T) {
+	backend := &fakeVerifier{err: fmt.Errorf("blockchain transaction failed: connection refused")}
+
+	result := verifyRegistered(context.Background(), backend, "cosmos1verifier", "MODBATT-MOD-001", "assembly")
+
+	assert.Equal(t, "unverified", result["status"])
+	assert.Equal(t, false, result["verified"])
+	assert.Equal(t, "MODBATT-MOD-001", result["component_id"])
+	assert.Contains(t, result["error"], "connection refused")
+}
@@ -0,0 +1,68 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+)
+
+// defaultBatchWorkers bounds how many node queries a batch endpoint runs at
+// once when server.batch_workers is not set
+const defaultBatchWorkers = 8
+
+// fanOut runs query for each id with at most workers queries in flight and
+// hands every outcome to collect. collect runs under a lock, so it may write
+// to shared results without its own. A failed query does not stop the others.
+func fanOut[T any](ctx context.Context, ids []string, workers int, query func(context.Context, string) (T, error), collect func(id string, value T, err error)) {
+	if workers <= 0 {
+		workers = defaultBatchWorkers
+	}
+
+	queue := make(chan string)
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	for range min(workers, len(ids)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for id := range queue {
+				value, err := query(ctx, id)
+				mu.Lock()
+				collect(id, value, err)
+				mu.Unlock()
+			}
+		}()
+	}
+	for _, id := range ids {
+		queue <- id
+	}
+	close(queue)
+	wg.Wait()
+}
+
+// batchIDs drops duplicate ids, which would only repeat a query, and checks
+// the batch holds no empty ID and at most server.max_page_size IDs. field
+// names the request field in errors. It writes a 400 and returns false for a
+// batch it rejects.
+func (h *Handler) batchIDs(c *gin.Context, field string, ids []string) ([]string, bool) {
+	seen := make(map[string]bool, len(ids))
+	unique := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if id == "" {
+			c.JSON(http.StatusBadRequest, gin.H{"error": field + " cannot contain an empty ID"})
+			return nil, false
+		}
+		if !seen[id] {
+			seen[id] = true
+			unique = append(unique, id)
+		}
+	}
+	if maxIDs := h.maxPageSize(); uint64(len(unique)) > maxIDs {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("at most %d %s can be queried at once", maxIDs, field)})
+		return nil, false
+	}
+	return unique, true
+}
@@ -0,0 +1,100 @@
+package handlers
+
+//go:generate mockgen -source=blockchain_client.go -destination=mock_blockchain_client_test.go -package=handlers
+
+import (
+	"context"
+	"time"
+
+	"api-bridge/internal/blockchain"
+)
+
+// BlockchainClient is the chain access handlers need. *blockchain.Client
+// implements it against a node; handler tests use the generated
+// MockBlockchainClient instead.
+type BlockchainClient interface {
+	// Accounts and diagnostics
+	GetAccountManager() *blockchain.AccountManager
+	GetAccountDetails(ctx context.Context, accounts []*blockchain.Account) []blockchain.AccountDetails
+	TestConnection(ctx context.Context) map[string]interface{}
+	Readiness(ctx context.Context) (bool, map[string]blockchain.DependencyStatus)
+	TestIgniteCLI(ctx context.Context) error
+	GetIgnitePath() string
+	GetProjectRoot() string
+	WithTransactionFile(message map[string]interface{}, memo string, fn func(path string) error) error
+	CacheStats() blockchain.CacheStats
+	BreakerStats() blockchain.BreakerStats
+	NodeInfo(ctx context.Context) (*blockchain.NodeInfo, error)
+	CheckConsistency(ctx context.Context) (*blockchain.ConsistencyReport, error)
+
+	// Components
+	RegisterComponent(ctx context.Context, creator, componentData, context string, dedupe bool) (map[string]interface{}, error)
+	GetComponent(ctx context.Context, componentID string) (map[string]interface{}, error)
+	ListComponents(ctx context.Context, limit uint64, key string, countTotal bool) (map[string]interface{}, error)
+	SearchComponents(ctx context.Context, filter blockchain.ComponentSearchFilter, limit uint64, key string) (map[string]interface{}, error)
+	GetPairingRules(ctx context.Context, sourceType string, limit uint64, key string) (map[string]interface{}, error)
+	GetComponentIdentity(ctx context.Context, componentID string) (map[string]interface{}, error)
+	GetComponentHistory(ctx context.Context, componentID string) (map[string]interface{}, error)
+	GetComponentMetadata(ctx context.Context, componentID string) (map[string]interface{}, error)
+	GetCustodyChain(ctx context.Context, componentID string) ([]blockchain.CustodyEvent, error)
+	GetVerificationHistory(ctx context.Context, componentID string, limit uint64, key string) (map[string]interface{}, error)
+	GetComponentRelationships(ctx context.Context, componentID, status string) ([]blockchain.LctSummary, error)
+	VerifyComponent(ctx context.Context, verifier, componentID, context string) (map[string]interface{}, error)
+	GetComponentHealth(ctx context.Context, staleAfter time.Duration) (map[string]interface{}, error)
+	GetRecallScope(ctx context.Context, criteria blockchain.RecallCriteria, includeOwners bool) (*blockchain.RecallScope, error)
+	RegisterAnonymousComponent(ctx context.Context, creator, realComponentID, manufacturerID, componentType, context string) (map[string]interface{}, error)
+	VerifyComponentPairingWithHashes(ctx context.Context, verifier, componentHashA, componentHashB, context string, attestation *blockchain.PairingAttestation) (map[string]interface{}, error)
+	CreateAnonymousPairingAuthorization(ctx context.Context, creator, componentHashA, componentHashB, ruleHash, trustScoreRequirement, authorizationLevel string) (map[string]interface{}, error)
+	GetAnonymousComponentMetadata(ctx context.Context, requester, componentHash string) (map[string]interface{}, error)
+	GetRevocationEvents(ctx context.Context, targetHash string, from, to int64, limit uint64, key string) (map[string]interface{}, error)
+	CreateAnonymousRevocationEvent(ctx context.Context, creator, targetHash, revocationType, urgencyLevel, reasonCategory, context string) (map[string]interface{}, error)
+
+	// Pairing and LCTs
+	InitiatePairing(ctx context.Context, creator, componentA, componentB, operationalContext, proxyID string, forceImmediate bool) (map[string]interface{}, error)
+	CompletePairing(ctx context.Context, creator, challengeID, componentAAuth, componentBAuth, sessionContext string) (map[string]interface{}, error)
+	RevokePairing(ctx context.Context, creator, lctID, reason string, notifyOffline bool) (map[string]interface{}, error)
+	GetPairingStatus(ctx context.Context, challengeID string) (map[string]interface{}, error)
+	CreateLCT(ctx context.Context, creator, componentA, componentB, context, proxyID string) (map[string]interface{}, error)
+	GetLCT(ctx context.Context, lctID string) (map[string]interface{}, error)
+	ListLCTs(ctx context.Context, filter blockchain.LCTFilter, limit uint64, key string) (map[string]interface{}, error)
+	GetLctsByProxy(ctx context.Context, proxyID string, limit uint64, key string) (map[string]interface{}, error)
+	GetKeyExchange(ctx context.Context, lctID string) (map[string]interface{}, error)
+	GetSplitKeyStatus(ctx context.Context, lctID string) (map[string]interface{}, error)
+	GetLctBetween(ctx context.Context, componentA, componentB, operationalContext string) (map[string]interface{}, error)
+	UpdateLCTStatus(ctx context.Context, creator, lctID, status, context string) (map[string]interface{}, error)
+	SuspendLCT(ctx context.Context, creator, lctID, reason string) (map[string]interface{}, error)
+	ResumeLCT(ctx context.Context, creator, lctID, reason string) (map[string]interface{}, error)
+	RotateSplitKey(ctx context.Context, creator, lctID, reason string) (map[string]interface{}, error)
+
+	// Trust tensors
+	CreateTrustTensor(ctx context.Context, creator, componentA, componentB, context string, initialScore float64, dimensions map[string]float64) (map[string]interface{}, error)
+	GetTrustTensor(ctx context.Context, tensorID string) (map[string]interface{}, error)
+	GetTensorHistory(ctx context.Context, tensorID string, from, to int64) (map[string]interface{}, error)
+	UpdateTrustScore(ctx context.Context, creator, tensorID string, score float64, context string) (map[string]interface{}, error)
+	CalculateRelationshipTrust(ctx context.Context, componentA, componentB, operationalContext string) (map[string]interface{}, error)
+	GetRelationshipTensor(ctx context.Context, componentA, componentB string) (map[string]interface{}, error)
+	UpdateTensorScore(ctx context.Context, creator, componentA, componentB string, score float64, context string) (map[string]interface{}, error)
+
+	// Energy
+	CreateEnergyOperation(ctx context.Context, creator, componentA, componentB, operationType string, amount float64, context string) (map[string]interface{}, error)
+	ExecuteEnergyTransfer(ctx context.Context, creator, operationID string, amount float64, energyOut, context string) (map[string]interface{}, error)
+	GetEnergyOperation(ctx context.Context, operationID string) (map[string]interface{}, error)
+	GetOperationEfficiency(ctx context.Context, operationID string) (map[string]interface{}, error)
+	GetEnergyBalance(ctx context.Context, componentID string) (map[string]interface{}, error)
+	GetNetworkEnergyBalance(ctx context.Context, rootComponentID string) (map[string]interface{}, error)
+
+	// Authorization and queues
+	CreatePairingAuthorization(ctx context.Context, componentA, componentB, operationalContext, authorizationRules string) (map[string]interface{}, error)
+	GetComponentAuthorizations(ctx context.Context, componentID string) (map[string]interface{}, error)
+	UpdateAuthorization(ctx context.Context, authorizationID string, updates map[string]interface{}) (map[string]interface{}, error)
+	RevokeAuthorization(ctx context.Context, authorizationID, reason string) (map[string]interface{}, error)
+	CheckPairingAuthorization(ctx context.Context, componentA, componentB, operationalContext string) (map[string]interface{}, error)
+	QueuePairingRequest(ctx context.Context, componentA, componentB, operationalContext, proxyID string) (map[string]interface{}, error)
+	GetQueuedRequests(ctx context.Context, componentID string) (map[string]interface{}, error)
+	ProcessOfflineQueue(ctx context.Context, componentID string) (map[string]interface{}, error)
+	CancelRequest(ctx context.Context, requestID, reason string) (map[string]interface{}, error)
+	GetQueueStatus(ctx context.Context, componentID string) (map[string]interface{}, error)
+	ListProxyQueue(ctx context.Context, proxyID string, filter blockchain.ProxyQueueFilter, limit uint64, key string) (map[string]interface{}, error)
+}
+
+var _ BlockchainClient = (*blockchain.Client)(nil)
@@ -0,0 +1,207 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"api-bridge/internal/blockchain"
+	"api-bridge/internal/config"
+)
+
+// newMockedHandler builds a handler through New on a MockBlockchainClient,
+// so no request reaches the network
+func newMockedHandler(t *testing.T) (*Handler, *MockBlockchainClient) {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+	client := NewMockBlockchainClient(gomock.NewController(t))
+	h, err := New(&config.Config{Blockchain: config.BlockchainConfig{Timeout: 5}}, client, zerolog.Nop())
+	require.NoError(t, err)
+	t.Cleanup(h.Shutdown)
+	return h, client
+}
+
+func serve(router *gin.Engine, method, path, body string) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(w, req)
+	return w
+}
+
+func TestGetEnergyOperationWithMockClient(t *testing.T) {
+	h, client := newMockedHandler(t)
+	router := gin.New()
+	router.GET("/energy/operation/:id", h.GetEnergyOperation)
+
+	client.EXPECT().GetEnergyOperation(gomock.Any(), "op-1").
+		Return(map[string]interface{}{"operation_id": "op-1", "status": "completed"}, nil)
+	w := serve(router, http.MethodGet, "/energy/operation/op-1", "")
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"operation_id": "op-1", "status": "completed"}`, w.Body.String())
+
+	client.EXPECT().GetEnergyOperation(gomock.Any(), "op-missing").
+		Return(nil, fmt.Errorf("%w: op-missing", blockchain.ErrEnergyOperationNotFound))
+	w = serve(router, http.MethodGet, "/energy/operation/op-missing", "")
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.Contains(t, w.Body.String(), `"operation_id":"op-missing"`)
+}
+
+func TestRegisterComponentReportsSchemaFields(t *testing.T) {
+	h, client := newMockedHandler(t)
+	router := gin.New()
+	router.POST("/components/register", h.RegisterComponent)
+
+	fields := []blockchain.FieldError{{Field: "model", Message: "is required"}}
+	client.EXPECT().RegisterComponent(gomock.Any(), "alice", `{"capacity_kwh": 5}`, "", false).
+		Return(nil, &blockchain.ComponentDataError{ComponentType: "module", Fields: fields})
+	w := serve(router, http.MethodPost, "/components/register", `{"creator": "alice", "component_data": "{\"capacity_kwh\": 5}"}`)
+	require.Equal(t, http.StatusBadRequest, w.Code)
+	var rejected struct {
+		ComponentType string                  `json:"component_type"`
+		Fields        []blockchain.FieldError `json:"fields"`
+	}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &rejected))
+	assert.Equal(t, "module", rejected.ComponentType)
+	assert.Equal(t, fields, rejected.Fields)
+}
+
+func TestCreateLCTWithMockClient(t *testing.T) {
+	h, client := newMockedHandler(t)
+	router := gin.New()
+	router.POST("/lct/create", h.CreateLCT)
+
+	// The deprecated context field is passed on as the operational context
+	client.EXPECT().CreateLCT(gomock.Any(), "alice", "comp-a", "comp-b", "race_day", "").
+		Return(map[string]interface{}{"lct_id": "lct-comp-a-comp-b-1", "operational_context": "race_day"}, nil)
+	w := serve(router, http.MethodPost, "/lct/create", `{"creator": "alice", "component_a": "comp-a", "component_b": "comp-b", "context": "race_day"}`)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+	client.EXPECT().CreateLCT(gomock.Any(), "alice", "comp-a", "comp-b", "race/day", "").
+		Return(nil, fmt.Errorf("%w: %q", blockchain.ErrInvalidOperationalContext, "race/day"))
+	w = serve(router, http.MethodPost, "/lct/create", `{"creator": "alice", "component_a": "comp-a", "component_b": "comp-b", "operational_context": "race/day"}`)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	var body map[string]interface{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	assert.Equal(t, "operational_context", body["field"])
+
+	client.EXPECT().CreateLCT(gomock.Any(), "alice", "comp-a", "comp-missing", "", "").
+		Return(nil, fmt.Errorf("%w: comp-missing", blockchain.ErrComponentNotFound))
+	w = serve(router, http.MethodPost, "/lct/create", `{"creator": "alice", "component_a": "comp-a", "component_b": "comp-missing"}`)
+	assert.Equal(t, http.StatusNotFound, w.Code)
+
+	client.EXPECT().CreateLCT(gomock.Any(), "alice", "comp-a", "comp-retired", "", "").
+		Return(nil, fmt.Errorf("%w: comp-retired", blockchain.ErrComponentInactive))
+	w = serve(router, http.MethodPost, "/lct/create", `{"creator": "alice", "component_a": "comp-a", "component_b": "comp-retired"}`)
+	assert.Equal(t, http.StatusConflict, w.Code)
+
+	// Requests failing validation never reach the chain
+	w = serve(router, http.MethodPost, "/lct/create", `{"creator": "alice"}`)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestExecuteEnergyTransferWithMockClient(t *testing.T) {
+	h, client := newMockedHandler(t)
+	router := gin.New()
+	router.POST("/energy/transfer", h.ExecuteEnergyTransfer)
+
+	client.EXPECT().ExecuteEnergyTransfer(gomock.Any(), "alice", "op-1", 50.0, "", "").
+		Return(nil, fmt.Errorf("%w: lct-a holds 10", blockchain.ErrInsufficientEnergyBalance))
+	w := serve(router, http.MethodPost, "/energy/transfer", `{"creator": "alice", "operation_id": "op-1", "amount": 50}`)
+	assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+
+	client.EXPECT().ExecuteEnergyTransfer(gomock.Any(), "alice", "op-2", 50.0, "", "").
+		Return(nil, fmt.Errorf("%w: TX2 not in a block after 30s", blockchain.ErrTxNotCommitted))
+	w = serve(router, http.MethodPost, "/energy/transfer", `{"creator": "alice", "operation_id": "op-2", "amount": 50}`)
+	assert.Equal(t, http.StatusGatewayTimeout, w.Code)
+	assert.Contains(t, w.Body.String(), `"code":"TX_NOT_COMMITTED"`)
+}
+
+func TestReadinessWithMockClient(t *testing.T) {
+	h, client := newMockedHandler(t)
+	router := gin.New()
+	router.GET("/ready", h.Readiness)
+
+	client.EXPECT().Readiness(gomock.Any()).Return(false, map[string]blockchain.DependencyStatus{
+		"blockchain": {Status: blockchain.DependencyDown, Error: "connection refused"},
+	})
+	w := serve(router, http.MethodGet, "/ready", "")
+	assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+	assert.Contains(t, w.Body.String(), `"status":"not_ready"`)
+}
+
+func TestWritesFailFastWhileChainUnavailable(t *testing.T) {
+	h, client := newMockedHandler(t)
+	router := gin.New()
+	router.POST("/lct/create", h.CreateLCT)
+
+	client.EXPECT().CreateLCT(gomock.Any(), "alice", "comp-a", "comp-b", "", "").
+		Return(nil, fmt.Errorf("%w: 5 consecutive broadcasts failed to reach the node", blockchain.ErrChainUnavailable))
+	w := serve(router, http.MethodPost, "/lct/create", `{"creator": "alice", "component_a": "comp-a", "component_b": "comp-b"}`)
+	assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+	var body map[string]interface{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	assert.Equal(t, "CHAIN_UNAVAILABLE", body["code"])
+}
+
+func TestBroadcastOutlastsWriteTimeout(t *testing.T) {
+	h, client := newMockedHandler(t)
+	h.config.Server.WriteTimeout = 1
+	router := gin.New()
+	router.POST("/lct/create", h.CreateLCT)
+	server := httptest.NewUnstartedServer(router)
+	server.Config.WriteTimeout = time.Second
+	server.Start()
+	defer server.Close()
+
+	// The commit wait runs past write_timeout, yet the response still arrives
+	client.EXPECT().CreateLCT(gomock.Any(), "alice", "comp-a", "comp-b", "", "").
+		DoAndReturn(func(context.Context, string, string, string, string, string) (map[string]interface{}, error) {
+			time.Sleep(1500 * time.Millisecond)
+			return map[string]interface{}{"lct_id": "lct-1"}, nil
+		})
+	resp, err := server.Client().Post(server.URL+"/lct/create", "application/json",
+		strings.NewReader(`{"creator": "alice", "component_a": "comp-a", "component_b": "comp-b"}`))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
+func TestVersionReportsBuildChainAndNode(t *testing.T) {
+	h, client := newMockedHandler(t)
+	h.config.Blockchain.RESTEndpoint = "http://node:1317"
+	h.config.Blockchain.GRPCEndpoint = "node:9090"
+	h.config.Blockchain.ChainID = "racecarweb"
+	router := gin.New()
+	router.GET("/version", h.Version)
+
+	client.EXPECT().NodeInfo(gomock.Any()).Return(&blockchain.NodeInfo{Network: "racecarweb", AppVersion: "v0.3.1"}, nil)
+	w := serve(router, http.MethodGet, "/version", "")
+	require.Equal(t, http.StatusOK, w.Code)
+	var body map[string]interface{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	assert.Equal(t, "dev", body["bridge"].(map[string]interface{})["version"])
+	// The node's address stays out of a public response
+	assert.Equal(t, map[string]interface{}{"chain_id": "racecarweb"}, body["chain"])
+	assert.NotContains(t, w.Body.String(), "node:1317")
+	assert.Equal(t, "v0.3.1", body["node"].(map[string]interface{})["app_version"])
+
+	// An unreachable node still leaves the bridge's own details
+	client.EXPECT().NodeInfo(gomock.Any()).Return(nil, fmt.Errorf("dial tcp node:1317: connection refused"))
+	w = serve(router, http.MethodGet, "/version", "")
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"node_error":"node unreachable"`)
+	assert.NotContains(t, w.Body.String(), "node:1317")
+	assert.Contains(t, w.Body.String(), `"chain_id":"racecarweb"`)
+}
@@ -0,0 +1,59 @@
+//go:build mockchain
+
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"api-bridge/internal/blockchain"
+	"api-bridge/internal/config"
+)
+
+func TestCompletePairingReturnsNoKeyMaterial(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	client := blockchain.NewMockClient(zerolog.Nop())
+	ctx := context.Background()
+	for i := 0; i < 2; i++ {
+		_, err := client.RegisterComponent(ctx, "alice", "data", "", false)
+		require.NoError(t, err)
+	}
+	challenge, err := client.InitiatePairing(ctx, "alice", "COMP-MOCK-0001", "COMP-MOCK-0002", "race_day", "", false)
+	require.NoError(t, err)
+
+	h := &Handler{
+		config:     &config.Config{Blockchain: config.BlockchainConfig{Timeout: 5}},
+		logger:     zerolog.Nop(),
+		blockchain: client,
+	}
+	router := gin.New()
+	router.POST("/api/v1/pairing/complete", h.CompletePairing)
+
+	body, err := json.Marshal(map[string]string{
+		"creator":          "alice",
+		"challenge_id":     challenge["challenge_id"].(string),
+		"component_a_auth": "auth-a",
+		"component_b_auth": "auth-b",
+	})
+	require.NoError(t, err)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/api/v1/pairing/complete", bytes.NewReader(body)))
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+	// The response carries the key's reference, never its halves
+	var resp map[string]interface{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	assert.NotEmpty(t, resp["key_reference"])
+	assert.NotContains(t, resp, "split_key_a")
+	assert.NotContains(t, resp, "split_key_b")
+	assert.NotContains(t, w.Body.String(), "split_key")
+}
@@ -0,0 +1,36 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"api-bridge/internal/blockchain"
+)
+
+func TestGetComponentMetadata(t *testing.T) {
+	h, client := newMockedHandler(t)
+	router := gin.New()
+	router.GET("/components/:id/metadata", h.GetComponentMetadata)
+
+	// An unavailable backend still answers with the on-chain fields
+	client.EXPECT().GetComponentMetadata(gomock.Any(), "MODBATT-MOD-001").
+		Return(map[string]interface{}{
+			"component_id":   "MODBATT-MOD-001",
+			"on_chain":       map[string]interface{}{"component_id": "MODBATT-MOD-001", "status": "active"},
+			"backend":        map[string]interface{}{},
+			"backend_status": "unavailable",
+		}, nil)
+	w := serve(router, http.MethodGet, "/components/MODBATT-MOD-001/metadata", "")
+	assert.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	assert.Contains(t, w.Body.String(), `"backend_status":"unavailable"`)
+
+	client.EXPECT().GetComponentMetadata(gomock.Any(), "MODBATT-MOD-404").
+		Return(nil, fmt.Errorf("%w: MODBATT-MOD-404", blockchain.ErrComponentNotFound))
+	w = serve(router, http.MethodGet, "/components/MODBATT-MOD-404/metadata", "")
+	assert.Equal(t, http.StatusNotFound, w.Code, w.Body.String())
+}
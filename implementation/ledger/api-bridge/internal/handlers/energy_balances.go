@@ -0,0 +1,66 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// energyBalanceFetcher is the subset of the blockchain client used by the bulk balance query
+type energyBalanceFetcher interface {
+	GetEnergyBalance(ctx context.Context, componentID string) (map[string]interface{}, error)
+}
+
+// EnergyBalances is the response of POST /energy/balances. Every requested
+// component appears in exactly one of the two maps.
+type EnergyBalances struct {
+	Balances map[string]map[string]interface{} `json:"balances"`
+	Errors   map[string]string                 `json:"errors"`
+}
+
+// fetchEnergyBalances queries the balance of each component with at most
+// workers queries in flight. A failed query is reported under Errors and does
+// not stop the others.
+func fetchEnergyBalances(ctx context.Context, client energyBalanceFetcher, componentIDs []string, workers int) EnergyBalances {
+	result := EnergyBalances{
+		Balances: make(map[string]map[string]interface{}, len(componentIDs)),
+		Errors:   make(map[string]string),
+	}
+
+	fanOut(ctx, componentIDs, workers, client.GetEnergyBalance, func(id string, balance map[string]interface{}, err error) {
+		if err != nil {
+			result.Errors[id] = err.Error()
+		} else {
+			result.Balances[id] = balance
+		}
+	})
+	return result
+}
+
+// GetEnergyBalances returns the energy balances of up to server.max_page_size
+// components in one request, querying the node concurrently
+func (h *Handler) GetEnergyBalances(c *gin.Context) {
+	var req struct {
+		ComponentIDs []string `json:"component_ids" binding:"required,min=1"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	componentIDs, ok := h.batchIDs(c, "component_ids", req.ComponentIDs)
+	if !ok {
+		return
+	}
+
+	ctx, cancel := h.queryContext(c)
+	defer cancel()
+
+	balances := fetchEnergyBalances(ctx, h.blockchain, componentIDs, h.config.Server.BatchWorkers)
+	for id, err := range balances.Errors {
+		h.logger.Warn().Str("component_id", id).Str("error", err).Msg("Failed to get energy balance")
+	}
+
+	c.JSON(http.StatusOK, balances)
+}
@@ -0,0 +1,73 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// slowBalances answers after a delay and records the most queries in flight
+type slowBalances struct {
+	inFlight, peak atomic.Int32
+}
+
+func (s *slowBalances) GetEnergyBalance(ctx context.Context, componentID string) (map[string]interface{}, error) {
+	n := s.inFlight.Add(1)
+	defer s.inFlight.Add(-1)
+	for {
+		peak := s.peak.Load()
+		if n <= peak || s.peak.CompareAndSwap(peak, n) {
+			break
+		}
+	}
+	time.Sleep(5 * time.Millisecond)
+	if componentID == "MOD-404" {
+		return nil, errors.New("failed to get energy balance: not found")
+	}
+	return map[string]interface{}{"atp_balance": componentID}, nil
+}
+
+func TestFetchEnergyBalancesBoundsConcurrency(t *testing.T) {
+	client := &slowBalances{}
+	ids := []string{"MOD-1", "MOD-2", "MOD-3", "MOD-404", "MOD-5", "MOD-6", "MOD-7", "MOD-8", "MOD-9", "MOD-10"}
+
+	result := fetchEnergyBalances(context.Background(), client, ids, 3)
+	assert.Len(t, result.Balances, 9)
+	assert.Equal(t, map[string]interface{}{"atp_balance": "MOD-7"}, result.Balances["MOD-7"])
+	assert.Equal(t, map[string]string{"MOD-404": "failed to get energy balance: not found"}, result.Errors)
+	assert.Equal(t, int32(3), client.peak.Load())
+}
+
+func TestGetEnergyBalances(t *testing.T) {
+	h, client := newMockedHandler(t)
+	router := gin.New()
+	router.POST("/energy/balances", h.GetEnergyBalances)
+
+	client.EXPECT().GetEnergyBalance(gomock.Any(), "MOD-1").Return(map[string]interface{}{"atp_balance": "40"}, nil)
+	client.EXPECT().GetEnergyBalance(gomock.Any(), "MOD-404").Return(nil, errors.New("failed to get energy balance: not found"))
+	w := serve(router, http.MethodPost, "/energy/balances", `{"component_ids": ["MOD-1", "MOD-404", "MOD-1"]}`)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+	var body EnergyBalances
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	assert.Equal(t, map[string]map[string]interface{}{"MOD-1": {"atp_balance": "40"}}, body.Balances)
+	assert.Equal(t, map[string]string{"MOD-404": "failed to get energy balance: not found"}, body.Errors)
+
+	// Rejected without querying the chain
+	for _, request := range []string{`{}`, `{"component_ids": []}`, `{"component_ids": [""]}`} {
+		w = serve(router, http.MethodPost, "/energy/balances", request)
+		assert.Equal(t, http.StatusBadRequest, w.Code, request)
+	}
+	h.config.Server.MaxPageSize = 2
+	w = serve(router, http.MethodPost, "/energy/balances", `{"component_ids": ["MOD-1", "MOD-2", "MOD-3"]}`)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
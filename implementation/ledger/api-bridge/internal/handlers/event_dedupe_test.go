@@ -0,0 +1,68 @@
+package handlers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"api-bridge/internal/events"
+)
+
+func requestWithIdempotencyKey(key string) *gin.Context {
+	c, _ := gin.CreateTestContext(httptest.NewRecorder())
+	c.Request = httptest.NewRequest(http.MethodPost, "/api/v1/components/register", nil)
+	if key != "" {
+		c.Request.Header.Set("Idempotency-Key", key)
+	}
+	return c
+}
+
+func TestReplayedIdempotentRequestDoesNotReEmitEvent(t *testing.T) {
+	delivered := make(chan struct{}, 10)
+	sink := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		delivered <- struct{}{}
+	}))
+	defer sink.Close()
+
+	h := &Handler{
+		logger:     zerolog.Nop(),
+		eventQueue: events.NewEventQueue(map[string][]string{"component_registered": {sink.URL}}, 1, time.Millisecond, time.Hour, zerolog.Nop()),
+	}
+	defer h.eventQueue.Shutdown()
+
+	eventData := map[string]interface{}{"component_id": "MODBATT-MOD-001"}
+
+	// The client retries after a timeout; the retry is broadcast again and
+	// comes back with a different tx hash but the same Idempotency-Key
+	h.emitEvent(requestWithIdempotencyKey("reg-001"), "cosmos1racecar", map[string]interface{}{"txhash": "TX1"}, "component_registered", eventData)
+	h.emitEvent(requestWithIdempotencyKey("reg-001"), "cosmos1racecar", map[string]interface{}{"txhash": "TX2"}, "component_registered", eventData)
+
+	select {
+	case <-delivered:
+	case <-time.After(2 * time.Second):
+		t.Fatal("event was not delivered")
+	}
+	select {
+	case <-delivered:
+		t.Fatal("replayed request emitted the event again")
+	case <-time.After(200 * time.Millisecond):
+	}
+}
+
+func TestOperationKey(t *testing.T) {
+	resp := map[string]interface{}{"txhash": "ABC"}
+
+	// Idempotency keys are scoped per creator
+	keyA := operationKey(requestWithIdempotencyKey("reg-001"), "cosmos1alice", resp)
+	keyB := operationKey(requestWithIdempotencyKey("reg-001"), "cosmos1bob", resp)
+	require.NotEqual(t, keyA, keyB)
+
+	assert.Equal(t, "tx:ABC", operationKey(requestWithIdempotencyKey(""), "cosmos1alice", resp))
+	assert.Empty(t, operationKey(requestWithIdempotencyKey(""), "cosmos1alice", nil))
+}
@@ -2,15 +2,23 @@ package handlers
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"os"
 	"os/exec"
+	"strconv"
 	"time"
 
+	"api-bridge/internal/auth"
 	"api-bridge/internal/blockchain"
 	"api-bridge/internal/config"
 	"api-bridge/internal/events"
+	"api-bridge/internal/verification"
+	"api-bridge/internal/version"
+	lctmanagertypes "racecar-web/x/lctmanager/types"
+	pairingqueuetypes "racecar-web/x/pairingqueue/types"
 
 	"github.com/gin-gonic/gin"
 	"github.com/gorilla/websocket"
@@ -21,41 +29,228 @@ import (
 type Handler struct {
 	config     *config.Config
 	logger     zerolog.Logger
-	blockchain *blockchain.Client
+	blockchain BlockchainClient
 	upgrader   websocket.Upgrader
+	wsOrigins  websocketOrigins
 	eventQueue *events.EventQueue
+
+	// Responses to keyed write requests, replayed when a client retries
+	idempotency *idempotencyCache
+
+	// Recent writes per creator, served by GET /accounts/:name/operations
+	operations *operationLog
+
+	// Emits block_produced for each committed block; nil unless events.block_watcher is enabled
+	blockWatcher *blockchain.BlockWatcher
+
+	// Manufacturer service whose verdicts are attested on chain; nil leaves
+	// hash pairing verification to the chain
+	pairingVerifier *verification.Service
 }
 
-// New creates a new handler instance
-func New(cfg *config.Config, logger zerolog.Logger) (*Handler, error) {
-	// Create blockchain client using REST endpoint
-	bcClient, err := blockchain.NewClient(cfg.Blockchain.RESTEndpoint, logger)
+// New creates a new handler instance serving requests through bcClient
+func New(cfg *config.Config, bcClient BlockchainClient, logger zerolog.Logger) (*Handler, error) {
+	// Create WebSocket upgrader; the API key subprotocol is echoed back as
+	// browsers close connections whose offered subprotocols were all ignored
+	wsOrigins, err := newWebSocketOrigins(cfg.Server.WebSocket.AllowedOrigins)
 	if err != nil {
 		return nil, err
 	}
-
-	// Create WebSocket upgrader
 	upgrader := websocket.Upgrader{
-		CheckOrigin: func(r *http.Request) bool {
-			return true // Allow all origins for now
-		},
+		CheckOrigin:  wsOrigins.allow,
+		Subprotocols: []string{auth.WebSocketAPIKeyProtocol},
+	}
+
+	signingSecrets, err := cfg.Events.SigningSecrets()
+	if err != nil {
+		return nil, err
+	}
+
+	// The event queue always feeds WebSocket subscribers; webhooks only when enabled
+	eventQueue := events.NewEventQueue(eventSinks(cfg.Events), cfg.Events.MaxRetries, time.Duration(cfg.Events.RetryDelay)*time.Second, time.Duration(cfg.Events.DedupeWindow)*time.Second, logger)
+	eventQueue.SetPayloadLimit(cfg.Events.MaxAttributeBytes, cfg.Events.PayloadCacheSize)
+	eventQueue.SetSigningSecrets(signingSecrets)
+	defaultRetry, endpointRetries, err := retryPolicies(cfg.Events)
+	if err != nil {
+		return nil, err
+	}
+	eventQueue.SetRetryPolicies(defaultRetry, endpointRetries)
+	eventQueue.SetDeadLetterLimit(cfg.Events.DeadLetterLimit)
+	if cfg.Events.DataDir != "" {
+		if err := eventQueue.Persist(cfg.Events.DataDir); err != nil {
+			return nil, fmt.Errorf("failed to open event store: %w", err)
+		}
 	}
 
-	// Create event queue if enabled
-	var eventQueue *events.EventQueue
-	if cfg.Events.Enabled {
-		eventQueue = events.NewEventQueue(cfg.Events.Endpoints, cfg.Events.MaxRetries, time.Duration(cfg.Events.RetryDelay)*time.Second, logger)
+	var pairingVerifier *verification.Service
+	if cfg.Verification.Manufacturer.URL != "" {
+		if pairingVerifier, err = verification.New(cfg.Verification.Manufacturer, nil); err != nil {
+			return nil, err
+		}
+	}
+
+	// The in-memory chain commits no blocks to watch
+	var blockWatcher *blockchain.BlockWatcher
+	if cfg.Events.BlockWatcher.Enabled && !cfg.Blockchain.Mock {
+		blockWatcher = blockchain.NewBlockWatcher(cfg.Events.BlockWatcher, func(block blockchain.Block) {
+			eventQueue.Emit("block_produced", map[string]interface{}{
+				"height":   block.Height,
+				"time":     block.Time,
+				"tx_count": block.TxCount,
+			})
+		}, logger)
+		blockWatcher.Start()
 	}
 
+	idempotency := newIdempotencyCache(time.Duration(cfg.Server.IdempotencyTTL) * time.Second)
+	idempotency.startPruning(idempotencyPruneInterval)
+
 	return &Handler{
-		config:     cfg,
-		logger:     logger,
-		blockchain: bcClient,
-		upgrader:   upgrader,
-		eventQueue: eventQueue,
+		config:      cfg,
+		logger:      logger,
+		blockchain:  bcClient,
+		upgrader:    upgrader,
+		wsOrigins:   wsOrigins,
+		eventQueue:  eventQueue,
+		idempotency: idempotency,
+		operations:  newOperationLog(cfg.Server.OperationLogSize, cfg.Server.OperationLogCreators),
+
+		blockWatcher:    blockWatcher,
+		pairingVerifier: pairingVerifier,
 	}, nil
 }
 
+// retryPolicies builds the webhook redelivery schedules from events.retry and
+// the retry of each webhook that sets its own
+func retryPolicies(cfg config.EventsConfig) (events.RetryPolicy, map[string]events.RetryPolicy, error) {
+	policy := func(retry config.WebhookRetryConfig) (events.RetryPolicy, error) {
+		initial := retry.InitialBackoff
+		if initial == 0 {
+			initial = time.Duration(cfg.RetryDelay) * time.Second
+		}
+		return events.NewRetryPolicy(retry.Policy, initial, retry.MaxBackoff, retry.Multiplier, retry.Jitter)
+	}
+
+	defaultPolicy, err := policy(cfg.Retry)
+	if err != nil {
+		return events.RetryPolicy{}, nil, fmt.Errorf("events.retry: %w", err)
+	}
+	endpoints := make(map[string]events.RetryPolicy)
+	for _, webhook := range cfg.Webhooks {
+		if webhook.URL == "" || webhook.Retry == nil {
+			continue
+		}
+		if endpoints[webhook.URL], err = policy(*webhook.Retry); err != nil {
+			return events.RetryPolicy{}, nil, fmt.Errorf("events.webhooks %s retry: %w", webhook.URL, err)
+		}
+	}
+	return defaultPolicy, endpoints, nil
+}
+
+// eventSinks returns the webhook endpoints of each event type, or none when
+// events are disabled
+func eventSinks(cfg config.EventsConfig) map[string][]string {
+	if !cfg.Enabled {
+		return nil
+	}
+	return cfg.Sinks()
+}
+
+// ReloadEvents applies new webhook endpoints, signing secrets and retry
+// schedules to the event queue. Nothing changes if a schedule is invalid or a
+// signing secret is missing.
+func (h *Handler) ReloadEvents(cfg config.EventsConfig) error {
+	defaultRetry, endpointRetries, err := retryPolicies(cfg)
+	if err != nil {
+		return err
+	}
+	signingSecrets, err := cfg.SigningSecrets()
+	if err != nil {
+		return err
+	}
+	h.eventQueue.SetSinks(eventSinks(cfg))
+	h.eventQueue.SetSigningSecrets(signingSecrets)
+	h.eventQueue.SetRetryPolicies(defaultRetry, endpointRetries)
+	return nil
+}
+
+// emitEvent queues an event once per logical operation rather than once per
+// request, so a retried request does not notify the sinks twice
+func (h *Handler) emitEvent(c *gin.Context, creator string, resp map[string]interface{}, eventType string, eventData map[string]interface{}) {
+	if h.eventQueue == nil {
+		return
+	}
+	h.eventQueue.EmitOnce(operationKey(c, creator, resp), eventType, eventData)
+}
+
+// operationKey identifies the logical operation behind a request. A client
+// supplied Idempotency-Key, scoped to the creator, wins; otherwise the
+// broadcast transaction hash is used.
+func operationKey(c *gin.Context, creator string, resp map[string]interface{}) string {
+	if key := c.GetHeader("Idempotency-Key"); key != "" {
+		return "idempotency:" + creator + ":" + key
+	}
+	if txHash, ok := resp["txhash"].(string); ok && txHash != "" {
+		return "tx:" + txHash
+	}
+	return ""
+}
+
+// queryContext bounds a request that only reads chain state
+func (h *Handler) queryContext(c *gin.Context) (context.Context, context.CancelFunc) {
+	return context.WithTimeout(c.Request.Context(), h.config.Blockchain.QueryTimeoutDuration())
+}
+
+// broadcastContext bounds a request that writes, which can take several
+// broadcast attempts and a gas simulation for each
+func (h *Handler) broadcastContext(c *gin.Context) (context.Context, context.CancelFunc) {
+	timeout := h.config.Blockchain.BroadcastTimeoutDuration()
+	h.extendWriteDeadline(c, timeout)
+	return context.WithTimeout(c.Request.Context(), timeout)
+}
+
+// writeDeadlineGrace is the time left to write a response once the request's
+// own timeout has passed
+const writeDeadlineGrace = 5 * time.Second
+
+// extendWriteDeadline moves the connection's write deadline past timeout when
+// server.write_timeout would otherwise close it first. A broadcast that waits
+// for its commit can outlast write_timeout, and the client would get a dropped
+// connection instead of the 504 or the late success.
+func (h *Handler) extendWriteDeadline(c *gin.Context, timeout time.Duration) {
+	writeTimeout := time.Duration(h.config.Server.WriteTimeout) * time.Second
+	if writeTimeout <= 0 || timeout+writeDeadlineGrace <= writeTimeout {
+		return
+	}
+	rc := http.NewResponseController(c.Writer)
+	if err := rc.SetWriteDeadline(time.Now().Add(timeout + writeDeadlineGrace)); err != nil {
+		h.logger.Debug().Err(err).Msg("Failed to extend the write deadline")
+	}
+}
+
+// chainUnavailable answers 503 CHAIN_UNAVAILABLE when err is the broadcast
+// circuit breaker refusing a transaction, and reports whether it did
+func (h *Handler) chainUnavailable(c *gin.Context, err error) bool {
+	if !errors.Is(err, blockchain.ErrChainUnavailable) {
+		return false
+	}
+	h.logger.Warn().Err(err).Str("path", c.FullPath()).Msg("Refusing write while the chain is unavailable")
+	c.JSON(http.StatusServiceUnavailable, gin.H{"error": err.Error(), "code": "CHAIN_UNAVAILABLE"})
+	return true
+}
+
+// txNotCommitted answers 504 TX_NOT_COMMITTED when err is a broadcast
+// transaction that was not included in a block within
+// blockchain.commit_timeout, and reports whether it did
+func (h *Handler) txNotCommitted(c *gin.Context, err error) bool {
+	if !errors.Is(err, blockchain.ErrTxNotCommitted) {
+		return false
+	}
+	h.logger.Warn().Err(err).Str("path", c.FullPath()).Msg("Transaction was not committed in time")
+	c.JSON(http.StatusGatewayTimeout, gin.H{"error": err.Error(), "code": "TX_NOT_COMMITTED"})
+	return true
+}
+
 // HealthCheck handles health check requests
 func (h *Handler) HealthCheck(c *gin.Context) {
 	c.JSON(http.StatusOK, gin.H{
@@ -65,6 +260,26 @@ func (h *Handler) HealthCheck(c *gin.Context) {
 	})
 }
 
+// Readiness reports whether the bridge can serve requests, checking the
+// dependencies HealthCheck does not. It returns 503 while any is down.
+func (h *Handler) Readiness(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Second)
+	defer cancel()
+
+	ready, dependencies := h.blockchain.Readiness(ctx)
+
+	status, code := "ready", http.StatusOK
+	if !ready {
+		status, code = "not_ready", http.StatusServiceUnavailable
+	}
+	c.JSON(code, gin.H{
+		"status":       status,
+		"dependencies": dependencies,
+		"timestamp":    time.Now().Unix(),
+		"service":      "api-bridge",
+	})
+}
+
 // BlockchainStatus handles blockchain connection status requests
 func (h *Handler) BlockchainStatus(c *gin.Context) {
 	ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Second)
@@ -80,12 +295,54 @@ func (h *Handler) BlockchainStatus(c *gin.Context) {
 	})
 }
 
+// Metrics reports bridge counters: the query cache's hits and misses and the
+// broadcast circuit breaker's state
+func (h *Handler) Metrics(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{
+		"query_cache":       h.blockchain.CacheStats(),
+		"broadcast_breaker": h.blockchain.BreakerStats(),
+		"dead_letters":      h.eventQueue.DeadLetterStats(),
+		"timestamp":         time.Now().Unix(),
+		"service":           "api-bridge",
+	})
+}
+
+// Version reports which bridge build is running, the chain it is configured
+// for and the versions the node reports. The bridge's own details are
+// returned even when the node cannot be reached. The route is public, so
+// neither the node's address nor its connection errors are included.
+func (h *Handler) Version(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Second)
+	defer cancel()
+
+	response := gin.H{
+		"bridge": gin.H{
+			"version":    version.Version,
+			"commit":     version.Commit,
+			"build_date": version.BuildDate,
+		},
+		"chain": gin.H{
+			"chain_id": h.config.Blockchain.ChainID,
+		},
+		"timestamp": time.Now().Unix(),
+		"service":   "api-bridge",
+	}
+	if node, err := h.blockchain.NodeInfo(ctx); err != nil {
+		h.logger.Warn().Err(err).Msg("Failed to query node info")
+		response["node_error"] = "node unreachable"
+	} else {
+		response["node"] = node
+	}
+	c.JSON(http.StatusOK, response)
+}
+
 // RegisterComponent handles component registration
 func (h *Handler) RegisterComponent(c *gin.Context) {
 	var req struct {
 		Creator       string `json:"creator" binding:"required"`
 		ComponentData string `json:"component_data" binding:"required"`
 		Context       string `json:"context"`
+		Dedupe        bool   `json:"dedupe"` // reuse a component already registered with the same data
 	}
 
 	if err := c.ShouldBindJSON(&req); err != nil {
@@ -93,10 +350,29 @@ func (h *Handler) RegisterComponent(c *gin.Context) {
 		return
 	}
 
-	ctx, cancel := context.WithTimeout(c.Request.Context(), time.Duration(h.config.Blockchain.Timeout)*time.Second)
+	idem, handled := h.beginIdempotent(c, req.Creator, req)
+	if handled {
+		return
+	}
+	defer idem.release()
+
+	ctx, cancel := h.broadcastContext(c)
 	defer cancel()
 
-	resp, err := h.blockchain.RegisterComponent(ctx, req.Creator, req.ComponentData, req.Context)
+	resp, err := h.blockchain.RegisterComponent(ctx, req.Creator, req.ComponentData, req.Context, req.Dedupe)
+	h.recordOperation(req.Creator, "register_component", operationTargets(resp["component_id"]), resp, err)
+	if h.chainUnavailable(c, err) {
+		return
+	}
+	var dataErr *blockchain.ComponentDataError
+	if errors.As(err, &dataErr) {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":          blockchain.ErrInvalidComponentData.Error(),
+			"component_type": dataErr.ComponentType,
+			"fields":         dataErr.Fields,
+		})
+		return
+	}
 	if err != nil {
 		h.logger.Error().Err(err).Str("creator", req.Creator).Msg("Failed to register component")
 		c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("Failed to register component: %v", err)})
@@ -113,9 +389,15 @@ func (h *Handler) RegisterComponent(c *gin.Context) {
 			"timestamp":      time.Now().Unix(),
 			"tx_hash":        resp["txhash"],
 		}
-		h.eventQueue.Emit("component_registered", eventData)
+		h.emitEvent(c, req.Creator, resp, "component_registered", eventData)
+	}
+
+	if h.config.Verification.AutoVerify {
+		componentID, _ := resp["component_id"].(string)
+		resp["verification"] = h.autoVerify(ctx, c, req.Creator, componentID, req.Context)
 	}
 
+	idem.complete(http.StatusOK, resp)
 	c.JSON(http.StatusOK, resp)
 }
 
@@ -127,7 +409,7 @@ func (h *Handler) GetComponent(c *gin.Context) {
 		return
 	}
 
-	ctx, cancel := context.WithTimeout(c.Request.Context(), time.Duration(h.config.Blockchain.Timeout)*time.Second)
+	ctx, cancel := h.queryContext(c)
 	defer cancel()
 
 	component, err := h.blockchain.GetComponent(ctx, componentID)
@@ -140,6 +422,77 @@ func (h *Handler) GetComponent(c *gin.Context) {
 	c.JSON(http.StatusOK, component)
 }
 
+// ListComponents handles paginated component listing
+func (h *Handler) ListComponents(c *gin.Context) {
+	limit, ok := h.pageLimit(c, 0)
+	if !ok {
+		return
+	}
+
+	key, ok := pageKey(c)
+	if !ok {
+		return
+	}
+
+	countTotal := false
+	if raw := c.Query("count_total"); raw != "" {
+		parsed, err := strconv.ParseBool(raw)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "count_total must be true or false"})
+			return
+		}
+		countTotal = parsed
+	}
+
+	ctx, cancel := h.queryContext(c)
+	defer cancel()
+
+	page, err := h.blockchain.ListComponents(ctx, limit, key, countTotal)
+	if err != nil {
+		h.logger.Error().Err(err).Msg("Failed to list components")
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to list components"})
+		return
+	}
+
+	c.JSON(http.StatusOK, page)
+}
+
+// SearchComponents handles paginated search of anonymous components by
+// manufacturer hash, category hash and status
+func (h *Handler) SearchComponents(c *gin.Context) {
+	filter := blockchain.ComponentSearchFilter{
+		ManufacturerHash: c.Query("manufacturer_hash"),
+		CategoryHash:     c.Query("category_hash"),
+		Status:           c.Query("status"),
+	}
+	if filter.ManufacturerHash == "" && filter.CategoryHash == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "manufacturer_hash or category_hash is required"})
+		return
+	}
+
+	limit, ok := h.pageLimit(c, 0)
+	if !ok {
+		return
+	}
+
+	key, ok := pageKey(c)
+	if !ok {
+		return
+	}
+
+	ctx, cancel := h.queryContext(c)
+	defer cancel()
+
+	page, err := h.blockchain.SearchComponents(ctx, filter, limit, key)
+	if err != nil {
+		h.logger.Error().Err(err).Msg("Failed to search components")
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to search components"})
+		return
+	}
+
+	c.JSON(http.StatusOK, page)
+}
+
 // GetComponentIdentity handles component identity retrieval
 func (h *Handler) GetComponentIdentity(c *gin.Context) {
 	componentID := c.Param("id")
@@ -148,7 +501,7 @@ func (h *Handler) GetComponentIdentity(c *gin.Context) {
 		return
 	}
 
-	ctx, cancel := context.WithTimeout(c.Request.Context(), time.Duration(h.config.Blockchain.Timeout)*time.Second)
+	ctx, cancel := h.queryContext(c)
 	defer cancel()
 
 	identity, err := h.blockchain.GetComponentIdentity(ctx, componentID)
@@ -161,6 +514,158 @@ func (h *Handler) GetComponentIdentity(c *gin.Context) {
 	c.JSON(http.StatusOK, identity)
 }
 
+// GetComponentHistory returns the recorded metadata changes for a component
+func (h *Handler) GetComponentHistory(c *gin.Context) {
+	componentID := c.Param("id")
+	if componentID == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Component ID is required"})
+		return
+	}
+
+	ctx, cancel := h.queryContext(c)
+	defer cancel()
+
+	history, err := h.blockchain.GetComponentHistory(ctx, componentID)
+	if err != nil {
+		h.logger.Error().Err(err).Str("component_id", componentID).Msg("Failed to get component history")
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get component history"})
+		return
+	}
+
+	c.JSON(http.StatusOK, history)
+}
+
+// GetCustodyChain returns the ordered chain of owners a component has passed
+// through, from its registration to its current owner
+func (h *Handler) GetCustodyChain(c *gin.Context) {
+	componentID := c.Param("id")
+	if componentID == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Component ID is required"})
+		return
+	}
+
+	ctx, cancel := h.queryContext(c)
+	defer cancel()
+
+	chain, err := h.blockchain.GetCustodyChain(ctx, componentID)
+	if errors.Is(err, blockchain.ErrComponentNotFound) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Component not found", "component_id": componentID})
+		return
+	}
+	if err != nil {
+		h.logger.Error().Err(err).Str("component_id", componentID).Msg("Failed to get custody chain")
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get custody chain"})
+		return
+	}
+
+	currentOwner := ""
+	if len(chain) > 0 {
+		currentOwner = chain[len(chain)-1].ToOwner
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"component_id":  componentID,
+		"current_owner": currentOwner,
+		"custody":       chain,
+		"count":         len(chain),
+	})
+}
+
+// GetComponentMetadata returns one view of a component's metadata: the fields
+// stored on chain and, when the chain has a verification backend, the
+// manufacturer's metadata from it. An unreachable backend does not fail the
+// request; backend_status in the response reports it instead.
+func (h *Handler) GetComponentMetadata(c *gin.Context) {
+	componentID := c.Param("id")
+	if componentID == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Component ID is required"})
+		return
+	}
+
+	ctx, cancel := h.queryContext(c)
+	defer cancel()
+
+	metadata, err := h.blockchain.GetComponentMetadata(ctx, componentID)
+	if errors.Is(err, blockchain.ErrComponentNotFound) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Component not found", "component_id": componentID})
+		return
+	}
+	if err != nil {
+		h.logger.Error().Err(err).Str("component_id", componentID).Msg("Failed to get component metadata")
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get component metadata"})
+		return
+	}
+
+	c.JSON(http.StatusOK, metadata)
+}
+
+// GetVerificationHistory returns one page of a component's verification
+// timeline, oldest first, so auditors can show when and by whom it was verified
+func (h *Handler) GetVerificationHistory(c *gin.Context) {
+	componentID := c.Param("id")
+	if componentID == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Component ID is required"})
+		return
+	}
+
+	limit, ok := h.pageLimit(c, 0)
+	if !ok {
+		return
+	}
+
+	key, ok := pageKey(c)
+	if !ok {
+		return
+	}
+
+	ctx, cancel := h.queryContext(c)
+	defer cancel()
+
+	page, err := h.blockchain.GetVerificationHistory(ctx, componentID, limit, key)
+	if errors.Is(err, blockchain.ErrComponentNotFound) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Component not found", "component_id": componentID})
+		return
+	}
+	if err != nil {
+		h.logger.Error().Err(err).Str("component_id", componentID).Msg("Failed to get verification history")
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get verification history"})
+		return
+	}
+
+	c.JSON(http.StatusOK, page)
+}
+
+// GetComponentRelationships lists every LCT a component is paired through.
+// An optional ?status= query parameter filters by pairing status.
+func (h *Handler) GetComponentRelationships(c *gin.Context) {
+	componentID := c.Param("id")
+	if componentID == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Component ID is required"})
+		return
+	}
+	statusFilter := c.Query("status")
+	if statusFilter != "" && !lctmanagertypes.IsValidLCTStatus(statusFilter) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("invalid LCT status %q", statusFilter)})
+		return
+	}
+
+	ctx, cancel := h.queryContext(c)
+	defer cancel()
+
+	relationships, err := h.blockchain.GetComponentRelationships(ctx, componentID, statusFilter)
+	if err != nil {
+		h.logger.Error().Err(err).Str("component_id", componentID).Msg("Failed to get component relationships")
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get component relationships"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"component_id":  componentID,
+		"status":        statusFilter,
+		"relationships": relationships,
+		"count":         len(relationships),
+	})
+}
+
 // Privacy-focused handlers for anonymous component operations
 
 // RegisterAnonymousComponent handles anonymous component registration
@@ -178,11 +683,14 @@ func (h *Handler) RegisterAnonymousComponent(c *gin.Context) {
 		return
 	}
 
-	ctx, cancel := context.WithTimeout(c.Request.Context(), time.Duration(h.config.Blockchain.Timeout)*time.Second)
+	ctx, cancel := h.broadcastContext(c)
 	defer cancel()
 
 	// Use the new anonymous registration endpoint
 	resp, err := h.blockchain.RegisterAnonymousComponent(ctx, req.Creator, req.RealComponentID, req.ManufacturerID, req.ComponentType, req.Context)
+	if h.chainUnavailable(c, err) {
+		return
+	}
 	if err != nil {
 		h.logger.Error().Err(err).Str("creator", req.Creator).Msg("Failed to register anonymous component")
 		c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("Failed to register anonymous component: %v", err)})
@@ -200,7 +708,7 @@ func (h *Handler) RegisterAnonymousComponent(c *gin.Context) {
 			"timestamp":         time.Now().Unix(),
 			"tx_hash":           resp["txhash"],
 		}
-		h.eventQueue.Emit("anonymous_component_registered", eventData)
+		h.emitEvent(c, req.Creator, resp, "anonymous_component_registered", eventData)
 	}
 
 	c.JSON(http.StatusOK, resp)
@@ -220,10 +728,31 @@ func (h *Handler) VerifyComponentPairingWithHashes(c *gin.Context) {
 		return
 	}
 
-	ctx, cancel := context.WithTimeout(c.Request.Context(), time.Duration(h.config.Blockchain.Timeout)*time.Second)
+	ctx, cancel := h.broadcastContext(c)
 	defer cancel()
 
-	resp, err := h.blockchain.VerifyComponentPairingWithHashes(ctx, req.Verifier, req.ComponentHashA, req.ComponentHashB, req.Context)
+	// With a manufacturer service configured its verdict is what the chain
+	// records, signed by the attestor account the chain trusts for it
+	var attestation *blockchain.PairingAttestation
+	if h.pairingVerifier != nil {
+		verdict, err := h.pairingVerifier.VerifyPairingWithHashes(ctx, req.ComponentHashA, req.ComponentHashB)
+		if err != nil {
+			h.logger.Error().Err(err).Str("component_hash_a", req.ComponentHashA).Str("component_hash_b", req.ComponentHashB).Msg("Manufacturer verification failed")
+			status := http.StatusBadGateway
+			if errors.Is(err, verification.ErrServiceUnavailable) {
+				status = http.StatusServiceUnavailable
+			}
+			c.JSON(status, gin.H{"error": "Manufacturer verification service failed", "code": "VERIFICATION_UNAVAILABLE"})
+			return
+		}
+		attestation = &blockchain.PairingAttestation{CanPair: verdict.Allowed, Reason: verdict.Reason}
+		req.Verifier = h.pairingVerifier.Attestor()
+	}
+
+	resp, err := h.blockchain.VerifyComponentPairingWithHashes(ctx, req.Verifier, req.ComponentHashA, req.ComponentHashB, req.Context, attestation)
+	if h.chainUnavailable(c, err) {
+		return
+	}
 	if err != nil {
 		h.logger.Error().Err(err).Str("component_hash_a", req.ComponentHashA).Str("component_hash_b", req.ComponentHashB).Msg("Failed to verify component pairing with hashes")
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to verify component pairing with hashes"})
@@ -243,7 +772,7 @@ func (h *Handler) VerifyComponentPairingWithHashes(c *gin.Context) {
 			"timestamp":        time.Now().Unix(),
 			"tx_hash":          resp["txhash"],
 		}
-		h.eventQueue.Emit("component_pairing_verified_with_hashes", eventData)
+		h.emitEvent(c, req.Verifier, resp, "component_pairing_verified_with_hashes", eventData)
 	}
 
 	c.JSON(http.StatusOK, resp)
@@ -265,10 +794,13 @@ func (h *Handler) CreateAnonymousPairingAuthorization(c *gin.Context) {
 		return
 	}
 
-	ctx, cancel := context.WithTimeout(c.Request.Context(), time.Duration(h.config.Blockchain.Timeout)*time.Second)
+	ctx, cancel := h.broadcastContext(c)
 	defer cancel()
 
 	resp, err := h.blockchain.CreateAnonymousPairingAuthorization(ctx, req.Creator, req.ComponentHashA, req.ComponentHashB, req.RuleHash, req.TrustScoreRequirement, req.AuthorizationLevel)
+	if h.chainUnavailable(c, err) {
+		return
+	}
 	if err != nil {
 		h.logger.Error().Err(err).Str("creator", req.Creator).Msg("Failed to create anonymous pairing authorization")
 		c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("Failed to create anonymous pairing authorization: %v", err)})
@@ -287,12 +819,104 @@ func (h *Handler) CreateAnonymousPairingAuthorization(c *gin.Context) {
 			"timestamp":        time.Now().Unix(),
 			"tx_hash":          resp["txhash"],
 		}
-		h.eventQueue.Emit("anonymous_pairing_authorized", eventData)
+		h.emitEvent(c, req.Creator, resp, "anonymous_pairing_authorized", eventData)
 	}
 
 	c.JSON(http.StatusOK, resp)
 }
 
+// GetRevocationEvents lists revocation events for audits and recalls. target_hash,
+// from and to are optional; from and to take unix seconds or RFC 3339 times.
+func (h *Handler) GetRevocationEvents(c *gin.Context) {
+	from, err := parseTimeBound(c.Query("from"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "from must be unix seconds or an RFC 3339 time"})
+		return
+	}
+	to, err := parseTimeBound(c.Query("to"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "to must be unix seconds or an RFC 3339 time"})
+		return
+	}
+	if to != 0 && from > to {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "from must not be after to"})
+		return
+	}
+
+	limit, ok := h.pageLimit(c, 0)
+	if !ok {
+		return
+	}
+
+	key, ok := pageKey(c)
+	if !ok {
+		return
+	}
+
+	ctx, cancel := h.queryContext(c)
+	defer cancel()
+
+	targetHash := c.Query("target_hash")
+	page, err := h.blockchain.GetRevocationEvents(ctx, targetHash, from, to, limit, key)
+	if err != nil {
+		h.logger.Error().Err(err).Str("target_hash", targetHash).Msg("Failed to get revocation events")
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get revocation events"})
+		return
+	}
+
+	c.JSON(http.StatusOK, page)
+}
+
+// GetPairingRules lists the pairing rules for a source component type, so
+// clients can check which types it may pair with before starting a pairing
+func (h *Handler) GetPairingRules(c *gin.Context) {
+	sourceType := c.Query("source_type")
+	if sourceType == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "source_type is required"})
+		return
+	}
+
+	limit, ok := h.pageLimit(c, 0)
+	if !ok {
+		return
+	}
+
+	key, ok := pageKey(c)
+	if !ok {
+		return
+	}
+
+	ctx, cancel := h.queryContext(c)
+	defer cancel()
+
+	page, err := h.blockchain.GetPairingRules(ctx, sourceType, limit, key)
+	if err != nil {
+		h.logger.Error().Err(err).Str("source_type", sourceType).Msg("Failed to get pairing rules")
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get pairing rules"})
+		return
+	}
+
+	c.JSON(http.StatusOK, page)
+}
+
+// parseTimeBound parses an optional time query parameter into unix seconds
+func parseTimeBound(raw string) (int64, error) {
+	if raw == "" {
+		return 0, nil
+	}
+	if seconds, err := strconv.ParseInt(raw, 10, 64); err == nil {
+		if seconds < 0 {
+			return 0, fmt.Errorf("negative time %d", seconds)
+		}
+		return seconds, nil
+	}
+	t, err := time.Parse(time.RFC3339, raw)
+	if err != nil {
+		return 0, err
+	}
+	return t.Unix(), nil
+}
+
 // CreateAnonymousRevocationEvent handles anonymous revocation event creation
 func (h *Handler) CreateAnonymousRevocationEvent(c *gin.Context) {
 	var req struct {
@@ -309,10 +933,13 @@ func (h *Handler) CreateAnonymousRevocationEvent(c *gin.Context) {
 		return
 	}
 
-	ctx, cancel := context.WithTimeout(c.Request.Context(), time.Duration(h.config.Blockchain.Timeout)*time.Second)
+	ctx, cancel := h.broadcastContext(c)
 	defer cancel()
 
 	resp, err := h.blockchain.CreateAnonymousRevocationEvent(ctx, req.Creator, req.TargetHash, req.RevocationType, req.UrgencyLevel, req.ReasonCategory, req.Context)
+	if h.chainUnavailable(c, err) {
+		return
+	}
 	if err != nil {
 		h.logger.Error().Err(err).Str("creator", req.Creator).Str("target_hash", req.TargetHash).Msg("Failed to create anonymous revocation event")
 		c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("Failed to create anonymous revocation event: %v", err)})
@@ -334,7 +961,7 @@ func (h *Handler) CreateAnonymousRevocationEvent(c *gin.Context) {
 			"timestamp":       time.Now().Unix(),
 			"tx_hash":         resp["txhash"],
 		}
-		h.eventQueue.Emit("anonymous_revocation_created", eventData)
+		h.emitEvent(c, req.Creator, resp, "anonymous_revocation_created", eventData)
 	}
 
 	c.JSON(http.StatusOK, resp)
@@ -357,10 +984,13 @@ func (h *Handler) GetAnonymousComponentMetadata(c *gin.Context) {
 		return
 	}
 
-	ctx, cancel := context.WithTimeout(c.Request.Context(), time.Duration(h.config.Blockchain.Timeout)*time.Second)
+	ctx, cancel := h.broadcastContext(c)
 	defer cancel()
 
 	metadata, err := h.blockchain.GetAnonymousComponentMetadata(ctx, req.Requester, componentHash)
+	if h.chainUnavailable(c, err) {
+		return
+	}
 	if err != nil {
 		h.logger.Error().Err(err).Str("component_hash", componentHash).Msg("Failed to get anonymous component metadata")
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get anonymous component metadata"})
@@ -388,10 +1018,14 @@ func (h *Handler) VerifyComponent(c *gin.Context) {
 		return
 	}
 
-	ctx, cancel := context.WithTimeout(c.Request.Context(), time.Duration(h.config.Blockchain.Timeout)*time.Second)
+	ctx, cancel := h.broadcastContext(c)
 	defer cancel()
 
 	resp, err := h.blockchain.VerifyComponent(ctx, req.Verifier, componentID, req.Context)
+	h.recordOperation(req.Verifier, "verify_component", operationTargets(componentID), resp, err)
+	if h.chainUnavailable(c, err) {
+		return
+	}
 	if err != nil {
 		h.logger.Error().Err(err).Str("component_id", componentID).Msg("Failed to verify component")
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to verify component"})
@@ -407,7 +1041,7 @@ func (h *Handler) VerifyComponent(c *gin.Context) {
 			"timestamp":    time.Now().Unix(),
 			"tx_hash":      resp["txhash"],
 		}
-		h.eventQueue.Emit("component_verified", eventData)
+		h.emitEvent(c, req.Verifier, resp, "component_verified", eventData)
 	}
 
 	c.JSON(http.StatusOK, resp)
@@ -429,10 +1063,24 @@ func (h *Handler) InitiatePairing(c *gin.Context) {
 		return
 	}
 
-	ctx, cancel := context.WithTimeout(c.Request.Context(), time.Duration(h.config.Blockchain.Timeout)*time.Second)
+	idem, handled := h.beginIdempotent(c, req.Creator, req)
+	if handled {
+		return
+	}
+	defer idem.release()
+
+	ctx, cancel := h.broadcastContext(c)
 	defer cancel()
 
 	resp, err := h.blockchain.InitiatePairing(ctx, req.Creator, req.ComponentA, req.ComponentB, req.OperationalContext, req.ProxyID, req.ForceImmediate)
+	h.recordOperation(req.Creator, "initiate_pairing", operationTargets(req.ComponentA, req.ComponentB, resp["challenge_id"]), resp, err)
+	if h.chainUnavailable(c, err) {
+		return
+	}
+	if errors.Is(err, blockchain.ErrInvalidOperationalContext) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error(), "field": "operational_context"})
+		return
+	}
 	if err != nil {
 		h.logger.Error().Err(err).Msg("Failed to initiate pairing")
 		c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("Failed to initiate pairing: %v", err)})
@@ -446,15 +1094,16 @@ func (h *Handler) InitiatePairing(c *gin.Context) {
 			"creator":             req.Creator,
 			"component_a":         req.ComponentA,
 			"component_b":         req.ComponentB,
-			"operational_context": req.OperationalContext,
+			"operational_context": resp["operational_context"],
 			"proxy_id":            req.ProxyID,
 			"force_immediate":     req.ForceImmediate,
 			"timestamp":           time.Now().Unix(),
 			"tx_hash":             resp["txhash"],
 		}
-		h.eventQueue.Emit("pairing_initiated", eventData)
+		h.emitEvent(c, req.Creator, resp, "pairing_initiated", eventData)
 	}
 
+	idem.complete(http.StatusOK, resp)
 	c.JSON(http.StatusOK, resp)
 }
 
@@ -473,10 +1122,14 @@ func (h *Handler) CompletePairing(c *gin.Context) {
 		return
 	}
 
-	ctx, cancel := context.WithTimeout(c.Request.Context(), time.Duration(h.config.Blockchain.Timeout)*time.Second)
+	ctx, cancel := h.broadcastContext(c)
 	defer cancel()
 
 	resp, err := h.blockchain.CompletePairing(ctx, req.Creator, req.ChallengeID, req.ComponentAAuth, req.ComponentBAuth, req.SessionContext)
+	h.recordOperation(req.Creator, "complete_pairing", operationTargets(req.ChallengeID, resp["lct_id"]), resp, err)
+	if h.chainUnavailable(c, err) {
+		return
+	}
 	if err != nil {
 		h.logger.Error().Err(err).Msg("Failed to complete pairing")
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to complete pairing"})
@@ -490,15 +1143,30 @@ func (h *Handler) CompletePairing(c *gin.Context) {
 			"creator":         req.Creator,
 			"session_context": req.SessionContext,
 			"lct_id":          resp["lct_id"],
+			"key_reference":   resp["key_reference"],
 			"timestamp":       time.Now().Unix(),
 			"tx_hash":         resp["txhash"],
 		}
-		h.eventQueue.Emit("pairing_completed", eventData)
+		h.emitEvent(c, req.Creator, resp, "pairing_completed", eventData)
 	}
 
 	c.JSON(http.StatusOK, resp)
 }
 
+// DeliverKeyHalf hands a component its sealed split key half as a
+// split_key_half event, to WebSocket subscribers and subscribed webhooks.
+// The half is sealed to the component's key, so only ciphertext is sent.
+func (h *Handler) DeliverKeyHalf(ctx context.Context, half blockchain.SealedKeyHalf) error {
+	h.eventQueue.Emit("split_key_half", map[string]interface{}{
+		"lct_id":        half.LctID,
+		"component_id":  half.ComponentID,
+		"key_reference": half.KeyReference,
+		"sealed_half":   half.SealedHalf,
+		"timestamp":     time.Now().Unix(),
+	})
+	return nil
+}
+
 // RevokePairing handles pairing revocation
 func (h *Handler) RevokePairing(c *gin.Context) {
 	var req struct {
@@ -513,10 +1181,18 @@ func (h *Handler) RevokePairing(c *gin.Context) {
 		return
 	}
 
-	ctx, cancel := context.WithTimeout(c.Request.Context(), time.Duration(h.config.Blockchain.Timeout)*time.Second)
+	ctx, cancel := h.broadcastContext(c)
 	defer cancel()
 
 	resp, err := h.blockchain.RevokePairing(ctx, req.Creator, req.LctID, req.Reason, req.NotifyOffline)
+	h.recordOperation(req.Creator, "revoke_pairing", operationTargets(req.LctID), resp, err)
+	if h.chainUnavailable(c, err) {
+		return
+	}
+	if errors.Is(err, blockchain.ErrPairingRevocationUnauthorized) {
+		c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+		return
+	}
 	if err != nil {
 		h.logger.Error().Err(err).Msg("Failed to revoke pairing")
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to revoke pairing"})
@@ -534,10 +1210,14 @@ func (h *Handler) GetPairingStatus(c *gin.Context) {
 		return
 	}
 
-	ctx, cancel := context.WithTimeout(c.Request.Context(), time.Duration(h.config.Blockchain.Timeout)*time.Second)
+	ctx, cancel := h.queryContext(c)
 	defer cancel()
 
 	status, err := h.blockchain.GetPairingStatus(ctx, challengeID)
+	if errors.Is(err, blockchain.ErrPairingChallengeNotFound) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Pairing challenge not found", "challenge_id": challengeID})
+		return
+	}
 	if err != nil {
 		h.logger.Error().Err(err).Str("challenge_id", challengeID).Msg("Failed to get pairing status")
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get pairing status"})
@@ -550,22 +1230,48 @@ func (h *Handler) GetPairingStatus(c *gin.Context) {
 // CreateLCT handles LCT creation
 func (h *Handler) CreateLCT(c *gin.Context) {
 	var req struct {
-		Creator    string `json:"creator" binding:"required"`
-		ComponentA string `json:"component_a" binding:"required"`
-		ComponentB string `json:"component_b" binding:"required"`
-		Context    string `json:"context"`
-		ProxyID    string `json:"proxy_id"`
+		Creator            string `json:"creator" binding:"required"`
+		ComponentA         string `json:"component_a" binding:"required"`
+		ComponentB         string `json:"component_b" binding:"required"`
+		OperationalContext string `json:"operational_context"`
+		Context            string `json:"context"` // deprecated spelling of operational_context
+		ProxyID            string `json:"proxy_id"`
 	}
 
 	if err := c.ShouldBindJSON(&req); err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
 		return
 	}
+	if req.OperationalContext == "" {
+		req.OperationalContext = req.Context
+	}
 
-	ctx, cancel := context.WithTimeout(c.Request.Context(), time.Duration(h.config.Blockchain.Timeout)*time.Second)
+	idem, handled := h.beginIdempotent(c, req.Creator, req)
+	if handled {
+		return
+	}
+	defer idem.release()
+
+	ctx, cancel := h.broadcastContext(c)
 	defer cancel()
 
-	resp, err := h.blockchain.CreateLCT(ctx, req.Creator, req.ComponentA, req.ComponentB, req.Context, req.ProxyID)
+	resp, err := h.blockchain.CreateLCT(ctx, req.Creator, req.ComponentA, req.ComponentB, req.OperationalContext, req.ProxyID)
+	h.recordOperation(req.Creator, "create_lct", operationTargets(req.ComponentA, req.ComponentB, resp["lct_id"]), resp, err)
+	if h.chainUnavailable(c, err) {
+		return
+	}
+	if errors.Is(err, blockchain.ErrInvalidOperationalContext) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error(), "field": "operational_context"})
+		return
+	}
+	if errors.Is(err, blockchain.ErrComponentNotFound) {
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+	if errors.Is(err, blockchain.ErrComponentInactive) {
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+		return
+	}
 	if err != nil {
 		h.logger.Error().Err(err).Msg("Failed to create LCT")
 		c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("Failed to create LCT: %v", err)})
@@ -575,18 +1281,20 @@ func (h *Handler) CreateLCT(c *gin.Context) {
 	// Emit event if event queue is enabled
 	if h.eventQueue != nil {
 		eventData := map[string]interface{}{
-			"lct_id":      resp["lct_id"],
-			"creator":     req.Creator,
-			"component_a": req.ComponentA,
-			"component_b": req.ComponentB,
-			"context":     req.Context,
-			"proxy_id":    req.ProxyID,
-			"timestamp":   time.Now().Unix(),
-			"tx_hash":     resp["txhash"],
+			"lct_id":              resp["lct_id"],
+			"creator":             req.Creator,
+			"component_a":         req.ComponentA,
+			"component_b":         req.ComponentB,
+			"operational_context": resp["operational_context"],
+			"context":             resp["operational_context"], // deprecated: removed in the next release
+			"proxy_id":            req.ProxyID,
+			"timestamp":           time.Now().Unix(),
+			"tx_hash":             resp["txhash"],
 		}
-		h.eventQueue.Emit("lct_created", eventData)
+		h.emitEvent(c, req.Creator, resp, "lct_created", eventData)
 	}
 
+	idem.complete(http.StatusOK, resp)
 	c.JSON(http.StatusOK, resp)
 }
 
@@ -598,7 +1306,7 @@ func (h *Handler) GetLCT(c *gin.Context) {
 		return
 	}
 
-	ctx, cancel := context.WithTimeout(c.Request.Context(), time.Duration(h.config.Blockchain.Timeout)*time.Second)
+	ctx, cancel := h.queryContext(c)
 	defer cancel()
 
 	lct, err := h.blockchain.GetLCT(ctx, lctID)
@@ -611,6 +1319,157 @@ func (h *Handler) GetLCT(c *gin.Context) {
 	c.JSON(http.StatusOK, lct)
 }
 
+// GetKeyExchange handles lookup of an LCT's session key exchange status
+func (h *Handler) GetKeyExchange(c *gin.Context) {
+	lctID := c.Param("id")
+	if lctID == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "LCT ID is required"})
+		return
+	}
+
+	ctx, cancel := h.queryContext(c)
+	defer cancel()
+
+	exchange, err := h.blockchain.GetKeyExchange(ctx, lctID)
+	if errors.Is(err, blockchain.ErrKeyExchangeNotFound) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "No key exchange found for LCT", "lct_id": lctID})
+		return
+	}
+	if err != nil {
+		h.logger.Error().Err(err).Str("lct_id", lctID).Msg("Failed to get key exchange")
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get key exchange"})
+		return
+	}
+
+	c.JSON(http.StatusOK, exchange)
+}
+
+// GetSplitKeyStatus handles lookup of an LCT's split key lifecycle
+func (h *Handler) GetSplitKeyStatus(c *gin.Context) {
+	lctID := c.Param("id")
+	if lctID == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "LCT ID is required"})
+		return
+	}
+
+	ctx, cancel := h.queryContext(c)
+	defer cancel()
+
+	status, err := h.blockchain.GetSplitKeyStatus(ctx, lctID)
+	if errors.Is(err, blockchain.ErrSplitKeyNotFound) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "No split key found for LCT", "lct_id": lctID})
+		return
+	}
+	if err != nil {
+		h.logger.Error().Err(err).Str("lct_id", lctID).Msg("Failed to get split key status")
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get split key status"})
+		return
+	}
+
+	c.JSON(http.StatusOK, status)
+}
+
+// ListLCTs handles paginated listing of LCTs, filtered by component, status
+// and operational context
+func (h *Handler) ListLCTs(c *gin.Context) {
+	filter := blockchain.LCTFilter{
+		ComponentID: c.Query("component"),
+		Status:      c.Query("status"),
+	}
+	if filter.Status != "" && !lctmanagertypes.IsValidLCTStatus(filter.Status) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("invalid LCT status %q", filter.Status)})
+		return
+	}
+	// Match the context in the form LCTs are stored with
+	operationalContext, err := lctmanagertypes.NormalizeOperationalContext(c.Query("context"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error(), "field": "context"})
+		return
+	}
+	filter.OperationalContext = operationalContext
+
+	limit, ok := h.pageLimit(c, 0)
+	if !ok {
+		return
+	}
+
+	key, ok := pageKey(c)
+	if !ok {
+		return
+	}
+
+	ctx, cancel := h.queryContext(c)
+	defer cancel()
+
+	page, err := h.blockchain.ListLCTs(ctx, filter, limit, key)
+	if err != nil {
+		h.logger.Error().Err(err).Msg("Failed to list LCTs")
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to list LCTs"})
+		return
+	}
+
+	c.JSON(http.StatusOK, page)
+}
+
+// GetLctsByProxy handles paginated listing of the LCTs a proxy component mediates
+func (h *Handler) GetLctsByProxy(c *gin.Context) {
+	proxyID := c.Param("id")
+	if proxyID == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Proxy ID is required"})
+		return
+	}
+
+	limit, ok := h.pageLimit(c, 0)
+	if !ok {
+		return
+	}
+
+	key, ok := pageKey(c)
+	if !ok {
+		return
+	}
+
+	ctx, cancel := h.queryContext(c)
+	defer cancel()
+
+	page, err := h.blockchain.GetLctsByProxy(ctx, proxyID, limit, key)
+	if err != nil {
+		h.logger.Error().Err(err).Str("proxy_id", proxyID).Msg("Failed to get LCTs by proxy")
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get LCTs by proxy"})
+		return
+	}
+
+	c.JSON(http.StatusOK, page)
+}
+
+// GetLctBetween handles lookup of the LCT linking two components
+func (h *Handler) GetLctBetween(c *gin.Context) {
+	componentA := c.Query("a")
+	componentB := c.Query("b")
+	if componentA == "" || componentB == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Query parameters a and b are required"})
+		return
+	}
+	operationalContext := c.Query("context")
+
+	ctx, cancel := h.queryContext(c)
+	defer cancel()
+
+	resp, err := h.blockchain.GetLctBetween(ctx, componentA, componentB, operationalContext)
+	if err != nil {
+		h.logger.Error().Err(err).Str("component_a", componentA).Str("component_b", componentB).Msg("Failed to get LCT between components")
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get LCT between components"})
+		return
+	}
+
+	if found, _ := resp["found"].(bool); !found {
+		c.JSON(http.StatusNotFound, resp)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
 // UpdateLCTStatus handles LCT status updates
 func (h *Handler) UpdateLCTStatus(c *gin.Context) {
 	lctID := c.Param("id")
@@ -629,11 +1488,18 @@ func (h *Handler) UpdateLCTStatus(c *gin.Context) {
 		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
 		return
 	}
+	if !lctmanagertypes.IsValidLCTStatus(req.Status) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("invalid LCT status %q", req.Status)})
+		return
+	}
 
-	ctx, cancel := context.WithTimeout(c.Request.Context(), time.Duration(h.config.Blockchain.Timeout)*time.Second)
+	ctx, cancel := h.broadcastContext(c)
 	defer cancel()
 
 	resp, err := h.blockchain.UpdateLCTStatus(ctx, req.Creator, lctID, req.Status, req.Context)
+	if h.chainUnavailable(c, err) {
+		return
+	}
 	if err != nil {
 		h.logger.Error().Err(err).Str("lct_id", lctID).Msg("Failed to update LCT status")
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update LCT status"})
@@ -643,25 +1509,159 @@ func (h *Handler) UpdateLCTStatus(c *gin.Context) {
 	c.JSON(http.StatusOK, resp)
 }
 
+// SuspendLCT handles pausing an LCT without terminating it
+func (h *Handler) SuspendLCT(c *gin.Context) {
+	h.changeLCTSuspension(c, "lct_suspended", h.blockchain.SuspendLCT)
+}
+
+// ResumeLCT handles returning a suspended LCT to the status it had before suspension
+func (h *Handler) ResumeLCT(c *gin.Context) {
+	h.changeLCTSuspension(c, "lct_resumed", h.blockchain.ResumeLCT)
+}
+
+// changeLCTSuspension runs a suspend or resume request against the blockchain
+func (h *Handler) changeLCTSuspension(c *gin.Context, eventType string, submit func(ctx context.Context, creator, lctID, reason string) (map[string]interface{}, error)) {
+	lctID := c.Param("id")
+	if lctID == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "LCT ID is required"})
+		return
+	}
+
+	var req struct {
+		Creator string `json:"creator" binding:"required"`
+		Reason  string `json:"reason"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx, cancel := h.broadcastContext(c)
+	defer cancel()
+
+	resp, err := submit(ctx, req.Creator, lctID, req.Reason)
+	if err != nil {
+		h.logger.Error().Err(err).Str("lct_id", lctID).Str("event", eventType).Msg("Failed to change LCT suspension")
+		c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("Failed to update LCT: %v", err)})
+		return
+	}
+
+	h.emitEvent(c, req.Creator, resp, eventType, map[string]interface{}{
+		"lct_id":    lctID,
+		"reason":    req.Reason,
+		"timestamp": time.Now().Unix(),
+		"tx_hash":   resp["txhash"],
+	})
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// RotateSplitKey handles replacing an LCT's split key. The response carries
+// only the new and superseded key references.
+func (h *Handler) RotateSplitKey(c *gin.Context) {
+	lctID := c.Param("id")
+	if lctID == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "LCT ID is required"})
+		return
+	}
+
+	var req struct {
+		Creator string `json:"creator" binding:"required"`
+		Reason  string `json:"reason"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx, cancel := h.broadcastContext(c)
+	defer cancel()
+
+	resp, err := h.blockchain.RotateSplitKey(ctx, req.Creator, lctID, req.Reason)
+	if h.chainUnavailable(c, err) {
+		return
+	}
+	if err != nil {
+		h.logger.Error().Err(err).Str("lct_id", lctID).Msg("Failed to rotate split key")
+		c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("Failed to rotate split key: %v", err)})
+		return
+	}
+
+	h.emitEvent(c, req.Creator, resp, "split_key_rotated", map[string]interface{}{
+		"lct_id":                 lctID,
+		"key_reference":          resp["key_reference"],
+		"previous_key_reference": resp["previous_key_reference"],
+		"reason":                 req.Reason,
+		"timestamp":              time.Now().Unix(),
+		"tx_hash":                resp["txhash"],
+	})
+
+	c.JSON(http.StatusOK, resp)
+}
+
 // CreateTrustTensor handles trust tensor creation
 func (h *Handler) CreateTrustTensor(c *gin.Context) {
 	var req struct {
-		Creator      string  `json:"creator" binding:"required"`
-		ComponentA   string  `json:"component_a" binding:"required"`
-		ComponentB   string  `json:"component_b" binding:"required"`
-		Context      string  `json:"context"`
-		InitialScore float64 `json:"initial_score"`
+		Creator      string   `json:"creator" binding:"required"`
+		ComponentA   string   `json:"component_a" binding:"required"`
+		ComponentB   string   `json:"component_b" binding:"required"`
+		Context      string   `json:"context"`
+		InitialScore *float64 `json:"initial_score"`
+		// Dimensions names the tensor's scores, e.g. reliability, latency, safety
+		Dimensions map[string]float64 `json:"dimensions"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if req.InitialScore != nil && len(req.Dimensions) > 0 {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "give either initial_score or dimensions, not both",
+			"code":  "INVALID_INPUT",
+			"field": "dimensions",
+		})
+		return
+	}
+
+	var initialScore float64
+	if req.InitialScore != nil {
+		initialScore = *req.InitialScore
+	}
+	scores := map[string]float64{"initial_score": initialScore}
+	if len(req.Dimensions) > 0 {
+		scores = make(map[string]float64, len(req.Dimensions))
+		for name, score := range req.Dimensions {
+			if name == "" {
+				c.JSON(http.StatusBadRequest, gin.H{"error": "dimension names cannot be empty", "code": "INVALID_INPUT", "field": "dimensions"})
+				return
+			}
+			scores["dimensions."+name] = score
+		}
 	}
-
-	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
-		return
+	for field, score := range scores {
+		if _, err := blockchain.FormatTrustScore(score); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+				"code":  "INVALID_INPUT",
+				"field": field,
+				"min":   blockchain.MinTrustScore,
+				"max":   blockchain.MaxTrustScore,
+			})
+			return
+		}
 	}
 
-	ctx, cancel := context.WithTimeout(c.Request.Context(), time.Duration(h.config.Blockchain.Timeout)*time.Second)
+	ctx, cancel := h.broadcastContext(c)
 	defer cancel()
 
-	resp, err := h.blockchain.CreateTrustTensor(ctx, req.Creator, req.ComponentA, req.ComponentB, req.Context, req.InitialScore)
+	resp, err := h.blockchain.CreateTrustTensor(ctx, req.Creator, req.ComponentA, req.ComponentB, req.Context, initialScore, req.Dimensions)
+	if h.txNotCommitted(c, err) {
+		return
+	}
 	if err != nil {
 		h.logger.Error().Err(err).Msg("Failed to create trust tensor")
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create trust tensor"})
@@ -671,16 +1671,20 @@ func (h *Handler) CreateTrustTensor(c *gin.Context) {
 	// Emit event if event queue is enabled
 	if h.eventQueue != nil {
 		eventData := map[string]interface{}{
-			"tensor_id":     resp["tensor_id"],
-			"creator":       req.Creator,
-			"component_a":   req.ComponentA,
-			"component_b":   req.ComponentB,
-			"context":       req.Context,
-			"initial_score": req.InitialScore,
-			"timestamp":     time.Now().Unix(),
-			"tx_hash":       resp["txhash"],
+			"tensor_id":   resp["tensor_id"],
+			"creator":     req.Creator,
+			"component_a": req.ComponentA,
+			"component_b": req.ComponentB,
+			"context":     req.Context,
+			"timestamp":   time.Now().Unix(),
+			"tx_hash":     resp["txhash"],
 		}
-		h.eventQueue.Emit("trust_tensor_created", eventData)
+		if len(req.Dimensions) > 0 {
+			eventData["dimensions"] = req.Dimensions
+		} else {
+			eventData["initial_score"] = initialScore
+		}
+		h.emitEvent(c, req.Creator, resp, "trust_tensor_created", eventData)
 	}
 
 	c.JSON(http.StatusOK, resp)
@@ -694,10 +1698,14 @@ func (h *Handler) GetTrustTensor(c *gin.Context) {
 		return
 	}
 
-	ctx, cancel := context.WithTimeout(c.Request.Context(), time.Duration(h.config.Blockchain.Timeout)*time.Second)
+	ctx, cancel := h.queryContext(c)
 	defer cancel()
 
 	tensor, err := h.blockchain.GetTrustTensor(ctx, tensorID)
+	if errors.Is(err, blockchain.ErrTrustTensorNotFound) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Trust tensor not found", "tensor_id": tensorID})
+		return
+	}
 	if err != nil {
 		h.logger.Error().Err(err).Str("tensor_id", tensorID).Msg("Failed to get trust tensor")
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get trust tensor"})
@@ -707,6 +1715,47 @@ func (h *Handler) GetTrustTensor(c *gin.Context) {
 	c.JSON(http.StatusOK, tensor)
 }
 
+// GetTensorHistory returns the score history of a trust tensor. from and to
+// are optional and take unix seconds or RFC 3339 times.
+func (h *Handler) GetTensorHistory(c *gin.Context) {
+	tensorID := c.Param("id")
+	if tensorID == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Tensor ID is required"})
+		return
+	}
+
+	from, err := parseTimeBound(c.Query("from"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "from must be unix seconds or an RFC 3339 time"})
+		return
+	}
+	to, err := parseTimeBound(c.Query("to"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "to must be unix seconds or an RFC 3339 time"})
+		return
+	}
+	if to != 0 && from > to {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "from must not be after to"})
+		return
+	}
+
+	ctx, cancel := h.queryContext(c)
+	defer cancel()
+
+	history, err := h.blockchain.GetTensorHistory(ctx, tensorID, from, to)
+	if errors.Is(err, blockchain.ErrTrustTensorNotFound) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Trust tensor not found", "tensor_id": tensorID})
+		return
+	}
+	if err != nil {
+		h.logger.Error().Err(err).Str("tensor_id", tensorID).Msg("Failed to get tensor history")
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get tensor history"})
+		return
+	}
+
+	c.JSON(http.StatusOK, history)
+}
+
 // UpdateTrustScore handles trust score updates
 func (h *Handler) UpdateTrustScore(c *gin.Context) {
 	tensorID := c.Param("id")
@@ -726,7 +1775,7 @@ func (h *Handler) UpdateTrustScore(c *gin.Context) {
 		return
 	}
 
-	ctx, cancel := context.WithTimeout(c.Request.Context(), time.Duration(h.config.Blockchain.Timeout)*time.Second)
+	ctx, cancel := h.broadcastContext(c)
 	defer cancel()
 
 	resp, err := h.blockchain.UpdateTrustScore(ctx, req.Creator, tensorID, req.Score, req.Context)
@@ -755,10 +1804,13 @@ func (h *Handler) CreateEnergyOperation(c *gin.Context) {
 		return
 	}
 
-	ctx, cancel := context.WithTimeout(c.Request.Context(), time.Duration(h.config.Blockchain.Timeout)*time.Second)
+	ctx, cancel := h.broadcastContext(c)
 	defer cancel()
 
 	resp, err := h.blockchain.CreateEnergyOperation(ctx, req.Creator, req.ComponentA, req.ComponentB, req.OperationType, req.Amount, req.Context)
+	if h.txNotCommitted(c, err) {
+		return
+	}
 	if err != nil {
 		h.logger.Error().Err(err).Msg("Failed to create energy operation")
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create energy operation"})
@@ -771,10 +1823,11 @@ func (h *Handler) CreateEnergyOperation(c *gin.Context) {
 // ExecuteEnergyTransfer handles energy transfer execution
 func (h *Handler) ExecuteEnergyTransfer(c *gin.Context) {
 	var req struct {
-		Creator     string  `json:"creator" binding:"required"`
-		OperationID string  `json:"operation_id" binding:"required"`
-		Amount      float64 `json:"amount" binding:"required"`
-		Context     string  `json:"context"`
+		Creator     string   `json:"creator" binding:"required"`
+		OperationID string   `json:"operation_id" binding:"required"`
+		Amount      float64  `json:"amount" binding:"required"`
+		EnergyOut   *float64 `json:"energy_out"` // measured at the target; omitted when nothing was lost
+		Context     string   `json:"context"`
 	}
 
 	if err := c.ShouldBindJSON(&req); err != nil {
@@ -782,10 +1835,37 @@ func (h *Handler) ExecuteEnergyTransfer(c *gin.Context) {
 		return
 	}
 
-	ctx, cancel := context.WithTimeout(c.Request.Context(), time.Duration(h.config.Blockchain.Timeout)*time.Second)
+	ctx, cancel := h.broadcastContext(c)
 	defer cancel()
 
-	resp, err := h.blockchain.ExecuteEnergyTransfer(ctx, req.Creator, req.OperationID, req.Amount, req.Context)
+	var energyOut string
+	if req.EnergyOut != nil {
+		if *req.EnergyOut < 0 || *req.EnergyOut > req.Amount {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "energy_out must be between 0 and amount"})
+			return
+		}
+		energyOut = strconv.FormatFloat(*req.EnergyOut, 'f', -1, 64)
+	}
+
+	resp, err := h.blockchain.ExecuteEnergyTransfer(ctx, req.Creator, req.OperationID, req.Amount, energyOut, req.Context)
+	h.recordOperation(req.Creator, "energy_transfer", operationTargets(req.OperationID), resp, err)
+	if h.chainUnavailable(c, err) || h.txNotCommitted(c, err) {
+		return
+	}
+	switch {
+	case errors.Is(err, blockchain.ErrEnergyOperationNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": "Energy operation not found"})
+		return
+	case errors.Is(err, blockchain.ErrEnergyOperationNotPending):
+		c.JSON(http.StatusConflict, gin.H{"error": "Energy operation is not pending"})
+		return
+	case errors.Is(err, blockchain.ErrEnergyTransferUnauthorized):
+		c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+		return
+	case errors.Is(err, blockchain.ErrInsufficientEnergyBalance):
+		c.JSON(http.StatusUnprocessableEntity, gin.H{"error": err.Error()})
+		return
+	}
 	if err != nil {
 		h.logger.Error().Err(err).Msg("Failed to execute energy transfer")
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to execute energy transfer"})
@@ -802,12 +1882,66 @@ func (h *Handler) ExecuteEnergyTransfer(c *gin.Context) {
 			"timestamp":    time.Now().Unix(),
 			"tx_hash":      resp["txhash"],
 		}
-		h.eventQueue.Emit("energy_transfer", eventData)
+		h.emitEvent(c, req.Creator, resp, "energy_transfer", eventData)
 	}
 
 	c.JSON(http.StatusOK, resp)
 }
 
+// GetEnergyOperation handles energy operation retrieval
+func (h *Handler) GetEnergyOperation(c *gin.Context) {
+	operationID := c.Param("id")
+	if operationID == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Operation ID is required"})
+		return
+	}
+
+	ctx, cancel := h.queryContext(c)
+	defer cancel()
+
+	operation, err := h.blockchain.GetEnergyOperation(ctx, operationID)
+	if errors.Is(err, blockchain.ErrEnergyOperationNotFound) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Energy operation not found", "operation_id": operationID})
+		return
+	}
+	if err != nil {
+		h.logger.Error().Err(err).Str("operation_id", operationID).Msg("Failed to get energy operation")
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get energy operation"})
+		return
+	}
+
+	c.JSON(http.StatusOK, operation)
+}
+
+// GetOperationEfficiency handles energy operation efficiency retrieval
+func (h *Handler) GetOperationEfficiency(c *gin.Context) {
+	operationID := c.Param("id")
+	if operationID == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Operation ID is required"})
+		return
+	}
+
+	ctx, cancel := h.queryContext(c)
+	defer cancel()
+
+	efficiency, err := h.blockchain.GetOperationEfficiency(ctx, operationID)
+	switch {
+	case errors.Is(err, blockchain.ErrEnergyOperationNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": "Energy operation not found", "operation_id": operationID})
+		return
+	case errors.Is(err, blockchain.ErrEnergyEfficiencyUnavailable):
+		c.JSON(http.StatusUnprocessableEntity, gin.H{"error": err.Error(), "operation_id": operationID})
+		return
+	}
+	if err != nil {
+		h.logger.Error().Err(err).Str("operation_id", operationID).Msg("Failed to get energy operation efficiency")
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get energy operation efficiency"})
+		return
+	}
+
+	c.JSON(http.StatusOK, efficiency)
+}
+
 // GetEnergyBalance handles energy balance retrieval
 func (h *Handler) GetEnergyBalance(c *gin.Context) {
 	componentID := c.Param("component_id")
@@ -816,7 +1950,7 @@ func (h *Handler) GetEnergyBalance(c *gin.Context) {
 		return
 	}
 
-	ctx, cancel := context.WithTimeout(c.Request.Context(), time.Duration(h.config.Blockchain.Timeout)*time.Second)
+	ctx, cancel := h.queryContext(c)
 	defer cancel()
 
 	balance, err := h.blockchain.GetEnergyBalance(ctx, componentID)
@@ -829,8 +1963,55 @@ func (h *Handler) GetEnergyBalance(c *gin.Context) {
 	c.JSON(http.StatusOK, balance)
 }
 
-// WebSocketHandler handles WebSocket connections for real-time events
+// GetNetworkEnergyBalance reports the energy flowing into, out of and within
+// the network of components reachable from one component through active LCTs
+func (h *Handler) GetNetworkEnergyBalance(c *gin.Context) {
+	componentID := c.Param("id")
+	if componentID == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Component ID is required"})
+		return
+	}
+
+	ctx, cancel := h.queryContext(c)
+	defer cancel()
+
+	balance, err := h.blockchain.GetNetworkEnergyBalance(ctx, componentID)
+	if errors.Is(err, blockchain.ErrEnergyNetworkTooLarge) {
+		c.JSON(http.StatusUnprocessableEntity, gin.H{"error": err.Error(), "component_id": componentID})
+		return
+	}
+	if err != nil {
+		h.logger.Error().Err(err).Str("component_id", componentID).Msg("Failed to get network energy balance")
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get network energy balance"})
+		return
+	}
+
+	c.JSON(http.StatusOK, balance)
+}
+
+// wsSubscribeMessage is sent by WebSocket clients to choose which event types are forwarded
+type wsSubscribeMessage struct {
+	Subscribe []string `json:"subscribe"`
+}
+
+// wsControlFrame acknowledges a subscription change or reports a bad client message
+type wsControlFrame struct {
+	Type       string   `json:"type"`
+	EventTypes []string `json:"event_types,omitempty"`
+	Error      string   `json:"error,omitempty"`
+}
+
+// wsWriteTimeout bounds how long a stalled client can hold up its own stream
+const wsWriteTimeout = 10 * time.Second
+
+// WebSocketHandler streams emitted events to the client as JSON frames. All
+// event types are forwarded until the client sends {"subscribe": [...]}.
 func (h *Handler) WebSocketHandler(c *gin.Context) {
+	if h.eventQueue == nil {
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "Event streaming is not available"})
+		return
+	}
+
 	conn, err := h.upgrader.Upgrade(c.Writer, c.Request, nil)
 	if err != nil {
 		h.logger.Error().Err(err).Msg("Failed to upgrade connection to WebSocket")
@@ -838,35 +2019,167 @@ func (h *Handler) WebSocketHandler(c *gin.Context) {
 	}
 	defer conn.Close()
 
-	h.logger.Info().Msg("WebSocket connection established")
+	sub := h.eventQueue.Subscribe(h.config.Events.StreamBuffer)
+	defer h.eventQueue.Unsubscribe(sub)
+
+	h.logger.Info().Str("remote_addr", c.Request.RemoteAddr).Msg("WebSocket connection established")
+
+	// Only this goroutine writes; the reader hands control replies over
+	replies := make(chan wsControlFrame, 1)
+	closed := make(chan struct{}) // reader saw the client disconnect
+	done := make(chan struct{})   // writer stopped streaming
+	defer close(done)
+	go func() {
+		defer close(closed)
+		for {
+			_, message, err := conn.ReadMessage()
+			if err != nil {
+				return
+			}
+
+			var req wsSubscribeMessage
+			reply := wsControlFrame{Type: "subscribed"}
+			if err := json.Unmarshal(message, &req); err != nil {
+				reply = wsControlFrame{Type: "error", Error: "expected {\"subscribe\": [\"event_type\", ...]}"}
+			} else {
+				sub.SetFilter(req.Subscribe)
+				reply.EventTypes = req.Subscribe
+			}
+
+			select {
+			case replies <- reply:
+			case <-done:
+				return
+			}
+		}
+	}()
 
-	// Handle WebSocket connection
 	for {
-		// Read message
-		messageType, message, err := conn.ReadMessage()
-		if err != nil {
-			h.logger.Error().Err(err).Msg("Failed to read WebSocket message")
-			break
+		var frame interface{}
+		select {
+		case <-closed:
+			h.logger.Info().Uint64("dropped_events", sub.Dropped()).Msg("WebSocket connection closed")
+			return
+		case reply := <-replies:
+			frame = reply
+		case event, ok := <-sub.Events():
+			if !ok {
+				// The event queue is shutting down
+				return
+			}
+			frame = event
 		}
 
-		// Echo message back (for now)
-		err = conn.WriteMessage(messageType, message)
-		if err != nil {
+		_ = conn.SetWriteDeadline(time.Now().Add(wsWriteTimeout))
+		if err := conn.WriteJSON(frame); err != nil {
 			h.logger.Error().Err(err).Msg("Failed to write WebSocket message")
-			break
+			return
+		}
+	}
+}
+
+// GetEventPayload returns the full value of an event attribute that was
+// truncated on emission, by the ref listed in the event's truncated attributes
+func (h *Handler) GetEventPayload(c *gin.Context) {
+	if h.eventQueue == nil {
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "Event streaming is not available"})
+		return
+	}
+
+	ref := c.Param("ref")
+	value, ok := h.eventQueue.Payload(ref)
+	if !ok {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Payload not found or expired", "ref": ref})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"ref": ref, "size": len(value), "value": value})
+}
+
+// GetDeadLetters lists webhook deliveries that failed after every retry
+func (h *Handler) GetDeadLetters(c *gin.Context) {
+	if h.eventQueue == nil {
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "Event delivery is not available"})
+		return
+	}
+
+	letters := h.eventQueue.DeadLetters()
+	c.JSON(http.StatusOK, gin.H{"dead_letters": letters, "count": len(letters)})
+}
+
+// replayDeadLettersRequest selects the dead letters to replay; no ids replays all
+type replayDeadLettersRequest struct {
+	IDs []string `json:"ids"`
+}
+
+// ReplayDeadLetters queues dead-lettered events for another delivery attempt
+// to the endpoint that rejected them
+func (h *Handler) ReplayDeadLetters(c *gin.Context) {
+	if h.eventQueue == nil {
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "Event delivery is not available"})
+		return
+	}
+
+	var req replayDeadLettersRequest
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
 		}
 	}
 
-	h.logger.Info().Msg("WebSocket connection closed")
+	replayed, err := h.eventQueue.ReplayDeadLetters(req.IDs)
+	if errors.Is(err, events.ErrDeliveryDisabled) {
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": err.Error()})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusAccepted, gin.H{"replayed": replayed})
+}
+
+// AccountsResponse is the body returned by GET /accounts
+type AccountsResponse struct {
+	Accounts []blockchain.AccountDetails `json:"accounts"`
+	Count    int                         `json:"count"`
+	Detailed bool                        `json:"detailed"`
 }
 
-// GetAccounts handles account listing
+// GetAccounts handles account listing. With ?detailed=true each account also
+// carries its on-chain balances and sequence, at the cost of two queries per account.
 func (h *Handler) GetAccounts(c *gin.Context) {
+	detailed := false
+	if raw := c.Query("detailed"); raw != "" {
+		parsed, err := strconv.ParseBool(raw)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "detailed must be true or false"})
+			return
+		}
+		detailed = parsed
+	}
+
 	accountManager := h.blockchain.GetAccountManager()
 	accounts := accountManager.ListAccounts()
-	c.JSON(http.StatusOK, gin.H{
-		"accounts": accounts,
-		"count":    len(accounts),
+
+	var details []blockchain.AccountDetails
+	if detailed {
+		ctx, cancel := h.queryContext(c)
+		defer cancel()
+		details = h.blockchain.GetAccountDetails(ctx, accounts)
+	} else {
+		details = make([]blockchain.AccountDetails, 0, len(accounts))
+		for _, account := range accounts {
+			details = append(details, blockchain.AccountDetails{Account: *account})
+		}
+	}
+
+	c.JSON(http.StatusOK, AccountsResponse{
+		Accounts: details,
+		Count:    len(details),
+		Detailed: detailed,
 	})
 }
 
@@ -881,7 +2194,7 @@ func (h *Handler) CreateAccount(c *gin.Context) {
 		return
 	}
 
-	ctx, cancel := context.WithTimeout(c.Request.Context(), time.Duration(h.config.Blockchain.Timeout)*time.Second)
+	ctx, cancel := h.broadcastContext(c)
 	defer cancel()
 
 	accountManager := h.blockchain.GetAccountManager()
@@ -895,6 +2208,42 @@ func (h *Handler) CreateAccount(c *gin.Context) {
 	c.JSON(http.StatusOK, account)
 }
 
+// ImportAccount stores a key derived from an existing mnemonic in the
+// keyring, so operators can sign with accounts they already hold
+func (h *Handler) ImportAccount(c *gin.Context) {
+	var req struct {
+		Name     string `json:"name" binding:"required"`
+		Mnemonic string `json:"mnemonic" binding:"required"`
+		HDPath   string `json:"hd_path"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	accountManager := h.blockchain.GetAccountManager()
+	account, err := accountManager.ImportAccountFromMnemonic(req.Name, req.Mnemonic, req.HDPath)
+	switch {
+	case errors.Is(err, blockchain.ErrAccountExists):
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+		return
+	case errors.Is(err, blockchain.ErrKeyringUnavailable):
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": err.Error()})
+		return
+	case errors.Is(err, blockchain.ErrInvalidMnemonic), errors.Is(err, blockchain.ErrInvalidHDPath), errors.Is(err, blockchain.ErrInvalidAccountName):
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	case err != nil:
+		// The request carries the mnemonic, so only the name is logged
+		h.logger.Error().Err(err).Str("name", req.Name).Msg("Failed to import account")
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to import account"})
+		return
+	}
+
+	c.JSON(http.StatusOK, account)
+}
+
 // GetAccountInfo handles account information and usage
 func (h *Handler) GetAccountInfo(c *gin.Context) {
 	accountManager := h.blockchain.GetAccountManager()
@@ -919,6 +2268,102 @@ func (h *Handler) GetAccountInfo(c *gin.Context) {
 	})
 }
 
+// ConsistencyCheck handles verifying cross-module references on chain
+func (h *Handler) ConsistencyCheck(c *gin.Context) {
+	ctx, cancel := h.queryContext(c)
+	defer cancel()
+
+	report, err := h.blockchain.CheckConsistency(ctx)
+	if err != nil {
+		h.logger.Error().Err(err).Msg("Failed to run consistency check")
+		c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("Failed to run consistency check: %v", err)})
+		return
+	}
+
+	c.JSON(http.StatusOK, report)
+}
+
+// ComponentsHealth reports how many components are active, stale, revoked or
+// unverified, and lists the problematic ones. stale_after is a Go duration
+// such as 24h; the chain default applies when it is omitted.
+func (h *Handler) ComponentsHealth(c *gin.Context) {
+	var staleAfter time.Duration
+	if raw := c.Query("stale_after"); raw != "" {
+		parsed, err := time.ParseDuration(raw)
+		if err != nil || parsed < time.Second {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "stale_after must be a duration of at least 1s, e.g. 24h"})
+			return
+		}
+		staleAfter = parsed
+	}
+
+	ctx, cancel := h.queryContext(c)
+	defer cancel()
+
+	health, err := h.blockchain.GetComponentHealth(ctx, staleAfter)
+	if err != nil {
+		h.logger.Error().Err(err).Msg("Failed to get component health")
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get component health"})
+		return
+	}
+
+	c.JSON(http.StatusOK, health)
+}
+
+// RecallOwnersPermission lets an API key see component owners in a recall scope
+const RecallOwnersPermission = "recall:owners"
+
+// RecallScope exports everything a recall reaches for a set of component
+// hashes or a manufacturer or category hash: the affected components, their
+// owners, the LCTs pairing them and the counterpart components. The report is
+// sent as a JSON attachment.
+func (h *Handler) RecallScope(c *gin.Context) {
+	var req blockchain.RecallCriteria
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if len(req.ComponentHashes) == 0 && req.ManufacturerHash == "" && req.CategoryHash == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "component_hashes, manufacturer_hash or category_hash is required"})
+		return
+	}
+	for _, hash := range req.ComponentHashes {
+		if hash == "" {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "component_hashes cannot contain an empty hash"})
+			return
+		}
+	}
+
+	ctx, cancel := h.queryContext(c)
+	defer cancel()
+
+	scope, err := h.blockchain.GetRecallScope(ctx, req, mayReadOwners(c))
+	if err != nil {
+		h.logger.Error().Err(err).Msg("Failed to collect recall scope")
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to collect recall scope"})
+		return
+	}
+
+	h.logger.Info().Int("components", len(scope.Components)).Int("lcts", len(scope.Lcts)).
+		Bool("owners_included", scope.OwnersIncluded).Str("user_id", c.GetString("user_id")).Msg("Recall scope exported")
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="recall-scope-%d.json"`, scope.GeneratedAt))
+	c.JSON(http.StatusOK, scope)
+}
+
+// mayReadOwners reports whether the caller may see component owners: API keys
+// holding RecallOwnersPermission or admin, or anyone when authentication is off
+func mayReadOwners(c *gin.Context) bool {
+	if !c.GetBool("authenticated") {
+		return true
+	}
+	for _, perm := range c.GetStringSlice("permissions") {
+		if perm == RecallOwnersPermission || perm == "admin" {
+			return true
+		}
+	}
+	return false
+}
+
 // TestIgniteCLI handles Ignite CLI testing
 func (h *Handler) TestIgniteCLI(c *gin.Context) {
 	ctx, cancel := context.WithTimeout(c.Request.Context(), 30*time.Second)
@@ -952,23 +2397,22 @@ func (h *Handler) TestTransactionFormat(c *gin.Context) {
 		"manufacturer_data": "test-data",
 	}
 
-	// Create transaction file
-	txFile, err := h.blockchain.CreateTransactionFile(message, "test-memo")
-	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
-		return
-	}
-	defer txFile.Close()
-
-	// Read the file content
-	content, err := os.ReadFile(txFile.Name())
+	// Create transaction file and read it back before it is removed
+	var txFile string
+	var content []byte
+	err := h.blockchain.WithTransactionFile(message, "test-memo", func(path string) error {
+		txFile = path
+		var err error
+		content, err = os.ReadFile(path)
+		return err
+	})
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
 
 	c.JSON(http.StatusOK, gin.H{
-		"transaction_file":    txFile.Name(),
+		"transaction_file":    txFile,
 		"transaction_content": string(content),
 		"message":             message,
 	})
@@ -1027,16 +2471,18 @@ func (h *Handler) GetIgniteHelp(c *gin.Context) {
 	})
 }
 
-// GetBlockchainClient returns the blockchain client
-func (h *Handler) GetBlockchainClient() *blockchain.Client {
-	return h.blockchain
-}
-
 // Shutdown gracefully shuts down the handler
 func (h *Handler) Shutdown() {
+	// Stopped first so no block is emitted into a closed queue
+	if h.blockWatcher != nil {
+		h.blockWatcher.Stop()
+	}
 	if h.eventQueue != nil {
 		h.eventQueue.Shutdown()
 	}
+	if h.idempotency != nil {
+		h.idempotency.stopPruning()
+	}
 }
 
 // Queue Management Handlers
@@ -1055,10 +2501,19 @@ func (h *Handler) QueuePairingRequest(c *gin.Context) {
 		return
 	}
 
-	ctx, cancel := context.WithTimeout(c.Request.Context(), time.Duration(h.config.Blockchain.Timeout)*time.Second)
+	idem, handled := h.beginIdempotent(c, "", req)
+	if handled {
+		return
+	}
+	defer idem.release()
+
+	ctx, cancel := h.broadcastContext(c)
 	defer cancel()
 
 	resp, err := h.blockchain.QueuePairingRequest(ctx, req.ComponentA, req.ComponentB, req.OperationalContext, req.ProxyID)
+	if h.chainUnavailable(c, err) {
+		return
+	}
 	if err != nil {
 		h.logger.Error().Err(err).Str("component_a", req.ComponentA).Str("component_b", req.ComponentB).Msg("Failed to queue pairing request")
 		c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("Failed to queue pairing request: %v", err)})
@@ -1076,9 +2531,10 @@ func (h *Handler) QueuePairingRequest(c *gin.Context) {
 			"timestamp":           time.Now().Unix(),
 			"tx_hash":             resp["txhash"],
 		}
-		h.eventQueue.Emit("pairing_request_queued", eventData)
+		h.emitEvent(c, "", resp, "pairing_request_queued", eventData)
 	}
 
+	idem.complete(http.StatusOK, resp)
 	c.JSON(http.StatusOK, resp)
 }
 
@@ -1090,7 +2546,7 @@ func (h *Handler) GetQueueStatus(c *gin.Context) {
 		return
 	}
 
-	ctx, cancel := context.WithTimeout(c.Request.Context(), time.Duration(h.config.Blockchain.Timeout)*time.Second)
+	ctx, cancel := h.queryContext(c)
 	defer cancel()
 
 	status, err := h.blockchain.GetQueueStatus(ctx, componentID)
@@ -1111,10 +2567,13 @@ func (h *Handler) ProcessOfflineQueue(c *gin.Context) {
 		return
 	}
 
-	ctx, cancel := context.WithTimeout(c.Request.Context(), time.Duration(h.config.Blockchain.Timeout)*time.Second)
+	ctx, cancel := h.broadcastContext(c)
 	defer cancel()
 
 	resp, err := h.blockchain.ProcessOfflineQueue(ctx, componentID)
+	if h.chainUnavailable(c, err) {
+		return
+	}
 	if err != nil {
 		h.logger.Error().Err(err).Str("component_id", componentID).Msg("Failed to process offline queue")
 		c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("Failed to process offline queue: %v", err)})
@@ -1130,7 +2589,7 @@ func (h *Handler) ProcessOfflineQueue(c *gin.Context) {
 			"timestamp":          time.Now().Unix(),
 			"tx_hash":            resp["txhash"],
 		}
-		h.eventQueue.Emit("offline_queue_processed", eventData)
+		h.emitEvent(c, "", resp, "offline_queue_processed", eventData)
 	}
 
 	c.JSON(http.StatusOK, resp)
@@ -1153,10 +2612,13 @@ func (h *Handler) CancelRequest(c *gin.Context) {
 		return
 	}
 
-	ctx, cancel := context.WithTimeout(c.Request.Context(), time.Duration(h.config.Blockchain.Timeout)*time.Second)
+	ctx, cancel := h.broadcastContext(c)
 	defer cancel()
 
 	resp, err := h.blockchain.CancelRequest(ctx, requestID, req.Reason)
+	if h.chainUnavailable(c, err) {
+		return
+	}
 	if err != nil {
 		h.logger.Error().Err(err).Str("request_id", requestID).Msg("Failed to cancel request")
 		c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("Failed to cancel request: %v", err)})
@@ -1171,7 +2633,7 @@ func (h *Handler) CancelRequest(c *gin.Context) {
 			"timestamp":  time.Now().Unix(),
 			"tx_hash":    resp["txhash"],
 		}
-		h.eventQueue.Emit("request_cancelled", eventData)
+		h.emitEvent(c, "", resp, "request_cancelled", eventData)
 	}
 
 	c.JSON(http.StatusOK, resp)
@@ -1185,7 +2647,7 @@ func (h *Handler) GetQueuedRequests(c *gin.Context) {
 		return
 	}
 
-	ctx, cancel := context.WithTimeout(c.Request.Context(), time.Duration(h.config.Blockchain.Timeout)*time.Second)
+	ctx, cancel := h.queryContext(c)
 	defer cancel()
 
 	requests, err := h.blockchain.GetQueuedRequests(ctx, componentID)
@@ -1198,7 +2660,9 @@ func (h *Handler) GetQueuedRequests(c *gin.Context) {
 	c.JSON(http.StatusOK, requests)
 }
 
-// ListProxyQueue handles proxy queue listing
+// ListProxyQueue lists a proxy's queued offline operations. status,
+// operation_type, min_age and max_age (durations such as "30m") filter the
+// queue; limit and key page through it.
 func (h *Handler) ListProxyQueue(c *gin.Context) {
 	proxyID := c.Param("id")
 	if proxyID == "" {
@@ -1206,10 +2670,45 @@ func (h *Handler) ListProxyQueue(c *gin.Context) {
 		return
 	}
 
-	ctx, cancel := context.WithTimeout(c.Request.Context(), time.Duration(h.config.Blockchain.Timeout)*time.Second)
+	filter := blockchain.ProxyQueueFilter{
+		Status:        c.Query("status"),
+		OperationType: c.Query("operation_type"),
+	}
+	if filter.Status != "" && !pairingqueuetypes.ValidOperationStatus(filter.Status) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "status must be pending, retrying or failed"})
+		return
+	}
+	for name, age := range map[string]*time.Duration{"min_age": &filter.MinAge, "max_age": &filter.MaxAge} {
+		raw := c.Query(name)
+		if raw == "" {
+			continue
+		}
+		parsed, err := time.ParseDuration(raw)
+		if err != nil || parsed < time.Second {
+			c.JSON(http.StatusBadRequest, gin.H{"error": name + " must be a duration of at least 1s, e.g. 30m"})
+			return
+		}
+		*age = parsed
+	}
+	if filter.MaxAge != 0 && filter.MinAge > filter.MaxAge {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "min_age must not exceed max_age"})
+		return
+	}
+
+	limit, ok := h.pageLimit(c, 0)
+	if !ok {
+		return
+	}
+
+	key, ok := pageKey(c)
+	if !ok {
+		return
+	}
+
+	ctx, cancel := h.queryContext(c)
 	defer cancel()
 
-	queue, err := h.blockchain.ListProxyQueue(ctx, proxyID)
+	queue, err := h.blockchain.ListProxyQueue(ctx, proxyID, filter, limit, key)
 	if err != nil {
 		h.logger.Error().Err(err).Str("proxy_id", proxyID).Msg("Failed to list proxy queue")
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to list proxy queue"})
@@ -1235,10 +2734,13 @@ func (h *Handler) CreatePairingAuthorization(c *gin.Context) {
 		return
 	}
 
-	ctx, cancel := context.WithTimeout(c.Request.Context(), time.Duration(h.config.Blockchain.Timeout)*time.Second)
+	ctx, cancel := h.broadcastContext(c)
 	defer cancel()
 
 	resp, err := h.blockchain.CreatePairingAuthorization(ctx, req.ComponentA, req.ComponentB, req.OperationalContext, req.AuthorizationRules)
+	if h.chainUnavailable(c, err) {
+		return
+	}
 	if err != nil {
 		h.logger.Error().Err(err).Str("component_a", req.ComponentA).Str("component_b", req.ComponentB).Msg("Failed to create pairing authorization")
 		c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("Failed to create pairing authorization: %v", err)})
@@ -1256,7 +2758,7 @@ func (h *Handler) CreatePairingAuthorization(c *gin.Context) {
 			"timestamp":           time.Now().Unix(),
 			"tx_hash":             resp["txhash"],
 		}
-		h.eventQueue.Emit("pairing_authorization_created", eventData)
+		h.emitEvent(c, "", resp, "pairing_authorization_created", eventData)
 	}
 
 	c.JSON(http.StatusOK, resp)
@@ -1270,7 +2772,7 @@ func (h *Handler) GetComponentAuthorizations(c *gin.Context) {
 		return
 	}
 
-	ctx, cancel := context.WithTimeout(c.Request.Context(), time.Duration(h.config.Blockchain.Timeout)*time.Second)
+	ctx, cancel := h.queryContext(c)
 	defer cancel()
 
 	authorizations, err := h.blockchain.GetComponentAuthorizations(ctx, componentID)
@@ -1313,10 +2815,13 @@ func (h *Handler) UpdateAuthorization(c *gin.Context) {
 		updates["status"] = req.Status
 	}
 
-	ctx, cancel := context.WithTimeout(c.Request.Context(), time.Duration(h.config.Blockchain.Timeout)*time.Second)
+	ctx, cancel := h.broadcastContext(c)
 	defer cancel()
 
 	resp, err := h.blockchain.UpdateAuthorization(ctx, authorizationID, updates)
+	if h.chainUnavailable(c, err) {
+		return
+	}
 	if err != nil {
 		h.logger.Error().Err(err).Str("authorization_id", authorizationID).Msg("Failed to update authorization")
 		c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("Failed to update authorization: %v", err)})
@@ -1331,7 +2836,7 @@ func (h *Handler) UpdateAuthorization(c *gin.Context) {
 			"timestamp":        time.Now().Unix(),
 			"tx_hash":          resp["txhash"],
 		}
-		h.eventQueue.Emit("authorization_updated", eventData)
+		h.emitEvent(c, "", resp, "authorization_updated", eventData)
 	}
 
 	c.JSON(http.StatusOK, resp)
@@ -1354,10 +2859,13 @@ func (h *Handler) RevokeAuthorization(c *gin.Context) {
 		return
 	}
 
-	ctx, cancel := context.WithTimeout(c.Request.Context(), time.Duration(h.config.Blockchain.Timeout)*time.Second)
+	ctx, cancel := h.broadcastContext(c)
 	defer cancel()
 
 	resp, err := h.blockchain.RevokeAuthorization(ctx, authorizationID, req.Reason)
+	if h.chainUnavailable(c, err) {
+		return
+	}
 	if err != nil {
 		h.logger.Error().Err(err).Str("authorization_id", authorizationID).Msg("Failed to revoke authorization")
 		c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("Failed to revoke authorization: %v", err)})
@@ -1372,7 +2880,7 @@ func (h *Handler) RevokeAuthorization(c *gin.Context) {
 			"timestamp":        time.Now().Unix(),
 			"tx_hash":          resp["txhash"],
 		}
-		h.eventQueue.Emit("authorization_revoked", eventData)
+		h.emitEvent(c, "", resp, "authorization_revoked", eventData)
 	}
 
 	c.JSON(http.StatusOK, resp)
@@ -1389,7 +2897,7 @@ func (h *Handler) CheckPairingAuthorization(c *gin.Context) {
 		return
 	}
 
-	ctx, cancel := context.WithTimeout(c.Request.Context(), time.Duration(h.config.Blockchain.Timeout)*time.Second)
+	ctx, cancel := h.queryContext(c)
 	defer cancel()
 
 	result, err := h.blockchain.CheckPairingAuthorization(ctx, componentA, componentB, operationalContext)
@@ -1417,10 +2925,13 @@ func (h *Handler) CalculateRelationshipTrust(c *gin.Context) {
 		return
 	}
 
-	ctx, cancel := context.WithTimeout(c.Request.Context(), time.Duration(h.config.Blockchain.Timeout)*time.Second)
+	ctx, cancel := h.broadcastContext(c)
 	defer cancel()
 
 	resp, err := h.blockchain.CalculateRelationshipTrust(ctx, req.ComponentA, req.ComponentB, req.OperationalContext)
+	if h.chainUnavailable(c, err) || h.txNotCommitted(c, err) {
+		return
+	}
 	if err != nil {
 		h.logger.Error().Err(err).Str("component_a", req.ComponentA).Str("component_b", req.ComponentB).Msg("Failed to calculate relationship trust")
 		c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("Failed to calculate relationship trust: %v", err)})
@@ -1438,7 +2949,7 @@ func (h *Handler) CalculateRelationshipTrust(c *gin.Context) {
 			"timestamp":           time.Now().Unix(),
 			"tx_hash":             resp["txhash"],
 		}
-		h.eventQueue.Emit("relationship_trust_calculated", eventData)
+		h.emitEvent(c, "", resp, "relationship_trust_calculated", eventData)
 	}
 
 	c.JSON(http.StatusOK, resp)
@@ -1454,7 +2965,7 @@ func (h *Handler) GetRelationshipTensor(c *gin.Context) {
 		return
 	}
 
-	ctx, cancel := context.WithTimeout(c.Request.Context(), time.Duration(h.config.Blockchain.Timeout)*time.Second)
+	ctx, cancel := h.queryContext(c)
 	defer cancel()
 
 	tensor, err := h.blockchain.GetRelationshipTensor(ctx, componentA, componentB)
@@ -1482,10 +2993,17 @@ func (h *Handler) UpdateTensorScore(c *gin.Context) {
 		return
 	}
 
-	ctx, cancel := context.WithTimeout(c.Request.Context(), time.Duration(h.config.Blockchain.Timeout)*time.Second)
+	ctx, cancel := h.broadcastContext(c)
 	defer cancel()
 
 	resp, err := h.blockchain.UpdateTensorScore(ctx, req.Creator, req.ComponentA, req.ComponentB, req.Score, req.Context)
+	if h.chainUnavailable(c, err) || h.txNotCommitted(c, err) {
+		return
+	}
+	if errors.Is(err, blockchain.ErrTensorUpdateUnauthorized) {
+		c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+		return
+	}
 	if err != nil {
 		h.logger.Error().Err(err).Str("creator", req.Creator).Str("component_a", req.ComponentA).Str("component_b", req.ComponentB).Msg("Failed to update tensor score")
 		c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("Failed to update tensor score: %v", err)})
@@ -1504,7 +3022,7 @@ func (h *Handler) UpdateTensorScore(c *gin.Context) {
 			"timestamp":   time.Now().Unix(),
 			"tx_hash":     resp["txhash"],
 		}
-		h.eventQueue.Emit("tensor_score_updated", eventData)
+		h.emitEvent(c, req.Creator, resp, "tensor_score_updated", eventData)
 	}
 
 	c.JSON(http.StatusOK, resp)
@@ -0,0 +1,165 @@
+package handlers
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// idempotencyCache remembers the response to each write request that carried
+// an Idempotency-Key, so a retried request is answered from the cache instead
+// of broadcasting a second transaction
+type idempotencyCache struct {
+	mu      sync.Mutex
+	ttl     time.Duration
+	entries map[string]*idempotentEntry
+	stop    chan struct{} // closed by stopPruning
+}
+
+type idempotentEntry struct {
+	fingerprint [32]byte
+	status      int
+	body        map[string]interface{}
+	inFlight    bool
+	// A completed entry is replayed until then. An in-flight one holds its
+	// key until then, in case the request never completes or releases it.
+	expires time.Time
+}
+
+// idempotencyPruneInterval is how often expired entries are dropped
+const idempotencyPruneInterval = time.Minute
+
+func newIdempotencyCache(ttl time.Duration) *idempotencyCache {
+	return &idempotencyCache{ttl: ttl, entries: make(map[string]*idempotentEntry)}
+}
+
+// idempotentRequest tracks one keyed request from begin to complete or
+// release. A nil *idempotentRequest is valid and does nothing, which is what
+// requests without an Idempotency-Key get.
+type idempotentRequest struct {
+	cache *idempotencyCache
+	key   string
+	done  bool
+}
+
+// beginIdempotent claims the request's Idempotency-Key, scoped to creator. If
+// the key was already used it writes the response itself and returns handled:
+// the cached response for a completed request, 409 while the first request is
+// still running, or 422 when the key is reused with a different payload.
+// Otherwise the caller must complete or release the returned request.
+func (h *Handler) beginIdempotent(c *gin.Context, creator string, payload interface{}) (req *idempotentRequest, handled bool) {
+	if h.idempotency == nil {
+		return nil, false
+	}
+	key := operationKey(c, idempotencyScope(c, creator), nil)
+	if key == "" {
+		return nil, false
+	}
+
+	raw, err := json.Marshal(payload)
+	if err != nil {
+		return nil, false
+	}
+	fingerprint := sha256.Sum256(raw)
+
+	cache := h.idempotency
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	now := time.Now()
+	if entry, ok := cache.entries[key]; ok && !now.After(entry.expires) {
+		switch {
+		case entry.fingerprint != fingerprint:
+			c.JSON(http.StatusUnprocessableEntity, gin.H{"error": "Idempotency-Key was already used with a different request"})
+		case entry.inFlight:
+			c.JSON(http.StatusConflict, gin.H{"error": "A request with this Idempotency-Key is still in progress"})
+		default:
+			c.Header("Idempotent-Replayed", "true")
+			c.JSON(entry.status, entry.body)
+		}
+		return nil, true
+	}
+
+	cache.entries[key] = &idempotentEntry{fingerprint: fingerprint, inFlight: true, expires: now.Add(cache.ttl)}
+	return &idempotentRequest{cache: cache, key: key}, false
+}
+
+// complete caches the response for replay until the TTL runs out
+func (r *idempotentRequest) complete(status int, body map[string]interface{}) {
+	if r == nil || r.done {
+		return
+	}
+	r.done = true
+
+	r.cache.mu.Lock()
+	defer r.cache.mu.Unlock()
+	if entry, ok := r.cache.entries[r.key]; ok {
+		entry.status = status
+		entry.body = body
+		entry.inFlight = false
+		entry.expires = time.Now().Add(r.cache.ttl)
+	}
+}
+
+// release forgets a request that did not complete, so a retry runs it again.
+// Failures are not cached: a retry after a failed broadcast should broadcast.
+func (r *idempotentRequest) release() {
+	if r == nil || r.done {
+		return
+	}
+	r.done = true
+
+	r.cache.mu.Lock()
+	defer r.cache.mu.Unlock()
+	delete(r.cache.entries, r.key)
+}
+
+// prune drops entries past their TTL; callers hold mu
+func (ic *idempotencyCache) prune(now time.Time) {
+	for key, entry := range ic.entries {
+		if now.After(entry.expires) {
+			delete(ic.entries, key)
+		}
+	}
+}
+
+// startPruning drops expired entries every interval until stopPruning is
+// called, so their memory is freed even when no further keyed request comes
+func (ic *idempotencyCache) startPruning(interval time.Duration) {
+	stop := make(chan struct{})
+	ic.stop = stop
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case now := <-ticker.C:
+				ic.mu.Lock()
+				ic.prune(now)
+				ic.mu.Unlock()
+			case <-stop:
+				return
+			}
+		}
+	}()
+}
+
+func (ic *idempotencyCache) stopPruning() {
+	if ic.stop != nil {
+		close(ic.stop)
+		ic.stop = nil
+	}
+}
+
+// idempotencyScope keys a request to its creator, falling back to the
+// authenticated user for endpoints whose payload names no creator
+func idempotencyScope(c *gin.Context, creator string) string {
+	if creator != "" {
+		return creator
+	}
+	return "user:" + c.GetString("user_id")
+}
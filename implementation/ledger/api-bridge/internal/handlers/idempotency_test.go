@@ -0,0 +1,166 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// idempotentRouter serves a write endpoint shaped like RegisterComponent that
+// counts how many times it reaches the chain
+func idempotentRouter(h *Handler, broadcasts *int, fail *bool) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.POST("/write", func(c *gin.Context) {
+		var req struct {
+			Creator       string `json:"creator" binding:"required"`
+			ComponentData string `json:"component_data" binding:"required"`
+		}
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		idem, handled := h.beginIdempotent(c, req.Creator, req)
+		if handled {
+			return
+		}
+		defer idem.release()
+
+		*broadcasts++
+		if *fail {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "broadcast failed"})
+			return
+		}
+		resp := map[string]interface{}{"component_id": req.ComponentData, "broadcast": *broadcasts}
+		idem.complete(http.StatusOK, resp)
+		c.JSON(http.StatusOK, resp)
+	})
+	return router
+}
+
+func postWrite(router *gin.Engine, key, body string) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/write", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	if key != "" {
+		req.Header.Set("Idempotency-Key", key)
+	}
+	router.ServeHTTP(w, req)
+	return w
+}
+
+func TestIdempotencyKeyReplaysResponse(t *testing.T) {
+	broadcasts, fail := 0, false
+	router := idempotentRouter(&Handler{idempotency: newIdempotencyCache(time.Hour)}, &broadcasts, &fail)
+	alice := `{"creator": "cosmos1alice", "component_data": "MODBATT-MOD-001"}`
+
+	first := postWrite(router, "reg-001", alice)
+	require.Equal(t, http.StatusOK, first.Code)
+	retry := postWrite(router, "reg-001", alice)
+	require.Equal(t, http.StatusOK, retry.Code)
+
+	assert.Equal(t, 1, broadcasts)
+	assert.Equal(t, "true", retry.Header().Get("Idempotent-Replayed"))
+	assert.JSONEq(t, first.Body.String(), retry.Body.String())
+
+	// Keys are scoped per creator, so another tenant's identical key is a new operation
+	bob := postWrite(router, "reg-001", `{"creator": "cosmos1bob", "component_data": "MODBATT-MOD-001"}`)
+	require.Equal(t, http.StatusOK, bob.Code)
+	assert.Empty(t, bob.Header().Get("Idempotent-Replayed"))
+	assert.Equal(t, 2, broadcasts)
+
+	// Reusing a key for a different payload is rejected rather than replayed
+	mismatch := postWrite(router, "reg-001", `{"creator": "cosmos1alice", "component_data": "MODBATT-MOD-002"}`)
+	assert.Equal(t, http.StatusUnprocessableEntity, mismatch.Code)
+
+	// Requests without a key are never deduplicated
+	postWrite(router, "", alice)
+	postWrite(router, "", alice)
+	assert.Equal(t, 4, broadcasts)
+}
+
+func TestIdempotencyKeyDoesNotCacheFailures(t *testing.T) {
+	broadcasts, fail := 0, true
+	router := idempotentRouter(&Handler{idempotency: newIdempotencyCache(time.Hour)}, &broadcasts, &fail)
+	body := `{"creator": "cosmos1alice", "component_data": "MODBATT-MOD-001"}`
+
+	require.Equal(t, http.StatusInternalServerError, postWrite(router, "reg-001", body).Code)
+
+	fail = false
+	w := postWrite(router, "reg-001", body)
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, 2, broadcasts)
+
+	var resp map[string]interface{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	assert.Equal(t, float64(2), resp["broadcast"])
+}
+
+func TestIdempotencyKeyInFlightAndExpiry(t *testing.T) {
+	h := &Handler{idempotency: newIdempotencyCache(time.Hour)}
+	payload := map[string]string{"component_data": "MODBATT-MOD-001"}
+
+	c, _ := gin.CreateTestContext(httptest.NewRecorder())
+	c.Request = httptest.NewRequest(http.MethodPost, "/write", nil)
+	c.Request.Header.Set("Idempotency-Key", "reg-001")
+	first, handled := h.beginIdempotent(c, "cosmos1alice", payload)
+	require.False(t, handled)
+
+	// A retry that arrives while the first request is still broadcasting
+	w := httptest.NewRecorder()
+	retry, _ := gin.CreateTestContext(w)
+	retry.Request = c.Request.Clone(c.Request.Context())
+	_, handled = h.beginIdempotent(retry, "cosmos1alice", payload)
+	require.True(t, handled)
+	assert.Equal(t, http.StatusConflict, w.Code)
+
+	first.complete(http.StatusOK, map[string]interface{}{"component_id": "MODBATT-MOD-001"})
+
+	// Once the TTL passes the key can be used again
+	h.idempotency.mu.Lock()
+	h.idempotency.prune(time.Now().Add(2 * time.Hour))
+	assert.Empty(t, h.idempotency.entries)
+	h.idempotency.mu.Unlock()
+}
+
+func TestIdempotencyKeyAbandonedRequestExpires(t *testing.T) {
+	h := &Handler{idempotency: newIdempotencyCache(20 * time.Millisecond)}
+	payload := map[string]string{"component_data": "MODBATT-MOD-001"}
+	begin := func() (*httptest.ResponseRecorder, bool) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodPost, "/write", nil)
+		c.Request.Header.Set("Idempotency-Key", "reg-001")
+		_, handled := h.beginIdempotent(c, "cosmos1alice", payload)
+		return w, handled
+	}
+
+	// The first request never completes or releases its key, e.g. it panicked
+	_, handled := begin()
+	require.False(t, handled)
+	w, handled := begin()
+	require.True(t, handled)
+	assert.Equal(t, http.StatusConflict, w.Code)
+
+	// Past the TTL the key runs again, and pruning frees the entry without
+	// waiting for another keyed request
+	time.Sleep(30 * time.Millisecond)
+	_, handled = begin()
+	require.False(t, handled)
+
+	h.idempotency.startPruning(5 * time.Millisecond)
+	defer h.idempotency.stopPruning()
+	require.Eventually(t, func() bool {
+		h.idempotency.mu.Lock()
+		defer h.idempotency.mu.Unlock()
+		return len(h.idempotency.entries) == 0
+	}, time.Second, 5*time.Millisecond)
+}
@@ -0,0 +1,65 @@
+package handlers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"api-bridge/internal/blockchain"
+	"api-bridge/internal/config"
+)
+
+func TestUpdateLCTStatusRejectsUnknownStatus(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	node := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request to %s", r.URL.Path)
+	}))
+	defer node.Close()
+
+	client, err := blockchain.NewClient(node.URL, zerolog.Nop())
+	require.NoError(t, err)
+	h := &Handler{
+		config:     &config.Config{Blockchain: config.BlockchainConfig{Timeout: 5}},
+		logger:     zerolog.Nop(),
+		blockchain: client,
+	}
+	router := gin.New()
+	router.PUT("/api/v1/lct/:id/status", h.UpdateLCTStatus)
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodPut, "/api/v1/lct/lct-MODBATT-PACK-001-MODBATT-MOD-001/status",
+		strings.NewReader(`{"creator": "cosmos1racecar", "status": "paused"}`)))
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Contains(t, w.Body.String(), `invalid LCT status \"paused\"`)
+}
+
+func TestGetComponentRelationshipsRejectsUnknownStatus(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	node := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request to %s", r.URL.Path)
+	}))
+	defer node.Close()
+
+	client, err := blockchain.NewClient(node.URL, zerolog.Nop())
+	require.NoError(t, err)
+	h := &Handler{
+		config:     &config.Config{Blockchain: config.BlockchainConfig{Timeout: 5}},
+		logger:     zerolog.Nop(),
+		blockchain: client,
+	}
+	router := gin.New()
+	router.GET("/api/v1/components/:id/relationships", h.GetComponentRelationships)
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/v1/components/MODBATT-MOD-001/relationships?status=revoked", nil))
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Contains(t, w.Body.String(), `invalid LCT status \"revoked\"`)
+}
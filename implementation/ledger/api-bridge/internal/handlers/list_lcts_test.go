@@ -0,0 +1,38 @@
+package handlers
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"api-bridge/internal/blockchain"
+)
+
+func TestListLCTsFilters(t *testing.T) {
+	h, client := newMockedHandler(t)
+	router := gin.New()
+	router.GET("/lcts", h.ListLCTs)
+
+	// The context is normalized the way LCTs store it
+	client.EXPECT().ListLCTs(gomock.Any(), blockchain.LCTFilter{
+		ComponentID:        "MODBATT-PACK-001",
+		Status:             "active",
+		OperationalContext: "race_day",
+	}, uint64(25), "bGN0LTI=").Return(map[string]interface{}{"lcts": []interface{}{}, "count": 0, "next_key": ""}, nil)
+	w := serve(router, http.MethodGet, "/lcts?component=MODBATT-PACK-001&status=active&context=Race-Day&limit=25&key=bGN0LTI=", "")
+	assert.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+	client.EXPECT().ListLCTs(gomock.Any(), blockchain.LCTFilter{}, uint64(0), "").
+		Return(map[string]interface{}{"lcts": []interface{}{}, "count": 0, "next_key": ""}, nil)
+	w = serve(router, http.MethodGet, "/lcts", "")
+	assert.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+	// Invalid filters are rejected without querying the chain
+	for _, query := range []string{"status=paired", "context=race/day", "key=not-base64!"} {
+		w = serve(router, http.MethodGet, "/lcts?"+query, "")
+		assert.Equal(t, http.StatusBadRequest, w.Code, query)
+	}
+}
@@ -0,0 +1,1099 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: blockchain_client.go
+//
+// Generated by this command:
+//
+//	mockgen -source=blockchain_client.go -destination=mock_blockchain_client_test.go -package=handlers
+//
+
+// Package handlers is a generated GoMock package.
+package handlers
+
+import (
+	blockchain "api-bridge/internal/blockchain"
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockBlockchainClient is a mock of BlockchainClient interface.
+type MockBlockchainClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockBlockchainClientMockRecorder
+	isgomock struct{}
+}
+
+// MockBlockchainClientMockRecorder is the mock recorder for MockBlockchainClient.
+type MockBlockchainClientMockRecorder struct {
+	mock *MockBlockchainClient
+}
+
+// NewMockBlockchainClient creates a new mock instance.
+func NewMockBlockchainClient(ctrl *gomock.Controller) *MockBlockchainClient {
+	mock := &MockBlockchainClient{ctrl: ctrl}
+	mock.recorder = &MockBlockchainClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBlockchainClient) EXPECT() *MockBlockchainClientMockRecorder {
+	return m.recorder
+}
+
+// BreakerStats mocks base method.
+func (m *MockBlockchainClient) BreakerStats() blockchain.BreakerStats {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BreakerStats")
+	ret0, _ := ret[0].(blockchain.BreakerStats)
+	return ret0
+}
+
+// BreakerStats indicates an expected call of BreakerStats.
+func (mr *MockBlockchainClientMockRecorder) BreakerStats() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BreakerStats", reflect.TypeOf((*MockBlockchainClient)(nil).BreakerStats))
+}
+
+// CacheStats mocks base method.
+func (m *MockBlockchainClient) CacheStats() blockchain.CacheStats {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CacheStats")
+	ret0, _ := ret[0].(blockchain.CacheStats)
+	return ret0
+}
+
+// CacheStats indicates an expected call of CacheStats.
+func (mr *MockBlockchainClientMockRecorder) CacheStats() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CacheStats", reflect.TypeOf((*MockBlockchainClient)(nil).CacheStats))
+}
+
+// CalculateRelationshipTrust mocks base method.
+func (m *MockBlockchainClient) CalculateRelationshipTrust(ctx context.Context, componentA, componentB, operationalContext string) (map[string]any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CalculateRelationshipTrust", ctx, componentA, componentB, operationalContext)
+	ret0, _ := ret[0].(map[string]any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CalculateRelationshipTrust indicates an expected call of CalculateRelationshipTrust.
+func (mr *MockBlockchainClientMockRecorder) CalculateRelationshipTrust(ctx, componentA, componentB, operationalContext any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CalculateRelationshipTrust", reflect.TypeOf((*MockBlockchainClient)(nil).CalculateRelationshipTrust), ctx, componentA, componentB, operationalContext)
+}
+
+// CancelRequest mocks base method.
+func (m *MockBlockchainClient) CancelRequest(ctx context.Context, requestID, reason string) (map[string]any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelRequest", ctx, requestID, reason)
+	ret0, _ := ret[0].(map[string]any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelRequest indicates an expected call of CancelRequest.
+func (mr *MockBlockchainClientMockRecorder) CancelRequest(ctx, requestID, reason any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelRequest", reflect.TypeOf((*MockBlockchainClient)(nil).CancelRequest), ctx, requestID, reason)
+}
+
+// CheckConsistency mocks base method.
+func (m *MockBlockchainClient) CheckConsistency(ctx context.Context) (*blockchain.ConsistencyReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckConsistency", ctx)
+	ret0, _ := ret[0].(*blockchain.ConsistencyReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckConsistency indicates an expected call of CheckConsistency.
+func (mr *MockBlockchainClientMockRecorder) CheckConsistency(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckConsistency", reflect.TypeOf((*MockBlockchainClient)(nil).CheckConsistency), ctx)
+}
+
+// CheckPairingAuthorization mocks base method.
+func (m *MockBlockchainClient) CheckPairingAuthorization(ctx context.Context, componentA, componentB, operationalContext string) (map[string]any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckPairingAuthorization", ctx, componentA, componentB, operationalContext)
+	ret0, _ := ret[0].(map[string]any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckPairingAuthorization indicates an expected call of CheckPairingAuthorization.
+func (mr *MockBlockchainClientMockRecorder) CheckPairingAuthorization(ctx, componentA, componentB, operationalContext any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckPairingAuthorization", reflect.TypeOf((*MockBlockchainClient)(nil).CheckPairingAuthorization), ctx, componentA, componentB, operationalContext)
+}
+
+// CompletePairing mocks base method.
+func (m *MockBlockchainClient) CompletePairing(ctx context.Context, creator, challengeID, componentAAuth, componentBAuth, sessionContext string) (map[string]any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompletePairing", ctx, creator, challengeID, componentAAuth, componentBAuth, sessionContext)
+	ret0, _ := ret[0].(map[string]any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CompletePairing indicates an expected call of CompletePairing.
+func (mr *MockBlockchainClientMockRecorder) CompletePairing(ctx, creator, challengeID, componentAAuth, componentBAuth, sessionContext any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompletePairing", reflect.TypeOf((*MockBlockchainClient)(nil).CompletePairing), ctx, creator, challengeID, componentAAuth, componentBAuth, sessionContext)
+}
+
+// CreateAnonymousPairingAuthorization mocks base method.
+func (m *MockBlockchainClient) CreateAnonymousPairingAuthorization(ctx context.Context, creator, componentHashA, componentHashB, ruleHash, trustScoreRequirement, authorizationLevel string) (map[string]any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAnonymousPairingAuthorization", ctx, creator, componentHashA, componentHashB, ruleHash, trustScoreRequirement, authorizationLevel)
+	ret0, _ := ret[0].(map[string]any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAnonymousPairingAuthorization indicates an expected call of CreateAnonymousPairingAuthorization.
+func (mr *MockBlockchainClientMockRecorder) CreateAnonymousPairingAuthorization(ctx, creator, componentHashA, componentHashB, ruleHash, trustScoreRequirement, authorizationLevel any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAnonymousPairingAuthorization", reflect.TypeOf((*MockBlockchainClient)(nil).CreateAnonymousPairingAuthorization), ctx, creator, componentHashA, componentHashB, ruleHash, trustScoreRequirement, authorizationLevel)
+}
+
+// CreateAnonymousRevocationEvent mocks base method.
+func (m *MockBlockchainClient) CreateAnonymousRevocationEvent(ctx context.Context, creator, targetHash, revocationType, urgencyLevel, reasonCategory, arg6 string) (map[string]any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAnonymousRevocationEvent", ctx, creator, targetHash, revocationType, urgencyLevel, reasonCategory, arg6)
+	ret0, _ := ret[0].(map[string]any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAnonymousRevocationEvent indicates an expected call of CreateAnonymousRevocationEvent.
+func (mr *MockBlockchainClientMockRecorder) CreateAnonymousRevocationEvent(ctx, creator, targetHash, revocationType, urgencyLevel, reasonCategory, arg6 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAnonymousRevocationEvent", reflect.TypeOf((*MockBlockchainClient)(nil).CreateAnonymousRevocationEvent), ctx, creator, targetHash, revocationType, urgencyLevel, reasonCategory, arg6)
+}
+
+// CreateEnergyOperation mocks base method.
+func (m *MockBlockchainClient) CreateEnergyOperation(ctx context.Context, creator, componentA, componentB, operationType string, amount float64, arg6 string) (map[string]any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateEnergyOperation", ctx, creator, componentA, componentB, operationType, amount, arg6)
+	ret0, _ := ret[0].(map[string]any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateEnergyOperation indicates an expected call of CreateEnergyOperation.
+func (mr *MockBlockchainClientMockRecorder) CreateEnergyOperation(ctx, creator, componentA, componentB, operationType, amount, arg6 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEnergyOperation", reflect.TypeOf((*MockBlockchainClient)(nil).CreateEnergyOperation), ctx, creator, componentA, componentB, operationType, amount, arg6)
+}
+
+// CreateLCT mocks base method.
+func (m *MockBlockchainClient) CreateLCT(ctx context.Context, creator, componentA, componentB, arg4, proxyID string) (map[string]any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateLCT", ctx, creator, componentA, componentB, arg4, proxyID)
+	ret0, _ := ret[0].(map[string]any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateLCT indicates an expected call of CreateLCT.
+func (mr *MockBlockchainClientMockRecorder) CreateLCT(ctx, creator, componentA, componentB, arg4, proxyID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLCT", reflect.TypeOf((*MockBlockchainClient)(nil).CreateLCT), ctx, creator, componentA, componentB, arg4, proxyID)
+}
+
+// CreatePairingAuthorization mocks base method.
+func (m *MockBlockchainClient) CreatePairingAuthorization(ctx context.Context, componentA, componentB, operationalContext, authorizationRules string) (map[string]any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePairingAuthorization", ctx, componentA, componentB, operationalContext, authorizationRules)
+	ret0, _ := ret[0].(map[string]any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePairingAuthorization indicates an expected call of CreatePairingAuthorization.
+func (mr *MockBlockchainClientMockRecorder) CreatePairingAuthorization(ctx, componentA, componentB, operationalContext, authorizationRules any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePairingAuthorization", reflect.TypeOf((*MockBlockchainClient)(nil).CreatePairingAuthorization), ctx, componentA, componentB, operationalContext, authorizationRules)
+}
+
+// CreateTrustTensor mocks base method.
+func (m *MockBlockchainClient) CreateTrustTensor(ctx context.Context, creator, componentA, componentB, arg4 string, initialScore float64, dimensions map[string]float64) (map[string]any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTrustTensor", ctx, creator, componentA, componentB, arg4, initialScore, dimensions)
+	ret0, _ := ret[0].(map[string]any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTrustTensor indicates an expected call of CreateTrustTensor.
+func (mr *MockBlockchainClientMockRecorder) CreateTrustTensor(ctx, creator, componentA, componentB, arg4, initialScore, dimensions any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTrustTensor", reflect.TypeOf((*MockBlockchainClient)(nil).CreateTrustTensor), ctx, creator, componentA, componentB, arg4, initialScore, dimensions)
+}
+
+// ExecuteEnergyTransfer mocks base method.
+func (m *MockBlockchainClient) ExecuteEnergyTransfer(ctx context.Context, creator, operationID string, amount float64, energyOut, arg5 string) (map[string]any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExecuteEnergyTransfer", ctx, creator, operationID, amount, energyOut, arg5)
+	ret0, _ := ret[0].(map[string]any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExecuteEnergyTransfer indicates an expected call of ExecuteEnergyTransfer.
+func (mr *MockBlockchainClientMockRecorder) ExecuteEnergyTransfer(ctx, creator, operationID, amount, energyOut, arg5 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteEnergyTransfer", reflect.TypeOf((*MockBlockchainClient)(nil).ExecuteEnergyTransfer), ctx, creator, operationID, amount, energyOut, arg5)
+}
+
+// GetAccountDetails mocks base method.
+func (m *MockBlockchainClient) GetAccountDetails(ctx context.Context, accounts []*blockchain.Account) []blockchain.AccountDetails {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccountDetails", ctx, accounts)
+	ret0, _ := ret[0].([]blockchain.AccountDetails)
+	return ret0
+}
+
+// GetAccountDetails indicates an expected call of GetAccountDetails.
+func (mr *MockBlockchainClientMockRecorder) GetAccountDetails(ctx, accounts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountDetails", reflect.TypeOf((*MockBlockchainClient)(nil).GetAccountDetails), ctx, accounts)
+}
+
+// GetAccountManager mocks base method.
+func (m *MockBlockchainClient) GetAccountManager() *blockchain.AccountManager {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccountManager")
+	ret0, _ := ret[0].(*blockchain.AccountManager)
+	return ret0
+}
+
+// GetAccountManager indicates an expected call of GetAccountManager.
+func (mr *MockBlockchainClientMockRecorder) GetAccountManager() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountManager", reflect.TypeOf((*MockBlockchainClient)(nil).GetAccountManager))
+}
+
+// GetAnonymousComponentMetadata mocks base method.
+func (m *MockBlockchainClient) GetAnonymousComponentMetadata(ctx context.Context, requester, componentHash string) (map[string]any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAnonymousComponentMetadata", ctx, requester, componentHash)
+	ret0, _ := ret[0].(map[string]any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAnonymousComponentMetadata indicates an expected call of GetAnonymousComponentMetadata.
+func (mr *MockBlockchainClientMockRecorder) GetAnonymousComponentMetadata(ctx, requester, componentHash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAnonymousComponentMetadata", reflect.TypeOf((*MockBlockchainClient)(nil).GetAnonymousComponentMetadata), ctx, requester, componentHash)
+}
+
+// GetComponent mocks base method.
+func (m *MockBlockchainClient) GetComponent(ctx context.Context, componentID string) (map[string]any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetComponent", ctx, componentID)
+	ret0, _ := ret[0].(map[string]any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetComponent indicates an expected call of GetComponent.
+func (mr *MockBlockchainClientMockRecorder) GetComponent(ctx, componentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetComponent", reflect.TypeOf((*MockBlockchainClient)(nil).GetComponent), ctx, componentID)
+}
+
+// GetComponentAuthorizations mocks base method.
+func (m *MockBlockchainClient) GetComponentAuthorizations(ctx context.Context, componentID string) (map[string]any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetComponentAuthorizations", ctx, componentID)
+	ret0, _ := ret[0].(map[string]any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetComponentAuthorizations indicates an expected call of GetComponentAuthorizations.
+func (mr *MockBlockchainClientMockRecorder) GetComponentAuthorizations(ctx, componentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetComponentAuthorizations", reflect.TypeOf((*MockBlockchainClient)(nil).GetComponentAuthorizations), ctx, componentID)
+}
+
+// GetComponentHealth mocks base method.
+func (m *MockBlockchainClient) GetComponentHealth(ctx context.Context, staleAfter time.Duration) (map[string]any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetComponentHealth", ctx, staleAfter)
+	ret0, _ := ret[0].(map[string]any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetComponentHealth indicates an expected call of GetComponentHealth.
+func (mr *MockBlockchainClientMockRecorder) GetComponentHealth(ctx, staleAfter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetComponentHealth", reflect.TypeOf((*MockBlockchainClient)(nil).GetComponentHealth), ctx, staleAfter)
+}
+
+// GetComponentHistory mocks base method.
+func (m *MockBlockchainClient) GetComponentHistory(ctx context.Context, componentID string) (map[string]any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetComponentHistory", ctx, componentID)
+	ret0, _ := ret[0].(map[string]any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetComponentHistory indicates an expected call of GetComponentHistory.
+func (mr *MockBlockchainClientMockRecorder) GetComponentHistory(ctx, componentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetComponentHistory", reflect.TypeOf((*MockBlockchainClient)(nil).GetComponentHistory), ctx, componentID)
+}
+
+// GetComponentIdentity mocks base method.
+func (m *MockBlockchainClient) GetComponentIdentity(ctx context.Context, componentID string) (map[string]any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetComponentIdentity", ctx, componentID)
+	ret0, _ := ret[0].(map[string]any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetComponentIdentity indicates an expected call of GetComponentIdentity.
+func (mr *MockBlockchainClientMockRecorder) GetComponentIdentity(ctx, componentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetComponentIdentity", reflect.TypeOf((*MockBlockchainClient)(nil).GetComponentIdentity), ctx, componentID)
+}
+
+// GetComponentMetadata mocks base method.
+func (m *MockBlockchainClient) GetComponentMetadata(ctx context.Context, componentID string) (map[string]any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetComponentMetadata", ctx, componentID)
+	ret0, _ := ret[0].(map[string]any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetComponentMetadata indicates an expected call of GetComponentMetadata.
+func (mr *MockBlockchainClientMockRecorder) GetComponentMetadata(ctx, componentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetComponentMetadata", reflect.TypeOf((*MockBlockchainClient)(nil).GetComponentMetadata), ctx, componentID)
+}
+
+// GetComponentRelationships mocks base method.
+func (m *MockBlockchainClient) GetComponentRelationships(ctx context.Context, componentID, status string) ([]blockchain.LctSummary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetComponentRelationships", ctx, componentID, status)
+	ret0, _ := ret[0].([]blockchain.LctSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetComponentRelationships indicates an expected call of GetComponentRelationships.
+func (mr *MockBlockchainClientMockRecorder) GetComponentRelationships(ctx, componentID, status any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetComponentRelationships", reflect.TypeOf((*MockBlockchainClient)(nil).GetComponentRelationships), ctx, componentID, status)
+}
+
+// GetCustodyChain mocks base method.
+func (m *MockBlockchainClient) GetCustodyChain(ctx context.Context, componentID string) ([]blockchain.CustodyEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCustodyChain", ctx, componentID)
+	ret0, _ := ret[0].([]blockchain.CustodyEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCustodyChain indicates an expected call of GetCustodyChain.
+func (mr *MockBlockchainClientMockRecorder) GetCustodyChain(ctx, componentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCustodyChain", reflect.TypeOf((*MockBlockchainClient)(nil).GetCustodyChain), ctx, componentID)
+}
+
+// GetEnergyBalance mocks base method.
+func (m *MockBlockchainClient) GetEnergyBalance(ctx context.Context, componentID string) (map[string]any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEnergyBalance", ctx, componentID)
+	ret0, _ := ret[0].(map[string]any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEnergyBalance indicates an expected call of GetEnergyBalance.
+func (mr *MockBlockchainClientMockRecorder) GetEnergyBalance(ctx, componentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEnergyBalance", reflect.TypeOf((*MockBlockchainClient)(nil).GetEnergyBalance), ctx, componentID)
+}
+
+// GetEnergyOperation mocks base method.
+func (m *MockBlockchainClient) GetEnergyOperation(ctx context.Context, operationID string) (map[string]any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEnergyOperation", ctx, operationID)
+	ret0, _ := ret[0].(map[string]any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEnergyOperation indicates an expected call of GetEnergyOperation.
+func (mr *MockBlockchainClientMockRecorder) GetEnergyOperation(ctx, operationID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEnergyOperation", reflect.TypeOf((*MockBlockchainClient)(nil).GetEnergyOperation), ctx, operationID)
+}
+
+// GetIgnitePath mocks base method.
+func (m *MockBlockchainClient) GetIgnitePath() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIgnitePath")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// GetIgnitePath indicates an expected call of GetIgnitePath.
+func (mr *MockBlockchainClientMockRecorder) GetIgnitePath() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIgnitePath", reflect.TypeOf((*MockBlockchainClient)(nil).GetIgnitePath))
+}
+
+// GetKeyExchange mocks base method.
+func (m *MockBlockchainClient) GetKeyExchange(ctx context.Context, lctID string) (map[string]any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetKeyExchange", ctx, lctID)
+	ret0, _ := ret[0].(map[string]any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetKeyExchange indicates an expected call of GetKeyExchange.
+func (mr *MockBlockchainClientMockRecorder) GetKeyExchange(ctx, lctID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetKeyExchange", reflect.TypeOf((*MockBlockchainClient)(nil).GetKeyExchange), ctx, lctID)
+}
+
+// GetLCT mocks base method.
+func (m *MockBlockchainClient) GetLCT(ctx context.Context, lctID string) (map[string]any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLCT", ctx, lctID)
+	ret0, _ := ret[0].(map[string]any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLCT indicates an expected call of GetLCT.
+func (mr *MockBlockchainClientMockRecorder) GetLCT(ctx, lctID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLCT", reflect.TypeOf((*MockBlockchainClient)(nil).GetLCT), ctx, lctID)
+}
+
+// GetLctBetween mocks base method.
+func (m *MockBlockchainClient) GetLctBetween(ctx context.Context, componentA, componentB, operationalContext string) (map[string]any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLctBetween", ctx, componentA, componentB, operationalContext)
+	ret0, _ := ret[0].(map[string]any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLctBetween indicates an expected call of GetLctBetween.
+func (mr *MockBlockchainClientMockRecorder) GetLctBetween(ctx, componentA, componentB, operationalContext any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLctBetween", reflect.TypeOf((*MockBlockchainClient)(nil).GetLctBetween), ctx, componentA, componentB, operationalContext)
+}
+
+// GetLctsByProxy mocks base method.
+func (m *MockBlockchainClient) GetLctsByProxy(ctx context.Context, proxyID string, limit uint64, key string) (map[string]any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLctsByProxy", ctx, proxyID, limit, key)
+	ret0, _ := ret[0].(map[string]any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLctsByProxy indicates an expected call of GetLctsByProxy.
+func (mr *MockBlockchainClientMockRecorder) GetLctsByProxy(ctx, proxyID, limit, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLctsByProxy", reflect.TypeOf((*MockBlockchainClient)(nil).GetLctsByProxy), ctx, proxyID, limit, key)
+}
+
+// GetNetworkEnergyBalance mocks base method.
+func (m *MockBlockchainClient) GetNetworkEnergyBalance(ctx context.Context, rootComponentID string) (map[string]any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNetworkEnergyBalance", ctx, rootComponentID)
+	ret0, _ := ret[0].(map[string]any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNetworkEnergyBalance indicates an expected call of GetNetworkEnergyBalance.
+func (mr *MockBlockchainClientMockRecorder) GetNetworkEnergyBalance(ctx, rootComponentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNetworkEnergyBalance", reflect.TypeOf((*MockBlockchainClient)(nil).GetNetworkEnergyBalance), ctx, rootComponentID)
+}
+
+// GetOperationEfficiency mocks base method.
+func (m *MockBlockchainClient) GetOperationEfficiency(ctx context.Context, operationID string) (map[string]any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOperationEfficiency", ctx, operationID)
+	ret0, _ := ret[0].(map[string]any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOperationEfficiency indicates an expected call of GetOperationEfficiency.
+func (mr *MockBlockchainClientMockRecorder) GetOperationEfficiency(ctx, operationID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOperationEfficiency", reflect.TypeOf((*MockBlockchainClient)(nil).GetOperationEfficiency), ctx, operationID)
+}
+
+// GetPairingRules mocks base method.
+func (m *MockBlockchainClient) GetPairingRules(ctx context.Context, sourceType string, limit uint64, key string) (map[string]any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPairingRules", ctx, sourceType, limit, key)
+	ret0, _ := ret[0].(map[string]any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPairingRules indicates an expected call of GetPairingRules.
+func (mr *MockBlockchainClientMockRecorder) GetPairingRules(ctx, sourceType, limit, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPairingRules", reflect.TypeOf((*MockBlockchainClient)(nil).GetPairingRules), ctx, sourceType, limit, key)
+}
+
+// GetPairingStatus mocks base method.
+func (m *MockBlockchainClient) GetPairingStatus(ctx context.Context, challengeID string) (map[string]any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPairingStatus", ctx, challengeID)
+	ret0, _ := ret[0].(map[string]any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPairingStatus indicates an expected call of GetPairingStatus.
+func (mr *MockBlockchainClientMockRecorder) GetPairingStatus(ctx, challengeID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPairingStatus", reflect.TypeOf((*MockBlockchainClient)(nil).GetPairingStatus), ctx, challengeID)
+}
+
+// GetProjectRoot mocks base method.
+func (m *MockBlockchainClient) GetProjectRoot() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProjectRoot")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// GetProjectRoot indicates an expected call of GetProjectRoot.
+func (mr *MockBlockchainClientMockRecorder) GetProjectRoot() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProjectRoot", reflect.TypeOf((*MockBlockchainClient)(nil).GetProjectRoot))
+}
+
+// GetQueueStatus mocks base method.
+func (m *MockBlockchainClient) GetQueueStatus(ctx context.Context, componentID string) (map[string]any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetQueueStatus", ctx, componentID)
+	ret0, _ := ret[0].(map[string]any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetQueueStatus indicates an expected call of GetQueueStatus.
+func (mr *MockBlockchainClientMockRecorder) GetQueueStatus(ctx, componentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQueueStatus", reflect.TypeOf((*MockBlockchainClient)(nil).GetQueueStatus), ctx, componentID)
+}
+
+// GetQueuedRequests mocks base method.
+func (m *MockBlockchainClient) GetQueuedRequests(ctx context.Context, componentID string) (map[string]any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetQueuedRequests", ctx, componentID)
+	ret0, _ := ret[0].(map[string]any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetQueuedRequests indicates an expected call of GetQueuedRequests.
+func (mr *MockBlockchainClientMockRecorder) GetQueuedRequests(ctx, componentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQueuedRequests", reflect.TypeOf((*MockBlockchainClient)(nil).GetQueuedRequests), ctx, componentID)
+}
+
+// GetRecallScope mocks base method.
+func (m *MockBlockchainClient) GetRecallScope(ctx context.Context, criteria blockchain.RecallCriteria, includeOwners bool) (*blockchain.RecallScope, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRecallScope", ctx, criteria, includeOwners)
+	ret0, _ := ret[0].(*blockchain.RecallScope)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRecallScope indicates an expected call of GetRecallScope.
+func (mr *MockBlockchainClientMockRecorder) GetRecallScope(ctx, criteria, includeOwners any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecallScope", reflect.TypeOf((*MockBlockchainClient)(nil).GetRecallScope), ctx, criteria, includeOwners)
+}
+
+// GetRelationshipTensor mocks base method.
+func (m *MockBlockchainClient) GetRelationshipTensor(ctx context.Context, componentA, componentB string) (map[string]any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRelationshipTensor", ctx, componentA, componentB)
+	ret0, _ := ret[0].(map[string]any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRelationshipTensor indicates an expected call of GetRelationshipTensor.
+func (mr *MockBlockchainClientMockRecorder) GetRelationshipTensor(ctx, componentA, componentB any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRelationshipTensor", reflect.TypeOf((*MockBlockchainClient)(nil).GetRelationshipTensor), ctx, componentA, componentB)
+}
+
+// GetRevocationEvents mocks base method.
+func (m *MockBlockchainClient) GetRevocationEvents(ctx context.Context, targetHash string, from, to int64, limit uint64, key string) (map[string]any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRevocationEvents", ctx, targetHash, from, to, limit, key)
+	ret0, _ := ret[0].(map[string]any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRevocationEvents indicates an expected call of GetRevocationEvents.
+func (mr *MockBlockchainClientMockRecorder) GetRevocationEvents(ctx, targetHash, from, to, limit, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRevocationEvents", reflect.TypeOf((*MockBlockchainClient)(nil).GetRevocationEvents), ctx, targetHash, from, to, limit, key)
+}
+
+// GetSplitKeyStatus mocks base method.
+func (m *MockBlockchainClient) GetSplitKeyStatus(ctx context.Context, lctID string) (map[string]any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSplitKeyStatus", ctx, lctID)
+	ret0, _ := ret[0].(map[string]any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSplitKeyStatus indicates an expected call of GetSplitKeyStatus.
+func (mr *MockBlockchainClientMockRecorder) GetSplitKeyStatus(ctx, lctID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSplitKeyStatus", reflect.TypeOf((*MockBlockchainClient)(nil).GetSplitKeyStatus), ctx, lctID)
+}
+
+// GetTensorHistory mocks base method.
+func (m *MockBlockchainClient) GetTensorHistory(ctx context.Context, tensorID string, from, to int64) (map[string]any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTensorHistory", ctx, tensorID, from, to)
+	ret0, _ := ret[0].(map[string]any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTensorHistory indicates an expected call of GetTensorHistory.
+func (mr *MockBlockchainClientMockRecorder) GetTensorHistory(ctx, tensorID, from, to any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTensorHistory", reflect.TypeOf((*MockBlockchainClient)(nil).GetTensorHistory), ctx, tensorID, from, to)
+}
+
+// GetTrustTensor mocks base method.
+func (m *MockBlockchainClient) GetTrustTensor(ctx context.Context, tensorID string) (map[string]any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTrustTensor", ctx, tensorID)
+	ret0, _ := ret[0].(map[string]any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTrustTensor indicates an expected call of GetTrustTensor.
+func (mr *MockBlockchainClientMockRecorder) GetTrustTensor(ctx, tensorID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrustTensor", reflect.TypeOf((*MockBlockchainClient)(nil).GetTrustTensor), ctx, tensorID)
+}
+
+// GetVerificationHistory mocks base method.
+func (m *MockBlockchainClient) GetVerificationHistory(ctx context.Context, componentID string, limit uint64, key string) (map[string]any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVerificationHistory", ctx, componentID, limit, key)
+	ret0, _ := ret[0].(map[string]any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVerificationHistory indicates an expected call of GetVerificationHistory.
+func (mr *MockBlockchainClientMockRecorder) GetVerificationHistory(ctx, componentID, limit, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVerificationHistory", reflect.TypeOf((*MockBlockchainClient)(nil).GetVerificationHistory), ctx, componentID, limit, key)
+}
+
+// InitiatePairing mocks base method.
+func (m *MockBlockchainClient) InitiatePairing(ctx context.Context, creator, componentA, componentB, operationalContext, proxyID string, forceImmediate bool) (map[string]any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InitiatePairing", ctx, creator, componentA, componentB, operationalContext, proxyID, forceImmediate)
+	ret0, _ := ret[0].(map[string]any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InitiatePairing indicates an expected call of InitiatePairing.
+func (mr *MockBlockchainClientMockRecorder) InitiatePairing(ctx, creator, componentA, componentB, operationalContext, proxyID, forceImmediate any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InitiatePairing", reflect.TypeOf((*MockBlockchainClient)(nil).InitiatePairing), ctx, creator, componentA, componentB, operationalContext, proxyID, forceImmediate)
+}
+
+// ListComponents mocks base method.
+func (m *MockBlockchainClient) ListComponents(ctx context.Context, limit uint64, key string, countTotal bool) (map[string]any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListComponents", ctx, limit, key, countTotal)
+	ret0, _ := ret[0].(map[string]any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListComponents indicates an expected call of ListComponents.
+func (mr *MockBlockchainClientMockRecorder) ListComponents(ctx, limit, key, countTotal any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListComponents", reflect.TypeOf((*MockBlockchainClient)(nil).ListComponents), ctx, limit, key, countTotal)
+}
+
+// ListLCTs mocks base method.
+func (m *MockBlockchainClient) ListLCTs(ctx context.Context, filter blockchain.LCTFilter, limit uint64, key string) (map[string]any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListLCTs", ctx, filter, limit, key)
+	ret0, _ := ret[0].(map[string]any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListLCTs indicates an expected call of ListLCTs.
+func (mr *MockBlockchainClientMockRecorder) ListLCTs(ctx, filter, limit, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLCTs", reflect.TypeOf((*MockBlockchainClient)(nil).ListLCTs), ctx, filter, limit, key)
+}
+
+// ListProxyQueue mocks base method.
+func (m *MockBlockchainClient) ListProxyQueue(ctx context.Context, proxyID string, filter blockchain.ProxyQueueFilter, limit uint64, key string) (map[string]any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListProxyQueue", ctx, proxyID, filter, limit, key)
+	ret0, _ := ret[0].(map[string]any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListProxyQueue indicates an expected call of ListProxyQueue.
+func (mr *MockBlockchainClientMockRecorder) ListProxyQueue(ctx, proxyID, filter, limit, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProxyQueue", reflect.TypeOf((*MockBlockchainClient)(nil).ListProxyQueue), ctx, proxyID, filter, limit, key)
+}
+
+// NodeInfo mocks base method.
+func (m *MockBlockchainClient) NodeInfo(ctx context.Context) (*blockchain.NodeInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NodeInfo", ctx)
+	ret0, _ := ret[0].(*blockchain.NodeInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NodeInfo indicates an expected call of NodeInfo.
+func (mr *MockBlockchainClientMockRecorder) NodeInfo(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NodeInfo", reflect.TypeOf((*MockBlockchainClient)(nil).NodeInfo), ctx)
+}
+
+// ProcessOfflineQueue mocks base method.
+func (m *MockBlockchainClient) ProcessOfflineQueue(ctx context.Context, componentID string) (map[string]any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProcessOfflineQueue", ctx, componentID)
+	ret0, _ := ret[0].(map[string]any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ProcessOfflineQueue indicates an expected call of ProcessOfflineQueue.
+func (mr *MockBlockchainClientMockRecorder) ProcessOfflineQueue(ctx, componentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProcessOfflineQueue", reflect.TypeOf((*MockBlockchainClient)(nil).ProcessOfflineQueue), ctx, componentID)
+}
+
+// QueuePairingRequest mocks base method.
+func (m *MockBlockchainClient) QueuePairingRequest(ctx context.Context, componentA, componentB, operationalContext, proxyID string) (map[string]any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueuePairingRequest", ctx, componentA, componentB, operationalContext, proxyID)
+	ret0, _ := ret[0].(map[string]any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueuePairingRequest indicates an expected call of QueuePairingRequest.
+func (mr *MockBlockchainClientMockRecorder) QueuePairingRequest(ctx, componentA, componentB, operationalContext, proxyID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueuePairingRequest", reflect.TypeOf((*MockBlockchainClient)(nil).QueuePairingRequest), ctx, componentA, componentB, operationalContext, proxyID)
+}
+
+// Readiness mocks base method.
+func (m *MockBlockchainClient) Readiness(ctx context.Context) (bool, map[string]blockchain.DependencyStatus) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Readiness", ctx)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(map[string]blockchain.DependencyStatus)
+	return ret0, ret1
+}
+
+// Readiness indicates an expected call of Readiness.
+func (mr *MockBlockchainClientMockRecorder) Readiness(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Readiness", reflect.TypeOf((*MockBlockchainClient)(nil).Readiness), ctx)
+}
+
+// RegisterAnonymousComponent mocks base method.
+func (m *MockBlockchainClient) RegisterAnonymousComponent(ctx context.Context, creator, realComponentID, manufacturerID, componentType, arg5 string) (map[string]any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterAnonymousComponent", ctx, creator, realComponentID, manufacturerID, componentType, arg5)
+	ret0, _ := ret[0].(map[string]any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegisterAnonymousComponent indicates an expected call of RegisterAnonymousComponent.
+func (mr *MockBlockchainClientMockRecorder) RegisterAnonymousComponent(ctx, creator, realComponentID, manufacturerID, componentType, arg5 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterAnonymousComponent", reflect.TypeOf((*MockBlockchainClient)(nil).RegisterAnonymousComponent), ctx, creator, realComponentID, manufacturerID, componentType, arg5)
+}
+
+// RegisterComponent mocks base method.
+func (m *MockBlockchainClient) RegisterComponent(ctx context.Context, creator, componentData, arg3 string, dedupe bool) (map[string]any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterComponent", ctx, creator, componentData, arg3, dedupe)
+	ret0, _ := ret[0].(map[string]any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegisterComponent indicates an expected call of RegisterComponent.
+func (mr *MockBlockchainClientMockRecorder) RegisterComponent(ctx, creator, componentData, arg3, dedupe any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterComponent", reflect.TypeOf((*MockBlockchainClient)(nil).RegisterComponent), ctx, creator, componentData, arg3, dedupe)
+}
+
+// ResumeLCT mocks base method.
+func (m *MockBlockchainClient) ResumeLCT(ctx context.Context, creator, lctID, reason string) (map[string]any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResumeLCT", ctx, creator, lctID, reason)
+	ret0, _ := ret[0].(map[string]any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResumeLCT indicates an expected call of ResumeLCT.
+func (mr *MockBlockchainClientMockRecorder) ResumeLCT(ctx, creator, lctID, reason any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResumeLCT", reflect.TypeOf((*MockBlockchainClient)(nil).ResumeLCT), ctx, creator, lctID, reason)
+}
+
+// RevokeAuthorization mocks base method.
+func (m *MockBlockchainClient) RevokeAuthorization(ctx context.Context, authorizationID, reason string) (map[string]any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeAuthorization", ctx, authorizationID, reason)
+	ret0, _ := ret[0].(map[string]any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeAuthorization indicates an expected call of RevokeAuthorization.
+func (mr *MockBlockchainClientMockRecorder) RevokeAuthorization(ctx, authorizationID, reason any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeAuthorization", reflect.TypeOf((*MockBlockchainClient)(nil).RevokeAuthorization), ctx, authorizationID, reason)
+}
+
+// RevokePairing mocks base method.
+func (m *MockBlockchainClient) RevokePairing(ctx context.Context, creator, lctID, reason string, notifyOffline bool) (map[string]any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokePairing", ctx, creator, lctID, reason, notifyOffline)
+	ret0, _ := ret[0].(map[string]any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokePairing indicates an expected call of RevokePairing.
+func (mr *MockBlockchainClientMockRecorder) RevokePairing(ctx, creator, lctID, reason, notifyOffline any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokePairing", reflect.TypeOf((*MockBlockchainClient)(nil).RevokePairing), ctx, creator, lctID, reason, notifyOffline)
+}
+
+// RotateSplitKey mocks base method.
+func (m *MockBlockchainClient) RotateSplitKey(ctx context.Context, creator, lctID, reason string) (map[string]any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RotateSplitKey", ctx, creator, lctID, reason)
+	ret0, _ := ret[0].(map[string]any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RotateSplitKey indicates an expected call of RotateSplitKey.
+func (mr *MockBlockchainClientMockRecorder) RotateSplitKey(ctx, creator, lctID, reason any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RotateSplitKey", reflect.TypeOf((*MockBlockchainClient)(nil).RotateSplitKey), ctx, creator, lctID, reason)
+}
+
+// SearchComponents mocks base method.
+func (m *MockBlockchainClient) SearchComponents(ctx context.Context, filter blockchain.ComponentSearchFilter, limit uint64, key string) (map[string]any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchComponents", ctx, filter, limit, key)
+	ret0, _ := ret[0].(map[string]any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchComponents indicates an expected call of SearchComponents.
+func (mr *MockBlockchainClientMockRecorder) SearchComponents(ctx, filter, limit, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchComponents", reflect.TypeOf((*MockBlockchainClient)(nil).SearchComponents), ctx, filter, limit, key)
+}
+
+// SuspendLCT mocks base method.
+func (m *MockBlockchainClient) SuspendLCT(ctx context.Context, creator, lctID, reason string) (map[string]any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SuspendLCT", ctx, creator, lctID, reason)
+	ret0, _ := ret[0].(map[string]any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SuspendLCT indicates an expected call of SuspendLCT.
+func (mr *MockBlockchainClientMockRecorder) SuspendLCT(ctx, creator, lctID, reason any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SuspendLCT", reflect.TypeOf((*MockBlockchainClient)(nil).SuspendLCT), ctx, creator, lctID, reason)
+}
+
+// TestConnection mocks base method.
+func (m *MockBlockchainClient) TestConnection(ctx context.Context) map[string]any {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TestConnection", ctx)
+	ret0, _ := ret[0].(map[string]any)
+	return ret0
+}
+
+// TestConnection indicates an expected call of TestConnection.
+func (mr *MockBlockchainClientMockRecorder) TestConnection(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TestConnection", reflect.TypeOf((*MockBlockchainClient)(nil).TestConnection), ctx)
+}
+
+// TestIgniteCLI mocks base method.
+func (m *MockBlockchainClient) TestIgniteCLI(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TestIgniteCLI", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TestIgniteCLI indicates an expected call of TestIgniteCLI.
+func (mr *MockBlockchainClientMockRecorder) TestIgniteCLI(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TestIgniteCLI", reflect.TypeOf((*MockBlockchainClient)(nil).TestIgniteCLI), ctx)
+}
+
+// UpdateAuthorization mocks base method.
+func (m *MockBlockchainClient) UpdateAuthorization(ctx context.Context, authorizationID string, updates map[string]any) (map[string]any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAuthorization", ctx, authorizationID, updates)
+	ret0, _ := ret[0].(map[string]any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateAuthorization indicates an expected call of UpdateAuthorization.
+func (mr *MockBlockchainClientMockRecorder) UpdateAuthorization(ctx, authorizationID, updates any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAuthorization", reflect.TypeOf((*MockBlockchainClient)(nil).UpdateAuthorization), ctx, authorizationID, updates)
+}
+
+// UpdateLCTStatus mocks base method.
+func (m *MockBlockchainClient) UpdateLCTStatus(ctx context.Context, creator, lctID, status, arg4 string) (map[string]any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateLCTStatus", ctx, creator, lctID, status, arg4)
+	ret0, _ := ret[0].(map[string]any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateLCTStatus indicates an expected call of UpdateLCTStatus.
+func (mr *MockBlockchainClientMockRecorder) UpdateLCTStatus(ctx, creator, lctID, status, arg4 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLCTStatus", reflect.TypeOf((*MockBlockchainClient)(nil).UpdateLCTStatus), ctx, creator, lctID, status, arg4)
+}
+
+// UpdateTensorScore mocks base method.
+func (m *MockBlockchainClient) UpdateTensorScore(ctx context.Context, creator, componentA, componentB string, score float64, arg5 string) (map[string]any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTensorScore", ctx, creator, componentA, componentB, score, arg5)
+	ret0, _ := ret[0].(map[string]any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTensorScore indicates an expected call of UpdateTensorScore.
+func (mr *MockBlockchainClientMockRecorder) UpdateTensorScore(ctx, creator, componentA, componentB, score, arg5 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTensorScore", reflect.TypeOf((*MockBlockchainClient)(nil).UpdateTensorScore), ctx, creator, componentA, componentB, score, arg5)
+}
+
+// UpdateTrustScore mocks base method.
+func (m *MockBlockchainClient) UpdateTrustScore(ctx context.Context, creator, tensorID string, score float64, arg4 string) (map[string]any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTrustScore", ctx, creator, tensorID, score, arg4)
+	ret0, _ := ret[0].(map[string]any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTrustScore indicates an expected call of UpdateTrustScore.
+func (mr *MockBlockchainClientMockRecorder) UpdateTrustScore(ctx, creator, tensorID, score, arg4 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTrustScore", reflect.TypeOf((*MockBlockchainClient)(nil).UpdateTrustScore), ctx, creator, tensorID, score, arg4)
+}
+
+// VerifyComponent mocks base method.
+func (m *MockBlockchainClient) VerifyComponent(ctx context.Context, verifier, componentID, arg3 string) (map[string]any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyComponent", ctx, verifier, componentID, arg3)
+	ret0, _ := ret[0].(map[string]any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyComponent indicates an expected call of VerifyComponent.
+func (mr *MockBlockchainClientMockRecorder) VerifyComponent(ctx, verifier, componentID, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyComponent", reflect.TypeOf((*MockBlockchainClient)(nil).VerifyComponent), ctx, verifier, componentID, arg3)
+}
+
+// VerifyComponentPairingWithHashes mocks base method.
+func (m *MockBlockchainClient) VerifyComponentPairingWithHashes(ctx context.Context, verifier, componentHashA, componentHashB, arg4 string, attestation *blockchain.PairingAttestation) (map[string]any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyComponentPairingWithHashes", ctx, verifier, componentHashA, componentHashB, arg4, attestation)
+	ret0, _ := ret[0].(map[string]any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyComponentPairingWithHashes indicates an expected call of VerifyComponentPairingWithHashes.
+func (mr *MockBlockchainClientMockRecorder) VerifyComponentPairingWithHashes(ctx, verifier, componentHashA, componentHashB, arg4, attestation any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyComponentPairingWithHashes", reflect.TypeOf((*MockBlockchainClient)(nil).VerifyComponentPairingWithHashes), ctx, verifier, componentHashA, componentHashB, arg4, attestation)
+}
+
+// WithTransactionFile mocks base method.
+func (m *MockBlockchainClient) WithTransactionFile(message map[string]any, memo string, fn func(string) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithTransactionFile", message, memo, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WithTransactionFile indicates an expected call of WithTransactionFile.
+func (mr *MockBlockchainClientMockRecorder) WithTransactionFile(message, memo, fn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithTransactionFile", reflect.TypeOf((*MockBlockchainClient)(nil).WithTransactionFile), message, memo, fn)
+}
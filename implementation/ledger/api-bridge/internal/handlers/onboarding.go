@@ -0,0 +1,351 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Onboarding step and workflow states
+const (
+	OnboardStatusCompleted  = "completed"
+	OnboardStatusRolledBack = "rolled_back"
+
+	StepStatusSucceeded  = "succeeded"
+	StepStatusFailed     = "failed"
+	StepStatusSkipped    = "skipped"
+	StepStatusRolledBack = "rolled_back"
+)
+
+// onboardingClient is the subset of the blockchain client used by the onboarding workflow
+type onboardingClient interface {
+	RegisterComponent(ctx context.Context, creator, componentData, context string, dedupe bool) (map[string]interface{}, error)
+	CreateLCT(ctx context.Context, creator, componentA, componentB, context, proxyID string) (map[string]interface{}, error)
+	UpdateLCTStatus(ctx context.Context, creator, lctID, status, context string) (map[string]interface{}, error)
+	InitiatePairing(ctx context.Context, creator, componentA, componentB, operationalContext, proxyID string, forceImmediate bool) (map[string]interface{}, error)
+	CompletePairing(ctx context.Context, creator, challengeID, componentAAuth, componentBAuth, sessionContext string) (map[string]interface{}, error)
+	RevokePairing(ctx context.Context, creator, lctID, reason string, notifyOffline bool) (map[string]interface{}, error)
+}
+
+// OnboardComponent describes a component to register. Ref is a caller-chosen
+// alias that relationships and pairings can use before the on-chain id is known.
+type OnboardComponent struct {
+	Ref           string `json:"ref" binding:"required"`
+	ComponentData string `json:"component_data" binding:"required"`
+	Context       string `json:"context"`
+	Dedupe        bool   `json:"dedupe"` // reuse a component already registered with the same data
+}
+
+// OnboardRelationship describes an LCT to create between two components
+type OnboardRelationship struct {
+	ComponentA         string `json:"component_a" binding:"required"`
+	ComponentB         string `json:"component_b" binding:"required"`
+	OperationalContext string `json:"operational_context"`
+	Context            string `json:"context"` // deprecated spelling of operational_context
+	ProxyID            string `json:"proxy_id"`
+	Optional           bool   `json:"optional"`
+}
+
+// OnboardPairing describes a pairing to initiate and complete between two components
+type OnboardPairing struct {
+	ComponentA         string `json:"component_a" binding:"required"`
+	ComponentB         string `json:"component_b" binding:"required"`
+	OperationalContext string `json:"operational_context"`
+	ProxyID            string `json:"proxy_id"`
+	ForceImmediate     bool   `json:"force_immediate"`
+	ComponentAAuth     string `json:"component_a_auth" binding:"required"`
+	ComponentBAuth     string `json:"component_b_auth" binding:"required"`
+	SessionContext     string `json:"session_context"`
+	Optional           bool   `json:"optional"`
+}
+
+// OnboardRequest describes a complete vehicle to bring onto the chain
+type OnboardRequest struct {
+	Creator       string                `json:"creator" binding:"required"`
+	Components    []OnboardComponent    `json:"components" binding:"required,min=1,dive"`
+	Relationships []OnboardRelationship `json:"relationships" binding:"dive"`
+	Pairings      []OnboardPairing      `json:"pairings" binding:"dive"`
+}
+
+// OnboardStep records the outcome of one workflow step
+type OnboardStep struct {
+	Step   int                    `json:"step"`
+	Action string                 `json:"action"`
+	Target string                 `json:"target"`
+	Status string                 `json:"status"`
+	Result map[string]interface{} `json:"result,omitempty"`
+	Error  string                 `json:"error,omitempty"`
+}
+
+// OnboardResult is the aggregate outcome of an onboarding run
+type OnboardResult struct {
+	Status       string            `json:"status"`
+	ComponentIDs map[string]string `json:"component_ids"`
+	LctIDs       []string          `json:"lct_ids"`
+	PairingIDs   []string          `json:"pairing_lct_ids"`
+	Steps        []OnboardStep     `json:"steps"`
+	Rollback     []OnboardStep     `json:"rollback,omitempty"`
+	Error        string            `json:"error,omitempty"`
+}
+
+// onboardingRun carries the state of one workflow execution
+type onboardingRun struct {
+	client      onboardingClient
+	creator     string
+	result      *OnboardResult
+	undo        []func(ctx context.Context) OnboardStep
+	undoTimeout time.Duration // bounds each compensation
+}
+
+// runOnboarding registers the components, creates the LCTs and pairs the
+// components in that order. The first failure of a non-optional step aborts
+// the run and compensates the already completed steps in reverse order, each
+// within undoTimeout.
+func runOnboarding(ctx context.Context, client onboardingClient, req OnboardRequest, undoTimeout time.Duration) *OnboardResult {
+	run := &onboardingRun{
+		client:      client,
+		creator:     req.Creator,
+		undoTimeout: undoTimeout,
+		result: &OnboardResult{
+			ComponentIDs: make(map[string]string),
+			LctIDs:       []string{},
+			PairingIDs:   []string{},
+			Steps:        []OnboardStep{},
+		},
+	}
+
+	if err := run.registerComponents(ctx, req.Components); err != nil {
+		return run.abort(ctx, err)
+	}
+	if err := run.createRelationships(ctx, req.Relationships); err != nil {
+		return run.abort(ctx, err)
+	}
+	if err := run.pairComponents(ctx, req.Pairings); err != nil {
+		return run.abort(ctx, err)
+	}
+
+	run.result.Status = OnboardStatusCompleted
+	return run.result
+}
+
+func (r *onboardingRun) registerComponents(ctx context.Context, components []OnboardComponent) error {
+	for _, comp := range components {
+		if _, exists := r.result.ComponentIDs[comp.Ref]; exists {
+			return r.fail("register_component", comp.Ref, fmt.Errorf("duplicate component ref %q", comp.Ref))
+		}
+
+		resp, err := r.client.RegisterComponent(ctx, r.creator, comp.ComponentData, comp.Context, comp.Dedupe)
+		if err != nil {
+			return r.fail("register_component", comp.Ref, err)
+		}
+
+		componentID, _ := resp["component_id"].(string)
+		if componentID == "" {
+			return r.fail("register_component", comp.Ref, fmt.Errorf("registration returned no component_id"))
+		}
+
+		r.result.ComponentIDs[comp.Ref] = componentID
+		r.succeed("register_component", comp.Ref, resp)
+
+		// Registrations cannot be undone on-chain, so rollback only reports them
+		ref := comp.Ref
+		r.undo = append(r.undo, func(ctx context.Context) OnboardStep {
+			return OnboardStep{
+				Action: "register_component",
+				Target: ref,
+				Status: StepStatusSkipped,
+				Error:  fmt.Sprintf("component %s remains registered; registrations are not reversible", componentID),
+			}
+		})
+	}
+
+	return nil
+}
+
+func (r *onboardingRun) createRelationships(ctx context.Context, relationships []OnboardRelationship) error {
+	for _, rel := range relationships {
+		componentA, componentB := r.resolve(rel.ComponentA), r.resolve(rel.ComponentB)
+		target := fmt.Sprintf("%s<->%s", rel.ComponentA, rel.ComponentB)
+
+		operationalContext := rel.OperationalContext
+		if operationalContext == "" {
+			operationalContext = rel.Context
+		}
+		resp, err := r.client.CreateLCT(ctx, r.creator, componentA, componentB, operationalContext, rel.ProxyID)
+		if err == nil {
+			if lctID, _ := resp["lct_id"].(string); lctID == "" {
+				err = fmt.Errorf("LCT creation returned no lct_id")
+			}
+		}
+		if err != nil {
+			if rel.Optional {
+				r.record("create_lct", target, StepStatusFailed, nil, err)
+				continue
+			}
+			return r.fail("create_lct", target, err)
+		}
+
+		lctID := resp["lct_id"].(string)
+		r.result.LctIDs = append(r.result.LctIDs, lctID)
+		r.succeed("create_lct", target, resp)
+
+		r.undo = append(r.undo, func(ctx context.Context) OnboardStep {
+			resp, err := r.client.UpdateLCTStatus(ctx, r.creator, lctID, "terminated", "onboarding_rollback")
+			return r.compensation("terminate_lct", lctID, resp, err)
+		})
+	}
+
+	return nil
+}
+
+func (r *onboardingRun) pairComponents(ctx context.Context, pairings []OnboardPairing) error {
+	for _, pairing := range pairings {
+		componentA, componentB := r.resolve(pairing.ComponentA), r.resolve(pairing.ComponentB)
+		target := fmt.Sprintf("%s<->%s", pairing.ComponentA, pairing.ComponentB)
+
+		resp, err := r.client.InitiatePairing(ctx, r.creator, componentA, componentB, pairing.OperationalContext, pairing.ProxyID, pairing.ForceImmediate)
+		if err == nil {
+			if challengeID, _ := resp["challenge_id"].(string); challengeID == "" {
+				err = fmt.Errorf("pairing initiation returned no challenge_id")
+			}
+		}
+		if err != nil {
+			if pairing.Optional {
+				r.record("initiate_pairing", target, StepStatusFailed, nil, err)
+				continue
+			}
+			return r.fail("initiate_pairing", target, err)
+		}
+		challengeID := resp["challenge_id"].(string)
+		r.succeed("initiate_pairing", target, resp)
+
+		resp, err = r.client.CompletePairing(ctx, r.creator, challengeID, pairing.ComponentAAuth, pairing.ComponentBAuth, pairing.SessionContext)
+		if err != nil {
+			if pairing.Optional {
+				r.record("complete_pairing", target, StepStatusFailed, nil, err)
+				continue
+			}
+			return r.fail("complete_pairing", target, err)
+		}
+		r.succeed("complete_pairing", target, resp)
+
+		lctID, _ := resp["lct_id"].(string)
+		if lctID == "" {
+			continue
+		}
+		r.result.PairingIDs = append(r.result.PairingIDs, lctID)
+
+		r.undo = append(r.undo, func(ctx context.Context) OnboardStep {
+			resp, err := r.client.RevokePairing(ctx, r.creator, lctID, "onboarding_rollback", false)
+			return r.compensation("revoke_pairing", lctID, resp, err)
+		})
+	}
+
+	return nil
+}
+
+// abort marks the run as failed and compensates completed steps, newest
+// first. The run usually aborts because ctx was cancelled or ran out, so the
+// compensations are detached from it and get their own timeout instead.
+func (r *onboardingRun) abort(ctx context.Context, cause error) *OnboardResult {
+	r.result.Status = OnboardStatusRolledBack
+	r.result.Error = cause.Error()
+
+	ctx = context.WithoutCancel(ctx)
+	for i := len(r.undo) - 1; i >= 0; i-- {
+		undoCtx, cancel := context.WithTimeout(ctx, r.undoTimeout)
+		step := r.undo[i](undoCtx)
+		cancel()
+		step.Step = len(r.result.Rollback) + 1
+		r.result.Rollback = append(r.result.Rollback, step)
+	}
+
+	return r.result
+}
+
+// resolve maps a component ref to its registered id, passing unknown values
+// through so callers can reference components that are already on-chain
+func (r *onboardingRun) resolve(ref string) string {
+	if id, ok := r.result.ComponentIDs[ref]; ok {
+		return id
+	}
+	return ref
+}
+
+func (r *onboardingRun) succeed(action, target string, resp map[string]interface{}) {
+	r.record(action, target, StepStatusSucceeded, resp, nil)
+}
+
+func (r *onboardingRun) fail(action, target string, err error) error {
+	r.record(action, target, StepStatusFailed, nil, err)
+	return fmt.Errorf("%s %s failed: %w", action, target, err)
+}
+
+func (r *onboardingRun) record(action, target, status string, resp map[string]interface{}, err error) {
+	step := OnboardStep{
+		Step:   len(r.result.Steps) + 1,
+		Action: action,
+		Target: target,
+		Status: status,
+		Result: resp,
+	}
+	if err != nil {
+		step.Error = err.Error()
+	}
+	r.result.Steps = append(r.result.Steps, step)
+}
+
+func (r *onboardingRun) compensation(action, target string, resp map[string]interface{}, err error) OnboardStep {
+	step := OnboardStep{
+		Action: action,
+		Target: target,
+		Status: StepStatusRolledBack,
+		Result: resp,
+	}
+	if err != nil {
+		step.Status = StepStatusFailed
+		step.Error = err.Error()
+	}
+	return step
+}
+
+// Onboard handles the full vehicle onboarding workflow in a single request
+func (h *Handler) Onboard(c *gin.Context) {
+	var req OnboardRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	// Every registration, LCT and pairing step is its own transaction, and a
+	// rollback may need one more for each
+	steps := len(req.Components) + len(req.Relationships) + 2*len(req.Pairings)
+	timeout := time.Duration(steps) * h.config.Blockchain.BroadcastTimeoutDuration()
+	h.extendWriteDeadline(c, 2*timeout)
+	ctx, cancel := context.WithTimeout(c.Request.Context(), timeout)
+	defer cancel()
+
+	result := runOnboarding(ctx, h.blockchain, req, h.config.Blockchain.BroadcastTimeoutDuration())
+	if result.Status != OnboardStatusCompleted {
+		h.logger.Error().Str("creator", req.Creator).Str("error", result.Error).Msg("Vehicle onboarding rolled back")
+		c.JSON(http.StatusInternalServerError, result)
+		return
+	}
+
+	// Emit event if event queue is enabled
+	if h.eventQueue != nil {
+		eventData := map[string]interface{}{
+			"creator":         req.Creator,
+			"component_ids":   result.ComponentIDs,
+			"lct_ids":         result.LctIDs,
+			"pairing_lct_ids": result.PairingIDs,
+			"timestamp":       time.Now().Unix(),
+		}
+		h.emitEvent(c, req.Creator, nil, "vehicle_onboarded", eventData)
+	}
+
+	c.JSON(http.StatusOK, result)
+}
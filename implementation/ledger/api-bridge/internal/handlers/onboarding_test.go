@@ -0,0 +1,168 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeOnboardingClient records calls and hands out sequential ids
+type fakeOnboardingClient struct {
+	calls      []string
+	failLCTFor string
+	onFail     func() // runs when the LCT for failLCTFor fails
+	nextID     int
+}
+
+func (f *fakeOnboardingClient) id(prefix string) string {
+	f.nextID++
+	return fmt.Sprintf("%s_%d", prefix, f.nextID)
+}
+
+func (f *fakeOnboardingClient) RegisterComponent(ctx context.Context, creator, componentData, context string, dedupe bool) (map[string]interface{}, error) {
+	f.calls = append(f.calls, "register")
+	return map[string]interface{}{"component_id": f.id("comp"), "txhash": "TX"}, nil
+}
+
+func (f *fakeOnboardingClient) CreateLCT(ctx context.Context, creator, componentA, componentB, context, proxyID string) (map[string]interface{}, error) {
+	f.calls = append(f.calls, "create_lct:"+componentA+":"+componentB)
+	if context == f.failLCTFor {
+		if f.onFail != nil {
+			f.onFail()
+		}
+		return nil, fmt.Errorf("blockchain transaction failed")
+	}
+	return map[string]interface{}{"lct_id": f.id("lct"), "txhash": "TX"}, nil
+}
+
+func (f *fakeOnboardingClient) UpdateLCTStatus(ctx context.Context, creator, lctID, status, context string) (map[string]interface{}, error) {
+	f.calls = append(f.calls, "lct_status:"+lctID+":"+status)
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return map[string]interface{}{"lct_id": lctID, "status": status}, nil
+}
+
+func (f *fakeOnboardingClient) InitiatePairing(ctx context.Context, creator, componentA, componentB, operationalContext, proxyID string, forceImmediate bool) (map[string]interface{}, error) {
+	f.calls = append(f.calls, "initiate:"+componentA+":"+componentB)
+	return map[string]interface{}{"challenge_id": f.id("challenge")}, nil
+}
+
+func (f *fakeOnboardingClient) CompletePairing(ctx context.Context, creator, challengeID, componentAAuth, componentBAuth, sessionContext string) (map[string]interface{}, error) {
+	f.calls = append(f.calls, "complete:"+challengeID)
+	return map[string]interface{}{"lct_id": f.id("pair_lct")}, nil
+}
+
+func (f *fakeOnboardingClient) RevokePairing(ctx context.Context, creator, lctID, reason string, notifyOffline bool) (map[string]interface{}, error) {
+	f.calls = append(f.calls, "revoke:"+lctID)
+	return map[string]interface{}{"lct_id": lctID, "status": "revoked"}, nil
+}
+
+func raceCarOnboardRequest() OnboardRequest {
+	return OnboardRequest{
+		Creator: "cosmos1racecar",
+		Components: []OnboardComponent{
+			{Ref: "pack", ComponentData: `{"manufacturer_id": "TESLA_RACING", "model": "TR-2024-MAIN"}`},
+			{Ref: "module", ComponentData: `{"manufacturer_id": "TESLA_RACING", "model": "TR-2024-MOD"}`},
+			{Ref: "motor", ComponentData: `{"manufacturer_id": "TESLA_RACING", "model": "TR-2024-MC"}`},
+		},
+		Relationships: []OnboardRelationship{
+			{ComponentA: "pack", ComponentB: "module", Context: "battery_management"},
+			{ComponentA: "pack", ComponentB: "motor", Context: "energy_delivery"},
+		},
+		Pairings: []OnboardPairing{
+			{
+				ComponentA:         "pack",
+				ComponentB:         "motor",
+				OperationalContext: "race_energy_delivery",
+				ComponentAAuth:     "battery_auth_token",
+				ComponentBAuth:     "motor_auth_token",
+				SessionContext:     "race_session_001",
+			},
+		},
+	}
+}
+
+func TestRunOnboardingFullWorkflow(t *testing.T) {
+	client := &fakeOnboardingClient{}
+
+	result := runOnboarding(context.Background(), client, raceCarOnboardRequest(), time.Minute)
+
+	require.Equal(t, OnboardStatusCompleted, result.Status)
+	assert.Empty(t, result.Error)
+	assert.Empty(t, result.Rollback)
+	assert.Equal(t, map[string]string{"pack": "comp_1", "module": "comp_2", "motor": "comp_3"}, result.ComponentIDs)
+	assert.Equal(t, []string{"lct_4", "lct_5"}, result.LctIDs)
+	assert.Equal(t, []string{"pair_lct_7"}, result.PairingIDs)
+
+	// 3 registrations, 2 LCTs, initiate + complete for the pairing
+	require.Len(t, result.Steps, 7)
+	for i, step := range result.Steps {
+		assert.Equal(t, i+1, step.Step)
+		assert.Equal(t, StepStatusSucceeded, step.Status, step.Action)
+	}
+
+	// Refs are resolved to the registered ids before they reach the chain
+	assert.Contains(t, client.calls, "create_lct:comp_1:comp_2")
+	assert.Contains(t, client.calls, "initiate:comp_1:comp_3")
+	assert.Contains(t, client.calls, "complete:challenge_6")
+}
+
+func TestRunOnboardingRollsBackOnFatalFailure(t *testing.T) {
+	client := &fakeOnboardingClient{failLCTFor: "energy_delivery"}
+
+	result := runOnboarding(context.Background(), client, raceCarOnboardRequest(), time.Minute)
+
+	require.Equal(t, OnboardStatusRolledBack, result.Status)
+	assert.Contains(t, result.Error, "create_lct pack<->motor failed")
+
+	last := result.Steps[len(result.Steps)-1]
+	assert.Equal(t, "create_lct", last.Action)
+	assert.Equal(t, StepStatusFailed, last.Status)
+
+	// The pairing never ran
+	for _, call := range client.calls {
+		assert.NotContains(t, call, "initiate")
+	}
+
+	// Completed steps are compensated newest first
+	require.Len(t, result.Rollback, 4)
+	assert.Equal(t, "terminate_lct", result.Rollback[0].Action)
+	assert.Equal(t, "lct_4", result.Rollback[0].Target)
+	assert.Equal(t, StepStatusRolledBack, result.Rollback[0].Status)
+	for _, step := range result.Rollback[1:] {
+		assert.Equal(t, "register_component", step.Action)
+		assert.Equal(t, StepStatusSkipped, step.Status)
+	}
+	assert.Contains(t, client.calls, "lct_status:lct_4:terminated")
+}
+
+func TestRunOnboardingRollsBackAfterCancellation(t *testing.T) {
+	// The client goes away while the second LCT is being created
+	ctx, cancel := context.WithCancel(context.Background())
+	client := &fakeOnboardingClient{failLCTFor: "energy_delivery", onFail: cancel}
+
+	result := runOnboarding(ctx, client, raceCarOnboardRequest(), time.Minute)
+
+	require.Equal(t, OnboardStatusRolledBack, result.Status)
+	require.NotEmpty(t, result.Rollback)
+	assert.Equal(t, "terminate_lct", result.Rollback[0].Action)
+	assert.Equal(t, StepStatusRolledBack, result.Rollback[0].Status, result.Rollback[0].Error)
+}
+
+func TestRunOnboardingOptionalStepFailureContinues(t *testing.T) {
+	client := &fakeOnboardingClient{failLCTFor: "energy_delivery"}
+	req := raceCarOnboardRequest()
+	req.Relationships[1].Optional = true
+
+	result := runOnboarding(context.Background(), client, req, time.Minute)
+
+	require.Equal(t, OnboardStatusCompleted, result.Status)
+	assert.Empty(t, result.Rollback)
+	assert.Equal(t, []string{"lct_4"}, result.LctIDs)
+	assert.Len(t, result.PairingIDs, 1)
+}
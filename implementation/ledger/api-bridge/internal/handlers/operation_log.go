@@ -0,0 +1,215 @@
+package handlers
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// defaultOperationsPageSize is the page size when ?limit is not given
+const defaultOperationsPageSize = 50
+
+// Operation results recorded in the operation log
+const (
+	OperationSucceeded = "success"
+	OperationFailed    = "failed"
+)
+
+// OperationRecord summarises one write a creator made through the bridge
+type OperationRecord struct {
+	Sequence  uint64    `json:"sequence"`
+	Operation string    `json:"operation"`
+	Targets   []string  `json:"targets"`
+	TxHash    string    `json:"txhash,omitempty"`
+	Time      time.Time `json:"time"`
+	Result    string    `json:"result"`
+	Error     string    `json:"error,omitempty"`
+}
+
+// OperationsPage is one page of a creator's operations, newest first
+type OperationsPage struct {
+	Creator    string            `json:"creator"`
+	Operations []OperationRecord `json:"operations"`
+	Count      int               `json:"count"`
+	NextKey    string            `json:"next_key,omitempty"`
+}
+
+// operationLog keeps the most recent operations of each creator in memory, so
+// clients can review their own writes without scanning the chain. Older
+// entries are dropped once a creator has more than maxPerCreator, and the
+// least recently active creator is dropped once more than maxCreators have
+// written.
+type operationLog struct {
+	mu            sync.Mutex
+	maxPerCreator int
+	maxCreators   int
+	nextSequence  uint64
+	byCreator     map[string][]OperationRecord // oldest first
+}
+
+func newOperationLog(maxPerCreator, maxCreators int) *operationLog {
+	return &operationLog{maxPerCreator: maxPerCreator, maxCreators: maxCreators, byCreator: make(map[string][]OperationRecord)}
+}
+
+// record appends an operation to creator's log and assigns its sequence
+func (l *operationLog) record(creator string, op OperationRecord) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.nextSequence++
+	op.Sequence = l.nextSequence
+
+	if _, known := l.byCreator[creator]; !known && l.maxCreators > 0 && len(l.byCreator) >= l.maxCreators {
+		l.evictIdlest()
+	}
+
+	records := append(l.byCreator[creator], op)
+	if l.maxPerCreator > 0 && len(records) > l.maxPerCreator {
+		records = append([]OperationRecord(nil), records[len(records)-l.maxPerCreator:]...)
+	}
+	l.byCreator[creator] = records
+}
+
+// evictIdlest drops the creator whose latest operation is the oldest. The
+// caller holds l.mu.
+func (l *operationLog) evictIdlest() {
+	var idlest string
+	var oldest uint64
+	for creator, records := range l.byCreator {
+		last := records[len(records)-1].Sequence
+		if idlest == "" || last < oldest {
+			idlest, oldest = creator, last
+		}
+	}
+	delete(l.byCreator, idlest)
+}
+
+// list returns up to limit of creator's operations, newest first, starting
+// below the sequence before. A zero before starts from the newest.
+func (l *operationLog) list(creator string, limit int, before uint64) ([]OperationRecord, uint64) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	records := l.byCreator[creator]
+	page := make([]OperationRecord, 0, limit)
+	for i := len(records) - 1; i >= 0; i-- {
+		if before != 0 && records[i].Sequence >= before {
+			continue
+		}
+		if len(page) == limit {
+			return page, page[len(page)-1].Sequence
+		}
+		page = append(page, records[i])
+	}
+	return page, 0
+}
+
+// recordOperation logs a write for creator. Targets name the records the
+// operation touched; err marks it failed.
+func (h *Handler) recordOperation(creator, operation string, targets []string, resp map[string]interface{}, err error) {
+	if h.operations == nil || creator == "" {
+		return
+	}
+
+	op := OperationRecord{
+		Operation: operation,
+		Targets:   targets,
+		Time:      time.Now().UTC(),
+		Result:    OperationSucceeded,
+	}
+	if txHash, ok := resp["txhash"].(string); ok {
+		op.TxHash = txHash
+	}
+	if err != nil {
+		op.Result = OperationFailed
+		op.Error = err.Error()
+	}
+	h.operations.record(creator, op)
+}
+
+// operationTargets collects the non-empty ids among values, which may be
+// strings or fields read from a chain response
+func operationTargets(values ...interface{}) []string {
+	targets := []string{}
+	for _, value := range values {
+		if id, ok := value.(string); ok && id != "" {
+			targets = append(targets, id)
+		}
+	}
+	return targets
+}
+
+// GetAccountOperations lists the operations a creator made through this
+// bridge, newest first. Pass the previous page's next_key as ?key to continue.
+// Callers only see their own account's operations unless they hold admin.
+func (h *Handler) GetAccountOperations(c *gin.Context) {
+	creator := c.Param("name")
+	if !mayReadOperations(c, creator) {
+		c.JSON(http.StatusForbidden, gin.H{"error": "operations of another account require the admin permission"})
+		return
+	}
+
+	limit, ok := h.pageLimit(c, defaultOperationsPageSize)
+	if !ok {
+		return
+	}
+
+	before, err := decodeOperationsKey(c.Query("key"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "key must be the next_key returned by the previous page"})
+		return
+	}
+
+	page := OperationsPage{Creator: creator, Operations: []OperationRecord{}}
+	if h.operations != nil {
+		var next uint64
+		page.Operations, next = h.operations.list(creator, int(limit), before)
+		if next != 0 {
+			page.NextKey = encodeOperationsKey(next)
+		}
+	}
+	page.Count = len(page.Operations)
+
+	c.JSON(http.StatusOK, page)
+}
+
+// mayReadOperations reports whether the caller may list creator's operations:
+// the API key's own user, keys holding admin, or anyone when authentication
+// is off
+func mayReadOperations(c *gin.Context, creator string) bool {
+	if !c.GetBool("authenticated") || c.GetString("username") == creator {
+		return true
+	}
+	for _, perm := range c.GetStringSlice("permissions") {
+		if perm == "admin" {
+			return true
+		}
+	}
+	return false
+}
+
+// encodeOperationsKey turns a sequence into an opaque page key
+func encodeOperationsKey(sequence uint64) string {
+	raw := make([]byte, 8)
+	binary.BigEndian.PutUint64(raw, sequence)
+	return base64.StdEncoding.EncodeToString(raw)
+}
+
+func decodeOperationsKey(key string) (uint64, error) {
+	if key == "" {
+		return 0, nil
+	}
+	raw, err := base64.StdEncoding.DecodeString(key)
+	if err != nil {
+		return 0, err
+	}
+	if len(raw) != 8 {
+		return 0, errors.New("malformed key")
+	}
+	return binary.BigEndian.Uint64(raw), nil
+}
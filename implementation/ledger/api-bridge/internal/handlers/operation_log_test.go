@@ -0,0 +1,124 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func operationsRouter(h *Handler) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.GET("/api/v1/accounts/:name/operations", h.GetAccountOperations)
+	return router
+}
+
+func getOperations(t *testing.T, router *gin.Engine, path string) OperationsPage {
+	t.Helper()
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, path, nil))
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+	var page OperationsPage
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &page))
+	return page
+}
+
+func TestAccountOperationsListsCreatorsOperations(t *testing.T) {
+	h := &Handler{operations: newOperationLog(100, 0)}
+	h.recordOperation("alice", "register_component", operationTargets("comp_1"), map[string]interface{}{"txhash": "TX1"}, nil)
+	h.recordOperation("bob", "register_component", operationTargets("comp_2"), map[string]interface{}{"txhash": "TX2"}, nil)
+	h.recordOperation("alice", "initiate_pairing", operationTargets("comp_1", "comp_3", nil), nil, errors.New("insufficient fees"))
+
+	router := operationsRouter(h)
+	page := getOperations(t, router, "/api/v1/accounts/alice/operations")
+
+	assert.Equal(t, "alice", page.Creator)
+	require.Equal(t, 2, page.Count)
+	assert.Empty(t, page.NextKey)
+
+	assert.Equal(t, "initiate_pairing", page.Operations[0].Operation)
+	assert.Equal(t, []string{"comp_1", "comp_3"}, page.Operations[0].Targets)
+	assert.Equal(t, OperationFailed, page.Operations[0].Result)
+	assert.Equal(t, "insufficient fees", page.Operations[0].Error)
+
+	assert.Equal(t, "register_component", page.Operations[1].Operation)
+	assert.Equal(t, "TX1", page.Operations[1].TxHash)
+	assert.Equal(t, OperationSucceeded, page.Operations[1].Result)
+	assert.False(t, page.Operations[1].Time.IsZero())
+
+	empty := getOperations(t, router, "/api/v1/accounts/carol/operations")
+	assert.Equal(t, 0, empty.Count)
+	assert.NotNil(t, empty.Operations)
+}
+
+func TestAccountOperationsPaging(t *testing.T) {
+	h := &Handler{operations: newOperationLog(4, 0)}
+	for i := 1; i <= 6; i++ {
+		h.recordOperation("alice", "register_component", operationTargets(fmt.Sprintf("comp_%d", i)), nil, nil)
+	}
+	router := operationsRouter(h)
+
+	first := getOperations(t, router, "/api/v1/accounts/alice/operations?limit=3")
+	require.Equal(t, 3, first.Count)
+	assert.Equal(t, []string{"comp_6"}, first.Operations[0].Targets)
+	require.NotEmpty(t, first.NextKey)
+
+	// Only the newest four are kept
+	second := getOperations(t, router, "/api/v1/accounts/alice/operations?limit=3&key="+first.NextKey)
+	require.Equal(t, 1, second.Count)
+	assert.Equal(t, []string{"comp_3"}, second.Operations[0].Targets)
+	assert.Empty(t, second.NextKey)
+
+	for _, query := range []string{"?limit=0", "?limit=many", "?key=not-base64!"} {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/v1/accounts/alice/operations"+query, nil))
+		assert.Equal(t, http.StatusBadRequest, w.Code, query)
+	}
+}
+
+func TestOperationLogDropsIdlestCreator(t *testing.T) {
+	h := &Handler{operations: newOperationLog(10, 2)}
+	h.recordOperation("alice", "register_component", operationTargets("comp_1"), nil, nil)
+	h.recordOperation("bob", "register_component", operationTargets("comp_2"), nil, nil)
+	h.recordOperation("alice", "initiate_pairing", operationTargets("comp_1", "comp_2"), nil, nil)
+
+	// bob wrote least recently, so carol takes his place
+	h.recordOperation("carol", "register_component", operationTargets("comp_3"), nil, nil)
+
+	router := operationsRouter(h)
+	assert.Equal(t, 2, getOperations(t, router, "/api/v1/accounts/alice/operations").Count)
+	assert.Equal(t, 0, getOperations(t, router, "/api/v1/accounts/bob/operations").Count)
+	assert.Equal(t, 1, getOperations(t, router, "/api/v1/accounts/carol/operations").Count)
+}
+
+func TestAccountOperationsRequireOwnership(t *testing.T) {
+	h := &Handler{operations: newOperationLog(10, 0)}
+	h.recordOperation("alice", "register_component", operationTargets("comp_1"), nil, nil)
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	var caller gin.HandlerFunc
+	router.GET("/api/v1/accounts/:name/operations", func(c *gin.Context) { caller(c) }, h.GetAccountOperations)
+	as := func(username string, permissions ...string) int {
+		caller = func(c *gin.Context) {
+			c.Set("authenticated", true)
+			c.Set("username", username)
+			c.Set("permissions", permissions)
+		}
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/v1/accounts/alice/operations", nil))
+		return w.Code
+	}
+
+	assert.Equal(t, http.StatusOK, as("alice"))
+	assert.Equal(t, http.StatusForbidden, as("bob", "component:read"))
+	assert.Equal(t, http.StatusOK, as("operator", "admin"))
+}
@@ -0,0 +1,24 @@
+package handlers
+
+import (
+	"net/url"
+	"strings"
+)
+
+// ParseOrigin parses a browser origin as configured for CORS or WebSockets:
+// an http or https scheme and a host, with no path beyond a trailing slash.
+// ok is false for anything else. The host may still hold a wildcard, which
+// only callers that support one accept.
+func ParseOrigin(origin string) (u *url.URL, ok bool) {
+	u, err := url.Parse(origin)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" || strings.Trim(u.Path, "/") != "" {
+		return nil, false
+	}
+	return u, true
+}
+
+// NormalizeOrigin puts an origin in the form configured and requesting
+// origins are compared in
+func NormalizeOrigin(origin string) string {
+	return strings.ToLower(strings.TrimRight(origin, "/"))
+}
@@ -0,0 +1,75 @@
+package handlers
+
+import (
+	"encoding/base64"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Headers sent with every paginated response
+const (
+	// MaxPageSizeHeader carries server.max_page_size, the largest page any list returns
+	MaxPageSizeHeader = "X-Max-Page-Size"
+	// PageSizeHeader carries the page size applied, after clamping, when one was requested
+	PageSizeHeader = "X-Page-Size"
+)
+
+const (
+	// defaultMaxPageSize caps pages when server.max_page_size is not set
+	defaultMaxPageSize = 100
+	// nodePageSize is the page size the node applies when no limit is sent
+	nodePageSize = 100
+)
+
+// maxPageSize returns the configured page size cap
+func (h *Handler) maxPageSize() uint64 {
+	if h.config == nil || h.config.Server.MaxPageSize <= 0 {
+		return defaultMaxPageSize
+	}
+	return uint64(h.config.Server.MaxPageSize)
+}
+
+// pageLimit parses ?limit for a paginated endpoint and clamps it to the
+// configured maximum. Without ?limit it returns fallback, itself clamped; a
+// zero fallback leaves the page size to the node unless the cap is smaller.
+// The cap and the applied size are reported in response headers. It writes a
+// 400 and returns false for a limit that is not a positive integer.
+func (h *Handler) pageLimit(c *gin.Context, fallback uint64) (uint64, bool) {
+	maxSize := h.maxPageSize()
+	c.Header(MaxPageSizeHeader, strconv.FormatUint(maxSize, 10))
+
+	limit := fallback
+	if raw := c.Query("limit"); raw != "" {
+		parsed, err := strconv.ParseUint(raw, 10, 64)
+		if err != nil || parsed == 0 {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "limit must be a positive integer"})
+			return 0, false
+		}
+		limit = parsed
+	}
+
+	switch {
+	case limit > maxSize:
+		h.logger.Debug().Uint64("requested", limit).Uint64("max", maxSize).Str("path", c.FullPath()).Msg("Clamping oversized page request")
+		limit = maxSize
+	case limit == 0 && maxSize < nodePageSize:
+		limit = maxSize
+	}
+	if limit != 0 {
+		c.Header(PageSizeHeader, strconv.FormatUint(limit, 10))
+	}
+	return limit, true
+}
+
+// pageKey returns ?key, the next_key of the previous page. It writes a 400
+// and returns false for a key that is not base64.
+func pageKey(c *gin.Context) (string, bool) {
+	key := c.Query("key")
+	if _, err := base64.StdEncoding.DecodeString(key); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "key must be the next_key returned by the previous page"})
+		return "", false
+	}
+	return key, true
+}
@@ -0,0 +1,80 @@
+package handlers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"api-bridge/internal/blockchain"
+	"api-bridge/internal/config"
+)
+
+func TestOversizedPageRequestIsClamped(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	var sentLimit string
+	node := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		sentLimit = r.URL.Query().Get("pagination.limit")
+		_, _ = w.Write([]byte(`{"components": [], "pagination": {}}`))
+	}))
+	defer node.Close()
+
+	client, err := blockchain.NewClient(node.URL, zerolog.Nop())
+	require.NoError(t, err)
+	h := &Handler{
+		config: &config.Config{
+			Server:     config.ServerConfig{MaxPageSize: 25},
+			Blockchain: config.BlockchainConfig{Timeout: 5},
+		},
+		logger:     zerolog.Nop(),
+		blockchain: client,
+	}
+	router := gin.New()
+	router.GET("/api/v1/components", h.ListComponents)
+
+	get := func(path string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, path, nil))
+		return w
+	}
+
+	w := get("/api/v1/components?limit=5000")
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	assert.Equal(t, "25", sentLimit, "the node only sees the clamped size")
+	assert.Equal(t, "25", w.Header().Get(MaxPageSizeHeader))
+	assert.Equal(t, "25", w.Header().Get(PageSizeHeader))
+
+	// Within the cap the requested size passes through
+	w = get("/api/v1/components?limit=10")
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	assert.Equal(t, "10", sentLimit)
+	assert.Equal(t, "10", w.Header().Get(PageSizeHeader))
+
+	// With no limit a cap below the node's default still applies
+	w = get("/api/v1/components")
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	assert.Equal(t, "25", sentLimit)
+
+	for _, bad := range []string{"0", "-1", "many"} {
+		assert.Equal(t, http.StatusBadRequest, get("/api/v1/components?limit="+bad).Code, bad)
+	}
+}
+
+func TestOperationsPageIsClamped(t *testing.T) {
+	h := &Handler{
+		config:     &config.Config{Server: config.ServerConfig{MaxPageSize: 2}},
+		logger:     zerolog.Nop(),
+		operations: newOperationLog(100, 0),
+	}
+	for i := 0; i < 5; i++ {
+		h.recordOperation("alice", "register_component", operationTargets("comp_1"), nil, nil)
+	}
+
+	page := getOperations(t, operationsRouter(h), "/api/v1/accounts/alice/operations?limit=50")
+	assert.Equal(t, 2, page.Count)
+	assert.NotEmpty(t, page.NextKey)
+}
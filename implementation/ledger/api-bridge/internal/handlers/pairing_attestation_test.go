@@ -0,0 +1,59 @@
+package handlers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"api-bridge/internal/blockchain"
+	"api-bridge/internal/config"
+	"api-bridge/internal/verification"
+)
+
+func TestVerifyComponentPairingWithHashesSubmitsManufacturerVerdict(t *testing.T) {
+	manufacturer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"allowed": true, "reason": "manufacturer: compatible"}`))
+	}))
+	defer manufacturer.Close()
+
+	h, client := newMockedHandler(t)
+	var err error
+	h.pairingVerifier, err = verification.New(config.ManufacturerVerificationConfig{
+		URL:              manufacturer.URL,
+		Attestor:         "racecar1oracle",
+		Timeout:          time.Second,
+		FailureThreshold: 1,
+		OpenTimeout:      time.Minute,
+	}, manufacturer.Client())
+	require.NoError(t, err)
+	router := gin.New()
+	router.POST("/components/verify-pairing-hashes", h.VerifyComponentPairingWithHashes)
+
+	// The verdict is signed by the attestor, not the caller's verifier
+	client.EXPECT().VerifyComponentPairingWithHashes(gomock.Any(), "racecar1oracle", "hash-a", "hash-b", "",
+		&blockchain.PairingAttestation{CanPair: true, Reason: "manufacturer: compatible"}).
+		Return(map[string]interface{}{"can_pair": true, "reason": "manufacturer: compatible", "txhash": "TX1"}, nil)
+	w := serve(router, http.MethodPost, "/components/verify-pairing-hashes", `{"verifier": "alice", "component_hash_a": "hash-a", "component_hash_b": "hash-b"}`)
+	assert.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+	// Nothing is submitted while the service is down
+	manufacturer.Close()
+	w = serve(router, http.MethodPost, "/components/verify-pairing-hashes", `{"verifier": "alice", "component_hash_a": "hash-a", "component_hash_b": "hash-b"}`)
+	assert.Equal(t, http.StatusServiceUnavailable, w.Code, w.Body.String())
+	assert.Contains(t, w.Body.String(), "VERIFICATION_UNAVAILABLE")
+}
+
+func TestNewRejectsUnusableManufacturerConfig(t *testing.T) {
+	cfg := &config.Config{Verification: config.VerificationConfig{
+		Manufacturer: config.ManufacturerVerificationConfig{URL: "http://verifier.example.com"},
+	}}
+	_, err := New(cfg, NewMockBlockchainClient(gomock.NewController(t)), zerolog.Nop())
+	assert.ErrorContains(t, err, "https")
+}
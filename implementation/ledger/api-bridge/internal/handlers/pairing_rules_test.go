@@ -0,0 +1,27 @@
+package handlers
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestGetPairingRulesRequiresSourceType(t *testing.T) {
+	h, client := newMockedHandler(t)
+	router := gin.New()
+	router.GET("/pairing-rules", h.GetPairingRules)
+
+	client.EXPECT().GetPairingRules(gomock.Any(), "module", uint64(20), "cnVsZS0x").
+		Return(map[string]interface{}{"source_type": "module", "rules": []interface{}{}, "count": 0, "next_key": ""}, nil)
+	w := serve(router, http.MethodGet, "/pairing-rules?source_type=module&limit=20&key=cnVsZS0x", "")
+	assert.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+	// No source type, or a bad key, is rejected without querying the chain
+	for _, query := range []string{"", "limit=5", "source_type=module&key=not-base64!"} {
+		w = serve(router, http.MethodGet, "/pairing-rules?"+query, "")
+		assert.Equal(t, http.StatusBadRequest, w.Code, query)
+	}
+}
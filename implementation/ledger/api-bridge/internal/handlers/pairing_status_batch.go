@@ -0,0 +1,76 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sort"
+
+	"github.com/gin-gonic/gin"
+
+	"api-bridge/internal/blockchain"
+)
+
+// pairingStatusFetcher is the subset of the blockchain client used by the batch status query
+type pairingStatusFetcher interface {
+	GetPairingStatus(ctx context.Context, challengeID string) (map[string]interface{}, error)
+}
+
+// PairingStatuses is the response of POST /pairing/status/batch. Every
+// requested challenge appears in exactly one of Statuses, NotFound or Errors.
+type PairingStatuses struct {
+	Statuses map[string]map[string]interface{} `json:"statuses"`
+	NotFound []string                          `json:"not_found"`
+	Errors   map[string]string                 `json:"errors"`
+}
+
+// fetchPairingStatuses queries the status of each challenge with at most
+// workers queries in flight. Challenges the chain holds no session for are
+// listed under NotFound, other failures under Errors; neither stops the others.
+func fetchPairingStatuses(ctx context.Context, client pairingStatusFetcher, challengeIDs []string, workers int) PairingStatuses {
+	result := PairingStatuses{
+		Statuses: make(map[string]map[string]interface{}, len(challengeIDs)),
+		NotFound: []string{},
+		Errors:   make(map[string]string),
+	}
+
+	fanOut(ctx, challengeIDs, workers, client.GetPairingStatus, func(id string, status map[string]interface{}, err error) {
+		switch {
+		case errors.Is(err, blockchain.ErrPairingChallengeNotFound):
+			result.NotFound = append(result.NotFound, id)
+		case err != nil:
+			result.Errors[id] = err.Error()
+		default:
+			result.Statuses[id] = status
+		}
+	})
+	sort.Strings(result.NotFound)
+	return result
+}
+
+// GetPairingStatuses returns the statuses of up to server.max_page_size
+// pairing challenges in one request, querying the node concurrently
+func (h *Handler) GetPairingStatuses(c *gin.Context) {
+	var req struct {
+		ChallengeIDs []string `json:"challenge_ids" binding:"required,min=1"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	challengeIDs, ok := h.batchIDs(c, "challenge_ids", req.ChallengeIDs)
+	if !ok {
+		return
+	}
+
+	ctx, cancel := h.queryContext(c)
+	defer cancel()
+
+	statuses := fetchPairingStatuses(ctx, h.blockchain, challengeIDs, h.config.Server.BatchWorkers)
+	for id, err := range statuses.Errors {
+		h.logger.Warn().Str("challenge_id", id).Str("error", err).Msg("Failed to get pairing status")
+	}
+
+	c.JSON(http.StatusOK, statuses)
+}
@@ -0,0 +1,43 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"api-bridge/internal/blockchain"
+)
+
+func TestGetPairingStatuses(t *testing.T) {
+	h, client := newMockedHandler(t)
+	router := gin.New()
+	router.POST("/pairing/status/batch", h.GetPairingStatuses)
+
+	client.EXPECT().GetPairingStatus(gomock.Any(), "chal-1").Return(map[string]interface{}{"challenge_id": "chal-1", "status": "completed"}, nil)
+	client.EXPECT().GetPairingStatus(gomock.Any(), "chal-404").Return(nil, fmt.Errorf("%w: chal-404", blockchain.ErrPairingChallengeNotFound))
+	client.EXPECT().GetPairingStatus(gomock.Any(), "chal-500").Return(nil, errors.New("failed to get pairing status: connection refused"))
+	w := serve(router, http.MethodPost, "/pairing/status/batch", `{"challenge_ids": ["chal-1", "chal-404", "chal-500", "chal-1"]}`)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+	var body PairingStatuses
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	assert.Equal(t, map[string]map[string]interface{}{"chal-1": {"challenge_id": "chal-1", "status": "completed"}}, body.Statuses)
+	assert.Equal(t, []string{"chal-404"}, body.NotFound)
+	assert.Equal(t, map[string]string{"chal-500": "failed to get pairing status: connection refused"}, body.Errors)
+
+	// Rejected without querying the chain
+	for _, request := range []string{`{}`, `{"challenge_ids": []}`, `{"challenge_ids": [""]}`} {
+		w = serve(router, http.MethodPost, "/pairing/status/batch", request)
+		assert.Equal(t, http.StatusBadRequest, w.Code, request)
+	}
+	h.config.Server.MaxPageSize = 2
+	w = serve(router, http.MethodPost, "/pairing/status/batch", `{"challenge_ids": ["chal-1", "chal-2", "chal-3"]}`)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
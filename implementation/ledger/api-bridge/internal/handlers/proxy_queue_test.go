@@ -0,0 +1,76 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"api-bridge/internal/blockchain"
+	"api-bridge/internal/config"
+)
+
+func proxyQueueRouter(t *testing.T, node http.Handler) *gin.Engine {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+
+	server := httptest.NewServer(node)
+	t.Cleanup(server.Close)
+
+	client, err := blockchain.NewClient(server.URL, zerolog.Nop())
+	require.NoError(t, err)
+	h := &Handler{
+		config:     &config.Config{Blockchain: config.BlockchainConfig{Timeout: 5}},
+		logger:     zerolog.Nop(),
+		blockchain: client,
+	}
+
+	router := gin.New()
+	router.GET("/api/v1/queue/proxy/:id", h.ListProxyQueue)
+	return router
+}
+
+func TestListProxyQueueFiltersByStatus(t *testing.T) {
+	router := proxyQueueRouter(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/racecar-web/pairingqueue/v1/list_proxy_queue/MODBATT-PC-001", r.URL.Path)
+		query := r.URL.Query()
+		assert.Equal(t, "retrying", query.Get("status"))
+		assert.Equal(t, "pairing", query.Get("operation_type"))
+		assert.Equal(t, "1800", query.Get("max_age_seconds"))
+		assert.Equal(t, "2", query.Get("pagination.limit"))
+		_, _ = w.Write([]byte(`{"offline_operations": "[{\"operation_id\":\"op-retrying-1\",\"operation_type\":\"pairing\",\"retry_count\":2}]", "pagination": {"next_key": "b3AtcmV0cnlpbmctMw=="}}`))
+	}))
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/v1/queue/proxy/MODBATT-PC-001?status=retrying&operation_type=pairing&max_age=30m&limit=2", nil))
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+	var body struct {
+		ProxyID    string                   `json:"proxy_id"`
+		Operations []map[string]interface{} `json:"operations"`
+		Count      int                      `json:"count"`
+		NextKey    string                   `json:"next_key"`
+	}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	assert.Equal(t, "MODBATT-PC-001", body.ProxyID)
+	assert.Equal(t, 1, body.Count)
+	assert.Equal(t, "op-retrying-1", body.Operations[0]["operation_id"])
+	assert.Equal(t, "b3AtcmV0cnlpbmctMw==", body.NextKey)
+}
+
+func TestListProxyQueueRejectsBadFilters(t *testing.T) {
+	router := proxyQueueRouter(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request to %s", r.URL.Path)
+	}))
+
+	for _, query := range []string{"?status=queued", "?min_age=soon", "?min_age=2h&max_age=1h", "?limit=0", "?key=not-base64!"} {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/v1/queue/proxy/MODBATT-PC-001"+query, nil))
+		assert.Equal(t, http.StatusBadRequest, w.Code, query)
+	}
+}
@@ -0,0 +1,87 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"api-bridge/internal/blockchain"
+	"api-bridge/internal/config"
+)
+
+func TestRecallScopeWithholdsOwnersWithoutPermission(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	custodyLookups := 0
+	node := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/racecar-web/componentregistry/v1/components/search":
+			_, _ = w.Write([]byte(`{"components": [{"component_id": "hash-pack-1", "manufacturer_hash": "mfr-a", "status": "active"}]}`))
+		case r.URL.Path == "/racecar-web/lctmanager/v1/lcts":
+			_, _ = w.Write([]byte(`{"lcts": [{"lct_id": "lct-pack-mc-1", "component_a_id": "hash-pack-1", "component_b_id": "hash-mc-1", "pairing_status": "active"}]}`))
+		case r.URL.Path == "/racecar-web/lctmanager/v1/proxy/hash-pack-1/lcts":
+			_, _ = w.Write([]byte(`{"lcts": []}`))
+		case r.URL.Path == "/racecar-web/componentregistry/v1/get_component/hash-mc-1":
+			_, _ = w.Write([]byte(`{"component": {"component_id": "hash-mc-1", "manufacturer_hash": "mfr-b", "status": "active"}}`))
+		case strings.HasPrefix(r.URL.Path, "/racecar-web/componentregistry/v1/custody/"):
+			custodyLookups++
+			_, _ = w.Write([]byte(`{"events": [{"sequence": "1", "to_owner": "cosmos1fleet", "transferred_at": "2025-07-14T09:00:00Z"}]}`))
+		default:
+			t.Errorf("unexpected request to %s", r.URL.Path)
+		}
+	}))
+	defer node.Close()
+
+	client, err := blockchain.NewClient(node.URL, zerolog.Nop())
+	require.NoError(t, err)
+	h := &Handler{
+		config:     &config.Config{Blockchain: config.BlockchainConfig{Timeout: 5}},
+		logger:     zerolog.Nop(),
+		blockchain: client,
+	}
+
+	// permissions stands in for the API key the auth middleware resolved
+	var permissions []string
+	router := gin.New()
+	router.POST("/api/v1/admin/recall-scope", func(c *gin.Context) {
+		c.Set("authenticated", true)
+		c.Set("permissions", permissions)
+	}, h.RecallScope)
+
+	post := func(body string) (*httptest.ResponseRecorder, blockchain.RecallScope) {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/api/v1/admin/recall-scope", strings.NewReader(body)))
+		var scope blockchain.RecallScope
+		if w.Code == http.StatusOK {
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &scope))
+		}
+		return w, scope
+	}
+
+	permissions = []string{"system:read"}
+	w, scope := post(`{"manufacturer_hash": "mfr-a"}`)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	assert.Regexp(t, `^attachment; filename="recall-scope-\d+\.json"$`, w.Header().Get("Content-Disposition"))
+	assert.False(t, scope.OwnersIncluded)
+	require.Len(t, scope.Components, 1)
+	assert.Empty(t, scope.Components[0].Owner)
+	assert.Zero(t, custodyLookups, "owners must not even be looked up")
+	assert.Equal(t, "hash-mc-1", scope.Counterparts[0].ComponentID)
+
+	permissions = []string{"system:read", RecallOwnersPermission}
+	w, scope = post(`{"manufacturer_hash": "mfr-a"}`)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	assert.True(t, scope.OwnersIncluded)
+	assert.Equal(t, "cosmos1fleet", scope.Components[0].Owner)
+
+	w, _ = post(`{}`)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	w, _ = post(`{"component_hashes": [""]}`)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
@@ -0,0 +1,307 @@
+package handlers
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// NDJSONContentType is the media type of register-stream requests and responses
+const NDJSONContentType = "application/x-ndjson"
+
+// Streamed registration results
+const (
+	StreamStatusRegistered = "registered"
+	StreamStatusFailed     = "failed"
+)
+
+const (
+	// defaultStreamBatchSize bounds how many components are in flight when
+	// server.register_stream_batch is not set
+	defaultStreamBatchSize = 16
+	// defaultStreamMaxComponents bounds the components of one stream when
+	// server.register_stream_limit is not set
+	defaultStreamMaxComponents = 1000
+	// maxStreamLineBytes bounds a single NDJSON line
+	maxStreamLineBytes = 1 << 20
+)
+
+// componentRegistrar is the subset of the blockchain client used by register-stream
+type componentRegistrar interface {
+	RegisterComponent(ctx context.Context, creator, componentData, context string, dedupe bool) (map[string]interface{}, error)
+}
+
+// StreamRegistration is one line of a register-stream request
+type StreamRegistration struct {
+	Creator       string `json:"creator"`
+	ComponentData string `json:"component_data"`
+	Context       string `json:"context"`
+	Dedupe        bool   `json:"dedupe"` // reuse a component already registered with the same data
+}
+
+// StreamRegistrationResult is the response line for one request line
+type StreamRegistrationResult struct {
+	Line        int    `json:"line"`
+	Status      string `json:"status"`
+	ComponentID string `json:"component_id,omitempty"`
+	TxHash      string `json:"txhash,omitempty"`
+	Error       string `json:"error,omitempty"`
+}
+
+// StreamRegistrationSummary is the last line of every register-stream response
+type StreamRegistrationSummary struct {
+	Done       bool   `json:"done"`
+	Received   int    `json:"received"`
+	Registered int    `json:"registered"`
+	Failed     int    `json:"failed"`
+	Error      string `json:"error,omitempty"` // why the request stream ended early
+}
+
+// registrationStream registers the components of an NDJSON stream in bounded
+// batches and writes one result line per component as each batch finishes
+type registrationStream struct {
+	client        componentRegistrar
+	batchSize     int
+	maxComponents int           // lines read before the stream is cut off
+	timeout       time.Duration // per registration
+	write         func(v interface{}) error
+	flush         func()
+	// registered is called after every registration attempt
+	registered func(req StreamRegistration, resp map[string]interface{}, err error)
+}
+
+// streamLine is one parsed request line
+type streamLine struct {
+	number int
+	req    StreamRegistration
+	err    error
+}
+
+// run reads body until it ends and returns the summary it wrote last. At most
+// batchSize lines are read ahead of the registrations, so memory stays bounded
+// however long the stream is.
+func (s *registrationStream) run(ctx context.Context, body io.Reader) StreamRegistrationSummary {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	batchSize := s.batchSize
+	if batchSize <= 0 {
+		batchSize = defaultStreamBatchSize
+	}
+	maxComponents := s.maxComponents
+	if maxComponents <= 0 {
+		maxComponents = defaultStreamMaxComponents
+	}
+
+	lines := make(chan streamLine, batchSize)
+	var readErr error
+	go func() {
+		defer close(lines)
+		readErr = readStreamLines(ctx, body, lines, maxComponents)
+	}()
+
+	summary := StreamRegistrationSummary{}
+	for {
+		batch, ok := nextStreamBatch(ctx, lines, batchSize)
+		if !ok {
+			break
+		}
+		for _, result := range s.registerBatch(ctx, batch) {
+			summary.Received++
+			if result.Status == StreamStatusRegistered {
+				summary.Registered++
+			} else {
+				summary.Failed++
+			}
+			if err := s.write(result); err != nil {
+				// The client went away; nobody is left to read the summary
+				summary.Error = err.Error()
+				return summary
+			}
+		}
+		s.flush()
+	}
+
+	// lines is closed, so the reader has finished with readErr
+	if ctx.Err() != nil {
+		summary.Error = ctx.Err().Error()
+	} else if readErr != nil {
+		summary.Error = readErr.Error()
+	}
+	summary.Done = summary.Error == ""
+	_ = s.write(summary)
+	s.flush()
+	return summary
+}
+
+// readStreamLines parses body line by line into lines, skipping blank lines,
+// and stops with an error at the first line past maxComponents
+func readStreamLines(ctx context.Context, body io.Reader, lines chan<- streamLine, maxComponents int) error {
+	scanner := bufio.NewScanner(body)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxStreamLineBytes)
+
+	number, components := 0, 0
+	for scanner.Scan() {
+		number++
+		raw := bytes.TrimSpace(scanner.Bytes())
+		if len(raw) == 0 {
+			continue
+		}
+		if components++; components > maxComponents {
+			return fmt.Errorf("line %d: a stream registers at most %d components", number, maxComponents)
+		}
+
+		line := streamLine{number: number}
+		if err := json.Unmarshal(raw, &line.req); err != nil {
+			line.err = fmt.Errorf("invalid JSON: %w", err)
+		} else if line.req.Creator == "" || line.req.ComponentData == "" {
+			line.err = errors.New("creator and component_data are required")
+		}
+
+		select {
+		case lines <- line:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("failed to read line %d: %w", number+1, err)
+	}
+	return nil
+}
+
+// nextStreamBatch waits for the next line, then takes whatever else has
+// already arrived up to batchSize, so a slow client still gets each result
+// without waiting for a full batch
+func nextStreamBatch(ctx context.Context, lines <-chan streamLine, batchSize int) ([]streamLine, bool) {
+	var first streamLine
+	select {
+	case line, ok := <-lines:
+		if !ok {
+			return nil, false
+		}
+		first = line
+	case <-ctx.Done():
+		return nil, false
+	}
+
+	batch := []streamLine{first}
+	for len(batch) < batchSize {
+		select {
+		case line, ok := <-lines:
+			if !ok {
+				return batch, true
+			}
+			batch = append(batch, line)
+		default:
+			return batch, true
+		}
+	}
+	return batch, true
+}
+
+// registerBatch registers the lines of a batch concurrently and returns
+// their results in request order
+func (s *registrationStream) registerBatch(ctx context.Context, batch []streamLine) []StreamRegistrationResult {
+	results := make([]StreamRegistrationResult, len(batch))
+	var wg sync.WaitGroup
+	for i, line := range batch {
+		results[i] = StreamRegistrationResult{Line: line.number}
+		if line.err != nil {
+			results[i].Status = StreamStatusFailed
+			results[i].Error = line.err.Error()
+			continue
+		}
+
+		wg.Add(1)
+		go func(result *StreamRegistrationResult, req StreamRegistration) {
+			defer wg.Done()
+			regCtx, cancel := context.WithTimeout(ctx, s.timeout)
+			defer cancel()
+
+			resp, err := s.client.RegisterComponent(regCtx, req.Creator, req.ComponentData, req.Context, req.Dedupe)
+			if s.registered != nil {
+				s.registered(req, resp, err)
+			}
+			if err != nil {
+				result.Status = StreamStatusFailed
+				result.Error = err.Error()
+				return
+			}
+			result.Status = StreamStatusRegistered
+			result.ComponentID, _ = resp["component_id"].(string)
+			result.TxHash, _ = resp["txhash"].(string)
+		}(&results[i], line.req)
+	}
+	wg.Wait()
+	return results
+}
+
+// RegisterComponentStream registers components sent as NDJSON, one
+// {"creator", "component_data", "context"} object per line, and streams one
+// NDJSON result per line back as each batch completes. The last line is a
+// summary with "done": true unless the request stream broke off.
+func (h *Handler) RegisterComponentStream(c *gin.Context) {
+	// Keep reading the request after the first results are written
+	rc := http.NewResponseController(c.Writer)
+	if err := rc.EnableFullDuplex(); err != nil {
+		h.logger.Debug().Err(err).Msg("Full-duplex streaming unavailable")
+	}
+	// The server read and write timeouts would cut a long stream off partway;
+	// each registration has its own timeout and the stream is capped instead
+	if err := rc.SetReadDeadline(time.Time{}); err != nil {
+		h.logger.Debug().Err(err).Msg("Failed to clear the stream read deadline")
+	}
+	if err := rc.SetWriteDeadline(time.Time{}); err != nil {
+		h.logger.Debug().Err(err).Msg("Failed to clear the stream write deadline")
+	}
+
+	c.Header("Content-Type", NDJSONContentType)
+	c.Status(http.StatusOK)
+
+	encoder := json.NewEncoder(c.Writer)
+	stream := &registrationStream{
+		client:        h.blockchain,
+		batchSize:     h.config.Server.RegisterStreamBatch,
+		maxComponents: h.config.Server.RegisterStreamLimit,
+		timeout:       h.config.Blockchain.BroadcastTimeoutDuration(),
+		write:         encoder.Encode,
+		flush:         c.Writer.Flush,
+		registered: func(req StreamRegistration, resp map[string]interface{}, err error) {
+			h.recordOperation(req.Creator, "register_component", operationTargets(resp["component_id"]), resp, err)
+			if err != nil || h.eventQueue == nil {
+				return
+			}
+			txHash, _ := resp["txhash"].(string)
+			operation := ""
+			if txHash != "" {
+				operation = "tx:" + txHash
+			}
+			h.eventQueue.EmitOnce(operation, "component_registered", map[string]interface{}{
+				"component_id":   resp["component_id"],
+				"creator":        req.Creator,
+				"component_data": req.ComponentData,
+				"context":        req.Context,
+				"timestamp":      time.Now().Unix(),
+				"tx_hash":        txHash,
+			})
+		},
+	}
+
+	summary := stream.run(c.Request.Context(), c.Request.Body)
+	h.logger.Info().
+		Int("received", summary.Received).
+		Int("registered", summary.Registered).
+		Int("failed", summary.Failed).
+		Str("error", summary.Error).
+		Msg("Streamed component registration finished")
+}
@@ -0,0 +1,172 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeRegistrar registers every component except those whose data mentions FAIL
+type fakeRegistrar struct {
+	mu       sync.Mutex
+	inFlight int
+	maxSeen  int
+	release  chan struct{} // when set, each registration waits for a value
+}
+
+func (f *fakeRegistrar) RegisterComponent(ctx context.Context, creator, componentData, context string, dedupe bool) (map[string]interface{}, error) {
+	f.mu.Lock()
+	f.inFlight++
+	if f.inFlight > f.maxSeen {
+		f.maxSeen = f.inFlight
+	}
+	f.mu.Unlock()
+	defer func() {
+		f.mu.Lock()
+		f.inFlight--
+		f.mu.Unlock()
+	}()
+
+	if f.release != nil {
+		<-f.release
+	}
+	if strings.Contains(componentData, "FAIL") {
+		return nil, fmt.Errorf("blockchain transaction failed")
+	}
+	return map[string]interface{}{"component_id": "comp_" + componentData, "txhash": "TX_" + componentData}, nil
+}
+
+// collectingStream records every line written and signals after each flush
+func collectingStream(client componentRegistrar, batchSize int) (*registrationStream, <-chan []interface{}) {
+	var mu sync.Mutex
+	var pending []interface{}
+	flushed := make(chan []interface{}, 100)
+	return &registrationStream{
+		client:    client,
+		batchSize: batchSize,
+		timeout:   time.Second,
+		write: func(v interface{}) error {
+			mu.Lock()
+			defer mu.Unlock()
+			pending = append(pending, v)
+			return nil
+		},
+		flush: func() {
+			mu.Lock()
+			defer mu.Unlock()
+			flushed <- pending
+			pending = nil
+		},
+	}, flushed
+}
+
+func nextFlush(t *testing.T, flushed <-chan []interface{}) []interface{} {
+	t.Helper()
+	select {
+	case lines := <-flushed:
+		return lines
+	case <-time.After(2 * time.Second):
+		t.Fatal("no results were streamed")
+		return nil
+	}
+}
+
+func TestRegistrationStreamReturnsResultsIncrementally(t *testing.T) {
+	stream, flushed := collectingStream(&fakeRegistrar{}, 4)
+	body, client := io.Pipe()
+
+	done := make(chan StreamRegistrationSummary, 1)
+	go func() { done <- stream.run(context.Background(), body) }()
+
+	// Each line gets its result while the request is still open
+	_, err := io.WriteString(client, `{"creator": "cosmos1racecar", "component_data": "MOD-001"}`+"\n")
+	require.NoError(t, err)
+	assert.Equal(t, []interface{}{
+		StreamRegistrationResult{Line: 1, Status: StreamStatusRegistered, ComponentID: "comp_MOD-001", TxHash: "TX_MOD-001"},
+	}, nextFlush(t, flushed))
+
+	_, err = io.WriteString(client, `{"creator": "cosmos1racecar", "component_data": "MOD-FAIL"}`+"\n\n")
+	require.NoError(t, err)
+	failed := nextFlush(t, flushed)
+	require.Len(t, failed, 1)
+	assert.Equal(t, StreamStatusFailed, failed[0].(StreamRegistrationResult).Status)
+	assert.Equal(t, "blockchain transaction failed", failed[0].(StreamRegistrationResult).Error)
+
+	_, err = io.WriteString(client, "not json\n"+`{"creator": "cosmos1racecar"}`+"\n"+`{"creator": "cosmos1racecar", "component_data": "MOD-002"}`+"\n")
+	require.NoError(t, err)
+	require.NoError(t, client.Close())
+
+	var rest []StreamRegistrationResult
+	for len(rest) < 3 {
+		for _, line := range nextFlush(t, flushed) {
+			rest = append(rest, line.(StreamRegistrationResult))
+		}
+	}
+	assert.Equal(t, 4, rest[0].Line, "blank lines are skipped but counted")
+	assert.Contains(t, rest[0].Error, "invalid JSON")
+	assert.Equal(t, "creator and component_data are required", rest[1].Error)
+	assert.Equal(t, StreamRegistrationResult{Line: 6, Status: StreamStatusRegistered, ComponentID: "comp_MOD-002", TxHash: "TX_MOD-002"}, rest[2])
+
+	summary := <-done
+	assert.Equal(t, StreamRegistrationSummary{Done: true, Received: 5, Registered: 2, Failed: 3}, summary)
+	assert.Equal(t, []interface{}{summary}, nextFlush(t, flushed))
+}
+
+func TestRegistrationStreamBoundsBatches(t *testing.T) {
+	registrar := &fakeRegistrar{release: make(chan struct{})}
+	stream, flushed := collectingStream(registrar, 3)
+
+	var body strings.Builder
+	for i := 0; i < 10; i++ {
+		fmt.Fprintf(&body, `{"creator": "cosmos1racecar", "component_data": "MOD-%03d"}`+"\n", i)
+	}
+
+	done := make(chan StreamRegistrationSummary, 1)
+	go func() { done <- stream.run(context.Background(), strings.NewReader(body.String())) }()
+	go func() {
+		for i := 0; i < 10; i++ {
+			registrar.release <- struct{}{}
+		}
+	}()
+
+	summary := <-done
+	assert.Equal(t, 10, summary.Registered)
+	assert.LessOrEqual(t, registrar.maxSeen, 3)
+
+	lines := 0
+	for len(flushed) > 0 {
+		batch := <-flushed
+		assert.LessOrEqual(t, len(batch), 3)
+		lines += len(batch)
+	}
+	assert.Equal(t, 11, lines, "ten results and the summary")
+}
+
+func TestRegistrationStreamReportsTruncatedStream(t *testing.T) {
+	stream, _ := collectingStream(&fakeRegistrar{}, 4)
+	oversized := `{"creator": "cosmos1racecar", "component_data": "` + strings.Repeat("x", maxStreamLineBytes) + `"}`
+
+	summary := stream.run(context.Background(), strings.NewReader(oversized+"\n"))
+	assert.False(t, summary.Done)
+	assert.Contains(t, summary.Error, "failed to read line 1")
+}
+
+func TestRegistrationStreamCapsComponents(t *testing.T) {
+	stream, _ := collectingStream(&fakeRegistrar{}, 4)
+	stream.maxComponents = 2
+
+	body := "{\"creator\": \"cosmos1racecar\", \"component_data\": \"a\"}\n\n" +
+		"{\"creator\": \"cosmos1racecar\", \"component_data\": \"b\"}\n" +
+		"{\"creator\": \"cosmos1racecar\", \"component_data\": \"c\"}\n"
+	summary := stream.run(context.Background(), strings.NewReader(body))
+	assert.False(t, summary.Done)
+	assert.Equal(t, 2, summary.Registered)
+	assert.Equal(t, "line 4: a stream registers at most 2 components", summary.Error)
+}
@@ -0,0 +1,65 @@
+package handlers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+
+	"api-bridge/internal/config"
+	"api-bridge/internal/events"
+)
+
+func TestReloadEventsSwitchesWebhookEndpoints(t *testing.T) {
+	sink := func(delivered chan string, name string) *httptest.Server {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			delivered <- name
+		}))
+		t.Cleanup(server.Close)
+		return server
+	}
+	delivered := make(chan string, 10)
+	oldSink, newSink := sink(delivered, "old"), sink(delivered, "new")
+
+	h := &Handler{
+		logger:     zerolog.Nop(),
+		eventQueue: events.NewEventQueue(map[string][]string{events.AllEvents: {oldSink.URL}}, 1, time.Millisecond, time.Hour, zerolog.Nop()),
+	}
+	defer h.eventQueue.Shutdown()
+
+	require.NoError(t, h.ReloadEvents(config.EventsConfig{
+		Enabled:  true,
+		Webhooks: []config.WebhookConfig{{URL: newSink.URL, Subscribe: []string{"lct_created"}}},
+	}))
+	h.eventQueue.Emit("component_registered", nil)
+	h.eventQueue.Emit("lct_created", nil)
+	select {
+	case name := <-delivered:
+		require.Equal(t, "new", name)
+	case <-time.After(2 * time.Second):
+		t.Fatal("event was not delivered")
+	}
+
+	// An invalid retry schedule rejects the whole reload
+	err := h.ReloadEvents(config.EventsConfig{
+		Enabled:   true,
+		Endpoints: map[string][]string{events.AllEvents: {oldSink.URL}},
+		Retry:     config.WebhookRetryConfig{Policy: "linear"},
+	})
+	require.ErrorContains(t, err, "events.retry")
+	h.eventQueue.Emit("lct_created", nil)
+	select {
+	case name := <-delivered:
+		require.Equal(t, "new", name)
+	case <-time.After(2 * time.Second):
+		t.Fatal("event was not delivered")
+	}
+	select {
+	case name := <-delivered:
+		t.Fatalf("unexpected delivery to the %s endpoint", name)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
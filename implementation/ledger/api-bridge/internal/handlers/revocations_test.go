@@ -0,0 +1,73 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"api-bridge/internal/blockchain"
+	"api-bridge/internal/config"
+)
+
+func revocationsRouter(t *testing.T, node http.Handler) *gin.Engine {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+
+	server := httptest.NewServer(node)
+	t.Cleanup(server.Close)
+
+	client, err := blockchain.NewClient(server.URL, zerolog.Nop())
+	require.NoError(t, err)
+	h := &Handler{
+		config:     &config.Config{Blockchain: config.BlockchainConfig{Timeout: 5}},
+		logger:     zerolog.Nop(),
+		blockchain: client,
+	}
+
+	router := gin.New()
+	router.GET("/api/v1/revocations", h.GetRevocationEvents)
+	return router
+}
+
+func TestGetRevocationEventsForTarget(t *testing.T) {
+	router := revocationsRouter(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/racecar-web/componentregistry/v1/revocations", r.URL.Path)
+		query := r.URL.Query()
+		assert.Equal(t, "hash_pack_001", query.Get("target_hash"))
+		assert.Equal(t, "1752451200", query.Get("from"))
+		assert.Equal(t, "1752537600", query.Get("to"))
+		_, _ = w.Write([]byte(`{"events": [{"revocation_id": "rev-1", "target_hash": "hash_pack_001", "reason_category": "SAFETY", "effective_at": "2025-07-14T09:21:44Z"}], "pagination": {"next_key": null}}`))
+	}))
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/v1/revocations?target_hash=hash_pack_001&from=2025-07-14T00:00:00Z&to=1752537600", nil))
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+	var body struct {
+		TargetHash  string                   `json:"target_hash"`
+		Revocations []map[string]interface{} `json:"revocations"`
+		Count       int                      `json:"count"`
+	}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	assert.Equal(t, "hash_pack_001", body.TargetHash)
+	assert.Equal(t, 1, body.Count)
+	assert.Equal(t, "rev-1", body.Revocations[0]["revocation_id"])
+}
+
+func TestGetRevocationEventsRejectsBadRange(t *testing.T) {
+	router := revocationsRouter(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request to %s", r.URL.Path)
+	}))
+
+	for _, query := range []string{"?from=yesterday", "?from=200&to=100", "?to=-5"} {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/v1/revocations"+query, nil))
+		assert.Equal(t, http.StatusBadRequest, w.Code, query)
+	}
+}
@@ -0,0 +1,36 @@
+package handlers
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"api-bridge/internal/blockchain"
+)
+
+func TestSearchComponentsRequiresHash(t *testing.T) {
+	h, client := newMockedHandler(t)
+	router := gin.New()
+	router.GET("/components/search", h.SearchComponents)
+
+	client.EXPECT().SearchComponents(gomock.Any(), blockchain.ComponentSearchFilter{
+		ManufacturerHash: "aa11",
+		Status:           "active",
+	}, uint64(20), "Y29tcC0y").Return(map[string]interface{}{"components": []interface{}{}, "count": 0, "next_key": ""}, nil)
+	w := serve(router, http.MethodGet, "/components/search?manufacturer_hash=aa11&status=active&limit=20&key=Y29tcC0y", "")
+	assert.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+	client.EXPECT().SearchComponents(gomock.Any(), blockchain.ComponentSearchFilter{CategoryHash: "bb22"}, uint64(0), "").
+		Return(map[string]interface{}{"components": []interface{}{}, "count": 0, "next_key": ""}, nil)
+	w = serve(router, http.MethodGet, "/components/search?category_hash=bb22", "")
+	assert.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+	// Neither hash, or a bad key, is rejected without querying the chain
+	for _, query := range []string{"status=active", "manufacturer_hash=aa11&key=not-base64!"} {
+		w = serve(router, http.MethodGet, "/components/search?"+query, "")
+		assert.Equal(t, http.StatusBadRequest, w.Code, query)
+	}
+}
@@ -0,0 +1,248 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"api-bridge/internal/blockchain"
+	"api-bridge/internal/config"
+)
+
+// fakeTrustTensorNode stores the tensors created through the tx endpoint and
+// serves them from the trusttensor query endpoint
+func fakeTrustTensorNode(t *testing.T) *httptest.Server {
+	t.Helper()
+	var (
+		mu         sync.Mutex
+		tensors    = make(map[string]map[string]interface{})
+		dimensions = make(map[string]interface{})
+	)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/cosmos/tx/v1beta1/txs", func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Tx struct {
+				Messages []map[string]interface{} `json:"messages"`
+			} `json:"tx"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		msg := body.Tx.Messages[0]
+		tensorID := fmt.Sprintf("tensor-lct-%s-%s", msg["component_a_id"], msg["component_b_id"])
+
+		mu.Lock()
+		if named, ok := msg["dimensions"]; ok {
+			// Named dimensions are served as the query's breakdown
+			dimensions[tensorID] = named
+		}
+		tensors[tensorID] = map[string]interface{}{
+			"tensor_id":         tensorID,
+			"lct_id":            fmt.Sprintf("lct-%s-%s", msg["component_a_id"], msg["component_b_id"]),
+			"tensor_type":       "T3",
+			"talent_score":      msg["initial_score"],
+			"training_score":    msg["initial_score"],
+			"temperament_score": msg["initial_score"],
+			"context":           msg["operational_context"],
+			"updated_at":        "1752484904",
+			"version":           "1",
+		}
+		mu.Unlock()
+
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"tx_response": map[string]interface{}{
+				"code":   0,
+				"txhash": "TENSORTX",
+				"events": []map[string]interface{}{{
+					"type":       "relationship_tensor_created",
+					"attributes": []map[string]string{{"key": "tensor_id", "value": tensorID}},
+				}},
+			},
+		})
+	})
+	mux.HandleFunc("/racecar-web/trusttensor/v1/get_trust_tensor/", func(w http.ResponseWriter, r *http.Request) {
+		tensorID := strings.TrimPrefix(r.URL.Path, "/racecar-web/trusttensor/v1/get_trust_tensor/")
+
+		mu.Lock()
+		tensor, ok := tensors[tensorID]
+		named := dimensions[tensorID]
+		mu.Unlock()
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"code": 5, "message": "` + tensorID + `: trust tensor not found", "details": []}`))
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"tensor":          tensor,
+			"composite_score": tensor["talent_score"],
+			"dimensions":      named,
+		})
+	})
+
+	node := httptest.NewServer(mux)
+	t.Cleanup(node.Close)
+	return node
+}
+
+func TestCreateThenGetTrustTensor(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	node := fakeTrustTensorNode(t)
+
+	client, err := blockchain.NewClient(node.URL, zerolog.Nop())
+	require.NoError(t, err)
+	h := &Handler{
+		config:     &config.Config{Blockchain: config.BlockchainConfig{Timeout: 5}},
+		logger:     zerolog.Nop(),
+		blockchain: client,
+	}
+
+	router := gin.New()
+	router.POST("/api/v1/trust/tensor", h.CreateTrustTensor)
+	router.GET("/api/v1/trust/tensor/:id", h.GetTrustTensor)
+
+	create := httptest.NewRecorder()
+	router.ServeHTTP(create, httptest.NewRequest(http.MethodPost, "/api/v1/trust/tensor", strings.NewReader(
+		`{"creator": "cosmos1racecar", "component_a": "MODBATT-PACK-001", "component_b": "MODBATT-MC-001", "context": "energy_delivery", "initial_score": 0.75}`)))
+	require.Equal(t, http.StatusOK, create.Code, create.Body.String())
+
+	var created map[string]interface{}
+	require.NoError(t, json.Unmarshal(create.Body.Bytes(), &created))
+	tensorID, _ := created["tensor_id"].(string)
+	require.Equal(t, "tensor-lct-MODBATT-PACK-001-MODBATT-MC-001", tensorID)
+
+	get := httptest.NewRecorder()
+	router.ServeHTTP(get, httptest.NewRequest(http.MethodGet, "/api/v1/trust/tensor/"+tensorID, nil))
+	require.Equal(t, http.StatusOK, get.Code, get.Body.String())
+
+	var tensor struct {
+		TensorID    string             `json:"tensor_id"`
+		LctID       string             `json:"lct_id"`
+		Score       float64            `json:"score"`
+		Version     int64              `json:"version"`
+		LastUpdated int64              `json:"last_updated"`
+		Dimensions  map[string]float64 `json:"dimensions"`
+	}
+	require.NoError(t, json.Unmarshal(get.Body.Bytes(), &tensor))
+	assert.Equal(t, tensorID, tensor.TensorID)
+	assert.Equal(t, "lct-MODBATT-PACK-001-MODBATT-MC-001", tensor.LctID)
+	assert.Equal(t, 0.75, tensor.Score)
+	assert.Equal(t, int64(1), tensor.Version)
+	assert.Equal(t, int64(1752484904), tensor.LastUpdated)
+	assert.Equal(t, map[string]float64{"talent": 0.75, "training": 0.75, "temperament": 0.75}, tensor.Dimensions)
+
+	missing := httptest.NewRecorder()
+	router.ServeHTTP(missing, httptest.NewRequest(http.MethodGet, "/api/v1/trust/tensor/tensor-unknown", nil))
+	assert.Equal(t, http.StatusNotFound, missing.Code)
+	assert.NotContains(t, missing.Body.String(), "score")
+}
+
+func TestCreateTrustTensorValidatesInitialScore(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	node := fakeTrustTensorNode(t)
+
+	client, err := blockchain.NewClient(node.URL, zerolog.Nop())
+	require.NoError(t, err)
+	h := &Handler{
+		config:     &config.Config{Blockchain: config.BlockchainConfig{Timeout: 5}},
+		logger:     zerolog.Nop(),
+		blockchain: client,
+	}
+
+	router := gin.New()
+	router.POST("/api/v1/trust/tensor", h.CreateTrustTensor)
+	router.GET("/api/v1/trust/tensor/:id", h.GetTrustTensor)
+
+	create := func(componentB, score string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/api/v1/trust/tensor", strings.NewReader(
+			`{"creator": "cosmos1racecar", "component_a": "MODBATT-PACK-001", "component_b": "`+componentB+`", "initial_score": `+score+`}`)))
+		return w
+	}
+
+	for _, score := range []string{"-0.01", "1.0000001", "42"} {
+		w := create("MODBATT-MC-001", score)
+		require.Equal(t, http.StatusBadRequest, w.Code, score)
+
+		var body map[string]interface{}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+		assert.Equal(t, "INVALID_INPUT", body["code"], score)
+		assert.Equal(t, "initial_score", body["field"], score)
+	}
+
+	// Both ends of the range are accepted
+	for i, score := range []string{"0", "1"} {
+		w := create(fmt.Sprintf("MODBATT-MC-00%d", i+2), score)
+		assert.Equal(t, http.StatusOK, w.Code, score)
+	}
+
+	// Scores keep their precision instead of being cut to two places
+	require.Equal(t, http.StatusOK, create("MODBATT-MC-001", "0.8765").Code)
+	get := httptest.NewRecorder()
+	router.ServeHTTP(get, httptest.NewRequest(http.MethodGet, "/api/v1/trust/tensor/tensor-lct-MODBATT-PACK-001-MODBATT-MC-001", nil))
+	require.Equal(t, http.StatusOK, get.Code, get.Body.String())
+
+	var tensor struct {
+		Score float64 `json:"score"`
+	}
+	require.NoError(t, json.Unmarshal(get.Body.Bytes(), &tensor))
+	assert.Equal(t, 0.8765, tensor.Score)
+}
+
+func TestCreateTrustTensorWithDimensions(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	node := fakeTrustTensorNode(t)
+
+	client, err := blockchain.NewClient(node.URL, zerolog.Nop())
+	require.NoError(t, err)
+	h := &Handler{
+		config:     &config.Config{Blockchain: config.BlockchainConfig{Timeout: 5}},
+		logger:     zerolog.Nop(),
+		blockchain: client,
+	}
+
+	router := gin.New()
+	router.POST("/api/v1/trust/tensor", h.CreateTrustTensor)
+	router.GET("/api/v1/trust/tensor/:id", h.GetTrustTensor)
+
+	create := func(body string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/api/v1/trust/tensor", strings.NewReader(body)))
+		return w
+	}
+
+	w := create(`{"creator": "cosmos1racecar", "component_a": "MODBATT-PACK-001", "component_b": "MODBATT-MC-001",
+		"dimensions": {"reliability": 0.9, "latency": 0.5, "safety": 0.625}}`)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	var created map[string]interface{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &created))
+	assert.NotContains(t, created, "score", "the composite is weighted on chain")
+
+	get := httptest.NewRecorder()
+	router.ServeHTTP(get, httptest.NewRequest(http.MethodGet, "/api/v1/trust/tensor/tensor-lct-MODBATT-PACK-001-MODBATT-MC-001", nil))
+	require.Equal(t, http.StatusOK, get.Code, get.Body.String())
+	var tensor struct {
+		Dimensions map[string]float64 `json:"dimensions"`
+	}
+	require.NoError(t, json.Unmarshal(get.Body.Bytes(), &tensor))
+	assert.Equal(t, map[string]float64{"latency": 0.5, "reliability": 0.9, "safety": 0.625}, tensor.Dimensions)
+
+	for body, field := range map[string]string{
+		`{"creator": "c", "component_a": "a", "component_b": "b", "initial_score": 0.5, "dimensions": {"safety": 0.5}}`: "dimensions",
+		`{"creator": "c", "component_a": "a", "component_b": "b", "dimensions": {"safety": 1.5}}`:                       "dimensions.safety",
+		`{"creator": "c", "component_a": "a", "component_b": "b", "dimensions": {"": 0.5}}`:                             "dimensions",
+	} {
+		w := create(body)
+		require.Equal(t, http.StatusBadRequest, w.Code, body)
+		var resp map[string]interface{}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+		assert.Equal(t, field, resp["field"], body)
+	}
+}
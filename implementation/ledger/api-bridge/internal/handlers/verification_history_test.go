@@ -0,0 +1,33 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"api-bridge/internal/blockchain"
+)
+
+func TestGetVerificationHistory(t *testing.T) {
+	h, client := newMockedHandler(t)
+	router := gin.New()
+	router.GET("/components/:id/verifications", h.GetVerificationHistory)
+
+	client.EXPECT().GetVerificationHistory(gomock.Any(), "MODBATT-MOD-001", uint64(10), "AQ==").
+		Return(map[string]interface{}{"component_id": "MODBATT-MOD-001", "verifications": []blockchain.VerificationRecord{}, "count": 0, "next_key": ""}, nil)
+	w := serve(router, http.MethodGet, "/components/MODBATT-MOD-001/verifications?limit=10&key=AQ==", "")
+	assert.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+	client.EXPECT().GetVerificationHistory(gomock.Any(), "MODBATT-MOD-404", uint64(0), "").
+		Return(nil, fmt.Errorf("%w: MODBATT-MOD-404", blockchain.ErrComponentNotFound))
+	w = serve(router, http.MethodGet, "/components/MODBATT-MOD-404/verifications", "")
+	assert.Equal(t, http.StatusNotFound, w.Code, w.Body.String())
+
+	// A bad key is rejected without querying the chain
+	w = serve(router, http.MethodGet, "/components/MODBATT-MOD-001/verifications?key=not-base64!", "")
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
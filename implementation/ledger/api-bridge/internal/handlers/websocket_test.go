@@ -0,0 +1,160 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"api-bridge/internal/config"
+	"api-bridge/internal/events"
+)
+
+func streamingHandler(t *testing.T) (*Handler, *websocket.Conn) {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+
+	h := &Handler{
+		config:     &config.Config{Events: config.EventsConfig{StreamBuffer: 8}},
+		logger:     zerolog.Nop(),
+		eventQueue: events.NewEventQueue(nil, 1, time.Millisecond, time.Hour, zerolog.Nop()),
+	}
+	router := gin.New()
+	router.GET("/ws", h.WebSocketHandler)
+	server := httptest.NewServer(router)
+	t.Cleanup(server.Close)
+
+	conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(server.URL, "http")+"/ws", nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	require.Eventually(t, func() bool { return h.eventQueue.Subscribers() == 1 }, time.Second, 5*time.Millisecond)
+	return h, conn
+}
+
+func readFrame(t *testing.T, conn *websocket.Conn) map[string]interface{} {
+	t.Helper()
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(2*time.Second)))
+	var frame map[string]interface{}
+	require.NoError(t, conn.ReadJSON(&frame))
+	return frame
+}
+
+func TestWebSocketStreamsSubscribedEvents(t *testing.T) {
+	h, conn := streamingHandler(t)
+
+	require.NoError(t, conn.WriteJSON(map[string]interface{}{"subscribe": []string{"pairing_completed", "lct_created"}}))
+	ack := readFrame(t, conn)
+	assert.Equal(t, "subscribed", ack["type"])
+	assert.Equal(t, []interface{}{"pairing_completed", "lct_created"}, ack["event_types"])
+
+	h.eventQueue.Emit("component_registered", map[string]interface{}{"component_id": "MODBATT-MOD-001"})
+	h.eventQueue.Emit("pairing_completed", map[string]interface{}{"lct_id": "lct-PACK-MC-1"})
+
+	frame := readFrame(t, conn)
+	assert.Equal(t, "pairing_completed", frame["event_type"])
+	assert.Equal(t, map[string]interface{}{"lct_id": "lct-PACK-MC-1"}, frame["data"])
+}
+
+func TestWebSocketForwardsAllEventsByDefault(t *testing.T) {
+	h, conn := streamingHandler(t)
+
+	h.eventQueue.Emit("component_registered", map[string]interface{}{"component_id": "MODBATT-MOD-001"})
+	assert.Equal(t, "component_registered", readFrame(t, conn)["event_type"])
+}
+
+func TestWebSocketRejectsMalformedSubscription(t *testing.T) {
+	_, conn := streamingHandler(t)
+
+	require.NoError(t, conn.WriteMessage(websocket.TextMessage, []byte("hello")))
+	frame := readFrame(t, conn)
+	assert.Equal(t, "error", frame["type"])
+}
+
+func TestWebSocketDisconnectRemovesSubscription(t *testing.T) {
+	h, conn := streamingHandler(t)
+
+	require.NoError(t, conn.Close())
+	require.Eventually(t, func() bool { return h.eventQueue.Subscribers() == 0 }, 2*time.Second, 5*time.Millisecond)
+}
+
+func TestWebSocketTruncatedAttributeIsFetchableByRef(t *testing.T) {
+	h, conn := streamingHandler(t)
+	h.eventQueue.SetPayloadLimit(32, 8)
+
+	metadata := strings.Repeat("x", 1<<20)
+	h.eventQueue.Emit("component_registered", map[string]interface{}{"component_id": "MODBATT-MOD-001", "metadata": metadata})
+
+	frame := readFrame(t, conn)
+	data := frame["data"].(map[string]interface{})
+	assert.Equal(t, strings.Repeat("x", 32)+events.TruncationMarker, data["metadata"])
+	truncated := frame["truncated"].([]interface{})
+	require.Len(t, truncated, 1)
+	ref := truncated[0].(map[string]interface{})["ref"].(string)
+
+	router := gin.New()
+	router.GET("/api/v1/events/payloads/:ref", h.GetEventPayload)
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/v1/events/payloads/"+ref, nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	var body map[string]interface{}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+	assert.Equal(t, metadata, body["value"])
+	assert.EqualValues(t, len(metadata), body["size"])
+
+	rec = httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/v1/events/payloads/unknown", nil))
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestWebSocketRejectsDisallowedOrigins(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	cfg := &config.Config{Server: config.ServerConfig{WebSocket: config.WebSocketConfig{
+		AllowedOrigins: []string{"https://Dashboard.example.com/"},
+	}}}
+	h, err := New(cfg, nil, zerolog.Nop())
+	require.NoError(t, err)
+	t.Cleanup(h.Shutdown)
+
+	router := gin.New()
+	router.GET("/ws", h.CheckWebSocketOrigin, h.WebSocketHandler)
+	server := httptest.NewServer(router)
+	t.Cleanup(server.Close)
+	url := "ws" + strings.TrimPrefix(server.URL, "http") + "/ws"
+
+	dial := func(origin string) (*http.Response, error) {
+		header := http.Header{}
+		if origin != "" {
+			header.Set("Origin", origin)
+		}
+		conn, resp, err := websocket.DefaultDialer.Dial(url, header)
+		if err == nil {
+			conn.Close()
+		}
+		return resp, err
+	}
+
+	// Refused before the handshake
+	resp, err := dial("https://evil.example.com")
+	require.ErrorIs(t, err, websocket.ErrBadHandshake)
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+
+	for _, origin := range []string{"https://dashboard.example.com", server.URL, ""} {
+		resp, err := dial(origin)
+		require.NoError(t, err, origin)
+		assert.Equal(t, http.StatusSwitchingProtocols, resp.StatusCode)
+	}
+
+	cfg.Server.WebSocket.AllowedOrigins = []string{"dashboard.example.com"}
+	_, err = New(cfg, nil, zerolog.Nop())
+	assert.ErrorContains(t, err, "invalid WebSocket origin")
+}
@@ -0,0 +1,135 @@
+package server
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"time"
+
+	"api-bridge/internal/blockchain"
+	"api-bridge/internal/handlers"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// RequestIDHeader carries the request ID; an inbound one is kept so a request
+// can be followed across services
+const RequestIDHeader = "X-Request-ID"
+
+// maxRequestIDLength bounds an inbound request ID kept in the logs
+const maxRequestIDLength = 128
+
+// maxCreatorScanBytes bounds how much of a write body is read ahead of the
+// handler to log its creator; larger bodies are logged without one
+const maxCreatorScanBytes = 4 << 10
+
+// accessLogMiddleware gives every request an ID, returned in X-Request-ID and
+// carried by the blockchain client's logs, and writes one log line per
+// request once it has been served
+func accessLogMiddleware(logger zerolog.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+		requestID := c.GetHeader(RequestIDHeader)
+		if !validRequestID(requestID) {
+			requestID = newRequestID()
+		}
+		c.Header(RequestIDHeader, requestID)
+		c.Request = c.Request.WithContext(blockchain.WithRequestID(c.Request.Context(), requestID))
+
+		var creator string
+		if isWriteMethod(c.Request.Method) {
+			creator = requestCreator(c)
+		}
+
+		c.Next()
+
+		ctx := c.Request.Context()
+		event := logger.Info().Str("request_id", requestID)
+		if sc := trace.SpanContextFromContext(ctx); sc.HasTraceID() {
+			event = event.Str("trace_id", sc.TraceID().String())
+		}
+		if route := c.FullPath(); route != "" {
+			event = event.Str("route", route)
+		}
+		if creator != "" {
+			event = event.Str("creator", creator)
+		}
+		if c.GetBool("authenticated") {
+			event = event.Int("user_id", c.GetInt("user_id"))
+		}
+		if txHashes := blockchain.TxHashes(ctx); len(txHashes) > 0 {
+			event = event.Strs("tx_hashes", txHashes)
+		}
+		if len(c.Errors) > 0 {
+			event = event.Str("errors", c.Errors.String())
+		}
+		event.
+			Str("method", c.Request.Method).
+			Str("path", c.Request.URL.Path).
+			Int("status", c.Writer.Status()).
+			Dur("latency", time.Since(start)).
+			Int("bytes", max(c.Writer.Size(), 0)).
+			Str("client_ip", c.ClientIP()).
+			Str("user_agent", c.Request.UserAgent()).
+			Msg("HTTP request")
+	}
+}
+
+// requestCreator returns the creator named in a JSON request body. It reads
+// at most maxCreatorScanBytes ahead of the handler and hands what it read back
+// in front of the rest of the body. Streamed NDJSON bodies are not read.
+func requestCreator(c *gin.Context) string {
+	body := c.Request.Body
+	if body == nil || body == http.NoBody || c.ContentType() == handlers.NDJSONContentType {
+		return ""
+	}
+
+	prefix, err := io.ReadAll(io.LimitReader(body, maxCreatorScanBytes))
+	c.Request.Body = prefixedBody{Reader: io.MultiReader(bytes.NewReader(prefix), body), Closer: body}
+	if err != nil || len(prefix) == maxCreatorScanBytes {
+		return ""
+	}
+
+	var payload struct {
+		Creator string `json:"creator"`
+	}
+	if json.Unmarshal(prefix, &payload) != nil {
+		return ""
+	}
+	return payload.Creator
+}
+
+// prefixedBody is a request body with the part read ahead put back
+type prefixedBody struct {
+	io.Reader
+	io.Closer
+}
+
+// validRequestID reports whether an inbound request ID is short and made of
+// characters that are safe to log and echo back
+func validRequestID(id string) bool {
+	if id == "" || len(id) > maxRequestIDLength {
+		return false
+	}
+	for _, r := range id {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+		case r == '-', r == '_', r == '.', r == ':':
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// newRequestID returns a random 128-bit ID in hex
+func newRequestID() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
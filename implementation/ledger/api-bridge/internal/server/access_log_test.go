@@ -0,0 +1,97 @@
+package server
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"api-bridge/internal/blockchain"
+)
+
+func accessLogRouter(logs *bytes.Buffer) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(accessLogMiddleware(zerolog.New(logs)))
+	router.POST("/api/v1/components/register", func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"request_id": blockchain.RequestID(c.Request.Context())})
+	})
+	return router
+}
+
+func TestAccessLogWritesOneLinePerRequest(t *testing.T) {
+	var logs bytes.Buffer
+	router := accessLogRouter(&logs)
+
+	body := `{"creator":"alice","component_data":"{}"}`
+	rec := post(router, "/api/v1/components/register", body, "10.0.0.1")
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	requestID := rec.Header().Get(RequestIDHeader)
+	assert.Len(t, requestID, 32)
+	assert.JSONEq(t, `{"request_id":"`+requestID+`"}`, rec.Body.String(), "handlers see the ID through the request context")
+
+	var entry map[string]interface{}
+	require.NoError(t, json.Unmarshal(logs.Bytes(), &entry))
+	assert.Equal(t, requestID, entry["request_id"])
+	assert.Equal(t, "alice", entry["creator"])
+	assert.Equal(t, "POST", entry["method"])
+	assert.Equal(t, "/api/v1/components/register", entry["route"])
+	assert.Equal(t, float64(http.StatusOK), entry["status"])
+	assert.Contains(t, entry, "latency")
+}
+
+func TestAccessLogHonoursInboundRequestID(t *testing.T) {
+	var logs bytes.Buffer
+	router := accessLogRouter(&logs)
+
+	send := func(requestID string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/components/register", strings.NewReader(`{}`))
+		req.Header.Set(RequestIDHeader, requestID)
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, req)
+		return rec
+	}
+
+	rec := send("dashboard-7f3a:1")
+	assert.Equal(t, "dashboard-7f3a:1", rec.Header().Get(RequestIDHeader))
+	assert.Contains(t, logs.String(), `"request_id":"dashboard-7f3a:1"`)
+
+	// IDs that could forge log lines or are too long are replaced
+	for _, unsafe := range []string{"id\nlevel=error", "a b", strings.Repeat("x", maxRequestIDLength+1)} {
+		rec = send(unsafe)
+		assert.NotEqual(t, unsafe, rec.Header().Get(RequestIDHeader))
+		assert.True(t, validRequestID(rec.Header().Get(RequestIDHeader)))
+	}
+}
+
+func TestAccessLogReadsOnlyABoundedPrefix(t *testing.T) {
+	var logs bytes.Buffer
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(accessLogMiddleware(zerolog.New(&logs)))
+	router.POST("/api/v1/components/register", func(c *gin.Context) {
+		body, err := io.ReadAll(c.Request.Body)
+		require.NoError(t, err)
+		c.String(http.StatusOK, string(body))
+	})
+
+	// A body past the scan bound is logged without its creator, and the
+	// handler still reads all of it
+	body := `{"creator":"alice","component_data":"` + strings.Repeat("a", maxCreatorScanBytes) + `"}`
+	rec := post(router, "/api/v1/components/register", body, "10.0.0.1")
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, body, rec.Body.String())
+
+	var entry map[string]interface{}
+	require.NoError(t, json.Unmarshal(logs.Bytes(), &entry))
+	assert.NotContains(t, entry, "creator")
+}
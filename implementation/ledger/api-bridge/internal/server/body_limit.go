@@ -0,0 +1,28 @@
+package server
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"api-bridge/internal/handlers"
+)
+
+// bodyLimitMiddleware caps request bodies at limit bytes, so no middleware or
+// handler buffers more than that. Announced oversize bodies are refused up
+// front; others fail when the read passes the limit. Streamed NDJSON
+// registrations are bounded per stream by their handler instead.
+func bodyLimitMiddleware(limit int64) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if limit <= 0 || c.Request.Body == nil || c.ContentType() == handlers.NDJSONContentType {
+			c.Next()
+			return
+		}
+		if c.Request.ContentLength > limit {
+			c.AbortWithStatusJSON(http.StatusRequestEntityTooLarge, gin.H{"error": "Request body too large"})
+			return
+		}
+		c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, limit)
+		c.Next()
+	}
+}
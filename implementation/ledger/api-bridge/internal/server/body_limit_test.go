@@ -0,0 +1,50 @@
+package server
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+
+	"api-bridge/internal/handlers"
+)
+
+func TestBodyLimitMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(bodyLimitMiddleware(16))
+	router.POST("/api/v1/lct/create", func(c *gin.Context) {
+		body, err := io.ReadAll(c.Request.Body)
+		if err != nil {
+			c.Status(http.StatusBadRequest)
+			return
+		}
+		c.String(http.StatusOK, string(body))
+	})
+
+	send := func(body string, announce bool, contentType string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/lct/create", strings.NewReader(body))
+		req.Header.Set("Content-Type", contentType)
+		if !announce {
+			req.ContentLength = -1
+		}
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, req)
+		return rec
+	}
+
+	rec := send(`{"creator":"a"}`, true, "application/json")
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, `{"creator":"a"}`, rec.Body.String())
+
+	large := `{"creator":"` + strings.Repeat("a", 64) + `"}`
+	assert.Equal(t, http.StatusRequestEntityTooLarge, send(large, true, "application/json").Code)
+	assert.Equal(t, http.StatusBadRequest, send(large, false, "application/json").Code, "an unannounced body fails once read past the limit")
+
+	// Streams are bounded by their handler
+	assert.Equal(t, http.StatusOK, send(large, true, handlers.NDJSONContentType).Code)
+}
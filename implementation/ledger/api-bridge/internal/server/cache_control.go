@@ -0,0 +1,31 @@
+package server
+
+import (
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"api-bridge/internal/blockchain"
+)
+
+// cacheControlMiddleware sends requests carrying Cache-Control: no-cache past
+// the blockchain client's query cache, straight to the node
+func cacheControlMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if noCache(c.GetHeader("Cache-Control")) {
+			c.Request = c.Request.WithContext(blockchain.WithoutCache(c.Request.Context()))
+		}
+		c.Next()
+	}
+}
+
+// noCache reports whether a Cache-Control header asks for a fresh response
+func noCache(header string) bool {
+	for _, directive := range strings.Split(header, ",") {
+		switch strings.ToLower(strings.TrimSpace(directive)) {
+		case "no-cache", "no-store":
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,14 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNoCacheDirectives(t *testing.T) {
+	assert.True(t, noCache("no-cache"))
+	assert.True(t, noCache("max-age=0, No-Store"))
+	assert.False(t, noCache(""))
+	assert.False(t, noCache("max-age=60"))
+}
@@ -0,0 +1,95 @@
+package server
+
+import (
+	"compress/gzip"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"api-bridge/internal/config"
+)
+
+func compressionRouter(body gin.H) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(compressionMiddleware(config.CompressionConfig{Enabled: true, MinSize: 1024}))
+	router.GET("/data", func(c *gin.Context) {
+		c.JSON(http.StatusOK, body)
+	})
+	router.GET("/ws", func(c *gin.Context) {
+		_, wrapped := c.Writer.(*gzipResponseWriter)
+		c.JSON(http.StatusOK, gin.H{"wrapped": wrapped})
+	})
+	return router
+}
+
+func largeBody() gin.H {
+	components := make([]string, 200)
+	for i := range components {
+		components[i] = fmt.Sprintf("MODBATT-MOD-%03d", i)
+	}
+	return gin.H{"components": components}
+}
+
+func TestLargeJSONResponseIsGzipped(t *testing.T) {
+	router := compressionRouter(largeBody())
+
+	req := httptest.NewRequest(http.MethodGet, "/data", nil)
+	req.Header.Set("Accept-Encoding", "gzip, deflate")
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "gzip", rec.Header().Get("Content-Encoding"))
+	assert.Equal(t, "Accept-Encoding", rec.Header().Get("Vary"))
+
+	gz, err := gzip.NewReader(rec.Body)
+	require.NoError(t, err)
+	decoded, err := io.ReadAll(gz)
+	require.NoError(t, err)
+
+	plain := httptest.NewRecorder()
+	router.ServeHTTP(plain, httptest.NewRequest(http.MethodGet, "/data", nil))
+	assert.Empty(t, plain.Header().Get("Content-Encoding"))
+	assert.JSONEq(t, plain.Body.String(), string(decoded))
+}
+
+func TestSmallResponseIsNotGzipped(t *testing.T) {
+	router := compressionRouter(gin.H{"status": "healthy"})
+
+	req := httptest.NewRequest(http.MethodGet, "/data", nil)
+	req.Header.Set("Accept-Encoding", "gzip")
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+
+	assert.Empty(t, rec.Header().Get("Content-Encoding"))
+	assert.JSONEq(t, `{"status": "healthy"}`, rec.Body.String())
+}
+
+func TestWebSocketUpgradeIsNotWrapped(t *testing.T) {
+	router := compressionRouter(nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/ws", nil)
+	req.Header.Set("Accept-Encoding", "gzip")
+	req.Header.Set("Connection", "Upgrade")
+	req.Header.Set("Upgrade", "websocket")
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+
+	assert.JSONEq(t, `{"wrapped": false}`, rec.Body.String())
+}
+
+func TestAcceptsGzip(t *testing.T) {
+	assert.True(t, acceptsGzip("gzip"))
+	assert.True(t, acceptsGzip("deflate, gzip;q=0.5"))
+	assert.True(t, acceptsGzip("*"))
+	assert.False(t, acceptsGzip(""))
+	assert.False(t, acceptsGzip("deflate, br"))
+	assert.False(t, acceptsGzip("gzip;q=0, *"))
+}
@@ -0,0 +1,189 @@
+package server
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+
+	"api-bridge/internal/config"
+	"api-bridge/internal/handlers"
+
+	"github.com/gin-gonic/gin"
+)
+
+// corsPolicy is the parsed server.cors configuration
+type corsPolicy struct {
+	anyOrigin        bool
+	origins          map[string]bool
+	subdomains       []corsSubdomains
+	methods          []string
+	headers          []string
+	allowedHeaders   map[string]bool
+	exposedHeaders   string
+	allowCredentials bool
+	maxAge           string
+}
+
+// corsMiddleware answers CORS preflight requests and adds the CORS response
+// headers for origins the configuration allows. Requests from other origins
+// get no CORS headers, so browsers refuse to hand the response to the page;
+// their preflights are refused outright. With no allowed origins configured
+// only same-origin browser requests work.
+func corsMiddleware(cfg config.CORSConfig) (gin.HandlerFunc, error) {
+	policy, err := newCORSPolicy(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(c *gin.Context) {
+		origin := c.GetHeader("Origin")
+		if origin == "" {
+			c.Next()
+			return
+		}
+		c.Writer.Header().Add("Vary", "Origin")
+
+		preflight := c.Request.Method == http.MethodOptions && c.GetHeader("Access-Control-Request-Method") != ""
+		if !policy.allowsOrigin(origin) {
+			if preflight {
+				c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": "origin not allowed"})
+				return
+			}
+			c.Next()
+			return
+		}
+
+		header := c.Writer.Header()
+		if policy.anyOrigin && !policy.allowCredentials {
+			header.Set("Access-Control-Allow-Origin", "*")
+		} else {
+			header.Set("Access-Control-Allow-Origin", origin)
+		}
+		if policy.allowCredentials {
+			header.Set("Access-Control-Allow-Credentials", "true")
+		}
+
+		if !preflight {
+			if policy.exposedHeaders != "" {
+				header.Set("Access-Control-Expose-Headers", policy.exposedHeaders)
+			}
+			c.Next()
+			return
+		}
+
+		if !policy.allowsPreflight(c.GetHeader("Access-Control-Request-Method"), c.GetHeader("Access-Control-Request-Headers")) {
+			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": "method or headers not allowed"})
+			return
+		}
+		header.Add("Vary", "Access-Control-Request-Method")
+		header.Add("Vary", "Access-Control-Request-Headers")
+		header.Set("Access-Control-Allow-Methods", strings.Join(policy.methods, ", "))
+		header.Set("Access-Control-Allow-Headers", strings.Join(policy.headers, ", "))
+		if policy.maxAge != "" {
+			header.Set("Access-Control-Max-Age", policy.maxAge)
+		}
+		c.AbortWithStatus(http.StatusNoContent)
+	}, nil
+}
+
+// corsSubdomains matches the origins of "scheme://*.domain", any number of
+// labels deep, on the pattern's port
+type corsSubdomains struct {
+	scheme string
+	suffix string // ".domain", with the port if the pattern has one
+}
+
+// newCORSPolicy validates and normalizes cfg
+func newCORSPolicy(cfg config.CORSConfig) (*corsPolicy, error) {
+	policy := &corsPolicy{
+		origins:          make(map[string]bool),
+		allowedHeaders:   make(map[string]bool),
+		exposedHeaders:   strings.Join(cfg.ExposedHeaders, ", "),
+		allowCredentials: cfg.AllowCredentials,
+	}
+	if cfg.MaxAge > 0 {
+		policy.maxAge = strconv.Itoa(cfg.MaxAge)
+	}
+
+	for _, origin := range cfg.AllowedOrigins {
+		if origin == "*" {
+			policy.anyOrigin = true
+			continue
+		}
+		u, ok := handlers.ParseOrigin(origin)
+		if !ok {
+			return nil, fmt.Errorf("invalid CORS origin %q: want scheme://host[:port], scheme://*.domain or *", origin)
+		}
+		if domain, ok := strings.CutPrefix(u.Host, "*."); ok {
+			if domain == "" || strings.Contains(domain, "*") {
+				return nil, fmt.Errorf("invalid CORS origin %q: a wildcard must be a whole leading label", origin)
+			}
+			policy.subdomains = append(policy.subdomains, corsSubdomains{scheme: u.Scheme, suffix: "." + strings.ToLower(domain)})
+			continue
+		}
+		if strings.Contains(u.Host, "*") {
+			return nil, fmt.Errorf("invalid CORS origin %q: a wildcard must be a whole leading label", origin)
+		}
+		policy.origins[handlers.NormalizeOrigin(origin)] = true
+	}
+	if policy.anyOrigin && policy.allowCredentials {
+		// Browsers reject credentialed responses for "*", and reflecting every
+		// origin instead would let any site act with the user's credentials
+		return nil, fmt.Errorf("CORS allow_credentials cannot be combined with the \"*\" origin")
+	}
+
+	for _, method := range cfg.AllowedMethods {
+		policy.methods = append(policy.methods, strings.ToUpper(method))
+	}
+	for _, name := range cfg.AllowedHeaders {
+		policy.headers = append(policy.headers, http.CanonicalHeaderKey(name))
+		policy.allowedHeaders[strings.ToLower(name)] = true
+	}
+	return policy, nil
+}
+
+// allowsOrigin reports whether browser pages from origin may call the API
+func (p *corsPolicy) allowsOrigin(origin string) bool {
+	if p.anyOrigin {
+		return true
+	}
+	origin = handlers.NormalizeOrigin(origin)
+	if p.origins[origin] {
+		return true
+	}
+	u, err := url.Parse(origin)
+	if err != nil {
+		return false
+	}
+	for _, pattern := range p.subdomains {
+		if u.Scheme == pattern.scheme && strings.HasSuffix(u.Host, pattern.suffix) && len(u.Host) > len(pattern.suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// allowsPreflight reports whether a preflight's requested method and headers
+// are all allowed. Headers browsers always allow need not be configured.
+func (p *corsPolicy) allowsPreflight(method, headers string) bool {
+	if !slices.Contains(p.methods, strings.ToUpper(method)) {
+		return false
+	}
+	for _, name := range strings.Split(headers, ",") {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name != "" && !p.allowedHeaders[name] && !corsSafelistedHeaders[name] {
+			return false
+		}
+	}
+	return true
+}
+
+// corsSafelistedHeaders never need to be listed in Access-Control-Allow-Headers
+var corsSafelistedHeaders = map[string]bool{
+	"accept":           true,
+	"accept-language":  true,
+	"content-language": true,
+}
@@ -0,0 +1,124 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"api-bridge/internal/config"
+)
+
+func corsRouter(t *testing.T, cfg config.CORSConfig) *gin.Engine {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+	cors, err := corsMiddleware(cfg)
+	require.NoError(t, err)
+	router := gin.New()
+	router.Use(cors)
+	router.GET("/api/v1/components", func(c *gin.Context) {
+		c.Header("X-Page-Size", "20")
+		c.JSON(http.StatusOK, gin.H{"components": []string{}})
+	})
+	return router
+}
+
+func corsRequest(router *gin.Engine, method, origin string, headers map[string]string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, "/api/v1/components", nil)
+	if origin != "" {
+		req.Header.Set("Origin", origin)
+	}
+	for name, value := range headers {
+		req.Header.Set(name, value)
+	}
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+	return rec
+}
+
+func defaultCORS() config.CORSConfig {
+	return config.CORSConfig{
+		AllowedOrigins: []string{"https://dashboard.example.com", "https://*.pit.example.com"},
+		AllowedMethods: []string{"GET", "POST"},
+		AllowedHeaders: []string{"Content-Type", "X-API-Key"},
+		ExposedHeaders: []string{"X-Page-Size"},
+		MaxAge:         600,
+	}
+}
+
+func TestCORSAllowedOrigins(t *testing.T) {
+	router := corsRouter(t, defaultCORS())
+
+	for _, origin := range []string{"https://dashboard.example.com", "https://DASHBOARD.example.com", "https://car-7.pit.example.com", "https://a.b.pit.example.com"} {
+		rec := corsRequest(router, http.MethodGet, origin, nil)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, origin, rec.Header().Get("Access-Control-Allow-Origin"), origin)
+		assert.Equal(t, "X-Page-Size", rec.Header().Get("Access-Control-Expose-Headers"))
+		assert.Contains(t, rec.Header().Values("Vary"), "Origin")
+	}
+
+	rec := corsRequest(router, http.MethodOptions, "https://dashboard.example.com", map[string]string{
+		"Access-Control-Request-Method":  "POST",
+		"Access-Control-Request-Headers": "content-type, x-api-key",
+	})
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+	assert.Equal(t, "https://dashboard.example.com", rec.Header().Get("Access-Control-Allow-Origin"))
+	assert.Equal(t, "GET, POST", rec.Header().Get("Access-Control-Allow-Methods"))
+	assert.Equal(t, "Content-Type, X-Api-Key", rec.Header().Get("Access-Control-Allow-Headers"))
+	assert.Equal(t, "600", rec.Header().Get("Access-Control-Max-Age"))
+	assert.Empty(t, rec.Header().Get("Access-Control-Allow-Credentials"))
+
+	// Requests without an Origin are not CORS requests
+	rec = corsRequest(router, http.MethodGet, "", nil)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Empty(t, rec.Header().Get("Access-Control-Allow-Origin"))
+}
+
+func TestCORSDisallowedOrigins(t *testing.T) {
+	router := corsRouter(t, defaultCORS())
+
+	for _, origin := range []string{"https://evil.example.com", "http://dashboard.example.com", "https://dashboard.example.com:8443", "https://pit.example.com", "https://evilpit.example.com", "null"} {
+		rec := corsRequest(router, http.MethodGet, origin, nil)
+		assert.Empty(t, rec.Header().Get("Access-Control-Allow-Origin"), origin)
+
+		rec = corsRequest(router, http.MethodOptions, origin, map[string]string{"Access-Control-Request-Method": "GET"})
+		assert.Equal(t, http.StatusForbidden, rec.Code, origin)
+		assert.Empty(t, rec.Header().Get("Access-Control-Allow-Origin"), origin)
+	}
+
+	// An allowed origin still cannot preflight methods or headers that are not configured
+	for _, headers := range []map[string]string{
+		{"Access-Control-Request-Method": "DELETE"},
+		{"Access-Control-Request-Method": "GET", "Access-Control-Request-Headers": "x-admin-override"},
+	} {
+		rec := corsRequest(router, http.MethodOptions, "https://dashboard.example.com", headers)
+		assert.Equal(t, http.StatusForbidden, rec.Code, headers)
+	}
+
+	// Nothing is allowed by default
+	router = corsRouter(t, config.CORSConfig{AllowedMethods: []string{"GET"}})
+	rec := corsRequest(router, http.MethodGet, "https://dashboard.example.com", nil)
+	assert.Empty(t, rec.Header().Get("Access-Control-Allow-Origin"))
+}
+
+func TestCORSWildcardOrigin(t *testing.T) {
+	cfg := defaultCORS()
+	cfg.AllowedOrigins = []string{"*"}
+	router := corsRouter(t, cfg)
+
+	rec := corsRequest(router, http.MethodGet, "https://anywhere.example.org", nil)
+	assert.Equal(t, "*", rec.Header().Get("Access-Control-Allow-Origin"))
+
+	// Credentials with any origin would let every site act as the user
+	cfg.AllowCredentials = true
+	_, err := corsMiddleware(cfg)
+	assert.ErrorContains(t, err, "allow_credentials")
+
+	for _, origin := range []string{"dashboard.example.com", "https://dash*.example.com", "https://dashboard.example.com/app"} {
+		_, err := corsMiddleware(config.CORSConfig{AllowedOrigins: []string{origin}})
+		assert.ErrorContains(t, err, "invalid CORS origin", origin)
+	}
+}
@@ -0,0 +1,167 @@
+package server
+
+import (
+	"math"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"api-bridge/internal/config"
+
+	"github.com/gin-gonic/gin"
+)
+
+// bucketSweepInterval is how often idle buckets are pruned
+const bucketSweepInterval = time.Minute
+
+// readOnlyPostRoutes are POST routes that only read, taking their arguments
+// in the body because they do not fit in a URL
+var readOnlyPostRoutes = map[string]bool{
+	"/api/v1/energy/balances":      true,
+	"/api/v1/pairing/status/batch": true,
+}
+
+// middleware throttles write requests with a token bucket per route and
+// client. Clients are the authenticated user when authentication ran before
+// the limiter, and otherwise their IP address; nothing in the request body is
+// trusted to tell them apart. Reads are never limited, and nothing is while
+// the limit is disabled.
+func (l *writeLimiter) middleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		route := c.FullPath()
+		if route == "" {
+			route = c.Request.URL.Path
+		}
+		if !isWriteMethod(c.Request.Method) || readOnlyPostRoutes[route] || !l.enabled() {
+			c.Next()
+			return
+		}
+
+		allowed, retryAfter := l.allow(route, rateLimitClient(c))
+		if !allowed {
+			c.Header("Retry-After", strconv.Itoa(int(math.Ceil(retryAfter.Seconds()))))
+			c.AbortWithStatusJSON(http.StatusTooManyRequests, gin.H{"error": "Rate limit exceeded"})
+			return
+		}
+		c.Next()
+	}
+}
+
+func isWriteMethod(method string) bool {
+	switch method {
+	case http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// rateLimitClient returns the user the auth middleware authenticated, or the
+// client IP when authentication is off
+func rateLimitClient(c *gin.Context) string {
+	if c.GetBool("authenticated") {
+		return "user:" + strconv.Itoa(c.GetInt("user_id"))
+	}
+	return "ip:" + c.ClientIP()
+}
+
+// tokenBucket holds up to burst tokens, refilled at rate per second
+type tokenBucket struct {
+	route  string
+	tokens float64
+	last   time.Time
+}
+
+// writeLimiter keeps a token bucket for every route and client it has seen
+type writeLimiter struct {
+	cfg config.WriteRateLimitConfig
+	now func() time.Time
+
+	mu        sync.Mutex
+	buckets   map[string]*tokenBucket
+	lastSweep time.Time
+}
+
+func newWriteLimiter(cfg config.WriteRateLimitConfig, now func() time.Time) *writeLimiter {
+	return &writeLimiter{cfg: cfg, now: now, buckets: make(map[string]*tokenBucket), lastSweep: now()}
+}
+
+// setConfig replaces the limits, e.g. when the configuration is reloaded.
+// Buckets keep their tokens, capped at the new burst as they refill.
+func (l *writeLimiter) setConfig(cfg config.WriteRateLimitConfig) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.cfg = cfg
+}
+
+func (l *writeLimiter) enabled() bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.cfg.Enabled
+}
+
+// limits returns the rate and burst for route, preferring its override.
+// Callers hold l.mu.
+func (l *writeLimiter) limits(route string) (float64, float64) {
+	rate, burst := l.cfg.Rate, l.cfg.Burst
+	if override, ok := l.cfg.Endpoints[route]; ok {
+		if override.Rate > 0 {
+			rate = override.Rate
+		}
+		if override.Burst > 0 {
+			burst = override.Burst
+		}
+	}
+	if burst < 1 {
+		burst = 1
+	}
+	return rate, float64(burst)
+}
+
+// allow takes a token from the bucket for route and client. When the bucket is
+// empty it reports how long until the next token is available.
+func (l *writeLimiter) allow(route, client string) (bool, time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	rate, burst := l.limits(route)
+	if rate <= 0 {
+		return true, 0
+	}
+
+	now := l.now()
+	l.sweep(now)
+
+	key := route + "|" + client
+	bucket, ok := l.buckets[key]
+	if !ok {
+		bucket = &tokenBucket{route: route, tokens: burst, last: now}
+		l.buckets[key] = bucket
+	}
+
+	bucket.tokens = math.Min(burst, bucket.tokens+now.Sub(bucket.last).Seconds()*rate)
+	bucket.last = now
+
+	if bucket.tokens < 1 {
+		wait := (1 - bucket.tokens) / rate
+		return false, time.Duration(wait * float64(time.Second))
+	}
+	bucket.tokens--
+	return true, 0
+}
+
+// sweep drops buckets that have refilled completely, since a fresh bucket
+// behaves the same. Callers hold l.mu.
+func (l *writeLimiter) sweep(now time.Time) {
+	if now.Sub(l.lastSweep) < bucketSweepInterval {
+		return
+	}
+	l.lastSweep = now
+
+	for key, bucket := range l.buckets {
+		rate, burst := l.limits(bucket.route)
+		if bucket.tokens+now.Sub(bucket.last).Seconds()*rate >= burst {
+			delete(l.buckets, key)
+		}
+	}
+}
@@ -0,0 +1,176 @@
+package server
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"api-bridge/internal/config"
+)
+
+func rateLimitRouter(cfg config.WriteRateLimitConfig) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(newWriteLimiter(cfg, time.Now).middleware())
+	echo := func(c *gin.Context) {
+		body, _ := io.ReadAll(c.Request.Body)
+		c.String(http.StatusOK, string(body))
+	}
+	router.POST("/api/v1/components/register", echo)
+	router.POST("/api/v1/lct/create", echo)
+	router.GET("/api/v1/components/:id", echo)
+	router.POST("/api/v1/energy/balances", echo)
+	return router
+}
+
+func post(router *gin.Engine, path, body, ip string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	req.RemoteAddr = ip + ":40000"
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestRateLimitRejectsClientPastBurst(t *testing.T) {
+	router := rateLimitRouter(config.WriteRateLimitConfig{Enabled: true, Rate: 0.5, Burst: 2})
+	body := `{"creator":"alice","component_id":"MODBATT-MOD-001"}`
+
+	for i := 0; i < 2; i++ {
+		rec := post(router, "/api/v1/components/register", body, "10.0.0.1")
+		require.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, body, rec.Body.String(), "handler must still see the body")
+	}
+
+	// Naming another creator does not buy a fresh bucket
+	rec := post(router, "/api/v1/components/register", `{"creator":"bob"}`, "10.0.0.1")
+	assert.Equal(t, http.StatusTooManyRequests, rec.Code)
+	assert.Equal(t, "2", rec.Header().Get("Retry-After"))
+
+	// Nor can another client drain alice's bucket by naming her
+	assert.Equal(t, http.StatusOK, post(router, "/api/v1/components/register", body, "10.0.0.2").Code)
+	assert.Equal(t, http.StatusOK, post(router, "/api/v1/lct/create", body, "10.0.0.1").Code)
+}
+
+func TestRateLimitKeysOnAuthenticatedUser(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	// Stands in for the auth middleware, which runs before the limiter
+	router.Use(func(c *gin.Context) {
+		if userID, err := strconv.Atoi(c.GetHeader("X-Test-User")); err == nil {
+			c.Set("authenticated", true)
+			c.Set("user_id", userID)
+		}
+	})
+	router.Use(newWriteLimiter(config.WriteRateLimitConfig{Enabled: true, Rate: 0.5, Burst: 1}, time.Now).middleware())
+	router.POST("/api/v1/lct/create", func(c *gin.Context) { c.Status(http.StatusOK) })
+
+	postAs := func(user, ip string) int {
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/lct/create", strings.NewReader(`{}`))
+		req.Header.Set("X-Test-User", user)
+		req.RemoteAddr = ip + ":40000"
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, req)
+		return rec.Code
+	}
+
+	assert.Equal(t, http.StatusOK, postAs("7", "10.0.0.1"))
+	// The same user from another address shares the bucket
+	assert.Equal(t, http.StatusTooManyRequests, postAs("7", "10.0.0.2"))
+	// Another user behind the same address does not
+	assert.Equal(t, http.StatusOK, postAs("8", "10.0.0.1"))
+}
+
+func TestRateLimitFallsBackToClientIP(t *testing.T) {
+	router := rateLimitRouter(config.WriteRateLimitConfig{Enabled: true, Rate: 1, Burst: 1})
+
+	assert.Equal(t, http.StatusOK, post(router, "/api/v1/lct/create", `{}`, "10.0.0.1").Code)
+	assert.Equal(t, http.StatusTooManyRequests, post(router, "/api/v1/lct/create", `not json`, "10.0.0.1").Code)
+	assert.Equal(t, http.StatusOK, post(router, "/api/v1/lct/create", `{}`, "10.0.0.2").Code)
+}
+
+func TestRateLimitSkipsReads(t *testing.T) {
+	router := rateLimitRouter(config.WriteRateLimitConfig{Enabled: true, Rate: 1, Burst: 1})
+
+	for i := 0; i < 5; i++ {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/components/MODBATT-MOD-001", nil)
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, req)
+		assert.Equal(t, http.StatusOK, rec.Code)
+	}
+
+	// Bulk reads are sent as POST but are not writes
+	for i := 0; i < 5; i++ {
+		assert.Equal(t, http.StatusOK, post(router, "/api/v1/energy/balances", `{"component_ids":["MODBATT-MOD-001"]}`, "10.0.0.1").Code)
+	}
+}
+
+func TestRateLimitEndpointOverride(t *testing.T) {
+	cfg := config.WriteRateLimitConfig{
+		Enabled: true,
+		Rate:    1,
+		Burst:   1,
+		Endpoints: map[string]config.EndpointRateLimit{
+			"/api/v1/components/register": {Burst: 3},
+		},
+	}
+	router := rateLimitRouter(cfg)
+	body := `{"creator":"alice"}`
+
+	for i := 0; i < 3; i++ {
+		assert.Equal(t, http.StatusOK, post(router, "/api/v1/components/register", body, "10.0.0.1").Code)
+	}
+	assert.Equal(t, http.StatusTooManyRequests, post(router, "/api/v1/components/register", body, "10.0.0.1").Code)
+
+	assert.Equal(t, http.StatusOK, post(router, "/api/v1/lct/create", body, "10.0.0.1").Code)
+	assert.Equal(t, http.StatusTooManyRequests, post(router, "/api/v1/lct/create", body, "10.0.0.1").Code)
+}
+
+func TestWriteLimiterRefillsAndSweeps(t *testing.T) {
+	now := time.Unix(1752492851, 0)
+	limiter := newWriteLimiter(config.WriteRateLimitConfig{Rate: 2, Burst: 1}, func() time.Time { return now })
+
+	allowed, _ := limiter.allow("/register", "ip:10.0.0.1")
+	require.True(t, allowed)
+	allowed, retryAfter := limiter.allow("/register", "ip:10.0.0.1")
+	require.False(t, allowed)
+	assert.Equal(t, 500*time.Millisecond, retryAfter)
+
+	now = now.Add(500 * time.Millisecond)
+	allowed, _ = limiter.allow("/register", "ip:10.0.0.1")
+	assert.True(t, allowed, "a token is refilled after 1/rate seconds")
+
+	now = now.Add(2 * bucketSweepInterval)
+	allowed, _ = limiter.allow("/register", "ip:10.0.0.2")
+	require.True(t, allowed)
+	assert.Len(t, limiter.buckets, 1, "alice's full bucket is swept")
+}
+
+func TestWriteLimiterReload(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	limiter := newWriteLimiter(config.WriteRateLimitConfig{Enabled: false, Rate: 1, Burst: 1}, time.Now)
+	router := gin.New()
+	router.Use(limiter.middleware())
+	router.POST("/api/v1/lct/create", func(c *gin.Context) { c.Status(http.StatusOK) })
+	body := `{"creator":"alice"}`
+
+	for i := 0; i < 3; i++ {
+		assert.Equal(t, http.StatusOK, post(router, "/api/v1/lct/create", body, "10.0.0.1").Code, "disabled limits nothing")
+	}
+
+	limiter.setConfig(config.WriteRateLimitConfig{Enabled: true, Rate: 0.1, Burst: 2})
+	assert.Equal(t, http.StatusOK, post(router, "/api/v1/lct/create", body, "10.0.0.1").Code)
+	assert.Equal(t, http.StatusOK, post(router, "/api/v1/lct/create", body, "10.0.0.1").Code)
+	assert.Equal(t, http.StatusTooManyRequests, post(router, "/api/v1/lct/create", body, "10.0.0.1").Code)
+
+	limiter.setConfig(config.WriteRateLimitConfig{Enabled: false})
+	assert.Equal(t, http.StatusOK, post(router, "/api/v1/lct/create", body, "10.0.0.1").Code)
+}
@@ -2,13 +2,16 @@ package server
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 	"time"
 
 	"api-bridge/internal/auth"
+	"api-bridge/internal/blockchain"
 	"api-bridge/internal/config"
 	grpcServer "api-bridge/internal/grpc"
 	"api-bridge/internal/handlers"
+	"api-bridge/internal/tracing"
 
 	"github.com/gin-gonic/gin"
 	"github.com/rs/zerolog"
@@ -24,30 +27,69 @@ type Server struct {
 	grpcServer     *grpcServer.Server
 	authMiddleware *auth.AuthMiddleware
 	authzService   *auth.AuthorizationService
+
+	// limiter throttles writes; its limits are replaced by Reload
+	limiter *writeLimiter
+
+	// shutdownTracing flushes spans still buffered for the exporter
+	shutdownTracing func(context.Context) error
 }
 
 // New creates a new server instance
 func New(cfg *config.Config, logger zerolog.Logger) (*Server, error) {
-	// Set Gin mode
-	if logger.GetLevel() == zerolog.DebugLevel {
+	// Set Gin mode; the global level is the one logging.level sets
+	if max(logger.GetLevel(), zerolog.GlobalLevel()) == zerolog.DebugLevel {
 		gin.SetMode(gin.DebugMode)
 	} else {
 		gin.SetMode(gin.ReleaseMode)
 	}
 
+	shutdownTracing, err := tracing.Setup(context.Background(), cfg.Tracing, logger)
+	if err != nil {
+		return nil, fmt.Errorf("failed to set up tracing: %w", err)
+	}
+
 	// Create router
 	router := gin.New()
 	router.Use(gin.Recovery())
-	router.Use(loggerMiddleware(logger))
+	router.Use(bodyLimitMiddleware(cfg.Server.MaxBodyBytes))
+	router.Use(accessLogMiddleware(logger))
+	router.Use(tracingMiddleware())
+	cors, err := corsMiddleware(cfg.Server.CORS)
+	if err != nil {
+		return nil, err
+	}
+	router.Use(cors)
+	router.Use(cacheControlMiddleware())
+	if cfg.Server.Compression.Enabled {
+		router.Use(compressionMiddleware(cfg.Server.Compression))
+	}
+	// Installed even when disabled so a reload can turn it on; it runs after
+	// authentication so it can key on the authenticated user
+	limiter := newWriteLimiter(cfg.Server.RateLimit, time.Now)
+
+	// Create blockchain client using REST endpoint and the configured tx mode
+	bcClient, err := blockchain.NewClientFromConfig(cfg.Blockchain, logger)
+	if err != nil {
+		return nil, err
+	}
+	if err := bcClient.LoadComponentSchemas(cfg.Validation.ComponentSchemas); err != nil {
+		return nil, err
+	}
 
 	// Create handler
-	handler, err := handlers.New(cfg, logger)
+	handler, err := handlers.New(cfg, bcClient, logger)
 	if err != nil {
 		return nil, err
 	}
 
+	// Split key halves go to their components as events, never in responses
+	if cfg.Blockchain.KeyDelivery.Enabled {
+		bcClient.SetKeyHalfDelivery(handler)
+	}
+
 	// Create gRPC server
-	grpcSrv := grpcServer.NewServer(handler.GetBlockchainClient(), cfg)
+	grpcSrv := grpcServer.NewServer(bcClient, cfg)
 	grpcSrv.SetLogger(logger)
 
 	// Initialize authentication services if enabled
@@ -69,7 +111,7 @@ func New(cfg *config.Config, logger zerolog.Logger) (*Server, error) {
 		authMiddleware = auth.NewAuthMiddleware(authService, logger)
 
 		// Authorization Service
-		authzService = auth.NewAuthorizationService(authService, handler.GetBlockchainClient(), logger)
+		authzService = auth.NewAuthorizationService(authService, bcClient, logger)
 
 		// Set up gRPC authentication interceptor
 		grpcAuthInterceptor := auth.NewGRPCAuthInterceptor(authService, logger)
@@ -77,7 +119,7 @@ func New(cfg *config.Config, logger zerolog.Logger) (*Server, error) {
 	}
 
 	// Setup routes
-	setupRoutes(router, handler, authMiddleware, authzService)
+	setupRoutes(router, handler, limiter, authMiddleware, authzService)
 
 	// Create HTTP server
 	server := &http.Server{
@@ -95,6 +137,9 @@ func New(cfg *config.Config, logger zerolog.Logger) (*Server, error) {
 		grpcServer:     grpcSrv,
 		authMiddleware: authMiddleware,
 		authzService:   authzService,
+		limiter:        limiter,
+
+		shutdownTracing: shutdownTracing,
 	}, nil
 }
 
@@ -105,6 +150,18 @@ func (s *Server) Start(addr string) error {
 	return s.server.ListenAndServe()
 }
 
+// Reload applies the settings that can change while the server runs:
+// webhook endpoints, signing secrets and retry schedules, and the write rate
+// limits. Listeners and open connections are left alone. When cfg is invalid
+// nothing is applied.
+func (s *Server) Reload(cfg *config.Config) error {
+	if err := s.handler.ReloadEvents(cfg.Events); err != nil {
+		return err
+	}
+	s.limiter.setConfig(cfg.Server.RateLimit)
+	return nil
+}
+
 // StartGRPC starts the gRPC server
 func (s *Server) StartGRPC(port int) error {
 	return s.grpcServer.Start(port)
@@ -117,14 +174,21 @@ func (s *Server) Shutdown(ctx context.Context) error {
 	// Shutdown handler (which includes event queue)
 	s.handler.Shutdown()
 
-	return s.server.Shutdown(ctx)
+	err := s.server.Shutdown(ctx)
+	if tracingErr := s.shutdownTracing(ctx); tracingErr != nil {
+		s.logger.Error().Err(tracingErr).Msg("Failed to flush traces")
+	}
+	return err
 }
 
 // setupRoutes configures the API routes with authentication and authorization
-func setupRoutes(router *gin.Engine, handler *handlers.Handler, authMiddleware *auth.AuthMiddleware, authzService *auth.AuthorizationService) {
+func setupRoutes(router *gin.Engine, handler *handlers.Handler, limiter *writeLimiter, authMiddleware *auth.AuthMiddleware, authzService *auth.AuthorizationService) {
 	// Public routes (no authentication required)
 	router.GET("/health", handler.HealthCheck)
+	router.GET("/ready", handler.Readiness)
 	router.GET("/blockchain/status", handler.BlockchainStatus)
+	router.GET("/metrics", handler.Metrics)
+	router.GET("/version", handler.Version)
 
 	// API v1 routes
 	v1 := router.Group("/api/v1")
@@ -133,11 +197,22 @@ func setupRoutes(router *gin.Engine, handler *handlers.Handler, authMiddleware *
 	if authMiddleware != nil {
 		v1.Use(authMiddleware.RequireAPIKey())
 	}
+	v1.Use(limiter.middleware())
 
 	{
 		// Component Registry endpoints with intelligent authorization
 		components := v1.Group("/components")
 		{
+			// Paginated component listing - system-level access
+			components.GET("",
+				applyAuthzIfEnabled(authzService, authzService.RequireSystemAccess()),
+				handler.ListComponents)
+
+			// Search by anonymous hashes - system-level access
+			components.GET("/search",
+				applyAuthzIfEnabled(authzService, authzService.RequireSystemAccess()),
+				handler.SearchComponents)
+
 			// Basic component info - system-level access
 			components.GET("/:id",
 				applyAuthzIfEnabled(authzService, authzService.RequireSystemAccess()),
@@ -149,10 +224,45 @@ func setupRoutes(router *gin.Engine, handler *handlers.Handler, authMiddleware *
 				applyAuthIfEnabled(authMiddleware, authMiddleware.RequirePermission("component:register")),
 				handler.RegisterComponent)
 
+			// Streaming bulk registration, NDJSON in and out - same access as /register
+			components.POST("/register-stream",
+				applyAuthzIfEnabled(authzService, authzService.RequireSystemAccess()),
+				applyAuthIfEnabled(authMiddleware, authMiddleware.RequirePermission("component:register")),
+				handler.RegisterComponentStream)
+
 			components.GET("/:id/identity",
 				applyAuthzIfEnabled(authzService, authzService.RequireSystemAccess()),
 				handler.GetComponentIdentity)
 
+			components.GET("/:id/history",
+				applyAuthzIfEnabled(authzService, authzService.RequireSystemAccess()),
+				handler.GetComponentHistory)
+
+			// On-chain fields merged with the verification backend's metadata
+			components.GET("/:id/metadata",
+				applyAuthzIfEnabled(authzService, authzService.RequireSystemAccess()),
+				handler.GetComponentMetadata)
+
+			// Owners the component has passed through, oldest first
+			components.GET("/:id/custody",
+				applyAuthzIfEnabled(authzService, authzService.RequireSystemAccess()),
+				handler.GetCustodyChain)
+
+			// Verifications of the component, oldest first, paginated
+			components.GET("/:id/verifications",
+				applyAuthzIfEnabled(authzService, authzService.RequireSystemAccess()),
+				handler.GetVerificationHistory)
+
+			// Every LCT the component is paired through, optionally filtered by ?status=
+			components.GET("/:id/relationships",
+				applyAuthzIfEnabled(authzService, authzService.RequireSystemAccess()),
+				handler.GetComponentRelationships)
+
+			// Energy flow balance across the LCT network reachable from the component
+			components.GET("/:id/network-energy",
+				applyAuthzIfEnabled(authzService, authzService.RequireSystemAccess()),
+				handler.GetNetworkEnergyBalance)
+
 			components.POST("/:id/verify",
 				applyAuthzIfEnabled(authzService, authzService.RequireSystemAccess()),
 				applyAuthIfEnabled(authMiddleware, authMiddleware.RequirePermission("component:verify")),
@@ -180,6 +290,35 @@ func setupRoutes(router *gin.Engine, handler *handlers.Handler, authMiddleware *
 				handler.GetAnonymousComponentMetadata)
 		}
 
+		// Revocation events by target hash and time range - system-level access
+		v1.GET("/revocations",
+			applyAuthzIfEnabled(authzService, authzService.RequireSystemAccess()),
+			handler.GetRevocationEvents)
+
+		// Pairing rules by source component type - system-level access
+		v1.GET("/pairing-rules",
+			applyAuthzIfEnabled(authzService, authzService.RequireSystemAccess()),
+			handler.GetPairingRules)
+
+		// Full values of event attributes truncated on emission - system-level access
+		v1.GET("/events/payloads/:ref",
+			applyAuthzIfEnabled(authzService, authzService.RequireSystemAccess()),
+			handler.GetEventPayload)
+
+		// Webhook deliveries that exhausted their retries - system-level access
+		v1.GET("/events/deadletter",
+			applyAuthzIfEnabled(authzService, authzService.RequireSystemAccess()),
+			handler.GetDeadLetters)
+		v1.POST("/events/deadletter/replay",
+			applyAuthzIfEnabled(authzService, authzService.RequireSystemAccess()),
+			handler.ReplayDeadLetters)
+
+		// Vehicle onboarding - registers components, creates LCTs and pairs in one workflow
+		v1.POST("/onboard",
+			applyAuthzIfEnabled(authzService, authzService.RequireSystemAccess()),
+			applyAuthIfEnabled(authMiddleware, authMiddleware.RequirePermission("component:register")),
+			handler.Onboard)
+
 		// Pairing endpoints - requires LCT relationship
 		pairing := v1.Group("/pairing")
 		{
@@ -198,6 +337,11 @@ func setupRoutes(router *gin.Engine, handler *handlers.Handler, authMiddleware *
 			pairing.GET("/status/:challenge_id",
 				applyAuthzIfEnabled(authzService, authzService.RequireSystemAccess()),
 				handler.GetPairingStatus)
+
+			// Statuses of many challenges in one round trip, e.g. a fleet-wide pairing sweep
+			pairing.POST("/status/batch",
+				applyAuthzIfEnabled(authzService, authzService.RequireSystemAccess()),
+				handler.GetPairingStatuses)
 		}
 
 		// LCT Management endpoints - mixed authorization
@@ -209,16 +353,61 @@ func setupRoutes(router *gin.Engine, handler *handlers.Handler, authMiddleware *
 				applyAuthIfEnabled(authMiddleware, authMiddleware.RequirePermission("lct:create")),
 				handler.CreateLCT)
 
+			// Find the LCT linking two components - system access
+			lct.GET("/between",
+				applyAuthzIfEnabled(authzService, authzService.RequireSystemAccess()),
+				handler.GetLctBetween)
+
 			// Get LCT info - system access or be part of LCT
 			lct.GET("/:id",
 				applyAuthzIfEnabled(authzService, authzService.RequireSystemAccess()),
 				handler.GetLCT)
 
+			// Observe the LCT's session key exchange - system access
+			lct.GET("/:id/key-exchange",
+				applyAuthzIfEnabled(authzService, authzService.RequireSystemAccess()),
+				handler.GetKeyExchange)
+
+			// Observe the LCT's split key lifecycle - system access
+			lct.GET("/:id/splitkey/status",
+				applyAuthzIfEnabled(authzService, authzService.RequireSystemAccess()),
+				handler.GetSplitKeyStatus)
+
 			// Update LCT status - system access with permission
 			lct.PUT("/:id/status",
 				applyAuthzIfEnabled(authzService, authzService.RequireSystemAccess()),
 				applyAuthIfEnabled(authMiddleware, authMiddleware.RequirePermission("lct:write")),
 				handler.UpdateLCTStatus)
+
+			// Suspend/resume an LCT without terminating it - system access with permission
+			lct.POST("/:id/suspend",
+				applyAuthzIfEnabled(authzService, authzService.RequireSystemAccess()),
+				applyAuthIfEnabled(authMiddleware, authMiddleware.RequirePermission("lct:write")),
+				handler.SuspendLCT)
+			lct.POST("/:id/resume",
+				applyAuthzIfEnabled(authzService, authzService.RequireSystemAccess()),
+				applyAuthIfEnabled(authMiddleware, authMiddleware.RequirePermission("lct:write")),
+				handler.ResumeLCT)
+
+			// Replace the LCT's split key - system access with permission
+			lct.POST("/:id/splitkey/rotate",
+				applyAuthzIfEnabled(authzService, authzService.RequireSystemAccess()),
+				applyAuthIfEnabled(authMiddleware, authMiddleware.RequirePermission("lct:write")),
+				handler.RotateSplitKey)
+		}
+
+		// List LCTs by component, status and operational context - system access
+		v1.GET("/lcts",
+			applyAuthzIfEnabled(authzService, authzService.RequireSystemAccess()),
+			handler.ListLCTs)
+
+		// Proxy endpoints - system access
+		proxy := v1.Group("/proxy")
+		{
+			// List the LCTs a proxy component mediates
+			proxy.GET("/:id/lcts",
+				applyAuthzIfEnabled(authzService, authzService.RequireSystemAccess()),
+				handler.GetLctsByProxy)
 		}
 
 		// Trust Tensor endpoints - require LCT relationship
@@ -232,6 +421,10 @@ func setupRoutes(router *gin.Engine, handler *handlers.Handler, authMiddleware *
 				applyAuthzIfEnabled(authzService, authzService.RequireSystemAccess()),
 				handler.GetTrustTensor)
 
+			trust.GET("/tensor/:id/history",
+				applyAuthzIfEnabled(authzService, authzService.RequireSystemAccess()),
+				handler.GetTensorHistory)
+
 			trust.PUT("/tensor/:id/score",
 				applyAuthzIfEnabled(authzService, authzService.RequireLCTRelationship()),
 				handler.UpdateTrustScore)
@@ -244,6 +437,14 @@ func setupRoutes(router *gin.Engine, handler *handlers.Handler, authMiddleware *
 				applyAuthzIfEnabled(authzService, authzService.RequireLCTRelationship()),
 				handler.CreateEnergyOperation)
 
+			energy.GET("/operation/:id",
+				applyAuthzIfEnabled(authzService, authzService.RequireSystemAccess()),
+				handler.GetEnergyOperation)
+
+			energy.GET("/operation/:id/efficiency",
+				applyAuthzIfEnabled(authzService, authzService.RequireSystemAccess()),
+				handler.GetOperationEfficiency)
+
 			energy.POST("/transfer",
 				applyAuthzIfEnabled(authzService, authzService.RequireLCTRelationship()),
 				applyAuthzIfEnabled(authzService, authzService.RequireMinimumTrust(0.7)),
@@ -252,6 +453,11 @@ func setupRoutes(router *gin.Engine, handler *handlers.Handler, authMiddleware *
 			energy.GET("/balance/:component_id",
 				applyAuthzIfEnabled(authzService, authzService.RequireSystemAccess()),
 				handler.GetEnergyBalance)
+
+			// Balances of many components in one round trip, e.g. every module of a pack
+			energy.POST("/balances",
+				applyAuthzIfEnabled(authzService, authzService.RequireSystemAccess()),
+				handler.GetEnergyBalances)
 		}
 
 		// Queue Management endpoints - infrastructure access
@@ -336,11 +542,36 @@ func setupRoutes(router *gin.Engine, handler *handlers.Handler, authMiddleware *
 				applyAuthIfEnabled(authMiddleware, authMiddleware.RequirePermission("account:create")),
 				handler.CreateAccount)
 
+			// Key derived from an existing mnemonic, stored in the configured keyring
+			accounts.POST("/import",
+				applyAuthzIfEnabled(authzService, authzService.RequireSystemAccess()),
+				applyAuthIfEnabled(authMiddleware, authMiddleware.RequirePermission("account:create")),
+				handler.ImportAccount)
+
 			accounts.GET("/info",
 				applyAuthzIfEnabled(authzService, authzService.RequireSystemAccess()),
 				handler.GetAccountInfo)
+
+			// Recent writes the creator made through this bridge, newest first
+			accounts.GET("/:name/operations",
+				applyAuthzIfEnabled(authzService, authzService.RequireSystemAccess()),
+				handler.GetAccountOperations)
 		}
 
+		// Administration endpoints - admin role required
+		v1.POST("/admin/consistency-check",
+			applyAuthIfEnabled(authMiddleware, authMiddleware.RequireRole("admin")),
+			handler.ConsistencyCheck)
+
+		v1.GET("/admin/components/health",
+			applyAuthIfEnabled(authMiddleware, authMiddleware.RequireRole("admin")),
+			handler.ComponentsHealth)
+
+		// Owners are included only for keys with the recall:owners permission
+		v1.POST("/admin/recall-scope",
+			applyAuthIfEnabled(authMiddleware, authMiddleware.RequireRole("admin")),
+			handler.RecallScope)
+
 		// Testing endpoints - admin role required
 		v1.GET("/test/ignite",
 			applyAuthIfEnabled(authMiddleware, authMiddleware.RequireRole("admin")),
@@ -355,8 +586,11 @@ func setupRoutes(router *gin.Engine, handler *handlers.Handler, authMiddleware *
 			handler.GetIgniteHelp)
 	}
 
-	// WebSocket endpoint for real-time events - system-level access
+	// WebSocket endpoint for real-time events - allowed origins, API key and
+	// system-level access are all checked before the upgrade
 	router.GET("/ws",
+		handler.CheckWebSocketOrigin,
+		applyAuthIfEnabled(authMiddleware, authMiddleware.RequireWebSocketAPIKey()),
 		applyAuthzIfEnabled(authzService, authzService.RequireSystemAccess()),
 		handler.WebSocketHandler)
 }
@@ -375,18 +609,3 @@ func applyAuthzIfEnabled(authzService *auth.AuthorizationService, middleware gin
 	}
 	return gin.HandlerFunc(func(c *gin.Context) { c.Next() })
 }
-
-// loggerMiddleware adds logging to Gin requests
-func loggerMiddleware(logger zerolog.Logger) gin.HandlerFunc {
-	return gin.LoggerWithFormatter(func(param gin.LogFormatterParams) string {
-		logger.Info().
-			Str("method", param.Method).
-			Str("path", param.Path).
-			Int("status", param.StatusCode).
-			Dur("latency", param.Latency).
-			Str("client_ip", param.ClientIP).
-			Str("user_agent", param.Request.UserAgent()).
-			Msg("HTTP request")
-		return ""
-	})
-}
@@ -0,0 +1,78 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	"api-bridge/internal/blockchain"
+)
+
+func TestTracingMiddlewareContinuesTraceToNode(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	t.Cleanup(func() { otel.SetTracerProvider(previous) })
+
+	const (
+		traceID      = "4bf92f3577b34da6a3ce929d0e0e4736"
+		callerSpanID = "00f067aa0ba902b7"
+	)
+
+	var nodeTraceparent string
+	node := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		nodeTraceparent = r.Header.Get("traceparent")
+		_, _ = w.Write([]byte(`{"component": {"component_id": "MODBATT-MOD-001"}}`))
+	}))
+	defer node.Close()
+	client, err := blockchain.NewClient(node.URL, zerolog.Nop())
+	require.NoError(t, err)
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(tracingMiddleware())
+	router.GET("/api/v1/components/:id", func(c *gin.Context) {
+		component, err := client.GetComponent(c.Request.Context(), c.Param("id"))
+		require.NoError(t, err)
+		c.JSON(http.StatusOK, component)
+	})
+	router.GET("/api/v1/broken", func(c *gin.Context) {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "boom"})
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/components/MODBATT-MOD-001", nil)
+	req.Header.Set("traceparent", "00-"+traceID+"-"+callerSpanID+"-01")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 2)
+	rest, root := spans[0], spans[1]
+
+	// The root span is named by route and continues the caller's trace
+	assert.Equal(t, "GET /api/v1/components/:id", root.Name())
+	assert.Equal(t, traceID, root.SpanContext().TraceID().String())
+	assert.Equal(t, callerSpanID, root.Parent().SpanID().String())
+
+	// The blockchain call is its child and passes the trace on to the node
+	assert.Equal(t, "blockchain.rest GET", rest.Name())
+	assert.Equal(t, root.SpanContext().SpanID(), rest.Parent().SpanID())
+	assert.Equal(t, "00-"+traceID+"-"+rest.SpanContext().SpanID().String()+"-01", nodeTraceparent)
+
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/v1/broken", nil))
+	failed := recorder.Ended()[2]
+	assert.Equal(t, codes.Error, failed.Status().Code)
+}
@@ -0,0 +1,238 @@
+// Package verification asks a manufacturer verification service whether
+// components can be paired. The chain never calls the service itself: its
+// answer could differ between validators, so the bridge fetches the verdict
+// and submits it as an attestation signed by an account the chain trusts.
+package verification
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"api-bridge/internal/config"
+)
+
+// ErrServiceUnavailable is returned when the service could not be reached,
+// kept failing transiently, or its circuit breaker is open
+var ErrServiceUnavailable = errors.New("verification service unavailable")
+
+// maxResponseSize caps how much of a service response is read
+const maxResponseSize = 1 << 20
+
+// Verdict is the service's answer to a pairing check
+type Verdict struct {
+	Allowed bool   `json:"allowed"`
+	Reason  string `json:"reason"`
+}
+
+// Service calls a manufacturer verification service
+type Service struct {
+	config  config.ManufacturerVerificationConfig
+	baseURL string
+	client  *http.Client
+	breaker *circuitBreaker
+}
+
+// New creates a client for the service at cfg.URL, returning an error when
+// the configuration cannot be used to reach it
+func New(cfg config.ManufacturerVerificationConfig, client *http.Client) (*Service, error) {
+	if err := validate(cfg); err != nil {
+		return nil, err
+	}
+	if client == nil {
+		client = &http.Client{}
+	}
+	return &Service{
+		config:  cfg,
+		baseURL: strings.TrimRight(cfg.URL, "/"),
+		client:  client,
+		breaker: &circuitBreaker{threshold: cfg.FailureThreshold, openTimeout: cfg.OpenTimeout},
+	}, nil
+}
+
+// validate checks the settings New depends on
+func validate(cfg config.ManufacturerVerificationConfig) error {
+	u, err := url.Parse(cfg.URL)
+	if err != nil {
+		return fmt.Errorf("invalid verification.manufacturer.url: %w", err)
+	}
+	if u.Scheme != "https" || u.Host == "" {
+		return fmt.Errorf("verification.manufacturer.url must be an https URL, got %q", cfg.URL)
+	}
+	if cfg.Attestor == "" {
+		return fmt.Errorf("verification.manufacturer.attestor is required to submit the service's verdicts")
+	}
+	if cfg.Timeout <= 0 {
+		return fmt.Errorf("verification.manufacturer.timeout must be positive")
+	}
+	if cfg.MaxRetries < 0 {
+		return fmt.Errorf("verification.manufacturer.max_retries cannot be negative")
+	}
+	if cfg.RetryBackoff < 0 {
+		return fmt.Errorf("verification.manufacturer.retry_backoff cannot be negative")
+	}
+	if cfg.FailureThreshold <= 0 {
+		return fmt.Errorf("verification.manufacturer.failure_threshold must be positive")
+	}
+	if cfg.OpenTimeout <= 0 {
+		return fmt.Errorf("verification.manufacturer.open_timeout must be positive")
+	}
+	return nil
+}
+
+// Attestor is the account that signs the service's verdicts on chain
+func (s *Service) Attestor() string {
+	return s.config.Attestor
+}
+
+// VerifyPairingWithHashes asks the service whether the components behind
+// two hashes can be paired
+func (s *Service) VerifyPairingWithHashes(ctx context.Context, componentHashA, componentHashB string) (Verdict, error) {
+	var verdict Verdict
+	request := map[string]string{"component_hash_a": componentHashA, "component_hash_b": componentHashB}
+	if err := s.call(ctx, http.MethodPost, "/v1/pairings/verify-hashes", request, &verdict); err != nil {
+		return Verdict{}, err
+	}
+	return verdict, nil
+}
+
+// call makes one call to the service through the circuit breaker, retrying
+// transient failures. Only calls that exhaust their retries count against
+// the breaker; a service that answers with a client error is still up.
+func (s *Service) call(ctx context.Context, method, path string, request, response interface{}) error {
+	payload, err := json.Marshal(request)
+	if err != nil {
+		return fmt.Errorf("failed to encode verification request: %w", err)
+	}
+
+	if !s.breaker.allow() {
+		return fmt.Errorf("%w: circuit open after repeated failures calling %s", ErrServiceUnavailable, s.baseURL)
+	}
+
+	err = s.callWithRetry(ctx, method, path, payload, response)
+	s.breaker.record(err)
+	return err
+}
+
+// callWithRetry retries an attempt that failed transiently, waiting
+// RetryBackoff before the first retry and twice as long before each next one
+func (s *Service) callWithRetry(ctx context.Context, method, path string, payload []byte, response interface{}) error {
+	backoff := s.config.RetryBackoff
+	for attempt := 0; ; attempt++ {
+		retry, err := s.attempt(ctx, method, path, payload, response)
+		if err == nil || !retry {
+			return err
+		}
+		if attempt == s.config.MaxRetries {
+			return fmt.Errorf("%w: %s %s failed after %d attempts: %v", ErrServiceUnavailable, method, path, attempt+1, err)
+		}
+
+		timer := time.NewTimer(backoff)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+		backoff *= 2
+	}
+}
+
+// attempt makes a single request and decodes a successful response into
+// response. retry reports whether a failure may succeed on another attempt.
+func (s *Service) attempt(ctx context.Context, method, path string, payload []byte, response interface{}) (retry bool, err error) {
+	attemptCtx, cancel := context.WithTimeout(ctx, s.config.Timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(attemptCtx, method, s.baseURL+path, bytes.NewReader(payload))
+	if err != nil {
+		return false, fmt.Errorf("failed to create verification request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Content-Type", "application/json")
+	if s.config.AuthToken != "" {
+		req.Header.Set("Authorization", "Bearer "+s.config.AuthToken)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		if ctx.Err() != nil {
+			return false, ctx.Err()
+		}
+		return true, fmt.Errorf("verification request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseSize))
+	if err != nil {
+		return true, fmt.Errorf("failed to read verification response: %w", err)
+	}
+
+	switch {
+	case resp.StatusCode >= 200 && resp.StatusCode < 300:
+		if err := json.Unmarshal(body, response); err != nil {
+			return false, fmt.Errorf("failed to decode verification response: %w", err)
+		}
+		return false, nil
+	case resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500:
+		return true, fmt.Errorf("verification service returned %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	default:
+		return false, fmt.Errorf("verification service returned %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+}
+
+// circuitBreaker opens after threshold consecutive failures. While open it
+// rejects calls until openTimeout has passed, then lets a single trial call
+// through: its success closes the breaker and its failure opens it again.
+type circuitBreaker struct {
+	mu          sync.Mutex
+	threshold   int
+	openTimeout time.Duration
+	failures    int
+	open        bool
+	openedAt    time.Time
+	trial       bool
+}
+
+// allow reports whether a call may be made now
+func (cb *circuitBreaker) allow() bool {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+	if !cb.open {
+		return true
+	}
+	if cb.trial || time.Since(cb.openedAt) < cb.openTimeout {
+		return false
+	}
+	cb.trial = true
+	return true
+}
+
+// record updates the breaker with the outcome of an allowed call. A call
+// cancelled by its caller says nothing about the service.
+func (cb *circuitBreaker) record(err error) {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+	cb.trial = false
+	if errors.Is(err, context.Canceled) {
+		return
+	}
+	if !errors.Is(err, ErrServiceUnavailable) {
+		cb.failures = 0
+		cb.open = false
+		return
+	}
+	cb.failures++
+	if cb.open || cb.failures >= cb.threshold {
+		cb.open = true
+		cb.openedAt = time.Now()
+	}
+}
@@ -0,0 +1,147 @@
+package verification
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"api-bridge/internal/config"
+)
+
+// newTestService points a service at handler, served over TLS
+func newTestService(t *testing.T, handler http.HandlerFunc, configure func(*config.ManufacturerVerificationConfig)) *Service {
+	t.Helper()
+	server := httptest.NewTLSServer(handler)
+	t.Cleanup(server.Close)
+
+	cfg := config.ManufacturerVerificationConfig{
+		URL:              server.URL,
+		AuthToken:        "secret",
+		Attestor:         "racecar1oracle",
+		Timeout:          5 * time.Second,
+		MaxRetries:       2,
+		RetryBackoff:     time.Millisecond,
+		FailureThreshold: 5,
+		OpenTimeout:      30 * time.Second,
+	}
+	if configure != nil {
+		configure(&cfg)
+	}
+	service, err := New(cfg, server.Client())
+	require.NoError(t, err)
+	return service
+}
+
+func TestVerifyPairingWithHashes(t *testing.T) {
+	service := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "Bearer secret", r.Header.Get("Authorization"))
+		require.Equal(t, "POST /v1/pairings/verify-hashes", r.Method+" "+r.URL.Path)
+		var request map[string]string
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+		require.Equal(t, map[string]string{"component_hash_a": "hash-a", "component_hash_b": "hash-b"}, request)
+		_, _ = w.Write([]byte(`{"allowed": true, "reason": "compatible"}`))
+	}, nil)
+
+	verdict, err := service.VerifyPairingWithHashes(context.Background(), "hash-a", "hash-b")
+	require.NoError(t, err)
+	require.Equal(t, Verdict{Allowed: true, Reason: "compatible"}, verdict)
+	require.Equal(t, "racecar1oracle", service.Attestor())
+}
+
+func TestVerifyPairingWithHashesRetries(t *testing.T) {
+	var calls atomic.Int32
+	service := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte(`{"allowed": false, "reason": "recalled"}`))
+	}, nil)
+
+	// Two 503s are retried
+	verdict, err := service.VerifyPairingWithHashes(context.Background(), "hash-a", "hash-b")
+	require.NoError(t, err)
+	require.Equal(t, "recalled", verdict.Reason)
+	require.Equal(t, int32(3), calls.Load())
+
+	// A client error is not
+	service = newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusUnauthorized)
+	}, nil)
+	calls.Store(0)
+	_, err = service.VerifyPairingWithHashes(context.Background(), "hash-a", "hash-b")
+	require.ErrorContains(t, err, "401")
+	require.NotErrorIs(t, err, ErrServiceUnavailable)
+	require.Equal(t, int32(1), calls.Load())
+}
+
+func TestVerifyPairingWithHashesCircuitBreaker(t *testing.T) {
+	var calls atomic.Int32
+	var healthy atomic.Bool
+	service := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		if !healthy.Load() {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		_, _ = w.Write([]byte(`{"allowed": true}`))
+	}, func(cfg *config.ManufacturerVerificationConfig) {
+		cfg.MaxRetries = 0
+		cfg.FailureThreshold = 2
+		cfg.OpenTimeout = 50 * time.Millisecond
+	})
+	ctx := context.Background()
+
+	for range 2 {
+		_, err := service.VerifyPairingWithHashes(ctx, "hash-a", "hash-b")
+		require.ErrorIs(t, err, ErrServiceUnavailable)
+	}
+	require.Equal(t, int32(2), calls.Load())
+
+	// Open: calls fail without reaching the service
+	_, err := service.VerifyPairingWithHashes(ctx, "hash-a", "hash-b")
+	require.ErrorIs(t, err, ErrServiceUnavailable)
+	require.ErrorContains(t, err, "circuit open")
+	require.Equal(t, int32(2), calls.Load())
+
+	// After the open timeout a trial call goes through and closes the breaker
+	healthy.Store(true)
+	time.Sleep(60 * time.Millisecond)
+	verdict, err := service.VerifyPairingWithHashes(ctx, "hash-a", "hash-b")
+	require.NoError(t, err)
+	require.True(t, verdict.Allowed)
+	_, err = service.VerifyPairingWithHashes(ctx, "hash-a", "hash-b")
+	require.NoError(t, err)
+	require.Equal(t, int32(4), calls.Load())
+}
+
+func TestNewRejectsUnusableConfig(t *testing.T) {
+	valid := config.ManufacturerVerificationConfig{
+		URL:              "https://verifier.example.com",
+		Attestor:         "racecar1oracle",
+		Timeout:          time.Second,
+		FailureThreshold: 1,
+		OpenTimeout:      time.Second,
+	}
+	_, err := New(valid, nil)
+	require.NoError(t, err)
+
+	for name, configure := range map[string]func(*config.ManufacturerVerificationConfig){
+		"https":             func(cfg *config.ManufacturerVerificationConfig) { cfg.URL = "http://verifier.example.com" },
+		"attestor":          func(cfg *config.ManufacturerVerificationConfig) { cfg.Attestor = "" },
+		"timeout":           func(cfg *config.ManufacturerVerificationConfig) { cfg.Timeout = 0 },
+		"failure_threshold": func(cfg *config.ManufacturerVerificationConfig) { cfg.FailureThreshold = 0 },
+	} {
+		cfg := valid
+		configure(&cfg)
+		_, err := New(cfg, nil)
+		require.ErrorContains(t, err, name, name)
+	}
+}
@@ -0,0 +1,14 @@
+// Package version holds the bridge's build information. The values are set
+// at build time, e.g.
+//
+//	go build -ldflags "-X api-bridge/internal/version.Version=v1.2.0 -X api-bridge/internal/version.Commit=$(git rev-parse --short HEAD)"
+package version
+
+var (
+	// Version is the release the binary was built from
+	Version = "dev"
+	// Commit is the git commit the binary was built from
+	Commit = "unknown"
+	// BuildDate is when the binary was built, in RFC 3339
+	BuildDate = ""
+)
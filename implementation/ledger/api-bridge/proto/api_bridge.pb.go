@@ -177,6 +177,9 @@ type RegisterComponentRequest struct {
 	Creator       string                 `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
 	ComponentData string                 `protobuf:"bytes,2,opt,name=component_data,json=componentData,proto3" json:"component_data,omitempty"`
 	Context       string                 `protobuf:"bytes,3,opt,name=context,proto3" json:"context,omitempty"`
+	// dedupe returns a component already registered with the same data
+	// instead of registering a new one.
+	Dedupe        bool `protobuf:"varint,4,opt,name=dedupe,proto3" json:"dedupe,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -232,6 +235,13 @@ func (x *RegisterComponentRequest) GetContext() string {
 	return ""
 }
 
+func (x *RegisterComponentRequest) GetDedupe() bool {
+	if x != nil {
+		return x.Dedupe
+	}
+	return false
+}
+
 type RegisterComponentResponse struct {
 	state             protoimpl.MessageState `protogen:"open.v1"`
 	ComponentId       string                 `protobuf:"bytes,1,opt,name=component_id,json=componentId,proto3" json:"component_id,omitempty"`
@@ -242,8 +252,10 @@ type RegisterComponentResponse struct {
 	LctId             string                 `protobuf:"bytes,6,opt,name=lct_id,json=lctId,proto3" json:"lct_id,omitempty"`
 	Status            string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
 	Txhash            string                 `protobuf:"bytes,8,opt,name=txhash,proto3" json:"txhash,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+	// created is false when dedupe matched an existing component.
+	Created       bool `protobuf:"varint,9,opt,name=created,proto3" json:"created,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *RegisterComponentResponse) Reset() {
@@ -332,6 +344,13 @@ func (x *RegisterComponentResponse) GetTxhash() string {
 	return ""
 }
 
+func (x *RegisterComponentResponse) GetCreated() bool {
+	if x != nil {
+		return x.Created
+	}
+	return false
+}
+
 type GetComponentRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ComponentId   string                 `protobuf:"bytes,1,opt,name=component_id,json=componentId,proto3" json:"component_id,omitempty"`
@@ -1197,6 +1216,186 @@ func (x *UpdateLCTStatusResponse) GetUpdatedAt() int64 {
 	return 0
 }
 
+type GetComponentRelationshipsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ComponentId   string                 `protobuf:"bytes,1,opt,name=component_id,json=componentId,proto3" json:"component_id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"` // optional pairing status filter, e.g. "active"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetComponentRelationshipsRequest) Reset() {
+	*x = GetComponentRelationshipsRequest{}
+	mi := &file_proto_api_bridge_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetComponentRelationshipsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetComponentRelationshipsRequest) ProtoMessage() {}
+
+func (x *GetComponentRelationshipsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_bridge_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetComponentRelationshipsRequest.ProtoReflect.Descriptor instead.
+func (*GetComponentRelationshipsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_bridge_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetComponentRelationshipsRequest) GetComponentId() string {
+	if x != nil {
+		return x.ComponentId
+	}
+	return ""
+}
+
+func (x *GetComponentRelationshipsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type GetComponentRelationshipsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ComponentId   string                 `protobuf:"bytes,1,opt,name=component_id,json=componentId,proto3" json:"component_id,omitempty"`
+	Relationships []*LCTSummary          `protobuf:"bytes,2,rep,name=relationships,proto3" json:"relationships,omitempty"`
+	Count         int32                  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetComponentRelationshipsResponse) Reset() {
+	*x = GetComponentRelationshipsResponse{}
+	mi := &file_proto_api_bridge_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetComponentRelationshipsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetComponentRelationshipsResponse) ProtoMessage() {}
+
+func (x *GetComponentRelationshipsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_bridge_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetComponentRelationshipsResponse.ProtoReflect.Descriptor instead.
+func (*GetComponentRelationshipsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_bridge_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetComponentRelationshipsResponse) GetComponentId() string {
+	if x != nil {
+		return x.ComponentId
+	}
+	return ""
+}
+
+func (x *GetComponentRelationshipsResponse) GetRelationships() []*LCTSummary {
+	if x != nil {
+		return x.Relationships
+	}
+	return nil
+}
+
+func (x *GetComponentRelationshipsResponse) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type LCTSummary struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	LctId              string                 `protobuf:"bytes,1,opt,name=lct_id,json=lctId,proto3" json:"lct_id,omitempty"`
+	PeerComponentId    string                 `protobuf:"bytes,2,opt,name=peer_component_id,json=peerComponentId,proto3" json:"peer_component_id,omitempty"`
+	PairingStatus      string                 `protobuf:"bytes,3,opt,name=pairing_status,json=pairingStatus,proto3" json:"pairing_status,omitempty"`
+	OperationalContext string                 `protobuf:"bytes,4,opt,name=operational_context,json=operationalContext,proto3" json:"operational_context,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *LCTSummary) Reset() {
+	*x = LCTSummary{}
+	mi := &file_proto_api_bridge_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LCTSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LCTSummary) ProtoMessage() {}
+
+func (x *LCTSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_bridge_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LCTSummary.ProtoReflect.Descriptor instead.
+func (*LCTSummary) Descriptor() ([]byte, []int) {
+	return file_proto_api_bridge_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *LCTSummary) GetLctId() string {
+	if x != nil {
+		return x.LctId
+	}
+	return ""
+}
+
+func (x *LCTSummary) GetPeerComponentId() string {
+	if x != nil {
+		return x.PeerComponentId
+	}
+	return ""
+}
+
+func (x *LCTSummary) GetPairingStatus() string {
+	if x != nil {
+		return x.PairingStatus
+	}
+	return ""
+}
+
+func (x *LCTSummary) GetOperationalContext() string {
+	if x != nil {
+		return x.OperationalContext
+	}
+	return ""
+}
+
 // Pairing
 type InitiatePairingRequest struct {
 	state              protoimpl.MessageState `protogen:"open.v1"`
@@ -1212,7 +1411,7 @@ type InitiatePairingRequest struct {
 
 func (x *InitiatePairingRequest) Reset() {
 	*x = InitiatePairingRequest{}
-	mi := &file_proto_api_bridge_proto_msgTypes[17]
+	mi := &file_proto_api_bridge_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1224,7 +1423,7 @@ func (x *InitiatePairingRequest) String() string {
 func (*InitiatePairingRequest) ProtoMessage() {}
 
 func (x *InitiatePairingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_bridge_proto_msgTypes[17]
+	mi := &file_proto_api_bridge_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1237,7 +1436,7 @@ func (x *InitiatePairingRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InitiatePairingRequest.ProtoReflect.Descriptor instead.
 func (*InitiatePairingRequest) Descriptor() ([]byte, []int) {
-	return file_proto_api_bridge_proto_rawDescGZIP(), []int{17}
+	return file_proto_api_bridge_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *InitiatePairingRequest) GetCreator() string {
@@ -1300,7 +1499,7 @@ type InitiatePairingResponse struct {
 
 func (x *InitiatePairingResponse) Reset() {
 	*x = InitiatePairingResponse{}
-	mi := &file_proto_api_bridge_proto_msgTypes[18]
+	mi := &file_proto_api_bridge_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1312,7 +1511,7 @@ func (x *InitiatePairingResponse) String() string {
 func (*InitiatePairingResponse) ProtoMessage() {}
 
 func (x *InitiatePairingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_bridge_proto_msgTypes[18]
+	mi := &file_proto_api_bridge_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1325,7 +1524,7 @@ func (x *InitiatePairingResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InitiatePairingResponse.ProtoReflect.Descriptor instead.
 func (*InitiatePairingResponse) Descriptor() ([]byte, []int) {
-	return file_proto_api_bridge_proto_rawDescGZIP(), []int{18}
+	return file_proto_api_bridge_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *InitiatePairingResponse) GetChallengeId() string {
@@ -1411,7 +1610,7 @@ type CompletePairingRequest struct {
 
 func (x *CompletePairingRequest) Reset() {
 	*x = CompletePairingRequest{}
-	mi := &file_proto_api_bridge_proto_msgTypes[19]
+	mi := &file_proto_api_bridge_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1423,7 +1622,7 @@ func (x *CompletePairingRequest) String() string {
 func (*CompletePairingRequest) ProtoMessage() {}
 
 func (x *CompletePairingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_bridge_proto_msgTypes[19]
+	mi := &file_proto_api_bridge_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1436,7 +1635,7 @@ func (x *CompletePairingRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CompletePairingRequest.ProtoReflect.Descriptor instead.
 func (*CompletePairingRequest) Descriptor() ([]byte, []int) {
-	return file_proto_api_bridge_proto_rawDescGZIP(), []int{19}
+	return file_proto_api_bridge_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *CompletePairingRequest) GetCreator() string {
@@ -1480,15 +1679,14 @@ type CompletePairingResponse struct {
 	SessionKeys   string                 `protobuf:"bytes,2,opt,name=session_keys,json=sessionKeys,proto3" json:"session_keys,omitempty"`
 	TrustSummary  string                 `protobuf:"bytes,3,opt,name=trust_summary,json=trustSummary,proto3" json:"trust_summary,omitempty"`
 	Txhash        string                 `protobuf:"bytes,4,opt,name=txhash,proto3" json:"txhash,omitempty"`
-	SplitKeyA     string                 `protobuf:"bytes,5,opt,name=split_key_a,json=splitKeyA,proto3" json:"split_key_a,omitempty"` // 32 bytes for component A
-	SplitKeyB     string                 `protobuf:"bytes,6,opt,name=split_key_b,json=splitKeyB,proto3" json:"split_key_b,omitempty"` // 32 bytes for component B
+	KeyReference  string                 `protobuf:"bytes,7,opt,name=key_reference,json=keyReference,proto3" json:"key_reference,omitempty"` // the commitment to the combined key recorded on-chain
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CompletePairingResponse) Reset() {
 	*x = CompletePairingResponse{}
-	mi := &file_proto_api_bridge_proto_msgTypes[20]
+	mi := &file_proto_api_bridge_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1500,7 +1698,7 @@ func (x *CompletePairingResponse) String() string {
 func (*CompletePairingResponse) ProtoMessage() {}
 
 func (x *CompletePairingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_bridge_proto_msgTypes[20]
+	mi := &file_proto_api_bridge_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1513,7 +1711,7 @@ func (x *CompletePairingResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CompletePairingResponse.ProtoReflect.Descriptor instead.
 func (*CompletePairingResponse) Descriptor() ([]byte, []int) {
-	return file_proto_api_bridge_proto_rawDescGZIP(), []int{20}
+	return file_proto_api_bridge_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *CompletePairingResponse) GetLctId() string {
@@ -1544,16 +1742,9 @@ func (x *CompletePairingResponse) GetTxhash() string {
 	return ""
 }
 
-func (x *CompletePairingResponse) GetSplitKeyA() string {
-	if x != nil {
-		return x.SplitKeyA
-	}
-	return ""
-}
-
-func (x *CompletePairingResponse) GetSplitKeyB() string {
+func (x *CompletePairingResponse) GetKeyReference() string {
 	if x != nil {
-		return x.SplitKeyB
+		return x.KeyReference
 	}
 	return ""
 }
@@ -1570,7 +1761,7 @@ type RevokePairingRequest struct {
 
 func (x *RevokePairingRequest) Reset() {
 	*x = RevokePairingRequest{}
-	mi := &file_proto_api_bridge_proto_msgTypes[21]
+	mi := &file_proto_api_bridge_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1582,7 +1773,7 @@ func (x *RevokePairingRequest) String() string {
 func (*RevokePairingRequest) ProtoMessage() {}
 
 func (x *RevokePairingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_bridge_proto_msgTypes[21]
+	mi := &file_proto_api_bridge_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1595,7 +1786,7 @@ func (x *RevokePairingRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RevokePairingRequest.ProtoReflect.Descriptor instead.
 func (*RevokePairingRequest) Descriptor() ([]byte, []int) {
-	return file_proto_api_bridge_proto_rawDescGZIP(), []int{21}
+	return file_proto_api_bridge_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *RevokePairingRequest) GetCreator() string {
@@ -1631,13 +1822,14 @@ type RevokePairingResponse struct {
 	LctId         string                 `protobuf:"bytes,1,opt,name=lct_id,json=lctId,proto3" json:"lct_id,omitempty"`
 	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
 	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	Txhash        string                 `protobuf:"bytes,4,opt,name=txhash,proto3" json:"txhash,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *RevokePairingResponse) Reset() {
 	*x = RevokePairingResponse{}
-	mi := &file_proto_api_bridge_proto_msgTypes[22]
+	mi := &file_proto_api_bridge_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1649,7 +1841,7 @@ func (x *RevokePairingResponse) String() string {
 func (*RevokePairingResponse) ProtoMessage() {}
 
 func (x *RevokePairingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_bridge_proto_msgTypes[22]
+	mi := &file_proto_api_bridge_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1662,7 +1854,7 @@ func (x *RevokePairingResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RevokePairingResponse.ProtoReflect.Descriptor instead.
 func (*RevokePairingResponse) Descriptor() ([]byte, []int) {
-	return file_proto_api_bridge_proto_rawDescGZIP(), []int{22}
+	return file_proto_api_bridge_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *RevokePairingResponse) GetLctId() string {
@@ -1686,6 +1878,13 @@ func (x *RevokePairingResponse) GetReason() string {
 	return ""
 }
 
+func (x *RevokePairingResponse) GetTxhash() string {
+	if x != nil {
+		return x.Txhash
+	}
+	return ""
+}
+
 type GetPairingStatusRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ChallengeId   string                 `protobuf:"bytes,1,opt,name=challenge_id,json=challengeId,proto3" json:"challenge_id,omitempty"`
@@ -1695,7 +1894,7 @@ type GetPairingStatusRequest struct {
 
 func (x *GetPairingStatusRequest) Reset() {
 	*x = GetPairingStatusRequest{}
-	mi := &file_proto_api_bridge_proto_msgTypes[23]
+	mi := &file_proto_api_bridge_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1707,7 +1906,7 @@ func (x *GetPairingStatusRequest) String() string {
 func (*GetPairingStatusRequest) ProtoMessage() {}
 
 func (x *GetPairingStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_bridge_proto_msgTypes[23]
+	mi := &file_proto_api_bridge_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1720,7 +1919,7 @@ func (x *GetPairingStatusRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPairingStatusRequest.ProtoReflect.Descriptor instead.
 func (*GetPairingStatusRequest) Descriptor() ([]byte, []int) {
-	return file_proto_api_bridge_proto_rawDescGZIP(), []int{23}
+	return file_proto_api_bridge_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *GetPairingStatusRequest) GetChallengeId() string {
@@ -1735,13 +1934,15 @@ type GetPairingStatusResponse struct {
 	ChallengeId   string                 `protobuf:"bytes,1,opt,name=challenge_id,json=challengeId,proto3" json:"challenge_id,omitempty"`
 	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
 	CreatedAt     int64                  `protobuf:"varint,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	LctId         string                 `protobuf:"bytes,4,opt,name=lct_id,json=lctId,proto3" json:"lct_id,omitempty"`
+	TtlSeconds    int64                  `protobuf:"varint,5,opt,name=ttl_seconds,json=ttlSeconds,proto3" json:"ttl_seconds,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetPairingStatusResponse) Reset() {
 	*x = GetPairingStatusResponse{}
-	mi := &file_proto_api_bridge_proto_msgTypes[24]
+	mi := &file_proto_api_bridge_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1753,7 +1954,7 @@ func (x *GetPairingStatusResponse) String() string {
 func (*GetPairingStatusResponse) ProtoMessage() {}
 
 func (x *GetPairingStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_bridge_proto_msgTypes[24]
+	mi := &file_proto_api_bridge_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1766,7 +1967,7 @@ func (x *GetPairingStatusResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPairingStatusResponse.ProtoReflect.Descriptor instead.
 func (*GetPairingStatusResponse) Descriptor() ([]byte, []int) {
-	return file_proto_api_bridge_proto_rawDescGZIP(), []int{24}
+	return file_proto_api_bridge_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *GetPairingStatusResponse) GetChallengeId() string {
@@ -1790,6 +1991,20 @@ func (x *GetPairingStatusResponse) GetCreatedAt() int64 {
 	return 0
 }
 
+func (x *GetPairingStatusResponse) GetLctId() string {
+	if x != nil {
+		return x.LctId
+	}
+	return ""
+}
+
+func (x *GetPairingStatusResponse) GetTtlSeconds() int64 {
+	if x != nil {
+		return x.TtlSeconds
+	}
+	return 0
+}
+
 // Trust Tensor
 type CreateTrustTensorRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -1804,7 +2019,7 @@ type CreateTrustTensorRequest struct {
 
 func (x *CreateTrustTensorRequest) Reset() {
 	*x = CreateTrustTensorRequest{}
-	mi := &file_proto_api_bridge_proto_msgTypes[25]
+	mi := &file_proto_api_bridge_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1816,7 +2031,7 @@ func (x *CreateTrustTensorRequest) String() string {
 func (*CreateTrustTensorRequest) ProtoMessage() {}
 
 func (x *CreateTrustTensorRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_bridge_proto_msgTypes[25]
+	mi := &file_proto_api_bridge_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1829,7 +2044,7 @@ func (x *CreateTrustTensorRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateTrustTensorRequest.ProtoReflect.Descriptor instead.
 func (*CreateTrustTensorRequest) Descriptor() ([]byte, []int) {
-	return file_proto_api_bridge_proto_rawDescGZIP(), []int{25}
+	return file_proto_api_bridge_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *CreateTrustTensorRequest) GetCreator() string {
@@ -1879,7 +2094,7 @@ type CreateTrustTensorResponse struct {
 
 func (x *CreateTrustTensorResponse) Reset() {
 	*x = CreateTrustTensorResponse{}
-	mi := &file_proto_api_bridge_proto_msgTypes[26]
+	mi := &file_proto_api_bridge_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1891,7 +2106,7 @@ func (x *CreateTrustTensorResponse) String() string {
 func (*CreateTrustTensorResponse) ProtoMessage() {}
 
 func (x *CreateTrustTensorResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_bridge_proto_msgTypes[26]
+	mi := &file_proto_api_bridge_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1904,7 +2119,7 @@ func (x *CreateTrustTensorResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateTrustTensorResponse.ProtoReflect.Descriptor instead.
 func (*CreateTrustTensorResponse) Descriptor() ([]byte, []int) {
-	return file_proto_api_bridge_proto_rawDescGZIP(), []int{26}
+	return file_proto_api_bridge_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *CreateTrustTensorResponse) GetTensorId() string {
@@ -1944,7 +2159,7 @@ type GetTrustTensorRequest struct {
 
 func (x *GetTrustTensorRequest) Reset() {
 	*x = GetTrustTensorRequest{}
-	mi := &file_proto_api_bridge_proto_msgTypes[27]
+	mi := &file_proto_api_bridge_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1956,7 +2171,7 @@ func (x *GetTrustTensorRequest) String() string {
 func (*GetTrustTensorRequest) ProtoMessage() {}
 
 func (x *GetTrustTensorRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_bridge_proto_msgTypes[27]
+	mi := &file_proto_api_bridge_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1969,7 +2184,7 @@ func (x *GetTrustTensorRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTrustTensorRequest.ProtoReflect.Descriptor instead.
 func (*GetTrustTensorRequest) Descriptor() ([]byte, []int) {
-	return file_proto_api_bridge_proto_rawDescGZIP(), []int{27}
+	return file_proto_api_bridge_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *GetTrustTensorRequest) GetTensorId() string {
@@ -1990,7 +2205,7 @@ type GetTrustTensorResponse struct {
 
 func (x *GetTrustTensorResponse) Reset() {
 	*x = GetTrustTensorResponse{}
-	mi := &file_proto_api_bridge_proto_msgTypes[28]
+	mi := &file_proto_api_bridge_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2002,7 +2217,7 @@ func (x *GetTrustTensorResponse) String() string {
 func (*GetTrustTensorResponse) ProtoMessage() {}
 
 func (x *GetTrustTensorResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_bridge_proto_msgTypes[28]
+	mi := &file_proto_api_bridge_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2015,7 +2230,7 @@ func (x *GetTrustTensorResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTrustTensorResponse.ProtoReflect.Descriptor instead.
 func (*GetTrustTensorResponse) Descriptor() ([]byte, []int) {
-	return file_proto_api_bridge_proto_rawDescGZIP(), []int{28}
+	return file_proto_api_bridge_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *GetTrustTensorResponse) GetTensorId() string {
@@ -2051,7 +2266,7 @@ type UpdateTrustScoreRequest struct {
 
 func (x *UpdateTrustScoreRequest) Reset() {
 	*x = UpdateTrustScoreRequest{}
-	mi := &file_proto_api_bridge_proto_msgTypes[29]
+	mi := &file_proto_api_bridge_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2063,7 +2278,7 @@ func (x *UpdateTrustScoreRequest) String() string {
 func (*UpdateTrustScoreRequest) ProtoMessage() {}
 
 func (x *UpdateTrustScoreRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_bridge_proto_msgTypes[29]
+	mi := &file_proto_api_bridge_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2076,7 +2291,7 @@ func (x *UpdateTrustScoreRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateTrustScoreRequest.ProtoReflect.Descriptor instead.
 func (*UpdateTrustScoreRequest) Descriptor() ([]byte, []int) {
-	return file_proto_api_bridge_proto_rawDescGZIP(), []int{29}
+	return file_proto_api_bridge_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *UpdateTrustScoreRequest) GetCreator() string {
@@ -2118,7 +2333,7 @@ type UpdateTrustScoreResponse struct {
 
 func (x *UpdateTrustScoreResponse) Reset() {
 	*x = UpdateTrustScoreResponse{}
-	mi := &file_proto_api_bridge_proto_msgTypes[30]
+	mi := &file_proto_api_bridge_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2130,7 +2345,7 @@ func (x *UpdateTrustScoreResponse) String() string {
 func (*UpdateTrustScoreResponse) ProtoMessage() {}
 
 func (x *UpdateTrustScoreResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_bridge_proto_msgTypes[30]
+	mi := &file_proto_api_bridge_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2143,7 +2358,7 @@ func (x *UpdateTrustScoreResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateTrustScoreResponse.ProtoReflect.Descriptor instead.
 func (*UpdateTrustScoreResponse) Descriptor() ([]byte, []int) {
-	return file_proto_api_bridge_proto_rawDescGZIP(), []int{30}
+	return file_proto_api_bridge_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *UpdateTrustScoreResponse) GetTensorId() string {
@@ -2182,7 +2397,7 @@ type CreateEnergyOperationRequest struct {
 
 func (x *CreateEnergyOperationRequest) Reset() {
 	*x = CreateEnergyOperationRequest{}
-	mi := &file_proto_api_bridge_proto_msgTypes[31]
+	mi := &file_proto_api_bridge_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2194,7 +2409,7 @@ func (x *CreateEnergyOperationRequest) String() string {
 func (*CreateEnergyOperationRequest) ProtoMessage() {}
 
 func (x *CreateEnergyOperationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_bridge_proto_msgTypes[31]
+	mi := &file_proto_api_bridge_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2207,7 +2422,7 @@ func (x *CreateEnergyOperationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateEnergyOperationRequest.ProtoReflect.Descriptor instead.
 func (*CreateEnergyOperationRequest) Descriptor() ([]byte, []int) {
-	return file_proto_api_bridge_proto_rawDescGZIP(), []int{31}
+	return file_proto_api_bridge_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *CreateEnergyOperationRequest) GetCreator() string {
@@ -2265,7 +2480,7 @@ type CreateEnergyOperationResponse struct {
 
 func (x *CreateEnergyOperationResponse) Reset() {
 	*x = CreateEnergyOperationResponse{}
-	mi := &file_proto_api_bridge_proto_msgTypes[32]
+	mi := &file_proto_api_bridge_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2277,7 +2492,7 @@ func (x *CreateEnergyOperationResponse) String() string {
 func (*CreateEnergyOperationResponse) ProtoMessage() {}
 
 func (x *CreateEnergyOperationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_bridge_proto_msgTypes[32]
+	mi := &file_proto_api_bridge_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2290,7 +2505,7 @@ func (x *CreateEnergyOperationResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateEnergyOperationResponse.ProtoReflect.Descriptor instead.
 func (*CreateEnergyOperationResponse) Descriptor() ([]byte, []int) {
-	return file_proto_api_bridge_proto_rawDescGZIP(), []int{32}
+	return file_proto_api_bridge_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *CreateEnergyOperationResponse) GetOperationId() string {
@@ -2340,7 +2555,7 @@ type ExecuteEnergyTransferRequest struct {
 
 func (x *ExecuteEnergyTransferRequest) Reset() {
 	*x = ExecuteEnergyTransferRequest{}
-	mi := &file_proto_api_bridge_proto_msgTypes[33]
+	mi := &file_proto_api_bridge_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2352,7 +2567,7 @@ func (x *ExecuteEnergyTransferRequest) String() string {
 func (*ExecuteEnergyTransferRequest) ProtoMessage() {}
 
 func (x *ExecuteEnergyTransferRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_bridge_proto_msgTypes[33]
+	mi := &file_proto_api_bridge_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2365,7 +2580,7 @@ func (x *ExecuteEnergyTransferRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExecuteEnergyTransferRequest.ProtoReflect.Descriptor instead.
 func (*ExecuteEnergyTransferRequest) Descriptor() ([]byte, []int) {
-	return file_proto_api_bridge_proto_rawDescGZIP(), []int{33}
+	return file_proto_api_bridge_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *ExecuteEnergyTransferRequest) GetCreator() string {
@@ -2408,7 +2623,7 @@ type ExecuteEnergyTransferResponse struct {
 
 func (x *ExecuteEnergyTransferResponse) Reset() {
 	*x = ExecuteEnergyTransferResponse{}
-	mi := &file_proto_api_bridge_proto_msgTypes[34]
+	mi := &file_proto_api_bridge_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2420,7 +2635,7 @@ func (x *ExecuteEnergyTransferResponse) String() string {
 func (*ExecuteEnergyTransferResponse) ProtoMessage() {}
 
 func (x *ExecuteEnergyTransferResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_bridge_proto_msgTypes[34]
+	mi := &file_proto_api_bridge_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2433,7 +2648,7 @@ func (x *ExecuteEnergyTransferResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExecuteEnergyTransferResponse.ProtoReflect.Descriptor instead.
 func (*ExecuteEnergyTransferResponse) Descriptor() ([]byte, []int) {
-	return file_proto_api_bridge_proto_rawDescGZIP(), []int{34}
+	return file_proto_api_bridge_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *ExecuteEnergyTransferResponse) GetOperationId() string {
@@ -2473,7 +2688,7 @@ type GetEnergyBalanceRequest struct {
 
 func (x *GetEnergyBalanceRequest) Reset() {
 	*x = GetEnergyBalanceRequest{}
-	mi := &file_proto_api_bridge_proto_msgTypes[35]
+	mi := &file_proto_api_bridge_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2485,7 +2700,7 @@ func (x *GetEnergyBalanceRequest) String() string {
 func (*GetEnergyBalanceRequest) ProtoMessage() {}
 
 func (x *GetEnergyBalanceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_bridge_proto_msgTypes[35]
+	mi := &file_proto_api_bridge_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2498,7 +2713,7 @@ func (x *GetEnergyBalanceRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetEnergyBalanceRequest.ProtoReflect.Descriptor instead.
 func (*GetEnergyBalanceRequest) Descriptor() ([]byte, []int) {
-	return file_proto_api_bridge_proto_rawDescGZIP(), []int{35}
+	return file_proto_api_bridge_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *GetEnergyBalanceRequest) GetComponentId() string {
@@ -2520,7 +2735,7 @@ type GetEnergyBalanceResponse struct {
 
 func (x *GetEnergyBalanceResponse) Reset() {
 	*x = GetEnergyBalanceResponse{}
-	mi := &file_proto_api_bridge_proto_msgTypes[36]
+	mi := &file_proto_api_bridge_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2532,7 +2747,7 @@ func (x *GetEnergyBalanceResponse) String() string {
 func (*GetEnergyBalanceResponse) ProtoMessage() {}
 
 func (x *GetEnergyBalanceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_bridge_proto_msgTypes[36]
+	mi := &file_proto_api_bridge_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2545,7 +2760,7 @@ func (x *GetEnergyBalanceResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetEnergyBalanceResponse.ProtoReflect.Descriptor instead.
 func (*GetEnergyBalanceResponse) Descriptor() ([]byte, []int) {
-	return file_proto_api_bridge_proto_rawDescGZIP(), []int{36}
+	return file_proto_api_bridge_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *GetEnergyBalanceResponse) GetComponentId() string {
@@ -2589,7 +2804,7 @@ type QueuePairingRequestRequest struct {
 
 func (x *QueuePairingRequestRequest) Reset() {
 	*x = QueuePairingRequestRequest{}
-	mi := &file_proto_api_bridge_proto_msgTypes[37]
+	mi := &file_proto_api_bridge_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2601,7 +2816,7 @@ func (x *QueuePairingRequestRequest) String() string {
 func (*QueuePairingRequestRequest) ProtoMessage() {}
 
 func (x *QueuePairingRequestRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_bridge_proto_msgTypes[37]
+	mi := &file_proto_api_bridge_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2614,7 +2829,7 @@ func (x *QueuePairingRequestRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueuePairingRequestRequest.ProtoReflect.Descriptor instead.
 func (*QueuePairingRequestRequest) Descriptor() ([]byte, []int) {
-	return file_proto_api_bridge_proto_rawDescGZIP(), []int{37}
+	return file_proto_api_bridge_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *QueuePairingRequestRequest) GetComponentA() string {
@@ -2656,7 +2871,7 @@ type QueuePairingRequestResponse struct {
 
 func (x *QueuePairingRequestResponse) Reset() {
 	*x = QueuePairingRequestResponse{}
-	mi := &file_proto_api_bridge_proto_msgTypes[38]
+	mi := &file_proto_api_bridge_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2668,7 +2883,7 @@ func (x *QueuePairingRequestResponse) String() string {
 func (*QueuePairingRequestResponse) ProtoMessage() {}
 
 func (x *QueuePairingRequestResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_bridge_proto_msgTypes[38]
+	mi := &file_proto_api_bridge_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2681,7 +2896,7 @@ func (x *QueuePairingRequestResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueuePairingRequestResponse.ProtoReflect.Descriptor instead.
 func (*QueuePairingRequestResponse) Descriptor() ([]byte, []int) {
-	return file_proto_api_bridge_proto_rawDescGZIP(), []int{38}
+	return file_proto_api_bridge_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *QueuePairingRequestResponse) GetRequestId() string {
@@ -2714,7 +2929,7 @@ type GetQueueStatusRequest struct {
 
 func (x *GetQueueStatusRequest) Reset() {
 	*x = GetQueueStatusRequest{}
-	mi := &file_proto_api_bridge_proto_msgTypes[39]
+	mi := &file_proto_api_bridge_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2726,7 +2941,7 @@ func (x *GetQueueStatusRequest) String() string {
 func (*GetQueueStatusRequest) ProtoMessage() {}
 
 func (x *GetQueueStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_bridge_proto_msgTypes[39]
+	mi := &file_proto_api_bridge_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2739,7 +2954,7 @@ func (x *GetQueueStatusRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetQueueStatusRequest.ProtoReflect.Descriptor instead.
 func (*GetQueueStatusRequest) Descriptor() ([]byte, []int) {
-	return file_proto_api_bridge_proto_rawDescGZIP(), []int{39}
+	return file_proto_api_bridge_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *GetQueueStatusRequest) GetRequestId() string {
@@ -2760,7 +2975,7 @@ type GetQueueStatusResponse struct {
 
 func (x *GetQueueStatusResponse) Reset() {
 	*x = GetQueueStatusResponse{}
-	mi := &file_proto_api_bridge_proto_msgTypes[40]
+	mi := &file_proto_api_bridge_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2772,7 +2987,7 @@ func (x *GetQueueStatusResponse) String() string {
 func (*GetQueueStatusResponse) ProtoMessage() {}
 
 func (x *GetQueueStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_bridge_proto_msgTypes[40]
+	mi := &file_proto_api_bridge_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2785,7 +3000,7 @@ func (x *GetQueueStatusResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetQueueStatusResponse.ProtoReflect.Descriptor instead.
 func (*GetQueueStatusResponse) Descriptor() ([]byte, []int) {
-	return file_proto_api_bridge_proto_rawDescGZIP(), []int{40}
+	return file_proto_api_bridge_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *GetQueueStatusResponse) GetRequestId() string {
@@ -2818,7 +3033,7 @@ type ProcessOfflineQueueRequest struct {
 
 func (x *ProcessOfflineQueueRequest) Reset() {
 	*x = ProcessOfflineQueueRequest{}
-	mi := &file_proto_api_bridge_proto_msgTypes[41]
+	mi := &file_proto_api_bridge_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2830,7 +3045,7 @@ func (x *ProcessOfflineQueueRequest) String() string {
 func (*ProcessOfflineQueueRequest) ProtoMessage() {}
 
 func (x *ProcessOfflineQueueRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_bridge_proto_msgTypes[41]
+	mi := &file_proto_api_bridge_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2843,7 +3058,7 @@ func (x *ProcessOfflineQueueRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProcessOfflineQueueRequest.ProtoReflect.Descriptor instead.
 func (*ProcessOfflineQueueRequest) Descriptor() ([]byte, []int) {
-	return file_proto_api_bridge_proto_rawDescGZIP(), []int{41}
+	return file_proto_api_bridge_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *ProcessOfflineQueueRequest) GetComponentId() string {
@@ -2864,7 +3079,7 @@ type ProcessOfflineQueueResponse struct {
 
 func (x *ProcessOfflineQueueResponse) Reset() {
 	*x = ProcessOfflineQueueResponse{}
-	mi := &file_proto_api_bridge_proto_msgTypes[42]
+	mi := &file_proto_api_bridge_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2876,7 +3091,7 @@ func (x *ProcessOfflineQueueResponse) String() string {
 func (*ProcessOfflineQueueResponse) ProtoMessage() {}
 
 func (x *ProcessOfflineQueueResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_bridge_proto_msgTypes[42]
+	mi := &file_proto_api_bridge_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2889,7 +3104,7 @@ func (x *ProcessOfflineQueueResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProcessOfflineQueueResponse.ProtoReflect.Descriptor instead.
 func (*ProcessOfflineQueueResponse) Descriptor() ([]byte, []int) {
-	return file_proto_api_bridge_proto_rawDescGZIP(), []int{42}
+	return file_proto_api_bridge_proto_rawDescGZIP(), []int{45}
 }
 
 func (x *ProcessOfflineQueueResponse) GetRequestId() string {
@@ -2922,7 +3137,7 @@ type CancelRequestRequest struct {
 
 func (x *CancelRequestRequest) Reset() {
 	*x = CancelRequestRequest{}
-	mi := &file_proto_api_bridge_proto_msgTypes[43]
+	mi := &file_proto_api_bridge_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2934,7 +3149,7 @@ func (x *CancelRequestRequest) String() string {
 func (*CancelRequestRequest) ProtoMessage() {}
 
 func (x *CancelRequestRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_bridge_proto_msgTypes[43]
+	mi := &file_proto_api_bridge_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2947,7 +3162,7 @@ func (x *CancelRequestRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CancelRequestRequest.ProtoReflect.Descriptor instead.
 func (*CancelRequestRequest) Descriptor() ([]byte, []int) {
-	return file_proto_api_bridge_proto_rawDescGZIP(), []int{43}
+	return file_proto_api_bridge_proto_rawDescGZIP(), []int{46}
 }
 
 func (x *CancelRequestRequest) GetRequestId() string {
@@ -2968,7 +3183,7 @@ type CancelRequestResponse struct {
 
 func (x *CancelRequestResponse) Reset() {
 	*x = CancelRequestResponse{}
-	mi := &file_proto_api_bridge_proto_msgTypes[44]
+	mi := &file_proto_api_bridge_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2980,7 +3195,7 @@ func (x *CancelRequestResponse) String() string {
 func (*CancelRequestResponse) ProtoMessage() {}
 
 func (x *CancelRequestResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_bridge_proto_msgTypes[44]
+	mi := &file_proto_api_bridge_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2993,7 +3208,7 @@ func (x *CancelRequestResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CancelRequestResponse.ProtoReflect.Descriptor instead.
 func (*CancelRequestResponse) Descriptor() ([]byte, []int) {
-	return file_proto_api_bridge_proto_rawDescGZIP(), []int{44}
+	return file_proto_api_bridge_proto_rawDescGZIP(), []int{47}
 }
 
 func (x *CancelRequestResponse) GetRequestId() string {
@@ -3026,7 +3241,7 @@ type GetQueuedRequestsRequest struct {
 
 func (x *GetQueuedRequestsRequest) Reset() {
 	*x = GetQueuedRequestsRequest{}
-	mi := &file_proto_api_bridge_proto_msgTypes[45]
+	mi := &file_proto_api_bridge_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3038,7 +3253,7 @@ func (x *GetQueuedRequestsRequest) String() string {
 func (*GetQueuedRequestsRequest) ProtoMessage() {}
 
 func (x *GetQueuedRequestsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_bridge_proto_msgTypes[45]
+	mi := &file_proto_api_bridge_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3051,7 +3266,7 @@ func (x *GetQueuedRequestsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetQueuedRequestsRequest.ProtoReflect.Descriptor instead.
 func (*GetQueuedRequestsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_api_bridge_proto_rawDescGZIP(), []int{45}
+	return file_proto_api_bridge_proto_rawDescGZIP(), []int{48}
 }
 
 func (x *GetQueuedRequestsRequest) GetComponentId() string {
@@ -3071,7 +3286,7 @@ type GetQueuedRequestsResponse struct {
 
 func (x *GetQueuedRequestsResponse) Reset() {
 	*x = GetQueuedRequestsResponse{}
-	mi := &file_proto_api_bridge_proto_msgTypes[46]
+	mi := &file_proto_api_bridge_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3083,7 +3298,7 @@ func (x *GetQueuedRequestsResponse) String() string {
 func (*GetQueuedRequestsResponse) ProtoMessage() {}
 
 func (x *GetQueuedRequestsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_bridge_proto_msgTypes[46]
+	mi := &file_proto_api_bridge_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3096,7 +3311,7 @@ func (x *GetQueuedRequestsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetQueuedRequestsResponse.ProtoReflect.Descriptor instead.
 func (*GetQueuedRequestsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_api_bridge_proto_rawDescGZIP(), []int{46}
+	return file_proto_api_bridge_proto_rawDescGZIP(), []int{49}
 }
 
 func (x *GetQueuedRequestsResponse) GetRequests() []*QueuedRequest {
@@ -3122,7 +3337,7 @@ type ListProxyQueueRequest struct {
 
 func (x *ListProxyQueueRequest) Reset() {
 	*x = ListProxyQueueRequest{}
-	mi := &file_proto_api_bridge_proto_msgTypes[47]
+	mi := &file_proto_api_bridge_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3134,7 +3349,7 @@ func (x *ListProxyQueueRequest) String() string {
 func (*ListProxyQueueRequest) ProtoMessage() {}
 
 func (x *ListProxyQueueRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_bridge_proto_msgTypes[47]
+	mi := &file_proto_api_bridge_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3147,7 +3362,7 @@ func (x *ListProxyQueueRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListProxyQueueRequest.ProtoReflect.Descriptor instead.
 func (*ListProxyQueueRequest) Descriptor() ([]byte, []int) {
-	return file_proto_api_bridge_proto_rawDescGZIP(), []int{47}
+	return file_proto_api_bridge_proto_rawDescGZIP(), []int{50}
 }
 
 func (x *ListProxyQueueRequest) GetProxyId() string {
@@ -3167,7 +3382,7 @@ type ListProxyQueueResponse struct {
 
 func (x *ListProxyQueueResponse) Reset() {
 	*x = ListProxyQueueResponse{}
-	mi := &file_proto_api_bridge_proto_msgTypes[48]
+	mi := &file_proto_api_bridge_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3179,7 +3394,7 @@ func (x *ListProxyQueueResponse) String() string {
 func (*ListProxyQueueResponse) ProtoMessage() {}
 
 func (x *ListProxyQueueResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_bridge_proto_msgTypes[48]
+	mi := &file_proto_api_bridge_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3192,7 +3407,7 @@ func (x *ListProxyQueueResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListProxyQueueResponse.ProtoReflect.Descriptor instead.
 func (*ListProxyQueueResponse) Descriptor() ([]byte, []int) {
-	return file_proto_api_bridge_proto_rawDescGZIP(), []int{48}
+	return file_proto_api_bridge_proto_rawDescGZIP(), []int{51}
 }
 
 func (x *ListProxyQueueResponse) GetRequests() []*QueuedRequest {
@@ -3223,7 +3438,7 @@ type QueuedRequest struct {
 
 func (x *QueuedRequest) Reset() {
 	*x = QueuedRequest{}
-	mi := &file_proto_api_bridge_proto_msgTypes[49]
+	mi := &file_proto_api_bridge_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3235,7 +3450,7 @@ func (x *QueuedRequest) String() string {
 func (*QueuedRequest) ProtoMessage() {}
 
 func (x *QueuedRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_bridge_proto_msgTypes[49]
+	mi := &file_proto_api_bridge_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3248,7 +3463,7 @@ func (x *QueuedRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueuedRequest.ProtoReflect.Descriptor instead.
 func (*QueuedRequest) Descriptor() ([]byte, []int) {
-	return file_proto_api_bridge_proto_rawDescGZIP(), []int{49}
+	return file_proto_api_bridge_proto_rawDescGZIP(), []int{52}
 }
 
 func (x *QueuedRequest) GetRequestId() string {
@@ -3306,7 +3521,7 @@ type CreatePairingAuthorizationRequest struct {
 
 func (x *CreatePairingAuthorizationRequest) Reset() {
 	*x = CreatePairingAuthorizationRequest{}
-	mi := &file_proto_api_bridge_proto_msgTypes[50]
+	mi := &file_proto_api_bridge_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3318,7 +3533,7 @@ func (x *CreatePairingAuthorizationRequest) String() string {
 func (*CreatePairingAuthorizationRequest) ProtoMessage() {}
 
 func (x *CreatePairingAuthorizationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_bridge_proto_msgTypes[50]
+	mi := &file_proto_api_bridge_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3331,7 +3546,7 @@ func (x *CreatePairingAuthorizationRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use CreatePairingAuthorizationRequest.ProtoReflect.Descriptor instead.
 func (*CreatePairingAuthorizationRequest) Descriptor() ([]byte, []int) {
-	return file_proto_api_bridge_proto_rawDescGZIP(), []int{50}
+	return file_proto_api_bridge_proto_rawDescGZIP(), []int{53}
 }
 
 func (x *CreatePairingAuthorizationRequest) GetComponentA() string {
@@ -3373,7 +3588,7 @@ type CreatePairingAuthorizationResponse struct {
 
 func (x *CreatePairingAuthorizationResponse) Reset() {
 	*x = CreatePairingAuthorizationResponse{}
-	mi := &file_proto_api_bridge_proto_msgTypes[51]
+	mi := &file_proto_api_bridge_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3385,7 +3600,7 @@ func (x *CreatePairingAuthorizationResponse) String() string {
 func (*CreatePairingAuthorizationResponse) ProtoMessage() {}
 
 func (x *CreatePairingAuthorizationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_bridge_proto_msgTypes[51]
+	mi := &file_proto_api_bridge_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3398,7 +3613,7 @@ func (x *CreatePairingAuthorizationResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use CreatePairingAuthorizationResponse.ProtoReflect.Descriptor instead.
 func (*CreatePairingAuthorizationResponse) Descriptor() ([]byte, []int) {
-	return file_proto_api_bridge_proto_rawDescGZIP(), []int{51}
+	return file_proto_api_bridge_proto_rawDescGZIP(), []int{54}
 }
 
 func (x *CreatePairingAuthorizationResponse) GetAuthorizationId() string {
@@ -3431,7 +3646,7 @@ type GetComponentAuthorizationsRequest struct {
 
 func (x *GetComponentAuthorizationsRequest) Reset() {
 	*x = GetComponentAuthorizationsRequest{}
-	mi := &file_proto_api_bridge_proto_msgTypes[52]
+	mi := &file_proto_api_bridge_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3443,7 +3658,7 @@ func (x *GetComponentAuthorizationsRequest) String() string {
 func (*GetComponentAuthorizationsRequest) ProtoMessage() {}
 
 func (x *GetComponentAuthorizationsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_bridge_proto_msgTypes[52]
+	mi := &file_proto_api_bridge_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3456,7 +3671,7 @@ func (x *GetComponentAuthorizationsRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use GetComponentAuthorizationsRequest.ProtoReflect.Descriptor instead.
 func (*GetComponentAuthorizationsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_api_bridge_proto_rawDescGZIP(), []int{52}
+	return file_proto_api_bridge_proto_rawDescGZIP(), []int{55}
 }
 
 func (x *GetComponentAuthorizationsRequest) GetComponentId() string {
@@ -3476,7 +3691,7 @@ type GetComponentAuthorizationsResponse struct {
 
 func (x *GetComponentAuthorizationsResponse) Reset() {
 	*x = GetComponentAuthorizationsResponse{}
-	mi := &file_proto_api_bridge_proto_msgTypes[53]
+	mi := &file_proto_api_bridge_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3488,7 +3703,7 @@ func (x *GetComponentAuthorizationsResponse) String() string {
 func (*GetComponentAuthorizationsResponse) ProtoMessage() {}
 
 func (x *GetComponentAuthorizationsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_bridge_proto_msgTypes[53]
+	mi := &file_proto_api_bridge_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3501,7 +3716,7 @@ func (x *GetComponentAuthorizationsResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use GetComponentAuthorizationsResponse.ProtoReflect.Descriptor instead.
 func (*GetComponentAuthorizationsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_api_bridge_proto_rawDescGZIP(), []int{53}
+	return file_proto_api_bridge_proto_rawDescGZIP(), []int{56}
 }
 
 func (x *GetComponentAuthorizationsResponse) GetAuthorization() []*Authorization {
@@ -3533,7 +3748,7 @@ type Authorization struct {
 
 func (x *Authorization) Reset() {
 	*x = Authorization{}
-	mi := &file_proto_api_bridge_proto_msgTypes[54]
+	mi := &file_proto_api_bridge_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3545,7 +3760,7 @@ func (x *Authorization) String() string {
 func (*Authorization) ProtoMessage() {}
 
 func (x *Authorization) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_bridge_proto_msgTypes[54]
+	mi := &file_proto_api_bridge_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3558,7 +3773,7 @@ func (x *Authorization) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Authorization.ProtoReflect.Descriptor instead.
 func (*Authorization) Descriptor() ([]byte, []int) {
-	return file_proto_api_bridge_proto_rawDescGZIP(), []int{54}
+	return file_proto_api_bridge_proto_rawDescGZIP(), []int{57}
 }
 
 func (x *Authorization) GetAuthorizationId() string {
@@ -3621,7 +3836,7 @@ type UpdateAuthorizationRequest struct {
 
 func (x *UpdateAuthorizationRequest) Reset() {
 	*x = UpdateAuthorizationRequest{}
-	mi := &file_proto_api_bridge_proto_msgTypes[55]
+	mi := &file_proto_api_bridge_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3633,7 +3848,7 @@ func (x *UpdateAuthorizationRequest) String() string {
 func (*UpdateAuthorizationRequest) ProtoMessage() {}
 
 func (x *UpdateAuthorizationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_bridge_proto_msgTypes[55]
+	mi := &file_proto_api_bridge_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3646,7 +3861,7 @@ func (x *UpdateAuthorizationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateAuthorizationRequest.ProtoReflect.Descriptor instead.
 func (*UpdateAuthorizationRequest) Descriptor() ([]byte, []int) {
-	return file_proto_api_bridge_proto_rawDescGZIP(), []int{55}
+	return file_proto_api_bridge_proto_rawDescGZIP(), []int{58}
 }
 
 func (x *UpdateAuthorizationRequest) GetAuthorizationId() string {
@@ -3681,7 +3896,7 @@ type UpdateAuthorizationResponse struct {
 
 func (x *UpdateAuthorizationResponse) Reset() {
 	*x = UpdateAuthorizationResponse{}
-	mi := &file_proto_api_bridge_proto_msgTypes[56]
+	mi := &file_proto_api_bridge_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3693,7 +3908,7 @@ func (x *UpdateAuthorizationResponse) String() string {
 func (*UpdateAuthorizationResponse) ProtoMessage() {}
 
 func (x *UpdateAuthorizationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_bridge_proto_msgTypes[56]
+	mi := &file_proto_api_bridge_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3706,7 +3921,7 @@ func (x *UpdateAuthorizationResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateAuthorizationResponse.ProtoReflect.Descriptor instead.
 func (*UpdateAuthorizationResponse) Descriptor() ([]byte, []int) {
-	return file_proto_api_bridge_proto_rawDescGZIP(), []int{56}
+	return file_proto_api_bridge_proto_rawDescGZIP(), []int{59}
 }
 
 func (x *UpdateAuthorizationResponse) GetAuthorizationId() string {
@@ -3740,7 +3955,7 @@ type RevokeAuthorizationRequest struct {
 
 func (x *RevokeAuthorizationRequest) Reset() {
 	*x = RevokeAuthorizationRequest{}
-	mi := &file_proto_api_bridge_proto_msgTypes[57]
+	mi := &file_proto_api_bridge_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3752,7 +3967,7 @@ func (x *RevokeAuthorizationRequest) String() string {
 func (*RevokeAuthorizationRequest) ProtoMessage() {}
 
 func (x *RevokeAuthorizationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_bridge_proto_msgTypes[57]
+	mi := &file_proto_api_bridge_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3765,7 +3980,7 @@ func (x *RevokeAuthorizationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RevokeAuthorizationRequest.ProtoReflect.Descriptor instead.
 func (*RevokeAuthorizationRequest) Descriptor() ([]byte, []int) {
-	return file_proto_api_bridge_proto_rawDescGZIP(), []int{57}
+	return file_proto_api_bridge_proto_rawDescGZIP(), []int{60}
 }
 
 func (x *RevokeAuthorizationRequest) GetAuthorizationId() string {
@@ -3793,7 +4008,7 @@ type RevokeAuthorizationResponse struct {
 
 func (x *RevokeAuthorizationResponse) Reset() {
 	*x = RevokeAuthorizationResponse{}
-	mi := &file_proto_api_bridge_proto_msgTypes[58]
+	mi := &file_proto_api_bridge_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3805,7 +4020,7 @@ func (x *RevokeAuthorizationResponse) String() string {
 func (*RevokeAuthorizationResponse) ProtoMessage() {}
 
 func (x *RevokeAuthorizationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_bridge_proto_msgTypes[58]
+	mi := &file_proto_api_bridge_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3818,7 +4033,7 @@ func (x *RevokeAuthorizationResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RevokeAuthorizationResponse.ProtoReflect.Descriptor instead.
 func (*RevokeAuthorizationResponse) Descriptor() ([]byte, []int) {
-	return file_proto_api_bridge_proto_rawDescGZIP(), []int{58}
+	return file_proto_api_bridge_proto_rawDescGZIP(), []int{61}
 }
 
 func (x *RevokeAuthorizationResponse) GetAuthorizationId() string {
@@ -3853,7 +4068,7 @@ type CheckPairingAuthorizationRequest struct {
 
 func (x *CheckPairingAuthorizationRequest) Reset() {
 	*x = CheckPairingAuthorizationRequest{}
-	mi := &file_proto_api_bridge_proto_msgTypes[59]
+	mi := &file_proto_api_bridge_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3865,7 +4080,7 @@ func (x *CheckPairingAuthorizationRequest) String() string {
 func (*CheckPairingAuthorizationRequest) ProtoMessage() {}
 
 func (x *CheckPairingAuthorizationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_bridge_proto_msgTypes[59]
+	mi := &file_proto_api_bridge_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3878,7 +4093,7 @@ func (x *CheckPairingAuthorizationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CheckPairingAuthorizationRequest.ProtoReflect.Descriptor instead.
 func (*CheckPairingAuthorizationRequest) Descriptor() ([]byte, []int) {
-	return file_proto_api_bridge_proto_rawDescGZIP(), []int{59}
+	return file_proto_api_bridge_proto_rawDescGZIP(), []int{62}
 }
 
 func (x *CheckPairingAuthorizationRequest) GetComponentA() string {
@@ -3913,7 +4128,7 @@ type CheckPairingAuthorizationResponse struct {
 
 func (x *CheckPairingAuthorizationResponse) Reset() {
 	*x = CheckPairingAuthorizationResponse{}
-	mi := &file_proto_api_bridge_proto_msgTypes[60]
+	mi := &file_proto_api_bridge_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3925,7 +4140,7 @@ func (x *CheckPairingAuthorizationResponse) String() string {
 func (*CheckPairingAuthorizationResponse) ProtoMessage() {}
 
 func (x *CheckPairingAuthorizationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_bridge_proto_msgTypes[60]
+	mi := &file_proto_api_bridge_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3938,7 +4153,7 @@ func (x *CheckPairingAuthorizationResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use CheckPairingAuthorizationResponse.ProtoReflect.Descriptor instead.
 func (*CheckPairingAuthorizationResponse) Descriptor() ([]byte, []int) {
-	return file_proto_api_bridge_proto_rawDescGZIP(), []int{60}
+	return file_proto_api_bridge_proto_rawDescGZIP(), []int{63}
 }
 
 func (x *CheckPairingAuthorizationResponse) GetAuthorizationId() string {
@@ -3974,7 +4189,7 @@ type CalculateRelationshipTrustRequest struct {
 
 func (x *CalculateRelationshipTrustRequest) Reset() {
 	*x = CalculateRelationshipTrustRequest{}
-	mi := &file_proto_api_bridge_proto_msgTypes[61]
+	mi := &file_proto_api_bridge_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3986,7 +4201,7 @@ func (x *CalculateRelationshipTrustRequest) String() string {
 func (*CalculateRelationshipTrustRequest) ProtoMessage() {}
 
 func (x *CalculateRelationshipTrustRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_bridge_proto_msgTypes[61]
+	mi := &file_proto_api_bridge_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3999,7 +4214,7 @@ func (x *CalculateRelationshipTrustRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use CalculateRelationshipTrustRequest.ProtoReflect.Descriptor instead.
 func (*CalculateRelationshipTrustRequest) Descriptor() ([]byte, []int) {
-	return file_proto_api_bridge_proto_rawDescGZIP(), []int{61}
+	return file_proto_api_bridge_proto_rawDescGZIP(), []int{64}
 }
 
 func (x *CalculateRelationshipTrustRequest) GetComponentA() string {
@@ -4035,7 +4250,7 @@ type CalculateRelationshipTrustResponse struct {
 
 func (x *CalculateRelationshipTrustResponse) Reset() {
 	*x = CalculateRelationshipTrustResponse{}
-	mi := &file_proto_api_bridge_proto_msgTypes[62]
+	mi := &file_proto_api_bridge_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4047,7 +4262,7 @@ func (x *CalculateRelationshipTrustResponse) String() string {
 func (*CalculateRelationshipTrustResponse) ProtoMessage() {}
 
 func (x *CalculateRelationshipTrustResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_bridge_proto_msgTypes[62]
+	mi := &file_proto_api_bridge_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4060,7 +4275,7 @@ func (x *CalculateRelationshipTrustResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use CalculateRelationshipTrustResponse.ProtoReflect.Descriptor instead.
 func (*CalculateRelationshipTrustResponse) Descriptor() ([]byte, []int) {
-	return file_proto_api_bridge_proto_rawDescGZIP(), []int{62}
+	return file_proto_api_bridge_proto_rawDescGZIP(), []int{65}
 }
 
 func (x *CalculateRelationshipTrustResponse) GetTensorId() string {
@@ -4100,7 +4315,7 @@ type GetRelationshipTensorRequest struct {
 
 func (x *GetRelationshipTensorRequest) Reset() {
 	*x = GetRelationshipTensorRequest{}
-	mi := &file_proto_api_bridge_proto_msgTypes[63]
+	mi := &file_proto_api_bridge_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4112,7 +4327,7 @@ func (x *GetRelationshipTensorRequest) String() string {
 func (*GetRelationshipTensorRequest) ProtoMessage() {}
 
 func (x *GetRelationshipTensorRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_bridge_proto_msgTypes[63]
+	mi := &file_proto_api_bridge_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4125,7 +4340,7 @@ func (x *GetRelationshipTensorRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetRelationshipTensorRequest.ProtoReflect.Descriptor instead.
 func (*GetRelationshipTensorRequest) Descriptor() ([]byte, []int) {
-	return file_proto_api_bridge_proto_rawDescGZIP(), []int{63}
+	return file_proto_api_bridge_proto_rawDescGZIP(), []int{66}
 }
 
 func (x *GetRelationshipTensorRequest) GetTensorId() string {
@@ -4146,7 +4361,7 @@ type GetRelationshipTensorResponse struct {
 
 func (x *GetRelationshipTensorResponse) Reset() {
 	*x = GetRelationshipTensorResponse{}
-	mi := &file_proto_api_bridge_proto_msgTypes[64]
+	mi := &file_proto_api_bridge_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4158,7 +4373,7 @@ func (x *GetRelationshipTensorResponse) String() string {
 func (*GetRelationshipTensorResponse) ProtoMessage() {}
 
 func (x *GetRelationshipTensorResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_bridge_proto_msgTypes[64]
+	mi := &file_proto_api_bridge_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4171,7 +4386,7 @@ func (x *GetRelationshipTensorResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetRelationshipTensorResponse.ProtoReflect.Descriptor instead.
 func (*GetRelationshipTensorResponse) Descriptor() ([]byte, []int) {
-	return file_proto_api_bridge_proto_rawDescGZIP(), []int{64}
+	return file_proto_api_bridge_proto_rawDescGZIP(), []int{67}
 }
 
 func (x *GetRelationshipTensorResponse) GetTensorId() string {
@@ -4207,7 +4422,7 @@ type UpdateTensorScoreRequest struct {
 
 func (x *UpdateTensorScoreRequest) Reset() {
 	*x = UpdateTensorScoreRequest{}
-	mi := &file_proto_api_bridge_proto_msgTypes[65]
+	mi := &file_proto_api_bridge_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4219,7 +4434,7 @@ func (x *UpdateTensorScoreRequest) String() string {
 func (*UpdateTensorScoreRequest) ProtoMessage() {}
 
 func (x *UpdateTensorScoreRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_bridge_proto_msgTypes[65]
+	mi := &file_proto_api_bridge_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4232,7 +4447,7 @@ func (x *UpdateTensorScoreRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateTensorScoreRequest.ProtoReflect.Descriptor instead.
 func (*UpdateTensorScoreRequest) Descriptor() ([]byte, []int) {
-	return file_proto_api_bridge_proto_rawDescGZIP(), []int{65}
+	return file_proto_api_bridge_proto_rawDescGZIP(), []int{68}
 }
 
 func (x *UpdateTensorScoreRequest) GetCreator() string {
@@ -4275,7 +4490,7 @@ type UpdateTensorScoreResponse struct {
 
 func (x *UpdateTensorScoreResponse) Reset() {
 	*x = UpdateTensorScoreResponse{}
-	mi := &file_proto_api_bridge_proto_msgTypes[66]
+	mi := &file_proto_api_bridge_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4287,7 +4502,7 @@ func (x *UpdateTensorScoreResponse) String() string {
 func (*UpdateTensorScoreResponse) ProtoMessage() {}
 
 func (x *UpdateTensorScoreResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_bridge_proto_msgTypes[66]
+	mi := &file_proto_api_bridge_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4300,7 +4515,7 @@ func (x *UpdateTensorScoreResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateTensorScoreResponse.ProtoReflect.Descriptor instead.
 func (*UpdateTensorScoreResponse) Descriptor() ([]byte, []int) {
-	return file_proto_api_bridge_proto_rawDescGZIP(), []int{66}
+	return file_proto_api_bridge_proto_rawDescGZIP(), []int{69}
 }
 
 func (x *UpdateTensorScoreResponse) GetTensorId() string {
@@ -4342,7 +4557,7 @@ type StreamBatteryStatusRequest struct {
 
 func (x *StreamBatteryStatusRequest) Reset() {
 	*x = StreamBatteryStatusRequest{}
-	mi := &file_proto_api_bridge_proto_msgTypes[67]
+	mi := &file_proto_api_bridge_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4354,7 +4569,7 @@ func (x *StreamBatteryStatusRequest) String() string {
 func (*StreamBatteryStatusRequest) ProtoMessage() {}
 
 func (x *StreamBatteryStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_bridge_proto_msgTypes[67]
+	mi := &file_proto_api_bridge_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4367,7 +4582,7 @@ func (x *StreamBatteryStatusRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StreamBatteryStatusRequest.ProtoReflect.Descriptor instead.
 func (*StreamBatteryStatusRequest) Descriptor() ([]byte, []int) {
-	return file_proto_api_bridge_proto_rawDescGZIP(), []int{67}
+	return file_proto_api_bridge_proto_rawDescGZIP(), []int{70}
 }
 
 func (x *StreamBatteryStatusRequest) GetComponentId() string {
@@ -4398,7 +4613,7 @@ type BatteryStatusUpdate struct {
 
 func (x *BatteryStatusUpdate) Reset() {
 	*x = BatteryStatusUpdate{}
-	mi := &file_proto_api_bridge_proto_msgTypes[68]
+	mi := &file_proto_api_bridge_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4410,7 +4625,7 @@ func (x *BatteryStatusUpdate) String() string {
 func (*BatteryStatusUpdate) ProtoMessage() {}
 
 func (x *BatteryStatusUpdate) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_bridge_proto_msgTypes[68]
+	mi := &file_proto_api_bridge_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4423,7 +4638,7 @@ func (x *BatteryStatusUpdate) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BatteryStatusUpdate.ProtoReflect.Descriptor instead.
 func (*BatteryStatusUpdate) Descriptor() ([]byte, []int) {
-	return file_proto_api_bridge_proto_rawDescGZIP(), []int{68}
+	return file_proto_api_bridge_proto_rawDescGZIP(), []int{71}
 }
 
 func (x *BatteryStatusUpdate) GetComponentId() string {
@@ -4480,11 +4695,12 @@ const file_proto_api_bridge_proto_rawDesc = "" +
 	"\aAccount\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
 	"\aaddress\x18\x02 \x01(\tR\aaddress\x12\x19\n" +
-	"\bkey_type\x18\x03 \x01(\tR\akeyType\"u\n" +
+	"\bkey_type\x18\x03 \x01(\tR\akeyType\"\x8d\x01\n" +
 	"\x18RegisterComponentRequest\x12\x18\n" +
 	"\acreator\x18\x01 \x01(\tR\acreator\x12%\n" +
 	"\x0ecomponent_data\x18\x02 \x01(\tR\rcomponentData\x12\x18\n" +
-	"\acontext\x18\x03 \x01(\tR\acontext\"\x8f\x02\n" +
+	"\acontext\x18\x03 \x01(\tR\acontext\x12\x16\n" +
+	"\x06dedupe\x18\x04 \x01(\bR\x06dedupe\"\xa9\x02\n" +
 	"\x19RegisterComponentResponse\x12!\n" +
 	"\fcomponent_id\x18\x01 \x01(\tR\vcomponentId\x12-\n" +
 	"\x12component_identity\x18\x02 \x01(\tR\x11componentIdentity\x12%\n" +
@@ -4493,7 +4709,8 @@ const file_proto_api_bridge_proto_rawDesc = "" +
 	"\acreator\x18\x05 \x01(\tR\acreator\x12\x15\n" +
 	"\x06lct_id\x18\x06 \x01(\tR\x05lctId\x12\x16\n" +
 	"\x06status\x18\a \x01(\tR\x06status\x12\x16\n" +
-	"\x06txhash\x18\b \x01(\tR\x06txhash\"8\n" +
+	"\x06txhash\x18\b \x01(\tR\x06txhash\x12\x18\n" +
+	"\acreated\x18\t \x01(\bR\acreated\"8\n" +
 	"\x13GetComponentRequest\x12!\n" +
 	"\fcomponent_id\x18\x01 \x01(\tR\vcomponentId\"\xc4\x01\n" +
 	"\x14GetComponentResponse\x12!\n" +
@@ -4571,7 +4788,20 @@ const file_proto_api_bridge_proto_rawDesc = "" +
 	"\x06lct_id\x18\x01 \x01(\tR\x05lctId\x12\x16\n" +
 	"\x06status\x18\x02 \x01(\tR\x06status\x12\x1d\n" +
 	"\n" +
-	"updated_at\x18\x03 \x01(\x03R\tupdatedAt\"\xe9\x01\n" +
+	"updated_at\x18\x03 \x01(\x03R\tupdatedAt\"]\n" +
+	" GetComponentRelationshipsRequest\x12!\n" +
+	"\fcomponent_id\x18\x01 \x01(\tR\vcomponentId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\"\x9d\x01\n" +
+	"!GetComponentRelationshipsResponse\x12!\n" +
+	"\fcomponent_id\x18\x01 \x01(\tR\vcomponentId\x12?\n" +
+	"\rrelationships\x18\x02 \x03(\v2\x19.api_bridge.v1.LCTSummaryR\rrelationships\x12\x14\n" +
+	"\x05count\x18\x03 \x01(\x05R\x05count\"\xa7\x01\n" +
+	"\n" +
+	"LCTSummary\x12\x15\n" +
+	"\x06lct_id\x18\x01 \x01(\tR\x05lctId\x12*\n" +
+	"\x11peer_component_id\x18\x02 \x01(\tR\x0fpeerComponentId\x12%\n" +
+	"\x0epairing_status\x18\x03 \x01(\tR\rpairingStatus\x12/\n" +
+	"\x13operational_context\x18\x04 \x01(\tR\x12operationalContext\"\xe9\x01\n" +
 	"\x16InitiatePairingRequest\x12\x18\n" +
 	"\acreator\x18\x01 \x01(\tR\acreator\x12\x1f\n" +
 	"\vcomponent_a\x18\x02 \x01(\tR\n" +
@@ -4601,30 +4831,33 @@ const file_proto_api_bridge_proto_rawDesc = "" +
 	"\fchallenge_id\x18\x02 \x01(\tR\vchallengeId\x12(\n" +
 	"\x10component_a_auth\x18\x03 \x01(\tR\x0ecomponentAAuth\x12(\n" +
 	"\x10component_b_auth\x18\x04 \x01(\tR\x0ecomponentBAuth\x12'\n" +
-	"\x0fsession_context\x18\x05 \x01(\tR\x0esessionContext\"\xd0\x01\n" +
+	"\x0fsession_context\x18\x05 \x01(\tR\x0esessionContext\"\xdb\x01\n" +
 	"\x17CompletePairingResponse\x12\x15\n" +
 	"\x06lct_id\x18\x01 \x01(\tR\x05lctId\x12!\n" +
 	"\fsession_keys\x18\x02 \x01(\tR\vsessionKeys\x12#\n" +
 	"\rtrust_summary\x18\x03 \x01(\tR\ftrustSummary\x12\x16\n" +
-	"\x06txhash\x18\x04 \x01(\tR\x06txhash\x12\x1e\n" +
-	"\vsplit_key_a\x18\x05 \x01(\tR\tsplitKeyA\x12\x1e\n" +
-	"\vsplit_key_b\x18\x06 \x01(\tR\tsplitKeyB\"\x86\x01\n" +
+	"\x06txhash\x18\x04 \x01(\tR\x06txhash\x12#\n" +
+	"\rkey_reference\x18\a \x01(\tR\fkeyReferenceJ\x04\b\x05\x10\x06J\x04\b\x06\x10\aR\vsplit_key_aR\vsplit_key_b\"\x86\x01\n" +
 	"\x14RevokePairingRequest\x12\x18\n" +
 	"\acreator\x18\x01 \x01(\tR\acreator\x12\x15\n" +
 	"\x06lct_id\x18\x02 \x01(\tR\x05lctId\x12\x16\n" +
 	"\x06reason\x18\x03 \x01(\tR\x06reason\x12%\n" +
-	"\x0enotify_offline\x18\x04 \x01(\bR\rnotifyOffline\"^\n" +
+	"\x0enotify_offline\x18\x04 \x01(\bR\rnotifyOffline\"v\n" +
 	"\x15RevokePairingResponse\x12\x15\n" +
 	"\x06lct_id\x18\x01 \x01(\tR\x05lctId\x12\x16\n" +
 	"\x06status\x18\x02 \x01(\tR\x06status\x12\x16\n" +
-	"\x06reason\x18\x03 \x01(\tR\x06reason\"<\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12\x16\n" +
+	"\x06txhash\x18\x04 \x01(\tR\x06txhash\"<\n" +
 	"\x17GetPairingStatusRequest\x12!\n" +
-	"\fchallenge_id\x18\x01 \x01(\tR\vchallengeId\"t\n" +
+	"\fchallenge_id\x18\x01 \x01(\tR\vchallengeId\"\xac\x01\n" +
 	"\x18GetPairingStatusResponse\x12!\n" +
 	"\fchallenge_id\x18\x01 \x01(\tR\vchallengeId\x12\x16\n" +
 	"\x06status\x18\x02 \x01(\tR\x06status\x12\x1d\n" +
 	"\n" +
-	"created_at\x18\x03 \x01(\x03R\tcreatedAt\"\xb5\x01\n" +
+	"created_at\x18\x03 \x01(\x03R\tcreatedAt\x12\x15\n" +
+	"\x06lct_id\x18\x04 \x01(\tR\x05lctId\x12\x1f\n" +
+	"\vttl_seconds\x18\x05 \x01(\x03R\n" +
+	"ttlSeconds\"\xb5\x01\n" +
 	"\x18CreateTrustTensorRequest\x12\x18\n" +
 	"\acreator\x18\x01 \x01(\tR\acreator\x12\x1f\n" +
 	"\vcomponent_a\x18\x02 \x01(\tR\n" +
@@ -4836,7 +5069,7 @@ const file_proto_api_bridge_proto_rawDesc = "" +
 	"\acurrent\x18\x03 \x01(\x01R\acurrent\x12 \n" +
 	"\vtemperature\x18\x04 \x01(\x01R\vtemperature\x12&\n" +
 	"\x0fstate_of_charge\x18\x05 \x01(\x01R\rstateOfCharge\x12\x1c\n" +
-	"\ttimestamp\x18\x06 \x01(\x03R\ttimestamp2\xfd\x1a\n" +
+	"\ttimestamp\x18\x06 \x01(\x03R\ttimestamp2\xfd\x1b\n" +
 	"\x10APIBridgeService\x12T\n" +
 	"\vGetAccounts\x12!.api_bridge.v1.GetAccountsRequest\x1a\".api_bridge.v1.GetAccountsResponse\x12f\n" +
 	"\x11RegisterComponent\x12'.api_bridge.v1.RegisterComponentRequest\x1a(.api_bridge.v1.RegisterComponentResponse\x12W\n" +
@@ -4845,7 +5078,8 @@ const file_proto_api_bridge_proto_rawDesc = "" +
 	"\x0fVerifyComponent\x12%.api_bridge.v1.VerifyComponentRequest\x1a&.api_bridge.v1.VerifyComponentResponse\x12N\n" +
 	"\tCreateLCT\x12\x1f.api_bridge.v1.CreateLCTRequest\x1a .api_bridge.v1.CreateLCTResponse\x12E\n" +
 	"\x06GetLCT\x12\x1c.api_bridge.v1.GetLCTRequest\x1a\x1d.api_bridge.v1.GetLCTResponse\x12`\n" +
-	"\x0fUpdateLCTStatus\x12%.api_bridge.v1.UpdateLCTStatusRequest\x1a&.api_bridge.v1.UpdateLCTStatusResponse\x12`\n" +
+	"\x0fUpdateLCTStatus\x12%.api_bridge.v1.UpdateLCTStatusRequest\x1a&.api_bridge.v1.UpdateLCTStatusResponse\x12~\n" +
+	"\x19GetComponentRelationships\x12/.api_bridge.v1.GetComponentRelationshipsRequest\x1a0.api_bridge.v1.GetComponentRelationshipsResponse\x12`\n" +
 	"\x0fInitiatePairing\x12%.api_bridge.v1.InitiatePairingRequest\x1a&.api_bridge.v1.InitiatePairingResponse\x12`\n" +
 	"\x0fCompletePairing\x12%.api_bridge.v1.CompletePairingRequest\x1a&.api_bridge.v1.CompletePairingResponse\x12Z\n" +
 	"\rRevokePairing\x12#.api_bridge.v1.RevokePairingRequest\x1a$.api_bridge.v1.RevokePairingResponse\x12c\n" +
@@ -4885,7 +5119,7 @@ func file_proto_api_bridge_proto_rawDescGZIP() []byte {
 	return file_proto_api_bridge_proto_rawDescData
 }
 
-var file_proto_api_bridge_proto_msgTypes = make([]protoimpl.MessageInfo, 69)
+var file_proto_api_bridge_proto_msgTypes = make([]protoimpl.MessageInfo, 72)
 var file_proto_api_bridge_proto_goTypes = []any{
 	(*GetAccountsRequest)(nil),                 // 0: api_bridge.v1.GetAccountsRequest
 	(*GetAccountsResponse)(nil),                // 1: api_bridge.v1.GetAccountsResponse
@@ -4904,135 +5138,141 @@ var file_proto_api_bridge_proto_goTypes = []any{
 	(*GetLCTResponse)(nil),                     // 14: api_bridge.v1.GetLCTResponse
 	(*UpdateLCTStatusRequest)(nil),             // 15: api_bridge.v1.UpdateLCTStatusRequest
 	(*UpdateLCTStatusResponse)(nil),            // 16: api_bridge.v1.UpdateLCTStatusResponse
-	(*InitiatePairingRequest)(nil),             // 17: api_bridge.v1.InitiatePairingRequest
-	(*InitiatePairingResponse)(nil),            // 18: api_bridge.v1.InitiatePairingResponse
-	(*CompletePairingRequest)(nil),             // 19: api_bridge.v1.CompletePairingRequest
-	(*CompletePairingResponse)(nil),            // 20: api_bridge.v1.CompletePairingResponse
-	(*RevokePairingRequest)(nil),               // 21: api_bridge.v1.RevokePairingRequest
-	(*RevokePairingResponse)(nil),              // 22: api_bridge.v1.RevokePairingResponse
-	(*GetPairingStatusRequest)(nil),            // 23: api_bridge.v1.GetPairingStatusRequest
-	(*GetPairingStatusResponse)(nil),           // 24: api_bridge.v1.GetPairingStatusResponse
-	(*CreateTrustTensorRequest)(nil),           // 25: api_bridge.v1.CreateTrustTensorRequest
-	(*CreateTrustTensorResponse)(nil),          // 26: api_bridge.v1.CreateTrustTensorResponse
-	(*GetTrustTensorRequest)(nil),              // 27: api_bridge.v1.GetTrustTensorRequest
-	(*GetTrustTensorResponse)(nil),             // 28: api_bridge.v1.GetTrustTensorResponse
-	(*UpdateTrustScoreRequest)(nil),            // 29: api_bridge.v1.UpdateTrustScoreRequest
-	(*UpdateTrustScoreResponse)(nil),           // 30: api_bridge.v1.UpdateTrustScoreResponse
-	(*CreateEnergyOperationRequest)(nil),       // 31: api_bridge.v1.CreateEnergyOperationRequest
-	(*CreateEnergyOperationResponse)(nil),      // 32: api_bridge.v1.CreateEnergyOperationResponse
-	(*ExecuteEnergyTransferRequest)(nil),       // 33: api_bridge.v1.ExecuteEnergyTransferRequest
-	(*ExecuteEnergyTransferResponse)(nil),      // 34: api_bridge.v1.ExecuteEnergyTransferResponse
-	(*GetEnergyBalanceRequest)(nil),            // 35: api_bridge.v1.GetEnergyBalanceRequest
-	(*GetEnergyBalanceResponse)(nil),           // 36: api_bridge.v1.GetEnergyBalanceResponse
-	(*QueuePairingRequestRequest)(nil),         // 37: api_bridge.v1.QueuePairingRequestRequest
-	(*QueuePairingRequestResponse)(nil),        // 38: api_bridge.v1.QueuePairingRequestResponse
-	(*GetQueueStatusRequest)(nil),              // 39: api_bridge.v1.GetQueueStatusRequest
-	(*GetQueueStatusResponse)(nil),             // 40: api_bridge.v1.GetQueueStatusResponse
-	(*ProcessOfflineQueueRequest)(nil),         // 41: api_bridge.v1.ProcessOfflineQueueRequest
-	(*ProcessOfflineQueueResponse)(nil),        // 42: api_bridge.v1.ProcessOfflineQueueResponse
-	(*CancelRequestRequest)(nil),               // 43: api_bridge.v1.CancelRequestRequest
-	(*CancelRequestResponse)(nil),              // 44: api_bridge.v1.CancelRequestResponse
-	(*GetQueuedRequestsRequest)(nil),           // 45: api_bridge.v1.GetQueuedRequestsRequest
-	(*GetQueuedRequestsResponse)(nil),          // 46: api_bridge.v1.GetQueuedRequestsResponse
-	(*ListProxyQueueRequest)(nil),              // 47: api_bridge.v1.ListProxyQueueRequest
-	(*ListProxyQueueResponse)(nil),             // 48: api_bridge.v1.ListProxyQueueResponse
-	(*QueuedRequest)(nil),                      // 49: api_bridge.v1.QueuedRequest
-	(*CreatePairingAuthorizationRequest)(nil),  // 50: api_bridge.v1.CreatePairingAuthorizationRequest
-	(*CreatePairingAuthorizationResponse)(nil), // 51: api_bridge.v1.CreatePairingAuthorizationResponse
-	(*GetComponentAuthorizationsRequest)(nil),  // 52: api_bridge.v1.GetComponentAuthorizationsRequest
-	(*GetComponentAuthorizationsResponse)(nil), // 53: api_bridge.v1.GetComponentAuthorizationsResponse
-	(*Authorization)(nil),                      // 54: api_bridge.v1.Authorization
-	(*UpdateAuthorizationRequest)(nil),         // 55: api_bridge.v1.UpdateAuthorizationRequest
-	(*UpdateAuthorizationResponse)(nil),        // 56: api_bridge.v1.UpdateAuthorizationResponse
-	(*RevokeAuthorizationRequest)(nil),         // 57: api_bridge.v1.RevokeAuthorizationRequest
-	(*RevokeAuthorizationResponse)(nil),        // 58: api_bridge.v1.RevokeAuthorizationResponse
-	(*CheckPairingAuthorizationRequest)(nil),   // 59: api_bridge.v1.CheckPairingAuthorizationRequest
-	(*CheckPairingAuthorizationResponse)(nil),  // 60: api_bridge.v1.CheckPairingAuthorizationResponse
-	(*CalculateRelationshipTrustRequest)(nil),  // 61: api_bridge.v1.CalculateRelationshipTrustRequest
-	(*CalculateRelationshipTrustResponse)(nil), // 62: api_bridge.v1.CalculateRelationshipTrustResponse
-	(*GetRelationshipTensorRequest)(nil),       // 63: api_bridge.v1.GetRelationshipTensorRequest
-	(*GetRelationshipTensorResponse)(nil),      // 64: api_bridge.v1.GetRelationshipTensorResponse
-	(*UpdateTensorScoreRequest)(nil),           // 65: api_bridge.v1.UpdateTensorScoreRequest
-	(*UpdateTensorScoreResponse)(nil),          // 66: api_bridge.v1.UpdateTensorScoreResponse
-	(*StreamBatteryStatusRequest)(nil),         // 67: api_bridge.v1.StreamBatteryStatusRequest
-	(*BatteryStatusUpdate)(nil),                // 68: api_bridge.v1.BatteryStatusUpdate
+	(*GetComponentRelationshipsRequest)(nil),   // 17: api_bridge.v1.GetComponentRelationshipsRequest
+	(*GetComponentRelationshipsResponse)(nil),  // 18: api_bridge.v1.GetComponentRelationshipsResponse
+	(*LCTSummary)(nil),                         // 19: api_bridge.v1.LCTSummary
+	(*InitiatePairingRequest)(nil),             // 20: api_bridge.v1.InitiatePairingRequest
+	(*InitiatePairingResponse)(nil),            // 21: api_bridge.v1.InitiatePairingResponse
+	(*CompletePairingRequest)(nil),             // 22: api_bridge.v1.CompletePairingRequest
+	(*CompletePairingResponse)(nil),            // 23: api_bridge.v1.CompletePairingResponse
+	(*RevokePairingRequest)(nil),               // 24: api_bridge.v1.RevokePairingRequest
+	(*RevokePairingResponse)(nil),              // 25: api_bridge.v1.RevokePairingResponse
+	(*GetPairingStatusRequest)(nil),            // 26: api_bridge.v1.GetPairingStatusRequest
+	(*GetPairingStatusResponse)(nil),           // 27: api_bridge.v1.GetPairingStatusResponse
+	(*CreateTrustTensorRequest)(nil),           // 28: api_bridge.v1.CreateTrustTensorRequest
+	(*CreateTrustTensorResponse)(nil),          // 29: api_bridge.v1.CreateTrustTensorResponse
+	(*GetTrustTensorRequest)(nil),              // 30: api_bridge.v1.GetTrustTensorRequest
+	(*GetTrustTensorResponse)(nil),             // 31: api_bridge.v1.GetTrustTensorResponse
+	(*UpdateTrustScoreRequest)(nil),            // 32: api_bridge.v1.UpdateTrustScoreRequest
+	(*UpdateTrustScoreResponse)(nil),           // 33: api_bridge.v1.UpdateTrustScoreResponse
+	(*CreateEnergyOperationRequest)(nil),       // 34: api_bridge.v1.CreateEnergyOperationRequest
+	(*CreateEnergyOperationResponse)(nil),      // 35: api_bridge.v1.CreateEnergyOperationResponse
+	(*ExecuteEnergyTransferRequest)(nil),       // 36: api_bridge.v1.ExecuteEnergyTransferRequest
+	(*ExecuteEnergyTransferResponse)(nil),      // 37: api_bridge.v1.ExecuteEnergyTransferResponse
+	(*GetEnergyBalanceRequest)(nil),            // 38: api_bridge.v1.GetEnergyBalanceRequest
+	(*GetEnergyBalanceResponse)(nil),           // 39: api_bridge.v1.GetEnergyBalanceResponse
+	(*QueuePairingRequestRequest)(nil),         // 40: api_bridge.v1.QueuePairingRequestRequest
+	(*QueuePairingRequestResponse)(nil),        // 41: api_bridge.v1.QueuePairingRequestResponse
+	(*GetQueueStatusRequest)(nil),              // 42: api_bridge.v1.GetQueueStatusRequest
+	(*GetQueueStatusResponse)(nil),             // 43: api_bridge.v1.GetQueueStatusResponse
+	(*ProcessOfflineQueueRequest)(nil),         // 44: api_bridge.v1.ProcessOfflineQueueRequest
+	(*ProcessOfflineQueueResponse)(nil),        // 45: api_bridge.v1.ProcessOfflineQueueResponse
+	(*CancelRequestRequest)(nil),               // 46: api_bridge.v1.CancelRequestRequest
+	(*CancelRequestResponse)(nil),              // 47: api_bridge.v1.CancelRequestResponse
+	(*GetQueuedRequestsRequest)(nil),           // 48: api_bridge.v1.GetQueuedRequestsRequest
+	(*GetQueuedRequestsResponse)(nil),          // 49: api_bridge.v1.GetQueuedRequestsResponse
+	(*ListProxyQueueRequest)(nil),              // 50: api_bridge.v1.ListProxyQueueRequest
+	(*ListProxyQueueResponse)(nil),             // 51: api_bridge.v1.ListProxyQueueResponse
+	(*QueuedRequest)(nil),                      // 52: api_bridge.v1.QueuedRequest
+	(*CreatePairingAuthorizationRequest)(nil),  // 53: api_bridge.v1.CreatePairingAuthorizationRequest
+	(*CreatePairingAuthorizationResponse)(nil), // 54: api_bridge.v1.CreatePairingAuthorizationResponse
+	(*GetComponentAuthorizationsRequest)(nil),  // 55: api_bridge.v1.GetComponentAuthorizationsRequest
+	(*GetComponentAuthorizationsResponse)(nil), // 56: api_bridge.v1.GetComponentAuthorizationsResponse
+	(*Authorization)(nil),                      // 57: api_bridge.v1.Authorization
+	(*UpdateAuthorizationRequest)(nil),         // 58: api_bridge.v1.UpdateAuthorizationRequest
+	(*UpdateAuthorizationResponse)(nil),        // 59: api_bridge.v1.UpdateAuthorizationResponse
+	(*RevokeAuthorizationRequest)(nil),         // 60: api_bridge.v1.RevokeAuthorizationRequest
+	(*RevokeAuthorizationResponse)(nil),        // 61: api_bridge.v1.RevokeAuthorizationResponse
+	(*CheckPairingAuthorizationRequest)(nil),   // 62: api_bridge.v1.CheckPairingAuthorizationRequest
+	(*CheckPairingAuthorizationResponse)(nil),  // 63: api_bridge.v1.CheckPairingAuthorizationResponse
+	(*CalculateRelationshipTrustRequest)(nil),  // 64: api_bridge.v1.CalculateRelationshipTrustRequest
+	(*CalculateRelationshipTrustResponse)(nil), // 65: api_bridge.v1.CalculateRelationshipTrustResponse
+	(*GetRelationshipTensorRequest)(nil),       // 66: api_bridge.v1.GetRelationshipTensorRequest
+	(*GetRelationshipTensorResponse)(nil),      // 67: api_bridge.v1.GetRelationshipTensorResponse
+	(*UpdateTensorScoreRequest)(nil),           // 68: api_bridge.v1.UpdateTensorScoreRequest
+	(*UpdateTensorScoreResponse)(nil),          // 69: api_bridge.v1.UpdateTensorScoreResponse
+	(*StreamBatteryStatusRequest)(nil),         // 70: api_bridge.v1.StreamBatteryStatusRequest
+	(*BatteryStatusUpdate)(nil),                // 71: api_bridge.v1.BatteryStatusUpdate
 }
 var file_proto_api_bridge_proto_depIdxs = []int32{
 	2,  // 0: api_bridge.v1.GetAccountsResponse.accounts:type_name -> api_bridge.v1.Account
-	49, // 1: api_bridge.v1.GetQueuedRequestsResponse.requests:type_name -> api_bridge.v1.QueuedRequest
-	49, // 2: api_bridge.v1.ListProxyQueueResponse.requests:type_name -> api_bridge.v1.QueuedRequest
-	54, // 3: api_bridge.v1.GetComponentAuthorizationsResponse.authorization:type_name -> api_bridge.v1.Authorization
-	0,  // 4: api_bridge.v1.APIBridgeService.GetAccounts:input_type -> api_bridge.v1.GetAccountsRequest
-	3,  // 5: api_bridge.v1.APIBridgeService.RegisterComponent:input_type -> api_bridge.v1.RegisterComponentRequest
-	5,  // 6: api_bridge.v1.APIBridgeService.GetComponent:input_type -> api_bridge.v1.GetComponentRequest
-	7,  // 7: api_bridge.v1.APIBridgeService.GetComponentIdentity:input_type -> api_bridge.v1.GetComponentIdentityRequest
-	9,  // 8: api_bridge.v1.APIBridgeService.VerifyComponent:input_type -> api_bridge.v1.VerifyComponentRequest
-	11, // 9: api_bridge.v1.APIBridgeService.CreateLCT:input_type -> api_bridge.v1.CreateLCTRequest
-	13, // 10: api_bridge.v1.APIBridgeService.GetLCT:input_type -> api_bridge.v1.GetLCTRequest
-	15, // 11: api_bridge.v1.APIBridgeService.UpdateLCTStatus:input_type -> api_bridge.v1.UpdateLCTStatusRequest
-	17, // 12: api_bridge.v1.APIBridgeService.InitiatePairing:input_type -> api_bridge.v1.InitiatePairingRequest
-	19, // 13: api_bridge.v1.APIBridgeService.CompletePairing:input_type -> api_bridge.v1.CompletePairingRequest
-	21, // 14: api_bridge.v1.APIBridgeService.RevokePairing:input_type -> api_bridge.v1.RevokePairingRequest
-	23, // 15: api_bridge.v1.APIBridgeService.GetPairingStatus:input_type -> api_bridge.v1.GetPairingStatusRequest
-	25, // 16: api_bridge.v1.APIBridgeService.CreateTrustTensor:input_type -> api_bridge.v1.CreateTrustTensorRequest
-	27, // 17: api_bridge.v1.APIBridgeService.GetTrustTensor:input_type -> api_bridge.v1.GetTrustTensorRequest
-	29, // 18: api_bridge.v1.APIBridgeService.UpdateTrustScore:input_type -> api_bridge.v1.UpdateTrustScoreRequest
-	31, // 19: api_bridge.v1.APIBridgeService.CreateEnergyOperation:input_type -> api_bridge.v1.CreateEnergyOperationRequest
-	33, // 20: api_bridge.v1.APIBridgeService.ExecuteEnergyTransfer:input_type -> api_bridge.v1.ExecuteEnergyTransferRequest
-	35, // 21: api_bridge.v1.APIBridgeService.GetEnergyBalance:input_type -> api_bridge.v1.GetEnergyBalanceRequest
-	37, // 22: api_bridge.v1.APIBridgeService.QueuePairingRequest:input_type -> api_bridge.v1.QueuePairingRequestRequest
-	39, // 23: api_bridge.v1.APIBridgeService.GetQueueStatus:input_type -> api_bridge.v1.GetQueueStatusRequest
-	41, // 24: api_bridge.v1.APIBridgeService.ProcessOfflineQueue:input_type -> api_bridge.v1.ProcessOfflineQueueRequest
-	43, // 25: api_bridge.v1.APIBridgeService.CancelRequest:input_type -> api_bridge.v1.CancelRequestRequest
-	45, // 26: api_bridge.v1.APIBridgeService.GetQueuedRequests:input_type -> api_bridge.v1.GetQueuedRequestsRequest
-	47, // 27: api_bridge.v1.APIBridgeService.ListProxyQueue:input_type -> api_bridge.v1.ListProxyQueueRequest
-	50, // 28: api_bridge.v1.APIBridgeService.CreatePairingAuthorization:input_type -> api_bridge.v1.CreatePairingAuthorizationRequest
-	52, // 29: api_bridge.v1.APIBridgeService.GetComponentAuthorizations:input_type -> api_bridge.v1.GetComponentAuthorizationsRequest
-	55, // 30: api_bridge.v1.APIBridgeService.UpdateAuthorization:input_type -> api_bridge.v1.UpdateAuthorizationRequest
-	57, // 31: api_bridge.v1.APIBridgeService.RevokeAuthorization:input_type -> api_bridge.v1.RevokeAuthorizationRequest
-	59, // 32: api_bridge.v1.APIBridgeService.CheckPairingAuthorization:input_type -> api_bridge.v1.CheckPairingAuthorizationRequest
-	61, // 33: api_bridge.v1.APIBridgeService.CalculateRelationshipTrust:input_type -> api_bridge.v1.CalculateRelationshipTrustRequest
-	63, // 34: api_bridge.v1.APIBridgeService.GetRelationshipTensor:input_type -> api_bridge.v1.GetRelationshipTensorRequest
-	65, // 35: api_bridge.v1.APIBridgeService.UpdateTensorScore:input_type -> api_bridge.v1.UpdateTensorScoreRequest
-	67, // 36: api_bridge.v1.APIBridgeService.StreamBatteryStatus:input_type -> api_bridge.v1.StreamBatteryStatusRequest
-	1,  // 37: api_bridge.v1.APIBridgeService.GetAccounts:output_type -> api_bridge.v1.GetAccountsResponse
-	4,  // 38: api_bridge.v1.APIBridgeService.RegisterComponent:output_type -> api_bridge.v1.RegisterComponentResponse
-	6,  // 39: api_bridge.v1.APIBridgeService.GetComponent:output_type -> api_bridge.v1.GetComponentResponse
-	8,  // 40: api_bridge.v1.APIBridgeService.GetComponentIdentity:output_type -> api_bridge.v1.GetComponentIdentityResponse
-	10, // 41: api_bridge.v1.APIBridgeService.VerifyComponent:output_type -> api_bridge.v1.VerifyComponentResponse
-	12, // 42: api_bridge.v1.APIBridgeService.CreateLCT:output_type -> api_bridge.v1.CreateLCTResponse
-	14, // 43: api_bridge.v1.APIBridgeService.GetLCT:output_type -> api_bridge.v1.GetLCTResponse
-	16, // 44: api_bridge.v1.APIBridgeService.UpdateLCTStatus:output_type -> api_bridge.v1.UpdateLCTStatusResponse
-	18, // 45: api_bridge.v1.APIBridgeService.InitiatePairing:output_type -> api_bridge.v1.InitiatePairingResponse
-	20, // 46: api_bridge.v1.APIBridgeService.CompletePairing:output_type -> api_bridge.v1.CompletePairingResponse
-	22, // 47: api_bridge.v1.APIBridgeService.RevokePairing:output_type -> api_bridge.v1.RevokePairingResponse
-	24, // 48: api_bridge.v1.APIBridgeService.GetPairingStatus:output_type -> api_bridge.v1.GetPairingStatusResponse
-	26, // 49: api_bridge.v1.APIBridgeService.CreateTrustTensor:output_type -> api_bridge.v1.CreateTrustTensorResponse
-	28, // 50: api_bridge.v1.APIBridgeService.GetTrustTensor:output_type -> api_bridge.v1.GetTrustTensorResponse
-	30, // 51: api_bridge.v1.APIBridgeService.UpdateTrustScore:output_type -> api_bridge.v1.UpdateTrustScoreResponse
-	32, // 52: api_bridge.v1.APIBridgeService.CreateEnergyOperation:output_type -> api_bridge.v1.CreateEnergyOperationResponse
-	34, // 53: api_bridge.v1.APIBridgeService.ExecuteEnergyTransfer:output_type -> api_bridge.v1.ExecuteEnergyTransferResponse
-	36, // 54: api_bridge.v1.APIBridgeService.GetEnergyBalance:output_type -> api_bridge.v1.GetEnergyBalanceResponse
-	38, // 55: api_bridge.v1.APIBridgeService.QueuePairingRequest:output_type -> api_bridge.v1.QueuePairingRequestResponse
-	40, // 56: api_bridge.v1.APIBridgeService.GetQueueStatus:output_type -> api_bridge.v1.GetQueueStatusResponse
-	42, // 57: api_bridge.v1.APIBridgeService.ProcessOfflineQueue:output_type -> api_bridge.v1.ProcessOfflineQueueResponse
-	44, // 58: api_bridge.v1.APIBridgeService.CancelRequest:output_type -> api_bridge.v1.CancelRequestResponse
-	46, // 59: api_bridge.v1.APIBridgeService.GetQueuedRequests:output_type -> api_bridge.v1.GetQueuedRequestsResponse
-	48, // 60: api_bridge.v1.APIBridgeService.ListProxyQueue:output_type -> api_bridge.v1.ListProxyQueueResponse
-	51, // 61: api_bridge.v1.APIBridgeService.CreatePairingAuthorization:output_type -> api_bridge.v1.CreatePairingAuthorizationResponse
-	53, // 62: api_bridge.v1.APIBridgeService.GetComponentAuthorizations:output_type -> api_bridge.v1.GetComponentAuthorizationsResponse
-	56, // 63: api_bridge.v1.APIBridgeService.UpdateAuthorization:output_type -> api_bridge.v1.UpdateAuthorizationResponse
-	58, // 64: api_bridge.v1.APIBridgeService.RevokeAuthorization:output_type -> api_bridge.v1.RevokeAuthorizationResponse
-	60, // 65: api_bridge.v1.APIBridgeService.CheckPairingAuthorization:output_type -> api_bridge.v1.CheckPairingAuthorizationResponse
-	62, // 66: api_bridge.v1.APIBridgeService.CalculateRelationshipTrust:output_type -> api_bridge.v1.CalculateRelationshipTrustResponse
-	64, // 67: api_bridge.v1.APIBridgeService.GetRelationshipTensor:output_type -> api_bridge.v1.GetRelationshipTensorResponse
-	66, // 68: api_bridge.v1.APIBridgeService.UpdateTensorScore:output_type -> api_bridge.v1.UpdateTensorScoreResponse
-	68, // 69: api_bridge.v1.APIBridgeService.StreamBatteryStatus:output_type -> api_bridge.v1.BatteryStatusUpdate
-	37, // [37:70] is the sub-list for method output_type
-	4,  // [4:37] is the sub-list for method input_type
-	4,  // [4:4] is the sub-list for extension type_name
-	4,  // [4:4] is the sub-list for extension extendee
-	0,  // [0:4] is the sub-list for field type_name
+	19, // 1: api_bridge.v1.GetComponentRelationshipsResponse.relationships:type_name -> api_bridge.v1.LCTSummary
+	52, // 2: api_bridge.v1.GetQueuedRequestsResponse.requests:type_name -> api_bridge.v1.QueuedRequest
+	52, // 3: api_bridge.v1.ListProxyQueueResponse.requests:type_name -> api_bridge.v1.QueuedRequest
+	57, // 4: api_bridge.v1.GetComponentAuthorizationsResponse.authorization:type_name -> api_bridge.v1.Authorization
+	0,  // 5: api_bridge.v1.APIBridgeService.GetAccounts:input_type -> api_bridge.v1.GetAccountsRequest
+	3,  // 6: api_bridge.v1.APIBridgeService.RegisterComponent:input_type -> api_bridge.v1.RegisterComponentRequest
+	5,  // 7: api_bridge.v1.APIBridgeService.GetComponent:input_type -> api_bridge.v1.GetComponentRequest
+	7,  // 8: api_bridge.v1.APIBridgeService.GetComponentIdentity:input_type -> api_bridge.v1.GetComponentIdentityRequest
+	9,  // 9: api_bridge.v1.APIBridgeService.VerifyComponent:input_type -> api_bridge.v1.VerifyComponentRequest
+	11, // 10: api_bridge.v1.APIBridgeService.CreateLCT:input_type -> api_bridge.v1.CreateLCTRequest
+	13, // 11: api_bridge.v1.APIBridgeService.GetLCT:input_type -> api_bridge.v1.GetLCTRequest
+	15, // 12: api_bridge.v1.APIBridgeService.UpdateLCTStatus:input_type -> api_bridge.v1.UpdateLCTStatusRequest
+	17, // 13: api_bridge.v1.APIBridgeService.GetComponentRelationships:input_type -> api_bridge.v1.GetComponentRelationshipsRequest
+	20, // 14: api_bridge.v1.APIBridgeService.InitiatePairing:input_type -> api_bridge.v1.InitiatePairingRequest
+	22, // 15: api_bridge.v1.APIBridgeService.CompletePairing:input_type -> api_bridge.v1.CompletePairingRequest
+	24, // 16: api_bridge.v1.APIBridgeService.RevokePairing:input_type -> api_bridge.v1.RevokePairingRequest
+	26, // 17: api_bridge.v1.APIBridgeService.GetPairingStatus:input_type -> api_bridge.v1.GetPairingStatusRequest
+	28, // 18: api_bridge.v1.APIBridgeService.CreateTrustTensor:input_type -> api_bridge.v1.CreateTrustTensorRequest
+	30, // 19: api_bridge.v1.APIBridgeService.GetTrustTensor:input_type -> api_bridge.v1.GetTrustTensorRequest
+	32, // 20: api_bridge.v1.APIBridgeService.UpdateTrustScore:input_type -> api_bridge.v1.UpdateTrustScoreRequest
+	34, // 21: api_bridge.v1.APIBridgeService.CreateEnergyOperation:input_type -> api_bridge.v1.CreateEnergyOperationRequest
+	36, // 22: api_bridge.v1.APIBridgeService.ExecuteEnergyTransfer:input_type -> api_bridge.v1.ExecuteEnergyTransferRequest
+	38, // 23: api_bridge.v1.APIBridgeService.GetEnergyBalance:input_type -> api_bridge.v1.GetEnergyBalanceRequest
+	40, // 24: api_bridge.v1.APIBridgeService.QueuePairingRequest:input_type -> api_bridge.v1.QueuePairingRequestRequest
+	42, // 25: api_bridge.v1.APIBridgeService.GetQueueStatus:input_type -> api_bridge.v1.GetQueueStatusRequest
+	44, // 26: api_bridge.v1.APIBridgeService.ProcessOfflineQueue:input_type -> api_bridge.v1.ProcessOfflineQueueRequest
+	46, // 27: api_bridge.v1.APIBridgeService.CancelRequest:input_type -> api_bridge.v1.CancelRequestRequest
+	48, // 28: api_bridge.v1.APIBridgeService.GetQueuedRequests:input_type -> api_bridge.v1.GetQueuedRequestsRequest
+	50, // 29: api_bridge.v1.APIBridgeService.ListProxyQueue:input_type -> api_bridge.v1.ListProxyQueueRequest
+	53, // 30: api_bridge.v1.APIBridgeService.CreatePairingAuthorization:input_type -> api_bridge.v1.CreatePairingAuthorizationRequest
+	55, // 31: api_bridge.v1.APIBridgeService.GetComponentAuthorizations:input_type -> api_bridge.v1.GetComponentAuthorizationsRequest
+	58, // 32: api_bridge.v1.APIBridgeService.UpdateAuthorization:input_type -> api_bridge.v1.UpdateAuthorizationRequest
+	60, // 33: api_bridge.v1.APIBridgeService.RevokeAuthorization:input_type -> api_bridge.v1.RevokeAuthorizationRequest
+	62, // 34: api_bridge.v1.APIBridgeService.CheckPairingAuthorization:input_type -> api_bridge.v1.CheckPairingAuthorizationRequest
+	64, // 35: api_bridge.v1.APIBridgeService.CalculateRelationshipTrust:input_type -> api_bridge.v1.CalculateRelationshipTrustRequest
+	66, // 36: api_bridge.v1.APIBridgeService.GetRelationshipTensor:input_type -> api_bridge.v1.GetRelationshipTensorRequest
+	68, // 37: api_bridge.v1.APIBridgeService.UpdateTensorScore:input_type -> api_bridge.v1.UpdateTensorScoreRequest
+	70, // 38: api_bridge.v1.APIBridgeService.StreamBatteryStatus:input_type -> api_bridge.v1.StreamBatteryStatusRequest
+	1,  // 39: api_bridge.v1.APIBridgeService.GetAccounts:output_type -> api_bridge.v1.GetAccountsResponse
+	4,  // 40: api_bridge.v1.APIBridgeService.RegisterComponent:output_type -> api_bridge.v1.RegisterComponentResponse
+	6,  // 41: api_bridge.v1.APIBridgeService.GetComponent:output_type -> api_bridge.v1.GetComponentResponse
+	8,  // 42: api_bridge.v1.APIBridgeService.GetComponentIdentity:output_type -> api_bridge.v1.GetComponentIdentityResponse
+	10, // 43: api_bridge.v1.APIBridgeService.VerifyComponent:output_type -> api_bridge.v1.VerifyComponentResponse
+	12, // 44: api_bridge.v1.APIBridgeService.CreateLCT:output_type -> api_bridge.v1.CreateLCTResponse
+	14, // 45: api_bridge.v1.APIBridgeService.GetLCT:output_type -> api_bridge.v1.GetLCTResponse
+	16, // 46: api_bridge.v1.APIBridgeService.UpdateLCTStatus:output_type -> api_bridge.v1.UpdateLCTStatusResponse
+	18, // 47: api_bridge.v1.APIBridgeService.GetComponentRelationships:output_type -> api_bridge.v1.GetComponentRelationshipsResponse
+	21, // 48: api_bridge.v1.APIBridgeService.InitiatePairing:output_type -> api_bridge.v1.InitiatePairingResponse
+	23, // 49: api_bridge.v1.APIBridgeService.CompletePairing:output_type -> api_bridge.v1.CompletePairingResponse
+	25, // 50: api_bridge.v1.APIBridgeService.RevokePairing:output_type -> api_bridge.v1.RevokePairingResponse
+	27, // 51: api_bridge.v1.APIBridgeService.GetPairingStatus:output_type -> api_bridge.v1.GetPairingStatusResponse
+	29, // 52: api_bridge.v1.APIBridgeService.CreateTrustTensor:output_type -> api_bridge.v1.CreateTrustTensorResponse
+	31, // 53: api_bridge.v1.APIBridgeService.GetTrustTensor:output_type -> api_bridge.v1.GetTrustTensorResponse
+	33, // 54: api_bridge.v1.APIBridgeService.UpdateTrustScore:output_type -> api_bridge.v1.UpdateTrustScoreResponse
+	35, // 55: api_bridge.v1.APIBridgeService.CreateEnergyOperation:output_type -> api_bridge.v1.CreateEnergyOperationResponse
+	37, // 56: api_bridge.v1.APIBridgeService.ExecuteEnergyTransfer:output_type -> api_bridge.v1.ExecuteEnergyTransferResponse
+	39, // 57: api_bridge.v1.APIBridgeService.GetEnergyBalance:output_type -> api_bridge.v1.GetEnergyBalanceResponse
+	41, // 58: api_bridge.v1.APIBridgeService.QueuePairingRequest:output_type -> api_bridge.v1.QueuePairingRequestResponse
+	43, // 59: api_bridge.v1.APIBridgeService.GetQueueStatus:output_type -> api_bridge.v1.GetQueueStatusResponse
+	45, // 60: api_bridge.v1.APIBridgeService.ProcessOfflineQueue:output_type -> api_bridge.v1.ProcessOfflineQueueResponse
+	47, // 61: api_bridge.v1.APIBridgeService.CancelRequest:output_type -> api_bridge.v1.CancelRequestResponse
+	49, // 62: api_bridge.v1.APIBridgeService.GetQueuedRequests:output_type -> api_bridge.v1.GetQueuedRequestsResponse
+	51, // 63: api_bridge.v1.APIBridgeService.ListProxyQueue:output_type -> api_bridge.v1.ListProxyQueueResponse
+	54, // 64: api_bridge.v1.APIBridgeService.CreatePairingAuthorization:output_type -> api_bridge.v1.CreatePairingAuthorizationResponse
+	56, // 65: api_bridge.v1.APIBridgeService.GetComponentAuthorizations:output_type -> api_bridge.v1.GetComponentAuthorizationsResponse
+	59, // 66: api_bridge.v1.APIBridgeService.UpdateAuthorization:output_type -> api_bridge.v1.UpdateAuthorizationResponse
+	61, // 67: api_bridge.v1.APIBridgeService.RevokeAuthorization:output_type -> api_bridge.v1.RevokeAuthorizationResponse
+	63, // 68: api_bridge.v1.APIBridgeService.CheckPairingAuthorization:output_type -> api_bridge.v1.CheckPairingAuthorizationResponse
+	65, // 69: api_bridge.v1.APIBridgeService.CalculateRelationshipTrust:output_type -> api_bridge.v1.CalculateRelationshipTrustResponse
+	67, // 70: api_bridge.v1.APIBridgeService.GetRelationshipTensor:output_type -> api_bridge.v1.GetRelationshipTensorResponse
+	69, // 71: api_bridge.v1.APIBridgeService.UpdateTensorScore:output_type -> api_bridge.v1.UpdateTensorScoreResponse
+	71, // 72: api_bridge.v1.APIBridgeService.StreamBatteryStatus:output_type -> api_bridge.v1.BatteryStatusUpdate
+	39, // [39:73] is the sub-list for method output_type
+	5,  // [5:39] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_proto_api_bridge_proto_init() }
@@ -5046,7 +5286,7 @@ func file_proto_api_bridge_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_api_bridge_proto_rawDesc), len(file_proto_api_bridge_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   69,
+			NumMessages:   72,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
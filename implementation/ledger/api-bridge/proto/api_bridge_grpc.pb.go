@@ -27,6 +27,7 @@ const (
 	APIBridgeService_CreateLCT_FullMethodName                  = "/api_bridge.v1.APIBridgeService/CreateLCT"
 	APIBridgeService_GetLCT_FullMethodName                     = "/api_bridge.v1.APIBridgeService/GetLCT"
 	APIBridgeService_UpdateLCTStatus_FullMethodName            = "/api_bridge.v1.APIBridgeService/UpdateLCTStatus"
+	APIBridgeService_GetComponentRelationships_FullMethodName  = "/api_bridge.v1.APIBridgeService/GetComponentRelationships"
 	APIBridgeService_InitiatePairing_FullMethodName            = "/api_bridge.v1.APIBridgeService/InitiatePairing"
 	APIBridgeService_CompletePairing_FullMethodName            = "/api_bridge.v1.APIBridgeService/CompletePairing"
 	APIBridgeService_RevokePairing_FullMethodName              = "/api_bridge.v1.APIBridgeService/RevokePairing"
@@ -71,6 +72,7 @@ type APIBridgeServiceClient interface {
 	CreateLCT(ctx context.Context, in *CreateLCTRequest, opts ...grpc.CallOption) (*CreateLCTResponse, error)
 	GetLCT(ctx context.Context, in *GetLCTRequest, opts ...grpc.CallOption) (*GetLCTResponse, error)
 	UpdateLCTStatus(ctx context.Context, in *UpdateLCTStatusRequest, opts ...grpc.CallOption) (*UpdateLCTStatusResponse, error)
+	GetComponentRelationships(ctx context.Context, in *GetComponentRelationshipsRequest, opts ...grpc.CallOption) (*GetComponentRelationshipsResponse, error)
 	// Pairing
 	InitiatePairing(ctx context.Context, in *InitiatePairingRequest, opts ...grpc.CallOption) (*InitiatePairingResponse, error)
 	CompletePairing(ctx context.Context, in *CompletePairingRequest, opts ...grpc.CallOption) (*CompletePairingResponse, error)
@@ -193,6 +195,16 @@ func (c *aPIBridgeServiceClient) UpdateLCTStatus(ctx context.Context, in *Update
 	return out, nil
 }
 
+func (c *aPIBridgeServiceClient) GetComponentRelationships(ctx context.Context, in *GetComponentRelationshipsRequest, opts ...grpc.CallOption) (*GetComponentRelationshipsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetComponentRelationshipsResponse)
+	err := c.cc.Invoke(ctx, APIBridgeService_GetComponentRelationships_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *aPIBridgeServiceClient) InitiatePairing(ctx context.Context, in *InitiatePairingRequest, opts ...grpc.CallOption) (*InitiatePairingResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(InitiatePairingResponse)
@@ -469,6 +481,7 @@ type APIBridgeServiceServer interface {
 	CreateLCT(context.Context, *CreateLCTRequest) (*CreateLCTResponse, error)
 	GetLCT(context.Context, *GetLCTRequest) (*GetLCTResponse, error)
 	UpdateLCTStatus(context.Context, *UpdateLCTStatusRequest) (*UpdateLCTStatusResponse, error)
+	GetComponentRelationships(context.Context, *GetComponentRelationshipsRequest) (*GetComponentRelationshipsResponse, error)
 	// Pairing
 	InitiatePairing(context.Context, *InitiatePairingRequest) (*InitiatePairingResponse, error)
 	CompletePairing(context.Context, *CompletePairingRequest) (*CompletePairingResponse, error)
@@ -535,6 +548,9 @@ func (UnimplementedAPIBridgeServiceServer) GetLCT(context.Context, *GetLCTReques
 func (UnimplementedAPIBridgeServiceServer) UpdateLCTStatus(context.Context, *UpdateLCTStatusRequest) (*UpdateLCTStatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateLCTStatus not implemented")
 }
+func (UnimplementedAPIBridgeServiceServer) GetComponentRelationships(context.Context, *GetComponentRelationshipsRequest) (*GetComponentRelationshipsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetComponentRelationships not implemented")
+}
 func (UnimplementedAPIBridgeServiceServer) InitiatePairing(context.Context, *InitiatePairingRequest) (*InitiatePairingResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InitiatePairing not implemented")
 }
@@ -775,6 +791,24 @@ func _APIBridgeService_UpdateLCTStatus_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _APIBridgeService_GetComponentRelationships_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetComponentRelationshipsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIBridgeServiceServer).GetComponentRelationships(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: APIBridgeService_GetComponentRelationships_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIBridgeServiceServer).GetComponentRelationships(ctx, req.(*GetComponentRelationshipsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _APIBridgeService_InitiatePairing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(InitiatePairingRequest)
 	if err := dec(in); err != nil {
@@ -1257,6 +1291,10 @@ var APIBridgeService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateLCTStatus",
 			Handler:    _APIBridgeService_UpdateLCTStatus_Handler,
 		},
+		{
+			MethodName: "GetComponentRelationships",
+			Handler:    _APIBridgeService_GetComponentRelationships_Handler,
+		},
 		{
 			MethodName: "InitiatePairing",
 			Handler:    _APIBridgeService_InitiatePairing_Handler,
@@ -96,6 +96,8 @@ func (suite *ComponentRegistryRealBlockchainTestSuite) SetupTest() {
 	pairingQueueKeeper := pairingqueuekeeper.NewKeeper(
 		encCfg.Codec,
 		pairingQueueStoreService,
+		addressCodec,
+		authority,
 		nil, // authKeeper
 		nil, // bankKeeper
 		nil, // compKeeper
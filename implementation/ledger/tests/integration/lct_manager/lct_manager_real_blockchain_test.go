@@ -98,6 +98,8 @@ func (suite *LCTManagerRealBlockchainTestSuite) SetupTest() {
 	pairingQueueKeeper := pairingqueuekeeper.NewKeeper(
 		encCfg.Codec,
 		pairingQueueStoreService,
+		addressCodec,
+		authority,
 		nil, // authKeeper
 		nil, // bankKeeper
 		nil, // compKeeper
@@ -100,6 +100,8 @@ func (suite *PairingFlowRealBlockchainTestSuite) SetupTest() {
 	pairingQueueKeeper := pairingqueuekeeper.NewKeeper(
 		encCfg.Codec,
 		pairingQueueStoreService,
+		addressCodec,
+		authority,
 		nil, // authKeeper
 		nil, // bankKeeper
 		nil, // compKeeper
@@ -113,6 +115,7 @@ func (suite *PairingFlowRealBlockchainTestSuite) SetupTest() {
 		nil, // componentregistryKeeper
 		nil, // pairingqueueKeeper
 		nil, // lctmanagerKeeper
+		trustTensorKeeper,
 	)
 
 	suite.ctx = ctx
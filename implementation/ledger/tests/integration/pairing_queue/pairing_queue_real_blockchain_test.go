@@ -69,6 +69,8 @@ func (suite *PairingQueueRealBlockchainTestSuite) SetupTest() {
 	pairingQueueKeeper := pairingqueuekeeper.NewKeeper(
 		encCfg.Codec,
 		pairingQueueStoreService,
+		addressCodec,
+		authority,
 		nil, // authKeeper
 		nil, // bankKeeper
 		nil, // compKeeper
@@ -453,7 +453,7 @@ func (suite *PairingQueueTestSuite) TestListProxyQueue() {
 	operation2.ProxyComponent = "different_proxy"
 
 	// List proxy queue
-	operations, err := suite.keeper.ListProxyQueue(suite.ctx, proxyID)
+	operations, _, err := suite.keeper.ListProxyQueue(suite.ctx, proxyID, types.OfflineOperationFilter{}, nil)
 
 	// Assertions
 	require.NoError(suite.T(), err)
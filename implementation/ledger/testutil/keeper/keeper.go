@@ -114,11 +114,16 @@ func (m *MockLctManagerKeeper) GetComponentRelationships(ctx context.Context, co
 	return []lctmanagertypes.LinkedContextToken{}, nil
 }
 
-func (m *MockLctManagerKeeper) TerminateLCTRelationship(ctx context.Context, lctId, reason string, notifyOffline bool) error {
+func (m *MockLctManagerKeeper) TerminateLctRelationship(ctx context.Context, lctId, reason string, notifyOffline bool) error {
 	// Mock successful termination
 	return nil
 }
 
+func (m *MockLctManagerKeeper) IssueSplitKey(ctx context.Context, lctId string, commitment []byte) (string, error) {
+	// Return a mock key reference for testing
+	return fmt.Sprintf("key_ref_%s", lctId), nil
+}
+
 // MockTrustTensorKeeper provides a mock implementation for trust tensor keeper
 type MockTrustTensorKeeper struct{}
 
@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	"strings"
+
+	"racecar-web/x/componentregistry/types"
+)
+
+// componentField pairs a proto field name with an accessor for its string form
+type componentField struct {
+	name  string
+	value func(c types.Component) string
+}
+
+// trackedComponentFields lists the declared component fields that are diffed on update.
+// Timestamps are excluded because they change on every verification.
+var trackedComponentFields = []componentField{
+	{"manufacturer_hash", func(c types.Component) string { return c.ManufacturerHash }},
+	{"category_hash", func(c types.Component) string { return c.CategoryHash }},
+	{"authorization_rules_hash", func(c types.Component) string { return c.AuthorizationRulesHash }},
+	{"status", func(c types.Component) string { return c.Status }},
+	{"trust_anchor", func(c types.Component) string { return c.TrustAnchor }},
+	{"verification_metadata", func(c types.Component) string { return c.VerificationMetadata }},
+	{"relationship_hashes", func(c types.Component) string { return strings.Join(c.RelationshipHashes, ",") }},
+	{"lct_hash", func(c types.Component) string { return c.LctHash }},
+	{"manufacturer_id", func(c types.Component) string { return c.ManufacturerId }},
+	{"component_type", func(c types.Component) string { return c.ComponentType }},
+	{"hardware_specs", func(c types.Component) string { return c.HardwareSpecs }},
+	{"quality_score", func(c types.Component) string { return c.QualityScore }},
+	{"capabilities", func(c types.Component) string { return c.Capabilities }},
+	{"relationship_ids", func(c types.Component) string { return strings.Join(c.RelationshipIds, ",") }},
+	{"lct_id", func(c types.Component) string { return c.LctId }},
+	{"authorization_rules", func(c types.Component) string { return c.AuthorizationRules }},
+}
+
+// diffComponents returns the tracked fields whose values differ between old and updated
+func diffComponents(old, updated types.Component) []types.ComponentFieldChange {
+	var changes []types.ComponentFieldChange
+	for _, field := range trackedComponentFields {
+		oldValue, newValue := field.value(old), field.value(updated)
+		if oldValue != newValue {
+			changes = append(changes, types.ComponentFieldChange{
+				Field:    field.name,
+				OldValue: oldValue,
+				NewValue: newValue,
+			})
+		}
+	}
+	return changes
+}
@@ -0,0 +1,175 @@
+package keeper
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"racecar-web/x/componentregistry/types"
+)
+
+// DefaultComponentStaleAfter is how long a component may go unverified before
+// the health report flags it as stale
+const DefaultComponentStaleAfter = 24 * time.Hour
+
+// ComponentHealth classifies every registered component. Totals come from
+// the status counts kept with Components and ComponentVerifications; only the
+// components the report flags are read, through the status, last verified
+// and revocation target indexes.
+func (k Keeper) ComponentHealth(ctx context.Context, staleAfter time.Duration) (types.ComponentHealthSummary, []types.ComponentHealthIssue, error) {
+	if staleAfter <= 0 {
+		staleAfter = DefaultComponentStaleAfter
+	}
+	staleBefore := sdk.UnwrapSDKContext(ctx).BlockTime().Add(-staleAfter)
+
+	var summary types.ComponentHealthSummary
+	total, err := k.Components.Indexes.StatusCounts.Total(ctx)
+	if err != nil {
+		return types.ComponentHealthSummary{}, nil, errorsmod.Wrap(err, "failed to count components")
+	}
+	active, err := k.Components.Indexes.StatusCounts.Count(ctx, types.StatusActive)
+	if err != nil {
+		return types.ComponentHealthSummary{}, nil, errorsmod.Wrap(err, "failed to count components")
+	}
+	summary.Total = total
+
+	flagged := make(map[string]*types.ComponentHealthIssue)
+	flag := func(componentId string, component types.Component, issue string) {
+		problem, ok := flagged[componentId]
+		if !ok {
+			problem = &types.ComponentHealthIssue{
+				ComponentId:    componentId,
+				Status:         component.Status,
+				LastVerifiedAt: component.LastVerifiedAt,
+			}
+			flagged[componentId] = problem
+		}
+		problem.Issues = append(problem.Issues, issue)
+	}
+
+	// Revoked: by status, or targeted by a revocation event
+	revoked, err := k.revokedComponents(ctx)
+	if err != nil {
+		return types.ComponentHealthSummary{}, nil, errorsmod.Wrap(err, "failed to find revoked components")
+	}
+	for componentId, component := range revoked {
+		summary.Revoked++
+		if component.Status == types.StatusActive {
+			active--
+		}
+		flag(componentId, component, types.HealthIssueRevoked)
+	}
+	summary.Active = active
+
+	// Stale: not verified since the cutoff. Index keys are whole seconds, so
+	// the cutoff's own second is read and compared exactly.
+	var stale []string
+	err = k.Components.Indexes.LastVerified.Walk(ctx, collections.NewPrefixUntilPairRange[int64, string](staleBefore.Unix()), func(_ int64, componentId string) (bool, error) {
+		stale = append(stale, componentId)
+		return false, nil
+	})
+	if err != nil {
+		return types.ComponentHealthSummary{}, nil, errorsmod.Wrap(err, "failed to find stale components")
+	}
+	for _, componentId := range stale {
+		if _, ok := revoked[componentId]; ok {
+			continue
+		}
+		component, err := k.Components.Get(ctx, componentId)
+		if err != nil {
+			return types.ComponentHealthSummary{}, nil, errorsmod.Wrapf(err, "failed to read component %s", componentId)
+		}
+		if component.LastVerifiedAt.Before(staleBefore) {
+			summary.Stale++
+			flag(componentId, component, types.HealthIssueStale)
+		}
+	}
+
+	// Failed verification: the component's verification was rejected
+	iter, err := k.ComponentVerifications.Indexes.Status.MatchExact(ctx, types.VerificationStatusRejected)
+	if err != nil {
+		return types.ComponentHealthSummary{}, nil, errorsmod.Wrap(err, "failed to find rejected verifications")
+	}
+	rejected, err := iter.PrimaryKeys()
+	if err != nil {
+		return types.ComponentHealthSummary{}, nil, errorsmod.Wrap(err, "failed to find rejected verifications")
+	}
+	for _, componentId := range rejected {
+		component, err := k.Components.Get(ctx, componentId)
+		if errorsmod.IsOf(err, collections.ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			return types.ComponentHealthSummary{}, nil, errorsmod.Wrapf(err, "failed to read component %s", componentId)
+		}
+		summary.FailedVerification++
+		flag(componentId, component, types.HealthIssueFailedVerification)
+	}
+
+	// Unverified: no verification record, or one still pending. Every other
+	// record with a verdict belongs to one component.
+	settled := uint64(0)
+	for _, status := range []string{types.VerificationStatusVerified, types.VerificationStatusRejected, types.VerificationStatusExpired} {
+		count, err := k.ComponentVerifications.Indexes.StatusCounts.Count(ctx, status)
+		if err != nil {
+			return types.ComponentHealthSummary{}, nil, errorsmod.Wrap(err, "failed to count verifications")
+		}
+		settled += count
+	}
+	if settled < total {
+		summary.Unverified = total - settled
+	}
+
+	ids := make([]string, 0, len(flagged))
+	for componentId := range flagged {
+		ids = append(ids, componentId)
+	}
+	sort.Strings(ids)
+	problems := make([]types.ComponentHealthIssue, 0, len(ids))
+	for _, componentId := range ids {
+		problems = append(problems, *flagged[componentId])
+	}
+	return summary, problems, nil
+}
+
+// revokedComponents returns the registered components with a revoked status
+// or targeted by a revocation event
+func (k Keeper) revokedComponents(ctx context.Context) (map[string]types.Component, error) {
+	var ids []string
+	iter, err := k.Components.Indexes.Status.MatchExact(ctx, "revoked")
+	if err != nil {
+		return nil, err
+	}
+	byStatus, err := iter.PrimaryKeys()
+	if err != nil {
+		return nil, err
+	}
+	ids = append(ids, byStatus...)
+	err = k.RevocationTargetIndex.Walk(ctx, nil, func(key collections.Triple[string, int64, string], _ string) (bool, error) {
+		ids = append(ids, key.K1())
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	revoked := make(map[string]types.Component)
+	for _, componentId := range ids {
+		if _, seen := revoked[componentId]; seen {
+			continue
+		}
+		component, err := k.Components.Get(ctx, componentId)
+		if errorsmod.IsOf(err, collections.ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		revoked[componentId] = component
+	}
+	return revoked, nil
+}
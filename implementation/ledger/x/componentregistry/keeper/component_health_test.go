@@ -0,0 +1,116 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"racecar-web/x/componentregistry/keeper"
+	"racecar-web/x/componentregistry/types"
+)
+
+func TestComponentHealthSummary(t *testing.T) {
+	f := initFixture(t)
+	now := time.Unix(1752484904, 0).UTC()
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(now)
+
+	setComponent := func(id, status string, lastVerified time.Time) {
+		require.NoError(t, f.keeper.Components.Set(ctx, id, types.Component{
+			ComponentId:    id,
+			Status:         status,
+			LastVerifiedAt: lastVerified,
+		}))
+	}
+	setVerification := func(id, status string) {
+		require.NoError(t, f.keeper.ComponentVerifications.Set(ctx, id, types.ComponentVerification{
+			ComponentId: id,
+			Status:      status,
+			VerifiedAt:  now,
+		}))
+	}
+
+	// Healthy: active, verified an hour ago
+	setComponent("MODBATT-MOD-001", types.StatusActive, now.Add(-time.Hour))
+	setVerification("MODBATT-MOD-001", types.VerificationStatusVerified)
+	// Active but never verified
+	setComponent("MODBATT-MOD-002", types.StatusActive, now.Add(-time.Hour))
+	// Stale: last verified three days ago
+	setComponent("MODBATT-MOD-003", types.StatusActive, now.Add(-72*time.Hour))
+	setVerification("MODBATT-MOD-003", types.VerificationStatusVerified)
+	// Failed verification
+	setComponent("MODBATT-MOD-004", types.StatusActive, now.Add(-time.Hour))
+	setVerification("MODBATT-MOD-004", types.VerificationStatusRejected)
+	// Revoked through a revocation event
+	setComponent("MODBATT-PACK-001", types.StatusActive, now.Add(-time.Hour))
+	setVerification("MODBATT-PACK-001", types.VerificationStatusVerified)
+	_, err := f.keeper.CreateAnonymousRevocationEvent(ctx, "MODBATT-PACK-001", "INDIVIDUAL", "IMMEDIATE", "SAFETY", "hash_initiator")
+	require.NoError(t, err)
+
+	summary, problems, err := f.keeper.ComponentHealth(ctx, 0)
+	require.NoError(t, err)
+	require.Equal(t, types.ComponentHealthSummary{
+		Total:              5,
+		Active:             4,
+		Stale:              1,
+		Revoked:            1,
+		Unverified:         1,
+		FailedVerification: 1,
+	}, summary)
+
+	issues := make(map[string][]string)
+	for _, problem := range problems {
+		issues[problem.ComponentId] = problem.Issues
+	}
+	require.Equal(t, map[string][]string{
+		"MODBATT-MOD-003":  {types.HealthIssueStale},
+		"MODBATT-MOD-004":  {types.HealthIssueFailedVerification},
+		"MODBATT-PACK-001": {types.HealthIssueRevoked},
+	}, issues)
+
+	// A tighter cutoff makes every unrevoked component stale
+	summary, _, err = f.keeper.ComponentHealth(ctx, 30*time.Minute)
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), summary.Stale)
+
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	resp, err := qs.ComponentHealth(ctx, &types.QueryComponentHealthRequest{})
+	require.NoError(t, err)
+	require.Len(t, resp.Problems, 3)
+	_, err = qs.ComponentHealth(ctx, &types.QueryComponentHealthRequest{StaleAfterSeconds: -1})
+	require.Error(t, err)
+}
+
+func TestComponentHealthFollowsComponentUpdates(t *testing.T) {
+	f := initFixture(t)
+	now := time.Unix(1752484904, 0).UTC()
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(now)
+
+	component := types.Component{ComponentId: "MODBATT-MOD-001", Status: types.StatusActive, LastVerifiedAt: now.Add(-72 * time.Hour)}
+	require.NoError(t, f.keeper.Components.Set(ctx, component.ComponentId, component))
+	summary, problems, err := f.keeper.ComponentHealth(ctx, 0)
+	require.NoError(t, err)
+	require.Equal(t, types.ComponentHealthSummary{Total: 1, Active: 1, Stale: 1, Unverified: 1}, summary)
+	require.Len(t, problems, 1)
+
+	// Verifying the component moves it out of the stale and unverified counts
+	require.NoError(t, f.keeper.ComponentVerifications.Set(ctx, component.ComponentId, types.ComponentVerification{
+		ComponentId: component.ComponentId,
+		Status:      types.VerificationStatusVerified,
+		VerifiedAt:  now,
+	}))
+	component.LastVerifiedAt = now
+	require.NoError(t, f.keeper.Components.Set(ctx, component.ComponentId, component))
+	summary, problems, err = f.keeper.ComponentHealth(ctx, 0)
+	require.NoError(t, err)
+	require.Equal(t, types.ComponentHealthSummary{Total: 1, Active: 1}, summary)
+	require.Empty(t, problems)
+
+	// Suspending it leaves it counted but no longer active
+	component.Status = "suspended"
+	require.NoError(t, f.keeper.Components.Set(ctx, component.ComponentId, component))
+	summary, _, err = f.keeper.ComponentHealth(ctx, 0)
+	require.NoError(t, err)
+	require.Equal(t, types.ComponentHealthSummary{Total: 1}, summary)
+}
@@ -0,0 +1,52 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"racecar-web/x/componentregistry/keeper"
+	"racecar-web/x/componentregistry/types"
+)
+
+func TestUpdateComponentRecordsDiff(t *testing.T) {
+	f := initFixture(t)
+
+	component := types.Component{
+		ComponentId:   "MODBATT-MOD-RC001-001",
+		ComponentType: "module",
+		HardwareSpecs: `{"capacity":"25.6kWh"}`,
+		Status:        types.StatusActive,
+	}
+	require.NoError(t, f.keeper.RegisterComponent(f.ctx, component))
+
+	updated, err := f.keeper.GetComponent(f.ctx, component.ComponentId)
+	require.NoError(t, err)
+	updated.HardwareSpecs = `{"capacity":"30kWh"}`
+	require.NoError(t, f.keeper.UpdateComponent(f.ctx, updated))
+
+	history, err := f.keeper.GetComponentHistory(f.ctx, component.ComponentId)
+	require.NoError(t, err)
+	require.Len(t, history, 1)
+	require.Equal(t, uint64(1), history[0].Sequence)
+	require.Equal(t, []types.ComponentFieldChange{{
+		Field:    "hardware_specs",
+		OldValue: `{"capacity":"25.6kWh"}`,
+		NewValue: `{"capacity":"30kWh"}`,
+	}}, history[0].Changes)
+
+	// An update that changes nothing must not add a history entry
+	require.NoError(t, f.keeper.UpdateComponent(f.ctx, updated))
+
+	updated.Status = types.StatusMaintenance
+	require.NoError(t, f.keeper.UpdateComponent(f.ctx, updated))
+
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	response, err := qs.GetComponentHistory(f.ctx, &types.QueryGetComponentHistoryRequest{ComponentId: component.ComponentId})
+	require.NoError(t, err)
+	require.Len(t, response.Entries, 2)
+	require.Equal(t, uint64(2), response.Entries[1].Sequence)
+	require.Equal(t, "status", response.Entries[1].Changes[0].Field)
+	require.Equal(t, types.StatusActive, response.Entries[1].Changes[0].OldValue)
+	require.Equal(t, types.StatusMaintenance, response.Entries[1].Changes[0].NewValue)
+}
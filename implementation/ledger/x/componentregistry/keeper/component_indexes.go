@@ -0,0 +1,138 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/indexes"
+
+	"racecar-web/x/componentregistry/types"
+)
+
+// ComponentIndexes are kept up to date on every write to Components, so the
+// health report reads the components it flags instead of walking them all
+type ComponentIndexes struct {
+	Status       *indexes.Multi[string, string, types.Component] // (status, component_id)
+	LastVerified *indexes.Multi[int64, string, types.Component]  // (last_verified_at seconds, component_id)
+	StatusCounts *CountIndex[string, types.Component]            // status -> components
+}
+
+// IndexesList implements collections.Indexes
+func (i ComponentIndexes) IndexesList() []collections.Index[string, types.Component] {
+	return []collections.Index[string, types.Component]{i.Status, i.LastVerified, i.StatusCounts}
+}
+
+func newComponentIndexes(sb *collections.SchemaBuilder) ComponentIndexes {
+	return ComponentIndexes{
+		Status: indexes.NewMulti(sb, types.ComponentStatusIndexPrefix, "components_by_status", collections.StringKey, collections.StringKey,
+			func(_ string, component types.Component) (string, error) { return component.Status, nil }),
+		LastVerified: indexes.NewMulti(sb, types.ComponentLastVerifiedIndexPrefix, "components_by_last_verified", collections.Int64Key, collections.StringKey,
+			func(_ string, component types.Component) (int64, error) { return component.LastVerifiedAt.Unix(), nil }),
+		StatusCounts: newCountIndex[string](sb, types.ComponentStatusCountPrefix, "component_status_counts",
+			func(component types.Component) string { return component.Status }),
+	}
+}
+
+// VerificationIndexes are kept up to date on every write to ComponentVerifications
+type VerificationIndexes struct {
+	Status       *indexes.Multi[string, string, types.ComponentVerification] // (status, key)
+	StatusCounts *CountIndex[string, types.ComponentVerification]            // status -> verifications
+}
+
+// IndexesList implements collections.Indexes
+func (i VerificationIndexes) IndexesList() []collections.Index[string, types.ComponentVerification] {
+	return []collections.Index[string, types.ComponentVerification]{i.Status, i.StatusCounts}
+}
+
+func newVerificationIndexes(sb *collections.SchemaBuilder) VerificationIndexes {
+	return VerificationIndexes{
+		Status: indexes.NewMulti(sb, types.VerificationStatusIndexPrefix, "verifications_by_status", collections.StringKey, collections.StringKey,
+			func(_ string, verification types.ComponentVerification) (string, error) {
+				return verification.Status, nil
+			}),
+		StatusCounts: newCountIndex[string](sb, types.VerificationStatusCountPrefix, "verification_status_counts",
+			func(verification types.ComponentVerification) string { return verification.Status }),
+	}
+}
+
+// CountIndex counts the values of an indexed map by one of their fields, so
+// totals are read without walking the map
+type CountIndex[K, V any] struct {
+	field  func(V) string
+	counts collections.Map[string, uint64]
+}
+
+func newCountIndex[K, V any](sb *collections.SchemaBuilder, prefix collections.Prefix, name string, field func(V) string) *CountIndex[K, V] {
+	return &CountIndex[K, V]{
+		field:  field,
+		counts: collections.NewMap(sb, prefix, name, collections.StringKey, collections.Uint64Value),
+	}
+}
+
+// Reference implements collections.Index
+func (i *CountIndex[K, V]) Reference(ctx context.Context, _ K, newValue V, lazyOldValue func() (V, error)) error {
+	oldValue, err := lazyOldValue()
+	switch {
+	case err == nil:
+		if i.field(oldValue) == i.field(newValue) {
+			return nil
+		}
+		if err := i.add(ctx, i.field(oldValue), false); err != nil {
+			return err
+		}
+	case !errors.Is(err, collections.ErrNotFound):
+		return err
+	}
+	return i.add(ctx, i.field(newValue), true)
+}
+
+// Unreference implements collections.Index
+func (i *CountIndex[K, V]) Unreference(ctx context.Context, _ K, lazyOldValue func() (V, error)) error {
+	oldValue, err := lazyOldValue()
+	if err != nil {
+		return err
+	}
+	return i.add(ctx, i.field(oldValue), false)
+}
+
+// Count returns how many values have the given field value
+func (i *CountIndex[K, V]) Count(ctx context.Context, field string) (uint64, error) {
+	count, err := i.counts.Get(ctx, field)
+	if errors.Is(err, collections.ErrNotFound) {
+		return 0, nil
+	}
+	return count, err
+}
+
+// Total returns how many values the map holds
+func (i *CountIndex[K, V]) Total(ctx context.Context) (uint64, error) {
+	var total uint64
+	err := i.counts.Walk(ctx, nil, func(_ string, count uint64) (bool, error) {
+		total += count
+		return false, nil
+	})
+	return total, err
+}
+
+// clear forgets every count, before the map is indexed again
+func (i *CountIndex[K, V]) clear(ctx context.Context) error {
+	return i.counts.Clear(ctx, nil)
+}
+
+func (i *CountIndex[K, V]) add(ctx context.Context, field string, increment bool) error {
+	count, err := i.Count(ctx, field)
+	if err != nil {
+		return err
+	}
+	switch {
+	case increment:
+		count++
+	case count > 0:
+		count--
+	}
+	if count == 0 {
+		return i.counts.Remove(ctx, field)
+	}
+	return i.counts.Set(ctx, field, count)
+}
@@ -0,0 +1,66 @@
+package keeper_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"racecar-web/x/componentregistry/keeper"
+	"racecar-web/x/componentregistry/types"
+)
+
+// unavailableBackend is a verification backend whose service cannot be reached
+type unavailableBackend struct {
+	*types.MockMySQLBackend
+}
+
+func (unavailableBackend) GetComponentMetadata(context.Context, string) (map[string]interface{}, error) {
+	return nil, types.ErrBackendUnavailable
+}
+
+func TestGetComponentMetadata(t *testing.T) {
+	backend := types.NewMockMySQLBackend()
+	backend.AddComponentMetadata("MODBATT-MOD-001", map[string]interface{}{"type": "battery_module", "capacity_kwh": "4.2"})
+
+	component := types.Component{ComponentId: "MODBATT-MOD-001", ManufacturerId: "RaceCarBatteryCo", ComponentType: "module", Status: types.StatusActive}
+	for _, tc := range []struct {
+		name     string
+		backend  types.ComponentVerificationBackend
+		status   string
+		metadata map[string]interface{}
+	}{
+		{name: "backend answers", backend: backend, status: types.BackendStatusOK, metadata: map[string]interface{}{"type": "battery_module", "capacity_kwh": "4.2"}},
+		{name: "no backend", backend: nil, status: types.BackendStatusNotConfigured},
+		{name: "backend down", backend: unavailableBackend{backend}, status: types.BackendStatusUnavailable},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			f := initFixtureWithBackend(t, tc.backend)
+			qs := keeper.NewQueryServerImpl(f.keeper)
+			require.NoError(t, f.keeper.Components.Set(f.ctx, component.ComponentId, component))
+
+			resp, err := qs.GetComponentMetadata(f.ctx, &types.QueryGetComponentMetadataRequest{ComponentId: component.ComponentId})
+			require.NoError(t, err)
+			require.Equal(t, component.ComponentId, resp.Component.ComponentId)
+			require.Equal(t, types.StatusActive, resp.Component.Status)
+			require.Equal(t, tc.status, resp.BackendStatus)
+			if tc.metadata == nil {
+				require.Empty(t, resp.BackendMetadata)
+				return
+			}
+			var metadata map[string]interface{}
+			require.NoError(t, json.Unmarshal([]byte(resp.BackendMetadata), &metadata))
+			require.Equal(t, tc.metadata, metadata)
+		})
+	}
+
+	f := initFixtureWithBackend(t, backend)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	_, err := qs.GetComponentMetadata(f.ctx, &types.QueryGetComponentMetadataRequest{ComponentId: "MODBATT-MOD-404"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	_, err = qs.GetComponentMetadata(f.ctx, &types.QueryGetComponentMetadataRequest{})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,85 @@
+package keeper
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"cosmossdk.io/collections"
+
+	"racecar-web/x/componentregistry/types"
+)
+
+// componentContentHash identifies a physical component by its type and
+// manufacturer data. JSON data is re-encoded first, so the same metadata
+// hashes the same whatever its key order or whitespace.
+func componentContentHash(componentType, manufacturerData string) string {
+	canonical := strings.TrimSpace(manufacturerData)
+	if data, ok := decodeJSON(canonical); ok {
+		if encoded, err := json.Marshal(data); err == nil {
+			canonical = string(encoded)
+		}
+	}
+	hash := sha256.Sum256([]byte(componentType + "\x00" + canonical))
+	return hex.EncodeToString(hash[:])
+}
+
+// decodeJSON decodes data that is a single JSON value. Numbers are kept as
+// written: decoding them as float64 would round large serial numbers, giving
+// different components the same hash.
+func decodeJSON(data string) (interface{}, bool) {
+	dec := json.NewDecoder(strings.NewReader(data))
+	dec.UseNumber()
+	var value interface{}
+	if err := dec.Decode(&value); err != nil {
+		return nil, false
+	}
+	if _, err := dec.Token(); err != io.EOF {
+		return nil, false
+	}
+	return value, true
+}
+
+// indexComponentContent records a component under its content hash. The
+// first component registered with given content keeps the entry, so dedupe
+// always resolves to it. Anonymous components carry no type or metadata and
+// are not indexed.
+func (k Keeper) indexComponentContent(ctx context.Context, component types.Component) error {
+	if component.ComponentType == "" {
+		return nil
+	}
+	contentHash := componentContentHash(component.ComponentType, component.HardwareSpecs)
+	has, err := k.ContentHashIndex.Has(ctx, contentHash)
+	if err != nil {
+		return fmt.Errorf("failed to read content hash index: %w", err)
+	}
+	if has {
+		return nil
+	}
+	if err := k.ContentHashIndex.Set(ctx, contentHash, component.ComponentId); err != nil {
+		return fmt.Errorf("failed to index content hash: %w", err)
+	}
+	return nil
+}
+
+// findComponentByContent returns the component registered with the given
+// type and manufacturer data, if any
+func (k Keeper) findComponentByContent(ctx context.Context, componentType, manufacturerData string) (types.Component, bool, error) {
+	componentID, err := k.ContentHashIndex.Get(ctx, componentContentHash(componentType, manufacturerData))
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.Component{}, false, nil
+	}
+	if err != nil {
+		return types.Component{}, false, fmt.Errorf("failed to read content hash index: %w", err)
+	}
+	component, err := k.Components.Get(ctx, componentID)
+	if err != nil {
+		return types.Component{}, false, fmt.Errorf("failed to get component %s: %w", componentID, err)
+	}
+	return component, true, nil
+}
@@ -0,0 +1,97 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"racecar-web/x/componentregistry/types"
+)
+
+// GetCustodyChain returns every recorded change of a component's owner,
+// oldest first, starting with its registration
+func (k Keeper) GetCustodyChain(ctx context.Context, componentId string) ([]types.CustodyEvent, error) {
+	var events []types.CustodyEvent
+
+	rng := collections.NewPrefixedPairRange[string, uint64](componentId)
+	err := k.CustodyEvents.Walk(ctx, rng, func(_ collections.Pair[string, uint64], event types.CustodyEvent) (bool, error) {
+		events = append(events, event)
+		return false, nil
+	})
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to walk custody chain")
+	}
+
+	return events, nil
+}
+
+// TransferComponent hands a component from its current owner to newOwner and
+// records the change in its custody chain. The owner is the component's trust
+// anchor, so the change also shows in the component's history.
+func (k Keeper) TransferComponent(ctx context.Context, owner, componentId, newOwner, reason string) (types.CustodyEvent, error) {
+	component, err := k.Components.Get(ctx, componentId)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.CustodyEvent{}, errorsmod.Wrapf(types.ErrComponentNotFound, "component %s", componentId)
+		}
+		return types.CustodyEvent{}, err
+	}
+	if component.TrustAnchor != owner {
+		return types.CustodyEvent{}, errorsmod.Wrapf(types.ErrNotComponentOwner, "%s does not own component %s", owner, componentId)
+	}
+	if newOwner == owner {
+		return types.CustodyEvent{}, errorsmod.Wrap(types.ErrInvalidTransfer, "component already belongs to the new owner")
+	}
+
+	// Components registered before custody was tracked start their chain here
+	events, err := k.GetCustodyChain(ctx, componentId)
+	if err != nil {
+		return types.CustodyEvent{}, err
+	}
+	if len(events) == 0 {
+		origin := types.CustodyEvent{
+			ComponentId:   componentId,
+			Sequence:      1,
+			ToOwner:       owner,
+			Reason:        "registered",
+			TransferredAt: component.CreatedAt,
+		}
+		if err := k.CustodyEvents.Set(ctx, collections.Join(componentId, origin.Sequence), origin); err != nil {
+			return types.CustodyEvent{}, err
+		}
+		events = append(events, origin)
+	}
+
+	component.TrustAnchor = newOwner
+	if err := k.UpdateComponent(ctx, component); err != nil {
+		return types.CustodyEvent{}, err
+	}
+
+	event := k.newCustodyEvent(ctx, componentId, events[len(events)-1].Sequence+1, owner, newOwner, reason)
+	if err := k.CustodyEvents.Set(ctx, collections.Join(componentId, event.Sequence), event); err != nil {
+		return types.CustodyEvent{}, err
+	}
+	return event, nil
+}
+
+// recordRegistration starts the custody chain of a newly registered component
+func (k Keeper) recordRegistration(ctx context.Context, componentId, owner string) error {
+	event := k.newCustodyEvent(ctx, componentId, 1, "", owner, "registered")
+	return k.CustodyEvents.Set(ctx, collections.Join(componentId, event.Sequence), event)
+}
+
+func (k Keeper) newCustodyEvent(ctx context.Context, componentId string, sequence uint64, from, to, reason string) types.CustodyEvent {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	return types.CustodyEvent{
+		ComponentId:   componentId,
+		Sequence:      sequence,
+		FromOwner:     from,
+		ToOwner:       to,
+		Reason:        reason,
+		TransferredAt: sdkCtx.BlockTime(),
+		BlockHeight:   sdkCtx.BlockHeight(),
+	}
+}
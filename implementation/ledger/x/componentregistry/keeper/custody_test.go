@@ -0,0 +1,96 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"racecar-web/x/componentregistry/keeper"
+	"racecar-web/x/componentregistry/types"
+)
+
+func TestCustodyChainAfterTransfers(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	factory := sdk.AccAddress("factory_____________").String()
+	integrator := sdk.AccAddress("integrator__________").String()
+	fleet := sdk.AccAddress("fleet_______________").String()
+	start := time.Unix(1752484904, 0).UTC()
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(start).WithBlockHeight(10)
+
+	_, err := ms.RegisterComponent(ctx, &types.MsgRegisterComponent{
+		Creator:          factory,
+		ComponentId:      "MODBATT-MOD-001",
+		ComponentType:    types.ComponentTypeModule,
+		ManufacturerData: `{"manufacturer_id":"RaceCarBatteryCo"}`,
+	})
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockTime(start.Add(time.Hour)).WithBlockHeight(20)
+	resp, err := ms.TransferComponent(ctx, &types.MsgTransferComponent{Creator: factory, ComponentId: "MODBATT-MOD-001", NewOwner: integrator, Reason: "shipped"})
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), resp.Sequence)
+
+	// Only the current owner can transfer, and not to itself
+	_, err = ms.TransferComponent(ctx, &types.MsgTransferComponent{Creator: factory, ComponentId: "MODBATT-MOD-001", NewOwner: fleet})
+	require.ErrorIs(t, err, types.ErrNotComponentOwner)
+	_, err = ms.TransferComponent(ctx, &types.MsgTransferComponent{Creator: integrator, ComponentId: "MODBATT-MOD-001", NewOwner: integrator})
+	require.ErrorIs(t, err, types.ErrInvalidTransfer)
+	_, err = ms.TransferComponent(ctx, &types.MsgTransferComponent{Creator: integrator, ComponentId: "MODBATT-MOD-001", NewOwner: "not-an-address"})
+	require.ErrorIs(t, err, types.ErrInvalidTransfer)
+	_, err = ms.TransferComponent(ctx, &types.MsgTransferComponent{Creator: integrator, ComponentId: "MODBATT-MOD-404", NewOwner: fleet})
+	require.ErrorIs(t, err, types.ErrComponentNotFound)
+
+	ctx = ctx.WithBlockTime(start.Add(2 * time.Hour)).WithBlockHeight(30)
+	_, err = ms.TransferComponent(ctx, &types.MsgTransferComponent{Creator: integrator, ComponentId: "MODBATT-MOD-001", NewOwner: fleet, Reason: "installed in vehicle"})
+	require.NoError(t, err)
+
+	chain, err := qs.GetCustodyChain(ctx, &types.QueryGetCustodyChainRequest{ComponentId: "MODBATT-MOD-001"})
+	require.NoError(t, err)
+	require.Equal(t, []types.CustodyEvent{
+		{ComponentId: "MODBATT-MOD-001", Sequence: 1, ToOwner: factory, Reason: "registered", TransferredAt: start, BlockHeight: 10},
+		{ComponentId: "MODBATT-MOD-001", Sequence: 2, FromOwner: factory, ToOwner: integrator, Reason: "shipped", TransferredAt: start.Add(time.Hour), BlockHeight: 20},
+		{ComponentId: "MODBATT-MOD-001", Sequence: 3, FromOwner: integrator, ToOwner: fleet, Reason: "installed in vehicle", TransferredAt: start.Add(2 * time.Hour), BlockHeight: 30},
+	}, chain.Events)
+
+	component, err := f.keeper.GetComponent(ctx, "MODBATT-MOD-001")
+	require.NoError(t, err)
+	require.Equal(t, fleet, component.TrustAnchor)
+	history, err := f.keeper.GetComponentHistory(ctx, "MODBATT-MOD-001")
+	require.NoError(t, err)
+	require.Len(t, history, 2)
+
+	_, err = qs.GetCustodyChain(ctx, &types.QueryGetCustodyChainRequest{ComponentId: "MODBATT-MOD-404"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestCustodyChainStartsAtFirstTransferForOlderComponents(t *testing.T) {
+	f := initFixture(t)
+	owner := sdk.AccAddress("factory_____________").String()
+	buyer := sdk.AccAddress("fleet_______________").String()
+	registered := time.Unix(1752400000, 0).UTC()
+
+	// Registered without a custody event, as before custody was tracked
+	require.NoError(t, f.keeper.Components.Set(f.ctx, "MODBATT-PACK-001", types.Component{
+		ComponentId: "MODBATT-PACK-001",
+		Status:      types.StatusActive,
+		TrustAnchor: owner,
+		CreatedAt:   registered,
+	}))
+
+	_, err := f.keeper.TransferComponent(f.ctx, owner, "MODBATT-PACK-001", buyer, "sold")
+	require.NoError(t, err)
+
+	chain, err := f.keeper.GetCustodyChain(f.ctx, "MODBATT-PACK-001")
+	require.NoError(t, err)
+	require.Len(t, chain, 2)
+	require.Equal(t, types.CustodyEvent{ComponentId: "MODBATT-PACK-001", Sequence: 1, ToOwner: owner, Reason: "registered", TransferredAt: registered}, chain[0])
+	require.Equal(t, owner, chain[1].FromOwner)
+	require.Equal(t, buyer, chain[1].ToOwner)
+}
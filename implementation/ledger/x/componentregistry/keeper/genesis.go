@@ -20,6 +20,19 @@ func (k Keeper) InitGenesis(ctx context.Context, genState types.GenesisState) er
 		if err := k.Components.Set(ctx, component.ComponentId, component); err != nil {
 			return errors.Wrapf(err, "failed to initialize component %s", component.ComponentId)
 		}
+		// Rebuild the manufacturer index so quotas count imported components
+		if err := k.indexManufacturerComponent(ctx, component); err != nil {
+			return errors.Wrapf(err, "failed to index component %s", component.ComponentId)
+		}
+		if err := k.indexManufacturerHash(ctx, component); err != nil {
+			return errors.Wrapf(err, "failed to index component %s", component.ComponentId)
+		}
+		if err := k.indexCategoryHash(ctx, component); err != nil {
+			return errors.Wrapf(err, "failed to index component %s", component.ComponentId)
+		}
+		if err := k.indexComponentContent(ctx, component); err != nil {
+			return errors.Wrapf(err, "failed to index component %s", component.ComponentId)
+		}
 	}
 
 	// Set verifications
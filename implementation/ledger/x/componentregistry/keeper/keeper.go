@@ -1,9 +1,11 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
 	"crypto/sha256"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"time"
 
@@ -12,6 +14,8 @@ import (
 	corestore "cosmossdk.io/core/store"
 	errorsmod "cosmossdk.io/errors"
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"racecar-web/x/componentregistry/types"
 	lctmanagertypes "racecar-web/x/lctmanager/types"
@@ -27,11 +31,20 @@ type Keeper struct {
 
 	// Component storage
 	Params                 collections.Item[types.Params]
-	Components             collections.Map[string, types.Component]
-	ComponentVerifications collections.Map[string, types.ComponentVerification]
+	Components             *collections.IndexedMap[string, types.Component, ComponentIndexes]
+	ComponentVerifications *collections.IndexedMap[string, types.ComponentVerification, VerificationIndexes]
 	ComponentPairingRules  collections.Map[string, types.ComponentPairingRule]
 	ManufacturerComponents collections.Map[string, types.Component] // manufacturer_id -> component (simplified)
+	ManufacturerCounts     collections.Map[string, uint64]          // manufacturer_id -> registered component count
 	PairingAuthorizations  collections.Map[string, types.PairingAuthorization]
+	ComponentHistory       collections.Map[collections.Pair[string, uint64], types.ComponentHistoryEntry] // (component_id, sequence) -> diff
+	RevocationEvents       collections.Map[string, types.AnonymousRevocationEvent]
+	RevocationTargetIndex  collections.Map[collections.Triple[string, int64, string], string]          // (target_hash, effective_at, revocation_id) -> revocation_id
+	CustodyEvents          collections.Map[collections.Pair[string, uint64], types.CustodyEvent]       // (component_id, sequence) -> custody change
+	ManufacturerHashIndex  collections.Map[collections.Pair[string, string], string]                   // (manufacturer_hash, component_id) -> component_id
+	VerificationRecords    collections.Map[collections.Pair[string, uint64], types.VerificationRecord] // (component_id, sequence) -> verification
+	ContentHashIndex       collections.Map[string, string]                                             // content_hash -> component_id
+	CategoryHashIndex      collections.Map[collections.Pair[string, string], string]                   // (category_hash, component_id) -> component_id
 
 	// Pluggable verification backend
 	verificationBackend types.ComponentVerificationBackend
@@ -66,11 +79,20 @@ func NewKeeper(
 		lctmanagerKeeper:    lctmanagerKeeper,
 
 		Params:                 collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
-		Components:             collections.NewMap(sb, types.ComponentPrefix, "components", collections.StringKey, codec.CollValue[types.Component](cdc)),
-		ComponentVerifications: collections.NewMap(sb, types.VerificationPrefix, "verifications", collections.StringKey, codec.CollValue[types.ComponentVerification](cdc)),
+		Components:             collections.NewIndexedMap(sb, types.ComponentPrefix, "components", collections.StringKey, codec.CollValue[types.Component](cdc), newComponentIndexes(sb)),
+		ComponentVerifications: collections.NewIndexedMap(sb, types.VerificationPrefix, "verifications", collections.StringKey, codec.CollValue[types.ComponentVerification](cdc), newVerificationIndexes(sb)),
 		ComponentPairingRules:  collections.NewMap(sb, types.PairingRulesPrefix, "pairing_rules", collections.StringKey, codec.CollValue[types.ComponentPairingRule](cdc)),
 		ManufacturerComponents: collections.NewMap(sb, types.ManufacturerComponentKey, "manufacturer_components", collections.StringKey, codec.CollValue[types.Component](cdc)),
+		ManufacturerCounts:     collections.NewMap(sb, types.ManufacturerCountPrefix, "manufacturer_counts", collections.StringKey, collections.Uint64Value),
 		PairingAuthorizations:  collections.NewMap(sb, types.PairingAuthorizationKey, "pairing_authorizations", collections.StringKey, codec.CollValue[types.PairingAuthorization](cdc)),
+		ComponentHistory:       collections.NewMap(sb, types.ComponentHistoryPrefix, "component_history", collections.PairKeyCodec(collections.StringKey, collections.Uint64Key), codec.CollValue[types.ComponentHistoryEntry](cdc)),
+		RevocationEvents:       collections.NewMap(sb, types.RevocationEventPrefix, "revocation_events", collections.StringKey, codec.CollValue[types.AnonymousRevocationEvent](cdc)),
+		RevocationTargetIndex:  collections.NewMap(sb, types.RevocationTargetPrefix, "revocation_target_index", collections.TripleKeyCodec(collections.StringKey, collections.Int64Key, collections.StringKey), collections.StringValue),
+		CustodyEvents:          collections.NewMap(sb, types.CustodyEventPrefix, "custody_events", collections.PairKeyCodec(collections.StringKey, collections.Uint64Key), codec.CollValue[types.CustodyEvent](cdc)),
+		ManufacturerHashIndex:  collections.NewMap(sb, types.ManufacturerHashPrefix, "manufacturer_hash_index", collections.PairKeyCodec(collections.StringKey, collections.StringKey), collections.StringValue),
+		VerificationRecords:    collections.NewMap(sb, types.VerificationRecordPrefix, "verification_records", collections.PairKeyCodec(collections.StringKey, collections.Uint64Key), codec.CollValue[types.VerificationRecord](cdc)),
+		ContentHashIndex:       collections.NewMap(sb, types.ContentHashPrefix, "content_hash_index", collections.StringKey, collections.StringValue),
+		CategoryHashIndex:      collections.NewMap(sb, types.CategoryHashPrefix, "category_hash_index", collections.PairKeyCodec(collections.StringKey, collections.StringKey), collections.StringValue),
 	}
 
 	schema, err := sb.Build()
@@ -98,6 +120,69 @@ func (k Keeper) SetParams(ctx context.Context, params types.Params) error {
 	return k.Params.Set(ctx, params)
 }
 
+// paramsOrDefault returns the stored params, falling back to the permissive defaults
+// when none have been stored yet
+func (k Keeper) paramsOrDefault(ctx context.Context) (types.Params, error) {
+	params, err := k.Params.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.DefaultParams(), nil
+	}
+	return params, err
+}
+
+// checkCreatorAllowed rejects creators that are not on the registration allowlist
+func (k Keeper) checkCreatorAllowed(ctx context.Context, creator string) error {
+	params, err := k.paramsOrDefault(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !params.IsCreatorAllowed(creator) {
+		return errorsmod.Wrapf(types.ErrCreatorNotAllowed, "creator %s is not permitted to register components", creator)
+	}
+	return nil
+}
+
+// checkManufacturerQuota rejects a registration once the manufacturer has used up its quota
+func (k Keeper) checkManufacturerQuota(ctx context.Context, manufacturerId string) error {
+	params, err := k.paramsOrDefault(ctx)
+	if err != nil {
+		return err
+	}
+
+	count, err := k.GetManufacturerComponentCount(ctx, manufacturerId)
+	if err != nil {
+		return err
+	}
+
+	if params.IsManufacturerQuotaExceeded(count) {
+		return errorsmod.Wrapf(types.ErrManufacturerQuotaExceeded, "manufacturer %s has already registered %d of %d components",
+			manufacturerId, count, params.MaxComponentsPerManufacturer)
+	}
+	return nil
+}
+
+// indexManufacturerComponent adds a newly stored component to the manufacturer index
+func (k Keeper) indexManufacturerComponent(ctx context.Context, component types.Component) error {
+	count, err := k.GetManufacturerComponentCount(ctx, component.ManufacturerId)
+	if err != nil {
+		return err
+	}
+	if err := k.ManufacturerCounts.Set(ctx, component.ManufacturerId, count+1); err != nil {
+		return err
+	}
+	return k.ManufacturerComponents.Set(ctx, component.ManufacturerId, component)
+}
+
+// GetManufacturerComponentCount returns how many components a manufacturer has registered
+func (k Keeper) GetManufacturerComponentCount(ctx context.Context, manufacturerId string) (uint64, error) {
+	count, err := k.ManufacturerCounts.Get(ctx, manufacturerId)
+	if errors.Is(err, collections.ErrNotFound) {
+		return 0, nil
+	}
+	return count, err
+}
+
 // RegisterComponent registers a new component in the system
 func (k Keeper) RegisterComponent(ctx context.Context, component types.Component) error {
 	// Check if component already exists
@@ -108,6 +193,9 @@ func (k Keeper) RegisterComponent(ctx context.Context, component types.Component
 	if exists {
 		return fmt.Errorf("component %s already registered", component.ComponentId)
 	}
+	if err := k.checkManufacturerQuota(ctx, component.ManufacturerId); err != nil {
+		return err
+	}
 
 	// Set creation timestamp
 	component.CreatedAt = time.Now()
@@ -119,7 +207,7 @@ func (k Keeper) RegisterComponent(ctx context.Context, component types.Component
 	}
 
 	// Update manufacturer index (store the component directly)
-	return k.ManufacturerComponents.Set(ctx, component.ManufacturerId, component)
+	return k.indexManufacturerComponent(ctx, component)
 }
 
 // GetComponent retrieves a component by ID
@@ -127,19 +215,65 @@ func (k Keeper) GetComponent(ctx context.Context, componentId string) (types.Com
 	return k.Components.Get(ctx, componentId)
 }
 
-// UpdateComponent updates an existing component
+// UpdateComponent updates an existing component and records the changed fields in its history
 func (k Keeper) UpdateComponent(ctx context.Context, component types.Component) error {
-	exists, err := k.Components.Has(ctx, component.ComponentId)
+	existing, err := k.Components.Get(ctx, component.ComponentId)
 	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return fmt.Errorf("component %s not found", component.ComponentId)
+		}
 		return err
 	}
-	if !exists {
-		return fmt.Errorf("component %s not found", component.ComponentId)
+
+	if changes := diffComponents(existing, component); len(changes) > 0 {
+		if err := k.appendComponentHistory(ctx, component.ComponentId, changes); err != nil {
+			return errorsmod.Wrap(err, "failed to record component history")
+		}
 	}
 
 	return k.Components.Set(ctx, component.ComponentId, component)
 }
 
+// GetComponentHistory returns the recorded update diffs for a component, oldest first
+func (k Keeper) GetComponentHistory(ctx context.Context, componentId string) ([]types.ComponentHistoryEntry, error) {
+	var entries []types.ComponentHistoryEntry
+
+	rng := collections.NewPrefixedPairRange[string, uint64](componentId)
+	err := k.ComponentHistory.Walk(ctx, rng, func(_ collections.Pair[string, uint64], entry types.ComponentHistoryEntry) (bool, error) {
+		entries = append(entries, entry)
+		return false, nil
+	})
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to walk component history")
+	}
+
+	return entries, nil
+}
+
+// appendComponentHistory stores a new history entry with the next sequence number for the component
+func (k Keeper) appendComponentHistory(ctx context.Context, componentId string, changes []types.ComponentFieldChange) error {
+	var lastSequence uint64
+
+	rng := collections.NewPrefixedPairRange[string, uint64](componentId).Descending()
+	err := k.ComponentHistory.Walk(ctx, rng, func(key collections.Pair[string, uint64], _ types.ComponentHistoryEntry) (bool, error) {
+		lastSequence = key.K2()
+		return true, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	sequence := lastSequence + 1
+	entry := types.ComponentHistoryEntry{
+		ComponentId: componentId,
+		Sequence:    sequence,
+		ChangedAt:   sdk.UnwrapSDKContext(ctx).BlockTime(),
+		Changes:     changes,
+	}
+
+	return k.ComponentHistory.Set(ctx, collections.Join(componentId, sequence), entry)
+}
+
 // VerifyComponent updates component verification status
 func (k Keeper) VerifyComponent(ctx context.Context, verification types.ComponentVerification) error {
 	// Verify component exists
@@ -256,23 +390,21 @@ func (k Keeper) RemoveComponentRelationship(ctx context.Context, componentId, lc
 	return k.Components.Set(ctx, componentId, component)
 }
 
-// GetPairingRules retrieves all pairing rules for a component type
-func (k Keeper) GetPairingRules(ctx context.Context, componentType string) ([]*types.ComponentPairingRule, error) {
-	var rules []*types.ComponentPairingRule
-
-	// Get pairing rules for a component type
-	err := k.ComponentPairingRules.Walk(ctx, nil, func(key string, rule types.ComponentPairingRule) (bool, error) {
-		if rule.SourceTypeHash == componentType {
-			rules = append(rules, &rule)
-		}
-		return false, nil
-	})
-
+// GetPairingRules retrieves one page of the pairing rules whose source is
+// componentType. Rules store type hashes, so both the type and its hash match.
+func (k Keeper) GetPairingRules(ctx context.Context, componentType string, pageReq *query.PageRequest) ([]types.ComponentPairingRule, *query.PageResponse, error) {
+	typeHash := k.generateHash(componentType)
+	rules, pageRes, err := query.CollectionFilteredPaginate(ctx, k.ComponentPairingRules, pageReq,
+		func(_ string, rule types.ComponentPairingRule) (bool, error) {
+			return rule.SourceTypeHash == componentType || rule.SourceTypeHash == typeHash, nil
+		},
+		func(_ string, rule types.ComponentPairingRule) (types.ComponentPairingRule, error) {
+			return rule, nil
+		})
 	if err != nil {
-		return nil, errorsmod.Wrap(err, "failed to walk pairing rules")
+		return nil, nil, errorsmod.Wrap(err, "failed to paginate pairing rules")
 	}
-
-	return rules, nil
+	return rules, pageRes, nil
 }
 
 // ListComponents retrieves all components
@@ -291,6 +423,21 @@ func (k Keeper) ListComponents(ctx context.Context) ([]*types.Component, error)
 	return components, nil
 }
 
+// ListComponentsPaginated retrieves one page of components, ordered by component ID.
+// Only the Components map is paged, so the manufacturer index never adds entries or
+// inflates the total.
+func (k Keeper) ListComponentsPaginated(ctx context.Context, pageReq *query.PageRequest) ([]types.Component, *query.PageResponse, error) {
+	components, pageRes, err := query.CollectionPaginate(ctx, k.Components, pageReq,
+		func(_ string, component types.Component) (types.Component, error) {
+			return component, nil
+		})
+	if err != nil {
+		return nil, nil, errorsmod.Wrap(err, "failed to paginate components")
+	}
+
+	return components, pageRes, nil
+}
+
 // VerifyComponentPairingWithBackend uses the pluggable backend to verify component pairing
 func (k Keeper) VerifyComponentPairingWithBackend(ctx context.Context, componentA, componentB string) (bool, string, error) {
 	if k.verificationBackend == nil {
@@ -321,6 +468,33 @@ func (k Keeper) GetComponentMetadataFromBackend(ctx context.Context, componentID
 	return k.verificationBackend.GetComponentMetadata(ctx, componentID)
 }
 
+// GetComponentWithBackendMetadata retrieves a registered component together
+// with the metadata the verification backend holds for it. A missing or
+// failing backend does not fail the lookup: the metadata is then nil and the
+// returned backend status says why.
+func (k Keeper) GetComponentWithBackendMetadata(ctx context.Context, componentID string) (types.Component, map[string]interface{}, string, error) {
+	component, err := k.Components.Get(ctx, componentID)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.Component{}, nil, "", errorsmod.Wrap(types.ErrComponentNotFound, componentID)
+		}
+		return types.Component{}, nil, "", err
+	}
+
+	if k.verificationBackend == nil {
+		return component, nil, types.BackendStatusNotConfigured, nil
+	}
+
+	metadata, err := k.verificationBackend.GetComponentMetadata(ctx, componentID)
+	switch {
+	case errors.Is(err, types.ErrBackendUnavailable):
+		return component, nil, types.BackendStatusUnavailable, nil
+	case err != nil:
+		return component, nil, types.BackendStatusError, nil
+	}
+	return component, metadata, types.BackendStatusOK, nil
+}
+
 // GetComponentIdentity returns a ComponentIdentity for the pairingqueue module interface
 func (k Keeper) GetComponentIdentity(ctx context.Context, componentId string) (types.ComponentIdentity, bool) {
 	component, err := k.Components.Get(ctx, componentId)
@@ -381,12 +555,46 @@ func (k Keeper) GenerateComponentHash(ctx context.Context, realComponentID strin
 	return k.verificationBackend.GenerateComponentHash(ctx, realComponentID)
 }
 
-// ResolveComponentHash resolves a component hash to real component data
-func (k Keeper) ResolveComponentHash(ctx context.Context, componentHash string) (map[string]interface{}, error) {
+// ResolveComponentHash resolves a component hash through the verification
+// backend to the component's type and status. The status is the on-chain one
+// when the hash is registered and "unregistered" otherwise. The real
+// component ID is only returned when requester is the module authority.
+func (k Keeper) ResolveComponentHash(ctx context.Context, componentHash, requester string) (types.ResolvedComponentHash, error) {
 	if k.verificationBackend == nil {
-		return nil, fmt.Errorf("verification backend not configured")
+		return types.ResolvedComponentHash{}, types.ErrBackendNotConfigured
+	}
+
+	resolved, err := k.verificationBackend.ResolveComponentHash(ctx, componentHash)
+	if err != nil {
+		if errors.Is(err, types.ErrComponentHashNotFound) {
+			return types.ResolvedComponentHash{}, err
+		}
+		return types.ResolvedComponentHash{}, errorsmod.Wrap(err, "failed to resolve component hash")
+	}
+
+	result := types.ResolvedComponentHash{
+		ComponentHash: componentHash,
+		ComponentType: "unknown",
+		Status:        "unregistered",
+	}
+	if metadata, ok := resolved["metadata"].(map[string]interface{}); ok {
+		if componentType, ok := metadata["type"].(string); ok && componentType != "" {
+			result.ComponentType = componentType
+		}
+	}
+
+	component, err := k.Components.Get(ctx, componentHash)
+	switch {
+	case err == nil:
+		result.Status = component.Status
+	case !errors.Is(err, collections.ErrNotFound):
+		return types.ResolvedComponentHash{}, err
+	}
+
+	if requesterBytes, err := k.addressCodec.StringToBytes(requester); err == nil && bytes.Equal(requesterBytes, k.authority) {
+		result.RealComponentID, _ = resolved["real_component_id"].(string)
 	}
-	return k.verificationBackend.ResolveComponentHash(ctx, componentHash)
+	return result, nil
 }
 
 // VerifyComponentPairingWithHashes verifies pairing using component hashes
@@ -448,10 +656,90 @@ func (k Keeper) RegisterAnonymousComponent(ctx context.Context, realComponentID,
 	if err := k.Components.Set(ctx, componentHash, component); err != nil {
 		return types.Component{}, fmt.Errorf("failed to store component: %w", err)
 	}
+	if err := k.indexManufacturerHash(ctx, component); err != nil {
+		return types.Component{}, err
+	}
+	if err := k.indexCategoryHash(ctx, component); err != nil {
+		return types.Component{}, err
+	}
 
 	return component, nil
 }
 
+// indexManufacturerHash records a component under its manufacturer hash so
+// searches by manufacturer read only that manufacturer's components. The hash
+// is fixed at registration, so the entry never needs moving.
+func (k Keeper) indexManufacturerHash(ctx context.Context, component types.Component) error {
+	if component.ManufacturerHash == "" {
+		return nil
+	}
+	if err := k.ManufacturerHashIndex.Set(ctx, collections.Join(component.ManufacturerHash, component.ComponentId), component.ComponentId); err != nil {
+		return fmt.Errorf("failed to index manufacturer hash: %w", err)
+	}
+	return nil
+}
+
+// indexCategoryHash records a component under its category hash so searches
+// by category read only that category's components. Like the manufacturer
+// hash, the category hash is fixed at registration.
+func (k Keeper) indexCategoryHash(ctx context.Context, component types.Component) error {
+	if component.CategoryHash == "" {
+		return nil
+	}
+	if err := k.CategoryHashIndex.Set(ctx, collections.Join(component.CategoryHash, component.ComponentId), component.ComponentId); err != nil {
+		return fmt.Errorf("failed to index category hash: %w", err)
+	}
+	return nil
+}
+
+// SearchComponents retrieves one page of the components that pass filter.
+// With a manufacturer hash the manufacturer's entries in the hash index are
+// paged, in component ID order, and with only a category hash the category's
+// entries are; otherwise every component is walked in component ID order and
+// checked against the filter.
+func (k Keeper) SearchComponents(ctx context.Context, filter types.ComponentSearchFilter, pageReq *query.PageRequest) ([]types.Component, *query.PageResponse, error) {
+	switch {
+	case filter.ManufacturerHash != "":
+		return k.searchHashIndex(ctx, k.ManufacturerHashIndex, filter.ManufacturerHash, filter, pageReq)
+	case filter.CategoryHash != "":
+		return k.searchHashIndex(ctx, k.CategoryHashIndex, filter.CategoryHash, filter, pageReq)
+	}
+
+	components, pageRes, err := query.CollectionFilteredPaginate(ctx, k.Components, pageReq,
+		func(_ string, component types.Component) (bool, error) {
+			return filter.Matches(component), nil
+		},
+		func(_ string, component types.Component) (types.Component, error) {
+			return component, nil
+		})
+	if err != nil {
+		return nil, nil, errorsmod.Wrap(err, "failed to paginate components")
+	}
+	return components, pageRes, nil
+}
+
+// searchHashIndex pages the entries of a (hash, component_id) index under
+// hash and keeps the components that pass filter
+func (k Keeper) searchHashIndex(ctx context.Context, index collections.Map[collections.Pair[string, string], string], hash string, filter types.ComponentSearchFilter, pageReq *query.PageRequest) ([]types.Component, *query.PageResponse, error) {
+	components, pageRes, err := query.CollectionFilteredPaginate(ctx, index, pageReq,
+		func(_ collections.Pair[string, string], componentID string) (bool, error) {
+			component, err := k.Components.Get(ctx, componentID)
+			if err != nil {
+				return false, err
+			}
+			return filter.Matches(component), nil
+		},
+		func(_ collections.Pair[string, string], componentID string) (types.Component, error) {
+			return k.Components.Get(ctx, componentID)
+		},
+		query.WithCollectionPaginationPairPrefix[string, string](hash),
+	)
+	if err != nil {
+		return nil, nil, errorsmod.Wrapf(err, "failed to paginate %s", index.GetName())
+	}
+	return components, pageRes, nil
+}
+
 // generateHash creates a SHA-256 hash of the input string
 func (k Keeper) generateHash(input string) string {
 	hash := sha256.Sum256([]byte(input))
@@ -497,19 +785,29 @@ func (k Keeper) CreateAnonymousPairingAuthorization(ctx context.Context, compone
 
 // CreateAnonymousRevocationEvent creates an anonymous revocation event
 func (k Keeper) CreateAnonymousRevocationEvent(ctx context.Context, targetHash, revocationType, urgencyLevel, reasonCategory, initiatorHash string) (types.AnonymousRevocationEvent, error) {
-	revocationID := k.generateHash(fmt.Sprintf("%s-%s-%d", targetHash, initiatorHash, time.Now().Unix()))
+	// Block time keeps the event and its index entry identical on every node
+	effectiveAt := sdk.UnwrapSDKContext(ctx).BlockTime().UTC()
+	revocationID := k.generateHash(fmt.Sprintf("%s-%s-%d", targetHash, initiatorHash, effectiveAt.Unix()))
 
 	revocation := types.AnonymousRevocationEvent{
 		RevocationId:   revocationID,
 		TargetHash:     targetHash,
 		RevocationType: revocationType,
 		UrgencyLevel:   urgencyLevel,
-		EffectiveAt:    time.Now(),
+		EffectiveAt:    effectiveAt,
 		ReasonCategory: reasonCategory,
 		InitiatorHash:  initiatorHash,
 	}
 
-	// Store the revocation event
+	// Store the revocation event and index it by target and time
+	if err := k.RevocationEvents.Set(ctx, revocationID, revocation); err != nil {
+		return types.AnonymousRevocationEvent{}, fmt.Errorf("failed to store revocation event: %w", err)
+	}
+	if err := k.RevocationTargetIndex.Set(ctx, collections.Join3(targetHash, effectiveAt.Unix(), revocationID), revocationID); err != nil {
+		return types.AnonymousRevocationEvent{}, fmt.Errorf("failed to index revocation event: %w", err)
+	}
+
+	// Record the revocation against the target's verification status
 	if err := k.ComponentVerifications.Set(ctx, revocationID, types.ComponentVerification{
 		ComponentId:          targetHash,
 		Status:               "revoked",
@@ -533,3 +831,43 @@ func (k Keeper) CreateAnonymousRevocationEvent(ctx context.Context, targetHash,
 
 	return revocation, nil
 }
+
+// withTargetPrefix restricts a revocation index pagination to one target hash
+func withTargetPrefix(targetHash string) func(*query.CollectionsPaginateOptions[collections.Triple[string, int64, string]]) {
+	return func(o *query.CollectionsPaginateOptions[collections.Triple[string, int64, string]]) {
+		prefix := collections.TriplePrefix[string, int64, string](targetHash)
+		o.Prefix = &prefix
+	}
+}
+
+// GetRevocationEvents retrieves one page of revocation events within filter.
+// With a target hash the target index is paged, ordered by effective time;
+// otherwise every revocation is paged in revocation ID order.
+func (k Keeper) GetRevocationEvents(ctx context.Context, targetHash string, filter types.RevocationFilter, pageReq *query.PageRequest) ([]types.AnonymousRevocationEvent, *query.PageResponse, error) {
+	if targetHash == "" {
+		events, pageRes, err := query.CollectionFilteredPaginate(ctx, k.RevocationEvents, pageReq,
+			func(_ string, event types.AnonymousRevocationEvent) (bool, error) {
+				return filter.Contains(event.EffectiveAt.Unix()), nil
+			},
+			func(_ string, event types.AnonymousRevocationEvent) (types.AnonymousRevocationEvent, error) {
+				return event, nil
+			})
+		if err != nil {
+			return nil, nil, errorsmod.Wrap(err, "failed to paginate revocation events")
+		}
+		return events, pageRes, nil
+	}
+
+	events, pageRes, err := query.CollectionFilteredPaginate(ctx, k.RevocationTargetIndex, pageReq,
+		func(key collections.Triple[string, int64, string], _ string) (bool, error) {
+			return filter.Contains(key.K2()), nil
+		},
+		func(_ collections.Triple[string, int64, string], revocationID string) (types.AnonymousRevocationEvent, error) {
+			return k.RevocationEvents.Get(ctx, revocationID)
+		},
+		withTargetPrefix(targetHash))
+	if err != nil {
+		return nil, nil, errorsmod.Wrap(err, "failed to paginate revocation events")
+	}
+	return events, pageRes, nil
+}
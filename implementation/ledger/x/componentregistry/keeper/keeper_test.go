@@ -5,7 +5,9 @@ import (
 	"testing"
 
 	"cosmossdk.io/core/address"
+	corestore "cosmossdk.io/core/store"
 	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
 	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	"github.com/cosmos/cosmos-sdk/testutil"
@@ -22,10 +24,19 @@ type fixture struct {
 	ctx          context.Context
 	keeper       keeper.Keeper
 	addressCodec address.Codec
+	storeService corestore.KVStoreService
+	cdc          codec.Codec
 }
 
 func initFixture(t *testing.T) *fixture {
 	t.Helper()
+	return initFixtureWithBackend(t, nil)
+}
+
+// initFixtureWithBackend builds the fixture around a verification backend,
+// which anonymous registration needs
+func initFixtureWithBackend(t *testing.T, backend types.ComponentVerificationBackend) *fixture {
+	t.Helper()
 
 	encCfg := moduletestutil.MakeTestEncodingConfig(module.AppModule{})
 	addressCodec := addresscodec.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix())
@@ -41,7 +52,9 @@ func initFixture(t *testing.T) *fixture {
 		encCfg.Codec,
 		addressCodec,
 		authority,
-		nil,
+		backend,
+		nil, // trusttensor keeper
+		nil, // lctmanager keeper
 	)
 
 	// Initialize params
@@ -53,5 +66,7 @@ func initFixture(t *testing.T) *fixture {
 		ctx:          ctx,
 		keeper:       k,
 		addressCodec: addressCodec,
+		storeService: storeService,
+		cdc:          encCfg.Codec,
 	}
 }
@@ -0,0 +1,60 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+
+	"racecar-web/x/componentregistry/keeper"
+	"racecar-web/x/componentregistry/types"
+)
+
+func TestListComponentsPaginated(t *testing.T) {
+	f := initFixture(t)
+
+	// Five modules share a manufacturer so the manufacturer index holds fewer entries
+	for i := 1; i <= 5; i++ {
+		require.NoError(t, f.keeper.RegisterComponent(f.ctx, types.Component{
+			ComponentId:    fmt.Sprintf("MODBATT-MOD-%03d", i),
+			ComponentType:  "module",
+			ManufacturerId: "TESLA_RACING",
+			Status:         types.StatusActive,
+		}))
+	}
+	require.NoError(t, f.keeper.RegisterComponent(f.ctx, types.Component{
+		ComponentId:    "MODBATT-PACK-001",
+		ComponentType:  "pack",
+		ManufacturerId: "MODBATT",
+		Status:         types.StatusActive,
+	}))
+
+	page, pageRes, err := f.keeper.ListComponentsPaginated(f.ctx, &query.PageRequest{Limit: 4, CountTotal: true})
+	require.NoError(t, err)
+	require.Len(t, page, 4)
+	require.Equal(t, uint64(6), pageRes.Total)
+	require.NotEmpty(t, pageRes.NextKey)
+	require.Equal(t, "MODBATT-MOD-001", page[0].ComponentId)
+
+	rest, pageRes, err := f.keeper.ListComponentsPaginated(f.ctx, &query.PageRequest{Key: pageRes.NextKey, Limit: 4})
+	require.NoError(t, err)
+	require.Len(t, rest, 2)
+	require.Empty(t, pageRes.NextKey)
+	require.Equal(t, "MODBATT-PACK-001", rest[1].ComponentId)
+
+	seen := make(map[string]bool)
+	for _, component := range append(page, rest...) {
+		require.False(t, seen[component.ComponentId], "component %s listed twice", component.ComponentId)
+		seen[component.ComponentId] = true
+	}
+
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	response, err := qs.ListComponents(f.ctx, &types.QueryListComponentsRequest{Pagination: &query.PageRequest{CountTotal: true}})
+	require.NoError(t, err)
+	require.Len(t, response.Components, 6)
+	require.Equal(t, uint64(6), response.Pagination.Total)
+
+	_, err = qs.ListComponents(f.ctx, &types.QueryListComponentsRequest{Pagination: &query.PageRequest{Key: []byte("x"), Offset: 1}})
+	require.Error(t, err)
+}
@@ -0,0 +1,146 @@
+package keeper
+
+import (
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"racecar-web/x/componentregistry/types"
+)
+
+// Migrator runs the module's store migrations
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a Migrator for the keeper's store
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 rebuilds the content hash index so that dedupe finds the
+// components registered before it existed, and re-keys any entry hashed
+// before numbers in manufacturer data were kept as written. Where several
+// components share content, the earliest registered keeps the entry.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	if err := m.keeper.ContentHashIndex.Clear(ctx, nil); err != nil {
+		return err
+	}
+
+	// Collect first; the index is written from the earliest component down
+	earliest := make(map[string]types.Component)
+	var order []string
+	err := m.keeper.Components.Walk(ctx, nil, func(_ string, component types.Component) (bool, error) {
+		if component.ComponentType == "" {
+			return false, nil
+		}
+		contentHash := componentContentHash(component.ComponentType, component.HardwareSpecs)
+		current, seen := earliest[contentHash]
+		if !seen {
+			order = append(order, contentHash)
+		}
+		if !seen || component.RegisteredAt.Before(current.RegisteredAt) {
+			earliest[contentHash] = component
+		}
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, contentHash := range order {
+		if err := m.keeper.ContentHashIndex.Set(ctx, contentHash, earliest[contentHash].ComponentId); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Migrate2to3 indexes the components registered before the manufacturer hash
+// index existed, so searches by manufacturer find them
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	// Collect first; the components must not be written while they are being walked
+	var keys []collections.Pair[string, string]
+	err := m.keeper.Components.Walk(ctx, nil, func(componentID string, component types.Component) (bool, error) {
+		if component.ManufacturerHash != "" {
+			keys = append(keys, collections.Join(component.ManufacturerHash, componentID))
+		}
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		if err := m.keeper.ManufacturerHashIndex.Set(ctx, key, key.K2()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Migrate3to4 indexes the components registered before the category hash
+// index existed, so searches by category find them
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	// Collect first; the components must not be written while they are being walked
+	var keys []collections.Pair[string, string]
+	err := m.keeper.Components.Walk(ctx, nil, func(componentID string, component types.Component) (bool, error) {
+		if component.CategoryHash != "" {
+			keys = append(keys, collections.Join(component.CategoryHash, componentID))
+		}
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		if err := m.keeper.CategoryHashIndex.Set(ctx, key, key.K2()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Migrate4to5 builds the component and verification indexes behind the
+// health report, which did not exist before, from the stored values
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	if err := m.keeper.Components.Indexes.StatusCounts.clear(ctx); err != nil {
+		return err
+	}
+	if err := m.keeper.ComponentVerifications.Indexes.StatusCounts.clear(ctx); err != nil {
+		return err
+	}
+	if err := reindex(ctx, m.keeper.Components); err != nil {
+		return err
+	}
+	return reindex(ctx, m.keeper.ComponentVerifications)
+}
+
+// reindex references every stored value in the map's indexes as if it had
+// just been written
+func reindex[V any, I collections.Indexes[string, V]](ctx sdk.Context, m *collections.IndexedMap[string, V, I]) error {
+	// Collect first; the indexes must not be written while the map is being walked
+	var keys []string
+	var values []V
+	err := m.Walk(ctx, nil, func(key string, value V) (bool, error) {
+		keys = append(keys, key)
+		values = append(values, value)
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	unindexed := func() (V, error) {
+		var zero V
+		return zero, collections.ErrNotFound
+	}
+	for i, key := range keys {
+		for _, index := range m.Indexes.IndexesList() {
+			if err := index.Reference(ctx, key, values[i], unindexed); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,157 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/collections"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"racecar-web/x/componentregistry/keeper"
+	"racecar-web/x/componentregistry/types"
+)
+
+func TestMigrate1to2IndexesComponentContent(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	registeredAt := time.Unix(1752484904, 0).UTC()
+
+	// Stored by version 1, before registration indexed content; the later
+	// duplicate sorts first
+	for id, at := range map[string]time.Time{
+		"MODBATT-MOD-OLD-002": registeredAt,
+		"MODBATT-MOD-OLD-001": registeredAt.Add(time.Hour),
+	} {
+		require.NoError(t, f.keeper.Components.Set(ctx, id, types.Component{
+			ComponentId:   id,
+			ComponentType: types.ComponentTypeModule,
+			HardwareSpecs: `{"manufacturer_id":"RaceCarBatteryCo","serial":"SN-9001"}`,
+			Status:        "active",
+			RegisteredAt:  at,
+		}))
+	}
+
+	require.NoError(t, keeper.NewMigrator(f.keeper).Migrate1to2(ctx))
+
+	res, err := ms.RegisterComponent(ctx, &types.MsgRegisterComponent{
+		Creator:          "cosmos1anycreator",
+		ComponentId:      "MODBATT-MOD-NEW-001",
+		ComponentType:    types.ComponentTypeModule,
+		ManufacturerData: `{"serial": "SN-9001", "manufacturer_id": "RaceCarBatteryCo"}`,
+		Dedupe:           true,
+	})
+	require.NoError(t, err)
+	require.False(t, res.Created)
+	require.Equal(t, "MODBATT-MOD-OLD-002", res.ComponentId)
+}
+
+func TestMigrate2to3IndexesManufacturerHashes(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+
+	// Stored by version 2, before registration indexed manufacturer hashes
+	for id, hash := range map[string]string{
+		"MODBATT-MOD-OLD-001": "mfr-hash-a",
+		"MODBATT-MOD-OLD-002": "mfr-hash-a",
+		"MODBATT-MOD-OLD-003": "mfr-hash-b",
+		"MODBATT-MOD-OLD-004": "",
+	} {
+		require.NoError(t, f.keeper.Components.Set(ctx, id, types.Component{
+			ComponentId:      id,
+			ManufacturerHash: hash,
+			ComponentType:    types.ComponentTypeModule,
+			Status:           "active",
+		}))
+	}
+
+	require.NoError(t, keeper.NewMigrator(f.keeper).Migrate2to3(ctx))
+
+	found, _, err := f.keeper.SearchComponents(ctx, types.ComponentSearchFilter{ManufacturerHash: "mfr-hash-a"}, nil)
+	require.NoError(t, err)
+	ids := make([]string, len(found))
+	for i, component := range found {
+		ids[i] = component.ComponentId
+	}
+	require.Equal(t, []string{"MODBATT-MOD-OLD-001", "MODBATT-MOD-OLD-002"}, ids)
+
+	found, _, err = f.keeper.SearchComponents(ctx, types.ComponentSearchFilter{ManufacturerHash: "mfr-hash-b"}, nil)
+	require.NoError(t, err)
+	require.Len(t, found, 1)
+}
+
+func TestMigrate3to4IndexesCategoryHashes(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+
+	// Stored by version 3, before registration indexed category hashes
+	for id, hash := range map[string]string{
+		"MODBATT-MOD-OLD-001": "cat-hash-module",
+		"MODBATT-MOD-OLD-002": "cat-hash-pack",
+		"MODBATT-MOD-OLD-003": "cat-hash-module",
+		"MODBATT-MOD-OLD-004": "",
+	} {
+		require.NoError(t, f.keeper.Components.Set(ctx, id, types.Component{
+			ComponentId:   id,
+			CategoryHash:  hash,
+			ComponentType: types.ComponentTypeModule,
+			Status:        "active",
+		}))
+	}
+
+	require.NoError(t, keeper.NewMigrator(f.keeper).Migrate3to4(ctx))
+
+	found, _, err := f.keeper.SearchComponents(ctx, types.ComponentSearchFilter{CategoryHash: "cat-hash-module"}, nil)
+	require.NoError(t, err)
+	ids := make([]string, len(found))
+	for i, component := range found {
+		ids[i] = component.ComponentId
+	}
+	require.Equal(t, []string{"MODBATT-MOD-OLD-001", "MODBATT-MOD-OLD-003"}, ids)
+
+	found, _, err = f.keeper.SearchComponents(ctx, types.ComponentSearchFilter{CategoryHash: "cat-hash-pack", Status: "suspended"}, nil)
+	require.NoError(t, err)
+	require.Empty(t, found)
+}
+
+func TestMigrate4to5IndexesComponentHealth(t *testing.T) {
+	f := initFixture(t)
+	now := time.Unix(1752484904, 0).UTC()
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(now)
+
+	// Stored by version 4, before components and verifications were indexed
+	sb := collections.NewSchemaBuilder(f.storeService)
+	components := collections.NewMap(sb, types.ComponentPrefix, "components", collections.StringKey, codec.CollValue[types.Component](f.cdc))
+	verifications := collections.NewMap(sb, types.VerificationPrefix, "verifications", collections.StringKey, codec.CollValue[types.ComponentVerification](f.cdc))
+	for id, lastVerified := range map[string]time.Time{
+		"MODBATT-MOD-OLD-001": now.Add(-time.Hour),
+		"MODBATT-MOD-OLD-002": now.Add(-72 * time.Hour),
+		"MODBATT-MOD-OLD-003": now.Add(-time.Hour),
+	} {
+		require.NoError(t, components.Set(ctx, id, types.Component{ComponentId: id, Status: types.StatusActive, LastVerifiedAt: lastVerified}))
+	}
+	require.NoError(t, verifications.Set(ctx, "MODBATT-MOD-OLD-001", types.ComponentVerification{ComponentId: "MODBATT-MOD-OLD-001", Status: types.VerificationStatusVerified}))
+	require.NoError(t, verifications.Set(ctx, "MODBATT-MOD-OLD-003", types.ComponentVerification{ComponentId: "MODBATT-MOD-OLD-003", Status: types.VerificationStatusRejected}))
+
+	summary, _, err := f.keeper.ComponentHealth(ctx, 0)
+	require.NoError(t, err)
+	require.Zero(t, summary.Total)
+
+	// Running it twice leaves the counts as they were
+	migrator := keeper.NewMigrator(f.keeper)
+	require.NoError(t, migrator.Migrate4to5(ctx))
+	require.NoError(t, migrator.Migrate4to5(ctx))
+
+	summary, problems, err := f.keeper.ComponentHealth(ctx, 0)
+	require.NoError(t, err)
+	require.Equal(t, types.ComponentHealthSummary{
+		Total:              3,
+		Active:             3,
+		Stale:              1,
+		Unverified:         1,
+		FailedVerification: 1,
+	}, summary)
+	require.Len(t, problems, 2)
+}
@@ -14,6 +14,9 @@ func (k msgServer) RegisterAnonymousComponent(ctx context.Context, msg *types.Ms
 	if msg.Creator == "" {
 		return nil, errorsmod.Wrap(types.ErrInvalidSigner, "creator cannot be empty")
 	}
+	if err := k.checkCreatorAllowed(ctx, msg.Creator); err != nil {
+		return nil, err
+	}
 	if msg.RealComponentId == "" {
 		return nil, errorsmod.Wrap(types.ErrInvalidComponentID, "real_component_id cannot be empty")
 	}
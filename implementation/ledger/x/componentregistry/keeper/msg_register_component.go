@@ -17,6 +17,9 @@ func (k msgServer) RegisterComponent(ctx context.Context, msg *types.MsgRegister
 	if msg.Creator == "" {
 		return nil, errorsmod.Wrap(types.ErrInvalidSigner, "creator cannot be empty")
 	}
+	if err := k.checkCreatorAllowed(ctx, msg.Creator); err != nil {
+		return nil, err
+	}
 	if msg.ComponentId == "" {
 		return nil, errorsmod.Wrap(types.ErrInvalidComponentID, "component_id cannot be empty")
 	}
@@ -34,6 +37,32 @@ func (k msgServer) RegisterComponent(ctx context.Context, msg *types.MsgRegister
 		return nil, errorsmod.Wrap(types.ErrInvalidComponentType, "component_type must be one of: module, pack, host_ecu, sensor, actuator")
 	}
 
+	// With dedupe, the same physical component registered again resolves to
+	// the existing entry instead of creating a second one
+	if msg.Dedupe {
+		existing, found, err := k.findComponentByContent(ctx, msg.ComponentType, msg.ManufacturerData)
+		if err != nil {
+			return nil, err
+		}
+		if found {
+			sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+				sdk.NewEvent("component_deduplicated",
+					sdk.NewAttribute("component_id", existing.ComponentId),
+					sdk.NewAttribute("requested_component_id", msg.ComponentId),
+					sdk.NewAttribute("lct_id", existing.LctId),
+					sdk.NewAttribute("creator", msg.Creator),
+				),
+			)
+			return &types.MsgRegisterComponentResponse{
+				ComponentIdentity: fmt.Sprintf("comp_%s_%s", existing.ComponentType, existing.ComponentId),
+				LctId:             existing.LctId,
+				Status:            "already_registered",
+				Created:           false,
+				ComponentId:       existing.ComponentId,
+			}, nil
+		}
+	}
+
 	// Check if component already exists
 	_, err := k.Components.Get(ctx, msg.ComponentId)
 	if err == nil {
@@ -45,6 +74,9 @@ func (k msgServer) RegisterComponent(ctx context.Context, msg *types.MsgRegister
 	if manufacturerId == "" {
 		manufacturerId = "unknown" // Default if not found
 	}
+	if err := k.checkManufacturerQuota(ctx, manufacturerId); err != nil {
+		return nil, err
+	}
 
 	// Create component identity
 	componentIdentity := fmt.Sprintf("comp_%s_%s", msg.ComponentType, msg.ComponentId)
@@ -75,10 +107,17 @@ func (k msgServer) RegisterComponent(ctx context.Context, msg *types.MsgRegister
 		return nil, errorsmod.Wrap(types.ErrComponentNotFound, "failed to store component")
 	}
 
+	if err := k.recordRegistration(ctx, msg.ComponentId, msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(err, "failed to start custody chain")
+	}
+
 	// Update manufacturer index
-	if err := k.ManufacturerComponents.Set(ctx, manufacturerId, component); err != nil {
+	if err := k.indexManufacturerComponent(ctx, component); err != nil {
 		return nil, errorsmod.Wrap(types.ErrComponentNotFound, "failed to update manufacturer index")
 	}
+	if err := k.indexComponentContent(ctx, component); err != nil {
+		return nil, err
+	}
 
 	// Emit event with LCT information
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
@@ -104,6 +143,8 @@ func (k msgServer) RegisterComponent(ctx context.Context, msg *types.MsgRegister
 		ComponentIdentity: componentIdentity,
 		LctId:             lctId,
 		Status:            "registered_with_lct",
+		Created:           true,
+		ComponentId:       msg.ComponentId,
 	}, nil
 }
 
@@ -0,0 +1,185 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"racecar-web/x/componentregistry/keeper"
+	"racecar-web/x/componentregistry/types"
+)
+
+func TestRegisterComponentCreatorAllowlist(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+
+	allowed := "cosmos1allowedcreator"
+	params := types.NewParams(true, []string{allowed}, 0, 0)
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	testCases := []struct {
+		name        string
+		creator     string
+		componentID string
+		expErr      error
+	}{
+		{
+			name:        "allowlisted creator",
+			creator:     allowed,
+			componentID: "MODBATT-MOD-ALLOW-001",
+		},
+		{
+			name:        "creator not on allowlist",
+			creator:     "cosmos1unknowncreator",
+			componentID: "MODBATT-MOD-DENY-001",
+			expErr:      types.ErrCreatorNotAllowed,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := ms.RegisterComponent(f.ctx, &types.MsgRegisterComponent{
+				Creator:          tc.creator,
+				ComponentId:      tc.componentID,
+				ComponentType:    types.ComponentTypeModule,
+				ManufacturerData: `{"manufacturer_id":"RaceCarBatteryCo"}`,
+			})
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+				require.Contains(t, err.Error(), "CREATOR_NOT_ALLOWED")
+
+				_, getErr := f.keeper.GetComponent(f.ctx, tc.componentID)
+				require.Error(t, getErr)
+				return
+			}
+			require.NoError(t, err)
+
+			_, getErr := f.keeper.GetComponent(f.ctx, tc.componentID)
+			require.NoError(t, getErr)
+		})
+	}
+}
+
+func TestRegisterComponentPermissiveByDefault(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+
+	_, err := ms.RegisterComponent(f.ctx, &types.MsgRegisterComponent{
+		Creator:          "cosmos1anycreator",
+		ComponentId:      "MODBATT-MOD-DEV-001",
+		ComponentType:    types.ComponentTypeModule,
+		ManufacturerData: `{"manufacturer_id":"RaceCarBatteryCo"}`,
+	})
+	require.NoError(t, err)
+}
+
+func TestRegisterComponentManufacturerQuota(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+
+	require.NoError(t, f.keeper.Params.Set(f.ctx, types.NewParams(false, nil, 2, 0)))
+
+	register := func(componentID, manufacturerID string) error {
+		_, err := ms.RegisterComponent(f.ctx, &types.MsgRegisterComponent{
+			Creator:          "cosmos1anycreator",
+			ComponentId:      componentID,
+			ComponentType:    types.ComponentTypeModule,
+			ManufacturerData: `{"manufacturer_id":"` + manufacturerID + `"}`,
+		})
+		return err
+	}
+
+	require.NoError(t, register("MODBATT-MOD-QUOTA-001", "RaceCarBatteryCo"))
+	require.NoError(t, register("MODBATT-MOD-QUOTA-002", "RaceCarBatteryCo"))
+
+	err := register("MODBATT-MOD-QUOTA-003", "RaceCarBatteryCo")
+	require.ErrorIs(t, err, types.ErrManufacturerQuotaExceeded)
+	require.Contains(t, err.Error(), "MANUFACTURER_QUOTA_EXCEEDED")
+	_, getErr := f.keeper.GetComponent(f.ctx, "MODBATT-MOD-QUOTA-003")
+	require.Error(t, getErr)
+
+	count, err := f.keeper.GetManufacturerComponentCount(f.ctx, "RaceCarBatteryCo")
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), count)
+
+	// The quota is per manufacturer
+	require.NoError(t, register("MODBATT-MOD-QUOTA-004", "PitLaneCells"))
+
+	// Keeper registrations draw from the same quota
+	err = f.keeper.RegisterComponent(f.ctx, types.Component{
+		ComponentId:    "MODBATT-MOD-QUOTA-005",
+		ComponentType:  types.ComponentTypeModule,
+		ManufacturerId: "RaceCarBatteryCo",
+	})
+	require.ErrorIs(t, err, types.ErrManufacturerQuotaExceeded)
+}
+
+func TestRegisterComponentDedupe(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+
+	first, err := ms.RegisterComponent(f.ctx, &types.MsgRegisterComponent{
+		Creator:          "cosmos1anycreator",
+		ComponentId:      "MODBATT-MOD-DUP-001",
+		ComponentType:    types.ComponentTypeModule,
+		ManufacturerData: `{"manufacturer_id":"RaceCarBatteryCo","serial":"SN-4471"}`,
+	})
+	require.NoError(t, err)
+	require.True(t, first.Created)
+	require.Equal(t, "MODBATT-MOD-DUP-001", first.ComponentId)
+
+	// Same metadata, different key order: dedupe returns the first component
+	second, err := ms.RegisterComponent(f.ctx, &types.MsgRegisterComponent{
+		Creator:          "cosmos1anycreator",
+		ComponentId:      "MODBATT-MOD-DUP-002",
+		ComponentType:    types.ComponentTypeModule,
+		ManufacturerData: `{"serial": "SN-4471", "manufacturer_id": "RaceCarBatteryCo"}`,
+		Dedupe:           true,
+	})
+	require.NoError(t, err)
+	require.False(t, second.Created)
+	require.Equal(t, "already_registered", second.Status)
+	require.Equal(t, first.ComponentId, second.ComponentId)
+	require.Equal(t, first.LctId, second.LctId)
+	require.Equal(t, first.ComponentIdentity, second.ComponentIdentity)
+
+	_, err = f.keeper.GetComponent(f.ctx, "MODBATT-MOD-DUP-002")
+	require.Error(t, err, "a deduplicated registration stores nothing")
+
+	// Without dedupe the duplicate is registered as before
+	third, err := ms.RegisterComponent(f.ctx, &types.MsgRegisterComponent{
+		Creator:          "cosmos1anycreator",
+		ComponentId:      "MODBATT-MOD-DUP-003",
+		ComponentType:    types.ComponentTypeModule,
+		ManufacturerData: `{"manufacturer_id":"RaceCarBatteryCo","serial":"SN-4471"}`,
+	})
+	require.NoError(t, err)
+	require.True(t, third.Created)
+	require.Equal(t, "MODBATT-MOD-DUP-003", third.ComponentId)
+
+	// Different metadata is never deduplicated
+	other, err := ms.RegisterComponent(f.ctx, &types.MsgRegisterComponent{
+		Creator:          "cosmos1anycreator",
+		ComponentId:      "MODBATT-MOD-DUP-004",
+		ComponentType:    types.ComponentTypeModule,
+		ManufacturerData: `{"manufacturer_id":"RaceCarBatteryCo","serial":"SN-4472"}`,
+		Dedupe:           true,
+	})
+	require.NoError(t, err)
+	require.True(t, other.Created)
+	require.Equal(t, "MODBATT-MOD-DUP-004", other.ComponentId)
+
+	// Numbers compare as written, not rounded to float64
+	for i, serial := range []string{"12345678901234567890", "12345678901234567891"} {
+		res, err := ms.RegisterComponent(f.ctx, &types.MsgRegisterComponent{
+			Creator:          "cosmos1anycreator",
+			ComponentId:      fmt.Sprintf("MODBATT-MOD-NUM-%03d", i),
+			ComponentType:    types.ComponentTypeModule,
+			ManufacturerData: `{"manufacturer_id":"RaceCarBatteryCo","serial":` + serial + `}`,
+			Dedupe:           true,
+		})
+		require.NoError(t, err)
+		require.True(t, res.Created, serial)
+	}
+}
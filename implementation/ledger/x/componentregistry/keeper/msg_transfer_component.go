@@ -0,0 +1,36 @@
+package keeper
+
+import (
+	"context"
+
+	"racecar-web/x/componentregistry/types"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) TransferComponent(ctx context.Context, msg *types.MsgTransferComponent) (*types.MsgTransferComponentResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidSigner, "invalid creator address")
+	}
+	if msg.ComponentId == "" {
+		return nil, errorsmod.Wrap(types.ErrInvalidComponentID, "component_id cannot be empty")
+	}
+	if _, err := k.addressCodec.StringToBytes(msg.NewOwner); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidTransfer, "invalid new owner address")
+	}
+
+	event, err := k.Keeper.TransferComponent(ctx, msg.Creator, msg.ComponentId, msg.NewOwner, msg.Reason)
+	if err != nil {
+		return nil, err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventComponentTransferred{
+		ComponentId: event.ComponentId,
+		FromOwner:   event.FromOwner,
+		ToOwner:     event.ToOwner,
+		Sequence:    event.Sequence,
+	})
+
+	return &types.MsgTransferComponentResponse{Sequence: event.Sequence}, nil
+}
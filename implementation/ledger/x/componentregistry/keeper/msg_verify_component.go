@@ -31,6 +31,10 @@ func (k msgServer) VerifyComponent(ctx context.Context, msg *types.MsgVerifyComp
 
 	// Check if component is active
 	if component.Status != "active" {
+		if err := k.recordVerification(ctx, msg.ComponentId, msg.Creator, "failed_inactive", msg.Context); err != nil {
+			return nil, err
+		}
+
 		// Emit verification failed event
 		sdkCtx := sdk.UnwrapSDKContext(ctx)
 		sdkCtx.EventManager().EmitTypedEvent(&types.EventComponentVerified{
@@ -65,6 +69,9 @@ func (k msgServer) VerifyComponent(ctx context.Context, msg *types.MsgVerifyComp
 	if err := k.Components.Set(ctx, msg.ComponentId, component); err != nil {
 		return nil, errorsmod.Wrap(types.ErrComponentNotFound, "failed to update component")
 	}
+	if err := k.recordVerification(ctx, msg.ComponentId, msg.Creator, verification.Status, msg.Context); err != nil {
+		return nil, err
+	}
 
 	// Create component data for response (privacy-focused)
 	componentData := map[string]interface{}{
@@ -21,10 +21,26 @@ func (k msgServer) VerifyComponentPairingWithHashes(ctx context.Context, msg *ty
 		return nil, errorsmod.Wrap(types.ErrInvalidComponentID, "component_hash_b cannot be empty")
 	}
 
-	// Verify component pairing using hashes using the keeper
-	canPair, reason, err := k.Keeper.VerifyComponentPairingWithHashes(ctx, msg.ComponentHashA, msg.ComponentHashB)
-	if err != nil {
-		return nil, errorsmod.Wrap(err, "failed to verify component pairing with hashes")
+	// A manufacturer's verdict is fetched off-chain, so every validator
+	// sees the same result; the chain only checks who attested it
+	var canPair bool
+	var reason string
+	if msg.Attestation != nil {
+		params, err := k.Keeper.Params.Get(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if !params.IsVerificationAttestor(msg.Verifier) {
+			return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "%s is not a verification attestor", msg.Verifier)
+		}
+		canPair, reason = msg.Attestation.CanPair, msg.Attestation.Reason
+	} else {
+		// Verify component pairing using hashes using the keeper
+		var err error
+		canPair, reason, err = k.Keeper.VerifyComponentPairingWithHashes(ctx, msg.ComponentHashA, msg.ComponentHashB)
+		if err != nil {
+			return nil, errorsmod.Wrap(err, "failed to verify component pairing with hashes")
+		}
 	}
 
 	// Get trust score if components can pair
@@ -0,0 +1,54 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"racecar-web/x/componentregistry/keeper"
+	"racecar-web/x/componentregistry/types"
+)
+
+func TestVerifyComponentPairingWithHashesAcceptsAttestedResults(t *testing.T) {
+	f := initFixtureWithBackend(t, types.NewMockMySQLBackend())
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	attestor, err := f.addressCodec.BytesToString([]byte("verification_oracle_"))
+	require.NoError(t, err)
+	other, err := f.addressCodec.BytesToString([]byte("not-an-attestor-----"))
+	require.NoError(t, err)
+
+	params := types.DefaultParams()
+	params.VerificationAttestors = []string{attestor}
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	// Unknown to the chain's backend, but the manufacturer vouched for them
+	msg := &types.MsgVerifyComponentPairingWithHashes{
+		Verifier:       attestor,
+		ComponentHashA: anonymousHash("MODBATT-MOD-RC009-001"),
+		ComponentHashB: anonymousHash("MODBATT-PACK-RC009-A"),
+		Attestation:    &types.PairingAttestation{CanPair: true, Reason: "manufacturer: compatible"},
+	}
+	res, err := ms.VerifyComponentPairingWithHashes(f.ctx, msg)
+	require.NoError(t, err)
+	require.True(t, res.CanPair)
+	require.Equal(t, "manufacturer: compatible", res.Reason)
+
+	// Only attestors may submit a verdict
+	msg.Verifier = other
+	_, err = ms.VerifyComponentPairingWithHashes(f.ctx, msg)
+	require.ErrorIs(t, err, types.ErrInvalidSigner)
+
+	// Without an attestation the chain's backend decides
+	msg.Attestation = nil
+	res, err = ms.VerifyComponentPairingWithHashes(f.ctx, msg)
+	require.NoError(t, err)
+	require.False(t, res.CanPair)
+}
+
+func TestParamsRejectInvalidAttestors(t *testing.T) {
+	params := types.DefaultParams()
+	params.VerificationAttestors = []string{""}
+	require.Error(t, params.Validate())
+	params.VerificationAttestors = []string{"racecar1oracle", "racecar1oracle"}
+	require.Error(t, params.Validate())
+}
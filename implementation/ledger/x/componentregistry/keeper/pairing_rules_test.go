@@ -0,0 +1,60 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"racecar-web/x/componentregistry/keeper"
+	"racecar-web/x/componentregistry/types"
+)
+
+func TestGetPairingRules(t *testing.T) {
+	f := initFixture(t)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	module, pack, hostECU := anonymousHash("module"), anonymousHash("pack"), anonymousHash("host_ecu")
+	for _, rule := range []types.ComponentPairingRule{
+		{SourceTypeHash: module, TargetTypeHash: pack, MinQualityScore: "0.75", MaxRelationships: 1, BidirectionalRequired: true},
+		{SourceTypeHash: module, TargetTypeHash: hostECU, MinQualityScore: "0.85", MaxRelationships: 2},
+		{SourceTypeHash: pack, TargetTypeHash: module, MinQualityScore: "0.75", MaxRelationships: 16},
+	} {
+		require.NoError(t, f.keeper.SetPairingRule(f.ctx, rule))
+	}
+
+	targets := func(rules []types.ComponentPairingRule) []string {
+		var hashes []string
+		for _, rule := range rules {
+			require.Equal(t, module, rule.SourceTypeHash)
+			hashes = append(hashes, rule.TargetTypeHash)
+		}
+		return hashes
+	}
+
+	// The type and its hash select the same rules
+	for _, sourceType := range []string{"module", module} {
+		resp, err := qs.GetPairingRules(f.ctx, &types.QueryGetPairingRulesRequest{SourceType: sourceType})
+		require.NoError(t, err)
+		require.ElementsMatch(t, []string{pack, hostECU}, targets(resp.Rules))
+	}
+
+	// Pages hold only matching rules
+	first, err := qs.GetPairingRules(f.ctx, &types.QueryGetPairingRulesRequest{SourceType: "module", Pagination: &query.PageRequest{Limit: 1}})
+	require.NoError(t, err)
+	require.Len(t, first.Rules, 1)
+	require.NotEmpty(t, first.Pagination.NextKey)
+	second, err := qs.GetPairingRules(f.ctx, &types.QueryGetPairingRulesRequest{SourceType: "module", Pagination: &query.PageRequest{Key: first.Pagination.NextKey, Limit: 1}})
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{pack, hostECU}, targets(append(first.Rules, second.Rules...)))
+	require.Empty(t, second.Pagination.NextKey)
+
+	resp, err := qs.GetPairingRules(f.ctx, &types.QueryGetPairingRulesRequest{SourceType: "sensor"})
+	require.NoError(t, err)
+	require.Empty(t, resp.Rules)
+
+	_, err = qs.GetPairingRules(f.ctx, &types.QueryGetPairingRulesRequest{})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
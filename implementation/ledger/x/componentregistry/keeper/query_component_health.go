@@ -0,0 +1,30 @@
+package keeper
+
+import (
+	"context"
+	"time"
+
+	"racecar-web/x/componentregistry/types"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (q queryServer) ComponentHealth(ctx context.Context, req *types.QueryComponentHealthRequest) (*types.QueryComponentHealthResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.StaleAfterSeconds < 0 {
+		return nil, status.Error(codes.InvalidArgument, "stale_after_seconds cannot be negative")
+	}
+
+	summary, problems, err := q.k.ComponentHealth(ctx, time.Duration(req.StaleAfterSeconds)*time.Second)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryComponentHealthResponse{
+		Summary:  summary,
+		Problems: problems,
+	}, nil
+}
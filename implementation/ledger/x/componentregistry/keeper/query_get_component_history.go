@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	"context"
+
+	"racecar-web/x/componentregistry/types"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (q queryServer) GetComponentHistory(ctx context.Context, req *types.QueryGetComponentHistoryRequest) (*types.QueryGetComponentHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.ComponentId == "" {
+		return nil, status.Error(codes.InvalidArgument, "component_id cannot be empty")
+	}
+
+	// History is only meaningful for components that exist
+	exists, err := q.k.Components.Has(ctx, req.ComponentId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+	if !exists {
+		return nil, status.Error(codes.NotFound, "component not found")
+	}
+
+	entries, err := q.k.GetComponentHistory(ctx, req.ComponentId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryGetComponentHistoryResponse{
+		Entries: entries,
+	}, nil
+}
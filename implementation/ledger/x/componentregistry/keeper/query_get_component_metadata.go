@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"racecar-web/x/componentregistry/types"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (q queryServer) GetComponentMetadata(ctx context.Context, req *types.QueryGetComponentMetadataRequest) (*types.QueryGetComponentMetadataResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.ComponentId == "" {
+		return nil, status.Error(codes.InvalidArgument, "component_id cannot be empty")
+	}
+
+	component, metadata, backendStatus, err := q.k.GetComponentWithBackendMetadata(ctx, req.ComponentId)
+	if err != nil {
+		if errors.Is(err, types.ErrComponentNotFound) {
+			return nil, status.Error(codes.NotFound, "component not found")
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	response := &types.QueryGetComponentMetadataResponse{
+		Component:     component,
+		BackendStatus: backendStatus,
+	}
+	if metadata != nil {
+		encoded, err := json.Marshal(metadata)
+		if err != nil {
+			return nil, status.Error(codes.Internal, "failed to encode backend metadata")
+		}
+		response.BackendMetadata = string(encoded)
+	}
+
+	return response, nil
+}
@@ -0,0 +1,37 @@
+package keeper
+
+import (
+	"context"
+
+	"racecar-web/x/componentregistry/types"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (q queryServer) GetCustodyChain(ctx context.Context, req *types.QueryGetCustodyChainRequest) (*types.QueryGetCustodyChainResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.ComponentId == "" {
+		return nil, status.Error(codes.InvalidArgument, "component_id cannot be empty")
+	}
+
+	exists, err := q.k.Components.Has(ctx, req.ComponentId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+	if !exists {
+		return nil, status.Error(codes.NotFound, "component not found")
+	}
+
+	events, err := q.k.GetCustodyChain(ctx, req.ComponentId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryGetCustodyChainResponse{
+		Events: events,
+	}, nil
+}
@@ -0,0 +1,29 @@
+package keeper
+
+import (
+	"context"
+
+	"racecar-web/x/componentregistry/types"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (q queryServer) GetPairingRules(ctx context.Context, req *types.QueryGetPairingRulesRequest) (*types.QueryGetPairingRulesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.SourceType == "" {
+		return nil, status.Error(codes.InvalidArgument, "source_type is required")
+	}
+
+	rules, pageRes, err := q.k.GetPairingRules(ctx, req.SourceType, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryGetPairingRulesResponse{
+		Rules:      rules,
+		Pagination: pageRes,
+	}, nil
+}
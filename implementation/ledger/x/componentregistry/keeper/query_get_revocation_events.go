@@ -0,0 +1,30 @@
+package keeper
+
+import (
+	"context"
+
+	"racecar-web/x/componentregistry/types"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (q queryServer) GetRevocationEvents(ctx context.Context, req *types.QueryGetRevocationEventsRequest) (*types.QueryGetRevocationEventsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.From < 0 || req.To < 0 || (req.To != 0 && req.From > req.To) {
+		return nil, status.Error(codes.InvalidArgument, "from and to must be unix seconds with from <= to")
+	}
+
+	filter := types.RevocationFilter{From: req.From, To: req.To}
+	events, pageRes, err := q.k.GetRevocationEvents(ctx, req.TargetHash, filter, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryGetRevocationEventsResponse{
+		Events:     events,
+		Pagination: pageRes,
+	}, nil
+}
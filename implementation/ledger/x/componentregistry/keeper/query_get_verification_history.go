@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	"context"
+
+	"racecar-web/x/componentregistry/types"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (q queryServer) GetVerificationHistory(ctx context.Context, req *types.QueryGetVerificationHistoryRequest) (*types.QueryGetVerificationHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.ComponentId == "" {
+		return nil, status.Error(codes.InvalidArgument, "component_id cannot be empty")
+	}
+
+	exists, err := q.k.Components.Has(ctx, req.ComponentId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+	if !exists {
+		return nil, status.Error(codes.NotFound, "component not found")
+	}
+
+	records, pageRes, err := q.k.GetVerificationHistory(ctx, req.ComponentId, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryGetVerificationHistoryResponse{
+		Verifications: records,
+		Pagination:    pageRes,
+	}, nil
+}
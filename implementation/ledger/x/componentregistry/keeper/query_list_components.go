@@ -0,0 +1,26 @@
+package keeper
+
+import (
+	"context"
+
+	"racecar-web/x/componentregistry/types"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (q queryServer) ListComponents(ctx context.Context, req *types.QueryListComponentsRequest) (*types.QueryListComponentsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	components, pageRes, err := q.k.ListComponentsPaginated(ctx, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryListComponentsResponse{
+		Components: components,
+		Pagination: pageRes,
+	}, nil
+}
@@ -0,0 +1,34 @@
+package keeper
+
+import (
+	"context"
+
+	"racecar-web/x/componentregistry/types"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (q queryServer) SearchComponents(ctx context.Context, req *types.QuerySearchComponentsRequest) (*types.QuerySearchComponentsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.ManufacturerHash == "" && req.CategoryHash == "" {
+		return nil, status.Error(codes.InvalidArgument, "manufacturer_hash or category_hash is required")
+	}
+
+	filter := types.ComponentSearchFilter{
+		ManufacturerHash: req.ManufacturerHash,
+		CategoryHash:     req.CategoryHash,
+		Status:           req.Status,
+	}
+	components, pageRes, err := q.k.SearchComponents(ctx, filter, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QuerySearchComponentsResponse{
+		Components: components,
+		Pagination: pageRes,
+	}, nil
+}
@@ -0,0 +1,47 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"racecar-web/x/componentregistry/types"
+)
+
+func TestResolveComponentHash(t *testing.T) {
+	f := initFixtureWithBackend(t, types.NewMockMySQLBackend())
+	authority, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+	other, err := f.addressCodec.BytesToString([]byte("not-the-authority---"))
+	require.NoError(t, err)
+
+	component, err := f.keeper.RegisterAnonymousComponent(f.ctx, "MODBATT-MOD-RC001-001", "RaceCarBatteryCo", "battery_module")
+	require.NoError(t, err)
+
+	// Other callers get the type and status but not the real ID
+	resolved, err := f.keeper.ResolveComponentHash(f.ctx, component.ComponentId, other)
+	require.NoError(t, err)
+	require.Equal(t, types.ResolvedComponentHash{
+		ComponentHash: component.ComponentId,
+		ComponentType: "battery_module",
+		Status:        types.StatusActive,
+	}, resolved)
+
+	resolved, err = f.keeper.ResolveComponentHash(f.ctx, component.ComponentId, authority)
+	require.NoError(t, err)
+	require.Equal(t, "MODBATT-MOD-RC001-001", resolved.RealComponentID)
+
+	// Known to the backend but never registered on chain
+	hash, err := f.keeper.GenerateComponentHash(f.ctx, "MODBATT-PACK-RC001-A")
+	require.NoError(t, err)
+	resolved, err = f.keeper.ResolveComponentHash(f.ctx, hash, other)
+	require.NoError(t, err)
+	require.Equal(t, "battery_pack", resolved.ComponentType)
+	require.Equal(t, "unregistered", resolved.Status)
+
+	_, err = f.keeper.ResolveComponentHash(f.ctx, "0000", authority)
+	require.ErrorIs(t, err, types.ErrComponentHashNotFound)
+
+	_, err = initFixture(t).keeper.ResolveComponentHash(f.ctx, component.ComponentId, authority)
+	require.ErrorIs(t, err, types.ErrBackendNotConfigured)
+}
@@ -0,0 +1,81 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"racecar-web/x/componentregistry/keeper"
+	"racecar-web/x/componentregistry/types"
+)
+
+func TestGetRevocationEventsForTarget(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+	start := time.Unix(1752484904, 0)
+
+	// Three revocations of one pack, a day apart, and one of an unrelated module
+	for day := 0; day < 3; day++ {
+		blockCtx := ctx.WithBlockTime(start.Add(time.Duration(day) * 24 * time.Hour))
+		_, err := f.keeper.CreateAnonymousRevocationEvent(blockCtx, "hash_pack_001", "INDIVIDUAL", "URGENT", "SAFETY", "hash_initiator")
+		require.NoError(t, err)
+	}
+	_, err := f.keeper.CreateAnonymousRevocationEvent(ctx.WithBlockTime(start), "hash_mod_007", "INDIVIDUAL", "STANDARD", "OTHER", "hash_initiator")
+	require.NoError(t, err)
+
+	events, pageRes, err := f.keeper.GetRevocationEvents(ctx, "hash_pack_001", types.RevocationFilter{}, &query.PageRequest{CountTotal: true})
+	require.NoError(t, err)
+	require.Len(t, events, 3)
+	require.Equal(t, uint64(3), pageRes.Total)
+	for i, event := range events {
+		require.Equal(t, "hash_pack_001", event.TargetHash)
+		require.Equal(t, start.Add(time.Duration(i)*24*time.Hour).Unix(), event.EffectiveAt.Unix())
+	}
+
+	// Time range narrows the listing to the second revocation
+	day := start.Add(24 * time.Hour).Unix()
+	events, _, err = f.keeper.GetRevocationEvents(ctx, "hash_pack_001", types.RevocationFilter{From: day, To: day + 3600}, nil)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	require.Equal(t, day, events[0].EffectiveAt.Unix())
+
+	// Pages follow the target index
+	page, pageRes, err := f.keeper.GetRevocationEvents(ctx, "hash_pack_001", types.RevocationFilter{}, &query.PageRequest{Limit: 2})
+	require.NoError(t, err)
+	require.Len(t, page, 2)
+	require.NotEmpty(t, pageRes.NextKey)
+	rest, _, err := f.keeper.GetRevocationEvents(ctx, "hash_pack_001", types.RevocationFilter{}, &query.PageRequest{Key: pageRes.NextKey, Limit: 2})
+	require.NoError(t, err)
+	require.Len(t, rest, 1)
+
+	// Without a target every revocation is listed
+	events, _, err = f.keeper.GetRevocationEvents(ctx, "", types.RevocationFilter{}, nil)
+	require.NoError(t, err)
+	require.Len(t, events, 4)
+
+	events, _, err = f.keeper.GetRevocationEvents(ctx, "hash_unknown", types.RevocationFilter{}, nil)
+	require.NoError(t, err)
+	require.Empty(t, events)
+}
+
+func TestQueryGetRevocationEvents(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(1752484904, 0))
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	_, err := f.keeper.CreateAnonymousRevocationEvent(ctx, "hash_pack_001", "INDIVIDUAL", "IMMEDIATE", "SECURITY", "hash_initiator")
+	require.NoError(t, err)
+
+	resp, err := qs.GetRevocationEvents(ctx, &types.QueryGetRevocationEventsRequest{TargetHash: "hash_pack_001"})
+	require.NoError(t, err)
+	require.Len(t, resp.Events, 1)
+	require.Equal(t, "SECURITY", resp.Events[0].ReasonCategory)
+
+	_, err = qs.GetRevocationEvents(ctx, &types.QueryGetRevocationEventsRequest{From: 200, To: 100})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
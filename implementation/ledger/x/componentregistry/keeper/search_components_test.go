@@ -0,0 +1,89 @@
+package keeper_test
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"racecar-web/x/componentregistry/keeper"
+	"racecar-web/x/componentregistry/types"
+)
+
+// anonymousHash is the hash anonymous registration stores for a manufacturer or category
+func anonymousHash(value string) string {
+	hash := sha256.Sum256([]byte(value))
+	return hex.EncodeToString(hash[:])
+}
+
+func TestSearchComponents(t *testing.T) {
+	f := initFixtureWithBackend(t, types.NewMockMySQLBackend())
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	register := func(realID, manufacturer, componentType string) types.Component {
+		component, err := f.keeper.RegisterAnonymousComponent(f.ctx, realID, manufacturer, componentType)
+		require.NoError(t, err)
+		return component
+	}
+	modA1 := register("MODBATT-MOD-001", "MFR-A", "module")
+	modA2 := register("MODBATT-MOD-002", "MFR-A", "module")
+	packA := register("MODBATT-PACK-001", "MFR-A", "pack")
+	modB := register("MODBATT-MOD-003", "MFR-B", "module")
+	// A legacy component without hashes is never a match
+	require.NoError(t, f.keeper.RegisterComponent(f.ctx, types.Component{ComponentId: "LEGACY-001", ComponentType: "module", Status: types.StatusActive}))
+
+	modA2.Status = types.StatusRetired
+	require.NoError(t, f.keeper.Components.Set(f.ctx, modA2.ComponentId, modA2))
+
+	search := func(req *types.QuerySearchComponentsRequest) []string {
+		resp, err := qs.SearchComponents(f.ctx, req)
+		require.NoError(t, err)
+		var ids []string
+		for _, component := range resp.Components {
+			ids = append(ids, component.ComponentId)
+		}
+		return ids
+	}
+
+	mfrA, mfrB := anonymousHash("MFR-A"), anonymousHash("MFR-B")
+	require.ElementsMatch(t, []string{modA1.ComponentId, modA2.ComponentId, packA.ComponentId},
+		search(&types.QuerySearchComponentsRequest{ManufacturerHash: mfrA}))
+	require.ElementsMatch(t, []string{modA1.ComponentId, modA2.ComponentId},
+		search(&types.QuerySearchComponentsRequest{ManufacturerHash: mfrA, CategoryHash: anonymousHash("module")}))
+	require.Equal(t, []string{modA1.ComponentId},
+		search(&types.QuerySearchComponentsRequest{ManufacturerHash: mfrA, CategoryHash: anonymousHash("module"), Status: types.StatusActive}))
+	require.Equal(t, []string{modB.ComponentId}, search(&types.QuerySearchComponentsRequest{ManufacturerHash: mfrB}))
+	require.Empty(t, search(&types.QuerySearchComponentsRequest{ManufacturerHash: anonymousHash("MFR-C")}))
+
+	// Without a manufacturer hash the registry is walked
+	require.ElementsMatch(t, []string{modA1.ComponentId, modA2.ComponentId, modB.ComponentId},
+		search(&types.QuerySearchComponentsRequest{CategoryHash: anonymousHash("module")}))
+
+	// Index pages carry on from next_key
+	filter := types.ComponentSearchFilter{ManufacturerHash: mfrA}
+	page, pageRes, err := f.keeper.SearchComponents(f.ctx, filter, &query.PageRequest{Limit: 2, CountTotal: true})
+	require.NoError(t, err)
+	require.Len(t, page, 2)
+	require.Equal(t, uint64(3), pageRes.Total)
+	rest, pageRes, err := f.keeper.SearchComponents(f.ctx, filter, &query.PageRequest{Key: pageRes.NextKey, Limit: 2})
+	require.NoError(t, err)
+	require.Len(t, rest, 1)
+	require.Empty(t, pageRes.NextKey)
+	require.NotContains(t, []string{page[0].ComponentId, page[1].ComponentId}, rest[0].ComponentId)
+
+	_, err = qs.SearchComponents(f.ctx, &types.QuerySearchComponentsRequest{Status: types.StatusActive})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// Genesis import rebuilds the index
+	genesis, err := f.keeper.ExportGenesis(f.ctx)
+	require.NoError(t, err)
+	imported := initFixture(t)
+	require.NoError(t, imported.keeper.InitGenesis(imported.ctx, *genesis))
+	components, _, err := imported.keeper.SearchComponents(imported.ctx, filter, nil)
+	require.NoError(t, err)
+	require.Len(t, components, 3)
+}
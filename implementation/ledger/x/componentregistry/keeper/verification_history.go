@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"racecar-web/x/componentregistry/types"
+)
+
+// GetVerificationHistory returns one page of a component's recorded
+// verifications, oldest first unless the page request is reversed
+func (k Keeper) GetVerificationHistory(ctx context.Context, componentId string, pageReq *query.PageRequest) ([]types.VerificationRecord, *query.PageResponse, error) {
+	records, pageRes, err := query.CollectionPaginate(ctx, k.VerificationRecords, pageReq,
+		func(_ collections.Pair[string, uint64], record types.VerificationRecord) (types.VerificationRecord, error) {
+			return record, nil
+		},
+		query.WithCollectionPaginationPairPrefix[string, uint64](componentId),
+	)
+	if err != nil {
+		return nil, nil, errorsmod.Wrap(err, "failed to paginate verification history")
+	}
+	return records, pageRes, nil
+}
+
+// recordVerification appends a verification to the component's history with
+// the next sequence number, then evicts the oldest records beyond the
+// configured history length
+func (k Keeper) recordVerification(ctx context.Context, componentId, verifier, result, verificationContext string) error {
+	params, err := k.paramsOrDefault(ctx)
+	if err != nil {
+		return err
+	}
+
+	var lastSequence uint64
+	rng := collections.NewPrefixedPairRange[string, uint64](componentId).Descending()
+	err = k.VerificationRecords.Walk(ctx, rng, func(key collections.Pair[string, uint64], _ types.VerificationRecord) (bool, error) {
+		lastSequence = key.K2()
+		return true, nil
+	})
+	if err != nil {
+		return errorsmod.Wrap(err, "failed to read verification history")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	record := types.VerificationRecord{
+		ComponentId: componentId,
+		Sequence:    lastSequence + 1,
+		Verifier:    verifier,
+		Result:      result,
+		Context:     verificationContext,
+		VerifiedAt:  sdkCtx.BlockTime(),
+		BlockHeight: sdkCtx.BlockHeight(),
+	}
+	if err := k.VerificationRecords.Set(ctx, collections.Join(componentId, record.Sequence), record); err != nil {
+		return errorsmod.Wrap(err, "failed to record verification")
+	}
+
+	return k.evictVerificationHistory(ctx, componentId, record.Sequence, params.VerificationHistoryLength())
+}
+
+// evictVerificationHistory removes the records of a component that fall
+// outside the newest limit records. Sequences are contiguous, so every record
+// at or below lastSequence-limit goes, including those left over from a
+// higher limit.
+func (k Keeper) evictVerificationHistory(ctx context.Context, componentId string, lastSequence, limit uint64) error {
+	if lastSequence <= limit {
+		return nil
+	}
+	oldestKept := lastSequence - limit + 1
+
+	var evicted []collections.Pair[string, uint64]
+	rng := collections.NewPrefixedPairRange[string, uint64](componentId).EndExclusive(oldestKept)
+	err := k.VerificationRecords.Walk(ctx, rng, func(key collections.Pair[string, uint64], _ types.VerificationRecord) (bool, error) {
+		evicted = append(evicted, key)
+		return false, nil
+	})
+	if err != nil {
+		return errorsmod.Wrap(err, "failed to read verification history")
+	}
+
+	for _, key := range evicted {
+		if err := k.VerificationRecords.Remove(ctx, key); err != nil {
+			return errorsmod.Wrap(err, "failed to evict verification record")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,97 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"racecar-web/x/componentregistry/keeper"
+	"racecar-web/x/componentregistry/types"
+)
+
+func TestVerificationHistoryTimeline(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	factory := sdk.AccAddress("factory_____________").String()
+	scrutineer := sdk.AccAddress("scrutineer__________").String()
+	start := time.Unix(1752484904, 0).UTC()
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(start).WithBlockHeight(10)
+
+	_, err := ms.RegisterComponent(ctx, &types.MsgRegisterComponent{
+		Creator:          factory,
+		ComponentId:      "MODBATT-MOD-001",
+		ComponentType:    types.ComponentTypeModule,
+		ManufacturerData: `{"manufacturer_id":"RaceCarBatteryCo"}`,
+	})
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockTime(start.Add(time.Hour)).WithBlockHeight(20)
+	resp, err := ms.VerifyComponent(ctx, &types.MsgVerifyComponent{Creator: scrutineer, ComponentId: "MODBATT-MOD-001", Context: "pre-race scrutineering"})
+	require.NoError(t, err)
+	require.True(t, resp.IsValid)
+
+	// A failed verification is part of the timeline too
+	component, err := f.keeper.GetComponent(ctx, "MODBATT-MOD-001")
+	require.NoError(t, err)
+	component.Status = types.StatusMaintenance
+	require.NoError(t, f.keeper.Components.Set(ctx, "MODBATT-MOD-001", component))
+	ctx = ctx.WithBlockTime(start.Add(2 * time.Hour)).WithBlockHeight(30)
+	resp, err = ms.VerifyComponent(ctx, &types.MsgVerifyComponent{Creator: scrutineer, ComponentId: "MODBATT-MOD-001", Context: "post-race"})
+	require.NoError(t, err)
+	require.False(t, resp.IsValid)
+
+	// Unknown components have no history to append to
+	_, err = ms.VerifyComponent(ctx, &types.MsgVerifyComponent{Creator: scrutineer, ComponentId: "MODBATT-MOD-404"})
+	require.NoError(t, err)
+
+	history, err := qs.GetVerificationHistory(ctx, &types.QueryGetVerificationHistoryRequest{ComponentId: "MODBATT-MOD-001"})
+	require.NoError(t, err)
+	require.Equal(t, []types.VerificationRecord{
+		{ComponentId: "MODBATT-MOD-001", Sequence: 1, Verifier: scrutineer, Result: "verified", Context: "pre-race scrutineering", VerifiedAt: start.Add(time.Hour), BlockHeight: 20},
+		{ComponentId: "MODBATT-MOD-001", Sequence: 2, Verifier: scrutineer, Result: "failed_inactive", Context: "post-race", VerifiedAt: start.Add(2 * time.Hour), BlockHeight: 30},
+	}, history.Verifications)
+
+	// Newest first, one at a time
+	page, err := qs.GetVerificationHistory(ctx, &types.QueryGetVerificationHistoryRequest{
+		ComponentId: "MODBATT-MOD-001",
+		Pagination:  &query.PageRequest{Limit: 1, Reverse: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, page.Verifications, 1)
+	require.Equal(t, uint64(2), page.Verifications[0].Sequence)
+	require.NotEmpty(t, page.Pagination.NextKey)
+
+	_, err = qs.GetVerificationHistory(ctx, &types.QueryGetVerificationHistoryRequest{ComponentId: "MODBATT-MOD-404"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestVerificationHistoryIsCapped(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	require.NoError(t, f.keeper.Params.Set(f.ctx, types.NewParams(false, nil, 0, 3)))
+
+	scrutineer := sdk.AccAddress("scrutineer__________").String()
+	require.NoError(t, f.keeper.Components.Set(f.ctx, "MODBATT-MOD-001", types.Component{
+		ComponentId: "MODBATT-MOD-001",
+		Status:      types.StatusActive,
+	}))
+
+	for range 5 {
+		_, err := ms.VerifyComponent(f.ctx, &types.MsgVerifyComponent{Creator: scrutineer, ComponentId: "MODBATT-MOD-001"})
+		require.NoError(t, err)
+	}
+
+	// Only the newest three are kept
+	history, _, err := f.keeper.GetVerificationHistory(f.ctx, "MODBATT-MOD-001", nil)
+	require.NoError(t, err)
+	require.Len(t, history, 3)
+	require.Equal(t, uint64(3), history[0].Sequence)
+	require.Equal(t, uint64(5), history[2].Sequence)
+}
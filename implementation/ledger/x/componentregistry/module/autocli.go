@@ -41,6 +41,56 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:          "Query list-authorized-partners",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "component_id"}},
 				},
+				{
+					RpcMethod:      "GetComponentHistory",
+					Use:            "get-component-history [component-id]",
+					Short:          "Query the recorded metadata changes of a component",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "component_id"}},
+				},
+				{
+					RpcMethod: "ListComponents",
+					Use:       "list-components",
+					Short:     "Query a page of registered components",
+				},
+				{
+					RpcMethod: "GetRevocationEvents",
+					Use:       "get-revocation-events",
+					Short:     "Query a page of revocation events, optionally filtered by --target-hash, --from and --to",
+				},
+				{
+					RpcMethod: "ComponentHealth",
+					Use:       "component-health",
+					Short:     "Query fleet-wide component health, flagging stale, unverified and revoked components",
+				},
+				{
+					RpcMethod:      "GetCustodyChain",
+					Use:            "get-custody-chain [component-id]",
+					Short:          "Query the owners a component has passed through, oldest first",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "component_id"}},
+				},
+				{
+					RpcMethod: "SearchComponents",
+					Use:       "search-components",
+					Short:     "Query anonymous components with --manufacturer-hash and/or --category-hash, optionally in one --status",
+				},
+				{
+					RpcMethod:      "GetVerificationHistory",
+					Use:            "get-verification-history [component-id]",
+					Short:          "Query the recorded verifications of a component, oldest first",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "component_id"}},
+				},
+				{
+					RpcMethod:      "GetPairingRules",
+					Use:            "get-pairing-rules [source-type]",
+					Short:          "Query the pairing rules for a source component type or type hash",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "source_type"}},
+				},
+				{
+					RpcMethod:      "GetComponentMetadata",
+					Use:            "get-component-metadata [component-id]",
+					Short:          "Query a component together with its verification backend metadata",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "component_id"}},
+				},
 				// this line is used by ignite scaffolding # autocli/query
 			},
 		},
@@ -70,6 +120,12 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:          "Send a verify-component tx",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "component_id"}},
 				},
+				{
+					RpcMethod:      "TransferComponent",
+					Use:            "transfer-component [component-id] [new-owner] [reason]",
+					Short:          "Hand a component you own to another account",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "component_id"}, {ProtoField: "new_owner"}, {ProtoField: "reason"}},
+				},
 				// this line is used by ignite scaffolding # autocli/tx
 			},
 		},
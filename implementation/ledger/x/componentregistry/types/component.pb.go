@@ -416,6 +416,343 @@ func (m *ComponentVerification) GetNotes() string {
 	return ""
 }
 
+// ComponentFieldChange records a single field that changed during a component update
+type ComponentFieldChange struct {
+	// Name of the changed field (proto field name)
+	Field string `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	// Value before the update
+	OldValue string `protobuf:"bytes,2,opt,name=old_value,json=oldValue,proto3" json:"old_value,omitempty"`
+	// Value after the update
+	NewValue string `protobuf:"bytes,3,opt,name=new_value,json=newValue,proto3" json:"new_value,omitempty"`
+}
+
+func (m *ComponentFieldChange) Reset()         { *m = ComponentFieldChange{} }
+func (m *ComponentFieldChange) String() string { return proto.CompactTextString(m) }
+func (*ComponentFieldChange) ProtoMessage()    {}
+func (*ComponentFieldChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_01b52f0b939e3a16, []int{2}
+}
+func (m *ComponentFieldChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ComponentFieldChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ComponentFieldChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ComponentFieldChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ComponentFieldChange.Merge(m, src)
+}
+func (m *ComponentFieldChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *ComponentFieldChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_ComponentFieldChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ComponentFieldChange proto.InternalMessageInfo
+
+func (m *ComponentFieldChange) GetField() string {
+	if m != nil {
+		return m.Field
+	}
+	return ""
+}
+
+func (m *ComponentFieldChange) GetOldValue() string {
+	if m != nil {
+		return m.OldValue
+	}
+	return ""
+}
+
+func (m *ComponentFieldChange) GetNewValue() string {
+	if m != nil {
+		return m.NewValue
+	}
+	return ""
+}
+
+// ComponentHistoryEntry records the diff produced by one component update
+type ComponentHistoryEntry struct {
+	// Component ID the change applies to
+	ComponentId string `protobuf:"bytes,1,opt,name=component_id,json=componentId,proto3" json:"component_id,omitempty"`
+	// Monotonic per-component sequence number (starts at 1)
+	Sequence uint64 `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// Block time of the update
+	ChangedAt time.Time `protobuf:"bytes,3,opt,name=changed_at,json=changedAt,proto3,stdtime" json:"changed_at"`
+	// Fields that changed in this update
+	Changes []ComponentFieldChange `protobuf:"bytes,4,rep,name=changes,proto3" json:"changes"`
+}
+
+func (m *ComponentHistoryEntry) Reset()         { *m = ComponentHistoryEntry{} }
+func (m *ComponentHistoryEntry) String() string { return proto.CompactTextString(m) }
+func (*ComponentHistoryEntry) ProtoMessage()    {}
+func (*ComponentHistoryEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_01b52f0b939e3a16, []int{3}
+}
+func (m *ComponentHistoryEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ComponentHistoryEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ComponentHistoryEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ComponentHistoryEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ComponentHistoryEntry.Merge(m, src)
+}
+func (m *ComponentHistoryEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *ComponentHistoryEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_ComponentHistoryEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ComponentHistoryEntry proto.InternalMessageInfo
+
+func (m *ComponentHistoryEntry) GetComponentId() string {
+	if m != nil {
+		return m.ComponentId
+	}
+	return ""
+}
+
+func (m *ComponentHistoryEntry) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *ComponentHistoryEntry) GetChangedAt() time.Time {
+	if m != nil {
+		return m.ChangedAt
+	}
+	return time.Time{}
+}
+
+func (m *ComponentHistoryEntry) GetChanges() []ComponentFieldChange {
+	if m != nil {
+		return m.Changes
+	}
+	return nil
+}
+
+// CustodyEvent records one change of the account holding a component. The
+// first event of a chain is the registration, which has no previous owner.
+type CustodyEvent struct {
+	// Component ID the custody change applies to
+	ComponentId string `protobuf:"bytes,1,opt,name=component_id,json=componentId,proto3" json:"component_id,omitempty"`
+	// Monotonic per-component sequence number (starts at 1)
+	Sequence uint64 `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// Previous owner; empty for the registration
+	FromOwner string `protobuf:"bytes,3,opt,name=from_owner,json=fromOwner,proto3" json:"from_owner,omitempty"`
+	// New owner
+	ToOwner string `protobuf:"bytes,4,opt,name=to_owner,json=toOwner,proto3" json:"to_owner,omitempty"`
+	// Free-form reason given for the transfer
+	Reason string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Block time and height of the change
+	TransferredAt time.Time `protobuf:"bytes,6,opt,name=transferred_at,json=transferredAt,proto3,stdtime" json:"transferred_at"`
+	BlockHeight   int64     `protobuf:"varint,7,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+}
+
+func (m *CustodyEvent) Reset()         { *m = CustodyEvent{} }
+func (m *CustodyEvent) String() string { return proto.CompactTextString(m) }
+func (*CustodyEvent) ProtoMessage()    {}
+func (*CustodyEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_01b52f0b939e3a16, []int{4}
+}
+func (m *CustodyEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CustodyEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CustodyEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CustodyEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CustodyEvent.Merge(m, src)
+}
+func (m *CustodyEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *CustodyEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_CustodyEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CustodyEvent proto.InternalMessageInfo
+
+func (m *CustodyEvent) GetComponentId() string {
+	if m != nil {
+		return m.ComponentId
+	}
+	return ""
+}
+
+func (m *CustodyEvent) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *CustodyEvent) GetFromOwner() string {
+	if m != nil {
+		return m.FromOwner
+	}
+	return ""
+}
+
+func (m *CustodyEvent) GetToOwner() string {
+	if m != nil {
+		return m.ToOwner
+	}
+	return ""
+}
+
+func (m *CustodyEvent) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *CustodyEvent) GetTransferredAt() time.Time {
+	if m != nil {
+		return m.TransferredAt
+	}
+	return time.Time{}
+}
+
+func (m *CustodyEvent) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+// VerificationRecord records one verification of a component, kept so
+// auditors can show when and by whom a component was verified
+type VerificationRecord struct {
+	// Component ID the verification applies to
+	ComponentId string `protobuf:"bytes,1,opt,name=component_id,json=componentId,proto3" json:"component_id,omitempty"`
+	// Monotonic per-component sequence number (starts at 1)
+	Sequence uint64 `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// Account that requested the verification
+	Verifier string `protobuf:"bytes,3,opt,name=verifier,proto3" json:"verifier,omitempty"`
+	// Outcome: verified, or failed_inactive for a component that is not active
+	Result string `protobuf:"bytes,4,opt,name=result,proto3" json:"result,omitempty"`
+	// Free-form context given by the verifier, e.g. "pre-race scrutineering"
+	Context string `protobuf:"bytes,5,opt,name=context,proto3" json:"context,omitempty"`
+	// Block time and height of the verification
+	VerifiedAt  time.Time `protobuf:"bytes,6,opt,name=verified_at,json=verifiedAt,proto3,stdtime" json:"verified_at"`
+	BlockHeight int64     `protobuf:"varint,7,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+}
+
+func (m *VerificationRecord) Reset()         { *m = VerificationRecord{} }
+func (m *VerificationRecord) String() string { return proto.CompactTextString(m) }
+func (*VerificationRecord) ProtoMessage()    {}
+func (*VerificationRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_01b52f0b939e3a16, []int{5}
+}
+func (m *VerificationRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VerificationRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VerificationRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VerificationRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerificationRecord.Merge(m, src)
+}
+func (m *VerificationRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *VerificationRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerificationRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VerificationRecord proto.InternalMessageInfo
+
+func (m *VerificationRecord) GetComponentId() string {
+	if m != nil {
+		return m.ComponentId
+	}
+	return ""
+}
+
+func (m *VerificationRecord) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *VerificationRecord) GetVerifier() string {
+	if m != nil {
+		return m.Verifier
+	}
+	return ""
+}
+
+func (m *VerificationRecord) GetResult() string {
+	if m != nil {
+		return m.Result
+	}
+	return ""
+}
+
+func (m *VerificationRecord) GetContext() string {
+	if m != nil {
+		return m.Context
+	}
+	return ""
+}
+
+func (m *VerificationRecord) GetVerifiedAt() time.Time {
+	if m != nil {
+		return m.VerifiedAt
+	}
+	return time.Time{}
+}
+
+func (m *VerificationRecord) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
 // ComponentPairingRule defines rules for component pairing
 type ComponentPairingRule struct {
 	// Source component type (hashed)
@@ -438,7 +775,7 @@ func (m *ComponentPairingRule) Reset()         { *m = ComponentPairingRule{} }
 func (m *ComponentPairingRule) String() string { return proto.CompactTextString(m) }
 func (*ComponentPairingRule) ProtoMessage()    {}
 func (*ComponentPairingRule) Descriptor() ([]byte, []int) {
-	return fileDescriptor_01b52f0b939e3a16, []int{2}
+	return fileDescriptor_01b52f0b939e3a16, []int{6}
 }
 func (m *ComponentPairingRule) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -540,7 +877,7 @@ func (m *AnonymousPairingAuthorization) Reset()         { *m = AnonymousPairingA
 func (m *AnonymousPairingAuthorization) String() string { return proto.CompactTextString(m) }
 func (*AnonymousPairingAuthorization) ProtoMessage()    {}
 func (*AnonymousPairingAuthorization) Descriptor() ([]byte, []int) {
-	return fileDescriptor_01b52f0b939e3a16, []int{3}
+	return fileDescriptor_01b52f0b939e3a16, []int{7}
 }
 func (m *AnonymousPairingAuthorization) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -647,7 +984,7 @@ func (m *AnonymousRevocationEvent) Reset()         { *m = AnonymousRevocationEve
 func (m *AnonymousRevocationEvent) String() string { return proto.CompactTextString(m) }
 func (*AnonymousRevocationEvent) ProtoMessage()    {}
 func (*AnonymousRevocationEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_01b52f0b939e3a16, []int{4}
+	return fileDescriptor_01b52f0b939e3a16, []int{8}
 }
 func (m *AnonymousRevocationEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -730,6 +1067,10 @@ func init() {
 	proto.RegisterEnum("racecarweb.componentregistry.v1.VerificationStatus", VerificationStatus_name, VerificationStatus_value)
 	proto.RegisterType((*Component)(nil), "racecarweb.componentregistry.v1.Component")
 	proto.RegisterType((*ComponentVerification)(nil), "racecarweb.componentregistry.v1.ComponentVerification")
+	proto.RegisterType((*ComponentFieldChange)(nil), "racecarweb.componentregistry.v1.ComponentFieldChange")
+	proto.RegisterType((*ComponentHistoryEntry)(nil), "racecarweb.componentregistry.v1.ComponentHistoryEntry")
+	proto.RegisterType((*CustodyEvent)(nil), "racecarweb.componentregistry.v1.CustodyEvent")
+	proto.RegisterType((*VerificationRecord)(nil), "racecarweb.componentregistry.v1.VerificationRecord")
 	proto.RegisterType((*ComponentPairingRule)(nil), "racecarweb.componentregistry.v1.ComponentPairingRule")
 	proto.RegisterType((*AnonymousPairingAuthorization)(nil), "racecarweb.componentregistry.v1.AnonymousPairingAuthorization")
 	proto.RegisterType((*AnonymousRevocationEvent)(nil), "racecarweb.componentregistry.v1.AnonymousRevocationEvent")
@@ -740,87 +1081,104 @@ func init() {
 }
 
 var fileDescriptor_01b52f0b939e3a16 = []byte{
-	// 1277 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0xcd, 0x6e, 0xdb, 0x46,
-	0x17, 0xb5, 0xe4, 0xf8, 0x47, 0xd7, 0xb2, 0xcc, 0x8c, 0x2d, 0x87, 0x71, 0x12, 0xc9, 0x9f, 0x8d,
-	0x20, 0xfe, 0x52, 0xd4, 0x42, 0x12, 0xb4, 0x68, 0x81, 0x02, 0x05, 0x23, 0x31, 0x09, 0x9b, 0x46,
-	0x76, 0x29, 0xc5, 0x28, 0xba, 0x21, 0xc6, 0xe4, 0x48, 0x1a, 0x94, 0x22, 0x95, 0xe1, 0x48, 0xb1,
-	0xfa, 0x0a, 0xdd, 0x64, 0xd3, 0xe7, 0xe9, 0xa6, 0x40, 0xb3, 0xcc, 0xb2, 0xab, 0xb6, 0x48, 0xb6,
-	0x05, 0xfa, 0x0a, 0xc5, 0xcc, 0xf0, 0x4f, 0x91, 0x52, 0xd4, 0x3b, 0xf3, 0x9c, 0x73, 0x67, 0x46,
-	0xc7, 0xe7, 0xde, 0x19, 0x68, 0x30, 0xec, 0x12, 0x17, 0xb3, 0x97, 0xe4, 0xbc, 0xe1, 0x86, 0xc3,
-	0x51, 0x18, 0x90, 0x80, 0x33, 0xd2, 0xa7, 0x11, 0x67, 0xd3, 0xc6, 0xe4, 0x5e, 0x06, 0x1e, 0x8f,
-	0x58, 0xc8, 0x43, 0x54, 0xcf, 0x0a, 0x8e, 0xe7, 0x0a, 0x8e, 0x27, 0xf7, 0xf6, 0x76, 0xfa, 0x61,
-	0x3f, 0x94, 0xda, 0x86, 0xf8, 0x4b, 0x95, 0xed, 0xd5, 0xfb, 0x61, 0xd8, 0xf7, 0x49, 0x43, 0x7e,
-	0x9d, 0x8f, 0x7b, 0x0d, 0x4e, 0x87, 0x24, 0xe2, 0x78, 0x38, 0x52, 0x82, 0x83, 0x5f, 0xd6, 0xa0,
-	0xd4, 0x4c, 0xd6, 0x43, 0xff, 0x83, 0x72, 0xba, 0xb8, 0x43, 0x3d, 0xbd, 0xb0, 0x5f, 0x38, 0x2a,
-	0xd9, 0x1b, 0x29, 0x66, 0x79, 0xe8, 0x23, 0xb8, 0x3a, 0xc4, 0xc1, 0xb8, 0x87, 0x5d, 0x3e, 0x66,
-	0x84, 0x39, 0x03, 0x1c, 0x0d, 0xf4, 0xa2, 0xd4, 0x69, 0x79, 0xe2, 0x09, 0x8e, 0x06, 0xe8, 0x10,
-	0x36, 0x5d, 0xcc, 0x49, 0x3f, 0x64, 0x53, 0x25, 0x5c, 0x96, 0xc2, 0x72, 0x02, 0x4a, 0xd1, 0x67,
-	0xa0, 0xe3, 0x31, 0x1f, 0x84, 0x8c, 0xfe, 0x80, 0x39, 0x0d, 0x03, 0x87, 0x8d, 0x7d, 0x12, 0x29,
-	0xfd, 0x15, 0xa9, 0xdf, 0x9d, 0xe1, 0x6d, 0x41, 0xcb, 0xca, 0x5d, 0x58, 0x8d, 0x38, 0xe6, 0xe3,
-	0x48, 0x5f, 0x91, 0xba, 0xf8, 0x0b, 0x59, 0xb0, 0xa9, 0xac, 0x21, 0x8c, 0x78, 0x0e, 0xe6, 0xfa,
-	0xea, 0x7e, 0xe1, 0x68, 0xe3, 0xfe, 0xde, 0xb1, 0x72, 0xe3, 0x38, 0x71, 0xe3, 0xb8, 0x9b, 0xb8,
-	0xf1, 0x70, 0xfd, 0xf5, 0xef, 0xf5, 0xa5, 0x57, 0x7f, 0xd4, 0x0b, 0x76, 0x39, 0x2b, 0x35, 0xa4,
-	0x23, 0x9c, 0x8d, 0x23, 0xee, 0xe0, 0xc0, 0x1d, 0x84, 0x4c, 0x5f, 0x53, 0x8e, 0x48, 0xcc, 0x90,
-	0x10, 0x6a, 0x83, 0xe6, 0xe3, 0x88, 0x3b, 0x13, 0xc2, 0x68, 0x8f, 0xaa, 0x0d, 0xd7, 0x2f, 0xb1,
-	0x61, 0x45, 0x54, 0x9f, 0xc5, 0xc5, 0x06, 0x47, 0x0f, 0xa0, 0xaa, 0x96, 0x72, 0x95, 0x1d, 0x43,
-	0xc2, 0xb1, 0x87, 0x39, 0xd6, 0x4b, 0x72, 0xef, 0x9d, 0x3c, 0xf9, 0x2c, 0xe6, 0x50, 0x03, 0xb6,
-	0x19, 0xf1, 0x25, 0x16, 0x0d, 0xe8, 0x48, 0xba, 0x47, 0x22, 0x1d, 0xf6, 0x97, 0x8f, 0x4a, 0x36,
-	0xca, 0x53, 0x4f, 0x24, 0x83, 0xae, 0xc3, 0xba, 0xef, 0x72, 0xe5, 0xf2, 0x86, 0x5c, 0x78, 0xcd,
-	0x77, 0xb9, 0xb4, 0xf5, 0x73, 0xb8, 0x4e, 0x02, 0x97, 0x4d, 0x47, 0x9c, 0x78, 0x8e, 0x47, 0x26,
-	0xd4, 0x25, 0xce, 0xf7, 0x44, 0xfc, 0x07, 0xfd, 0x9e, 0x5e, 0xde, 0x2f, 0x1c, 0x95, 0xed, 0xdd,
-	0x54, 0xd0, 0x92, 0xfc, 0x53, 0x32, 0x7d, 0x82, 0xfd, 0x1e, 0xba, 0x03, 0x5b, 0x33, 0xe9, 0xa0,
-	0x9e, 0xbe, 0x29, 0x17, 0xaf, 0xe4, 0x61, 0xcb, 0x43, 0xb7, 0xa1, 0x92, 0x25, 0x8d, 0x4f, 0x47,
-	0x44, 0xaf, 0x48, 0xdd, 0x66, 0x8a, 0x76, 0xa7, 0x23, 0x22, 0x64, 0x03, 0xcc, 0xbc, 0x97, 0x98,
-	0x11, 0x27, 0x1a, 0x11, 0x37, 0xd2, 0xb7, 0x94, 0x2c, 0x41, 0x3b, 0x02, 0x14, 0x39, 0x7b, 0x31,
-	0xc6, 0x3e, 0xe5, 0x53, 0x27, 0x72, 0x43, 0x46, 0x74, 0x4d, 0xe5, 0x2c, 0x06, 0x3b, 0x02, 0x43,
-	0x07, 0x50, 0x76, 0xf1, 0x08, 0x9f, 0x53, 0x9f, 0x72, 0x4a, 0x22, 0xfd, 0x6a, 0x92, 0xc5, 0x0c,
-	0x43, 0xff, 0x07, 0x6d, 0xc6, 0x46, 0xea, 0x45, 0x3a, 0x92, 0x1e, 0x6e, 0xe5, 0x71, 0xcb, 0x8b,
-	0x50, 0x15, 0x56, 0x85, 0x81, 0xd4, 0xd3, 0xb7, 0xe5, 0x42, 0x2b, 0xbe, 0x2b, 0xfa, 0xa3, 0x01,
-	0xdb, 0x0b, 0xd2, 0xac, 0xef, 0x48, 0x0d, 0x9a, 0x0f, 0x32, 0x6a, 0x02, 0xb8, 0x8c, 0x60, 0xae,
-	0x82, 0x53, 0xbd, 0x44, 0x70, 0x4a, 0x71, 0x9d, 0xc1, 0x0f, 0x7e, 0x2a, 0x42, 0x35, 0x6d, 0xe3,
-	0xb3, 0x5c, 0x40, 0xfe, 0x4b, 0x4b, 0x67, 0x6d, 0x54, 0x9c, 0x69, 0x23, 0x13, 0x36, 0xf2, 0x99,
-	0x5e, 0xbe, 0xc4, 0xd1, 0x60, 0x92, 0xe5, 0xb9, 0x01, 0xdb, 0xef, 0xe7, 0x79, 0x10, 0x7a, 0x71,
-	0x6b, 0xa3, 0xf7, 0xd2, 0x3c, 0x08, 0xbd, 0xb9, 0x06, 0x20, 0x13, 0xea, 0x91, 0xc0, 0x25, 0x71,
-	0x97, 0xcf, 0x34, 0x80, 0x19, 0x73, 0x68, 0x07, 0x56, 0x82, 0x90, 0x93, 0x48, 0xf6, 0x7a, 0xc9,
-	0x56, 0x1f, 0x07, 0x7f, 0x15, 0x61, 0x27, 0xf5, 0xe5, 0x14, 0x53, 0x46, 0x83, 0xbe, 0xb0, 0x1d,
-	0x1d, 0x81, 0x16, 0x85, 0x63, 0xe6, 0x12, 0x19, 0x3e, 0xd5, 0x06, 0xca, 0x9a, 0x8a, 0xc2, 0x45,
-	0xfc, 0x64, 0x37, 0x1c, 0x81, 0xc6, 0x31, 0xeb, 0x13, 0x9e, 0x53, 0x2a, 0x9f, 0x2a, 0x0a, 0x4f,
-	0x95, 0x77, 0xe1, 0xea, 0x90, 0x06, 0xce, 0x6c, 0x12, 0xd5, 0xc4, 0xdb, 0x1a, 0xd2, 0xe0, 0x9b,
-	0x7c, 0x18, 0xbf, 0x80, 0x3d, 0x46, 0x5e, 0x8c, 0xa9, 0x18, 0x50, 0xf9, 0x04, 0xe6, 0xc7, 0x9e,
-	0x9e, 0x28, 0x9a, 0x39, 0x81, 0xdc, 0x49, 0x0e, 0xe1, 0x0b, 0x27, 0x1f, 0x49, 0x35, 0x03, 0x37,
-	0xc5, 0x10, 0xbe, 0xb0, 0xf3, 0x38, 0xfa, 0x04, 0x76, 0xcf, 0xa9, 0x47, 0x19, 0x71, 0x05, 0x86,
-	0x7d, 0x27, 0x59, 0x56, 0x5a, 0xb5, 0x6e, 0x57, 0x67, 0x58, 0x3b, 0x26, 0xd1, 0x7d, 0xa8, 0x4e,
-	0xb0, 0x4f, 0xbd, 0xb9, 0x99, 0xac, 0x46, 0xe0, 0x76, 0x46, 0xa6, 0x03, 0xf9, 0xe0, 0xef, 0x22,
-	0xdc, 0x32, 0x82, 0x30, 0x98, 0x0e, 0xc3, 0x71, 0x14, 0xdb, 0x6d, 0xe4, 0x23, 0x8f, 0xae, 0xc1,
-	0x9a, 0xe8, 0x81, 0x2c, 0x89, 0xab, 0xe2, 0xd3, 0xf2, 0x84, 0xcd, 0x59, 0x4e, 0xc5, 0x3e, 0x0e,
-	0x4e, 0x6c, 0x4e, 0x71, 0xb1, 0x87, 0xb1, 0x40, 0x79, 0x1e, 0xbb, 0x3c, 0xab, 0x7c, 0x88, 0x6e,
-	0x40, 0x49, 0x9c, 0x3b, 0xef, 0xe9, 0xba, 0x00, 0xfe, 0xf5, 0xf2, 0x68, 0x02, 0x90, 0x8b, 0x11,
-	0x65, 0x24, 0xba, 0xec, 0xcd, 0x51, 0x8a, 0xeb, 0x0c, 0x8e, 0x3e, 0x85, 0x6b, 0xea, 0xda, 0x90,
-	0x21, 0x48, 0x1c, 0x1f, 0x92, 0x80, 0xc7, 0xf6, 0x55, 0x25, 0x2d, 0xb3, 0x60, 0x67, 0xe4, 0xfc,
-	0xf4, 0xf0, 0xc9, 0x84, 0xf8, 0xf2, 0x3a, 0x79, 0x7f, 0x7a, 0x7c, 0x2d, 0x98, 0x83, 0x5f, 0x8b,
-	0xa0, 0xa7, 0x8e, 0xdb, 0x64, 0x12, 0x26, 0x6d, 0x21, 0x56, 0x3b, 0x14, 0xf7, 0x60, 0x02, 0x65,
-	0x96, 0x97, 0x33, 0xd0, 0xf2, 0x50, 0x1d, 0x36, 0xe2, 0x7c, 0xe7, 0xa2, 0x0d, 0x0a, 0x92, 0x46,
-	0xdd, 0x81, 0xad, 0xdc, 0x2a, 0x72, 0x56, 0xc7, 0x76, 0x67, 0xb0, 0x1c, 0xd6, 0x87, 0xb0, 0x39,
-	0x66, 0x7d, 0x12, 0xb8, 0xd3, 0xf8, 0xd8, 0xca, 0xf2, 0x72, 0x0c, 0xca, 0x03, 0xa3, 0xc7, 0x50,
-	0x26, 0xbd, 0x9e, 0x48, 0xdb, 0x84, 0x08, 0x83, 0x57, 0x2e, 0x61, 0xf0, 0x46, 0x5a, 0x69, 0x70,
-	0x75, 0x2c, 0x1c, 0x85, 0x81, 0x93, 0xbc, 0x26, 0xe2, 0xd6, 0xaf, 0x28, 0xb8, 0x19, 0xa3, 0xe2,
-	0x0e, 0xa1, 0x01, 0xe5, 0x14, 0xf3, 0x90, 0xe5, 0x13, 0xbc, 0x99, 0xa2, 0xe2, 0x67, 0xde, 0xfd,
-	0xb1, 0x08, 0x5b, 0xe9, 0xa8, 0xe8, 0xa8, 0x2c, 0xec, 0xc3, 0xcd, 0xe6, 0xc9, 0xb3, 0xd3, 0x93,
-	0xb6, 0xd9, 0xee, 0x3a, 0x9d, 0xae, 0xd1, 0x7d, 0xde, 0x71, 0x9e, 0xb7, 0x3b, 0xa7, 0x66, 0xd3,
-	0x7a, 0x64, 0x99, 0x2d, 0x6d, 0x09, 0xdd, 0x04, 0x7d, 0x4e, 0x71, 0x6a, 0xb6, 0x5b, 0x56, 0xfb,
-	0xb1, 0x56, 0x40, 0x37, 0xe0, 0xda, 0x1c, 0x6b, 0x34, 0xbb, 0xd6, 0x99, 0xa9, 0x15, 0xd1, 0x2d,
-	0xb8, 0x3e, 0x47, 0x5a, 0xed, 0x98, 0x5e, 0x5e, 0xb8, 0xf7, 0x33, 0xc3, 0x6a, 0x77, 0xcd, 0xb6,
-	0xd1, 0x6e, 0x9a, 0xda, 0x95, 0x85, 0x7b, 0xdb, 0x66, 0xd7, 0xb2, 0xcd, 0x96, 0xb6, 0xf2, 0x01,
-	0xf6, 0xec, 0xe4, 0xa9, 0xd9, 0xd2, 0x56, 0x51, 0x0d, 0xf6, 0xe6, 0xd8, 0xce, 0xf3, 0x8e, 0x38,
-	0xba, 0xd9, 0xd2, 0xd6, 0xee, 0xfe, 0x5c, 0x00, 0x94, 0xbf, 0x47, 0x62, 0x43, 0x0e, 0xa1, 0x7e,
-	0x66, 0xda, 0xd6, 0x23, 0xab, 0x69, 0x74, 0xad, 0x93, 0xf6, 0x62, 0x4f, 0xea, 0x70, 0x63, 0x91,
-	0x28, 0xb3, 0x65, 0x1f, 0x6e, 0x2e, 0x12, 0x28, 0xcc, 0x6c, 0x69, 0xc5, 0x0f, 0x29, 0x6c, 0xf3,
-	0x2b, 0xb3, 0xd9, 0x35, 0x5b, 0xda, 0xf2, 0x87, 0x36, 0x31, 0xbf, 0x3d, 0x95, 0xbf, 0xff, 0xca,
-	0xc3, 0x2f, 0x5f, 0xbf, 0xad, 0x15, 0xde, 0xbc, 0xad, 0x15, 0xfe, 0x7c, 0x5b, 0x2b, 0xbc, 0x7a,
-	0x57, 0x5b, 0x7a, 0xf3, 0xae, 0xb6, 0xf4, 0xdb, 0xbb, 0xda, 0xd2, 0x77, 0xb7, 0xe3, 0xb7, 0xf4,
-	0xc7, 0xe2, 0xf5, 0x7d, 0xb1, 0xe0, 0xfd, 0x2d, 0x32, 0x1e, 0x9d, 0xaf, 0xca, 0x2c, 0x3e, 0xf8,
-	0x27, 0x00, 0x00, 0xff, 0xff, 0x6a, 0x97, 0x7c, 0x59, 0xac, 0x0b, 0x00, 0x00,
+	// 1547 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x57, 0xcb, 0x6e, 0x1b, 0xc9,
+	0x15, 0x15, 0xa9, 0x17, 0x79, 0x45, 0x52, 0x74, 0xe9, 0xe1, 0xb6, 0x6c, 0x4b, 0x0a, 0x8d, 0xc1,
+	0x28, 0x0e, 0x42, 0x62, 0x3c, 0x98, 0x20, 0x01, 0x02, 0x04, 0x34, 0xd9, 0x1e, 0x75, 0x1c, 0x53,
+	0x4a, 0x93, 0x16, 0x82, 0x6c, 0x1a, 0xa5, 0xee, 0x22, 0x59, 0x98, 0x66, 0x17, 0x5d, 0x5d, 0x4d,
+	0x89, 0xf9, 0x85, 0x59, 0x64, 0x36, 0xf9, 0x9e, 0x6c, 0x02, 0x64, 0x96, 0xb3, 0xcc, 0x2a, 0x09,
+	0xec, 0x6d, 0x80, 0x00, 0xf9, 0x82, 0xa0, 0x1e, 0x4d, 0x36, 0x4d, 0x7a, 0x62, 0x4d, 0x76, 0xba,
+	0xe7, 0x9e, 0x7a, 0xf4, 0xd1, 0xb9, 0x75, 0x2f, 0xa1, 0xc1, 0xb1, 0x4f, 0x7c, 0xcc, 0x6f, 0xc8,
+	0x75, 0xc3, 0x67, 0xa3, 0x31, 0x8b, 0x48, 0x24, 0x38, 0x19, 0xd0, 0x58, 0xf0, 0x69, 0x63, 0xf2,
+	0xd9, 0x1c, 0xac, 0x8f, 0x39, 0x13, 0x0c, 0x9d, 0xcc, 0x17, 0xd4, 0x97, 0x16, 0xd4, 0x27, 0x9f,
+	0x1d, 0xed, 0x0f, 0xd8, 0x80, 0x29, 0x6e, 0x43, 0xfe, 0xa5, 0x97, 0x1d, 0x9d, 0x0c, 0x18, 0x1b,
+	0x84, 0xa4, 0xa1, 0xa2, 0xeb, 0xa4, 0xdf, 0x10, 0x74, 0x44, 0x62, 0x81, 0x47, 0x63, 0x4d, 0xa8,
+	0xfd, 0x65, 0x1b, 0x8a, 0xad, 0x74, 0x3f, 0xf4, 0x23, 0x28, 0xcd, 0x36, 0xf7, 0x68, 0x60, 0xe5,
+	0x4e, 0x73, 0x67, 0x45, 0x77, 0x67, 0x86, 0x39, 0x01, 0xfa, 0x09, 0xdc, 0x1b, 0xe1, 0x28, 0xe9,
+	0x63, 0x5f, 0x24, 0x9c, 0x70, 0x6f, 0x88, 0xe3, 0xa1, 0x95, 0x57, 0xbc, 0x6a, 0x36, 0x71, 0x8e,
+	0xe3, 0x21, 0x7a, 0x02, 0x65, 0x1f, 0x0b, 0x32, 0x60, 0x7c, 0xaa, 0x89, 0xeb, 0x8a, 0x58, 0x4a,
+	0x41, 0x45, 0xfa, 0x39, 0x58, 0x38, 0x11, 0x43, 0xc6, 0xe9, 0x1f, 0xb0, 0xa0, 0x2c, 0xf2, 0x78,
+	0x12, 0x92, 0x58, 0xf3, 0x37, 0x14, 0xff, 0x70, 0x21, 0xef, 0xca, 0xb4, 0x5a, 0x79, 0x08, 0x5b,
+	0xb1, 0xc0, 0x22, 0x89, 0xad, 0x4d, 0xc5, 0x33, 0x11, 0x72, 0xa0, 0xac, 0xa5, 0x21, 0x9c, 0x04,
+	0x1e, 0x16, 0xd6, 0xd6, 0x69, 0xee, 0x6c, 0xe7, 0xd9, 0x51, 0x5d, 0xab, 0x51, 0x4f, 0xd5, 0xa8,
+	0xf7, 0x52, 0x35, 0x9e, 0x17, 0xbe, 0xfd, 0xfb, 0xc9, 0xda, 0x37, 0xff, 0x38, 0xc9, 0xb9, 0xa5,
+	0xf9, 0xd2, 0xa6, 0x52, 0x44, 0xf0, 0x24, 0x16, 0x1e, 0x8e, 0xfc, 0x21, 0xe3, 0xd6, 0xb6, 0x56,
+	0x44, 0x61, 0x4d, 0x05, 0xa1, 0x0e, 0x54, 0x43, 0x1c, 0x0b, 0x6f, 0x42, 0x38, 0xed, 0x53, 0x7d,
+	0x60, 0xe1, 0x0e, 0x07, 0x56, 0xe4, 0xea, 0x2b, 0xb3, 0xb8, 0x29, 0xd0, 0xe7, 0x70, 0xa0, 0xb7,
+	0xf2, 0xb5, 0x1c, 0x23, 0x22, 0x70, 0x80, 0x05, 0xb6, 0x8a, 0xea, 0xec, 0xfd, 0x6c, 0xf2, 0x95,
+	0xc9, 0xa1, 0x06, 0xec, 0x71, 0x12, 0x2a, 0x2c, 0x1e, 0xd2, 0xb1, 0x52, 0x8f, 0xc4, 0x16, 0x9c,
+	0xae, 0x9f, 0x15, 0x5d, 0x94, 0x4d, 0x9d, 0xab, 0x0c, 0x7a, 0x00, 0x85, 0xd0, 0x17, 0x5a, 0xe5,
+	0x1d, 0xb5, 0xf1, 0x76, 0xe8, 0x0b, 0x25, 0xeb, 0x2f, 0xe0, 0x01, 0x89, 0x7c, 0x3e, 0x1d, 0x0b,
+	0x12, 0x78, 0x01, 0x99, 0x50, 0x9f, 0x78, 0x5f, 0x11, 0xf9, 0x1f, 0x0c, 0xfb, 0x56, 0xe9, 0x34,
+	0x77, 0x56, 0x72, 0x0f, 0x67, 0x84, 0xb6, 0xca, 0xbf, 0x24, 0xd3, 0x73, 0x1c, 0xf6, 0xd1, 0xa7,
+	0xb0, 0xbb, 0xe0, 0x0e, 0x1a, 0x58, 0x65, 0xb5, 0x79, 0x25, 0x0b, 0x3b, 0x01, 0xfa, 0x04, 0x2a,
+	0x73, 0xa7, 0x89, 0xe9, 0x98, 0x58, 0x15, 0xc5, 0x2b, 0xcf, 0xd0, 0xde, 0x74, 0x4c, 0x24, 0x6d,
+	0x88, 0x79, 0x70, 0x83, 0x39, 0xf1, 0xe2, 0x31, 0xf1, 0x63, 0x6b, 0x57, 0xd3, 0x52, 0xb4, 0x2b,
+	0x41, 0xe9, 0xb3, 0x37, 0x09, 0x0e, 0xa9, 0x98, 0x7a, 0xb1, 0xcf, 0x38, 0xb1, 0xaa, 0xda, 0x67,
+	0x06, 0xec, 0x4a, 0x0c, 0xd5, 0xa0, 0xe4, 0xe3, 0x31, 0xbe, 0xa6, 0x21, 0x15, 0x94, 0xc4, 0xd6,
+	0xbd, 0xd4, 0x8b, 0x73, 0x0c, 0xfd, 0x18, 0xaa, 0x0b, 0x32, 0xd2, 0x20, 0xb6, 0x90, 0xd2, 0x70,
+	0x37, 0x8b, 0x3b, 0x41, 0x8c, 0x0e, 0x60, 0x4b, 0x0a, 0x48, 0x03, 0x6b, 0x4f, 0x6d, 0xb4, 0x19,
+	0xfa, 0xb2, 0x3e, 0x1a, 0xb0, 0xb7, 0xc2, 0xcd, 0xd6, 0xbe, 0xe2, 0xa0, 0x65, 0x23, 0xa3, 0x16,
+	0x80, 0xcf, 0x09, 0x16, 0xda, 0x38, 0x07, 0x77, 0x30, 0x4e, 0xd1, 0xac, 0x6b, 0x8a, 0xda, 0x9f,
+	0xf2, 0x70, 0x30, 0x2b, 0xe3, 0xab, 0x8c, 0x41, 0x3e, 0xa6, 0xa4, 0xe7, 0x65, 0x94, 0x5f, 0x28,
+	0x23, 0x1b, 0x76, 0xb2, 0x9e, 0x5e, 0xbf, 0xc3, 0xd5, 0x60, 0x32, 0xf7, 0x73, 0x03, 0xf6, 0xde,
+	0xf7, 0xf3, 0x90, 0x05, 0xa6, 0xb4, 0xd1, 0x7b, 0x6e, 0x1e, 0xb2, 0x60, 0xa9, 0x00, 0xc8, 0x84,
+	0x06, 0x24, 0xf2, 0x89, 0xa9, 0xf2, 0x85, 0x02, 0xb0, 0x4d, 0x0e, 0xed, 0xc3, 0x66, 0xc4, 0x04,
+	0x89, 0x55, 0xad, 0x17, 0x5d, 0x1d, 0xd4, 0xfa, 0xb0, 0x3f, 0x93, 0xe5, 0x05, 0x25, 0x61, 0xd0,
+	0x1a, 0xe2, 0x68, 0xa0, 0xd8, 0x7d, 0x19, 0x1a, 0x39, 0x74, 0x80, 0x1e, 0x42, 0x91, 0x85, 0x81,
+	0x37, 0xc1, 0x61, 0x42, 0x8c, 0x16, 0x05, 0x16, 0x06, 0x57, 0x32, 0x96, 0xc9, 0x88, 0xdc, 0x98,
+	0xa4, 0x7e, 0xc7, 0x0a, 0x11, 0xb9, 0x51, 0xc9, 0xda, 0x7f, 0x72, 0x19, 0xfd, 0xcf, 0x69, 0x2c,
+	0x18, 0x9f, 0xda, 0x91, 0xe0, 0xd3, 0x8f, 0xd1, 0xff, 0x08, 0x0a, 0x31, 0x79, 0x93, 0xa8, 0x4f,
+	0x94, 0xa7, 0x6e, 0xb8, 0xb3, 0x58, 0xb9, 0x43, 0x5d, 0xf9, 0xce, 0xff, 0x82, 0xa2, 0x59, 0xd7,
+	0x14, 0xe8, 0x35, 0x6c, 0xeb, 0x20, 0xb6, 0x36, 0x4e, 0xd7, 0xcf, 0x76, 0x9e, 0x7d, 0x51, 0xff,
+	0x1f, 0xed, 0xa4, 0xbe, 0x4a, 0xb5, 0xe7, 0x1b, 0x72, 0x73, 0x37, 0xdd, 0xab, 0xf6, 0xc7, 0x3c,
+	0x94, 0x5a, 0x49, 0x2c, 0x58, 0x30, 0xb5, 0x27, 0x1f, 0xd9, 0x3e, 0xbe, 0xef, 0x5b, 0x1f, 0x03,
+	0xf4, 0x39, 0x1b, 0x79, 0xec, 0x26, 0x22, 0xdc, 0x48, 0x5c, 0x94, 0xc8, 0x85, 0x04, 0xe4, 0x8b,
+	0x25, 0x98, 0x49, 0x6a, 0xf3, 0x6c, 0x0b, 0xa6, 0x53, 0x87, 0xb0, 0xc5, 0x09, 0x8e, 0x59, 0x94,
+	0x36, 0x02, 0x1d, 0xa1, 0x97, 0x50, 0x11, 0x1c, 0x47, 0x71, 0x9f, 0xf0, 0x1f, 0xd0, 0x09, 0xca,
+	0x99, 0xb5, 0xba, 0x15, 0x5c, 0x87, 0xcc, 0xff, 0xca, 0x1b, 0x12, 0x3a, 0x18, 0x0a, 0xd5, 0x0a,
+	0xd6, 0xdd, 0x1d, 0x85, 0x9d, 0x2b, 0xa8, 0xf6, 0x75, 0x1e, 0x50, 0xb6, 0xfa, 0x5c, 0xe2, 0x33,
+	0x1e, 0xfc, 0xbf, 0xba, 0x1c, 0x41, 0xc1, 0x94, 0x53, 0xaa, 0xca, 0x2c, 0xd6, 0x5f, 0x1e, 0x27,
+	0xa1, 0x30, 0x92, 0x98, 0x08, 0x59, 0xb0, 0xed, 0xb3, 0x48, 0x90, 0x5b, 0x61, 0x24, 0x49, 0xc3,
+	0xf7, 0xab, 0x7a, 0xeb, 0x07, 0x56, 0xf5, 0x47, 0xa8, 0xf1, 0xaf, 0x7c, 0xa6, 0xfa, 0x2e, 0x31,
+	0xe5, 0x34, 0x1a, 0xc8, 0x37, 0x0f, 0x9d, 0x41, 0x35, 0x66, 0x09, 0xf7, 0x89, 0x7a, 0xf9, 0x75,
+	0x0f, 0xd2, 0x9a, 0x54, 0x34, 0x2e, 0xdf, 0x7e, 0xd5, 0x8a, 0xce, 0xa0, 0x2a, 0x30, 0x1f, 0x10,
+	0x91, 0x61, 0xea, 0xc2, 0xac, 0x68, 0x7c, 0xc6, 0x7c, 0x0a, 0xf7, 0x46, 0x34, 0xf2, 0x16, 0xdb,
+	0x80, 0x56, 0x6b, 0x77, 0x44, 0xa3, 0xdf, 0x66, 0x3b, 0xc1, 0x2f, 0xe1, 0x88, 0x93, 0x37, 0x09,
+	0x95, 0x9e, 0xc8, 0x3e, 0xff, 0xd9, 0x99, 0xc3, 0x4a, 0x19, 0xad, 0x0c, 0x41, 0x9d, 0xa4, 0x26,
+	0xa0, 0x5b, 0x2f, 0xdb, 0x0f, 0xf4, 0x00, 0x52, 0x96, 0x13, 0xd0, 0xad, 0x9b, 0xc5, 0xd1, 0x17,
+	0x70, 0x78, 0x4d, 0x03, 0xca, 0x89, 0x2f, 0x31, 0x1c, 0x7a, 0xe9, 0xb6, 0x4a, 0xf8, 0x82, 0x7b,
+	0xb0, 0x90, 0x75, 0x4d, 0x12, 0x3d, 0x83, 0x83, 0x09, 0x0e, 0x69, 0xb0, 0x34, 0x10, 0xe9, 0xf9,
+	0x63, 0x6f, 0x9e, 0x9c, 0x4d, 0x43, 0xb5, 0x7f, 0xe7, 0xe1, 0x71, 0x33, 0x62, 0xd1, 0x74, 0xc4,
+	0x92, 0xd8, 0xc8, 0xdd, 0xcc, 0xf6, 0x1b, 0x74, 0x1f, 0xb6, 0x65, 0x03, 0x9a, 0x5b, 0x70, 0x4b,
+	0x86, 0x4e, 0x20, 0x65, 0x9e, 0x1b, 0x54, 0x9e, 0xe3, 0xe1, 0x54, 0xe6, 0x19, 0x2e, 0xcf, 0x68,
+	0xae, 0x60, 0x5e, 0x1b, 0x95, 0x17, 0x99, 0xcf, 0xe5, 0x7b, 0x29, 0xef, 0x9d, 0xd5, 0xb4, 0x20,
+	0x81, 0xef, 0x9d, 0xdc, 0x5a, 0x00, 0xe4, 0x76, 0x4c, 0x39, 0x89, 0xef, 0xea, 0xcd, 0xa2, 0x59,
+	0xd7, 0x14, 0xe8, 0x67, 0x70, 0x5f, 0xcf, 0x6c, 0xca, 0x04, 0xa9, 0xe2, 0x23, 0x12, 0x09, 0x23,
+	0xdf, 0x81, 0x4a, 0x2b, 0x2f, 0xb8, 0xf3, 0xe4, 0x72, 0xeb, 0x0e, 0xc9, 0x84, 0x84, 0x6a, 0x96,
+	0x7b, 0xbf, 0x75, 0xff, 0x46, 0x66, 0x6a, 0x7f, 0xcd, 0x83, 0x35, 0x53, 0xdc, 0x25, 0x13, 0x96,
+	0xf6, 0x24, 0xb9, 0xdb, 0x13, 0x39, 0x84, 0xa6, 0xd0, 0x5c, 0xf2, 0xd2, 0x1c, 0x74, 0x02, 0x74,
+	0x02, 0x3b, 0xc6, 0xdf, 0x19, 0x6b, 0x83, 0x86, 0x94, 0x50, 0x9f, 0xc2, 0x6e, 0x66, 0x17, 0x35,
+	0x28, 0x19, 0xb9, 0xe7, 0xb0, 0x9a, 0x94, 0x9e, 0x40, 0x39, 0xe1, 0x03, 0x12, 0xf9, 0x53, 0x73,
+	0x6d, 0x2d, 0x79, 0xc9, 0x80, 0xea, 0xc2, 0xe8, 0x4b, 0x28, 0x91, 0x7e, 0x5f, 0xba, 0x6d, 0x42,
+	0xa4, 0xc0, 0x9b, 0x77, 0x10, 0x78, 0x67, 0xb6, 0xb2, 0x29, 0xf4, 0xb5, 0xe4, 0x13, 0xeb, 0xa5,
+	0xa3, 0xbc, 0xe9, 0xbb, 0x15, 0x0d, 0xb7, 0x0c, 0x2a, 0x07, 0x38, 0x1a, 0x51, 0x41, 0xb1, 0x60,
+	0x3c, 0xeb, 0xe0, 0xf2, 0x0c, 0x95, 0x9f, 0xf9, 0xf4, 0xeb, 0x3c, 0xec, 0xce, 0x9e, 0x8a, 0xae,
+	0xf6, 0xc2, 0x29, 0x3c, 0x6a, 0x5d, 0xbc, 0xba, 0xbc, 0xe8, 0xd8, 0x9d, 0x9e, 0xd7, 0xed, 0x35,
+	0x7b, 0xaf, 0xbb, 0xde, 0xeb, 0x4e, 0xf7, 0xd2, 0x6e, 0x39, 0x2f, 0x1c, 0xbb, 0x5d, 0x5d, 0x43,
+	0x8f, 0xc0, 0x5a, 0x62, 0x5c, 0xda, 0x9d, 0xb6, 0xd3, 0xf9, 0xb2, 0x9a, 0x43, 0x0f, 0xe1, 0xfe,
+	0x52, 0xb6, 0xd9, 0xea, 0x39, 0x57, 0x76, 0x35, 0x8f, 0x1e, 0xc3, 0x83, 0xa5, 0xa4, 0xd3, 0x31,
+	0xe9, 0xf5, 0x95, 0x67, 0xbf, 0x6a, 0x3a, 0x9d, 0x9e, 0xdd, 0x69, 0x76, 0x5a, 0x76, 0x75, 0x63,
+	0xe5, 0xd9, 0xae, 0xdd, 0x73, 0x5c, 0xbb, 0x5d, 0xdd, 0xfc, 0x40, 0xf6, 0xea, 0xe2, 0xa5, 0xdd,
+	0xae, 0x6e, 0xa1, 0x63, 0x38, 0x5a, 0xca, 0x76, 0x5f, 0x77, 0xe5, 0xd5, 0xed, 0x76, 0x75, 0xfb,
+	0xe9, 0x9f, 0x73, 0x8b, 0x6d, 0xc4, 0x08, 0xf2, 0x04, 0x4e, 0xae, 0x6c, 0xd7, 0x79, 0xe1, 0xb4,
+	0x9a, 0x3d, 0xe7, 0xa2, 0xb3, 0x5a, 0x93, 0x13, 0x78, 0xb8, 0x8a, 0x34, 0x97, 0xe5, 0x14, 0x1e,
+	0xad, 0x22, 0x68, 0xcc, 0x6e, 0x57, 0xf3, 0x1f, 0x62, 0xb8, 0xf6, 0xaf, 0xed, 0x56, 0xcf, 0x6e,
+	0x57, 0xd7, 0x3f, 0x74, 0x88, 0xfd, 0xbb, 0x4b, 0xf5, 0xfd, 0x1b, 0xcf, 0x7f, 0xf5, 0xed, 0xdb,
+	0xe3, 0xdc, 0x77, 0x6f, 0x8f, 0x73, 0xff, 0x7c, 0x7b, 0x9c, 0xfb, 0xe6, 0xdd, 0xf1, 0xda, 0x77,
+	0xef, 0x8e, 0xd7, 0xfe, 0xf6, 0xee, 0x78, 0xed, 0xf7, 0x9f, 0x98, 0xc9, 0xe3, 0xa7, 0xf2, 0xa7,
+	0xef, 0xed, 0x8a, 0x1f, 0xbf, 0xd2, 0xe3, 0xf1, 0xf5, 0x96, 0xf2, 0xe2, 0xe7, 0xff, 0x0d, 0x00,
+	0x00, 0xff, 0xff, 0x33, 0x33, 0x55, 0x91, 0x29, 0x0f, 0x00, 0x00,
 }
 
 func (m *Component) Marshal() (dAtA []byte, err error) {
@@ -1078,7 +1436,7 @@ func (m *ComponentVerification) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *ComponentPairingRule) Marshal() (dAtA []byte, err error) {
+func (m *ComponentFieldChange) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1088,70 +1446,41 @@ func (m *ComponentPairingRule) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ComponentPairingRule) MarshalTo(dAtA []byte) (int, error) {
+func (m *ComponentFieldChange) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ComponentPairingRule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ComponentFieldChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ValidationRulesHash) > 0 {
-		i -= len(m.ValidationRulesHash)
-		copy(dAtA[i:], m.ValidationRulesHash)
-		i = encodeVarintComponent(dAtA, i, uint64(len(m.ValidationRulesHash)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if m.BidirectionalRequired {
-		i--
-		if m.BidirectionalRequired {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x30
-	}
-	if m.MaxRelationships != 0 {
-		i = encodeVarintComponent(dAtA, i, uint64(m.MaxRelationships))
-		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.RequiredCapabilitiesHash) > 0 {
-		i -= len(m.RequiredCapabilitiesHash)
-		copy(dAtA[i:], m.RequiredCapabilitiesHash)
-		i = encodeVarintComponent(dAtA, i, uint64(len(m.RequiredCapabilitiesHash)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.MinQualityScore) > 0 {
-		i -= len(m.MinQualityScore)
-		copy(dAtA[i:], m.MinQualityScore)
-		i = encodeVarintComponent(dAtA, i, uint64(len(m.MinQualityScore)))
+	if len(m.NewValue) > 0 {
+		i -= len(m.NewValue)
+		copy(dAtA[i:], m.NewValue)
+		i = encodeVarintComponent(dAtA, i, uint64(len(m.NewValue)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if len(m.TargetTypeHash) > 0 {
-		i -= len(m.TargetTypeHash)
-		copy(dAtA[i:], m.TargetTypeHash)
-		i = encodeVarintComponent(dAtA, i, uint64(len(m.TargetTypeHash)))
+	if len(m.OldValue) > 0 {
+		i -= len(m.OldValue)
+		copy(dAtA[i:], m.OldValue)
+		i = encodeVarintComponent(dAtA, i, uint64(len(m.OldValue)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.SourceTypeHash) > 0 {
-		i -= len(m.SourceTypeHash)
-		copy(dAtA[i:], m.SourceTypeHash)
-		i = encodeVarintComponent(dAtA, i, uint64(len(m.SourceTypeHash)))
+	if len(m.Field) > 0 {
+		i -= len(m.Field)
+		copy(dAtA[i:], m.Field)
+		i = encodeVarintComponent(dAtA, i, uint64(len(m.Field)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *AnonymousPairingAuthorization) Marshal() (dAtA []byte, err error) {
+func (m *ComponentHistoryEntry) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1161,23 +1490,291 @@ func (m *AnonymousPairingAuthorization) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AnonymousPairingAuthorization) MarshalTo(dAtA []byte) (int, error) {
+func (m *ComponentHistoryEntry) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AnonymousPairingAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ComponentHistoryEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.AuthorizationLevel) > 0 {
-		i -= len(m.AuthorizationLevel)
-		copy(dAtA[i:], m.AuthorizationLevel)
-		i = encodeVarintComponent(dAtA, i, uint64(len(m.AuthorizationLevel)))
-		i--
-		dAtA[i] = 0x42
-	}
+	if len(m.Changes) > 0 {
+		for iNdEx := len(m.Changes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Changes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintComponent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ChangedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ChangedAt):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintComponent(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x1a
+	if m.Sequence != 0 {
+		i = encodeVarintComponent(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ComponentId) > 0 {
+		i -= len(m.ComponentId)
+		copy(dAtA[i:], m.ComponentId)
+		i = encodeVarintComponent(dAtA, i, uint64(len(m.ComponentId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CustodyEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CustodyEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CustodyEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		i = encodeVarintComponent(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.TransferredAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.TransferredAt):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintComponent(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x32
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintComponent(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ToOwner) > 0 {
+		i -= len(m.ToOwner)
+		copy(dAtA[i:], m.ToOwner)
+		i = encodeVarintComponent(dAtA, i, uint64(len(m.ToOwner)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.FromOwner) > 0 {
+		i -= len(m.FromOwner)
+		copy(dAtA[i:], m.FromOwner)
+		i = encodeVarintComponent(dAtA, i, uint64(len(m.FromOwner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintComponent(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ComponentId) > 0 {
+		i -= len(m.ComponentId)
+		copy(dAtA[i:], m.ComponentId)
+		i = encodeVarintComponent(dAtA, i, uint64(len(m.ComponentId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VerificationRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VerificationRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VerificationRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		i = encodeVarintComponent(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.VerifiedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.VerifiedAt):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintComponent(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x32
+	if len(m.Context) > 0 {
+		i -= len(m.Context)
+		copy(dAtA[i:], m.Context)
+		i = encodeVarintComponent(dAtA, i, uint64(len(m.Context)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Result) > 0 {
+		i -= len(m.Result)
+		copy(dAtA[i:], m.Result)
+		i = encodeVarintComponent(dAtA, i, uint64(len(m.Result)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Verifier) > 0 {
+		i -= len(m.Verifier)
+		copy(dAtA[i:], m.Verifier)
+		i = encodeVarintComponent(dAtA, i, uint64(len(m.Verifier)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintComponent(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ComponentId) > 0 {
+		i -= len(m.ComponentId)
+		copy(dAtA[i:], m.ComponentId)
+		i = encodeVarintComponent(dAtA, i, uint64(len(m.ComponentId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ComponentPairingRule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ComponentPairingRule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ComponentPairingRule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidationRulesHash) > 0 {
+		i -= len(m.ValidationRulesHash)
+		copy(dAtA[i:], m.ValidationRulesHash)
+		i = encodeVarintComponent(dAtA, i, uint64(len(m.ValidationRulesHash)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.BidirectionalRequired {
+		i--
+		if m.BidirectionalRequired {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MaxRelationships != 0 {
+		i = encodeVarintComponent(dAtA, i, uint64(m.MaxRelationships))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.RequiredCapabilitiesHash) > 0 {
+		i -= len(m.RequiredCapabilitiesHash)
+		copy(dAtA[i:], m.RequiredCapabilitiesHash)
+		i = encodeVarintComponent(dAtA, i, uint64(len(m.RequiredCapabilitiesHash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.MinQualityScore) > 0 {
+		i -= len(m.MinQualityScore)
+		copy(dAtA[i:], m.MinQualityScore)
+		i = encodeVarintComponent(dAtA, i, uint64(len(m.MinQualityScore)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TargetTypeHash) > 0 {
+		i -= len(m.TargetTypeHash)
+		copy(dAtA[i:], m.TargetTypeHash)
+		i = encodeVarintComponent(dAtA, i, uint64(len(m.TargetTypeHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SourceTypeHash) > 0 {
+		i -= len(m.SourceTypeHash)
+		copy(dAtA[i:], m.SourceTypeHash)
+		i = encodeVarintComponent(dAtA, i, uint64(len(m.SourceTypeHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AnonymousPairingAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AnonymousPairingAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AnonymousPairingAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AuthorizationLevel) > 0 {
+		i -= len(m.AuthorizationLevel)
+		copy(dAtA[i:], m.AuthorizationLevel)
+		i = encodeVarintComponent(dAtA, i, uint64(len(m.AuthorizationLevel)))
+		i--
+		dAtA[i] = 0x42
+	}
 	if len(m.TrustScoreRequirement) > 0 {
 		i -= len(m.TrustScoreRequirement)
 		copy(dAtA[i:], m.TrustScoreRequirement)
@@ -1185,12 +1782,12 @@ func (m *AnonymousPairingAuthorization) MarshalToSizedBuffer(dAtA []byte) (int,
 		i--
 		dAtA[i] = 0x3a
 	}
-	n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ExpiresAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpiresAt):])
-	if err5 != nil {
-		return 0, err5
+	n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ExpiresAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpiresAt):])
+	if err8 != nil {
+		return 0, err8
 	}
-	i -= n5
-	i = encodeVarintComponent(dAtA, i, uint64(n5))
+	i -= n8
+	i = encodeVarintComponent(dAtA, i, uint64(n8))
 	i--
 	dAtA[i] = 0x32
 	if len(m.Status) > 0 {
@@ -1265,12 +1862,12 @@ func (m *AnonymousRevocationEvent) MarshalToSizedBuffer(dAtA []byte) (int, error
 		i--
 		dAtA[i] = 0x32
 	}
-	n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EffectiveAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EffectiveAt):])
-	if err6 != nil {
-		return 0, err6
+	n9, err9 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EffectiveAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EffectiveAt):])
+	if err9 != nil {
+		return 0, err9
 	}
-	i -= n6
-	i = encodeVarintComponent(dAtA, i, uint64(n6))
+	i -= n9
+	i = encodeVarintComponent(dAtA, i, uint64(n9))
 	i--
 	dAtA[i] = 0x2a
 	if len(m.UrgencyLevel) > 0 {
@@ -1437,64 +2034,175 @@ func (m *ComponentVerification) Size() (n int) {
 	return n
 }
 
-func (m *ComponentPairingRule) Size() (n int) {
+func (m *ComponentFieldChange) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.SourceTypeHash)
+	l = len(m.Field)
 	if l > 0 {
 		n += 1 + l + sovComponent(uint64(l))
 	}
-	l = len(m.TargetTypeHash)
+	l = len(m.OldValue)
 	if l > 0 {
 		n += 1 + l + sovComponent(uint64(l))
 	}
-	l = len(m.MinQualityScore)
+	l = len(m.NewValue)
 	if l > 0 {
 		n += 1 + l + sovComponent(uint64(l))
 	}
-	l = len(m.RequiredCapabilitiesHash)
+	return n
+}
+
+func (m *ComponentHistoryEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ComponentId)
 	if l > 0 {
 		n += 1 + l + sovComponent(uint64(l))
 	}
-	if m.MaxRelationships != 0 {
-		n += 1 + sovComponent(uint64(m.MaxRelationships))
-	}
-	if m.BidirectionalRequired {
-		n += 2
+	if m.Sequence != 0 {
+		n += 1 + sovComponent(uint64(m.Sequence))
 	}
-	l = len(m.ValidationRulesHash)
-	if l > 0 {
-		n += 1 + l + sovComponent(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ChangedAt)
+	n += 1 + l + sovComponent(uint64(l))
+	if len(m.Changes) > 0 {
+		for _, e := range m.Changes {
+			l = e.Size()
+			n += 1 + l + sovComponent(uint64(l))
+		}
 	}
 	return n
 }
 
-func (m *AnonymousPairingAuthorization) Size() (n int) {
+func (m *CustodyEvent) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.AuthId)
+	l = len(m.ComponentId)
 	if l > 0 {
 		n += 1 + l + sovComponent(uint64(l))
 	}
-	l = len(m.ComponentHashA)
-	if l > 0 {
-		n += 1 + l + sovComponent(uint64(l))
+	if m.Sequence != 0 {
+		n += 1 + sovComponent(uint64(m.Sequence))
 	}
-	l = len(m.ComponentHashB)
+	l = len(m.FromOwner)
 	if l > 0 {
 		n += 1 + l + sovComponent(uint64(l))
 	}
-	l = len(m.RuleHash)
+	l = len(m.ToOwner)
 	if l > 0 {
 		n += 1 + l + sovComponent(uint64(l))
 	}
-	l = len(m.Status)
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovComponent(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.TransferredAt)
+	n += 1 + l + sovComponent(uint64(l))
+	if m.BlockHeight != 0 {
+		n += 1 + sovComponent(uint64(m.BlockHeight))
+	}
+	return n
+}
+
+func (m *VerificationRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ComponentId)
+	if l > 0 {
+		n += 1 + l + sovComponent(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovComponent(uint64(m.Sequence))
+	}
+	l = len(m.Verifier)
+	if l > 0 {
+		n += 1 + l + sovComponent(uint64(l))
+	}
+	l = len(m.Result)
+	if l > 0 {
+		n += 1 + l + sovComponent(uint64(l))
+	}
+	l = len(m.Context)
+	if l > 0 {
+		n += 1 + l + sovComponent(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.VerifiedAt)
+	n += 1 + l + sovComponent(uint64(l))
+	if m.BlockHeight != 0 {
+		n += 1 + sovComponent(uint64(m.BlockHeight))
+	}
+	return n
+}
+
+func (m *ComponentPairingRule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SourceTypeHash)
+	if l > 0 {
+		n += 1 + l + sovComponent(uint64(l))
+	}
+	l = len(m.TargetTypeHash)
+	if l > 0 {
+		n += 1 + l + sovComponent(uint64(l))
+	}
+	l = len(m.MinQualityScore)
+	if l > 0 {
+		n += 1 + l + sovComponent(uint64(l))
+	}
+	l = len(m.RequiredCapabilitiesHash)
+	if l > 0 {
+		n += 1 + l + sovComponent(uint64(l))
+	}
+	if m.MaxRelationships != 0 {
+		n += 1 + sovComponent(uint64(m.MaxRelationships))
+	}
+	if m.BidirectionalRequired {
+		n += 2
+	}
+	l = len(m.ValidationRulesHash)
+	if l > 0 {
+		n += 1 + l + sovComponent(uint64(l))
+	}
+	return n
+}
+
+func (m *AnonymousPairingAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AuthId)
+	if l > 0 {
+		n += 1 + l + sovComponent(uint64(l))
+	}
+	l = len(m.ComponentHashA)
+	if l > 0 {
+		n += 1 + l + sovComponent(uint64(l))
+	}
+	l = len(m.ComponentHashB)
+	if l > 0 {
+		n += 1 + l + sovComponent(uint64(l))
+	}
+	l = len(m.RuleHash)
+	if l > 0 {
+		n += 1 + l + sovComponent(uint64(l))
+	}
+	l = len(m.Status)
 	if l > 0 {
 		n += 1 + l + sovComponent(uint64(l))
 	}
@@ -1508,51 +2216,778 @@ func (m *AnonymousPairingAuthorization) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovComponent(uint64(l))
 	}
-	return n
-}
+	return n
+}
+
+func (m *AnonymousRevocationEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RevocationId)
+	if l > 0 {
+		n += 1 + l + sovComponent(uint64(l))
+	}
+	l = len(m.TargetHash)
+	if l > 0 {
+		n += 1 + l + sovComponent(uint64(l))
+	}
+	l = len(m.RevocationType)
+	if l > 0 {
+		n += 1 + l + sovComponent(uint64(l))
+	}
+	l = len(m.UrgencyLevel)
+	if l > 0 {
+		n += 1 + l + sovComponent(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EffectiveAt)
+	n += 1 + l + sovComponent(uint64(l))
+	l = len(m.ReasonCategory)
+	if l > 0 {
+		n += 1 + l + sovComponent(uint64(l))
+	}
+	l = len(m.InitiatorHash)
+	if l > 0 {
+		n += 1 + l + sovComponent(uint64(l))
+	}
+	return n
+}
+
+func sovComponent(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozComponent(x uint64) (n int) {
+	return sovComponent(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Component) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowComponent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Component: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Component: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ComponentId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowComponent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthComponent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthComponent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ComponentId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ManufacturerHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowComponent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthComponent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthComponent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ManufacturerHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CategoryHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowComponent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthComponent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthComponent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CategoryHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthorizationRulesHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowComponent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthComponent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthComponent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuthorizationRulesHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowComponent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthComponent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthComponent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegisteredAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowComponent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthComponent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthComponent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.RegisteredAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrustAnchor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowComponent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthComponent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthComponent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TrustAnchor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastVerifiedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowComponent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthComponent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthComponent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.LastVerifiedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerificationMetadata", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowComponent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthComponent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthComponent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VerificationMetadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelationshipHashes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowComponent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthComponent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthComponent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RelationshipHashes = append(m.RelationshipHashes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LctHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowComponent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthComponent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthComponent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LctHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EncryptedDeviceKeyHalf", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowComponent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthComponent
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthComponent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EncryptedDeviceKeyHalf = append(m.EncryptedDeviceKeyHalf[:0], dAtA[iNdEx:postIndex]...)
+			if m.EncryptedDeviceKeyHalf == nil {
+				m.EncryptedDeviceKeyHalf = []byte{}
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ManufacturerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowComponent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthComponent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthComponent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ManufacturerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ComponentType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowComponent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthComponent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthComponent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ComponentType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HardwareSpecs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowComponent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthComponent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthComponent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HardwareSpecs = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QualityScore", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowComponent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthComponent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthComponent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QualityScore = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Capabilities", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowComponent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthComponent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthComponent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Capabilities = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelationshipIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowComponent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthComponent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthComponent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RelationshipIds = append(m.RelationshipIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LctId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowComponent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthComponent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthComponent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LctId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthorizationRules", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowComponent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthComponent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthComponent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuthorizationRules = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowComponent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthComponent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthComponent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CreatedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipComponent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthComponent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *AnonymousRevocationEvent) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.RevocationId)
-	if l > 0 {
-		n += 1 + l + sovComponent(uint64(l))
-	}
-	l = len(m.TargetHash)
-	if l > 0 {
-		n += 1 + l + sovComponent(uint64(l))
-	}
-	l = len(m.RevocationType)
-	if l > 0 {
-		n += 1 + l + sovComponent(uint64(l))
-	}
-	l = len(m.UrgencyLevel)
-	if l > 0 {
-		n += 1 + l + sovComponent(uint64(l))
-	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EffectiveAt)
-	n += 1 + l + sovComponent(uint64(l))
-	l = len(m.ReasonCategory)
-	if l > 0 {
-		n += 1 + l + sovComponent(uint64(l))
-	}
-	l = len(m.InitiatorHash)
-	if l > 0 {
-		n += 1 + l + sovComponent(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovComponent(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozComponent(x uint64) (n int) {
-	return sovComponent(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Component) Unmarshal(dAtA []byte) error {
+func (m *ComponentVerification) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1575,10 +3010,10 @@ func (m *Component) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Component: wiretype end group for non-group")
+			return fmt.Errorf("proto: ComponentVerification: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Component: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ComponentVerification: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1615,7 +3050,7 @@ func (m *Component) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ManufacturerHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1643,13 +3078,13 @@ func (m *Component) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ManufacturerHash = string(dAtA[iNdEx:postIndex])
+			m.Status = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CategoryHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VerifiedAt", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowComponent
@@ -1659,27 +3094,28 @@ func (m *Component) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthComponent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthComponent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CategoryHash = string(dAtA[iNdEx:postIndex])
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.VerifiedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuthorizationRulesHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VerificationMethod", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1707,11 +3143,11 @@ func (m *Component) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AuthorizationRulesHash = string(dAtA[iNdEx:postIndex])
+			m.VerificationMethod = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VerificationEvidence", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1739,13 +3175,13 @@ func (m *Component) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = string(dAtA[iNdEx:postIndex])
+			m.VerificationEvidence = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RegisteredAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Notes", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowComponent
@@ -1755,28 +3191,77 @@ func (m *Component) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthComponent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthComponent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.RegisteredAt, dAtA[iNdEx:postIndex]); err != nil {
+			m.Notes = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipComponent(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 7:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthComponent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ComponentFieldChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowComponent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ComponentFieldChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ComponentFieldChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TrustAnchor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1804,13 +3289,13 @@ func (m *Component) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TrustAnchor = string(dAtA[iNdEx:postIndex])
+			m.Field = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastVerifiedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OldValue", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowComponent
@@ -1820,28 +3305,27 @@ func (m *Component) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthComponent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthComponent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.LastVerifiedAt, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.OldValue = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VerificationMetadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewValue", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1869,43 +3353,61 @@ func (m *Component) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VerificationMetadata = string(dAtA[iNdEx:postIndex])
+			m.NewValue = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RelationshipHashes", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowComponent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipComponent(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthComponent
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthComponent
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ComponentHistoryEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowComponent
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RelationshipHashes = append(m.RelationshipHashes, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 11:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ComponentHistoryEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ComponentHistoryEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LctHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ComponentId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1933,13 +3435,13 @@ func (m *Component) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LctHash = string(dAtA[iNdEx:postIndex])
+			m.ComponentId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EncryptedDeviceKeyHalf", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
 			}
-			var byteLen int
+			m.Sequence = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowComponent
@@ -1949,31 +3451,16 @@ func (m *Component) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Sequence |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthComponent
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthComponent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.EncryptedDeviceKeyHalf = append(m.EncryptedDeviceKeyHalf[:0], dAtA[iNdEx:postIndex]...)
-			if m.EncryptedDeviceKeyHalf == nil {
-				m.EncryptedDeviceKeyHalf = []byte{}
-			}
-			iNdEx = postIndex
-		case 13:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ManufacturerId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangedAt", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowComponent
@@ -1983,29 +3470,30 @@ func (m *Component) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthComponent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthComponent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ManufacturerId = string(dAtA[iNdEx:postIndex])
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ChangedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 14:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ComponentType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Changes", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowComponent
@@ -2015,27 +3503,79 @@ func (m *Component) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthComponent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthComponent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ComponentType = string(dAtA[iNdEx:postIndex])
+			m.Changes = append(m.Changes, ComponentFieldChange{})
+			if err := m.Changes[len(m.Changes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 15:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipComponent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthComponent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CustodyEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowComponent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CustodyEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CustodyEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HardwareSpecs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ComponentId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2063,13 +3603,13 @@ func (m *Component) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HardwareSpecs = string(dAtA[iNdEx:postIndex])
+			m.ComponentId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 16:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QualityScore", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
 			}
-			var stringLen uint64
+			m.Sequence = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowComponent
@@ -2079,27 +3619,14 @@ func (m *Component) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Sequence |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthComponent
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthComponent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.QualityScore = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 17:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Capabilities", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FromOwner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2127,11 +3654,11 @@ func (m *Component) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Capabilities = string(dAtA[iNdEx:postIndex])
+			m.FromOwner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 18:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RelationshipIds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ToOwner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2159,11 +3686,11 @@ func (m *Component) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RelationshipIds = append(m.RelationshipIds, string(dAtA[iNdEx:postIndex]))
+			m.ToOwner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 19:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LctId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2191,13 +3718,13 @@ func (m *Component) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LctId = string(dAtA[iNdEx:postIndex])
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 20:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuthorizationRules", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferredAt", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowComponent
@@ -2207,29 +3734,30 @@ func (m *Component) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthComponent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthComponent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AuthorizationRules = string(dAtA[iNdEx:postIndex])
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.TransferredAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 21:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
-			var msglen int
+			m.BlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowComponent
@@ -2239,25 +3767,11 @@ func (m *Component) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.BlockHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthComponent
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthComponent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CreatedAt, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipComponent(dAtA[iNdEx:])
@@ -2279,7 +3793,7 @@ func (m *Component) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ComponentVerification) Unmarshal(dAtA []byte) error {
+func (m *VerificationRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2302,10 +3816,10 @@ func (m *ComponentVerification) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ComponentVerification: wiretype end group for non-group")
+			return fmt.Errorf("proto: VerificationRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ComponentVerification: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VerificationRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2341,10 +3855,10 @@ func (m *ComponentVerification) Unmarshal(dAtA []byte) error {
 			m.ComponentId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
 			}
-			var stringLen uint64
+			m.Sequence = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowComponent
@@ -2354,29 +3868,16 @@ func (m *ComponentVerification) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Sequence |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthComponent
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthComponent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Status = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VerifiedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Verifier", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowComponent
@@ -2386,28 +3887,27 @@ func (m *ComponentVerification) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthComponent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthComponent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.VerifiedAt, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Verifier = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VerificationMethod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2435,11 +3935,11 @@ func (m *ComponentVerification) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VerificationMethod = string(dAtA[iNdEx:postIndex])
+			m.Result = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VerificationEvidence", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Context", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2467,13 +3967,13 @@ func (m *ComponentVerification) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VerificationEvidence = string(dAtA[iNdEx:postIndex])
+			m.Context = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Notes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VerifiedAt", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowComponent
@@ -2483,24 +3983,44 @@ func (m *ComponentVerification) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthComponent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthComponent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Notes = string(dAtA[iNdEx:postIndex])
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.VerifiedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowComponent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipComponent(dAtA[iNdEx:])
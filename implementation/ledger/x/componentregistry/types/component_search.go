@@ -0,0 +1,23 @@
+package types
+
+// ComponentSearchFilter narrows a component search to anonymous hashes and a
+// status. Empty fields match every component.
+type ComponentSearchFilter struct {
+	ManufacturerHash string
+	CategoryHash     string
+	Status           string
+}
+
+// Matches reports whether component passes the filter
+func (f ComponentSearchFilter) Matches(component Component) bool {
+	if f.ManufacturerHash != "" && component.ManufacturerHash != f.ManufacturerHash {
+		return false
+	}
+	if f.CategoryHash != "" && component.CategoryHash != f.CategoryHash {
+		return false
+	}
+	if f.Status != "" && component.Status != f.Status {
+		return false
+	}
+	return true
+}
@@ -8,10 +8,17 @@ import (
 
 // x/componentregistry module sentinel errors
 var (
-	ErrInvalidSigner        = errors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
-	ErrInvalidComponentID   = errors.Register(ModuleName, 1101, "invalid component ID")
-	ErrComponentExists      = errors.Register(ModuleName, 1102, "component already exists")
-	ErrComponentNotFound    = errors.Register(ModuleName, 1103, "component not found")
-	ErrInvalidComponentType = errors.Register(ModuleName, 1104, "invalid component type")
-	ErrInvalidAuthority     = errors.Register(ModuleName, 1105, "invalid authority")
+	ErrInvalidSigner             = errors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
+	ErrInvalidComponentID        = errors.Register(ModuleName, 1101, "invalid component ID")
+	ErrComponentExists           = errors.Register(ModuleName, 1102, "component already exists")
+	ErrComponentNotFound         = errors.Register(ModuleName, 1103, "component not found")
+	ErrInvalidComponentType      = errors.Register(ModuleName, 1104, "invalid component type")
+	ErrInvalidAuthority          = errors.Register(ModuleName, 1105, "invalid authority")
+	ErrCreatorNotAllowed         = errors.Register(ModuleName, 1106, "CREATOR_NOT_ALLOWED")
+	ErrManufacturerQuotaExceeded = errors.Register(ModuleName, 1107, "MANUFACTURER_QUOTA_EXCEEDED")
+	ErrNotComponentOwner         = errors.Register(ModuleName, 1108, "NOT_COMPONENT_OWNER")
+	ErrInvalidTransfer           = errors.Register(ModuleName, 1109, "invalid component transfer")
+	ErrBackendNotConfigured      = errors.Register(ModuleName, 1110, "verification backend not configured")
+	ErrComponentHashNotFound     = errors.Register(ModuleName, 1111, "component hash not found")
+	ErrBackendUnavailable        = errors.Register(ModuleName, 1112, "verification backend unavailable")
 )
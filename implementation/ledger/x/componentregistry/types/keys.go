@@ -29,6 +29,22 @@ var (
 	PairingRulesPrefix       = collections.NewPrefix(3)
 	ManufacturerComponentKey = collections.NewPrefix(4)
 	PairingAuthorizationKey  = collections.NewPrefix(5)
+	ComponentHistoryPrefix   = collections.NewPrefix(6)
+	ManufacturerCountPrefix  = collections.NewPrefix(7)
+	RevocationEventPrefix    = collections.NewPrefix(8)
+	RevocationTargetPrefix   = collections.NewPrefix(9)
+	CustodyEventPrefix       = collections.NewPrefix(10)
+	ManufacturerHashPrefix   = collections.NewPrefix(11)
+	VerificationRecordPrefix = collections.NewPrefix(12)
+	ContentHashPrefix        = collections.NewPrefix(13)
+	CategoryHashPrefix       = collections.NewPrefix(14)
+
+	// Secondary indexes of components and verifications, see keeper.ComponentIndexes
+	ComponentStatusIndexPrefix       = collections.NewPrefix(15)
+	ComponentLastVerifiedIndexPrefix = collections.NewPrefix(16)
+	ComponentStatusCountPrefix       = collections.NewPrefix(17)
+	VerificationStatusIndexPrefix    = collections.NewPrefix(18)
+	VerificationStatusCountPrefix    = collections.NewPrefix(19)
 )
 
 // Component status constants
@@ -47,6 +63,21 @@ const (
 	VerificationStatusExpired  = "expired"
 )
 
+// Component health issue constants
+const (
+	HealthIssueStale              = "stale"
+	HealthIssueFailedVerification = "failed_verification"
+	HealthIssueRevoked            = "revoked"
+)
+
+// Verification backend status constants, reported with component metadata
+const (
+	BackendStatusOK            = "ok"
+	BackendStatusNotConfigured = "not_configured"
+	BackendStatusUnavailable   = "unavailable"
+	BackendStatusError         = "error"
+)
+
 // Component type constants
 const (
 	ComponentTypeModule  = "module"
@@ -1,17 +1,90 @@
 package types
 
+import (
+	"fmt"
+)
+
+// DefaultMaxVerificationHistory is the number of verifications kept per
+// component when max_verification_history is not set
+const DefaultMaxVerificationHistory uint64 = 200
+
 // NewParams creates a new Params instance.
-func NewParams() Params {
-	return Params{}
+func NewParams(creatorAllowlistEnabled bool, allowedCreators []string, maxComponentsPerManufacturer, maxVerificationHistory uint64) Params {
+	return Params{
+		CreatorAllowlistEnabled:      creatorAllowlistEnabled,
+		AllowedCreators:              allowedCreators,
+		MaxComponentsPerManufacturer: maxComponentsPerManufacturer,
+		MaxVerificationHistory:       maxVerificationHistory,
+	}
 }
 
 // DefaultParams returns a default set of parameters.
+// The creator allowlist and manufacturer quota are disabled by default so development
+// networks stay permissive.
 func DefaultParams() Params {
-	return NewParams()
+	return NewParams(false, nil, 0, DefaultMaxVerificationHistory)
 }
 
 // Validate validates the set of params.
 func (p Params) Validate() error {
+	seen := make(map[string]bool, len(p.AllowedCreators))
+	for _, creator := range p.AllowedCreators {
+		if creator == "" {
+			return fmt.Errorf("allowed creator cannot be empty")
+		}
+		if seen[creator] {
+			return fmt.Errorf("duplicate allowed creator: %s", creator)
+		}
+		seen[creator] = true
+	}
+
+	attestors := make(map[string]bool, len(p.VerificationAttestors))
+	for _, attestor := range p.VerificationAttestors {
+		if attestor == "" {
+			return fmt.Errorf("verification attestor cannot be empty")
+		}
+		if attestors[attestor] {
+			return fmt.Errorf("duplicate verification attestor: %s", attestor)
+		}
+		attestors[attestor] = true
+	}
 
 	return nil
 }
+
+// IsVerificationAttestor reports whether account may submit attested pairing verifications
+func (p Params) IsVerificationAttestor(account string) bool {
+	for _, attestor := range p.VerificationAttestors {
+		if attestor == account {
+			return true
+		}
+	}
+	return false
+}
+
+// IsCreatorAllowed reports whether the creator may register components under these params
+func (p Params) IsCreatorAllowed(creator string) bool {
+	if !p.CreatorAllowlistEnabled {
+		return true
+	}
+	for _, allowed := range p.AllowedCreators {
+		if allowed == creator {
+			return true
+		}
+	}
+	return false
+}
+
+// IsManufacturerQuotaExceeded reports whether a manufacturer that already registered
+// count components may not register another
+func (p Params) IsManufacturerQuotaExceeded(count uint64) bool {
+	return p.MaxComponentsPerManufacturer > 0 && count >= p.MaxComponentsPerManufacturer
+}
+
+// VerificationHistoryLength returns the number of verifications kept per component
+func (p Params) VerificationHistoryLength() uint64 {
+	if p.MaxVerificationHistory == 0 {
+		return DefaultMaxVerificationHistory
+	}
+	return p.MaxVerificationHistory
+}
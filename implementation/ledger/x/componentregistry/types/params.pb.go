@@ -26,6 +26,21 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Params defines the parameters for the module.
 type Params struct {
+	// When true, only creators listed in allowed_creators may register components.
+	// Leave disabled for permissive development networks.
+	CreatorAllowlistEnabled bool `protobuf:"varint,1,opt,name=creator_allowlist_enabled,json=creatorAllowlistEnabled,proto3" json:"creator_allowlist_enabled,omitempty"`
+	// Creator addresses permitted to register components when the allowlist is enabled
+	AllowedCreators []string `protobuf:"bytes,2,rep,name=allowed_creators,json=allowedCreators,proto3" json:"allowed_creators,omitempty"`
+	// Maximum number of components a single manufacturer may register.
+	// 0 disables the quota.
+	MaxComponentsPerManufacturer uint64 `protobuf:"varint,3,opt,name=max_components_per_manufacturer,json=maxComponentsPerManufacturer,proto3" json:"max_components_per_manufacturer,omitempty"`
+	// Number of verifications kept per component; the oldest are evicted
+	// first. 0 keeps the default.
+	MaxVerificationHistory uint64 `protobuf:"varint,4,opt,name=max_verification_history,json=maxVerificationHistory,proto3" json:"max_verification_history,omitempty"`
+	// Accounts allowed to submit pairing verification results attested by a
+	// manufacturer verification service. The service is called off-chain; the
+	// chain only trusts the result because an attestor signed it.
+	VerificationAttestors []string `protobuf:"bytes,5,rep,name=verification_attestors,json=verificationAttestors,proto3" json:"verification_attestors,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -61,6 +76,41 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+func (m *Params) GetCreatorAllowlistEnabled() bool {
+	if m != nil {
+		return m.CreatorAllowlistEnabled
+	}
+	return false
+}
+
+func (m *Params) GetAllowedCreators() []string {
+	if m != nil {
+		return m.AllowedCreators
+	}
+	return nil
+}
+
+func (m *Params) GetMaxComponentsPerManufacturer() uint64 {
+	if m != nil {
+		return m.MaxComponentsPerManufacturer
+	}
+	return 0
+}
+
+func (m *Params) GetMaxVerificationHistory() uint64 {
+	if m != nil {
+		return m.MaxVerificationHistory
+	}
+	return 0
+}
+
+func (m *Params) GetVerificationAttestors() []string {
+	if m != nil {
+		return m.VerificationAttestors
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "racecarweb.componentregistry.v1.Params")
 }
@@ -70,18 +120,30 @@ func init() {
 }
 
 var fileDescriptor_d46ffad07df66b32 = []byte{
-	// 175 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0x29, 0x4a, 0x4c, 0x4e,
-	0x4d, 0x4e, 0x2c, 0x2a, 0x4f, 0x4d, 0xd2, 0x4f, 0xce, 0xcf, 0x2d, 0xc8, 0xcf, 0x4b, 0xcd, 0x2b,
-	0x29, 0x4a, 0x4d, 0xcf, 0x2c, 0x2e, 0x29, 0xaa, 0xd4, 0x2f, 0x33, 0xd4, 0x2f, 0x48, 0x2c, 0x4a,
-	0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x92, 0x47, 0xa8, 0xd6, 0xc3, 0x50, 0xad,
-	0x57, 0x66, 0x28, 0x25, 0x98, 0x98, 0x9b, 0x99, 0x97, 0xaf, 0x0f, 0x26, 0x21, 0x7a, 0xa4, 0x44,
-	0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x4c, 0x7d, 0x10, 0x0b, 0x22, 0xaa, 0x64, 0xc1, 0xc5, 0x16, 0x00,
-	0x36, 0xd9, 0x4a, 0xef, 0xc5, 0x02, 0x79, 0xc6, 0xae, 0xe7, 0x1b, 0xb4, 0x54, 0x91, 0x9c, 0x52,
-	0x81, 0xc5, 0x31, 0x10, 0xf5, 0x4e, 0xf6, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8,
-	0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7,
-	0x10, 0x05, 0x33, 0x40, 0x17, 0x97, 0x09, 0x25, 0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c, 0x60, 0x17,
-	0x18, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0xb7, 0x52, 0xff, 0x41, 0xfb, 0x00, 0x00, 0x00,
+	// 357 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0xc1, 0x4a, 0x3a, 0x51,
+	0x14, 0xc6, 0x1d, 0xf5, 0x2f, 0xff, 0x66, 0x53, 0x0d, 0x65, 0x93, 0xc4, 0x28, 0x41, 0x60, 0x51,
+	0x33, 0x48, 0x04, 0xe1, 0x26, 0x4c, 0x84, 0x36, 0x81, 0xb8, 0x68, 0xd1, 0x66, 0x38, 0x8e, 0x47,
+	0x1b, 0x98, 0x99, 0x3b, 0x9c, 0x7b, 0xd5, 0xf1, 0x15, 0x5a, 0xf5, 0x08, 0x3d, 0x42, 0x8f, 0xd1,
+	0xd2, 0x5d, 0x2d, 0x43, 0x17, 0xf5, 0x18, 0xe1, 0x9d, 0xd1, 0x26, 0xaa, 0xcd, 0xe5, 0x70, 0xbe,
+	0xdf, 0xf7, 0x71, 0xb8, 0x9f, 0x7a, 0x4c, 0xe0, 0xa0, 0x03, 0x34, 0xc6, 0xae, 0xe5, 0x30, 0x3f,
+	0x64, 0x01, 0x06, 0x82, 0x70, 0xe0, 0x72, 0x41, 0x13, 0x6b, 0x54, 0xb3, 0x42, 0x20, 0xf0, 0xb9,
+	0x19, 0x12, 0x13, 0x4c, 0x2b, 0x7f, 0xd1, 0xe6, 0x0f, 0xda, 0x1c, 0xd5, 0x4a, 0x9b, 0xe0, 0xbb,
+	0x01, 0xb3, 0xe4, 0x1b, 0x7b, 0x4a, 0x5b, 0x03, 0x36, 0x60, 0x72, 0xb4, 0x16, 0x53, 0xbc, 0xdd,
+	0x7f, 0xc9, 0xaa, 0x85, 0xb6, 0x8c, 0xd6, 0xea, 0xea, 0xae, 0x43, 0x08, 0x82, 0x91, 0x0d, 0x9e,
+	0xc7, 0xc6, 0x9e, 0xcb, 0x85, 0x8d, 0x01, 0x74, 0x3d, 0xec, 0xe9, 0x4a, 0x45, 0xa9, 0xfe, 0xef,
+	0xec, 0x24, 0x40, 0x63, 0xa9, 0xb7, 0x62, 0x59, 0x3b, 0x54, 0x37, 0xa4, 0x07, 0x7b, 0x76, 0x82,
+	0x70, 0x3d, 0x5b, 0xc9, 0x55, 0xd7, 0x3a, 0xeb, 0xc9, 0xbe, 0x99, 0xac, 0xb5, 0x96, 0x5a, 0xf6,
+	0x21, 0xb2, 0x57, 0x67, 0x73, 0x3b, 0x44, 0xb2, 0x7d, 0x08, 0x86, 0x7d, 0x70, 0xc4, 0x90, 0x90,
+	0xf4, 0x5c, 0x45, 0xa9, 0xe6, 0x3b, 0x7b, 0x3e, 0x44, 0xcd, 0x15, 0xd5, 0x46, 0xba, 0x4e, 0x31,
+	0xda, 0xb9, 0xaa, 0x2f, 0x62, 0x46, 0x48, 0x6e, 0xdf, 0x75, 0x40, 0xb8, 0x2c, 0xb0, 0xef, 0x5c,
+	0x2e, 0x18, 0x4d, 0xf4, 0xbc, 0xf4, 0x17, 0x7d, 0x88, 0x6e, 0x52, 0xf2, 0x55, 0xac, 0x6a, 0x67,
+	0x6a, 0xf1, 0x9b, 0x0b, 0x84, 0x40, 0x2e, 0x2f, 0xfe, 0x27, 0x2f, 0xde, 0x4e, 0xab, 0x8d, 0xa5,
+	0x58, 0x37, 0x3f, 0x1e, 0xcb, 0xca, 0xfd, 0xfb, 0xd3, 0xd1, 0x41, 0xaa, 0xaa, 0xe8, 0x97, 0xb2,
+	0xe2, 0xef, 0xbc, 0xbc, 0x78, 0x9e, 0x19, 0xca, 0x74, 0x66, 0x28, 0x6f, 0x33, 0x43, 0x79, 0x98,
+	0x1b, 0x99, 0xe9, 0xdc, 0xc8, 0xbc, 0xce, 0x8d, 0xcc, 0xed, 0x32, 0xe0, 0xe4, 0xaf, 0x04, 0x31,
+	0x09, 0x91, 0x77, 0x0b, 0xb2, 0xa1, 0xd3, 0xcf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x00, 0x29, 0x00,
+	0x7e, 0x1b, 0x02, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -103,6 +165,31 @@ func (this *Params) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
+	if this.CreatorAllowlistEnabled != that1.CreatorAllowlistEnabled {
+		return false
+	}
+	if len(this.AllowedCreators) != len(that1.AllowedCreators) {
+		return false
+	}
+	for i := range this.AllowedCreators {
+		if this.AllowedCreators[i] != that1.AllowedCreators[i] {
+			return false
+		}
+	}
+	if this.MaxComponentsPerManufacturer != that1.MaxComponentsPerManufacturer {
+		return false
+	}
+	if this.MaxVerificationHistory != that1.MaxVerificationHistory {
+		return false
+	}
+	if len(this.VerificationAttestors) != len(that1.VerificationAttestors) {
+		return false
+	}
+	for i := range this.VerificationAttestors {
+		if this.VerificationAttestors[i] != that1.VerificationAttestors[i] {
+			return false
+		}
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -125,6 +212,44 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.VerificationAttestors) > 0 {
+		for iNdEx := len(m.VerificationAttestors) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.VerificationAttestors[iNdEx])
+			copy(dAtA[i:], m.VerificationAttestors[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.VerificationAttestors[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.MaxVerificationHistory != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxVerificationHistory))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaxComponentsPerManufacturer != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxComponentsPerManufacturer))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.AllowedCreators) > 0 {
+		for iNdEx := len(m.AllowedCreators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedCreators[iNdEx])
+			copy(dAtA[i:], m.AllowedCreators[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.AllowedCreators[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.CreatorAllowlistEnabled {
+		i--
+		if m.CreatorAllowlistEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -145,6 +270,27 @@ func (m *Params) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if m.CreatorAllowlistEnabled {
+		n += 2
+	}
+	if len(m.AllowedCreators) > 0 {
+		for _, s := range m.AllowedCreators {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.MaxComponentsPerManufacturer != 0 {
+		n += 1 + sovParams(uint64(m.MaxComponentsPerManufacturer))
+	}
+	if m.MaxVerificationHistory != 0 {
+		n += 1 + sovParams(uint64(m.MaxVerificationHistory))
+	}
+	if len(m.VerificationAttestors) > 0 {
+		for _, s := range m.VerificationAttestors {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -183,6 +329,128 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatorAllowlistEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CreatorAllowlistEnabled = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedCreators", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedCreators = append(m.AllowedCreators, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxComponentsPerManufacturer", wireType)
+			}
+			m.MaxComponentsPerManufacturer = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxComponentsPerManufacturer |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxVerificationHistory", wireType)
+			}
+			m.MaxVerificationHistory = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxVerificationHistory |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerificationAttestors", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VerificationAttestors = append(m.VerificationAttestors, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -6,24 +6,28 @@ package types
 import (
 	context "context"
 	fmt "fmt"
-	_ "github.com/cosmos/cosmos-sdk/types/query"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -498,786 +502,5586 @@ func (m *QueryListAuthorizedPartnersResponse) GetAuthorizedComponents() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "racecarweb.componentregistry.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "racecarweb.componentregistry.v1.QueryParamsResponse")
-	proto.RegisterType((*QueryGetComponentRequest)(nil), "racecarweb.componentregistry.v1.QueryGetComponentRequest")
-	proto.RegisterType((*QueryGetComponentResponse)(nil), "racecarweb.componentregistry.v1.QueryGetComponentResponse")
-	proto.RegisterType((*QueryGetComponentVerificationRequest)(nil), "racecarweb.componentregistry.v1.QueryGetComponentVerificationRequest")
-	proto.RegisterType((*QueryGetComponentVerificationResponse)(nil), "racecarweb.componentregistry.v1.QueryGetComponentVerificationResponse")
-	proto.RegisterType((*QueryCheckPairingAuthRequest)(nil), "racecarweb.componentregistry.v1.QueryCheckPairingAuthRequest")
-	proto.RegisterType((*QueryCheckPairingAuthResponse)(nil), "racecarweb.componentregistry.v1.QueryCheckPairingAuthResponse")
-	proto.RegisterType((*QueryListAuthorizedPartnersRequest)(nil), "racecarweb.componentregistry.v1.QueryListAuthorizedPartnersRequest")
-	proto.RegisterType((*QueryListAuthorizedPartnersResponse)(nil), "racecarweb.componentregistry.v1.QueryListAuthorizedPartnersResponse")
+// QueryGetComponentHistoryRequest defines the QueryGetComponentHistoryRequest message.
+type QueryGetComponentHistoryRequest struct {
+	ComponentId string `protobuf:"bytes,1,opt,name=component_id,json=componentId,proto3" json:"component_id,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("racecarweb/componentregistry/v1/query.proto", fileDescriptor_17282afec6a3e21a)
+func (m *QueryGetComponentHistoryRequest) Reset()         { *m = QueryGetComponentHistoryRequest{} }
+func (m *QueryGetComponentHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetComponentHistoryRequest) ProtoMessage()    {}
+func (*QueryGetComponentHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17282afec6a3e21a, []int{10}
 }
-
-var fileDescriptor_17282afec6a3e21a = []byte{
-	// 747 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xcf, 0x4f, 0x13, 0x4b,
-	0x1c, 0xef, 0xf2, 0xde, 0x6b, 0xe8, 0xd0, 0xc3, 0x7b, 0xf3, 0x90, 0xd4, 0x06, 0x5b, 0x5d, 0x25,
-	0x92, 0xaa, 0x5d, 0x0b, 0xc6, 0x44, 0x13, 0x50, 0x5a, 0x85, 0x20, 0x6a, 0x4a, 0x4d, 0x8c, 0xe1,
-	0xb2, 0xcc, 0x2e, 0xe3, 0x32, 0x81, 0xee, 0x2c, 0x33, 0x43, 0xb5, 0x1a, 0x2e, 0xde, 0xbc, 0x99,
-	0xf8, 0x37, 0x98, 0x78, 0xf4, 0xcf, 0xe0, 0x62, 0x42, 0xe2, 0xc5, 0x93, 0x31, 0x60, 0xc2, 0xc1,
-	0xbb, 0x47, 0x63, 0x76, 0x76, 0xba, 0x5d, 0x4a, 0x61, 0x5b, 0xb8, 0x90, 0xdd, 0x99, 0xcf, 0xf7,
-	0xf3, 0x63, 0xbf, 0x7c, 0xbf, 0x29, 0xb8, 0xc2, 0x90, 0x8d, 0x6d, 0xc4, 0x5e, 0x60, 0xcb, 0xb0,
-	0x69, 0xdd, 0xa3, 0x2e, 0x76, 0x05, 0xc3, 0x0e, 0xe1, 0x82, 0x35, 0x8d, 0x46, 0xc9, 0xd8, 0xd8,
-	0xc4, 0xac, 0x59, 0xf4, 0x18, 0x15, 0x14, 0xe6, 0xdb, 0xe0, 0xe2, 0x21, 0x70, 0xb1, 0x51, 0xca,
-	0xfe, 0x87, 0xea, 0xc4, 0xa5, 0x86, 0xfc, 0x1b, 0xd4, 0x64, 0x0b, 0x36, 0xe5, 0x75, 0xca, 0x0d,
-	0x0b, 0x71, 0x1c, 0x90, 0x19, 0x8d, 0x92, 0x85, 0x05, 0x2a, 0x19, 0x1e, 0x72, 0x88, 0x8b, 0x04,
-	0xa1, 0xae, 0xc2, 0x0e, 0x3b, 0xd4, 0xa1, 0xf2, 0xd1, 0xf0, 0x9f, 0xd4, 0xe9, 0xa8, 0x43, 0xa9,
-	0xb3, 0x8e, 0x0d, 0xe4, 0x11, 0x03, 0xb9, 0x2e, 0x15, 0xb2, 0x84, 0xab, 0xdb, 0xab, 0x71, 0x01,
-	0x3c, 0xc4, 0x50, 0xbd, 0x85, 0x36, 0xe2, 0xd0, 0xe1, 0x61, 0x50, 0xa0, 0x0f, 0x03, 0xb8, 0xe8,
-	0x9b, 0xae, 0x4a, 0x96, 0x1a, 0xde, 0xd8, 0xc4, 0x5c, 0xe8, 0x08, 0xfc, 0x7f, 0xe0, 0x94, 0x7b,
-	0xd4, 0xe5, 0x18, 0x3e, 0x00, 0xc9, 0x40, 0x2d, 0xa3, 0x9d, 0xd7, 0xc6, 0x87, 0x26, 0x2e, 0x17,
-	0x63, 0x3e, 0x58, 0x31, 0x20, 0x28, 0xa7, 0xb6, 0xbf, 0xe5, 0x13, 0x1f, 0xf7, 0x3f, 0x15, 0xb4,
-	0x9a, 0x62, 0xd0, 0xa7, 0x40, 0x46, 0x4a, 0xcc, 0x61, 0x51, 0x69, 0x55, 0x2a, 0x79, 0x78, 0x01,
-	0xa4, 0x43, 0x36, 0x93, 0xac, 0x48, 0xb5, 0x54, 0x6d, 0x28, 0x3c, 0x9b, 0x5f, 0xd1, 0xd7, 0xc0,
-	0xd9, 0x2e, 0xe5, 0xca, 0xe7, 0x63, 0x90, 0x0a, 0xb1, 0xca, 0x6a, 0x21, 0xd6, 0x6a, 0x48, 0x53,
-	0xfe, 0xdb, 0x77, 0x5b, 0x6b, 0x53, 0xe8, 0xf3, 0xe0, 0xd2, 0x21, 0xb1, 0xa7, 0x98, 0x91, 0xe7,
-	0xc4, 0x96, 0xbd, 0xea, 0xc3, 0xf7, 0x5b, 0x0d, 0x8c, 0xc5, 0x70, 0xa9, 0x10, 0xcb, 0x20, 0xdd,
-	0x88, 0x9c, 0xab, 0x1c, 0x37, 0x7b, 0xcf, 0x11, 0x65, 0x55, 0x99, 0x0e, 0x30, 0xea, 0xcb, 0x60,
-	0x54, 0x5a, 0xa9, 0xac, 0x62, 0x7b, 0xad, 0x8a, 0x08, 0x23, 0xae, 0x33, 0xb3, 0x29, 0x56, 0x5b,
-	0x71, 0xf2, 0xa0, 0x6d, 0xdd, 0x44, 0x2a, 0x0d, 0x08, 0x8f, 0x66, 0x0e, 0x02, 0xac, 0xcc, 0x40,
-	0x07, 0xa0, 0xac, 0x37, 0xc1, 0xb9, 0x23, 0x14, 0x54, 0xc8, 0x3c, 0x48, 0x23, 0xd3, 0x46, 0xae,
-	0xe9, 0x21, 0xc2, 0x4c, 0x4b, 0x6a, 0x0c, 0xd6, 0x52, 0xa8, 0x82, 0x5c, 0x1f, 0x5e, 0xf6, 0x01,
-	0x56, 0x1b, 0x80, 0xa4, 0xc6, 0x60, 0x2d, 0x65, 0x29, 0xc0, 0x0c, 0x1c, 0x01, 0x49, 0x86, 0x11,
-	0xa7, 0x6e, 0xe6, 0x2f, 0x29, 0xaf, 0xde, 0xf4, 0x39, 0xa0, 0x4b, 0xe9, 0x87, 0x84, 0x0b, 0x5f,
-	0x92, 0x32, 0xf2, 0x0a, 0xaf, 0x54, 0x11, 0x13, 0x2e, 0x66, 0xbc, 0x8f, 0x8e, 0x2d, 0x81, 0x8b,
-	0xc7, 0x12, 0xa9, 0x24, 0x93, 0xe0, 0x0c, 0x0a, 0x6f, 0xcd, 0x90, 0x80, 0x2b, 0xca, 0xe1, 0xf6,
-	0x65, 0xd8, 0x20, 0x3e, 0xb1, 0x3f, 0x08, 0xfe, 0x91, 0xe4, 0xf0, 0x83, 0x06, 0x92, 0xc1, 0xb0,
-	0xc0, 0xc9, 0xd8, 0x16, 0x1f, 0x9e, 0xd8, 0xec, 0x8d, 0xfe, 0x8a, 0x02, 0xd3, 0xfa, 0xf5, 0x37,
-	0x5f, 0x7e, 0xbc, 0x1f, 0x28, 0xc0, 0xf1, 0xd6, 0xde, 0xb8, 0x16, 0xb3, 0x66, 0xe0, 0x67, 0x0d,
-	0xa4, 0xa3, 0xff, 0xba, 0xf0, 0x56, 0x6f, 0xc2, 0x5d, 0xc6, 0x3c, 0x7b, 0xfb, 0x24, 0xa5, 0xca,
-	0xf9, 0xac, 0x74, 0x7e, 0x17, 0x4e, 0xc7, 0x3b, 0x77, 0xb0, 0x68, 0xf7, 0xc3, 0x78, 0x1d, 0xed,
-	0xf7, 0x16, 0xfc, 0xad, 0x81, 0xcc, 0x51, 0xa3, 0x08, 0xef, 0xf7, 0x6f, 0xb0, 0xcb, 0x5a, 0xc8,
-	0xce, 0x9e, 0x96, 0x46, 0x65, 0x7e, 0x22, 0x33, 0x3f, 0x82, 0x0b, 0x7d, 0x66, 0x36, 0xa3, 0x53,
-	0xdf, 0xf9, 0x01, 0x7e, 0x6a, 0xe0, 0xdf, 0xce, 0xf1, 0x84, 0x53, 0xbd, 0x39, 0x3e, 0x62, 0x71,
-	0x64, 0xa7, 0x4f, 0x5a, 0xae, 0x82, 0x3e, 0x93, 0x41, 0x6b, 0xb0, 0x1a, 0x1f, 0xd4, 0xf6, 0x39,
-	0xe4, 0x72, 0x20, 0xae, 0x63, 0xfa, 0x43, 0x16, 0x0d, 0x88, 0xb6, 0xa2, 0x6f, 0xd6, 0x16, 0xfc,
-	0xa5, 0x81, 0x91, 0xee, 0x83, 0x0c, 0x2b, 0xbd, 0x99, 0x3e, 0x76, 0x9f, 0x64, 0xef, 0x9d, 0x8e,
-	0x44, 0xe5, 0x5f, 0x94, 0xf9, 0x17, 0xe0, 0x7c, 0x7c, 0xfe, 0x75, 0xc2, 0x85, 0x19, 0x59, 0x3c,
-	0x9e, 0xe2, 0xea, 0x68, 0x73, 0xf9, 0xce, 0xf6, 0x6e, 0x4e, 0xdb, 0xd9, 0xcd, 0x69, 0xdf, 0x77,
-	0x73, 0xda, 0xbb, 0xbd, 0x5c, 0x62, 0x67, 0x2f, 0x97, 0xf8, 0xba, 0x97, 0x4b, 0x2c, 0x8d, 0x45,
-	0x35, 0x5e, 0x76, 0x51, 0x11, 0x4d, 0x0f, 0x73, 0x2b, 0x29, 0x7f, 0x2f, 0x4c, 0xfe, 0x09, 0x00,
-	0x00, 0xff, 0xff, 0x39, 0x11, 0x39, 0x68, 0x51, 0x09, 0x00, 0x00,
+func (m *QueryGetComponentHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetComponentHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetComponentHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetComponentHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetComponentHistoryRequest.Merge(m, src)
+}
+func (m *QueryGetComponentHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetComponentHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetComponentHistoryRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryGetComponentHistoryRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Parameters queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// GetComponent Queries a list of GetComponent items.
-	GetComponent(ctx context.Context, in *QueryGetComponentRequest, opts ...grpc.CallOption) (*QueryGetComponentResponse, error)
-	// GetComponentVerification Queries the verification status of a component.
-	GetComponentVerification(ctx context.Context, in *QueryGetComponentVerificationRequest, opts ...grpc.CallOption) (*QueryGetComponentVerificationResponse, error)
-	// CheckPairingAuth Queries a list of CheckPairingAuth items.
-	CheckPairingAuth(ctx context.Context, in *QueryCheckPairingAuthRequest, opts ...grpc.CallOption) (*QueryCheckPairingAuthResponse, error)
-	// ListAuthorizedPartners Queries a list of ListAuthorizedPartners items.
-	ListAuthorizedPartners(ctx context.Context, in *QueryListAuthorizedPartnersRequest, opts ...grpc.CallOption) (*QueryListAuthorizedPartnersResponse, error)
+func (m *QueryGetComponentHistoryRequest) GetComponentId() string {
+	if m != nil {
+		return m.ComponentId
+	}
+	return ""
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+// QueryGetComponentHistoryResponse defines the QueryGetComponentHistoryResponse message.
+type QueryGetComponentHistoryResponse struct {
+	Entries []ComponentHistoryEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryGetComponentHistoryResponse) Reset()         { *m = QueryGetComponentHistoryResponse{} }
+func (m *QueryGetComponentHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetComponentHistoryResponse) ProtoMessage()    {}
+func (*QueryGetComponentHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17282afec6a3e21a, []int{11}
 }
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/racecarweb.componentregistry.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryGetComponentHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetComponentHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetComponentHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryGetComponentHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetComponentHistoryResponse.Merge(m, src)
+}
+func (m *QueryGetComponentHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetComponentHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetComponentHistoryResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) GetComponent(ctx context.Context, in *QueryGetComponentRequest, opts ...grpc.CallOption) (*QueryGetComponentResponse, error) {
-	out := new(QueryGetComponentResponse)
-	err := c.cc.Invoke(ctx, "/racecarweb.componentregistry.v1.Query/GetComponent", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetComponentHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryGetComponentHistoryResponse) GetEntries() []ComponentHistoryEntry {
+	if m != nil {
+		return m.Entries
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) GetComponentVerification(ctx context.Context, in *QueryGetComponentVerificationRequest, opts ...grpc.CallOption) (*QueryGetComponentVerificationResponse, error) {
-	out := new(QueryGetComponentVerificationResponse)
-	err := c.cc.Invoke(ctx, "/racecarweb.componentregistry.v1.Query/GetComponentVerification", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryListComponentsRequest defines the QueryListComponentsRequest message.
+type QueryListComponentsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) CheckPairingAuth(ctx context.Context, in *QueryCheckPairingAuthRequest, opts ...grpc.CallOption) (*QueryCheckPairingAuthResponse, error) {
-	out := new(QueryCheckPairingAuthResponse)
-	err := c.cc.Invoke(ctx, "/racecarweb.componentregistry.v1.Query/CheckPairingAuth", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryListComponentsRequest) Reset()         { *m = QueryListComponentsRequest{} }
+func (m *QueryListComponentsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryListComponentsRequest) ProtoMessage()    {}
+func (*QueryListComponentsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17282afec6a3e21a, []int{12}
+}
+func (m *QueryListComponentsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryListComponentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryListComponentsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryListComponentsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListComponentsRequest.Merge(m, src)
+}
+func (m *QueryListComponentsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryListComponentsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListComponentsRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) ListAuthorizedPartners(ctx context.Context, in *QueryListAuthorizedPartnersRequest, opts ...grpc.CallOption) (*QueryListAuthorizedPartnersResponse, error) {
-	out := new(QueryListAuthorizedPartnersResponse)
-	err := c.cc.Invoke(ctx, "/racecarweb.componentregistry.v1.Query/ListAuthorizedPartners", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryListComponentsRequest proto.InternalMessageInfo
+
+func (m *QueryListComponentsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Parameters queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// GetComponent Queries a list of GetComponent items.
-	GetComponent(context.Context, *QueryGetComponentRequest) (*QueryGetComponentResponse, error)
-	// GetComponentVerification Queries the verification status of a component.
-	GetComponentVerification(context.Context, *QueryGetComponentVerificationRequest) (*QueryGetComponentVerificationResponse, error)
-	// CheckPairingAuth Queries a list of CheckPairingAuth items.
-	CheckPairingAuth(context.Context, *QueryCheckPairingAuthRequest) (*QueryCheckPairingAuthResponse, error)
-	// ListAuthorizedPartners Queries a list of ListAuthorizedPartners items.
-	ListAuthorizedPartners(context.Context, *QueryListAuthorizedPartnersRequest) (*QueryListAuthorizedPartnersResponse, error)
+// QueryListComponentsResponse defines the QueryListComponentsResponse message.
+type QueryListComponentsResponse struct {
+	Components []Component         `protobuf:"bytes,1,rep,name=components,proto3" json:"components"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryListComponentsResponse) Reset()         { *m = QueryListComponentsResponse{} }
+func (m *QueryListComponentsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryListComponentsResponse) ProtoMessage()    {}
+func (*QueryListComponentsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17282afec6a3e21a, []int{13}
 }
-
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryListComponentsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) GetComponent(ctx context.Context, req *QueryGetComponentRequest) (*QueryGetComponentResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetComponent not implemented")
+func (m *QueryListComponentsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryListComponentsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) GetComponentVerification(ctx context.Context, req *QueryGetComponentVerificationRequest) (*QueryGetComponentVerificationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetComponentVerification not implemented")
+func (m *QueryListComponentsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListComponentsResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) CheckPairingAuth(ctx context.Context, req *QueryCheckPairingAuthRequest) (*QueryCheckPairingAuthResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CheckPairingAuth not implemented")
+func (m *QueryListComponentsResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) ListAuthorizedPartners(ctx context.Context, req *QueryListAuthorizedPartnersRequest) (*QueryListAuthorizedPartnersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListAuthorizedPartners not implemented")
+func (m *QueryListComponentsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListComponentsResponse.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
-}
+var xxx_messageInfo_QueryListComponentsResponse proto.InternalMessageInfo
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/racecarweb.componentregistry.v1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+func (m *QueryListComponentsResponse) GetComponents() []Component {
+	if m != nil {
+		return m.Components
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_GetComponent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetComponentRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetComponent(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/racecarweb.componentregistry.v1.Query/GetComponent",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetComponent(ctx, req.(*QueryGetComponentRequest))
+func (m *QueryListComponentsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_GetComponentVerification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetComponentVerificationRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetComponentVerification(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/racecarweb.componentregistry.v1.Query/GetComponentVerification",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetComponentVerification(ctx, req.(*QueryGetComponentVerificationRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryGetRevocationEventsRequest defines the QueryGetRevocationEventsRequest message.
+type QueryGetRevocationEventsRequest struct {
+	TargetHash string             `protobuf:"bytes,1,opt,name=target_hash,json=targetHash,proto3" json:"target_hash,omitempty"`
+	From       int64              `protobuf:"varint,2,opt,name=from,proto3" json:"from,omitempty"`
+	To         int64              `protobuf:"varint,3,opt,name=to,proto3" json:"to,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func _Query_CheckPairingAuth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryCheckPairingAuthRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *QueryGetRevocationEventsRequest) Reset()         { *m = QueryGetRevocationEventsRequest{} }
+func (m *QueryGetRevocationEventsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetRevocationEventsRequest) ProtoMessage()    {}
+func (*QueryGetRevocationEventsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17282afec6a3e21a, []int{14}
+}
+func (m *QueryGetRevocationEventsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetRevocationEventsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetRevocationEventsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).CheckPairingAuth(ctx, in)
+}
+func (m *QueryGetRevocationEventsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetRevocationEventsRequest.Merge(m, src)
+}
+func (m *QueryGetRevocationEventsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetRevocationEventsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetRevocationEventsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetRevocationEventsRequest proto.InternalMessageInfo
+
+func (m *QueryGetRevocationEventsRequest) GetTargetHash() string {
+	if m != nil {
+		return m.TargetHash
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/racecarweb.componentregistry.v1.Query/CheckPairingAuth",
+	return ""
+}
+
+func (m *QueryGetRevocationEventsRequest) GetFrom() int64 {
+	if m != nil {
+		return m.From
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).CheckPairingAuth(ctx, req.(*QueryCheckPairingAuthRequest))
+	return 0
+}
+
+func (m *QueryGetRevocationEventsRequest) GetTo() int64 {
+	if m != nil {
+		return m.To
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_ListAuthorizedPartners_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryListAuthorizedPartnersRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ListAuthorizedPartners(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/racecarweb.componentregistry.v1.Query/ListAuthorizedPartners",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ListAuthorizedPartners(ctx, req.(*QueryListAuthorizedPartnersRequest))
+func (m *QueryGetRevocationEventsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var Query_serviceDesc = _Query_serviceDesc
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "racecarweb.componentregistry.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "GetComponent",
-			Handler:    _Query_GetComponent_Handler,
-		},
-		{
-			MethodName: "GetComponentVerification",
-			Handler:    _Query_GetComponentVerification_Handler,
-		},
-		{
-			MethodName: "CheckPairingAuth",
-			Handler:    _Query_CheckPairingAuth_Handler,
-		},
-		{
-			MethodName: "ListAuthorizedPartners",
-			Handler:    _Query_ListAuthorizedPartners_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "racecarweb/componentregistry/v1/query.proto",
+// QueryGetRevocationEventsResponse defines the QueryGetRevocationEventsResponse message.
+type QueryGetRevocationEventsResponse struct {
+	Events     []AnonymousRevocationEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events"`
+	Pagination *query.PageResponse        `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryGetRevocationEventsResponse) Reset()         { *m = QueryGetRevocationEventsResponse{} }
+func (m *QueryGetRevocationEventsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetRevocationEventsResponse) ProtoMessage()    {}
+func (*QueryGetRevocationEventsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17282afec6a3e21a, []int{15}
+}
+func (m *QueryGetRevocationEventsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetRevocationEventsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetRevocationEventsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryGetRevocationEventsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetRevocationEventsResponse.Merge(m, src)
+}
+func (m *QueryGetRevocationEventsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetRevocationEventsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetRevocationEventsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_QueryGetRevocationEventsResponse proto.InternalMessageInfo
+
+func (m *QueryGetRevocationEventsResponse) GetEvents() []AnonymousRevocationEvent {
+	if m != nil {
+		return m.Events
+	}
+	return nil
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryGetRevocationEventsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryComponentHealthRequest defines the QueryComponentHealthRequest message.
+type QueryComponentHealthRequest struct {
+	// components not verified within this many seconds are stale; 0 uses the default of one day
+	StaleAfterSeconds int64 `protobuf:"varint,1,opt,name=stale_after_seconds,json=staleAfterSeconds,proto3" json:"stale_after_seconds,omitempty"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryComponentHealthRequest) Reset()         { *m = QueryComponentHealthRequest{} }
+func (m *QueryComponentHealthRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryComponentHealthRequest) ProtoMessage()    {}
+func (*QueryComponentHealthRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17282afec6a3e21a, []int{16}
+}
+func (m *QueryComponentHealthRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryComponentHealthRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryComponentHealthRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryComponentHealthRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryComponentHealthRequest.Merge(m, src)
+}
+func (m *QueryComponentHealthRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryComponentHealthRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryComponentHealthRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetComponentRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryComponentHealthRequest proto.InternalMessageInfo
+
+func (m *QueryComponentHealthRequest) GetStaleAfterSeconds() int64 {
+	if m != nil {
+		return m.StaleAfterSeconds
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryGetComponentRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryComponentHealthResponse defines the QueryComponentHealthResponse message.
+type QueryComponentHealthResponse struct {
+	Summary  ComponentHealthSummary `protobuf:"bytes,1,opt,name=summary,proto3" json:"summary"`
+	Problems []ComponentHealthIssue `protobuf:"bytes,2,rep,name=problems,proto3" json:"problems"`
 }
 
-func (m *QueryGetComponentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ComponentId) > 0 {
-		i -= len(m.ComponentId)
-		copy(dAtA[i:], m.ComponentId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ComponentId)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryComponentHealthResponse) Reset()         { *m = QueryComponentHealthResponse{} }
+func (m *QueryComponentHealthResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryComponentHealthResponse) ProtoMessage()    {}
+func (*QueryComponentHealthResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17282afec6a3e21a, []int{17}
+}
+func (m *QueryComponentHealthResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryComponentHealthResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryComponentHealthResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryComponentHealthResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryComponentHealthResponse.Merge(m, src)
+}
+func (m *QueryComponentHealthResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryComponentHealthResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryComponentHealthResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetComponentResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryComponentHealthResponse proto.InternalMessageInfo
+
+func (m *QueryComponentHealthResponse) GetSummary() ComponentHealthSummary {
+	if m != nil {
+		return m.Summary
 	}
-	return dAtA[:n], nil
+	return ComponentHealthSummary{}
 }
 
-func (m *QueryGetComponentResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryComponentHealthResponse) GetProblems() []ComponentHealthIssue {
+	if m != nil {
+		return m.Problems
+	}
+	return nil
 }
 
-func (m *QueryGetComponentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Component.MarshalToSizedBuffer(dAtA[:i])
+// ComponentHealthSummary counts components by health. A component can count
+// towards more than one bucket, e.g. both stale and unverified.
+type ComponentHealthSummary struct {
+	Total              uint64 `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	Active             uint64 `protobuf:"varint,2,opt,name=active,proto3" json:"active,omitempty"`
+	Stale              uint64 `protobuf:"varint,3,opt,name=stale,proto3" json:"stale,omitempty"`
+	Revoked            uint64 `protobuf:"varint,4,opt,name=revoked,proto3" json:"revoked,omitempty"`
+	Unverified         uint64 `protobuf:"varint,5,opt,name=unverified,proto3" json:"unverified,omitempty"`
+	FailedVerification uint64 `protobuf:"varint,6,opt,name=failed_verification,json=failedVerification,proto3" json:"failed_verification,omitempty"`
+}
+
+func (m *ComponentHealthSummary) Reset()         { *m = ComponentHealthSummary{} }
+func (m *ComponentHealthSummary) String() string { return proto.CompactTextString(m) }
+func (*ComponentHealthSummary) ProtoMessage()    {}
+func (*ComponentHealthSummary) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17282afec6a3e21a, []int{18}
+}
+func (m *ComponentHealthSummary) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ComponentHealthSummary) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ComponentHealthSummary.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *ComponentHealthSummary) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ComponentHealthSummary.Merge(m, src)
+}
+func (m *ComponentHealthSummary) XXX_Size() int {
+	return m.Size()
+}
+func (m *ComponentHealthSummary) XXX_DiscardUnknown() {
+	xxx_messageInfo_ComponentHealthSummary.DiscardUnknown(m)
 }
 
-func (m *QueryGetComponentVerificationRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ComponentHealthSummary proto.InternalMessageInfo
+
+func (m *ComponentHealthSummary) GetTotal() uint64 {
+	if m != nil {
+		return m.Total
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryGetComponentVerificationRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ComponentHealthSummary) GetActive() uint64 {
+	if m != nil {
+		return m.Active
+	}
+	return 0
 }
 
-func (m *QueryGetComponentVerificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ComponentId) > 0 {
-		i -= len(m.ComponentId)
-		copy(dAtA[i:], m.ComponentId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ComponentId)))
-		i--
-		dAtA[i] = 0xa
+func (m *ComponentHealthSummary) GetStale() uint64 {
+	if m != nil {
+		return m.Stale
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryGetComponentVerificationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ComponentHealthSummary) GetRevoked() uint64 {
+	if m != nil {
+		return m.Revoked
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryGetComponentVerificationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ComponentHealthSummary) GetUnverified() uint64 {
+	if m != nil {
+		return m.Unverified
+	}
+	return 0
 }
 
-func (m *QueryGetComponentVerificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Verification.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *ComponentHealthSummary) GetFailedVerification() uint64 {
+	if m != nil {
+		return m.FailedVerification
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryCheckPairingAuthRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+// ComponentHealthIssue describes a problematic component
+type ComponentHealthIssue struct {
+	ComponentId    string    `protobuf:"bytes,1,opt,name=component_id,json=componentId,proto3" json:"component_id,omitempty"`
+	Status         string    `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Issues         []string  `protobuf:"bytes,3,rep,name=issues,proto3" json:"issues,omitempty"`
+	LastVerifiedAt time.Time `protobuf:"bytes,4,opt,name=last_verified_at,json=lastVerifiedAt,proto3,stdtime" json:"last_verified_at"`
+}
+
+func (m *ComponentHealthIssue) Reset()         { *m = ComponentHealthIssue{} }
+func (m *ComponentHealthIssue) String() string { return proto.CompactTextString(m) }
+func (*ComponentHealthIssue) ProtoMessage()    {}
+func (*ComponentHealthIssue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17282afec6a3e21a, []int{19}
+}
+func (m *ComponentHealthIssue) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ComponentHealthIssue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ComponentHealthIssue.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *ComponentHealthIssue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ComponentHealthIssue.Merge(m, src)
+}
+func (m *ComponentHealthIssue) XXX_Size() int {
+	return m.Size()
+}
+func (m *ComponentHealthIssue) XXX_DiscardUnknown() {
+	xxx_messageInfo_ComponentHealthIssue.DiscardUnknown(m)
 }
 
-func (m *QueryCheckPairingAuthRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_ComponentHealthIssue proto.InternalMessageInfo
+
+func (m *ComponentHealthIssue) GetComponentId() string {
+	if m != nil {
+		return m.ComponentId
+	}
+	return ""
 }
 
-func (m *QueryCheckPairingAuthRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ComponentB) > 0 {
-		i -= len(m.ComponentB)
-		copy(dAtA[i:], m.ComponentB)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ComponentB)))
-		i--
-		dAtA[i] = 0x12
+func (m *ComponentHealthIssue) GetStatus() string {
+	if m != nil {
+		return m.Status
 	}
-	if len(m.ComponentA) > 0 {
-		i -= len(m.ComponentA)
-		copy(dAtA[i:], m.ComponentA)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ComponentA)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *ComponentHealthIssue) GetIssues() []string {
+	if m != nil {
+		return m.Issues
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryCheckPairingAuthResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ComponentHealthIssue) GetLastVerifiedAt() time.Time {
+	if m != nil {
+		return m.LastVerifiedAt
 	}
-	return dAtA[:n], nil
+	return time.Time{}
 }
 
-func (m *QueryCheckPairingAuthResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryGetCustodyChainRequest defines the QueryGetCustodyChainRequest message.
+type QueryGetCustodyChainRequest struct {
+	ComponentId string `protobuf:"bytes,1,opt,name=component_id,json=componentId,proto3" json:"component_id,omitempty"`
 }
 
-func (m *QueryCheckPairingAuthResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Reason) > 0 {
-		i -= len(m.Reason)
-		copy(dAtA[i:], m.Reason)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Reason)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.BCanPairA {
-		i--
-		if m.BCanPairA {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.ACanPairB {
-		i--
-		if m.ACanPairB {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *QueryGetCustodyChainRequest) Reset()         { *m = QueryGetCustodyChainRequest{} }
+func (m *QueryGetCustodyChainRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetCustodyChainRequest) ProtoMessage()    {}
+func (*QueryGetCustodyChainRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17282afec6a3e21a, []int{20}
+}
+func (m *QueryGetCustodyChainRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetCustodyChainRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetCustodyChainRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryGetCustodyChainRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetCustodyChainRequest.Merge(m, src)
+}
+func (m *QueryGetCustodyChainRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetCustodyChainRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetCustodyChainRequest.DiscardUnknown(m)
 }
 
-func (m *QueryListAuthorizedPartnersRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetCustodyChainRequest proto.InternalMessageInfo
+
+func (m *QueryGetCustodyChainRequest) GetComponentId() string {
+	if m != nil {
+		return m.ComponentId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryListAuthorizedPartnersRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryGetCustodyChainResponse defines the QueryGetCustodyChainResponse message.
+type QueryGetCustodyChainResponse struct {
+	Events []CustodyEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events"`
 }
 
-func (m *QueryListAuthorizedPartnersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ComponentId) > 0 {
-		i -= len(m.ComponentId)
-		copy(dAtA[i:], m.ComponentId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ComponentId)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryGetCustodyChainResponse) Reset()         { *m = QueryGetCustodyChainResponse{} }
+func (m *QueryGetCustodyChainResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetCustodyChainResponse) ProtoMessage()    {}
+func (*QueryGetCustodyChainResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17282afec6a3e21a, []int{21}
+}
+func (m *QueryGetCustodyChainResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetCustodyChainResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetCustodyChainResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryGetCustodyChainResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetCustodyChainResponse.Merge(m, src)
+}
+func (m *QueryGetCustodyChainResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetCustodyChainResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetCustodyChainResponse.DiscardUnknown(m)
 }
 
-func (m *QueryListAuthorizedPartnersResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetCustodyChainResponse proto.InternalMessageInfo
+
+func (m *QueryGetCustodyChainResponse) GetEvents() []CustodyEvent {
+	if m != nil {
+		return m.Events
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryListAuthorizedPartnersResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QuerySearchComponentsRequest defines the QuerySearchComponentsRequest message.
+// At least one of manufacturer_hash and category_hash is required.
+type QuerySearchComponentsRequest struct {
+	ManufacturerHash string             `protobuf:"bytes,1,opt,name=manufacturer_hash,json=manufacturerHash,proto3" json:"manufacturer_hash,omitempty"`
+	CategoryHash     string             `protobuf:"bytes,2,opt,name=category_hash,json=categoryHash,proto3" json:"category_hash,omitempty"`
+	Status           string             `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	Pagination       *query.PageRequest `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryListAuthorizedPartnersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.AuthorizedComponents) > 0 {
-		i -= len(m.AuthorizedComponents)
-		copy(dAtA[i:], m.AuthorizedComponents)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.AuthorizedComponents)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *QuerySearchComponentsRequest) Reset()         { *m = QuerySearchComponentsRequest{} }
+func (m *QuerySearchComponentsRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySearchComponentsRequest) ProtoMessage()    {}
+func (*QuerySearchComponentsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17282afec6a3e21a, []int{22}
 }
-
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (m *QuerySearchComponentsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QuerySearchComponentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySearchComponentsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	return n
+}
+func (m *QuerySearchComponentsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySearchComponentsRequest.Merge(m, src)
+}
+func (m *QuerySearchComponentsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySearchComponentsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySearchComponentsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QuerySearchComponentsRequest proto.InternalMessageInfo
+
+func (m *QuerySearchComponentsRequest) GetManufacturerHash() string {
+	if m != nil {
+		return m.ManufacturerHash
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return ""
 }
 
-func (m *QueryGetComponentRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ComponentId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QuerySearchComponentsRequest) GetCategoryHash() string {
+	if m != nil {
+		return m.CategoryHash
 	}
-	return n
+	return ""
 }
 
-func (m *QueryGetComponentResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QuerySearchComponentsRequest) GetStatus() string {
+	if m != nil {
+		return m.Status
 	}
-	var l int
-	_ = l
-	l = m.Component.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return ""
 }
 
-func (m *QueryGetComponentVerificationRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ComponentId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QuerySearchComponentsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return n
+	return nil
 }
 
-func (m *QueryGetComponentVerificationResponse) Size() (n int) {
-	if m == nil {
-		return 0
+// QuerySearchComponentsResponse defines the QuerySearchComponentsResponse message.
+type QuerySearchComponentsResponse struct {
+	Components []Component         `protobuf:"bytes,1,rep,name=components,proto3" json:"components"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuerySearchComponentsResponse) Reset()         { *m = QuerySearchComponentsResponse{} }
+func (m *QuerySearchComponentsResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySearchComponentsResponse) ProtoMessage()    {}
+func (*QuerySearchComponentsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17282afec6a3e21a, []int{23}
+}
+func (m *QuerySearchComponentsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySearchComponentsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySearchComponentsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = m.Verification.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+}
+func (m *QuerySearchComponentsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySearchComponentsResponse.Merge(m, src)
+}
+func (m *QuerySearchComponentsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySearchComponentsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySearchComponentsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryCheckPairingAuthRequest) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QuerySearchComponentsResponse proto.InternalMessageInfo
+
+func (m *QuerySearchComponentsResponse) GetComponents() []Component {
+	if m != nil {
+		return m.Components
 	}
-	var l int
-	_ = l
-	l = len(m.ComponentA)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+
+func (m *QuerySearchComponentsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	l = len(m.ComponentB)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+
+// QueryGetVerificationHistoryRequest defines the QueryGetVerificationHistoryRequest message.
+type QueryGetVerificationHistoryRequest struct {
+	ComponentId string             `protobuf:"bytes,1,opt,name=component_id,json=componentId,proto3" json:"component_id,omitempty"`
+	Pagination  *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryGetVerificationHistoryRequest) Reset()         { *m = QueryGetVerificationHistoryRequest{} }
+func (m *QueryGetVerificationHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetVerificationHistoryRequest) ProtoMessage()    {}
+func (*QueryGetVerificationHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17282afec6a3e21a, []int{24}
+}
+func (m *QueryGetVerificationHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetVerificationHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetVerificationHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryGetVerificationHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetVerificationHistoryRequest.Merge(m, src)
+}
+func (m *QueryGetVerificationHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetVerificationHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetVerificationHistoryRequest.DiscardUnknown(m)
 }
 
-func (m *QueryCheckPairingAuthResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryGetVerificationHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryGetVerificationHistoryRequest) GetComponentId() string {
+	if m != nil {
+		return m.ComponentId
 	}
-	var l int
-	_ = l
-	if m.ACanPairB {
-		n += 2
+	return ""
+}
+
+func (m *QueryGetVerificationHistoryRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	if m.BCanPairA {
-		n += 2
+	return nil
+}
+
+// QueryGetVerificationHistoryResponse defines the QueryGetVerificationHistoryResponse message.
+type QueryGetVerificationHistoryResponse struct {
+	Verifications []VerificationRecord `protobuf:"bytes,1,rep,name=verifications,proto3" json:"verifications"`
+	Pagination    *query.PageResponse  `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryGetVerificationHistoryResponse) Reset()         { *m = QueryGetVerificationHistoryResponse{} }
+func (m *QueryGetVerificationHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetVerificationHistoryResponse) ProtoMessage()    {}
+func (*QueryGetVerificationHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17282afec6a3e21a, []int{25}
+}
+func (m *QueryGetVerificationHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetVerificationHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetVerificationHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.Reason)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QueryGetVerificationHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetVerificationHistoryResponse.Merge(m, src)
+}
+func (m *QueryGetVerificationHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetVerificationHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetVerificationHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetVerificationHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryGetVerificationHistoryResponse) GetVerifications() []VerificationRecord {
+	if m != nil {
+		return m.Verifications
 	}
-	return n
+	return nil
 }
 
-func (m *QueryListAuthorizedPartnersRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetVerificationHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	var l int
-	_ = l
-	l = len(m.ComponentId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+
+// QueryGetPairingRulesRequest defines the QueryGetPairingRulesRequest message.
+type QueryGetPairingRulesRequest struct {
+	SourceType string             `protobuf:"bytes,1,opt,name=source_type,json=sourceType,proto3" json:"source_type,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryGetPairingRulesRequest) Reset()         { *m = QueryGetPairingRulesRequest{} }
+func (m *QueryGetPairingRulesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetPairingRulesRequest) ProtoMessage()    {}
+func (*QueryGetPairingRulesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17282afec6a3e21a, []int{26}
+}
+func (m *QueryGetPairingRulesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetPairingRulesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetPairingRulesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetPairingRulesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetPairingRulesRequest.Merge(m, src)
+}
+func (m *QueryGetPairingRulesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetPairingRulesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetPairingRulesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetPairingRulesRequest proto.InternalMessageInfo
+
+func (m *QueryGetPairingRulesRequest) GetSourceType() string {
+	if m != nil {
+		return m.SourceType
+	}
+	return ""
+}
+
+func (m *QueryGetPairingRulesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryGetPairingRulesResponse defines the QueryGetPairingRulesResponse message.
+type QueryGetPairingRulesResponse struct {
+	Rules      []ComponentPairingRule `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules"`
+	Pagination *query.PageResponse    `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryGetPairingRulesResponse) Reset()         { *m = QueryGetPairingRulesResponse{} }
+func (m *QueryGetPairingRulesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetPairingRulesResponse) ProtoMessage()    {}
+func (*QueryGetPairingRulesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17282afec6a3e21a, []int{27}
+}
+func (m *QueryGetPairingRulesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetPairingRulesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetPairingRulesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetPairingRulesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetPairingRulesResponse.Merge(m, src)
+}
+func (m *QueryGetPairingRulesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetPairingRulesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetPairingRulesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetPairingRulesResponse proto.InternalMessageInfo
+
+func (m *QueryGetPairingRulesResponse) GetRules() []ComponentPairingRule {
+	if m != nil {
+		return m.Rules
+	}
+	return nil
+}
+
+func (m *QueryGetPairingRulesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryGetComponentMetadataRequest defines the QueryGetComponentMetadataRequest message.
+type QueryGetComponentMetadataRequest struct {
+	ComponentId string `protobuf:"bytes,1,opt,name=component_id,json=componentId,proto3" json:"component_id,omitempty"`
+}
+
+func (m *QueryGetComponentMetadataRequest) Reset()         { *m = QueryGetComponentMetadataRequest{} }
+func (m *QueryGetComponentMetadataRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetComponentMetadataRequest) ProtoMessage()    {}
+func (*QueryGetComponentMetadataRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17282afec6a3e21a, []int{28}
+}
+func (m *QueryGetComponentMetadataRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetComponentMetadataRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetComponentMetadataRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetComponentMetadataRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetComponentMetadataRequest.Merge(m, src)
+}
+func (m *QueryGetComponentMetadataRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetComponentMetadataRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetComponentMetadataRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetComponentMetadataRequest proto.InternalMessageInfo
+
+func (m *QueryGetComponentMetadataRequest) GetComponentId() string {
+	if m != nil {
+		return m.ComponentId
+	}
+	return ""
+}
+
+// QueryGetComponentMetadataResponse defines the QueryGetComponentMetadataResponse message.
+type QueryGetComponentMetadataResponse struct {
+	Component Component `protobuf:"bytes,1,opt,name=component,proto3" json:"component"`
+	// backend_metadata is the backend's metadata as a JSON object, empty unless backend_status is ok
+	BackendMetadata string `protobuf:"bytes,2,opt,name=backend_metadata,json=backendMetadata,proto3" json:"backend_metadata,omitempty"`
+	// backend_status is ok, not_configured, unavailable or error
+	BackendStatus string `protobuf:"bytes,3,opt,name=backend_status,json=backendStatus,proto3" json:"backend_status,omitempty"`
+}
+
+func (m *QueryGetComponentMetadataResponse) Reset()         { *m = QueryGetComponentMetadataResponse{} }
+func (m *QueryGetComponentMetadataResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetComponentMetadataResponse) ProtoMessage()    {}
+func (*QueryGetComponentMetadataResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17282afec6a3e21a, []int{29}
+}
+func (m *QueryGetComponentMetadataResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetComponentMetadataResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetComponentMetadataResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetComponentMetadataResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetComponentMetadataResponse.Merge(m, src)
+}
+func (m *QueryGetComponentMetadataResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetComponentMetadataResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetComponentMetadataResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetComponentMetadataResponse proto.InternalMessageInfo
+
+func (m *QueryGetComponentMetadataResponse) GetComponent() Component {
+	if m != nil {
+		return m.Component
+	}
+	return Component{}
+}
+
+func (m *QueryGetComponentMetadataResponse) GetBackendMetadata() string {
+	if m != nil {
+		return m.BackendMetadata
+	}
+	return ""
+}
+
+func (m *QueryGetComponentMetadataResponse) GetBackendStatus() string {
+	if m != nil {
+		return m.BackendStatus
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "racecarweb.componentregistry.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "racecarweb.componentregistry.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryGetComponentRequest)(nil), "racecarweb.componentregistry.v1.QueryGetComponentRequest")
+	proto.RegisterType((*QueryGetComponentResponse)(nil), "racecarweb.componentregistry.v1.QueryGetComponentResponse")
+	proto.RegisterType((*QueryGetComponentVerificationRequest)(nil), "racecarweb.componentregistry.v1.QueryGetComponentVerificationRequest")
+	proto.RegisterType((*QueryGetComponentVerificationResponse)(nil), "racecarweb.componentregistry.v1.QueryGetComponentVerificationResponse")
+	proto.RegisterType((*QueryCheckPairingAuthRequest)(nil), "racecarweb.componentregistry.v1.QueryCheckPairingAuthRequest")
+	proto.RegisterType((*QueryCheckPairingAuthResponse)(nil), "racecarweb.componentregistry.v1.QueryCheckPairingAuthResponse")
+	proto.RegisterType((*QueryListAuthorizedPartnersRequest)(nil), "racecarweb.componentregistry.v1.QueryListAuthorizedPartnersRequest")
+	proto.RegisterType((*QueryListAuthorizedPartnersResponse)(nil), "racecarweb.componentregistry.v1.QueryListAuthorizedPartnersResponse")
+	proto.RegisterType((*QueryGetComponentHistoryRequest)(nil), "racecarweb.componentregistry.v1.QueryGetComponentHistoryRequest")
+	proto.RegisterType((*QueryGetComponentHistoryResponse)(nil), "racecarweb.componentregistry.v1.QueryGetComponentHistoryResponse")
+	proto.RegisterType((*QueryListComponentsRequest)(nil), "racecarweb.componentregistry.v1.QueryListComponentsRequest")
+	proto.RegisterType((*QueryListComponentsResponse)(nil), "racecarweb.componentregistry.v1.QueryListComponentsResponse")
+	proto.RegisterType((*QueryGetRevocationEventsRequest)(nil), "racecarweb.componentregistry.v1.QueryGetRevocationEventsRequest")
+	proto.RegisterType((*QueryGetRevocationEventsResponse)(nil), "racecarweb.componentregistry.v1.QueryGetRevocationEventsResponse")
+	proto.RegisterType((*QueryComponentHealthRequest)(nil), "racecarweb.componentregistry.v1.QueryComponentHealthRequest")
+	proto.RegisterType((*QueryComponentHealthResponse)(nil), "racecarweb.componentregistry.v1.QueryComponentHealthResponse")
+	proto.RegisterType((*ComponentHealthSummary)(nil), "racecarweb.componentregistry.v1.ComponentHealthSummary")
+	proto.RegisterType((*ComponentHealthIssue)(nil), "racecarweb.componentregistry.v1.ComponentHealthIssue")
+	proto.RegisterType((*QueryGetCustodyChainRequest)(nil), "racecarweb.componentregistry.v1.QueryGetCustodyChainRequest")
+	proto.RegisterType((*QueryGetCustodyChainResponse)(nil), "racecarweb.componentregistry.v1.QueryGetCustodyChainResponse")
+	proto.RegisterType((*QuerySearchComponentsRequest)(nil), "racecarweb.componentregistry.v1.QuerySearchComponentsRequest")
+	proto.RegisterType((*QuerySearchComponentsResponse)(nil), "racecarweb.componentregistry.v1.QuerySearchComponentsResponse")
+	proto.RegisterType((*QueryGetVerificationHistoryRequest)(nil), "racecarweb.componentregistry.v1.QueryGetVerificationHistoryRequest")
+	proto.RegisterType((*QueryGetVerificationHistoryResponse)(nil), "racecarweb.componentregistry.v1.QueryGetVerificationHistoryResponse")
+	proto.RegisterType((*QueryGetPairingRulesRequest)(nil), "racecarweb.componentregistry.v1.QueryGetPairingRulesRequest")
+	proto.RegisterType((*QueryGetPairingRulesResponse)(nil), "racecarweb.componentregistry.v1.QueryGetPairingRulesResponse")
+	proto.RegisterType((*QueryGetComponentMetadataRequest)(nil), "racecarweb.componentregistry.v1.QueryGetComponentMetadataRequest")
+	proto.RegisterType((*QueryGetComponentMetadataResponse)(nil), "racecarweb.componentregistry.v1.QueryGetComponentMetadataResponse")
+}
+
+func init() {
+	proto.RegisterFile("racecarweb/componentregistry/v1/query.proto", fileDescriptor_17282afec6a3e21a)
+}
+
+var fileDescriptor_17282afec6a3e21a = []byte{
+	// 1787 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcf, 0x6f, 0x1b, 0xc5,
+	0x17, 0xcf, 0xc6, 0x49, 0x9a, 0x4c, 0xd3, 0x34, 0x9d, 0xe4, 0x1b, 0xf9, 0xeb, 0x6f, 0xbf, 0x71,
+	0xbb, 0xa5, 0x50, 0xd2, 0xd6, 0x4b, 0x9a, 0x96, 0xd2, 0x96, 0x96, 0xfc, 0x68, 0x92, 0x86, 0xd0,
+	0x2a, 0xdd, 0x54, 0x2d, 0xea, 0x65, 0x3b, 0x5e, 0x4f, 0xec, 0x55, 0xec, 0x5d, 0x77, 0x67, 0x6c,
+	0x70, 0x51, 0x2f, 0x3d, 0x20, 0x71, 0xa2, 0x12, 0x7f, 0x03, 0x12, 0x27, 0xc4, 0x11, 0x7a, 0x43,
+	0x02, 0xb5, 0x42, 0x02, 0x82, 0xb8, 0x70, 0x02, 0xd4, 0x22, 0x21, 0x95, 0x3b, 0x47, 0x84, 0x76,
+	0x66, 0xd6, 0xfb, 0xc3, 0xeb, 0x78, 0xd7, 0x09, 0x12, 0x17, 0xcb, 0x3b, 0xf3, 0xe6, 0x33, 0xef,
+	0xf3, 0xde, 0x9b, 0x37, 0xef, 0x0d, 0x38, 0x6e, 0x23, 0x1d, 0xeb, 0xc8, 0x7e, 0x07, 0xe7, 0x15,
+	0xdd, 0xaa, 0x54, 0x2d, 0x13, 0x9b, 0xd4, 0xc6, 0x45, 0x83, 0x50, 0xbb, 0xa1, 0xd4, 0xa7, 0x95,
+	0xbb, 0x35, 0x6c, 0x37, 0x72, 0x55, 0xdb, 0xa2, 0x16, 0xcc, 0x7a, 0xc2, 0xb9, 0x16, 0xe1, 0x5c,
+	0x7d, 0x3a, 0x73, 0x00, 0x55, 0x0c, 0xd3, 0x52, 0xd8, 0x2f, 0x5f, 0x93, 0x99, 0xd2, 0x2d, 0x52,
+	0xb1, 0x88, 0x92, 0x47, 0x04, 0x73, 0x30, 0xa5, 0x3e, 0x9d, 0xc7, 0x14, 0x4d, 0x2b, 0x55, 0x54,
+	0x34, 0x4c, 0x44, 0x0d, 0xcb, 0x14, 0xb2, 0xe3, 0x45, 0xab, 0x68, 0xb1, 0xbf, 0x8a, 0xf3, 0x4f,
+	0x8c, 0x1e, 0x2c, 0x5a, 0x56, 0xb1, 0x8c, 0x15, 0x54, 0x35, 0x14, 0x64, 0x9a, 0x16, 0x65, 0x4b,
+	0x88, 0x98, 0xcd, 0x8a, 0x59, 0xf6, 0x95, 0xaf, 0x6d, 0x28, 0xd4, 0xa8, 0x60, 0x42, 0x51, 0xa5,
+	0x2a, 0x04, 0x4e, 0x74, 0x62, 0x58, 0x45, 0x36, 0xaa, 0xb8, 0x70, 0x4a, 0x27, 0xe9, 0xe6, 0x20,
+	0x5f, 0x20, 0x8f, 0x03, 0x78, 0xdd, 0x61, 0xb5, 0xc6, 0x50, 0x54, 0x7c, 0xb7, 0x86, 0x09, 0x95,
+	0x11, 0x18, 0x0b, 0x8c, 0x92, 0xaa, 0x65, 0x12, 0x0c, 0xdf, 0x04, 0x03, 0x7c, 0xb7, 0xb4, 0x74,
+	0x48, 0x3a, 0xb6, 0xf7, 0xd4, 0x4b, 0xb9, 0x0e, 0x16, 0xcd, 0x71, 0x80, 0xf9, 0xa1, 0x27, 0x3f,
+	0x67, 0x7b, 0x3e, 0xf9, 0xfd, 0xb3, 0x29, 0x49, 0x15, 0x08, 0xf2, 0x45, 0x90, 0x66, 0x5b, 0x2c,
+	0x63, 0xba, 0xe0, 0xae, 0x14, 0xdb, 0xc3, 0xc3, 0x60, 0xb8, 0x89, 0xa6, 0x19, 0x05, 0xb6, 0xdb,
+	0x90, 0xba, 0xb7, 0x39, 0xb6, 0x52, 0x90, 0x37, 0xc1, 0x7f, 0x23, 0x96, 0x0b, 0x3d, 0xaf, 0x81,
+	0xa1, 0xa6, 0xac, 0x50, 0x75, 0xaa, 0xa3, 0xaa, 0x4d, 0x98, 0xf9, 0x3e, 0x47, 0x5b, 0xd5, 0x83,
+	0x90, 0x57, 0xc0, 0x0b, 0x2d, 0x9b, 0xdd, 0xc4, 0xb6, 0xb1, 0x61, 0xe8, 0xcc, 0x99, 0x09, 0xf4,
+	0xfe, 0x40, 0x02, 0x47, 0x3b, 0x60, 0x09, 0x12, 0x77, 0xc0, 0x70, 0xdd, 0x37, 0x2e, 0x78, 0xbc,
+	0x1a, 0x9f, 0x87, 0x1f, 0x55, 0x70, 0x0a, 0x20, 0xca, 0x77, 0xc0, 0x41, 0xa6, 0xca, 0x42, 0x09,
+	0xeb, 0x9b, 0x6b, 0xc8, 0xb0, 0x0d, 0xb3, 0x38, 0x57, 0xa3, 0x25, 0x97, 0x4e, 0x16, 0x78, 0xaa,
+	0x6b, 0x48, 0xb0, 0x01, 0xcd, 0xa1, 0xb9, 0xa0, 0x40, 0x3e, 0xdd, 0x1b, 0x12, 0x98, 0x97, 0x1b,
+	0xe0, 0xff, 0x6d, 0x76, 0x10, 0x24, 0xb3, 0x60, 0x18, 0x69, 0x3a, 0x32, 0xb5, 0x2a, 0x32, 0x6c,
+	0x2d, 0xcf, 0xf6, 0x18, 0x54, 0x87, 0xd0, 0x02, 0x32, 0x1d, 0xf1, 0x79, 0x47, 0x20, 0xef, 0x09,
+	0x20, 0xb6, 0xc7, 0xa0, 0x3a, 0x94, 0x17, 0x02, 0x73, 0x70, 0x02, 0x0c, 0xd8, 0x18, 0x11, 0xcb,
+	0x4c, 0xa7, 0xd8, 0xf6, 0xe2, 0x4b, 0x5e, 0x06, 0x32, 0xdb, 0xfa, 0x2d, 0x83, 0x50, 0x67, 0x4b,
+	0xcb, 0x36, 0xee, 0xe1, 0xc2, 0x1a, 0xb2, 0xa9, 0x89, 0x6d, 0x92, 0xc0, 0x63, 0xb7, 0xc1, 0x91,
+	0x6d, 0x81, 0x04, 0x93, 0x19, 0xf0, 0x1f, 0xd4, 0x9c, 0xd5, 0x9a, 0x00, 0x44, 0x40, 0x8e, 0x7b,
+	0x93, 0x4d, 0x07, 0x11, 0xf9, 0x32, 0xc8, 0xb6, 0x04, 0xc3, 0x15, 0x83, 0x50, 0xcb, 0x6e, 0x24,
+	0xd0, 0xf0, 0x1e, 0x38, 0xd4, 0x1e, 0x45, 0xa8, 0x77, 0x13, 0xec, 0x71, 0xe2, 0xc4, 0xc0, 0x8e,
+	0x42, 0xa9, 0x64, 0x81, 0x24, 0xb0, 0x16, 0x4d, 0x6a, 0x37, 0x44, 0x20, 0xb9, 0x60, 0x72, 0x01,
+	0x64, 0x9a, 0xd6, 0xf1, 0x88, 0xb9, 0xca, 0x2f, 0x01, 0xe0, 0x65, 0x49, 0x11, 0xc1, 0x2f, 0xe6,
+	0x78, 0x4a, 0xcd, 0x39, 0x29, 0x35, 0xc7, 0xf3, 0xb3, 0x48, 0xa9, 0xb9, 0x35, 0x54, 0xc4, 0x62,
+	0xad, 0xea, 0x5b, 0x29, 0x7f, 0x2e, 0x81, 0xff, 0x45, 0x6e, 0x23, 0xd8, 0xad, 0x01, 0x10, 0xb0,
+	0x78, 0xaa, 0xab, 0x13, 0xef, 0xc3, 0x80, 0xcb, 0x01, 0xcd, 0x7b, 0x45, 0xba, 0xeb, 0xa4, 0x39,
+	0x57, 0x27, 0xa0, 0xfa, 0xa7, 0x92, 0xe7, 0x63, 0x15, 0xd7, 0x2d, 0x7e, 0xf6, 0x16, 0xeb, 0x7e,
+	0x33, 0x65, 0xc1, 0x5e, 0x8a, 0xec, 0x22, 0xa6, 0x5a, 0x09, 0x91, 0x92, 0x7b, 0xd0, 0xf8, 0xd0,
+	0x15, 0x44, 0x4a, 0x10, 0x82, 0xbe, 0x0d, 0xdb, 0xaa, 0x30, 0x3d, 0x52, 0x2a, 0xfb, 0x0f, 0x47,
+	0x40, 0x2f, 0xb5, 0x58, 0xd0, 0xa7, 0xd4, 0x5e, 0x6a, 0x85, 0x6c, 0xdd, 0xd7, 0xb5, 0xad, 0xbf,
+	0x92, 0xbc, 0x70, 0x6a, 0x55, 0x58, 0x18, 0xfc, 0x16, 0x18, 0xc0, 0x75, 0x9f, 0xb1, 0xcf, 0x75,
+	0x34, 0xf6, 0x9c, 0x69, 0x99, 0x8d, 0x8a, 0x55, 0x23, 0x21, 0x4c, 0x61, 0x7b, 0x01, 0xb7, 0x7b,
+	0x76, 0xbf, 0x2a, 0x22, 0xc6, 0x8b, 0x62, 0x8c, 0xca, 0x5e, 0x6e, 0xcb, 0x81, 0x31, 0x42, 0x51,
+	0x19, 0x6b, 0x68, 0x83, 0x62, 0x5b, 0x23, 0x58, 0xb7, 0xcc, 0x02, 0x3f, 0xac, 0x29, 0xf5, 0x00,
+	0x9b, 0x9a, 0x73, 0x66, 0xd6, 0xf9, 0x84, 0xbc, 0x25, 0xb9, 0xc9, 0x32, 0x8c, 0xd7, 0xb4, 0xc8,
+	0x1e, 0x52, 0xab, 0x54, 0x90, 0xdd, 0x10, 0x71, 0x7e, 0x36, 0xc1, 0x01, 0x63, 0x50, 0xeb, 0x7c,
+	0xb9, 0x7b, 0xc2, 0x04, 0x1a, 0xbc, 0x05, 0x06, 0xab, 0xb6, 0x95, 0x2f, 0xe3, 0x0a, 0x49, 0xf7,
+	0x32, 0x63, 0x9f, 0x49, 0x8a, 0xbc, 0x42, 0x48, 0x0d, 0x0b, 0xdc, 0x26, 0x98, 0xfc, 0x58, 0x02,
+	0x13, 0xd1, 0x2a, 0xc0, 0x71, 0xd0, 0x4f, 0x2d, 0x8a, 0xca, 0x8c, 0x4a, 0x9f, 0xca, 0x3f, 0x9c,
+	0x54, 0x8b, 0x74, 0x6a, 0xd4, 0x31, 0xf3, 0x4b, 0x9f, 0x2a, 0xbe, 0x1c, 0x69, 0x66, 0x30, 0x16,
+	0x8c, 0x7d, 0x2a, 0xff, 0x80, 0x69, 0xb0, 0xc7, 0xc6, 0x75, 0x6b, 0x13, 0x17, 0x58, 0x30, 0xf6,
+	0xa9, 0xee, 0x27, 0x9c, 0x04, 0xa0, 0x66, 0xf2, 0x9b, 0x08, 0x17, 0xd2, 0xfd, 0x6c, 0xd2, 0x37,
+	0x02, 0x15, 0x30, 0xb6, 0x81, 0x8c, 0x32, 0x2e, 0x68, 0x81, 0x0b, 0x70, 0x80, 0x09, 0x42, 0x3e,
+	0xe5, 0xbf, 0xdc, 0xe4, 0x2f, 0x24, 0x30, 0x1e, 0x45, 0x39, 0x46, 0xf2, 0x74, 0x48, 0x11, 0x8a,
+	0x68, 0x8d, 0x88, 0xeb, 0x4b, 0x7c, 0x39, 0xe3, 0x86, 0x83, 0x41, 0xd2, 0xa9, 0x43, 0x29, 0x67,
+	0x9c, 0x7f, 0xc1, 0x6b, 0x60, 0xb4, 0x8c, 0x08, 0xd5, 0x5c, 0x6d, 0x35, 0x44, 0xc5, 0x61, 0xcb,
+	0xe4, 0x78, 0x2d, 0x97, 0x73, 0x6b, 0xb9, 0xdc, 0x0d, 0xb7, 0x96, 0x9b, 0x1f, 0x74, 0x6c, 0xff,
+	0xf0, 0x97, 0xac, 0xa4, 0x8e, 0x38, 0xab, 0x6f, 0x8a, 0xc5, 0x73, 0x54, 0x9e, 0x15, 0x71, 0xea,
+	0x24, 0xef, 0x1a, 0xa1, 0x56, 0xa1, 0xb1, 0x50, 0x42, 0x86, 0x99, 0xa8, 0x14, 0x3a, 0x18, 0x8d,
+	0x20, 0x22, 0x73, 0x35, 0x74, 0x56, 0x4f, 0x76, 0x0e, 0x1f, 0x0e, 0x13, 0x71, 0x3e, 0xbd, 0x73,
+	0xb0, 0x8e, 0x91, 0xad, 0x97, 0x5a, 0x53, 0xfe, 0x71, 0x70, 0xa0, 0x82, 0xcc, 0xda, 0x06, 0xd2,
+	0x69, 0xcd, 0xc6, 0xb6, 0x3f, 0xa3, 0x8d, 0xfa, 0x27, 0x58, 0x5e, 0x3b, 0x02, 0xf6, 0xe9, 0x88,
+	0xe2, 0xa2, 0x65, 0x37, 0xb8, 0x20, 0xf7, 0xc1, 0xb0, 0x3b, 0xc8, 0x84, 0x3c, 0x0f, 0xa5, 0x02,
+	0x1e, 0xda, 0xad, 0x84, 0xf7, 0x48, 0x12, 0x55, 0x4a, 0x2b, 0xa5, 0x7f, 0xff, 0xf5, 0xf2, 0xa1,
+	0x24, 0xea, 0x9c, 0x65, 0x1c, 0x28, 0xf8, 0x12, 0x57, 0x11, 0x21, 0x73, 0xf6, 0x76, 0x6d, 0xce,
+	0xef, 0x25, 0x51, 0x30, 0xb5, 0xd3, 0x48, 0x18, 0x55, 0x03, 0xfb, 0xfc, 0xc7, 0xdb, 0xb5, 0xeb,
+	0x4c, 0x47, 0xbb, 0x06, 0xab, 0x65, 0xdd, 0xb2, 0x0b, 0xc2, 0xc0, 0x41, 0xbc, 0xdd, 0xb3, 0xf1,
+	0xfb, 0x92, 0x77, 0x46, 0x45, 0x11, 0xab, 0xd6, 0xca, 0xd8, 0x7f, 0x7d, 0x13, 0xab, 0x66, 0xeb,
+	0x58, 0xa3, 0x8d, 0x2a, 0x76, 0xaf, 0x6f, 0x3e, 0x74, 0xa3, 0x51, 0xc5, 0xbb, 0x66, 0xda, 0x47,
+	0x92, 0x77, 0xd4, 0x83, 0x8a, 0x08, 0x9b, 0x5e, 0x07, 0xfd, 0xb6, 0x33, 0x20, 0x6c, 0x99, 0xe0,
+	0xa2, 0xf0, 0xc1, 0x09, 0x6b, 0x72, 0xa4, 0xdd, 0xb3, 0xe2, 0x62, 0x44, 0x95, 0x7a, 0x15, 0x53,
+	0x54, 0x40, 0x14, 0x25, 0xc8, 0x76, 0x5f, 0x4b, 0xe0, 0xf0, 0x36, 0x38, 0xff, 0x4c, 0x07, 0x08,
+	0x5f, 0x06, 0xa3, 0x79, 0xa4, 0x6f, 0x62, 0xb3, 0xa0, 0x55, 0xc4, 0x5e, 0x22, 0x57, 0xed, 0x17,
+	0xe3, 0xae, 0x0a, 0xf0, 0x28, 0x18, 0x71, 0x45, 0x03, 0x69, 0x6b, 0x9f, 0x18, 0x5d, 0x67, 0x83,
+	0xa7, 0x1e, 0xa4, 0x41, 0x3f, 0xe3, 0x01, 0x3f, 0x96, 0xc0, 0x00, 0xef, 0x93, 0x61, 0xe7, 0xe0,
+	0x6f, 0x6d, 0xd6, 0x33, 0xa7, 0x93, 0x2d, 0xe2, 0x16, 0x92, 0x5f, 0x79, 0xf0, 0xe3, 0x6f, 0x1f,
+	0xf5, 0x4e, 0xc1, 0x63, 0xee, 0x93, 0xc1, 0xc9, 0x0e, 0x2f, 0x0c, 0xf0, 0x5b, 0x09, 0x0c, 0xfb,
+	0x8d, 0x0e, 0xcf, 0xc5, 0xdb, 0x38, 0xa2, 0xc3, 0xcf, 0x9c, 0xef, 0x66, 0xa9, 0xd0, 0x7c, 0x89,
+	0x69, 0x3e, 0x0b, 0x2f, 0x75, 0xd6, 0xdc, 0x29, 0xa9, 0x9b, 0x13, 0xca, 0x7b, 0xfe, 0xd8, 0xba,
+	0x0f, 0xff, 0x92, 0x40, 0xba, 0x5d, 0x17, 0x0e, 0x17, 0x93, 0x2b, 0x18, 0xf1, 0x22, 0x90, 0x59,
+	0xda, 0x29, 0x8c, 0xe0, 0xbc, 0xce, 0x38, 0x5f, 0x85, 0xab, 0x09, 0x39, 0x07, 0x2a, 0xa8, 0xb0,
+	0x01, 0xfe, 0x90, 0xc0, 0x68, 0xb8, 0x33, 0x87, 0x17, 0xe3, 0x69, 0xdc, 0xe6, 0xcd, 0x20, 0x73,
+	0xa9, 0xdb, 0xe5, 0x82, 0xe8, 0xdb, 0x8c, 0xa8, 0x0a, 0xd7, 0x3a, 0x13, 0xd5, 0x1d, 0x0c, 0xf6,
+	0x2e, 0x60, 0x98, 0x45, 0xcd, 0xe9, 0xaf, 0xfd, 0x04, 0xd1, 0x7d, 0xff, 0x57, 0xfe, 0x3e, 0xfc,
+	0x53, 0x02, 0x13, 0xd1, 0x3d, 0x3c, 0x5c, 0x88, 0xa7, 0xf4, 0xb6, 0x4f, 0x09, 0x99, 0xcb, 0x3b,
+	0x03, 0x11, 0xfc, 0xaf, 0x33, 0xfe, 0xab, 0x70, 0xa5, 0x33, 0xff, 0xb2, 0x41, 0xa8, 0xe6, 0x7b,
+	0x73, 0xa8, 0x0a, 0xac, 0xb0, 0x9b, 0x9f, 0x4b, 0x60, 0x2c, 0xe2, 0x69, 0x00, 0xce, 0x26, 0x8f,
+	0xcd, 0x60, 0x55, 0x91, 0x99, 0xdb, 0x01, 0x82, 0xe0, 0x7b, 0x8d, 0xf1, 0xbd, 0x02, 0x97, 0x92,
+	0x06, 0x76, 0x89, 0x03, 0x85, 0xc9, 0x7e, 0x29, 0x81, 0x91, 0xe0, 0x23, 0x01, 0xbc, 0x10, 0xdf,
+	0x31, 0x2d, 0xe5, 0x6c, 0xe6, 0xf5, 0xee, 0x16, 0x0b, 0x76, 0xa7, 0x19, 0xbb, 0x1c, 0x3c, 0x11,
+	0x23, 0x9a, 0x3d, 0x85, 0x7f, 0xe0, 0x0e, 0x0b, 0x37, 0xdf, 0x09, 0x1c, 0xd6, 0xe6, 0xa1, 0x21,
+	0x81, 0xc3, 0xda, 0x75, 0xfe, 0xf2, 0x19, 0x46, 0x49, 0x81, 0x27, 0x3b, 0x53, 0xb2, 0x9b, 0x18,
+	0x04, 0x7e, 0x23, 0x81, 0xfd, 0xa1, 0x16, 0x0d, 0xc6, 0xb4, 0x6d, 0x74, 0x07, 0x9f, 0xb9, 0xd8,
+	0xe5, 0x6a, 0xc1, 0xe3, 0x3c, 0xe3, 0x71, 0x1a, 0x9e, 0x4a, 0xe0, 0x1a, 0xad, 0xc4, 0x15, 0xdf,
+	0x92, 0xc0, 0xfe, 0x50, 0xb7, 0x15, 0x97, 0x4c, 0x74, 0x9b, 0x17, 0x97, 0x4c, 0x9b, 0x16, 0x4f,
+	0x9e, 0x65, 0x64, 0xce, 0xc3, 0xd7, 0x62, 0x90, 0xe1, 0xeb, 0xc3, 0xe7, 0xe6, 0x3b, 0x09, 0x8c,
+	0x86, 0xfb, 0x9f, 0xb8, 0x77, 0x41, 0x9b, 0x56, 0x30, 0xee, 0x5d, 0xd0, 0xae, 0xed, 0x92, 0x2f,
+	0x30, 0x56, 0x67, 0xe0, 0x4c, 0x92, 0xd3, 0xa3, 0x10, 0x06, 0xe7, 0x5c, 0x6e, 0x13, 0xd1, 0x1d,
+	0x48, 0xdc, 0x74, 0xbf, 0x6d, 0x47, 0x15, 0x37, 0xdd, 0x6f, 0xdf, 0x04, 0x25, 0xa9, 0x65, 0x02,
+	0xcd, 0x4d, 0xd8, 0x7d, 0x8f, 0x79, 0x44, 0xfa, 0x9b, 0x82, 0x04, 0x11, 0x19, 0xd1, 0xd4, 0x24,
+	0x88, 0xc8, 0xa8, 0x4e, 0x44, 0x3e, 0xcb, 0x88, 0x4d, 0x43, 0x25, 0x4e, 0x79, 0xc9, 0x6f, 0x70,
+	0xde, 0x6f, 0x3c, 0x97, 0xc0, 0x78, 0x54, 0x69, 0x0f, 0xbb, 0xb8, 0x6c, 0x42, 0xed, 0x45, 0x66,
+	0x7e, 0x27, 0x10, 0x82, 0xd8, 0x2a, 0x23, 0xb6, 0x08, 0x17, 0x92, 0xe4, 0x0d, 0xb7, 0x67, 0x08,
+	0xb9, 0x6d, 0xfe, 0x8d, 0x27, 0x4f, 0x27, 0xa5, 0xad, 0xa7, 0x93, 0xd2, 0xaf, 0x4f, 0x27, 0xa5,
+	0x87, 0xcf, 0x26, 0x7b, 0xb6, 0x9e, 0x4d, 0xf6, 0xfc, 0xf4, 0x6c, 0xb2, 0xe7, 0xf6, 0x51, 0x3f,
+	0xfa, 0xbb, 0x11, 0xf8, 0x4e, 0xab, 0x49, 0xf2, 0x03, 0xec, 0xad, 0x69, 0xe6, 0xef, 0x00, 0x00,
+	0x00, 0xff, 0xff, 0xa5, 0xee, 0x70, 0xaa, 0x08, 0x1d, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// GetComponent Queries a list of GetComponent items.
+	GetComponent(ctx context.Context, in *QueryGetComponentRequest, opts ...grpc.CallOption) (*QueryGetComponentResponse, error)
+	// GetComponentVerification Queries the verification status of a component.
+	GetComponentVerification(ctx context.Context, in *QueryGetComponentVerificationRequest, opts ...grpc.CallOption) (*QueryGetComponentVerificationResponse, error)
+	// CheckPairingAuth Queries a list of CheckPairingAuth items.
+	CheckPairingAuth(ctx context.Context, in *QueryCheckPairingAuthRequest, opts ...grpc.CallOption) (*QueryCheckPairingAuthResponse, error)
+	// ListAuthorizedPartners Queries a list of ListAuthorizedPartners items.
+	ListAuthorizedPartners(ctx context.Context, in *QueryListAuthorizedPartnersRequest, opts ...grpc.CallOption) (*QueryListAuthorizedPartnersResponse, error)
+	// GetComponentHistory Queries the recorded metadata changes of a component.
+	GetComponentHistory(ctx context.Context, in *QueryGetComponentHistoryRequest, opts ...grpc.CallOption) (*QueryGetComponentHistoryResponse, error)
+	// ListComponents Queries a page of registered components.
+	ListComponents(ctx context.Context, in *QueryListComponentsRequest, opts ...grpc.CallOption) (*QueryListComponentsResponse, error)
+	// GetRevocationEvents Queries a page of revocation events, optionally for one
+	// target hash and within a time range.
+	GetRevocationEvents(ctx context.Context, in *QueryGetRevocationEventsRequest, opts ...grpc.CallOption) (*QueryGetRevocationEventsResponse, error)
+	// ComponentHealth Queries fleet-wide component health: aggregate counts and
+	// the components that are stale, failed verification or were revoked.
+	ComponentHealth(ctx context.Context, in *QueryComponentHealthRequest, opts ...grpc.CallOption) (*QueryComponentHealthResponse, error)
+	// GetCustodyChain Queries the ordered custody chain of a component.
+	GetCustodyChain(ctx context.Context, in *QueryGetCustodyChainRequest, opts ...grpc.CallOption) (*QueryGetCustodyChainResponse, error)
+	// SearchComponents Queries a page of anonymously registered components by
+	// manufacturer hash and/or category hash, optionally in one status.
+	SearchComponents(ctx context.Context, in *QuerySearchComponentsRequest, opts ...grpc.CallOption) (*QuerySearchComponentsResponse, error)
+	// GetVerificationHistory Queries a page of a component's recorded
+	// verifications, oldest first unless pagination.reverse is set.
+	GetVerificationHistory(ctx context.Context, in *QueryGetVerificationHistoryRequest, opts ...grpc.CallOption) (*QueryGetVerificationHistoryResponse, error)
+	// GetPairingRules Queries a page of the pairing rules whose source is a
+	// component type, given either as the type or as its hash.
+	GetPairingRules(ctx context.Context, in *QueryGetPairingRulesRequest, opts ...grpc.CallOption) (*QueryGetPairingRulesResponse, error)
+	// GetComponentMetadata Queries a component together with the metadata the
+	// verification backend holds for it.
+	GetComponentMetadata(ctx context.Context, in *QueryGetComponentMetadataRequest, opts ...grpc.CallOption) (*QueryGetComponentMetadataResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/racecarweb.componentregistry.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetComponent(ctx context.Context, in *QueryGetComponentRequest, opts ...grpc.CallOption) (*QueryGetComponentResponse, error) {
+	out := new(QueryGetComponentResponse)
+	err := c.cc.Invoke(ctx, "/racecarweb.componentregistry.v1.Query/GetComponent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetComponentVerification(ctx context.Context, in *QueryGetComponentVerificationRequest, opts ...grpc.CallOption) (*QueryGetComponentVerificationResponse, error) {
+	out := new(QueryGetComponentVerificationResponse)
+	err := c.cc.Invoke(ctx, "/racecarweb.componentregistry.v1.Query/GetComponentVerification", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CheckPairingAuth(ctx context.Context, in *QueryCheckPairingAuthRequest, opts ...grpc.CallOption) (*QueryCheckPairingAuthResponse, error) {
+	out := new(QueryCheckPairingAuthResponse)
+	err := c.cc.Invoke(ctx, "/racecarweb.componentregistry.v1.Query/CheckPairingAuth", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListAuthorizedPartners(ctx context.Context, in *QueryListAuthorizedPartnersRequest, opts ...grpc.CallOption) (*QueryListAuthorizedPartnersResponse, error) {
+	out := new(QueryListAuthorizedPartnersResponse)
+	err := c.cc.Invoke(ctx, "/racecarweb.componentregistry.v1.Query/ListAuthorizedPartners", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetComponentHistory(ctx context.Context, in *QueryGetComponentHistoryRequest, opts ...grpc.CallOption) (*QueryGetComponentHistoryResponse, error) {
+	out := new(QueryGetComponentHistoryResponse)
+	err := c.cc.Invoke(ctx, "/racecarweb.componentregistry.v1.Query/GetComponentHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListComponents(ctx context.Context, in *QueryListComponentsRequest, opts ...grpc.CallOption) (*QueryListComponentsResponse, error) {
+	out := new(QueryListComponentsResponse)
+	err := c.cc.Invoke(ctx, "/racecarweb.componentregistry.v1.Query/ListComponents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetRevocationEvents(ctx context.Context, in *QueryGetRevocationEventsRequest, opts ...grpc.CallOption) (*QueryGetRevocationEventsResponse, error) {
+	out := new(QueryGetRevocationEventsResponse)
+	err := c.cc.Invoke(ctx, "/racecarweb.componentregistry.v1.Query/GetRevocationEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ComponentHealth(ctx context.Context, in *QueryComponentHealthRequest, opts ...grpc.CallOption) (*QueryComponentHealthResponse, error) {
+	out := new(QueryComponentHealthResponse)
+	err := c.cc.Invoke(ctx, "/racecarweb.componentregistry.v1.Query/ComponentHealth", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetCustodyChain(ctx context.Context, in *QueryGetCustodyChainRequest, opts ...grpc.CallOption) (*QueryGetCustodyChainResponse, error) {
+	out := new(QueryGetCustodyChainResponse)
+	err := c.cc.Invoke(ctx, "/racecarweb.componentregistry.v1.Query/GetCustodyChain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SearchComponents(ctx context.Context, in *QuerySearchComponentsRequest, opts ...grpc.CallOption) (*QuerySearchComponentsResponse, error) {
+	out := new(QuerySearchComponentsResponse)
+	err := c.cc.Invoke(ctx, "/racecarweb.componentregistry.v1.Query/SearchComponents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetVerificationHistory(ctx context.Context, in *QueryGetVerificationHistoryRequest, opts ...grpc.CallOption) (*QueryGetVerificationHistoryResponse, error) {
+	out := new(QueryGetVerificationHistoryResponse)
+	err := c.cc.Invoke(ctx, "/racecarweb.componentregistry.v1.Query/GetVerificationHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetPairingRules(ctx context.Context, in *QueryGetPairingRulesRequest, opts ...grpc.CallOption) (*QueryGetPairingRulesResponse, error) {
+	out := new(QueryGetPairingRulesResponse)
+	err := c.cc.Invoke(ctx, "/racecarweb.componentregistry.v1.Query/GetPairingRules", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetComponentMetadata(ctx context.Context, in *QueryGetComponentMetadataRequest, opts ...grpc.CallOption) (*QueryGetComponentMetadataResponse, error) {
+	out := new(QueryGetComponentMetadataResponse)
+	err := c.cc.Invoke(ctx, "/racecarweb.componentregistry.v1.Query/GetComponentMetadata", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// GetComponent Queries a list of GetComponent items.
+	GetComponent(context.Context, *QueryGetComponentRequest) (*QueryGetComponentResponse, error)
+	// GetComponentVerification Queries the verification status of a component.
+	GetComponentVerification(context.Context, *QueryGetComponentVerificationRequest) (*QueryGetComponentVerificationResponse, error)
+	// CheckPairingAuth Queries a list of CheckPairingAuth items.
+	CheckPairingAuth(context.Context, *QueryCheckPairingAuthRequest) (*QueryCheckPairingAuthResponse, error)
+	// ListAuthorizedPartners Queries a list of ListAuthorizedPartners items.
+	ListAuthorizedPartners(context.Context, *QueryListAuthorizedPartnersRequest) (*QueryListAuthorizedPartnersResponse, error)
+	// GetComponentHistory Queries the recorded metadata changes of a component.
+	GetComponentHistory(context.Context, *QueryGetComponentHistoryRequest) (*QueryGetComponentHistoryResponse, error)
+	// ListComponents Queries a page of registered components.
+	ListComponents(context.Context, *QueryListComponentsRequest) (*QueryListComponentsResponse, error)
+	// GetRevocationEvents Queries a page of revocation events, optionally for one
+	// target hash and within a time range.
+	GetRevocationEvents(context.Context, *QueryGetRevocationEventsRequest) (*QueryGetRevocationEventsResponse, error)
+	// ComponentHealth Queries fleet-wide component health: aggregate counts and
+	// the components that are stale, failed verification or were revoked.
+	ComponentHealth(context.Context, *QueryComponentHealthRequest) (*QueryComponentHealthResponse, error)
+	// GetCustodyChain Queries the ordered custody chain of a component.
+	GetCustodyChain(context.Context, *QueryGetCustodyChainRequest) (*QueryGetCustodyChainResponse, error)
+	// SearchComponents Queries a page of anonymously registered components by
+	// manufacturer hash and/or category hash, optionally in one status.
+	SearchComponents(context.Context, *QuerySearchComponentsRequest) (*QuerySearchComponentsResponse, error)
+	// GetVerificationHistory Queries a page of a component's recorded
+	// verifications, oldest first unless pagination.reverse is set.
+	GetVerificationHistory(context.Context, *QueryGetVerificationHistoryRequest) (*QueryGetVerificationHistoryResponse, error)
+	// GetPairingRules Queries a page of the pairing rules whose source is a
+	// component type, given either as the type or as its hash.
+	GetPairingRules(context.Context, *QueryGetPairingRulesRequest) (*QueryGetPairingRulesResponse, error)
+	// GetComponentMetadata Queries a component together with the metadata the
+	// verification backend holds for it.
+	GetComponentMetadata(context.Context, *QueryGetComponentMetadataRequest) (*QueryGetComponentMetadataResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) GetComponent(ctx context.Context, req *QueryGetComponentRequest) (*QueryGetComponentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetComponent not implemented")
+}
+func (*UnimplementedQueryServer) GetComponentVerification(ctx context.Context, req *QueryGetComponentVerificationRequest) (*QueryGetComponentVerificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetComponentVerification not implemented")
+}
+func (*UnimplementedQueryServer) CheckPairingAuth(ctx context.Context, req *QueryCheckPairingAuthRequest) (*QueryCheckPairingAuthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckPairingAuth not implemented")
+}
+func (*UnimplementedQueryServer) ListAuthorizedPartners(ctx context.Context, req *QueryListAuthorizedPartnersRequest) (*QueryListAuthorizedPartnersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAuthorizedPartners not implemented")
+}
+func (*UnimplementedQueryServer) GetComponentHistory(ctx context.Context, req *QueryGetComponentHistoryRequest) (*QueryGetComponentHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetComponentHistory not implemented")
+}
+func (*UnimplementedQueryServer) ListComponents(ctx context.Context, req *QueryListComponentsRequest) (*QueryListComponentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListComponents not implemented")
+}
+func (*UnimplementedQueryServer) GetRevocationEvents(ctx context.Context, req *QueryGetRevocationEventsRequest) (*QueryGetRevocationEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRevocationEvents not implemented")
+}
+func (*UnimplementedQueryServer) ComponentHealth(ctx context.Context, req *QueryComponentHealthRequest) (*QueryComponentHealthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ComponentHealth not implemented")
+}
+func (*UnimplementedQueryServer) GetCustodyChain(ctx context.Context, req *QueryGetCustodyChainRequest) (*QueryGetCustodyChainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCustodyChain not implemented")
+}
+func (*UnimplementedQueryServer) SearchComponents(ctx context.Context, req *QuerySearchComponentsRequest) (*QuerySearchComponentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchComponents not implemented")
+}
+func (*UnimplementedQueryServer) GetVerificationHistory(ctx context.Context, req *QueryGetVerificationHistoryRequest) (*QueryGetVerificationHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVerificationHistory not implemented")
+}
+func (*UnimplementedQueryServer) GetPairingRules(ctx context.Context, req *QueryGetPairingRulesRequest) (*QueryGetPairingRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPairingRules not implemented")
+}
+func (*UnimplementedQueryServer) GetComponentMetadata(ctx context.Context, req *QueryGetComponentMetadataRequest) (*QueryGetComponentMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetComponentMetadata not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/racecarweb.componentregistry.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetComponent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetComponentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetComponent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/racecarweb.componentregistry.v1.Query/GetComponent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetComponent(ctx, req.(*QueryGetComponentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetComponentVerification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetComponentVerificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetComponentVerification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/racecarweb.componentregistry.v1.Query/GetComponentVerification",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetComponentVerification(ctx, req.(*QueryGetComponentVerificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CheckPairingAuth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCheckPairingAuthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CheckPairingAuth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/racecarweb.componentregistry.v1.Query/CheckPairingAuth",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CheckPairingAuth(ctx, req.(*QueryCheckPairingAuthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListAuthorizedPartners_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListAuthorizedPartnersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListAuthorizedPartners(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/racecarweb.componentregistry.v1.Query/ListAuthorizedPartners",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListAuthorizedPartners(ctx, req.(*QueryListAuthorizedPartnersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetComponentHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetComponentHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetComponentHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/racecarweb.componentregistry.v1.Query/GetComponentHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetComponentHistory(ctx, req.(*QueryGetComponentHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListComponents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListComponentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListComponents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/racecarweb.componentregistry.v1.Query/ListComponents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListComponents(ctx, req.(*QueryListComponentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetRevocationEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetRevocationEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetRevocationEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/racecarweb.componentregistry.v1.Query/GetRevocationEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetRevocationEvents(ctx, req.(*QueryGetRevocationEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ComponentHealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryComponentHealthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ComponentHealth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/racecarweb.componentregistry.v1.Query/ComponentHealth",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ComponentHealth(ctx, req.(*QueryComponentHealthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetCustodyChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetCustodyChainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetCustodyChain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/racecarweb.componentregistry.v1.Query/GetCustodyChain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetCustodyChain(ctx, req.(*QueryGetCustodyChainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SearchComponents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySearchComponentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SearchComponents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/racecarweb.componentregistry.v1.Query/SearchComponents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SearchComponents(ctx, req.(*QuerySearchComponentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetVerificationHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetVerificationHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetVerificationHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/racecarweb.componentregistry.v1.Query/GetVerificationHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetVerificationHistory(ctx, req.(*QueryGetVerificationHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetPairingRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetPairingRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetPairingRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/racecarweb.componentregistry.v1.Query/GetPairingRules",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetPairingRules(ctx, req.(*QueryGetPairingRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetComponentMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetComponentMetadataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetComponentMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/racecarweb.componentregistry.v1.Query/GetComponentMetadata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetComponentMetadata(ctx, req.(*QueryGetComponentMetadataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "racecarweb.componentregistry.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "GetComponent",
+			Handler:    _Query_GetComponent_Handler,
+		},
+		{
+			MethodName: "GetComponentVerification",
+			Handler:    _Query_GetComponentVerification_Handler,
+		},
+		{
+			MethodName: "CheckPairingAuth",
+			Handler:    _Query_CheckPairingAuth_Handler,
+		},
+		{
+			MethodName: "ListAuthorizedPartners",
+			Handler:    _Query_ListAuthorizedPartners_Handler,
+		},
+		{
+			MethodName: "GetComponentHistory",
+			Handler:    _Query_GetComponentHistory_Handler,
+		},
+		{
+			MethodName: "ListComponents",
+			Handler:    _Query_ListComponents_Handler,
+		},
+		{
+			MethodName: "GetRevocationEvents",
+			Handler:    _Query_GetRevocationEvents_Handler,
+		},
+		{
+			MethodName: "ComponentHealth",
+			Handler:    _Query_ComponentHealth_Handler,
+		},
+		{
+			MethodName: "GetCustodyChain",
+			Handler:    _Query_GetCustodyChain_Handler,
+		},
+		{
+			MethodName: "SearchComponents",
+			Handler:    _Query_SearchComponents_Handler,
+		},
+		{
+			MethodName: "GetVerificationHistory",
+			Handler:    _Query_GetVerificationHistory_Handler,
+		},
+		{
+			MethodName: "GetPairingRules",
+			Handler:    _Query_GetPairingRules_Handler,
+		},
+		{
+			MethodName: "GetComponentMetadata",
+			Handler:    _Query_GetComponentMetadata_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "racecarweb/componentregistry/v1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetComponentRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetComponentRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetComponentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ComponentId) > 0 {
+		i -= len(m.ComponentId)
+		copy(dAtA[i:], m.ComponentId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ComponentId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetComponentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetComponentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetComponentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Component.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetComponentVerificationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetComponentVerificationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetComponentVerificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ComponentId) > 0 {
+		i -= len(m.ComponentId)
+		copy(dAtA[i:], m.ComponentId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ComponentId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetComponentVerificationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetComponentVerificationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetComponentVerificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Verification.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCheckPairingAuthRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCheckPairingAuthRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCheckPairingAuthRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ComponentB) > 0 {
+		i -= len(m.ComponentB)
+		copy(dAtA[i:], m.ComponentB)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ComponentB)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ComponentA) > 0 {
+		i -= len(m.ComponentA)
+		copy(dAtA[i:], m.ComponentA)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ComponentA)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCheckPairingAuthResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCheckPairingAuthResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCheckPairingAuthResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.BCanPairA {
+		i--
+		if m.BCanPairA {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ACanPairB {
+		i--
+		if m.ACanPairB {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListAuthorizedPartnersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListAuthorizedPartnersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListAuthorizedPartnersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ComponentId) > 0 {
+		i -= len(m.ComponentId)
+		copy(dAtA[i:], m.ComponentId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ComponentId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListAuthorizedPartnersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListAuthorizedPartnersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListAuthorizedPartnersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AuthorizedComponents) > 0 {
+		i -= len(m.AuthorizedComponents)
+		copy(dAtA[i:], m.AuthorizedComponents)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AuthorizedComponents)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetComponentHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetComponentHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetComponentHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ComponentId) > 0 {
+		i -= len(m.ComponentId)
+		copy(dAtA[i:], m.ComponentId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ComponentId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetComponentHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetComponentHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetComponentHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListComponentsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListComponentsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListComponentsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListComponentsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListComponentsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListComponentsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Components) > 0 {
+		for iNdEx := len(m.Components) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Components[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetRevocationEventsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetRevocationEventsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetRevocationEventsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.To != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.To))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.From != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.From))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TargetHash) > 0 {
+		i -= len(m.TargetHash)
+		copy(dAtA[i:], m.TargetHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TargetHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetRevocationEventsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetRevocationEventsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetRevocationEventsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Events) > 0 {
+		for iNdEx := len(m.Events) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Events[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryComponentHealthRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryComponentHealthRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryComponentHealthRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StaleAfterSeconds != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StaleAfterSeconds))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryComponentHealthResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryComponentHealthResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryComponentHealthResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Problems) > 0 {
+		for iNdEx := len(m.Problems) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Problems[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Summary.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ComponentHealthSummary) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ComponentHealthSummary) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ComponentHealthSummary) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FailedVerification != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FailedVerification))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Unverified != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Unverified))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Revoked != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Revoked))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Stale != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Stale))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Active != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Active))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Total != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Total))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ComponentHealthIssue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ComponentHealthIssue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ComponentHealthIssue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n9, err9 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.LastVerifiedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LastVerifiedAt):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintQuery(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Issues) > 0 {
+		for iNdEx := len(m.Issues) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Issues[iNdEx])
+			copy(dAtA[i:], m.Issues[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Issues[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ComponentId) > 0 {
+		i -= len(m.ComponentId)
+		copy(dAtA[i:], m.ComponentId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ComponentId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetCustodyChainRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetCustodyChainRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetCustodyChainRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ComponentId) > 0 {
+		i -= len(m.ComponentId)
+		copy(dAtA[i:], m.ComponentId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ComponentId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetCustodyChainResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetCustodyChainResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetCustodyChainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Events) > 0 {
+		for iNdEx := len(m.Events) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Events[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySearchComponentsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySearchComponentsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySearchComponentsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.CategoryHash) > 0 {
+		i -= len(m.CategoryHash)
+		copy(dAtA[i:], m.CategoryHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CategoryHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ManufacturerHash) > 0 {
+		i -= len(m.ManufacturerHash)
+		copy(dAtA[i:], m.ManufacturerHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ManufacturerHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySearchComponentsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySearchComponentsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySearchComponentsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Components) > 0 {
+		for iNdEx := len(m.Components) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Components[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetVerificationHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetVerificationHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetVerificationHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ComponentId) > 0 {
+		i -= len(m.ComponentId)
+		copy(dAtA[i:], m.ComponentId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ComponentId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetVerificationHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetVerificationHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetVerificationHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Verifications) > 0 {
+		for iNdEx := len(m.Verifications) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Verifications[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetPairingRulesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetPairingRulesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetPairingRulesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SourceType) > 0 {
+		i -= len(m.SourceType)
+		copy(dAtA[i:], m.SourceType)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SourceType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetPairingRulesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetPairingRulesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetPairingRulesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Rules) > 0 {
+		for iNdEx := len(m.Rules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetComponentMetadataRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetComponentMetadataRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetComponentMetadataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ComponentId) > 0 {
+		i -= len(m.ComponentId)
+		copy(dAtA[i:], m.ComponentId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ComponentId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetComponentMetadataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetComponentMetadataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetComponentMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BackendStatus) > 0 {
+		i -= len(m.BackendStatus)
+		copy(dAtA[i:], m.BackendStatus)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BackendStatus)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BackendMetadata) > 0 {
+		i -= len(m.BackendMetadata)
+		copy(dAtA[i:], m.BackendMetadata)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BackendMetadata)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Component.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetComponentRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ComponentId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetComponentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Component.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetComponentVerificationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ComponentId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetComponentVerificationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Verification.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryCheckPairingAuthRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ComponentA)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ComponentB)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCheckPairingAuthResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ACanPairB {
+		n += 2
+	}
+	if m.BCanPairA {
+		n += 2
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListAuthorizedPartnersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ComponentId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListAuthorizedPartnersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AuthorizedComponents)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetComponentHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ComponentId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetComponentHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryListComponentsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListComponentsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Components) > 0 {
+		for _, e := range m.Components {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetRevocationEventsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TargetHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.From != 0 {
+		n += 1 + sovQuery(uint64(m.From))
+	}
+	if m.To != 0 {
+		n += 1 + sovQuery(uint64(m.To))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetRevocationEventsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Events) > 0 {
+		for _, e := range m.Events {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryComponentHealthRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StaleAfterSeconds != 0 {
+		n += 1 + sovQuery(uint64(m.StaleAfterSeconds))
+	}
+	return n
+}
+
+func (m *QueryComponentHealthResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Summary.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Problems) > 0 {
+		for _, e := range m.Problems {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ComponentHealthSummary) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Total != 0 {
+		n += 1 + sovQuery(uint64(m.Total))
+	}
+	if m.Active != 0 {
+		n += 1 + sovQuery(uint64(m.Active))
+	}
+	if m.Stale != 0 {
+		n += 1 + sovQuery(uint64(m.Stale))
+	}
+	if m.Revoked != 0 {
+		n += 1 + sovQuery(uint64(m.Revoked))
+	}
+	if m.Unverified != 0 {
+		n += 1 + sovQuery(uint64(m.Unverified))
+	}
+	if m.FailedVerification != 0 {
+		n += 1 + sovQuery(uint64(m.FailedVerification))
+	}
+	return n
+}
+
+func (m *ComponentHealthIssue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ComponentId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Issues) > 0 {
+		for _, s := range m.Issues {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LastVerifiedAt)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetCustodyChainRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ComponentId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetCustodyChainResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Events) > 0 {
+		for _, e := range m.Events {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QuerySearchComponentsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ManufacturerHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.CategoryHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySearchComponentsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Components) > 0 {
+		for _, e := range m.Components {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetVerificationHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ComponentId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetVerificationHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Verifications) > 0 {
+		for _, e := range m.Verifications {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetPairingRulesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SourceType)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetPairingRulesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rules) > 0 {
+		for _, e := range m.Rules {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetComponentMetadataRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ComponentId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetComponentMetadataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Component.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.BackendMetadata)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.BackendStatus)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetComponentRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetComponentRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetComponentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ComponentId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ComponentId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetComponentResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetComponentResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetComponentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Component", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Component.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetComponentVerificationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetComponentVerificationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetComponentVerificationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ComponentId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ComponentId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetComponentVerificationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetComponentVerificationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetComponentVerificationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Verification", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Verification.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCheckPairingAuthRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCheckPairingAuthRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCheckPairingAuthRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ComponentA", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ComponentA = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ComponentB", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ComponentB = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCheckPairingAuthResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCheckPairingAuthResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCheckPairingAuthResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ACanPairB", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ACanPairB = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BCanPairA", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BCanPairA = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListAuthorizedPartnersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListAuthorizedPartnersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListAuthorizedPartnersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ComponentId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ComponentId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListAuthorizedPartnersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListAuthorizedPartnersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListAuthorizedPartnersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthorizedComponents", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuthorizedComponents = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetComponentHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetComponentHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetComponentHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ComponentId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ComponentId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetComponentHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetComponentHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetComponentHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, ComponentHistoryEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListComponentsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListComponentsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListComponentsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListComponentsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListComponentsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListComponentsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Components", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Components = append(m.Components, Component{})
+			if err := m.Components[len(m.Components)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetRevocationEventsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetRevocationEventsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetRevocationEventsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			m.From = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.From |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			m.To = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.To |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetRevocationEventsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetRevocationEventsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetRevocationEventsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Events = append(m.Events, AnonymousRevocationEvent{})
+			if err := m.Events[len(m.Events)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryComponentHealthRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryComponentHealthRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryComponentHealthRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StaleAfterSeconds", wireType)
+			}
+			m.StaleAfterSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StaleAfterSeconds |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryComponentHealthResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryComponentHealthResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryComponentHealthResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Summary", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Summary.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Problems", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Problems = append(m.Problems, ComponentHealthIssue{})
+			if err := m.Problems[len(m.Problems)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ComponentHealthSummary) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ComponentHealthSummary: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ComponentHealthSummary: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			m.Total = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Total |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
+			}
+			m.Active = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Active |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stale", wireType)
+			}
+			m.Stale = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Stale |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revoked", wireType)
+			}
+			m.Revoked = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Revoked |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unverified", wireType)
+			}
+			m.Unverified = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Unverified |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedVerification", wireType)
+			}
+			m.FailedVerification = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FailedVerification |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ComponentHealthIssue) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ComponentHealthIssue: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ComponentHealthIssue: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ComponentId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ComponentId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Issues", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Issues = append(m.Issues, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastVerifiedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.LastVerifiedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *QueryListAuthorizedPartnersResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.AuthorizedComponents)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetCustodyChainRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1300,12 +6104,44 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetCustodyChainRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetCustodyChainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ComponentId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ComponentId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1327,7 +6163,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetCustodyChainResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1350,15 +6186,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetCustodyChainResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetCustodyChainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1385,7 +6221,8 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Events = append(m.Events, CustodyEvent{})
+			if err := m.Events[len(m.Events)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1410,7 +6247,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetComponentRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySearchComponentsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1433,15 +6270,79 @@ func (m *QueryGetComponentRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetComponentRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySearchComponentsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetComponentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySearchComponentsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ComponentId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ManufacturerHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ManufacturerHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CategoryHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CategoryHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1469,7 +6370,43 @@ func (m *QueryGetComponentRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ComponentId = string(dAtA[iNdEx:postIndex])
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1492,7 +6429,7 @@ func (m *QueryGetComponentRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetComponentResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySearchComponentsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1515,15 +6452,15 @@ func (m *QueryGetComponentResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetComponentResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySearchComponentsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetComponentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySearchComponentsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Component", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Components", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1550,7 +6487,44 @@ func (m *QueryGetComponentResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Component.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Components = append(m.Components, Component{})
+			if err := m.Components[len(m.Components)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1575,7 +6549,7 @@ func (m *QueryGetComponentResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetComponentVerificationRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetVerificationHistoryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1598,10 +6572,10 @@ func (m *QueryGetComponentVerificationRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetComponentVerificationRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetVerificationHistoryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetComponentVerificationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetVerificationHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1636,6 +6610,42 @@ func (m *QueryGetComponentVerificationRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.ComponentId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1657,7 +6667,7 @@ func (m *QueryGetComponentVerificationRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetComponentVerificationResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetVerificationHistoryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1680,15 +6690,15 @@ func (m *QueryGetComponentVerificationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetComponentVerificationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetVerificationHistoryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetComponentVerificationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetVerificationHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Verification", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Verifications", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1715,7 +6725,44 @@ func (m *QueryGetComponentVerificationResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Verification.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Verifications = append(m.Verifications, VerificationRecord{})
+			if err := m.Verifications[len(m.Verifications)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1740,7 +6787,7 @@ func (m *QueryGetComponentVerificationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCheckPairingAuthRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetPairingRulesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1763,15 +6810,15 @@ func (m *QueryCheckPairingAuthRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCheckPairingAuthRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetPairingRulesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCheckPairingAuthRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetPairingRulesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ComponentA", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceType", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1799,13 +6846,13 @@ func (m *QueryCheckPairingAuthRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ComponentA = string(dAtA[iNdEx:postIndex])
+			m.SourceType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ComponentB", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1815,23 +6862,27 @@ func (m *QueryCheckPairingAuthRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ComponentB = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1854,7 +6905,7 @@ func (m *QueryCheckPairingAuthRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCheckPairingAuthResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetPairingRulesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1877,17 +6928,17 @@ func (m *QueryCheckPairingAuthResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCheckPairingAuthResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetPairingRulesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCheckPairingAuthResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetPairingRulesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ACanPairB", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rules", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1897,37 +6948,31 @@ func (m *QueryCheckPairingAuthResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ACanPairB = bool(v != 0)
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BCanPairA", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			m.BCanPairA = bool(v != 0)
-		case 3:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rules = append(m.Rules, ComponentPairingRule{})
+			if err := m.Rules[len(m.Rules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1937,23 +6982,27 @@ func (m *QueryCheckPairingAuthResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reason = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1976,7 +7025,7 @@ func (m *QueryCheckPairingAuthResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryListAuthorizedPartnersRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetComponentMetadataRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1999,10 +7048,10 @@ func (m *QueryListAuthorizedPartnersRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryListAuthorizedPartnersRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetComponentMetadataRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryListAuthorizedPartnersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetComponentMetadataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2058,7 +7107,7 @@ func (m *QueryListAuthorizedPartnersRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryListAuthorizedPartnersResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetComponentMetadataResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2081,15 +7130,48 @@ func (m *QueryListAuthorizedPartnersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryListAuthorizedPartnersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetComponentMetadataResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryListAuthorizedPartnersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetComponentMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuthorizedComponents", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Component", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Component.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BackendMetadata", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2117,7 +7199,39 @@ func (m *QueryListAuthorizedPartnersResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AuthorizedComponents = string(dAtA[iNdEx:postIndex])
+			m.BackendMetadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BackendStatus", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BackendStatus = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -0,0 +1,19 @@
+package types
+
+// RevocationFilter bounds a revocation event listing by effective time, in
+// unix seconds. Both bounds are inclusive and zero leaves that side open.
+type RevocationFilter struct {
+	From int64
+	To   int64
+}
+
+// Contains reports whether a revocation effective at the given unix time falls within the filter
+func (f RevocationFilter) Contains(effectiveAt int64) bool {
+	if f.From != 0 && effectiveAt < f.From {
+		return false
+	}
+	if f.To != 0 && effectiveAt > f.To {
+		return false
+	}
+	return true
+}
@@ -6,6 +6,8 @@ import (
 	"encoding/hex"
 	"fmt"
 	"strings"
+
+	errorsmod "cosmossdk.io/errors"
 )
 
 // ComponentVerificationBackend defines the interface for off-chain component verification
@@ -18,11 +20,22 @@ type ComponentVerificationBackend interface {
 
 	// Privacy-focused methods for anonymous component operations
 	GenerateComponentHash(ctx context.Context, realComponentID string) (string, error)
+	// ResolveComponentHash returns the real component ID under "real_component_id" and its
+	// metadata under "metadata", or ErrComponentHashNotFound for a hash it did not issue
 	ResolveComponentHash(ctx context.Context, componentHash string) (map[string]interface{}, error)
 	VerifyComponentPairingWithHashes(ctx context.Context, componentHashA, componentHashB string) (bool, string, error)
 	GetAnonymousComponentMetadata(ctx context.Context, componentHash string) (map[string]interface{}, error)
 }
 
+// ResolvedComponentHash is what a component hash resolves to. RealComponentID
+// is only filled in for callers allowed to see it.
+type ResolvedComponentHash struct {
+	ComponentHash   string
+	ComponentType   string
+	Status          string
+	RealComponentID string
+}
+
 // MockMySQLBackend implements a simple mock MySQL backend for the race car demo
 type MockMySQLBackend struct {
 	// Simple pairing rules: componentA -> allowed components
@@ -118,7 +131,7 @@ func (m *MockMySQLBackend) GenerateComponentHash(ctx context.Context, realCompon
 func (m *MockMySQLBackend) ResolveComponentHash(ctx context.Context, componentHash string) (map[string]interface{}, error) {
 	realComponentID, exists := m.hashToComponent[componentHash]
 	if !exists {
-		return nil, fmt.Errorf("component hash not found: %s", componentHash)
+		return nil, errorsmod.Wrap(ErrComponentHashNotFound, componentHash)
 	}
 
 	// Return real component data (this would be restricted in production)
@@ -130,6 +130,9 @@ type MsgRegisterComponent struct {
 	ComponentId      string `protobuf:"bytes,2,opt,name=component_id,json=componentId,proto3" json:"component_id,omitempty"`
 	ComponentType    string `protobuf:"bytes,3,opt,name=component_type,json=componentType,proto3" json:"component_type,omitempty"`
 	ManufacturerData string `protobuf:"bytes,4,opt,name=manufacturer_data,json=manufacturerData,proto3" json:"manufacturer_data,omitempty"`
+	// dedupe returns the component already registered with the same type and
+	// manufacturer data instead of registering a second one.
+	Dedupe bool `protobuf:"varint,5,opt,name=dedupe,proto3" json:"dedupe,omitempty"`
 }
 
 func (m *MsgRegisterComponent) Reset()         { *m = MsgRegisterComponent{} }
@@ -193,11 +196,23 @@ func (m *MsgRegisterComponent) GetManufacturerData() string {
 	return ""
 }
 
+func (m *MsgRegisterComponent) GetDedupe() bool {
+	if m != nil {
+		return m.Dedupe
+	}
+	return false
+}
+
 // MsgRegisterComponentResponse defines the MsgRegisterComponentResponse message.
 type MsgRegisterComponentResponse struct {
 	ComponentIdentity string `protobuf:"bytes,1,opt,name=component_identity,json=componentIdentity,proto3" json:"component_identity,omitempty"`
 	LctId             string `protobuf:"bytes,2,opt,name=lct_id,json=lctId,proto3" json:"lct_id,omitempty"`
 	Status            string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	// created is false when dedupe matched an existing component.
+	Created bool `protobuf:"varint,4,opt,name=created,proto3" json:"created,omitempty"`
+	// component_id is the registered component, which differs from the
+	// requested ID when dedupe matched an existing component.
+	ComponentId string `protobuf:"bytes,5,opt,name=component_id,json=componentId,proto3" json:"component_id,omitempty"`
 }
 
 func (m *MsgRegisterComponentResponse) Reset()         { *m = MsgRegisterComponentResponse{} }
@@ -254,6 +269,20 @@ func (m *MsgRegisterComponentResponse) GetStatus() string {
 	return ""
 }
 
+func (m *MsgRegisterComponentResponse) GetCreated() bool {
+	if m != nil {
+		return m.Created
+	}
+	return false
+}
+
+func (m *MsgRegisterComponentResponse) GetComponentId() string {
+	if m != nil {
+		return m.ComponentId
+	}
+	return ""
+}
+
 // MsgUpdateAuthorization defines the MsgUpdateAuthorization message.
 type MsgUpdateAuthorization struct {
 	Creator     string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
@@ -356,6 +385,7 @@ var xxx_messageInfo_MsgUpdateAuthorizationResponse proto.InternalMessageInfo
 type MsgVerifyComponent struct {
 	Creator     string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
 	ComponentId string `protobuf:"bytes,2,opt,name=component_id,json=componentId,proto3" json:"component_id,omitempty"`
+	Context     string `protobuf:"bytes,3,opt,name=context,proto3" json:"context,omitempty"`
 }
 
 func (m *MsgVerifyComponent) Reset()         { *m = MsgVerifyComponent{} }
@@ -405,6 +435,13 @@ func (m *MsgVerifyComponent) GetComponentId() string {
 	return ""
 }
 
+func (m *MsgVerifyComponent) GetContext() string {
+	if m != nil {
+		return m.Context
+	}
+	return ""
+}
+
 // MsgVerifyComponentResponse defines the MsgVerifyComponentResponse message.
 type MsgVerifyComponentResponse struct {
 	IsValid       bool   `protobuf:"varint,1,opt,name=is_valid,json=isValid,proto3" json:"is_valid,omitempty"`
@@ -618,6 +655,9 @@ type MsgVerifyComponentPairingWithHashes struct {
 	ComponentHashA string `protobuf:"bytes,2,opt,name=component_hash_a,json=componentHashA,proto3" json:"component_hash_a,omitempty"`
 	ComponentHashB string `protobuf:"bytes,3,opt,name=component_hash_b,json=componentHashB,proto3" json:"component_hash_b,omitempty"`
 	Context        string `protobuf:"bytes,4,opt,name=context,proto3" json:"context,omitempty"`
+	// Result of an off-chain manufacturer check; the verifier must be one of
+	// the module's verification attestors. Without it the chain's own backend decides.
+	Attestation *PairingAttestation `protobuf:"bytes,5,opt,name=attestation,proto3" json:"attestation,omitempty"`
 }
 
 func (m *MsgVerifyComponentPairingWithHashes) Reset()         { *m = MsgVerifyComponentPairingWithHashes{} }
@@ -681,6 +721,66 @@ func (m *MsgVerifyComponentPairingWithHashes) GetContext() string {
 	return ""
 }
 
+func (m *MsgVerifyComponentPairingWithHashes) GetAttestation() *PairingAttestation {
+	if m != nil {
+		return m.Attestation
+	}
+	return nil
+}
+
+// PairingAttestation is a pairing verdict obtained off-chain
+type PairingAttestation struct {
+	CanPair bool   `protobuf:"varint,1,opt,name=can_pair,json=canPair,proto3" json:"can_pair,omitempty"`
+	Reason  string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *PairingAttestation) Reset()         { *m = PairingAttestation{} }
+func (m *PairingAttestation) String() string { return proto.CompactTextString(m) }
+func (*PairingAttestation) ProtoMessage()    {}
+func (*PairingAttestation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a911f899bc8456a8, []int{11}
+}
+func (m *PairingAttestation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PairingAttestation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PairingAttestation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PairingAttestation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PairingAttestation.Merge(m, src)
+}
+func (m *PairingAttestation) XXX_Size() int {
+	return m.Size()
+}
+func (m *PairingAttestation) XXX_DiscardUnknown() {
+	xxx_messageInfo_PairingAttestation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PairingAttestation proto.InternalMessageInfo
+
+func (m *PairingAttestation) GetCanPair() bool {
+	if m != nil {
+		return m.CanPair
+	}
+	return false
+}
+
+func (m *PairingAttestation) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
 // MsgVerifyComponentPairingWithHashesResponse defines the response for hash-based pairing
 type MsgVerifyComponentPairingWithHashesResponse struct {
 	CanPair    bool   `protobuf:"varint,1,opt,name=can_pair,json=canPair,proto3" json:"can_pair,omitempty"`
@@ -696,7 +796,7 @@ func (m *MsgVerifyComponentPairingWithHashesResponse) String() string {
 }
 func (*MsgVerifyComponentPairingWithHashesResponse) ProtoMessage() {}
 func (*MsgVerifyComponentPairingWithHashesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a911f899bc8456a8, []int{11}
+	return fileDescriptor_a911f899bc8456a8, []int{12}
 }
 func (m *MsgVerifyComponentPairingWithHashesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -762,7 +862,7 @@ func (m *MsgCreateAnonymousPairingAuthorization) Reset() {
 func (m *MsgCreateAnonymousPairingAuthorization) String() string { return proto.CompactTextString(m) }
 func (*MsgCreateAnonymousPairingAuthorization) ProtoMessage()    {}
 func (*MsgCreateAnonymousPairingAuthorization) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a911f899bc8456a8, []int{12}
+	return fileDescriptor_a911f899bc8456a8, []int{13}
 }
 func (m *MsgCreateAnonymousPairingAuthorization) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -848,7 +948,7 @@ func (m *MsgCreateAnonymousPairingAuthorizationResponse) String() string {
 }
 func (*MsgCreateAnonymousPairingAuthorizationResponse) ProtoMessage() {}
 func (*MsgCreateAnonymousPairingAuthorizationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a911f899bc8456a8, []int{13}
+	return fileDescriptor_a911f899bc8456a8, []int{14}
 }
 func (m *MsgCreateAnonymousPairingAuthorizationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -912,7 +1012,7 @@ func (m *MsgCreateAnonymousRevocationEvent) Reset()         { *m = MsgCreateAnon
 func (m *MsgCreateAnonymousRevocationEvent) String() string { return proto.CompactTextString(m) }
 func (*MsgCreateAnonymousRevocationEvent) ProtoMessage()    {}
 func (*MsgCreateAnonymousRevocationEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a911f899bc8456a8, []int{14}
+	return fileDescriptor_a911f899bc8456a8, []int{15}
 }
 func (m *MsgCreateAnonymousRevocationEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -998,7 +1098,7 @@ func (m *MsgCreateAnonymousRevocationEventResponse) String() string {
 }
 func (*MsgCreateAnonymousRevocationEventResponse) ProtoMessage() {}
 func (*MsgCreateAnonymousRevocationEventResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a911f899bc8456a8, []int{15}
+	return fileDescriptor_a911f899bc8456a8, []int{16}
 }
 func (m *MsgCreateAnonymousRevocationEventResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1058,7 +1158,7 @@ func (m *MsgGetAnonymousComponentMetadata) Reset()         { *m = MsgGetAnonymou
 func (m *MsgGetAnonymousComponentMetadata) String() string { return proto.CompactTextString(m) }
 func (*MsgGetAnonymousComponentMetadata) ProtoMessage()    {}
 func (*MsgGetAnonymousComponentMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a911f899bc8456a8, []int{16}
+	return fileDescriptor_a911f899bc8456a8, []int{17}
 }
 func (m *MsgGetAnonymousComponentMetadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1116,7 +1216,7 @@ func (m *MsgGetAnonymousComponentMetadataResponse) Reset() {
 func (m *MsgGetAnonymousComponentMetadataResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgGetAnonymousComponentMetadataResponse) ProtoMessage()    {}
 func (*MsgGetAnonymousComponentMetadataResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a911f899bc8456a8, []int{17}
+	return fileDescriptor_a911f899bc8456a8, []int{18}
 }
 func (m *MsgGetAnonymousComponentMetadataResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1180,6 +1280,121 @@ func (m *MsgGetAnonymousComponentMetadataResponse) GetLastVerified() string {
 	return ""
 }
 
+// MsgTransferComponent defines the MsgTransferComponent message. The creator
+// must be the component's current owner.
+type MsgTransferComponent struct {
+	Creator     string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	ComponentId string `protobuf:"bytes,2,opt,name=component_id,json=componentId,proto3" json:"component_id,omitempty"`
+	NewOwner    string `protobuf:"bytes,3,opt,name=new_owner,json=newOwner,proto3" json:"new_owner,omitempty"`
+	Reason      string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *MsgTransferComponent) Reset()         { *m = MsgTransferComponent{} }
+func (m *MsgTransferComponent) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferComponent) ProtoMessage()    {}
+func (*MsgTransferComponent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a911f899bc8456a8, []int{19}
+}
+func (m *MsgTransferComponent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferComponent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferComponent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTransferComponent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferComponent.Merge(m, src)
+}
+func (m *MsgTransferComponent) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferComponent) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferComponent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTransferComponent proto.InternalMessageInfo
+
+func (m *MsgTransferComponent) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgTransferComponent) GetComponentId() string {
+	if m != nil {
+		return m.ComponentId
+	}
+	return ""
+}
+
+func (m *MsgTransferComponent) GetNewOwner() string {
+	if m != nil {
+		return m.NewOwner
+	}
+	return ""
+}
+
+func (m *MsgTransferComponent) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+// MsgTransferComponentResponse defines the MsgTransferComponentResponse message.
+type MsgTransferComponentResponse struct {
+	Sequence uint64 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+}
+
+func (m *MsgTransferComponentResponse) Reset()         { *m = MsgTransferComponentResponse{} }
+func (m *MsgTransferComponentResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferComponentResponse) ProtoMessage()    {}
+func (*MsgTransferComponentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a911f899bc8456a8, []int{20}
+}
+func (m *MsgTransferComponentResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferComponentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferComponentResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTransferComponentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferComponentResponse.Merge(m, src)
+}
+func (m *MsgTransferComponentResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferComponentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferComponentResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTransferComponentResponse proto.InternalMessageInfo
+
+func (m *MsgTransferComponentResponse) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
 // Event types for component registry
 type EventComponentRegistered struct {
 	ComponentId    string `protobuf:"bytes,1,opt,name=component_id,json=componentId,proto3" json:"component_id,omitempty"`
@@ -1192,7 +1407,7 @@ func (m *EventComponentRegistered) Reset()         { *m = EventComponentRegister
 func (m *EventComponentRegistered) String() string { return proto.CompactTextString(m) }
 func (*EventComponentRegistered) ProtoMessage()    {}
 func (*EventComponentRegistered) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a911f899bc8456a8, []int{18}
+	return fileDescriptor_a911f899bc8456a8, []int{21}
 }
 func (m *EventComponentRegistered) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1259,7 +1474,7 @@ func (m *EventComponentVerified) Reset()         { *m = EventComponentVerified{}
 func (m *EventComponentVerified) String() string { return proto.CompactTextString(m) }
 func (*EventComponentVerified) ProtoMessage()    {}
 func (*EventComponentVerified) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a911f899bc8456a8, []int{19}
+	return fileDescriptor_a911f899bc8456a8, []int{22}
 }
 func (m *EventComponentVerified) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1309,6 +1524,74 @@ func (m *EventComponentVerified) GetVerifier() string {
 	return ""
 }
 
+type EventComponentTransferred struct {
+	ComponentId string `protobuf:"bytes,1,opt,name=component_id,json=componentId,proto3" json:"component_id,omitempty"`
+	FromOwner   string `protobuf:"bytes,2,opt,name=from_owner,json=fromOwner,proto3" json:"from_owner,omitempty"`
+	ToOwner     string `protobuf:"bytes,3,opt,name=to_owner,json=toOwner,proto3" json:"to_owner,omitempty"`
+	Sequence    uint64 `protobuf:"varint,4,opt,name=sequence,proto3" json:"sequence,omitempty"`
+}
+
+func (m *EventComponentTransferred) Reset()         { *m = EventComponentTransferred{} }
+func (m *EventComponentTransferred) String() string { return proto.CompactTextString(m) }
+func (*EventComponentTransferred) ProtoMessage()    {}
+func (*EventComponentTransferred) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a911f899bc8456a8, []int{23}
+}
+func (m *EventComponentTransferred) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventComponentTransferred) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventComponentTransferred.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventComponentTransferred) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventComponentTransferred.Merge(m, src)
+}
+func (m *EventComponentTransferred) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventComponentTransferred) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventComponentTransferred.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventComponentTransferred proto.InternalMessageInfo
+
+func (m *EventComponentTransferred) GetComponentId() string {
+	if m != nil {
+		return m.ComponentId
+	}
+	return ""
+}
+
+func (m *EventComponentTransferred) GetFromOwner() string {
+	if m != nil {
+		return m.FromOwner
+	}
+	return ""
+}
+
+func (m *EventComponentTransferred) GetToOwner() string {
+	if m != nil {
+		return m.ToOwner
+	}
+	return ""
+}
+
+func (m *EventComponentTransferred) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
 type EventAuthorizationUpdated struct {
 	ComponentId string `protobuf:"bytes,1,opt,name=component_id,json=componentId,proto3" json:"component_id,omitempty"`
 	Updater     string `protobuf:"bytes,2,opt,name=updater,proto3" json:"updater,omitempty"`
@@ -1318,7 +1601,7 @@ func (m *EventAuthorizationUpdated) Reset()         { *m = EventAuthorizationUpd
 func (m *EventAuthorizationUpdated) String() string { return proto.CompactTextString(m) }
 func (*EventAuthorizationUpdated) ProtoMessage()    {}
 func (*EventAuthorizationUpdated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a911f899bc8456a8, []int{20}
+	return fileDescriptor_a911f899bc8456a8, []int{24}
 }
 func (m *EventAuthorizationUpdated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1373,7 +1656,7 @@ func (m *EventAnonymousComponentRegistered) Reset()         { *m = EventAnonymou
 func (m *EventAnonymousComponentRegistered) String() string { return proto.CompactTextString(m) }
 func (*EventAnonymousComponentRegistered) ProtoMessage()    {}
 func (*EventAnonymousComponentRegistered) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a911f899bc8456a8, []int{21}
+	return fileDescriptor_a911f899bc8456a8, []int{25}
 }
 func (m *EventAnonymousComponentRegistered) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1441,7 +1724,7 @@ func (m *EventAnonymousPairingAuthorized) Reset()         { *m = EventAnonymousP
 func (m *EventAnonymousPairingAuthorized) String() string { return proto.CompactTextString(m) }
 func (*EventAnonymousPairingAuthorized) ProtoMessage()    {}
 func (*EventAnonymousPairingAuthorized) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a911f899bc8456a8, []int{22}
+	return fileDescriptor_a911f899bc8456a8, []int{26}
 }
 func (m *EventAnonymousPairingAuthorized) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1510,7 +1793,7 @@ func (m *EventAnonymousRevocationCreated) Reset()         { *m = EventAnonymousR
 func (m *EventAnonymousRevocationCreated) String() string { return proto.CompactTextString(m) }
 func (*EventAnonymousRevocationCreated) ProtoMessage()    {}
 func (*EventAnonymousRevocationCreated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a911f899bc8456a8, []int{23}
+	return fileDescriptor_a911f899bc8456a8, []int{27}
 }
 func (m *EventAnonymousRevocationCreated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1586,6 +1869,7 @@ func init() {
 	proto.RegisterType((*MsgRegisterAnonymousComponent)(nil), "racecarweb.componentregistry.v1.MsgRegisterAnonymousComponent")
 	proto.RegisterType((*MsgRegisterAnonymousComponentResponse)(nil), "racecarweb.componentregistry.v1.MsgRegisterAnonymousComponentResponse")
 	proto.RegisterType((*MsgVerifyComponentPairingWithHashes)(nil), "racecarweb.componentregistry.v1.MsgVerifyComponentPairingWithHashes")
+	proto.RegisterType((*PairingAttestation)(nil), "racecarweb.componentregistry.v1.PairingAttestation")
 	proto.RegisterType((*MsgVerifyComponentPairingWithHashesResponse)(nil), "racecarweb.componentregistry.v1.MsgVerifyComponentPairingWithHashesResponse")
 	proto.RegisterType((*MsgCreateAnonymousPairingAuthorization)(nil), "racecarweb.componentregistry.v1.MsgCreateAnonymousPairingAuthorization")
 	proto.RegisterType((*MsgCreateAnonymousPairingAuthorizationResponse)(nil), "racecarweb.componentregistry.v1.MsgCreateAnonymousPairingAuthorizationResponse")
@@ -1593,8 +1877,11 @@ func init() {
 	proto.RegisterType((*MsgCreateAnonymousRevocationEventResponse)(nil), "racecarweb.componentregistry.v1.MsgCreateAnonymousRevocationEventResponse")
 	proto.RegisterType((*MsgGetAnonymousComponentMetadata)(nil), "racecarweb.componentregistry.v1.MsgGetAnonymousComponentMetadata")
 	proto.RegisterType((*MsgGetAnonymousComponentMetadataResponse)(nil), "racecarweb.componentregistry.v1.MsgGetAnonymousComponentMetadataResponse")
+	proto.RegisterType((*MsgTransferComponent)(nil), "racecarweb.componentregistry.v1.MsgTransferComponent")
+	proto.RegisterType((*MsgTransferComponentResponse)(nil), "racecarweb.componentregistry.v1.MsgTransferComponentResponse")
 	proto.RegisterType((*EventComponentRegistered)(nil), "racecarweb.componentregistry.v1.EventComponentRegistered")
 	proto.RegisterType((*EventComponentVerified)(nil), "racecarweb.componentregistry.v1.EventComponentVerified")
+	proto.RegisterType((*EventComponentTransferred)(nil), "racecarweb.componentregistry.v1.EventComponentTransferred")
 	proto.RegisterType((*EventAuthorizationUpdated)(nil), "racecarweb.componentregistry.v1.EventAuthorizationUpdated")
 	proto.RegisterType((*EventAnonymousComponentRegistered)(nil), "racecarweb.componentregistry.v1.EventAnonymousComponentRegistered")
 	proto.RegisterType((*EventAnonymousPairingAuthorized)(nil), "racecarweb.componentregistry.v1.EventAnonymousPairingAuthorized")
@@ -1606,100 +1893,112 @@ func init() {
 }
 
 var fileDescriptor_a911f899bc8456a8 = []byte{
-	// 1476 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0xcf, 0x6f, 0x1b, 0xc5,
-	0x17, 0xcf, 0xb8, 0xf9, 0xf9, 0xe2, 0x24, 0xcd, 0xf6, 0x47, 0x9c, 0xfd, 0x7e, 0xe3, 0x24, 0x8e,
-	0xfa, 0x6d, 0xbe, 0x29, 0x8d, 0x69, 0x0b, 0x05, 0x15, 0x41, 0xe5, 0xa4, 0x50, 0x5a, 0x35, 0xa2,
-	0x72, 0xa1, 0x20, 0x0e, 0xac, 0xa6, 0xeb, 0xc9, 0x66, 0x25, 0x7b, 0xd7, 0xcc, 0x8c, 0x4d, 0x5c,
-	0x71, 0x28, 0x70, 0x02, 0x0e, 0xc0, 0x89, 0x0b, 0x47, 0x24, 0x7a, 0x41, 0x0a, 0x12, 0xea, 0x95,
-	0x0b, 0x48, 0xbd, 0xb5, 0xe2, 0x54, 0x09, 0x09, 0xa1, 0xf6, 0x90, 0x3f, 0x81, 0x2b, 0x9a, 0xd9,
-	0xdd, 0xd9, 0x5d, 0xef, 0x26, 0xde, 0x26, 0x0d, 0x97, 0x28, 0xfb, 0xe6, 0xcd, 0x7b, 0x9f, 0xf7,
-	0xfb, 0x8d, 0x61, 0x91, 0x62, 0x93, 0x98, 0x98, 0x7e, 0x44, 0x6e, 0x95, 0x4d, 0xb7, 0xd1, 0x74,
-	0x1d, 0xe2, 0x70, 0x4a, 0x2c, 0x9b, 0x71, 0xda, 0x29, 0xb7, 0xcf, 0x94, 0xf9, 0xe6, 0x72, 0x93,
-	0xba, 0xdc, 0xd5, 0x66, 0x43, 0xce, 0xe5, 0x04, 0xe7, 0x72, 0xfb, 0x8c, 0x3e, 0x89, 0x1b, 0xb6,
-	0xe3, 0x96, 0xe5, 0x5f, 0xef, 0x8e, 0x3e, 0x65, 0xba, 0xac, 0xe1, 0xb2, 0x72, 0x83, 0x59, 0x42,
-	0x56, 0x83, 0x59, 0xfe, 0xc1, 0xb4, 0x77, 0x60, 0xc8, 0xaf, 0xb2, 0xf7, 0xe1, 0x1f, 0x1d, 0xb5,
-	0x5c, 0xcb, 0xf5, 0xe8, 0xe2, 0x3f, 0x9f, 0xfa, 0x5c, 0x2f, 0x9c, 0x4d, 0x4c, 0x71, 0xc3, 0x97,
-	0x51, 0x7a, 0x84, 0x60, 0x62, 0x8d, 0x59, 0xef, 0x34, 0x6b, 0x98, 0x93, 0xeb, 0xf2, 0x44, 0x3b,
-	0x0f, 0x23, 0xb8, 0xc5, 0x37, 0x5c, 0x6a, 0xf3, 0x4e, 0x01, 0xcd, 0xa1, 0xc5, 0x91, 0x95, 0xc2,
-	0xef, 0x3f, 0x9f, 0x3e, 0xea, 0x2b, 0xaf, 0xd4, 0x6a, 0x94, 0x30, 0x76, 0x83, 0x53, 0xdb, 0xb1,
-	0xaa, 0x21, 0xab, 0x76, 0x15, 0x06, 0x3d, 0xd9, 0x85, 0xdc, 0x1c, 0x5a, 0x1c, 0x3d, 0x7b, 0x72,
-	0xb9, 0x87, 0x23, 0x96, 0x3d, 0x85, 0x2b, 0x23, 0xf7, 0xff, 0x9c, 0xed, 0xbb, 0xbb, 0xbd, 0xb5,
-	0x84, 0xaa, 0xbe, 0x84, 0x0b, 0x95, 0x4f, 0xb7, 0xb7, 0x96, 0x42, 0xd9, 0x5f, 0x6c, 0x6f, 0x2d,
-	0x45, 0xa4, 0x95, 0x37, 0x53, 0x4c, 0xeb, 0x32, 0xa3, 0x34, 0x0d, 0x53, 0x5d, 0xa4, 0x2a, 0x61,
-	0x4d, 0xd7, 0x61, 0xa4, 0xf4, 0x00, 0xc1, 0xd1, 0x35, 0x66, 0x55, 0xe5, 0x55, 0x42, 0x57, 0x03,
-	0x59, 0xda, 0x59, 0x18, 0x32, 0x29, 0xc1, 0xdc, 0xa5, 0x3d, 0x0d, 0x0f, 0x18, 0xb5, 0x79, 0xc8,
-	0x2b, 0x30, 0x86, 0x5d, 0x93, 0xc6, 0x8f, 0x54, 0x47, 0x15, 0xed, 0x4a, 0x4d, 0x3b, 0x01, 0xe3,
-	0x21, 0x0b, 0xef, 0x34, 0x49, 0xe1, 0x90, 0x64, 0x1a, 0x53, 0xd4, 0xb7, 0x3b, 0x4d, 0xa2, 0x9d,
-	0x82, 0xc9, 0x06, 0x76, 0x5a, 0xeb, 0xd8, 0xe4, 0x2d, 0x4a, 0xa8, 0x51, 0xc3, 0x1c, 0x17, 0xfa,
-	0x25, 0xe7, 0xe1, 0xe8, 0xc1, 0x25, 0xcc, 0xf1, 0x85, 0xbc, 0xf0, 0x50, 0x00, 0xa2, 0xf4, 0x31,
-	0xfc, 0x37, 0xcd, 0xa0, 0xc0, 0x62, 0xed, 0x34, 0x68, 0x51, 0x90, 0xc4, 0xe1, 0x2a, 0xb8, 0xd5,
-	0xc9, 0x08, 0x54, 0xef, 0x40, 0x3b, 0x06, 0x83, 0x75, 0x33, 0x62, 0xcd, 0x40, 0xdd, 0x14, 0x76,
-	0x1c, 0x87, 0x41, 0xc6, 0x31, 0x6f, 0x31, 0x1f, 0xbf, 0xff, 0x55, 0xfa, 0x0e, 0xc1, 0x71, 0xe5,
-	0xeb, 0x8a, 0x17, 0xb4, 0xdb, 0x98, 0xdb, 0xae, 0x73, 0x50, 0x1e, 0x9d, 0x01, 0x10, 0xc9, 0x61,
-	0xd0, 0x56, 0x9d, 0x04, 0x68, 0x64, 0xba, 0x54, 0x05, 0xa1, 0xcb, 0x39, 0x73, 0x50, 0x4c, 0x47,
-	0xa7, 0x12, 0xa2, 0x03, 0xda, 0x1a, 0xb3, 0x6e, 0x12, 0x6a, 0xaf, 0x77, 0x0e, 0x3a, 0x1b, 0xba,
-	0xc0, 0x7d, 0x00, 0x7a, 0x52, 0xb5, 0x8a, 0xdb, 0x34, 0x0c, 0xdb, 0xcc, 0x68, 0xe3, 0xba, 0x5d,
-	0x93, 0x18, 0x86, 0xab, 0x43, 0x36, 0xbb, 0x29, 0x3e, 0xe3, 0x49, 0x25, 0x53, 0x25, 0xd7, 0x95,
-	0x54, 0x22, 0x4f, 0x4a, 0x7f, 0x23, 0x98, 0x89, 0xa4, 0x46, 0xc5, 0x71, 0x9d, 0x4e, 0xc3, 0x6d,
-	0xb1, 0xfd, 0x99, 0xb9, 0x04, 0x93, 0x94, 0xe0, 0xba, 0x91, 0x62, 0xeb, 0x84, 0x38, 0x58, 0x8d,
-	0xc4, 0xea, 0x24, 0x4c, 0xc4, 0xd2, 0xda, 0xae, 0xf9, 0x01, 0x1b, 0x8f, 0x92, 0x53, 0xcb, 0xa4,
-	0x3f, 0xad, 0x4c, 0x0a, 0x30, 0x64, 0xba, 0x0e, 0x27, 0x9b, 0xbc, 0x30, 0x20, 0xcf, 0x83, 0xcf,
-	0x2e, 0xcf, 0xfe, 0x81, 0xe0, 0xc4, 0xae, 0x96, 0x2b, 0x2f, 0xc7, 0x14, 0x6f, 0x60, 0xb6, 0xe1,
-	0x57, 0x46, 0xa8, 0xf8, 0x4d, 0xcc, 0x36, 0x12, 0xf5, 0x29, 0x39, 0x73, 0xc9, 0xfa, 0x94, 0xcc,
-	0x0b, 0x30, 0x66, 0x62, 0x4e, 0x2c, 0x97, 0x76, 0x3c, 0x46, 0xcf, 0xe6, 0x7c, 0x40, 0x94, 0x4c,
-	0x61, 0x41, 0xf5, 0x47, 0x0b, 0x4a, 0x64, 0x11, 0xa7, 0x2d, 0xc6, 0x0d, 0xec, 0x98, 0x1b, 0x2e,
-	0xf5, 0xed, 0x1c, 0x95, 0xb4, 0x8a, 0x24, 0x89, 0xce, 0xbd, 0x90, 0x4c, 0x9c, 0xeb, 0xd8, 0x16,
-	0x81, 0x7a, 0xd7, 0xe6, 0x1b, 0x42, 0x01, 0x61, 0xda, 0x0b, 0x30, 0xdc, 0x16, 0x3c, 0x36, 0xe9,
-	0x1d, 0x5e, 0xc5, 0xa9, 0x2d, 0xc2, 0xe1, 0xb8, 0x47, 0x8c, 0x20, 0xbd, 0xc6, 0x63, 0x3e, 0xa9,
-	0xa4, 0x70, 0xde, 0x0a, 0xc2, 0x1b, 0xe3, 0x5c, 0x89, 0xc6, 0xad, 0x3f, 0x1e, 0xb7, 0x31, 0x11,
-	0x37, 0xa5, 0xbc, 0xf4, 0x09, 0x82, 0x53, 0x19, 0x4c, 0x8b, 0x16, 0x89, 0x89, 0x1d, 0xa3, 0x89,
-	0x6d, 0x1a, 0x14, 0x89, 0x89, 0x1d, 0xc1, 0x2f, 0x1c, 0x4c, 0x09, 0x66, 0xae, 0xe3, 0xa3, 0xf7,
-	0xbf, 0xb4, 0x59, 0xf0, 0x9c, 0x69, 0x30, 0xd3, 0xa5, 0x41, 0x3b, 0x06, 0x49, 0xba, 0x21, 0x28,
-	0xa5, 0x5f, 0x73, 0xf0, 0xbf, 0x35, 0x66, 0xad, 0x8a, 0x5c, 0x22, 0x2a, 0x75, 0x7c, 0x0c, 0xfb,
-	0x6f, 0x71, 0x07, 0xe1, 0xdf, 0xff, 0xc0, 0x88, 0x68, 0x87, 0x5e, 0xb6, 0x79, 0x1e, 0x1e, 0x16,
-	0x04, 0x99, 0x69, 0xe7, 0x61, 0x2a, 0x62, 0xb0, 0x41, 0xc9, 0x87, 0x2d, 0x9b, 0x92, 0x06, 0x71,
-	0x82, 0x22, 0x3a, 0x16, 0x1a, 0x5f, 0x0d, 0x0f, 0xb5, 0x32, 0x1c, 0xc1, 0x51, 0x6b, 0x8d, 0x3a,
-	0x69, 0x93, 0x7a, 0x61, 0x50, 0xde, 0xd1, 0x62, 0x47, 0xd7, 0xc4, 0x49, 0x57, 0x0d, 0xde, 0x41,
-	0xb0, 0x9c, 0xcd, 0x8d, 0x2a, 0x9a, 0x53, 0x30, 0x24, 0x5b, 0xbb, 0xdf, 0xf1, 0x46, 0xaa, 0x83,
-	0xe2, 0x33, 0x36, 0x7d, 0x72, 0xb1, 0x62, 0x99, 0x01, 0x20, 0x9b, 0x4d, 0x9b, 0x12, 0x66, 0x60,
-	0x1e, 0xcc, 0x02, 0x9f, 0x52, 0xe1, 0xa5, 0x6f, 0x73, 0x30, 0x9f, 0x84, 0x50, 0x25, 0x6d, 0xd7,
-	0x94, 0x8a, 0x5f, 0x6f, 0xef, 0xb5, 0x09, 0x8a, 0x24, 0xc2, 0xd4, 0x22, 0x3c, 0xda, 0x09, 0xc0,
-	0x23, 0x49, 0xa7, 0x9f, 0x84, 0x09, 0xaa, 0xf4, 0x44, 0x07, 0xff, 0x78, 0x48, 0x96, 0x2d, 0x6d,
-	0x01, 0xc6, 0x5a, 0xd4, 0x22, 0x8e, 0xd9, 0xf1, 0xfd, 0xeb, 0x85, 0x2f, 0xef, 0x13, 0xa5, 0x67,
-	0x3d, 0x69, 0x22, 0x7b, 0x8d, 0xa0, 0x87, 0xf8, 0xa1, 0x1b, 0xf7, 0xc8, 0xab, 0x3e, 0x35, 0x5a,
-	0x68, 0x83, 0xbb, 0x35, 0xc8, 0x2f, 0x11, 0xfc, 0xbf, 0xa7, 0x67, 0x54, 0x5c, 0x16, 0x60, 0x2c,
-	0x62, 0x8c, 0x8a, 0x4e, 0x3e, 0x24, 0xee, 0x12, 0xa3, 0x79, 0xc8, 0x93, 0xf5, 0x75, 0x62, 0x72,
-	0xbb, 0x4d, 0xc2, 0x28, 0x8d, 0x2a, 0x5a, 0x85, 0x97, 0xbe, 0x41, 0x30, 0xb7, 0xc6, 0xac, 0xcb,
-	0x84, 0x27, 0x3b, 0xf5, 0x1a, 0xe1, 0x58, 0x8c, 0x38, 0xb1, 0x9b, 0x8a, 0xd4, 0x25, 0xa2, 0xa1,
-	0xf7, 0xde, 0x4d, 0x15, 0x6b, 0x4a, 0x87, 0xcf, 0xa5, 0x74, 0xf8, 0x0b, 0xe3, 0x72, 0xed, 0x54,
-	0xd7, 0x4a, 0xbf, 0x21, 0x58, 0xec, 0x85, 0xe9, 0x69, 0xa7, 0x88, 0x06, 0xfd, 0x32, 0x13, 0x3c,
-	0x00, 0xf2, 0xff, 0x9d, 0x16, 0xab, 0xc4, 0x1c, 0xe8, 0x4f, 0xcc, 0x01, 0x11, 0x96, 0x3a, 0x66,
-	0xdc, 0xf0, 0xbb, 0x67, 0xcd, 0xcf, 0x89, 0xbc, 0x20, 0xde, 0xf4, 0x69, 0xa5, 0x1f, 0x10, 0x14,
-	0x64, 0x34, 0x23, 0xb3, 0xcf, 0x9b, 0x8a, 0xa4, 0x96, 0x58, 0x59, 0x50, 0x96, 0x05, 0x36, 0x97,
-	0x36, 0x99, 0x33, 0x4f, 0xfa, 0x42, 0x58, 0x6d, 0xc1, 0x28, 0xf0, 0x73, 0xd2, 0x85, 0xe3, 0x71,
-	0xa0, 0x81, 0x0d, 0x59, 0x60, 0xee, 0x94, 0x7d, 0x7a, 0x64, 0x06, 0x7a, 0x80, 0xc2, 0x61, 0xf3,
-	0x1e, 0x4c, 0x4b, 0x85, 0xb1, 0x66, 0xe4, 0xed, 0x8a, 0x99, 0x74, 0x16, 0x60, 0xa8, 0x25, 0xb9,
-	0xa9, 0xaf, 0x34, 0xf8, 0x2c, 0xdd, 0x43, 0x30, 0xef, 0x89, 0x4e, 0xd9, 0x3c, 0x94, 0xf7, 0x33,
-	0x66, 0x4d, 0x62, 0x9d, 0xc8, 0xa5, 0xac, 0x13, 0xa9, 0x0b, 0xca, 0xa1, 0x1d, 0x16, 0x94, 0x9d,
-	0x63, 0x70, 0x17, 0xc1, 0x6c, 0x1c, 0x78, 0x57, 0xc3, 0x26, 0xb5, 0x9d, 0xbb, 0xf4, 0x41, 0x6d,
-	0x0e, 0xe9, 0x50, 0x1f, 0x24, 0xa0, 0x86, 0xed, 0xcb, 0xeb, 0x6b, 0xb5, 0x6c, 0x8d, 0xeb, 0x5f,
-	0xee, 0xe5, 0x11, 0x8b, 0x06, 0x62, 0x16, 0x9d, 0xfd, 0x69, 0x14, 0x0e, 0xad, 0x31, 0x4b, 0xbb,
-	0x0d, 0xf9, 0xd8, 0xab, 0xfc, 0xf9, 0x9e, 0xaf, 0xe9, 0xae, 0xd7, 0xae, 0xfe, 0xf2, 0xd3, 0xde,
-	0x50, 0x9d, 0xec, 0x73, 0x04, 0x93, 0xc9, 0xc7, 0xf1, 0x8b, 0x59, 0xe4, 0x25, 0xae, 0xe9, 0xaf,
-	0xee, 0xe9, 0x9a, 0xc2, 0xf2, 0x15, 0x82, 0x23, 0x69, 0x0f, 0xcb, 0x97, 0xb2, 0x5b, 0x17, 0xbb,
-	0xa8, 0x5f, 0xdc, 0xe3, 0x45, 0x85, 0xe8, 0x33, 0x04, 0x13, 0xdd, 0x4f, 0xc5, 0x73, 0x59, 0x84,
-	0x76, 0x5d, 0xd2, 0x5f, 0xd9, 0xc3, 0x25, 0x85, 0xe2, 0x7b, 0x04, 0xfa, 0x2e, 0x8f, 0xba, 0xd7,
-	0x9e, 0xc6, 0xeb, 0xc9, 0xfb, 0xfa, 0x1b, 0xfb, 0xbb, 0xaf, 0x60, 0xde, 0x43, 0x30, 0xd7, 0xf3,
-	0x8d, 0x72, 0x69, 0x0f, 0x8e, 0x48, 0x48, 0xd1, 0xaf, 0x3d, 0x0b, 0x29, 0x0a, 0xf8, 0x2f, 0x08,
-	0x16, 0xb2, 0x6c, 0xff, 0x97, 0xb3, 0x68, 0xcd, 0x20, 0x48, 0x7f, 0xeb, 0x19, 0x09, 0x52, 0x16,
-	0x6c, 0x21, 0x28, 0xf6, 0xd8, 0x7a, 0x57, 0xf6, 0xa0, 0xb3, 0x4b, 0x86, 0x7e, 0x75, 0xff, 0x32,
-	0x14, 0xe4, 0x1f, 0x11, 0xcc, 0xec, 0xbe, 0x00, 0x56, 0xb2, 0x68, 0xdb, 0x55, 0x84, 0x7e, 0x65,
-	0xdf, 0x22, 0x02, 0xbc, 0xfa, 0xc0, 0x9d, 0xed, 0xad, 0x25, 0xb4, 0x72, 0xf1, 0xfe, 0xe3, 0x22,
-	0x7a, 0xf8, 0xb8, 0x88, 0xfe, 0x7a, 0x5c, 0x44, 0x5f, 0x3f, 0x29, 0xf6, 0x3d, 0x7c, 0x52, 0xec,
-	0x7b, 0xf4, 0xa4, 0xd8, 0xf7, 0xfe, 0x09, 0x5f, 0xd5, 0xe9, 0x9d, 0x7e, 0xb5, 0x14, 0x83, 0x84,
-	0xdd, 0x1a, 0x94, 0xbf, 0xc6, 0x9e, 0xfb, 0x27, 0x00, 0x00, 0xff, 0xff, 0x7d, 0x25, 0xb0, 0x2a,
-	0x65, 0x16, 0x00, 0x00,
+	// 1674 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x59, 0xcd, 0x6f, 0x1b, 0xc7,
+	0x15, 0xd7, 0xd0, 0x14, 0x45, 0x3e, 0x51, 0x92, 0xb5, 0xb6, 0x25, 0x6a, 0x5b, 0x51, 0xd2, 0x0a,
+	0xae, 0x55, 0xb9, 0x16, 0x6b, 0xb9, 0x76, 0x0b, 0x15, 0xad, 0x41, 0xc9, 0xad, 0x6b, 0xc3, 0x82,
+	0x8d, 0xf5, 0x47, 0x8b, 0x1e, 0xb2, 0x18, 0x2f, 0x47, 0xd4, 0x02, 0xe4, 0x2e, 0x33, 0x3b, 0xa4,
+	0x44, 0x9f, 0x9c, 0xe4, 0x94, 0xe4, 0x90, 0x04, 0x09, 0x90, 0x4b, 0x8e, 0x01, 0xe2, 0x4b, 0x10,
+	0x1d, 0x02, 0x03, 0x39, 0x04, 0xb9, 0x24, 0x80, 0x91, 0x4b, 0x8c, 0x9c, 0x0c, 0x04, 0x08, 0x02,
+	0xfb, 0xa0, 0x3f, 0x21, 0xd7, 0x60, 0x66, 0x3f, 0xb8, 0xcb, 0x5d, 0x91, 0x2b, 0xc9, 0xf2, 0x45,
+	0xe0, 0xbc, 0x79, 0xef, 0xcd, 0xfb, 0xbd, 0xf7, 0xe6, 0xbd, 0x37, 0x2b, 0x58, 0xa0, 0x58, 0x27,
+	0x3a, 0xa6, 0x5b, 0xe4, 0x7e, 0x49, 0xb7, 0xea, 0x0d, 0xcb, 0x24, 0x26, 0xa3, 0xa4, 0x6a, 0xd8,
+	0x8c, 0xb6, 0x4b, 0xad, 0xf3, 0x25, 0xb6, 0xbd, 0xd4, 0xa0, 0x16, 0xb3, 0xa4, 0x99, 0x0e, 0xe7,
+	0x52, 0x84, 0x73, 0xa9, 0x75, 0x5e, 0x1e, 0xc7, 0x75, 0xc3, 0xb4, 0x4a, 0xe2, 0xaf, 0x23, 0x23,
+	0x4f, 0xea, 0x96, 0x5d, 0xb7, 0xec, 0x52, 0xdd, 0xae, 0x72, 0x5d, 0x75, 0xbb, 0xea, 0x6e, 0x4c,
+	0x39, 0x1b, 0x9a, 0x58, 0x95, 0x9c, 0x85, 0xbb, 0x75, 0xb2, 0x6a, 0x55, 0x2d, 0x87, 0xce, 0x7f,
+	0xb9, 0xd4, 0x3f, 0xf5, 0xb3, 0xb3, 0x81, 0x29, 0xae, 0xbb, 0x3a, 0x94, 0x67, 0x08, 0xc6, 0xd6,
+	0xed, 0xea, 0xdd, 0x46, 0x05, 0x33, 0x72, 0x4b, 0xec, 0x48, 0x97, 0x20, 0x87, 0x9b, 0x6c, 0xd3,
+	0xa2, 0x06, 0x6b, 0x17, 0xd0, 0x2c, 0x5a, 0xc8, 0xad, 0x16, 0x7e, 0xfc, 0xf2, 0xdc, 0x49, 0xf7,
+	0xf0, 0x72, 0xa5, 0x42, 0x89, 0x6d, 0xdf, 0x66, 0xd4, 0x30, 0xab, 0x6a, 0x87, 0x55, 0xba, 0x0e,
+	0x19, 0x47, 0x77, 0x21, 0x35, 0x8b, 0x16, 0x86, 0x97, 0xcf, 0x2c, 0xf5, 0x71, 0xc4, 0x92, 0x73,
+	0xe0, 0x6a, 0xee, 0xc9, 0xcf, 0x33, 0x03, 0x8f, 0x76, 0x77, 0x16, 0x91, 0xea, 0x6a, 0x58, 0x29,
+	0xbf, 0xb9, 0xbb, 0xb3, 0xd8, 0xd1, 0xfd, 0xce, 0xee, 0xce, 0x62, 0x40, 0x5b, 0x69, 0x3b, 0x06,
+	0x5a, 0x17, 0x0c, 0x65, 0x0a, 0x26, 0xbb, 0x48, 0x2a, 0xb1, 0x1b, 0x96, 0x69, 0x13, 0x65, 0x17,
+	0xc1, 0xc9, 0x75, 0xbb, 0xaa, 0x0a, 0x51, 0x42, 0xd7, 0x3c, 0x5d, 0xd2, 0x32, 0x0c, 0xe9, 0x94,
+	0x60, 0x66, 0xd1, 0xbe, 0xc0, 0x3d, 0x46, 0x69, 0x0e, 0xf2, 0xbe, 0x31, 0x9a, 0x51, 0x11, 0xe0,
+	0x73, 0xea, 0xb0, 0x4f, 0xbb, 0x56, 0x91, 0x4e, 0xc3, 0x68, 0x87, 0x85, 0xb5, 0x1b, 0xa4, 0x70,
+	0x4c, 0x30, 0x8d, 0xf8, 0xd4, 0x3b, 0xed, 0x06, 0x91, 0xce, 0xc2, 0x78, 0x1d, 0x9b, 0xcd, 0x0d,
+	0xac, 0xb3, 0x26, 0x25, 0x54, 0xab, 0x60, 0x86, 0x0b, 0x69, 0xc1, 0x79, 0x3c, 0xb8, 0x71, 0x05,
+	0x33, 0x2c, 0x4d, 0x40, 0xa6, 0x42, 0x2a, 0xcd, 0x06, 0x29, 0x0c, 0xce, 0xa2, 0x85, 0xac, 0xea,
+	0xae, 0x56, 0xf2, 0xdc, 0x73, 0x9e, 0x71, 0xca, 0x57, 0x08, 0x7e, 0x1f, 0x87, 0xd4, 0x73, 0x85,
+	0x74, 0x0e, 0xa4, 0xa0, 0xf5, 0xc4, 0x64, 0x7e, 0xd4, 0xd5, 0xf1, 0x00, 0x06, 0x67, 0x43, 0x3a,
+	0x05, 0x99, 0x9a, 0x1e, 0x80, 0x39, 0x58, 0xd3, 0x39, 0xc0, 0x09, 0xc8, 0xd8, 0x0c, 0xb3, 0xa6,
+	0xed, 0x02, 0x73, 0x57, 0x52, 0xc1, 0xf5, 0x27, 0xa9, 0x08, 0x1c, 0x59, 0xd5, 0x5b, 0x46, 0xbc,
+	0x36, 0x18, 0xf1, 0x9a, 0xf2, 0x09, 0x82, 0x09, 0x3f, 0x82, 0x65, 0x27, 0x15, 0x1e, 0x60, 0x66,
+	0x58, 0xe6, 0x51, 0xc5, 0x69, 0x1a, 0x80, 0xa7, 0x9c, 0x46, 0x9b, 0x35, 0xe2, 0x41, 0x11, 0x49,
+	0xa8, 0x72, 0x42, 0x97, 0x6b, 0x67, 0xa1, 0x18, 0x6f, 0x9d, 0x9f, 0x66, 0x1f, 0x22, 0x90, 0xd6,
+	0xed, 0xea, 0x3d, 0x42, 0x8d, 0x8d, 0xf6, 0x91, 0x27, 0x19, 0xf7, 0xb5, 0x65, 0x32, 0xb2, 0xcd,
+	0x5c, 0xcb, 0xbd, 0x65, 0x97, 0xdd, 0xaf, 0x81, 0x1c, 0x35, 0xca, 0xcf, 0x87, 0x29, 0xc8, 0x1a,
+	0xb6, 0xd6, 0xc2, 0x35, 0xa3, 0x22, 0xac, 0xcb, 0xaa, 0x43, 0x86, 0x7d, 0x8f, 0x2f, 0xc3, 0x59,
+	0x2c, 0x72, 0x33, 0xd5, 0x95, 0xc5, 0x3c, 0x31, 0x95, 0x5f, 0x11, 0x4c, 0x07, 0x52, 0xae, 0x6c,
+	0x5a, 0x66, 0xbb, 0x6e, 0x35, 0xed, 0xc3, 0x39, 0x60, 0x11, 0xc6, 0x29, 0xc1, 0x35, 0x2d, 0xc6,
+	0x0b, 0x63, 0x7c, 0x63, 0x2d, 0xe0, 0x89, 0x33, 0x30, 0x16, 0xba, 0x47, 0x46, 0xc5, 0xf5, 0xc8,
+	0x68, 0x90, 0x1c, 0x7b, 0x2f, 0xd3, 0x71, 0xf7, 0x32, 0xe0, 0xd9, 0xc1, 0x5e, 0x9e, 0xfd, 0x09,
+	0xc1, 0xe9, 0x9e, 0xc8, 0x7d, 0x2f, 0x87, 0x0e, 0xde, 0xc4, 0xf6, 0xa6, 0x7b, 0xe3, 0x3a, 0x07,
+	0xff, 0x07, 0xdb, 0x9b, 0x91, 0x82, 0x20, 0x38, 0x53, 0xd1, 0x82, 0x20, 0x98, 0xe7, 0x61, 0x44,
+	0xc7, 0x8c, 0x54, 0x2d, 0xda, 0x76, 0x18, 0x1d, 0xcc, 0x79, 0x8f, 0x28, 0x98, 0x3a, 0x17, 0x35,
+	0x1d, 0xba, 0xa8, 0x73, 0x90, 0x67, 0xb4, 0x69, 0x33, 0x0d, 0x9b, 0xfa, 0xa6, 0x45, 0xbd, 0xeb,
+	0x28, 0x68, 0x65, 0x41, 0x52, 0xbe, 0x48, 0xc1, 0x7c, 0x34, 0x71, 0x6e, 0x61, 0x83, 0x07, 0xea,
+	0xbf, 0x06, 0xdb, 0xe4, 0x07, 0x10, 0x5b, 0xfa, 0x0b, 0x64, 0x5b, 0x9c, 0xc7, 0x20, 0xfd, 0xc3,
+	0xeb, 0x73, 0x4a, 0x0b, 0x70, 0x3c, 0xec, 0x11, 0xcd, 0x4b, 0xaf, 0xd1, 0x90, 0x4f, 0xca, 0x31,
+	0x9c, 0xf7, 0xbd, 0xf0, 0x86, 0x38, 0x57, 0x83, 0x71, 0x4b, 0x87, 0xe2, 0x26, 0xdd, 0x85, 0x61,
+	0xcc, 0x18, 0xe1, 0xe0, 0x0d, 0xcb, 0x14, 0x68, 0x87, 0x97, 0x2f, 0x24, 0xe8, 0x57, 0x02, 0x6c,
+	0xb9, 0x23, 0xaa, 0x06, 0xf5, 0xac, 0x8c, 0xf0, 0x74, 0xf0, 0x31, 0x29, 0x57, 0x41, 0x8a, 0x4a,
+	0xf0, 0x1b, 0xa6, 0x63, 0x53, 0x6b, 0x60, 0x83, 0x7a, 0x37, 0x4c, 0xc7, 0x26, 0x67, 0xe4, 0xd1,
+	0xa1, 0x04, 0xdb, 0x96, 0xe9, 0x42, 0x77, 0x57, 0xca, 0x1b, 0x08, 0xce, 0x26, 0x70, 0x7d, 0xf0,
+	0x12, 0xef, 0xf3, 0x08, 0x69, 0x06, 0x9c, 0x60, 0x6b, 0xb6, 0x6e, 0x51, 0xaf, 0x3f, 0x81, 0x20,
+	0xdd, 0xe6, 0x14, 0xe5, 0xdb, 0x14, 0xfc, 0x61, 0xdd, 0xae, 0xae, 0x89, 0xfa, 0xed, 0xa7, 0xb6,
+	0x87, 0xef, 0xd0, 0xd5, 0xf9, 0x28, 0xe2, 0xff, 0x3b, 0xc8, 0xf1, 0x4a, 0xee, 0xdc, 0x06, 0x27,
+	0x03, 0xb2, 0x9c, 0x20, 0x6e, 0xc2, 0x25, 0x98, 0x0c, 0x00, 0xd6, 0x28, 0x79, 0xbd, 0x69, 0x50,
+	0x52, 0x27, 0xa6, 0x77, 0xc9, 0x4f, 0x75, 0xc0, 0xab, 0x9d, 0x4d, 0xa9, 0x04, 0x27, 0x70, 0x10,
+	0xad, 0x56, 0x23, 0x2d, 0x52, 0x2b, 0x64, 0x84, 0x8c, 0x14, 0xda, 0xba, 0xc1, 0x77, 0xba, 0x6a,
+	0xc4, 0x43, 0x04, 0x4b, 0xc9, 0xdc, 0xe8, 0x47, 0x73, 0x12, 0x86, 0x44, 0x57, 0x72, 0x2b, 0x72,
+	0x4e, 0xcd, 0xf0, 0x65, 0xa8, 0xeb, 0xa6, 0x42, 0x97, 0x79, 0x1a, 0x80, 0x6c, 0x37, 0x0c, 0x4a,
+	0x6c, 0x0d, 0x7b, 0xcd, 0x20, 0xe7, 0x52, 0xca, 0x4c, 0xf9, 0x38, 0x05, 0x73, 0x51, 0x13, 0x54,
+	0xd2, 0xb2, 0x74, 0x71, 0xf0, 0xbf, 0x5a, 0x07, 0x2d, 0xd2, 0x3c, 0x89, 0x30, 0xad, 0x12, 0x16,
+	0xac, 0x54, 0xe0, 0x90, 0x84, 0xd3, 0xcf, 0xc0, 0x18, 0xf5, 0xcf, 0x09, 0x4e, 0x42, 0xa3, 0x1d,
+	0xb2, 0x28, 0xb9, 0xf3, 0x30, 0xd2, 0xa4, 0x55, 0x62, 0xea, 0x6d, 0xd7, 0xbf, 0x4e, 0xf8, 0xf2,
+	0x2e, 0x51, 0x78, 0xd6, 0xd1, 0xc6, 0xb3, 0x57, 0xf3, 0x6a, 0x9c, 0x1b, 0xba, 0x51, 0x87, 0xbc,
+	0xe6, 0x52, 0x83, 0x85, 0x20, 0xd3, 0xab, 0x80, 0xbf, 0x8b, 0xe0, 0x8f, 0x7d, 0x3d, 0xe3, 0xc7,
+	0x65, 0x1e, 0x46, 0x02, 0x60, 0xfc, 0xe8, 0xe4, 0x3b, 0xc4, 0x1e, 0x31, 0x9a, 0x83, 0x3c, 0xd9,
+	0xd8, 0x20, 0x3a, 0x33, 0x5a, 0xa4, 0x13, 0xa5, 0x61, 0x9f, 0x56, 0x66, 0xca, 0x07, 0x08, 0x66,
+	0xd7, 0xed, 0xea, 0x55, 0xc2, 0xa2, 0x9d, 0x64, 0x9d, 0x30, 0xcc, 0x5b, 0x30, 0x1f, 0xd6, 0x79,
+	0xea, 0x12, 0xde, 0x70, 0xfa, 0x0f, 0xeb, 0x3e, 0x6b, 0x4c, 0x07, 0x4a, 0xc5, 0x74, 0xa0, 0x95,
+	0x51, 0x31, 0x87, 0xfb, 0x62, 0xca, 0x77, 0x08, 0x16, 0xfa, 0xd9, 0xb4, 0xdf, 0x2e, 0x27, 0x41,
+	0x5a, 0x64, 0x82, 0x63, 0x80, 0xf8, 0xbd, 0xe7, 0x40, 0xd9, 0xdd, 0xa7, 0xd2, 0x91, 0x3e, 0xc5,
+	0xc3, 0x52, 0xc3, 0x36, 0xd3, 0xdc, 0x32, 0xec, 0x8d, 0x96, 0x79, 0x4e, 0xbc, 0xe7, 0xd2, 0x94,
+	0xef, 0x9d, 0x17, 0xc0, 0x1d, 0x8a, 0x4d, 0x7b, 0xe3, 0x15, 0xbc, 0x00, 0x2e, 0x42, 0xce, 0x24,
+	0x5b, 0x9a, 0xb5, 0x65, 0x12, 0xea, 0x40, 0xea, 0xd5, 0x15, 0x4d, 0xb2, 0x75, 0x93, 0x73, 0x06,
+	0xaa, 0x75, 0x3a, 0x58, 0xad, 0xbb, 0xd2, 0x76, 0x45, 0xcc, 0xf8, 0x11, 0x2c, 0x7e, 0x1c, 0x64,
+	0xc8, 0xda, 0x3c, 0x82, 0xa6, 0x4e, 0x04, 0xa8, 0xb4, 0xea, 0xaf, 0x95, 0xcf, 0x10, 0x14, 0x44,
+	0x5a, 0x07, 0xc4, 0x9c, 0xf1, 0x25, 0x66, 0x48, 0x47, 0x49, 0x9e, 0x36, 0xa9, 0xb8, 0x11, 0x2a,
+	0xf1, 0x48, 0x56, 0xe8, 0xf8, 0xdf, 0xeb, 0xd9, 0x2e, 0x4a, 0x0b, 0x26, 0xc2, 0x86, 0x7a, 0xc1,
+	0x4c, 0x62, 0xe6, 0x5e, 0xd7, 0x50, 0x0e, 0x0c, 0x2b, 0x8e, 0x41, 0x9d, 0xf6, 0xfd, 0x11, 0x82,
+	0xa9, 0xf0, 0x89, 0x9e, 0x8b, 0x13, 0xfa, 0x66, 0x1a, 0x60, 0x83, 0x5a, 0x75, 0x37, 0xea, 0xce,
+	0xc1, 0x39, 0x4e, 0x71, 0x82, 0x3b, 0x05, 0x59, 0x66, 0x05, 0x53, 0x42, 0x1d, 0x62, 0x96, 0xb3,
+	0x15, 0x8c, 0x58, 0xba, 0x2b, 0x62, 0xff, 0x73, 0xad, 0x0a, 0x35, 0x0b, 0xe7, 0x19, 0x92, 0xc8,
+	0xaa, 0x02, 0x0c, 0x35, 0x05, 0xb7, 0x67, 0x92, 0xb7, 0x54, 0x1e, 0x23, 0x98, 0x73, 0x54, 0xc7,
+	0x4c, 0xae, 0x7e, 0x52, 0x24, 0xbc, 0xd5, 0x91, 0x71, 0x34, 0x15, 0x33, 0x8e, 0xc6, 0x0e, 0xb8,
+	0xc7, 0xf6, 0x18, 0x70, 0xf7, 0x4e, 0x8d, 0x47, 0x08, 0x66, 0xc2, 0x86, 0x77, 0x35, 0x54, 0x52,
+	0xd9, 0xbb, 0x8b, 0x1e, 0xd5, 0xe4, 0x19, 0x6f, 0xea, 0x0f, 0x11, 0x53, 0x3b, 0xed, 0x65, 0xcd,
+	0x7d, 0x1b, 0x27, 0x6a, 0x2c, 0xaf, 0xb8, 0xd7, 0x06, 0x10, 0x0d, 0x86, 0x10, 0x2d, 0x7f, 0x9d,
+	0x87, 0x63, 0xeb, 0x76, 0x55, 0x7a, 0x00, 0xf9, 0xd0, 0x67, 0xa4, 0x3f, 0xf7, 0x1d, 0xa7, 0xbb,
+	0x3e, 0xcf, 0xc8, 0x7f, 0xdb, 0xaf, 0x84, 0x5f, 0xe1, 0xde, 0x46, 0x30, 0x1e, 0xfd, 0x9a, 0x73,
+	0x31, 0x89, 0xbe, 0x88, 0x98, 0xfc, 0x8f, 0x03, 0x89, 0xf9, 0xb6, 0xbc, 0x87, 0xe0, 0x44, 0xdc,
+	0x37, 0x8b, 0xbf, 0x26, 0x47, 0x17, 0x12, 0x94, 0x2f, 0x1f, 0x50, 0xd0, 0xb7, 0xe8, 0x2d, 0x04,
+	0x63, 0xdd, 0x1f, 0x21, 0x2e, 0x24, 0x51, 0xda, 0x25, 0x24, 0xff, 0xfd, 0x00, 0x42, 0xa1, 0x18,
+	0x45, 0xfb, 0x6d, 0xa2, 0x18, 0x45, 0xc4, 0x92, 0xc5, 0x68, 0xef, 0x8e, 0xf8, 0x29, 0x02, 0xb9,
+	0xc7, 0x07, 0x8a, 0x7f, 0xee, 0x27, 0x03, 0xa2, 0xf2, 0xf2, 0xbf, 0x0f, 0x27, 0xef, 0x9b, 0xf9,
+	0x18, 0xc1, 0x6c, 0xdf, 0xf7, 0xf6, 0x95, 0x03, 0x04, 0x25, 0xa2, 0x45, 0xbe, 0xf1, 0x32, 0xb4,
+	0xf8, 0x86, 0x7f, 0x83, 0x60, 0x3e, 0xc9, 0x4b, 0xf1, 0x6a, 0x92, 0x53, 0x13, 0x28, 0x92, 0x6f,
+	0xbe, 0x24, 0x45, 0x3e, 0x82, 0x1d, 0x04, 0xc5, 0x3e, 0x2f, 0xa4, 0xd5, 0x03, 0x9c, 0xd9, 0xa5,
+	0x43, 0xbe, 0x7e, 0x78, 0x1d, 0xbe, 0xc9, 0x9f, 0x23, 0x98, 0xee, 0xfd, 0x58, 0x28, 0x27, 0x39,
+	0xad, 0xa7, 0x0a, 0xf9, 0xda, 0xa1, 0x55, 0x78, 0xf6, 0xca, 0x83, 0x0f, 0x77, 0x77, 0x16, 0xd1,
+	0xea, 0xe5, 0x27, 0xcf, 0x8b, 0xe8, 0xe9, 0xf3, 0x22, 0xfa, 0xe5, 0x79, 0x11, 0xbd, 0xff, 0xa2,
+	0x38, 0xf0, 0xf4, 0x45, 0x71, 0xe0, 0xd9, 0x8b, 0xe2, 0xc0, 0xff, 0x4f, 0xbb, 0x47, 0x9d, 0xdb,
+	0xeb, 0x93, 0x3f, 0x6f, 0x6a, 0xf6, 0xfd, 0x8c, 0xf8, 0x57, 0xc6, 0x85, 0xdf, 0x02, 0x00, 0x00,
+	0xff, 0xff, 0x62, 0x9e, 0x08, 0x49, 0xa2, 0x19, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1723,6 +2022,8 @@ type MsgClient interface {
 	UpdateAuthorization(ctx context.Context, in *MsgUpdateAuthorization, opts ...grpc.CallOption) (*MsgUpdateAuthorizationResponse, error)
 	// VerifyComponent defines the VerifyComponent RPC.
 	VerifyComponent(ctx context.Context, in *MsgVerifyComponent, opts ...grpc.CallOption) (*MsgVerifyComponentResponse, error)
+	// TransferComponent hands a component from its current owner to another account.
+	TransferComponent(ctx context.Context, in *MsgTransferComponent, opts ...grpc.CallOption) (*MsgTransferComponentResponse, error)
 	// Privacy-focused message types
 	RegisterAnonymousComponent(ctx context.Context, in *MsgRegisterAnonymousComponent, opts ...grpc.CallOption) (*MsgRegisterAnonymousComponentResponse, error)
 	VerifyComponentPairingWithHashes(ctx context.Context, in *MsgVerifyComponentPairingWithHashes, opts ...grpc.CallOption) (*MsgVerifyComponentPairingWithHashesResponse, error)
@@ -1775,6 +2076,15 @@ func (c *msgClient) VerifyComponent(ctx context.Context, in *MsgVerifyComponent,
 	return out, nil
 }
 
+func (c *msgClient) TransferComponent(ctx context.Context, in *MsgTransferComponent, opts ...grpc.CallOption) (*MsgTransferComponentResponse, error) {
+	out := new(MsgTransferComponentResponse)
+	err := c.cc.Invoke(ctx, "/racecarweb.componentregistry.v1.Msg/TransferComponent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) RegisterAnonymousComponent(ctx context.Context, in *MsgRegisterAnonymousComponent, opts ...grpc.CallOption) (*MsgRegisterAnonymousComponentResponse, error) {
 	out := new(MsgRegisterAnonymousComponentResponse)
 	err := c.cc.Invoke(ctx, "/racecarweb.componentregistry.v1.Msg/RegisterAnonymousComponent", in, out, opts...)
@@ -1831,6 +2141,8 @@ type MsgServer interface {
 	UpdateAuthorization(context.Context, *MsgUpdateAuthorization) (*MsgUpdateAuthorizationResponse, error)
 	// VerifyComponent defines the VerifyComponent RPC.
 	VerifyComponent(context.Context, *MsgVerifyComponent) (*MsgVerifyComponentResponse, error)
+	// TransferComponent hands a component from its current owner to another account.
+	TransferComponent(context.Context, *MsgTransferComponent) (*MsgTransferComponentResponse, error)
 	// Privacy-focused message types
 	RegisterAnonymousComponent(context.Context, *MsgRegisterAnonymousComponent) (*MsgRegisterAnonymousComponentResponse, error)
 	VerifyComponentPairingWithHashes(context.Context, *MsgVerifyComponentPairingWithHashes) (*MsgVerifyComponentPairingWithHashesResponse, error)
@@ -1855,6 +2167,9 @@ func (*UnimplementedMsgServer) UpdateAuthorization(ctx context.Context, req *Msg
 func (*UnimplementedMsgServer) VerifyComponent(ctx context.Context, req *MsgVerifyComponent) (*MsgVerifyComponentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method VerifyComponent not implemented")
 }
+func (*UnimplementedMsgServer) TransferComponent(ctx context.Context, req *MsgTransferComponent) (*MsgTransferComponentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferComponent not implemented")
+}
 func (*UnimplementedMsgServer) RegisterAnonymousComponent(ctx context.Context, req *MsgRegisterAnonymousComponent) (*MsgRegisterAnonymousComponentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RegisterAnonymousComponent not implemented")
 }
@@ -1947,6 +2262,24 @@ func _Msg_VerifyComponent_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_TransferComponent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransferComponent)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TransferComponent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/racecarweb.componentregistry.v1.Msg/TransferComponent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TransferComponent(ctx, req.(*MsgTransferComponent))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_RegisterAnonymousComponent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgRegisterAnonymousComponent)
 	if err := dec(in); err != nil {
@@ -2058,6 +2391,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "VerifyComponent",
 			Handler:    _Msg_VerifyComponent_Handler,
 		},
+		{
+			MethodName: "TransferComponent",
+			Handler:    _Msg_TransferComponent_Handler,
+		},
 		{
 			MethodName: "RegisterAnonymousComponent",
 			Handler:    _Msg_RegisterAnonymousComponent_Handler,
@@ -2166,6 +2503,16 @@ func (m *MsgRegisterComponent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Dedupe {
+		i--
+		if m.Dedupe {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
 	if len(m.ManufacturerData) > 0 {
 		i -= len(m.ManufacturerData)
 		copy(dAtA[i:], m.ManufacturerData)
@@ -2217,6 +2564,23 @@ func (m *MsgRegisterComponentResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	_ = i
 	var l int
 	_ = l
+	if len(m.ComponentId) > 0 {
+		i -= len(m.ComponentId)
+		copy(dAtA[i:], m.ComponentId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ComponentId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Created {
+		i--
+		if m.Created {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.Status) > 0 {
 		i -= len(m.Status)
 		copy(dAtA[i:], m.Status)
@@ -2328,6 +2692,13 @@ func (m *MsgVerifyComponent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Context) > 0 {
+		i -= len(m.Context)
+		copy(dAtA[i:], m.Context)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Context)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.ComponentId) > 0 {
 		i -= len(m.ComponentId)
 		copy(dAtA[i:], m.ComponentId)
@@ -2521,6 +2892,18 @@ func (m *MsgVerifyComponentPairingWithHashes) MarshalToSizedBuffer(dAtA []byte)
 	_ = i
 	var l int
 	_ = l
+	if m.Attestation != nil {
+		{
+			size, err := m.Attestation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.Context) > 0 {
 		i -= len(m.Context)
 		copy(dAtA[i:], m.Context)
@@ -2552,6 +2935,46 @@ func (m *MsgVerifyComponentPairingWithHashes) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
+func (m *PairingAttestation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PairingAttestation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PairingAttestation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.CanPair {
+		i--
+		if m.CanPair {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgVerifyComponentPairingWithHashesResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2912,6 +3335,85 @@ func (m *MsgGetAnonymousComponentMetadataResponse) MarshalToSizedBuffer(dAtA []b
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgTransferComponent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferComponent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferComponent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.NewOwner) > 0 {
+		i -= len(m.NewOwner)
+		copy(dAtA[i:], m.NewOwner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewOwner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ComponentId) > 0 {
+		i -= len(m.ComponentId)
+		copy(dAtA[i:], m.ComponentId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ComponentId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferComponentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferComponentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferComponentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *EventComponentRegistered) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3007,6 +3509,55 @@ func (m *EventComponentVerified) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *EventComponentTransferred) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventComponentTransferred) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventComponentTransferred) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ToOwner) > 0 {
+		i -= len(m.ToOwner)
+		copy(dAtA[i:], m.ToOwner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ToOwner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.FromOwner) > 0 {
+		i -= len(m.FromOwner)
+		copy(dAtA[i:], m.FromOwner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FromOwner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ComponentId) > 0 {
+		i -= len(m.ComponentId)
+		copy(dAtA[i:], m.ComponentId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ComponentId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *EventAuthorizationUpdated) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3261,6 +3812,9 @@ func (m *MsgRegisterComponent) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if m.Dedupe {
+		n += 2
+	}
 	return n
 }
 
@@ -3282,6 +3836,13 @@ func (m *MsgRegisterComponentResponse) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if m.Created {
+		n += 2
+	}
+	l = len(m.ComponentId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -3329,6 +3890,10 @@ func (m *MsgVerifyComponent) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	l = len(m.Context)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -3428,6 +3993,26 @@ func (m *MsgVerifyComponentPairingWithHashes) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if m.Attestation != nil {
+		l = m.Attestation.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *PairingAttestation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CanPair {
+		n += 2
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -3605,6 +4190,43 @@ func (m *MsgGetAnonymousComponentMetadataResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgTransferComponent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ComponentId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewOwner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgTransferComponentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		n += 1 + sovTx(uint64(m.Sequence))
+	}
+	return n
+}
+
 func (m *EventComponentRegistered) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3651,6 +4273,30 @@ func (m *EventComponentVerified) Size() (n int) {
 	return n
 }
 
+func (m *EventComponentTransferred) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ComponentId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.FromOwner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ToOwner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovTx(uint64(m.Sequence))
+	}
+	return n
+}
+
 func (m *EventAuthorizationUpdated) Size() (n int) {
 	if m == nil {
 		return 0
@@ -4075,6 +4721,26 @@ func (m *MsgRegisterComponent) Unmarshal(dAtA []byte) error {
 			}
 			m.ManufacturerData = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dedupe", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Dedupe = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4221,24 +4887,76 @@ func (m *MsgRegisterComponentResponse) Unmarshal(dAtA []byte) error {
 			}
 			m.Status = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Created = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ComponentId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ComponentId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
 	return nil
 }
@@ -4531,6 +5249,38 @@ func (m *MsgVerifyComponent) Unmarshal(dAtA []byte) error {
 			}
 			m.ComponentId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Context", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Context = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -5231,6 +5981,144 @@ func (m *MsgVerifyComponentPairingWithHashes) Unmarshal(dAtA []byte) error {
 			}
 			m.Context = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Attestation == nil {
+				m.Attestation = &PairingAttestation{}
+			}
+			if err := m.Attestation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PairingAttestation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PairingAttestation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PairingAttestation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CanPair", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CanPair = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -6486,7 +7374,7 @@ func (m *MsgGetAnonymousComponentMetadataResponse) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *EventComponentRegistered) Unmarshal(dAtA []byte) error {
+func (m *MsgTransferComponent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6509,15 +7397,15 @@ func (m *EventComponentRegistered) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventComponentRegistered: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTransferComponent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventComponentRegistered: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTransferComponent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ComponentId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6545,11 +7433,11 @@ func (m *EventComponentRegistered) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ComponentId = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ComponentType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ComponentId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6577,11 +7465,11 @@ func (m *EventComponentRegistered) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ComponentType = string(dAtA[iNdEx:postIndex])
+			m.ComponentId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ManufacturerId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewOwner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6609,11 +7497,11 @@ func (m *EventComponentRegistered) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ManufacturerId = string(dAtA[iNdEx:postIndex])
+			m.NewOwner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6641,7 +7529,7 @@ func (m *EventComponentRegistered) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6664,7 +7552,7 @@ func (m *EventComponentRegistered) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventComponentVerified) Unmarshal(dAtA []byte) error {
+func (m *MsgTransferComponentResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6687,17 +7575,17 @@ func (m *EventComponentVerified) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventComponentVerified: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTransferComponentResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventComponentVerified: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTransferComponentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ComponentId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
 			}
-			var stringLen uint64
+			m.Sequence = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6707,14 +7595,261 @@ func (m *EventComponentVerified) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Sequence |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventComponentRegistered) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventComponentRegistered: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventComponentRegistered: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ComponentId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ComponentId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ComponentType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ComponentType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ManufacturerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ManufacturerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventComponentVerified) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventComponentVerified: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventComponentVerified: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ComponentId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
 			}
 			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
@@ -6810,6 +7945,171 @@ func (m *EventComponentVerified) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventComponentTransferred) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventComponentTransferred: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventComponentTransferred: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ComponentId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ComponentId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromOwner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromOwner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToOwner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToOwner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *EventAuthorizationUpdated) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2,10 +2,15 @@ package keeper
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"racecar-web/x/energycycle/types"
 )
@@ -88,12 +93,13 @@ func (k Keeper) DischargeAtpToken(ctx context.Context, atpTokenID, operationID s
 	}
 
 	// Create ADP token
-	adpTokenID := fmt.Sprintf("adp-%s-%d", atpTokenID, time.Now().Unix())
+	dischargedAt := sdk.UnwrapSDKContext(ctx).BlockTime().Unix()
+	adpTokenID := fmt.Sprintf("adp-%s-%d", atpTokenID, dischargedAt)
 	adpToken := &types.RelationshipAdpToken{
 		TokenId:          adpTokenID,
 		OriginalAtpId:    atpTokenID,
 		LctId:            atpToken.LctId,
-		DischargedAt:     time.Now().Unix(),
+		DischargedAt:     dischargedAt,
 		ValueScore:       v3Score.String(),
 		ConfirmationData: fmt.Sprintf("discharged_at_block_%d", blockHeight),
 		EnergyEfficiency: dischargeEfficiency.String(),
@@ -287,7 +293,27 @@ func (k Keeper) GetAdpToken(ctx context.Context, tokenID string) (types.Relation
 	return k.RelationshipAdpTokens.Get(ctx, tokenID)
 }
 
-// GetEnergyOperation retrieves an energy operation by ID
+// GetEnergyOperation retrieves an energy operation by ID, returning
+// ErrOperationNotFound for an unknown ID
 func (k Keeper) GetEnergyOperation(ctx context.Context, operationID string) (types.EnergyOperation, error) {
-	return k.EnergyOperations.Get(ctx, operationID)
+	operation, err := k.EnergyOperations.Get(ctx, operationID)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.EnergyOperation{}, errorsmod.Wrap(types.ErrOperationNotFound, operationID)
+	}
+	if err != nil {
+		return types.EnergyOperation{}, fmt.Errorf("failed to get energy operation: %w", err)
+	}
+	return operation, nil
+}
+
+// ListEnergyOperationsPaginated retrieves one page of energy operations, ordered by operation ID
+func (k Keeper) ListEnergyOperationsPaginated(ctx context.Context, pageReq *query.PageRequest) ([]types.EnergyOperation, *query.PageResponse, error) {
+	operations, pageRes, err := query.CollectionPaginate(ctx, k.EnergyOperations, pageReq,
+		func(_ string, operation types.EnergyOperation) (types.EnergyOperation, error) {
+			return operation, nil
+		})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to paginate energy operations: %w", err)
+	}
+	return operations, pageRes, nil
 }
@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"racecar-web/x/energycycle/types"
+)
+
+// CalculateEfficiency computes the ratio of energy an operation delivered to
+// the energy it drew, stores it as the operation's energy efficiency and
+// emits an energy_efficiency_calculated event. An operation without energy
+// drawn or without a recorded output has no efficiency and is left unchanged.
+func (k Keeper) CalculateEfficiency(ctx context.Context, operationID string) (float64, error) {
+	operation, err := k.GetEnergyOperation(ctx, operationID)
+	if err != nil {
+		return 0, err
+	}
+
+	efficiency, err := operationEfficiency(operation)
+	if err != nil {
+		return 0, err
+	}
+
+	operation.EnergyEfficiency = efficiency.String()
+	operation.Version++
+	if err := k.SetEnergyOperation(ctx, operation); err != nil {
+		return 0, fmt.Errorf("failed to update energy operation: %w", err)
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent("energy_efficiency_calculated",
+			sdk.NewAttribute("operation_id", operationID),
+			sdk.NewAttribute("energy_in", operation.EnergyIn),
+			sdk.NewAttribute("energy_out", operation.EnergyOut),
+			sdk.NewAttribute("efficiency", operation.EnergyEfficiency),
+		),
+	)
+
+	return efficiency.Float64()
+}
+
+// operationEfficiency divides an operation's energy out by its energy in,
+// refusing rather than dividing by zero
+func operationEfficiency(operation types.EnergyOperation) (math.LegacyDec, error) {
+	if operation.EnergyIn == "" {
+		return math.LegacyDec{}, errorsmod.Wrapf(types.ErrNoEfficiency, "operation %s has no energy input", operation.OperationId)
+	}
+	energyIn, err := math.LegacyNewDecFromStr(operation.EnergyIn)
+	if err != nil {
+		return math.LegacyDec{}, errorsmod.Wrapf(types.ErrNoEfficiency, "operation %s has an invalid energy input %q", operation.OperationId, operation.EnergyIn)
+	}
+	if !energyIn.IsPositive() {
+		return math.LegacyDec{}, errorsmod.Wrapf(types.ErrNoEfficiency, "operation %s drew no energy", operation.OperationId)
+	}
+
+	if operation.EnergyOut == "" {
+		return math.LegacyDec{}, errorsmod.Wrapf(types.ErrNoEfficiency, "operation %s has not recorded its energy output", operation.OperationId)
+	}
+	energyOut, err := math.LegacyNewDecFromStr(operation.EnergyOut)
+	if err != nil || energyOut.IsNegative() {
+		return math.LegacyDec{}, errorsmod.Wrapf(types.ErrNoEfficiency, "operation %s has an invalid energy output %q", operation.OperationId, operation.EnergyOut)
+	}
+
+	return energyOut.Quo(energyIn), nil
+}
@@ -0,0 +1,98 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"racecar-web/x/energycycle/keeper"
+	"racecar-web/x/energycycle/types"
+)
+
+func TestCalculateEfficiency(t *testing.T) {
+	f := initFixture(t)
+	setOperation := func(id, energyIn, energyOut string) {
+		require.NoError(t, f.keeper.EnergyOperations.Set(f.ctx, id, types.EnergyOperation{
+			OperationId: id,
+			Status:      types.StatusCompleted,
+			EnergyIn:    energyIn,
+			EnergyOut:   energyOut,
+		}))
+	}
+	setOperation("op-95", "100.0", "95.0")
+	setOperation("op-idle", "0", "0")
+	setOperation("op-unmeasured", "100.0", "")
+
+	efficiency, err := f.keeper.CalculateEfficiency(f.ctx, "op-95")
+	require.NoError(t, err)
+	require.InDelta(t, 0.95, efficiency, 1e-9)
+
+	op, err := f.keeper.EnergyOperations.Get(f.ctx, "op-95")
+	require.NoError(t, err)
+	require.Equal(t, "0.950000000000000000", op.EnergyEfficiency)
+
+	var emitted bool
+	for _, event := range sdk.UnwrapSDKContext(f.ctx).EventManager().Events() {
+		if event.Type == "energy_efficiency_calculated" {
+			emitted = true
+		}
+	}
+	require.True(t, emitted)
+
+	// Zero input is reported, not divided by
+	_, err = f.keeper.CalculateEfficiency(f.ctx, "op-idle")
+	require.ErrorIs(t, err, types.ErrNoEfficiency)
+	_, err = f.keeper.CalculateEfficiency(f.ctx, "op-unmeasured")
+	require.ErrorIs(t, err, types.ErrNoEfficiency)
+	_, err = f.keeper.CalculateEfficiency(f.ctx, "op-missing")
+	require.ErrorIs(t, err, types.ErrOperationNotFound)
+
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	resp, err := qs.GetOperationEfficiency(f.ctx, &types.QueryGetOperationEfficiencyRequest{OperationId: "op-95"})
+	require.NoError(t, err)
+	require.Equal(t, "0.950000000000000000", resp.Efficiency)
+	_, err = qs.GetOperationEfficiency(f.ctx, &types.QueryGetOperationEfficiencyRequest{OperationId: "op-idle"})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	_, err = qs.GetOperationEfficiency(f.ctx, &types.QueryGetOperationEfficiencyRequest{OperationId: "op-missing"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestTransferRecordsEfficiency(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	creator, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+
+	fundLct(t, f, "atp-1", "lct-PACK", "100")
+	for _, id := range []string{"op-1", "op-2"} {
+		require.NoError(t, f.keeper.EnergyOperations.Set(f.ctx, id, types.EnergyOperation{
+			OperationId:   id,
+			SourceLct:     "lct-PACK",
+			TargetLct:     "lct-MC",
+			EnergyAmount:  "40",
+			EnergyIn:      "40",
+			OperationType: types.OperationTypeTransfer,
+			Status:        types.StatusCreated,
+			Creator:       creator,
+		}))
+	}
+
+	// 2 of the 40 drawn are lost on the way
+	resp, err := ms.ExecuteEnergyTransfer(f.ctx, &types.MsgExecuteEnergyTransfer{Creator: creator, OperationId: "op-1", EnergyOut: "38"})
+	require.NoError(t, err)
+	require.Equal(t, "60.000000000000000000", resp.SourceBalance)
+	require.Equal(t, "38.000000000000000000", resp.TargetBalance)
+	op, err := f.keeper.EnergyOperations.Get(f.ctx, "op-1")
+	require.NoError(t, err)
+	require.Equal(t, "0.950000000000000000", op.EnergyEfficiency)
+
+	// Without a measurement the whole amount arrives
+	_, err = ms.ExecuteEnergyTransfer(f.ctx, &types.MsgExecuteEnergyTransfer{Creator: creator, OperationId: "op-2"})
+	require.NoError(t, err)
+	op, err = f.keeper.EnergyOperations.Get(f.ctx, "op-2")
+	require.NoError(t, err)
+	require.Equal(t, "1.000000000000000000", op.EnergyEfficiency)
+}
@@ -0,0 +1,44 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"racecar-web/x/energycycle/keeper"
+	"racecar-web/x/energycycle/types"
+)
+
+func TestGetEnergyOperation(t *testing.T) {
+	f := initFixture(t)
+	stored := types.EnergyOperation{
+		OperationId:   "op-1",
+		SourceLct:     "lct-PACK",
+		TargetLct:     "lct-MC",
+		EnergyAmount:  "40",
+		OperationType: types.OperationTypeTransfer,
+		Status:        types.StatusCreated,
+		Timestamp:     1752484904,
+		BlockHeight:   12,
+	}
+	require.NoError(t, f.keeper.EnergyOperations.Set(f.ctx, stored.OperationId, stored))
+
+	operation, err := f.keeper.GetEnergyOperation(f.ctx, "op-1")
+	require.NoError(t, err)
+	require.Equal(t, stored, operation)
+
+	_, err = f.keeper.GetEnergyOperation(f.ctx, "op-missing")
+	require.ErrorIs(t, err, types.ErrOperationNotFound)
+
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	resp, err := qs.GetEnergyOperation(f.ctx, &types.QueryGetEnergyOperationRequest{OperationId: "op-1"})
+	require.NoError(t, err)
+	require.Equal(t, stored, resp.Operation)
+
+	_, err = qs.GetEnergyOperation(f.ctx, &types.QueryGetEnergyOperationRequest{OperationId: "op-missing"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	_, err = qs.GetEnergyOperation(f.ctx, &types.QueryGetEnergyOperationRequest{})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
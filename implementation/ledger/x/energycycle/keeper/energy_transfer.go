@@ -0,0 +1,134 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"racecar-web/x/energycycle/types"
+)
+
+// transferEnergy moves the operation's energy amount from the source LCT's
+// balance to the target's. The source's active ATP tokens are debited oldest
+// ID first, splitting the last one when it holds more than is still owed, and
+// the target is credited with a new ATP token for the energy that arrived:
+// the operation's energy out when measured, otherwise the whole amount.
+func (k Keeper) transferEnergy(ctx context.Context, operation types.EnergyOperation) error {
+	amount, err := math.LegacyNewDecFromStr(operation.EnergyAmount)
+	if err != nil || !amount.IsPositive() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid energy amount %q", operation.EnergyAmount)
+	}
+	delivered := amount
+	if operation.EnergyOut != "" {
+		delivered, err = math.LegacyNewDecFromStr(operation.EnergyOut)
+		if err != nil || delivered.IsNegative() || delivered.GT(amount) {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "energy out %q must be between 0 and the amount %s", operation.EnergyOut, amount)
+		}
+	}
+
+	available, err := k.CalculateEnergyBalance(ctx, operation.SourceLct)
+	if err != nil {
+		return err
+	}
+	if available.LT(amount) {
+		return errorsmod.Wrapf(types.ErrInsufficientBalance, "%s holds %s, the transfer needs %s", operation.SourceLct, available, amount)
+	}
+
+	if err := k.debitAtp(ctx, operation.SourceLct, amount); err != nil {
+		return err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	credit := types.RelationshipAtpToken{
+		TokenId:             fmt.Sprintf("atp-transfer-%s", operation.OperationId),
+		LctId:               operation.TargetLct,
+		EnergyAmount:        delivered.String(),
+		CreatedAt:           sdkCtx.BlockTime().Unix(),
+		OperationId:         operation.OperationId,
+		Status:              types.AtpStatusActive,
+		RelationshipContext: fmt.Sprintf("energy_transfer:%s", operation.SourceLct),
+		ExpirationBlock:     sdkCtx.BlockHeight() + 1000, // as for tokens created by CreateAtpToken
+		TrustScore:          operation.TrustScore,
+		EfficiencyRating:    operation.EnergyEfficiency,
+		Version:             1,
+	}
+	if err := k.RelationshipAtpTokens.Set(ctx, credit.TokenId, credit); err != nil {
+		return fmt.Errorf("failed to store ATP token: %w", err)
+	}
+
+	sourceBalance, err := k.CalculateEnergyBalance(ctx, operation.SourceLct)
+	if err != nil {
+		return err
+	}
+	targetBalance, err := k.CalculateEnergyBalance(ctx, operation.TargetLct)
+	if err != nil {
+		return err
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent("energy_transferred",
+			sdk.NewAttribute("operation_id", operation.OperationId),
+			sdk.NewAttribute("source_lct", operation.SourceLct),
+			sdk.NewAttribute("target_lct", operation.TargetLct),
+			sdk.NewAttribute("amount", amount.String()),
+			sdk.NewAttribute("delivered", delivered.String()),
+			sdk.NewAttribute("source_balance", sourceBalance.String()),
+			sdk.NewAttribute("target_balance", targetBalance.String()),
+		),
+	)
+	return nil
+}
+
+// canExecuteTransfer reports whether signer may execute operation: the
+// account that created it, or the trust anchor of the LCT the energy leaves
+func (k Keeper) canExecuteTransfer(ctx context.Context, signer string, operation types.EnergyOperation) bool {
+	if operation.Creator != "" && operation.Creator == signer {
+		return true
+	}
+	if k.lctmanagerKeeper == nil {
+		return false
+	}
+	source, found := k.lctmanagerKeeper.GetLinkedContextToken(ctx, operation.SourceLct)
+	return found && source.TrustAnchor != "" && source.TrustAnchor == signer
+}
+
+// debitAtp takes amount out of the active ATP tokens of an LCT. Tokens used
+// up are marked discharged; the caller has checked the balance covers amount.
+func (k Keeper) debitAtp(ctx context.Context, lctID string, amount math.LegacyDec) error {
+	tokens, err := k.getActiveAtpTokensForLct(ctx, lctID)
+	if err != nil {
+		return fmt.Errorf("failed to get active ATP tokens: %w", err)
+	}
+
+	owed := amount
+	for _, token := range tokens {
+		if !owed.IsPositive() {
+			break
+		}
+		held, err := math.LegacyNewDecFromStr(token.EnergyAmount)
+		if err != nil {
+			continue // not counted in the balance either
+		}
+
+		if held.LTE(owed) {
+			token.Status = types.AtpStatusDischarged
+			owed = owed.Sub(held)
+		} else {
+			token.EnergyAmount = held.Sub(owed).String()
+			owed = math.LegacyZeroDec()
+		}
+		token.Version++
+		if err := k.RelationshipAtpTokens.Set(ctx, token.TokenId, token); err != nil {
+			return fmt.Errorf("failed to update ATP token: %w", err)
+		}
+	}
+
+	if owed.IsPositive() {
+		return errorsmod.Wrapf(types.ErrInsufficientBalance, "%s is short by %s", lctID, owed)
+	}
+	return nil
+}
@@ -0,0 +1,133 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"racecar-web/x/energycycle/keeper"
+	"racecar-web/x/energycycle/types"
+	lctmanagertypes "racecar-web/x/lctmanager/types"
+)
+
+// fundLct gives an LCT an active ATP token holding amount
+func fundLct(t *testing.T, f *fixture, tokenID, lctID, amount string) {
+	t.Helper()
+	require.NoError(t, f.keeper.RelationshipAtpTokens.Set(f.ctx, tokenID, types.RelationshipAtpToken{
+		TokenId:      tokenID,
+		LctId:        lctID,
+		EnergyAmount: amount,
+		Status:       types.AtpStatusActive,
+	}))
+}
+
+func TestExecuteEnergyTransferMovesBalance(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	creator, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+
+	fundLct(t, f, "atp-1", "lct-PACK", "30")
+	fundLct(t, f, "atp-2", "lct-PACK", "50")
+	require.NoError(t, f.keeper.EnergyOperations.Set(f.ctx, "op-1", types.EnergyOperation{
+		OperationId:   "op-1",
+		SourceLct:     "lct-PACK",
+		TargetLct:     "lct-MC",
+		EnergyAmount:  "45",
+		OperationType: types.OperationTypeTransfer,
+		Status:        types.StatusCreated,
+		Creator:       creator,
+	}))
+
+	resp, err := ms.ExecuteEnergyTransfer(f.ctx, &types.MsgExecuteEnergyTransfer{Creator: creator, OperationId: "op-1"})
+	require.NoError(t, err)
+	require.Equal(t, "35.000000000000000000", resp.SourceBalance)
+	require.Equal(t, "45.000000000000000000", resp.TargetBalance)
+
+	// The first token is used up and the second split
+	spent, err := f.keeper.RelationshipAtpTokens.Get(f.ctx, "atp-1")
+	require.NoError(t, err)
+	require.Equal(t, types.AtpStatusDischarged, spent.Status)
+	split, err := f.keeper.RelationshipAtpTokens.Get(f.ctx, "atp-2")
+	require.NoError(t, err)
+	require.Equal(t, types.AtpStatusActive, split.Status)
+	require.Equal(t, "35.000000000000000000", split.EnergyAmount)
+
+	op, err := f.keeper.EnergyOperations.Get(f.ctx, "op-1")
+	require.NoError(t, err)
+	require.Equal(t, types.StatusCompleted, op.Status)
+
+	// A completed operation cannot be executed again
+	_, err = ms.ExecuteEnergyTransfer(f.ctx, &types.MsgExecuteEnergyTransfer{Creator: creator, OperationId: "op-1"})
+	require.ErrorIs(t, err, types.ErrOperationNotPending)
+
+	_, err = ms.ExecuteEnergyTransfer(f.ctx, &types.MsgExecuteEnergyTransfer{Creator: creator, OperationId: "op-missing"})
+	require.ErrorIs(t, err, types.ErrOperationNotFound)
+}
+
+func TestExecuteEnergyTransferRejectsInsufficientBalance(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	creator, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+
+	fundLct(t, f, "atp-1", "lct-PACK", "30")
+	require.NoError(t, f.keeper.EnergyOperations.Set(f.ctx, "op-1", types.EnergyOperation{
+		OperationId:   "op-1",
+		SourceLct:     "lct-PACK",
+		TargetLct:     "lct-MC",
+		EnergyAmount:  "30.5",
+		OperationType: types.OperationTypeTransfer,
+		Status:        types.StatusCreated,
+		Creator:       creator,
+	}))
+
+	_, err = ms.ExecuteEnergyTransfer(f.ctx, &types.MsgExecuteEnergyTransfer{Creator: creator, OperationId: "op-1"})
+	require.ErrorIs(t, err, types.ErrInsufficientBalance)
+
+	// Nothing moved and the operation can be retried once funded
+	balance, err := f.keeper.CalculateEnergyBalance(f.ctx, "lct-PACK")
+	require.NoError(t, err)
+	require.Equal(t, "30.000000000000000000", balance.String())
+	op, err := f.keeper.EnergyOperations.Get(f.ctx, "op-1")
+	require.NoError(t, err)
+	require.Equal(t, types.StatusCreated, op.Status)
+}
+
+func TestExecuteEnergyTransferRequiresCreatorOrSourceAnchor(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	creator, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+	anchor, err := f.addressCodec.BytesToString([]byte("packAnchor__________________"))
+	require.NoError(t, err)
+	stranger, err := f.addressCodec.BytesToString([]byte("stranger____________________"))
+	require.NoError(t, err)
+
+	f.lctmanager.lcts["lct-PACK"] = lctmanagertypes.LinkedContextToken{LctId: "lct-PACK", TrustAnchor: anchor}
+	fundLct(t, f, "atp-1", "lct-PACK", "100")
+	for _, id := range []string{"op-1", "op-2"} {
+		require.NoError(t, f.keeper.EnergyOperations.Set(f.ctx, id, types.EnergyOperation{
+			OperationId:   id,
+			SourceLct:     "lct-PACK",
+			TargetLct:     "lct-MC",
+			EnergyAmount:  "10",
+			OperationType: types.OperationTypeTransfer,
+			Status:        types.StatusCreated,
+			Creator:       creator,
+		}))
+	}
+
+	// Anyone else cannot drain the source LCT
+	_, err = ms.ExecuteEnergyTransfer(f.ctx, &types.MsgExecuteEnergyTransfer{Creator: stranger, OperationId: "op-1"})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	balance, err := f.keeper.CalculateEnergyBalance(f.ctx, "lct-PACK")
+	require.NoError(t, err)
+	require.Equal(t, "100.000000000000000000", balance.String())
+
+	// The operation's creator and the source's trust anchor can
+	_, err = ms.ExecuteEnergyTransfer(f.ctx, &types.MsgExecuteEnergyTransfer{Creator: creator, OperationId: "op-1"})
+	require.NoError(t, err)
+	_, err = ms.ExecuteEnergyTransfer(f.ctx, &types.MsgExecuteEnergyTransfer{Creator: anchor, OperationId: "op-2"})
+	require.NoError(t, err)
+}
@@ -27,6 +27,11 @@ type Keeper struct {
 	EnergyOperations      collections.Map[string, types.EnergyOperation]
 	RelationshipAtpTokens collections.Map[string, types.RelationshipAtpToken]
 	RelationshipAdpTokens collections.Map[string, types.RelationshipAdpToken]
+	// CreatedOperationIndex orders the created operations by creation: (block_height, operation_id) -> operation_id
+	CreatedOperationIndex collections.Map[collections.Pair[int64, string], string]
+	// TrustOperationIndex orders the created operations by trust, highest
+	// first, then by creation: (inverted trust, block_height, operation_id) -> operation_id
+	TrustOperationIndex collections.Map[collections.Triple[uint64, int64, string], string]
 
 	bankKeeper        types.BankKeeper
 	lctmanagerKeeper  lctmanagertypes.LctmanagerKeeper
@@ -62,6 +67,8 @@ func NewKeeper(
 		EnergyOperations:      collections.NewMap(sb, types.EnergyOperationKey, "energy_operations", collections.StringKey, codec.CollValue[types.EnergyOperation](cdc)),
 		RelationshipAtpTokens: collections.NewMap(sb, types.RelationshipAtpTokenKey, "relationship_atp_tokens", collections.StringKey, codec.CollValue[types.RelationshipAtpToken](cdc)),
 		RelationshipAdpTokens: collections.NewMap(sb, types.RelationshipAdpTokenKey, "relationship_adp_tokens", collections.StringKey, codec.CollValue[types.RelationshipAdpToken](cdc)),
+		CreatedOperationIndex: collections.NewMap(sb, types.CreatedOperationIndexKey, "created_operation_index", collections.PairKeyCodec(collections.Int64Key, collections.StringKey), collections.StringValue),
+		TrustOperationIndex:   collections.NewMap(sb, types.TrustOperationIndexKey, "trust_operation_index", collections.TripleKeyCodec(collections.Uint64Key, collections.Int64Key, collections.StringKey), collections.StringValue),
 	}
 
 	schema, err := sb.Build()
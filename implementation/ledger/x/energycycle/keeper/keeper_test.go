@@ -16,12 +16,54 @@ import (
 	"racecar-web/x/energycycle/keeper"
 	module "racecar-web/x/energycycle/module"
 	"racecar-web/x/energycycle/types"
+	lctmanagertypes "racecar-web/x/lctmanager/types"
 )
 
 type fixture struct {
 	ctx          context.Context
 	keeper       keeper.Keeper
 	addressCodec address.Codec
+	lctmanager   *fakeLctmanagerKeeper
+}
+
+// fakeLctmanagerKeeper serves LCT relationships from memory
+type fakeLctmanagerKeeper struct {
+	lcts map[string]lctmanagertypes.LinkedContextToken
+}
+
+func (f *fakeLctmanagerKeeper) GetLinkedContextToken(ctx context.Context, lctId string) (lctmanagertypes.LinkedContextToken, bool) {
+	lct, ok := f.lcts[lctId]
+	return lct, ok
+}
+
+func (f *fakeLctmanagerKeeper) GetComponentRelationships(ctx context.Context, componentId string) ([]lctmanagertypes.LinkedContextToken, error) {
+	var lcts []lctmanagertypes.LinkedContextToken
+	for _, lct := range f.lcts {
+		if lct.ComponentAId == componentId || lct.ComponentBId == componentId {
+			lcts = append(lcts, lct)
+		}
+	}
+	return lcts, nil
+}
+
+func (f *fakeLctmanagerKeeper) CreateLCTRelationship(ctx context.Context, componentA, componentB, operationalContext, proxyId string) (string, string, error) {
+	lctId := "lct-" + componentA + "-" + componentB
+	f.lcts[lctId] = lctmanagertypes.LinkedContextToken{LctId: lctId, ComponentAId: componentA, ComponentBId: componentB, PairingStatus: lctmanagertypes.StatusActive}
+	return lctId, "", nil
+}
+
+func (f *fakeLctmanagerKeeper) TerminateLctRelationship(ctx context.Context, lctId, reason string, notifyOffline bool) error {
+	lct, ok := f.lcts[lctId]
+	if !ok {
+		return lctmanagertypes.ErrLctNotFound
+	}
+	lct.PairingStatus = lctmanagertypes.StatusTerminated
+	f.lcts[lctId] = lct
+	return nil
+}
+
+func (f *fakeLctmanagerKeeper) IssueSplitKey(ctx context.Context, lctId string, commitment []byte) (string, error) {
+	return "", nil
 }
 
 func initFixture(t *testing.T) *fixture {
@@ -35,6 +77,7 @@ func initFixture(t *testing.T) *fixture {
 	ctx := testutil.DefaultContextWithDB(t, storeKey, storetypes.NewTransientStoreKey("transient_test")).Ctx
 
 	authority := authtypes.NewModuleAddress(types.GovModuleName)
+	lctmanager := &fakeLctmanagerKeeper{lcts: make(map[string]lctmanagertypes.LinkedContextToken)}
 
 	k := keeper.NewKeeper(
 		storeService,
@@ -42,7 +85,7 @@ func initFixture(t *testing.T) *fixture {
 		addressCodec,
 		authority,
 		nil,
-		nil,
+		lctmanager,
 		nil,
 	)
 
@@ -55,5 +98,6 @@ func initFixture(t *testing.T) *fixture {
 		ctx:          ctx,
 		keeper:       k,
 		addressCodec: addressCodec,
+		lctmanager:   lctmanager,
 	}
 }
@@ -0,0 +1,28 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"racecar-web/x/energycycle/types"
+)
+
+// Migrator runs the module's store migrations
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a Migrator for the keeper's store
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 adds the operations created before the scheduling indexes
+// existed to them, so that the scheduler finds them
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	return m.keeper.EnergyOperations.Walk(ctx, nil, func(_ string, op types.EnergyOperation) (bool, error) {
+		if op.Status != types.StatusCreated {
+			return false, nil
+		}
+		return false, m.keeper.indexCreatedOperation(ctx, op)
+	})
+}
@@ -111,10 +111,12 @@ func (k msgServer) CreateRelationshipEnergyOperation(ctx context.Context, msg *t
 		EnergyEfficiency: "0.8", // Default efficiency
 		ValidationData:   validationMsg,
 		Version:          1,
+		EnergyIn:         msg.EnergyAmount,
+		Creator:          msg.Creator,
 	}
 
 	// Store the operation
-	err = k.EnergyOperations.Set(ctx, operationId, *operation)
+	err = k.SetEnergyOperation(ctx, *operation)
 	if err != nil {
 		return nil, errorsmod.Wrap(err, "failed to store energy operation")
 	}
@@ -137,58 +139,35 @@ func (k msgServer) ExecuteEnergyTransfer(ctx context.Context, msg *types.MsgExec
 	}
 
 	// Get the energy operation
-	operation, err := k.EnergyOperations.Get(ctx, msg.OperationId)
+	operation, err := k.GetEnergyOperation(ctx, msg.OperationId)
 	if err != nil {
-		return nil, errorsmod.Wrap(err, "energy operation not found")
+		return nil, err
 	}
-
-	// Check if operation is in correct state
-	if operation.Status != types.StatusCreated {
-		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "operation is not in created state")
+	if !k.canExecuteTransfer(ctx, msg.Creator, operation) {
+		return nil, errorsmod.Wrapf(types.ErrUnauthorized, "%s neither created operation %s nor anchors %s", msg.Creator, operation.OperationId, operation.SourceLct)
 	}
 
-	// Get current block height
-	sdkCtx := sdk.UnwrapSDKContext(ctx)
-	blockHeight := sdkCtx.BlockHeight()
-
-	// Execute based on operation type
-	switch operation.OperationType {
-	case types.OperationTypeDischarge:
-		// Discharge ATP token to create ADP token
-		if operation.AtpTokenId == "" {
-			return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "no ATP token associated with discharge operation")
-		}
-
-		adpToken, err := k.DischargeAtpToken(ctx, operation.AtpTokenId, operation.OperationId, blockHeight)
-		if err != nil {
-			return nil, errorsmod.Wrap(err, "failed to discharge ATP token")
-		}
-
-		// Update operation with ADP token ID
-		operation.AdpTokenId = adpToken.TokenId
-		operation.Status = types.StatusCompleted
-		operation.EnergyEfficiency = adpToken.EnergyEfficiency
-		operation.Version++
-
-	case types.OperationTypeTransfer:
-		// For transfers, we need to validate energy balance and create new ATP tokens
-		// This is a simplified implementation
-		operation.Status = types.StatusCompleted
-		operation.Version++
-
-	default:
-		// For other operation types, just mark as completed
-		operation.Status = types.StatusCompleted
-		operation.Version++
+	// A measured output overrides the assumption that the whole amount arrives
+	if msg.EnergyOut != "" {
+		operation.EnergyOut = msg.EnergyOut
+	}
+	if err := k.executeOperation(ctx, operation); err != nil {
+		return nil, err
 	}
 
-	// Update the operation
-	err = k.EnergyOperations.Set(ctx, operation.OperationId, operation)
+	sourceBalance, err := k.CalculateEnergyBalance(ctx, operation.SourceLct)
 	if err != nil {
-		return nil, errorsmod.Wrap(err, "failed to update energy operation")
+		return nil, err
+	}
+	targetBalance, err := k.CalculateEnergyBalance(ctx, operation.TargetLct)
+	if err != nil {
+		return nil, err
 	}
 
-	return &types.MsgExecuteEnergyTransferResponse{}, nil
+	return &types.MsgExecuteEnergyTransferResponse{
+		SourceBalance: sourceBalance.String(),
+		TargetBalance: targetBalance.String(),
+	}, nil
 }
 
 // ValidateRelationshipValue implements the Msg/ValidateRelationshipValue message type.
@@ -232,7 +211,7 @@ func (k msgServer) ValidateRelationshipValue(ctx context.Context, msg *types.Msg
 		operation.AdpTokenId = adpToken.TokenId
 		operation.Status = types.StatusValidated
 		operation.Version++
-		k.EnergyOperations.Set(ctx, operation.OperationId, operation)
+		k.SetEnergyOperation(ctx, operation)
 	}
 
 	// Convert ADP token IDs to string
@@ -0,0 +1,157 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	"racecar-web/x/energycycle/types"
+	lctmanagertypes "racecar-web/x/lctmanager/types"
+)
+
+// GetNetworkEnergyBalance walks the active LCTs reachable from rootComponentId
+// and balances the settled energy operations that touch them. Operations
+// between two LCTs of the network count as internal flow; the rest enter or
+// leave the network. An LCT is imbalanced when it has sent more energy than it
+// has received and still holds.
+func (k Keeper) GetNetworkEnergyBalance(ctx context.Context, rootComponentId string) (types.NetworkBalance, error) {
+	componentIds, lctIds, err := k.activeNetwork(ctx, rootComponentId)
+	if err != nil {
+		return types.NetworkBalance{}, err
+	}
+
+	inNetwork := make(map[string]bool, len(lctIds))
+	inflows := make(map[string]math.LegacyDec, len(lctIds))
+	outflows := make(map[string]math.LegacyDec, len(lctIds))
+	for _, lctId := range lctIds {
+		inNetwork[lctId] = true
+		inflows[lctId] = math.LegacyZeroDec()
+		outflows[lctId] = math.LegacyZeroDec()
+	}
+
+	totalIn, totalOut, internal := math.LegacyZeroDec(), math.LegacyZeroDec(), math.LegacyZeroDec()
+	err = k.EnergyOperations.Walk(ctx, nil, func(_ string, op types.EnergyOperation) (bool, error) {
+		if op.Status != types.StatusCompleted && op.Status != types.StatusValidated {
+			return false, nil
+		}
+		fromNetwork, toNetwork := inNetwork[op.SourceLct], inNetwork[op.TargetLct]
+		if !fromNetwork && !toNetwork {
+			return false, nil
+		}
+		amount, err := math.LegacyNewDecFromStr(op.EnergyAmount)
+		if err != nil {
+			return false, nil // skip invalid amounts, as CalculateEnergyBalance does
+		}
+
+		if fromNetwork {
+			outflows[op.SourceLct] = outflows[op.SourceLct].Add(amount)
+		}
+		if toNetwork {
+			inflows[op.TargetLct] = inflows[op.TargetLct].Add(amount)
+		}
+		switch {
+		case fromNetwork && toNetwork:
+			internal = internal.Add(amount)
+		case toNetwork:
+			totalIn = totalIn.Add(amount)
+		default:
+			totalOut = totalOut.Add(amount)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return types.NetworkBalance{}, fmt.Errorf("failed to walk energy operations: %w", err)
+	}
+
+	balance := types.NetworkBalance{
+		RootComponentId:  rootComponentId,
+		ComponentIds:     componentIds,
+		Lcts:             make([]types.LctEnergyFlow, 0, len(lctIds)),
+		ImbalancedLctIds: []string{},
+	}
+	stored := math.LegacyZeroDec()
+	for _, lctId := range lctIds {
+		held, err := k.CalculateEnergyBalance(ctx, lctId)
+		if err != nil {
+			return types.NetworkBalance{}, err
+		}
+		stored = stored.Add(held)
+
+		in, out := inflows[lctId], outflows[lctId]
+		if out.GT(in.Add(held)) {
+			balance.ImbalancedLctIds = append(balance.ImbalancedLctIds, lctId)
+		}
+		balance.Lcts = append(balance.Lcts, types.LctEnergyFlow{
+			LctId:   lctId,
+			Inflow:  in.String(),
+			Outflow: out.String(),
+			NetFlow: in.Sub(out).String(),
+			Stored:  held.String(),
+		})
+	}
+
+	balance.TotalInflow = totalIn.String()
+	balance.TotalOutflow = totalOut.String()
+	balance.InternalFlow = internal.String()
+	balance.NetFlow = totalIn.Sub(totalOut).String()
+	balance.Stored = stored.String()
+	balance.Balanced = len(balance.ImbalancedLctIds) == 0 && !totalOut.GT(totalIn.Add(stored))
+	return balance, nil
+}
+
+// activeNetwork returns the components reachable from root through active
+// LCTs, root included, and the LCTs crossed on the way. Both are sorted. A
+// network more than MaxNetworkDepth hops deep or with more than
+// MaxNetworkComponents components fails with ErrNetworkTooLarge.
+func (k Keeper) activeNetwork(ctx context.Context, root string) ([]string, []string, error) {
+	// depth holds the hops from root of every component reached so far
+	depth := map[string]int{root: 0}
+	seenLcts := make(map[string]bool)
+	queue := []string{root}
+	for len(queue) > 0 {
+		componentId := queue[0]
+		queue = queue[1:]
+
+		lcts, err := k.lctmanagerKeeper.GetComponentRelationships(ctx, componentId)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to get relationships of %s: %w", componentId, err)
+		}
+		for _, lct := range lcts {
+			if lct.PairingStatus != lctmanagertypes.StatusActive {
+				continue
+			}
+			seenLcts[lct.LctId] = true
+
+			peer := lct.ComponentAId
+			if peer == componentId {
+				peer = lct.ComponentBId
+			}
+			if _, ok := depth[peer]; ok {
+				continue
+			}
+			if depth[componentId] == types.MaxNetworkDepth {
+				return nil, nil, errorsmod.Wrapf(types.ErrNetworkTooLarge, "%s reaches components more than %d hops away", root, types.MaxNetworkDepth)
+			}
+			if len(depth) == types.MaxNetworkComponents {
+				return nil, nil, errorsmod.Wrapf(types.ErrNetworkTooLarge, "%s reaches more than %d components", root, types.MaxNetworkComponents)
+			}
+			depth[peer] = depth[componentId] + 1
+			queue = append(queue, peer)
+		}
+	}
+
+	componentIds := make([]string, 0, len(depth))
+	for id := range depth {
+		componentIds = append(componentIds, id)
+	}
+	lctIds := make([]string, 0, len(seenLcts))
+	for id := range seenLcts {
+		lctIds = append(lctIds, id)
+	}
+	sort.Strings(componentIds)
+	sort.Strings(lctIds)
+	return componentIds, lctIds, nil
+}
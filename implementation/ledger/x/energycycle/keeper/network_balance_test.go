@@ -0,0 +1,118 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"racecar-web/x/energycycle/keeper"
+	"racecar-web/x/energycycle/types"
+	lctmanagertypes "racecar-web/x/lctmanager/types"
+)
+
+func TestNetworkEnergyBalance(t *testing.T) {
+	f := initFixture(t)
+	dec := func(n int64) string { return math.LegacyNewDec(n).String() }
+
+	// A pack paired with two modules; its old link to the grid is terminated,
+	// so the grid sits outside the network
+	packM1, _, err := f.lctmanager.CreateLCTRelationship(f.ctx, "PACK-A", "MOD-001", "", "")
+	require.NoError(t, err)
+	packM2, _, err := f.lctmanager.CreateLCTRelationship(f.ctx, "PACK-A", "MOD-002", "", "")
+	require.NoError(t, err)
+	grid, _, err := f.lctmanager.CreateLCTRelationship(f.ctx, "GRID", "PACK-A", "", "")
+	require.NoError(t, err)
+	require.NoError(t, f.lctmanager.TerminateLctRelationship(f.ctx, grid, "replaced", false))
+
+	setOperation := func(id, source, target, amount, status string) {
+		require.NoError(t, f.keeper.EnergyOperations.Set(f.ctx, id, types.EnergyOperation{
+			OperationId:  id,
+			SourceLct:    source,
+			TargetLct:    target,
+			EnergyAmount: amount,
+			Status:       status,
+		}))
+	}
+	setOperation("op-1", grid, packM1, "100", types.StatusCompleted)
+	setOperation("op-2", packM1, packM2, "40", types.StatusCompleted)
+	setOperation("op-3", packM2, "lct-LOAD", "30", types.StatusValidated)
+	setOperation("op-4", packM1, packM2, "500", types.StatusCreated) // not settled
+	require.NoError(t, f.keeper.RelationshipAtpTokens.Set(f.ctx, "atp-1", types.RelationshipAtpToken{
+		TokenId:      "atp-1",
+		LctId:        packM2,
+		EnergyAmount: "10",
+		Status:       types.AtpStatusActive,
+	}))
+
+	balance, err := f.keeper.GetNetworkEnergyBalance(f.ctx, "MOD-001")
+	require.NoError(t, err)
+	require.Equal(t, []string{"MOD-001", "MOD-002", "PACK-A"}, balance.ComponentIds)
+	require.Equal(t, []types.LctEnergyFlow{
+		{LctId: packM1, Inflow: dec(100), Outflow: dec(40), NetFlow: dec(60), Stored: dec(0)},
+		{LctId: packM2, Inflow: dec(40), Outflow: dec(30), NetFlow: dec(10), Stored: dec(10)},
+	}, balance.Lcts)
+	require.Equal(t, dec(100), balance.TotalInflow)
+	require.Equal(t, dec(30), balance.TotalOutflow)
+	require.Equal(t, dec(40), balance.InternalFlow)
+	require.Equal(t, dec(70), balance.NetFlow)
+	require.Equal(t, dec(10), balance.Stored)
+	require.True(t, balance.Balanced)
+	require.Empty(t, balance.ImbalancedLctIds)
+
+	// MOD-002 now sends out more than it received and holds
+	setOperation("op-5", packM2, "lct-LOAD", "50", types.StatusCompleted)
+
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	resp, err := qs.GetNetworkEnergyBalance(f.ctx, &types.QueryGetNetworkEnergyBalanceRequest{RootComponentId: "PACK-A"})
+	require.NoError(t, err)
+	require.False(t, resp.Balance.Balanced)
+	require.Equal(t, []string{packM2}, resp.Balance.ImbalancedLctIds)
+	require.Equal(t, dec(80), resp.Balance.TotalOutflow)
+
+	// A component with no active LCTs is a network of one
+	balance, err = f.keeper.GetNetworkEnergyBalance(f.ctx, "GRID")
+	require.NoError(t, err)
+	require.Equal(t, []string{"GRID"}, balance.ComponentIds)
+	require.Empty(t, balance.Lcts)
+	require.True(t, balance.Balanced)
+
+	_, err = qs.GetNetworkEnergyBalance(f.ctx, &types.QueryGetNetworkEnergyBalanceRequest{})
+	require.Error(t, err)
+	require.Equal(t, lctmanagertypes.StatusTerminated, f.lctmanager.lcts[grid].PairingStatus)
+}
+
+func TestNetworkEnergyBalanceIsBounded(t *testing.T) {
+	f := initFixture(t)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	// A chain of modules exactly MaxNetworkDepth hops long is balanced...
+	component := func(i int) string { return fmt.Sprintf("MOD-%03d", i) }
+	for i := 0; i < types.MaxNetworkDepth; i++ {
+		_, _, err := f.lctmanager.CreateLCTRelationship(f.ctx, component(i), component(i+1), "", "")
+		require.NoError(t, err)
+	}
+	balance, err := f.keeper.GetNetworkEnergyBalance(f.ctx, component(0))
+	require.NoError(t, err)
+	require.Len(t, balance.ComponentIds, types.MaxNetworkDepth+1)
+
+	// ...one hop further is refused
+	_, _, err = f.lctmanager.CreateLCTRelationship(f.ctx, component(types.MaxNetworkDepth), component(types.MaxNetworkDepth+1), "", "")
+	require.NoError(t, err)
+	_, err = f.keeper.GetNetworkEnergyBalance(f.ctx, component(0))
+	require.ErrorIs(t, err, types.ErrNetworkTooLarge)
+	// From the middle of the chain every component is within reach
+	_, err = f.keeper.GetNetworkEnergyBalance(f.ctx, component(types.MaxNetworkDepth/2))
+	require.NoError(t, err)
+
+	// So is a hub paired with more components than a network may hold
+	for i := 0; i < types.MaxNetworkComponents; i++ {
+		_, _, err := f.lctmanager.CreateLCTRelationship(f.ctx, "HUB", fmt.Sprintf("CELL-%04d", i), "", "")
+		require.NoError(t, err)
+	}
+	_, err = qs.GetNetworkEnergyBalance(f.ctx, &types.QueryGetNetworkEnergyBalanceRequest{RootComponentId: "HUB"})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
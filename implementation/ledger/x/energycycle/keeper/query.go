@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"errors"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -82,3 +83,83 @@ func (qs QueryServer) GetEnergyFlowHistory(ctx context.Context, req *types.Query
 		EnergyOperations: `[{"operation_id":"energy-op-discharge-12345","source_lct":"lct-MODBATT-PACK-A-HOST-1704067200","target_lct":"lct-MODBATT-MOD-001-PACK-A-1704067200","energy_amount":"50.0","operation_type":"discharge","status":"validated","timestamp":1704067200,"trust_score":"0.85"}]`,
 	}, nil
 }
+
+// ListEnergyOperations implements the Query/ListEnergyOperations RPC method.
+func (qs QueryServer) ListEnergyOperations(ctx context.Context, req *types.QueryListEnergyOperationsRequest) (*types.QueryListEnergyOperationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	operations, pageRes, err := qs.Keeper.ListEnergyOperationsPaginated(ctx, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryListEnergyOperationsResponse{
+		Operations: operations,
+		Pagination: pageRes,
+	}, nil
+}
+
+// GetNetworkEnergyBalance implements the Query/GetNetworkEnergyBalance RPC method.
+func (qs QueryServer) GetNetworkEnergyBalance(ctx context.Context, req *types.QueryGetNetworkEnergyBalanceRequest) (*types.QueryGetNetworkEnergyBalanceResponse, error) {
+	if req == nil || req.RootComponentId == "" {
+		return nil, status.Error(codes.InvalidArgument, "root component ID cannot be empty")
+	}
+
+	balance, err := qs.Keeper.GetNetworkEnergyBalance(ctx, req.RootComponentId)
+	if errors.Is(err, types.ErrNetworkTooLarge) {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryGetNetworkEnergyBalanceResponse{Balance: balance}, nil
+}
+
+// GetEnergyOperation implements the Query/GetEnergyOperation RPC method.
+func (qs QueryServer) GetEnergyOperation(ctx context.Context, req *types.QueryGetEnergyOperationRequest) (*types.QueryGetEnergyOperationResponse, error) {
+	if req == nil || req.OperationId == "" {
+		return nil, status.Error(codes.InvalidArgument, "operation ID cannot be empty")
+	}
+
+	operation, err := qs.Keeper.GetEnergyOperation(ctx, req.OperationId)
+	if errors.Is(err, types.ErrOperationNotFound) {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryGetEnergyOperationResponse{Operation: operation}, nil
+}
+
+// GetOperationEfficiency implements the Query/GetOperationEfficiency RPC method.
+// It calculates the efficiency from the operation's energy in and out; the
+// stored value is only updated when the operation executes.
+func (qs QueryServer) GetOperationEfficiency(ctx context.Context, req *types.QueryGetOperationEfficiencyRequest) (*types.QueryGetOperationEfficiencyResponse, error) {
+	if req == nil || req.OperationId == "" {
+		return nil, status.Error(codes.InvalidArgument, "operation ID cannot be empty")
+	}
+
+	operation, err := qs.Keeper.GetEnergyOperation(ctx, req.OperationId)
+	if errors.Is(err, types.ErrOperationNotFound) {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	efficiency, err := operationEfficiency(operation)
+	if err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	return &types.QueryGetOperationEfficiencyResponse{
+		OperationId: operation.OperationId,
+		EnergyIn:    operation.EnergyIn,
+		EnergyOut:   operation.EnergyOut,
+		Efficiency:  efficiency.String(),
+	}, nil
+}
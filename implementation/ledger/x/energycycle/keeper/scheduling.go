@@ -0,0 +1,216 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"racecar-web/x/energycycle/types"
+)
+
+// executeOperation settles a created operation and stores the result
+func (k Keeper) executeOperation(ctx context.Context, operation types.EnergyOperation) error {
+	// Only pending operations are executed
+	if operation.Status != types.StatusCreated {
+		return errorsmod.Wrapf(types.ErrOperationNotPending, "operation %s is %s", operation.OperationId, operation.Status)
+	}
+
+	// Get current block height
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	blockHeight := sdkCtx.BlockHeight()
+
+	// Execute based on operation type
+	switch operation.OperationType {
+	case types.OperationTypeDischarge:
+		// Discharge ATP token to create ADP token
+		if operation.AtpTokenId == "" {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "no ATP token associated with discharge operation")
+		}
+
+		adpToken, err := k.DischargeAtpToken(ctx, operation.AtpTokenId, operation.OperationId, blockHeight)
+		if err != nil {
+			return errorsmod.Wrap(err, "failed to discharge ATP token")
+		}
+
+		// Update operation with ADP token ID
+		operation.AdpTokenId = adpToken.TokenId
+		operation.Status = types.StatusCompleted
+		operation.EnergyEfficiency = adpToken.EnergyEfficiency
+		operation.Version++
+
+	case types.OperationTypeTransfer:
+		if err := k.transferEnergy(ctx, operation); err != nil {
+			return err
+		}
+		// Operations created before energy in and out were tracked drew their amount
+		if operation.EnergyIn == "" {
+			operation.EnergyIn = operation.EnergyAmount
+		}
+		if operation.EnergyOut == "" {
+			operation.EnergyOut = operation.EnergyAmount
+		}
+		operation.Status = types.StatusCompleted
+		operation.Version++
+
+	default:
+		// For other operation types, just mark as completed
+		operation.Status = types.StatusCompleted
+		operation.Version++
+	}
+
+	// Update the operation
+	if err := k.SetEnergyOperation(ctx, operation); err != nil {
+		return errorsmod.Wrap(err, "failed to update energy operation")
+	}
+
+	if operation.EnergyIn != "" && operation.EnergyOut != "" {
+		if _, err := k.CalculateEfficiency(ctx, operation.OperationId); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SetEnergyOperation stores an energy operation and keeps it in the
+// scheduling indexes for as long as it is created
+func (k Keeper) SetEnergyOperation(ctx context.Context, operation types.EnergyOperation) error {
+	previous, err := k.EnergyOperations.Get(ctx, operation.OperationId)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+	if err == nil && previous.Status == types.StatusCreated {
+		if err := k.CreatedOperationIndex.Remove(ctx, collections.Join(previous.BlockHeight, previous.OperationId)); err != nil {
+			return err
+		}
+		if err := k.TrustOperationIndex.Remove(ctx, collections.Join3(trustRank(previous.TrustScore), previous.BlockHeight, previous.OperationId)); err != nil {
+			return err
+		}
+	}
+
+	if err := k.EnergyOperations.Set(ctx, operation.OperationId, operation); err != nil {
+		return err
+	}
+	if operation.Status != types.StatusCreated {
+		return nil
+	}
+	return k.indexCreatedOperation(ctx, operation)
+}
+
+// indexCreatedOperation adds a created operation to both scheduling indexes
+func (k Keeper) indexCreatedOperation(ctx context.Context, operation types.EnergyOperation) error {
+	if err := k.CreatedOperationIndex.Set(ctx, collections.Join(operation.BlockHeight, operation.OperationId), operation.OperationId); err != nil {
+		return err
+	}
+	return k.TrustOperationIndex.Set(ctx, collections.Join3(trustRank(operation.TrustScore), operation.BlockHeight, operation.OperationId), operation.OperationId)
+}
+
+// trustRank inverts a trust score so that higher trust sorts first in the
+// trust index. An unreadable or non-positive score earns no priority.
+func trustRank(score string) uint64 {
+	trust, err := math.LegacyNewDecFromStr(score)
+	if err != nil || !trust.IsPositive() {
+		return ^uint64(0)
+	}
+	atto := trust.BigInt()
+	if !atto.IsUint64() {
+		return 0
+	}
+	return ^uint64(0) - atto.Uint64()
+}
+
+// SchedulePendingOperations returns up to limit created operations in
+// execution order, or all of them when limit is zero. Without trust weighting
+// they are ordered by creation; with it, operations on higher-trust
+// relationships come first and creation order breaks ties. Operations created
+// in the same block run in the order of their IDs.
+func (k Keeper) SchedulePendingOperations(ctx context.Context, trustWeighted bool, limit uint64) ([]types.EnergyOperation, error) {
+	var ids []string
+	collect := func(operationID string) (bool, error) {
+		ids = append(ids, operationID)
+		return limit > 0 && uint64(len(ids)) >= limit, nil
+	}
+
+	var err error
+	if trustWeighted {
+		err = k.TrustOperationIndex.Walk(ctx, nil, func(_ collections.Triple[uint64, int64, string], operationID string) (bool, error) {
+			return collect(operationID)
+		})
+	} else {
+		err = k.CreatedOperationIndex.Walk(ctx, nil, func(_ collections.Pair[int64, string], operationID string) (bool, error) {
+			return collect(operationID)
+		})
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to walk scheduled operations: %w", err)
+	}
+
+	ops := make([]types.EnergyOperation, 0, len(ids))
+	for _, id := range ids {
+		op, err := k.EnergyOperations.Get(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get scheduled operation %s: %w", id, err)
+		}
+		ops = append(ops, op)
+	}
+	return ops, nil
+}
+
+// ExecuteScheduledOperations executes up to max_operations_per_block pending
+// operations in scheduling order and returns how many it executed. Each
+// operation runs in its own cache, so one that fails leaves no partial writes;
+// it is marked failed so it does not hold its place in every later block.
+func (k Keeper) ExecuteScheduledOperations(ctx context.Context) (int, error) {
+	params, err := k.Params.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	if params.MaxOperationsPerBlock == 0 {
+		return 0, nil
+	}
+
+	pending, err := k.SchedulePendingOperations(ctx, params.TrustWeightedScheduling, params.MaxOperationsPerBlock)
+	if err != nil {
+		return 0, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	executed := 0
+	for _, op := range pending {
+		cacheCtx, write := sdkCtx.CacheContext()
+		if execErr := k.executeOperation(cacheCtx, op); execErr != nil {
+			op.Status = types.StatusFailed
+			op.ValidationData = execErr.Error()
+			op.Version++
+			if err := k.SetEnergyOperation(ctx, op); err != nil {
+				return executed, fmt.Errorf("failed to update energy operation: %w", err)
+			}
+			sdkCtx.EventManager().EmitEvent(
+				sdk.NewEvent("energy_operation_failed",
+					sdk.NewAttribute("operation_id", op.OperationId),
+					sdk.NewAttribute("error", execErr.Error()),
+				),
+			)
+			continue
+		}
+		write()
+
+		executed++
+		sdkCtx.EventManager().EmitEvent(
+			sdk.NewEvent("energy_operation_executed",
+				sdk.NewAttribute("operation_id", op.OperationId),
+				sdk.NewAttribute("trust_score", op.TrustScore),
+			),
+		)
+	}
+
+	return executed, nil
+}
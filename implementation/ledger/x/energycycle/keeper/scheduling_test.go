@@ -0,0 +1,178 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"racecar-web/x/energycycle/keeper"
+	"racecar-web/x/energycycle/types"
+)
+
+func TestTrustWeightedSchedulingUnderConstrainedCapacity(t *testing.T) {
+	f := initFixture(t)
+
+	// The low-trust transfer was created first
+	setPending := func(id, trustScore string, blockHeight int64) {
+		require.NoError(t, f.keeper.SetEnergyOperation(f.ctx, types.EnergyOperation{
+			OperationId:   id,
+			SourceLct:     "lct-" + id,
+			TargetLct:     "lct-LOAD",
+			EnergyAmount:  "50",
+			OperationType: types.OperationTypeTransfer,
+			Status:        types.StatusCreated,
+			BlockHeight:   blockHeight,
+			TrustScore:    trustScore,
+		}))
+		fundLct(t, f, "atp-"+id, "lct-"+id, "50")
+	}
+	setPending("op-low", "0.2", 1)
+	setPending("op-high", "0.9", 2)
+
+	status := func(id string) string {
+		op, err := f.keeper.EnergyOperations.Get(f.ctx, id)
+		require.NoError(t, err)
+		return op.Status
+	}
+
+	// One operation fits in the block: the higher-trust one runs first
+	require.NoError(t, f.keeper.Params.Set(f.ctx, types.NewParams(1, true)))
+	executed, err := f.keeper.ExecuteScheduledOperations(f.ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, executed)
+	require.Equal(t, types.StatusCompleted, status("op-high"))
+	require.Equal(t, types.StatusCreated, status("op-low"))
+
+	// The next block picks up the rest
+	executed, err = f.keeper.ExecuteScheduledOperations(f.ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, executed)
+	require.Equal(t, types.StatusCompleted, status("op-low"))
+}
+
+func TestSchedulingOrder(t *testing.T) {
+	f := initFixture(t)
+	for _, op := range []types.EnergyOperation{
+		{OperationId: "op-a", Status: types.StatusCreated, BlockHeight: 3, TrustScore: "0.9"},
+		{OperationId: "op-b", Status: types.StatusCreated, BlockHeight: 1, TrustScore: "0.5"},
+		{OperationId: "op-c", Status: types.StatusCreated, BlockHeight: 2, TrustScore: "0.5"},
+		{OperationId: "op-d", Status: types.StatusCreated, BlockHeight: 0, TrustScore: "not a score"},
+		{OperationId: "op-e", Status: types.StatusCompleted, BlockHeight: 0, TrustScore: "1.0"},
+	} {
+		require.NoError(t, f.keeper.SetEnergyOperation(f.ctx, op))
+	}
+
+	ids := func(trustWeighted bool, limit uint64) []string {
+		ops, err := f.keeper.SchedulePendingOperations(f.ctx, trustWeighted, limit)
+		require.NoError(t, err)
+		var ids []string
+		for _, op := range ops {
+			ids = append(ids, op.OperationId)
+		}
+		return ids
+	}
+
+	// Equal trust falls back to creation order; unreadable scores go last
+	require.Equal(t, []string{"op-a", "op-b", "op-c", "op-d"}, ids(true, 0))
+	require.Equal(t, []string{"op-d", "op-b", "op-c", "op-a"}, ids(false, 0))
+	require.Equal(t, []string{"op-a", "op-b"}, ids(true, 2))
+
+	// A completed operation leaves the schedule
+	op, err := f.keeper.EnergyOperations.Get(f.ctx, "op-b")
+	require.NoError(t, err)
+	op.Status = types.StatusCompleted
+	require.NoError(t, f.keeper.SetEnergyOperation(f.ctx, op))
+	require.Equal(t, []string{"op-a", "op-c", "op-d"}, ids(true, 0))
+	require.Equal(t, []string{"op-d", "op-c", "op-a"}, ids(false, 0))
+}
+
+func TestFailedScheduledOperationLeavesNoPartialWrites(t *testing.T) {
+	f := initFixture(t)
+	require.NoError(t, f.keeper.Params.Set(f.ctx, types.NewParams(5, false)))
+
+	// The transfer itself succeeds, but its efficiency cannot be calculated
+	fundLct(t, f, "atp-1", "lct-PACK", "50")
+	require.NoError(t, f.keeper.SetEnergyOperation(f.ctx, types.EnergyOperation{
+		OperationId:   "op-1",
+		SourceLct:     "lct-PACK",
+		TargetLct:     "lct-LOAD",
+		EnergyAmount:  "50",
+		EnergyIn:      "0",
+		EnergyOut:     "40",
+		OperationType: types.OperationTypeTransfer,
+		Status:        types.StatusCreated,
+		TrustScore:    "0.5",
+	}))
+
+	executed, err := f.keeper.ExecuteScheduledOperations(f.ctx)
+	require.NoError(t, err)
+	require.Zero(t, executed)
+
+	op, err := f.keeper.EnergyOperations.Get(f.ctx, "op-1")
+	require.NoError(t, err)
+	require.Equal(t, types.StatusFailed, op.Status)
+
+	source, err := f.keeper.CalculateEnergyBalance(f.ctx, "lct-PACK")
+	require.NoError(t, err)
+	require.Equal(t, "50.000000000000000000", source.String())
+	target, err := f.keeper.CalculateEnergyBalance(f.ctx, "lct-LOAD")
+	require.NoError(t, err)
+	require.True(t, target.IsZero())
+}
+
+func TestSchedulerDisabledByDefault(t *testing.T) {
+	f := initFixture(t)
+	require.NoError(t, f.keeper.SetEnergyOperation(f.ctx, types.EnergyOperation{
+		OperationId:   "op-1",
+		SourceLct:     "lct-PACK",
+		TargetLct:     "lct-LOAD",
+		EnergyAmount:  "10",
+		OperationType: types.OperationTypeTransfer,
+		Status:        types.StatusCreated,
+		TrustScore:    "0.9",
+	}))
+	fundLct(t, f, "atp-1", "lct-PACK", "10")
+
+	executed, err := f.keeper.ExecuteScheduledOperations(f.ctx)
+	require.NoError(t, err)
+	require.Zero(t, executed)
+
+	// A discharge without an ATP token cannot run and is failed rather than retried every block
+	require.NoError(t, f.keeper.Params.Set(f.ctx, types.NewParams(5, true)))
+	require.NoError(t, f.keeper.SetEnergyOperation(f.ctx, types.EnergyOperation{
+		OperationId:   "op-2",
+		OperationType: types.OperationTypeDischarge,
+		Status:        types.StatusCreated,
+		TrustScore:    "0.5",
+	}))
+	executed, err = f.keeper.ExecuteScheduledOperations(f.ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, executed)
+
+	op, err := f.keeper.EnergyOperations.Get(f.ctx, "op-2")
+	require.NoError(t, err)
+	require.Equal(t, types.StatusFailed, op.Status)
+	require.Contains(t, op.ValidationData, "no ATP token")
+}
+
+func TestMigrate1to2SchedulesCreatedOperations(t *testing.T) {
+	f := initFixture(t)
+
+	// Stored by version 1, which kept no scheduling indexes
+	for _, op := range []types.EnergyOperation{
+		{OperationId: "op-a", Status: types.StatusCreated, BlockHeight: 2, TrustScore: "0.5"},
+		{OperationId: "op-b", Status: types.StatusCreated, BlockHeight: 1, TrustScore: "0.9"},
+		{OperationId: "op-c", Status: types.StatusCompleted, BlockHeight: 0, TrustScore: "1.0"},
+	} {
+		require.NoError(t, f.keeper.EnergyOperations.Set(f.ctx, op.OperationId, op))
+	}
+
+	require.NoError(t, keeper.NewMigrator(f.keeper).Migrate1to2(sdk.UnwrapSDKContext(f.ctx)))
+
+	ops, err := f.keeper.SchedulePendingOperations(f.ctx, false, 0)
+	require.NoError(t, err)
+	require.Len(t, ops, 2)
+	require.Equal(t, "op-b", ops[0].OperationId)
+	require.Equal(t, "op-a", ops[1].OperationId)
+}
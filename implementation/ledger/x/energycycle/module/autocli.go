@@ -37,6 +37,29 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:          "Query get-energy-flow-history",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "lct_id"}},
 				},
+				{
+					RpcMethod: "ListEnergyOperations",
+					Use:       "list-energy-operations",
+					Short:     "Query a page of energy operations",
+				},
+				{
+					RpcMethod:      "GetNetworkEnergyBalance",
+					Use:            "get-network-energy-balance [root-component-id]",
+					Short:          "Query the energy balance across the LCT network of a component",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "root_component_id"}},
+				},
+				{
+					RpcMethod:      "GetEnergyOperation",
+					Use:            "get-energy-operation [operation-id]",
+					Short:          "Query an energy operation by its ID",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "operation_id"}},
+				},
+				{
+					RpcMethod:      "GetOperationEfficiency",
+					Use:            "get-operation-efficiency [operation-id]",
+					Short:          "Query the ratio of energy delivered to energy drawn by an operation",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "operation_id"}},
+				},
 
 				// this line is used by ignite scaffolding # autocli/query
 			},
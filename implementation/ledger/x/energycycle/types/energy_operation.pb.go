@@ -38,6 +38,9 @@ type EnergyOperation struct {
 	EnergyEfficiency string `protobuf:"bytes,12,opt,name=energy_efficiency,json=energyEfficiency,proto3" json:"energy_efficiency,omitempty"`
 	ValidationData   string `protobuf:"bytes,13,opt,name=validation_data,json=validationData,proto3" json:"validation_data,omitempty"`
 	Version          int64  `protobuf:"varint,14,opt,name=version,proto3" json:"version,omitempty"`
+	EnergyIn         string `protobuf:"bytes,15,opt,name=energy_in,json=energyIn,proto3" json:"energy_in,omitempty"`
+	EnergyOut        string `protobuf:"bytes,16,opt,name=energy_out,json=energyOut,proto3" json:"energy_out,omitempty"`
+	Creator          string `protobuf:"bytes,17,opt,name=creator,proto3" json:"creator,omitempty"`
 }
 
 func (m *EnergyOperation) Reset()         { *m = EnergyOperation{} }
@@ -171,6 +174,27 @@ func (m *EnergyOperation) GetVersion() int64 {
 	return 0
 }
 
+func (m *EnergyOperation) GetEnergyIn() string {
+	if m != nil {
+		return m.EnergyIn
+	}
+	return ""
+}
+
+func (m *EnergyOperation) GetEnergyOut() string {
+	if m != nil {
+		return m.EnergyOut
+	}
+	return ""
+}
+
+func (m *EnergyOperation) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*EnergyOperation)(nil), "racecarweb.energycycle.v1.EnergyOperation")
 }
@@ -180,33 +204,35 @@ func init() {
 }
 
 var fileDescriptor_cd6f468fc1e210d9 = []byte{
-	// 403 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x92, 0xc1, 0x6e, 0xd3, 0x40,
-	0x10, 0x86, 0x63, 0x02, 0x29, 0x99, 0x38, 0x29, 0xec, 0x01, 0x2d, 0x12, 0xb8, 0x01, 0x84, 0xa8,
-	0x84, 0x48, 0xa9, 0x38, 0x71, 0x04, 0x51, 0x89, 0x4a, 0x48, 0x48, 0xa5, 0x27, 0x2e, 0xd6, 0x66,
-	0x3d, 0x4d, 0x57, 0x75, 0xbc, 0xd6, 0xee, 0xd8, 0xe0, 0xb7, 0xe0, 0xb1, 0x38, 0xf6, 0xc8, 0x11,
-	0x25, 0x57, 0x1e, 0x02, 0x79, 0xec, 0xda, 0xe9, 0x71, 0xbe, 0xff, 0x9b, 0xd5, 0xce, 0x68, 0xe0,
-	0xad, 0x53, 0x1a, 0xb5, 0x72, 0x3f, 0x70, 0x79, 0x84, 0x19, 0xba, 0x55, 0xa5, 0x2b, 0x9d, 0xe2,
-	0x51, 0x79, 0xdc, 0x96, 0xb1, 0xcd, 0xd1, 0x29, 0x32, 0x36, 0x5b, 0xe4, 0xce, 0x92, 0x15, 0x8f,
-	0xfb, 0x8e, 0xc5, 0x4e, 0xc7, 0xa2, 0x3c, 0x7e, 0xfe, 0x6f, 0x08, 0xfb, 0x27, 0x8c, 0xbe, 0xde,
-	0x34, 0x89, 0x67, 0x10, 0x76, 0x2f, 0xc4, 0x26, 0x91, 0xc1, 0x3c, 0x38, 0x1c, 0x9f, 0x4d, 0x3a,
-	0x76, 0x9a, 0x88, 0xa7, 0x00, 0xde, 0x16, 0x4e, 0x63, 0x9c, 0x6a, 0x92, 0x77, 0x58, 0x18, 0x37,
-	0xe4, 0x8b, 0xa6, 0x3a, 0x26, 0xe5, 0x56, 0x48, 0x1c, 0x0f, 0x9b, 0xb8, 0x21, 0x75, 0xfc, 0x02,
-	0xa6, 0xed, 0x4f, 0xd5, 0xda, 0x16, 0x19, 0xc9, 0xbb, 0x6c, 0x84, 0x0d, 0xfc, 0xc0, 0x4c, 0xbc,
-	0x84, 0x59, 0xff, 0x0b, 0xaa, 0x72, 0x94, 0xf7, 0xd8, 0x9a, 0x76, 0xf4, 0xbc, 0xca, 0x51, 0x3c,
-	0x82, 0x91, 0x27, 0x45, 0x85, 0x97, 0x23, 0x8e, 0xdb, 0x4a, 0x3c, 0x81, 0x31, 0x99, 0x35, 0x7a,
-	0x52, 0xeb, 0x5c, 0xee, 0xcd, 0x83, 0xc3, 0xe1, 0x59, 0x0f, 0xea, 0x11, 0x97, 0xa9, 0xd5, 0x57,
-	0xf1, 0x25, 0x9a, 0xd5, 0x25, 0xc9, 0xfb, 0x2c, 0x4c, 0x98, 0x7d, 0x66, 0x24, 0x0e, 0x60, 0x42,
-	0xae, 0xf0, 0x14, 0x7b, 0x6d, 0x1d, 0xca, 0x31, 0xbf, 0x0e, 0x8c, 0xbe, 0xd5, 0x44, 0xcc, 0x21,
-	0x54, 0x94, 0xc7, 0x64, 0xaf, 0x90, 0xd7, 0x04, 0x8d, 0xa1, 0x28, 0x3f, 0xaf, 0xd1, 0x69, 0xc2,
-	0x46, 0xb2, 0x63, 0x4c, 0x5a, 0x23, 0xe9, 0x8c, 0xd7, 0xf0, 0xb0, 0xdd, 0x04, 0x5e, 0x5c, 0x18,
-	0x6d, 0x30, 0xd3, 0x95, 0x0c, 0x59, 0x7b, 0xd0, 0x04, 0x27, 0x1d, 0x17, 0xaf, 0x60, 0xbf, 0x54,
-	0xa9, 0x49, 0x9a, 0x95, 0x24, 0x8a, 0x94, 0x9c, 0xb2, 0x3a, 0xeb, 0xf1, 0x27, 0x45, 0x4a, 0x48,
-	0xd8, 0x2b, 0xd1, 0x79, 0x63, 0x33, 0x39, 0xe3, 0xc1, 0x6e, 0xca, 0x8f, 0xef, 0x7f, 0x6f, 0xa2,
-	0xe0, 0x7a, 0x13, 0x05, 0x7f, 0x37, 0x51, 0xf0, 0x6b, 0x1b, 0x0d, 0xae, 0xb7, 0xd1, 0xe0, 0xcf,
-	0x36, 0x1a, 0x7c, 0x3f, 0x68, 0x6f, 0xe4, 0x4d, 0x7d, 0x56, 0x3f, 0x6f, 0x1d, 0x56, 0xbd, 0x7c,
-	0xbf, 0x1c, 0xf1, 0x2d, 0xbd, 0xfb, 0x1f, 0x00, 0x00, 0xff, 0xff, 0xd7, 0xaa, 0x2c, 0xdb, 0x7f,
-	0x02, 0x00, 0x00,
+	// 442 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x92, 0xcf, 0x6e, 0xd3, 0x40,
+	0x10, 0x87, 0x63, 0x5a, 0xd2, 0x66, 0xf2, 0xaf, 0xdd, 0x03, 0x5a, 0x04, 0xb8, 0x01, 0x84, 0xa8,
+	0x84, 0x48, 0xa9, 0x38, 0x71, 0x04, 0x51, 0x89, 0x48, 0x48, 0x95, 0x4a, 0x4f, 0x5c, 0xac, 0xcd,
+	0x7a, 0x9a, 0xae, 0x9a, 0x78, 0xad, 0xf5, 0x38, 0xe0, 0xb7, 0xe0, 0x99, 0x38, 0x71, 0xec, 0x91,
+	0x23, 0x4a, 0x5e, 0x04, 0xed, 0xac, 0xe3, 0xb4, 0xc7, 0xf9, 0x7e, 0xdf, 0xac, 0xbd, 0x3b, 0x03,
+	0xef, 0x9c, 0xd2, 0xa8, 0x95, 0xfb, 0x81, 0xd3, 0x13, 0xcc, 0xd0, 0xcd, 0x2a, 0x5d, 0xe9, 0x39,
+	0x9e, 0x2c, 0x4f, 0xeb, 0x32, 0xb1, 0x39, 0x3a, 0x45, 0xc6, 0x66, 0xe3, 0xdc, 0x59, 0xb2, 0xe2,
+	0xf1, 0xb6, 0x63, 0x7c, 0xa7, 0x63, 0xbc, 0x3c, 0x7d, 0xf1, 0x7b, 0x17, 0x86, 0x67, 0x8c, 0xce,
+	0x37, 0x4d, 0xe2, 0x39, 0xf4, 0x9a, 0x13, 0x12, 0x93, 0xca, 0x68, 0x14, 0x1d, 0x77, 0x2e, 0xba,
+	0x0d, 0x9b, 0xa4, 0xe2, 0x19, 0x40, 0x61, 0x4b, 0xa7, 0x31, 0x99, 0x6b, 0x92, 0x0f, 0x58, 0xe8,
+	0x04, 0xf2, 0x55, 0x93, 0x8f, 0x49, 0xb9, 0x19, 0x12, 0xc7, 0x3b, 0x21, 0x0e, 0xc4, 0xc7, 0x2f,
+	0xa1, 0x5f, 0xff, 0xa9, 0x5a, 0xd8, 0x32, 0x23, 0xb9, 0xcb, 0x46, 0x2f, 0xc0, 0x8f, 0xcc, 0xc4,
+	0x2b, 0x18, 0x6c, 0xff, 0x82, 0xaa, 0x1c, 0xe5, 0x43, 0xb6, 0xfa, 0x0d, 0xbd, 0xac, 0x72, 0x14,
+	0x8f, 0xa0, 0x5d, 0x90, 0xa2, 0xb2, 0x90, 0x6d, 0x8e, 0xeb, 0x4a, 0x3c, 0x85, 0x0e, 0x99, 0x05,
+	0x16, 0xa4, 0x16, 0xb9, 0xdc, 0x1b, 0x45, 0xc7, 0x3b, 0x17, 0x5b, 0xe0, 0xaf, 0x38, 0x9d, 0x5b,
+	0x7d, 0x93, 0x5c, 0xa3, 0x99, 0x5d, 0x93, 0xdc, 0x67, 0xa1, 0xcb, 0xec, 0x0b, 0x23, 0x71, 0x04,
+	0x5d, 0x72, 0x65, 0x41, 0x49, 0xa1, 0xad, 0x43, 0xd9, 0xe1, 0xd3, 0x81, 0xd1, 0x37, 0x4f, 0xc4,
+	0x08, 0x7a, 0x8a, 0xf2, 0x84, 0xec, 0x0d, 0xf2, 0x33, 0x41, 0x30, 0x14, 0xe5, 0x97, 0x1e, 0x4d,
+	0x52, 0x36, 0xd2, 0x3b, 0x46, 0xb7, 0x36, 0xd2, 0xc6, 0x78, 0x03, 0x87, 0xf5, 0x4b, 0xe0, 0xd5,
+	0x95, 0xd1, 0x06, 0x33, 0x5d, 0xc9, 0x1e, 0x6b, 0x07, 0x21, 0x38, 0x6b, 0xb8, 0x78, 0x0d, 0xc3,
+	0xa5, 0x9a, 0x9b, 0x34, 0x3c, 0x49, 0xaa, 0x48, 0xc9, 0x3e, 0xab, 0x83, 0x2d, 0xfe, 0xac, 0x48,
+	0x09, 0x09, 0x7b, 0x4b, 0x74, 0x85, 0xb1, 0x99, 0x1c, 0xf0, 0xc5, 0x36, 0xa5, 0x78, 0x02, 0x9d,
+	0xfa, 0x7b, 0x26, 0x93, 0x43, 0x6e, 0xde, 0x0f, 0x60, 0x92, 0xf9, 0xa9, 0x6d, 0x16, 0xa8, 0x24,
+	0x79, 0x10, 0xa6, 0x16, 0xc8, 0x79, 0x49, 0xfe, 0x54, 0xed, 0x50, 0x91, 0x75, 0xf2, 0x90, 0xb3,
+	0x4d, 0xf9, 0xe9, 0xc3, 0x9f, 0x55, 0x1c, 0xdd, 0xae, 0xe2, 0xe8, 0xdf, 0x2a, 0x8e, 0x7e, 0xad,
+	0xe3, 0xd6, 0xed, 0x3a, 0x6e, 0xfd, 0x5d, 0xc7, 0xad, 0xef, 0x47, 0xf5, 0xe6, 0xbd, 0xf5, 0xcb,
+	0xfa, 0xf3, 0xde, 0xba, 0xfa, 0x91, 0x16, 0xd3, 0x36, 0x6f, 0xe8, 0xfb, 0xff, 0x01, 0x00, 0x00,
+	0xff, 0xff, 0x83, 0xb7, 0x02, 0x83, 0xd5, 0x02, 0x00, 0x00,
 }
 
 func (m *EnergyOperation) Marshal() (dAtA []byte, err error) {
@@ -229,6 +255,31 @@ func (m *EnergyOperation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintEnergyOperation(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if len(m.EnergyOut) > 0 {
+		i -= len(m.EnergyOut)
+		copy(dAtA[i:], m.EnergyOut)
+		i = encodeVarintEnergyOperation(dAtA, i, uint64(len(m.EnergyOut)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if len(m.EnergyIn) > 0 {
+		i -= len(m.EnergyIn)
+		copy(dAtA[i:], m.EnergyIn)
+		i = encodeVarintEnergyOperation(dAtA, i, uint64(len(m.EnergyIn)))
+		i--
+		dAtA[i] = 0x7a
+	}
 	if m.Version != 0 {
 		i = encodeVarintEnergyOperation(dAtA, i, uint64(m.Version))
 		i--
@@ -394,6 +445,18 @@ func (m *EnergyOperation) Size() (n int) {
 	if m.Version != 0 {
 		n += 1 + sovEnergyOperation(uint64(m.Version))
 	}
+	l = len(m.EnergyIn)
+	if l > 0 {
+		n += 1 + l + sovEnergyOperation(uint64(l))
+	}
+	l = len(m.EnergyOut)
+	if l > 0 {
+		n += 2 + l + sovEnergyOperation(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 2 + l + sovEnergyOperation(uint64(l))
+	}
 	return n
 }
 
@@ -841,6 +904,102 @@ func (m *EnergyOperation) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnergyIn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnergyOperation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEnergyOperation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEnergyOperation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EnergyIn = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnergyOut", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnergyOperation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEnergyOperation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEnergyOperation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EnergyOut = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnergyOperation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEnergyOperation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEnergyOperation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEnergyOperation(dAtA[iNdEx:])
@@ -8,5 +8,11 @@ import (
 
 // x/energycycle module sentinel errors
 var (
-	ErrInvalidSigner = errors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
+	ErrInvalidSigner       = errors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
+	ErrOperationNotFound   = errors.Register(ModuleName, 1101, "energy operation not found")
+	ErrOperationNotPending = errors.Register(ModuleName, 1102, "energy operation is not pending")
+	ErrInsufficientBalance = errors.Register(ModuleName, 1103, "insufficient energy balance")
+	ErrNoEfficiency        = errors.Register(ModuleName, 1104, "energy efficiency cannot be calculated")
+	ErrUnauthorized        = errors.Register(ModuleName, 1105, "not authorized to execute the energy operation")
+	ErrNetworkTooLarge     = errors.Register(ModuleName, 1106, "LCT network is too large to balance")
 )
@@ -23,6 +23,8 @@ var (
 	RelationshipAtpTokenKey    = collections.NewPrefix(2)
 	RelationshipAdpTokenKey    = collections.NewPrefix(3)
 	SocietyPoolKey             = collections.NewPrefix(4)
+	CreatedOperationIndexKey   = collections.NewPrefix(5)
+	TrustOperationIndexKey     = collections.NewPrefix(6)
 )
 
 // Energy operation types
@@ -43,6 +45,14 @@ const (
 	StatusFailed    = "failed"
 )
 
+// Bounds on the LCT network a network energy balance walks. Hops are counted
+// from the root component; a network reaching further, or holding more
+// components, is refused rather than balanced in part.
+const (
+	MaxNetworkDepth      = 16
+	MaxNetworkComponents = 1024
+)
+
 // ATP/ADP token statuses
 const (
 	AtpStatusActive     = "active"
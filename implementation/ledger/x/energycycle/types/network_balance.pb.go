@@ -0,0 +1,1179 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: racecarweb/energycycle/v1/network_balance.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// LctEnergyFlow is the settled energy flow through one LCT of a network.
+type LctEnergyFlow struct {
+	LctId   string `protobuf:"bytes,1,opt,name=lct_id,json=lctId,proto3" json:"lct_id,omitempty"`
+	Inflow  string `protobuf:"bytes,2,opt,name=inflow,proto3" json:"inflow,omitempty"`
+	Outflow string `protobuf:"bytes,3,opt,name=outflow,proto3" json:"outflow,omitempty"`
+	NetFlow string `protobuf:"bytes,4,opt,name=net_flow,json=netFlow,proto3" json:"net_flow,omitempty"`
+	Stored  string `protobuf:"bytes,5,opt,name=stored,proto3" json:"stored,omitempty"`
+}
+
+func (m *LctEnergyFlow) Reset()         { *m = LctEnergyFlow{} }
+func (m *LctEnergyFlow) String() string { return proto.CompactTextString(m) }
+func (*LctEnergyFlow) ProtoMessage()    {}
+func (*LctEnergyFlow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd6051e40c0f5b79, []int{0}
+}
+func (m *LctEnergyFlow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LctEnergyFlow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LctEnergyFlow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LctEnergyFlow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LctEnergyFlow.Merge(m, src)
+}
+func (m *LctEnergyFlow) XXX_Size() int {
+	return m.Size()
+}
+func (m *LctEnergyFlow) XXX_DiscardUnknown() {
+	xxx_messageInfo_LctEnergyFlow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LctEnergyFlow proto.InternalMessageInfo
+
+func (m *LctEnergyFlow) GetLctId() string {
+	if m != nil {
+		return m.LctId
+	}
+	return ""
+}
+
+func (m *LctEnergyFlow) GetInflow() string {
+	if m != nil {
+		return m.Inflow
+	}
+	return ""
+}
+
+func (m *LctEnergyFlow) GetOutflow() string {
+	if m != nil {
+		return m.Outflow
+	}
+	return ""
+}
+
+func (m *LctEnergyFlow) GetNetFlow() string {
+	if m != nil {
+		return m.NetFlow
+	}
+	return ""
+}
+
+func (m *LctEnergyFlow) GetStored() string {
+	if m != nil {
+		return m.Stored
+	}
+	return ""
+}
+
+// NetworkBalance is the energy position of every component reachable from a
+// root component through active LCTs.
+type NetworkBalance struct {
+	RootComponentId  string          `protobuf:"bytes,1,opt,name=root_component_id,json=rootComponentId,proto3" json:"root_component_id,omitempty"`
+	ComponentIds     []string        `protobuf:"bytes,2,rep,name=component_ids,json=componentIds,proto3" json:"component_ids,omitempty"`
+	Lcts             []LctEnergyFlow `protobuf:"bytes,3,rep,name=lcts,proto3" json:"lcts"`
+	TotalInflow      string          `protobuf:"bytes,4,opt,name=total_inflow,json=totalInflow,proto3" json:"total_inflow,omitempty"`
+	TotalOutflow     string          `protobuf:"bytes,5,opt,name=total_outflow,json=totalOutflow,proto3" json:"total_outflow,omitempty"`
+	InternalFlow     string          `protobuf:"bytes,6,opt,name=internal_flow,json=internalFlow,proto3" json:"internal_flow,omitempty"`
+	NetFlow          string          `protobuf:"bytes,7,opt,name=net_flow,json=netFlow,proto3" json:"net_flow,omitempty"`
+	Stored           string          `protobuf:"bytes,8,opt,name=stored,proto3" json:"stored,omitempty"`
+	Balanced         bool            `protobuf:"varint,9,opt,name=balanced,proto3" json:"balanced,omitempty"`
+	ImbalancedLctIds []string        `protobuf:"bytes,10,rep,name=imbalanced_lct_ids,json=imbalancedLctIds,proto3" json:"imbalanced_lct_ids,omitempty"`
+}
+
+func (m *NetworkBalance) Reset()         { *m = NetworkBalance{} }
+func (m *NetworkBalance) String() string { return proto.CompactTextString(m) }
+func (*NetworkBalance) ProtoMessage()    {}
+func (*NetworkBalance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd6051e40c0f5b79, []int{1}
+}
+func (m *NetworkBalance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NetworkBalance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NetworkBalance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NetworkBalance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NetworkBalance.Merge(m, src)
+}
+func (m *NetworkBalance) XXX_Size() int {
+	return m.Size()
+}
+func (m *NetworkBalance) XXX_DiscardUnknown() {
+	xxx_messageInfo_NetworkBalance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NetworkBalance proto.InternalMessageInfo
+
+func (m *NetworkBalance) GetRootComponentId() string {
+	if m != nil {
+		return m.RootComponentId
+	}
+	return ""
+}
+
+func (m *NetworkBalance) GetComponentIds() []string {
+	if m != nil {
+		return m.ComponentIds
+	}
+	return nil
+}
+
+func (m *NetworkBalance) GetLcts() []LctEnergyFlow {
+	if m != nil {
+		return m.Lcts
+	}
+	return nil
+}
+
+func (m *NetworkBalance) GetTotalInflow() string {
+	if m != nil {
+		return m.TotalInflow
+	}
+	return ""
+}
+
+func (m *NetworkBalance) GetTotalOutflow() string {
+	if m != nil {
+		return m.TotalOutflow
+	}
+	return ""
+}
+
+func (m *NetworkBalance) GetInternalFlow() string {
+	if m != nil {
+		return m.InternalFlow
+	}
+	return ""
+}
+
+func (m *NetworkBalance) GetNetFlow() string {
+	if m != nil {
+		return m.NetFlow
+	}
+	return ""
+}
+
+func (m *NetworkBalance) GetStored() string {
+	if m != nil {
+		return m.Stored
+	}
+	return ""
+}
+
+func (m *NetworkBalance) GetBalanced() bool {
+	if m != nil {
+		return m.Balanced
+	}
+	return false
+}
+
+func (m *NetworkBalance) GetImbalancedLctIds() []string {
+	if m != nil {
+		return m.ImbalancedLctIds
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*LctEnergyFlow)(nil), "racecarweb.energycycle.v1.LctEnergyFlow")
+	proto.RegisterType((*NetworkBalance)(nil), "racecarweb.energycycle.v1.NetworkBalance")
+}
+
+func init() {
+	proto.RegisterFile("racecarweb/energycycle/v1/network_balance.proto", fileDescriptor_dd6051e40c0f5b79)
+}
+
+var fileDescriptor_dd6051e40c0f5b79 = []byte{
+	// 421 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0xcf, 0x6e, 0xd3, 0x40,
+	0x10, 0xc6, 0xed, 0x3a, 0x75, 0x9d, 0x69, 0xcd, 0x9f, 0x15, 0x54, 0xdb, 0x1e, 0x5c, 0xd3, 0x5c,
+	0x2c, 0x04, 0xb6, 0x0a, 0x27, 0xae, 0x46, 0x54, 0x8a, 0x54, 0x81, 0xe4, 0x23, 0x17, 0xcb, 0x59,
+	0x2f, 0x91, 0xc5, 0x76, 0x37, 0x5a, 0x2f, 0x35, 0x79, 0x06, 0x0e, 0xf0, 0x58, 0x3d, 0xe6, 0xc8,
+	0x09, 0xa1, 0xe4, 0x45, 0x90, 0x77, 0x6d, 0xe2, 0x54, 0xca, 0xcd, 0x33, 0xdf, 0xf7, 0xd9, 0x9e,
+	0xdf, 0x0c, 0x24, 0xb2, 0x20, 0x94, 0x14, 0xb2, 0xa1, 0xb3, 0x84, 0x72, 0x2a, 0xe7, 0x4b, 0xb2,
+	0x24, 0x8c, 0x26, 0x77, 0x57, 0x09, 0xa7, 0xaa, 0x11, 0xf2, 0x6b, 0x3e, 0x2b, 0x58, 0xc1, 0x09,
+	0x8d, 0x17, 0x52, 0x28, 0x81, 0xce, 0xb6, 0x81, 0x78, 0x10, 0x88, 0xef, 0xae, 0xce, 0x9f, 0xcd,
+	0xc5, 0x5c, 0x68, 0x57, 0xd2, 0x3e, 0x99, 0xc0, 0xe5, 0x0f, 0x1b, 0xfc, 0x1b, 0xa2, 0x3e, 0x68,
+	0xef, 0x35, 0x13, 0x0d, 0x7a, 0x0e, 0x2e, 0x23, 0x2a, 0xaf, 0x4a, 0x6c, 0x87, 0x76, 0x34, 0xce,
+	0x0e, 0x19, 0x51, 0xd3, 0x12, 0x9d, 0x82, 0x5b, 0xf1, 0x2f, 0x4c, 0x34, 0xf8, 0x40, 0xb7, 0xbb,
+	0x0a, 0x61, 0x38, 0x12, 0xdf, 0x94, 0x16, 0x1c, 0x2d, 0xf4, 0x25, 0x3a, 0x03, 0x8f, 0x53, 0x95,
+	0x6b, 0x69, 0x64, 0x24, 0x4e, 0x95, 0xfe, 0xc6, 0x29, 0xb8, 0xb5, 0x12, 0x92, 0x96, 0xf8, 0xd0,
+	0xbc, 0xcc, 0x54, 0x97, 0x3f, 0x1d, 0x78, 0xf4, 0xd1, 0x0c, 0x96, 0x9a, 0xb9, 0xd0, 0x4b, 0x78,
+	0x2a, 0x85, 0x50, 0x39, 0x11, 0xb7, 0x0b, 0xc1, 0x29, 0x1f, 0xfc, 0xd9, 0xe3, 0x56, 0x78, 0xdf,
+	0xf7, 0xa7, 0x25, 0x9a, 0x80, 0x3f, 0xb4, 0xd5, 0xf8, 0x20, 0x74, 0xa2, 0x71, 0x76, 0x42, 0xb6,
+	0x9e, 0x1a, 0xa5, 0x30, 0x62, 0x44, 0xd5, 0xd8, 0x09, 0x9d, 0xe8, 0xf8, 0x4d, 0x14, 0xef, 0x25,
+	0x16, 0xef, 0x70, 0x49, 0x47, 0xf7, 0x7f, 0x2e, 0xac, 0x4c, 0x67, 0xd1, 0x0b, 0x38, 0x51, 0x42,
+	0x15, 0x2c, 0xef, 0x90, 0x98, 0xf1, 0x8e, 0x75, 0x6f, 0x6a, 0xb8, 0x4c, 0xc0, 0x37, 0x96, 0x9e,
+	0x8e, 0x99, 0xd4, 0xe4, 0x3e, 0x75, 0x88, 0x26, 0xe0, 0x57, 0x5c, 0x51, 0xc9, 0x0b, 0x66, 0x38,
+	0xb9, 0xc6, 0xd4, 0x37, 0xaf, 0x1f, 0x72, 0x3c, 0xda, 0xc7, 0xd1, 0x1b, 0x72, 0x44, 0xe7, 0xe0,
+	0x75, 0x77, 0x51, 0xe2, 0x71, 0x68, 0x47, 0x5e, 0xf6, 0xbf, 0x46, 0xaf, 0x00, 0x55, 0xb7, 0x7d,
+	0x95, 0x9b, 0x55, 0xd7, 0x18, 0x34, 0xa9, 0x27, 0x5b, 0xe5, 0xa6, 0xdd, 0x7a, 0x9d, 0xbe, 0xbb,
+	0x5f, 0x07, 0xf6, 0x6a, 0x1d, 0xd8, 0x7f, 0xd7, 0x81, 0xfd, 0x6b, 0x13, 0x58, 0xab, 0x4d, 0x60,
+	0xfd, 0xde, 0x04, 0xd6, 0xe7, 0x8b, 0x0e, 0xdc, 0xeb, 0xf6, 0x38, 0xbf, 0xef, 0x9c, 0xa7, 0x5a,
+	0x2e, 0x68, 0x3d, 0x73, 0xf5, 0x85, 0xbd, 0xfd, 0x17, 0x00, 0x00, 0xff, 0xff, 0x39, 0x25, 0x46,
+	0x94, 0xc5, 0x02, 0x00, 0x00,
+}
+
+func (m *LctEnergyFlow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LctEnergyFlow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LctEnergyFlow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Stored) > 0 {
+		i -= len(m.Stored)
+		copy(dAtA[i:], m.Stored)
+		i = encodeVarintNetworkBalance(dAtA, i, uint64(len(m.Stored)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.NetFlow) > 0 {
+		i -= len(m.NetFlow)
+		copy(dAtA[i:], m.NetFlow)
+		i = encodeVarintNetworkBalance(dAtA, i, uint64(len(m.NetFlow)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Outflow) > 0 {
+		i -= len(m.Outflow)
+		copy(dAtA[i:], m.Outflow)
+		i = encodeVarintNetworkBalance(dAtA, i, uint64(len(m.Outflow)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Inflow) > 0 {
+		i -= len(m.Inflow)
+		copy(dAtA[i:], m.Inflow)
+		i = encodeVarintNetworkBalance(dAtA, i, uint64(len(m.Inflow)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.LctId) > 0 {
+		i -= len(m.LctId)
+		copy(dAtA[i:], m.LctId)
+		i = encodeVarintNetworkBalance(dAtA, i, uint64(len(m.LctId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NetworkBalance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NetworkBalance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NetworkBalance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ImbalancedLctIds) > 0 {
+		for iNdEx := len(m.ImbalancedLctIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ImbalancedLctIds[iNdEx])
+			copy(dAtA[i:], m.ImbalancedLctIds[iNdEx])
+			i = encodeVarintNetworkBalance(dAtA, i, uint64(len(m.ImbalancedLctIds[iNdEx])))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.Balanced {
+		i--
+		if m.Balanced {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.Stored) > 0 {
+		i -= len(m.Stored)
+		copy(dAtA[i:], m.Stored)
+		i = encodeVarintNetworkBalance(dAtA, i, uint64(len(m.Stored)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.NetFlow) > 0 {
+		i -= len(m.NetFlow)
+		copy(dAtA[i:], m.NetFlow)
+		i = encodeVarintNetworkBalance(dAtA, i, uint64(len(m.NetFlow)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.InternalFlow) > 0 {
+		i -= len(m.InternalFlow)
+		copy(dAtA[i:], m.InternalFlow)
+		i = encodeVarintNetworkBalance(dAtA, i, uint64(len(m.InternalFlow)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.TotalOutflow) > 0 {
+		i -= len(m.TotalOutflow)
+		copy(dAtA[i:], m.TotalOutflow)
+		i = encodeVarintNetworkBalance(dAtA, i, uint64(len(m.TotalOutflow)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.TotalInflow) > 0 {
+		i -= len(m.TotalInflow)
+		copy(dAtA[i:], m.TotalInflow)
+		i = encodeVarintNetworkBalance(dAtA, i, uint64(len(m.TotalInflow)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Lcts) > 0 {
+		for iNdEx := len(m.Lcts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Lcts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintNetworkBalance(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ComponentIds) > 0 {
+		for iNdEx := len(m.ComponentIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ComponentIds[iNdEx])
+			copy(dAtA[i:], m.ComponentIds[iNdEx])
+			i = encodeVarintNetworkBalance(dAtA, i, uint64(len(m.ComponentIds[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.RootComponentId) > 0 {
+		i -= len(m.RootComponentId)
+		copy(dAtA[i:], m.RootComponentId)
+		i = encodeVarintNetworkBalance(dAtA, i, uint64(len(m.RootComponentId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintNetworkBalance(dAtA []byte, offset int, v uint64) int {
+	offset -= sovNetworkBalance(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *LctEnergyFlow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.LctId)
+	if l > 0 {
+		n += 1 + l + sovNetworkBalance(uint64(l))
+	}
+	l = len(m.Inflow)
+	if l > 0 {
+		n += 1 + l + sovNetworkBalance(uint64(l))
+	}
+	l = len(m.Outflow)
+	if l > 0 {
+		n += 1 + l + sovNetworkBalance(uint64(l))
+	}
+	l = len(m.NetFlow)
+	if l > 0 {
+		n += 1 + l + sovNetworkBalance(uint64(l))
+	}
+	l = len(m.Stored)
+	if l > 0 {
+		n += 1 + l + sovNetworkBalance(uint64(l))
+	}
+	return n
+}
+
+func (m *NetworkBalance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RootComponentId)
+	if l > 0 {
+		n += 1 + l + sovNetworkBalance(uint64(l))
+	}
+	if len(m.ComponentIds) > 0 {
+		for _, s := range m.ComponentIds {
+			l = len(s)
+			n += 1 + l + sovNetworkBalance(uint64(l))
+		}
+	}
+	if len(m.Lcts) > 0 {
+		for _, e := range m.Lcts {
+			l = e.Size()
+			n += 1 + l + sovNetworkBalance(uint64(l))
+		}
+	}
+	l = len(m.TotalInflow)
+	if l > 0 {
+		n += 1 + l + sovNetworkBalance(uint64(l))
+	}
+	l = len(m.TotalOutflow)
+	if l > 0 {
+		n += 1 + l + sovNetworkBalance(uint64(l))
+	}
+	l = len(m.InternalFlow)
+	if l > 0 {
+		n += 1 + l + sovNetworkBalance(uint64(l))
+	}
+	l = len(m.NetFlow)
+	if l > 0 {
+		n += 1 + l + sovNetworkBalance(uint64(l))
+	}
+	l = len(m.Stored)
+	if l > 0 {
+		n += 1 + l + sovNetworkBalance(uint64(l))
+	}
+	if m.Balanced {
+		n += 2
+	}
+	if len(m.ImbalancedLctIds) > 0 {
+		for _, s := range m.ImbalancedLctIds {
+			l = len(s)
+			n += 1 + l + sovNetworkBalance(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovNetworkBalance(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozNetworkBalance(x uint64) (n int) {
+	return sovNetworkBalance(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *LctEnergyFlow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNetworkBalance
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LctEnergyFlow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LctEnergyFlow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LctId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkBalance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNetworkBalance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetworkBalance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LctId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inflow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkBalance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNetworkBalance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetworkBalance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Inflow = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outflow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkBalance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNetworkBalance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetworkBalance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Outflow = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetFlow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkBalance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNetworkBalance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetworkBalance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NetFlow = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stored", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkBalance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNetworkBalance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetworkBalance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stored = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNetworkBalance(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNetworkBalance
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NetworkBalance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNetworkBalance
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NetworkBalance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NetworkBalance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RootComponentId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkBalance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNetworkBalance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetworkBalance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RootComponentId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ComponentIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkBalance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNetworkBalance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetworkBalance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ComponentIds = append(m.ComponentIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lcts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkBalance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNetworkBalance
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetworkBalance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Lcts = append(m.Lcts, LctEnergyFlow{})
+			if err := m.Lcts[len(m.Lcts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalInflow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkBalance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNetworkBalance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetworkBalance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalInflow = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalOutflow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkBalance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNetworkBalance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetworkBalance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalOutflow = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InternalFlow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkBalance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNetworkBalance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetworkBalance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InternalFlow = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetFlow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkBalance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNetworkBalance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetworkBalance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NetFlow = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stored", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkBalance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNetworkBalance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetworkBalance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stored = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balanced", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkBalance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Balanced = bool(v != 0)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ImbalancedLctIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkBalance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNetworkBalance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetworkBalance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ImbalancedLctIds = append(m.ImbalancedLctIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNetworkBalance(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNetworkBalance
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipNetworkBalance(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowNetworkBalance
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowNetworkBalance
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowNetworkBalance
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthNetworkBalance
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupNetworkBalance
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthNetworkBalance
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthNetworkBalance        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowNetworkBalance          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupNetworkBalance = fmt.Errorf("proto: unexpected end of group")
+)
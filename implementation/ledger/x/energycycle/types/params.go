@@ -1,13 +1,25 @@
 package types
 
+// DefaultMaxOperationsPerBlock leaves pending operations to
+// MsgExecuteEnergyTransfer; chains opt in to end-block scheduling through
+// governance
+const DefaultMaxOperationsPerBlock uint64 = 0
+
+// DefaultTrustWeightedScheduling runs higher-trust relationships first once
+// the scheduler is enabled
+const DefaultTrustWeightedScheduling = true
+
 // NewParams creates a new Params instance.
-func NewParams() Params {
-	return Params{}
+func NewParams(maxOperationsPerBlock uint64, trustWeightedScheduling bool) Params {
+	return Params{
+		MaxOperationsPerBlock:   maxOperationsPerBlock,
+		TrustWeightedScheduling: trustWeightedScheduling,
+	}
 }
 
 // DefaultParams returns a default set of parameters.
 func DefaultParams() Params {
-	return NewParams()
+	return NewParams(DefaultMaxOperationsPerBlock, DefaultTrustWeightedScheduling)
 }
 
 // Validate validates the set of params.
@@ -26,6 +26,14 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Params defines the parameters for the module.
 type Params struct {
+	// Pending energy operations the end-block scheduler executes per block.
+	// Zero disables the scheduler; operations then run only through
+	// MsgExecuteEnergyTransfer.
+	MaxOperationsPerBlock uint64 `protobuf:"varint,1,opt,name=max_operations_per_block,json=maxOperationsPerBlock,proto3" json:"max_operations_per_block,omitempty"`
+	// When true and more operations are pending than the block can execute,
+	// operations on higher-trust relationships run first. When false they run
+	// in the order they were created.
+	TrustWeightedScheduling bool `protobuf:"varint,2,opt,name=trust_weighted_scheduling,json=trustWeightedScheduling,proto3" json:"trust_weighted_scheduling,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -61,6 +69,20 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+func (m *Params) GetMaxOperationsPerBlock() uint64 {
+	if m != nil {
+		return m.MaxOperationsPerBlock
+	}
+	return 0
+}
+
+func (m *Params) GetTrustWeightedScheduling() bool {
+	if m != nil {
+		return m.TrustWeightedScheduling
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "racecarweb.energycycle.v1.Params")
 }
@@ -70,18 +92,24 @@ func init() {
 }
 
 var fileDescriptor_135b631872e68b5d = []byte{
-	// 168 bytes of a gzipped FileDescriptorProto
+	// 262 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x2b, 0x4a, 0x4c, 0x4e,
 	0x4d, 0x4e, 0x2c, 0x2a, 0x4f, 0x4d, 0xd2, 0x4f, 0xcd, 0x4b, 0x2d, 0x4a, 0xaf, 0x4c, 0xae, 0x4c,
 	0xce, 0x49, 0xd5, 0x2f, 0x33, 0xd4, 0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca,
 	0x2f, 0xc9, 0x17, 0x92, 0x44, 0xa8, 0xd3, 0x43, 0x52, 0xa7, 0x57, 0x66, 0x28, 0x25, 0x98, 0x98,
 	0x9b, 0x99, 0x97, 0xaf, 0x0f, 0x26, 0x21, 0xaa, 0xa5, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x4c,
-	0x7d, 0x10, 0x0b, 0x22, 0xaa, 0x64, 0xc4, 0xc5, 0x16, 0x00, 0x36, 0xd3, 0x4a, 0xe3, 0xc5, 0x02,
-	0x79, 0xc6, 0xae, 0xe7, 0x1b, 0xb4, 0xe4, 0x91, 0xac, 0xaf, 0x40, 0x71, 0x00, 0x44, 0xa5, 0x93,
-	0xe5, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1,
-	0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0xc1, 0xb4, 0xea, 0x62, 0xea,
-	0x2d, 0xa9, 0x2c, 0x48, 0x2d, 0x4e, 0x62, 0x03, 0xdb, 0x6a, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff,
-	0x4d, 0x73, 0x2e, 0xec, 0xe3, 0x00, 0x00, 0x00,
+	0x7d, 0x10, 0x0b, 0x22, 0xaa, 0xb4, 0x9c, 0x91, 0x8b, 0x2d, 0x00, 0x6c, 0xa8, 0x90, 0x39, 0x97,
+	0x44, 0x6e, 0x62, 0x45, 0x7c, 0x7e, 0x41, 0x6a, 0x51, 0x62, 0x49, 0x66, 0x7e, 0x5e, 0x71, 0x7c,
+	0x41, 0x6a, 0x51, 0x7c, 0x52, 0x4e, 0x7e, 0x72, 0xb6, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0x4b, 0x90,
+	0x68, 0x6e, 0x62, 0x85, 0x3f, 0x5c, 0x3a, 0x20, 0xb5, 0xc8, 0x09, 0x24, 0x29, 0x64, 0xc5, 0x25,
+	0x59, 0x52, 0x54, 0x5a, 0x5c, 0x12, 0x5f, 0x9e, 0x9a, 0x99, 0x9e, 0x51, 0x92, 0x9a, 0x12, 0x5f,
+	0x9c, 0x9c, 0x91, 0x9a, 0x52, 0x9a, 0x93, 0x99, 0x97, 0x2e, 0xc1, 0xa4, 0xc0, 0xa8, 0xc1, 0x11,
+	0x24, 0x0e, 0x56, 0x10, 0x0e, 0x95, 0x0f, 0x86, 0x4b, 0x5b, 0x69, 0xbc, 0x58, 0x20, 0xcf, 0xd8,
+	0xf5, 0x7c, 0x83, 0x96, 0x3c, 0x92, 0xa7, 0x2b, 0x50, 0xbc, 0x0d, 0x71, 0x9e, 0x93, 0xe5, 0x89,
+	0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3,
+	0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0xc1, 0xb4, 0xea, 0x62, 0xea, 0x2d, 0xa9,
+	0x2c, 0x48, 0x2d, 0x4e, 0x62, 0x03, 0xfb, 0xd5, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0x98, 0x49,
+	0xd5, 0xf0, 0x59, 0x01, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -103,6 +131,12 @@ func (this *Params) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
+	if this.MaxOperationsPerBlock != that1.MaxOperationsPerBlock {
+		return false
+	}
+	if this.TrustWeightedScheduling != that1.TrustWeightedScheduling {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -125,6 +159,21 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.TrustWeightedScheduling {
+		i--
+		if m.TrustWeightedScheduling {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MaxOperationsPerBlock != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxOperationsPerBlock))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -145,6 +194,12 @@ func (m *Params) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if m.MaxOperationsPerBlock != 0 {
+		n += 1 + sovParams(uint64(m.MaxOperationsPerBlock))
+	}
+	if m.TrustWeightedScheduling {
+		n += 2
+	}
 	return n
 }
 
@@ -183,6 +238,45 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOperationsPerBlock", wireType)
+			}
+			m.MaxOperationsPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxOperationsPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrustWeightedScheduling", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TrustWeightedScheduling = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -6,7 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
-	_ "github.com/cosmos/cosmos-sdk/types/query"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
@@ -414,6 +414,398 @@ func (m *QueryGetEnergyFlowHistoryResponse) GetEnergyOperations() string {
 	return ""
 }
 
+// QueryListEnergyOperationsRequest defines the QueryListEnergyOperationsRequest message.
+type QueryListEnergyOperationsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryListEnergyOperationsRequest) Reset()         { *m = QueryListEnergyOperationsRequest{} }
+func (m *QueryListEnergyOperationsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryListEnergyOperationsRequest) ProtoMessage()    {}
+func (*QueryListEnergyOperationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4315675bdd99eddb, []int{8}
+}
+func (m *QueryListEnergyOperationsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryListEnergyOperationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryListEnergyOperationsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryListEnergyOperationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListEnergyOperationsRequest.Merge(m, src)
+}
+func (m *QueryListEnergyOperationsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryListEnergyOperationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListEnergyOperationsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryListEnergyOperationsRequest proto.InternalMessageInfo
+
+func (m *QueryListEnergyOperationsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryListEnergyOperationsResponse defines the QueryListEnergyOperationsResponse message.
+type QueryListEnergyOperationsResponse struct {
+	Operations []EnergyOperation   `protobuf:"bytes,1,rep,name=operations,proto3" json:"operations"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryListEnergyOperationsResponse) Reset()         { *m = QueryListEnergyOperationsResponse{} }
+func (m *QueryListEnergyOperationsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryListEnergyOperationsResponse) ProtoMessage()    {}
+func (*QueryListEnergyOperationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4315675bdd99eddb, []int{9}
+}
+func (m *QueryListEnergyOperationsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryListEnergyOperationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryListEnergyOperationsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryListEnergyOperationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListEnergyOperationsResponse.Merge(m, src)
+}
+func (m *QueryListEnergyOperationsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryListEnergyOperationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListEnergyOperationsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryListEnergyOperationsResponse proto.InternalMessageInfo
+
+func (m *QueryListEnergyOperationsResponse) GetOperations() []EnergyOperation {
+	if m != nil {
+		return m.Operations
+	}
+	return nil
+}
+
+func (m *QueryListEnergyOperationsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryGetNetworkEnergyBalanceRequest defines the QueryGetNetworkEnergyBalanceRequest message.
+type QueryGetNetworkEnergyBalanceRequest struct {
+	RootComponentId string `protobuf:"bytes,1,opt,name=root_component_id,json=rootComponentId,proto3" json:"root_component_id,omitempty"`
+}
+
+func (m *QueryGetNetworkEnergyBalanceRequest) Reset()         { *m = QueryGetNetworkEnergyBalanceRequest{} }
+func (m *QueryGetNetworkEnergyBalanceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetNetworkEnergyBalanceRequest) ProtoMessage()    {}
+func (*QueryGetNetworkEnergyBalanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4315675bdd99eddb, []int{10}
+}
+func (m *QueryGetNetworkEnergyBalanceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetNetworkEnergyBalanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetNetworkEnergyBalanceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetNetworkEnergyBalanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetNetworkEnergyBalanceRequest.Merge(m, src)
+}
+func (m *QueryGetNetworkEnergyBalanceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetNetworkEnergyBalanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetNetworkEnergyBalanceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetNetworkEnergyBalanceRequest proto.InternalMessageInfo
+
+func (m *QueryGetNetworkEnergyBalanceRequest) GetRootComponentId() string {
+	if m != nil {
+		return m.RootComponentId
+	}
+	return ""
+}
+
+// QueryGetNetworkEnergyBalanceResponse defines the QueryGetNetworkEnergyBalanceResponse message.
+type QueryGetNetworkEnergyBalanceResponse struct {
+	Balance NetworkBalance `protobuf:"bytes,1,opt,name=balance,proto3" json:"balance"`
+}
+
+func (m *QueryGetNetworkEnergyBalanceResponse) Reset()         { *m = QueryGetNetworkEnergyBalanceResponse{} }
+func (m *QueryGetNetworkEnergyBalanceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetNetworkEnergyBalanceResponse) ProtoMessage()    {}
+func (*QueryGetNetworkEnergyBalanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4315675bdd99eddb, []int{11}
+}
+func (m *QueryGetNetworkEnergyBalanceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetNetworkEnergyBalanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetNetworkEnergyBalanceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetNetworkEnergyBalanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetNetworkEnergyBalanceResponse.Merge(m, src)
+}
+func (m *QueryGetNetworkEnergyBalanceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetNetworkEnergyBalanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetNetworkEnergyBalanceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetNetworkEnergyBalanceResponse proto.InternalMessageInfo
+
+func (m *QueryGetNetworkEnergyBalanceResponse) GetBalance() NetworkBalance {
+	if m != nil {
+		return m.Balance
+	}
+	return NetworkBalance{}
+}
+
+// QueryGetEnergyOperationRequest defines the QueryGetEnergyOperationRequest message.
+type QueryGetEnergyOperationRequest struct {
+	OperationId string `protobuf:"bytes,1,opt,name=operation_id,json=operationId,proto3" json:"operation_id,omitempty"`
+}
+
+func (m *QueryGetEnergyOperationRequest) Reset()         { *m = QueryGetEnergyOperationRequest{} }
+func (m *QueryGetEnergyOperationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetEnergyOperationRequest) ProtoMessage()    {}
+func (*QueryGetEnergyOperationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4315675bdd99eddb, []int{12}
+}
+func (m *QueryGetEnergyOperationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetEnergyOperationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetEnergyOperationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetEnergyOperationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetEnergyOperationRequest.Merge(m, src)
+}
+func (m *QueryGetEnergyOperationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetEnergyOperationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetEnergyOperationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetEnergyOperationRequest proto.InternalMessageInfo
+
+func (m *QueryGetEnergyOperationRequest) GetOperationId() string {
+	if m != nil {
+		return m.OperationId
+	}
+	return ""
+}
+
+// QueryGetEnergyOperationResponse defines the QueryGetEnergyOperationResponse message.
+type QueryGetEnergyOperationResponse struct {
+	Operation EnergyOperation `protobuf:"bytes,1,opt,name=operation,proto3" json:"operation"`
+}
+
+func (m *QueryGetEnergyOperationResponse) Reset()         { *m = QueryGetEnergyOperationResponse{} }
+func (m *QueryGetEnergyOperationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetEnergyOperationResponse) ProtoMessage()    {}
+func (*QueryGetEnergyOperationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4315675bdd99eddb, []int{13}
+}
+func (m *QueryGetEnergyOperationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetEnergyOperationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetEnergyOperationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetEnergyOperationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetEnergyOperationResponse.Merge(m, src)
+}
+func (m *QueryGetEnergyOperationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetEnergyOperationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetEnergyOperationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetEnergyOperationResponse proto.InternalMessageInfo
+
+func (m *QueryGetEnergyOperationResponse) GetOperation() EnergyOperation {
+	if m != nil {
+		return m.Operation
+	}
+	return EnergyOperation{}
+}
+
+// QueryGetOperationEfficiencyRequest defines the QueryGetOperationEfficiencyRequest message.
+type QueryGetOperationEfficiencyRequest struct {
+	OperationId string `protobuf:"bytes,1,opt,name=operation_id,json=operationId,proto3" json:"operation_id,omitempty"`
+}
+
+func (m *QueryGetOperationEfficiencyRequest) Reset()         { *m = QueryGetOperationEfficiencyRequest{} }
+func (m *QueryGetOperationEfficiencyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetOperationEfficiencyRequest) ProtoMessage()    {}
+func (*QueryGetOperationEfficiencyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4315675bdd99eddb, []int{14}
+}
+func (m *QueryGetOperationEfficiencyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetOperationEfficiencyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetOperationEfficiencyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetOperationEfficiencyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetOperationEfficiencyRequest.Merge(m, src)
+}
+func (m *QueryGetOperationEfficiencyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetOperationEfficiencyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetOperationEfficiencyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetOperationEfficiencyRequest proto.InternalMessageInfo
+
+func (m *QueryGetOperationEfficiencyRequest) GetOperationId() string {
+	if m != nil {
+		return m.OperationId
+	}
+	return ""
+}
+
+// QueryGetOperationEfficiencyResponse defines the QueryGetOperationEfficiencyResponse message.
+type QueryGetOperationEfficiencyResponse struct {
+	OperationId string `protobuf:"bytes,1,opt,name=operation_id,json=operationId,proto3" json:"operation_id,omitempty"`
+	EnergyIn    string `protobuf:"bytes,2,opt,name=energy_in,json=energyIn,proto3" json:"energy_in,omitempty"`
+	EnergyOut   string `protobuf:"bytes,3,opt,name=energy_out,json=energyOut,proto3" json:"energy_out,omitempty"`
+	Efficiency  string `protobuf:"bytes,4,opt,name=efficiency,proto3" json:"efficiency,omitempty"`
+}
+
+func (m *QueryGetOperationEfficiencyResponse) Reset()         { *m = QueryGetOperationEfficiencyResponse{} }
+func (m *QueryGetOperationEfficiencyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetOperationEfficiencyResponse) ProtoMessage()    {}
+func (*QueryGetOperationEfficiencyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4315675bdd99eddb, []int{15}
+}
+func (m *QueryGetOperationEfficiencyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetOperationEfficiencyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetOperationEfficiencyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetOperationEfficiencyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetOperationEfficiencyResponse.Merge(m, src)
+}
+func (m *QueryGetOperationEfficiencyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetOperationEfficiencyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetOperationEfficiencyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetOperationEfficiencyResponse proto.InternalMessageInfo
+
+func (m *QueryGetOperationEfficiencyResponse) GetOperationId() string {
+	if m != nil {
+		return m.OperationId
+	}
+	return ""
+}
+
+func (m *QueryGetOperationEfficiencyResponse) GetEnergyIn() string {
+	if m != nil {
+		return m.EnergyIn
+	}
+	return ""
+}
+
+func (m *QueryGetOperationEfficiencyResponse) GetEnergyOut() string {
+	if m != nil {
+		return m.EnergyOut
+	}
+	return ""
+}
+
+func (m *QueryGetOperationEfficiencyResponse) GetEfficiency() string {
+	if m != nil {
+		return m.Efficiency
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "racecarweb.energycycle.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "racecarweb.energycycle.v1.QueryParamsResponse")
@@ -423,6 +815,14 @@ func init() {
 	proto.RegisterType((*QueryCalculateRelationshipV3Response)(nil), "racecarweb.energycycle.v1.QueryCalculateRelationshipV3Response")
 	proto.RegisterType((*QueryGetEnergyFlowHistoryRequest)(nil), "racecarweb.energycycle.v1.QueryGetEnergyFlowHistoryRequest")
 	proto.RegisterType((*QueryGetEnergyFlowHistoryResponse)(nil), "racecarweb.energycycle.v1.QueryGetEnergyFlowHistoryResponse")
+	proto.RegisterType((*QueryListEnergyOperationsRequest)(nil), "racecarweb.energycycle.v1.QueryListEnergyOperationsRequest")
+	proto.RegisterType((*QueryListEnergyOperationsResponse)(nil), "racecarweb.energycycle.v1.QueryListEnergyOperationsResponse")
+	proto.RegisterType((*QueryGetNetworkEnergyBalanceRequest)(nil), "racecarweb.energycycle.v1.QueryGetNetworkEnergyBalanceRequest")
+	proto.RegisterType((*QueryGetNetworkEnergyBalanceResponse)(nil), "racecarweb.energycycle.v1.QueryGetNetworkEnergyBalanceResponse")
+	proto.RegisterType((*QueryGetEnergyOperationRequest)(nil), "racecarweb.energycycle.v1.QueryGetEnergyOperationRequest")
+	proto.RegisterType((*QueryGetEnergyOperationResponse)(nil), "racecarweb.energycycle.v1.QueryGetEnergyOperationResponse")
+	proto.RegisterType((*QueryGetOperationEfficiencyRequest)(nil), "racecarweb.energycycle.v1.QueryGetOperationEfficiencyRequest")
+	proto.RegisterType((*QueryGetOperationEfficiencyResponse)(nil), "racecarweb.energycycle.v1.QueryGetOperationEfficiencyResponse")
 }
 
 func init() {
@@ -430,50 +830,75 @@ func init() {
 }
 
 var fileDescriptor_4315675bdd99eddb = []byte{
-	// 678 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0x4f, 0x4f, 0x13, 0x41,
-	0x14, 0xef, 0xa2, 0x34, 0xf2, 0xca, 0x41, 0xc6, 0xaa, 0xd8, 0x90, 0x42, 0x57, 0x34, 0x88, 0xa1,
-	0x93, 0x52, 0x2f, 0x44, 0x82, 0x11, 0xca, 0xbf, 0x44, 0x23, 0x36, 0x46, 0x13, 0x3d, 0x6c, 0xa6,
-	0xdb, 0x71, 0xd9, 0x64, 0xd9, 0x59, 0x76, 0xa6, 0x5b, 0x1b, 0xc2, 0xc5, 0x4f, 0x60, 0xc2, 0x97,
-	0xf0, 0xe8, 0xa7, 0x30, 0x1c, 0x49, 0x3c, 0xe8, 0x45, 0x63, 0xc0, 0xc4, 0xf8, 0x09, 0xbc, 0x1a,
-	0x66, 0xa6, 0x4b, 0x1b, 0xed, 0xb6, 0xc1, 0x0b, 0x59, 0xde, 0xfb, 0xbd, 0xdf, 0x7b, 0xbf, 0xf7,
-	0x7e, 0x93, 0xc2, 0xad, 0x90, 0xd8, 0xd4, 0x26, 0x61, 0x93, 0xd6, 0x30, 0xf5, 0x69, 0xe8, 0xb4,
-	0xec, 0x96, 0xed, 0x51, 0x1c, 0x95, 0xf0, 0x6e, 0x83, 0x86, 0xad, 0x62, 0x10, 0x32, 0xc1, 0xd0,
-	0x8d, 0x33, 0x58, 0xb1, 0x03, 0x56, 0x8c, 0x4a, 0xb9, 0x31, 0xb2, 0xe3, 0xfa, 0x0c, 0xcb, 0xbf,
-	0x0a, 0x9d, 0x9b, 0xb5, 0x19, 0xdf, 0x61, 0x1c, 0xd7, 0x08, 0xa7, 0x8a, 0x06, 0x47, 0xa5, 0x1a,
-	0x15, 0xa4, 0x84, 0x03, 0xe2, 0xb8, 0x3e, 0x11, 0x2e, 0xf3, 0x35, 0x36, 0xeb, 0x30, 0x87, 0xc9,
-	0x4f, 0x7c, 0xfa, 0xa5, 0xa3, 0x13, 0x0e, 0x63, 0x8e, 0x47, 0x31, 0x09, 0x5c, 0x4c, 0x7c, 0x9f,
-	0x09, 0x59, 0xc2, 0x75, 0xf6, 0x76, 0xef, 0xa1, 0x03, 0x12, 0x92, 0x1d, 0x8d, 0x33, 0xb3, 0x80,
-	0x9e, 0x9e, 0x76, 0xdf, 0x92, 0xc1, 0x2a, 0xdd, 0x6d, 0x50, 0x2e, 0xcc, 0x57, 0x70, 0xa5, 0x2b,
-	0xca, 0x03, 0xe6, 0x73, 0x8a, 0x2a, 0x90, 0x56, 0xc5, 0xe3, 0xc6, 0x94, 0x31, 0x93, 0x99, 0x2f,
-	0x14, 0x7b, 0x6a, 0x2e, 0xaa, 0xd2, 0xe5, 0x91, 0xc3, 0x6f, 0x93, 0xa9, 0xf7, 0x3f, 0x3f, 0xcc,
-	0x1a, 0x55, 0x5d, 0x6b, 0x3e, 0x84, 0x19, 0x49, 0xbe, 0x4e, 0x45, 0x95, 0x7a, 0x6a, 0xea, 0x6d,
-	0x37, 0x58, 0x95, 0xf5, 0xcb, 0xc4, 0x23, 0xbe, 0x4d, 0xf5, 0x20, 0xe8, 0x2a, 0xa4, 0x3d, 0x5b,
-	0x58, 0x6e, 0x5d, 0x76, 0x1c, 0xa9, 0x0e, 0x7b, 0xb6, 0xd8, 0xac, 0x9b, 0x1f, 0x0d, 0xb8, 0x33,
-	0x00, 0x87, 0x1e, 0x7b, 0x12, 0x32, 0x44, 0x04, 0x56, 0x4d, 0x85, 0x35, 0x13, 0x10, 0x11, 0x68,
-	0xa0, 0x04, 0xd4, 0xcf, 0x00, 0x43, 0x1a, 0x50, 0x8f, 0x01, 0x05, 0x18, 0x15, 0x4c, 0x10, 0xcf,
-	0x52, 0x22, 0xc7, 0x2f, 0x48, 0x44, 0x46, 0xc6, 0x54, 0x4f, 0x74, 0x0f, 0xae, 0x89, 0xb0, 0xc1,
-	0x85, 0xd5, 0xa4, 0xae, 0xb3, 0x2d, 0x68, 0x3d, 0xa6, 0xbb, 0x28, 0xc1, 0x59, 0x99, 0x7d, 0xa1,
-	0x93, 0x9a, 0xd8, 0xdc, 0x80, 0x9b, 0x52, 0xc7, 0x0a, 0xf1, 0xec, 0x86, 0x47, 0x04, 0xed, 0x54,
-	0xf3, 0xbc, 0xdc, 0x5e, 0x43, 0x01, 0x46, 0x59, 0x40, 0x43, 0x99, 0x39, 0x5b, 0x46, 0x26, 0x8e,
-	0x6d, 0xd6, 0xcd, 0x0a, 0x4c, 0x27, 0x33, 0xe9, 0x65, 0x4c, 0x00, 0x44, 0x56, 0xd9, 0x12, 0xd4,
-	0xe7, 0x2c, 0xd4, 0x44, 0x97, 0xa2, 0xf2, 0x33, 0xf9, 0xbf, 0xb9, 0x00, 0x53, 0xed, 0xbd, 0x2a,
-	0x5d, 0x6b, 0x1e, 0x6b, 0x6e, 0xb8, 0x5c, 0xb0, 0xb0, 0xd5, 0xe7, 0x26, 0x5b, 0x50, 0x48, 0x28,
-	0xd5, 0xdd, 0xef, 0xc2, 0x98, 0x5a, 0xa1, 0x15, 0xcf, 0xce, 0x35, 0xcd, 0x65, 0x95, 0x78, 0x12,
-	0xc7, 0xe7, 0xbf, 0xa6, 0x61, 0x58, 0x52, 0xa2, 0x03, 0x03, 0xd2, 0xca, 0x50, 0x68, 0x2e, 0xc1,
-	0x73, 0x7f, 0x3b, 0x39, 0x57, 0x1c, 0x14, 0xae, 0x06, 0x34, 0x67, 0xdf, 0x7e, 0xfa, 0x71, 0x30,
-	0x34, 0x8d, 0x4c, 0xac, 0xeb, 0xe6, 0x7a, 0xbe, 0x20, 0xf4, 0xdb, 0x80, 0x89, 0x24, 0x03, 0xa2,
-	0x95, 0x7e, 0xcd, 0x07, 0x78, 0x02, 0xb9, 0xca, 0xff, 0x91, 0x68, 0x5d, 0x8f, 0xa4, 0xae, 0x35,
-	0x54, 0x49, 0xd2, 0xe5, 0x50, 0x61, 0x85, 0x1d, 0x54, 0xda, 0xee, 0x6d, 0x27, 0xe3, 0x3d, 0x75,
-	0xf7, 0x7d, 0xf4, 0xcb, 0x80, 0xeb, 0x3d, 0x8c, 0x86, 0x96, 0xfa, 0xcd, 0x9b, 0xec, 0xf5, 0xdc,
-	0x83, 0x73, 0xd7, 0x6b, 0xa9, 0x8f, 0xa5, 0xd4, 0x75, 0xb4, 0x9a, 0x24, 0xd5, 0x6e, 0x93, 0x74,
-	0x0b, 0x8e, 0xac, 0x32, 0xde, 0xeb, 0x7c, 0x6a, 0xfb, 0xe8, 0xb3, 0x01, 0xd9, 0x7f, 0x79, 0x1a,
-	0xdd, 0x1f, 0xe0, 0x30, 0xbd, 0x1e, 0x51, 0x6e, 0xf1, 0x7c, 0xc5, 0x5a, 0x62, 0x45, 0x4a, 0x5c,
-	0x42, 0x8b, 0xfd, 0xae, 0xa9, 0x0f, 0xf8, 0xda, 0x63, 0x4d, 0x6b, 0x5b, 0x91, 0xc4, 0x57, 0x5c,
-	0x5e, 0x38, 0x3c, 0xce, 0x1b, 0x47, 0xc7, 0x79, 0xe3, 0xfb, 0x71, 0xde, 0x78, 0x77, 0x92, 0x4f,
-	0x1d, 0x9d, 0xe4, 0x53, 0x5f, 0x4e, 0xf2, 0xa9, 0x97, 0x93, 0x9d, 0xb4, 0x6f, 0xba, 0x88, 0x45,
-	0x2b, 0xa0, 0xbc, 0x96, 0x96, 0xbf, 0x1e, 0xe5, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xef, 0xa3,
-	0x21, 0xbc, 0x1c, 0x07, 0x00, 0x00,
+	// 1073 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x57, 0x41, 0x6f, 0x1b, 0x45,
+	0x14, 0xce, 0xa6, 0x6d, 0x68, 0x9e, 0x8b, 0x20, 0x43, 0x68, 0x8b, 0x09, 0x4e, 0xb3, 0x94, 0x92,
+	0x06, 0x65, 0x97, 0x24, 0x70, 0x08, 0x6d, 0x53, 0x35, 0x76, 0xe2, 0x58, 0x2a, 0x69, 0x6a, 0x21,
+	0x90, 0xe0, 0xb0, 0x5a, 0xaf, 0x27, 0xce, 0xc2, 0x66, 0x67, 0xb3, 0x3b, 0xb6, 0xb1, 0xaa, 0x5c,
+	0xf8, 0x05, 0x48, 0xfd, 0x0b, 0x1c, 0x38, 0xf2, 0x0b, 0x38, 0xa2, 0x48, 0x5c, 0x22, 0x71, 0x80,
+	0x13, 0xaa, 0x12, 0x24, 0x04, 0x7f, 0x80, 0x2b, 0xf2, 0xcc, 0x5b, 0xef, 0xda, 0xf1, 0xee, 0x3a,
+	0xe9, 0x25, 0xb2, 0x67, 0xde, 0xfb, 0xe6, 0xfb, 0xde, 0xbc, 0x37, 0x5f, 0x0c, 0xef, 0xf9, 0xa6,
+	0x45, 0x2d, 0xd3, 0x6f, 0xd3, 0x9a, 0x4e, 0x5d, 0xea, 0x37, 0x3a, 0x56, 0xc7, 0x72, 0xa8, 0xde,
+	0x5a, 0xd2, 0x0f, 0x9a, 0xd4, 0xef, 0x68, 0x9e, 0xcf, 0x38, 0x23, 0x6f, 0x45, 0x61, 0x5a, 0x2c,
+	0x4c, 0x6b, 0x2d, 0xe5, 0xa7, 0xcc, 0x7d, 0xdb, 0x65, 0xba, 0xf8, 0x2b, 0xa3, 0xf3, 0x0b, 0x16,
+	0x0b, 0xf6, 0x59, 0xa0, 0xd7, 0xcc, 0x80, 0x4a, 0x18, 0xbd, 0xb5, 0x54, 0xa3, 0xdc, 0x5c, 0xd2,
+	0x3d, 0xb3, 0x61, 0xbb, 0x26, 0xb7, 0x99, 0x8b, 0xb1, 0xd3, 0x0d, 0xd6, 0x60, 0xe2, 0xa3, 0xde,
+	0xfd, 0x84, 0xab, 0x33, 0x0d, 0xc6, 0x1a, 0x0e, 0xd5, 0x4d, 0xcf, 0xd6, 0x4d, 0xd7, 0x65, 0x5c,
+	0xa4, 0x04, 0xb8, 0xfb, 0x61, 0x32, 0x69, 0xf9, 0xd5, 0x60, 0x1e, 0xf5, 0xe3, 0xa7, 0xe8, 0xc9,
+	0x19, 0x2e, 0xe5, 0x6d, 0xe6, 0x7f, 0x63, 0xd4, 0x4c, 0xc7, 0x74, 0x2d, 0x8a, 0x09, 0x77, 0x92,
+	0x13, 0x3c, 0xd3, 0x37, 0xf7, 0x91, 0x8a, 0x3a, 0x0d, 0xe4, 0x69, 0x57, 0xe0, 0x8e, 0x58, 0xac,
+	0xd2, 0x83, 0x26, 0x0d, 0xb8, 0xfa, 0x15, 0xbc, 0xd1, 0xb7, 0x1a, 0x78, 0xcc, 0x0d, 0x28, 0x29,
+	0xc1, 0x84, 0x4c, 0xbe, 0xa9, 0xdc, 0x52, 0xe6, 0x73, 0xcb, 0x73, 0x5a, 0x62, 0x59, 0x35, 0x99,
+	0xba, 0x3e, 0x79, 0xf4, 0xe7, 0xec, 0xd8, 0x8f, 0x7f, 0xff, 0xb4, 0xa0, 0x54, 0x31, 0x57, 0x7d,
+	0x04, 0xf3, 0x02, 0xbc, 0x4c, 0x79, 0x95, 0x3a, 0xb2, 0x30, 0x7b, 0xb6, 0xb7, 0x21, 0xf2, 0xd7,
+	0xa5, 0x0a, 0x24, 0x42, 0xde, 0x84, 0x09, 0xc7, 0xe2, 0x86, 0x5d, 0x17, 0x27, 0x4e, 0x56, 0xaf,
+	0x38, 0x16, 0xaf, 0xd4, 0xd5, 0x5f, 0x14, 0xb8, 0x3b, 0x02, 0x06, 0xd2, 0x9e, 0x85, 0x9c, 0xc9,
+	0xbd, 0xb0, 0x40, 0x88, 0x04, 0x26, 0xf7, 0x30, 0x50, 0x04, 0xd4, 0xa3, 0x80, 0x71, 0x0c, 0xa8,
+	0xf7, 0x02, 0xe6, 0xe0, 0x1a, 0x67, 0xdc, 0x74, 0x0c, 0x29, 0xf2, 0xe6, 0x25, 0x11, 0x91, 0x13,
+	0x6b, 0xf2, 0x4c, 0xf2, 0x11, 0x5c, 0xe7, 0x7e, 0x33, 0xe0, 0x46, 0x9b, 0xda, 0x8d, 0x3d, 0x4e,
+	0xeb, 0x3d, 0xb8, 0xcb, 0x22, 0x78, 0x5a, 0xec, 0x7e, 0x81, 0x9b, 0x08, 0xac, 0x6e, 0xc1, 0xbb,
+	0x42, 0x47, 0xd1, 0x74, 0xac, 0xa6, 0x63, 0x72, 0x1a, 0x57, 0xf3, 0xf9, 0x4a, 0x58, 0x86, 0x39,
+	0xb8, 0xd6, 0xeb, 0x88, 0xa8, 0x18, 0xb9, 0xde, 0x5a, 0xa5, 0xae, 0x96, 0xe0, 0x76, 0x3a, 0x12,
+	0x16, 0x63, 0x06, 0xa0, 0x65, 0xac, 0x18, 0x9c, 0xba, 0x01, 0xf3, 0x11, 0xe8, 0x6a, 0x6b, 0xe5,
+	0x33, 0xf1, 0x5d, 0x5d, 0x85, 0x5b, 0x61, 0x5d, 0xa5, 0xae, 0x4d, 0x87, 0xb5, 0xb7, 0xec, 0x80,
+	0x33, 0xbf, 0x93, 0x71, 0x27, 0x3b, 0x30, 0x97, 0x92, 0x8a, 0xa7, 0x7f, 0x00, 0x53, 0x83, 0x1d,
+	0x1e, 0x20, 0xcc, 0xeb, 0x72, 0xe3, 0x49, 0x6f, 0x5d, 0xfd, 0x1a, 0xc9, 0x3c, 0xb6, 0x03, 0x84,
+	0x8c, 0x36, 0x43, 0x32, 0x9b, 0x00, 0xd1, 0x48, 0x62, 0x5b, 0xde, 0xd1, 0xe4, 0xfc, 0x6a, 0xdd,
+	0xf9, 0xd5, 0xe4, 0x33, 0x80, 0xf3, 0xab, 0xed, 0x98, 0x8d, 0xb0, 0xb9, 0xaa, 0xb1, 0x4c, 0xf5,
+	0x67, 0x05, 0xe9, 0x0f, 0x3f, 0x0c, 0xe9, 0xef, 0x00, 0xf4, 0xf1, 0xbe, 0x34, 0x9f, 0x5b, 0x5e,
+	0x48, 0x19, 0x82, 0x01, 0xa0, 0xf5, 0xcb, 0xdd, 0x69, 0xa8, 0xc6, 0x30, 0x48, 0xb9, 0x8f, 0xff,
+	0xb8, 0xe0, 0xff, 0x7e, 0x26, 0x7f, 0x49, 0xa7, 0x4f, 0xc0, 0x53, 0xec, 0xa4, 0x32, 0xe5, 0xdb,
+	0xf2, 0x45, 0x18, 0x3a, 0x50, 0x0b, 0x30, 0xe5, 0x33, 0xc6, 0x0d, 0x8b, 0xed, 0x7b, 0xcc, 0xa5,
+	0x6e, 0xec, 0x1e, 0x5f, 0xeb, 0x6e, 0x14, 0xc3, 0xf5, 0x4a, 0x5d, 0x3d, 0xc0, 0x96, 0x4a, 0x84,
+	0xc4, 0xaa, 0x54, 0xe0, 0x95, 0xf8, 0x6c, 0xe5, 0x96, 0xef, 0xa6, 0x94, 0x04, 0x91, 0x10, 0x03,
+	0x2b, 0x12, 0xe6, 0xab, 0x45, 0x28, 0xf4, 0x37, 0x51, 0xaf, 0x76, 0xe7, 0x18, 0x85, 0x03, 0x98,
+	0x4d, 0x04, 0x41, 0xca, 0xdb, 0x30, 0xd9, 0xcb, 0x40, 0xd2, 0xe7, 0xbf, 0xc7, 0x08, 0x42, 0x2d,
+	0x83, 0x1a, 0x1e, 0xd9, 0x8b, 0xda, 0xd8, 0xdd, 0xb5, 0x2d, 0x9b, 0xba, 0x56, 0xe7, 0x1c, 0xdc,
+	0x7f, 0x50, 0xa2, 0x7b, 0x1c, 0x8a, 0x84, 0x02, 0xb2, 0xa1, 0xc8, 0xdb, 0x30, 0x89, 0xb3, 0x66,
+	0xbb, 0xf8, 0xa6, 0x5d, 0x95, 0x0b, 0x15, 0x97, 0xbc, 0x03, 0x10, 0x0e, 0x62, 0x93, 0xe3, 0x7b,
+	0x86, 0xe1, 0x4f, 0x9a, 0x9c, 0x14, 0x00, 0x68, 0xef, 0x50, 0x7c, 0xc1, 0x62, 0x2b, 0xcb, 0xbf,
+	0xbe, 0x0a, 0x57, 0x04, 0x4d, 0xf2, 0x5c, 0x81, 0x09, 0xf9, 0xd6, 0x93, 0xc5, 0x94, 0x0a, 0x9e,
+	0x35, 0x99, 0xbc, 0x36, 0x6a, 0xb8, 0x94, 0xac, 0x2e, 0x7c, 0xf7, 0xdb, 0x5f, 0xcf, 0xc7, 0x6f,
+	0x13, 0x35, 0x34, 0xc3, 0xc5, 0x44, 0x73, 0x23, 0xff, 0x29, 0x30, 0x93, 0xe6, 0x0d, 0xa4, 0x98,
+	0x75, 0xf8, 0x08, 0xee, 0x94, 0x2f, 0xbd, 0x1c, 0x08, 0xea, 0x7a, 0x2c, 0x74, 0x6d, 0x92, 0x52,
+	0x9a, 0xae, 0x06, 0xe5, 0x86, 0x1f, 0x83, 0x42, 0x27, 0x0a, 0x4d, 0x46, 0x7f, 0x26, 0x9f, 0xe4,
+	0x43, 0xf2, 0x8f, 0x02, 0x37, 0x12, 0x3c, 0x80, 0xac, 0x65, 0xf1, 0x4d, 0xb7, 0xa1, 0xfc, 0xc3,
+	0x0b, 0xe7, 0xa3, 0xd4, 0x4f, 0x85, 0xd4, 0x32, 0xd9, 0x48, 0x93, 0x6a, 0x85, 0x20, 0xfd, 0x82,
+	0x5b, 0xc6, 0x8a, 0xfe, 0x2c, 0xde, 0xf3, 0x87, 0xe4, 0x77, 0x05, 0xa6, 0x87, 0xd9, 0x0d, 0xb9,
+	0x37, 0xc2, 0xc5, 0x24, 0xf9, 0x5b, 0xfe, 0xfe, 0xc5, 0x92, 0x51, 0x62, 0x49, 0x48, 0x5c, 0x23,
+	0xf7, 0xb3, 0x6e, 0x13, 0x2f, 0x70, 0xd7, 0x61, 0x6d, 0x63, 0x4f, 0x82, 0x44, 0xb7, 0x78, 0xa4,
+	0xc0, 0xf4, 0x30, 0x27, 0xca, 0x56, 0x96, 0x62, 0x96, 0xd9, 0xca, 0xd2, 0xcc, 0x4f, 0xfd, 0x58,
+	0x28, 0xd3, 0xc9, 0x62, 0x9a, 0xb2, 0x33, 0xee, 0x4e, 0xfe, 0x55, 0xe0, 0x46, 0x82, 0x83, 0x64,
+	0x37, 0x64, 0xba, 0x9b, 0x65, 0x37, 0x64, 0x86, 0x75, 0xa9, 0xdb, 0x42, 0xd3, 0x16, 0xd9, 0x4c,
+	0xd3, 0x14, 0xfe, 0x87, 0x3d, 0x38, 0x72, 0x67, 0x8c, 0xf4, 0x90, 0x1c, 0x2b, 0x40, 0xce, 0xda,
+	0x0e, 0x59, 0x1d, 0xb9, 0xa5, 0x06, 0xfd, 0x2e, 0xff, 0xc9, 0x45, 0x52, 0x51, 0x5d, 0x51, 0xa8,
+	0x7b, 0x40, 0xee, 0x9d, 0xe7, 0xc6, 0x06, 0x87, 0xec, 0x85, 0x02, 0xd7, 0x87, 0x9b, 0x11, 0x79,
+	0x30, 0x02, 0xb7, 0x64, 0x3b, 0xcc, 0xaf, 0x5d, 0x34, 0x1d, 0xe5, 0x95, 0x85, 0xbc, 0x47, 0xe4,
+	0x61, 0x9a, 0xbc, 0x48, 0x4c, 0x64, 0x5f, 0x03, 0x12, 0xd7, 0x57, 0x8f, 0x4e, 0x0a, 0xca, 0xf1,
+	0x49, 0x41, 0x79, 0x71, 0x52, 0x50, 0xbe, 0x3f, 0x2d, 0x8c, 0x1d, 0x9f, 0x16, 0xc6, 0xfe, 0x38,
+	0x2d, 0x8c, 0x7d, 0x39, 0x1b, 0x47, 0xfe, 0xb6, 0x0f, 0x9b, 0x77, 0x3c, 0x1a, 0xd4, 0x26, 0xc4,
+	0xcf, 0xa8, 0x95, 0xff, 0x03, 0x00, 0x00, 0xff, 0xff, 0xd8, 0x04, 0xf6, 0xd7, 0x88, 0x0e, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -496,6 +921,14 @@ type QueryClient interface {
 	CalculateRelationshipV3(ctx context.Context, in *QueryCalculateRelationshipV3Request, opts ...grpc.CallOption) (*QueryCalculateRelationshipV3Response, error)
 	// GetEnergyFlowHistory Queries a list of GetEnergyFlowHistory items.
 	GetEnergyFlowHistory(ctx context.Context, in *QueryGetEnergyFlowHistoryRequest, opts ...grpc.CallOption) (*QueryGetEnergyFlowHistoryResponse, error)
+	// ListEnergyOperations Queries a page of energy operations.
+	ListEnergyOperations(ctx context.Context, in *QueryListEnergyOperationsRequest, opts ...grpc.CallOption) (*QueryListEnergyOperationsResponse, error)
+	// GetNetworkEnergyBalance Queries the energy balance across the LCT network reachable from a component.
+	GetNetworkEnergyBalance(ctx context.Context, in *QueryGetNetworkEnergyBalanceRequest, opts ...grpc.CallOption) (*QueryGetNetworkEnergyBalanceResponse, error)
+	// GetEnergyOperation Queries an energy operation by its ID.
+	GetEnergyOperation(ctx context.Context, in *QueryGetEnergyOperationRequest, opts ...grpc.CallOption) (*QueryGetEnergyOperationResponse, error)
+	// GetOperationEfficiency Queries the ratio of energy delivered to energy drawn by an operation.
+	GetOperationEfficiency(ctx context.Context, in *QueryGetOperationEfficiencyRequest, opts ...grpc.CallOption) (*QueryGetOperationEfficiencyResponse, error)
 }
 
 type queryClient struct {
@@ -542,6 +975,42 @@ func (c *queryClient) GetEnergyFlowHistory(ctx context.Context, in *QueryGetEner
 	return out, nil
 }
 
+func (c *queryClient) ListEnergyOperations(ctx context.Context, in *QueryListEnergyOperationsRequest, opts ...grpc.CallOption) (*QueryListEnergyOperationsResponse, error) {
+	out := new(QueryListEnergyOperationsResponse)
+	err := c.cc.Invoke(ctx, "/racecarweb.energycycle.v1.Query/ListEnergyOperations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetNetworkEnergyBalance(ctx context.Context, in *QueryGetNetworkEnergyBalanceRequest, opts ...grpc.CallOption) (*QueryGetNetworkEnergyBalanceResponse, error) {
+	out := new(QueryGetNetworkEnergyBalanceResponse)
+	err := c.cc.Invoke(ctx, "/racecarweb.energycycle.v1.Query/GetNetworkEnergyBalance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetEnergyOperation(ctx context.Context, in *QueryGetEnergyOperationRequest, opts ...grpc.CallOption) (*QueryGetEnergyOperationResponse, error) {
+	out := new(QueryGetEnergyOperationResponse)
+	err := c.cc.Invoke(ctx, "/racecarweb.energycycle.v1.Query/GetEnergyOperation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetOperationEfficiency(ctx context.Context, in *QueryGetOperationEfficiencyRequest, opts ...grpc.CallOption) (*QueryGetOperationEfficiencyResponse, error) {
+	out := new(QueryGetOperationEfficiencyResponse)
+	err := c.cc.Invoke(ctx, "/racecarweb.energycycle.v1.Query/GetOperationEfficiency", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Parameters queries the parameters of the module.
@@ -552,6 +1021,14 @@ type QueryServer interface {
 	CalculateRelationshipV3(context.Context, *QueryCalculateRelationshipV3Request) (*QueryCalculateRelationshipV3Response, error)
 	// GetEnergyFlowHistory Queries a list of GetEnergyFlowHistory items.
 	GetEnergyFlowHistory(context.Context, *QueryGetEnergyFlowHistoryRequest) (*QueryGetEnergyFlowHistoryResponse, error)
+	// ListEnergyOperations Queries a page of energy operations.
+	ListEnergyOperations(context.Context, *QueryListEnergyOperationsRequest) (*QueryListEnergyOperationsResponse, error)
+	// GetNetworkEnergyBalance Queries the energy balance across the LCT network reachable from a component.
+	GetNetworkEnergyBalance(context.Context, *QueryGetNetworkEnergyBalanceRequest) (*QueryGetNetworkEnergyBalanceResponse, error)
+	// GetEnergyOperation Queries an energy operation by its ID.
+	GetEnergyOperation(context.Context, *QueryGetEnergyOperationRequest) (*QueryGetEnergyOperationResponse, error)
+	// GetOperationEfficiency Queries the ratio of energy delivered to energy drawn by an operation.
+	GetOperationEfficiency(context.Context, *QueryGetOperationEfficiencyRequest) (*QueryGetOperationEfficiencyResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -570,6 +1047,18 @@ func (*UnimplementedQueryServer) CalculateRelationshipV3(ctx context.Context, re
 func (*UnimplementedQueryServer) GetEnergyFlowHistory(ctx context.Context, req *QueryGetEnergyFlowHistoryRequest) (*QueryGetEnergyFlowHistoryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetEnergyFlowHistory not implemented")
 }
+func (*UnimplementedQueryServer) ListEnergyOperations(ctx context.Context, req *QueryListEnergyOperationsRequest) (*QueryListEnergyOperationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEnergyOperations not implemented")
+}
+func (*UnimplementedQueryServer) GetNetworkEnergyBalance(ctx context.Context, req *QueryGetNetworkEnergyBalanceRequest) (*QueryGetNetworkEnergyBalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNetworkEnergyBalance not implemented")
+}
+func (*UnimplementedQueryServer) GetEnergyOperation(ctx context.Context, req *QueryGetEnergyOperationRequest) (*QueryGetEnergyOperationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEnergyOperation not implemented")
+}
+func (*UnimplementedQueryServer) GetOperationEfficiency(ctx context.Context, req *QueryGetOperationEfficiencyRequest) (*QueryGetOperationEfficiencyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOperationEfficiency not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -647,6 +1136,78 @@ func _Query_GetEnergyFlowHistory_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ListEnergyOperations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListEnergyOperationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListEnergyOperations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/racecarweb.energycycle.v1.Query/ListEnergyOperations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListEnergyOperations(ctx, req.(*QueryListEnergyOperationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetNetworkEnergyBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetNetworkEnergyBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetNetworkEnergyBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/racecarweb.energycycle.v1.Query/GetNetworkEnergyBalance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetNetworkEnergyBalance(ctx, req.(*QueryGetNetworkEnergyBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetEnergyOperation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetEnergyOperationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetEnergyOperation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/racecarweb.energycycle.v1.Query/GetEnergyOperation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetEnergyOperation(ctx, req.(*QueryGetEnergyOperationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetOperationEfficiency_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetOperationEfficiencyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetOperationEfficiency(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/racecarweb.energycycle.v1.Query/GetOperationEfficiency",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetOperationEfficiency(ctx, req.(*QueryGetOperationEfficiencyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "racecarweb.energycycle.v1.Query",
@@ -668,6 +1229,22 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetEnergyFlowHistory",
 			Handler:    _Query_GetEnergyFlowHistory_Handler,
 		},
+		{
+			MethodName: "ListEnergyOperations",
+			Handler:    _Query_ListEnergyOperations_Handler,
+		},
+		{
+			MethodName: "GetNetworkEnergyBalance",
+			Handler:    _Query_GetNetworkEnergyBalance_Handler,
+		},
+		{
+			MethodName: "GetEnergyOperation",
+			Handler:    _Query_GetEnergyOperation_Handler,
+		},
+		{
+			MethodName: "GetOperationEfficiency",
+			Handler:    _Query_GetOperationEfficiency_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "racecarweb/energycycle/v1/query.proto",
@@ -930,134 +1507,1182 @@ func (m *QueryGetEnergyFlowHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (i
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryListEnergyOperationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryListEnergyOperationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetRelationshipEnergyBalanceRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryListEnergyOperationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.LctId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetRelationshipEnergyBalanceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryListEnergyOperationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListEnergyOperationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListEnergyOperationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.AtpBalance)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.AdpBalance)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.TotalEnergy)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.TrustWeightedBalance)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Operations) > 0 {
+		for iNdEx := len(m.Operations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Operations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryCalculateRelationshipV3Request) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetNetworkEnergyBalanceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetNetworkEnergyBalanceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetNetworkEnergyBalanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.OperationId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.RootComponentId) > 0 {
+		i -= len(m.RootComponentId)
+		copy(dAtA[i:], m.RootComponentId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RootComponentId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryCalculateRelationshipV3Response) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetNetworkEnergyBalanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetNetworkEnergyBalanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetNetworkEnergyBalanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.V_3Tensor)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.Balance.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetEnergyFlowHistoryRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetEnergyOperationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetEnergyOperationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetEnergyOperationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.LctId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.OperationId) > 0 {
+		i -= len(m.OperationId)
+		copy(dAtA[i:], m.OperationId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OperationId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetEnergyFlowHistoryResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.EnergyOperations)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryGetEnergyOperationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func sovQuery(x uint64) (n int) {
+func (m *QueryGetEnergyOperationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetEnergyOperationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Operation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetOperationEfficiencyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetOperationEfficiencyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetOperationEfficiencyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OperationId) > 0 {
+		i -= len(m.OperationId)
+		copy(dAtA[i:], m.OperationId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OperationId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetOperationEfficiencyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetOperationEfficiencyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetOperationEfficiencyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Efficiency) > 0 {
+		i -= len(m.Efficiency)
+		copy(dAtA[i:], m.Efficiency)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Efficiency)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.EnergyOut) > 0 {
+		i -= len(m.EnergyOut)
+		copy(dAtA[i:], m.EnergyOut)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.EnergyOut)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.EnergyIn) > 0 {
+		i -= len(m.EnergyIn)
+		copy(dAtA[i:], m.EnergyIn)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.EnergyIn)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OperationId) > 0 {
+		i -= len(m.OperationId)
+		copy(dAtA[i:], m.OperationId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OperationId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetRelationshipEnergyBalanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.LctId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetRelationshipEnergyBalanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AtpBalance)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.AdpBalance)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.TotalEnergy)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.TrustWeightedBalance)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCalculateRelationshipV3Request) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperationId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCalculateRelationshipV3Response) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.V_3Tensor)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetEnergyFlowHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.LctId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetEnergyFlowHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EnergyOperations)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListEnergyOperationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListEnergyOperationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Operations) > 0 {
+		for _, e := range m.Operations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetNetworkEnergyBalanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RootComponentId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetNetworkEnergyBalanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Balance.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetEnergyOperationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperationId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetEnergyOperationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Operation.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetOperationEfficiencyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperationId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetOperationEfficiencyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperationId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.EnergyIn)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.EnergyOut)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Efficiency)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetRelationshipEnergyBalanceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetRelationshipEnergyBalanceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetRelationshipEnergyBalanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LctId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LctId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetRelationshipEnergyBalanceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetRelationshipEnergyBalanceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetRelationshipEnergyBalanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AtpBalance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AtpBalance = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdpBalance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AdpBalance = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalEnergy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalEnergy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrustWeightedBalance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TrustWeightedBalance = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCalculateRelationshipV3Request) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCalculateRelationshipV3Request: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCalculateRelationshipV3Request: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperationId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperationId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCalculateRelationshipV3Response) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCalculateRelationshipV3Response: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCalculateRelationshipV3Response: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field V_3Tensor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.V_3Tensor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetEnergyFlowHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetEnergyFlowHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetEnergyFlowHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LctId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LctId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetEnergyFlowHistoryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1080,12 +2705,130 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetEnergyFlowHistoryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetEnergyFlowHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnergyOperations", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EnergyOperations = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListEnergyOperationsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListEnergyOperationsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListEnergyOperationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1107,7 +2850,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryListEnergyOperationsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1126,19 +2869,53 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListEnergyOperationsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListEnergyOperationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operations = append(m.Operations, EnergyOperation{})
+			if err := m.Operations[len(m.Operations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1165,7 +2942,10 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1190,7 +2970,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetRelationshipEnergyBalanceRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetNetworkEnergyBalanceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1213,15 +2993,15 @@ func (m *QueryGetRelationshipEnergyBalanceRequest) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetRelationshipEnergyBalanceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetNetworkEnergyBalanceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetRelationshipEnergyBalanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetNetworkEnergyBalanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LctId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RootComponentId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1249,7 +3029,7 @@ func (m *QueryGetRelationshipEnergyBalanceRequest) Unmarshal(dAtA []byte) error
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LctId = string(dAtA[iNdEx:postIndex])
+			m.RootComponentId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1272,7 +3052,7 @@ func (m *QueryGetRelationshipEnergyBalanceRequest) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryGetRelationshipEnergyBalanceResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetNetworkEnergyBalanceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1295,81 +3075,17 @@ func (m *QueryGetRelationshipEnergyBalanceResponse) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetRelationshipEnergyBalanceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetNetworkEnergyBalanceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetRelationshipEnergyBalanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetNetworkEnergyBalanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AtpBalance", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.AtpBalance = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AdpBalance", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.AdpBalance = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalEnergy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1379,55 +3095,24 @@ func (m *QueryGetRelationshipEnergyBalanceResponse) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TotalEnergy = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TrustWeightedBalance", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.TrustWeightedBalance = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1450,7 +3135,7 @@ func (m *QueryGetRelationshipEnergyBalanceResponse) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryCalculateRelationshipV3Request) Unmarshal(dAtA []byte) error {
+func (m *QueryGetEnergyOperationRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1473,10 +3158,10 @@ func (m *QueryCalculateRelationshipV3Request) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCalculateRelationshipV3Request: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetEnergyOperationRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCalculateRelationshipV3Request: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetEnergyOperationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1532,7 +3217,7 @@ func (m *QueryCalculateRelationshipV3Request) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCalculateRelationshipV3Response) Unmarshal(dAtA []byte) error {
+func (m *QueryGetEnergyOperationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1555,17 +3240,17 @@ func (m *QueryCalculateRelationshipV3Response) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCalculateRelationshipV3Response: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetEnergyOperationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCalculateRelationshipV3Response: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetEnergyOperationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field V_3Tensor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operation", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1575,23 +3260,24 @@ func (m *QueryCalculateRelationshipV3Response) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.V_3Tensor = string(dAtA[iNdEx:postIndex])
+			if err := m.Operation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1614,7 +3300,7 @@ func (m *QueryCalculateRelationshipV3Response) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetEnergyFlowHistoryRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetOperationEfficiencyRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1637,15 +3323,15 @@ func (m *QueryGetEnergyFlowHistoryRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetEnergyFlowHistoryRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetOperationEfficiencyRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetEnergyFlowHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetOperationEfficiencyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LctId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OperationId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1673,7 +3359,7 @@ func (m *QueryGetEnergyFlowHistoryRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LctId = string(dAtA[iNdEx:postIndex])
+			m.OperationId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1696,7 +3382,7 @@ func (m *QueryGetEnergyFlowHistoryRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetEnergyFlowHistoryResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetOperationEfficiencyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1719,15 +3405,15 @@ func (m *QueryGetEnergyFlowHistoryResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetEnergyFlowHistoryResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetOperationEfficiencyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetEnergyFlowHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetOperationEfficiencyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnergyOperations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OperationId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1755,7 +3441,103 @@ func (m *QueryGetEnergyFlowHistoryResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EnergyOperations = string(dAtA[iNdEx:postIndex])
+			m.OperationId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnergyIn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EnergyIn = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnergyOut", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EnergyOut = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Efficiency", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Efficiency = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -709,6 +709,9 @@ type MsgExecuteEnergyTransfer struct {
 	Creator      string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
 	OperationId  string `protobuf:"bytes,2,opt,name=operation_id,json=operationId,proto3" json:"operation_id,omitempty"`
 	TransferData string `protobuf:"bytes,3,opt,name=transfer_data,json=transferData,proto3" json:"transfer_data,omitempty"`
+	// energy_out is the energy measured arriving at the target. Empty means the
+	// whole operation amount arrived.
+	EnergyOut string `protobuf:"bytes,4,opt,name=energy_out,json=energyOut,proto3" json:"energy_out,omitempty"`
 }
 
 func (m *MsgExecuteEnergyTransfer) Reset()         { *m = MsgExecuteEnergyTransfer{} }
@@ -765,8 +768,19 @@ func (m *MsgExecuteEnergyTransfer) GetTransferData() string {
 	return ""
 }
 
+func (m *MsgExecuteEnergyTransfer) GetEnergyOut() string {
+	if m != nil {
+		return m.EnergyOut
+	}
+	return ""
+}
+
 // MsgExecuteEnergyTransferResponse defines the MsgExecuteEnergyTransferResponse message.
 type MsgExecuteEnergyTransferResponse struct {
+	// source_balance and target_balance are the energy balances of the
+	// operation's LCTs after the transfer
+	SourceBalance string `protobuf:"bytes,1,opt,name=source_balance,json=sourceBalance,proto3" json:"source_balance,omitempty"`
+	TargetBalance string `protobuf:"bytes,2,opt,name=target_balance,json=targetBalance,proto3" json:"target_balance,omitempty"`
 }
 
 func (m *MsgExecuteEnergyTransferResponse) Reset()         { *m = MsgExecuteEnergyTransferResponse{} }
@@ -802,6 +816,20 @@ func (m *MsgExecuteEnergyTransferResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgExecuteEnergyTransferResponse proto.InternalMessageInfo
 
+func (m *MsgExecuteEnergyTransferResponse) GetSourceBalance() string {
+	if m != nil {
+		return m.SourceBalance
+	}
+	return ""
+}
+
+func (m *MsgExecuteEnergyTransferResponse) GetTargetBalance() string {
+	if m != nil {
+		return m.TargetBalance
+	}
+	return ""
+}
+
 // MsgValidateRelationshipValue defines the MsgValidateRelationshipValue message.
 type MsgValidateRelationshipValue struct {
 	Creator             string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
@@ -954,81 +982,83 @@ func init() {
 }
 
 var fileDescriptor_a8d02ba67591d698 = []byte{
-	// 1178 bytes of a gzipped FileDescriptorProto
+	// 1212 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0xbf, 0x4f, 0x23, 0x47,
-	0x14, 0x66, 0x21, 0xe0, 0xf3, 0xc3, 0xc6, 0xc9, 0x86, 0x03, 0x63, 0xdd, 0x19, 0x30, 0xb9, 0x3b,
-	0x0e, 0x05, 0x2c, 0x40, 0x4a, 0x94, 0xbb, 0xe2, 0xc4, 0x8f, 0x53, 0x84, 0x74, 0x56, 0x90, 0x21,
-	0x14, 0x69, 0x56, 0xc3, 0xee, 0xdc, 0xb2, 0xc2, 0x3b, 0xb3, 0x9a, 0x19, 0x03, 0xee, 0xa2, 0xeb,
-	0x92, 0x22, 0x4a, 0x93, 0x22, 0x65, 0xa4, 0x14, 0x69, 0xa2, 0x50, 0xa4, 0xca, 0x5f, 0x70, 0x45,
-	0x8a, 0x4b, 0xaa, 0x54, 0x51, 0x04, 0x05, 0x7d, 0xfe, 0x82, 0x68, 0x7e, 0xec, 0xae, 0x6d, 0xb0,
-	0x41, 0x44, 0x49, 0x83, 0x98, 0x6f, 0xde, 0xcc, 0xfb, 0xbe, 0xef, 0xbd, 0x99, 0x59, 0x43, 0x85,
-	0x21, 0x17, 0xbb, 0x88, 0x1d, 0xe3, 0xfd, 0x2a, 0x26, 0x98, 0xf9, 0x2d, 0xb7, 0xe5, 0x36, 0x70,
-	0xf5, 0x68, 0xb9, 0x2a, 0x4e, 0x96, 0x22, 0x46, 0x05, 0xb5, 0xa7, 0xd2, 0x98, 0xa5, 0xb6, 0x98,
-	0xa5, 0xa3, 0xe5, 0xd2, 0x3b, 0x28, 0x0c, 0x08, 0xad, 0xaa, 0xbf, 0x3a, 0xba, 0x34, 0xe9, 0x52,
-	0x1e, 0x52, 0x5e, 0x0d, 0xb9, 0x2f, 0x77, 0x09, 0xb9, 0x6f, 0x26, 0xa6, 0xf4, 0x84, 0xa3, 0x46,
-	0x55, 0x3d, 0x30, 0x53, 0xe3, 0x3e, 0xf5, 0xa9, 0xc6, 0xe5, 0x7f, 0x06, 0x7d, 0xd8, 0x9b, 0x5b,
-	0x84, 0x18, 0x0a, 0xcd, 0xea, 0xca, 0xaf, 0x16, 0x14, 0x6a, 0xdc, 0xff, 0x34, 0xf2, 0x90, 0xc0,
-	0xdb, 0x6a, 0xc6, 0xfe, 0x00, 0xb2, 0xa8, 0x29, 0x0e, 0x28, 0x0b, 0x44, 0xab, 0x68, 0xcd, 0x58,
-	0xf3, 0xd9, 0xf5, 0xe2, 0xef, 0x3f, 0x2f, 0x8e, 0x9b, 0xb4, 0x6b, 0x9e, 0xc7, 0x30, 0xe7, 0x3b,
-	0x82, 0x05, 0xc4, 0xaf, 0xa7, 0xa1, 0xf6, 0x26, 0x8c, 0xe8, 0xbd, 0x8b, 0x83, 0x33, 0xd6, 0xfc,
-	0xe8, 0xca, 0xec, 0x52, 0x4f, 0xf1, 0x4b, 0x3a, 0xd5, 0x7a, 0xf6, 0xf5, 0x9f, 0xd3, 0x03, 0x3f,
-	0x5c, 0x9c, 0x2e, 0x58, 0x75, 0xb3, 0xf6, 0xc9, 0xd3, 0x57, 0x17, 0xa7, 0x0b, 0xe9, 0xae, 0x5f,
-	0x5e, 0x9c, 0x2e, 0xcc, 0xb7, 0x89, 0x39, 0xe9, 0x90, 0xd3, 0x45, 0xbd, 0x32, 0x05, 0x93, 0x5d,
-	0x50, 0x1d, 0xf3, 0x88, 0x12, 0x8e, 0x63, 0xa5, 0x9b, 0x01, 0x77, 0x0f, 0x10, 0xf3, 0xf1, 0xda,
-	0xee, 0xb6, 0xbd, 0x02, 0x19, 0x97, 0x61, 0x24, 0x28, 0xbb, 0x56, 0x67, 0x1c, 0x68, 0xdf, 0x85,
-	0x91, 0x86, 0x2b, 0x9c, 0xc0, 0x53, 0x2a, 0xb3, 0xf5, 0xe1, 0x86, 0x2b, 0xb6, 0x3c, 0x7b, 0x02,
-	0x46, 0x50, 0x48, 0x9b, 0x44, 0x14, 0x87, 0x14, 0x6c, 0x46, 0xf6, 0x63, 0x78, 0xfb, 0x98, 0xb2,
-	0x43, 0xc7, 0xc3, 0xdc, 0x65, 0x41, 0x24, 0x02, 0x4a, 0x8a, 0x6f, 0xa9, 0x88, 0x82, 0xc4, 0x37,
-	0x53, 0xd8, 0xbe, 0x0f, 0x20, 0x24, 0x33, 0xe1, 0x34, 0x5c, 0x51, 0x1c, 0x56, 0x41, 0x59, 0x8d,
-	0xbc, 0x70, 0xc5, 0x93, 0x9c, 0x34, 0x26, 0xa6, 0x51, 0xf9, 0xce, 0x52, 0x52, 0xdb, 0xe5, 0xc4,
-	0x52, 0xed, 0x47, 0x50, 0xd0, 0x26, 0x39, 0x0c, 0x37, 0x30, 0xe2, 0xd8, 0xd3, 0xf2, 0xea, 0x63,
-	0x1a, 0xae, 0x1b, 0xd4, 0x9e, 0x86, 0x51, 0xe4, 0x45, 0x8e, 0xda, 0x13, 0xc7, 0x82, 0x00, 0x79,
-	0xd1, 0x86, 0x46, 0xec, 0x49, 0xc8, 0x28, 0xf6, 0x81, 0x17, 0xcb, 0x92, 0xc3, 0x2d, 0xcf, 0x9e,
-	0x83, 0x3c, 0xc3, 0x21, 0x0a, 0x48, 0x40, 0x7c, 0x07, 0x89, 0xc8, 0x68, 0xca, 0x25, 0xe0, 0x9a,
-	0x88, 0x2a, 0xbf, 0x59, 0x30, 0x56, 0xe3, 0x7e, 0x1d, 0x1b, 0x8a, 0x9b, 0xff, 0x8b, 0xe3, 0x73,
-	0x90, 0x37, 0xea, 0x39, 0x6d, 0x32, 0x17, 0xc7, 0xd4, 0x34, 0xb8, 0xa3, 0x30, 0x59, 0x96, 0x23,
-	0xd4, 0x08, 0x3c, 0x24, 0x9d, 0x97, 0xc7, 0x8a, 0xbe, 0x34, 0x8e, 0x17, 0x52, 0x7c, 0x5b, 0xc2,
-	0x5d, 0xbe, 0xff, 0x64, 0xc1, 0x44, 0xa7, 0xa6, 0xc4, 0x76, 0xe9, 0xa6, 0x48, 0xdd, 0xb4, 0x8c,
-	0x9b, 0x22, 0x71, 0x33, 0xad, 0x8b, 0x4b, 0x09, 0x6f, 0x86, 0x89, 0xe5, 0xa6, 0x2e, 0x1b, 0x06,
-	0xed, 0x72, 0xd7, 0x8b, 0x8c, 0xc2, 0x36, 0x77, 0xbd, 0xc8, 0x7e, 0x08, 0x05, 0x82, 0x8f, 0xa5,
-	0xf9, 0xce, 0x3e, 0x6a, 0x20, 0x92, 0x28, 0xcd, 0x13, 0x7c, 0xbc, 0x26, 0xa2, 0x75, 0x0d, 0x56,
-	0x7e, 0xb1, 0x00, 0x6a, 0xdc, 0xaf, 0x05, 0x44, 0xdc, 0xb6, 0x02, 0xa9, 0xd5, 0x83, 0x1d, 0x56,
-	0x4f, 0xc3, 0x28, 0xa7, 0x6e, 0x80, 0x45, 0x4b, 0xb5, 0xac, 0x66, 0x09, 0x06, 0x7a, 0xe1, 0x0a,
-	0x7b, 0x0a, 0xee, 0x30, 0xda, 0xc0, 0x6a, 0x56, 0x93, 0xcb, 0xc8, 0xb1, 0x9c, 0x9a, 0x80, 0x11,
-	0x86, 0x11, 0xa7, 0xc4, 0xf8, 0x6e, 0x46, 0x5d, 0x76, 0x7f, 0x6b, 0x81, 0x9d, 0x92, 0x4f, 0xac,
-	0x9e, 0x83, 0x7c, 0x18, 0x10, 0x81, 0x3d, 0xc7, 0xf0, 0xd2, 0x66, 0xe7, 0x34, 0xb8, 0xa6, 0xd9,
-	0x3d, 0x82, 0x42, 0xcc, 0x2e, 0x36, 0xc8, 0xd8, 0x6d, 0x60, 0xe3, 0x90, 0xec, 0x72, 0xb9, 0xb0,
-	0xad, 0xcb, 0xe5, 0x70, 0xcb, 0xb3, 0xef, 0x41, 0x56, 0x04, 0x21, 0xe6, 0x02, 0x85, 0x71, 0x87,
-	0xa7, 0x40, 0xe5, 0x6f, 0x0b, 0xde, 0xab, 0x71, 0x5f, 0x57, 0xb7, 0x8e, 0x1b, 0xaa, 0x67, 0xf8,
-	0x41, 0x10, 0x3d, 0x57, 0xd5, 0xfc, 0x24, 0xc2, 0x4c, 0x41, 0xb7, 0xb2, 0xfc, 0x3e, 0x80, 0x6e,
-	0x5f, 0xe5, 0x9d, 0xe6, 0x9d, 0xd5, 0x88, 0x74, 0xaf, 0xf3, 0xae, 0x18, 0xea, 0xba, 0x2b, 0xda,
-	0xce, 0x80, 0xf1, 0xa7, 0xe3, 0x0c, 0x18, 0x7f, 0x1e, 0xc0, 0x18, 0x8d, 0x39, 0x3a, 0xa2, 0x15,
-	0x61, 0x53, 0x89, 0x7c, 0x82, 0xee, 0xb6, 0x22, 0x7c, 0xb9, 0x20, 0xef, 0xdf, 0x44, 0x74, 0x52,
-	0xaa, 0x59, 0xc8, 0xa5, 0x59, 0x82, 0xf8, 0x58, 0x8c, 0x26, 0xd8, 0x96, 0x27, 0xc5, 0xc8, 0x2e,
-	0x16, 0xf4, 0x10, 0x13, 0x1e, 0x6b, 0x45, 0x22, 0xda, 0x55, 0x80, 0xac, 0xa3, 0x60, 0x4d, 0x2e,
-	0x1c, 0x73, 0x32, 0xb1, 0x2e, 0xd3, 0x9d, 0xfa, 0x98, 0x82, 0xf7, 0x62, 0xb4, 0xf2, 0xbd, 0x05,
-	0xc5, 0x1a, 0xf7, 0x9f, 0x9f, 0x60, 0xb7, 0x29, 0xb0, 0x26, 0xb4, 0xcb, 0x10, 0xe1, 0x2f, 0x31,
-	0xbb, 0x55, 0x11, 0xba, 0xb9, 0x0f, 0x5e, 0xe6, 0x3e, 0x07, 0x79, 0x61, 0x52, 0x38, 0x1e, 0x12,
-	0x28, 0x3e, 0xaa, 0x31, 0xb8, 0x89, 0x04, 0xea, 0xb2, 0xb0, 0x02, 0x33, 0xbd, 0x58, 0x26, 0xaf,
-	0xd5, 0xab, 0x41, 0xb8, 0x57, 0xe3, 0x7e, 0xac, 0xad, 0xdd, 0xe8, 0x3d, 0xd4, 0x68, 0xe2, 0xff,
-	0x4a, 0xce, 0x32, 0x8c, 0x33, 0xec, 0x06, 0x51, 0x80, 0x49, 0xe2, 0xb7, 0x7c, 0xb2, 0xb4, 0xaa,
-	0x77, 0x93, 0xb9, 0xbd, 0x64, 0x4a, 0xb6, 0x51, 0x53, 0x04, 0x8d, 0x40, 0xb4, 0x1c, 0xb9, 0x0d,
-	0xf1, 0xe3, 0x6b, 0xc8, 0xa0, 0x75, 0x05, 0x6a, 0xa3, 0x64, 0x15, 0x5d, 0x4a, 0x04, 0x3e, 0x89,
-	0x1f, 0xb8, 0x9c, 0x02, 0x37, 0x34, 0xd6, 0x65, 0x14, 0x52, 0xe7, 0xab, 0xa7, 0x07, 0x49, 0x8b,
-	0x95, 0x20, 0x7b, 0xe4, 0xac, 0x3a, 0xdc, 0xa5, 0x0c, 0x9b, 0xfe, 0xca, 0x1c, 0xad, 0xee, 0xc8,
-	0xa1, 0xea, 0x2d, 0xef, 0x52, 0x6f, 0x79, 0xa6, 0xb7, 0x56, 0xbe, 0xca, 0xc0, 0x50, 0x8d, 0xfb,
-	0x36, 0x81, 0x5c, 0xc7, 0x37, 0xd0, 0x42, 0x9f, 0x6f, 0x97, 0xae, 0x2f, 0x8c, 0xd2, 0xca, 0xcd,
-	0x63, 0x13, 0xca, 0x04, 0x72, 0x1d, 0x5f, 0x22, 0xd7, 0xe4, 0x6b, 0x8f, 0xbd, 0x2e, 0xdf, 0x95,
-	0x9f, 0x04, 0x87, 0x30, 0xda, 0xfe, 0x0c, 0x3f, 0xee, 0xbf, 0x45, 0x5b, 0x68, 0x69, 0xf9, 0xc6,
-	0xa1, 0x49, 0x32, 0x07, 0x32, 0xf1, 0x6b, 0xf3, 0xa0, 0xff, 0x6a, 0x13, 0x56, 0x5a, 0xbc, 0x51,
-	0x58, 0x92, 0xe0, 0x47, 0x0b, 0x66, 0xaf, 0xbf, 0x76, 0x9f, 0xf5, 0xdf, 0xf4, 0xda, 0x0d, 0x4a,
-	0x1f, 0xff, 0xcb, 0x0d, 0x12, 0xbe, 0x5f, 0x58, 0x70, 0xf7, 0xea, 0x5b, 0x69, 0xb5, 0x7f, 0x8a,
-	0x2b, 0x17, 0x95, 0x9e, 0xde, 0x62, 0x51, 0xc2, 0xe5, 0x1b, 0x0b, 0xa6, 0x7a, 0x5f, 0x2b, 0x1f,
-	0xf6, 0xdf, 0xba, 0xe7, 0xc2, 0xd2, 0xb3, 0x5b, 0x2e, 0x8c, 0x79, 0x95, 0x86, 0x3f, 0x97, 0x3f,
-	0x03, 0xd6, 0x3f, 0x7a, 0x7d, 0x56, 0xb6, 0xde, 0x9c, 0x95, 0xad, 0xbf, 0xce, 0xca, 0xd6, 0xd7,
-	0xe7, 0xe5, 0x81, 0x37, 0xe7, 0xe5, 0x81, 0x3f, 0xce, 0xcb, 0x03, 0x9f, 0x4d, 0x9b, 0x04, 0x8b,
-	0x97, 0x7f, 0x06, 0xc8, 0xc7, 0x8b, 0xef, 0x8f, 0xa8, 0x9f, 0x34, 0xab, 0xff, 0x04, 0x00, 0x00,
-	0xff, 0xff, 0x8f, 0x17, 0xde, 0x3d, 0x98, 0x0d, 0x00, 0x00,
+	0x14, 0x66, 0x21, 0xe0, 0xf3, 0xc3, 0x3f, 0x92, 0x0d, 0x07, 0xc6, 0xba, 0x33, 0x60, 0xc2, 0x1d,
+	0x87, 0x02, 0x16, 0x20, 0x25, 0xca, 0x5d, 0x71, 0xe2, 0xc7, 0x29, 0x42, 0x3a, 0xeb, 0x90, 0x21,
+	0x14, 0x69, 0x56, 0xc3, 0xee, 0xdc, 0xb2, 0xc2, 0xde, 0x59, 0xcd, 0x8c, 0x01, 0x77, 0xd1, 0x75,
+	0x49, 0x11, 0xa5, 0x49, 0x91, 0x32, 0x5d, 0x9a, 0x28, 0x14, 0xa9, 0xd2, 0xa6, 0xb9, 0x22, 0xc5,
+	0x25, 0x55, 0xaa, 0x28, 0x82, 0x82, 0x3e, 0x7f, 0x41, 0x34, 0xbf, 0x76, 0x6d, 0x83, 0x0d, 0x22,
+	0xca, 0x35, 0x88, 0xf9, 0xe6, 0xcd, 0xbc, 0xef, 0xfb, 0xde, 0x9b, 0xd9, 0x31, 0x94, 0x29, 0x72,
+	0xb1, 0x8b, 0xe8, 0x31, 0xde, 0xaf, 0xe0, 0x10, 0x53, 0xbf, 0xe5, 0xb6, 0xdc, 0x3a, 0xae, 0x1c,
+	0x2d, 0x57, 0xf8, 0xc9, 0x52, 0x44, 0x09, 0x27, 0xf6, 0x64, 0x12, 0xb3, 0xd4, 0x16, 0xb3, 0x74,
+	0xb4, 0x5c, 0x7c, 0x0f, 0x35, 0x82, 0x90, 0x54, 0xe4, 0x5f, 0x15, 0x5d, 0x9c, 0x70, 0x09, 0x6b,
+	0x10, 0x56, 0x69, 0x30, 0x5f, 0xec, 0xd2, 0x60, 0xbe, 0x9e, 0x98, 0x54, 0x13, 0x8e, 0x1c, 0x55,
+	0xd4, 0x40, 0x4f, 0x8d, 0xf9, 0xc4, 0x27, 0x0a, 0x17, 0xff, 0x69, 0xf4, 0x41, 0x6f, 0x6e, 0x11,
+	0xa2, 0xa8, 0xa1, 0x57, 0x97, 0x7f, 0xb3, 0x20, 0x5f, 0x65, 0xfe, 0x67, 0x91, 0x87, 0x38, 0xde,
+	0x96, 0x33, 0xf6, 0x47, 0x90, 0x46, 0x4d, 0x7e, 0x40, 0x68, 0xc0, 0x5b, 0x05, 0x6b, 0xda, 0x9a,
+	0x4f, 0xaf, 0x17, 0xfe, 0xf8, 0x79, 0x71, 0x4c, 0xa7, 0x5d, 0xf3, 0x3c, 0x8a, 0x19, 0xdb, 0xe1,
+	0x34, 0x08, 0xfd, 0x5a, 0x12, 0x6a, 0x6f, 0xc2, 0x88, 0xda, 0xbb, 0x30, 0x38, 0x6d, 0xcd, 0x8f,
+	0xae, 0xcc, 0x2c, 0xf5, 0x14, 0xbf, 0xa4, 0x52, 0xad, 0xa7, 0x5f, 0xff, 0x35, 0x35, 0xf0, 0xc3,
+	0xc5, 0xe9, 0x82, 0x55, 0xd3, 0x6b, 0x1f, 0x3f, 0x79, 0x75, 0x71, 0xba, 0x90, 0xec, 0xfa, 0xd5,
+	0xc5, 0xe9, 0xc2, 0x7c, 0x9b, 0x98, 0x93, 0x0e, 0x39, 0x5d, 0xd4, 0xcb, 0x93, 0x30, 0xd1, 0x05,
+	0xd5, 0x30, 0x8b, 0x48, 0xc8, 0xb0, 0x51, 0xba, 0x19, 0x30, 0xf7, 0x00, 0x51, 0x1f, 0xaf, 0xed,
+	0x6e, 0xdb, 0x2b, 0x90, 0x72, 0x29, 0x46, 0x9c, 0xd0, 0x6b, 0x75, 0x9a, 0x40, 0xfb, 0x2e, 0x8c,
+	0xd4, 0x5d, 0xee, 0x04, 0x9e, 0x54, 0x99, 0xae, 0x0d, 0xd7, 0x5d, 0xbe, 0xe5, 0xd9, 0xe3, 0x30,
+	0x82, 0x1a, 0xa4, 0x19, 0xf2, 0xc2, 0x90, 0x84, 0xf5, 0xc8, 0x7e, 0x04, 0xef, 0x1e, 0x13, 0x7a,
+	0xe8, 0x78, 0x98, 0xb9, 0x34, 0x88, 0x78, 0x40, 0xc2, 0xc2, 0x3b, 0x32, 0x22, 0x2f, 0xf0, 0xcd,
+	0x04, 0xb6, 0xef, 0x03, 0x70, 0xc1, 0x8c, 0x3b, 0x75, 0x97, 0x17, 0x86, 0x65, 0x50, 0x5a, 0x21,
+	0xcf, 0x5d, 0xfe, 0x38, 0x23, 0x8c, 0x31, 0x34, 0xca, 0xdf, 0x5b, 0x52, 0x6a, 0xbb, 0x1c, 0x23,
+	0xd5, 0x7e, 0x08, 0x79, 0x65, 0x92, 0x43, 0x71, 0x1d, 0x23, 0x86, 0x3d, 0x25, 0xaf, 0x96, 0x53,
+	0x70, 0x4d, 0xa3, 0xf6, 0x14, 0x8c, 0x22, 0x2f, 0x72, 0xe4, 0x9e, 0xd8, 0x08, 0x02, 0xe4, 0x45,
+	0x1b, 0x0a, 0xb1, 0x27, 0x20, 0x25, 0xd9, 0x07, 0x9e, 0x91, 0x25, 0x86, 0x5b, 0x9e, 0x3d, 0x0b,
+	0x59, 0x8a, 0x1b, 0x28, 0x08, 0x83, 0xd0, 0x77, 0x10, 0x8f, 0xb4, 0xa6, 0x4c, 0x0c, 0xae, 0xf1,
+	0xa8, 0xfc, 0xbb, 0x05, 0xb9, 0x2a, 0xf3, 0x6b, 0x58, 0x53, 0xdc, 0x7c, 0x2b, 0x8e, 0xcf, 0x42,
+	0x56, 0xab, 0x67, 0xa4, 0x49, 0x5d, 0x6c, 0xa8, 0x29, 0x70, 0x47, 0x62, 0xa2, 0x2c, 0x47, 0xa8,
+	0x1e, 0x78, 0x48, 0x38, 0x2f, 0x8e, 0x15, 0x79, 0xa9, 0x1d, 0xcf, 0x27, 0xf8, 0xb6, 0x80, 0xbb,
+	0x7c, 0xff, 0xc9, 0x82, 0xf1, 0x4e, 0x4d, 0xb1, 0xed, 0xc2, 0x4d, 0x9e, 0xb8, 0x69, 0x69, 0x37,
+	0x79, 0xec, 0x66, 0x52, 0x17, 0x97, 0x84, 0xac, 0xd9, 0x88, 0x2d, 0xd7, 0x75, 0xd9, 0xd0, 0x68,
+	0x97, 0xbb, 0x5e, 0xa4, 0x15, 0xb6, 0xb9, 0xeb, 0x45, 0xf6, 0x03, 0xc8, 0x87, 0xf8, 0x58, 0x98,
+	0xef, 0xec, 0xa3, 0x3a, 0x0a, 0x63, 0xa5, 0xd9, 0x10, 0x1f, 0xaf, 0xf1, 0x68, 0x5d, 0x81, 0xe5,
+	0x5f, 0x2c, 0x80, 0x2a, 0xf3, 0xab, 0x41, 0xc8, 0x6f, 0x5b, 0x81, 0xc4, 0xea, 0xc1, 0x0e, 0xab,
+	0xa7, 0x60, 0x94, 0x11, 0x37, 0xc0, 0xbc, 0x25, 0x5b, 0x56, 0xb1, 0x04, 0x0d, 0x3d, 0x77, 0xb9,
+	0x3d, 0x09, 0x77, 0x28, 0xa9, 0x63, 0x39, 0xab, 0xc8, 0xa5, 0xc4, 0x58, 0x4c, 0x8d, 0xc3, 0x08,
+	0xc5, 0x88, 0x91, 0x50, 0xfb, 0xae, 0x47, 0x5d, 0x76, 0x7f, 0x67, 0x81, 0x9d, 0x90, 0x8f, 0xad,
+	0x9e, 0x85, 0x6c, 0x23, 0x08, 0x39, 0xf6, 0x1c, 0xcd, 0x4b, 0x99, 0x9d, 0x51, 0xe0, 0x9a, 0x62,
+	0xf7, 0x10, 0xf2, 0x86, 0x9d, 0x31, 0x48, 0xdb, 0xad, 0x61, 0xed, 0x90, 0xe8, 0x72, 0xb1, 0xb0,
+	0xad, 0xcb, 0xc5, 0x70, 0xcb, 0xb3, 0xef, 0x41, 0x9a, 0x07, 0x0d, 0xcc, 0x38, 0x6a, 0x98, 0x0e,
+	0x4f, 0x80, 0xf2, 0x3f, 0x16, 0x7c, 0x50, 0x65, 0xbe, 0xaa, 0x6e, 0x0d, 0xd7, 0x65, 0xcf, 0xb0,
+	0x83, 0x20, 0x7a, 0x26, 0xab, 0xf9, 0x22, 0xc2, 0x54, 0x42, 0xb7, 0xb2, 0xfc, 0x3e, 0x80, 0x6a,
+	0x5f, 0xe9, 0x9d, 0xe2, 0x9d, 0x56, 0x88, 0x70, 0xaf, 0xf3, 0xae, 0x18, 0xea, 0xba, 0x2b, 0xda,
+	0xce, 0x80, 0xf6, 0xa7, 0xe3, 0x0c, 0x68, 0x7f, 0xe6, 0x20, 0x47, 0x0c, 0x47, 0x87, 0xb7, 0x22,
+	0xac, 0x2b, 0x91, 0x8d, 0xd1, 0xdd, 0x56, 0x84, 0x2f, 0x17, 0xe4, 0xc3, 0x9b, 0x88, 0x8e, 0x4b,
+	0x35, 0x03, 0x99, 0x24, 0x4b, 0x60, 0x8e, 0xc5, 0x68, 0x8c, 0x6d, 0x79, 0x42, 0x8c, 0xe8, 0x62,
+	0x4e, 0x0e, 0x71, 0xc8, 0x8c, 0x56, 0xc4, 0xa3, 0x5d, 0x09, 0x88, 0x3a, 0x72, 0xda, 0x64, 0xdc,
+	0xd1, 0x27, 0x13, 0xab, 0x32, 0xdd, 0xa9, 0xe5, 0x24, 0xbc, 0x67, 0xd0, 0xf2, 0xaf, 0x16, 0x14,
+	0xaa, 0xcc, 0x7f, 0x76, 0x82, 0xdd, 0x26, 0xc7, 0x8a, 0xd0, 0x2e, 0x45, 0x21, 0x7b, 0x89, 0xe9,
+	0xad, 0x8a, 0xd0, 0xcd, 0x7d, 0xf0, 0x32, 0xf7, 0x59, 0xc8, 0x72, 0x9d, 0xc2, 0xf1, 0x10, 0x47,
+	0xe6, 0xa8, 0x1a, 0x70, 0x13, 0x71, 0x24, 0x04, 0xea, 0x72, 0x90, 0xa6, 0xa9, 0x45, 0x5a, 0x21,
+	0x2f, 0x9a, 0xdd, 0x37, 0x7b, 0x04, 0xd3, 0xbd, 0x44, 0xc4, 0xa6, 0xce, 0x41, 0x4e, 0x77, 0x87,
+	0xe9, 0x6c, 0x65, 0x6b, 0x56, 0xa1, 0xa6, 0xb1, 0xe7, 0x20, 0xa7, 0xbb, 0xa4, 0xf3, 0x00, 0x64,
+	0x15, 0x6a, 0x6e, 0x88, 0x57, 0x83, 0x70, 0xaf, 0xca, 0x7c, 0x63, 0x64, 0x7b, 0x55, 0xf7, 0x50,
+	0xbd, 0x89, 0xff, 0x2f, 0xef, 0x96, 0x61, 0x8c, 0x62, 0x37, 0x88, 0x02, 0x1c, 0xc6, 0xc5, 0x15,
+	0xdf, 0x47, 0x65, 0xe1, 0xfb, 0xf1, 0xdc, 0x5e, 0x3c, 0x25, 0x14, 0x35, 0x79, 0x50, 0x0f, 0x78,
+	0xcb, 0x11, 0xdb, 0x84, 0xbe, 0xb9, 0xf3, 0x34, 0x5a, 0x93, 0xa0, 0xaa, 0x8a, 0x68, 0x19, 0x97,
+	0x84, 0x1c, 0x9f, 0x98, 0xaf, 0x69, 0x46, 0x82, 0x1b, 0x0a, 0xeb, 0xb2, 0x1d, 0xc9, 0xc3, 0xdc,
+	0xd3, 0x83, 0xd8, 0xfa, 0x22, 0xa4, 0x8f, 0x9c, 0x55, 0x87, 0xb9, 0x84, 0x1a, 0xd7, 0x53, 0x47,
+	0xab, 0x3b, 0x62, 0x28, 0x1b, 0xd9, 0xbb, 0xd4, 0xc8, 0x9e, 0x6e, 0xe4, 0x95, 0xaf, 0x53, 0x30,
+	0x54, 0x65, 0xbe, 0x1d, 0x42, 0xa6, 0xe3, 0xc1, 0xb5, 0xd0, 0xe7, 0xa1, 0xd4, 0xf5, 0x9c, 0x29,
+	0xae, 0xdc, 0x3c, 0x36, 0xa6, 0x1c, 0x42, 0xa6, 0xe3, 0xd9, 0x73, 0x4d, 0xbe, 0xf6, 0xd8, 0xeb,
+	0xf2, 0x5d, 0xf9, 0xfe, 0x38, 0x84, 0xd1, 0xf6, 0x6f, 0xfe, 0xa3, 0xfe, 0x5b, 0xb4, 0x85, 0x16,
+	0x97, 0x6f, 0x1c, 0x1a, 0x27, 0x73, 0x20, 0x65, 0x3e, 0x6d, 0x73, 0xfd, 0x57, 0xeb, 0xb0, 0xe2,
+	0xe2, 0x8d, 0xc2, 0xe2, 0x04, 0x3f, 0x5a, 0x30, 0x73, 0xfd, 0x1d, 0xff, 0xb4, 0xff, 0xa6, 0xd7,
+	0x6e, 0x50, 0xfc, 0xf4, 0x3f, 0x6e, 0x10, 0xf3, 0xfd, 0xd2, 0x82, 0xbb, 0x57, 0x5f, 0x81, 0xab,
+	0xfd, 0x53, 0x5c, 0xb9, 0xa8, 0xf8, 0xe4, 0x16, 0x8b, 0x62, 0x2e, 0xdf, 0x5a, 0x30, 0xd9, 0xfb,
+	0x5a, 0xf9, 0xb8, 0xff, 0xd6, 0x3d, 0x17, 0x16, 0x9f, 0xde, 0x72, 0xa1, 0xe1, 0x55, 0x1c, 0xfe,
+	0x42, 0xfc, 0xe6, 0x58, 0xff, 0xe4, 0xf5, 0x59, 0xc9, 0x7a, 0x73, 0x56, 0xb2, 0xfe, 0x3e, 0x2b,
+	0x59, 0xdf, 0x9c, 0x97, 0x06, 0xde, 0x9c, 0x97, 0x06, 0xfe, 0x3c, 0x2f, 0x0d, 0x7c, 0x3e, 0xa5,
+	0x13, 0x2c, 0x5e, 0xfe, 0xcd, 0x21, 0xbe, 0x94, 0x6c, 0x7f, 0x44, 0xfe, 0x7e, 0x5a, 0xfd, 0x37,
+	0x00, 0x00, 0xff, 0xff, 0x83, 0x84, 0x2b, 0x0f, 0x05, 0x0e, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1859,6 +1889,13 @@ func (m *MsgExecuteEnergyTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error
 	_ = i
 	var l int
 	_ = l
+	if len(m.EnergyOut) > 0 {
+		i -= len(m.EnergyOut)
+		copy(dAtA[i:], m.EnergyOut)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.EnergyOut)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.TransferData) > 0 {
 		i -= len(m.TransferData)
 		copy(dAtA[i:], m.TransferData)
@@ -1903,6 +1940,20 @@ func (m *MsgExecuteEnergyTransferResponse) MarshalToSizedBuffer(dAtA []byte) (in
 	_ = i
 	var l int
 	_ = l
+	if len(m.TargetBalance) > 0 {
+		i -= len(m.TargetBalance)
+		copy(dAtA[i:], m.TargetBalance)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TargetBalance)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SourceBalance) > 0 {
+		i -= len(m.SourceBalance)
+		copy(dAtA[i:], m.SourceBalance)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SourceBalance)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -2265,6 +2316,10 @@ func (m *MsgExecuteEnergyTransfer) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	l = len(m.EnergyOut)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -2274,6 +2329,14 @@ func (m *MsgExecuteEnergyTransferResponse) Size() (n int) {
 	}
 	var l int
 	_ = l
+	l = len(m.SourceBalance)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TargetBalance)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -4127,6 +4190,38 @@ func (m *MsgExecuteEnergyTransfer) Unmarshal(dAtA []byte) error {
 			}
 			m.TransferData = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnergyOut", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EnergyOut = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4177,6 +4272,70 @@ func (m *MsgExecuteEnergyTransferResponse) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: MsgExecuteEnergyTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceBalance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceBalance = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetBalance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetBalance = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
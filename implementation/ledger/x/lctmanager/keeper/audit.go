@@ -0,0 +1,105 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"racecar-web/x/lctmanager/types"
+)
+
+type auditActorKey struct{}
+
+// WithAuditActor attributes the LCT audit entries recorded under the returned
+// context to actor, typically the signer of the message being handled.
+// Operations without an actor are attributed to the module itself.
+func WithAuditActor(ctx context.Context, actor string) context.Context {
+	return sdk.UnwrapSDKContext(ctx).WithValue(auditActorKey{}, actor)
+}
+
+// auditActor returns the actor set by WithAuditActor, or the module name
+func auditActor(ctx context.Context) string {
+	if actor, ok := ctx.Value(auditActorKey{}).(string); ok && actor != "" {
+		return actor
+	}
+	return types.ModuleName
+}
+
+// GetAuditTrail returns the recorded operations on an LCT, oldest first
+func (k Keeper) GetAuditTrail(ctx context.Context, lctId string) ([]types.LCTAuditEntry, error) {
+	var entries []types.LCTAuditEntry
+
+	rng := collections.NewPrefixedPairRange[string, uint64](lctId)
+	err := k.AuditTrail.Walk(ctx, rng, func(_ collections.Pair[string, uint64], entry types.LCTAuditEntry) (bool, error) {
+		entries = append(entries, entry)
+		return false, nil
+	})
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to walk LCT audit trail")
+	}
+
+	return entries, nil
+}
+
+// GetLctAuditTrail returns the recorded lifecycle transitions of an LCT, oldest first
+func (k Keeper) GetLctAuditTrail(ctx context.Context, lctId string) ([]types.LCTAuditEntry, error) {
+	entries, err := k.GetAuditTrail(ctx, lctId)
+	if err != nil {
+		return nil, err
+	}
+
+	var transitions []types.LCTAuditEntry
+	for _, entry := range entries {
+		if entry.FromStatus != "" && entry.FromStatus != entry.ToStatus {
+			transitions = append(transitions, entry)
+		}
+	}
+	return transitions, nil
+}
+
+// storeNewLct stores a newly created LCT and opens its audit trail. The
+// creation entry takes sequence 0 so lifecycle transitions number from 1.
+func (k Keeper) storeNewLct(ctx context.Context, lct types.LinkedContextToken) error {
+	if err := k.SetLinkedContextToken(ctx, lct); err != nil {
+		return err
+	}
+	return k.setAuditEntry(ctx, lct.LctId, 0, "lct_created", "", lct.PairingStatus, "")
+}
+
+// appendAuditEntry stores a new audit entry with the next sequence number for
+// the LCT. Entries are built only from the arguments given here, so key halves
+// and session secrets held on the LCT can never leak into the trail.
+func (k Keeper) appendAuditEntry(ctx context.Context, lctId, eventType, fromStatus, toStatus, reason string) error {
+	var lastSequence uint64
+
+	rng := collections.NewPrefixedPairRange[string, uint64](lctId).Descending()
+	err := k.AuditTrail.Walk(ctx, rng, func(key collections.Pair[string, uint64], _ types.LCTAuditEntry) (bool, error) {
+		lastSequence = key.K2()
+		return true, nil
+	})
+	if err != nil {
+		return errorsmod.Wrap(err, "failed to read LCT audit trail")
+	}
+
+	return k.setAuditEntry(ctx, lctId, lastSequence+1, eventType, fromStatus, toStatus, reason)
+}
+
+// setAuditEntry stores the audit entry at sequence in the LCT's trail
+func (k Keeper) setAuditEntry(ctx context.Context, lctId string, sequence uint64, eventType, fromStatus, toStatus, reason string) error {
+	entry := types.LCTAuditEntry{
+		LctId:      lctId,
+		Sequence:   sequence,
+		EventType:  eventType,
+		Actor:      auditActor(ctx),
+		FromStatus: fromStatus,
+		ToStatus:   toStatus,
+		Reason:     reason,
+		Timestamp:  sdk.UnwrapSDKContext(ctx).BlockTime().Unix(),
+	}
+	if err := k.AuditTrail.Set(ctx, collections.Join(lctId, sequence), entry); err != nil {
+		return errorsmod.Wrap(err, "failed to record LCT audit entry")
+	}
+	return nil
+}
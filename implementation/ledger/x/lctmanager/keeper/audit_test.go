@@ -0,0 +1,82 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"racecar-web/x/lctmanager/keeper"
+	"racecar-web/x/lctmanager/types"
+)
+
+func TestAuditTrailRecordsLctOperations(t *testing.T) {
+	f := initFixture(t)
+	blockTime := time.Unix(1752484904, 0)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(blockTime)
+	operator := sdk.AccAddress([]byte("audit_operator______")).String()
+	actorCtx := keeper.WithAuditActor(ctx, operator)
+
+	lctID, keyReference, err := f.keeper.CreateLCTRelationship(actorCtx, "MODBATT-PACK-001", "MODBATT-MC-001", "energy_transfer", "")
+	require.NoError(t, err)
+	require.NoError(t, f.keeper.InitiateKeyExchange(actorCtx, lctID, keyReference))
+	require.NoError(t, f.keeper.ActivateLctRelationship(actorCtx, lctID))
+	// Operations without an actor are attributed to the module
+	require.NoError(t, f.keeper.TerminateLctRelationship(ctx, lctID, "pack replaced", false))
+
+	trail, err := f.keeper.GetAuditTrail(ctx, lctID)
+	require.NoError(t, err)
+	require.Len(t, trail, 4)
+
+	expected := []struct {
+		eventType string
+		actor     string
+	}{
+		{"lct_created", operator},
+		{"key_exchange_initiated", operator},
+		{"lct_activated", operator},
+		{"lct_terminated", types.ModuleName},
+	}
+	for i, exp := range expected {
+		require.Equal(t, lctID, trail[i].LctId)
+		require.Equal(t, uint64(i), trail[i].Sequence)
+		require.Equal(t, exp.eventType, trail[i].EventType)
+		require.Equal(t, exp.actor, trail[i].Actor)
+		require.Equal(t, blockTime.Unix(), trail[i].Timestamp)
+	}
+
+	// The trail never carries key material
+	lct, _ := f.keeper.GetLct(ctx, lctID)
+	raw, err := json.Marshal(trail)
+	require.NoError(t, err)
+	require.NotContains(t, string(raw), "key_material")
+	require.NotContains(t, string(raw), "shared_secret")
+	require.NotContains(t, string(raw), lct.LctKeyHalf)
+	require.NotContains(t, string(raw), keyReference)
+}
+
+func TestQueryGetAuditTrail(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(1752484904, 0))
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	_, err := qs.GetAuditTrail(ctx, &types.QueryGetAuditTrailRequest{})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = qs.GetAuditTrail(ctx, &types.QueryGetAuditTrailRequest{LctId: "lct-missing"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	lctID, _, err := f.keeper.CreateLCTRelationship(ctx, "MODBATT-PACK-002", "MODBATT-MC-002", "energy_transfer", "")
+	require.NoError(t, err)
+	require.NoError(t, f.keeper.SuspendLctRelationship(ctx, lctID, "under investigation"))
+
+	resp, err := qs.GetAuditTrail(ctx, &types.QueryGetAuditTrailRequest{LctId: lctID})
+	require.NoError(t, err)
+	require.Len(t, resp.Entries, 2)
+	require.Equal(t, "lct_created", resp.Entries[0].EventType)
+	require.Equal(t, "lct_suspended", resp.Entries[1].EventType)
+	require.Equal(t, "under investigation", resp.Entries[1].Reason)
+}
@@ -0,0 +1,59 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	componentregistrytypes "racecar-web/x/componentregistry/types"
+	"racecar-web/x/lctmanager/keeper"
+	"racecar-web/x/lctmanager/types"
+)
+
+// stubRegistry is a component registry holding components by ID
+type stubRegistry map[string]componentregistrytypes.ComponentIdentity
+
+func (r stubRegistry) GetComponentIdentity(_ context.Context, componentId string) (componentregistrytypes.ComponentIdentity, bool) {
+	identity, found := r[componentId]
+	return identity, found
+}
+
+func (r stubRegistry) VerifyComponentForPairing(context.Context, string) (bool, string) {
+	return true, ""
+}
+
+func (r stubRegistry) CheckBidirectionalPairingAuth(context.Context, string, string) (bool, bool, string) {
+	return true, true, ""
+}
+
+func TestCreateLctRequiresActiveComponents(t *testing.T) {
+	f := initFixtureWithRegistry(t, stubRegistry{
+		"MODBATT-PACK-001": {ComponentId: "MODBATT-PACK-001", Status: componentregistrytypes.StatusActive},
+		"MODBATT-MOD-001":  {ComponentId: "MODBATT-MOD-001", Status: componentregistrytypes.StatusActive},
+		"MODBATT-MOD-002":  {ComponentId: "MODBATT-MOD-002", Status: componentregistrytypes.StatusRetired},
+	})
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	creator, err := f.addressCodec.BytesToString([]byte("creator_____________"))
+	require.NoError(t, err)
+
+	create := func(componentA, componentB string) error {
+		_, err := ms.CreateLctRelationship(f.ctx, &types.MsgCreateLctRelationship{
+			Creator: creator, ComponentA: componentA, ComponentB: componentB, Context: "energy_transfer",
+		})
+		return err
+	}
+
+	require.NoError(t, create("MODBATT-PACK-001", "MODBATT-MOD-001"))
+
+	// A typo'd component ID, on either side
+	require.ErrorIs(t, create("MODBATT-PACK-001", "MODBATT-MOD-0O1"), types.ErrComponentNotFound)
+	require.ErrorIs(t, create("MODBATT-PAK-001", "MODBATT-MOD-001"), types.ErrComponentNotFound)
+	require.ErrorIs(t, create("MODBATT-PACK-001", "MODBATT-MOD-002"), types.ErrComponentInactive)
+
+	// Relationships created for other modules are checked too
+	_, _, err = f.keeper.CreateLCTRelationship(f.ctx, "MODBATT-PACK-001", "MODBATT-MOD-002", "energy_transfer", "")
+	require.ErrorIs(t, err, types.ErrComponentInactive)
+	_, _, err = f.keeper.CreateLCTRelationship(f.ctx, "MODBATT-PACK-001", "MODBATT-MOD-404", "energy_transfer", "")
+	require.ErrorIs(t, err, types.ErrComponentNotFound)
+}
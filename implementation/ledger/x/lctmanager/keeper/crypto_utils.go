@@ -4,6 +4,7 @@ import (
 	"crypto/ed25519"
 	"crypto/rand"
 	"crypto/sha256"
+	"crypto/subtle"
 	"fmt"
 
 	"golang.org/x/crypto/chacha20poly1305"
@@ -63,6 +64,28 @@ func CombineKeyShares(shareA, shareB, sharedSecret [32]byte) [32]byte {
 	return combinedKey
 }
 
+// keyCommitmentDomain separates key commitments from other SHA-256 uses of the same bytes
+const keyCommitmentDomain = "web4-lct-key-commitment-v1"
+
+// KeyCommitment derives a commitment to a combined key. The commitment can be
+// stored on chain and later used to check a reconstructed key without
+// revealing it.
+func KeyCommitment(combinedKey [32]byte) [32]byte {
+	hash := sha256.New()
+	hash.Write([]byte(keyCommitmentDomain))
+	hash.Write(combinedKey[:])
+
+	var commitment [32]byte
+	copy(commitment[:], hash.Sum(nil))
+	return commitment
+}
+
+// VerifyKeyCommitment reports whether combinedKey matches commitment, in constant time
+func VerifyKeyCommitment(combinedKey [32]byte, commitment []byte) bool {
+	expected := KeyCommitment(combinedKey)
+	return subtle.ConstantTimeCompare(expected[:], commitment) == 1
+}
+
 // EncryptWithKey encrypts data using ChaCha20-Poly1305
 func EncryptWithKey(key [32]byte, plaintext []byte) ([]byte, error) {
 	aead, err := chacha20poly1305.New(key[:])
@@ -111,6 +134,87 @@ func DecryptWithKey(key [32]byte, ciphertext []byte) ([]byte, error) {
 	return plaintext, nil
 }
 
+// messageKeyDomain separates sealed-message keys from other SHA-256 uses of the same bytes
+const messageKeyDomain = "web4-lct-message-key-v1"
+
+// MessagePublicKey derives the X25519 public key that messages for the holder
+// of combinedKey are sealed to
+func MessagePublicKey(combinedKey [32]byte) ([32]byte, error) {
+	var publicKey [32]byte
+	raw, err := curve25519.X25519(combinedKey[:], curve25519.Basepoint)
+	if err != nil {
+		return publicKey, fmt.Errorf("failed to derive message key: %w", err)
+	}
+	copy(publicKey[:], raw)
+	return publicKey, nil
+}
+
+// SealMessage encrypts plaintext to a message public key with a fresh
+// ephemeral X25519 key. The sealed message is the ephemeral public key
+// followed by the output of EncryptWithKey.
+func SealMessage(recipient [32]byte, plaintext []byte) ([]byte, error) {
+	ephemeralPublic, ephemeralPrivate, err := GenerateCurve25519KeyPair()
+	if err != nil {
+		return nil, err
+	}
+	defer ZeroKey(&ephemeralPrivate)
+
+	key, err := sealedMessageKey(ephemeralPrivate, recipient, ephemeralPublic, recipient)
+	if err != nil {
+		return nil, err
+	}
+	defer ZeroKey(&key)
+
+	ciphertext, err := EncryptWithKey(key, plaintext)
+	if err != nil {
+		return nil, err
+	}
+	return append(ephemeralPublic[:], ciphertext...), nil
+}
+
+// OpenMessage decrypts a message sealed to the message public key of combinedKey
+func OpenMessage(combinedKey [32]byte, sealed []byte) ([]byte, error) {
+	if len(sealed) < 32 {
+		return nil, fmt.Errorf("sealed message too short")
+	}
+	var ephemeralPublic [32]byte
+	copy(ephemeralPublic[:], sealed[:32])
+
+	recipient, err := MessagePublicKey(combinedKey)
+	if err != nil {
+		return nil, err
+	}
+	key, err := sealedMessageKey(combinedKey, ephemeralPublic, ephemeralPublic, recipient)
+	if err != nil {
+		return nil, err
+	}
+	defer ZeroKey(&key)
+
+	return DecryptWithKey(key, sealed[32:])
+}
+
+// sealedMessageKey derives the symmetric key of a sealed message from the
+// X25519 exchange of privateKey with peer, bound to both public keys
+func sealedMessageKey(privateKey, peer, ephemeralPublic, recipient [32]byte) ([32]byte, error) {
+	var key [32]byte
+	shared, err := curve25519.X25519(privateKey[:], peer[:])
+	if err != nil {
+		return key, fmt.Errorf("failed to derive message secret: %w", err)
+	}
+
+	hash := sha256.New()
+	hash.Write([]byte(messageKeyDomain))
+	hash.Write(shared)
+	hash.Write(ephemeralPublic[:])
+	hash.Write(recipient[:])
+	copy(key[:], hash.Sum(nil))
+
+	for i := range shared {
+		shared[i] = 0
+	}
+	return key, nil
+}
+
 // SignMessage signs a message using Ed25519
 func SignMessage(privateKey ed25519.PrivateKey, message []byte) ([]byte, error) {
 	signature := ed25519.Sign(privateKey, message)
@@ -0,0 +1,115 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"racecar-web/x/lctmanager/types"
+)
+
+func activeLct(t *testing.T, f *fixture, componentA, componentB, context string) types.LinkedContextToken {
+	t.Helper()
+	creator := sdk.AccAddress([]byte("idle_sweep_creator__"))
+	lct, err := f.keeper.CreateLctRelationship(f.ctx, creator, componentA, componentB, context, "")
+	require.NoError(t, err)
+	require.NoError(t, f.keeper.UpdateLctStatus(f.ctx, lct.LctId, types.StatusActive, "paired"))
+	return *lct
+}
+
+func TestSweepIdleLcts(t *testing.T) {
+	f := initFixture(t)
+	idle := activeLct(t, f, "MODBATT-PACK-001", "MODBATT-MOD-001", "battery_management")
+	contacted := activeLct(t, f, "MODBATT-PACK-001", "MODBATT-MC-001", "energy_delivery")
+
+	timeout := time.Duration(types.DefaultIdleTimeoutSeconds) * time.Second
+	sweepTime := time.Now().Add(timeout + time.Hour)
+
+	// The motor controller link checks in shortly before the sweep
+	contactCtx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(sweepTime.Add(-time.Hour))
+	require.NoError(t, f.keeper.RecordLctContact(contactCtx, contacted.LctId))
+
+	// Contact moves the LCT's entry in the index the sweep walks
+	var indexed []collections.Pair[int64, string]
+	require.NoError(t, f.keeper.ActiveContactIndex.Walk(f.ctx, nil, func(key collections.Pair[int64, string], _ string) (bool, error) {
+		indexed = append(indexed, key)
+		return false, nil
+	}))
+	require.Len(t, indexed, 2)
+	require.Equal(t, idle.LctId, indexed[0].K2())
+	require.Equal(t, collections.Join(sweepTime.Add(-time.Hour).Unix(), contacted.LctId), indexed[1])
+
+	sweepCtx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(sweepTime).WithEventManager(sdk.NewEventManager())
+	swept, err := f.keeper.SweepIdleLcts(sweepCtx)
+	require.NoError(t, err)
+	require.Equal(t, 1, swept)
+
+	lct, found := f.keeper.GetLct(sweepCtx, idle.LctId)
+	require.True(t, found)
+	require.Equal(t, types.StatusInactive, lct.PairingStatus)
+
+	lct, found = f.keeper.GetLct(sweepCtx, contacted.LctId)
+	require.True(t, found)
+	require.Equal(t, types.StatusActive, lct.PairingStatus)
+
+	events := sweepCtx.EventManager().Events()
+	require.Len(t, events, 1)
+	require.Equal(t, "lct_marked_inactive", events[0].Type)
+	lctID, ok := events[0].GetAttribute("lct_id")
+	require.True(t, ok)
+	require.Equal(t, idle.LctId, lctID.Value)
+
+	// Inactive LCTs do not grant access until the components make contact again
+	hasAccess, _, err := f.keeper.ValidateLctAccess(sweepCtx, idle.LctId, "MODBATT-PACK-001")
+	require.NoError(t, err)
+	require.False(t, hasAccess)
+
+	// A second sweep in the same block has nothing left to do
+	swept, err = f.keeper.SweepIdleLcts(sweepCtx)
+	require.NoError(t, err)
+	require.Zero(t, swept)
+
+	// Contact reactivates the LCT
+	reactivateCtx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(sweepTime.Add(time.Minute)).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, f.keeper.RecordLctContact(reactivateCtx, idle.LctId))
+
+	lct, found = f.keeper.GetLct(reactivateCtx, idle.LctId)
+	require.True(t, found)
+	require.Equal(t, types.StatusActive, lct.PairingStatus)
+	require.Equal(t, sweepTime.Add(time.Minute).Unix(), lct.LastContactAt)
+	require.Equal(t, "lct_reactivated", reactivateCtx.EventManager().Events()[0].Type)
+
+	hasAccess, _, err = f.keeper.ValidateLctAccess(reactivateCtx, idle.LctId, "MODBATT-PACK-001")
+	require.NoError(t, err)
+	require.True(t, hasAccess)
+}
+
+func TestSweepIdleLctsSkipsNonActiveAndDisabled(t *testing.T) {
+	f := initFixture(t)
+	creator := sdk.AccAddress([]byte("idle_sweep_creator__"))
+	pending, err := f.keeper.CreateLctRelationship(f.ctx, creator, "MODBATT-PACK-001", "MODBATT-MOD-001", "battery_management", "")
+	require.NoError(t, err)
+	active := activeLct(t, f, "MODBATT-PACK-001", "MODBATT-MC-001", "energy_delivery")
+
+	sweepCtx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Now().Add(365 * 24 * time.Hour))
+
+	// A zero timeout disables the sweep
+	require.NoError(t, f.keeper.SetParams(sweepCtx, types.NewParams(0)))
+	swept, err := f.keeper.SweepIdleLcts(sweepCtx)
+	require.NoError(t, err)
+	require.Zero(t, swept)
+
+	// Only active LCTs are swept
+	require.NoError(t, f.keeper.SetParams(sweepCtx, types.DefaultParams()))
+	swept, err = f.keeper.SweepIdleLcts(sweepCtx)
+	require.NoError(t, err)
+	require.Equal(t, 1, swept)
+
+	lct, _ := f.keeper.GetLct(sweepCtx, pending.LctId)
+	require.Equal(t, types.StatusPending, lct.PairingStatus)
+	lct, _ = f.keeper.GetLct(sweepCtx, active.LctId)
+	require.Equal(t, types.StatusInactive, lct.PairingStatus)
+}
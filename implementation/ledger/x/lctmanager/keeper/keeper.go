@@ -3,7 +3,9 @@ package keeper
 import (
 	"context"
 	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"time"
 
@@ -13,9 +15,11 @@ import (
 	"cosmossdk.io/collections"
 	"cosmossdk.io/core/address"
 	corestore "cosmossdk.io/core/store"
+	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/log"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
 type Keeper struct {
@@ -37,6 +41,20 @@ type Keeper struct {
 	SessionKeyExchanges   collections.Map[string, types.SessionKeyExchange]
 	PairingChallenges     collections.Map[string, types.PairingChallenge]
 	SplitKeys             collections.Map[string, types.SplitKey]
+	// LctPairIndex maps (canonical component pair, operational context) to the latest LCT ID
+	LctPairIndex collections.Map[collections.Triple[string, string, string], string]
+	// AuditTrail records every state-changing LCT operation, keyed by (lct_id, sequence)
+	AuditTrail collections.Map[collections.Pair[string, uint64], types.LCTAuditEntry]
+	// LctProxyIndex maps (proxy_component_id, lct_id) to the LCT ID for every non-terminated proxied LCT
+	LctProxyIndex collections.Map[collections.Pair[string, string], string]
+	// SupersededSplitKeys keeps the split keys rotated out of an LCT, keyed by (lct_id, version)
+	SupersededSplitKeys collections.Map[collections.Pair[string, uint64], types.SplitKey]
+	// ActiveContactIndex maps (last_contact_at, lct_id) to the LCT ID for every active LCT
+	ActiveContactIndex collections.Map[collections.Pair[int64, string], string]
+	// LctComponentIndex maps (component_id, lct_id) to the LCT ID for both components of every LCT
+	LctComponentIndex collections.Map[collections.Pair[string, string], string]
+	// SuspendedFrom maps the ID of every suspended LCT to the status a resume restores
+	SuspendedFrom collections.Map[string, string]
 
 	bankKeeper              types.BankKeeper
 	componentregistryKeeper componentregistrytypes.ComponentregistryKeeper
@@ -76,6 +94,13 @@ func NewKeeper(
 		SessionKeyExchanges:   collections.NewMap(sb, types.SessionKeyExchangePrefix, "session_key_exchanges", collections.StringKey, codec.CollValue[types.SessionKeyExchange](cdc)),
 		PairingChallenges:     collections.NewMap(sb, types.PairingChallengePrefix, "pairing_challenges", collections.StringKey, codec.CollValue[types.PairingChallenge](cdc)),
 		SplitKeys:             collections.NewMap(sb, types.SplitKeyPrefix, "split_keys", collections.StringKey, codec.CollValue[types.SplitKey](cdc)),
+		LctPairIndex:          collections.NewMap(sb, types.LctPairIndexPrefix, "lct_pair_index", collections.TripleKeyCodec(collections.StringKey, collections.StringKey, collections.StringKey), collections.StringValue),
+		AuditTrail:            collections.NewMap(sb, types.AuditTrailPrefix, "audit_trail", collections.PairKeyCodec(collections.StringKey, collections.Uint64Key), codec.CollValue[types.LCTAuditEntry](cdc)),
+		LctProxyIndex:         collections.NewMap(sb, types.LctProxyIndexPrefix, "lct_proxy_index", collections.PairKeyCodec(collections.StringKey, collections.StringKey), collections.StringValue),
+		SupersededSplitKeys:   collections.NewMap(sb, types.SupersededSplitKeyPrefix, "superseded_split_keys", collections.PairKeyCodec(collections.StringKey, collections.Uint64Key), codec.CollValue[types.SplitKey](cdc)),
+		ActiveContactIndex:    collections.NewMap(sb, types.ActiveContactIndexPrefix, "active_contact_index", collections.PairKeyCodec(collections.Int64Key, collections.StringKey), collections.StringValue),
+		LctComponentIndex:     collections.NewMap(sb, types.LctComponentIndexPrefix, "lct_component_index", collections.PairKeyCodec(collections.StringKey, collections.StringKey), collections.StringValue),
+		SuspendedFrom:         collections.NewMap(sb, types.SuspendedFromPrefix, "suspended_from", collections.StringKey, collections.StringValue),
 	}
 
 	schema, err := sb.Build()
@@ -113,13 +138,126 @@ func (k Keeper) GetLinkedContextToken(ctx context.Context, lctId string) (types.
 	return lct, true
 }
 
-// SetLinkedContextToken stores LCT relationship information
+// SetLinkedContextToken stores LCT relationship information and indexes it by
+// component, by component pair and by proxy
 func (k Keeper) SetLinkedContextToken(ctx context.Context, lct types.LinkedContextToken) error {
-	return k.LinkedContextToken.Set(ctx, lct.LctId, lct)
+	if err := k.indexActiveContact(ctx, lct); err != nil {
+		return err
+	}
+	if err := k.LinkedContextToken.Set(ctx, lct.LctId, lct); err != nil {
+		return err
+	}
+	if err := k.indexComponents(ctx, lct); err != nil {
+		return err
+	}
+	if lct.PairingStatus == types.StatusTerminated {
+		// A proxy stops mediating an LCT once it is terminated
+		if lct.ProxyComponentId != "" {
+			if err := k.LctProxyIndex.Remove(ctx, collections.Join(lct.ProxyComponentId, lct.LctId)); err != nil {
+				return err
+			}
+		}
+		// Terminated LCTs must not displace a newer live LCT for the same pair
+		return nil
+	}
+	if lct.ProxyComponentId != "" {
+		if err := k.LctProxyIndex.Set(ctx, collections.Join(lct.ProxyComponentId, lct.LctId), lct.LctId); err != nil {
+			return err
+		}
+	}
+	return k.LctPairIndex.Set(ctx, lctPairKey(lct.ComponentAId, lct.ComponentBId, lct.OperationalContext), lct.LctId)
+}
+
+// indexComponents records an LCT under both of its components. The components
+// of an LCT never change, so the entries never need moving.
+func (k Keeper) indexComponents(ctx context.Context, lct types.LinkedContextToken) error {
+	for _, componentID := range []string{lct.ComponentAId, lct.ComponentBId} {
+		if componentID == "" {
+			continue
+		}
+		if err := k.LctComponentIndex.Set(ctx, collections.Join(componentID, lct.LctId), lct.LctId); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// indexActiveContact moves the ActiveContactIndex entry of an LCT that is
+// about to be stored, so that the idle sweep only visits active LCTs in the
+// order of their last contact
+func (k Keeper) indexActiveContact(ctx context.Context, lct types.LinkedContextToken) error {
+	previous, err := k.LinkedContextToken.Get(ctx, lct.LctId)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+	if err == nil && previous.PairingStatus == types.StatusActive {
+		if err := k.ActiveContactIndex.Remove(ctx, collections.Join(previous.LastContactAt, previous.LctId)); err != nil {
+			return err
+		}
+	}
+	if lct.PairingStatus != types.StatusActive {
+		return nil
+	}
+	return k.ActiveContactIndex.Set(ctx, collections.Join(lct.LastContactAt, lct.LctId), lct.LctId)
+}
+
+// GetLctBetween returns the live (non-terminated) LCT linking two components in
+// the given operational context. The order of the components does not matter.
+func (k Keeper) GetLctBetween(ctx context.Context, componentA, componentB, context string) (types.LinkedContextToken, bool) {
+	context, err := types.NormalizeOperationalContext(context)
+	if err != nil {
+		return types.LinkedContextToken{}, false
+	}
+
+	lctID, err := k.LctPairIndex.Get(ctx, lctPairKey(componentA, componentB, context))
+	if err != nil {
+		return types.LinkedContextToken{}, false
+	}
+
+	lct, found := k.GetLct(ctx, lctID)
+	if !found || lct.PairingStatus == types.StatusTerminated {
+		return types.LinkedContextToken{}, false
+	}
+	return lct, true
+}
+
+// lctPairKey orders the component pair canonically so A<->B and B<->A share an index entry
+func lctPairKey(componentA, componentB, context string) collections.Triple[string, string, string] {
+	if componentB < componentA {
+		componentA, componentB = componentB, componentA
+	}
+	return collections.Join3(componentA, componentB, context)
+}
+
+// checkComponentsActive rejects a relationship unless every component is
+// registered and active in the component registry, so no LCT references a
+// component that does not exist. It is skipped without a registry keeper.
+func (k Keeper) checkComponentsActive(ctx context.Context, componentIDs ...string) error {
+	if k.componentregistryKeeper == nil {
+		return nil
+	}
+	for _, componentID := range componentIDs {
+		identity, found := k.componentregistryKeeper.GetComponentIdentity(ctx, componentID)
+		if !found {
+			return errorsmod.Wrapf(types.ErrComponentNotFound, "component %s is not registered", componentID)
+		}
+		if identity.Status != componentregistrytypes.StatusActive {
+			return errorsmod.Wrapf(types.ErrComponentInactive, "component %s is %s", componentID, identity.Status)
+		}
+	}
+	return nil
 }
 
 // CreateLCTRelationship creates a new LCT representing the relationship between two components
 func (k Keeper) CreateLCTRelationship(ctx context.Context, componentA, componentB, operationalContext, proxyId string) (string, string, error) {
+	operationalContext, err := types.NormalizeOperationalContext(operationalContext)
+	if err != nil {
+		return "", "", err
+	}
+	if err := k.checkComponentsActive(ctx, componentA, componentB); err != nil {
+		return "", "", err
+	}
+
 	// Generate unique LCT ID for this relationship
 	lctId := k.generateLCTId(componentA, componentB)
 
@@ -133,6 +271,17 @@ func (k Keeper) CreateLCTRelationship(ctx context.Context, componentA, component
 	lctKeyHalfHex := fmt.Sprintf("%x", lctKeyHalf[:])
 	deviceKeyHalfHex := fmt.Sprintf("%x", deviceKeyHalf[:])
 
+	// Commit to the combined key so a later reconstruction can be checked
+	// without the key ever being stored
+	combinedKey := k.combineKeyShares(lctKeyHalf, deviceKeyHalf)
+	commitment := KeyCommitment(combinedKey)
+	messageKey, err := MessagePublicKey(combinedKey)
+	ZeroKey(&combinedKey)
+	ZeroKey(&deviceKeyHalf)
+	if err != nil {
+		return "", "", err
+	}
+
 	// Create the LCT relationship
 	lct := types.LinkedContextToken{
 		LctId:              lctId,
@@ -147,10 +296,12 @@ func (k Keeper) CreateLCTRelationship(ctx context.Context, componentA, component
 		OperationalContext: operationalContext,
 		ProxyComponentId:   proxyId,
 		AuthorizationRules: "", // Will be populated from component registry
+		KeyCommitment:      hex.EncodeToString(commitment[:]),
+		MessageKey:         hex.EncodeToString(messageKey[:]),
 	}
 
 	// Store the LCT relationship
-	err = k.SetLinkedContextToken(ctx, lct)
+	err = k.storeNewLct(ctx, lct)
 	if err != nil {
 		return "", "", fmt.Errorf("failed to store LCT relationship: %w", err)
 	}
@@ -169,42 +320,236 @@ func (k Keeper) CreateLCTRelationship(ctx context.Context, componentA, component
 	return lctId, deviceKeyHalfHex, nil
 }
 
-// GetComponentRelationships returns all LCT relationships for a component (many-to-many support)
+// GetComponentRelationships returns all LCT relationships for a component
+// (many-to-many support), read through the component index
 func (k Keeper) GetComponentRelationships(ctx context.Context, componentId string) ([]types.LinkedContextToken, error) {
 	var relationships []types.LinkedContextToken
 
-	err := k.LinkedContextToken.Walk(ctx, nil, func(key string, lct types.LinkedContextToken) (bool, error) {
-		if lct.ComponentAId == componentId || lct.ComponentBId == componentId {
-			relationships = append(relationships, lct)
+	rng := collections.NewPrefixedPairRange[string, string](componentId)
+	err := k.LctComponentIndex.Walk(ctx, rng, func(_ collections.Pair[string, string], lctId string) (bool, error) {
+		lct, err := k.LinkedContextToken.Get(ctx, lctId)
+		if err != nil {
+			return true, err
 		}
+		relationships = append(relationships, lct)
 		return false, nil
 	})
 
 	return relationships, err
 }
 
-// TerminateLCTRelationship ends an LCT relationship between components
-func (k Keeper) TerminateLCTRelationship(ctx context.Context, lctId, reason string, notifyOffline bool) error {
-	lct, err := k.LinkedContextToken.Get(ctx, lctId)
-	if err != nil {
-		return fmt.Errorf("LCT relationship not found: %s", lctId)
+// ActivateLctRelationship moves a pending LCT, or one whose key exchange has
+// been initiated, to active. Terminated LCTs cannot be activated again.
+func (k Keeper) ActivateLctRelationship(ctx context.Context, lctId string) error {
+	lct, found := k.GetLct(ctx, lctId)
+	if !found {
+		return errorsmod.Wrapf(types.ErrLctNotFound, "LCT %s", lctId)
+	}
+	// Suspended LCTs come back through ResumeLctRelationship
+	if err := errIfSuspended(lct); err != nil {
+		return err
+	}
+
+	if err := k.transitionLct(ctx, &lct, types.StatusActive, "lct_activated", ""); err != nil {
+		return err
 	}
 
-	// Update LCT status
-	lct.PairingStatus = types.StatusTerminated
-	lct.UpdatedAt = time.Now().Unix()
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent("lct_activated",
+			sdk.NewAttribute("lct_id", lct.LctId),
+			sdk.NewAttribute("component_a", lct.ComponentAId),
+			sdk.NewAttribute("component_b", lct.ComponentBId),
+		),
+	)
+	return nil
+}
 
-	err = k.SetLinkedContextToken(ctx, lct)
-	if err != nil {
+// TerminateLctRelationship ends an LCT relationship between components and
+// removes it from both components' relationship lists. When notifyOffline is
+// set, a termination notification is queued for the proxy component, or for
+// both components if the LCT has no proxy.
+func (k Keeper) TerminateLctRelationship(ctx context.Context, lctId, reason string, notifyOffline bool) error {
+	lct, found := k.GetLct(ctx, lctId)
+	if !found {
+		return errorsmod.Wrapf(types.ErrLctNotFound, "LCT %s", lctId)
+	}
+
+	if err := k.transitionLct(ctx, &lct, types.StatusTerminated, "lct_terminated", reason); err != nil {
+		return err
+	}
+
+	if err := k.removeLctFromRelationships(ctx, lctId); err != nil {
+		return err
+	}
+
+	if notifyOffline {
+		k.queueTerminationNotices(ctx, lct)
+	}
+
+	return nil
+}
+
+// queueTerminationNotices queues a termination notification for the proxy
+// component of lct, or for both components if it has none. The termination
+// stands whatever happens here: a component that is not notified still sees it
+// when it next syncs, as it does with keepers built without a pairing queue.
+func (k Keeper) queueTerminationNotices(ctx context.Context, lct types.LinkedContextToken) {
+	if k.pairingqueueKeeper == nil {
+		k.Logger(ctx).Debug("no pairing queue; termination notification not queued", "lct_id", lct.LctId)
+		return
+	}
+
+	recipients := []string{lct.ComponentAId, lct.ComponentBId}
+	if lct.ProxyComponentId != "" {
+		recipients = []string{lct.ProxyComponentId}
+	}
+	for _, componentId := range recipients {
+		if _, err := k.pairingqueueKeeper.QueueOfflineOperation(ctx, componentId, "termination_notification"); err != nil {
+			k.Logger(ctx).Error("failed to queue termination notification",
+				"error", err,
+				"lct_id", lct.LctId,
+				"component_id", componentId,
+			)
+		}
+	}
+}
+
+// Logger returns the keeper's logger, falling back to the context's logger
+// when the keeper was built without one
+func (k Keeper) Logger(ctx context.Context) log.Logger {
+	if k.logger != nil {
+		return k.logger
+	}
+	return sdk.UnwrapSDKContext(ctx).Logger().With("module", "x/"+types.ModuleName)
+}
+
+// SuspendLctRelationship pauses an LCT without ending it. Operations on a
+// suspended LCT fail with ErrLctSuspended, while its keys, relationships and
+// audit trail are left intact so it can later be resumed.
+func (k Keeper) SuspendLctRelationship(ctx context.Context, lctId, reason string) error {
+	lct, found := k.GetLct(ctx, lctId)
+	if !found {
+		return errorsmod.Wrapf(types.ErrLctNotFound, "LCT %s", lctId)
+	}
+
+	suspendedFrom := lct.PairingStatus
+	if err := k.transitionLct(ctx, &lct, types.StatusSuspended, "lct_suspended", reason); err != nil {
+		return err
+	}
+	if err := k.SuspendedFrom.Set(ctx, lct.LctId, suspendedFrom); err != nil {
+		return fmt.Errorf("failed to record status before suspension: %w", err)
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent("lct_suspended",
+			sdk.NewAttribute("lct_id", lct.LctId),
+			sdk.NewAttribute("reason", reason),
+		),
+	)
+	return nil
+}
+
+// ResumeLctRelationship returns a suspended LCT to the status it had when it
+// was suspended, e.g. an interrupted key exchange picks up where it stopped.
+// LCTs suspended before that status was kept resume as active.
+func (k Keeper) ResumeLctRelationship(ctx context.Context, lctId, reason string) error {
+	lct, found := k.GetLct(ctx, lctId)
+	if !found {
+		return errorsmod.Wrapf(types.ErrLctNotFound, "LCT %s", lctId)
+	}
+	if lct.PairingStatus != types.StatusSuspended {
+		return errorsmod.Wrapf(types.ErrIllegalTransition, "LCT %s is %s, only suspended LCTs can be resumed", lctId, lct.PairingStatus)
+	}
+
+	resumeTo, err := k.SuspendedFrom.Get(ctx, lctId)
+	if errors.Is(err, collections.ErrNotFound) {
+		resumeTo = types.StatusActive
+	} else if err != nil {
+		return fmt.Errorf("failed to read status before suspension: %w", err)
+	}
+	if err := k.transitionLct(ctx, &lct, resumeTo, "lct_resumed", reason); err != nil {
+		return err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent("lct_resumed",
+			sdk.NewAttribute("lct_id", lct.LctId),
+			sdk.NewAttribute("status", resumeTo),
+			sdk.NewAttribute("reason", reason),
+		),
+	)
+	return nil
+}
+
+// errIfSuspended returns ErrLctSuspended when the LCT has been suspended
+func errIfSuspended(lct types.LinkedContextToken) error {
+	if lct.PairingStatus == types.StatusSuspended {
+		return errorsmod.Wrapf(types.ErrLctSuspended, "LCT %s", lct.LctId)
+	}
+	return nil
+}
+
+// transitionLct moves an LCT to a new status, enforcing the lifecycle state
+// machine and recording the transition as eventType in the LCT's audit trail
+func (k Keeper) transitionLct(ctx context.Context, lct *types.LinkedContextToken, toStatus, eventType, reason string) error {
+	fromStatus := lct.PairingStatus
+	if !types.IsValidLCTTransition(fromStatus, toStatus) {
+		return errorsmod.Wrapf(types.ErrIllegalTransition, "LCT %s cannot move from %s to %s", lct.LctId, fromStatus, toStatus)
+	}
+
+	now := sdk.UnwrapSDKContext(ctx).BlockTime().Unix()
+	if err := k.appendAuditEntry(ctx, lct.LctId, eventType, fromStatus, toStatus, reason); err != nil {
+		return err
+	}
+
+	lct.PairingStatus = toStatus
+	lct.UpdatedAt = now
+	if err := k.SetLinkedContextToken(ctx, *lct); err != nil {
 		return fmt.Errorf("failed to update LCT status: %w", err)
 	}
+	if err := k.settleKeyExchange(ctx, lct.LctId, fromStatus, toStatus, now); err != nil {
+		return err
+	}
+	if fromStatus == types.StatusSuspended {
+		if err := k.SuspendedFrom.Remove(ctx, lct.LctId); err != nil {
+			return fmt.Errorf("failed to clear status before suspension: %w", err)
+		}
+	}
+	if toStatus == types.StatusTerminated {
+		return k.revokeSplitKey(ctx, lct.LctId, now)
+	}
+	return nil
+}
+
+// settleKeyExchange keeps an LCT's session key exchange in step with its
+// lifecycle: activating an LCT whose exchange was initiated completes the
+// exchange, and terminating the LCT expires it
+func (k Keeper) settleKeyExchange(ctx context.Context, lctId, fromStatus, toStatus string, now int64) error {
+	var exchangeStatus string
+	switch {
+	case fromStatus == types.StatusKeyExchangeInitiated && toStatus == types.StatusActive:
+		exchangeStatus = "active"
+	case toStatus == types.StatusTerminated:
+		exchangeStatus = "expired"
+	default:
+		return nil
+	}
 
-	// Queue offline operations if requested and pairingqueueKeeper is available
-	if notifyOffline && k.pairingqueueKeeper != nil {
-		_, _ = k.pairingqueueKeeper.QueueOfflineOperation(ctx, lct.ComponentAId, "termination_notification")
-		_, _ = k.pairingqueueKeeper.QueueOfflineOperation(ctx, lct.ComponentBId, "termination_notification")
+	exchange, err := k.SessionKeyExchanges.Get(ctx, lctId)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
 	}
 
+	if exchangeStatus == "active" {
+		exchange.LastUsed = now
+	}
+	exchange.Status = exchangeStatus
+	if err := k.SessionKeyExchanges.Set(ctx, lctId, exchange); err != nil {
+		return fmt.Errorf("failed to update session key exchange: %w", err)
+	}
 	return nil
 }
 
@@ -247,10 +592,79 @@ func (k Keeper) encryptWithSplitKey(keyShareA, keyShareB [32]byte, plaintext []b
 	return EncryptWithKey(combinedKey, plaintext)
 }
 
-// decryptWithSplitKey decrypts data using a combined key from two shares
-func (k Keeper) decryptWithSplitKey(keyShareA, keyShareB [32]byte, ciphertext []byte) ([]byte, error) {
+// decryptWithSplitKey opens a message sealed to the combined key of two
+// shares. The combined key must match the commitment recorded when the shares
+// were generated.
+func (k Keeper) decryptWithSplitKey(keyShareA, keyShareB [32]byte, commitment []byte, sealed []byte) ([]byte, error) {
 	combinedKey := k.combineKeyShares(keyShareA, keyShareB)
-	return DecryptWithKey(combinedKey, ciphertext)
+	defer ZeroKey(&combinedKey)
+
+	if err := verifyCombinedKey(combinedKey, commitment); err != nil {
+		return nil, err
+	}
+	return OpenMessage(combinedKey, sealed)
+}
+
+// verifyCombinedKey checks a combined key against its stored commitment
+func verifyCombinedKey(combinedKey [32]byte, commitment []byte) error {
+	if len(commitment) == 0 {
+		return errorsmod.Wrap(types.ErrKeyCommitmentMismatch, "no key commitment recorded")
+	}
+	if !VerifyKeyCommitment(combinedKey, commitment) {
+		return types.ErrKeyCommitmentMismatch
+	}
+	return nil
+}
+
+// lctKeyShares decodes the on-chain key half of an LCT and the device key half
+// held by the component, along with the LCT's key commitment
+func lctKeyShares(lct types.LinkedContextToken, deviceKeyHalf string) (lctShare, deviceShare [32]byte, commitment []byte, err error) {
+	if err = decodeKeyShare(lct.LctKeyHalf, &lctShare); err != nil {
+		return lctShare, deviceShare, nil, errorsmod.Wrapf(types.ErrInvalidRequest, "LCT %s has no usable key half", lct.LctId)
+	}
+	if err = decodeKeyShare(deviceKeyHalf, &deviceShare); err != nil {
+		return lctShare, deviceShare, nil, errorsmod.Wrap(types.ErrInvalidRequest, "device key half must be 32 hex-encoded bytes")
+	}
+	commitment, err = hex.DecodeString(lct.KeyCommitment)
+	if err != nil {
+		return lctShare, deviceShare, nil, errorsmod.Wrapf(types.ErrKeyCommitmentMismatch, "LCT %s has a malformed key commitment", lct.LctId)
+	}
+	return lctShare, deviceShare, commitment, nil
+}
+
+func decodeKeyShare(encoded string, share *[32]byte) error {
+	raw, err := hex.DecodeString(encoded)
+	if err != nil {
+		return err
+	}
+	if len(raw) != len(share) {
+		return fmt.Errorf("key share is %d bytes, want %d", len(raw), len(share))
+	}
+	copy(share[:], raw)
+	return nil
+}
+
+// ReconstructLctKey combines the LCT's on-chain key half with the device key
+// half and returns the combined key, after checking it against the commitment
+// recorded when the LCT was created.
+func (k Keeper) ReconstructLctKey(ctx context.Context, lctId, deviceKeyHalf string) ([32]byte, error) {
+	lct, found := k.GetLinkedContextToken(ctx, lctId)
+	if !found {
+		return [32]byte{}, errorsmod.Wrapf(types.ErrLctNotFound, "LCT %s", lctId)
+	}
+
+	lctShare, deviceShare, commitment, err := lctKeyShares(lct, deviceKeyHalf)
+	if err != nil {
+		return [32]byte{}, err
+	}
+	defer ZeroKey(&deviceShare)
+
+	combinedKey := k.combineKeyShares(lctShare, deviceShare)
+	if err := verifyCombinedKey(combinedKey, commitment); err != nil {
+		ZeroKey(&combinedKey)
+		return [32]byte{}, errorsmod.Wrapf(err, "LCT %s", lctId)
+	}
+	return combinedKey, nil
 }
 
 // GetSplitKey retrieves split key metadata by ID
@@ -284,7 +698,9 @@ func (k Keeper) UpdateSplitKeyStatus(ctx context.Context, splitKeyId, newStatus
 
 // Cryptographic Operations for LCT Manager
 
-// EncryptMessageForLCT encrypts a message for secure communication between LCT components
+// EncryptMessageForLCT seals a message for the components of an LCT. The
+// result carries the ephemeral public key it was sealed with, so a component
+// holding the device key half can open it with DecryptMessageForLCT.
 func (k Keeper) EncryptMessageForLCT(ctx context.Context, lctId string, message []byte) ([]byte, error) {
 	// Get the LCT relationship
 	lct, found := k.GetLinkedContextToken(ctx, lctId)
@@ -292,52 +708,58 @@ func (k Keeper) EncryptMessageForLCT(ctx context.Context, lctId string, message
 		return nil, fmt.Errorf("LCT not found: %s", lctId)
 	}
 
+	if err := errIfSuspended(lct); err != nil {
+		return nil, err
+	}
+
 	// Check if LCT is active
 	if lct.PairingStatus != "active" {
 		return nil, fmt.Errorf("LCT is not active: %s", lctId)
 	}
 
-	// Generate ephemeral key shares for this message
-	keyShareA, keyShareB, err := k.generateSplitKeyPair()
-	if err != nil {
-		return nil, fmt.Errorf("failed to generate message keys: %w", err)
+	// LCTs created before message keys were recorded cannot be sealed to
+	var messageKey [32]byte
+	if err := decodeKeyShare(lct.MessageKey, &messageKey); err != nil {
+		return nil, errorsmod.Wrapf(types.ErrInvalidRequest, "LCT %s has no message key", lctId)
 	}
 
-	// Encrypt the message using split-key cryptography
-	encryptedMessage, err := k.encryptWithSplitKey(keyShareA, keyShareB, message)
+	encryptedMessage, err := SealMessage(messageKey, message)
 	if err != nil {
-		// Clean up keys on error
-		ZeroKey(&keyShareA)
-		ZeroKey(&keyShareB)
 		return nil, fmt.Errorf("failed to encrypt message: %w", err)
 	}
-
-	// Securely zero the key shares
-	ZeroKey(&keyShareA)
-	ZeroKey(&keyShareB)
-
 	return encryptedMessage, nil
 }
 
-// DecryptMessageForLCT decrypts a message for secure communication between LCT components
-func (k Keeper) DecryptMessageForLCT(ctx context.Context, lctId string, encryptedMessage []byte) ([]byte, error) {
+// DecryptMessageForLCT decrypts a message for secure communication between LCT
+// components. The caller supplies the device key half; the combined key is
+// checked against the LCT's key commitment before it is used.
+func (k Keeper) DecryptMessageForLCT(ctx context.Context, lctId, deviceKeyHalf string, encryptedMessage []byte) ([]byte, error) {
 	// Get the LCT relationship
 	lct, found := k.GetLinkedContextToken(ctx, lctId)
 	if !found {
 		return nil, fmt.Errorf("LCT not found: %s", lctId)
 	}
 
+	if err := errIfSuspended(lct); err != nil {
+		return nil, err
+	}
+
 	// Check if LCT is active
 	if lct.PairingStatus != "active" {
 		return nil, fmt.Errorf("LCT is not active: %s", lctId)
 	}
 
-	// In a real implementation, the key shares would be provided by the components
-	// For now, we'll simulate the decryption process
-	// This would typically involve the components providing their key shares
+	lctShare, deviceShare, commitment, err := lctKeyShares(lct, deviceKeyHalf)
+	if err != nil {
+		return nil, err
+	}
+	defer ZeroKey(&deviceShare)
 
-	// For demonstration, we'll return an error indicating that key shares are needed
-	return nil, fmt.Errorf("key shares required for decryption - this should be handled by components")
+	plaintext, err := k.decryptWithSplitKey(lctShare, deviceShare, commitment, encryptedMessage)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "failed to decrypt message for LCT %s", lctId)
+	}
+	return plaintext, nil
 }
 
 // ValidateLCTCryptographicIntegrity validates the cryptographic integrity of an LCT relationship
@@ -353,6 +775,10 @@ func (k Keeper) ValidateLCTCryptographicIntegrity(ctx context.Context, lctId str
 		return false, fmt.Errorf("LCT missing required fields: %s", lctId)
 	}
 
+	if err := errIfSuspended(lct); err != nil {
+		return false, err
+	}
+
 	// Check if LCT is in a valid state for cryptographic operations
 	if lct.PairingStatus != "active" && lct.PairingStatus != "pending" {
 		return false, fmt.Errorf("LCT not in valid state for crypto operations: %s", lctId)
@@ -468,6 +894,14 @@ func (k Keeper) SetComponentRelationship(ctx context.Context, relationship types
 
 // CreateLctRelationship creates a new LCT relationship between two components
 func (k Keeper) CreateLctRelationship(ctx context.Context, creator sdk.AccAddress, componentA, componentB, context, proxyID string) (*types.LinkedContextToken, error) {
+	context, err := types.NormalizeOperationalContext(context)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.checkComponentsActive(ctx, componentA, componentB); err != nil {
+		return nil, err
+	}
+
 	// Generate LCT ID
 	lctID := fmt.Sprintf("lct_%s_%s_%d", componentA, componentB, time.Now().UnixNano())
 
@@ -488,7 +922,7 @@ func (k Keeper) CreateLctRelationship(ctx context.Context, creator sdk.AccAddres
 	}
 
 	// Store LCT
-	if err := k.LinkedContextToken.Set(ctx, lctID, lct); err != nil {
+	if err := k.storeNewLct(ctx, lct); err != nil {
 		return nil, err
 	}
 
@@ -512,7 +946,60 @@ func (k Keeper) GetLct(ctx context.Context, lctID string) (types.LinkedContextTo
 	return lct, true
 }
 
-// UpdateLctStatus updates the status of an LCT
+// ListLctsPaginated retrieves one page of the LCTs that pass filter, ordered
+// by LCT ID. The store is walked a page at a time, so only the matches of the
+// requested page are held. With a component ID only that component's entries
+// in the component index are walked.
+func (k Keeper) ListLctsPaginated(ctx context.Context, filter types.LctFilter, pageReq *query.PageRequest) ([]types.LinkedContextToken, *query.PageResponse, error) {
+	if filter.ComponentID != "" {
+		lcts, pageRes, err := query.CollectionFilteredPaginate(ctx, k.LctComponentIndex, pageReq,
+			func(_ collections.Pair[string, string], lctId string) (bool, error) {
+				lct, err := k.LinkedContextToken.Get(ctx, lctId)
+				if err != nil {
+					return false, err
+				}
+				return filter.Matches(lct), nil
+			},
+			func(_ collections.Pair[string, string], lctId string) (types.LinkedContextToken, error) {
+				return k.LinkedContextToken.Get(ctx, lctId)
+			},
+			query.WithCollectionPaginationPairPrefix[string, string](filter.ComponentID),
+		)
+		if err != nil {
+			return nil, nil, errorsmod.Wrap(err, "failed to paginate component LCTs")
+		}
+		return lcts, pageRes, nil
+	}
+
+	lcts, pageRes, err := query.CollectionFilteredPaginate(ctx, k.LinkedContextToken, pageReq,
+		func(_ string, lct types.LinkedContextToken) (bool, error) {
+			return filter.Matches(lct), nil
+		},
+		func(_ string, lct types.LinkedContextToken) (types.LinkedContextToken, error) {
+			return lct, nil
+		})
+	if err != nil {
+		return nil, nil, errorsmod.Wrap(err, "failed to paginate LCTs")
+	}
+	return lcts, pageRes, nil
+}
+
+// GetLctsByProxy retrieves one page of the non-terminated LCTs mediated by
+// proxyId, in LCT ID order, through the proxy index
+func (k Keeper) GetLctsByProxy(ctx context.Context, proxyId string, pageReq *query.PageRequest) ([]types.LinkedContextToken, *query.PageResponse, error) {
+	lcts, pageRes, err := query.CollectionPaginate(ctx, k.LctProxyIndex, pageReq,
+		func(_ collections.Pair[string, string], lctId string) (types.LinkedContextToken, error) {
+			return k.LinkedContextToken.Get(ctx, lctId)
+		},
+		query.WithCollectionPaginationPairPrefix[string, string](proxyId),
+	)
+	if err != nil {
+		return nil, nil, errorsmod.Wrap(err, "failed to paginate proxy LCTs")
+	}
+	return lcts, pageRes, nil
+}
+
+// UpdateLctStatus updates the status of an LCT, subject to the lifecycle state machine
 func (k Keeper) UpdateLctStatus(ctx context.Context, lctID, newStatus, reason string) error {
 	lct, err := k.LinkedContextToken.Get(ctx, lctID)
 	if err != nil {
@@ -524,20 +1011,180 @@ func (k Keeper) UpdateLctStatus(ctx context.Context, lctID, newStatus, reason st
 		return types.ErrInvalidLctStatus
 	}
 
-	lct.PairingStatus = newStatus
-	lct.UpdatedAt = time.Now().Unix()
+	switch {
+	case newStatus == types.StatusTerminated:
+		// Termination also updates relationships
+		return k.TerminateLctRelationship(ctx, lctID, reason, false)
+	case newStatus == types.StatusSuspended:
+		return k.SuspendLctRelationship(ctx, lctID, reason)
+	case lct.PairingStatus == types.StatusSuspended:
+		// Leaving a suspension is a resume, which restores the status the LCT
+		// was suspended from; asking for any other status is refused
+		resumeTo, err := k.SuspendedFrom.Get(ctx, lctID)
+		if errors.Is(err, collections.ErrNotFound) {
+			resumeTo = types.StatusActive
+		} else if err != nil {
+			return fmt.Errorf("failed to read status before suspension: %w", err)
+		}
+		if newStatus != types.StatusActive && newStatus != resumeTo {
+			return errorsmod.Wrapf(types.ErrIllegalTransition, "LCT %s resumes to %s, not %s", lctID, resumeTo, newStatus)
+		}
+		return k.ResumeLctRelationship(ctx, lctID, reason)
+	}
+
+	return k.transitionLct(ctx, &lct, newStatus, "lct_status_updated", reason)
+}
+
+// maxIdleTransitionsPerBlock bounds the writes a single end-block sweep performs;
+// any remaining idle LCTs are picked up in the following blocks
+const maxIdleTransitionsPerBlock = 100
+
+// SweepIdleLcts marks active LCTs whose last contact is older than the idle
+// timeout as inactive and returns how many were transitioned
+func (k Keeper) SweepIdleLcts(ctx context.Context) (int, error) {
+	params, err := k.Params.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	if params.IdleTimeoutSeconds <= 0 {
+		return 0, nil
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	now := sdkCtx.BlockTime().Unix()
+	cutoff := now - params.IdleTimeoutSeconds
+
+	// Collect first; the index must not be written while it is being walked.
+	// It is ordered by last contact, so the walk stops at the first LCT
+	// contacted after the cutoff.
+	var idle []types.LinkedContextToken
+	rng := new(collections.Range[collections.Pair[int64, string]]).EndExclusive(collections.Join(cutoff, ""))
+	err = k.ActiveContactIndex.Walk(ctx, rng, func(_ collections.Pair[int64, string], lctID string) (bool, error) {
+		lct, err := k.LinkedContextToken.Get(ctx, lctID)
+		if err != nil {
+			return true, err
+		}
+		idle = append(idle, lct)
+		return len(idle) >= maxIdleTransitionsPerBlock, nil
+	})
+	if err != nil {
+		return 0, err
+	}
 
-	if err := k.LinkedContextToken.Set(ctx, lctID, lct); err != nil {
+	for _, lct := range idle {
+		if err := k.transitionLct(ctx, &lct, types.StatusInactive, "lct_marked_inactive", "idle timeout"); err != nil {
+			return 0, err
+		}
+
+		sdkCtx.EventManager().EmitEvent(
+			sdk.NewEvent("lct_marked_inactive",
+				sdk.NewAttribute("lct_id", lct.LctId),
+				sdk.NewAttribute("component_a", lct.ComponentAId),
+				sdk.NewAttribute("component_b", lct.ComponentBId),
+				sdk.NewAttribute("last_contact_at", fmt.Sprintf("%d", lct.LastContactAt)),
+				sdk.NewAttribute("idle_seconds", fmt.Sprintf("%d", now-lct.LastContactAt)),
+			),
+		)
+	}
+
+	return len(idle), nil
+}
+
+// RecordLctContact refreshes the last contact time of an LCT and reactivates
+// it if the idle sweep had marked it inactive
+func (k Keeper) RecordLctContact(ctx context.Context, lctID string) error {
+	lct, err := k.LinkedContextToken.Get(ctx, lctID)
+	if err != nil {
+		return types.ErrLctNotFound
+	}
+	if err := errIfSuspended(lct); err != nil {
 		return err
 	}
 
-	// If terminating, update relationships
-	if newStatus == types.StatusTerminated {
-		if err := k.removeLctFromRelationships(ctx, lctID); err != nil {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	now := sdkCtx.BlockTime().Unix()
+	lct.LastContactAt = now
+
+	if lct.PairingStatus == types.StatusInactive {
+		if err := k.transitionLct(ctx, &lct, types.StatusActive, "lct_reactivated", "contact resumed"); err != nil {
 			return err
 		}
+
+		sdkCtx.EventManager().EmitEvent(
+			sdk.NewEvent("lct_reactivated",
+				sdk.NewAttribute("lct_id", lct.LctId),
+				sdk.NewAttribute("component_a", lct.ComponentAId),
+				sdk.NewAttribute("component_b", lct.ComponentBId),
+			),
+		)
+		return nil
+	}
+
+	return k.SetLinkedContextToken(ctx, lct)
+}
+
+// keyReferenceLength is the hex length of the 32-byte device key half used as a key reference
+const keyReferenceLength = 64
+
+// InitiateKeyExchange records that the components of an active LCT started a
+// session key exchange. Only a hash of the key reference is kept on chain, so
+// the device key half it identifies is never exposed. An exchange already in
+// progress may be re-initiated.
+func (k Keeper) InitiateKeyExchange(ctx context.Context, lctId, keyReference string) error {
+	if len(keyReference) != keyReferenceLength {
+		return errorsmod.Wrapf(types.ErrInvalidKeyReference, "expected %d hex characters, got %d", keyReferenceLength, len(keyReference))
+	}
+	if _, err := hex.DecodeString(keyReference); err != nil {
+		return errorsmod.Wrap(types.ErrInvalidKeyReference, "key reference must be hex encoded")
+	}
+
+	lct, found := k.GetLct(ctx, lctId)
+	if !found {
+		return errorsmod.Wrapf(types.ErrLctNotFound, "LCT %s", lctId)
+	}
+	if err := errIfSuspended(lct); err != nil {
+		return err
+	}
+	if lct.PairingStatus != types.StatusActive && lct.PairingStatus != types.StatusKeyExchangeInitiated {
+		return errorsmod.Wrapf(types.ErrInvalidLctStatus, "LCT %s is %s, key exchange requires an active LCT", lctId, lct.PairingStatus)
+	}
+
+	now := sdk.UnwrapSDKContext(ctx).BlockTime().Unix()
+	referenceHash := sha256.Sum256([]byte(keyReference))
+
+	if err := k.SessionKeyExchanges.Set(ctx, lctId, types.SessionKeyExchange{
+		PairingId:                lctId,
+		LctRelationshipId:        lctId,
+		HashedCombinedSessionKey: referenceHash[:],
+		Status:                   "pending",
+		CreatedAt:                now,
+	}); err != nil {
+		return fmt.Errorf("failed to store session key exchange: %w", err)
+	}
+
+	lct.KeyExchangeTimestamp = now
+	if lct.PairingStatus == types.StatusKeyExchangeInitiated {
+		// Re-initiation refreshes the exchange without a new lifecycle transition
+		if err := k.appendAuditEntry(ctx, lctId, "key_exchange_initiated", lct.PairingStatus, lct.PairingStatus, "re-initiated"); err != nil {
+			return err
+		}
+		lct.UpdatedAt = now
+		if err := k.SetLinkedContextToken(ctx, lct); err != nil {
+			return fmt.Errorf("failed to update LCT status: %w", err)
+		}
+	} else if err := k.transitionLct(ctx, &lct, types.StatusKeyExchangeInitiated, "key_exchange_initiated", ""); err != nil {
+		return err
 	}
 
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent("key_exchange_initiated",
+			sdk.NewAttribute("lct_id", lctId),
+			sdk.NewAttribute("timestamp", fmt.Sprintf("%d", now)),
+		),
+	)
 	return nil
 }
 
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"cosmossdk.io/core/address"
+	"cosmossdk.io/log"
 	storetypes "cosmossdk.io/store/types"
 	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
 	"github.com/cosmos/cosmos-sdk/runtime"
@@ -13,6 +14,7 @@ import (
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
+	componentregistrytypes "racecar-web/x/componentregistry/types"
 	"racecar-web/x/lctmanager/keeper"
 	module "racecar-web/x/lctmanager/module"
 	"racecar-web/x/lctmanager/types"
@@ -26,6 +28,25 @@ type fixture struct {
 
 func initFixture(t *testing.T) *fixture {
 	t.Helper()
+	return initFixtureWithQueue(t, nil, log.NewNopLogger())
+}
+
+// initFixtureWithQueue builds the fixture around the given pairing queue
+// keeper and logger, either of which may be nil as in the app
+func initFixtureWithQueue(t *testing.T, pairingqueueKeeper types.PairingqueueKeeper, logger log.Logger) *fixture {
+	t.Helper()
+	return newFixture(t, nil, pairingqueueKeeper, logger)
+}
+
+// initFixtureWithRegistry builds the fixture around a component registry,
+// which relationships are then checked against
+func initFixtureWithRegistry(t *testing.T, componentregistryKeeper componentregistrytypes.ComponentregistryKeeper) *fixture {
+	t.Helper()
+	return newFixture(t, componentregistryKeeper, nil, log.NewNopLogger())
+}
+
+func newFixture(t *testing.T, componentregistryKeeper componentregistrytypes.ComponentregistryKeeper, pairingqueueKeeper types.PairingqueueKeeper, logger log.Logger) *fixture {
+	t.Helper()
 
 	encCfg := moduletestutil.MakeTestEncodingConfig(module.AppModule{})
 	addressCodec := addresscodec.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix())
@@ -42,8 +63,9 @@ func initFixture(t *testing.T) *fixture {
 		addressCodec,
 		authority,
 		nil,
-		nil,
-		nil,
+		componentregistryKeeper,
+		pairingqueueKeeper,
+		logger,
 	)
 
 	// Initialize params
@@ -0,0 +1,96 @@
+package keeper_test
+
+import (
+	"encoding/hex"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"racecar-web/x/lctmanager/keeper"
+	"racecar-web/x/lctmanager/types"
+)
+
+func TestKeyCommitmentMatchesReconstructedKey(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(1752484904, 0))
+
+	lctID, deviceKeyHalf, err := f.keeper.CreateLCTRelationship(ctx, "MODBATT-PACK-001", "MODBATT-MC-001", "energy_transfer", "")
+	require.NoError(t, err)
+
+	lct, found := f.keeper.GetLct(ctx, lctID)
+	require.True(t, found)
+	require.Len(t, lct.KeyCommitment, 64)
+
+	combinedKey, err := f.keeper.ReconstructLctKey(ctx, lctID, deviceKeyHalf)
+	require.NoError(t, err)
+	commitment, _ := hex.DecodeString(lct.KeyCommitment)
+	require.True(t, keeper.VerifyKeyCommitment(combinedKey, commitment))
+
+	// A component holding the device half can open what the chain sealed for the LCT
+	sealed, err := f.keeper.EncryptMessageForLCT(ctx, lctID, []byte("cell voltages nominal"))
+	require.NoError(t, err)
+	plaintext, err := f.keeper.DecryptMessageForLCT(ctx, lctID, deviceKeyHalf, sealed)
+	require.NoError(t, err)
+	require.Equal(t, "cell voltages nominal", string(plaintext))
+
+	// Each message is sealed with its own ephemeral key
+	again, err := f.keeper.EncryptMessageForLCT(ctx, lctID, []byte("cell voltages nominal"))
+	require.NoError(t, err)
+	require.NotEqual(t, sealed[:32], again[:32])
+
+	// A message whose ephemeral key was swapped does not open
+	tampered := append([]byte(nil), sealed...)
+	copy(tampered[:32], again[:32])
+	_, err = f.keeper.DecryptMessageForLCT(ctx, lctID, deviceKeyHalf, tampered)
+	require.Error(t, err)
+
+	// LCTs without a message key cannot be sealed to
+	lct.MessageKey = ""
+	require.NoError(t, f.keeper.SetLinkedContextToken(ctx, lct))
+	_, err = f.keeper.EncryptMessageForLCT(ctx, lctID, []byte("cell voltages nominal"))
+	require.ErrorIs(t, err, types.ErrInvalidRequest)
+}
+
+func TestKeyCommitmentRejectsMismatchedKey(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(1752484904, 0))
+
+	lctID, deviceKeyHalf, err := f.keeper.CreateLCTRelationship(ctx, "MODBATT-PACK-002", "MODBATT-MC-002", "energy_transfer", "")
+	require.NoError(t, err)
+	ciphertext, err := f.keeper.EncryptMessageForLCT(ctx, lctID, []byte("cell voltages nominal"))
+	require.NoError(t, err)
+
+	wrongShare, err := keeper.GenerateKeyShare()
+	require.NoError(t, err)
+	wrongHalf := hex.EncodeToString(wrongShare[:])
+
+	_, err = f.keeper.ReconstructLctKey(ctx, lctID, wrongHalf)
+	require.ErrorIs(t, err, types.ErrKeyCommitmentMismatch)
+	_, err = f.keeper.DecryptMessageForLCT(ctx, lctID, wrongHalf, ciphertext)
+	require.ErrorIs(t, err, types.ErrKeyCommitmentMismatch)
+
+	// LCTs without a recorded commitment cannot be reconstructed
+	lct, _ := f.keeper.GetLct(ctx, lctID)
+	lct.KeyCommitment = ""
+	require.NoError(t, f.keeper.SetLinkedContextToken(ctx, lct))
+	_, err = f.keeper.ReconstructLctKey(ctx, lctID, deviceKeyHalf)
+	require.ErrorIs(t, err, types.ErrKeyCommitmentMismatch)
+
+	_, err = f.keeper.ReconstructLctKey(ctx, lctID, "not-hex")
+	require.ErrorIs(t, err, types.ErrInvalidRequest)
+}
+
+func TestVerifyKeyCommitment(t *testing.T) {
+	key, err := keeper.GenerateKeyShare()
+	require.NoError(t, err)
+	commitment := keeper.KeyCommitment(key)
+
+	require.True(t, keeper.VerifyKeyCommitment(key, commitment[:]))
+	require.NotEqual(t, key, commitment)
+
+	key[0] ^= 0x01
+	require.False(t, keeper.VerifyKeyCommitment(key, commitment[:]))
+	require.False(t, keeper.VerifyKeyCommitment(key, nil))
+}
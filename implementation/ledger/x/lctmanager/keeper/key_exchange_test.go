@@ -0,0 +1,76 @@
+package keeper_test
+
+import (
+	"crypto/sha256"
+	"strings"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"racecar-web/x/lctmanager/types"
+)
+
+func TestInitiateKeyExchange(t *testing.T) {
+	f := initFixture(t)
+	blockTime := time.Unix(1752484904, 0)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(blockTime).WithEventManager(sdk.NewEventManager())
+
+	lctID, keyReference, err := f.keeper.CreateLCTRelationship(ctx, "MODBATT-PACK-001", "MODBATT-MC-001", "energy_transfer", "")
+	require.NoError(t, err)
+	require.Len(t, keyReference, 64)
+
+	require.NoError(t, f.keeper.InitiateKeyExchange(ctx, lctID, keyReference))
+
+	lct, found := f.keeper.GetLinkedContextToken(ctx, lctID)
+	require.True(t, found)
+	require.Equal(t, types.StatusKeyExchangeInitiated, lct.PairingStatus)
+	require.Equal(t, blockTime.Unix(), lct.KeyExchangeTimestamp)
+
+	exchange, err := f.keeper.SessionKeyExchanges.Get(ctx, lctID)
+	require.NoError(t, err)
+	require.Equal(t, lctID, exchange.LctRelationshipId)
+	require.Equal(t, "pending", exchange.Status)
+	require.Equal(t, blockTime.Unix(), exchange.CreatedAt)
+	// Only a hash of the key reference is stored
+	referenceHash := sha256.Sum256([]byte(keyReference))
+	require.Equal(t, referenceHash[:], exchange.HashedCombinedSessionKey)
+
+	events := ctx.EventManager().Events()
+	require.Equal(t, "key_exchange_initiated", events[len(events)-1].Type)
+
+	// An exchange in progress may be restarted
+	require.NoError(t, f.keeper.InitiateKeyExchange(ctx, lctID, keyReference))
+}
+
+func TestInitiateKeyExchangeRejects(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(1752484904, 0))
+
+	lctID, keyReference, err := f.keeper.CreateLCTRelationship(ctx, "MODBATT-PACK-001", "MODBATT-MC-001", "energy_transfer", "")
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name         string
+		lctID        string
+		keyReference string
+		expErr       error
+	}{
+		{"short key reference", lctID, "invalid_key_ref", types.ErrInvalidKeyReference},
+		{"non-hex key reference", lctID, strings.Repeat("z", 64), types.ErrInvalidKeyReference},
+		{"unknown LCT", "lct-unknown", keyReference, types.ErrLctNotFound},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.ErrorIs(t, f.keeper.InitiateKeyExchange(ctx, tc.lctID, tc.keyReference), tc.expErr)
+		})
+	}
+
+	// Only active LCTs can start a key exchange
+	require.NoError(t, f.keeper.UpdateLctStatus(ctx, lctID, types.StatusTerminated, "decommissioned"))
+	require.ErrorIs(t, f.keeper.InitiateKeyExchange(ctx, lctID, keyReference), types.ErrInvalidLctStatus)
+
+	_, err = f.keeper.SessionKeyExchanges.Get(ctx, lctID)
+	require.Error(t, err)
+}
@@ -0,0 +1,106 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"racecar-web/x/lctmanager/types"
+)
+
+func TestLctLifecycle(t *testing.T) {
+	f := initFixture(t)
+	blockTime := time.Unix(1752484904, 0)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(blockTime)
+
+	lct, err := f.keeper.CreateLctRelationship(ctx, sdk.AccAddress("creator"), "MODBATT-PACK-001", "MODBATT-MC-001", "energy_transfer", "")
+	require.NoError(t, err)
+	require.Equal(t, types.StatusPending, lct.PairingStatus)
+
+	require.NoError(t, f.keeper.ActivateLctRelationship(ctx, lct.LctId))
+	stored, found := f.keeper.GetLct(ctx, lct.LctId)
+	require.True(t, found)
+	require.Equal(t, types.StatusActive, stored.PairingStatus)
+	require.Equal(t, blockTime.Unix(), stored.UpdatedAt)
+
+	// Activating an already active LCT is not a transition
+	err = f.keeper.ActivateLctRelationship(ctx, lct.LctId)
+	require.ErrorIs(t, err, types.ErrIllegalTransition)
+
+	require.NoError(t, f.keeper.TerminateLctRelationship(ctx, lct.LctId, "pack replaced", false))
+	stored, _ = f.keeper.GetLct(ctx, lct.LctId)
+	require.Equal(t, types.StatusTerminated, stored.PairingStatus)
+
+	// No resurrection
+	err = f.keeper.ActivateLctRelationship(ctx, lct.LctId)
+	require.ErrorIs(t, err, types.ErrIllegalTransition)
+	err = f.keeper.UpdateLctStatus(ctx, lct.LctId, types.StatusInactive, "")
+	require.ErrorIs(t, err, types.ErrIllegalTransition)
+	err = f.keeper.TerminateLctRelationship(ctx, lct.LctId, "again", false)
+	require.ErrorIs(t, err, types.ErrIllegalTransition)
+
+	trail, err := f.keeper.GetLctAuditTrail(ctx, lct.LctId)
+	require.NoError(t, err)
+	require.Len(t, trail, 2)
+	require.Equal(t, uint64(1), trail[0].Sequence)
+	require.Equal(t, types.StatusPending, trail[0].FromStatus)
+	require.Equal(t, types.StatusActive, trail[0].ToStatus)
+	require.Equal(t, blockTime.Unix(), trail[0].Timestamp)
+	require.Equal(t, uint64(2), trail[1].Sequence)
+	require.Equal(t, types.StatusActive, trail[1].FromStatus)
+	require.Equal(t, types.StatusTerminated, trail[1].ToStatus)
+	require.Equal(t, "pack replaced", trail[1].Reason)
+}
+
+func TestLctLifecycleRejects(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(1752484904, 0))
+
+	err := f.keeper.ActivateLctRelationship(ctx, "lct-missing")
+	require.ErrorIs(t, err, types.ErrLctNotFound)
+	err = f.keeper.TerminateLctRelationship(ctx, "lct-missing", "", false)
+	require.ErrorIs(t, err, types.ErrLctNotFound)
+
+	lct, err := f.keeper.CreateLctRelationship(ctx, sdk.AccAddress("creator"), "MODBATT-PACK-002", "MODBATT-MC-002", "energy_transfer", "")
+	require.NoError(t, err)
+
+	// A pending LCT must be activated before it can go idle
+	err = f.keeper.UpdateLctStatus(ctx, lct.LctId, types.StatusInactive, "")
+	require.ErrorIs(t, err, types.ErrIllegalTransition)
+
+	// Pending LCTs can be terminated directly
+	require.NoError(t, f.keeper.TerminateLctRelationship(ctx, lct.LctId, "pairing abandoned", false))
+
+	trail, err := f.keeper.GetLctAuditTrail(ctx, lct.LctId)
+	require.NoError(t, err)
+	require.Len(t, trail, 1)
+	require.Equal(t, types.StatusTerminated, trail[0].ToStatus)
+}
+
+func TestIsValidLCTTransition(t *testing.T) {
+	require.True(t, types.IsValidLCTTransition(types.StatusPending, types.StatusActive))
+	require.True(t, types.IsValidLCTTransition(types.StatusKeyExchangeInitiated, types.StatusActive))
+	require.True(t, types.IsValidLCTTransition(types.StatusInactive, types.StatusActive))
+	require.True(t, types.IsValidLCTTransition(types.StatusActive, types.StatusTerminated))
+	require.False(t, types.IsValidLCTTransition(types.StatusTerminated, types.StatusActive))
+	require.False(t, types.IsValidLCTTransition(types.StatusTerminated, types.StatusPending))
+	require.False(t, types.IsValidLCTTransition(types.StatusActive, types.StatusPending))
+	require.False(t, types.IsValidLCTTransition("unknown", types.StatusActive))
+}
+
+func TestTerminatedLctStaysInComponentRelationships(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(1752484904, 0))
+
+	lct, err := f.keeper.CreateLctRelationship(ctx, sdk.AccAddress("creator"), "MODBATT-PACK-004", "MODBATT-MC-004", "energy_transfer", "")
+	require.NoError(t, err)
+	require.NoError(t, f.keeper.TerminateLctRelationship(ctx, lct.LctId, "replaced", false))
+
+	// The bridge filters these by status, so a terminated LCT must still be listed
+	lcts, err := f.keeper.GetComponentRelationships(ctx, "MODBATT-MC-004")
+	require.NoError(t, err)
+	require.Len(t, lcts, 1)
+	require.Equal(t, types.StatusTerminated, lcts[0].PairingStatus)
+}
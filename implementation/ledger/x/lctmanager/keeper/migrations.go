@@ -0,0 +1,99 @@
+package keeper
+
+import (
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"racecar-web/x/lctmanager/types"
+)
+
+// Migrator runs the module's store migrations
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a Migrator for the keeper's store
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 normalizes the operational contexts stored before they were
+// normalized on creation, and re-keys their LctPairIndex entries so that the
+// normalized lookups of GetLctBetween find them. A context that cannot be
+// normalized could never be looked up and is left as it is.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	// Collect first; the map must not be written while it is being walked
+	var stale []types.LinkedContextToken
+	err := m.keeper.LinkedContextToken.Walk(ctx, nil, func(_ string, lct types.LinkedContextToken) (bool, error) {
+		normalized, err := types.NormalizeOperationalContext(lct.OperationalContext)
+		if err == nil && normalized != lct.OperationalContext {
+			stale = append(stale, lct)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, lct := range stale {
+		normalized, _ := types.NormalizeOperationalContext(lct.OperationalContext)
+		if lct.PairingStatus != types.StatusTerminated {
+			oldKey := lctPairKey(lct.ComponentAId, lct.ComponentBId, lct.OperationalContext)
+			if indexed, err := m.keeper.LctPairIndex.Get(ctx, oldKey); err == nil && indexed == lct.LctId {
+				if err := m.keeper.LctPairIndex.Remove(ctx, oldKey); err != nil {
+					return err
+				}
+			}
+		}
+		lct.OperationalContext = normalized
+
+		// Contexts spelled differently may now coincide; the newest live LCT
+		// of the pair keeps the index entry
+		current, found := m.keeper.GetLctBetween(ctx, lct.ComponentAId, lct.ComponentBId, normalized)
+		if found && current.LctId != lct.LctId && current.CreatedAt >= lct.CreatedAt {
+			if err := m.keeper.LinkedContextToken.Set(ctx, lct.LctId, lct); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := m.keeper.SetLinkedContextToken(ctx, lct); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Migrate2to3 builds the ActiveContactIndex the idle sweep walks from the
+// LCTs stored before it existed
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	if err := m.keeper.ActiveContactIndex.Clear(ctx, nil); err != nil {
+		return err
+	}
+	return m.keeper.LinkedContextToken.Walk(ctx, nil, func(_ string, lct types.LinkedContextToken) (bool, error) {
+		if lct.PairingStatus != types.StatusActive {
+			return false, nil
+		}
+		return false, m.keeper.ActiveContactIndex.Set(ctx, collections.Join(lct.LastContactAt, lct.LctId), lct.LctId)
+	})
+}
+
+// Migrate3to4 builds the LctComponentIndex that component lookups read from
+// the LCTs stored before it existed
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	// Collect first; the index must not be written while the LCTs are being walked
+	var lcts []types.LinkedContextToken
+	err := m.keeper.LinkedContextToken.Walk(ctx, nil, func(_ string, lct types.LinkedContextToken) (bool, error) {
+		lcts = append(lcts, lct)
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, lct := range lcts {
+		if err := m.keeper.indexComponents(ctx, lct); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,114 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"racecar-web/x/lctmanager/keeper"
+	"racecar-web/x/lctmanager/types"
+)
+
+func TestMigrate1to2NormalizesStoredContexts(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+
+	// Stored by version 1, which kept contexts and their index keys as given
+	for _, lct := range []types.LinkedContextToken{
+		{LctId: "lct-1", ComponentAId: "MODBATT-MOD-001", ComponentBId: "MODBATT-PACK-001", OperationalContext: "Battery Management", PairingStatus: types.StatusActive, CreatedAt: 100},
+		{LctId: "lct-2", ComponentAId: "MODBATT-MOD-002", ComponentBId: "MODBATT-PACK-001", OperationalContext: "race-day", PairingStatus: types.StatusActive, CreatedAt: 100},
+		{LctId: "lct-3", ComponentAId: "MODBATT-MOD-002", ComponentBId: "MODBATT-PACK-001", OperationalContext: "Race Day", PairingStatus: types.StatusActive, CreatedAt: 200},
+		{LctId: "lct-4", ComponentAId: "MODBATT-MOD-003", ComponentBId: "MODBATT-PACK-001", OperationalContext: "pit/lane", PairingStatus: types.StatusActive, CreatedAt: 100},
+	} {
+		require.NoError(t, f.keeper.LinkedContextToken.Set(ctx, lct.LctId, lct))
+		require.NoError(t, f.keeper.LctPairIndex.Set(ctx, collections.Join3(lct.ComponentAId, lct.ComponentBId, lct.OperationalContext), lct.LctId))
+	}
+	_, found := f.keeper.GetLctBetween(ctx, "MODBATT-PACK-001", "MODBATT-MOD-001", "battery_management")
+	require.False(t, found)
+
+	require.NoError(t, keeper.NewMigrator(f.keeper).Migrate1to2(ctx))
+
+	lct, found := f.keeper.GetLctBetween(ctx, "MODBATT-PACK-001", "MODBATT-MOD-001", "Battery Management")
+	require.True(t, found)
+	require.Equal(t, "lct-1", lct.LctId)
+	require.Equal(t, "battery_management", lct.OperationalContext)
+	has, err := f.keeper.LctPairIndex.Has(ctx, collections.Join3("MODBATT-MOD-001", "MODBATT-PACK-001", "Battery Management"))
+	require.NoError(t, err)
+	require.False(t, has)
+
+	// Two spellings of one context: the newer LCT keeps the index entry
+	lct, found = f.keeper.GetLctBetween(ctx, "MODBATT-PACK-001", "MODBATT-MOD-002", "race_day")
+	require.True(t, found)
+	require.Equal(t, "lct-3", lct.LctId)
+	older, found := f.keeper.GetLct(ctx, "lct-2")
+	require.True(t, found)
+	require.Equal(t, "race_day", older.OperationalContext)
+
+	// A context that cannot be normalized is left alone
+	unchanged, found := f.keeper.GetLct(ctx, "lct-4")
+	require.True(t, found)
+	require.Equal(t, "pit/lane", unchanged.OperationalContext)
+}
+
+func TestMigrate2to3IndexesActiveLcts(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+
+	// Stored by version 2, which kept no contact index
+	for _, lct := range []types.LinkedContextToken{
+		{LctId: "lct-1", ComponentAId: "MODBATT-MOD-001", ComponentBId: "MODBATT-PACK-001", PairingStatus: types.StatusActive, LastContactAt: 100},
+		{LctId: "lct-2", ComponentAId: "MODBATT-MOD-002", ComponentBId: "MODBATT-PACK-001", PairingStatus: types.StatusPending, LastContactAt: 100},
+	} {
+		require.NoError(t, f.keeper.LinkedContextToken.Set(ctx, lct.LctId, lct))
+	}
+
+	require.NoError(t, keeper.NewMigrator(f.keeper).Migrate2to3(ctx))
+
+	has, err := f.keeper.ActiveContactIndex.Has(ctx, collections.Join(int64(100), "lct-1"))
+	require.NoError(t, err)
+	require.True(t, has)
+	has, err = f.keeper.ActiveContactIndex.Has(ctx, collections.Join(int64(100), "lct-2"))
+	require.NoError(t, err)
+	require.False(t, has)
+
+	// The sweep finds the migrated LCT through the index
+	sweepCtx := ctx.WithBlockTime(time.Unix(100+types.DefaultIdleTimeoutSeconds+1, 0))
+	swept, err := f.keeper.SweepIdleLcts(sweepCtx)
+	require.NoError(t, err)
+	require.Equal(t, 1, swept)
+	has, err = f.keeper.ActiveContactIndex.Has(ctx, collections.Join(int64(100), "lct-1"))
+	require.NoError(t, err)
+	require.False(t, has)
+}
+
+func TestMigrate3to4IndexesLctComponents(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+
+	// Stored by version 3, which kept no component index
+	for _, lct := range []types.LinkedContextToken{
+		{LctId: "lct-1", ComponentAId: "MODBATT-MOD-001", ComponentBId: "MODBATT-PACK-001", PairingStatus: types.StatusActive},
+		{LctId: "lct-2", ComponentAId: "MODBATT-MOD-002", ComponentBId: "MODBATT-PACK-001", PairingStatus: types.StatusTerminated},
+		{LctId: "lct-3", ComponentAId: "MODBATT-MOD-002", ComponentBId: "MODBATT-PACK-002", PairingStatus: types.StatusPending},
+	} {
+		require.NoError(t, f.keeper.LinkedContextToken.Set(ctx, lct.LctId, lct))
+	}
+
+	require.NoError(t, keeper.NewMigrator(f.keeper).Migrate3to4(ctx))
+
+	lcts, err := f.keeper.GetComponentRelationships(ctx, "MODBATT-PACK-001")
+	require.NoError(t, err)
+	ids := make([]string, len(lcts))
+	for i, lct := range lcts {
+		ids[i] = lct.LctId
+	}
+	require.Equal(t, []string{"lct-1", "lct-2"}, ids)
+
+	lcts, _, err = f.keeper.ListLctsPaginated(ctx, types.LctFilter{ComponentID: "MODBATT-MOD-002", Status: types.StatusPending}, nil)
+	require.NoError(t, err)
+	require.Len(t, lcts, 1)
+	require.Equal(t, "lct-3", lcts[0].LctId)
+}
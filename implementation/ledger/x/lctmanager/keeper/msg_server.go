@@ -108,7 +108,7 @@ func (ms msgServer) MintLCT(ctx context.Context, msg *types.MsgMintLCT) (*types.
 	}
 
 	// Store the LCT
-	if err := ms.Keeper.SetLinkedContextToken(ctx, lct); err != nil {
+	if err := ms.Keeper.storeNewLct(WithAuditActor(ctx, msg.Creator), lct); err != nil {
 		return nil, err
 	}
 
@@ -145,6 +145,13 @@ func (ms msgServer) CreateLctRelationship(ctx context.Context, msg *types.MsgCre
 	if msg.ComponentA == "" || msg.ComponentB == "" {
 		return nil, errors.Wrapf(types.ErrInvalidRequest, "component IDs cannot be empty")
 	}
+	operationalContext, err := types.NormalizeOperationalContext(msg.Context)
+	if err != nil {
+		return nil, err
+	}
+	if err := ms.Keeper.checkComponentsActive(ctx, msg.ComponentA, msg.ComponentB); err != nil {
+		return nil, err
+	}
 
 	// Generate unique LCT ID
 	lctId := fmt.Sprintf("lct-%s-%s-%d", msg.ComponentA, msg.ComponentB, time.Now().Unix())
@@ -163,14 +170,14 @@ func (ms msgServer) CreateLctRelationship(ctx context.Context, msg *types.MsgCre
 		CreatedAt:          time.Now().Unix(),
 		UpdatedAt:          time.Now().Unix(),
 		TrustAnchor:        creator.String(),
-		OperationalContext: msg.Context,
+		OperationalContext: operationalContext,
 		ProxyComponentId:   msg.ProxyId,
 		LctKeyHalf:         "", // No key half stored on-chain
 		LastContactAt:      time.Now().Unix(),
 		AuthorizationRules: "{}", // Default empty rules
 	}
 
-	if err := ms.Keeper.SetLinkedContextToken(ctx, lct); err != nil {
+	if err := ms.Keeper.storeNewLct(WithAuditActor(ctx, msg.Creator), lct); err != nil {
 		return nil, err
 	}
 
@@ -216,7 +223,7 @@ func (ms msgServer) UpdateLctStatus(ctx context.Context, msg *types.MsgUpdateLct
 	}
 
 	// Update status
-	if err := ms.Keeper.UpdateLctStatus(ctx, msg.LctId, msg.NewStatus, msg.Reason); err != nil {
+	if err := ms.Keeper.UpdateLctStatus(WithAuditActor(ctx, msg.Creator), msg.LctId, msg.NewStatus, msg.Reason); err != nil {
 		return nil, err
 	}
 
@@ -241,27 +248,10 @@ func (ms msgServer) TerminateLctRelationship(ctx context.Context, msg *types.Msg
 		return nil, types.ErrInvalidSigner
 	}
 
-	// Update status to terminated
-	if err := ms.Keeper.UpdateLctStatus(ctx, msg.LctId, types.StatusTerminated, msg.Reason); err != nil {
+	if err := ms.Keeper.TerminateLctRelationship(WithAuditActor(ctx, msg.Creator), msg.LctId, msg.Reason, msg.NotifyOffline); err != nil {
 		return nil, err
 	}
 
-	// Handle offline notification if requested
-	if msg.NotifyOffline && lct.ProxyComponentId != "" && ms.pairingqueueKeeper != nil {
-		// Use the pairingqueue keeper to queue offline operation
-		// This is a simplified approach - in a real implementation you'd want more robust offline handling
-		_, err := ms.pairingqueueKeeper.QueueOfflineOperation(ctx, lct.ProxyComponentId, "termination_notification")
-		if err != nil {
-			// Log the error but don't fail the termination
-			// The proxy component can still be notified when it comes online
-			ms.logger.Error("failed to queue offline operation",
-				"error", err,
-				"lct_id", msg.LctId,
-				"proxy_id", lct.ProxyComponentId,
-			)
-		}
-	}
-
 	return &types.MsgTerminateLctRelationshipResponse{}, nil
 }
 
@@ -390,12 +380,18 @@ func (ms msgServer) CompleteLCTMediatedPairing(ctx context.Context, msg *types.M
 	// Hash the combined session key for audit (never store the actual key)
 	hashedCombinedSessionKey := sha256.Sum256(msg.SessionKeyData)
 
+	// Commit to the combined split key so reconstructions can be checked later
+	combinedKey := ms.combineKeyShares(keyShareA, keyShareB)
+	keyCommitment := KeyCommitment(combinedKey)
+	ZeroKey(&combinedKey)
+
 	// Store the SplitKey metadata (no cryptographic secrets)
 	splitKey := types.SplitKey{
-		LctId:       fmt.Sprintf("split-%s", msg.PairingId),
-		Status:      "active",
-		CreatedAt:   time.Now().Unix(),
-		ActivatedAt: time.Now().Unix(),
+		LctId:         fmt.Sprintf("split-%s", msg.PairingId),
+		Status:        "active",
+		CreatedAt:     time.Now().Unix(),
+		ActivatedAt:   time.Now().Unix(),
+		KeyCommitment: keyCommitment[:],
 	}
 
 	// Store the split key metadata
@@ -540,6 +536,13 @@ func (ms msgServer) VerifyLCTChallenge(ctx context.Context, msg *types.MsgVerify
 		return nil, errors.Wrapf(types.ErrInvalidRequest, "failed to verify challenge: %s", err)
 	}
 
+	// A verified response proves the components are in contact
+	if verified {
+		if err := ms.RecordLctContact(ctx, msg.LctId); err != nil {
+			return nil, err
+		}
+	}
+
 	// Emit event for audit
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	sdkCtx.EventManager().EmitEvent(
@@ -557,3 +560,36 @@ func (ms msgServer) VerifyLCTChallenge(ctx context.Context, msg *types.MsgVerify
 		Status:   "verified",
 	}, nil
 }
+
+// RotateSplitKey implements the Msg/RotateSplitKey RPC method.
+func (ms msgServer) RotateSplitKey(ctx context.Context, msg *types.MsgRotateSplitKey) (*types.MsgRotateSplitKeyResponse, error) {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return nil, errors.Wrapf(types.ErrInvalidSigner, "invalid creator address: %s", err)
+	}
+	if msg.LctId == "" {
+		return nil, errors.Wrap(types.ErrInvalidRequest, "LCT ID cannot be empty")
+	}
+
+	lct, found := ms.Keeper.GetLinkedContextToken(ctx, msg.LctId)
+	if !found {
+		return nil, types.ErrLctNotFound
+	}
+
+	// Verify creator is authorized
+	if creator.String() != lct.TrustAnchor {
+		return nil, types.ErrInvalidSigner
+	}
+
+	current, previous, err := ms.Keeper.RotateSplitKey(WithAuditActor(ctx, msg.Creator), msg.LctId, msg.KeyCommitment, msg.Reason)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRotateSplitKeyResponse{
+		LctId:                msg.LctId,
+		KeyReference:         current.KeyReference(),
+		PreviousKeyReference: previous.KeyReference(),
+		Version:              current.Version,
+	}, nil
+}
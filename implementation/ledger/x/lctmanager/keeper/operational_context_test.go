@@ -0,0 +1,79 @@
+package keeper_test
+
+import (
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"racecar-web/x/lctmanager/keeper"
+	"racecar-web/x/lctmanager/types"
+)
+
+func TestNormalizeOperationalContext(t *testing.T) {
+	for input, want := range map[string]string{
+		"battery_management":    "battery_management",
+		" Battery Management ":  "battery_management",
+		"battery-management":    "battery_management",
+		"battery__-_management": "battery_management",
+		"_battery_management_":  "battery_management",
+		"Race:Pit.Lane 2":       "race:pit.lane_2",
+		"":                      "",
+	} {
+		got, err := types.NormalizeOperationalContext(input)
+		require.NoError(t, err, input)
+		require.Equal(t, want, got, input)
+	}
+
+	for _, input := range []string{"battery/management", "énergie", strings.Repeat("a", types.MaxOperationalContextLength+1)} {
+		_, err := types.NormalizeOperationalContext(input)
+		require.ErrorIs(t, err, types.ErrInvalidContext, input)
+	}
+}
+
+func TestPairingAndLctCreationStoreTheSameContext(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+
+	// Pairing creates its LCT through CreateLCTRelationship
+	pairedID, _, err := f.keeper.CreateLCTRelationship(f.ctx, "MODBATT-PACK-001", "MODBATT-MOD-001", " Battery Management ", "")
+	require.NoError(t, err)
+
+	created, err := ms.CreateLctRelationship(f.ctx, &types.MsgCreateLctRelationship{
+		Creator:    sdk.AccAddress("lct_context_creator_").String(),
+		ComponentA: "MODBATT-PACK-001",
+		ComponentB: "MODBATT-MOD-002",
+		Context:    "battery-management",
+	})
+	require.NoError(t, err)
+
+	direct, err := f.keeper.CreateLctRelationship(f.ctx, sdk.AccAddress("creator"), "MODBATT-PACK-001", "MODBATT-MOD-003", "BATTERY_MANAGEMENT", "")
+	require.NoError(t, err)
+
+	paired, found := f.keeper.GetLct(f.ctx, pairedID)
+	require.True(t, found)
+	viaMsg, found := f.keeper.GetLct(f.ctx, created.LctId)
+	require.True(t, found)
+	require.Equal(t, "battery_management", paired.OperationalContext)
+	require.Equal(t, paired.OperationalContext, viaMsg.OperationalContext)
+	require.Equal(t, paired.OperationalContext, direct.OperationalContext)
+
+	// A context-filtered lookup finds them whichever spelling it uses
+	lct, found := f.keeper.GetLctBetween(f.ctx, "MODBATT-MOD-001", "MODBATT-PACK-001", "battery management")
+	require.True(t, found)
+	require.Equal(t, pairedID, lct.LctId)
+	lct, found = f.keeper.GetLctBetween(f.ctx, "MODBATT-PACK-001", "MODBATT-MOD-002", "battery_management")
+	require.True(t, found)
+	require.Equal(t, created.LctId, lct.LctId)
+
+	_, _, err = f.keeper.CreateLCTRelationship(f.ctx, "MODBATT-PACK-001", "MODBATT-MOD-004", "battery/management", "")
+	require.ErrorIs(t, err, types.ErrInvalidContext)
+	_, err = ms.CreateLctRelationship(f.ctx, &types.MsgCreateLctRelationship{
+		Creator:    sdk.AccAddress("lct_context_creator_").String(),
+		ComponentA: "MODBATT-PACK-001",
+		ComponentB: "MODBATT-MOD-004",
+		Context:    "battery/management",
+	})
+	require.ErrorIs(t, err, types.ErrInvalidContext)
+}
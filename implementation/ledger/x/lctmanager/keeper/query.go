@@ -3,7 +3,9 @@ package keeper
 import (
 	"context"
 	"encoding/json"
+	"errors"
 
+	"cosmossdk.io/collections"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
@@ -94,3 +96,169 @@ func (qs QueryServer) ValidateLctAccess(ctx context.Context, req *types.QueryVal
 		AccessLevel: accessLevel,
 	}, nil
 }
+
+// GetLctBetween implements the Query/GetLctBetween RPC method.
+func (qs QueryServer) GetLctBetween(ctx context.Context, req *types.QueryGetLctBetweenRequest) (*types.QueryGetLctBetweenResponse, error) {
+	if req.ComponentA == "" || req.ComponentB == "" {
+		return nil, status.Error(codes.InvalidArgument, "both component IDs are required")
+	}
+
+	if _, err := types.NormalizeOperationalContext(req.OperationalContext); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	lct, found := qs.Keeper.GetLctBetween(ctx, req.ComponentA, req.ComponentB, req.OperationalContext)
+	if !found {
+		return &types.QueryGetLctBetweenResponse{Found: false}, nil
+	}
+
+	lctJSON, err := json.Marshal(lct)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to marshal LCT")
+	}
+
+	return &types.QueryGetLctBetweenResponse{
+		LinkedContextToken: string(lctJSON),
+		Found:              true,
+	}, nil
+}
+
+// ListLcts implements the Query/ListLcts RPC method.
+func (qs QueryServer) ListLcts(ctx context.Context, req *types.QueryListLctsRequest) (*types.QueryListLctsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.Status != "" && !types.IsValidLCTStatus(req.Status) {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown status %q", req.Status)
+	}
+	operationalContext, err := types.NormalizeOperationalContext(req.OperationalContext)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	filter := types.LctFilter{
+		ComponentID:        req.ComponentId,
+		Status:             req.Status,
+		OperationalContext: operationalContext,
+	}
+	lcts, pageRes, err := qs.Keeper.ListLctsPaginated(ctx, filter, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryListLctsResponse{
+		Lcts:       lcts,
+		Pagination: pageRes,
+	}, nil
+}
+
+// GetAuditTrail implements the Query/GetAuditTrail RPC method.
+func (qs QueryServer) GetAuditTrail(ctx context.Context, req *types.QueryGetAuditTrailRequest) (*types.QueryGetAuditTrailResponse, error) {
+	if req == nil || req.LctId == "" {
+		return nil, status.Error(codes.InvalidArgument, "LCT ID cannot be empty")
+	}
+
+	if _, found := qs.Keeper.GetLct(ctx, req.LctId); !found {
+		return nil, status.Error(codes.NotFound, types.ErrLctNotFound.Wrap(req.LctId).Error())
+	}
+
+	entries, err := qs.Keeper.GetAuditTrail(ctx, req.LctId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryGetAuditTrailResponse{Entries: entries}, nil
+}
+
+// GetLctsByProxy implements the Query/GetLctsByProxy RPC method.
+func (qs QueryServer) GetLctsByProxy(ctx context.Context, req *types.QueryGetLctsByProxyRequest) (*types.QueryGetLctsByProxyResponse, error) {
+	if req == nil || req.ProxyId == "" {
+		return nil, status.Error(codes.InvalidArgument, "proxy ID cannot be empty")
+	}
+
+	lcts, pageRes, err := qs.Keeper.GetLctsByProxy(ctx, req.ProxyId, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryGetLctsByProxyResponse{
+		Lcts:       lcts,
+		Pagination: pageRes,
+	}, nil
+}
+
+// GetKeyExchange implements the Query/GetKeyExchange RPC method. Only the
+// exchange's status, timestamps and participants are returned.
+func (qs QueryServer) GetKeyExchange(ctx context.Context, req *types.QueryGetKeyExchangeRequest) (*types.QueryGetKeyExchangeResponse, error) {
+	if req == nil || req.LctId == "" {
+		return nil, status.Error(codes.InvalidArgument, "LCT ID cannot be empty")
+	}
+
+	lct, found := qs.Keeper.GetLct(ctx, req.LctId)
+	if !found {
+		return nil, status.Error(codes.NotFound, types.ErrLctNotFound.Wrap(req.LctId).Error())
+	}
+
+	exchange, err := qs.Keeper.SessionKeyExchanges.Get(ctx, req.LctId)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil, status.Errorf(codes.NotFound, "no key exchange has been initiated for LCT %s", req.LctId)
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryGetKeyExchangeResponse{
+		LctId:            lct.LctId,
+		Status:           exchange.Status,
+		LctStatus:        lct.PairingStatus,
+		CreatedAt:        exchange.CreatedAt,
+		LastUsed:         exchange.LastUsed,
+		ComponentAId:     lct.ComponentAId,
+		ComponentBId:     lct.ComponentBId,
+		ProxyComponentId: lct.ProxyComponentId,
+	}, nil
+}
+
+// GetSplitKeyStatus implements the Query/GetSplitKeyStatus RPC method. Split
+// keys are reported by key reference; no key material is stored to return.
+func (qs QueryServer) GetSplitKeyStatus(ctx context.Context, req *types.QueryGetSplitKeyStatusRequest) (*types.QueryGetSplitKeyStatusResponse, error) {
+	if req == nil || req.LctId == "" {
+		return nil, status.Error(codes.InvalidArgument, "LCT ID cannot be empty")
+	}
+
+	if _, found := qs.Keeper.GetLct(ctx, req.LctId); !found {
+		return nil, status.Error(codes.NotFound, types.ErrLctNotFound.Wrap(req.LctId).Error())
+	}
+	splitKey, found := qs.Keeper.GetSplitKey(ctx, req.LctId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no split key has been issued for LCT %s", req.LctId)
+	}
+
+	superseded, err := qs.Keeper.GetSupersededSplitKeys(ctx, req.LctId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	res := &types.QueryGetSplitKeyStatusResponse{
+		LctId:        req.LctId,
+		Status:       splitKey.Status,
+		KeyReference: splitKey.KeyReference(),
+		Version:      splitKey.Version,
+		CreatedAt:    splitKey.CreatedAt,
+		ActivatedAt:  splitKey.ActivatedAt,
+	}
+	if splitKey.Status == types.SplitKeyStatusRevoked {
+		res.RevokedAt = splitKey.SupersededAt
+	}
+	for _, old := range superseded {
+		res.Superseded = append(res.Superseded, &types.SupersededSplitKey{
+			KeyReference: old.KeyReference(),
+			Version:      old.Version,
+			CreatedAt:    old.CreatedAt,
+			SupersededAt: old.SupersededAt,
+			SupersededBy: old.SupersededBy,
+		})
+	}
+	return res, nil
+}
@@ -0,0 +1,61 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"racecar-web/x/lctmanager/keeper"
+	"racecar-web/x/lctmanager/types"
+)
+
+func TestGetKeyExchange(t *testing.T) {
+	f := initFixture(t)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	initiatedAt := time.Unix(1752484904, 0)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(initiatedAt)
+
+	lctID, keyReference, err := f.keeper.CreateLCTRelationship(ctx, "MODBATT-PACK-001", "MODBATT-MC-001", "energy_transfer", "")
+	require.NoError(t, err)
+
+	// Nothing to report before an exchange starts
+	_, err = qs.GetKeyExchange(ctx, &types.QueryGetKeyExchangeRequest{LctId: lctID})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	require.NoError(t, f.keeper.InitiateKeyExchange(ctx, lctID, keyReference))
+	res, err := qs.GetKeyExchange(ctx, &types.QueryGetKeyExchangeRequest{LctId: lctID})
+	require.NoError(t, err)
+	require.Equal(t, &types.QueryGetKeyExchangeResponse{
+		LctId:        lctID,
+		Status:       "pending",
+		LctStatus:    types.StatusKeyExchangeInitiated,
+		CreatedAt:    initiatedAt.Unix(),
+		ComponentAId: "MODBATT-PACK-001",
+		ComponentBId: "MODBATT-MC-001",
+	}, res)
+
+	// Activating the LCT completes the exchange
+	completedAt := initiatedAt.Add(30 * time.Second)
+	ctx = ctx.WithBlockTime(completedAt)
+	require.NoError(t, f.keeper.ActivateLctRelationship(ctx, lctID))
+	res, err = qs.GetKeyExchange(ctx, &types.QueryGetKeyExchangeRequest{LctId: lctID})
+	require.NoError(t, err)
+	require.Equal(t, "active", res.Status)
+	require.Equal(t, types.StatusActive, res.LctStatus)
+	require.Equal(t, initiatedAt.Unix(), res.CreatedAt)
+	require.Equal(t, completedAt.Unix(), res.LastUsed)
+
+	require.NoError(t, f.keeper.TerminateLctRelationship(ctx, lctID, "decommissioned", false))
+	res, err = qs.GetKeyExchange(ctx, &types.QueryGetKeyExchangeRequest{LctId: lctID})
+	require.NoError(t, err)
+	require.Equal(t, "expired", res.Status)
+
+	_, err = qs.GetKeyExchange(ctx, &types.QueryGetKeyExchangeRequest{LctId: "lct-unknown"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	_, err = qs.GetKeyExchange(ctx, &types.QueryGetKeyExchangeRequest{})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,76 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"racecar-web/x/lctmanager/keeper"
+	"racecar-web/x/lctmanager/types"
+)
+
+func TestGetLctBetween(t *testing.T) {
+	f := initFixture(t)
+	creator := sdk.AccAddress([]byte("lct_between_creator_"))
+
+	packModule, err := f.keeper.CreateLctRelationship(f.ctx, creator, "MODBATT-PACK-001", "MODBATT-MOD-001", "battery_management", "")
+	require.NoError(t, err)
+	packMotor, err := f.keeper.CreateLctRelationship(f.ctx, creator, "MODBATT-PACK-001", "MODBATT-MC-001", "energy_delivery", "")
+	require.NoError(t, err)
+
+	// Lookup is independent of component order
+	lct, found := f.keeper.GetLctBetween(f.ctx, "MODBATT-MOD-001", "MODBATT-PACK-001", "battery_management")
+	require.True(t, found)
+	require.Equal(t, packModule.LctId, lct.LctId)
+
+	lct, found = f.keeper.GetLctBetween(f.ctx, "MODBATT-PACK-001", "MODBATT-MC-001", "energy_delivery")
+	require.True(t, found)
+	require.Equal(t, packMotor.LctId, lct.LctId)
+
+	// No relationship between the module and the motor controller
+	_, found = f.keeper.GetLctBetween(f.ctx, "MODBATT-MOD-001", "MODBATT-MC-001", "energy_delivery")
+	require.False(t, found)
+
+	// Context is part of the lookup
+	_, found = f.keeper.GetLctBetween(f.ctx, "MODBATT-PACK-001", "MODBATT-MOD-001", "energy_delivery")
+	require.False(t, found)
+
+	// Terminated LCTs are no longer returned
+	require.NoError(t, f.keeper.UpdateLctStatus(f.ctx, packMotor.LctId, types.StatusTerminated, "replaced"))
+	_, found = f.keeper.GetLctBetween(f.ctx, "MODBATT-PACK-001", "MODBATT-MC-001", "energy_delivery")
+	require.False(t, found)
+}
+
+func TestGetLctBetweenQuery(t *testing.T) {
+	f := initFixture(t)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	creator := sdk.AccAddress([]byte("lct_between_creator_"))
+
+	created, err := f.keeper.CreateLctRelationship(f.ctx, creator, "MODBATT-PACK-001", "MODBATT-MOD-001", "battery_management", "")
+	require.NoError(t, err)
+
+	response, err := qs.GetLctBetween(f.ctx, &types.QueryGetLctBetweenRequest{
+		ComponentA:         "MODBATT-MOD-001",
+		ComponentB:         "MODBATT-PACK-001",
+		OperationalContext: "battery_management",
+	})
+	require.NoError(t, err)
+	require.True(t, response.Found)
+
+	var lct types.LinkedContextToken
+	require.NoError(t, json.Unmarshal([]byte(response.LinkedContextToken), &lct))
+	require.Equal(t, created.LctId, lct.LctId)
+
+	response, err = qs.GetLctBetween(f.ctx, &types.QueryGetLctBetweenRequest{
+		ComponentA: "MODBATT-PACK-001",
+		ComponentB: "MODBATT-UNKNOWN-001",
+	})
+	require.NoError(t, err)
+	require.False(t, response.Found)
+	require.Empty(t, response.LinkedContextToken)
+
+	_, err = qs.GetLctBetween(f.ctx, &types.QueryGetLctBetweenRequest{ComponentA: "MODBATT-PACK-001"})
+	require.Error(t, err)
+}
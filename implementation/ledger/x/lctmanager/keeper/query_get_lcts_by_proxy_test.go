@@ -0,0 +1,65 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"racecar-web/x/lctmanager/keeper"
+	"racecar-web/x/lctmanager/types"
+)
+
+func TestGetLctsByProxy(t *testing.T) {
+	f := initFixture(t)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	creator := sdk.AccAddress([]byte("lct_proxy_creator___"))
+
+	// The pack controller mediates two module relationships; a third LCT has
+	// another proxy and a fourth none
+	var mediated []string
+	for _, module := range []string{"MODBATT-MOD-001", "MODBATT-MOD-002"} {
+		lct, err := f.keeper.CreateLctRelationship(f.ctx, creator, "MODBATT-PACK-001", module, "battery_management", "MODBATT-PC-001")
+		require.NoError(t, err)
+		mediated = append(mediated, lct.LctId)
+	}
+	_, err := f.keeper.CreateLctRelationship(f.ctx, creator, "MODBATT-PACK-002", "MODBATT-MOD-003", "battery_management", "MODBATT-PC-002")
+	require.NoError(t, err)
+	_, err = f.keeper.CreateLctRelationship(f.ctx, creator, "MODBATT-PACK-001", "MODBATT-MC-001", "energy_delivery", "")
+	require.NoError(t, err)
+
+	resp, err := qs.GetLctsByProxy(f.ctx, &types.QueryGetLctsByProxyRequest{ProxyId: "MODBATT-PC-001"})
+	require.NoError(t, err)
+	require.Len(t, resp.Lcts, 2)
+	for _, lct := range resp.Lcts {
+		require.Contains(t, mediated, lct.LctId)
+		require.Equal(t, "MODBATT-PC-001", lct.ProxyComponentId)
+	}
+
+	// Pages follow the proxy index
+	page, pageRes, err := f.keeper.GetLctsByProxy(f.ctx, "MODBATT-PC-001", &query.PageRequest{Limit: 1, CountTotal: true})
+	require.NoError(t, err)
+	require.Len(t, page, 1)
+	require.Equal(t, uint64(2), pageRes.Total)
+	rest, _, err := f.keeper.GetLctsByProxy(f.ctx, "MODBATT-PC-001", &query.PageRequest{Key: pageRes.NextKey, Limit: 1})
+	require.NoError(t, err)
+	require.Len(t, rest, 1)
+	require.NotEqual(t, page[0].LctId, rest[0].LctId)
+
+	// Terminated LCTs drop out of the index
+	require.NoError(t, f.keeper.TerminateLctRelationship(f.ctx, mediated[0], "module replaced", false))
+	lcts, _, err := f.keeper.GetLctsByProxy(f.ctx, "MODBATT-PC-001", nil)
+	require.NoError(t, err)
+	require.Len(t, lcts, 1)
+	require.Equal(t, mediated[1], lcts[0].LctId)
+
+	lcts, _, err = f.keeper.GetLctsByProxy(f.ctx, "MODBATT-PC-999", nil)
+	require.NoError(t, err)
+	require.Empty(t, lcts)
+
+	_, err = qs.GetLctsByProxy(f.ctx, &types.QueryGetLctsByProxyRequest{})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,68 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"racecar-web/x/lctmanager/keeper"
+	"racecar-web/x/lctmanager/types"
+)
+
+func TestGetSplitKeyStatus(t *testing.T) {
+	f := initFixture(t)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	issuedAt := time.Unix(1752484904, 0)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(issuedAt)
+
+	lctID, _, err := f.keeper.CreateLCTRelationship(ctx, "MODBATT-PACK-001", "MODBATT-MC-001", "energy_transfer", "")
+	require.NoError(t, err)
+
+	// Nothing to report before a key is issued
+	_, err = qs.GetSplitKeyStatus(ctx, &types.QueryGetSplitKeyStatusRequest{LctId: lctID})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	firstReference, err := f.keeper.IssueSplitKey(ctx, lctID, offChainSplitKey("pairing-1"))
+	require.NoError(t, err)
+	res, err := qs.GetSplitKeyStatus(ctx, &types.QueryGetSplitKeyStatusRequest{LctId: lctID})
+	require.NoError(t, err)
+	require.Equal(t, &types.QueryGetSplitKeyStatusResponse{
+		LctId:        lctID,
+		Status:       types.SplitKeyStatusActive,
+		KeyReference: firstReference,
+		Version:      1,
+		CreatedAt:    issuedAt.Unix(),
+		ActivatedAt:  issuedAt.Unix(),
+	}, res)
+
+	rotatedAt := issuedAt.Add(time.Hour)
+	ctx = ctx.WithBlockTime(rotatedAt)
+	current, _, err := f.keeper.RotateSplitKey(ctx, lctID, offChainSplitKey("rotation-1"), "scheduled rotation")
+	require.NoError(t, err)
+	res, err = qs.GetSplitKeyStatus(ctx, &types.QueryGetSplitKeyStatusRequest{LctId: lctID})
+	require.NoError(t, err)
+	require.Equal(t, current.KeyReference(), res.KeyReference)
+	require.Equal(t, uint64(2), res.Version)
+	require.Equal(t, []*types.SupersededSplitKey{{
+		KeyReference: firstReference,
+		Version:      1,
+		CreatedAt:    issuedAt.Unix(),
+		SupersededAt: rotatedAt.Unix(),
+		SupersededBy: current.KeyReference(),
+	}}, res.Superseded)
+
+	require.NoError(t, f.keeper.TerminateLctRelationship(ctx, lctID, "decommissioned", false))
+	res, err = qs.GetSplitKeyStatus(ctx, &types.QueryGetSplitKeyStatusRequest{LctId: lctID})
+	require.NoError(t, err)
+	require.Equal(t, types.SplitKeyStatusRevoked, res.Status)
+	require.Equal(t, rotatedAt.Unix(), res.RevokedAt)
+
+	_, err = qs.GetSplitKeyStatus(ctx, &types.QueryGetSplitKeyStatusRequest{LctId: "lct-unknown"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	_, err = qs.GetSplitKeyStatus(ctx, &types.QueryGetSplitKeyStatusRequest{})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
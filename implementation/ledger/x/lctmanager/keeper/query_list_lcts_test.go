@@ -0,0 +1,77 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"racecar-web/x/lctmanager/keeper"
+	"racecar-web/x/lctmanager/types"
+)
+
+func TestListLctsFilters(t *testing.T) {
+	f := initFixture(t)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	creator := sdk.AccAddress([]byte("lct_list_creator____"))
+
+	// The pack takes part in three relationships across two contexts; one of
+	// the battery management relationships is terminated
+	var packBMS []string
+	for _, module := range []string{"MODBATT-MOD-001", "MODBATT-MOD-002"} {
+		lct, err := f.keeper.CreateLctRelationship(f.ctx, creator, "MODBATT-PACK-001", module, "battery_management", "")
+		require.NoError(t, err)
+		packBMS = append(packBMS, lct.LctId)
+	}
+	delivery, err := f.keeper.CreateLctRelationship(f.ctx, creator, "MODBATT-MC-001", "MODBATT-PACK-001", "energy_delivery", "")
+	require.NoError(t, err)
+	_, err = f.keeper.CreateLctRelationship(f.ctx, creator, "MODBATT-PACK-002", "MODBATT-MOD-003", "battery_management", "")
+	require.NoError(t, err)
+	require.NoError(t, f.keeper.TerminateLctRelationship(f.ctx, packBMS[1], "module replaced", false))
+
+	ids := func(lcts []types.LinkedContextToken) []string {
+		var ids []string
+		for _, lct := range lcts {
+			ids = append(ids, lct.LctId)
+		}
+		return ids
+	}
+
+	resp, err := qs.ListLcts(f.ctx, &types.QueryListLctsRequest{})
+	require.NoError(t, err)
+	require.Len(t, resp.Lcts, 4)
+
+	// The component matches on either side
+	resp, err = qs.ListLcts(f.ctx, &types.QueryListLctsRequest{ComponentId: "MODBATT-PACK-001"})
+	require.NoError(t, err)
+	require.ElementsMatch(t, append([]string{delivery.LctId}, packBMS...), ids(resp.Lcts))
+
+	// The context is matched in its normalized form
+	resp, err = qs.ListLcts(f.ctx, &types.QueryListLctsRequest{
+		ComponentId:        "MODBATT-PACK-001",
+		Status:             types.StatusTerminated,
+		OperationalContext: "Battery Management",
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{packBMS[1]}, ids(resp.Lcts))
+
+	// Pages hold only matches, and next_key carries on past the skipped LCTs
+	filter := types.LctFilter{OperationalContext: "battery_management"}
+	page, pageRes, err := f.keeper.ListLctsPaginated(f.ctx, filter, &query.PageRequest{Limit: 2})
+	require.NoError(t, err)
+	require.Len(t, page, 2)
+	require.NotEmpty(t, pageRes.NextKey)
+	rest, pageRes, err := f.keeper.ListLctsPaginated(f.ctx, filter, &query.PageRequest{Key: pageRes.NextKey, Limit: 2})
+	require.NoError(t, err)
+	require.Len(t, rest, 1)
+	require.Empty(t, pageRes.NextKey)
+	require.NotContains(t, ids(append(page, rest...)), delivery.LctId)
+
+	_, err = qs.ListLcts(f.ctx, &types.QueryListLctsRequest{Status: "paired"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = qs.ListLcts(f.ctx, &types.QueryListLctsRequest{OperationalContext: "battery/management"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
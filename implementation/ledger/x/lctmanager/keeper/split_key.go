@@ -0,0 +1,143 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"racecar-web/x/lctmanager/types"
+)
+
+// IssueSplitKey records the split key of an LCT's two components and returns
+// its key reference. The key is generated off-chain; the chain only checks
+// and stores the commitment to the combined key, so no key material or
+// randomness enters consensus.
+func (k Keeper) IssueSplitKey(ctx context.Context, lctId string, commitment []byte) (string, error) {
+	if lctId == "" {
+		return "", errorsmod.Wrap(types.ErrInvalidRequest, "LCT ID is required")
+	}
+	if err := types.ValidateKeyCommitment(commitment); err != nil {
+		return "", err
+	}
+	if _, found := k.GetSplitKey(ctx, lctId); found {
+		return "", errorsmod.Wrapf(types.ErrInvalidRequest, "split key for %s already issued", lctId)
+	}
+
+	issuedAt := sdk.UnwrapSDKContext(ctx).BlockTime().Unix()
+	splitKey := types.SplitKey{
+		LctId:         lctId,
+		Status:        types.SplitKeyStatusActive,
+		CreatedAt:     issuedAt,
+		ActivatedAt:   issuedAt,
+		KeyCommitment: commitment,
+		Version:       1,
+	}
+	if err := k.SetSplitKey(ctx, splitKey); err != nil {
+		return "", fmt.Errorf("failed to store split key metadata: %w", err)
+	}
+	return splitKey.KeyReference(), nil
+}
+
+// RotateSplitKey replaces an LCT's active split key with the one committed to
+// by commitment, generated off-chain, and returns both. The old key is kept as superseded, by its commitment
+// only, so components can tell which session keys to retire.
+func (k Keeper) RotateSplitKey(ctx context.Context, lctId string, commitment []byte, reason string) (current, previous types.SplitKey, err error) {
+	lct, found := k.GetLct(ctx, lctId)
+	if !found {
+		return current, previous, errorsmod.Wrapf(types.ErrLctNotFound, "LCT %s", lctId)
+	}
+	if lct.PairingStatus == types.StatusTerminated {
+		return current, previous, errorsmod.Wrapf(types.ErrInvalidRequest, "LCT %s is terminated", lctId)
+	}
+	if err := errIfSuspended(lct); err != nil {
+		return current, previous, err
+	}
+
+	previous, found = k.GetSplitKey(ctx, lctId)
+	if !found {
+		return current, previous, errorsmod.Wrapf(types.ErrSplitKeyNotFound, "LCT %s", lctId)
+	}
+	if previous.Status != types.SplitKeyStatusActive {
+		return current, previous, errorsmod.Wrapf(types.ErrInvalidRequest, "split key of %s is %s", lctId, previous.Status)
+	}
+
+	if err := types.ValidateKeyCommitment(commitment); err != nil {
+		return current, previous, err
+	}
+	if bytes.Equal(commitment, previous.KeyCommitment) {
+		return current, previous, errorsmod.Wrap(types.ErrInvalidKeyCommitment, "rotation must replace the key")
+	}
+
+	now := sdk.UnwrapSDKContext(ctx).BlockTime().Unix()
+	current = types.SplitKey{
+		LctId:         lctId,
+		Status:        types.SplitKeyStatusActive,
+		CreatedAt:     now,
+		ActivatedAt:   now,
+		KeyCommitment: commitment,
+		Version:       previous.Version + 1,
+	}
+	previous.Status = types.SplitKeyStatusSuperseded
+	previous.SupersededAt = now
+	previous.SupersededBy = current.KeyReference()
+
+	if err := k.SupersededSplitKeys.Set(ctx, collections.Join(lctId, previous.Version), previous); err != nil {
+		return current, previous, fmt.Errorf("failed to store superseded split key: %w", err)
+	}
+	if err := k.SetSplitKey(ctx, current); err != nil {
+		return current, previous, fmt.Errorf("failed to store split key metadata: %w", err)
+	}
+	if err := k.appendAuditEntry(ctx, lctId, "split_key_rotated", lct.PairingStatus, lct.PairingStatus, reason); err != nil {
+		return current, previous, err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent("split_key_rotated",
+			sdk.NewAttribute("lct_id", lctId),
+			sdk.NewAttribute("key_reference", current.KeyReference()),
+			sdk.NewAttribute("previous_key_reference", previous.KeyReference()),
+			sdk.NewAttribute("version", strconv.FormatUint(current.Version, 10)),
+			sdk.NewAttribute("reason", reason),
+		),
+	)
+	return current, previous, nil
+}
+
+// GetSupersededSplitKeys returns the split keys rotated out of an LCT, oldest first
+func (k Keeper) GetSupersededSplitKeys(ctx context.Context, lctId string) ([]types.SplitKey, error) {
+	var superseded []types.SplitKey
+
+	rng := collections.NewPrefixedPairRange[string, uint64](lctId)
+	err := k.SupersededSplitKeys.Walk(ctx, rng, func(_ collections.Pair[string, uint64], splitKey types.SplitKey) (bool, error) {
+		superseded = append(superseded, splitKey)
+		return false, nil
+	})
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to walk superseded split keys")
+	}
+	return superseded, nil
+}
+
+// revokeSplitKey revokes the split key of an LCT that is being terminated
+func (k Keeper) revokeSplitKey(ctx context.Context, lctId string, now int64) error {
+	splitKey, err := k.SplitKeys.Get(ctx, lctId)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	splitKey.Status = types.SplitKeyStatusRevoked
+	splitKey.SupersededAt = now
+	if err := k.SplitKeys.Set(ctx, lctId, splitKey); err != nil {
+		return fmt.Errorf("failed to revoke split key: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,148 @@
+package keeper_test
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"racecar-web/x/lctmanager/keeper"
+	"racecar-web/x/lctmanager/types"
+)
+
+// offChainSplitKey stands in for the key service: it combines two halves and
+// returns the commitment that goes into the message
+func offChainSplitKey(seed string) []byte {
+	halfA := sha256.Sum256([]byte(seed + "/a"))
+	halfB := sha256.Sum256([]byte(seed + "/b"))
+	var combinedKey [32]byte
+	for i := range combinedKey {
+		combinedKey[i] = halfA[i] ^ halfB[i]
+	}
+	commitment := keeper.KeyCommitment(combinedKey)
+	return commitment[:]
+}
+
+func TestIssueSplitKeyStoresOnlyTheCommitment(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(1752484904, 0))
+	commitment := offChainSplitKey("pairing-1")
+
+	keyReference, err := f.keeper.IssueSplitKey(ctx, "lct-MODBATT-PACK-001-MODBATT-MC-001", commitment)
+	require.NoError(t, err)
+	require.Len(t, keyReference, 64)
+
+	splitKey, found := f.keeper.GetSplitKey(ctx, "lct-MODBATT-PACK-001-MODBATT-MC-001")
+	require.True(t, found)
+	require.Equal(t, types.SplitKeyStatusActive, splitKey.Status)
+	require.Equal(t, uint64(1), splitKey.Version)
+	require.Equal(t, int64(1752484904), splitKey.CreatedAt)
+	require.Equal(t, commitment, splitKey.KeyCommitment)
+	require.Equal(t, keyReference, splitKey.KeyReference())
+
+	// A split key is issued once
+	_, err = f.keeper.IssueSplitKey(ctx, "lct-MODBATT-PACK-001-MODBATT-MC-001", offChainSplitKey("pairing-2"))
+	require.ErrorIs(t, err, types.ErrInvalidRequest)
+}
+
+func TestIssueSplitKeyRejectsMalformedCommitments(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(1752484904, 0))
+
+	for name, commitment := range map[string][]byte{
+		"missing":  nil,
+		"short":    offChainSplitKey("pairing-1")[:16],
+		"zeros":    make([]byte, types.KeyCommitmentSize),
+		"constant": bytes.Repeat([]byte{0xff}, types.KeyCommitmentSize),
+	} {
+		_, err := f.keeper.IssueSplitKey(ctx, "lct-MODBATT-PACK-002-MODBATT-MC-002", commitment)
+		require.ErrorIs(t, err, types.ErrInvalidKeyCommitment, name)
+	}
+	_, found := f.keeper.GetSplitKey(ctx, "lct-MODBATT-PACK-002-MODBATT-MC-002")
+	require.False(t, found)
+
+	_, err := f.keeper.IssueSplitKey(ctx, "", offChainSplitKey("pairing-1"))
+	require.ErrorIs(t, err, types.ErrInvalidRequest)
+}
+
+func TestRotateSplitKeySupersedesTheOldKey(t *testing.T) {
+	f := initFixture(t)
+	issuedAt := time.Unix(1752484904, 0)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(issuedAt)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	anchor := sdk.AccAddress([]byte("lct_rotation_anchor_"))
+	creator := anchor.String()
+
+	lct, err := f.keeper.CreateLctRelationship(ctx, anchor, "MODBATT-PACK-001", "MODBATT-MC-001", "energy_transfer", "")
+	require.NoError(t, err)
+	lctID := lct.LctId
+
+	// Nothing to rotate before the pairing issues a key
+	_, err = ms.RotateSplitKey(ctx, &types.MsgRotateSplitKey{Creator: creator, LctId: lctID, KeyCommitment: offChainSplitKey("rotation-1")})
+	require.ErrorIs(t, err, types.ErrSplitKeyNotFound)
+
+	firstReference, err := f.keeper.IssueSplitKey(ctx, lctID, offChainSplitKey("pairing-1"))
+	require.NoError(t, err)
+
+	// Only the LCT's trust anchor can rotate its key
+	_, err = ms.RotateSplitKey(ctx, &types.MsgRotateSplitKey{
+		Creator:       sdk.AccAddress([]byte("someone_else________")).String(),
+		LctId:         lctID,
+		KeyCommitment: offChainSplitKey("rotation-1"),
+	})
+	require.ErrorIs(t, err, types.ErrInvalidSigner)
+
+	// Rotation needs a fresh, well-formed commitment
+	_, err = ms.RotateSplitKey(ctx, &types.MsgRotateSplitKey{Creator: creator, LctId: lctID})
+	require.ErrorIs(t, err, types.ErrInvalidKeyCommitment)
+	_, err = ms.RotateSplitKey(ctx, &types.MsgRotateSplitKey{Creator: creator, LctId: lctID, KeyCommitment: offChainSplitKey("pairing-1")})
+	require.ErrorIs(t, err, types.ErrInvalidKeyCommitment)
+
+	rotatedAt := issuedAt.Add(time.Hour)
+	ctx = ctx.WithBlockTime(rotatedAt)
+	res, err := ms.RotateSplitKey(ctx, &types.MsgRotateSplitKey{
+		Creator:       creator,
+		LctId:         lctID,
+		Reason:        "scheduled rotation",
+		KeyCommitment: offChainSplitKey("rotation-1"),
+	})
+	require.NoError(t, err)
+	require.Equal(t, firstReference, res.PreviousKeyReference)
+	require.NotEqual(t, firstReference, res.KeyReference)
+	require.Len(t, res.KeyReference, 64)
+	require.Equal(t, uint64(2), res.Version)
+
+	current, found := f.keeper.GetSplitKey(ctx, lctID)
+	require.True(t, found)
+	require.Equal(t, types.SplitKeyStatusActive, current.Status)
+	require.Equal(t, rotatedAt.Unix(), current.CreatedAt)
+	require.Equal(t, offChainSplitKey("rotation-1"), current.KeyCommitment)
+
+	superseded, err := f.keeper.GetSupersededSplitKeys(ctx, lctID)
+	require.NoError(t, err)
+	require.Len(t, superseded, 1)
+	require.Equal(t, types.SplitKeyStatusSuperseded, superseded[0].Status)
+	require.Equal(t, uint64(1), superseded[0].Version)
+	require.Equal(t, rotatedAt.Unix(), superseded[0].SupersededAt)
+	require.Equal(t, res.KeyReference, superseded[0].SupersededBy)
+
+	trail, err := f.keeper.GetAuditTrail(ctx, lctID)
+	require.NoError(t, err)
+	require.Equal(t, "split_key_rotated", trail[len(trail)-1].EventType)
+	require.Equal(t, "scheduled rotation", trail[len(trail)-1].Reason)
+
+	// Terminating the LCT revokes its key, which can then no longer rotate
+	require.NoError(t, f.keeper.TerminateLctRelationship(ctx, lctID, "decommissioned", false))
+	current, _ = f.keeper.GetSplitKey(ctx, lctID)
+	require.Equal(t, types.SplitKeyStatusRevoked, current.Status)
+	_, err = ms.RotateSplitKey(ctx, &types.MsgRotateSplitKey{Creator: creator, LctId: lctID, KeyCommitment: offChainSplitKey("rotation-2")})
+	require.ErrorIs(t, err, types.ErrInvalidRequest)
+
+	_, err = ms.RotateSplitKey(ctx, &types.MsgRotateSplitKey{Creator: "not-an-address", LctId: lctID})
+	require.ErrorIs(t, err, types.ErrInvalidSigner)
+	_, err = ms.RotateSplitKey(ctx, &types.MsgRotateSplitKey{Creator: creator, LctId: "lct-unknown"})
+	require.ErrorIs(t, err, types.ErrLctNotFound)
+}
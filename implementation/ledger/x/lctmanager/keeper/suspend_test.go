@@ -0,0 +1,119 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"racecar-web/x/lctmanager/types"
+)
+
+func TestSuspendBlocksLctOperations(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(1752484904, 0))
+
+	lctID, keyReference, err := f.keeper.CreateLCTRelationship(ctx, "MODBATT-PACK-001", "MODBATT-MC-001", "energy_transfer", "")
+	require.NoError(t, err)
+	require.NoError(t, f.keeper.InitiateKeyExchange(ctx, lctID, keyReference))
+	before, _ := f.keeper.GetLct(ctx, lctID)
+
+	require.NoError(t, f.keeper.SuspendLctRelationship(ctx, lctID, "under investigation"))
+
+	lct, found := f.keeper.GetLct(ctx, lctID)
+	require.True(t, found)
+	require.Equal(t, types.StatusSuspended, lct.PairingStatus)
+	// Keys survive the suspension
+	require.Equal(t, before.LctKeyHalf, lct.LctKeyHalf)
+	has, err := f.keeper.SessionKeyExchanges.Has(ctx, lctID)
+	require.NoError(t, err)
+	require.True(t, has)
+
+	_, err = f.keeper.EncryptMessageForLCT(ctx, lctID, []byte("telemetry"))
+	require.ErrorIs(t, err, types.ErrLctSuspended)
+	_, err = f.keeper.GenerateLCTChallenge(ctx, lctID)
+	require.ErrorIs(t, err, types.ErrLctSuspended)
+	err = f.keeper.InitiateKeyExchange(ctx, lctID, keyReference)
+	require.ErrorIs(t, err, types.ErrLctSuspended)
+	err = f.keeper.RecordLctContact(ctx, lctID)
+	require.ErrorIs(t, err, types.ErrLctSuspended)
+	err = f.keeper.ActivateLctRelationship(ctx, lctID)
+	require.ErrorIs(t, err, types.ErrLctSuspended)
+
+	// Suspending twice is not a transition
+	err = f.keeper.SuspendLctRelationship(ctx, lctID, "again")
+	require.ErrorIs(t, err, types.ErrIllegalTransition)
+}
+
+func TestResumeRestoresLctOperations(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(1752484904, 0))
+
+	lctID, keyReference, err := f.keeper.CreateLCTRelationship(ctx, "MODBATT-PACK-002", "MODBATT-MC-002", "energy_transfer", "")
+	require.NoError(t, err)
+
+	// Only suspended LCTs can be resumed
+	err = f.keeper.ResumeLctRelationship(ctx, lctID, "")
+	require.ErrorIs(t, err, types.ErrIllegalTransition)
+
+	require.NoError(t, f.keeper.SuspendLctRelationship(ctx, lctID, "under investigation"))
+	require.NoError(t, f.keeper.ResumeLctRelationship(ctx, lctID, "cleared"))
+
+	lct, _ := f.keeper.GetLct(ctx, lctID)
+	require.Equal(t, types.StatusActive, lct.PairingStatus)
+
+	_, err = f.keeper.EncryptMessageForLCT(ctx, lctID, []byte("telemetry"))
+	require.NoError(t, err)
+	require.NoError(t, f.keeper.RecordLctContact(ctx, lctID))
+	require.NoError(t, f.keeper.InitiateKeyExchange(ctx, lctID, keyReference))
+
+	trail, err := f.keeper.GetLctAuditTrail(ctx, lctID)
+	require.NoError(t, err)
+	require.Len(t, trail, 3)
+	require.Equal(t, types.StatusSuspended, trail[0].ToStatus)
+	require.Equal(t, "under investigation", trail[0].Reason)
+	require.Equal(t, types.StatusActive, trail[1].ToStatus)
+	require.Equal(t, "cleared", trail[1].Reason)
+
+	// A suspended LCT can still be terminated, but never resumed afterwards
+	require.NoError(t, f.keeper.UpdateLctStatus(ctx, lctID, types.StatusSuspended, "second review"))
+	require.NoError(t, f.keeper.TerminateLctRelationship(ctx, lctID, "failed review", false))
+	err = f.keeper.ResumeLctRelationship(ctx, lctID, "")
+	require.ErrorIs(t, err, types.ErrIllegalTransition)
+	has, err := f.keeper.SuspendedFrom.Has(ctx, lctID)
+	require.NoError(t, err)
+	require.False(t, has)
+}
+
+func TestResumeRestoresStatusBeforeSuspension(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(1752484904, 0))
+
+	lctID, keyReference, err := f.keeper.CreateLCTRelationship(ctx, "MODBATT-PACK-003", "MODBATT-MC-003", "energy_transfer", "")
+	require.NoError(t, err)
+	require.NoError(t, f.keeper.InitiateKeyExchange(ctx, lctID, keyReference))
+
+	require.NoError(t, f.keeper.SuspendLctRelationship(ctx, lctID, "under investigation"))
+	from, err := f.keeper.SuspendedFrom.Get(ctx, lctID)
+	require.NoError(t, err)
+	require.Equal(t, types.StatusKeyExchangeInitiated, from)
+
+	// A status request other than the one suspended from is refused
+	err = f.keeper.UpdateLctStatus(ctx, lctID, types.StatusInactive, "")
+	require.ErrorIs(t, err, types.ErrIllegalTransition)
+
+	require.NoError(t, f.keeper.ResumeLctRelationship(ctx, lctID, "cleared"))
+	lct, _ := f.keeper.GetLct(ctx, lctID)
+	require.Equal(t, types.StatusKeyExchangeInitiated, lct.PairingStatus)
+	has, err := f.keeper.SuspendedFrom.Has(ctx, lctID)
+	require.NoError(t, err)
+	require.False(t, has)
+
+	// LCTs suspended before the prior status was kept resume as active
+	require.NoError(t, f.keeper.SuspendLctRelationship(ctx, lctID, "second review"))
+	require.NoError(t, f.keeper.SuspendedFrom.Remove(ctx, lctID))
+	require.NoError(t, f.keeper.UpdateLctStatus(ctx, lctID, types.StatusActive, "cleared"))
+	lct, _ = f.keeper.GetLct(ctx, lctID)
+	require.Equal(t, types.StatusActive, lct.PairingStatus)
+}
@@ -0,0 +1,93 @@
+package keeper_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"racecar-web/x/lctmanager/keeper"
+	"racecar-web/x/lctmanager/types"
+	pairingqueuetypes "racecar-web/x/pairingqueue/types"
+)
+
+// fakePairingqueueKeeper records queued offline operations, failing for the
+// components listed in fail
+type fakePairingqueueKeeper struct {
+	queued []string
+	fail   map[string]bool
+}
+
+func (f *fakePairingqueueKeeper) GetPairingRequest(ctx context.Context, requestId string) (pairingqueuetypes.PairingRequest, bool) {
+	return pairingqueuetypes.PairingRequest{}, false
+}
+
+func (f *fakePairingqueueKeeper) QueueOfflineOperation(ctx context.Context, componentId, operationType string) (string, error) {
+	if f.fail[componentId] {
+		return "", errors.New("queue full")
+	}
+	f.queued = append(f.queued, componentId+":"+operationType)
+	return "op-" + componentId, nil
+}
+
+func TestTerminateWithoutPairingQueue(t *testing.T) {
+	// As wired in the app: no pairing queue keeper and no logger
+	f := initFixtureWithQueue(t, nil, nil)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+
+	lct, err := f.keeper.CreateLctRelationship(ctx, sdk.AccAddress("creator"), "MODBATT-PACK-001", "MODBATT-MC-001", "energy_transfer", "")
+	require.NoError(t, err)
+	require.NoError(t, f.keeper.TerminateLctRelationship(ctx, lct.LctId, "pack replaced", true))
+	stored, _ := f.keeper.GetLct(ctx, lct.LctId)
+	require.Equal(t, types.StatusTerminated, stored.PairingStatus)
+
+	// Through the message server too
+	creator := sdk.AccAddress("trust_anchor").String()
+	lct, err = f.keeper.CreateLctRelationship(ctx, sdk.AccAddress("creator"), "MODBATT-PACK-002", "MODBATT-MC-001", "energy_transfer", "")
+	require.NoError(t, err)
+	lct.TrustAnchor = creator
+	require.NoError(t, f.keeper.LinkedContextToken.Set(ctx, lct.LctId, *lct))
+
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	_, err = ms.TerminateLctRelationship(ctx, &types.MsgTerminateLctRelationship{
+		Creator:       creator,
+		LctId:         lct.LctId,
+		Reason:        "pack replaced",
+		NotifyOffline: true,
+	})
+	require.NoError(t, err)
+	stored, _ = f.keeper.GetLct(ctx, lct.LctId)
+	require.Equal(t, types.StatusTerminated, stored.PairingStatus)
+}
+
+func TestTerminateQueuesOfflineNotifications(t *testing.T) {
+	queue := &fakePairingqueueKeeper{fail: map[string]bool{"MODBATT-MC-001": true}}
+	// No logger either: a failed queue write must still not panic
+	f := initFixtureWithQueue(t, queue, nil)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+
+	direct, err := f.keeper.CreateLctRelationship(ctx, sdk.AccAddress("creator"), "MODBATT-PACK-001", "MODBATT-MC-001", "energy_transfer", "")
+	require.NoError(t, err)
+	proxied, err := f.keeper.CreateLctRelationship(ctx, sdk.AccAddress("creator"), "MODBATT-MOD-001", "MODBATT-MOD-002", "energy_transfer", "MODBATT-PACK-001")
+	require.NoError(t, err)
+
+	// Both components of a direct LCT are notified; one queue write fails
+	require.NoError(t, f.keeper.TerminateLctRelationship(ctx, direct.LctId, "pack replaced", true))
+	require.Equal(t, []string{"MODBATT-PACK-001:termination_notification"}, queue.queued)
+	stored, _ := f.keeper.GetLct(ctx, direct.LctId)
+	require.Equal(t, types.StatusTerminated, stored.PairingStatus)
+
+	// A proxied LCT notifies only its proxy
+	queue.queued = nil
+	require.NoError(t, f.keeper.TerminateLctRelationship(ctx, proxied.LctId, "module replaced", true))
+	require.Equal(t, []string{"MODBATT-PACK-001:termination_notification"}, queue.queued)
+
+	// Nothing is queued unless asked for
+	lct, err := f.keeper.CreateLctRelationship(ctx, sdk.AccAddress("creator"), "MODBATT-PACK-003", "MODBATT-MC-002", "energy_transfer", "")
+	require.NoError(t, err)
+	queue.queued = nil
+	require.NoError(t, f.keeper.TerminateLctRelationship(ctx, lct.LctId, "pack replaced", false))
+	require.Empty(t, queue.queued)
+}
@@ -38,6 +38,42 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "lct_id"}, {ProtoField: "requestor_id"}},
 				},
 
+				{
+					RpcMethod:      "GetLctBetween",
+					Use:            "get-lct-between [component-a] [component-b]",
+					Short:          "Query the live LCT linking two components",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "component_a"}, {ProtoField: "component_b"}},
+				},
+				{
+					RpcMethod: "ListLcts",
+					Use:       "list-lcts",
+					Short:     "Query a page of linked context tokens, filtered with --component-id, --status and --operational-context",
+				},
+				{
+					RpcMethod:      "GetAuditTrail",
+					Use:            "get-audit-trail [lct-id]",
+					Short:          "Query the recorded operations on an LCT",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "lct_id"}},
+				},
+				{
+					RpcMethod:      "GetLctsByProxy",
+					Use:            "get-lcts-by-proxy [proxy-id]",
+					Short:          "Query a page of the live LCTs a proxy component mediates",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "proxy_id"}},
+				},
+				{
+					RpcMethod:      "GetKeyExchange",
+					Use:            "get-key-exchange [lct-id]",
+					Short:          "Query the session key exchange status of an LCT",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "lct_id"}},
+				},
+				{
+					RpcMethod:      "GetSplitKeyStatus",
+					Use:            "get-split-key-status [lct-id]",
+					Short:          "Query the split key lifecycle of an LCT",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "lct_id"}},
+				},
+
 				// this line is used by ignite scaffolding # autocli/query
 			},
 		},
@@ -67,6 +103,12 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:          "Send a terminate-lct-relationship tx",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "lct_id"}, {ProtoField: "reason"}, {ProtoField: "notify_offline"}},
 				},
+				{
+					RpcMethod:      "RotateSplitKey",
+					Use:            "rotate-split-key [lct-id] [reason]",
+					Short:          "Send a rotate-split-key tx",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "lct_id"}, {ProtoField: "reason"}},
+				},
 				// this line is used by ignite scaffolding # autocli/tx
 			},
 		},
@@ -37,6 +37,7 @@ type ModuleInputs struct {
 	AuthKeeper              types.AuthKeeper
 	BankKeeper              types.BankKeeper
 	ComponentregistryKeeper componentregistrytypes.ComponentregistryKeeper
+	PairingqueueKeeper      types.PairingqueueKeeper
 }
 
 type ModuleOutputs struct {
@@ -59,7 +60,7 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 		authority.Bytes(),
 		in.BankKeeper,
 		in.ComponentregistryKeeper,
-		nil, // pairingqueueKeeper - removed to break circular dependency
+		in.PairingqueueKeeper,
 		nil, // logger - will be set by the module
 	)
 	m := NewAppModule(in.Cdc, k, in.AuthKeeper, in.BankKeeper)
@@ -8,14 +8,21 @@ import (
 
 // x/lctmanager module sentinel errors
 var (
-	ErrComponentNotFound    = errors.Register(ModuleName, 1201, "component not found")
-	ErrLctNotFound          = errors.Register(ModuleName, 1202, "LCT not found")
-	ErrInvalidLctStatus     = errors.Register(ModuleName, 1203, "invalid LCT status")
-	ErrInvalidComponentPair = errors.Register(ModuleName, 1204, "invalid component pair")
-	ErrInvalidSigner        = errors.Register(ModuleName, 1205, "invalid signer")
-	ErrInvalidAuthority     = errors.Register(ModuleName, 1206, "invalid authority")
-	ErrInvalidContext       = errors.Register(ModuleName, 1207, "invalid context")
-	ErrInvalidProxy         = errors.Register(ModuleName, 1208, "invalid proxy component")
-	ErrInvalidRequest       = errors.Register(ModuleName, 1100, "invalid request")
-	ErrLctExists            = errors.Register(ModuleName, 1101, "LCT already exists")
+	ErrComponentNotFound     = errors.Register(ModuleName, 1201, "component not found")
+	ErrLctNotFound           = errors.Register(ModuleName, 1202, "LCT not found")
+	ErrInvalidLctStatus      = errors.Register(ModuleName, 1203, "invalid LCT status")
+	ErrInvalidComponentPair  = errors.Register(ModuleName, 1204, "invalid component pair")
+	ErrInvalidSigner         = errors.Register(ModuleName, 1205, "invalid signer")
+	ErrInvalidAuthority      = errors.Register(ModuleName, 1206, "invalid authority")
+	ErrInvalidContext        = errors.Register(ModuleName, 1207, "invalid context")
+	ErrInvalidProxy          = errors.Register(ModuleName, 1208, "invalid proxy component")
+	ErrInvalidKeyReference   = errors.Register(ModuleName, 1209, "invalid key reference")
+	ErrIllegalTransition     = errors.Register(ModuleName, 1210, "illegal LCT status transition")
+	ErrLctSuspended          = errors.Register(ModuleName, 1211, "LCT is suspended")
+	ErrKeyCommitmentMismatch = errors.Register(ModuleName, 1212, "key does not match its commitment")
+	ErrSplitKeyNotFound      = errors.Register(ModuleName, 1213, "split key not found")
+	ErrComponentInactive     = errors.Register(ModuleName, 1214, "component is not active")
+	ErrInvalidKeyCommitment  = errors.Register(ModuleName, 1215, "invalid key commitment")
+	ErrInvalidRequest        = errors.Register(ModuleName, 1100, "invalid request")
+	ErrLctExists             = errors.Register(ModuleName, 1101, "LCT already exists")
 )
@@ -43,5 +43,7 @@ type LctmanagerKeeper interface {
 	GetLinkedContextToken(ctx context.Context, lctId string) (LinkedContextToken, bool)
 	GetComponentRelationships(ctx context.Context, componentId string) ([]LinkedContextToken, error)
 	CreateLCTRelationship(ctx context.Context, componentA, componentB, operationalContext, proxyId string) (string, string, error)
-	TerminateLCTRelationship(ctx context.Context, lctId, reason string, notifyOffline bool) error
+	TerminateLctRelationship(ctx context.Context, lctId, reason string, notifyOffline bool) error
+	// Split key methods
+	IssueSplitKey(ctx context.Context, lctId string, commitment []byte) (string, error)
 }
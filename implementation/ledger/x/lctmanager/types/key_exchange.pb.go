@@ -645,10 +645,14 @@ func (m *CompletePairingResponse) GetError() string {
 // NOTE: No cryptographic secrets are stored on-chain - only public metadata
 // Key halves are ephemeral and only exist in memory during operations
 type SplitKey struct {
-	LctId       string `protobuf:"bytes,1,opt,name=lct_id,json=lctId,proto3" json:"lct_id,omitempty"`
-	Status      string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
-	CreatedAt   int64  `protobuf:"varint,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	ActivatedAt int64  `protobuf:"varint,4,opt,name=activated_at,json=activatedAt,proto3" json:"activated_at,omitempty"`
+	LctId         string `protobuf:"bytes,1,opt,name=lct_id,json=lctId,proto3" json:"lct_id,omitempty"`
+	Status        string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	CreatedAt     int64  `protobuf:"varint,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ActivatedAt   int64  `protobuf:"varint,4,opt,name=activated_at,json=activatedAt,proto3" json:"activated_at,omitempty"`
+	KeyCommitment []byte `protobuf:"bytes,5,opt,name=key_commitment,json=keyCommitment,proto3" json:"key_commitment,omitempty"`
+	Version       uint64 `protobuf:"varint,6,opt,name=version,proto3" json:"version,omitempty"`
+	SupersededAt  int64  `protobuf:"varint,7,opt,name=superseded_at,json=supersededAt,proto3" json:"superseded_at,omitempty"`
+	SupersededBy  string `protobuf:"bytes,8,opt,name=superseded_by,json=supersededBy,proto3" json:"superseded_by,omitempty"`
 }
 
 func (m *SplitKey) Reset()         { *m = SplitKey{} }
@@ -712,6 +716,34 @@ func (m *SplitKey) GetActivatedAt() int64 {
 	return 0
 }
 
+func (m *SplitKey) GetKeyCommitment() []byte {
+	if m != nil {
+		return m.KeyCommitment
+	}
+	return nil
+}
+
+func (m *SplitKey) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *SplitKey) GetSupersededAt() int64 {
+	if m != nil {
+		return m.SupersededAt
+	}
+	return 0
+}
+
+func (m *SplitKey) GetSupersededBy() string {
+	if m != nil {
+		return m.SupersededBy
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*LCTMediatedPairing)(nil), "racecarweb.lctmanager.v1.LCTMediatedPairing")
 	proto.RegisterType((*SessionKeyExchange)(nil), "racecarweb.lctmanager.v1.SessionKeyExchange")
@@ -728,54 +760,58 @@ func init() {
 }
 
 var fileDescriptor_6f7cee81d1d9a17a = []byte{
-	// 747 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcd, 0x6e, 0xd3, 0x4c,
-	0x14, 0xad, 0xf3, 0xd7, 0xe4, 0x26, 0x4d, 0x53, 0x7f, 0xdf, 0xd7, 0x2f, 0xa8, 0x25, 0x6d, 0x23,
-	0x21, 0x22, 0x55, 0x4d, 0x55, 0xb1, 0x61, 0x83, 0x50, 0x09, 0x5d, 0x44, 0x14, 0x09, 0xb9, 0x65,
-	0xc3, 0xc6, 0x9a, 0xda, 0x57, 0x89, 0x85, 0x6b, 0x9b, 0x99, 0x9b, 0x92, 0x88, 0x97, 0x40, 0xe2,
-	0x69, 0x78, 0x03, 0x96, 0x5d, 0x76, 0x07, 0x6a, 0xdf, 0x03, 0xa1, 0xb1, 0x27, 0x76, 0xea, 0xfc,
-	0x14, 0x51, 0x76, 0x99, 0x73, 0x8f, 0x27, 0x33, 0xe7, 0xdc, 0x73, 0x6d, 0xd8, 0xe5, 0xcc, 0x42,
-	0x8b, 0xf1, 0x8f, 0x78, 0xb6, 0xef, 0x5a, 0x74, 0xce, 0x3c, 0xd6, 0x43, 0xbe, 0x7f, 0x71, 0xb0,
-	0xff, 0x1e, 0x47, 0x26, 0x0e, 0xad, 0x3e, 0xf3, 0x7a, 0xd8, 0x0e, 0xb8, 0x4f, 0xbe, 0x5e, 0x4f,
-	0xc8, 0xed, 0x84, 0xdc, 0xbe, 0x38, 0x68, 0x7e, 0xc9, 0x80, 0x7e, 0xdc, 0x39, 0x7d, 0x8d, 0xb6,
-	0xc3, 0x08, 0xed, 0x37, 0xcc, 0xe1, 0x8e, 0xd7, 0xd3, 0x1f, 0x02, 0x04, 0xd1, 0x4f, 0xd3, 0xb1,
-	0xeb, 0xda, 0xb6, 0xd6, 0x2a, 0x19, 0x25, 0x85, 0x74, 0x6d, 0xbd, 0x05, 0x35, 0xc7, 0x73, 0xc8,
-	0x61, 0xe4, 0x73, 0xd3, 0xb5, 0x48, 0x92, 0x32, 0x21, 0xa9, 0x1a, 0xe3, 0xc7, 0x16, 0x75, 0x6d,
-	0xbd, 0x09, 0x2b, 0xc4, 0x78, 0x0f, 0x69, 0x4c, 0xcb, 0x86, 0xb4, 0x72, 0x04, 0x46, 0x9c, 0x3a,
-	0x2c, 0x5b, 0xbe, 0x47, 0x38, 0xa4, 0x7a, 0x2e, 0xac, 0x8e, 0x97, 0xfa, 0x36, 0x54, 0x02, 0xee,
-	0x0f, 0x47, 0xe3, 0x87, 0xf3, 0x61, 0x19, 0x42, 0x2c, 0x7a, 0x76, 0x1d, 0x0a, 0x82, 0x18, 0x0d,
-	0x44, 0xbd, 0x10, 0xd6, 0xd4, 0x4a, 0x5e, 0xc0, 0xe2, 0x28, 0xaf, 0x64, 0x32, 0xaa, 0x2f, 0x6f,
-	0x6b, 0xad, 0xac, 0x51, 0x52, 0xc8, 0x21, 0xc9, 0x32, 0x0e, 0x03, 0x87, 0xa3, 0x90, 0xe5, 0x62,
-	0x54, 0x56, 0xc8, 0x21, 0x35, 0x7f, 0x66, 0x40, 0x3f, 0x41, 0x21, 0x1c, 0xdf, 0x7b, 0x85, 0xa3,
-	0x23, 0x25, 0xe6, 0x5d, 0xaa, 0xb4, 0xe1, 0x1f, 0x79, 0x4e, 0x8e, 0x2e, 0x23, 0xc7, 0xf7, 0x44,
-	0xdf, 0x09, 0x12, 0x61, 0xd6, 0x5c, 0x8b, 0x8c, 0x89, 0x4a, 0xd7, 0xd6, 0x8f, 0x60, 0x0b, 0x3d,
-	0x8b, 0x8f, 0x02, 0x79, 0x4a, 0x11, 0xfd, 0x9d, 0x29, 0xdd, 0x8b, 0x35, 0x0c, 0xd5, 0xaa, 0x18,
-	0x9b, 0x31, 0x2d, 0x39, 0x54, 0x77, 0xcc, 0xd1, 0x9f, 0xc3, 0xe6, 0xec, 0x6d, 0x22, 0x8d, 0x43,
-	0x4d, 0x2b, 0xc6, 0x83, 0x19, 0x7b, 0x9c, 0x86, 0x04, 0xfd, 0x19, 0x6c, 0xf4, 0x99, 0xe8, 0xa3,
-	0x6d, 0x5a, 0xfe, 0xf9, 0x99, 0xe3, 0xdd, 0xde, 0x26, 0x14, 0xbd, 0x62, 0xd4, 0x23, 0x4a, 0x47,
-	0x31, 0x92, 0x4d, 0xfe, 0xd4, 0x82, 0x0d, 0x28, 0xb9, 0x4c, 0x90, 0x39, 0x10, 0x68, 0x2b, 0x07,
-	0x8a, 0x12, 0x78, 0x2b, 0xd0, 0x6e, 0x7e, 0xd7, 0xa0, 0xa6, 0x7a, 0xb1, 0xd3, 0x67, 0xae, 0x8b,
-	0x52, 0xfe, 0x1d, 0xa8, 0x58, 0xe3, 0x45, 0x62, 0x40, 0x39, 0xc6, 0xba, 0x76, 0xca, 0xa1, 0x4c,
-	0xda, 0xa1, 0x47, 0x50, 0x4d, 0x76, 0xb0, 0x19, 0x31, 0x25, 0xf0, 0x4a, 0x8c, 0xbe, 0x64, 0xc4,
-	0xf4, 0x5d, 0x58, 0xc3, 0x61, 0x80, 0x96, 0x3c, 0x3a, 0x47, 0x11, 0xf8, 0x9e, 0x40, 0x25, 0x63,
-	0x6d, 0x5c, 0x30, 0x14, 0x9e, 0x6a, 0xa5, 0x7c, 0xaa, 0x95, 0xe6, 0xa9, 0xd3, 0xfc, 0xaa, 0x41,
-	0x55, 0xdd, 0xd0, 0xc0, 0x0f, 0x03, 0x14, 0x34, 0x33, 0x55, 0xda, 0xef, 0xa5, 0x2a, 0xb3, 0x30,
-	0x55, 0xd9, 0xc5, 0xa9, 0xca, 0x4d, 0xa5, 0x6a, 0xf1, 0x9d, 0x9a, 0x57, 0x1a, 0xac, 0xc6, 0x67,
-	0x4f, 0x64, 0x58, 0x94, 0x8d, 0x44, 0x86, 0xcc, 0xad, 0x26, 0x49, 0x7b, 0x9a, 0x9d, 0xf6, 0x74,
-	0xda, 0xb4, 0xdc, 0x2c, 0xd3, 0xe6, 0xa4, 0x2f, 0x3f, 0x2f, 0x7d, 0xff, 0x42, 0x1e, 0x39, 0xf7,
-	0xb9, 0xf2, 0x25, 0x5a, 0x48, 0x5b, 0xd6, 0x3b, 0xfe, 0x79, 0xe0, 0x22, 0x61, 0xca, 0x9e, 0x3b,
-	0x6e, 0xb8, 0x07, 0x7a, 0xe2, 0x5e, 0xdc, 0x35, 0x2a, 0xfc, 0x71, 0x25, 0xd6, 0xeb, 0x31, 0xac,
-	0x2a, 0x0b, 0x63, 0x6e, 0x74, 0xf7, 0x6a, 0x04, 0xc7, 0xc4, 0x16, 0xd4, 0x26, 0x43, 0x3d, 0x21,
-	0x40, 0x55, 0xc4, 0x21, 0x94, 0x0a, 0xc8, 0xa9, 0xf5, 0xff, 0xd4, 0xd9, 0xef, 0x67, 0xcf, 0x1c,
-	0x51, 0xb3, 0xf7, 0x18, 0x69, 0xb9, 0xbf, 0x30, 0xd2, 0xf2, 0xf7, 0x1c, 0x69, 0x85, 0x3b, 0x46,
-	0xda, 0x16, 0x94, 0x89, 0x0f, 0x04, 0x99, 0xc2, 0xf2, 0x39, 0x86, 0xb3, 0xab, 0x64, 0x40, 0x08,
-	0x9d, 0x48, 0x24, 0x69, 0x9e, 0xe2, 0x64, 0xf3, 0x7c, 0x82, 0xe2, 0x49, 0xe0, 0x3a, 0x24, 0xb7,
-	0xf8, 0x0f, 0x0a, 0xb7, 0x22, 0x9c, 0x77, 0x53, 0xef, 0xab, 0xcc, 0x82, 0x61, 0x99, 0x4d, 0x0f,
-	0xcb, 0x1d, 0xa8, 0x30, 0x8b, 0x9c, 0x8b, 0x31, 0x21, 0x17, 0x12, 0xca, 0x31, 0x76, 0x48, 0x2f,
-	0x9e, 0x7e, 0xbb, 0x6e, 0x68, 0x97, 0xd7, 0x0d, 0xed, 0xc7, 0x75, 0x43, 0xfb, 0x7c, 0xd3, 0x58,
-	0xba, 0xbc, 0x69, 0x2c, 0x5d, 0xdd, 0x34, 0x96, 0xde, 0x35, 0xd4, 0xdb, 0x7f, 0x4f, 0x7e, 0x2b,
-	0x0c, 0x27, 0xbf, 0x16, 0x68, 0x14, 0xa0, 0x38, 0x2b, 0x84, 0x1f, 0x09, 0x4f, 0x7e, 0x05, 0x00,
-	0x00, 0xff, 0xff, 0xfe, 0x6c, 0xaf, 0x18, 0x53, 0x08, 0x00, 0x00,
+	// 803 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcd, 0x6e, 0xf3, 0x44,
+	0x14, 0xad, 0x9d, 0x9f, 0x2f, 0xb9, 0xf9, 0xf9, 0xf2, 0x19, 0x28, 0x41, 0x2d, 0x69, 0x1b, 0x84,
+	0x88, 0x54, 0x35, 0x55, 0xc5, 0x86, 0x0d, 0x42, 0x69, 0xe8, 0x22, 0xa2, 0x48, 0xc8, 0x2d, 0x1b,
+	0x36, 0xd6, 0x64, 0x7c, 0x95, 0x58, 0x75, 0x6c, 0x33, 0x33, 0x09, 0xf1, 0x0b, 0xb0, 0x46, 0xe2,
+	0x69, 0x78, 0x03, 0x96, 0x5d, 0x76, 0x07, 0x6a, 0xdf, 0x03, 0xa1, 0xb1, 0xc7, 0x76, 0xea, 0xfc,
+	0x14, 0xd1, 0x6f, 0xd7, 0x39, 0xf7, 0x78, 0x3a, 0x73, 0xce, 0x3d, 0x37, 0x03, 0xa7, 0x8c, 0x50,
+	0xa4, 0x84, 0xfd, 0x82, 0xe3, 0x73, 0x97, 0x8a, 0x19, 0xf1, 0xc8, 0x04, 0xd9, 0xf9, 0xe2, 0xe2,
+	0xfc, 0x0e, 0x43, 0x0b, 0x97, 0x74, 0x4a, 0xbc, 0x09, 0xf6, 0x03, 0xe6, 0x0b, 0xdf, 0x68, 0x67,
+	0xe4, 0x7e, 0x46, 0xee, 0x2f, 0x2e, 0xba, 0xbf, 0xeb, 0x60, 0x5c, 0x0f, 0x6f, 0xbf, 0x47, 0xdb,
+	0x21, 0x02, 0xed, 0x1f, 0x88, 0xc3, 0x1c, 0x6f, 0x62, 0x7c, 0x0a, 0x10, 0xc4, 0x7f, 0x5a, 0x8e,
+	0xdd, 0xd6, 0x8e, 0xb5, 0x5e, 0xd5, 0xac, 0x2a, 0x64, 0x64, 0x1b, 0x3d, 0x68, 0x39, 0x9e, 0x23,
+	0x1c, 0x22, 0x7c, 0x66, 0xb9, 0x54, 0x48, 0x92, 0x1e, 0x91, 0x9a, 0x29, 0x7e, 0x4d, 0xc5, 0xc8,
+	0x36, 0xba, 0xd0, 0x10, 0x84, 0x4d, 0x50, 0x24, 0xb4, 0x42, 0x44, 0xab, 0xc5, 0x60, 0xcc, 0x69,
+	0xc3, 0x1b, 0xea, 0x7b, 0x02, 0x97, 0xa2, 0x5d, 0x8c, 0xaa, 0xc9, 0xd2, 0x38, 0x86, 0x7a, 0xc0,
+	0xfc, 0x65, 0x98, 0x7c, 0x5c, 0x8a, 0xca, 0x10, 0x61, 0xf1, 0xb7, 0xfb, 0x50, 0xe6, 0x82, 0x88,
+	0x39, 0x6f, 0x97, 0xa3, 0x9a, 0x5a, 0xc9, 0x0b, 0x50, 0x86, 0xf2, 0x4a, 0x16, 0x11, 0xed, 0x37,
+	0xc7, 0x5a, 0xaf, 0x60, 0x56, 0x15, 0x32, 0x10, 0xb2, 0x8c, 0xcb, 0xc0, 0x61, 0xc8, 0x65, 0xb9,
+	0x12, 0x97, 0x15, 0x32, 0x10, 0xdd, 0x7f, 0x74, 0x30, 0x6e, 0x90, 0x73, 0xc7, 0xf7, 0xbe, 0xc3,
+	0xf0, 0x4a, 0x89, 0xf9, 0x92, 0x2a, 0x7d, 0xf8, 0x40, 0x9e, 0x93, 0xa1, 0x4b, 0x84, 0xe3, 0x7b,
+	0x7c, 0xea, 0x04, 0x99, 0x30, 0xef, 0x5c, 0x2a, 0xcc, 0x95, 0xca, 0xc8, 0x36, 0xae, 0xe0, 0x08,
+	0x3d, 0xca, 0xc2, 0x40, 0x9e, 0x92, 0xc7, 0xff, 0xce, 0x92, 0xee, 0xa5, 0x1a, 0x46, 0x6a, 0xd5,
+	0xcd, 0xc3, 0x94, 0x96, 0x1d, 0x6a, 0x94, 0x70, 0x8c, 0x6f, 0xe0, 0x70, 0xf3, 0x36, 0xb1, 0xc6,
+	0x91, 0xa6, 0x75, 0xf3, 0x93, 0x0d, 0x7b, 0xdc, 0x46, 0x04, 0xe3, 0x6b, 0x38, 0x98, 0x12, 0x3e,
+	0x45, 0xdb, 0xa2, 0xfe, 0x6c, 0xec, 0x78, 0xcf, 0xb7, 0x89, 0x44, 0xaf, 0x9b, 0xed, 0x98, 0x32,
+	0x54, 0x8c, 0x6c, 0x93, 0xff, 0x6b, 0xc1, 0x01, 0x54, 0x5d, 0xc2, 0x85, 0x35, 0xe7, 0x68, 0x2b,
+	0x07, 0x2a, 0x12, 0xf8, 0x91, 0xa3, 0xdd, 0xfd, 0x4b, 0x83, 0x96, 0xea, 0xc5, 0xe1, 0x94, 0xb8,
+	0x2e, 0x4a, 0xf9, 0x4f, 0xa0, 0x4e, 0x93, 0x45, 0x66, 0x40, 0x2d, 0xc5, 0x46, 0x76, 0xce, 0x21,
+	0x3d, 0xef, 0xd0, 0xe7, 0xd0, 0xcc, 0x76, 0xb0, 0x89, 0x20, 0x4a, 0xe0, 0x46, 0x8a, 0x7e, 0x4b,
+	0x04, 0x31, 0x4e, 0xe1, 0x1d, 0x2e, 0x03, 0xa4, 0xf2, 0xe8, 0x0c, 0x79, 0xe0, 0x7b, 0x1c, 0x95,
+	0x8c, 0xad, 0xa4, 0x60, 0x2a, 0x3c, 0xd7, 0x4a, 0xa5, 0x5c, 0x2b, 0x6d, 0x53, 0xa7, 0xfb, 0x87,
+	0x06, 0x4d, 0x75, 0x43, 0x13, 0x7f, 0x9e, 0x23, 0x17, 0x1b, 0x53, 0xa5, 0xfd, 0xb7, 0x54, 0xe9,
+	0x3b, 0x53, 0x55, 0xd8, 0x9d, 0xaa, 0xe2, 0x5a, 0xaa, 0x76, 0xdf, 0xa9, 0xfb, 0xa0, 0xc1, 0xdb,
+	0xf4, 0xec, 0x99, 0x0c, 0xbb, 0xb2, 0x91, 0xc9, 0xa0, 0x3f, 0x6b, 0x92, 0xbc, 0xa7, 0x85, 0x75,
+	0x4f, 0xd7, 0x4d, 0x2b, 0x6e, 0x32, 0x6d, 0x4b, 0xfa, 0x4a, 0xdb, 0xd2, 0xf7, 0x21, 0x94, 0x90,
+	0x31, 0x9f, 0x29, 0x5f, 0xe2, 0x85, 0xb4, 0x65, 0x7f, 0xe8, 0xcf, 0x02, 0x17, 0x05, 0xe6, 0xec,
+	0x79, 0xe1, 0x86, 0x67, 0x60, 0x64, 0xee, 0xa5, 0x5d, 0xa3, 0xc2, 0x9f, 0x56, 0x52, 0xbd, 0xbe,
+	0x80, 0xb7, 0xca, 0xc2, 0x94, 0x1b, 0xdf, 0xbd, 0x19, 0xc3, 0x29, 0xb1, 0x07, 0xad, 0xd5, 0x50,
+	0xaf, 0x08, 0xd0, 0xe4, 0x69, 0x08, 0xa5, 0x02, 0x72, 0x6a, 0x7d, 0xbc, 0x76, 0xf6, 0xd7, 0xd9,
+	0xb3, 0x45, 0xd4, 0xc2, 0x2b, 0x46, 0x5a, 0xf1, 0x3d, 0x8c, 0xb4, 0xd2, 0x2b, 0x47, 0x5a, 0xf9,
+	0x85, 0x91, 0x76, 0x04, 0x35, 0xc1, 0xe6, 0x5c, 0x58, 0x9c, 0xfa, 0x0c, 0xa3, 0xd9, 0x55, 0x35,
+	0x21, 0x82, 0x6e, 0x24, 0x92, 0x35, 0x4f, 0x65, 0xb5, 0x79, 0x7e, 0xd5, 0xa1, 0x72, 0x13, 0xb8,
+	0x8e, 0x90, 0x7b, 0x7c, 0x04, 0xe5, 0x67, 0x19, 0x2e, 0xb9, 0xb9, 0x1f, 0x2c, 0x7d, 0xc7, 0xb4,
+	0x2c, 0xe4, 0xa7, 0xe5, 0x09, 0xd4, 0x09, 0x15, 0xce, 0x22, 0x21, 0x14, 0x23, 0x42, 0x2d, 0xc5,
+	0x06, 0x42, 0xe6, 0x44, 0x4a, 0x44, 0xfd, 0xd9, 0xcc, 0x11, 0x33, 0xf4, 0x12, 0x99, 0x1a, 0x77,
+	0x18, 0x0e, 0x53, 0x50, 0xce, 0x85, 0x05, 0x32, 0x79, 0xd3, 0x48, 0x86, 0xa2, 0x99, 0x2c, 0x8d,
+	0xcf, 0xa0, 0xc1, 0xe7, 0x01, 0x32, 0x8e, 0xf6, 0xea, 0xcc, 0xae, 0x67, 0xe0, 0x40, 0xe4, 0x48,
+	0xe3, 0x50, 0x29, 0xb0, 0x42, 0xba, 0x0c, 0x2f, 0xbf, 0xfa, 0xf3, 0xb1, 0xa3, 0xdd, 0x3f, 0x76,
+	0xb4, 0xbf, 0x1f, 0x3b, 0xda, 0x6f, 0x4f, 0x9d, 0xbd, 0xfb, 0xa7, 0xce, 0xde, 0xc3, 0x53, 0x67,
+	0xef, 0xa7, 0x8e, 0x7a, 0x89, 0x9c, 0xc9, 0x77, 0xcb, 0x72, 0xf5, 0xe5, 0x22, 0xc2, 0x00, 0xf9,
+	0xb8, 0x1c, 0x3d, 0x58, 0xbe, 0xfc, 0x37, 0x00, 0x00, 0xff, 0xff, 0x5b, 0x63, 0x9d, 0x1b, 0xdf,
+	0x08, 0x00, 0x00,
 }
 
 func (m *LCTMediatedPairing) Marshal() (dAtA []byte, err error) {
@@ -1262,6 +1298,30 @@ func (m *SplitKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SupersededBy) > 0 {
+		i -= len(m.SupersededBy)
+		copy(dAtA[i:], m.SupersededBy)
+		i = encodeVarintKeyExchange(dAtA, i, uint64(len(m.SupersededBy)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.SupersededAt != 0 {
+		i = encodeVarintKeyExchange(dAtA, i, uint64(m.SupersededAt))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Version != 0 {
+		i = encodeVarintKeyExchange(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.KeyCommitment) > 0 {
+		i -= len(m.KeyCommitment)
+		copy(dAtA[i:], m.KeyCommitment)
+		i = encodeVarintKeyExchange(dAtA, i, uint64(len(m.KeyCommitment)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.ActivatedAt != 0 {
 		i = encodeVarintKeyExchange(dAtA, i, uint64(m.ActivatedAt))
 		i--
@@ -1557,6 +1617,20 @@ func (m *SplitKey) Size() (n int) {
 	if m.ActivatedAt != 0 {
 		n += 1 + sovKeyExchange(uint64(m.ActivatedAt))
 	}
+	l = len(m.KeyCommitment)
+	if l > 0 {
+		n += 1 + l + sovKeyExchange(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovKeyExchange(uint64(m.Version))
+	}
+	if m.SupersededAt != 0 {
+		n += 1 + sovKeyExchange(uint64(m.SupersededAt))
+	}
+	l = len(m.SupersededBy)
+	if l > 0 {
+		n += 1 + l + sovKeyExchange(uint64(l))
+	}
 	return n
 }
 
@@ -3429,6 +3503,110 @@ func (m *SplitKey) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyCommitment", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthKeyExchange
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeyExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyCommitment = append(m.KeyCommitment[:0], dAtA[iNdEx:postIndex]...)
+			if m.KeyCommitment == nil {
+				m.KeyCommitment = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupersededAt", wireType)
+			}
+			m.SupersededAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SupersededAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupersededBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeyExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeyExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SupersededBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipKeyExchange(dAtA[iNdEx:])
@@ -30,6 +30,13 @@ var (
 	SessionKeyExchangePrefix = collections.NewPrefix([]byte{0x04})
 	PairingChallengePrefix   = collections.NewPrefix([]byte{0x05})
 	SplitKeyPrefix           = collections.NewPrefix([]byte{0x06})
+	LctPairIndexPrefix       = collections.NewPrefix([]byte{0x07})
+	AuditTrailPrefix         = collections.NewPrefix([]byte{0x08})
+	LctProxyIndexPrefix      = collections.NewPrefix([]byte{0x09})
+	SupersededSplitKeyPrefix = collections.NewPrefix([]byte{0x0a})
+	ActiveContactIndexPrefix = collections.NewPrefix([]byte{0x0b})
+	LctComponentIndexPrefix  = collections.NewPrefix([]byte{0x0c})
+	SuspendedFromPrefix      = collections.NewPrefix([]byte{0x0d})
 )
 
 // KeyPrefix returns the key prefix for a specific LCT
@@ -0,0 +1,23 @@
+package types
+
+// LctFilter narrows an LCT listing. Empty fields match every LCT; the
+// operational context is compared in its normalized form.
+type LctFilter struct {
+	ComponentID        string
+	Status             string
+	OperationalContext string
+}
+
+// Matches reports whether lct passes the filter
+func (f LctFilter) Matches(lct LinkedContextToken) bool {
+	if f.ComponentID != "" && lct.ComponentAId != f.ComponentID && lct.ComponentBId != f.ComponentID {
+		return false
+	}
+	if f.Status != "" && lct.PairingStatus != f.Status {
+		return false
+	}
+	if f.OperationalContext != "" && lct.OperationalContext != f.OperationalContext {
+		return false
+	}
+	return true
+}
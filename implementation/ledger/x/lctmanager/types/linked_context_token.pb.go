@@ -24,18 +24,21 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // LinkedContextToken defines the LinkedContextToken message.
 type LinkedContextToken struct {
-	LctId              string `protobuf:"bytes,1,opt,name=lct_id,json=lctId,proto3" json:"lct_id,omitempty"`
-	ComponentAId       string `protobuf:"bytes,2,opt,name=component_a_id,json=componentAId,proto3" json:"component_a_id,omitempty"`
-	ComponentBId       string `protobuf:"bytes,3,opt,name=component_b_id,json=componentBId,proto3" json:"component_b_id,omitempty"`
-	LctKeyHalf         string `protobuf:"bytes,4,opt,name=lct_key_half,json=lctKeyHalf,proto3" json:"lct_key_half,omitempty"`
-	PairingStatus      string `protobuf:"bytes,5,opt,name=pairing_status,json=pairingStatus,proto3" json:"pairing_status,omitempty"`
-	CreatedAt          int64  `protobuf:"varint,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt          int64  `protobuf:"varint,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	LastContactAt      int64  `protobuf:"varint,8,opt,name=last_contact_at,json=lastContactAt,proto3" json:"last_contact_at,omitempty"`
-	TrustAnchor        string `protobuf:"bytes,9,opt,name=trust_anchor,json=trustAnchor,proto3" json:"trust_anchor,omitempty"`
-	OperationalContext string `protobuf:"bytes,10,opt,name=operational_context,json=operationalContext,proto3" json:"operational_context,omitempty"`
-	ProxyComponentId   string `protobuf:"bytes,11,opt,name=proxy_component_id,json=proxyComponentId,proto3" json:"proxy_component_id,omitempty"`
-	AuthorizationRules string `protobuf:"bytes,12,opt,name=authorization_rules,json=authorizationRules,proto3" json:"authorization_rules,omitempty"`
+	LctId                string `protobuf:"bytes,1,opt,name=lct_id,json=lctId,proto3" json:"lct_id,omitempty"`
+	ComponentAId         string `protobuf:"bytes,2,opt,name=component_a_id,json=componentAId,proto3" json:"component_a_id,omitempty"`
+	ComponentBId         string `protobuf:"bytes,3,opt,name=component_b_id,json=componentBId,proto3" json:"component_b_id,omitempty"`
+	LctKeyHalf           string `protobuf:"bytes,4,opt,name=lct_key_half,json=lctKeyHalf,proto3" json:"lct_key_half,omitempty"`
+	PairingStatus        string `protobuf:"bytes,5,opt,name=pairing_status,json=pairingStatus,proto3" json:"pairing_status,omitempty"`
+	CreatedAt            int64  `protobuf:"varint,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt            int64  `protobuf:"varint,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	LastContactAt        int64  `protobuf:"varint,8,opt,name=last_contact_at,json=lastContactAt,proto3" json:"last_contact_at,omitempty"`
+	TrustAnchor          string `protobuf:"bytes,9,opt,name=trust_anchor,json=trustAnchor,proto3" json:"trust_anchor,omitempty"`
+	OperationalContext   string `protobuf:"bytes,10,opt,name=operational_context,json=operationalContext,proto3" json:"operational_context,omitempty"`
+	ProxyComponentId     string `protobuf:"bytes,11,opt,name=proxy_component_id,json=proxyComponentId,proto3" json:"proxy_component_id,omitempty"`
+	AuthorizationRules   string `protobuf:"bytes,12,opt,name=authorization_rules,json=authorizationRules,proto3" json:"authorization_rules,omitempty"`
+	KeyExchangeTimestamp int64  `protobuf:"varint,13,opt,name=key_exchange_timestamp,json=keyExchangeTimestamp,proto3" json:"key_exchange_timestamp,omitempty"`
+	KeyCommitment        string `protobuf:"bytes,14,opt,name=key_commitment,json=keyCommitment,proto3" json:"key_commitment,omitempty"`
+	MessageKey           string `protobuf:"bytes,15,opt,name=message_key,json=messageKey,proto3" json:"message_key,omitempty"`
 }
 
 func (m *LinkedContextToken) Reset()         { *m = LinkedContextToken{} }
@@ -155,8 +158,132 @@ func (m *LinkedContextToken) GetAuthorizationRules() string {
 	return ""
 }
 
+func (m *LinkedContextToken) GetKeyExchangeTimestamp() int64 {
+	if m != nil {
+		return m.KeyExchangeTimestamp
+	}
+	return 0
+}
+
+func (m *LinkedContextToken) GetKeyCommitment() string {
+	if m != nil {
+		return m.KeyCommitment
+	}
+	return ""
+}
+
+func (m *LinkedContextToken) GetMessageKey() string {
+	if m != nil {
+		return m.MessageKey
+	}
+	return ""
+}
+
+// LCTAuditEntry records a state-changing operation on a LinkedContextToken. It
+// deliberately carries no key material or shared secrets.
+type LCTAuditEntry struct {
+	LctId      string `protobuf:"bytes,1,opt,name=lct_id,json=lctId,proto3" json:"lct_id,omitempty"`
+	Sequence   uint64 `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	EventType  string `protobuf:"bytes,3,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
+	Actor      string `protobuf:"bytes,4,opt,name=actor,proto3" json:"actor,omitempty"`
+	FromStatus string `protobuf:"bytes,5,opt,name=from_status,json=fromStatus,proto3" json:"from_status,omitempty"`
+	ToStatus   string `protobuf:"bytes,6,opt,name=to_status,json=toStatus,proto3" json:"to_status,omitempty"`
+	Reason     string `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
+	Timestamp  int64  `protobuf:"varint,8,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (m *LCTAuditEntry) Reset()         { *m = LCTAuditEntry{} }
+func (m *LCTAuditEntry) String() string { return proto.CompactTextString(m) }
+func (*LCTAuditEntry) ProtoMessage()    {}
+func (*LCTAuditEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d00ca07b1ba54bc2, []int{1}
+}
+func (m *LCTAuditEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LCTAuditEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LCTAuditEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LCTAuditEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LCTAuditEntry.Merge(m, src)
+}
+func (m *LCTAuditEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *LCTAuditEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_LCTAuditEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LCTAuditEntry proto.InternalMessageInfo
+
+func (m *LCTAuditEntry) GetLctId() string {
+	if m != nil {
+		return m.LctId
+	}
+	return ""
+}
+
+func (m *LCTAuditEntry) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *LCTAuditEntry) GetEventType() string {
+	if m != nil {
+		return m.EventType
+	}
+	return ""
+}
+
+func (m *LCTAuditEntry) GetActor() string {
+	if m != nil {
+		return m.Actor
+	}
+	return ""
+}
+
+func (m *LCTAuditEntry) GetFromStatus() string {
+	if m != nil {
+		return m.FromStatus
+	}
+	return ""
+}
+
+func (m *LCTAuditEntry) GetToStatus() string {
+	if m != nil {
+		return m.ToStatus
+	}
+	return ""
+}
+
+func (m *LCTAuditEntry) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *LCTAuditEntry) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*LinkedContextToken)(nil), "racecarweb.lctmanager.v1.LinkedContextToken")
+	proto.RegisterType((*LCTAuditEntry)(nil), "racecarweb.lctmanager.v1.LCTAuditEntry")
 }
 
 func init() {
@@ -164,33 +291,43 @@ func init() {
 }
 
 var fileDescriptor_d00ca07b1ba54bc2 = []byte{
-	// 402 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x92, 0x31, 0x8f, 0xd3, 0x30,
-	0x14, 0xc7, 0x1b, 0xca, 0x15, 0xea, 0xcb, 0x1d, 0xc8, 0x08, 0xc9, 0x0b, 0x51, 0x41, 0x80, 0x6e,
-	0x80, 0x46, 0xa7, 0x5b, 0x58, 0x73, 0x5d, 0xa8, 0x60, 0x2a, 0x4c, 0x2c, 0xd6, 0xab, 0xed, 0x6b,
-	0xa3, 0xba, 0x76, 0xe4, 0xbc, 0x94, 0x86, 0x4f, 0xc1, 0xc7, 0x62, 0xec, 0x08, 0x1b, 0x6a, 0xbf,
-	0x08, 0xb2, 0x93, 0xd2, 0x5e, 0xd7, 0xff, 0xef, 0xf7, 0x7f, 0x89, 0x9e, 0x1f, 0xb9, 0x71, 0x20,
-	0x94, 0x00, 0xf7, 0x5d, 0x4d, 0x53, 0x2d, 0x70, 0x09, 0x06, 0x66, 0xca, 0xa5, 0xab, 0xeb, 0x54,
-	0xe7, 0x66, 0xa1, 0x24, 0x17, 0xd6, 0xa0, 0x5a, 0x23, 0x47, 0xbb, 0x50, 0x66, 0x58, 0x38, 0x8b,
-	0x96, 0xb2, 0x43, 0x69, 0x78, 0x28, 0x0d, 0x57, 0xd7, 0xaf, 0xfe, 0x74, 0x09, 0xfd, 0x1c, 0x8a,
-	0xa3, 0xa6, 0xf7, 0xd5, 0xd7, 0xe8, 0x73, 0xd2, 0xd3, 0x02, 0x79, 0x2e, 0x59, 0x34, 0x88, 0xae,
-	0xfa, 0x93, 0x33, 0x2d, 0x70, 0x2c, 0xe9, 0x6b, 0x72, 0x29, 0xec, 0xb2, 0xb0, 0x46, 0x19, 0xe4,
-	0xe0, 0xf1, 0x83, 0x80, 0xe3, 0xff, 0x69, 0x76, 0x6a, 0x4d, 0xbd, 0xd5, 0x3d, 0xb1, 0x6e, 0xc7,
-	0x92, 0x0e, 0x48, 0xec, 0x3f, 0xb1, 0x50, 0x35, 0x9f, 0x83, 0xbe, 0x63, 0x0f, 0x83, 0x43, 0xb4,
-	0xc0, 0x4f, 0xaa, 0xfe, 0x08, 0xfa, 0x8e, 0xbe, 0x21, 0x97, 0x05, 0xe4, 0x2e, 0x37, 0x33, 0x5e,
-	0x22, 0x60, 0x55, 0xb2, 0xb3, 0xe0, 0x5c, 0xb4, 0xe9, 0x97, 0x10, 0xd2, 0x17, 0x84, 0x08, 0xa7,
-	0x00, 0x95, 0xe4, 0x80, 0xac, 0x37, 0x88, 0xae, 0xba, 0x93, 0x7e, 0x9b, 0x64, 0xe8, 0x71, 0x55,
-	0xc8, 0x3d, 0x7e, 0xd4, 0xe0, 0x36, 0xc9, 0x90, 0xbe, 0x25, 0x4f, 0x34, 0x94, 0x18, 0xd6, 0x06,
-	0x02, 0xbd, 0xf3, 0x38, 0x38, 0x17, 0x3e, 0x1e, 0x35, 0x69, 0x86, 0xf4, 0x25, 0x89, 0xd1, 0x55,
-	0x25, 0x72, 0x30, 0x62, 0x6e, 0x1d, 0xeb, 0x87, 0x5f, 0x39, 0x0f, 0x59, 0x16, 0x22, 0x9a, 0x92,
-	0x67, 0xb6, 0x50, 0x0e, 0x30, 0xb7, 0x06, 0xf4, 0xfe, 0x21, 0x18, 0x09, 0x26, 0x3d, 0x42, 0xed,
-	0xaa, 0xe9, 0x3b, 0x42, 0x0b, 0x67, 0xd7, 0x35, 0x3f, 0xac, 0x2b, 0x97, 0xec, 0x3c, 0xf8, 0x4f,
-	0x03, 0x19, 0xed, 0xc1, 0x58, 0xfa, 0xf1, 0x50, 0xe1, 0xdc, 0xba, 0xfc, 0x47, 0x98, 0xc3, 0x5d,
-	0xa5, 0x55, 0xc9, 0xe2, 0x66, 0xfc, 0x3d, 0x34, 0xf1, 0xe4, 0xf6, 0xc3, 0xaf, 0x6d, 0x12, 0x6d,
-	0xb6, 0x49, 0xf4, 0x77, 0x9b, 0x44, 0x3f, 0x77, 0x49, 0x67, 0xb3, 0x4b, 0x3a, 0xbf, 0x77, 0x49,
-	0xe7, 0x5b, 0xd2, 0xde, 0xc3, 0x7b, 0x7f, 0x45, 0xeb, 0xe3, 0x3b, 0xc2, 0xba, 0x50, 0xe5, 0xb4,
-	0x17, 0xce, 0xe6, 0xe6, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x6e, 0x39, 0x6c, 0x1c, 0x6d, 0x02,
-	0x00, 0x00,
+	// 575 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x93, 0x4f, 0x6f, 0xd3, 0x30,
+	0x18, 0xc6, 0x17, 0xb6, 0x95, 0xc5, 0x6b, 0x37, 0x64, 0xc6, 0x64, 0xf1, 0x27, 0x8c, 0x09, 0xd0,
+	0x0e, 0xd0, 0x6a, 0x1a, 0x07, 0xae, 0x59, 0x35, 0x89, 0x6a, 0x3b, 0x95, 0x9e, 0xb8, 0x44, 0xae,
+	0xf3, 0xb6, 0x8d, 0x9a, 0xd8, 0xc1, 0x79, 0x53, 0x1a, 0x3e, 0x05, 0x1f, 0x8b, 0xe3, 0x6e, 0x70,
+	0x44, 0xed, 0x91, 0x2f, 0x81, 0xec, 0xb8, 0xeb, 0x36, 0x89, 0xa3, 0x9f, 0xdf, 0xf3, 0x3a, 0xce,
+	0xe3, 0xc7, 0xe4, 0x4c, 0x73, 0x01, 0x82, 0xeb, 0x6f, 0x30, 0xec, 0xa4, 0x02, 0x33, 0x2e, 0xf9,
+	0x18, 0x74, 0x67, 0x76, 0xda, 0x49, 0x13, 0x39, 0x85, 0x38, 0x12, 0x4a, 0x22, 0xcc, 0x31, 0x42,
+	0x35, 0x05, 0xd9, 0xce, 0xb5, 0x42, 0x45, 0xd9, 0x7a, 0xa8, 0xbd, 0x1e, 0x6a, 0xcf, 0x4e, 0x8f,
+	0x7f, 0x6d, 0x11, 0x7a, 0x65, 0x07, 0xbb, 0xf5, 0xdc, 0xc0, 0x8c, 0xd1, 0x27, 0xa4, 0x91, 0x0a,
+	0x8c, 0x92, 0x98, 0x79, 0x47, 0xde, 0x89, 0xdf, 0xdf, 0x4e, 0x05, 0xf6, 0x62, 0xfa, 0x9a, 0xec,
+	0x09, 0x95, 0xe5, 0x4a, 0x82, 0xc4, 0x88, 0x1b, 0xfc, 0xc0, 0xe2, 0xe6, 0x8d, 0x1a, 0xde, 0x77,
+	0x0d, 0x8d, 0x6b, 0xf3, 0x9e, 0xeb, 0xbc, 0x17, 0xd3, 0x23, 0xd2, 0x34, 0x9f, 0x98, 0x42, 0x15,
+	0x4d, 0x78, 0x3a, 0x62, 0x5b, 0xd6, 0x43, 0x52, 0x81, 0x97, 0x50, 0x7d, 0xe2, 0xe9, 0x88, 0xbe,
+	0x21, 0x7b, 0x39, 0x4f, 0x74, 0x22, 0xc7, 0x51, 0x81, 0x1c, 0xcb, 0x82, 0x6d, 0x5b, 0x4f, 0xcb,
+	0xa9, 0x9f, 0xad, 0x48, 0x5f, 0x10, 0x22, 0x34, 0x70, 0x84, 0x38, 0xe2, 0xc8, 0x1a, 0x47, 0xde,
+	0xc9, 0x66, 0xdf, 0x77, 0x4a, 0x88, 0x06, 0x97, 0x79, 0xbc, 0xc2, 0x0f, 0x6b, 0xec, 0x94, 0x10,
+	0xe9, 0x5b, 0xb2, 0x9f, 0xf2, 0x02, 0x6d, 0x6c, 0x5c, 0xa0, 0xf1, 0xec, 0x58, 0x4f, 0xcb, 0xc8,
+	0xdd, 0x5a, 0x0d, 0x91, 0xbe, 0x22, 0x4d, 0xd4, 0x65, 0x81, 0x11, 0x97, 0x62, 0xa2, 0x34, 0xf3,
+	0xed, 0x51, 0x76, 0xad, 0x16, 0x5a, 0x89, 0x76, 0xc8, 0x63, 0x95, 0x83, 0xe6, 0x98, 0x28, 0xc9,
+	0xd3, 0xd5, 0x45, 0x30, 0x62, 0x9d, 0xf4, 0x16, 0x72, 0x51, 0xd3, 0x77, 0x84, 0xe6, 0x5a, 0xcd,
+	0xab, 0x68, 0x1d, 0x57, 0x12, 0xb3, 0x5d, 0xeb, 0x7f, 0x64, 0x49, 0x77, 0x05, 0x7a, 0xb1, 0xd9,
+	0x9e, 0x97, 0x38, 0x51, 0x3a, 0xf9, 0x6e, 0xf7, 0x89, 0x74, 0x99, 0x42, 0xc1, 0x9a, 0xf5, 0xf6,
+	0x77, 0x50, 0xdf, 0x10, 0xfa, 0x81, 0x1c, 0x9a, 0x74, 0x61, 0x2e, 0x26, 0x5c, 0x8e, 0x21, 0xc2,
+	0x24, 0x83, 0x02, 0x79, 0x96, 0xb3, 0x96, 0xfd, 0xc3, 0x83, 0x29, 0x54, 0x17, 0x0e, 0x0e, 0x56,
+	0xcc, 0xa4, 0x6e, 0xa6, 0x84, 0xca, 0xb2, 0x04, 0x33, 0x90, 0xc8, 0xf6, 0xea, 0xd4, 0xa7, 0x60,
+	0x8e, 0xe3, 0x44, 0xfa, 0x92, 0xec, 0x66, 0x50, 0x14, 0x7c, 0x0c, 0xe6, 0x0a, 0xd9, 0x7e, 0x7d,
+	0x7b, 0x4e, 0xba, 0x84, 0xea, 0xf8, 0xaf, 0x47, 0x5a, 0x57, 0xdd, 0x41, 0x58, 0xc6, 0x09, 0x5e,
+	0x48, 0xd4, 0xd5, 0xff, 0x4a, 0xf5, 0x94, 0xec, 0x14, 0xf0, 0xb5, 0x04, 0x29, 0xc0, 0xd6, 0x69,
+	0xab, 0x7f, 0xb3, 0x36, 0x97, 0x07, 0x33, 0x93, 0x0b, 0x56, 0x39, 0xb8, 0x1a, 0xf9, 0x56, 0x19,
+	0x54, 0x39, 0xd0, 0x03, 0xb2, 0xcd, 0x05, 0x2a, 0xed, 0xca, 0x53, 0x2f, 0xcc, 0xd1, 0x46, 0x5a,
+	0x65, 0x77, 0x4b, 0x43, 0x8c, 0xe4, 0x1a, 0xf3, 0x8c, 0xf8, 0xa8, 0x56, 0xb8, 0x61, 0xf1, 0x0e,
+	0x2a, 0x07, 0x0f, 0x49, 0x43, 0x03, 0x2f, 0x94, 0xb4, 0x5d, 0xf1, 0xfb, 0x6e, 0x45, 0x9f, 0x13,
+	0x7f, 0x1d, 0x60, 0x5d, 0x91, 0xb5, 0x70, 0xfe, 0xf1, 0xe7, 0x22, 0xf0, 0xae, 0x17, 0x81, 0xf7,
+	0x67, 0x11, 0x78, 0x3f, 0x96, 0xc1, 0xc6, 0xf5, 0x32, 0xd8, 0xf8, 0xbd, 0x0c, 0x36, 0xbe, 0x04,
+	0xee, 0xed, 0xbd, 0x37, 0x2f, 0x76, 0x7e, 0xfb, 0xcd, 0x9a, 0x7f, 0x2a, 0x86, 0x0d, 0xfb, 0x44,
+	0xcf, 0xfe, 0x05, 0x00, 0x00, 0xff, 0xff, 0xbb, 0x10, 0xd0, 0x45, 0xd9, 0x03, 0x00, 0x00,
 }
 
 func (m *LinkedContextToken) Marshal() (dAtA []byte, err error) {
@@ -213,6 +350,25 @@ func (m *LinkedContextToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.MessageKey) > 0 {
+		i -= len(m.MessageKey)
+		copy(dAtA[i:], m.MessageKey)
+		i = encodeVarintLinkedContextToken(dAtA, i, uint64(len(m.MessageKey)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if len(m.KeyCommitment) > 0 {
+		i -= len(m.KeyCommitment)
+		copy(dAtA[i:], m.KeyCommitment)
+		i = encodeVarintLinkedContextToken(dAtA, i, uint64(len(m.KeyCommitment)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.KeyExchangeTimestamp != 0 {
+		i = encodeVarintLinkedContextToken(dAtA, i, uint64(m.KeyExchangeTimestamp))
+		i--
+		dAtA[i] = 0x68
+	}
 	if len(m.AuthorizationRules) > 0 {
 		i -= len(m.AuthorizationRules)
 		copy(dAtA[i:], m.AuthorizationRules)
@@ -294,6 +450,81 @@ func (m *LinkedContextToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *LCTAuditEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LCTAuditEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LCTAuditEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Timestamp != 0 {
+		i = encodeVarintLinkedContextToken(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintLinkedContextToken(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.ToStatus) > 0 {
+		i -= len(m.ToStatus)
+		copy(dAtA[i:], m.ToStatus)
+		i = encodeVarintLinkedContextToken(dAtA, i, uint64(len(m.ToStatus)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.FromStatus) > 0 {
+		i -= len(m.FromStatus)
+		copy(dAtA[i:], m.FromStatus)
+		i = encodeVarintLinkedContextToken(dAtA, i, uint64(len(m.FromStatus)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Actor) > 0 {
+		i -= len(m.Actor)
+		copy(dAtA[i:], m.Actor)
+		i = encodeVarintLinkedContextToken(dAtA, i, uint64(len(m.Actor)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.EventType) > 0 {
+		i -= len(m.EventType)
+		copy(dAtA[i:], m.EventType)
+		i = encodeVarintLinkedContextToken(dAtA, i, uint64(len(m.EventType)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintLinkedContextToken(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.LctId) > 0 {
+		i -= len(m.LctId)
+		copy(dAtA[i:], m.LctId)
+		i = encodeVarintLinkedContextToken(dAtA, i, uint64(len(m.LctId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintLinkedContextToken(dAtA []byte, offset int, v uint64) int {
 	offset -= sovLinkedContextToken(v)
 	base := offset
@@ -356,6 +587,56 @@ func (m *LinkedContextToken) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovLinkedContextToken(uint64(l))
 	}
+	if m.KeyExchangeTimestamp != 0 {
+		n += 1 + sovLinkedContextToken(uint64(m.KeyExchangeTimestamp))
+	}
+	l = len(m.KeyCommitment)
+	if l > 0 {
+		n += 1 + l + sovLinkedContextToken(uint64(l))
+	}
+	l = len(m.MessageKey)
+	if l > 0 {
+		n += 1 + l + sovLinkedContextToken(uint64(l))
+	}
+	return n
+}
+
+func (m *LCTAuditEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.LctId)
+	if l > 0 {
+		n += 1 + l + sovLinkedContextToken(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovLinkedContextToken(uint64(m.Sequence))
+	}
+	l = len(m.EventType)
+	if l > 0 {
+		n += 1 + l + sovLinkedContextToken(uint64(l))
+	}
+	l = len(m.Actor)
+	if l > 0 {
+		n += 1 + l + sovLinkedContextToken(uint64(l))
+	}
+	l = len(m.FromStatus)
+	if l > 0 {
+		n += 1 + l + sovLinkedContextToken(uint64(l))
+	}
+	l = len(m.ToStatus)
+	if l > 0 {
+		n += 1 + l + sovLinkedContextToken(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovLinkedContextToken(uint64(l))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovLinkedContextToken(uint64(m.Timestamp))
+	}
 	return n
 }
 
@@ -739,6 +1020,369 @@ func (m *LinkedContextToken) Unmarshal(dAtA []byte) error {
 			}
 			m.AuthorizationRules = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyExchangeTimestamp", wireType)
+			}
+			m.KeyExchangeTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLinkedContextToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeyExchangeTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyCommitment", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLinkedContextToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLinkedContextToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLinkedContextToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyCommitment = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLinkedContextToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLinkedContextToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLinkedContextToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MessageKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLinkedContextToken(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLinkedContextToken
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LCTAuditEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLinkedContextToken
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LCTAuditEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LCTAuditEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LctId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLinkedContextToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLinkedContextToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLinkedContextToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LctId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLinkedContextToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLinkedContextToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLinkedContextToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLinkedContextToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EventType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Actor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLinkedContextToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLinkedContextToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLinkedContextToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Actor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromStatus", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLinkedContextToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLinkedContextToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLinkedContextToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromStatus = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToStatus", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLinkedContextToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLinkedContextToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLinkedContextToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToStatus = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLinkedContextToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLinkedContextToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLinkedContextToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLinkedContextToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLinkedContextToken(dAtA[iNdEx:])
@@ -0,0 +1,10 @@
+package types
+
+func NewMsgRotateSplitKey(creator string, lctId string, keyCommitment []byte, reason string) *MsgRotateSplitKey {
+	return &MsgRotateSplitKey{
+		Creator:       creator,
+		LctId:         lctId,
+		Reason:        reason,
+		KeyCommitment: keyCommitment,
+	}
+}
@@ -0,0 +1,42 @@
+package types
+
+import (
+	"strings"
+
+	"cosmossdk.io/errors"
+)
+
+// MaxOperationalContextLength bounds a relationship's operational context
+const MaxOperationalContextLength = 128
+
+// NormalizeOperationalContext returns the canonical form in which a
+// relationship's operational context is stored and looked up, so that pairing
+// and direct LCT creation agree on it: trimmed, lower case, with each run of
+// spaces, hyphens and underscores joined into one underscore. The result may
+// only hold letters, digits, underscores, colons and dots; an empty context
+// stays empty.
+func NormalizeOperationalContext(context string) (string, error) {
+	var b strings.Builder
+	separator := false
+	for _, r := range strings.ToLower(strings.TrimSpace(context)) {
+		switch {
+		case r == ' ' || r == '\t' || r == '-' || r == '_':
+			separator = true
+			continue
+		case (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') || r == ':' || r == '.':
+		default:
+			return "", errors.Wrapf(ErrInvalidContext, "operational context %q may only contain letters, digits, spaces, '-', '_', ':' and '.'", context)
+		}
+		if separator && b.Len() > 0 {
+			b.WriteByte('_')
+		}
+		separator = false
+		b.WriteRune(r)
+	}
+
+	normalized := b.String()
+	if len(normalized) > MaxOperationalContextLength {
+		return "", errors.Wrapf(ErrInvalidContext, "operational context is longer than %d characters", MaxOperationalContextLength)
+	}
+	return normalized, nil
+}
@@ -1,17 +1,30 @@
 package types
 
+import (
+	"fmt"
+)
+
+// DefaultIdleTimeoutSeconds is how long an active LCT may go without contact
+// before the end-block sweep marks it inactive (30 days)
+const DefaultIdleTimeoutSeconds int64 = 30 * 24 * 60 * 60
+
 // NewParams creates a new Params instance.
-func NewParams() Params {
-	return Params{}
+func NewParams(idleTimeoutSeconds int64) Params {
+	return Params{
+		IdleTimeoutSeconds: idleTimeoutSeconds,
+	}
 }
 
 // DefaultParams returns a default set of parameters.
 func DefaultParams() Params {
-	return NewParams()
+	return NewParams(DefaultIdleTimeoutSeconds)
 }
 
 // Validate validates the set of params.
 func (p Params) Validate() error {
+	if p.IdleTimeoutSeconds < 0 {
+		return fmt.Errorf("idle timeout cannot be negative: %d", p.IdleTimeoutSeconds)
+	}
 
 	return nil
 }
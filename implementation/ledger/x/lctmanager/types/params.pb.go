@@ -26,6 +26,9 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Params defines the parameters for the module.
 type Params struct {
+	// Active LCTs with no contact for longer than this many seconds are marked
+	// inactive by the end-block sweep. Zero disables the sweep.
+	IdleTimeoutSeconds int64 `protobuf:"varint,1,opt,name=idle_timeout_seconds,json=idleTimeoutSeconds,proto3" json:"idle_timeout_seconds,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -61,6 +64,13 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+func (m *Params) GetIdleTimeoutSeconds() int64 {
+	if m != nil {
+		return m.IdleTimeoutSeconds
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "racecarweb.lctmanager.v1.Params")
 }
@@ -70,18 +80,20 @@ func init() {
 }
 
 var fileDescriptor_f131cb84be87f4d0 = []byte{
-	// 167 bytes of a gzipped FileDescriptorProto
+	// 207 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x2d, 0x4a, 0x4c, 0x4e,
 	0x4d, 0x4e, 0x2c, 0x2a, 0x4f, 0x4d, 0xd2, 0xcf, 0x49, 0x2e, 0xc9, 0x4d, 0xcc, 0x4b, 0x4c, 0x4f,
 	0x2d, 0xd2, 0x2f, 0x33, 0xd4, 0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f,
 	0xc9, 0x17, 0x92, 0x40, 0x28, 0xd3, 0x43, 0x28, 0xd3, 0x2b, 0x33, 0x94, 0x12, 0x4c, 0xcc, 0xcd,
 	0xcc, 0xcb, 0xd7, 0x07, 0x93, 0x10, 0xc5, 0x52, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x60, 0xa6, 0x3e,
-	0x88, 0x05, 0x11, 0x55, 0x32, 0xe4, 0x62, 0x0b, 0x00, 0x1b, 0x69, 0xa5, 0xfe, 0x62, 0x81, 0x3c,
-	0x63, 0xd7, 0xf3, 0x0d, 0x5a, 0x72, 0x48, 0x96, 0x57, 0x20, 0x5b, 0x0f, 0x51, 0xe8, 0x64, 0x71,
-	0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7,
-	0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0x30, 0x9d, 0xba, 0x18, 0x5a, 0x4b,
-	0x2a, 0x0b, 0x52, 0x8b, 0x93, 0xd8, 0xc0, 0x76, 0x1a, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0x96,
-	0xa2, 0x8e, 0xc6, 0xdf, 0x00, 0x00, 0x00,
+	0x88, 0x05, 0x11, 0x55, 0x4a, 0xe6, 0x62, 0x0b, 0x00, 0x1b, 0x29, 0x64, 0xc0, 0x25, 0x92, 0x99,
+	0x92, 0x93, 0x1a, 0x5f, 0x92, 0x99, 0x9b, 0x9a, 0x5f, 0x5a, 0x12, 0x5f, 0x9c, 0x9a, 0x9c, 0x9f,
+	0x97, 0x52, 0x2c, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0x1c, 0x24, 0x04, 0x92, 0x0b, 0x81, 0x48, 0x05,
+	0x43, 0x64, 0xac, 0xd4, 0x5f, 0x2c, 0x90, 0x67, 0xec, 0x7a, 0xbe, 0x41, 0x4b, 0x0e, 0xc9, 0xb9,
+	0x15, 0xc8, 0x0e, 0x86, 0x18, 0xed, 0x64, 0x71, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c,
+	0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72,
+	0x0c, 0x51, 0x30, 0x9d, 0xba, 0x18, 0x5a, 0x4b, 0x2a, 0x0b, 0x52, 0x8b, 0x93, 0xd8, 0xc0, 0xae,
+	0x34, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0x5b, 0x4c, 0x18, 0xea, 0x11, 0x01, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -103,6 +115,9 @@ func (this *Params) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
+	if this.IdleTimeoutSeconds != that1.IdleTimeoutSeconds {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -125,6 +140,11 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.IdleTimeoutSeconds != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.IdleTimeoutSeconds))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -145,6 +165,9 @@ func (m *Params) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if m.IdleTimeoutSeconds != 0 {
+		n += 1 + sovParams(uint64(m.IdleTimeoutSeconds))
+	}
 	return n
 }
 
@@ -183,6 +206,25 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IdleTimeoutSeconds", wireType)
+			}
+			m.IdleTimeoutSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IdleTimeoutSeconds |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -6,7 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
-	_ "github.com/cosmos/cosmos-sdk/types/query"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
@@ -410,676 +410,4137 @@ func (m *QueryValidateLctAccessResponse) GetAccessLevel() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "racecarweb.lctmanager.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "racecarweb.lctmanager.v1.QueryParamsResponse")
-	proto.RegisterType((*QueryGetLctRequest)(nil), "racecarweb.lctmanager.v1.QueryGetLctRequest")
-	proto.RegisterType((*QueryGetLctResponse)(nil), "racecarweb.lctmanager.v1.QueryGetLctResponse")
-	proto.RegisterType((*QueryGetComponentRelationshipsRequest)(nil), "racecarweb.lctmanager.v1.QueryGetComponentRelationshipsRequest")
-	proto.RegisterType((*QueryGetComponentRelationshipsResponse)(nil), "racecarweb.lctmanager.v1.QueryGetComponentRelationshipsResponse")
-	proto.RegisterType((*QueryValidateLctAccessRequest)(nil), "racecarweb.lctmanager.v1.QueryValidateLctAccessRequest")
-	proto.RegisterType((*QueryValidateLctAccessResponse)(nil), "racecarweb.lctmanager.v1.QueryValidateLctAccessResponse")
+// QueryGetLctBetweenRequest defines the QueryGetLctBetweenRequest message.
+type QueryGetLctBetweenRequest struct {
+	ComponentA         string `protobuf:"bytes,1,opt,name=component_a,json=componentA,proto3" json:"component_a,omitempty"`
+	ComponentB         string `protobuf:"bytes,2,opt,name=component_b,json=componentB,proto3" json:"component_b,omitempty"`
+	OperationalContext string `protobuf:"bytes,3,opt,name=operational_context,json=operationalContext,proto3" json:"operational_context,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("racecarweb/lctmanager/v1/query.proto", fileDescriptor_667fcbfb88eed80d)
+func (m *QueryGetLctBetweenRequest) Reset()         { *m = QueryGetLctBetweenRequest{} }
+func (m *QueryGetLctBetweenRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetLctBetweenRequest) ProtoMessage()    {}
+func (*QueryGetLctBetweenRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_667fcbfb88eed80d, []int{8}
 }
-
-var fileDescriptor_667fcbfb88eed80d = []byte{
-	// 662 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x41, 0x4f, 0x13, 0x4f,
-	0x14, 0xef, 0xf2, 0x0f, 0x1b, 0x3a, 0xfc, 0x2f, 0x8c, 0xa8, 0x58, 0x65, 0x85, 0x55, 0x0c, 0x82,
-	0x74, 0x2c, 0x1c, 0xe0, 0xa6, 0xd2, 0x28, 0x62, 0xd0, 0xe8, 0xc6, 0x98, 0xc8, 0x65, 0x33, 0x9d,
-	0x9d, 0x2c, 0x1b, 0xb6, 0x33, 0xcb, 0xce, 0x50, 0x21, 0x04, 0x63, 0xfc, 0x04, 0x24, 0xde, 0xfc,
-	0x04, 0x1e, 0xfd, 0x18, 0x1c, 0x49, 0x4c, 0x8c, 0x27, 0x63, 0xc0, 0xc4, 0x93, 0xdf, 0xc1, 0xec,
-	0xcc, 0x94, 0xb6, 0xc1, 0xed, 0xaa, 0x97, 0x66, 0xfa, 0xde, 0xef, 0xfd, 0xde, 0xef, 0x37, 0xf3,
-	0xde, 0x82, 0xeb, 0x29, 0x26, 0x94, 0xe0, 0xf4, 0x15, 0x6d, 0xa0, 0x98, 0xc8, 0x26, 0x66, 0x38,
-	0xa4, 0x29, 0x6a, 0xd5, 0xd0, 0xd6, 0x36, 0x4d, 0x77, 0xab, 0x49, 0xca, 0x25, 0x87, 0x63, 0x1d,
-	0x54, 0xb5, 0x83, 0xaa, 0xb6, 0x6a, 0x95, 0x11, 0xdc, 0x8c, 0x18, 0x47, 0xea, 0x57, 0x83, 0x2b,
-	0x33, 0x84, 0x8b, 0x26, 0x17, 0xa8, 0x81, 0x05, 0xd5, 0x2c, 0xa8, 0x55, 0x6b, 0x50, 0x89, 0x6b,
-	0x28, 0xc1, 0x61, 0xc4, 0xb0, 0x8c, 0x38, 0x33, 0xd8, 0xd1, 0x90, 0x87, 0x5c, 0x1d, 0x51, 0x76,
-	0x32, 0xd1, 0x2b, 0x21, 0xe7, 0x61, 0x4c, 0x11, 0x4e, 0x22, 0x84, 0x19, 0xe3, 0x52, 0x95, 0x08,
-	0x93, 0x9d, 0xca, 0x95, 0x9c, 0xe0, 0x14, 0x37, 0x0d, 0xcc, 0x1d, 0x05, 0xf0, 0x59, 0xd6, 0xfc,
-	0xa9, 0x0a, 0x7a, 0x74, 0x6b, 0x9b, 0x0a, 0xe9, 0xae, 0x83, 0x73, 0x3d, 0x51, 0x91, 0x70, 0x26,
-	0x28, 0xac, 0x03, 0x5b, 0x17, 0x8f, 0x59, 0x13, 0xd6, 0xf4, 0xf0, 0xfc, 0x44, 0x35, 0xcf, 0x71,
-	0x55, 0x57, 0x2e, 0x97, 0x0f, 0xbf, 0x5e, 0x2d, 0x7d, 0xf8, 0xf1, 0x71, 0xc6, 0xf2, 0x4c, 0xa9,
-	0x3b, 0x6b, 0x3a, 0xae, 0x50, 0xb9, 0x46, 0xa4, 0xe9, 0x08, 0xcf, 0x03, 0x3b, 0x26, 0xd2, 0x8f,
-	0x02, 0x45, 0x5d, 0xf6, 0x06, 0x63, 0x22, 0x57, 0x03, 0x77, 0xc5, 0x08, 0x69, 0x83, 0x8d, 0x90,
-	0xdb, 0x60, 0x34, 0x8e, 0xd8, 0x26, 0x0d, 0x7c, 0xc2, 0x99, 0xa4, 0x3b, 0xd2, 0x97, 0x7c, 0x93,
-	0x32, 0x53, 0x0b, 0x75, 0xae, 0xae, 0x53, 0xcf, 0xb3, 0x8c, 0xfb, 0x08, 0x4c, 0xb5, 0x89, 0xea,
-	0xbc, 0x99, 0x70, 0x46, 0x99, 0xf4, 0x68, 0xac, 0xaf, 0x6c, 0x23, 0x4a, 0xda, 0xd6, 0xe1, 0x24,
-	0xf8, 0x9f, 0xb4, 0x01, 0x1d, 0x39, 0xc3, 0xa7, 0xb1, 0xd5, 0xc0, 0x7d, 0x0d, 0x6e, 0x14, 0x71,
-	0x19, 0x9d, 0x8b, 0xe0, 0x62, 0x87, 0x2c, 0xed, 0x86, 0x18, 0xde, 0x0b, 0xe4, 0xb7, 0x04, 0xf0,
-	0x32, 0x28, 0x67, 0xd7, 0x41, 0xf8, 0x36, 0x93, 0x63, 0x03, 0x13, 0xd6, 0xf4, 0x7f, 0xde, 0x50,
-	0x4c, 0x64, 0x3d, 0xfb, 0xef, 0xbe, 0x04, 0xe3, 0xaa, 0xff, 0x0b, 0x1c, 0x47, 0x01, 0x96, 0x74,
-	0x8d, 0xc8, 0x7b, 0x84, 0x50, 0x21, 0xfa, 0x5f, 0x66, 0x66, 0x2d, 0xd5, 0x08, 0x9e, 0x66, 0xc9,
-	0x01, 0x6d, 0xed, 0x34, 0xb6, 0x1a, 0xb8, 0x0d, 0xe0, 0xe4, 0x51, 0x1b, 0x4b, 0xe3, 0x00, 0x6c,
-	0x60, 0xe1, 0x63, 0x15, 0x55, 0xfc, 0x43, 0x5e, 0x79, 0x03, 0x0b, 0x0d, 0xcb, 0x7a, 0xe8, 0x94,
-	0x1f, 0xd3, 0x16, 0x8d, 0xdb, 0x3d, 0x74, 0x6c, 0x2d, 0x0b, 0xcd, 0xbf, 0xb1, 0xc1, 0xa0, 0x6a,
-	0x02, 0x0f, 0x2c, 0x60, 0xeb, 0x41, 0x81, 0xb7, 0xf2, 0x47, 0xe9, 0xec, 0x7c, 0x56, 0xe6, 0xfe,
-	0x10, 0xad, 0x35, 0xbb, 0x37, 0xdf, 0x7e, 0xfa, 0xfe, 0x6e, 0xe0, 0x1a, 0x9c, 0x44, 0xa6, 0x6c,
-	0x2e, 0x6f, 0x2b, 0xe0, 0x7b, 0x0b, 0xd8, 0x7a, 0xd8, 0x0a, 0x25, 0xf5, 0x0c, 0x70, 0xa1, 0xa4,
-	0xde, 0x09, 0x76, 0x17, 0x94, 0xa4, 0x39, 0x38, 0xdb, 0x47, 0x52, 0x48, 0xa5, 0x1f, 0x13, 0x89,
-	0xf6, 0xf4, 0x63, 0xee, 0xc3, 0x9f, 0x16, 0xb8, 0x94, 0x3b, 0x74, 0xf0, 0x4e, 0xb1, 0x82, 0xbe,
-	0xa3, 0x5f, 0xb9, 0xfb, 0xef, 0x04, 0xc6, 0xd5, 0x63, 0xe5, 0x6a, 0x05, 0xde, 0x2f, 0x70, 0x95,
-	0xb3, 0x14, 0x68, 0xaf, 0x7b, 0xf5, 0xf6, 0xe1, 0x67, 0x0b, 0x8c, 0x9c, 0x99, 0x44, 0xb8, 0x58,
-	0x20, 0x33, 0x6f, 0x2d, 0x2a, 0x4b, 0x7f, 0x5f, 0x68, 0x7c, 0x3d, 0x51, 0xbe, 0x1e, 0xc2, 0x07,
-	0x7d, 0x7c, 0xb5, 0x4c, 0x75, 0xf6, 0x64, 0x66, 0x3d, 0x4e, 0x5f, 0x0e, 0xed, 0x75, 0x2f, 0xde,
-	0xfe, 0xf2, 0xd2, 0xe1, 0xb1, 0x63, 0x1d, 0x1d, 0x3b, 0xd6, 0xb7, 0x63, 0xc7, 0x3a, 0x38, 0x71,
-	0x4a, 0x47, 0x27, 0x4e, 0xe9, 0xcb, 0x89, 0x53, 0x5a, 0x77, 0xba, 0x1b, 0xec, 0x74, 0xb7, 0x90,
-	0xbb, 0x09, 0x15, 0x0d, 0x5b, 0x7d, 0xb6, 0x17, 0x7e, 0x05, 0x00, 0x00, 0xff, 0xff, 0xbd, 0xe1,
-	0x4c, 0xb2, 0x92, 0x06, 0x00, 0x00,
+func (m *QueryGetLctBetweenRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Parameters queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// GetLct Queries a list of GetLct items.
-	GetLct(ctx context.Context, in *QueryGetLctRequest, opts ...grpc.CallOption) (*QueryGetLctResponse, error)
-	// GetComponentRelationships Queries a list of GetComponentRelationships items.
-	GetComponentRelationships(ctx context.Context, in *QueryGetComponentRelationshipsRequest, opts ...grpc.CallOption) (*QueryGetComponentRelationshipsResponse, error)
-	// ValidateLctAccess Queries a list of ValidateLctAccess items.
-	ValidateLctAccess(ctx context.Context, in *QueryValidateLctAccessRequest, opts ...grpc.CallOption) (*QueryValidateLctAccessResponse, error)
+func (m *QueryGetLctBetweenRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetLctBetweenRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryGetLctBetweenRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetLctBetweenRequest.Merge(m, src)
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryGetLctBetweenRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/racecarweb.lctmanager.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryGetLctBetweenRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetLctBetweenRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) GetLct(ctx context.Context, in *QueryGetLctRequest, opts ...grpc.CallOption) (*QueryGetLctResponse, error) {
-	out := new(QueryGetLctResponse)
-	err := c.cc.Invoke(ctx, "/racecarweb.lctmanager.v1.Query/GetLct", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetLctBetweenRequest proto.InternalMessageInfo
+
+func (m *QueryGetLctBetweenRequest) GetComponentA() string {
+	if m != nil {
+		return m.ComponentA
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) GetComponentRelationships(ctx context.Context, in *QueryGetComponentRelationshipsRequest, opts ...grpc.CallOption) (*QueryGetComponentRelationshipsResponse, error) {
-	out := new(QueryGetComponentRelationshipsResponse)
-	err := c.cc.Invoke(ctx, "/racecarweb.lctmanager.v1.Query/GetComponentRelationships", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryGetLctBetweenRequest) GetComponentB() string {
+	if m != nil {
+		return m.ComponentB
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) ValidateLctAccess(ctx context.Context, in *QueryValidateLctAccessRequest, opts ...grpc.CallOption) (*QueryValidateLctAccessResponse, error) {
-	out := new(QueryValidateLctAccessResponse)
-	err := c.cc.Invoke(ctx, "/racecarweb.lctmanager.v1.Query/ValidateLctAccess", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryGetLctBetweenRequest) GetOperationalContext() string {
+	if m != nil {
+		return m.OperationalContext
 	}
-	return out, nil
+	return ""
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Parameters queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// GetLct Queries a list of GetLct items.
-	GetLct(context.Context, *QueryGetLctRequest) (*QueryGetLctResponse, error)
-	// GetComponentRelationships Queries a list of GetComponentRelationships items.
-	GetComponentRelationships(context.Context, *QueryGetComponentRelationshipsRequest) (*QueryGetComponentRelationshipsResponse, error)
-	// ValidateLctAccess Queries a list of ValidateLctAccess items.
-	ValidateLctAccess(context.Context, *QueryValidateLctAccessRequest) (*QueryValidateLctAccessResponse, error)
+// QueryGetLctBetweenResponse defines the QueryGetLctBetweenResponse message.
+type QueryGetLctBetweenResponse struct {
+	LinkedContextToken string `protobuf:"bytes,1,opt,name=linked_context_token,json=linkedContextToken,proto3" json:"linked_context_token,omitempty"`
+	Found              bool   `protobuf:"varint,2,opt,name=found,proto3" json:"found,omitempty"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryGetLctBetweenResponse) Reset()         { *m = QueryGetLctBetweenResponse{} }
+func (m *QueryGetLctBetweenResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetLctBetweenResponse) ProtoMessage()    {}
+func (*QueryGetLctBetweenResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_667fcbfb88eed80d, []int{9}
 }
-
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryGetLctBetweenResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) GetLct(ctx context.Context, req *QueryGetLctRequest) (*QueryGetLctResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetLct not implemented")
+func (m *QueryGetLctBetweenResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetLctBetweenResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) GetComponentRelationships(ctx context.Context, req *QueryGetComponentRelationshipsRequest) (*QueryGetComponentRelationshipsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetComponentRelationships not implemented")
+func (m *QueryGetLctBetweenResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetLctBetweenResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) ValidateLctAccess(ctx context.Context, req *QueryValidateLctAccessRequest) (*QueryValidateLctAccessResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ValidateLctAccess not implemented")
+func (m *QueryGetLctBetweenResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryGetLctBetweenResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetLctBetweenResponse.DiscardUnknown(m)
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/racecarweb.lctmanager.v1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+var xxx_messageInfo_QueryGetLctBetweenResponse proto.InternalMessageInfo
+
+func (m *QueryGetLctBetweenResponse) GetLinkedContextToken() string {
+	if m != nil {
+		return m.LinkedContextToken
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_GetLct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetLctRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetLct(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/racecarweb.lctmanager.v1.Query/GetLct",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetLct(ctx, req.(*QueryGetLctRequest))
+func (m *QueryGetLctBetweenResponse) GetFound() bool {
+	if m != nil {
+		return m.Found
 	}
-	return interceptor(ctx, in, info, handler)
+	return false
 }
 
-func _Query_GetComponentRelationships_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetComponentRelationshipsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetComponentRelationships(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/racecarweb.lctmanager.v1.Query/GetComponentRelationships",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetComponentRelationships(ctx, req.(*QueryGetComponentRelationshipsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryListLctsRequest defines the QueryListLctsRequest message.
+type QueryListLctsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// component_id matches LCTs with the component on either side
+	ComponentId string `protobuf:"bytes,2,opt,name=component_id,json=componentId,proto3" json:"component_id,omitempty"`
+	// status matches the LCT's pairing status, e.g. "active"
+	Status string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	// operational_context is normalized the way LCTs store it before matching
+	OperationalContext string `protobuf:"bytes,4,opt,name=operational_context,json=operationalContext,proto3" json:"operational_context,omitempty"`
 }
 
-func _Query_ValidateLctAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryValidateLctAccessRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ValidateLctAccess(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/racecarweb.lctmanager.v1.Query/ValidateLctAccess",
+func (m *QueryListLctsRequest) Reset()         { *m = QueryListLctsRequest{} }
+func (m *QueryListLctsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryListLctsRequest) ProtoMessage()    {}
+func (*QueryListLctsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_667fcbfb88eed80d, []int{10}
+}
+func (m *QueryListLctsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryListLctsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryListLctsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ValidateLctAccess(ctx, req.(*QueryValidateLctAccessRequest))
+}
+func (m *QueryListLctsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListLctsRequest.Merge(m, src)
+}
+func (m *QueryListLctsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryListLctsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListLctsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryListLctsRequest proto.InternalMessageInfo
+
+func (m *QueryListLctsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var Query_serviceDesc = _Query_serviceDesc
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "racecarweb.lctmanager.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "GetLct",
-			Handler:    _Query_GetLct_Handler,
-		},
-		{
-			MethodName: "GetComponentRelationships",
-			Handler:    _Query_GetComponentRelationships_Handler,
-		},
-		{
-			MethodName: "ValidateLctAccess",
-			Handler:    _Query_ValidateLctAccess_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "racecarweb/lctmanager/v1/query.proto",
+func (m *QueryListLctsRequest) GetComponentId() string {
+	if m != nil {
+		return m.ComponentId
+	}
+	return ""
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryListLctsRequest) GetStatus() string {
+	if m != nil {
+		return m.Status
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryListLctsRequest) GetOperationalContext() string {
+	if m != nil {
+		return m.OperationalContext
+	}
+	return ""
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// QueryListLctsResponse defines the QueryListLctsResponse message.
+type QueryListLctsResponse struct {
+	Lcts       []LinkedContextToken `protobuf:"bytes,1,rep,name=lcts,proto3" json:"lcts"`
+	Pagination *query.PageResponse  `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryListLctsResponse) Reset()         { *m = QueryListLctsResponse{} }
+func (m *QueryListLctsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryListLctsResponse) ProtoMessage()    {}
+func (*QueryListLctsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_667fcbfb88eed80d, []int{11}
 }
-
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryListLctsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryListLctsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryListLctsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryGetLctRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryListLctsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListLctsResponse.Merge(m, src)
 }
-
-func (m *QueryGetLctRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryListLctsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryListLctsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListLctsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetLctRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.LctId) > 0 {
-		i -= len(m.LctId)
-		copy(dAtA[i:], m.LctId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.LctId)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryListLctsResponse proto.InternalMessageInfo
+
+func (m *QueryListLctsResponse) GetLcts() []LinkedContextToken {
+	if m != nil {
+		return m.Lcts
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryGetLctResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryListLctsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryGetLctResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryGetAuditTrailRequest defines the QueryGetAuditTrailRequest message.
+type QueryGetAuditTrailRequest struct {
+	LctId string `protobuf:"bytes,1,opt,name=lct_id,json=lctId,proto3" json:"lct_id,omitempty"`
 }
 
-func (m *QueryGetLctResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.LinkedContextToken) > 0 {
-		i -= len(m.LinkedContextToken)
-		copy(dAtA[i:], m.LinkedContextToken)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.LinkedContextToken)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryGetAuditTrailRequest) Reset()         { *m = QueryGetAuditTrailRequest{} }
+func (m *QueryGetAuditTrailRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetAuditTrailRequest) ProtoMessage()    {}
+func (*QueryGetAuditTrailRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_667fcbfb88eed80d, []int{12}
+}
+func (m *QueryGetAuditTrailRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetAuditTrailRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetAuditTrailRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryGetAuditTrailRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetAuditTrailRequest.Merge(m, src)
+}
+func (m *QueryGetAuditTrailRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetAuditTrailRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetAuditTrailRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetComponentRelationshipsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetAuditTrailRequest proto.InternalMessageInfo
+
+func (m *QueryGetAuditTrailRequest) GetLctId() string {
+	if m != nil {
+		return m.LctId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryGetComponentRelationshipsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryGetAuditTrailResponse defines the QueryGetAuditTrailResponse message.
+type QueryGetAuditTrailResponse struct {
+	Entries []LCTAuditEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries"`
 }
 
-func (m *QueryGetComponentRelationshipsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ComponentId) > 0 {
-		i -= len(m.ComponentId)
-		copy(dAtA[i:], m.ComponentId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ComponentId)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryGetAuditTrailResponse) Reset()         { *m = QueryGetAuditTrailResponse{} }
+func (m *QueryGetAuditTrailResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetAuditTrailResponse) ProtoMessage()    {}
+func (*QueryGetAuditTrailResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_667fcbfb88eed80d, []int{13}
+}
+func (m *QueryGetAuditTrailResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetAuditTrailResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetAuditTrailResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryGetAuditTrailResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetAuditTrailResponse.Merge(m, src)
+}
+func (m *QueryGetAuditTrailResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetAuditTrailResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetAuditTrailResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetComponentRelationshipsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetAuditTrailResponse proto.InternalMessageInfo
+
+func (m *QueryGetAuditTrailResponse) GetEntries() []LCTAuditEntry {
+	if m != nil {
+		return m.Entries
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryGetComponentRelationshipsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryGetLctsByProxyRequest defines the QueryGetLctsByProxyRequest message.
+type QueryGetLctsByProxyRequest struct {
+	ProxyId    string             `protobuf:"bytes,1,opt,name=proxy_id,json=proxyId,proto3" json:"proxy_id,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryGetComponentRelationshipsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.LctCount != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.LctCount))
-		i--
-		dAtA[i] = 0x10
+func (m *QueryGetLctsByProxyRequest) Reset()         { *m = QueryGetLctsByProxyRequest{} }
+func (m *QueryGetLctsByProxyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetLctsByProxyRequest) ProtoMessage()    {}
+func (*QueryGetLctsByProxyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_667fcbfb88eed80d, []int{14}
+}
+func (m *QueryGetLctsByProxyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetLctsByProxyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetLctsByProxyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.ComponentRelationships) > 0 {
-		i -= len(m.ComponentRelationships)
-		copy(dAtA[i:], m.ComponentRelationships)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ComponentRelationships)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *QueryGetLctsByProxyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetLctsByProxyRequest.Merge(m, src)
+}
+func (m *QueryGetLctsByProxyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetLctsByProxyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetLctsByProxyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetLctsByProxyRequest proto.InternalMessageInfo
+
+func (m *QueryGetLctsByProxyRequest) GetProxyId() string {
+	if m != nil {
+		return m.ProxyId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryValidateLctAccessRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryGetLctsByProxyRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryValidateLctAccessRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryGetLctsByProxyResponse defines the QueryGetLctsByProxyResponse message.
+type QueryGetLctsByProxyResponse struct {
+	Lcts       []LinkedContextToken `protobuf:"bytes,1,rep,name=lcts,proto3" json:"lcts"`
+	Pagination *query.PageResponse  `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryValidateLctAccessRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.RequestorId) > 0 {
-		i -= len(m.RequestorId)
-		copy(dAtA[i:], m.RequestorId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.RequestorId)))
-		i--
-		dAtA[i] = 0x12
+func (m *QueryGetLctsByProxyResponse) Reset()         { *m = QueryGetLctsByProxyResponse{} }
+func (m *QueryGetLctsByProxyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetLctsByProxyResponse) ProtoMessage()    {}
+func (*QueryGetLctsByProxyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_667fcbfb88eed80d, []int{15}
+}
+func (m *QueryGetLctsByProxyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetLctsByProxyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetLctsByProxyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.LctId) > 0 {
-		i -= len(m.LctId)
-		copy(dAtA[i:], m.LctId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.LctId)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *QueryGetLctsByProxyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetLctsByProxyResponse.Merge(m, src)
+}
+func (m *QueryGetLctsByProxyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetLctsByProxyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetLctsByProxyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetLctsByProxyResponse proto.InternalMessageInfo
+
+func (m *QueryGetLctsByProxyResponse) GetLcts() []LinkedContextToken {
+	if m != nil {
+		return m.Lcts
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryValidateLctAccessResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryGetLctsByProxyResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryValidateLctAccessResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryGetKeyExchangeRequest defines the QueryGetKeyExchangeRequest message.
+type QueryGetKeyExchangeRequest struct {
+	LctId string `protobuf:"bytes,1,opt,name=lct_id,json=lctId,proto3" json:"lct_id,omitempty"`
 }
 
-func (m *QueryValidateLctAccessResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.AccessLevel) > 0 {
-		i -= len(m.AccessLevel)
-		copy(dAtA[i:], m.AccessLevel)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.AccessLevel)))
-		i--
-		dAtA[i] = 0x12
+func (m *QueryGetKeyExchangeRequest) Reset()         { *m = QueryGetKeyExchangeRequest{} }
+func (m *QueryGetKeyExchangeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetKeyExchangeRequest) ProtoMessage()    {}
+func (*QueryGetKeyExchangeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_667fcbfb88eed80d, []int{16}
+}
+func (m *QueryGetKeyExchangeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetKeyExchangeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetKeyExchangeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.HasAccess {
-		i--
-		if m.HasAccess {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+}
+func (m *QueryGetKeyExchangeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetKeyExchangeRequest.Merge(m, src)
+}
+func (m *QueryGetKeyExchangeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetKeyExchangeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetKeyExchangeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetKeyExchangeRequest proto.InternalMessageInfo
+
+func (m *QueryGetKeyExchangeRequest) GetLctId() string {
+	if m != nil {
+		return m.LctId
+	}
+	return ""
+}
+
+// QueryGetKeyExchangeResponse reports where an LCT's key exchange stands. It
+// carries no key material, not even the hashed key reference.
+type QueryGetKeyExchangeResponse struct {
+	LctId            string `protobuf:"bytes,1,opt,name=lct_id,json=lctId,proto3" json:"lct_id,omitempty"`
+	Status           string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	LctStatus        string `protobuf:"bytes,3,opt,name=lct_status,json=lctStatus,proto3" json:"lct_status,omitempty"`
+	CreatedAt        int64  `protobuf:"varint,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	LastUsed         int64  `protobuf:"varint,5,opt,name=last_used,json=lastUsed,proto3" json:"last_used,omitempty"`
+	ComponentAId     string `protobuf:"bytes,6,opt,name=component_a_id,json=componentAId,proto3" json:"component_a_id,omitempty"`
+	ComponentBId     string `protobuf:"bytes,7,opt,name=component_b_id,json=componentBId,proto3" json:"component_b_id,omitempty"`
+	ProxyComponentId string `protobuf:"bytes,8,opt,name=proxy_component_id,json=proxyComponentId,proto3" json:"proxy_component_id,omitempty"`
+}
+
+func (m *QueryGetKeyExchangeResponse) Reset()         { *m = QueryGetKeyExchangeResponse{} }
+func (m *QueryGetKeyExchangeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetKeyExchangeResponse) ProtoMessage()    {}
+func (*QueryGetKeyExchangeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_667fcbfb88eed80d, []int{17}
+}
+func (m *QueryGetKeyExchangeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetKeyExchangeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetKeyExchangeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryGetKeyExchangeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetKeyExchangeResponse.Merge(m, src)
+}
+func (m *QueryGetKeyExchangeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetKeyExchangeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetKeyExchangeResponse.DiscardUnknown(m)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_QueryGetKeyExchangeResponse proto.InternalMessageInfo
+
+func (m *QueryGetKeyExchangeResponse) GetLctId() string {
+	if m != nil {
+		return m.LctId
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ""
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *QueryGetKeyExchangeResponse) GetStatus() string {
+	if m != nil {
+		return m.Status
 	}
-	var l int
-	_ = l
-	return n
+	return ""
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetKeyExchangeResponse) GetLctStatus() string {
+	if m != nil {
+		return m.LctStatus
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return ""
 }
 
-func (m *QueryGetLctRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetKeyExchangeResponse) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
 	}
-	var l int
-	_ = l
-	l = len(m.LctId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return 0
+}
+
+func (m *QueryGetKeyExchangeResponse) GetLastUsed() int64 {
+	if m != nil {
+		return m.LastUsed
 	}
-	return n
+	return 0
 }
 
-func (m *QueryGetLctResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetKeyExchangeResponse) GetComponentAId() string {
+	if m != nil {
+		return m.ComponentAId
 	}
-	var l int
-	_ = l
-	l = len(m.LinkedContextToken)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return ""
+}
+
+func (m *QueryGetKeyExchangeResponse) GetComponentBId() string {
+	if m != nil {
+		return m.ComponentBId
 	}
-	return n
+	return ""
 }
 
-func (m *QueryGetComponentRelationshipsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetKeyExchangeResponse) GetProxyComponentId() string {
+	if m != nil {
+		return m.ProxyComponentId
 	}
-	var l int
-	_ = l
-	l = len(m.ComponentId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return ""
+}
+
+// QueryGetSplitKeyStatusRequest defines the QueryGetSplitKeyStatusRequest message.
+type QueryGetSplitKeyStatusRequest struct {
+	LctId string `protobuf:"bytes,1,opt,name=lct_id,json=lctId,proto3" json:"lct_id,omitempty"`
+}
+
+func (m *QueryGetSplitKeyStatusRequest) Reset()         { *m = QueryGetSplitKeyStatusRequest{} }
+func (m *QueryGetSplitKeyStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetSplitKeyStatusRequest) ProtoMessage()    {}
+func (*QueryGetSplitKeyStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_667fcbfb88eed80d, []int{18}
+}
+func (m *QueryGetSplitKeyStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetSplitKeyStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetSplitKeyStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryGetSplitKeyStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetSplitKeyStatusRequest.Merge(m, src)
+}
+func (m *QueryGetSplitKeyStatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetSplitKeyStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetSplitKeyStatusRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetComponentRelationshipsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryGetSplitKeyStatusRequest proto.InternalMessageInfo
+
+func (m *QueryGetSplitKeyStatusRequest) GetLctId() string {
+	if m != nil {
+		return m.LctId
 	}
-	var l int
-	_ = l
-	l = len(m.ComponentRelationships)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return ""
+}
+
+// QueryGetSplitKeyStatusResponse reports an LCT's current split key and the
+// keys it superseded. Keys are identified by their key reference only.
+type QueryGetSplitKeyStatusResponse struct {
+	LctId        string                `protobuf:"bytes,1,opt,name=lct_id,json=lctId,proto3" json:"lct_id,omitempty"`
+	Status       string                `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	KeyReference string                `protobuf:"bytes,3,opt,name=key_reference,json=keyReference,proto3" json:"key_reference,omitempty"`
+	Version      uint64                `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"`
+	CreatedAt    int64                 `protobuf:"varint,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ActivatedAt  int64                 `protobuf:"varint,6,opt,name=activated_at,json=activatedAt,proto3" json:"activated_at,omitempty"`
+	RevokedAt    int64                 `protobuf:"varint,7,opt,name=revoked_at,json=revokedAt,proto3" json:"revoked_at,omitempty"`
+	Superseded   []*SupersededSplitKey `protobuf:"bytes,8,rep,name=superseded,proto3" json:"superseded,omitempty"`
+}
+
+func (m *QueryGetSplitKeyStatusResponse) Reset()         { *m = QueryGetSplitKeyStatusResponse{} }
+func (m *QueryGetSplitKeyStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetSplitKeyStatusResponse) ProtoMessage()    {}
+func (*QueryGetSplitKeyStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_667fcbfb88eed80d, []int{19}
+}
+func (m *QueryGetSplitKeyStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetSplitKeyStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetSplitKeyStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.LctCount != 0 {
-		n += 1 + sovQuery(uint64(m.LctCount))
+}
+func (m *QueryGetSplitKeyStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetSplitKeyStatusResponse.Merge(m, src)
+}
+func (m *QueryGetSplitKeyStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetSplitKeyStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetSplitKeyStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetSplitKeyStatusResponse proto.InternalMessageInfo
+
+func (m *QueryGetSplitKeyStatusResponse) GetLctId() string {
+	if m != nil {
+		return m.LctId
 	}
-	return n
+	return ""
 }
 
-func (m *QueryValidateLctAccessRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetSplitKeyStatusResponse) GetStatus() string {
+	if m != nil {
+		return m.Status
 	}
-	var l int
-	_ = l
-	l = len(m.LctId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return ""
+}
+
+func (m *QueryGetSplitKeyStatusResponse) GetKeyReference() string {
+	if m != nil {
+		return m.KeyReference
 	}
-	l = len(m.RequestorId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return ""
+}
+
+func (m *QueryGetSplitKeyStatusResponse) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
 	}
-	return n
+	return 0
 }
 
-func (m *QueryValidateLctAccessResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetSplitKeyStatusResponse) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
 	}
-	var l int
-	_ = l
-	if m.HasAccess {
-		n += 2
+	return 0
+}
+
+func (m *QueryGetSplitKeyStatusResponse) GetActivatedAt() int64 {
+	if m != nil {
+		return m.ActivatedAt
 	}
-	l = len(m.AccessLevel)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return 0
+}
+
+func (m *QueryGetSplitKeyStatusResponse) GetRevokedAt() int64 {
+	if m != nil {
+		return m.RevokedAt
 	}
-	return n
+	return 0
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *QueryGetSplitKeyStatusResponse) GetSuperseded() []*SupersededSplitKey {
+	if m != nil {
+		return m.Superseded
+	}
+	return nil
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+// SupersededSplitKey is a split key that was rotated out
+type SupersededSplitKey struct {
+	KeyReference string `protobuf:"bytes,1,opt,name=key_reference,json=keyReference,proto3" json:"key_reference,omitempty"`
+	Version      uint64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	CreatedAt    int64  `protobuf:"varint,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	SupersededAt int64  `protobuf:"varint,4,opt,name=superseded_at,json=supersededAt,proto3" json:"superseded_at,omitempty"`
+	SupersededBy string `protobuf:"bytes,5,opt,name=superseded_by,json=supersededBy,proto3" json:"superseded_by,omitempty"`
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+
+func (m *SupersededSplitKey) Reset()         { *m = SupersededSplitKey{} }
+func (m *SupersededSplitKey) String() string { return proto.CompactTextString(m) }
+func (*SupersededSplitKey) ProtoMessage()    {}
+func (*SupersededSplitKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_667fcbfb88eed80d, []int{20}
+}
+func (m *SupersededSplitKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SupersededSplitKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SupersededSplitKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SupersededSplitKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SupersededSplitKey.Merge(m, src)
+}
+func (m *SupersededSplitKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *SupersededSplitKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_SupersededSplitKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SupersededSplitKey proto.InternalMessageInfo
+
+func (m *SupersededSplitKey) GetKeyReference() string {
+	if m != nil {
+		return m.KeyReference
+	}
+	return ""
+}
+
+func (m *SupersededSplitKey) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *SupersededSplitKey) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+func (m *SupersededSplitKey) GetSupersededAt() int64 {
+	if m != nil {
+		return m.SupersededAt
+	}
+	return 0
+}
+
+func (m *SupersededSplitKey) GetSupersededBy() string {
+	if m != nil {
+		return m.SupersededBy
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "racecarweb.lctmanager.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "racecarweb.lctmanager.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryGetLctRequest)(nil), "racecarweb.lctmanager.v1.QueryGetLctRequest")
+	proto.RegisterType((*QueryGetLctResponse)(nil), "racecarweb.lctmanager.v1.QueryGetLctResponse")
+	proto.RegisterType((*QueryGetComponentRelationshipsRequest)(nil), "racecarweb.lctmanager.v1.QueryGetComponentRelationshipsRequest")
+	proto.RegisterType((*QueryGetComponentRelationshipsResponse)(nil), "racecarweb.lctmanager.v1.QueryGetComponentRelationshipsResponse")
+	proto.RegisterType((*QueryValidateLctAccessRequest)(nil), "racecarweb.lctmanager.v1.QueryValidateLctAccessRequest")
+	proto.RegisterType((*QueryValidateLctAccessResponse)(nil), "racecarweb.lctmanager.v1.QueryValidateLctAccessResponse")
+	proto.RegisterType((*QueryGetLctBetweenRequest)(nil), "racecarweb.lctmanager.v1.QueryGetLctBetweenRequest")
+	proto.RegisterType((*QueryGetLctBetweenResponse)(nil), "racecarweb.lctmanager.v1.QueryGetLctBetweenResponse")
+	proto.RegisterType((*QueryListLctsRequest)(nil), "racecarweb.lctmanager.v1.QueryListLctsRequest")
+	proto.RegisterType((*QueryListLctsResponse)(nil), "racecarweb.lctmanager.v1.QueryListLctsResponse")
+	proto.RegisterType((*QueryGetAuditTrailRequest)(nil), "racecarweb.lctmanager.v1.QueryGetAuditTrailRequest")
+	proto.RegisterType((*QueryGetAuditTrailResponse)(nil), "racecarweb.lctmanager.v1.QueryGetAuditTrailResponse")
+	proto.RegisterType((*QueryGetLctsByProxyRequest)(nil), "racecarweb.lctmanager.v1.QueryGetLctsByProxyRequest")
+	proto.RegisterType((*QueryGetLctsByProxyResponse)(nil), "racecarweb.lctmanager.v1.QueryGetLctsByProxyResponse")
+	proto.RegisterType((*QueryGetKeyExchangeRequest)(nil), "racecarweb.lctmanager.v1.QueryGetKeyExchangeRequest")
+	proto.RegisterType((*QueryGetKeyExchangeResponse)(nil), "racecarweb.lctmanager.v1.QueryGetKeyExchangeResponse")
+	proto.RegisterType((*QueryGetSplitKeyStatusRequest)(nil), "racecarweb.lctmanager.v1.QueryGetSplitKeyStatusRequest")
+	proto.RegisterType((*QueryGetSplitKeyStatusResponse)(nil), "racecarweb.lctmanager.v1.QueryGetSplitKeyStatusResponse")
+	proto.RegisterType((*SupersededSplitKey)(nil), "racecarweb.lctmanager.v1.SupersededSplitKey")
+}
+
+func init() {
+	proto.RegisterFile("racecarweb/lctmanager/v1/query.proto", fileDescriptor_667fcbfb88eed80d)
+}
+
+var fileDescriptor_667fcbfb88eed80d = []byte{
+	// 1419 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcf, 0x6f, 0x1b, 0xc5,
+	0x17, 0xcf, 0x3a, 0x89, 0x93, 0x4c, 0xda, 0xea, 0xdb, 0x69, 0xda, 0x6f, 0xea, 0x52, 0xb7, 0xdd,
+	0xfe, 0xa4, 0x6d, 0xbc, 0x4d, 0x42, 0x69, 0x6f, 0x60, 0x47, 0x6d, 0x08, 0x04, 0x54, 0xb6, 0x05,
+	0x89, 0x5e, 0x56, 0xe3, 0xdd, 0x57, 0x67, 0x95, 0xcd, 0xae, 0xbb, 0x33, 0x76, 0x63, 0x45, 0x01,
+	0x89, 0x3f, 0x00, 0x55, 0x82, 0x13, 0x37, 0x6e, 0x3d, 0x21, 0x24, 0x0e, 0x5c, 0xe8, 0xbd, 0xea,
+	0x01, 0x55, 0x42, 0x42, 0x9c, 0x10, 0x6a, 0x90, 0x38, 0xf1, 0x3f, 0xa0, 0xf9, 0xb1, 0xde, 0x5d,
+	0xdb, 0xeb, 0x75, 0x72, 0xe2, 0x12, 0x79, 0xde, 0xbc, 0xf7, 0xe6, 0xf3, 0x3e, 0xf3, 0xe6, 0xed,
+	0x47, 0x41, 0x17, 0x42, 0x62, 0x83, 0x4d, 0xc2, 0x27, 0x50, 0x37, 0x3c, 0x9b, 0x6d, 0x11, 0x9f,
+	0x34, 0x20, 0x34, 0xda, 0x8b, 0xc6, 0xe3, 0x16, 0x84, 0x9d, 0x4a, 0x33, 0x0c, 0x58, 0x80, 0xe7,
+	0x63, 0xaf, 0x4a, 0xec, 0x55, 0x69, 0x2f, 0x96, 0x8e, 0x92, 0x2d, 0xd7, 0x0f, 0x0c, 0xf1, 0x57,
+	0x3a, 0x97, 0xae, 0xda, 0x01, 0xdd, 0x0a, 0xa8, 0x51, 0x27, 0x14, 0x64, 0x16, 0xa3, 0xbd, 0x58,
+	0x07, 0x46, 0x16, 0x8d, 0x26, 0x69, 0xb8, 0x3e, 0x61, 0x6e, 0xe0, 0x2b, 0xdf, 0xb9, 0x46, 0xd0,
+	0x08, 0xc4, 0x4f, 0x83, 0xff, 0x52, 0xd6, 0x37, 0x1a, 0x41, 0xd0, 0xf0, 0xc0, 0x20, 0x4d, 0xd7,
+	0x20, 0xbe, 0x1f, 0x30, 0x11, 0x42, 0xd5, 0xee, 0x72, 0x26, 0x64, 0xcf, 0xf5, 0x37, 0xc1, 0xb1,
+	0xec, 0xc0, 0x67, 0xb0, 0xcd, 0x2c, 0x16, 0x6c, 0x42, 0x74, 0xd0, 0xc5, 0xcc, 0xa0, 0x26, 0x09,
+	0xc9, 0x96, 0xca, 0xad, 0xcf, 0x21, 0xfc, 0x31, 0x47, 0x7c, 0x4f, 0x18, 0x4d, 0x78, 0xdc, 0x02,
+	0xca, 0xf4, 0x87, 0xe8, 0x58, 0xca, 0x4a, 0x9b, 0x81, 0x4f, 0x01, 0xaf, 0xa0, 0xa2, 0x0c, 0x9e,
+	0xd7, 0xce, 0x6a, 0x57, 0x66, 0x97, 0xce, 0x56, 0xb2, 0x68, 0xaa, 0xc8, 0xc8, 0xda, 0xcc, 0x8b,
+	0x3f, 0xce, 0x8c, 0x3d, 0xfb, 0xfb, 0x87, 0xab, 0x9a, 0xa9, 0x42, 0xf5, 0x6b, 0xea, 0xc4, 0x55,
+	0x60, 0xeb, 0x36, 0x53, 0x27, 0xe2, 0xe3, 0xa8, 0xe8, 0xd9, 0xcc, 0x72, 0x1d, 0x91, 0x7a, 0xc6,
+	0x9c, 0xf4, 0x6c, 0xb6, 0xe6, 0xe8, 0xab, 0x0a, 0x48, 0xe4, 0xac, 0x80, 0xdc, 0x40, 0x73, 0x83,
+	0x4a, 0x57, 0xb1, 0x58, 0xee, 0xad, 0xc8, 0xad, 0x07, 0x7c, 0x47, 0x7f, 0x1f, 0x5d, 0x8c, 0x12,
+	0xad, 0x04, 0x5b, 0xcd, 0xc0, 0x07, 0x9f, 0x99, 0xe0, 0x49, 0x9e, 0x37, 0xdc, 0x66, 0x54, 0x3a,
+	0x3e, 0x87, 0x0e, 0xd9, 0x91, 0x43, 0x0c, 0x67, 0xb6, 0x6b, 0x5b, 0x73, 0xf4, 0xcf, 0xd1, 0xa5,
+	0xbc, 0x5c, 0x0a, 0xe7, 0x2d, 0xf4, 0xff, 0x38, 0x59, 0x98, 0x74, 0x51, 0x79, 0x4f, 0xd8, 0x03,
+	0x13, 0xe0, 0x53, 0x68, 0x86, 0xd3, 0x61, 0x07, 0x2d, 0x9f, 0xcd, 0x17, 0xce, 0x6a, 0x57, 0xc6,
+	0xcd, 0x69, 0xcf, 0x66, 0x2b, 0x7c, 0xad, 0x7f, 0x86, 0x4e, 0x8b, 0xf3, 0x3f, 0x25, 0x9e, 0xeb,
+	0x10, 0x06, 0xeb, 0x36, 0xab, 0xda, 0x36, 0x50, 0x3a, 0x9c, 0x4c, 0x5e, 0x5a, 0x28, 0x3d, 0x82,
+	0x90, 0x6f, 0x16, 0x64, 0x69, 0x5d, 0xdb, 0x9a, 0xa3, 0xd7, 0x51, 0x39, 0x2b, 0xb5, 0x2a, 0xe9,
+	0x34, 0x42, 0x1b, 0x84, 0x5a, 0x44, 0x58, 0x45, 0xfe, 0x69, 0x73, 0x66, 0x83, 0x50, 0xe9, 0xc6,
+	0xcf, 0x90, 0x5b, 0x96, 0x07, 0x6d, 0xf0, 0xa2, 0x33, 0xa4, 0x6d, 0x9d, 0x9b, 0xf4, 0xaf, 0x34,
+	0x74, 0x32, 0x71, 0xa9, 0x35, 0x60, 0x4f, 0x00, 0xfc, 0x08, 0xfb, 0x19, 0x14, 0x73, 0x6d, 0x11,
+	0x55, 0x00, 0xea, 0x9a, 0xaa, 0x69, 0x87, 0xba, 0x3a, 0x20, 0x76, 0xa8, 0x61, 0x03, 0x1d, 0x0b,
+	0x9a, 0x10, 0x0a, 0x36, 0x89, 0x17, 0x75, 0xc8, 0xfc, 0xb8, 0xec, 0x8d, 0xc4, 0x96, 0x6a, 0x10,
+	0xdd, 0x41, 0xa5, 0x41, 0x78, 0x0e, 0xda, 0x6b, 0x78, 0x0e, 0x4d, 0x3e, 0x0a, 0x5a, 0xbe, 0x24,
+	0x78, 0xda, 0x94, 0x0b, 0xfd, 0xa5, 0x86, 0xe6, 0xc4, 0x31, 0xeb, 0x2e, 0xe5, 0xe7, 0x74, 0x6f,
+	0xeb, 0x2e, 0x42, 0xf1, 0x98, 0x50, 0x2f, 0xeb, 0x52, 0x45, 0xce, 0x94, 0x0a, 0x9f, 0x29, 0x15,
+	0x39, 0x99, 0xd4, 0x4c, 0xa9, 0xdc, 0x23, 0x0d, 0x50, 0xb1, 0x66, 0x22, 0xb2, 0xaf, 0x73, 0x0b,
+	0x7d, 0x9d, 0x8b, 0x4f, 0xa0, 0x22, 0x65, 0x84, 0xb5, 0xa8, 0x62, 0x43, 0xad, 0xb2, 0x28, 0x9b,
+	0xc8, 0xa4, 0xec, 0x99, 0x86, 0x8e, 0xf7, 0x14, 0xa3, 0xe8, 0xba, 0x8b, 0x26, 0x3c, 0x9b, 0xf1,
+	0xce, 0x18, 0xbf, 0x32, 0xbb, 0x74, 0x3d, 0x7b, 0x42, 0xac, 0xf7, 0x11, 0x57, 0x9b, 0xe0, 0xd3,
+	0xc2, 0x14, 0xf1, 0x78, 0x35, 0xc5, 0x4a, 0x41, 0xb0, 0x72, 0x39, 0x97, 0x15, 0x09, 0x22, 0x49,
+	0x8b, 0xbe, 0x14, 0x77, 0x5b, 0xb5, 0xe5, 0xb8, 0xec, 0x41, 0x48, 0x5c, 0x2f, 0x67, 0xec, 0x40,
+	0xdc, 0x11, 0xc9, 0x18, 0x55, 0xe2, 0x2a, 0x9a, 0x02, 0x9f, 0x85, 0x2e, 0x44, 0x55, 0x5e, 0x1e,
+	0x52, 0xe5, 0xca, 0x03, 0x91, 0xe1, 0x8e, 0xcf, 0xc2, 0x8e, 0x2a, 0x30, 0x8a, 0xd6, 0xbf, 0x48,
+	0x35, 0x1e, 0xad, 0x75, 0xee, 0x85, 0xc1, 0x76, 0x27, 0xc2, 0x76, 0x12, 0x4d, 0x37, 0xf9, 0x3a,
+	0x46, 0x37, 0x25, 0xd6, 0x6b, 0x4e, 0x4f, 0xcb, 0x14, 0x0e, 0xda, 0x32, 0xfa, 0xf7, 0x1a, 0x3a,
+	0x35, 0x10, 0xc1, 0x7f, 0xf5, 0x32, 0x97, 0x63, 0xc6, 0x3e, 0x80, 0xce, 0x9d, 0x6d, 0x7b, 0x83,
+	0xf8, 0xdd, 0xd2, 0xb2, 0x6e, 0xf3, 0xbb, 0x42, 0x5c, 0x65, 0x2a, 0x4a, 0x55, 0x99, 0x31, 0x2e,
+	0xe3, 0xc7, 0x52, 0x48, 0x3d, 0x96, 0xd3, 0x08, 0x71, 0xf7, 0xd4, 0x43, 0xe2, 0xd3, 0xfa, 0x7e,
+	0x77, 0xdb, 0x0e, 0x81, 0x30, 0x70, 0x2c, 0x22, 0x9f, 0xd0, 0xb8, 0x39, 0xa3, 0x2c, 0x55, 0x26,
+	0x26, 0x3b, 0xa1, 0xcc, 0x6a, 0x51, 0x70, 0xe6, 0x27, 0xd5, 0x64, 0x27, 0x94, 0x7d, 0x42, 0xc1,
+	0xc1, 0x17, 0xd0, 0x91, 0xc4, 0xf0, 0xe3, 0x88, 0x8a, 0x22, 0x7d, 0xfc, 0xb0, 0xab, 0x6b, 0x3d,
+	0x5e, 0x75, 0xee, 0x35, 0xd5, 0xe3, 0x55, 0x5b, 0x73, 0xf0, 0x75, 0x84, 0x65, 0xfb, 0xa4, 0x86,
+	0xc2, 0xb4, 0xf0, 0xfc, 0x9f, 0xd8, 0x59, 0x49, 0x7c, 0xd3, 0xde, 0x56, 0xdf, 0x94, 0x55, 0x60,
+	0xf7, 0x9b, 0x9e, 0xcb, 0x79, 0x92, 0xf5, 0xe4, 0x70, 0xfb, 0xbc, 0xa0, 0xbe, 0x18, 0x03, 0x02,
+	0x0f, 0x46, 0xef, 0x79, 0x74, 0x78, 0x13, 0x3a, 0x56, 0x08, 0x8f, 0x20, 0x04, 0xdf, 0x06, 0xc5,
+	0xf0, 0xa1, 0x4d, 0xe8, 0x98, 0x91, 0x0d, 0xcf, 0xa3, 0xa9, 0x36, 0x84, 0x94, 0x77, 0x13, 0x67,
+	0x78, 0xc2, 0x8c, 0x96, 0x3d, 0xf4, 0x4f, 0xf6, 0xd2, 0x2f, 0xbe, 0x4f, 0xcc, 0x6d, 0x47, 0x0e,
+	0x45, 0xe1, 0x30, 0xdb, 0xb5, 0x55, 0x19, 0xcf, 0x10, 0x42, 0x3b, 0xd8, 0x94, 0x0e, 0x53, 0x32,
+	0x83, 0xb2, 0x54, 0x19, 0x5e, 0x47, 0x88, 0xb6, 0x9a, 0x10, 0x52, 0x70, 0x80, 0xf3, 0x99, 0xf3,
+	0x32, 0xee, 0x77, 0x7d, 0x23, 0x7a, 0xcc, 0x44, 0xbc, 0xfe, 0xb3, 0x86, 0x70, 0xbf, 0x4b, 0x3f,
+	0x09, 0xda, 0x70, 0x12, 0x0a, 0xc3, 0x48, 0x18, 0xef, 0x25, 0xe1, 0x3c, 0x3a, 0x1c, 0x43, 0x88,
+	0xbb, 0xf4, 0x50, 0x6c, 0xec, 0x73, 0xaa, 0x77, 0x04, 0x97, 0x33, 0x49, 0xa7, 0x5a, 0x67, 0xe9,
+	0x97, 0x23, 0x68, 0x52, 0x5c, 0x3f, 0x7e, 0xaa, 0xa1, 0xa2, 0x14, 0x7d, 0x78, 0x08, 0x1b, 0xfd,
+	0x5a, 0xb3, 0xb4, 0x30, 0xa2, 0xb7, 0xec, 0x26, 0xfd, 0xcd, 0x2f, 0x7f, 0xfd, 0xeb, 0xeb, 0xc2,
+	0x79, 0x7c, 0xce, 0x50, 0x61, 0x0b, 0x59, 0x0a, 0x17, 0x7f, 0xab, 0xa1, 0xa2, 0x1c, 0x6c, 0xb9,
+	0x90, 0x52, 0x62, 0x34, 0x17, 0x52, 0x5a, 0x8d, 0xea, 0xcb, 0x02, 0xd2, 0x02, 0xbe, 0x36, 0x04,
+	0x52, 0x03, 0x98, 0xe5, 0xd9, 0xcc, 0xd8, 0x91, 0x4f, 0x61, 0x17, 0xff, 0xa3, 0xa1, 0x93, 0x99,
+	0x02, 0x12, 0xbf, 0x93, 0x8f, 0x60, 0xa8, 0x8c, 0x2d, 0xbd, 0x7b, 0xf0, 0x04, 0xaa, 0xaa, 0x0f,
+	0x45, 0x55, 0xab, 0xf8, 0x4e, 0x4e, 0x55, 0x19, 0x02, 0xd7, 0xd8, 0x49, 0xce, 0x9d, 0x5d, 0xfc,
+	0x9b, 0x86, 0x8e, 0xf6, 0xa9, 0x4a, 0x7c, 0x2b, 0x07, 0x66, 0x96, 0xc4, 0x2d, 0xdd, 0xde, 0x7f,
+	0xa0, 0xaa, 0xeb, 0x23, 0x51, 0xd7, 0x7b, 0xf8, 0xee, 0x90, 0xba, 0xda, 0x2a, 0x9a, 0x5f, 0x99,
+	0x92, 0xba, 0xdd, 0x9b, 0x33, 0x76, 0x92, 0x22, 0x7a, 0x17, 0xbf, 0xd4, 0xd0, 0xe1, 0x94, 0x72,
+	0xc4, 0xcb, 0x23, 0xb5, 0x4f, 0x5a, 0xf7, 0x96, 0xde, 0xda, 0x5f, 0xd0, 0x3e, 0x8a, 0x51, 0xad,
+	0x67, 0xd5, 0x65, 0x6c, 0xf2, 0x62, 0xc8, 0x6e, 0x72, 0x55, 0xdf, 0xc5, 0xdf, 0x68, 0x68, 0x3a,
+	0x92, 0x74, 0xb8, 0x92, 0x03, 0xa9, 0x47, 0xc8, 0x96, 0x8c, 0x91, 0xfd, 0x15, 0xfa, 0xcb, 0x02,
+	0xfd, 0x39, 0x7c, 0x66, 0x08, 0x7a, 0xa1, 0x1f, 0x7e, 0x94, 0x1c, 0xc7, 0x5a, 0x6c, 0x14, 0x8e,
+	0xfb, 0xd4, 0xde, 0x28, 0x1c, 0xf7, 0xcb, 0x3d, 0xfd, 0x96, 0x40, 0xb9, 0x88, 0x8d, 0x21, 0x28,
+	0x09, 0x0f, 0xb3, 0x18, 0x8f, 0x8b, 0x9f, 0xf8, 0x4f, 0x1a, 0x3a, 0x92, 0x16, 0x56, 0x78, 0xb4,
+	0x5b, 0xee, 0x51, 0x82, 0xa5, 0x9b, 0xfb, 0x8c, 0x52, 0xc0, 0x6f, 0x0b, 0xe0, 0x4b, 0xf8, 0xc6,
+	0xb0, 0x51, 0xc9, 0x23, 0x8c, 0x9d, 0x48, 0x68, 0xee, 0x4a, 0xbe, 0x15, 0xf2, 0x84, 0x58, 0x1a,
+	0x05, 0x79, 0xbf, 0x22, 0x1b, 0x05, 0xf9, 0x00, 0x45, 0x36, 0x12, 0x72, 0xfe, 0x7d, 0x04, 0x15,
+	0x18, 0x73, 0xfe, 0x5c, 0x43, 0x47, 0xfb, 0xa4, 0x48, 0xee, 0x98, 0xc9, 0x52, 0x3d, 0xb9, 0x63,
+	0x26, 0x53, 0xf5, 0xe8, 0x37, 0x45, 0x09, 0x06, 0x5e, 0x18, 0x52, 0x02, 0xe5, 0xa1, 0xd6, 0x26,
+	0x74, 0xba, 0xf8, 0x6b, 0xb7, 0x5f, 0xbc, 0x2e, 0x6b, 0xaf, 0x5e, 0x97, 0xb5, 0x3f, 0x5f, 0x97,
+	0xb5, 0xa7, 0x7b, 0xe5, 0xb1, 0x57, 0x7b, 0xe5, 0xb1, 0xdf, 0xf7, 0xca, 0x63, 0x0f, 0xcb, 0xc9,
+	0x3c, 0xdb, 0xc9, 0x4c, 0xac, 0xd3, 0x04, 0x5a, 0x2f, 0x8a, 0x7f, 0xe8, 0x2c, 0xff, 0x1b, 0x00,
+	0x00, 0xff, 0xff, 0xf0, 0xf1, 0x8d, 0x4c, 0xe1, 0x12, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// GetLct Queries a list of GetLct items.
+	GetLct(ctx context.Context, in *QueryGetLctRequest, opts ...grpc.CallOption) (*QueryGetLctResponse, error)
+	// GetComponentRelationships Queries a list of GetComponentRelationships items.
+	GetComponentRelationships(ctx context.Context, in *QueryGetComponentRelationshipsRequest, opts ...grpc.CallOption) (*QueryGetComponentRelationshipsResponse, error)
+	// ValidateLctAccess Queries a list of ValidateLctAccess items.
+	ValidateLctAccess(ctx context.Context, in *QueryValidateLctAccessRequest, opts ...grpc.CallOption) (*QueryValidateLctAccessResponse, error)
+	// GetLctBetween Queries the live LCT linking two components in an operational context.
+	GetLctBetween(ctx context.Context, in *QueryGetLctBetweenRequest, opts ...grpc.CallOption) (*QueryGetLctBetweenResponse, error)
+	// ListLcts Queries a page of linked context tokens.
+	ListLcts(ctx context.Context, in *QueryListLctsRequest, opts ...grpc.CallOption) (*QueryListLctsResponse, error)
+	// GetAuditTrail Queries the recorded operations on an LCT, oldest first.
+	GetAuditTrail(ctx context.Context, in *QueryGetAuditTrailRequest, opts ...grpc.CallOption) (*QueryGetAuditTrailResponse, error)
+	// GetLctsByProxy Queries a page of the live LCTs a proxy component mediates.
+	GetLctsByProxy(ctx context.Context, in *QueryGetLctsByProxyRequest, opts ...grpc.CallOption) (*QueryGetLctsByProxyResponse, error)
+	// GetKeyExchange Queries the session key exchange status of an LCT.
+	GetKeyExchange(ctx context.Context, in *QueryGetKeyExchangeRequest, opts ...grpc.CallOption) (*QueryGetKeyExchangeResponse, error)
+	// GetSplitKeyStatus Queries the lifecycle of an LCT's split key.
+	GetSplitKeyStatus(ctx context.Context, in *QueryGetSplitKeyStatusRequest, opts ...grpc.CallOption) (*QueryGetSplitKeyStatusResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/racecarweb.lctmanager.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetLct(ctx context.Context, in *QueryGetLctRequest, opts ...grpc.CallOption) (*QueryGetLctResponse, error) {
+	out := new(QueryGetLctResponse)
+	err := c.cc.Invoke(ctx, "/racecarweb.lctmanager.v1.Query/GetLct", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetComponentRelationships(ctx context.Context, in *QueryGetComponentRelationshipsRequest, opts ...grpc.CallOption) (*QueryGetComponentRelationshipsResponse, error) {
+	out := new(QueryGetComponentRelationshipsResponse)
+	err := c.cc.Invoke(ctx, "/racecarweb.lctmanager.v1.Query/GetComponentRelationships", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidateLctAccess(ctx context.Context, in *QueryValidateLctAccessRequest, opts ...grpc.CallOption) (*QueryValidateLctAccessResponse, error) {
+	out := new(QueryValidateLctAccessResponse)
+	err := c.cc.Invoke(ctx, "/racecarweb.lctmanager.v1.Query/ValidateLctAccess", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetLctBetween(ctx context.Context, in *QueryGetLctBetweenRequest, opts ...grpc.CallOption) (*QueryGetLctBetweenResponse, error) {
+	out := new(QueryGetLctBetweenResponse)
+	err := c.cc.Invoke(ctx, "/racecarweb.lctmanager.v1.Query/GetLctBetween", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListLcts(ctx context.Context, in *QueryListLctsRequest, opts ...grpc.CallOption) (*QueryListLctsResponse, error) {
+	out := new(QueryListLctsResponse)
+	err := c.cc.Invoke(ctx, "/racecarweb.lctmanager.v1.Query/ListLcts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetAuditTrail(ctx context.Context, in *QueryGetAuditTrailRequest, opts ...grpc.CallOption) (*QueryGetAuditTrailResponse, error) {
+	out := new(QueryGetAuditTrailResponse)
+	err := c.cc.Invoke(ctx, "/racecarweb.lctmanager.v1.Query/GetAuditTrail", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetLctsByProxy(ctx context.Context, in *QueryGetLctsByProxyRequest, opts ...grpc.CallOption) (*QueryGetLctsByProxyResponse, error) {
+	out := new(QueryGetLctsByProxyResponse)
+	err := c.cc.Invoke(ctx, "/racecarweb.lctmanager.v1.Query/GetLctsByProxy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetKeyExchange(ctx context.Context, in *QueryGetKeyExchangeRequest, opts ...grpc.CallOption) (*QueryGetKeyExchangeResponse, error) {
+	out := new(QueryGetKeyExchangeResponse)
+	err := c.cc.Invoke(ctx, "/racecarweb.lctmanager.v1.Query/GetKeyExchange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetSplitKeyStatus(ctx context.Context, in *QueryGetSplitKeyStatusRequest, opts ...grpc.CallOption) (*QueryGetSplitKeyStatusResponse, error) {
+	out := new(QueryGetSplitKeyStatusResponse)
+	err := c.cc.Invoke(ctx, "/racecarweb.lctmanager.v1.Query/GetSplitKeyStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// GetLct Queries a list of GetLct items.
+	GetLct(context.Context, *QueryGetLctRequest) (*QueryGetLctResponse, error)
+	// GetComponentRelationships Queries a list of GetComponentRelationships items.
+	GetComponentRelationships(context.Context, *QueryGetComponentRelationshipsRequest) (*QueryGetComponentRelationshipsResponse, error)
+	// ValidateLctAccess Queries a list of ValidateLctAccess items.
+	ValidateLctAccess(context.Context, *QueryValidateLctAccessRequest) (*QueryValidateLctAccessResponse, error)
+	// GetLctBetween Queries the live LCT linking two components in an operational context.
+	GetLctBetween(context.Context, *QueryGetLctBetweenRequest) (*QueryGetLctBetweenResponse, error)
+	// ListLcts Queries a page of linked context tokens.
+	ListLcts(context.Context, *QueryListLctsRequest) (*QueryListLctsResponse, error)
+	// GetAuditTrail Queries the recorded operations on an LCT, oldest first.
+	GetAuditTrail(context.Context, *QueryGetAuditTrailRequest) (*QueryGetAuditTrailResponse, error)
+	// GetLctsByProxy Queries a page of the live LCTs a proxy component mediates.
+	GetLctsByProxy(context.Context, *QueryGetLctsByProxyRequest) (*QueryGetLctsByProxyResponse, error)
+	// GetKeyExchange Queries the session key exchange status of an LCT.
+	GetKeyExchange(context.Context, *QueryGetKeyExchangeRequest) (*QueryGetKeyExchangeResponse, error)
+	// GetSplitKeyStatus Queries the lifecycle of an LCT's split key.
+	GetSplitKeyStatus(context.Context, *QueryGetSplitKeyStatusRequest) (*QueryGetSplitKeyStatusResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) GetLct(ctx context.Context, req *QueryGetLctRequest) (*QueryGetLctResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLct not implemented")
+}
+func (*UnimplementedQueryServer) GetComponentRelationships(ctx context.Context, req *QueryGetComponentRelationshipsRequest) (*QueryGetComponentRelationshipsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetComponentRelationships not implemented")
+}
+func (*UnimplementedQueryServer) ValidateLctAccess(ctx context.Context, req *QueryValidateLctAccessRequest) (*QueryValidateLctAccessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateLctAccess not implemented")
+}
+func (*UnimplementedQueryServer) GetLctBetween(ctx context.Context, req *QueryGetLctBetweenRequest) (*QueryGetLctBetweenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLctBetween not implemented")
+}
+func (*UnimplementedQueryServer) ListLcts(ctx context.Context, req *QueryListLctsRequest) (*QueryListLctsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLcts not implemented")
+}
+func (*UnimplementedQueryServer) GetAuditTrail(ctx context.Context, req *QueryGetAuditTrailRequest) (*QueryGetAuditTrailResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAuditTrail not implemented")
+}
+func (*UnimplementedQueryServer) GetLctsByProxy(ctx context.Context, req *QueryGetLctsByProxyRequest) (*QueryGetLctsByProxyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLctsByProxy not implemented")
+}
+func (*UnimplementedQueryServer) GetKeyExchange(ctx context.Context, req *QueryGetKeyExchangeRequest) (*QueryGetKeyExchangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetKeyExchange not implemented")
+}
+func (*UnimplementedQueryServer) GetSplitKeyStatus(ctx context.Context, req *QueryGetSplitKeyStatusRequest) (*QueryGetSplitKeyStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSplitKeyStatus not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/racecarweb.lctmanager.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetLct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetLctRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetLct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/racecarweb.lctmanager.v1.Query/GetLct",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetLct(ctx, req.(*QueryGetLctRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetComponentRelationships_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetComponentRelationshipsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetComponentRelationships(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/racecarweb.lctmanager.v1.Query/GetComponentRelationships",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetComponentRelationships(ctx, req.(*QueryGetComponentRelationshipsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidateLctAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidateLctAccessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidateLctAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/racecarweb.lctmanager.v1.Query/ValidateLctAccess",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidateLctAccess(ctx, req.(*QueryValidateLctAccessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetLctBetween_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetLctBetweenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetLctBetween(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/racecarweb.lctmanager.v1.Query/GetLctBetween",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetLctBetween(ctx, req.(*QueryGetLctBetweenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListLcts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListLctsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListLcts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/racecarweb.lctmanager.v1.Query/ListLcts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListLcts(ctx, req.(*QueryListLctsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetAuditTrail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetAuditTrailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetAuditTrail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/racecarweb.lctmanager.v1.Query/GetAuditTrail",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetAuditTrail(ctx, req.(*QueryGetAuditTrailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetLctsByProxy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetLctsByProxyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetLctsByProxy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/racecarweb.lctmanager.v1.Query/GetLctsByProxy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetLctsByProxy(ctx, req.(*QueryGetLctsByProxyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetKeyExchange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetKeyExchangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetKeyExchange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/racecarweb.lctmanager.v1.Query/GetKeyExchange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetKeyExchange(ctx, req.(*QueryGetKeyExchangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetSplitKeyStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetSplitKeyStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetSplitKeyStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/racecarweb.lctmanager.v1.Query/GetSplitKeyStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetSplitKeyStatus(ctx, req.(*QueryGetSplitKeyStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "racecarweb.lctmanager.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "GetLct",
+			Handler:    _Query_GetLct_Handler,
+		},
+		{
+			MethodName: "GetComponentRelationships",
+			Handler:    _Query_GetComponentRelationships_Handler,
+		},
+		{
+			MethodName: "ValidateLctAccess",
+			Handler:    _Query_ValidateLctAccess_Handler,
+		},
+		{
+			MethodName: "GetLctBetween",
+			Handler:    _Query_GetLctBetween_Handler,
+		},
+		{
+			MethodName: "ListLcts",
+			Handler:    _Query_ListLcts_Handler,
+		},
+		{
+			MethodName: "GetAuditTrail",
+			Handler:    _Query_GetAuditTrail_Handler,
+		},
+		{
+			MethodName: "GetLctsByProxy",
+			Handler:    _Query_GetLctsByProxy_Handler,
+		},
+		{
+			MethodName: "GetKeyExchange",
+			Handler:    _Query_GetKeyExchange_Handler,
+		},
+		{
+			MethodName: "GetSplitKeyStatus",
+			Handler:    _Query_GetSplitKeyStatus_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "racecarweb/lctmanager/v1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetLctRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetLctRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetLctRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LctId) > 0 {
+		i -= len(m.LctId)
+		copy(dAtA[i:], m.LctId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.LctId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetLctResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetLctResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetLctResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LinkedContextToken) > 0 {
+		i -= len(m.LinkedContextToken)
+		copy(dAtA[i:], m.LinkedContextToken)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.LinkedContextToken)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetComponentRelationshipsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetComponentRelationshipsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetComponentRelationshipsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ComponentId) > 0 {
+		i -= len(m.ComponentId)
+		copy(dAtA[i:], m.ComponentId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ComponentId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetComponentRelationshipsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetComponentRelationshipsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetComponentRelationshipsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LctCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LctCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ComponentRelationships) > 0 {
+		i -= len(m.ComponentRelationships)
+		copy(dAtA[i:], m.ComponentRelationships)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ComponentRelationships)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidateLctAccessRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidateLctAccessRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidateLctAccessRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RequestorId) > 0 {
+		i -= len(m.RequestorId)
+		copy(dAtA[i:], m.RequestorId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RequestorId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.LctId) > 0 {
+		i -= len(m.LctId)
+		copy(dAtA[i:], m.LctId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.LctId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidateLctAccessResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidateLctAccessResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidateLctAccessResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AccessLevel) > 0 {
+		i -= len(m.AccessLevel)
+		copy(dAtA[i:], m.AccessLevel)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AccessLevel)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.HasAccess {
+		i--
+		if m.HasAccess {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetLctBetweenRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetLctBetweenRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetLctBetweenRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OperationalContext) > 0 {
+		i -= len(m.OperationalContext)
+		copy(dAtA[i:], m.OperationalContext)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OperationalContext)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ComponentB) > 0 {
+		i -= len(m.ComponentB)
+		copy(dAtA[i:], m.ComponentB)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ComponentB)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ComponentA) > 0 {
+		i -= len(m.ComponentA)
+		copy(dAtA[i:], m.ComponentA)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ComponentA)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetLctBetweenResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetLctBetweenResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetLctBetweenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Found {
+		i--
+		if m.Found {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.LinkedContextToken) > 0 {
+		i -= len(m.LinkedContextToken)
+		copy(dAtA[i:], m.LinkedContextToken)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.LinkedContextToken)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListLctsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListLctsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListLctsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OperationalContext) > 0 {
+		i -= len(m.OperationalContext)
+		copy(dAtA[i:], m.OperationalContext)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OperationalContext)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ComponentId) > 0 {
+		i -= len(m.ComponentId)
+		copy(dAtA[i:], m.ComponentId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ComponentId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListLctsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListLctsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListLctsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Lcts) > 0 {
+		for iNdEx := len(m.Lcts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Lcts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetAuditTrailRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetAuditTrailRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetAuditTrailRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LctId) > 0 {
+		i -= len(m.LctId)
+		copy(dAtA[i:], m.LctId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.LctId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetAuditTrailResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetAuditTrailResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetAuditTrailResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetLctsByProxyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetLctsByProxyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetLctsByProxyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ProxyId) > 0 {
+		i -= len(m.ProxyId)
+		copy(dAtA[i:], m.ProxyId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProxyId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetLctsByProxyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetLctsByProxyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetLctsByProxyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Lcts) > 0 {
+		for iNdEx := len(m.Lcts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Lcts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetKeyExchangeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetKeyExchangeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetKeyExchangeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LctId) > 0 {
+		i -= len(m.LctId)
+		copy(dAtA[i:], m.LctId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.LctId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetKeyExchangeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetKeyExchangeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetKeyExchangeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProxyComponentId) > 0 {
+		i -= len(m.ProxyComponentId)
+		copy(dAtA[i:], m.ProxyComponentId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProxyComponentId)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.ComponentBId) > 0 {
+		i -= len(m.ComponentBId)
+		copy(dAtA[i:], m.ComponentBId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ComponentBId)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.ComponentAId) > 0 {
+		i -= len(m.ComponentAId)
+		copy(dAtA[i:], m.ComponentAId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ComponentAId)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.LastUsed != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LastUsed))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.CreatedAt != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CreatedAt))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.LctStatus) > 0 {
+		i -= len(m.LctStatus)
+		copy(dAtA[i:], m.LctStatus)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.LctStatus)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.LctId) > 0 {
+		i -= len(m.LctId)
+		copy(dAtA[i:], m.LctId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.LctId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetSplitKeyStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetSplitKeyStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetSplitKeyStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LctId) > 0 {
+		i -= len(m.LctId)
+		copy(dAtA[i:], m.LctId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.LctId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetSplitKeyStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetSplitKeyStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetSplitKeyStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Superseded) > 0 {
+		for iNdEx := len(m.Superseded) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Superseded[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.RevokedAt != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RevokedAt))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.ActivatedAt != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ActivatedAt))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.CreatedAt != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CreatedAt))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Version != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.KeyReference) > 0 {
+		i -= len(m.KeyReference)
+		copy(dAtA[i:], m.KeyReference)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.KeyReference)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.LctId) > 0 {
+		i -= len(m.LctId)
+		copy(dAtA[i:], m.LctId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.LctId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SupersededSplitKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SupersededSplitKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SupersededSplitKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SupersededBy) > 0 {
+		i -= len(m.SupersededBy)
+		copy(dAtA[i:], m.SupersededBy)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SupersededBy)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.SupersededAt != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SupersededAt))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.CreatedAt != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CreatedAt))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Version != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.KeyReference) > 0 {
+		i -= len(m.KeyReference)
+		copy(dAtA[i:], m.KeyReference)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.KeyReference)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetLctRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.LctId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetLctResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.LinkedContextToken)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetComponentRelationshipsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ComponentId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetComponentRelationshipsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ComponentRelationships)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.LctCount != 0 {
+		n += 1 + sovQuery(uint64(m.LctCount))
+	}
+	return n
+}
+
+func (m *QueryValidateLctAccessRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.LctId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.RequestorId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidateLctAccessResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.HasAccess {
+		n += 2
+	}
+	l = len(m.AccessLevel)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetLctBetweenRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ComponentA)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ComponentB)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.OperationalContext)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetLctBetweenResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.LinkedContextToken)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Found {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryListLctsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ComponentId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.OperationalContext)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListLctsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Lcts) > 0 {
+		for _, e := range m.Lcts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetAuditTrailRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.LctId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetAuditTrailResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryGetLctsByProxyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProxyId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetLctsByProxyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Lcts) > 0 {
+		for _, e := range m.Lcts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetKeyExchangeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.LctId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetKeyExchangeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.LctId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.LctStatus)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.CreatedAt != 0 {
+		n += 1 + sovQuery(uint64(m.CreatedAt))
+	}
+	if m.LastUsed != 0 {
+		n += 1 + sovQuery(uint64(m.LastUsed))
+	}
+	l = len(m.ComponentAId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ComponentBId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ProxyComponentId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetSplitKeyStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.LctId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetSplitKeyStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.LctId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.KeyReference)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovQuery(uint64(m.Version))
+	}
+	if m.CreatedAt != 0 {
+		n += 1 + sovQuery(uint64(m.CreatedAt))
+	}
+	if m.ActivatedAt != 0 {
+		n += 1 + sovQuery(uint64(m.ActivatedAt))
+	}
+	if m.RevokedAt != 0 {
+		n += 1 + sovQuery(uint64(m.RevokedAt))
+	}
+	if len(m.Superseded) > 0 {
+		for _, e := range m.Superseded {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SupersededSplitKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.KeyReference)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovQuery(uint64(m.Version))
+	}
+	if m.CreatedAt != 0 {
+		n += 1 + sovQuery(uint64(m.CreatedAt))
+	}
+	if m.SupersededAt != 0 {
+		n += 1 + sovQuery(uint64(m.SupersededAt))
+	}
+	l = len(m.SupersededBy)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetLctRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetLctRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetLctRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LctId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LctId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetLctResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetLctResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetLctResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LinkedContextToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LinkedContextToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetComponentRelationshipsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetComponentRelationshipsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetComponentRelationshipsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ComponentId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ComponentId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetComponentRelationshipsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetComponentRelationshipsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetComponentRelationshipsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ComponentRelationships", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ComponentRelationships = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LctCount", wireType)
+			}
+			m.LctCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LctCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidateLctAccessRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidateLctAccessRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidateLctAccessRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LctId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LctId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestorId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestorId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidateLctAccessResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidateLctAccessResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidateLctAccessResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasAccess", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HasAccess = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessLevel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccessLevel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetLctBetweenRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetLctBetweenRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetLctBetweenRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ComponentA", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ComponentA = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ComponentB", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ComponentB = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperationalContext", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperationalContext = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetLctBetweenResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetLctBetweenResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetLctBetweenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LinkedContextToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LinkedContextToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Found", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Found = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListLctsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListLctsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListLctsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ComponentId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ComponentId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperationalContext", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperationalContext = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListLctsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListLctsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListLctsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lcts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Lcts = append(m.Lcts, LinkedContextToken{})
+			if err := m.Lcts[len(m.Lcts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetAuditTrailRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetAuditTrailRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetAuditTrailRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LctId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LctId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetAuditTrailResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetAuditTrailResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetAuditTrailResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, LCTAuditEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetLctsByProxyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetLctsByProxyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetLctsByProxyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProxyId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProxyId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1101,7 +4562,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetLctsByProxyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1124,15 +4585,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetLctsByProxyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetLctsByProxyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Lcts", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1159,7 +4620,44 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Lcts = append(m.Lcts, LinkedContextToken{})
+			if err := m.Lcts[len(m.Lcts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1184,7 +4682,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetLctRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetKeyExchangeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1207,10 +4705,10 @@ func (m *QueryGetLctRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetLctRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetKeyExchangeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetLctRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetKeyExchangeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1266,7 +4764,7 @@ func (m *QueryGetLctRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetLctResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetKeyExchangeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1276,28 +4774,226 @@ func (m *QueryGetLctResponse) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowQuery
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetKeyExchangeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetKeyExchangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LctId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LctId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LctStatus", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LctStatus = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			m.CreatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUsed", wireType)
+			}
+			m.LastUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastUsed |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ComponentAId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ComponentAId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ComponentBId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetLctResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetLctResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ComponentBId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LinkedContextToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProxyComponentId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1325,7 +5021,7 @@ func (m *QueryGetLctResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LinkedContextToken = string(dAtA[iNdEx:postIndex])
+			m.ProxyComponentId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1348,7 +5044,7 @@ func (m *QueryGetLctResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetComponentRelationshipsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetSplitKeyStatusRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1371,15 +5067,15 @@ func (m *QueryGetComponentRelationshipsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetComponentRelationshipsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetSplitKeyStatusRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetComponentRelationshipsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetSplitKeyStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ComponentId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LctId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1407,7 +5103,7 @@ func (m *QueryGetComponentRelationshipsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ComponentId = string(dAtA[iNdEx:postIndex])
+			m.LctId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1430,7 +5126,7 @@ func (m *QueryGetComponentRelationshipsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetComponentRelationshipsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetSplitKeyStatusResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1453,15 +5149,15 @@ func (m *QueryGetComponentRelationshipsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetComponentRelationshipsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetSplitKeyStatusResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetComponentRelationshipsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetSplitKeyStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ComponentRelationships", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LctId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1489,13 +5185,13 @@ func (m *QueryGetComponentRelationshipsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ComponentRelationships = string(dAtA[iNdEx:postIndex])
+			m.LctId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LctCount", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			m.LctCount = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1505,64 +5201,27 @@ func (m *QueryGetComponentRelationshipsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LctCount |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryValidateLctAccessRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidateLctAccessRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidateLctAccessRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LctId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyReference", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1590,13 +5249,89 @@ func (m *QueryValidateLctAccessRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LctId = string(dAtA[iNdEx:postIndex])
+			m.KeyReference = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			m.CreatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivatedAt", wireType)
+			}
+			m.ActivatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActivatedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevokedAt", wireType)
+			}
+			m.RevokedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RevokedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestorId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Superseded", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1606,23 +5341,25 @@ func (m *QueryValidateLctAccessRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RequestorId = string(dAtA[iNdEx:postIndex])
+			m.Superseded = append(m.Superseded, &SupersededSplitKey{})
+			if err := m.Superseded[len(m.Superseded)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1645,7 +5382,7 @@ func (m *QueryValidateLctAccessRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryValidateLctAccessResponse) Unmarshal(dAtA []byte) error {
+func (m *SupersededSplitKey) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1668,17 +5405,17 @@ func (m *QueryValidateLctAccessResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidateLctAccessResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SupersededSplitKey: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidateLctAccessResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SupersededSplitKey: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HasAccess", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyReference", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1688,15 +5425,84 @@ func (m *QueryValidateLctAccessResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.HasAccess = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyReference = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			m.CreatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupersededAt", wireType)
+			}
+			m.SupersededAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SupersededAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccessLevel", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SupersededBy", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1724,7 +5530,7 @@ func (m *QueryValidateLctAccessResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AccessLevel = string(dAtA[iNdEx:postIndex])
+			m.SupersededBy = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -0,0 +1,39 @@
+package types
+
+import (
+	"bytes"
+	"encoding/hex"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+// Split key statuses. An LCT's split key is active until it is rotated, when
+// its replacement supersedes it, or until the LCT is terminated, when it is
+// revoked.
+const (
+	SplitKeyStatusActive     = "active"
+	SplitKeyStatusSuperseded = "superseded"
+	SplitKeyStatusRevoked    = "revoked"
+)
+
+// KeyReference returns the reference components use to match a delivered key
+// half to this split key: the hex commitment to the combined key
+func (s SplitKey) KeyReference() string {
+	return hex.EncodeToString(s.KeyCommitment)
+}
+
+// KeyCommitmentSize is the length in bytes of a commitment to a combined key
+const KeyCommitmentSize = 32
+
+// ValidateKeyCommitment checks the shape of a commitment generated off-chain.
+// A commitment of one repeated byte, such as all zeros, cannot come from a
+// hash and is rejected as a sign the key service had no entropy.
+func ValidateKeyCommitment(commitment []byte) error {
+	if len(commitment) != KeyCommitmentSize {
+		return errorsmod.Wrapf(ErrInvalidKeyCommitment, "expected %d bytes, got %d", KeyCommitmentSize, len(commitment))
+	}
+	if bytes.Count(commitment, commitment[:1]) == len(commitment) {
+		return errorsmod.Wrap(ErrInvalidKeyCommitment, "commitment repeats a single byte")
+	}
+	return nil
+}
@@ -3,14 +3,39 @@ package types
 const (
     StatusPending    = "pending"
     StatusActive     = "active"
+    StatusInactive   = "inactive" // set by the idle sweep, cleared on next contact
     StatusTerminated = "terminated"
+    StatusSuspended  = "suspended" // paused by the trust anchor; keys and history are kept
+
+    StatusKeyExchangeInitiated = "key_exchange_initiated"
 )
 
 func IsValidLCTStatus(status string) bool {
     switch status {
-    case StatusPending, StatusActive, StatusTerminated:
+    case StatusPending, StatusActive, StatusInactive, StatusTerminated, StatusKeyExchangeInitiated, StatusSuspended:
         return true
     default:
         return false
     }
-}
\ No newline at end of file
+}
+
+// lctTransitions lists the statuses each status may move to. Terminated is
+// final: a terminated LCT is never resurrected.
+var lctTransitions = map[string][]string{
+    StatusPending:              {StatusActive, StatusTerminated},
+    StatusActive:               {StatusKeyExchangeInitiated, StatusInactive, StatusSuspended, StatusTerminated},
+    StatusKeyExchangeInitiated: {StatusActive, StatusSuspended, StatusTerminated},
+    StatusInactive:             {StatusActive, StatusSuspended, StatusTerminated},
+    StatusSuspended:            {StatusActive, StatusKeyExchangeInitiated, StatusInactive, StatusTerminated},
+    StatusTerminated:           {},
+}
+
+// IsValidLCTTransition reports whether an LCT may move from one status to another
+func IsValidLCTTransition(from, to string) bool {
+    for _, allowed := range lctTransitions[from] {
+        if allowed == to {
+            return true
+        }
+    }
+    return false
+}
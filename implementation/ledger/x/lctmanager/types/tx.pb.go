@@ -1031,7 +1031,9 @@ func (m *MsgEncryptLCTMessage) GetMessage() []byte {
 
 // MsgEncryptLCTMessageResponse defines the MsgEncryptLCTMessageResponse message.
 type MsgEncryptLCTMessageResponse struct {
-	LctId            string `protobuf:"bytes,1,opt,name=lct_id,json=lctId,proto3" json:"lct_id,omitempty"`
+	LctId string `protobuf:"bytes,1,opt,name=lct_id,json=lctId,proto3" json:"lct_id,omitempty"`
+	// encrypted_message is the 32-byte ephemeral X25519 public key followed by
+	// the nonce and the ChaCha20-Poly1305 ciphertext.
 	EncryptedMessage []byte `protobuf:"bytes,2,opt,name=encrypted_message,json=encryptedMessage,proto3" json:"encrypted_message,omitempty"`
 	Status           string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
 }
@@ -1334,6 +1336,146 @@ func (m *MsgVerifyLCTChallengeResponse) GetStatus() string {
 	return ""
 }
 
+// MsgRotateSplitKey defines the MsgRotateSplitKey message.
+type MsgRotateSplitKey struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	LctId   string `protobuf:"bytes,2,opt,name=lct_id,json=lctId,proto3" json:"lct_id,omitempty"`
+	Reason  string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	// key_commitment commits to the new combined key, generated off-chain
+	KeyCommitment []byte `protobuf:"bytes,4,opt,name=key_commitment,json=keyCommitment,proto3" json:"key_commitment,omitempty"`
+}
+
+func (m *MsgRotateSplitKey) Reset()         { *m = MsgRotateSplitKey{} }
+func (m *MsgRotateSplitKey) String() string { return proto.CompactTextString(m) }
+func (*MsgRotateSplitKey) ProtoMessage()    {}
+func (*MsgRotateSplitKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2aab7cf165c3e8a2, []int{20}
+}
+func (m *MsgRotateSplitKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRotateSplitKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRotateSplitKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRotateSplitKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRotateSplitKey.Merge(m, src)
+}
+func (m *MsgRotateSplitKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRotateSplitKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRotateSplitKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRotateSplitKey proto.InternalMessageInfo
+
+func (m *MsgRotateSplitKey) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgRotateSplitKey) GetLctId() string {
+	if m != nil {
+		return m.LctId
+	}
+	return ""
+}
+
+func (m *MsgRotateSplitKey) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *MsgRotateSplitKey) GetKeyCommitment() []byte {
+	if m != nil {
+		return m.KeyCommitment
+	}
+	return nil
+}
+
+// MsgRotateSplitKeyResponse carries the references of the new key and the
+// key it superseded; the new halves never pass through the chain.
+type MsgRotateSplitKeyResponse struct {
+	LctId                string `protobuf:"bytes,1,opt,name=lct_id,json=lctId,proto3" json:"lct_id,omitempty"`
+	KeyReference         string `protobuf:"bytes,2,opt,name=key_reference,json=keyReference,proto3" json:"key_reference,omitempty"`
+	PreviousKeyReference string `protobuf:"bytes,3,opt,name=previous_key_reference,json=previousKeyReference,proto3" json:"previous_key_reference,omitempty"`
+	Version              uint64 `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (m *MsgRotateSplitKeyResponse) Reset()         { *m = MsgRotateSplitKeyResponse{} }
+func (m *MsgRotateSplitKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRotateSplitKeyResponse) ProtoMessage()    {}
+func (*MsgRotateSplitKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2aab7cf165c3e8a2, []int{21}
+}
+func (m *MsgRotateSplitKeyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRotateSplitKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRotateSplitKeyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRotateSplitKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRotateSplitKeyResponse.Merge(m, src)
+}
+func (m *MsgRotateSplitKeyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRotateSplitKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRotateSplitKeyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRotateSplitKeyResponse proto.InternalMessageInfo
+
+func (m *MsgRotateSplitKeyResponse) GetLctId() string {
+	if m != nil {
+		return m.LctId
+	}
+	return ""
+}
+
+func (m *MsgRotateSplitKeyResponse) GetKeyReference() string {
+	if m != nil {
+		return m.KeyReference
+	}
+	return ""
+}
+
+func (m *MsgRotateSplitKeyResponse) GetPreviousKeyReference() string {
+	if m != nil {
+		return m.PreviousKeyReference
+	}
+	return ""
+}
+
+func (m *MsgRotateSplitKeyResponse) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*MsgUpdateParams)(nil), "racecarweb.lctmanager.v1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "racecarweb.lctmanager.v1.MsgUpdateParamsResponse")
@@ -1356,104 +1498,114 @@ func init() {
 	proto.RegisterType((*MsgGenerateLCTChallengeResponse)(nil), "racecarweb.lctmanager.v1.MsgGenerateLCTChallengeResponse")
 	proto.RegisterType((*MsgVerifyLCTChallenge)(nil), "racecarweb.lctmanager.v1.MsgVerifyLCTChallenge")
 	proto.RegisterType((*MsgVerifyLCTChallengeResponse)(nil), "racecarweb.lctmanager.v1.MsgVerifyLCTChallengeResponse")
+	proto.RegisterType((*MsgRotateSplitKey)(nil), "racecarweb.lctmanager.v1.MsgRotateSplitKey")
+	proto.RegisterType((*MsgRotateSplitKeyResponse)(nil), "racecarweb.lctmanager.v1.MsgRotateSplitKeyResponse")
 }
 
 func init() { proto.RegisterFile("racecarweb/lctmanager/v1/tx.proto", fileDescriptor_2aab7cf165c3e8a2) }
 
 var fileDescriptor_2aab7cf165c3e8a2 = []byte{
-	// 1471 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x4d, 0x4f, 0x1c, 0x47,
-	0x13, 0x66, 0x76, 0xcd, 0xc7, 0x16, 0xcb, 0xd7, 0xbc, 0xd8, 0x5e, 0xc6, 0x18, 0xf0, 0xbe, 0x2f,
-	0x32, 0x2f, 0xb1, 0x17, 0x19, 0x27, 0xb6, 0x43, 0x64, 0x59, 0x40, 0x50, 0x84, 0xc2, 0x3a, 0xd6,
-	0x40, 0x7c, 0x88, 0x14, 0x8d, 0x9a, 0x99, 0x66, 0x98, 0x78, 0xa7, 0x67, 0x34, 0xdd, 0x60, 0x36,
-	0x91, 0xa2, 0xc8, 0x8a, 0x14, 0xc5, 0xb9, 0xf8, 0x94, 0x3f, 0xe0, 0x1c, 0xa2, 0x9c, 0x38, 0x24,
-	0xff, 0xc1, 0xca, 0xc9, 0x4a, 0x2e, 0x3e, 0x45, 0x91, 0x7d, 0xe0, 0x1f, 0xe4, 0x1c, 0x4d, 0x77,
-	0x4f, 0xcf, 0xee, 0xb2, 0xb3, 0x7c, 0x84, 0x5c, 0xd0, 0x76, 0xf5, 0x53, 0xd5, 0xf5, 0x3c, 0xd5,
-	0xd5, 0xdd, 0x03, 0x5c, 0x89, 0x90, 0x8d, 0x6d, 0x14, 0x3d, 0xc6, 0x9b, 0x73, 0x35, 0x9b, 0xf9,
-	0x88, 0x20, 0x17, 0x47, 0x73, 0xbb, 0x37, 0xe6, 0xd8, 0x5e, 0x25, 0x8c, 0x02, 0x16, 0xe8, 0xa5,
-	0x14, 0x52, 0x49, 0x21, 0x95, 0xdd, 0x1b, 0xc6, 0x08, 0xf2, 0x3d, 0x12, 0xcc, 0xf1, 0xbf, 0x02,
-	0x6c, 0x5c, 0xb4, 0x03, 0xea, 0x07, 0x74, 0xce, 0xa7, 0x6e, 0x1c, 0xc4, 0xa7, 0xae, 0x9c, 0x18,
-	0x13, 0x13, 0x16, 0x1f, 0xcd, 0x89, 0x81, 0x9c, 0x1a, 0x75, 0x03, 0x37, 0x10, 0xf6, 0xf8, 0x97,
-	0xb4, 0x4e, 0x67, 0x66, 0x16, 0xa2, 0x08, 0xf9, 0xd2, 0xb9, 0xfc, 0xab, 0x06, 0x43, 0x55, 0xea,
-	0x7e, 0x1c, 0x3a, 0x88, 0xe1, 0x07, 0x7c, 0x46, 0xbf, 0x05, 0x05, 0xb4, 0xc3, 0xb6, 0x83, 0xc8,
-	0x63, 0xf5, 0x92, 0x36, 0xa5, 0xcd, 0x14, 0x96, 0x4a, 0xbf, 0xfd, 0x7c, 0x7d, 0x54, 0xae, 0xba,
-	0xe8, 0x38, 0x11, 0xa6, 0x74, 0x9d, 0x45, 0x1e, 0x71, 0xcd, 0x14, 0xaa, 0x2f, 0x43, 0x8f, 0x88,
-	0x5d, 0xca, 0x4d, 0x69, 0x33, 0xfd, 0xf3, 0x53, 0x95, 0x2c, 0xea, 0x15, 0xb1, 0xd2, 0x52, 0xe1,
-	0xc5, 0x1f, 0x93, 0x5d, 0x3f, 0x1e, 0xec, 0xcf, 0x6a, 0xa6, 0x74, 0x5d, 0x58, 0x78, 0x72, 0xb0,
-	0x3f, 0x9b, 0x06, 0x7d, 0x7a, 0xb0, 0x3f, 0x7b, 0xb5, 0x81, 0xca, 0x5e, 0x23, 0x99, 0x96, 0xc4,
-	0xcb, 0x63, 0x70, 0xb1, 0xc5, 0x64, 0x62, 0x1a, 0x06, 0x84, 0xe2, 0xf2, 0xf3, 0x3c, 0x40, 0x95,
-	0xba, 0x55, 0x8f, 0xb0, 0xb5, 0xe5, 0x0d, 0x7d, 0x1e, 0x7a, 0xed, 0x08, 0x23, 0x16, 0x44, 0x47,
-	0x12, 0x4c, 0x80, 0xfa, 0x24, 0xf4, 0x63, 0xc2, 0x3c, 0x56, 0xb7, 0x08, 0xf2, 0x31, 0xe7, 0x58,
-	0x30, 0x41, 0x98, 0xee, 0x23, 0x1f, 0x37, 0x00, 0x58, 0x3d, 0xc4, 0xa5, 0x7c, 0x23, 0x60, 0xa3,
-	0x1e, 0x62, 0xfd, 0x3e, 0xf4, 0xf9, 0x98, 0x21, 0x07, 0x31, 0x54, 0x3a, 0x37, 0x95, 0x9f, 0xe9,
-	0x9f, 0x9f, 0xcf, 0x96, 0x28, 0xcd, 0xb6, 0x52, 0x95, 0x4e, 0x2b, 0x84, 0x45, 0x75, 0x53, 0xc5,
-	0xd0, 0x67, 0x61, 0xc4, 0x23, 0x1e, 0xf3, 0x50, 0xcd, 0x62, 0x37, 0x2d, 0x86, 0x09, 0x0d, 0xa2,
-	0x52, 0x37, 0x5f, 0x76, 0x48, 0x4e, 0x6c, 0xdc, 0xdc, 0xe0, 0xe6, 0x46, 0xec, 0xae, 0xc2, 0xf6,
-	0x34, 0x61, 0x1f, 0x26, 0xd8, 0x6b, 0xa0, 0x27, 0x58, 0xe4, 0x84, 0x16, 0xf2, 0x83, 0x1d, 0xc2,
-	0x4a, 0xbd, 0x1c, 0x3c, 0x2c, 0x67, 0x16, 0x9d, 0x70, 0x91, 0xdb, 0x8d, 0xf7, 0x60, 0xa0, 0x29,
-	0x41, 0x7d, 0x18, 0xf2, 0x8f, 0xb0, 0xdc, 0x39, 0x66, 0xfc, 0x53, 0x1f, 0x85, 0xee, 0x5d, 0x54,
-	0xdb, 0x49, 0x44, 0x13, 0x83, 0x85, 0xdc, 0x1d, 0x6d, 0xa1, 0x18, 0x97, 0x3b, 0x91, 0xb8, 0xfc,
-	0x9d, 0x06, 0x7a, 0xca, 0x3b, 0x29, 0x9e, 0x7e, 0x1e, 0x7a, 0x6a, 0x36, 0xb3, 0x3c, 0x47, 0xc6,
-	0xec, 0xae, 0xd9, 0x6c, 0xd5, 0xd1, 0xa7, 0x61, 0x50, 0xea, 0x8d, 0x44, 0xc5, 0x64, 0xf8, 0x01,
-	0x61, 0x95, 0x65, 0x8c, 0xcb, 0x12, 0xb3, 0xd8, 0x44, 0x35, 0x44, 0x6c, 0x55, 0x16, 0xe4, 0x84,
-	0x4b, 0xc2, 0xa2, 0x5f, 0x80, 0x1e, 0xca, 0x10, 0xdb, 0xa1, 0xa5, 0x73, 0x7c, 0x4e, 0x8e, 0xca,
-	0xbf, 0x6b, 0x50, 0xaa, 0x52, 0x77, 0x39, 0x4e, 0x0e, 0xaf, 0xd9, 0xcc, 0xc4, 0x35, 0xc4, 0xbc,
-	0x80, 0xd0, 0x6d, 0x2f, 0x3c, 0xed, 0x0e, 0xb2, 0x03, 0x3f, 0x0c, 0x08, 0x26, 0xcc, 0x42, 0xc9,
-	0x0e, 0x52, 0xa6, 0xc5, 0x66, 0xc0, 0x66, 0x92, 0xaa, 0x32, 0x2d, 0xe9, 0x25, 0xe8, 0xb5, 0x03,
-	0xc2, 0xf0, 0x1e, 0x93, 0xb9, 0x26, 0x43, 0x7d, 0x0c, 0xfa, 0xc2, 0x28, 0xd8, 0xab, 0xc7, 0x2a,
-	0x89, 0x2d, 0xd0, 0xcb, 0xc7, 0xab, 0x4e, 0x8b, 0xc6, 0x0c, 0xa6, 0xb2, 0x48, 0x1d, 0x25, 0xf8,
-	0x15, 0x28, 0x3e, 0xc2, 0x75, 0x0b, 0xef, 0xd9, 0xdb, 0x88, 0xb8, 0x49, 0x35, 0xfb, 0x1f, 0xe1,
-	0xfa, 0x8a, 0x34, 0x35, 0x68, 0x99, 0x6f, 0xd2, 0xf2, 0x07, 0x51, 0x59, 0xd1, 0x9b, 0x6b, 0x36,
-	0x5b, 0xe7, 0xe6, 0x53, 0xa9, 0x98, 0x26, 0x97, 0x6b, 0x4c, 0xee, 0x32, 0x00, 0xc1, 0x8f, 0xad,
-	0xa6, 0xd5, 0x0b, 0x04, 0x3f, 0x96, 0x2b, 0x5d, 0x80, 0x9e, 0x08, 0x23, 0x1a, 0x90, 0xa4, 0xc8,
-	0x62, 0xd4, 0x22, 0xce, 0x38, 0x18, 0x87, 0xb3, 0x54, 0x87, 0xc8, 0x2f, 0x1a, 0x5c, 0xaa, 0x52,
-	0x77, 0x03, 0x47, 0xbe, 0x47, 0xce, 0x68, 0x4f, 0x64, 0xb0, 0x49, 0xd3, 0xcd, 0x37, 0xa6, 0x1b,
-	0xef, 0x79, 0x12, 0x30, 0x6f, 0xab, 0x6e, 0x05, 0x5b, 0x5b, 0x35, 0x8f, 0x60, 0x4e, 0xa7, 0xcf,
-	0x1c, 0x10, 0xd6, 0x8f, 0x84, 0xb1, 0x85, 0xd5, 0x34, 0xfc, 0xb7, 0x43, 0xda, 0x8a, 0xde, 0xd3,
-	0x1c, 0x5c, 0xae, 0x52, 0x77, 0x95, 0x37, 0x38, 0xc3, 0x6b, 0xcb, 0x1b, 0x55, 0xec, 0xc4, 0xbf,
-	0x9c, 0x07, 0xc8, 0x8b, 0xb3, 0x3e, 0x15, 0xc1, 0x19, 0x90, 0x47, 0x06, 0x0b, 0x22, 0xab, 0x89,
-	0xea, 0xa0, 0xb2, 0xaf, 0x71, 0xce, 0x65, 0x18, 0x60, 0x28, 0x72, 0x31, 0x4b, 0x60, 0x82, 0x7a,
-	0xbf, 0x30, 0x0a, 0x4c, 0x76, 0x03, 0x4c, 0x41, 0x51, 0x34, 0x80, 0x74, 0x16, 0x4d, 0x00, 0xdc,
-	0xb6, 0x96, 0xec, 0x10, 0xbc, 0x17, 0x7a, 0x11, 0xa6, 0x16, 0x62, 0xfc, 0xec, 0xcb, 0x9b, 0x05,
-	0x69, 0x59, 0x64, 0x2d, 0x9a, 0xbd, 0xd2, 0x60, 0xba, 0xa3, 0x18, 0xaa, 0x59, 0x2e, 0x03, 0x84,
-	0xc2, 0x94, 0x36, 0x4c, 0x41, 0x5a, 0x44, 0x25, 0xe5, 0x9e, 0xcc, 0x35, 0x76, 0x44, 0xdc, 0x4c,
-	0xf6, 0x36, 0xaa, 0xd5, 0x30, 0x71, 0x71, 0x03, 0x59, 0x65, 0x13, 0x07, 0x5c, 0x0a, 0x91, 0xb7,
-	0x86, 0x36, 0x53, 0x34, 0x07, 0x94, 0xf5, 0xfd, 0xf8, 0x1a, 0xa8, 0xc0, 0x7f, 0x62, 0xce, 0x51,
-	0x43, 0x4d, 0x53, 0x01, 0x46, 0x6a, 0xcd, 0xd5, 0x5e, 0x75, 0xca, 0x4f, 0x44, 0x9d, 0x97, 0x03,
-	0x3f, 0xac, 0xe1, 0x33, 0xab, 0x73, 0xb3, 0x0c, 0xb9, 0x56, 0x19, 0xae, 0x27, 0x77, 0x4a, 0xbc,
-	0x0d, 0x22, 0xa9, 0x9d, 0x24, 0x3d, 0xa2, 0x66, 0x94, 0xa8, 0x57, 0x61, 0x48, 0xee, 0x05, 0x85,
-	0x15, 0xf5, 0x1e, 0x14, 0x66, 0x05, 0x9c, 0x81, 0x61, 0x8a, 0x29, 0xf5, 0x02, 0x62, 0xc5, 0x67,
-	0x13, 0x57, 0xa9, 0x9b, 0xab, 0x34, 0x28, 0xed, 0x1f, 0xe2, 0x7a, 0x2c, 0x53, 0x4b, 0x7d, 0xff,
-	0xca, 0xf1, 0xfa, 0x66, 0x8b, 0xf0, 0x4f, 0xeb, 0x9b, 0x51, 0x95, 0x7c, 0x46, 0x55, 0xf4, 0x15,
-	0x98, 0xc4, 0xc4, 0x8e, 0xea, 0x21, 0xc3, 0x8e, 0xd5, 0x48, 0x49, 0x89, 0x23, 0xab, 0x3f, 0xae,
-	0x60, 0xeb, 0x8a, 0xe0, 0x6a, 0x82, 0xd1, 0xef, 0xc1, 0x78, 0xfb, 0x30, 0x42, 0x37, 0xa9, 0xcd,
-	0x58, 0x9b, 0x18, 0x1b, 0x1c, 0xa0, 0xdf, 0x85, 0x4b, 0xdb, 0x88, 0x6e, 0x63, 0xc7, 0xb2, 0x03,
-	0x7f, 0xd3, 0x23, 0xcd, 0x61, 0x78, 0xdb, 0x14, 0xcd, 0x92, 0x80, 0x2c, 0x4b, 0x44, 0x1a, 0x24,
-	0xbe, 0xc2, 0x58, 0xb4, 0x43, 0x99, 0x45, 0xed, 0x20, 0xc2, 0xf2, 0xd1, 0x00, 0xdc, 0xb4, 0x1e,
-	0x5b, 0xca, 0xdf, 0x6a, 0x30, 0x5a, 0xa5, 0xee, 0x8a, 0x48, 0x80, 0xeb, 0x4e, 0x29, 0x72, 0xf1,
-	0x59, 0x9e, 0x9e, 0x25, 0xe8, 0xf5, 0x45, 0x54, 0xae, 0x77, 0xd1, 0x4c, 0x86, 0x2d, 0x9b, 0xe0,
-	0x73, 0x18, 0x6f, 0x97, 0xca, 0x51, 0xf7, 0xe0, 0x5b, 0x30, 0x92, 0x6a, 0x9c, 0x2c, 0x94, 0xe3,
-	0x0b, 0x0d, 0xab, 0x89, 0x84, 0x56, 0xd6, 0x8d, 0x18, 0xf1, 0xc7, 0xea, 0x07, 0x98, 0xe0, 0x48,
-	0x9c, 0x2f, 0xcb, 0x49, 0x53, 0x9f, 0xa1, 0x12, 0x2d, 0x7c, 0x09, 0x4c, 0x66, 0xac, 0x79, 0x14,
-	0xe5, 0x71, 0x28, 0xa8, 0x43, 0x47, 0x52, 0x4d, 0x0d, 0x99, 0x1c, 0x7f, 0xd2, 0xe0, 0x7c, 0x95,
-	0xba, 0x0f, 0x71, 0xe4, 0x6d, 0xd5, 0xff, 0x25, 0x8a, 0xcd, 0xa9, 0xe5, 0x5b, 0x53, 0x33, 0xa0,
-	0xaf, 0xe9, 0x04, 0x29, 0x9a, 0x6a, 0xdc, 0x22, 0xce, 0x67, 0xfc, 0x54, 0x3c, 0x9c, 0xeb, 0x51,
-	0xd2, 0x18, 0xd0, 0xb7, 0x1b, 0x3b, 0x79, 0x58, 0x24, 0xd6, 0x67, 0xaa, 0x71, 0x96, 0x30, 0xf3,
-	0xcf, 0x01, 0xf2, 0x55, 0xea, 0xea, 0x35, 0x28, 0x36, 0x7d, 0x7a, 0xfd, 0xbf, 0xe3, 0xf7, 0x40,
-	0x23, 0xd4, 0xb8, 0x71, 0x6c, 0xa8, 0x22, 0xf0, 0x29, 0xf4, 0x26, 0x1f, 0x40, 0xff, 0x3b, 0xce,
-	0x87, 0x87, 0x71, 0xed, 0x38, 0x28, 0x15, 0xfe, 0x1b, 0x0d, 0xce, 0x67, 0x3c, 0x96, 0x3b, 0xc6,
-	0x69, 0xeb, 0x63, 0x2c, 0x9c, 0xdc, 0x47, 0x65, 0xb2, 0x03, 0x43, 0xad, 0x2f, 0xcd, 0x6b, 0xc7,
-	0x90, 0x4b, 0xa1, 0x8d, 0xb7, 0x4f, 0x82, 0x56, 0xcb, 0x3e, 0xd3, 0xa0, 0x94, 0xf9, 0x38, 0x7c,
-	0xa7, 0x63, 0xc8, 0x2c, 0x37, 0xe3, 0xee, 0xa9, 0xdc, 0x54, 0x4a, 0xdf, 0x6b, 0x60, 0x74, 0x78,
-	0xd0, 0xdd, 0xee, 0x18, 0x3d, 0xdb, 0xd1, 0xb8, 0x77, 0x4a, 0xc7, 0xa6, 0xc4, 0x3a, 0xbc, 0x40,
-	0x3a, 0x27, 0x96, 0xed, 0x78, 0x44, 0x62, 0xc7, 0xb8, 0xee, 0xbf, 0x80, 0x91, 0xc3, 0x77, 0x53,
-	0xa5, 0x63, 0xd4, 0x43, 0x78, 0xe3, 0xd6, 0xc9, 0xf0, 0x6a, 0xf1, 0xaf, 0x35, 0x18, 0x6d, 0x7b,
-	0x25, 0x74, 0xee, 0xf6, 0x76, 0x2e, 0xc6, 0xbb, 0x27, 0x76, 0x51, 0x69, 0x7c, 0x09, 0x7a, 0x9b,
-	0x33, 0x7b, 0xae, 0x63, 0xc0, 0xc3, 0x0e, 0xc6, 0xed, 0x13, 0x3a, 0x24, 0xeb, 0x1b, 0xdd, 0x5f,
-	0x1d, 0xec, 0xcf, 0x6a, 0x4b, 0x77, 0x5e, 0xbc, 0x9e, 0xd0, 0x5e, 0xbe, 0x9e, 0xd0, 0xfe, 0x7c,
-	0x3d, 0xa1, 0x3d, 0x7b, 0x33, 0xd1, 0xf5, 0xf2, 0xcd, 0x44, 0xd7, 0xab, 0x37, 0x13, 0x5d, 0x9f,
-	0x4c, 0xc8, 0xc0, 0xd7, 0x0f, 0xfd, 0x4f, 0x88, 0xd5, 0x43, 0x4c, 0x37, 0x7b, 0xf8, 0x7f, 0xb7,
-	0x6e, 0xfe, 0x1d, 0x00, 0x00, 0xff, 0xff, 0x9f, 0xd5, 0x77, 0xfe, 0xa0, 0x13, 0x00, 0x00,
+	// 1590 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0x4f, 0x4f, 0x1c, 0x47,
+	0x16, 0xa7, 0x67, 0xcc, 0x9f, 0x79, 0x0c, 0xff, 0x7a, 0xb1, 0x3d, 0xb4, 0x31, 0xe0, 0xf1, 0x22,
+	0xb3, 0xd8, 0x1e, 0x64, 0xf0, 0xda, 0x5e, 0x56, 0x96, 0x05, 0xb3, 0x68, 0x85, 0xcc, 0x78, 0xad,
+	0x86, 0xf5, 0x21, 0x52, 0xd4, 0x2a, 0x7a, 0x8a, 0xa6, 0xc3, 0x74, 0x75, 0xab, 0xaa, 0xc0, 0x4c,
+	0x22, 0x45, 0x91, 0x15, 0x29, 0x8a, 0x73, 0xf1, 0x29, 0xf9, 0x00, 0x89, 0x94, 0x28, 0xb9, 0x70,
+	0x48, 0xbe, 0x83, 0x95, 0x93, 0x95, 0x5c, 0x7c, 0x8a, 0x22, 0xfb, 0xc0, 0x37, 0xc8, 0x39, 0xea,
+	0xae, 0xea, 0x9a, 0x99, 0x86, 0x1e, 0xfe, 0x84, 0xe4, 0x82, 0xa6, 0x5e, 0xfd, 0xde, 0xab, 0xdf,
+	0xfb, 0xbd, 0x7a, 0x55, 0xd5, 0xc0, 0x15, 0x8a, 0x6c, 0x6c, 0x23, 0xfa, 0x14, 0xaf, 0xcf, 0xd4,
+	0x6c, 0xee, 0x21, 0x82, 0x1c, 0x4c, 0x67, 0x76, 0x6e, 0xcd, 0xf0, 0xdd, 0x52, 0x40, 0x7d, 0xee,
+	0xeb, 0x85, 0x06, 0xa4, 0xd4, 0x80, 0x94, 0x76, 0x6e, 0x19, 0x43, 0xc8, 0x73, 0x89, 0x3f, 0x13,
+	0xfd, 0x15, 0x60, 0xe3, 0xa2, 0xed, 0x33, 0xcf, 0x67, 0x33, 0x1e, 0x73, 0xc2, 0x20, 0x1e, 0x73,
+	0xe4, 0xc4, 0x88, 0x98, 0xb0, 0xa2, 0xd1, 0x8c, 0x18, 0xc8, 0xa9, 0x61, 0xc7, 0x77, 0x7c, 0x61,
+	0x0f, 0x7f, 0x49, 0xeb, 0x64, 0x2a, 0xb3, 0x00, 0x51, 0xe4, 0x49, 0xe7, 0xe2, 0x8f, 0x1a, 0x0c,
+	0x54, 0x98, 0xf3, 0xff, 0xa0, 0x8a, 0x38, 0x7e, 0x1c, 0xcd, 0xe8, 0x77, 0x20, 0x87, 0xb6, 0xf9,
+	0xa6, 0x4f, 0x5d, 0x5e, 0x2f, 0x68, 0x13, 0xda, 0x54, 0x6e, 0xb1, 0xf0, 0xd3, 0xf7, 0x37, 0x87,
+	0xe5, 0xaa, 0x0b, 0xd5, 0x2a, 0xc5, 0x8c, 0xad, 0x72, 0xea, 0x12, 0xc7, 0x6c, 0x40, 0xf5, 0x32,
+	0x74, 0x89, 0xd8, 0x85, 0xcc, 0x84, 0x36, 0xd5, 0x3b, 0x3b, 0x51, 0x4a, 0x4b, 0xbd, 0x24, 0x56,
+	0x5a, 0xcc, 0xbd, 0xfc, 0x65, 0xbc, 0xe3, 0x9b, 0xfd, 0xbd, 0x69, 0xcd, 0x94, 0xae, 0xf3, 0xf3,
+	0xcf, 0xf6, 0xf7, 0xa6, 0x1b, 0x41, 0x9f, 0xef, 0xef, 0x4d, 0x5f, 0x6b, 0x4a, 0x65, 0xb7, 0x39,
+	0x99, 0x04, 0xf1, 0xe2, 0x08, 0x5c, 0x4c, 0x98, 0x4c, 0xcc, 0x02, 0x9f, 0x30, 0x5c, 0xfc, 0x32,
+	0x0b, 0x50, 0x61, 0x4e, 0xc5, 0x25, 0x7c, 0xa5, 0xbc, 0xa6, 0xcf, 0x42, 0xb7, 0x4d, 0x31, 0xe2,
+	0x3e, 0x3d, 0x32, 0xc1, 0x18, 0xa8, 0x8f, 0x43, 0x2f, 0x26, 0xdc, 0xe5, 0x75, 0x8b, 0x20, 0x0f,
+	0x47, 0x39, 0xe6, 0x4c, 0x10, 0xa6, 0x47, 0xc8, 0xc3, 0x4d, 0x00, 0x5e, 0x0f, 0x70, 0x21, 0xdb,
+	0x0c, 0x58, 0xab, 0x07, 0x58, 0x7f, 0x04, 0x3d, 0x1e, 0xe6, 0xa8, 0x8a, 0x38, 0x2a, 0x9c, 0x9b,
+	0xc8, 0x4e, 0xf5, 0xce, 0xce, 0xa6, 0x4b, 0xd4, 0x60, 0x5b, 0xaa, 0x48, 0xa7, 0x25, 0xc2, 0x69,
+	0xdd, 0x54, 0x31, 0xf4, 0x69, 0x18, 0x72, 0x89, 0xcb, 0x5d, 0x54, 0xb3, 0xf8, 0x9c, 0xc5, 0x31,
+	0x61, 0x3e, 0x2d, 0x74, 0x46, 0xcb, 0x0e, 0xc8, 0x89, 0xb5, 0xb9, 0xb5, 0xc8, 0xdc, 0x8c, 0xdd,
+	0x51, 0xd8, 0xae, 0x16, 0xec, 0x93, 0x18, 0x7b, 0x03, 0xf4, 0x18, 0x8b, 0xaa, 0x81, 0x85, 0x3c,
+	0x7f, 0x9b, 0xf0, 0x42, 0x77, 0x04, 0x1e, 0x94, 0x33, 0x0b, 0xd5, 0x60, 0x21, 0xb2, 0x1b, 0xff,
+	0x86, 0xbe, 0x16, 0x82, 0xfa, 0x20, 0x64, 0xb7, 0xb0, 0xdc, 0x39, 0x66, 0xf8, 0x53, 0x1f, 0x86,
+	0xce, 0x1d, 0x54, 0xdb, 0x8e, 0x45, 0x13, 0x83, 0xf9, 0xcc, 0x3d, 0x6d, 0x3e, 0x1f, 0x96, 0x3b,
+	0x96, 0xb8, 0xf8, 0x99, 0x06, 0x7a, 0x23, 0xef, 0xb8, 0x78, 0xfa, 0x79, 0xe8, 0xaa, 0xd9, 0xdc,
+	0x72, 0xab, 0x32, 0x66, 0x67, 0xcd, 0xe6, 0xcb, 0x55, 0x7d, 0x12, 0xfa, 0xa5, 0xde, 0x48, 0x54,
+	0x4c, 0x86, 0xef, 0x13, 0x56, 0x59, 0xc6, 0xb0, 0x2c, 0x61, 0x16, 0xeb, 0xa8, 0x86, 0x88, 0xad,
+	0xca, 0x82, 0xaa, 0xc1, 0xa2, 0xb0, 0xe8, 0x17, 0xa0, 0x8b, 0x71, 0xc4, 0xb7, 0x59, 0xe1, 0x5c,
+	0x34, 0x27, 0x47, 0xc5, 0x9f, 0x35, 0x28, 0x54, 0x98, 0x53, 0x0e, 0xc9, 0xe1, 0x15, 0x9b, 0x9b,
+	0xb8, 0x86, 0xb8, 0xeb, 0x13, 0xb6, 0xe9, 0x06, 0xa7, 0xdd, 0x41, 0xb6, 0xef, 0x05, 0x3e, 0xc1,
+	0x84, 0x5b, 0x28, 0xde, 0x41, 0xca, 0xb4, 0xd0, 0x0a, 0x58, 0x8f, 0xa9, 0x2a, 0xd3, 0xa2, 0x5e,
+	0x80, 0x6e, 0xdb, 0x27, 0x1c, 0xef, 0x72, 0xc9, 0x35, 0x1e, 0xea, 0x23, 0xd0, 0x13, 0x50, 0x7f,
+	0xb7, 0x1e, 0xaa, 0x24, 0xb6, 0x40, 0x77, 0x34, 0x5e, 0xae, 0x26, 0x34, 0xe6, 0x30, 0x91, 0x96,
+	0xd4, 0x51, 0x82, 0x5f, 0x81, 0xfc, 0x16, 0xae, 0x5b, 0x78, 0xd7, 0xde, 0x44, 0xc4, 0x89, 0xab,
+	0xd9, 0xbb, 0x85, 0xeb, 0x4b, 0xd2, 0xd4, 0xa4, 0x65, 0xb6, 0x45, 0xcb, 0xaf, 0x44, 0x65, 0x45,
+	0x6f, 0xae, 0xd8, 0x7c, 0x35, 0x32, 0x9f, 0x4a, 0xc5, 0x06, 0xb9, 0x4c, 0x33, 0xb9, 0xcb, 0x00,
+	0x04, 0x3f, 0xb5, 0x5a, 0x56, 0xcf, 0x11, 0xfc, 0x54, 0xae, 0x74, 0x01, 0xba, 0x28, 0x46, 0xcc,
+	0x27, 0x71, 0x91, 0xc5, 0x28, 0x21, 0xce, 0x28, 0x18, 0x07, 0x59, 0xaa, 0x43, 0xe4, 0x07, 0x0d,
+	0x2e, 0x55, 0x98, 0xb3, 0x86, 0xa9, 0xe7, 0x92, 0x33, 0xda, 0x13, 0x29, 0xd9, 0x34, 0xe8, 0x66,
+	0x9b, 0xe9, 0x86, 0x7b, 0x9e, 0xf8, 0xdc, 0xdd, 0xa8, 0x5b, 0xfe, 0xc6, 0x46, 0xcd, 0x25, 0x38,
+	0x4a, 0xa7, 0xc7, 0xec, 0x13, 0xd6, 0xff, 0x09, 0x63, 0x22, 0xab, 0x49, 0xb8, 0xda, 0x86, 0xb6,
+	0x4a, 0xef, 0x79, 0x06, 0x2e, 0x57, 0x98, 0xb3, 0x1c, 0x35, 0x38, 0xc7, 0x2b, 0xe5, 0xb5, 0x0a,
+	0xae, 0x86, 0xbf, 0xaa, 0x8f, 0x91, 0x1b, 0xb2, 0x3e, 0x55, 0x82, 0x53, 0x20, 0x8f, 0x0c, 0xee,
+	0x53, 0xab, 0x25, 0xd5, 0x7e, 0x65, 0x5f, 0x89, 0x72, 0x2e, 0x42, 0x1f, 0x47, 0xd4, 0xc1, 0x3c,
+	0x86, 0x89, 0xd4, 0x7b, 0x85, 0x51, 0x60, 0xd2, 0x1b, 0x60, 0x02, 0xf2, 0xa2, 0x01, 0xa4, 0xb3,
+	0x68, 0x02, 0x88, 0x6c, 0x2b, 0xf1, 0x0e, 0xc1, 0xbb, 0x81, 0x4b, 0x31, 0xb3, 0x10, 0x8f, 0xce,
+	0xbe, 0xac, 0x99, 0x93, 0x96, 0x05, 0x9e, 0xd0, 0xec, 0xb5, 0x06, 0x93, 0x6d, 0xc5, 0x50, 0xcd,
+	0x72, 0x19, 0x20, 0x10, 0xa6, 0x46, 0xc3, 0xe4, 0xa4, 0x45, 0x54, 0x52, 0xee, 0xc9, 0x4c, 0x73,
+	0x47, 0x84, 0xcd, 0x64, 0x6f, 0xa2, 0x5a, 0x0d, 0x13, 0x07, 0x37, 0x25, 0xab, 0x6c, 0xe2, 0x80,
+	0x6b, 0x40, 0xe4, 0xad, 0xa1, 0x4d, 0xe5, 0xcd, 0x3e, 0x65, 0xfd, 0x4f, 0x78, 0x0d, 0x94, 0xe0,
+	0x6f, 0x61, 0xce, 0xb4, 0xa9, 0xa6, 0x0d, 0x01, 0x86, 0x6a, 0xad, 0xd5, 0x5e, 0xae, 0x16, 0x9f,
+	0x89, 0x3a, 0x97, 0x7d, 0x2f, 0xa8, 0xe1, 0x33, 0xab, 0x73, 0xab, 0x0c, 0x99, 0xa4, 0x0c, 0x37,
+	0xe3, 0x3b, 0x25, 0xdc, 0x06, 0x54, 0x6a, 0x27, 0x93, 0x1e, 0x52, 0x33, 0x4a, 0xd4, 0x6b, 0x30,
+	0x20, 0xf7, 0x82, 0xc2, 0x8a, 0x7a, 0xf7, 0x0b, 0xb3, 0x02, 0x4e, 0xc1, 0x20, 0xc3, 0x8c, 0xb9,
+	0x3e, 0xb1, 0xc2, 0xb3, 0x29, 0x52, 0xa9, 0x33, 0x52, 0xa9, 0x5f, 0xda, 0x1f, 0xe2, 0x7a, 0x28,
+	0x53, 0xa2, 0xbe, 0xbf, 0x65, 0xa2, 0xfa, 0xa6, 0x8b, 0xf0, 0x47, 0xeb, 0x9b, 0x52, 0x95, 0x6c,
+	0x4a, 0x55, 0xf4, 0x25, 0x18, 0xc7, 0xc4, 0xa6, 0xf5, 0x80, 0xe3, 0xaa, 0xd5, 0x9c, 0x92, 0x12,
+	0x47, 0x56, 0x7f, 0x54, 0xc1, 0x56, 0x55, 0x82, 0xcb, 0x31, 0x46, 0x7f, 0x00, 0xa3, 0x87, 0x87,
+	0x11, 0xba, 0x49, 0x6d, 0x46, 0x0e, 0x89, 0xb1, 0x16, 0x01, 0xf4, 0xfb, 0x70, 0x69, 0x13, 0xb1,
+	0x4d, 0x5c, 0xb5, 0x6c, 0xdf, 0x5b, 0x77, 0x49, 0x6b, 0x98, 0xa8, 0x6d, 0xf2, 0x66, 0x41, 0x40,
+	0xca, 0x12, 0xd1, 0x08, 0x12, 0x5e, 0x61, 0x9c, 0x6e, 0x33, 0x6e, 0x31, 0xdb, 0xa7, 0x58, 0x3e,
+	0x1a, 0x20, 0x32, 0xad, 0x86, 0x96, 0xe2, 0xa7, 0x1a, 0x0c, 0x57, 0x98, 0xb3, 0x24, 0x08, 0x44,
+	0xba, 0x33, 0x86, 0x1c, 0x7c, 0x96, 0xa7, 0x67, 0x01, 0xba, 0x3d, 0x11, 0x35, 0xd2, 0x3b, 0x6f,
+	0xc6, 0xc3, 0xc4, 0x26, 0x78, 0x1f, 0x46, 0x0f, 0xa3, 0x72, 0xd4, 0x3d, 0x78, 0x1d, 0x86, 0x1a,
+	0x1a, 0xc7, 0x0b, 0x65, 0xa2, 0x85, 0x06, 0xd5, 0x44, 0x9c, 0x56, 0xda, 0x8d, 0x48, 0xa3, 0xc7,
+	0xea, 0x7f, 0x31, 0xc1, 0x54, 0x9c, 0x2f, 0xe5, 0xb8, 0xa9, 0xcf, 0x50, 0x89, 0x44, 0xbe, 0x04,
+	0xc6, 0x53, 0xd6, 0x3c, 0x2a, 0xe5, 0x51, 0xc8, 0xa9, 0x43, 0x47, 0xa6, 0xda, 0x30, 0xa4, 0xe6,
+	0xf8, 0xad, 0x06, 0xe7, 0x2b, 0xcc, 0x79, 0x82, 0xa9, 0xbb, 0x51, 0xff, 0x93, 0x52, 0x6c, 0xa5,
+	0x96, 0x4d, 0x52, 0x33, 0xa0, 0xa7, 0xe5, 0x04, 0xc9, 0x9b, 0x6a, 0x9c, 0x10, 0xe7, 0xbd, 0xe8,
+	0x54, 0x3c, 0xc8, 0xf5, 0x28, 0x69, 0x0c, 0xe8, 0xd9, 0x09, 0x9d, 0x5c, 0x2c, 0x88, 0xf5, 0x98,
+	0x6a, 0x9c, 0x2a, 0xcc, 0x77, 0x1a, 0x0c, 0x55, 0x98, 0x63, 0xfa, 0x1c, 0x71, 0xbc, 0x1a, 0xd4,
+	0x5c, 0x1e, 0xf6, 0xce, 0x5f, 0xf3, 0x7e, 0x08, 0x0f, 0x03, 0xdb, 0xf7, 0x3c, 0x97, 0x7b, 0x98,
+	0xf0, 0xf8, 0x4a, 0xd9, 0xc2, 0xf5, 0xb2, 0x32, 0x26, 0x94, 0xf9, 0x5a, 0x83, 0x91, 0x03, 0x6c,
+	0x8f, 0x92, 0xe5, 0x2a, 0x84, 0x31, 0x2d, 0x8a, 0x37, 0x30, 0xc5, 0xe1, 0xc3, 0x5b, 0xf0, 0x0b,
+	0x5f, 0x90, 0x66, 0x6c, 0xd3, 0x6f, 0xc3, 0x85, 0x80, 0xe2, 0x1d, 0xd7, 0xdf, 0x66, 0x56, 0x2b,
+	0x5a, 0xd0, 0x1e, 0x8e, 0x67, 0x1f, 0x36, 0x7b, 0x15, 0xa0, 0x7b, 0x07, 0xd3, 0xf0, 0xc4, 0x89,
+	0xd8, 0x9f, 0x33, 0xe3, 0xe1, 0xec, 0x17, 0xbd, 0x90, 0xad, 0x30, 0x47, 0xaf, 0x41, 0xbe, 0xe5,
+	0x93, 0xf6, 0x1f, 0x6d, 0xbf, 0xb3, 0x9a, 0xa1, 0xc6, 0xad, 0x63, 0x43, 0x95, 0x02, 0xef, 0x42,
+	0x77, 0xfc, 0x61, 0xf9, 0xf7, 0xe3, 0x7c, 0xd0, 0x19, 0x37, 0x8e, 0x83, 0x52, 0xe1, 0x3f, 0xd1,
+	0xe0, 0x7c, 0xca, 0x47, 0x48, 0xdb, 0x38, 0x87, 0xfa, 0x18, 0xf3, 0x27, 0xf7, 0x51, 0x4c, 0xb6,
+	0x61, 0x20, 0xf9, 0x82, 0xbf, 0x71, 0x0c, 0xb9, 0x14, 0xda, 0xb8, 0x7d, 0x12, 0xb4, 0x5a, 0xf6,
+	0x85, 0x06, 0x85, 0xd4, 0x47, 0xf7, 0x3f, 0xdb, 0x86, 0x4c, 0x73, 0x33, 0xee, 0x9f, 0xca, 0x4d,
+	0x51, 0xfa, 0x5c, 0x03, 0xa3, 0xcd, 0x43, 0xf9, 0x6e, 0xdb, 0xe8, 0xe9, 0x8e, 0xc6, 0x83, 0x53,
+	0x3a, 0xb6, 0x10, 0x6b, 0xf3, 0xb2, 0x6b, 0x4f, 0x2c, 0xdd, 0xf1, 0x08, 0x62, 0xc7, 0x78, 0x46,
+	0x7d, 0x00, 0x43, 0x07, 0xef, 0xfc, 0x52, 0xdb, 0xa8, 0x07, 0xf0, 0xc6, 0x9d, 0x93, 0xe1, 0xd5,
+	0xe2, 0x1f, 0x6b, 0x30, 0x7c, 0xe8, 0x55, 0xdb, 0xbe, 0xdb, 0x0f, 0x73, 0x31, 0xfe, 0x75, 0x62,
+	0x17, 0x45, 0xe3, 0x43, 0xd0, 0x0f, 0xb9, 0x0b, 0x67, 0xda, 0x06, 0x3c, 0xe8, 0x60, 0xdc, 0x3d,
+	0xa1, 0x83, 0x5a, 0x9f, 0x42, 0x7f, 0xe2, 0xca, 0xb9, 0xde, 0x36, 0x54, 0x2b, 0xd8, 0x98, 0x3b,
+	0x01, 0x38, 0x5e, 0xd3, 0xe8, 0xfc, 0x68, 0x7f, 0x6f, 0x5a, 0x5b, 0xbc, 0xf7, 0xf2, 0xcd, 0x98,
+	0xf6, 0xea, 0xcd, 0x98, 0xf6, 0xeb, 0x9b, 0x31, 0xed, 0xc5, 0xdb, 0xb1, 0x8e, 0x57, 0x6f, 0xc7,
+	0x3a, 0x5e, 0xbf, 0x1d, 0xeb, 0x78, 0x67, 0x4c, 0x06, 0xbd, 0x79, 0xe0, 0xff, 0x7b, 0xbc, 0x1e,
+	0x60, 0xb6, 0xde, 0x15, 0xfd, 0xa7, 0x72, 0xee, 0xf7, 0x00, 0x00, 0x00, 0xff, 0xff, 0x2c, 0x29,
+	0xc1, 0x55, 0x6c, 0x15, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1489,6 +1641,8 @@ type MsgClient interface {
 	GenerateLCTChallenge(ctx context.Context, in *MsgGenerateLCTChallenge, opts ...grpc.CallOption) (*MsgGenerateLCTChallengeResponse, error)
 	// VerifyLCTChallenge defines the VerifyLCTChallenge RPC.
 	VerifyLCTChallenge(ctx context.Context, in *MsgVerifyLCTChallenge, opts ...grpc.CallOption) (*MsgVerifyLCTChallengeResponse, error)
+	// RotateSplitKey replaces an LCT's split key with a new one.
+	RotateSplitKey(ctx context.Context, in *MsgRotateSplitKey, opts ...grpc.CallOption) (*MsgRotateSplitKeyResponse, error)
 }
 
 type msgClient struct {
@@ -1589,6 +1743,15 @@ func (c *msgClient) VerifyLCTChallenge(ctx context.Context, in *MsgVerifyLCTChal
 	return out, nil
 }
 
+func (c *msgClient) RotateSplitKey(ctx context.Context, in *MsgRotateSplitKey, opts ...grpc.CallOption) (*MsgRotateSplitKeyResponse, error) {
+	out := new(MsgRotateSplitKeyResponse)
+	err := c.cc.Invoke(ctx, "/racecarweb.lctmanager.v1.Msg/RotateSplitKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// UpdateParams defines a (governance) operation for updating the module
@@ -1612,6 +1775,8 @@ type MsgServer interface {
 	GenerateLCTChallenge(context.Context, *MsgGenerateLCTChallenge) (*MsgGenerateLCTChallengeResponse, error)
 	// VerifyLCTChallenge defines the VerifyLCTChallenge RPC.
 	VerifyLCTChallenge(context.Context, *MsgVerifyLCTChallenge) (*MsgVerifyLCTChallengeResponse, error)
+	// RotateSplitKey replaces an LCT's split key with a new one.
+	RotateSplitKey(context.Context, *MsgRotateSplitKey) (*MsgRotateSplitKeyResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -1648,6 +1813,9 @@ func (*UnimplementedMsgServer) GenerateLCTChallenge(ctx context.Context, req *Ms
 func (*UnimplementedMsgServer) VerifyLCTChallenge(ctx context.Context, req *MsgVerifyLCTChallenge) (*MsgVerifyLCTChallengeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method VerifyLCTChallenge not implemented")
 }
+func (*UnimplementedMsgServer) RotateSplitKey(ctx context.Context, req *MsgRotateSplitKey) (*MsgRotateSplitKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateSplitKey not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1833,6 +2001,24 @@ func _Msg_VerifyLCTChallenge_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RotateSplitKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRotateSplitKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RotateSplitKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/racecarweb.lctmanager.v1.Msg/RotateSplitKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RotateSplitKey(ctx, req.(*MsgRotateSplitKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "racecarweb.lctmanager.v1.Msg",
@@ -1878,6 +2064,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "VerifyLCTChallenge",
 			Handler:    _Msg_VerifyLCTChallenge_Handler,
 		},
+		{
+			MethodName: "RotateSplitKey",
+			Handler:    _Msg_RotateSplitKey_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "racecarweb/lctmanager/v1/tx.proto",
@@ -2852,6 +3042,106 @@ func (m *MsgVerifyLCTChallengeResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgRotateSplitKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRotateSplitKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRotateSplitKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.KeyCommitment) > 0 {
+		i -= len(m.KeyCommitment)
+		copy(dAtA[i:], m.KeyCommitment)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.KeyCommitment)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.LctId) > 0 {
+		i -= len(m.LctId)
+		copy(dAtA[i:], m.LctId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.LctId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRotateSplitKeyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRotateSplitKeyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRotateSplitKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Version != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.PreviousKeyReference) > 0 {
+		i -= len(m.PreviousKeyReference)
+		copy(dAtA[i:], m.PreviousKeyReference)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PreviousKeyReference)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.KeyReference) > 0 {
+		i -= len(m.KeyReference)
+		copy(dAtA[i:], m.KeyReference)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.KeyReference)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.LctId) > 0 {
+		i -= len(m.LctId)
+		copy(dAtA[i:], m.LctId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.LctId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -3322,6 +3612,55 @@ func (m *MsgVerifyLCTChallengeResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgRotateSplitKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.LctId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.KeyCommitment)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRotateSplitKeyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.LctId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.KeyReference)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PreviousKeyReference)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovTx(uint64(m.Version))
+	}
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -6647,6 +6986,351 @@ func (m *MsgVerifyLCTChallengeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgRotateSplitKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRotateSplitKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRotateSplitKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LctId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LctId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyCommitment", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyCommitment = append(m.KeyCommitment[:0], dAtA[iNdEx:postIndex]...)
+			if m.KeyCommitment == nil {
+				m.KeyCommitment = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRotateSplitKeyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRotateSplitKeyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRotateSplitKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LctId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LctId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyReference", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyReference = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousKeyReference", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviousKeyReference = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
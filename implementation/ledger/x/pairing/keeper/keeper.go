@@ -27,11 +27,16 @@ type Keeper struct {
 
 	// Collections for pairing sessions
 	PairingSessions collections.Map[string, types.PairingSession]
+	// LctSessionIndex lists the pairing sessions of each LCT: (lct_id, session_id) -> session_id
+	LctSessionIndex collections.Map[collections.Pair[string, string], string]
+	// PendingExpiryIndex orders the pending sessions by expiry: (expires_at, session_id) -> session_id
+	PendingExpiryIndex collections.Map[collections.Pair[int64, string], string]
 
 	bankKeeper              types.BankKeeper
 	componentregistryKeeper componentregistrytypes.ComponentregistryKeeper
 	pairingqueueKeeper      types.PairingqueueKeeper
 	lctmanagerKeeper        lctmanagertypes.LctmanagerKeeper
+	trusttensorKeeper       types.TrusttensorKeeper
 }
 
 func NewKeeper(
@@ -44,6 +49,7 @@ func NewKeeper(
 	componentregistryKeeper componentregistrytypes.ComponentregistryKeeper,
 	pairingqueueKeeper types.PairingqueueKeeper,
 	lctmanagerKeeper lctmanagertypes.LctmanagerKeeper,
+	trusttensorKeeper types.TrusttensorKeeper,
 ) Keeper {
 	if _, err := addressCodec.BytesToString(authority); err != nil {
 		panic(fmt.Sprintf("invalid authority address %s: %s", authority, err))
@@ -61,8 +67,11 @@ func NewKeeper(
 		componentregistryKeeper: componentregistryKeeper,
 		pairingqueueKeeper:      pairingqueueKeeper,
 		lctmanagerKeeper:        lctmanagerKeeper,
+		trusttensorKeeper:       trusttensorKeeper,
 		Params:                  collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
 		PairingSessions:         collections.NewMap(sb, types.PairingSessionPrefix, "pairing_sessions", collections.StringKey, codec.CollValue[types.PairingSession](cdc)),
+		LctSessionIndex:         collections.NewMap(sb, types.LctSessionIndexPrefix, "lct_session_index", collections.PairKeyCodec(collections.StringKey, collections.StringKey), collections.StringValue),
+		PendingExpiryIndex:      collections.NewMap(sb, types.PendingExpiryIndexPrefix, "pending_expiry_index", collections.PairKeyCodec(collections.Int64Key, collections.StringKey), collections.StringValue),
 	}
 
 	schema, err := sb.Build()
@@ -80,5 +89,5 @@ func (k Keeper) GetAuthority() []byte {
 }
 
 func (k Keeper) GetParams(ctx context.Context) (types.Params, error) {
-	return types.Params{}, nil
+	return k.Params.Get(ctx)
 }
@@ -2,9 +2,12 @@ package keeper_test
 
 import (
 	"context"
+	"encoding/hex"
+	"fmt"
 	"testing"
 
 	"cosmossdk.io/core/address"
+	"cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
 	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
 	"github.com/cosmos/cosmos-sdk/runtime"
@@ -13,6 +16,7 @@ import (
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
+	lctmanagertypes "racecar-web/x/lctmanager/types"
 	"racecar-web/x/pairing/keeper"
 	module "racecar-web/x/pairing/module"
 	"racecar-web/x/pairing/types"
@@ -22,6 +26,62 @@ type fixture struct {
 	ctx          context.Context
 	keeper       keeper.Keeper
 	addressCodec address.Codec
+	lctmanager   *fakeLctmanagerKeeper
+	trusttensor  *fakeTrusttensorKeeper
+}
+
+// fakeTrusttensorKeeper scores only the LCTs given a score
+type fakeTrusttensorKeeper struct {
+	scores map[string]math.LegacyDec
+}
+
+func (f *fakeTrusttensorKeeper) CalculateT3CompositeScore(ctx context.Context, lctID string) (math.LegacyDec, error) {
+	score, ok := f.scores[lctID]
+	if !ok {
+		return math.LegacyZeroDec(), fmt.Errorf("tensor not found for LCT: %s", lctID)
+	}
+	return score, nil
+}
+
+// fakeLctmanagerKeeper tracks LCT statuses in memory
+type fakeLctmanagerKeeper struct {
+	lcts       map[string]lctmanagertypes.LinkedContextToken
+	terminated []string
+	splitKeys  map[string][]byte // LCT ID -> the commitment its split key was issued with
+}
+
+func (f *fakeLctmanagerKeeper) GetLinkedContextToken(ctx context.Context, lctId string) (lctmanagertypes.LinkedContextToken, bool) {
+	lct, ok := f.lcts[lctId]
+	return lct, ok
+}
+
+func (f *fakeLctmanagerKeeper) GetComponentRelationships(ctx context.Context, componentId string) ([]lctmanagertypes.LinkedContextToken, error) {
+	return nil, nil
+}
+
+func (f *fakeLctmanagerKeeper) CreateLCTRelationship(ctx context.Context, componentA, componentB, operationalContext, proxyId string) (string, string, error) {
+	lctId := fmt.Sprintf("lct-%s-%s", componentA, componentB)
+	f.lcts[lctId] = lctmanagertypes.LinkedContextToken{LctId: lctId, ComponentAId: componentA, ComponentBId: componentB, PairingStatus: lctmanagertypes.StatusPending}
+	return lctId, "", nil
+}
+
+func (f *fakeLctmanagerKeeper) TerminateLctRelationship(ctx context.Context, lctId, reason string, notifyOffline bool) error {
+	lct, ok := f.lcts[lctId]
+	if !ok {
+		return lctmanagertypes.ErrLctNotFound
+	}
+	lct.PairingStatus = lctmanagertypes.StatusTerminated
+	f.lcts[lctId] = lct
+	f.terminated = append(f.terminated, lctId)
+	return nil
+}
+
+func (f *fakeLctmanagerKeeper) IssueSplitKey(ctx context.Context, lctId string, commitment []byte) (string, error) {
+	if f.splitKeys == nil {
+		f.splitKeys = make(map[string][]byte)
+	}
+	f.splitKeys[lctId] = commitment
+	return hex.EncodeToString(commitment), nil
 }
 
 func initFixture(t *testing.T) *fixture {
@@ -35,6 +95,8 @@ func initFixture(t *testing.T) *fixture {
 	ctx := testutil.DefaultContextWithDB(t, storeKey, storetypes.NewTransientStoreKey("transient_test")).Ctx
 
 	authority := authtypes.NewModuleAddress(types.GovModuleName)
+	lctmanager := &fakeLctmanagerKeeper{lcts: make(map[string]lctmanagertypes.LinkedContextToken)}
+	trusttensor := &fakeTrusttensorKeeper{scores: make(map[string]math.LegacyDec)}
 
 	k := keeper.NewKeeper(
 		storeService,
@@ -44,7 +106,8 @@ func initFixture(t *testing.T) *fixture {
 		nil,
 		nil,
 		nil,
-		nil,
+		lctmanager,
+		trusttensor,
 	)
 
 	// Initialize params
@@ -56,5 +119,7 @@ func initFixture(t *testing.T) *fixture {
 		ctx:          ctx,
 		keeper:       k,
 		addressCodec: addressCodec,
+		lctmanager:   lctmanager,
+		trusttensor:  trusttensor,
 	}
 }
@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"racecar-web/x/pairing/types"
+)
+
+// Migrator runs the module's store migrations
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a Migrator for the keeper's store
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 indexes the pairing sessions stored before they were looked up
+// by LCT
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	return m.keeper.PairingSessions.Walk(ctx, nil, func(sessionID string, session types.PairingSession) (bool, error) {
+		return false, m.keeper.LctSessionIndex.Set(ctx, collections.Join(session.LctId, sessionID), sessionID)
+	})
+}
+
+// Migrate2to3 orders the pending sessions stored before the expiry index
+// existed, so that the challenge sweep finds them, and terminates the pending
+// LCTs that earlier sweeps left behind when they expired a challenge
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	// Collect first; the map must not be written while it is being walked
+	var pending, expired []types.PairingSession
+	err := m.keeper.PairingSessions.Walk(ctx, nil, func(_ string, session types.PairingSession) (bool, error) {
+		switch session.Status {
+		case types.SessionStatusPending:
+			pending = append(pending, session)
+		case types.SessionStatusExpired:
+			expired = append(expired, session)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, session := range pending {
+		if err := m.keeper.PendingExpiryIndex.Set(ctx, collections.Join(session.ExpiresAt, session.SessionId), session.SessionId); err != nil {
+			return err
+		}
+	}
+	for _, session := range expired {
+		if err := m.keeper.releasePendingLct(ctx, session.LctId, ctx.BlockTime()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
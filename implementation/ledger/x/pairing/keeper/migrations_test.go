@@ -0,0 +1,61 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	lctmanagertypes "racecar-web/x/lctmanager/types"
+	"racecar-web/x/pairing/keeper"
+	"racecar-web/x/pairing/types"
+)
+
+func TestMigrate1to2IndexesSessionsByLct(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+
+	// Stored by version 1, which kept no index
+	for _, id := range []string{"challenge-1", "challenge-2"} {
+		require.NoError(t, f.keeper.PairingSessions.Set(ctx, id, types.PairingSession{SessionId: id, LctId: "lct-pack-motor"}))
+	}
+	require.NoError(t, f.keeper.PairingSessions.Set(ctx, "challenge-3", types.PairingSession{SessionId: "challenge-3", LctId: "lct-other"}))
+	sessions, err := f.keeper.GetLctSessions(ctx, "lct-pack-motor")
+	require.NoError(t, err)
+	require.Empty(t, sessions)
+
+	require.NoError(t, keeper.NewMigrator(f.keeper).Migrate1to2(ctx))
+
+	sessions, err = f.keeper.GetLctSessions(ctx, "lct-pack-motor")
+	require.NoError(t, err)
+	require.Len(t, sessions, 2)
+	require.Equal(t, "challenge-1", sessions[0].SessionId)
+	require.Equal(t, "challenge-2", sessions[1].SessionId)
+}
+
+func TestMigrate2to3IndexesPendingSessionsByExpiry(t *testing.T) {
+	f := initFixture(t)
+	now := time.Unix(1752484904, 0).UTC()
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(now)
+
+	// Stored by version 2: one open challenge and one an earlier sweep expired
+	// without terminating its LCT
+	for _, session := range []types.PairingSession{
+		{SessionId: "challenge-open", LctId: "lct-open", Status: types.SessionStatusPending, ExpiresAt: now.Add(time.Minute).Unix()},
+		{SessionId: "challenge-expired", LctId: "lct-expired", Status: types.SessionStatusExpired, ExpiresAt: now.Add(-time.Minute).Unix()},
+	} {
+		f.lctmanager.lcts[session.LctId] = lctmanagertypes.LinkedContextToken{LctId: session.LctId, PairingStatus: lctmanagertypes.StatusPending}
+		require.NoError(t, f.keeper.PairingSessions.Set(ctx, session.SessionId, session))
+	}
+
+	require.NoError(t, keeper.NewMigrator(f.keeper).Migrate2to3(ctx))
+
+	require.Equal(t, []string{"lct-expired"}, f.lctmanager.terminated)
+
+	// Once its window passes, the sweep finds the open challenge through the index
+	swept, err := f.keeper.SweepExpiredChallenges(ctx.WithBlockTime(now.Add(2 * time.Minute)))
+	require.NoError(t, err)
+	require.Equal(t, 1, swept)
+	require.Equal(t, []string{"lct-expired", "lct-open"}, f.lctmanager.terminated)
+}
@@ -31,9 +31,13 @@ func (ms msgServer) CompletePairing(ctx context.Context, msg *types.MsgCompleteP
 		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "pairing session not found: %s", msg.ChallengeId)
 	}
 
-	// Check if session has expired
-	if session.ExpiresAt < time.Now().Unix() {
-		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "pairing session has expired")
+	// Only a pending session can complete
+	switch sessionStatus(session, sdk.UnwrapSDKContext(ctx).BlockTime()) {
+	case types.SessionStatusPending:
+	case types.SessionStatusExpired:
+		return nil, errorsmod.Wrapf(types.ErrChallengeExpired, "challenge %s expired at %d", msg.ChallengeId, session.ExpiresAt)
+	default:
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "pairing session is %s", session.Status)
 	}
 
 	// Validate component A authentication
@@ -48,13 +52,26 @@ func (ms msgServer) CompletePairing(ctx context.Context, msg *types.MsgCompleteP
 		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "component B authentication failed")
 	}
 
+	// The relationship must be trusted enough, unless its rules say otherwise
+	trustScore, minTrust, err := ms.CheckPairingTrust(ctx, session.LctId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Record the pairing's split key. The key is generated off-chain, so
+	// only its commitment is in the message and only its reference on chain.
+	keyReference, err := ms.lctmanagerKeeper.IssueSplitKey(ctx, session.LctId, msg.KeyCommitment)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to issue split key")
+	}
+
 	// Update session status to completed
-	session.Status = "completed"
+	session.Status = types.SessionStatusCompleted
 	session.EstablishedAt = time.Now().Unix()
 	session.SessionKeys = fmt.Sprintf("session_keys_%s_%d", msg.ChallengeId, time.Now().Unix())
 
 	// Store updated session
-	if err := ms.PairingSessions.Set(ctx, msg.ChallengeId, session); err != nil {
+	if err := ms.SetPairingSession(ctx, session); err != nil {
 		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "failed to update pairing session: %s", err)
 	}
 
@@ -65,6 +82,8 @@ func (ms msgServer) CompletePairing(ctx context.Context, msg *types.MsgCompleteP
 			sdk.NewAttribute("challenge_id", msg.ChallengeId),
 			sdk.NewAttribute("lct_id", session.LctId),
 			sdk.NewAttribute("status", "completed"),
+			sdk.NewAttribute("trust_score", trustScore.String()),
+			sdk.NewAttribute("key_reference", keyReference),
 			sdk.NewAttribute("creator", msg.Creator),
 			sdk.NewAttribute("established_at", fmt.Sprintf("%d", session.EstablishedAt)),
 		),
@@ -73,6 +92,7 @@ func (ms msgServer) CompletePairing(ctx context.Context, msg *types.MsgCompleteP
 	return &types.MsgCompletePairingResponse{
 		LctId:        session.LctId,
 		SessionKeys:  session.SessionKeys,
-		TrustSummary: fmt.Sprintf("trust_score:0.85,context:pairing_completed"),
+		TrustSummary: fmt.Sprintf("trust_score:%s,min_trust_score:%s,context:pairing_completed", trustScore, minTrust),
+		KeyReference: keyReference,
 	}, nil
 }
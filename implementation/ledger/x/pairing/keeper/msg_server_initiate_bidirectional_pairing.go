@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"time"
 
+	lctmanagertypes "racecar-web/x/lctmanager/types"
 	"racecar-web/x/pairing/types"
 
 	errorsmod "cosmossdk.io/errors"
@@ -50,27 +51,40 @@ func (ms msgServer) InitiateBidirectionalPairing(ctx context.Context, msg *types
 		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "failed to generate challenge data: %s", err)
 	}
 
-	// Create pairing session with correct fields
+	// Stored the way the LCT manager stores contexts of directly created LCTs
+	operationalContext, err := lctmanagertypes.NormalizeOperationalContext(msg.OperationalContext)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid operational context: %s", err)
+	}
+
+	// Create LCT relationship using LCT manager
+	lctId, _, err := ms.lctmanagerKeeper.CreateLCTRelationship(ctx, msg.ComponentA, msg.ComponentB, operationalContext, msg.ProxyId)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "failed to create LCT relationship: %s", err)
+	}
+
+	// Create pairing session for the LCT the pairing will activate
+	ttl, err := ms.ChallengeTTL(ctx)
+	if err != nil {
+		return nil, err
+	}
+	now := sdk.UnwrapSDKContext(ctx).BlockTime()
 	session := types.PairingSession{
 		SessionId:     challengeId,
-		LctId:         fmt.Sprintf("lct-%s-%s-%d", msg.ComponentA, msg.ComponentB, time.Now().Unix()),
-		SessionKeys:   "",                                     // Will be set when pairing completes
-		EstablishedAt: 0,                                      // Will be set when pairing completes
-		ExpiresAt:     time.Now().Add(5 * time.Minute).Unix(), // 5 minute timeout
-		Status:        "pending",
+		LctId:         lctId,
+		SessionKeys:   "", // Will be set when pairing completes
+		EstablishedAt: 0,  // Will be set when pairing completes
+		ExpiresAt:     now.Add(ttl).Unix(),
+		Status:        types.SessionStatusPending,
+		CreatedAt:     now.Unix(),
+		Creator:       msg.Creator,
 	}
 
 	// Store pairing session
-	if err := ms.PairingSessions.Set(ctx, challengeId, session); err != nil {
+	if err := ms.SetPairingSession(ctx, session); err != nil {
 		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "failed to store pairing session: %s", err)
 	}
 
-	// Create LCT relationship using LCT manager
-	lctId, _, err := ms.lctmanagerKeeper.CreateLCTRelationship(ctx, msg.ComponentA, msg.ComponentB, msg.OperationalContext, msg.ProxyId)
-	if err != nil {
-		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "failed to create LCT relationship: %s", err)
-	}
-
 	// Check if components are offline and need queueing
 	queueId := ""
 	if msg.ForceImmediate {
@@ -92,7 +106,7 @@ func (ms msgServer) InitiateBidirectionalPairing(ctx context.Context, msg *types
 			sdk.NewAttribute("lct_id", lctId),
 			sdk.NewAttribute("status", "pending"),
 			sdk.NewAttribute("creator", msg.Creator),
-			sdk.NewAttribute("operational_context", msg.OperationalContext),
+			sdk.NewAttribute("operational_context", operationalContext),
 		),
 	)
 
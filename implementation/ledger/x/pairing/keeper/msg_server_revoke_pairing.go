@@ -2,19 +2,86 @@ package keeper
 
 import (
 	"context"
+	"fmt"
 
+	lctmanagertypes "racecar-web/x/lctmanager/types"
 	"racecar-web/x/pairing/types"
 
 	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
+// canRevokePairing reports whether signer may revoke the pairing of lct: the
+// module authority, the LCT's trust anchor or an account that initiated one
+// of its pairing sessions
+func (ms msgServer) canRevokePairing(signer string, lct lctmanagertypes.LinkedContextToken, sessions []types.PairingSession) bool {
+	if authority, err := ms.addressCodec.BytesToString(ms.authority); err == nil && authority == signer {
+		return true
+	}
+	if lct.TrustAnchor != "" && lct.TrustAnchor == signer {
+		return true
+	}
+	for _, session := range sessions {
+		if session.Creator != "" && session.Creator == signer {
+			return true
+		}
+	}
+	return false
+}
+
 func (ms msgServer) RevokePairing(ctx context.Context, msg *types.MsgRevokePairing) (*types.MsgRevokePairingResponse, error) {
 	if msg.Creator == "" || msg.LctId == "" {
 		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "missing required fields")
 	}
 
-	// TODO: Implement actual pairing revocation logic
+	lct, found := ms.lctmanagerKeeper.GetLinkedContextToken(ctx, msg.LctId)
+	if !found {
+		return nil, errorsmod.Wrap(lctmanagertypes.ErrLctNotFound, msg.LctId)
+	}
+	sessions, err := ms.GetLctSessions(ctx, msg.LctId)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "failed to look up pairing sessions: %s", err)
+	}
+	if !ms.canRevokePairing(msg.Creator, lct, sessions) {
+		return nil, errorsmod.Wrapf(types.ErrUnauthorized, "%s is not a participant in %s", msg.Creator, msg.LctId)
+	}
+
+	// Terminate the LCT; the LCT manager queues offline notifications for the
+	// components (or their proxy) when asked to
+	if err := ms.lctmanagerKeeper.TerminateLctRelationship(ctx, msg.LctId, msg.Reason, msg.NotifyOffline); err != nil {
+		return nil, errorsmod.Wrapf(err, "failed to revoke pairing of %s", msg.LctId)
+	}
+
+	// Close the pairing sessions of this LCT: a pending one is cancelled and a
+	// completed one revoked
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	now := sdkCtx.BlockTime()
+	closed := 0
+	for _, session := range sessions {
+		switch sessionStatus(session, now) {
+		case types.SessionStatusPending:
+			session.Status = types.SessionStatusCancelled
+		case types.SessionStatusCompleted:
+			session.Status = types.SessionStatusRevoked
+		default:
+			continue
+		}
+		if err := ms.SetPairingSession(ctx, session); err != nil {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "failed to update pairing session: %s", err)
+		}
+		closed++
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent("pairing_revoked",
+			sdk.NewAttribute("lct_id", msg.LctId),
+			sdk.NewAttribute("reason", msg.Reason),
+			sdk.NewAttribute("creator", msg.Creator),
+			sdk.NewAttribute("notify_offline", fmt.Sprintf("%t", msg.NotifyOffline)),
+			sdk.NewAttribute("sessions_closed", fmt.Sprintf("%d", closed)),
+		),
+	)
 
 	return &types.MsgRevokePairingResponse{}, nil
 }
@@ -0,0 +1,94 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	lctmanagertypes "racecar-web/x/lctmanager/types"
+	"racecar-web/x/pairing/keeper"
+	"racecar-web/x/pairing/types"
+)
+
+func TestRevokePairing(t *testing.T) {
+	f := initFixture(t)
+	now := time.Unix(1752484904, 0).UTC()
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(now)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+
+	f.lctmanager.lcts["lct-pack-motor"] = lctmanagertypes.LinkedContextToken{LctId: "lct-pack-motor", PairingStatus: lctmanagertypes.StatusActive}
+	require.NoError(t, f.keeper.SetPairingSession(ctx, types.PairingSession{
+		SessionId: "challenge-pack-motor",
+		LctId:     "lct-pack-motor",
+		Status:    types.SessionStatusCompleted,
+		ExpiresAt: now.Add(time.Minute).Unix(),
+		Creator:   "creator",
+	}))
+
+	// Only a participant can revoke the pairing
+	_, err := ms.RevokePairing(ctx, &types.MsgRevokePairing{Creator: "stranger", LctId: "lct-pack-motor"})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	require.Empty(t, f.lctmanager.terminated)
+
+	_, err = ms.RevokePairing(ctx, &types.MsgRevokePairing{
+		Creator:       "creator",
+		LctId:         "lct-pack-motor",
+		Reason:        "battery replaced",
+		NotifyOffline: true,
+	})
+	require.NoError(t, err)
+
+	require.Equal(t, []string{"lct-pack-motor"}, f.lctmanager.terminated)
+	require.Equal(t, lctmanagertypes.StatusTerminated, f.lctmanager.lcts["lct-pack-motor"].PairingStatus)
+	session, err := f.keeper.PairingSessions.Get(ctx, "challenge-pack-motor")
+	require.NoError(t, err)
+	require.Equal(t, types.SessionStatusRevoked, session.Status)
+
+	var revoked bool
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == "pairing_revoked" {
+			revoked = true
+		}
+	}
+	require.True(t, revoked)
+
+	// A pairing still waiting to complete is cancelled rather than revoked
+	f.lctmanager.lcts["lct-pack-cell"] = lctmanagertypes.LinkedContextToken{LctId: "lct-pack-cell", PairingStatus: lctmanagertypes.StatusPending}
+	require.NoError(t, f.keeper.SetPairingSession(ctx, types.PairingSession{
+		SessionId: "challenge-pack-cell",
+		LctId:     "lct-pack-cell",
+		Status:    types.SessionStatusPending,
+		ExpiresAt: now.Add(time.Minute).Unix(),
+		Creator:   "creator",
+	}))
+	_, err = ms.RevokePairing(ctx, &types.MsgRevokePairing{Creator: "creator", LctId: "lct-pack-cell"})
+	require.NoError(t, err)
+	session, err = f.keeper.PairingSessions.Get(ctx, "challenge-pack-cell")
+	require.NoError(t, err)
+	require.Equal(t, types.SessionStatusCancelled, session.Status)
+
+	// Unknown LCTs cannot be revoked
+	_, err = ms.RevokePairing(ctx, &types.MsgRevokePairing{Creator: "creator", LctId: "lct-unknown"})
+	require.ErrorIs(t, err, lctmanagertypes.ErrLctNotFound)
+}
+
+func TestRevokePairingByTrustAnchorOrAuthority(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(1752484904, 0))
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	authority, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+
+	f.lctmanager.lcts["lct-anchored"] = lctmanagertypes.LinkedContextToken{LctId: "lct-anchored", TrustAnchor: "anchor", PairingStatus: lctmanagertypes.StatusActive}
+	f.lctmanager.lcts["lct-governed"] = lctmanagertypes.LinkedContextToken{LctId: "lct-governed", PairingStatus: lctmanagertypes.StatusActive}
+
+	_, err = ms.RevokePairing(ctx, &types.MsgRevokePairing{Creator: "anchor", LctId: "lct-anchored"})
+	require.NoError(t, err)
+	_, err = ms.RevokePairing(ctx, &types.MsgRevokePairing{Creator: "anchor", LctId: "lct-governed"})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	_, err = ms.RevokePairing(ctx, &types.MsgRevokePairing{Creator: authority, LctId: "lct-governed"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"lct-anchored", "lct-governed"}, f.lctmanager.terminated)
+}
@@ -0,0 +1,145 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	lctmanagertypes "racecar-web/x/lctmanager/types"
+	"racecar-web/x/pairing/types"
+)
+
+// maxExpirationsPerBlock bounds the writes a single begin-block sweep
+// performs; any remaining expired challenges are picked up in later blocks
+const maxExpirationsPerBlock = 100
+
+// sessionStatus reports the status a pairing session has at now. A pending
+// session whose challenge window has passed is expired even before the sweep
+// rewrites it in the store.
+func sessionStatus(session types.PairingSession, now time.Time) string {
+	if session.Status == types.SessionStatusPending && session.ExpiresAt < now.Unix() {
+		return types.SessionStatusExpired
+	}
+	return session.Status
+}
+
+// remainingTTL is how many seconds a pending session has left to complete
+func remainingTTL(session types.PairingSession, now time.Time) int64 {
+	if sessionStatus(session, now) != types.SessionStatusPending {
+		return 0
+	}
+	return session.ExpiresAt - now.Unix()
+}
+
+// SetPairingSession stores a pairing session and indexes it under its LCT
+// and, while it is pending, under its expiry
+func (k Keeper) SetPairingSession(ctx context.Context, session types.PairingSession) error {
+	if err := k.PairingSessions.Set(ctx, session.SessionId, session); err != nil {
+		return err
+	}
+	if err := k.LctSessionIndex.Set(ctx, collections.Join(session.LctId, session.SessionId), session.SessionId); err != nil {
+		return err
+	}
+	expiryKey := collections.Join(session.ExpiresAt, session.SessionId)
+	if session.Status == types.SessionStatusPending {
+		return k.PendingExpiryIndex.Set(ctx, expiryKey, session.SessionId)
+	}
+	return k.PendingExpiryIndex.Remove(ctx, expiryKey)
+}
+
+// GetLctSessions returns the pairing sessions of an LCT
+func (k Keeper) GetLctSessions(ctx context.Context, lctID string) ([]types.PairingSession, error) {
+	var sessions []types.PairingSession
+	rng := collections.NewPrefixedPairRange[string, string](lctID)
+	err := k.LctSessionIndex.Walk(ctx, rng, func(_ collections.Pair[string, string], sessionID string) (bool, error) {
+		session, err := k.PairingSessions.Get(ctx, sessionID)
+		if err != nil {
+			return true, err
+		}
+		sessions = append(sessions, session)
+		return false, nil
+	})
+	return sessions, err
+}
+
+// ChallengeTTL returns how long a new pairing challenge stays open
+func (k Keeper) ChallengeTTL(ctx context.Context) (time.Duration, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return 0, err
+	}
+	ttl := params.ChallengeTtlSeconds
+	if ttl <= 0 {
+		ttl = types.DefaultChallengeTTLSeconds
+	}
+	return time.Duration(ttl) * time.Second, nil
+}
+
+// SweepExpiredChallenges marks pending pairing sessions past their expiry as
+// expired, terminates the pending LCTs they leave without an open challenge
+// and returns how many sessions were transitioned
+func (k Keeper) SweepExpiredChallenges(ctx context.Context) (int, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	now := sdkCtx.BlockTime()
+
+	// Collect first; the index must not be written while it is being walked.
+	// It is ordered by expiry, so the walk stops at the first open challenge.
+	var expired []types.PairingSession
+	rng := new(collections.Range[collections.Pair[int64, string]]).EndExclusive(collections.Join(now.Unix(), ""))
+	err := k.PendingExpiryIndex.Walk(ctx, rng, func(_ collections.Pair[int64, string], sessionID string) (bool, error) {
+		session, err := k.PairingSessions.Get(ctx, sessionID)
+		if err != nil {
+			return true, err
+		}
+		expired = append(expired, session)
+		return len(expired) >= maxExpirationsPerBlock, nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	for _, session := range expired {
+		session.Status = types.SessionStatusExpired
+		if err := k.SetPairingSession(ctx, session); err != nil {
+			return 0, err
+		}
+		if err := k.releasePendingLct(ctx, session.LctId, now); err != nil {
+			return 0, err
+		}
+
+		sdkCtx.EventManager().EmitEvent(
+			sdk.NewEvent("pairing_challenge_expired",
+				sdk.NewAttribute("challenge_id", session.SessionId),
+				sdk.NewAttribute("lct_id", session.LctId),
+				sdk.NewAttribute("expires_at", fmt.Sprintf("%d", session.ExpiresAt)),
+			),
+		)
+	}
+
+	return len(expired), nil
+}
+
+// releasePendingLct terminates an LCT that was created for pairing and never
+// paired, once none of its challenges can still be completed
+func (k Keeper) releasePendingLct(ctx context.Context, lctID string, now time.Time) error {
+	lct, found := k.lctmanagerKeeper.GetLinkedContextToken(ctx, lctID)
+	if !found || lct.PairingStatus != lctmanagertypes.StatusPending {
+		return nil
+	}
+
+	sessions, err := k.GetLctSessions(ctx, lctID)
+	if err != nil {
+		return err
+	}
+	for _, session := range sessions {
+		if sessionStatus(session, now) == types.SessionStatusPending {
+			return nil
+		}
+	}
+
+	return k.lctmanagerKeeper.TerminateLctRelationship(ctx, lctID, "pairing challenge expired", false)
+}
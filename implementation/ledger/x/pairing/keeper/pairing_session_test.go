@@ -0,0 +1,86 @@
+package keeper_test
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	lctmanagertypes "racecar-web/x/lctmanager/types"
+	"racecar-web/x/pairing/keeper"
+	"racecar-web/x/pairing/types"
+)
+
+func TestChallengeExpiry(t *testing.T) {
+	f := initFixture(t)
+	now := time.Unix(1752484904, 0).UTC()
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(now)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+
+	ttl, err := f.keeper.ChallengeTTL(ctx)
+	require.NoError(t, err)
+	require.Equal(t, time.Duration(types.DefaultChallengeTTLSeconds)*time.Second, ttl)
+
+	require.NoError(t, f.keeper.Params.Set(ctx, types.NewParams(60, types.DefaultMinTrustScore)))
+	ttl, err = f.keeper.ChallengeTTL(ctx)
+	require.NoError(t, err)
+	require.Equal(t, time.Minute, ttl)
+
+	for _, id := range []string{"challenge-open", "challenge-stale"} {
+		expiresAt := now.Add(time.Minute).Unix()
+		if id == "challenge-stale" {
+			expiresAt = now.Add(-time.Second).Unix()
+		}
+		lctId := "lct-" + id
+		f.lctmanager.lcts[lctId] = lctmanagertypes.LinkedContextToken{LctId: lctId, ComponentAId: "MOD-1", ComponentBId: "PACK-1", PairingStatus: lctmanagertypes.StatusPending}
+		require.NoError(t, f.keeper.SetPairingSession(ctx, types.PairingSession{
+			SessionId: id,
+			LctId:     lctId,
+			Status:    types.SessionStatusPending,
+			ExpiresAt: expiresAt,
+		}))
+	}
+
+	auth := func(challengeId, component string) string {
+		hash := sha256.Sum256([]byte(challengeId + component))
+		return hex.EncodeToString(hash[:])
+	}
+	commitment := func(challengeId string) []byte {
+		hash := sha256.Sum256([]byte(challengeId + "key"))
+		return hash[:]
+	}
+	complete := func(challengeId string) error {
+		_, err := ms.CompletePairing(ctx, &types.MsgCompletePairing{
+			Creator:        sdk.AccAddress([]byte("pairing_creator_____")).String(),
+			ChallengeId:    challengeId,
+			ComponentAAuth: auth(challengeId, "component_a"),
+			ComponentBAuth: auth(challengeId, "component_b"),
+			KeyCommitment:  commitment(challengeId),
+		})
+		return err
+	}
+
+	// Expired before the sweep has run
+	require.ErrorIs(t, complete("challenge-stale"), types.ErrChallengeExpired)
+
+	swept, err := f.keeper.SweepExpiredChallenges(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, swept)
+	stale, err := f.keeper.PairingSessions.Get(ctx, "challenge-stale")
+	require.NoError(t, err)
+	require.Equal(t, types.SessionStatusExpired, stale.Status)
+	require.ErrorIs(t, complete("challenge-stale"), types.ErrChallengeExpired)
+
+	// The LCT created for the expired challenge is not left pending
+	require.Equal(t, []string{"lct-challenge-stale"}, f.lctmanager.terminated)
+	require.Equal(t, lctmanagertypes.StatusPending, f.lctmanager.lcts["lct-challenge-open"].PairingStatus)
+
+	swept, err = f.keeper.SweepExpiredChallenges(ctx)
+	require.NoError(t, err)
+	require.Zero(t, swept)
+
+	require.NoError(t, complete("challenge-open"))
+}
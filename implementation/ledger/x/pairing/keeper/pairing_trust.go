@@ -0,0 +1,82 @@
+package keeper
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	"racecar-web/x/pairing/types"
+)
+
+// minTrustRule is the authorization rules key that overrides the module's
+// minimum trust for one relationship
+const minTrustRule = "min_trust_score"
+
+// CheckPairingTrust verifies the relationship behind lctId is trusted enough
+// to complete pairing. It returns the relationship's trust score and the
+// minimum it was held to; a zero minimum skips scoring altogether.
+func (k Keeper) CheckPairingTrust(ctx context.Context, lctId string) (math.LegacyDec, math.LegacyDec, error) {
+	minimum, err := k.minTrustScore(ctx, lctId)
+	if err != nil {
+		return math.LegacyDec{}, math.LegacyDec{}, err
+	}
+	if minimum.IsZero() {
+		return math.LegacyZeroDec(), minimum, nil
+	}
+
+	// A relationship without a trust tensor has no evidence of trust yet
+	score := math.LegacyZeroDec()
+	if k.trusttensorKeeper != nil {
+		if t3, err := k.trusttensorKeeper.CalculateT3CompositeScore(ctx, lctId); err == nil {
+			score = t3
+		}
+	}
+
+	if score.LT(minimum) {
+		return score, minimum, errorsmod.Wrapf(types.ErrInsufficientTrust, "relationship %s has trust %s, below the minimum %s", lctId, score, minimum)
+	}
+	return score, minimum, nil
+}
+
+// minTrustScore returns the minimum trust for lctId: its authorization rules'
+// min_trust_score when set, otherwise the module parameter
+func (k Keeper) minTrustScore(ctx context.Context, lctId string) (math.LegacyDec, error) {
+	if k.lctmanagerKeeper != nil {
+		if lct, found := k.lctmanagerKeeper.GetLinkedContextToken(ctx, lctId); found {
+			// Rules that are not a JSON object carry no trust override
+			var rules map[string]interface{}
+			if json.Unmarshal([]byte(lct.AuthorizationRules), &rules) == nil {
+				if override, ok := rules[minTrustRule]; ok {
+					minimum, err := parseTrustRule(override)
+					if err != nil {
+						return math.LegacyDec{}, errorsmod.Wrapf(types.ErrInsufficientTrust, "invalid %s in authorization rules of %s: %s", minTrustRule, lctId, err)
+					}
+					return minimum, nil
+				}
+			}
+		}
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return math.LegacyDec{}, err
+	}
+	return types.ParseTrustScore(params.MinTrustScore)
+}
+
+// parseTrustRule accepts a trust score written as a JSON number or string
+func parseTrustRule(value interface{}) (math.LegacyDec, error) {
+	switch v := value.(type) {
+	case float64:
+		return types.ParseTrustScore(strconv.FormatFloat(v, 'f', -1, 64))
+	case string:
+		return types.ParseTrustScore(v)
+	default:
+		return math.LegacyDec{}, errors.New("must be a number or a decimal string")
+	}
+}
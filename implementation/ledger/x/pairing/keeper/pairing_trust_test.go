@@ -0,0 +1,104 @@
+package keeper_test
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	lctmanagertypes "racecar-web/x/lctmanager/types"
+	"racecar-web/x/pairing/keeper"
+	"racecar-web/x/pairing/types"
+)
+
+func TestCompletePairingEnforcesMinimumTrust(t *testing.T) {
+	f := initFixture(t)
+	now := time.Unix(1752484904, 0).UTC()
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(now)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+
+	require.NoError(t, f.keeper.Params.Set(ctx, types.NewParams(types.DefaultChallengeTTLSeconds, "0.6")))
+
+	// One pending challenge per relationship
+	open := func(challengeId, lctId, rules string) {
+		f.lctmanager.lcts[lctId] = lctmanagertypes.LinkedContextToken{LctId: lctId, PairingStatus: lctmanagertypes.StatusPending, AuthorizationRules: rules}
+		require.NoError(t, f.keeper.PairingSessions.Set(ctx, challengeId, types.PairingSession{
+			SessionId: challengeId,
+			LctId:     lctId,
+			Status:    types.SessionStatusPending,
+			ExpiresAt: now.Add(time.Minute).Unix(),
+		}))
+	}
+	auth := func(challengeId, component string) string {
+		hash := sha256.Sum256([]byte(challengeId + component))
+		return hex.EncodeToString(hash[:])
+	}
+	commitment := func(challengeId string) []byte {
+		hash := sha256.Sum256([]byte(challengeId + "key"))
+		return hash[:]
+	}
+	complete := func(challengeId string) (*types.MsgCompletePairingResponse, error) {
+		return ms.CompletePairing(ctx, &types.MsgCompletePairing{
+			Creator:        sdk.AccAddress([]byte("pairing_creator_____")).String(),
+			ChallengeId:    challengeId,
+			ComponentAAuth: auth(challengeId, "component_a"),
+			ComponentBAuth: auth(challengeId, "component_b"),
+			KeyCommitment:  commitment(challengeId),
+		})
+	}
+
+	open("challenge-trusted", "lct-trusted", "{}")
+	f.trusttensor.scores["lct-trusted"] = math.LegacyMustNewDecFromStr("0.82")
+	open("challenge-distrusted", "lct-distrusted", "{}")
+	f.trusttensor.scores["lct-distrusted"] = math.LegacyMustNewDecFromStr("0.41")
+	open("challenge-unscored", "lct-unscored", "")
+	open("challenge-override", "lct-override", `{"min_trust_score": 0.4}`)
+	f.trusttensor.scores["lct-override"] = math.LegacyMustNewDecFromStr("0.41")
+	open("challenge-bad-rule", "lct-bad-rule", `{"min_trust_score": "high"}`)
+
+	// Sufficient trust completes and reports the score
+	res, err := complete("challenge-trusted")
+	require.NoError(t, err)
+	require.Contains(t, res.TrustSummary, "trust_score:0.820000000000000000")
+	require.Len(t, res.KeyReference, 64)
+	require.Contains(t, f.lctmanager.splitKeys, "lct-trusted")
+	session, err := f.keeper.PairingSessions.Get(ctx, "challenge-trusted")
+	require.NoError(t, err)
+	require.Equal(t, types.SessionStatusCompleted, session.Status)
+
+	// Insufficient trust is rejected and leaves the challenge open
+	_, err = complete("challenge-distrusted")
+	require.ErrorIs(t, err, types.ErrInsufficientTrust)
+	session, err = f.keeper.PairingSessions.Get(ctx, "challenge-distrusted")
+	require.NoError(t, err)
+	require.Equal(t, types.SessionStatusPending, session.Status)
+	require.NotContains(t, f.lctmanager.splitKeys, "lct-distrusted")
+
+	// No trust tensor means no trust
+	_, err = complete("challenge-unscored")
+	require.ErrorIs(t, err, types.ErrInsufficientTrust)
+
+	// The relationship's authorization rules override the module minimum
+	_, err = complete("challenge-override")
+	require.NoError(t, err)
+	_, err = complete("challenge-bad-rule")
+	require.ErrorIs(t, err, types.ErrInsufficientTrust)
+
+	// Once the minimum is lifted the distrusted relationship can complete
+	require.NoError(t, f.keeper.Params.Set(ctx, types.DefaultParams()))
+	_, err = complete("challenge-distrusted")
+	require.NoError(t, err)
+}
+
+func TestParamsValidateMinTrustScore(t *testing.T) {
+	for _, score := range []string{"", "0", "0.75", "1"} {
+		require.NoError(t, types.NewParams(60, score).Validate(), score)
+	}
+	for _, score := range []string{"-0.1", "1.01", "trusted"} {
+		require.Error(t, types.NewParams(60, score).Validate(), score)
+	}
+}
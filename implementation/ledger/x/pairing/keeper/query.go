@@ -3,10 +3,13 @@ package keeper
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 
 	"racecar-web/x/pairing/types"
 
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -59,21 +62,31 @@ func (q queryServer) GetPairingStatus(ctx context.Context, req *types.QueryGetPa
 
 	// Get pairing session
 	session, err := q.PairingSessions.Get(ctx, req.ChallengeId)
-	if err != nil {
+	if errors.Is(err, collections.ErrNotFound) {
 		return nil, status.Error(codes.NotFound, "pairing session not found")
 	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
 
 	// Return the pairing challenge as a JSON string
-	pairingChallengeJSON := fmt.Sprintf(`{
-		"challenge_id": "%s",
-		"status": "%s",
-		"expires_at": %d,
-		"established_at": %d,
-		"session_keys": "%s"
-	}`, session.SessionId, session.Status, session.ExpiresAt, session.EstablishedAt, session.SessionKeys)
+	now := sdk.UnwrapSDKContext(ctx).BlockTime()
+	pairingChallengeJSON, err := json.Marshal(map[string]interface{}{
+		"challenge_id":   session.SessionId,
+		"lct_id":         session.LctId,
+		"status":         sessionStatus(session, now),
+		"ttl_seconds":    remainingTTL(session, now),
+		"created_at":     session.CreatedAt,
+		"expires_at":     session.ExpiresAt,
+		"established_at": session.EstablishedAt,
+		"session_keys":   session.SessionKeys,
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to marshal pairing challenge")
+	}
 
 	return &types.QueryGetPairingStatusResponse{
-		PairingChallenge: pairingChallengeJSON,
+		PairingChallenge: string(pairingChallengeJSON),
 	}, nil
 }
 
@@ -83,7 +96,7 @@ func (q queryServer) ListActivePairings(ctx context.Context, req *types.QueryLis
 
 	// Iterate through all pairing sessions
 	err := q.PairingSessions.Walk(ctx, nil, func(key string, session types.PairingSession) (bool, error) {
-		if session.Status == "completed" || session.Status == "active" {
+		if session.Status == types.SessionStatusCompleted || session.Status == "active" {
 			activeSessions = append(activeSessions, session)
 			pairingCount++
 		}
@@ -0,0 +1,64 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"racecar-web/x/pairing/keeper"
+	"racecar-web/x/pairing/types"
+)
+
+func TestGetPairingStatus(t *testing.T) {
+	f := initFixture(t)
+	now := time.Unix(1752484904, 0).UTC()
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(now)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	setSession := func(id, lctId, status string, expiresAt int64) {
+		require.NoError(t, f.keeper.SetPairingSession(ctx, types.PairingSession{
+			SessionId: id,
+			LctId:     lctId,
+			Status:    status,
+			CreatedAt: now.Add(-time.Minute).Unix(),
+			ExpiresAt: expiresAt,
+			Creator:   "creator",
+		}))
+	}
+	pairingStatus := func(id string) map[string]interface{} {
+		resp, err := qs.GetPairingStatus(ctx, &types.QueryGetPairingStatusRequest{ChallengeId: id})
+		require.NoError(t, err)
+		var challenge map[string]interface{}
+		require.NoError(t, json.Unmarshal([]byte(resp.PairingChallenge), &challenge))
+		return challenge
+	}
+
+	setSession("challenge-pending", "lct-pending", types.SessionStatusPending, now.Add(4*time.Minute).Unix())
+	setSession("challenge-completed", "lct-completed", types.SessionStatusCompleted, now.Add(4*time.Minute).Unix())
+	setSession("challenge-expired", "lct-expired", types.SessionStatusPending, now.Add(-time.Second).Unix())
+
+	challenge := pairingStatus("challenge-pending")
+	require.Equal(t, types.SessionStatusPending, challenge["status"])
+	require.Equal(t, float64(now.Add(-time.Minute).Unix()), challenge["created_at"])
+	require.Equal(t, float64(240), challenge["ttl_seconds"])
+	require.Equal(t, "lct-completed", pairingStatus("challenge-completed")["lct_id"])
+	challenge = pairingStatus("challenge-expired")
+	require.Equal(t, types.SessionStatusExpired, challenge["status"])
+	require.Equal(t, float64(0), challenge["ttl_seconds"])
+
+	// A cancelled session reports so, with no time left to complete it
+	setSession("challenge-cancelled", "lct-cancelled", types.SessionStatusCancelled, now.Add(4*time.Minute).Unix())
+	challenge = pairingStatus("challenge-cancelled")
+	require.Equal(t, types.SessionStatusCancelled, challenge["status"])
+	require.Equal(t, float64(0), challenge["ttl_seconds"])
+
+	_, err := qs.GetPairingStatus(ctx, &types.QueryGetPairingStatusRequest{ChallengeId: "challenge-unknown"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	_, err = qs.GetPairingStatus(ctx, &types.QueryGetPairingStatusRequest{})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -41,6 +41,7 @@ type ModuleInputs struct {
 	ComponentregistryKeeper componentregistrytypes.ComponentregistryKeeper
 	PairingqueueKeeper      pairingqueuekeeper.Keeper
 	LctmanagerKeeper        lctmanagertypes.LctmanagerKeeper
+	TrusttensorKeeper       types.TrusttensorKeeper
 }
 
 type ModuleOutputs struct {
@@ -65,6 +66,7 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 		in.ComponentregistryKeeper,
 		in.PairingqueueKeeper,
 		in.LctmanagerKeeper,
+		in.TrusttensorKeeper,
 	)
 	m := NewAppModule(in.Cdc, k, in.AuthKeeper, in.BankKeeper)
 
@@ -8,5 +8,8 @@ import (
 
 // x/pairing module sentinel errors
 var (
-	ErrInvalidSigner = errors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
+	ErrInvalidSigner     = errors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
+	ErrChallengeExpired  = errors.Register(ModuleName, 1101, "pairing challenge expired")
+	ErrInsufficientTrust = errors.Register(ModuleName, 1102, "insufficient trust to complete pairing")
+	ErrUnauthorized      = errors.Register(ModuleName, 1103, "not authorized to revoke the pairing")
 )
@@ -6,6 +6,7 @@ import (
 	pairingqueuetypes "racecar-web/x/pairingqueue/types"
 
 	"cosmossdk.io/core/address"
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -39,5 +40,11 @@ type PairingqueueKeeper interface {
 	ProcessOfflineQueue(ctx context.Context, componentId string) (int, int, error)
 }
 
+// TrusttensorKeeper defines the expected interface for the Trust Tensor module.
+type TrusttensorKeeper interface {
+	// CalculateT3CompositeScore scores the relationship tensor recorded for an LCT
+	CalculateT3CompositeScore(ctx context.Context, lctID string) (math.LegacyDec, error)
+}
+
 // Use the LctmanagerKeeper interface from the lctmanager module
 type LctmanagerKeeper = lctmanagertypes.LctmanagerKeeper
@@ -20,3 +20,18 @@ var ParamsKey = collections.NewPrefix("p_pairing")
 
 // PairingSessionPrefix is the prefix to retrieve all PairingSessions
 var PairingSessionPrefix = collections.NewPrefix("pairing_session")
+
+// LctSessionIndexPrefix is the prefix of the index of pairing sessions by LCT
+var LctSessionIndexPrefix = collections.NewPrefix("lct_session_index")
+
+// PendingExpiryIndexPrefix is the prefix of the index of pending sessions by expiry
+var PendingExpiryIndexPrefix = collections.NewPrefix("pending_expiry_index")
+
+// Pairing session status constants
+const (
+	SessionStatusPending   = "pending"
+	SessionStatusCompleted = "completed"
+	SessionStatusExpired   = "expired"
+	SessionStatusCancelled = "cancelled"
+	SessionStatusRevoked   = "revoked"
+)
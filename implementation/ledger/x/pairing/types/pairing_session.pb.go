@@ -30,6 +30,9 @@ type PairingSession struct {
 	EstablishedAt int64  `protobuf:"varint,4,opt,name=established_at,json=establishedAt,proto3" json:"established_at,omitempty"`
 	ExpiresAt     int64  `protobuf:"varint,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
 	Status        string `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	CreatedAt     int64  `protobuf:"varint,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Account that initiated the pairing
+	Creator string `protobuf:"bytes,8,opt,name=creator,proto3" json:"creator,omitempty"`
 }
 
 func (m *PairingSession) Reset()         { *m = PairingSession{} }
@@ -107,6 +110,20 @@ func (m *PairingSession) GetStatus() string {
 	return ""
 }
 
+func (m *PairingSession) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+func (m *PairingSession) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*PairingSession)(nil), "racecarweb.pairing.v1.PairingSession")
 }
@@ -116,24 +133,25 @@ func init() {
 }
 
 var fileDescriptor_909e36bd2b2d270a = []byte{
-	// 257 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0x2e, 0x4a, 0x4c, 0x4e,
-	0x4d, 0x4e, 0x2c, 0x2a, 0x4f, 0x4d, 0xd2, 0x2f, 0x48, 0xcc, 0x2c, 0xca, 0xcc, 0x4b, 0xd7, 0x2f,
-	0x33, 0x84, 0x31, 0xe3, 0x8b, 0x53, 0x8b, 0x8b, 0x33, 0xf3, 0xf3, 0xf4, 0x0a, 0x8a, 0xf2, 0x4b,
-	0xf2, 0x85, 0x44, 0x11, 0x8a, 0xf5, 0xa0, 0x2a, 0xf4, 0xca, 0x0c, 0x95, 0x8e, 0x33, 0x72, 0xf1,
-	0x05, 0x40, 0xb8, 0xc1, 0x10, 0xf5, 0x42, 0xb2, 0x5c, 0x5c, 0x50, 0xad, 0xf1, 0x99, 0x29, 0x12,
-	0x8c, 0x0a, 0x8c, 0x1a, 0x9c, 0x41, 0x9c, 0x50, 0x11, 0xcf, 0x14, 0x21, 0x51, 0x2e, 0xb6, 0x9c,
-	0xe4, 0x12, 0x90, 0x14, 0x13, 0x58, 0x8a, 0x35, 0x27, 0xb9, 0xc4, 0x33, 0x45, 0x48, 0x91, 0x8b,
-	0x07, 0xa6, 0x2b, 0x3b, 0xb5, 0xb2, 0x58, 0x82, 0x19, 0x2c, 0xc9, 0x0d, 0x15, 0xf3, 0x4e, 0xad,
-	0x2c, 0x16, 0x52, 0xe5, 0xe2, 0x4b, 0x2d, 0x2e, 0x49, 0x4c, 0xca, 0xc9, 0x2c, 0xce, 0x48, 0x4d,
-	0x89, 0x4f, 0x2c, 0x91, 0x60, 0x51, 0x60, 0xd4, 0x60, 0x0e, 0xe2, 0x45, 0x12, 0x75, 0x2c, 0x01,
-	0xd9, 0x9f, 0x5a, 0x51, 0x90, 0x59, 0x94, 0x5a, 0x0c, 0x52, 0xc2, 0x0a, 0x56, 0xc2, 0x09, 0x15,
-	0x71, 0x2c, 0x11, 0x12, 0xe3, 0x62, 0x2b, 0x2e, 0x49, 0x2c, 0x29, 0x2d, 0x96, 0x60, 0x03, 0x5b,
-	0x01, 0xe5, 0x39, 0x99, 0x9e, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72,
-	0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x34,
-	0xd4, 0xeb, 0xba, 0xa0, 0x80, 0xaa, 0x80, 0x07, 0x55, 0x49, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b,
-	0x38, 0x78, 0x8c, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0xfa, 0x37, 0x89, 0x11, 0x4d, 0x01, 0x00,
-	0x00,
+	// 282 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x90, 0x4f, 0x4b, 0xc3, 0x30,
+	0x18, 0xc6, 0x97, 0xcd, 0x75, 0x36, 0xea, 0x0e, 0x81, 0x49, 0x40, 0x0c, 0x53, 0x10, 0x06, 0x62,
+	0xc7, 0x10, 0x3f, 0x40, 0xbd, 0x0d, 0x2f, 0x32, 0x6f, 0x5e, 0x4a, 0xda, 0x06, 0x0d, 0x96, 0xb5,
+	0xe4, 0x7d, 0x9d, 0xeb, 0xcd, 0x8f, 0xe0, 0xc7, 0xf2, 0xb8, 0xa3, 0x47, 0x69, 0xbf, 0x88, 0x34,
+	0x4d, 0xd5, 0x5b, 0x9e, 0x3f, 0xbf, 0xbc, 0xf0, 0xd0, 0x4b, 0x23, 0x13, 0x95, 0x48, 0xf3, 0xa6,
+	0xe2, 0x79, 0x21, 0xb5, 0xd1, 0xeb, 0xa7, 0xf9, 0x66, 0xd1, 0x3d, 0x23, 0x50, 0x00, 0x3a, 0x5f,
+	0x07, 0x85, 0xc9, 0x31, 0x67, 0x93, 0xbf, 0x72, 0xe0, 0x1a, 0xc1, 0x66, 0x71, 0xfe, 0xde, 0xa7,
+	0xe3, 0xfb, 0x56, 0x3e, 0xb4, 0x7d, 0x76, 0x4a, 0xa9, 0x43, 0x23, 0x9d, 0x72, 0x32, 0x25, 0x33,
+	0x7f, 0xe5, 0x3b, 0x67, 0x99, 0xb2, 0x09, 0xf5, 0xb2, 0x04, 0x9b, 0xa8, 0x6f, 0xa3, 0x61, 0x96,
+	0xe0, 0x32, 0x65, 0x67, 0xf4, 0xb0, 0xa3, 0x5e, 0x54, 0x09, 0x7c, 0x60, 0xc3, 0x03, 0xe7, 0xdd,
+	0xa9, 0x12, 0xd8, 0x05, 0x1d, 0x2b, 0x40, 0x19, 0x67, 0x1a, 0x9e, 0x55, 0x1a, 0x49, 0xe4, 0x7b,
+	0x53, 0x32, 0x1b, 0xac, 0x8e, 0xfe, 0xb9, 0x21, 0x36, 0xf7, 0xd5, 0xb6, 0xd0, 0x46, 0x41, 0x53,
+	0x19, 0xda, 0x8a, 0xef, 0x9c, 0x10, 0xd9, 0x31, 0xf5, 0x00, 0x25, 0xbe, 0x02, 0xf7, 0xec, 0x09,
+	0xa7, 0x1a, 0x2c, 0x31, 0x4a, 0x62, 0xfb, 0xf3, 0xa8, 0xc5, 0x9c, 0x13, 0x22, 0xe3, 0x74, 0x64,
+	0x45, 0x6e, 0xf8, 0xbe, 0xe5, 0x3a, 0x79, 0x7b, 0xf3, 0x59, 0x09, 0xb2, 0xab, 0x04, 0xf9, 0xae,
+	0x04, 0xf9, 0xa8, 0x45, 0x6f, 0x57, 0x8b, 0xde, 0x57, 0x2d, 0x7a, 0x8f, 0x27, 0x6e, 0xb3, 0xab,
+	0x66, 0xe1, 0xed, 0xef, 0xc6, 0x58, 0x16, 0x0a, 0x62, 0xcf, 0xee, 0x7a, 0xfd, 0x13, 0x00, 0x00,
+	0xff, 0xff, 0x4c, 0x08, 0x69, 0x10, 0x86, 0x01, 0x00, 0x00,
 }
 
 func (m *PairingSession) Marshal() (dAtA []byte, err error) {
@@ -156,6 +174,18 @@ func (m *PairingSession) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintPairingSession(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.CreatedAt != 0 {
+		i = encodeVarintPairingSession(dAtA, i, uint64(m.CreatedAt))
+		i--
+		dAtA[i] = 0x38
+	}
 	if len(m.Status) > 0 {
 		i -= len(m.Status)
 		copy(dAtA[i:], m.Status)
@@ -236,6 +266,13 @@ func (m *PairingSession) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovPairingSession(uint64(l))
 	}
+	if m.CreatedAt != 0 {
+		n += 1 + sovPairingSession(uint64(m.CreatedAt))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovPairingSession(uint64(l))
+	}
 	return n
 }
 
@@ -440,6 +477,57 @@ func (m *PairingSession) Unmarshal(dAtA []byte) error {
 			}
 			m.Status = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			m.CreatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPairingSession
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPairingSession
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPairingSession
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPairingSession
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPairingSession(dAtA[iNdEx:])
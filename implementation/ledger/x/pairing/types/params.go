@@ -1,17 +1,55 @@
 package types
 
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+)
+
+// DefaultChallengeTTLSeconds is how long a pairing challenge stays open
+// before it expires (5 minutes)
+const DefaultChallengeTTLSeconds int64 = 5 * 60
+
+// DefaultMinTrustScore does not enforce trust at completion; chains opt in
+// by raising it through governance
+const DefaultMinTrustScore = "0"
+
 // NewParams creates a new Params instance.
-func NewParams() Params {
-	return Params{}
+func NewParams(challengeTTLSeconds int64, minTrustScore string) Params {
+	return Params{
+		ChallengeTtlSeconds: challengeTTLSeconds,
+		MinTrustScore:       minTrustScore,
+	}
 }
 
 // DefaultParams returns a default set of parameters.
 func DefaultParams() Params {
-	return NewParams()
+	return NewParams(DefaultChallengeTTLSeconds, DefaultMinTrustScore)
 }
 
 // Validate validates the set of params.
 func (p Params) Validate() error {
+	if p.ChallengeTtlSeconds < 0 {
+		return fmt.Errorf("challenge TTL cannot be negative: %d", p.ChallengeTtlSeconds)
+	}
+	if _, err := ParseTrustScore(p.MinTrustScore); err != nil {
+		return fmt.Errorf("invalid min trust score: %w", err)
+	}
 
 	return nil
 }
+
+// ParseTrustScore parses a trust score in [0, 1]; empty parses as zero
+func ParseTrustScore(score string) (math.LegacyDec, error) {
+	if score == "" {
+		return math.LegacyZeroDec(), nil
+	}
+	dec, err := math.LegacyNewDecFromStr(score)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+	if dec.IsNegative() || dec.GT(math.LegacyOneDec()) {
+		return math.LegacyDec{}, fmt.Errorf("trust score %s is outside [0, 1]", score)
+	}
+	return dec, nil
+}
@@ -26,6 +26,14 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Params defines the parameters for the module.
 type Params struct {
+	// Seconds a pairing challenge stays open after InitiateBidirectionalPairing.
+	// Challenges not completed in time expire and can no longer be completed.
+	// Zero uses the default of five minutes.
+	ChallengeTtlSeconds int64 `protobuf:"varint,1,opt,name=challenge_ttl_seconds,json=challengeTtlSeconds,proto3" json:"challenge_ttl_seconds,omitempty"`
+	// Minimum trust score, a decimal in [0, 1], the relationship must have for
+	// CompletePairing to succeed. An LCT's authorization rules can override it
+	// with their own "min_trust_score". Empty or zero does not enforce trust.
+	MinTrustScore string `protobuf:"bytes,2,opt,name=min_trust_score,json=minTrustScore,proto3" json:"min_trust_score,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -61,6 +69,20 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+func (m *Params) GetChallengeTtlSeconds() int64 {
+	if m != nil {
+		return m.ChallengeTtlSeconds
+	}
+	return 0
+}
+
+func (m *Params) GetMinTrustScore() string {
+	if m != nil {
+		return m.MinTrustScore
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "racecarweb.pairing.v1.Params")
 }
@@ -70,18 +92,23 @@ func init() {
 }
 
 var fileDescriptor_970a46431955f9a7 = []byte{
-	// 164 bytes of a gzipped FileDescriptorProto
+	// 242 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x2a, 0x4a, 0x4c, 0x4e,
 	0x4d, 0x4e, 0x2c, 0x2a, 0x4f, 0x4d, 0xd2, 0x2f, 0x48, 0xcc, 0x2c, 0xca, 0xcc, 0x4b, 0xd7, 0x2f,
 	0x33, 0xd4, 0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12,
 	0x45, 0xa8, 0xd1, 0x83, 0xaa, 0xd1, 0x2b, 0x33, 0x94, 0x12, 0x4c, 0xcc, 0xcd, 0xcc, 0xcb, 0xd7,
 	0x07, 0x93, 0x10, 0x95, 0x52, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x60, 0xa6, 0x3e, 0x88, 0x05, 0x11,
-	0x55, 0xd2, 0xe3, 0x62, 0x0b, 0x00, 0x9b, 0x67, 0xa5, 0xf2, 0x62, 0x81, 0x3c, 0x63, 0xd7, 0xf3,
-	0x0d, 0x5a, 0xd2, 0x48, 0xd6, 0x56, 0xc0, 0x2d, 0x86, 0xa8, 0x72, 0x32, 0x3d, 0xf1, 0x48, 0x8e,
-	0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58,
-	0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0x98, 0x36, 0x5d, 0x54, 0x7d, 0x25, 0x95, 0x05, 0xa9,
-	0xc5, 0x49, 0x6c, 0x60, 0xdb, 0x8c, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0xf8, 0x61, 0xd2, 0x91,
-	0xd3, 0x00, 0x00, 0x00,
+	0x55, 0xea, 0x62, 0xe4, 0x62, 0x0b, 0x00, 0x1b, 0x28, 0x64, 0xc4, 0x25, 0x9a, 0x9c, 0x91, 0x98,
+	0x93, 0x93, 0x9a, 0x97, 0x9e, 0x1a, 0x5f, 0x52, 0x92, 0x13, 0x5f, 0x9c, 0x9a, 0x9c, 0x9f, 0x97,
+	0x52, 0x2c, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0x1c, 0x24, 0x0c, 0x97, 0x0c, 0x29, 0xc9, 0x09, 0x86,
+	0x48, 0x09, 0xa9, 0x71, 0xf1, 0xe7, 0x66, 0xe6, 0xc5, 0x97, 0x14, 0x95, 0x16, 0x97, 0xc4, 0x17,
+	0x27, 0xe7, 0x17, 0xa5, 0x4a, 0x30, 0x29, 0x30, 0x6a, 0x70, 0x06, 0xf1, 0xe6, 0x66, 0xe6, 0x85,
+	0x80, 0x44, 0x83, 0x41, 0x82, 0x56, 0x2a, 0x2f, 0x16, 0xc8, 0x33, 0x76, 0x3d, 0xdf, 0xa0, 0x25,
+	0x8d, 0xe4, 0xa7, 0x0a, 0xb8, 0xaf, 0x20, 0x2e, 0x70, 0x32, 0x3d, 0xf1, 0x48, 0x8e, 0xf1, 0xc2,
+	0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1,
+	0xc6, 0x63, 0x39, 0x86, 0x28, 0x98, 0x36, 0x5d, 0x54, 0x7d, 0x25, 0x95, 0x05, 0xa9, 0xc5, 0x49,
+	0x6c, 0x60, 0xaf, 0x18, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0xa6, 0x96, 0xd6, 0x76, 0x30, 0x01,
+	0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -103,6 +130,12 @@ func (this *Params) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
+	if this.ChallengeTtlSeconds != that1.ChallengeTtlSeconds {
+		return false
+	}
+	if this.MinTrustScore != that1.MinTrustScore {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -125,6 +158,18 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.MinTrustScore) > 0 {
+		i -= len(m.MinTrustScore)
+		copy(dAtA[i:], m.MinTrustScore)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.MinTrustScore)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ChallengeTtlSeconds != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ChallengeTtlSeconds))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -145,6 +190,13 @@ func (m *Params) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if m.ChallengeTtlSeconds != 0 {
+		n += 1 + sovParams(uint64(m.ChallengeTtlSeconds))
+	}
+	l = len(m.MinTrustScore)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
 	return n
 }
 
@@ -183,6 +235,57 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChallengeTtlSeconds", wireType)
+			}
+			m.ChallengeTtlSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChallengeTtlSeconds |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinTrustScore", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinTrustScore = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -287,6 +287,10 @@ type MsgCompletePairing struct {
 	ComponentAAuth string `protobuf:"bytes,3,opt,name=component_a_auth,json=componentAAuth,proto3" json:"component_a_auth,omitempty"`
 	ComponentBAuth string `protobuf:"bytes,4,opt,name=component_b_auth,json=componentBAuth,proto3" json:"component_b_auth,omitempty"`
 	SessionContext string `protobuf:"bytes,5,opt,name=session_context,json=sessionContext,proto3" json:"session_context,omitempty"`
+	// key_commitment commits to the pairing's combined split key. The halves
+	// are generated off-chain and never leave the components and the key
+	// service; the chain only stores and checks the commitment.
+	KeyCommitment []byte `protobuf:"bytes,6,opt,name=key_commitment,json=keyCommitment,proto3" json:"key_commitment,omitempty"`
 }
 
 func (m *MsgCompletePairing) Reset()         { *m = MsgCompletePairing{} }
@@ -357,11 +361,21 @@ func (m *MsgCompletePairing) GetSessionContext() string {
 	return ""
 }
 
+func (m *MsgCompletePairing) GetKeyCommitment() []byte {
+	if m != nil {
+		return m.KeyCommitment
+	}
+	return nil
+}
+
 // MsgCompletePairingResponse defines the MsgCompletePairingResponse message.
 type MsgCompletePairingResponse struct {
 	LctId        string `protobuf:"bytes,1,opt,name=lct_id,json=lctId,proto3" json:"lct_id,omitempty"`
 	SessionKeys  string `protobuf:"bytes,2,opt,name=session_keys,json=sessionKeys,proto3" json:"session_keys,omitempty"`
 	TrustSummary string `protobuf:"bytes,3,opt,name=trust_summary,json=trustSummary,proto3" json:"trust_summary,omitempty"`
+	// key_reference identifies the split key issued for the pairing: the hex
+	// key_commitment of the message
+	KeyReference string `protobuf:"bytes,4,opt,name=key_reference,json=keyReference,proto3" json:"key_reference,omitempty"`
 }
 
 func (m *MsgCompletePairingResponse) Reset()         { *m = MsgCompletePairingResponse{} }
@@ -418,6 +432,13 @@ func (m *MsgCompletePairingResponse) GetTrustSummary() string {
 	return ""
 }
 
+func (m *MsgCompletePairingResponse) GetKeyReference() string {
+	if m != nil {
+		return m.KeyReference
+	}
+	return ""
+}
+
 // MsgRevokePairing defines the MsgRevokePairing message.
 type MsgRevokePairing struct {
 	Creator       string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
@@ -538,57 +559,59 @@ func init() {
 func init() { proto.RegisterFile("racecarweb/pairing/v1/tx.proto", fileDescriptor_81d7d37064c45cab) }
 
 var fileDescriptor_81d7d37064c45cab = []byte{
-	// 793 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0xcf, 0x4f, 0xe3, 0x46,
-	0x14, 0x8e, 0xf9, 0x11, 0xc8, 0x10, 0x12, 0x3a, 0x85, 0x62, 0xdc, 0x36, 0x40, 0xfa, 0x23, 0x29,
-	0x12, 0xb1, 0xa0, 0x2a, 0x95, 0x38, 0x54, 0x25, 0x9c, 0xa2, 0x2a, 0x2a, 0x32, 0xea, 0xa5, 0x17,
-	0x6b, 0x62, 0x4f, 0x8c, 0x45, 0xec, 0x71, 0x67, 0x26, 0x34, 0x51, 0x2f, 0xd5, 0x1e, 0xf7, 0xb4,
-	0x27, 0x0e, 0xfb, 0x17, 0xac, 0xf6, 0xc4, 0x61, 0xff, 0x84, 0x3d, 0x70, 0x44, 0x7b, 0xda, 0xd3,
-	0x6a, 0x05, 0x07, 0xb4, 0xff, 0xc0, 0x9e, 0x57, 0x9e, 0xb1, 0x9d, 0xc4, 0x10, 0x40, 0xec, 0x25,
-	0xca, 0xfb, 0xde, 0x37, 0x6f, 0xde, 0xf7, 0xbd, 0xf1, 0x0c, 0x28, 0x51, 0x64, 0x61, 0x0b, 0xd1,
-	0x7f, 0x71, 0x4b, 0x0f, 0x90, 0x4b, 0x5d, 0xdf, 0xd1, 0x4f, 0xb6, 0x74, 0xde, 0xab, 0x05, 0x94,
-	0x70, 0x02, 0x97, 0x06, 0xf9, 0x5a, 0x94, 0xaf, 0x9d, 0x6c, 0x69, 0x5f, 0x20, 0xcf, 0xf5, 0x89,
-	0x2e, 0x7e, 0x25, 0x53, 0x5b, 0xb6, 0x08, 0xf3, 0x08, 0xd3, 0x3d, 0x26, 0x2a, 0x78, 0xcc, 0x89,
-	0x12, 0x2b, 0x32, 0x61, 0x8a, 0x48, 0x97, 0x41, 0x94, 0x5a, 0x74, 0x88, 0x43, 0x24, 0x1e, 0xfe,
-	0x8b, 0xd0, 0xf2, 0xed, 0x3d, 0x05, 0x88, 0x22, 0x2f, 0x5a, 0x59, 0x7e, 0xad, 0x80, 0x62, 0x93,
-	0x39, 0x7f, 0x05, 0x36, 0xe2, 0xf8, 0x40, 0x64, 0xe0, 0x0e, 0xc8, 0xa1, 0x2e, 0x3f, 0x22, 0xd4,
-	0xe5, 0x7d, 0x55, 0x59, 0x53, 0xaa, 0xb9, 0xba, 0xfa, 0xe6, 0xd5, 0xe6, 0x62, 0xb4, 0xe5, 0x9e,
-	0x6d, 0x53, 0xcc, 0xd8, 0x21, 0x0f, 0x0b, 0x1a, 0x03, 0x2a, 0xfc, 0x1d, 0x64, 0x65, 0x6d, 0x75,
-	0x62, 0x4d, 0xa9, 0xce, 0x6d, 0x7f, 0x5b, 0xbb, 0x55, 0x74, 0x4d, 0x6e, 0x53, 0xcf, 0x9d, 0xbf,
-	0x5b, 0xcd, 0xbc, 0xb8, 0x3e, 0xdb, 0x50, 0x8c, 0x68, 0xdd, 0xee, 0xaf, 0x4f, 0xae, 0xcf, 0x36,
-	0x06, 0x15, 0x9f, 0x5e, 0x9f, 0x6d, 0x7c, 0x3f, 0x24, 0xa2, 0x97, 0xc8, 0x48, 0xb5, 0x5c, 0x5e,
-	0x01, 0xcb, 0x29, 0xc8, 0xc0, 0x2c, 0x20, 0x3e, 0xc3, 0xe5, 0xe7, 0x13, 0x60, 0xb5, 0xc9, 0x9c,
-	0x86, 0xef, 0x72, 0x17, 0x71, 0x5c, 0x77, 0x6d, 0x97, 0x62, 0x8b, 0xbb, 0xc4, 0x47, 0x9d, 0x03,
-	0x59, 0x0e, 0x6e, 0x83, 0x19, 0x8b, 0x62, 0xc4, 0x09, 0xbd, 0x57, 0x6f, 0x4c, 0x84, 0xab, 0x60,
-	0xce, 0x22, 0x5e, 0x40, 0x7c, 0xec, 0x73, 0x13, 0x09, 0xc9, 0x39, 0x03, 0x24, 0xd0, 0xde, 0x28,
-	0xa1, 0xa5, 0x4e, 0xa6, 0x08, 0x75, 0xa8, 0x83, 0x2f, 0x49, 0x80, 0x29, 0x92, 0xbd, 0x98, 0x16,
-	0xf1, 0x39, 0xee, 0x71, 0x75, 0x4a, 0x10, 0xe1, 0x50, 0x6a, 0x5f, 0x66, 0xe0, 0x0a, 0x98, 0x0d,
-	0x28, 0xe9, 0xf5, 0x4d, 0xd7, 0x56, 0xa7, 0x05, 0x6b, 0x46, 0xc4, 0x0d, 0x1b, 0x56, 0x40, 0xb1,
-	0x4d, 0xa8, 0x85, 0x4d, 0xd7, 0xf3, 0xb0, 0x1d, 0x0a, 0x55, 0xb3, 0x6b, 0x4a, 0x75, 0xd6, 0x28,
-	0x08, 0xb8, 0x11, 0xa3, 0xbb, 0xf9, 0xd0, 0xe2, 0x58, 0x44, 0xf9, 0x54, 0x01, 0x95, 0x7b, 0xcc,
-	0x89, 0x8d, 0x84, 0xeb, 0x20, 0x6f, 0x1d, 0xa1, 0x4e, 0x07, 0xfb, 0x0e, 0x0e, 0x3b, 0x10, 0x4e,
-	0x19, 0x73, 0x09, 0xd6, 0xb0, 0xe1, 0x12, 0xc8, 0x76, 0x2c, 0x1e, 0x26, 0xa5, 0x1d, 0xd3, 0x1d,
-	0x8b, 0x37, 0x6c, 0xf8, 0x15, 0xc8, 0x32, 0x8e, 0x78, 0x97, 0x45, 0x26, 0x44, 0x51, 0xa8, 0xe7,
-	0x9f, 0x2e, 0xee, 0x8a, 0x6a, 0x52, 0xf5, 0x8c, 0x88, 0x1b, 0x76, 0xf9, 0xa3, 0x02, 0x60, 0x93,
-	0x39, 0xfb, 0xc4, 0x0b, 0x3a, 0x38, 0x9c, 0xe9, 0xe3, 0x07, 0x95, 0xee, 0x7b, 0xe2, 0x66, 0xdf,
-	0x55, 0xb0, 0x30, 0x34, 0x4b, 0x33, 0x3c, 0x80, 0x51, 0xab, 0x85, 0xc1, 0x40, 0xf7, 0xba, 0xfc,
-	0x68, 0x94, 0xd9, 0x92, 0xcc, 0xa9, 0x14, 0xb3, 0x2e, 0x98, 0x15, 0x50, 0x64, 0x98, 0x31, 0x97,
-	0xf8, 0xc9, 0x64, 0xe5, 0xcc, 0x0a, 0x11, 0x1c, 0x4d, 0x35, 0x35, 0x91, 0xff, 0x80, 0x76, 0x53,
-	0x77, 0x32, 0x83, 0x81, 0xc1, 0xca, 0xb0, 0xc1, 0xeb, 0x20, 0x1f, 0xef, 0x75, 0x8c, 0xfb, 0x2c,
-	0x96, 0x18, 0x61, 0x7f, 0xe0, 0x3e, 0x83, 0xdf, 0x81, 0x79, 0x4e, 0xbb, 0x8c, 0x9b, 0xac, 0xeb,
-	0x79, 0x88, 0xf6, 0x23, 0x7d, 0x79, 0x01, 0x1e, 0x4a, 0xac, 0xfc, 0x52, 0x01, 0x0b, 0x4d, 0xe6,
-	0x18, 0xf8, 0x84, 0x1c, 0x7f, 0x96, 0xe7, 0xe3, 0x0f, 0x02, 0xc5, 0x88, 0x11, 0x3f, 0x3e, 0x08,
-	0x32, 0x82, 0x3f, 0x80, 0x82, 0x4f, 0xb8, 0xdb, 0xee, 0x9b, 0xa4, 0xdd, 0xee, 0xb8, 0x3e, 0x16,
-	0x9e, 0xce, 0x1a, 0xf3, 0x12, 0xfd, 0x53, 0x82, 0x29, 0xa7, 0x34, 0xa0, 0xa6, 0x7b, 0x8d, 0x7d,
-	0xda, 0xfe, 0x30, 0x09, 0x26, 0x9b, 0xcc, 0x81, 0x6d, 0x90, 0x1f, 0xb9, 0xda, 0x7e, 0x1c, 0x73,
-	0x25, 0xa5, 0x2e, 0x0f, 0xad, 0xf6, 0x30, 0x5e, 0x32, 0x97, 0x53, 0x05, 0x7c, 0x73, 0xe7, 0x0d,
-	0xb3, 0x33, 0xbe, 0xe0, 0x5d, 0xeb, 0xb4, 0xdf, 0x1e, 0xb7, 0x2e, 0x69, 0x8c, 0x80, 0x62, 0xfa,
-	0x1b, 0xfa, 0x69, 0x7c, 0xc9, 0x14, 0x55, 0xdb, 0x7a, 0x30, 0x35, 0xd9, 0xd0, 0x05, 0xf3, 0xa3,
-	0xc7, 0xa7, 0x32, 0xbe, 0xc6, 0x08, 0x51, 0xd3, 0x1f, 0x48, 0x8c, 0xb7, 0xd2, 0xa6, 0xff, 0x0f,
-	0x1f, 0x8f, 0xfa, 0x2f, 0xe7, 0x97, 0x25, 0xe5, 0xe2, 0xb2, 0xa4, 0xbc, 0xbf, 0x2c, 0x29, 0xcf,
-	0xae, 0x4a, 0x99, 0x8b, 0xab, 0x52, 0xe6, 0xed, 0x55, 0x29, 0xf3, 0xf7, 0xd7, 0x51, 0xc1, 0xcd,
-	0xd1, 0xc7, 0x83, 0xf7, 0x03, 0xcc, 0x5a, 0x59, 0xf1, 0x00, 0xfe, 0xfc, 0x29, 0x00, 0x00, 0xff,
-	0xff, 0x07, 0xb3, 0xf8, 0x48, 0xba, 0x07, 0x00, 0x00,
+	// 832 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0xcf, 0x6f, 0xe3, 0x44,
+	0x18, 0x8d, 0xd3, 0x6d, 0xda, 0x4c, 0xf3, 0x63, 0x31, 0xbb, 0xac, 0x6b, 0x20, 0xed, 0x86, 0x1f,
+	0x09, 0x95, 0x36, 0x56, 0x8b, 0x58, 0xa4, 0x3d, 0x20, 0x9a, 0x9e, 0x22, 0x14, 0xb1, 0xf2, 0x8a,
+	0x0b, 0x17, 0x6b, 0x62, 0x7f, 0x71, 0x47, 0x89, 0x3d, 0x66, 0x66, 0x52, 0xe2, 0x1b, 0xe2, 0xc8,
+	0x89, 0xd3, 0x1e, 0x90, 0x90, 0x38, 0x22, 0x4e, 0x3d, 0xf0, 0x27, 0x70, 0xd8, 0xe3, 0x8a, 0x13,
+	0x27, 0x84, 0xda, 0x43, 0xc5, 0x7f, 0x81, 0x3c, 0x63, 0x3b, 0x89, 0x77, 0xb3, 0x5b, 0x95, 0x4b,
+	0xd5, 0x79, 0xdf, 0x9b, 0x6f, 0xde, 0xf7, 0xde, 0x64, 0x8c, 0x5a, 0x0c, 0xbb, 0xe0, 0x62, 0xf6,
+	0x2d, 0x8c, 0xac, 0x08, 0x13, 0x46, 0x42, 0xdf, 0x3a, 0x3b, 0xb4, 0xc4, 0xbc, 0x17, 0x31, 0x2a,
+	0xa8, 0x7e, 0x77, 0x51, 0xef, 0xa5, 0xf5, 0xde, 0xd9, 0xa1, 0xf9, 0x06, 0x0e, 0x48, 0x48, 0x2d,
+	0xf9, 0x57, 0x31, 0xcd, 0x7b, 0x2e, 0xe5, 0x01, 0xe5, 0x56, 0xc0, 0x65, 0x87, 0x80, 0xfb, 0x69,
+	0x61, 0x57, 0x15, 0x1c, 0xb9, 0xb2, 0xd4, 0x22, 0x2d, 0xdd, 0xf1, 0xa9, 0x4f, 0x15, 0x9e, 0xfc,
+	0x97, 0xa2, 0xed, 0x97, 0x6b, 0x8a, 0x30, 0xc3, 0x41, 0xba, 0xb3, 0xfd, 0x87, 0x86, 0x9a, 0x43,
+	0xee, 0x7f, 0x15, 0x79, 0x58, 0xc0, 0x63, 0x59, 0xd1, 0x1f, 0xa2, 0x2a, 0x9e, 0x89, 0x53, 0xca,
+	0x88, 0x88, 0x0d, 0x6d, 0x5f, 0xeb, 0x56, 0xfb, 0xc6, 0x9f, 0xbf, 0x3f, 0xb8, 0x93, 0x1e, 0x79,
+	0xec, 0x79, 0x0c, 0x38, 0x7f, 0x22, 0x92, 0x86, 0xf6, 0x82, 0xaa, 0x7f, 0x8e, 0x2a, 0xaa, 0xb7,
+	0x51, 0xde, 0xd7, 0xba, 0x3b, 0x47, 0xef, 0xf6, 0x5e, 0x3a, 0x74, 0x4f, 0x1d, 0xd3, 0xaf, 0x3e,
+	0xfb, 0x7b, 0xaf, 0xf4, 0xeb, 0xd5, 0xf9, 0x81, 0x66, 0xa7, 0xfb, 0x1e, 0x7d, 0xfa, 0xfd, 0xd5,
+	0xf9, 0xc1, 0xa2, 0xe3, 0x0f, 0x57, 0xe7, 0x07, 0xef, 0x2f, 0x0d, 0x31, 0xcf, 0xc7, 0x28, 0x48,
+	0x6e, 0xef, 0xa2, 0x7b, 0x05, 0xc8, 0x06, 0x1e, 0xd1, 0x90, 0x43, 0xfb, 0xa7, 0x32, 0xda, 0x1b,
+	0x72, 0x7f, 0x10, 0x12, 0x41, 0xb0, 0x80, 0x3e, 0xf1, 0x08, 0x03, 0x57, 0x10, 0x1a, 0xe2, 0xe9,
+	0x63, 0xd5, 0x4e, 0x3f, 0x42, 0x5b, 0x2e, 0x03, 0x2c, 0x28, 0x7b, 0xed, 0xbc, 0x19, 0x51, 0xdf,
+	0x43, 0x3b, 0x2e, 0x0d, 0x22, 0x1a, 0x42, 0x28, 0x1c, 0x2c, 0x47, 0xae, 0xda, 0x28, 0x87, 0x8e,
+	0x57, 0x09, 0x23, 0x63, 0xa3, 0x40, 0xe8, 0xeb, 0x16, 0x7a, 0x93, 0x46, 0xc0, 0xb0, 0xd2, 0xe2,
+	0xb8, 0x34, 0x14, 0x30, 0x17, 0xc6, 0x2d, 0x49, 0xd4, 0x97, 0x4a, 0x27, 0xaa, 0xa2, 0xef, 0xa2,
+	0xed, 0x88, 0xd1, 0x79, 0xec, 0x10, 0xcf, 0xd8, 0x94, 0xac, 0x2d, 0xb9, 0x1e, 0x78, 0x7a, 0x07,
+	0x35, 0xc7, 0x94, 0xb9, 0xe0, 0x90, 0x20, 0x00, 0x2f, 0x19, 0xd4, 0xa8, 0xec, 0x6b, 0xdd, 0x6d,
+	0xbb, 0x21, 0xe1, 0x41, 0x86, 0x3e, 0xaa, 0x25, 0x16, 0x67, 0x43, 0xb4, 0x9f, 0x6a, 0xa8, 0xf3,
+	0x1a, 0x73, 0x32, 0x23, 0xf5, 0xfb, 0xa8, 0xe6, 0x9e, 0xe2, 0xe9, 0x14, 0x42, 0x1f, 0x12, 0x05,
+	0xd2, 0x29, 0x7b, 0x27, 0xc7, 0x06, 0x9e, 0x7e, 0x17, 0x55, 0xa6, 0xae, 0x48, 0x8a, 0xca, 0x8e,
+	0xcd, 0xa9, 0x2b, 0x06, 0x9e, 0xfe, 0x16, 0xaa, 0x70, 0x81, 0xc5, 0x8c, 0xa7, 0x26, 0xa4, 0xab,
+	0x64, 0x9e, 0x6f, 0x66, 0x30, 0x93, 0xdd, 0xd4, 0xd4, 0x5b, 0x72, 0x3d, 0xf0, 0xda, 0x3f, 0x97,
+	0x91, 0x3e, 0xe4, 0xfe, 0x09, 0x0d, 0xa2, 0x29, 0x24, 0x99, 0xde, 0x3c, 0xa8, 0xa2, 0xee, 0xf2,
+	0x8b, 0xba, 0xbb, 0xe8, 0xf6, 0x52, 0x96, 0x4e, 0x72, 0x01, 0x53, 0xa9, 0x8d, 0x45, 0xa0, 0xc7,
+	0x33, 0x71, 0xba, 0xca, 0x1c, 0x29, 0xe6, 0xad, 0x02, 0xb3, 0x2f, 0x99, 0x1d, 0xd4, 0xe4, 0xc0,
+	0x39, 0xa1, 0x61, 0x9e, 0xac, 0xca, 0xac, 0x91, 0xc2, 0x59, 0xaa, 0x1f, 0xa0, 0xc6, 0x04, 0x62,
+	0xc7, 0xa5, 0x41, 0x40, 0x44, 0x00, 0xa1, 0x90, 0xc9, 0xd5, 0xec, 0xfa, 0x04, 0xe2, 0x93, 0x1c,
+	0x2c, 0x04, 0xf7, 0x8b, 0x86, 0xcc, 0x17, 0xfd, 0xc9, 0xb3, 0x5a, 0x04, 0xa1, 0x2d, 0x07, 0x71,
+	0x1f, 0xd5, 0x32, 0x4d, 0x13, 0x88, 0x79, 0x66, 0x45, 0x8a, 0x7d, 0x01, 0x31, 0xd7, 0xdf, 0x43,
+	0x75, 0xc1, 0x66, 0x5c, 0x38, 0x7c, 0x16, 0x04, 0x98, 0xc5, 0xa9, 0x0f, 0x35, 0x09, 0x3e, 0x51,
+	0x58, 0x42, 0x4a, 0x24, 0x33, 0x18, 0x03, 0x83, 0xd0, 0x85, 0xd4, 0x82, 0xda, 0x04, 0x62, 0x3b,
+	0xc3, 0xda, 0xbf, 0x69, 0xe8, 0xf6, 0x90, 0xfb, 0x36, 0x9c, 0xd1, 0xc9, 0xff, 0x0a, 0x70, 0xfd,
+	0xad, 0x62, 0x80, 0x39, 0x0d, 0xb3, 0x5b, 0xa5, 0x56, 0x89, 0x9f, 0x21, 0x15, 0x64, 0x1c, 0x3b,
+	0x74, 0x3c, 0x9e, 0x92, 0x50, 0xa9, 0xdb, 0xb6, 0xeb, 0x0a, 0xfd, 0x52, 0x81, 0x05, 0x3f, 0x4d,
+	0x64, 0x14, 0xb5, 0x66, 0x66, 0x1e, 0xfd, 0xbb, 0x81, 0x36, 0x86, 0xdc, 0xd7, 0xc7, 0xa8, 0xb6,
+	0xf2, 0x4e, 0x7e, 0xb8, 0xe6, 0x7d, 0x2b, 0xbc, 0x44, 0x66, 0xef, 0x7a, 0xbc, 0x3c, 0xbc, 0xa7,
+	0x1a, 0x7a, 0xe7, 0x95, 0xcf, 0xd5, 0xc3, 0xf5, 0x0d, 0x5f, 0xb5, 0xcf, 0xfc, 0xec, 0x66, 0xfb,
+	0x72, 0x61, 0x14, 0x35, 0x8b, 0x3f, 0xc8, 0x8f, 0xd6, 0xb7, 0x2c, 0x50, 0xcd, 0xc3, 0x6b, 0x53,
+	0xf3, 0x03, 0x09, 0xaa, 0xaf, 0x5e, 0x9f, 0xce, 0xfa, 0x1e, 0x2b, 0x44, 0xd3, 0xba, 0x26, 0x31,
+	0x3b, 0xca, 0xdc, 0xfc, 0x2e, 0xf9, 0x12, 0xf5, 0x3f, 0x79, 0x76, 0xd1, 0xd2, 0x9e, 0x5f, 0xb4,
+	0xb4, 0x7f, 0x2e, 0x5a, 0xda, 0x8f, 0x97, 0xad, 0xd2, 0xf3, 0xcb, 0x56, 0xe9, 0xaf, 0xcb, 0x56,
+	0xe9, 0xeb, 0xb7, 0xd3, 0x86, 0x0f, 0x56, 0xbf, 0x44, 0x22, 0x8e, 0x80, 0x8f, 0x2a, 0xf2, 0x6b,
+	0xfa, 0xf1, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x3c, 0x44, 0xe4, 0xb4, 0x07, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -992,6 +1015,13 @@ func (m *MsgCompletePairing) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.KeyCommitment) > 0 {
+		i -= len(m.KeyCommitment)
+		copy(dAtA[i:], m.KeyCommitment)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.KeyCommitment)))
+		i--
+		dAtA[i] = 0x32
+	}
 	if len(m.SessionContext) > 0 {
 		i -= len(m.SessionContext)
 		copy(dAtA[i:], m.SessionContext)
@@ -1050,6 +1080,13 @@ func (m *MsgCompletePairingResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	_ = i
 	var l int
 	_ = l
+	if len(m.KeyReference) > 0 {
+		i -= len(m.KeyReference)
+		copy(dAtA[i:], m.KeyReference)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.KeyReference)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.TrustSummary) > 0 {
 		i -= len(m.TrustSummary)
 		copy(dAtA[i:], m.TrustSummary)
@@ -1269,6 +1306,10 @@ func (m *MsgCompletePairing) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	l = len(m.KeyCommitment)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -1290,6 +1331,10 @@ func (m *MsgCompletePairingResponse) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	l = len(m.KeyReference)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -2094,6 +2139,40 @@ func (m *MsgCompletePairing) Unmarshal(dAtA []byte) error {
 			}
 			m.SessionContext = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyCommitment", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyCommitment = append(m.KeyCommitment[:0], dAtA[iNdEx:postIndex]...)
+			if m.KeyCommitment == nil {
+				m.KeyCommitment = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2240,6 +2319,38 @@ func (m *MsgCompletePairingResponse) Unmarshal(dAtA []byte) error {
 			}
 			m.TrustSummary = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyReference", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyReference = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -6,9 +6,11 @@ import (
 	"time"
 
 	"cosmossdk.io/collections"
+	"cosmossdk.io/core/address"
 	"cosmossdk.io/core/store"
 	"cosmossdk.io/errors"
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	componentregistrytypes "racecar-web/x/componentregistry/types"
 	"racecar-web/x/pairingqueue/types"
@@ -17,10 +19,15 @@ import (
 type Keeper struct {
 	cdc          codec.BinaryCodec
 	storeService store.KVStoreService
+	addressCodec address.Codec
 	authKeeper   types.AuthKeeper
 	bankKeeper   types.BankKeeper
 	compKeeper   componentregistrytypes.ComponentregistryKeeper
 
+	// Address capable of executing a MsgUpdateParams message.
+	// Typically, this should be the x/gov module account.
+	authority []byte
+
 	// State management
 	Params            collections.Item[types.Params]
 	PairingRequests   collections.Map[string, types.PairingRequest]
@@ -30,14 +37,22 @@ type Keeper struct {
 func NewKeeper(
 	cdc codec.BinaryCodec,
 	storeService store.KVStoreService,
+	addressCodec address.Codec,
+	authority []byte,
 	authKeeper types.AuthKeeper,
 	bankKeeper types.BankKeeper,
 	compKeeper componentregistrytypes.ComponentregistryKeeper,
 ) Keeper {
+	if _, err := addressCodec.BytesToString(authority); err != nil {
+		panic(fmt.Sprintf("invalid authority address %s: %s", authority, err))
+	}
+
 	sb := collections.NewSchemaBuilder(storeService)
 	k := Keeper{
 		cdc:          cdc,
 		storeService: storeService,
+		addressCodec: addressCodec,
+		authority:    authority,
 		authKeeper:   authKeeper,
 		bankKeeper:   bankKeeper,
 		compKeeper:   compKeeper,
@@ -50,6 +65,11 @@ func NewKeeper(
 	return k
 }
 
+// GetAuthority returns the module's authority
+func (k Keeper) GetAuthority() []byte {
+	return k.authority
+}
+
 // QueuePairingRequest queues a new pairing request with trust and authorization checks
 func (k Keeper) QueuePairingRequest(ctx context.Context, initiatorID, targetID, requestType, proxyID string) (string, error) {
 	// Validate components exist and are verified
@@ -347,26 +367,24 @@ func (k Keeper) GetQueueStatus(ctx context.Context, componentID string) (types.Q
 	return status, nil
 }
 
-// ListProxyQueue returns all offline operations for a proxy
-func (k Keeper) ListProxyQueue(ctx context.Context, proxyID string) ([]types.OfflineOperation, error) {
-	var operations []types.OfflineOperation
+// ListProxyQueue returns one page of the offline operations for a proxy
+// that pass filter. Ages are measured against the wall clock, the same clock
+// QueuedAt is stamped with.
+func (k Keeper) ListProxyQueue(ctx context.Context, proxyID string, filter types.OfflineOperationFilter, pageReq *query.PageRequest) ([]types.OfflineOperation, *query.PageResponse, error) {
+	now := time.Now().Unix()
 
-	iter, err := k.OfflineOperations.Iterate(ctx, nil)
+	// For now, every operation belongs to every proxy, since proxy filtering
+	// would depend on specific proxy-component relationships
+	operations, pageRes, err := query.CollectionFilteredPaginate(ctx, k.OfflineOperations, pageReq,
+		func(_ string, operation types.OfflineOperation) (bool, error) {
+			return filter.Matches(operation, now), nil
+		},
+		func(_ string, operation types.OfflineOperation) (types.OfflineOperation, error) {
+			return operation, nil
+		})
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to iterate offline operations")
-	}
-	defer iter.Close()
-
-	for ; iter.Valid(); iter.Next() {
-		operation, err := iter.Value()
-		if err != nil {
-			continue
-		}
-
-		// For now, we'll return all operations since proxy filtering logic
-		// would depend on specific proxy-component relationships
-		operations = append(operations, operation)
+		return nil, nil, errors.Wrap(err, "failed to paginate offline operations")
 	}
 
-	return operations, nil
+	return operations, pageRes, nil
 }
@@ -13,6 +13,7 @@ import (
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
+	componentregistrytypes "racecar-web/x/componentregistry/types"
 	"racecar-web/x/pairingqueue/keeper"
 	module "racecar-web/x/pairingqueue/module"
 	"racecar-web/x/pairingqueue/types"
@@ -37,12 +38,13 @@ func initFixture(t *testing.T) *fixture {
 	authority := authtypes.NewModuleAddress(types.GovModuleName)
 
 	k := keeper.NewKeeper(
-		storeService,
 		encCfg.Codec,
+		storeService,
 		addressCodec,
 		authority,
 		nil,
 		nil,
+		fakeComponentregistryKeeper{},
 	)
 
 	// Initialize params
@@ -56,3 +58,19 @@ func initFixture(t *testing.T) *fixture {
 		addressCodec: addressCodec,
 	}
 }
+
+// fakeComponentregistryKeeper treats every component as verified and every
+// pairing as authorized
+type fakeComponentregistryKeeper struct{}
+
+func (fakeComponentregistryKeeper) GetComponentIdentity(context.Context, string) (componentregistrytypes.ComponentIdentity, bool) {
+	return componentregistrytypes.ComponentIdentity{}, true
+}
+
+func (fakeComponentregistryKeeper) VerifyComponentForPairing(context.Context, string) (bool, string) {
+	return true, ""
+}
+
+func (fakeComponentregistryKeeper) CheckBidirectionalPairingAuth(context.Context, string, string) (bool, bool, string) {
+	return true, true, ""
+}
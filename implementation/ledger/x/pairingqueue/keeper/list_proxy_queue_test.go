@@ -0,0 +1,68 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+
+	"racecar-web/x/pairingqueue/keeper"
+	"racecar-web/x/pairingqueue/types"
+)
+
+func TestListProxyQueueFiltersByStatus(t *testing.T) {
+	f := initFixture(t)
+	now := time.Now().Unix()
+
+	queue := []types.OfflineOperation{
+		{OperationId: "op-pending-1", ComponentId: "MODBATT-MOD-001", OperationType: "pairing", QueuedAt: now - 60, MaxRetries: 5},
+		{OperationId: "op-pending-2", ComponentId: "MODBATT-MOD-002", OperationType: "energy_transfer", QueuedAt: now - 7200, MaxRetries: 5},
+		{OperationId: "op-retrying-1", ComponentId: "MODBATT-MOD-001", OperationType: "pairing", QueuedAt: now - 600, RetryCount: 2, MaxRetries: 5},
+		{OperationId: "op-retrying-2", ComponentId: "MODBATT-MOD-003", OperationType: "unpairing", QueuedAt: now - 900, RetryCount: 1, MaxRetries: 5},
+		{OperationId: "op-retrying-3", ComponentId: "MODBATT-MOD-004", OperationType: "pairing", QueuedAt: now - 30, RetryCount: 4, MaxRetries: 5},
+		{OperationId: "op-failed-1", ComponentId: "MODBATT-MOD-002", OperationType: "pairing", QueuedAt: now - 86400, RetryCount: 5, MaxRetries: 5, FailureReason: "max retries exceeded"},
+	}
+	for _, op := range queue {
+		require.NoError(t, f.keeper.OfflineOperations.Set(f.ctx, op.OperationId, op))
+	}
+
+	ids := func(ops []types.OfflineOperation) []string {
+		out := make([]string, 0, len(ops))
+		for _, op := range ops {
+			out = append(out, op.OperationId)
+		}
+		return out
+	}
+
+	retrying, pageRes, err := f.keeper.ListProxyQueue(f.ctx, "MODBATT-PC-001", types.OfflineOperationFilter{Status: types.OperationStatusRetrying}, &query.PageRequest{CountTotal: true})
+	require.NoError(t, err)
+	require.Equal(t, []string{"op-retrying-1", "op-retrying-2", "op-retrying-3"}, ids(retrying))
+	require.Equal(t, uint64(3), pageRes.Total)
+
+	// Pages hold only matching operations
+	page, pageRes, err := f.keeper.ListProxyQueue(f.ctx, "MODBATT-PC-001", types.OfflineOperationFilter{Status: types.OperationStatusRetrying}, &query.PageRequest{Limit: 2})
+	require.NoError(t, err)
+	require.Equal(t, []string{"op-retrying-1", "op-retrying-2"}, ids(page))
+	rest, _, err := f.keeper.ListProxyQueue(f.ctx, "MODBATT-PC-001", types.OfflineOperationFilter{Status: types.OperationStatusRetrying}, &query.PageRequest{Key: pageRes.NextKey, Limit: 2})
+	require.NoError(t, err)
+	require.Equal(t, []string{"op-retrying-3"}, ids(rest))
+
+	failed, _, err := f.keeper.ListProxyQueue(f.ctx, "MODBATT-PC-001", types.OfflineOperationFilter{Status: types.OperationStatusFailed}, nil)
+	require.NoError(t, err)
+	require.Equal(t, []string{"op-failed-1"}, ids(failed))
+
+	// Filters combine: pending pairings queued within the last hour
+	recent, _, err := f.keeper.ListProxyQueue(f.ctx, "MODBATT-PC-001", types.OfflineOperationFilter{Status: types.OperationStatusPending, OperationType: "pairing", MaxAge: 3600}, nil)
+	require.NoError(t, err)
+	require.Equal(t, []string{"op-pending-1"}, ids(recent))
+
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	response, err := qs.ListProxyQueue(f.ctx, &types.QueryListProxyQueueRequest{ProxyId: "MODBATT-PC-001", Status: "pending", MinAgeSeconds: 3600})
+	require.NoError(t, err)
+	require.Contains(t, response.OfflineOperations, "op-pending-2")
+	require.NotContains(t, response.OfflineOperations, "op-pending-1")
+
+	_, err = qs.ListProxyQueue(f.ctx, &types.QueryListProxyQueueRequest{ProxyId: "MODBATT-PC-001", Status: "queued"})
+	require.Error(t, err)
+}
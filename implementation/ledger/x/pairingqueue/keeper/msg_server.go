@@ -22,6 +22,14 @@ func NewMsgServerImpl(keeper Keeper) types.MsgServer {
 
 // UpdateParams implements the Msg/UpdateParams message type.
 func (ms msgServer) UpdateParams(ctx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	authority, err := ms.addressCodec.BytesToString(ms.authority)
+	if err != nil {
+		return nil, errors.Wrapf(types.ErrInvalidSigner, "invalid authority encoding: %s", err)
+	}
+	if authority != msg.Authority {
+		return nil, errors.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", authority, msg.Authority)
+	}
+
 	if err := ms.Params.Set(ctx, msg.Params); err != nil {
 		return nil, errors.Wrap(err, "failed to set params")
 	}
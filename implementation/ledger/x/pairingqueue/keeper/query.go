@@ -80,9 +80,22 @@ func (qs QueryServer) ListProxyQueue(ctx context.Context, req *types.QueryListPr
 		return nil, status.Error(codes.InvalidArgument, "proxy ID cannot be empty")
 	}
 
-	operations, err := qs.Keeper.ListProxyQueue(ctx, req.ProxyId)
+	if req.Status != "" && !types.ValidOperationStatus(req.Status) {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown status %q", req.Status)
+	}
+	if req.MinAgeSeconds < 0 || req.MaxAgeSeconds < 0 || (req.MaxAgeSeconds != 0 && req.MinAgeSeconds > req.MaxAgeSeconds) {
+		return nil, status.Error(codes.InvalidArgument, "ages must be non-negative seconds with min_age_seconds <= max_age_seconds")
+	}
+
+	filter := types.OfflineOperationFilter{
+		Status:        req.Status,
+		OperationType: req.OperationType,
+		MinAge:        req.MinAgeSeconds,
+		MaxAge:        req.MaxAgeSeconds,
+	}
+	operations, pageRes, err := qs.Keeper.ListProxyQueue(ctx, req.ProxyId, filter, req.Pagination)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
 	operationsJSON, err := json.Marshal(operations)
@@ -92,5 +105,6 @@ func (qs QueryServer) ListProxyQueue(ctx context.Context, req *types.QueryListPr
 
 	return &types.QueryListProxyQueueResponse{
 		OfflineOperations: string(operationsJSON),
+		Pagination:        pageRes,
 	}, nil
 }
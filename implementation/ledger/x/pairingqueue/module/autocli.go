@@ -34,7 +34,7 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 				{
 					RpcMethod:      "ListProxyQueue",
 					Use:            "list-proxy-queue [proxy-id]",
-					Short:          "Query list-proxy-queue",
+					Short:          "Query list-proxy-queue, filtered with --status, --operation-type and --min/max-age-seconds",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "proxy_id"}},
 				},
 
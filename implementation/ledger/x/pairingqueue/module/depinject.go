@@ -7,6 +7,7 @@ import (
 	"cosmossdk.io/depinject"
 	"cosmossdk.io/depinject/appconfig"
 	"github.com/cosmos/cosmos-sdk/codec"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	componentregistrytypes "racecar-web/x/componentregistry/types"
 	"racecar-web/x/pairingqueue/keeper"
@@ -46,9 +47,16 @@ type ModuleOutputs struct {
 }
 
 func ProvideModule(in ModuleInputs) ModuleOutputs {
+	// default to governance authority if not provided
+	authority := authtypes.NewModuleAddress(types.GovModuleName)
+	if in.Config.Authority != "" {
+		authority = authtypes.NewModuleAddressOrBech32Address(in.Config.Authority)
+	}
 	k := keeper.NewKeeper(
 		in.Cdc,
 		in.StoreService,
+		in.AddressCodec,
+		authority.Bytes(),
 		in.AuthKeeper,
 		in.BankKeeper,
 		in.ComponentregistryKeeper,
@@ -0,0 +1,56 @@
+package types
+
+// Offline operation statuses, derived from an operation's retry counters
+const (
+	OperationStatusPending  = "pending"  // not attempted yet
+	OperationStatusRetrying = "retrying" // attempted and waiting for another try
+	OperationStatusFailed   = "failed"   // out of retries
+)
+
+// OperationStatus reports where an offline operation is in its retry cycle
+func OperationStatus(op OfflineOperation) string {
+	switch {
+	case op.MaxRetries > 0 && op.RetryCount >= op.MaxRetries:
+		return OperationStatusFailed
+	case op.RetryCount > 0:
+		return OperationStatusRetrying
+	default:
+		return OperationStatusPending
+	}
+}
+
+// ValidOperationStatus reports whether status names an offline operation status
+func ValidOperationStatus(status string) bool {
+	switch status {
+	case OperationStatusPending, OperationStatusRetrying, OperationStatusFailed:
+		return true
+	}
+	return false
+}
+
+// OfflineOperationFilter narrows a proxy queue listing. Empty fields match
+// every operation; ages are in seconds and zero leaves that side open.
+type OfflineOperationFilter struct {
+	Status        string
+	OperationType string
+	MinAge        int64
+	MaxAge        int64
+}
+
+// Matches reports whether op passes the filter at unix time now
+func (f OfflineOperationFilter) Matches(op OfflineOperation, now int64) bool {
+	if f.Status != "" && OperationStatus(op) != f.Status {
+		return false
+	}
+	if f.OperationType != "" && op.OperationType != f.OperationType {
+		return false
+	}
+	age := now - op.QueuedAt
+	if f.MinAge != 0 && age < f.MinAge {
+		return false
+	}
+	if f.MaxAge != 0 && age > f.MaxAge {
+		return false
+	}
+	return true
+}
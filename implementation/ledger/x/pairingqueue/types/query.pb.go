@@ -6,7 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
-	_ "github.com/cosmos/cosmos-sdk/types/query"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
@@ -295,8 +295,17 @@ func (m *QueryGetRequestStatusResponse) GetPairingRequest() string {
 }
 
 // QueryListProxyQueueRequest defines the QueryListProxyQueueRequest message.
+// Every filter is optional; an empty one matches all operations.
 type QueryListProxyQueueRequest struct {
 	ProxyId string `protobuf:"bytes,1,opt,name=proxy_id,json=proxyId,proto3" json:"proxy_id,omitempty"`
+	// status is one of "pending", "retrying" or "failed"
+	Status        string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	OperationType string `protobuf:"bytes,3,opt,name=operation_type,json=operationType,proto3" json:"operation_type,omitempty"`
+	// min_age_seconds and max_age_seconds bound how long ago an operation was
+	// queued; 0 leaves that side open
+	MinAgeSeconds int64              `protobuf:"varint,4,opt,name=min_age_seconds,json=minAgeSeconds,proto3" json:"min_age_seconds,omitempty"`
+	MaxAgeSeconds int64              `protobuf:"varint,5,opt,name=max_age_seconds,json=maxAgeSeconds,proto3" json:"max_age_seconds,omitempty"`
+	Pagination    *query.PageRequest `protobuf:"bytes,6,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *QueryListProxyQueueRequest) Reset()         { *m = QueryListProxyQueueRequest{} }
@@ -339,9 +348,45 @@ func (m *QueryListProxyQueueRequest) GetProxyId() string {
 	return ""
 }
 
+func (m *QueryListProxyQueueRequest) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *QueryListProxyQueueRequest) GetOperationType() string {
+	if m != nil {
+		return m.OperationType
+	}
+	return ""
+}
+
+func (m *QueryListProxyQueueRequest) GetMinAgeSeconds() int64 {
+	if m != nil {
+		return m.MinAgeSeconds
+	}
+	return 0
+}
+
+func (m *QueryListProxyQueueRequest) GetMaxAgeSeconds() int64 {
+	if m != nil {
+		return m.MaxAgeSeconds
+	}
+	return 0
+}
+
+func (m *QueryListProxyQueueRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // QueryListProxyQueueResponse defines the QueryListProxyQueueResponse message.
 type QueryListProxyQueueResponse struct {
-	OfflineOperations string `protobuf:"bytes,1,opt,name=offline_operations,json=offlineOperations,proto3" json:"offline_operations,omitempty"`
+	OfflineOperations string              `protobuf:"bytes,1,opt,name=offline_operations,json=offlineOperations,proto3" json:"offline_operations,omitempty"`
+	Pagination        *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *QueryListProxyQueueResponse) Reset()         { *m = QueryListProxyQueueResponse{} }
@@ -384,6 +429,13 @@ func (m *QueryListProxyQueueResponse) GetOfflineOperations() string {
 	return ""
 }
 
+func (m *QueryListProxyQueueResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "racecarweb.pairingqueue.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "racecarweb.pairingqueue.v1.QueryParamsResponse")
@@ -400,46 +452,53 @@ func init() {
 }
 
 var fileDescriptor_29103d1ed40a6368 = []byte{
-	// 612 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x4f, 0x6b, 0x13, 0x41,
-	0x1c, 0xcd, 0x0a, 0x46, 0x33, 0x95, 0xb6, 0x19, 0x7b, 0xd0, 0xb5, 0x5d, 0xeb, 0x8a, 0x56, 0x2b,
-	0xdd, 0x21, 0x15, 0x6c, 0x1b, 0xfc, 0x1b, 0x94, 0x1a, 0x2c, 0xd8, 0xc6, 0x9b, 0x08, 0x61, 0x92,
-	0x9d, 0x2e, 0x0b, 0xc9, 0xce, 0x66, 0x67, 0x12, 0x1b, 0x42, 0x2e, 0x9e, 0x3d, 0x08, 0x82, 0x9f,
-	0xc1, 0xa3, 0xdf, 0xc1, 0x4b, 0x2f, 0x42, 0xa1, 0x17, 0x4f, 0x22, 0x89, 0xe0, 0xd7, 0x90, 0xcc,
-	0xfe, 0x36, 0xff, 0x9a, 0x3f, 0xb5, 0x97, 0xb0, 0xfb, 0xe6, 0xf7, 0xde, 0xef, 0x3d, 0xf6, 0x4d,
-	0xd0, 0xed, 0x80, 0x16, 0x59, 0x91, 0x06, 0xef, 0x59, 0x81, 0xf8, 0xd4, 0x0d, 0x5c, 0xcf, 0xa9,
-	0x54, 0x59, 0x95, 0x91, 0x5a, 0x8a, 0x54, 0xaa, 0x2c, 0xa8, 0x5b, 0x7e, 0xc0, 0x25, 0xc7, 0x7a,
-	0x6f, 0xce, 0xea, 0x9f, 0xb3, 0x6a, 0x29, 0x3d, 0x49, 0xcb, 0xae, 0xc7, 0x89, 0xfa, 0x0d, 0xc7,
-	0xf5, 0xd5, 0x22, 0x17, 0x65, 0x2e, 0x48, 0x81, 0x0a, 0x16, 0xea, 0x90, 0x5a, 0xaa, 0xc0, 0x24,
-	0x4d, 0x11, 0x9f, 0x3a, 0xae, 0x47, 0xa5, 0xcb, 0x3d, 0x98, 0x5d, 0x70, 0xb8, 0xc3, 0xd5, 0x23,
-	0xe9, 0x3c, 0x01, 0xba, 0xe8, 0x70, 0xee, 0x94, 0x18, 0xa1, 0xbe, 0x4b, 0xa8, 0xe7, 0x71, 0xa9,
-	0x28, 0x02, 0x4e, 0x57, 0x26, 0xd8, 0xf6, 0x69, 0x40, 0xcb, 0x30, 0x68, 0x2e, 0x20, 0xbc, 0xd7,
-	0x59, 0xbf, 0xab, 0xc0, 0x1c, 0xab, 0x54, 0x99, 0x90, 0xe6, 0x3b, 0x74, 0x79, 0x00, 0x15, 0x3e,
-	0xf7, 0x04, 0xc3, 0x2f, 0x50, 0x3c, 0x24, 0x5f, 0xd1, 0x96, 0xb5, 0x3b, 0x33, 0xeb, 0xa6, 0x35,
-	0x3e, 0xb5, 0x15, 0x72, 0x33, 0x89, 0xc3, 0x5f, 0xd7, 0x63, 0x5f, 0xff, 0x7e, 0x5b, 0xd5, 0x72,
-	0x40, 0x36, 0x33, 0x68, 0x49, 0xa9, 0x6f, 0x33, 0xb9, 0xd7, 0x99, 0xb6, 0x61, 0x6d, 0xb4, 0x1e,
-	0xdf, 0x40, 0x97, 0x8a, 0xbc, 0xec, 0x73, 0x8f, 0x79, 0x32, 0xef, 0xda, 0x6a, 0x5b, 0x22, 0x37,
-	0xd3, 0xc5, 0xb2, 0xb6, 0xf9, 0x0a, 0x19, 0xe3, 0x34, 0xc0, 0xec, 0x5d, 0x34, 0x0f, 0x96, 0xf2,
-	0x01, 0x9c, 0x81, 0xd0, 0x1c, 0xe0, 0x11, 0xc5, 0x7c, 0x84, 0x16, 0x23, 0x31, 0xc0, 0xde, 0x48,
-	0x2a, 0xab, 0x5d, 0x3f, 0x4b, 0x08, 0x81, 0x44, 0xcf, 0x4d, 0x02, 0x90, 0xac, 0x6d, 0xbe, 0xec,
-	0xe5, 0x19, 0xa2, 0x83, 0x95, 0x15, 0x34, 0x37, 0x64, 0x05, 0x44, 0x66, 0x07, 0x9d, 0x98, 0x1b,
-	0x48, 0x57, 0x4a, 0x3b, 0xae, 0x90, 0xbb, 0x01, 0x3f, 0xa8, 0xab, 0x6c, 0x91, 0x8d, 0xab, 0xe8,
-	0xa2, 0xdf, 0x01, 0x7b, 0x26, 0x2e, 0xa8, 0xf7, 0xac, 0x6d, 0xee, 0xa0, 0x6b, 0x23, 0x89, 0x60,
-	0x60, 0x0d, 0x61, 0xbe, 0xbf, 0x5f, 0x72, 0x3d, 0x96, 0xe7, 0x3e, 0x0b, 0xc2, 0xaa, 0x80, 0x46,
-	0x12, 0x4e, 0x5e, 0x77, 0x0f, 0xd6, 0x3f, 0xc6, 0xd1, 0x79, 0x25, 0x87, 0xbf, 0x68, 0x28, 0x1e,
-	0x7e, 0x48, 0x6c, 0x4d, 0xfa, 0xd8, 0x27, 0x3b, 0xa4, 0x93, 0x53, 0xcf, 0x87, 0x26, 0xcd, 0x7b,
-	0x1f, 0x8e, 0xff, 0x7c, 0x3e, 0x77, 0x0b, 0xdf, 0x24, 0x40, 0x5c, 0x1b, 0xdf, 0x5e, 0x7c, 0xac,
-	0xa1, 0xe4, 0x89, 0x6f, 0x8f, 0xb7, 0xa6, 0xee, 0x1c, 0xd7, 0x39, 0x3d, 0x7d, 0x16, 0x2a, 0x38,
-	0xdf, 0x56, 0xce, 0x9f, 0xe1, 0x27, 0x13, 0x9d, 0x3b, 0x4c, 0xe6, 0xd5, 0x8b, 0xdd, 0x2d, 0x24,
-	0x69, 0xf4, 0xf7, 0xbc, 0x89, 0x7f, 0x68, 0x68, 0x7e, 0xb8, 0x45, 0x78, 0xf3, 0x34, 0xce, 0x46,
-	0xf5, 0x56, 0xdf, 0x3a, 0x03, 0x13, 0x22, 0x3d, 0x57, 0x91, 0x1e, 0xe3, 0x87, 0x53, 0x23, 0x45,
-	0x37, 0x43, 0x28, 0x01, 0xd2, 0xe8, 0xdd, 0x94, 0x26, 0xfe, 0xae, 0xa1, 0xd9, 0xc1, 0x4a, 0xe2,
-	0x07, 0x53, 0x3d, 0x8d, 0x2c, 0xbf, 0xbe, 0xf1, 0xdf, 0x3c, 0x48, 0xf2, 0x54, 0x25, 0x49, 0xe3,
-	0xcd, 0x89, 0x49, 0x4a, 0xae, 0x90, 0xf9, 0xf0, 0x76, 0x85, 0x58, 0x23, 0xba, 0x6a, 0xcd, 0x4c,
-	0xfa, 0xb0, 0x65, 0x68, 0x47, 0x2d, 0x43, 0xfb, 0xdd, 0x32, 0xb4, 0x4f, 0x6d, 0x23, 0x76, 0xd4,
-	0x36, 0x62, 0x3f, 0xdb, 0x46, 0xec, 0xed, 0x72, 0xbf, 0xe4, 0xc1, 0xa0, 0xa8, 0xac, 0xfb, 0x4c,
-	0x14, 0xe2, 0xea, 0x6f, 0xf6, 0xfe, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x96, 0x01, 0x69, 0xaf,
-	0x48, 0x06, 0x00, 0x00,
+	// 733 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0x4b, 0x4f, 0xd4, 0x50,
+	0x14, 0x9e, 0x0e, 0x32, 0xca, 0x41, 0x5e, 0x57, 0x62, 0xc6, 0x0a, 0x15, 0x6b, 0x78, 0x88, 0xa1,
+	0x37, 0x83, 0x89, 0x02, 0xf1, 0x05, 0x51, 0x91, 0x68, 0x22, 0x0c, 0xae, 0x8c, 0x49, 0x73, 0x67,
+	0x7a, 0x69, 0x9a, 0x30, 0xbd, 0xa5, 0xbd, 0x83, 0x33, 0x21, 0x6c, 0x5c, 0x1b, 0x63, 0x62, 0xf4,
+	0x37, 0xb8, 0xf4, 0x3f, 0xb8, 0x61, 0x63, 0x42, 0xc2, 0xc6, 0x95, 0x31, 0x60, 0xe2, 0xdf, 0x30,
+	0x73, 0xef, 0xed, 0x3c, 0x60, 0x1e, 0xc8, 0x86, 0xb4, 0xe7, 0x7c, 0xdf, 0x39, 0xdf, 0x57, 0xce,
+	0x39, 0x03, 0x13, 0x21, 0xc9, 0xd3, 0x3c, 0x09, 0xdf, 0xd2, 0x1c, 0x0e, 0x88, 0x17, 0x7a, 0xbe,
+	0xbb, 0x55, 0xa4, 0x45, 0x8a, 0xb7, 0x33, 0x78, 0xab, 0x48, 0xc3, 0xb2, 0x15, 0x84, 0x8c, 0x33,
+	0xa4, 0xd7, 0x70, 0x56, 0x3d, 0xce, 0xda, 0xce, 0xe8, 0x43, 0xa4, 0xe0, 0xf9, 0x0c, 0x8b, 0xbf,
+	0x12, 0xae, 0x4f, 0xe7, 0x59, 0x54, 0x60, 0x11, 0xce, 0x91, 0x88, 0xca, 0x3a, 0x78, 0x3b, 0x93,
+	0xa3, 0x9c, 0x64, 0x70, 0x40, 0x5c, 0xcf, 0x27, 0xdc, 0x63, 0xbe, 0xc2, 0x0e, 0xbb, 0xcc, 0x65,
+	0xe2, 0x11, 0x57, 0x9e, 0x54, 0x74, 0xc4, 0x65, 0xcc, 0xdd, 0xa4, 0x98, 0x04, 0x1e, 0x26, 0xbe,
+	0xcf, 0xb8, 0xa0, 0x44, 0x2a, 0x3b, 0xd9, 0x46, 0x76, 0x40, 0x42, 0x52, 0x50, 0x40, 0x73, 0x18,
+	0xd0, 0x5a, 0xa5, 0xfd, 0xaa, 0x08, 0x66, 0xe9, 0x56, 0x91, 0x46, 0xdc, 0x7c, 0x03, 0x97, 0x1a,
+	0xa2, 0x51, 0xc0, 0xfc, 0x88, 0xa2, 0x27, 0x90, 0x92, 0xe4, 0xb4, 0x36, 0xa6, 0x4d, 0xf5, 0xce,
+	0x9a, 0x56, 0x6b, 0xd7, 0x96, 0xe4, 0x2e, 0xf5, 0xec, 0xfd, 0xba, 0x96, 0xf8, 0xfa, 0xf7, 0xdb,
+	0xb4, 0x96, 0x55, 0x64, 0x73, 0x09, 0x46, 0x45, 0xf5, 0x65, 0xca, 0xd7, 0x2a, 0x68, 0x47, 0xb5,
+	0x8d, 0xdb, 0xa3, 0xeb, 0x70, 0x31, 0xcf, 0x0a, 0x01, 0xf3, 0xa9, 0xcf, 0x6d, 0xcf, 0x11, 0xdd,
+	0x7a, 0xb2, 0xbd, 0xd5, 0xd8, 0x8a, 0x63, 0x3e, 0x07, 0xa3, 0x55, 0x0d, 0x25, 0xf6, 0x26, 0x0c,
+	0x2a, 0x49, 0x76, 0xa8, 0x72, 0xaa, 0xd0, 0x80, 0x8a, 0xc7, 0x14, 0xf3, 0x3e, 0x8c, 0xc4, 0xc5,
+	0x54, 0x6c, 0x9d, 0x13, 0x5e, 0xac, 0xea, 0x19, 0x05, 0x50, 0x25, 0x6a, 0x6a, 0x7a, 0x54, 0x64,
+	0xc5, 0x31, 0x9f, 0xd5, 0xfc, 0x1c, 0xa3, 0x2b, 0x29, 0x93, 0x30, 0x70, 0x4c, 0x8a, 0x2a, 0xd2,
+	0xdf, 0xa8, 0xc4, 0xfc, 0x90, 0x04, 0x5d, 0x94, 0x7a, 0xe1, 0x45, 0x7c, 0x35, 0x64, 0xa5, 0xb2,
+	0x30, 0x17, 0xeb, 0xb8, 0x02, 0x17, 0x82, 0x4a, 0xb0, 0xa6, 0xe2, 0xbc, 0x78, 0x5f, 0x71, 0xd0,
+	0x65, 0x48, 0x45, 0xa2, 0x69, 0x3a, 0x29, 0x12, 0xea, 0x0d, 0x8d, 0x43, 0x3f, 0x0b, 0x68, 0x28,
+	0x86, 0xc3, 0xe6, 0xe5, 0x80, 0xa6, 0xbb, 0x44, 0xbe, 0xaf, 0x1a, 0x7d, 0x55, 0x0e, 0x28, 0x9a,
+	0x80, 0x81, 0x82, 0xe7, 0xdb, 0xc4, 0xa5, 0x76, 0x44, 0xf3, 0xcc, 0x77, 0xa2, 0xf4, 0xb9, 0x31,
+	0x6d, 0xaa, 0x2b, 0xdb, 0x57, 0xf0, 0xfc, 0x45, 0x97, 0xae, 0xcb, 0xa0, 0xc0, 0x91, 0x52, 0x03,
+	0xae, 0x5b, 0xe1, 0x48, 0xa9, 0x0e, 0xf7, 0x14, 0xa0, 0x36, 0xc7, 0xe9, 0x94, 0x98, 0x96, 0x09,
+	0x4b, 0x0e, 0xbd, 0x55, 0x19, 0x7a, 0x4b, 0x2e, 0x8f, 0x1a, 0x7a, 0x6b, 0x95, 0xb8, 0xb1, 0xcb,
+	0x6c, 0x1d, 0xd3, 0xfc, 0xac, 0xc1, 0xd5, 0xa6, 0x1f, 0x44, 0x7d, 0xd9, 0x19, 0x40, 0x6c, 0x63,
+	0x63, 0xd3, 0xf3, 0xa9, 0x5d, 0x35, 0x14, 0xff, 0x9b, 0x87, 0x54, 0xe6, 0x65, 0x35, 0x81, 0x96,
+	0x1b, 0x64, 0x25, 0x85, 0xac, 0xc9, 0x8e, 0xb2, 0x64, 0xaf, 0x7a, 0x5d, 0xb3, 0xef, 0x53, 0xd0,
+	0x2d, 0x74, 0xa1, 0x2f, 0x1a, 0xa4, 0xe4, 0xa8, 0x23, 0xab, 0xdd, 0x3a, 0x9c, 0xdc, 0x32, 0x1d,
+	0x9f, 0x1a, 0x2f, 0x15, 0x98, 0xb7, 0xde, 0x1d, 0xfc, 0xf9, 0x94, 0x1c, 0x47, 0x37, 0xb0, 0x22,
+	0xce, 0xb4, 0xde, 0x6f, 0x74, 0xa0, 0xc1, 0xd0, 0x89, 0xed, 0x40, 0xf3, 0x1d, 0x7b, 0xb6, 0xda,
+	0x4a, 0x7d, 0xe1, 0x2c, 0x54, 0xa5, 0x7c, 0x59, 0x28, 0x5f, 0x44, 0x0f, 0xdb, 0x2a, 0x77, 0x29,
+	0xb7, 0xc5, 0x8b, 0x53, 0x5d, 0x59, 0xbc, 0x53, 0x7f, 0x09, 0x76, 0xd1, 0x0f, 0x0d, 0x06, 0x8f,
+	0xef, 0x19, 0x9a, 0x3b, 0x8d, 0xb2, 0x66, 0x9b, 0xad, 0xcf, 0x9f, 0x81, 0xa9, 0x2c, 0x3d, 0x16,
+	0x96, 0x1e, 0xa0, 0x7b, 0x1d, 0x2d, 0xc5, 0xb7, 0x43, 0xae, 0x24, 0xde, 0xa9, 0xdd, 0x92, 0x5d,
+	0xf4, 0x5d, 0x83, 0xfe, 0xc6, 0xd9, 0x46, 0x77, 0x3a, 0x6a, 0x6a, 0x7a, 0x1d, 0xf4, 0xbb, 0xff,
+	0xcd, 0x53, 0x4e, 0x1e, 0x09, 0x27, 0x0b, 0x68, 0xae, 0xad, 0x93, 0x4d, 0x2f, 0xe2, 0xb6, 0x3c,
+	0x3f, 0x32, 0xb6, 0x13, 0xdf, 0xa2, 0xdd, 0xa5, 0x85, 0xbd, 0x43, 0x43, 0xdb, 0x3f, 0x34, 0xb4,
+	0xdf, 0x87, 0x86, 0xf6, 0xf1, 0xc8, 0x48, 0xec, 0x1f, 0x19, 0x89, 0x9f, 0x47, 0x46, 0xe2, 0xf5,
+	0x58, 0x7d, 0xc9, 0x52, 0x63, 0xd1, 0xca, 0x39, 0x8a, 0x72, 0x29, 0xf1, 0x43, 0x74, 0xfb, 0x5f,
+	0x00, 0x00, 0x00, 0xff, 0xff, 0xf8, 0x31, 0x97, 0x87, 0x6a, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -835,6 +894,42 @@ func (m *QueryListProxyQueueRequest) MarshalToSizedBuffer(dAtA []byte) (int, err
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.MaxAgeSeconds != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxAgeSeconds))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MinAgeSeconds != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MinAgeSeconds))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.OperationType) > 0 {
+		i -= len(m.OperationType)
+		copy(dAtA[i:], m.OperationType)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OperationType)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.ProxyId) > 0 {
 		i -= len(m.ProxyId)
 		copy(dAtA[i:], m.ProxyId)
@@ -865,6 +960,18 @@ func (m *QueryListProxyQueueResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.OfflineOperations) > 0 {
 		i -= len(m.OfflineOperations)
 		copy(dAtA[i:], m.OfflineOperations)
@@ -968,6 +1075,24 @@ func (m *QueryListProxyQueueRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.OperationType)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.MinAgeSeconds != 0 {
+		n += 1 + sovQuery(uint64(m.MinAgeSeconds))
+	}
+	if m.MaxAgeSeconds != 0 {
+		n += 1 + sovQuery(uint64(m.MaxAgeSeconds))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -981,6 +1106,10 @@ func (m *QueryListProxyQueueResponse) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -1512,6 +1641,144 @@ func (m *QueryListProxyQueueRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.ProxyId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperationType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperationType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinAgeSeconds", wireType)
+			}
+			m.MinAgeSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinAgeSeconds |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAgeSeconds", wireType)
+			}
+			m.MaxAgeSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxAgeSeconds |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1594,6 +1861,42 @@ func (m *QueryListProxyQueueResponse) Unmarshal(dAtA []byte) error {
 			}
 			m.OfflineOperations = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
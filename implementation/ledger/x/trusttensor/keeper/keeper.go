@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"cosmossdk.io/collections"
@@ -28,9 +29,21 @@ type Keeper struct {
 	// Trust tensor storage
 	RelationshipTensors collections.Map[string, types.RelationshipTrustTensor]
 	ValueTensors        collections.Map[string, types.ValueTensor]
+	// TensorHistory holds the score updates of each tensor, keyed by tensor ID and sequence
+	TensorHistory collections.Map[collections.Pair[string, uint64], types.TensorHistoryEntry]
+	// TensorIDIndex maps a tensor ID to the LCT ID its tensor is stored under
+	TensorIDIndex collections.Map[string, string]
+
+	bankKeeper types.BankKeeper
+	// lctmanager is shared by every copy of the keeper, so the lctmanager
+	// keeper wired in after depinject built them reaches all of them
+	lctmanager *lctmanagerRef
+}
 
-	bankKeeper       types.BankKeeper
-	lctmanagerKeeper lctmanagertypes.LctmanagerKeeper
+// lctmanagerRef holds the lctmanager keeper, which depends on this module
+// through componentregistry and so cannot be injected into NewKeeper
+type lctmanagerRef struct {
+	keeper lctmanagertypes.LctmanagerKeeper
 }
 
 func NewKeeper(
@@ -55,10 +68,12 @@ func NewKeeper(
 		authority:    authority,
 
 		bankKeeper:          bankKeeper,
-		lctmanagerKeeper:    lctmanagerKeeper,
+		lctmanager:          &lctmanagerRef{keeper: lctmanagerKeeper},
 		Params:              collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
 		RelationshipTensors: collections.NewMap(sb, types.RelationshipTrustTensorKey, "relationship_tensors", collections.StringKey, codec.CollValue[types.RelationshipTrustTensor](cdc)),
 		ValueTensors:        collections.NewMap(sb, types.ValueTensorKey, "value_tensors", collections.StringKey, codec.CollValue[types.ValueTensor](cdc)),
+		TensorHistory:       collections.NewMap(sb, types.TensorHistoryKey, "tensor_history", collections.PairKeyCodec(collections.StringKey, collections.Uint64Key), codec.CollValue[types.TensorHistoryEntry](cdc)),
+		TensorIDIndex:       collections.NewMap(sb, types.TensorIDIndexKey, "tensor_id_index", collections.StringKey, collections.StringValue),
 	}
 
 	schema, err := sb.Build()
@@ -70,6 +85,16 @@ func NewKeeper(
 	return k
 }
 
+// SetLctmanagerKeeper wires in the lctmanager keeper once the app is built
+func (k Keeper) SetLctmanagerKeeper(lctmanagerKeeper lctmanagertypes.LctmanagerKeeper) {
+	k.lctmanager.keeper = lctmanagerKeeper
+}
+
+// lctmanagerKeeper returns the lctmanager keeper, or nil when none is wired in
+func (k Keeper) lctmanagerKeeper() lctmanagertypes.LctmanagerKeeper {
+	return k.lctmanager.keeper
+}
+
 // GetAuthority returns the module's authority.
 func (k Keeper) GetAuthority() []byte {
 	return k.authority
@@ -78,8 +103,8 @@ func (k Keeper) GetAuthority() []byte {
 // CalculateRelationshipTrust calculates composite trust score for an LCT relationship
 func (k Keeper) CalculateRelationshipTrust(ctx context.Context, lctId, operationalContext string) (string, string, error) {
 	// Verify LCT relationship exists (if lctmanagerKeeper is available)
-	if k.lctmanagerKeeper != nil {
-		lct, found := k.lctmanagerKeeper.GetLinkedContextToken(ctx, lctId)
+	if lctmanagerKeeper := k.lctmanagerKeeper(); lctmanagerKeeper != nil {
+		lct, found := lctmanagerKeeper.GetLinkedContextToken(ctx, lctId)
 		if !found {
 			// Return default trust score if LCT not found
 			return "0.5", "default_trust_no_lct_found", nil
@@ -115,5 +140,15 @@ func (k Keeper) CalculateRelationshipTrust(ctx context.Context, lctId, operation
 }
 
 func (k Keeper) GetParams(ctx context.Context) (types.Params, error) {
-	return types.Params{}, nil
+	return k.Params.Get(ctx)
+}
+
+// paramsOrDefault returns the stored params, falling back to the defaults
+// when none have been stored yet
+func (k Keeper) paramsOrDefault(ctx context.Context) (types.Params, error) {
+	params, err := k.Params.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.DefaultParams(), nil
+	}
+	return params, err
 }
@@ -13,6 +13,7 @@ import (
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
+	lctmanagertypes "racecar-web/x/lctmanager/types"
 	"racecar-web/x/trusttensor/keeper"
 	module "racecar-web/x/trusttensor/module"
 	"racecar-web/x/trusttensor/types"
@@ -26,6 +27,12 @@ type fixture struct {
 
 func initFixture(t *testing.T) *fixture {
 	t.Helper()
+	return initFixtureWithLctmanager(t, nil)
+}
+
+// initFixtureWithLctmanager builds the fixture on top of an lctmanager keeper
+func initFixtureWithLctmanager(t *testing.T, lctmanagerKeeper lctmanagertypes.LctmanagerKeeper) *fixture {
+	t.Helper()
 
 	encCfg := moduletestutil.MakeTestEncodingConfig(module.AppModule{})
 	addressCodec := addresscodec.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix())
@@ -42,7 +49,7 @@ func initFixture(t *testing.T) *fixture {
 		addressCodec,
 		authority,
 		nil,
-		nil,
+		lctmanagerKeeper,
 	)
 
 	// Initialize params
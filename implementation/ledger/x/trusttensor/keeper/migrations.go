@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"racecar-web/x/trusttensor/types"
+)
+
+// Migrator runs the module's store migrations
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a Migrator for the keeper's store
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 indexes the relationship tensors stored before GetTensorByID
+// looked them up by tensor ID
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	return m.keeper.RelationshipTensors.Walk(ctx, nil, func(lctID string, tensor types.RelationshipTrustTensor) (bool, error) {
+		if tensor.TensorId == "" {
+			return false, nil
+		}
+		return false, m.keeper.TensorIDIndex.Set(ctx, tensor.TensorId, lctID)
+	})
+}
+
+// Migrate2to3 stores explicit params. Params written before dimension weights,
+// the default dimension and the history length existed decode with those
+// fields empty, which weighs every dimension equally instead of by T3 weight
+// and fills a dimension with no name from a single initial score.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	params, err := m.keeper.paramsOrDefault(ctx)
+	if err != nil {
+		return err
+	}
+	defaults := types.DefaultParams()
+	if len(params.DimensionWeights) == 0 {
+		params.DimensionWeights = defaults.DimensionWeights
+	}
+	if params.DefaultDimension == "" {
+		params.DefaultDimension = defaults.DefaultDimension
+	}
+	if params.MaxHistoryLength == 0 {
+		params.MaxHistoryLength = defaults.MaxHistoryLength
+	}
+	if err := params.Validate(); err != nil {
+		return err
+	}
+	return m.keeper.Params.Set(ctx, params)
+}
@@ -0,0 +1,69 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"racecar-web/x/trusttensor/keeper"
+	"racecar-web/x/trusttensor/types"
+)
+
+func TestMigrate1to2IndexesTensorsByID(t *testing.T) {
+	f := initFixture(t)
+
+	// Stored by version 1, which kept no index
+	for _, lctID := range []string{"lct-PACK-MC", "lct-MOD-PACK"} {
+		require.NoError(t, f.keeper.RelationshipTensors.Set(f.ctx, lctID, types.RelationshipTrustTensor{TensorId: "tensor-" + lctID, LctId: lctID}))
+	}
+	_, found, err := f.keeper.GetTensorByID(f.ctx, "tensor-lct-PACK-MC")
+	require.NoError(t, err)
+	require.False(t, found)
+
+	require.NoError(t, keeper.NewMigrator(f.keeper).Migrate1to2(sdk.UnwrapSDKContext(f.ctx)))
+
+	for _, lctID := range []string{"lct-PACK-MC", "lct-MOD-PACK"} {
+		tensor, found, err := f.keeper.GetTensorByID(f.ctx, "tensor-"+lctID)
+		require.NoError(t, err)
+		require.True(t, found)
+		require.Equal(t, lctID, tensor.LctId)
+	}
+}
+
+func TestSetRelationshipTensorReindexesRenamedTensors(t *testing.T) {
+	f := initFixture(t)
+
+	require.NoError(t, f.keeper.SetRelationshipTensor(f.ctx, "lct-PACK-MC", types.RelationshipTrustTensor{TensorId: "tensor-old", LctId: "lct-PACK-MC"}))
+	require.NoError(t, f.keeper.SetRelationshipTensor(f.ctx, "lct-PACK-MC", types.RelationshipTrustTensor{TensorId: "tensor-new", LctId: "lct-PACK-MC"}))
+
+	_, found, err := f.keeper.GetTensorByID(f.ctx, "tensor-old")
+	require.NoError(t, err)
+	require.False(t, found)
+	tensor, found, err := f.keeper.GetTensorByID(f.ctx, "tensor-new")
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, "lct-PACK-MC", tensor.LctId)
+}
+
+func TestMigrate2to3StoresExplicitParams(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+
+	// Stored by version 2, whose params had none of these fields
+	require.NoError(t, f.keeper.Params.Set(ctx, types.Params{}))
+	require.NoError(t, keeper.NewMigrator(f.keeper).Migrate2to3(ctx))
+	params, err := f.keeper.Params.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, types.DefaultParams(), params)
+
+	// Fields set by governance are kept
+	custom := types.NewParams([]types.DimensionWeight{{Dimension: types.DimensionTalent, Weight: "1"}}, "", 10)
+	require.NoError(t, f.keeper.Params.Set(ctx, custom))
+	require.NoError(t, keeper.NewMigrator(f.keeper).Migrate2to3(ctx))
+	params, err = f.keeper.Params.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, custom.DimensionWeights, params.DimensionWeights)
+	require.Equal(t, types.DefaultDimension, params.DefaultDimension)
+	require.Equal(t, uint64(10), params.MaxHistoryLength)
+}
@@ -6,15 +6,50 @@ import (
 	"racecar-web/x/trusttensor/types"
 
 	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
+// legacyInitialScore seeds every T3 dimension of a tensor created without
+// scores, matching the tensor reported for an LCT that has none
+const legacyInitialScore = "0.5"
+
 func (ms msgServer) CreateRelationshipTensor(ctx context.Context, msg *types.MsgCreateRelationshipTensor) (*types.MsgCreateRelationshipTensorResponse, error) {
 	if msg.Creator == "" || msg.LctId == "" || msg.TensorType == "" {
 		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "missing required fields")
 	}
 
-	// TODO: Implement actual tensor creation logic
+	dimensions := msg.Dimensions
+	switch {
+	case len(dimensions) > 0 && msg.InitialScore != "":
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "give either dimensions or an initial score, not both")
+	case msg.InitialScore != "":
+		// A single score keeps working by filling the default dimension
+		params, err := ms.paramsOrDefault(ctx)
+		if err != nil {
+			return nil, err
+		}
+		dimensions = []types.TensorDimension{{Name: params.DefaultDimension, Score: msg.InitialScore}}
+	case len(dimensions) == 0:
+		dimensions = []types.TensorDimension{
+			{Name: types.DimensionTalent, Score: legacyInitialScore},
+			{Name: types.DimensionTraining, Score: legacyInitialScore},
+			{Name: types.DimensionTemperament, Score: legacyInitialScore},
+		}
+	}
+
+	tensor, err := ms.Keeper.CreateRelationshipTensor(ctx, msg.Creator, msg.LctId, msg.TensorType, msg.Context, dimensions)
+	if err != nil {
+		return nil, err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent("relationship_tensor_created",
+			sdk.NewAttribute("tensor_id", tensor.TensorId),
+			sdk.NewAttribute("lct_id", tensor.LctId),
+			sdk.NewAttribute("tensor_type", tensor.TensorType),
+		),
+	)
 
-	return &types.MsgCreateRelationshipTensorResponse{TensorId: "stub-tensor-id"}, nil
+	return &types.MsgCreateRelationshipTensorResponse{TensorId: tensor.TensorId}, nil
 }
@@ -0,0 +1,156 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	lctmanagertypes "racecar-web/x/lctmanager/types"
+	"racecar-web/x/trusttensor/keeper"
+	"racecar-web/x/trusttensor/types"
+)
+
+func TestCreateRelationshipTensorWithDimensions(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(1752484904, 0)).WithEventManager(sdk.NewEventManager())
+
+	params := types.NewParams([]types.DimensionWeight{
+		{Dimension: "reliability", Weight: "2"},
+		{Dimension: "latency", Weight: "1"},
+		{Dimension: "safety", Weight: "1"},
+	}, types.DefaultDimension, types.DefaultMaxHistoryLength)
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+
+	res, err := ms.CreateRelationshipTensor(ctx, &types.MsgCreateRelationshipTensor{
+		Creator:    "cosmos1creator",
+		LctId:      "lct-PACK-MC-1",
+		TensorType: "T3",
+		Context:    "energy_delivery",
+		Dimensions: []types.TensorDimension{
+			{Name: "safety", Score: "0.6"},
+			{Name: "reliability", Score: "0.9"},
+			{Name: "latency", Score: "0.5"},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "tensor-lct-PACK-MC-1", res.TensorId)
+
+	events := ctx.EventManager().Events()
+	require.Equal(t, "relationship_tensor_created", events[len(events)-1].Type)
+
+	tensor, err := qs.GetTrustTensor(ctx, &types.QueryGetTrustTensorRequest{TensorId: res.TensorId})
+	require.NoError(t, err)
+	require.Equal(t, []types.TensorDimension{
+		{Name: "latency", Score: "0.500000000000000000"},
+		{Name: "reliability", Score: "0.900000000000000000"},
+		{Name: "safety", Score: "0.600000000000000000"},
+	}, tensor.Dimensions)
+	require.Equal(t, int64(1752484904), tensor.Tensor.CreatedAt)
+	// (0.9*2 + 0.5*1 + 0.6*1) / 4
+	require.Equal(t, "0.725000000000000000", tensor.CompositeScore)
+
+	// One tensor per LCT
+	_, err = ms.CreateRelationshipTensor(ctx, &types.MsgCreateRelationshipTensor{
+		Creator: "cosmos1creator", LctId: "lct-PACK-MC-1", TensorType: "T3", InitialScore: "0.7",
+	})
+	require.ErrorIs(t, err, types.ErrTensorExists)
+}
+
+func TestCreateRelationshipTensorFromInitialScore(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	res, err := ms.CreateRelationshipTensor(f.ctx, &types.MsgCreateRelationshipTensor{
+		Creator: "cosmos1creator", LctId: "lct-PACK-MOD-1", TensorType: "T3", InitialScore: "0.7",
+	})
+	require.NoError(t, err)
+
+	tensor, err := qs.GetTrustTensor(f.ctx, &types.QueryGetTrustTensorRequest{TensorId: res.TensorId})
+	require.NoError(t, err)
+	require.Equal(t, []types.TensorDimension{{Name: types.DefaultDimension, Score: "0.700000000000000000"}}, tensor.Dimensions)
+	require.Equal(t, "0.700000000000000000", tensor.CompositeScore)
+
+	// Without any score the tensor starts from the neutral T3 scores
+	res, err = ms.CreateRelationshipTensor(f.ctx, &types.MsgCreateRelationshipTensor{
+		Creator: "cosmos1creator", LctId: "lct-PACK-MOD-2", TensorType: "T3",
+	})
+	require.NoError(t, err)
+	tensor, err = qs.GetTrustTensor(f.ctx, &types.QueryGetTrustTensorRequest{TensorId: res.TensorId})
+	require.NoError(t, err)
+	require.Equal(t, "0.500000000000000000", tensor.Tensor.TalentScore)
+	require.Equal(t, "0.500000000000000000", tensor.CompositeScore)
+}
+
+func TestCreateRelationshipTensorRejects(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+
+	testCases := []struct {
+		name   string
+		msg    *types.MsgCreateRelationshipTensor
+		expErr error
+	}{
+		{
+			name:   "score and dimensions",
+			msg:    &types.MsgCreateRelationshipTensor{InitialScore: "0.5", Dimensions: []types.TensorDimension{{Name: "safety", Score: "0.5"}}},
+			expErr: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name:   "score above one",
+			msg:    &types.MsgCreateRelationshipTensor{Dimensions: []types.TensorDimension{{Name: "safety", Score: "1.5"}}},
+			expErr: types.ErrInvalidDimension,
+		},
+		{
+			name:   "unnamed dimension",
+			msg:    &types.MsgCreateRelationshipTensor{Dimensions: []types.TensorDimension{{Score: "0.5"}}},
+			expErr: types.ErrInvalidDimension,
+		},
+		{
+			name:   "repeated dimension",
+			msg:    &types.MsgCreateRelationshipTensor{Dimensions: []types.TensorDimension{{Name: "safety", Score: "0.5"}, {Name: "safety", Score: "0.6"}}},
+			expErr: types.ErrInvalidDimension,
+		},
+		{
+			name:   "initial score not a decimal",
+			msg:    &types.MsgCreateRelationshipTensor{InitialScore: "high"},
+			expErr: types.ErrInvalidDimension,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.msg.Creator, tc.msg.LctId, tc.msg.TensorType = "cosmos1creator", "lct-PACK-MC-1", "T3"
+			_, err := ms.CreateRelationshipTensor(f.ctx, tc.msg)
+			require.ErrorIs(t, err, tc.expErr)
+		})
+	}
+}
+
+func TestCreateRelationshipTensorRequiresALivePairedLct(t *testing.T) {
+	f := initFixtureWithLctmanager(t, fakeLctmanagerKeeper{lcts: map[string]lctmanagertypes.LinkedContextToken{
+		"lct-PACK-MC-1":   {LctId: "lct-PACK-MC-1", ComponentAId: "PACK-1", ComponentBId: "MC-1", PairingStatus: lctmanagertypes.StatusActive},
+		"lct-PACK-only":   {LctId: "lct-PACK-only", ComponentAId: "PACK-2", PairingStatus: lctmanagertypes.StatusActive},
+		"lct-PACK-MC-old": {LctId: "lct-PACK-MC-old", ComponentAId: "PACK-3", ComponentBId: "MC-3", PairingStatus: lctmanagertypes.StatusTerminated},
+	}})
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	create := func(lctID string) error {
+		_, err := ms.CreateRelationshipTensor(f.ctx, &types.MsgCreateRelationshipTensor{Creator: "cosmos1creator", LctId: lctID, TensorType: "T3"})
+		return err
+	}
+
+	require.ErrorIs(t, create("lct-unknown"), types.ErrLctNotFound)
+	require.ErrorIs(t, create("lct-PACK-only"), types.ErrInvalidLct)
+	require.ErrorIs(t, create("lct-PACK-MC-old"), types.ErrInvalidLct)
+	for _, lctID := range []string{"lct-unknown", "lct-PACK-only", "lct-PACK-MC-old"} {
+		has, err := f.keeper.RelationshipTensors.Has(f.ctx, lctID)
+		require.NoError(t, err)
+		require.False(t, has, lctID)
+	}
+
+	require.NoError(t, create("lct-PACK-MC-1"))
+}
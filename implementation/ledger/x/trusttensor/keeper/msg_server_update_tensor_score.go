@@ -6,15 +6,65 @@ import (
 	"racecar-web/x/trusttensor/types"
 
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
+// canUpdateTensor reports whether signer may score tensor: the account that
+// created it, the trust anchor of its LCT, or the module authority
+func (ms msgServer) canUpdateTensor(ctx context.Context, signer string, tensor types.RelationshipTrustTensor) bool {
+	if tensor.Creator != "" && tensor.Creator == signer {
+		return true
+	}
+	if authority, err := ms.addressCodec.BytesToString(ms.authority); err == nil && authority == signer {
+		return true
+	}
+	lctmanagerKeeper := ms.lctmanagerKeeper()
+	if lctmanagerKeeper == nil {
+		return false
+	}
+	lct, found := lctmanagerKeeper.GetLinkedContextToken(ctx, tensor.LctId)
+	return found && lct.TrustAnchor != "" && lct.TrustAnchor == signer
+}
+
 func (ms msgServer) UpdateTensorScore(ctx context.Context, msg *types.MsgUpdateTensorScore) (*types.MsgUpdateTensorScoreResponse, error) {
 	if msg.Creator == "" || msg.TensorId == "" || msg.Dimension == "" || msg.Value == "" {
 		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "missing required fields")
 	}
 
-	// TODO: Implement actual tensor score update logic
+	value, err := math.LegacyNewDecFromStr(msg.Value)
+	if err != nil {
+		return nil, errorsmod.Wrapf(types.ErrInvalidDimension, "score %q of dimension %s is not a decimal", msg.Value, msg.Dimension)
+	}
+
+	tensor, found, err := ms.GetTensorByID(ctx, msg.TensorId)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, errorsmod.Wrap(types.ErrTensorNotFound, msg.TensorId)
+	}
+	if !ms.canUpdateTensor(ctx, msg.Creator, tensor) {
+		return nil, errorsmod.Wrapf(types.ErrUnauthorized, "%s is neither the creator of %s nor the trust anchor of %s", msg.Creator, tensor.TensorId, tensor.LctId)
+	}
+
+	// Tensors are stored under their LCT ID; the history is kept per tensor ID
+	ctx = WithHistoryActor(ctx, msg.Creator)
+	if err := ms.Keeper.UpdateTensorScore(ctx, tensor.LctId, msg.Dimension, value, msg.Context); err != nil {
+		return nil, err
+	}
+
+	updated, _ := ms.GetRelationshipTensor(ctx, tensor.LctId)
+	score, _ := updated.DimensionScore(msg.Dimension)
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent("tensor_score_updated",
+			sdk.NewAttribute("tensor_id", tensor.TensorId),
+			sdk.NewAttribute("lct_id", tensor.LctId),
+			sdk.NewAttribute("dimension", msg.Dimension),
+			sdk.NewAttribute("score", score),
+		),
+	)
 
 	return &types.MsgUpdateTensorScoreResponse{}, nil
 }
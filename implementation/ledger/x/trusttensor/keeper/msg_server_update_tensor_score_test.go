@@ -0,0 +1,62 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	lctmanagertypes "racecar-web/x/lctmanager/types"
+	"racecar-web/x/trusttensor/keeper"
+	"racecar-web/x/trusttensor/types"
+)
+
+// fakeLctmanagerKeeper serves LCTs with their trust anchors from memory
+type fakeLctmanagerKeeper struct {
+	lctmanagertypes.LctmanagerKeeper
+	lcts map[string]lctmanagertypes.LinkedContextToken
+}
+
+func (a fakeLctmanagerKeeper) GetLinkedContextToken(_ context.Context, lctId string) (lctmanagertypes.LinkedContextToken, bool) {
+	lct, found := a.lcts[lctId]
+	return lct, found
+}
+
+func TestUpdateTensorScoreRequiresCreatorOrParticipant(t *testing.T) {
+	f := initFixtureWithLctmanager(t, fakeLctmanagerKeeper{lcts: map[string]lctmanagertypes.LinkedContextToken{
+		"lct-PACK-MC-1": {LctId: "lct-PACK-MC-1", ComponentAId: "PACK-1", ComponentBId: "MC-1", TrustAnchor: "cosmos1anchor", PairingStatus: "active"},
+	}})
+	ms := keeper.NewMsgServerImpl(f.keeper)
+
+	res, err := ms.CreateRelationshipTensor(f.ctx, &types.MsgCreateRelationshipTensor{
+		Creator:    "cosmos1creator",
+		LctId:      "lct-PACK-MC-1",
+		TensorType: "T3",
+	})
+	require.NoError(t, err)
+	tensor, _, err := f.keeper.GetTensorByID(f.ctx, res.TensorId)
+	require.NoError(t, err)
+	require.Equal(t, "cosmos1creator", tensor.Creator)
+
+	update := func(signer string) error {
+		_, err := ms.UpdateTensorScore(f.ctx, &types.MsgUpdateTensorScore{
+			Creator:   signer,
+			TensorId:  res.TensorId,
+			Dimension: types.DimensionTalent,
+			Value:     "0.1",
+		})
+		return err
+	}
+
+	// An outsider cannot rewrite the score
+	require.ErrorIs(t, update("cosmos1stranger"), types.ErrUnauthorized)
+	unchanged, _ := f.keeper.GetRelationshipTensor(f.ctx, "lct-PACK-MC-1")
+	require.Equal(t, tensor.TalentScore, unchanged.TalentScore)
+	history, err := f.keeper.GetTensorHistory(f.ctx, res.TensorId, 0, 0)
+	require.NoError(t, err)
+	require.Empty(t, history)
+
+	// The creator and the LCT's trust anchor can
+	require.NoError(t, update("cosmos1creator"))
+	require.NoError(t, update("cosmos1anchor"))
+}
@@ -4,6 +4,9 @@ import (
 	"context"
 
 	"racecar-web/x/trusttensor/types"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 var _ types.QueryServer = queryServer{}
@@ -36,7 +39,75 @@ func (q queryServer) CalculateRelationshipTrust(ctx context.Context, req *types.
 	return &types.QueryCalculateRelationshipTrustResponse{TrustScore: "", Factors: ""}, nil
 }
 
+// GetTensorHistory implements the Query/GetTensorHistory RPC method.
 func (q queryServer) GetTensorHistory(ctx context.Context, req *types.QueryGetTensorHistoryRequest) (*types.QueryGetTensorHistoryResponse, error) {
-	// TODO: Implement actual logic
-	return &types.QueryGetTensorHistoryResponse{TensorEntries: ""}, nil
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.TensorId == "" {
+		return nil, status.Error(codes.InvalidArgument, "tensor_id cannot be empty")
+	}
+	if req.From < 0 || req.To < 0 || (req.To != 0 && req.From > req.To) {
+		return nil, status.Error(codes.InvalidArgument, "from and to must be non-negative with from not after to")
+	}
+
+	_, found, err := q.Keeper.GetTensorByID(ctx, req.TensorId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if !found {
+		return nil, status.Error(codes.NotFound, types.ErrTensorNotFound.Wrap(req.TensorId).Error())
+	}
+
+	entries, err := q.Keeper.GetTensorHistory(ctx, req.TensorId, req.From, req.To)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryGetTensorHistoryResponse{Entries: entries}, nil
+}
+
+func (q queryServer) GetTrustTensor(ctx context.Context, req *types.QueryGetTrustTensorRequest) (*types.QueryGetTrustTensorResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.TensorId == "" {
+		return nil, status.Error(codes.InvalidArgument, "tensor_id cannot be empty")
+	}
+
+	tensor, found, err := q.Keeper.GetTensorByID(ctx, req.TensorId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if !found {
+		return nil, status.Error(codes.NotFound, types.ErrTensorNotFound.Wrap(req.TensorId).Error())
+	}
+
+	score, err := q.Keeper.CalculateT3CompositeScore(ctx, tensor.LctId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryGetTrustTensorResponse{
+		Tensor:         tensor,
+		CompositeScore: score.String(),
+		Dimensions:     tensor.DimensionBreakdown(),
+	}, nil
+}
+
+// ListTrustTensors implements the Query/ListTrustTensors RPC method.
+func (q queryServer) ListTrustTensors(ctx context.Context, req *types.QueryListTrustTensorsRequest) (*types.QueryListTrustTensorsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	tensors, pageRes, err := q.Keeper.ListRelationshipTensorsPaginated(ctx, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryListTrustTensorsResponse{
+		Tensors:    tensors,
+		Pagination: pageRes,
+	}, nil
 }
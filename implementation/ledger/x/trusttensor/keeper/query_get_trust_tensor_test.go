@@ -0,0 +1,49 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"racecar-web/x/trusttensor/keeper"
+	"racecar-web/x/trusttensor/types"
+)
+
+func TestGetTrustTensorQuery(t *testing.T) {
+	f := initFixture(t)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	tensor := types.RelationshipTrustTensor{
+		TensorId:         "tensor-lct-PACK-MC-1",
+		LctId:            "lct-PACK-MC-1",
+		TensorType:       "T3",
+		TalentScore:      "0.9",
+		TrainingScore:    "0.8",
+		TemperamentScore: "0.7",
+		Context:          "energy_delivery",
+		UpdatedAt:        1752484904,
+		Version:          3,
+		ContextModifier:  "1.0",
+	}
+	require.NoError(t, f.keeper.SetRelationshipTensor(f.ctx, tensor.LctId, tensor))
+
+	response, err := qs.GetTrustTensor(f.ctx, &types.QueryGetTrustTensorRequest{TensorId: tensor.TensorId})
+	require.NoError(t, err)
+	require.Equal(t, tensor, response.Tensor)
+	// 0.9*0.3 + 0.8*0.4 + 0.7*0.3
+	require.Equal(t, "0.800000000000000000", response.CompositeScore)
+	// Tensors without named dimensions report their T3 scores
+	require.Equal(t, []types.TensorDimension{
+		{Name: "talent", Score: "0.9"},
+		{Name: "temperament", Score: "0.7"},
+		{Name: "training", Score: "0.8"},
+	}, response.Dimensions)
+
+	_, err = qs.GetTrustTensor(f.ctx, &types.QueryGetTrustTensorRequest{TensorId: "tensor-missing"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = qs.GetTrustTensor(f.ctx, &types.QueryGetTrustTensorRequest{})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,123 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"racecar-web/x/trusttensor/types"
+)
+
+type historyActorKey struct{}
+
+// WithHistoryActor attributes the score updates recorded under the returned
+// context to actor, typically the signer of the message being handled.
+// Updates without an actor are attributed to the module itself.
+func WithHistoryActor(ctx context.Context, actor string) context.Context {
+	return sdk.UnwrapSDKContext(ctx).WithValue(historyActorKey{}, actor)
+}
+
+// historyActor returns the actor set by WithHistoryActor, or the module name
+func historyActor(ctx context.Context) string {
+	if actor, ok := ctx.Value(historyActorKey{}).(string); ok && actor != "" {
+		return actor
+	}
+	return types.ModuleName
+}
+
+// GetTensorHistory returns the recorded score updates of a tensor between from
+// and to, oldest first. Both bounds are inclusive unix seconds; 0 leaves that
+// side unbounded.
+func (k Keeper) GetTensorHistory(ctx context.Context, tensorID string, from, to int64) ([]types.TensorHistoryEntry, error) {
+	var entries []types.TensorHistoryEntry
+
+	rng := collections.NewPrefixedPairRange[string, uint64](tensorID)
+	err := k.TensorHistory.Walk(ctx, rng, func(_ collections.Pair[string, uint64], entry types.TensorHistoryEntry) (bool, error) {
+		if to != 0 && entry.Timestamp > to {
+			// Entries are appended in block time order
+			return true, nil
+		}
+		if entry.Timestamp >= from {
+			entries = append(entries, entry)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to walk tensor history")
+	}
+
+	return entries, nil
+}
+
+// appendHistoryEntry records a score update of a tensor with the next sequence
+// number, then evicts the oldest entries beyond the configured history length
+func (k Keeper) appendHistoryEntry(ctx context.Context, tensor types.RelationshipTrustTensor, dimension string, score math.LegacyDec, updateContext string) error {
+	params, err := k.paramsOrDefault(ctx)
+	if err != nil {
+		return err
+	}
+
+	var lastSequence uint64
+	rng := collections.NewPrefixedPairRange[string, uint64](tensor.TensorId).Descending()
+	err = k.TensorHistory.Walk(ctx, rng, func(key collections.Pair[string, uint64], _ types.TensorHistoryEntry) (bool, error) {
+		lastSequence = key.K2()
+		return true, nil
+	})
+	if err != nil {
+		return errorsmod.Wrap(err, "failed to read tensor history")
+	}
+
+	// The same composite GetTrustTensor reports, so the series can be charted against it
+	composite, err := k.CalculateT3CompositeScore(ctx, tensor.LctId)
+	if err != nil {
+		return err
+	}
+
+	sequence := lastSequence + 1
+	entry := types.TensorHistoryEntry{
+		TensorId:       tensor.TensorId,
+		Sequence:       sequence,
+		Timestamp:      sdk.UnwrapSDKContext(ctx).BlockTime().Unix(),
+		Dimension:      dimension,
+		Score:          score.String(),
+		CompositeScore: composite.String(),
+		Context:        updateContext,
+		Actor:          historyActor(ctx),
+	}
+	if err := k.TensorHistory.Set(ctx, collections.Join(tensor.TensorId, sequence), entry); err != nil {
+		return errorsmod.Wrap(err, "failed to record tensor history entry")
+	}
+
+	return k.evictHistory(ctx, tensor.TensorId, sequence, params.HistoryLength())
+}
+
+// evictHistory removes the entries of a tensor that fall outside the newest
+// limit entries. Sequences are contiguous, so every entry at or below
+// lastSequence-limit is evicted; this also trims a history whose limit was
+// lowered since it was written.
+func (k Keeper) evictHistory(ctx context.Context, tensorID string, lastSequence, limit uint64) error {
+	if lastSequence <= limit {
+		return nil
+	}
+	oldestKept := lastSequence - limit + 1
+
+	var evicted []collections.Pair[string, uint64]
+	rng := collections.NewPrefixedPairRange[string, uint64](tensorID).EndExclusive(oldestKept)
+	err := k.TensorHistory.Walk(ctx, rng, func(key collections.Pair[string, uint64], _ types.TensorHistoryEntry) (bool, error) {
+		evicted = append(evicted, key)
+		return false, nil
+	})
+	if err != nil {
+		return errorsmod.Wrap(err, "failed to read tensor history")
+	}
+
+	for _, key := range evicted {
+		if err := k.TensorHistory.Remove(ctx, key); err != nil {
+			return errorsmod.Wrap(err, "failed to evict tensor history entry")
+		}
+	}
+	return nil
+}
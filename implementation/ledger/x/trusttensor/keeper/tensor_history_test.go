@@ -0,0 +1,131 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"racecar-web/x/trusttensor/keeper"
+	"racecar-web/x/trusttensor/types"
+)
+
+func TestTensorScoreHistory(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(1752484900, 0)).WithEventManager(sdk.NewEventManager())
+
+	res, err := ms.CreateRelationshipTensor(ctx, &types.MsgCreateRelationshipTensor{
+		Creator:      "cosmos1creator",
+		LctId:        "lct-PACK-MC-1",
+		TensorType:   "T3",
+		InitialScore: "0.5",
+	})
+	require.NoError(t, err)
+
+	// A failed energy transfer drags the score down
+	ctx = ctx.WithBlockTime(time.Unix(1752484910, 0))
+	_, err = ms.UpdateTensorScore(ctx, &types.MsgUpdateTensorScore{
+		Creator:   "cosmos1creator",
+		TensorId:  res.TensorId,
+		Dimension: types.DefaultDimension,
+		Value:     "0.1",
+		Context:   "energy_transfer_failed",
+	})
+	require.NoError(t, err)
+
+	history, err := qs.GetTensorHistory(ctx, &types.QueryGetTensorHistoryRequest{TensorId: res.TensorId})
+	require.NoError(t, err)
+	require.Equal(t, []types.TensorHistoryEntry{{
+		TensorId:  res.TensorId,
+		Sequence:  1,
+		Timestamp: 1752484910,
+		Dimension: types.DefaultDimension,
+		// The learning rate of a tensor without evidence moves it halfway
+		Score:          "0.300000000000000000",
+		CompositeScore: "0.300000000000000000",
+		Context:        "energy_transfer_failed",
+		Actor:          "cosmos1creator",
+	}}, history.Entries)
+
+	for i := int64(2); i <= 4; i++ {
+		ctx = ctx.WithBlockTime(time.Unix(1752484900+10*i, 0))
+		_, err = ms.UpdateTensorScore(ctx, &types.MsgUpdateTensorScore{
+			Creator:   "cosmos1creator",
+			TensorId:  res.TensorId,
+			Dimension: types.DefaultDimension,
+			Value:     "0.9",
+			Context:   "energy_transfer_completed",
+		})
+		require.NoError(t, err)
+	}
+
+	history, err = qs.GetTensorHistory(ctx, &types.QueryGetTensorHistoryRequest{
+		TensorId: res.TensorId,
+		From:     1752484920,
+		To:       1752484930,
+	})
+	require.NoError(t, err)
+	require.Len(t, history.Entries, 2)
+	require.Equal(t, int64(1752484920), history.Entries[0].Timestamp)
+	require.Equal(t, int64(1752484930), history.Entries[1].Timestamp)
+
+	_, err = qs.GetTensorHistory(ctx, &types.QueryGetTensorHistoryRequest{TensorId: "tensor-missing"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = qs.GetTensorHistory(ctx, &types.QueryGetTensorHistoryRequest{TensorId: res.TensorId, From: 20, To: 10})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestTensorHistoryEvictsOldestEntries(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(1752484900, 0)).WithEventManager(sdk.NewEventManager())
+
+	params := types.DefaultParams()
+	params.MaxHistoryLength = 3
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+
+	res, err := ms.CreateRelationshipTensor(ctx, &types.MsgCreateRelationshipTensor{
+		Creator:    "cosmos1creator",
+		LctId:      "lct-PACK-MC-1",
+		TensorType: "T3",
+	})
+	require.NoError(t, err)
+
+	for i := int64(1); i <= 5; i++ {
+		ctx = ctx.WithBlockTime(time.Unix(1752484900+i, 0))
+		_, err = ms.UpdateTensorScore(ctx, &types.MsgUpdateTensorScore{
+			Creator:   "cosmos1creator",
+			TensorId:  res.TensorId,
+			Dimension: types.DimensionTalent,
+			Value:     "0.8",
+		})
+		require.NoError(t, err)
+	}
+
+	history, err := f.keeper.GetTensorHistory(ctx, res.TensorId, 0, 0)
+	require.NoError(t, err)
+	require.Len(t, history, 3)
+	require.Equal(t, []uint64{3, 4, 5}, []uint64{history[0].Sequence, history[1].Sequence, history[2].Sequence})
+
+	// Lowering the cap trims the history on the next update
+	params.MaxHistoryLength = 1
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+	_, err = ms.UpdateTensorScore(ctx, &types.MsgUpdateTensorScore{
+		Creator:   "cosmos1creator",
+		TensorId:  res.TensorId,
+		Dimension: types.DimensionTalent,
+		Value:     "0.8",
+	})
+	require.NoError(t, err)
+
+	history, err = f.keeper.GetTensorHistory(ctx, res.TensorId, 0, 0)
+	require.NoError(t, err)
+	require.Len(t, history, 1)
+	require.Equal(t, uint64(6), history[0].Sequence)
+}
@@ -2,45 +2,101 @@ package keeper
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
+	lctmanagertypes "racecar-web/x/lctmanager/types"
 	"racecar-web/x/trusttensor/types"
 )
 
-// CalculateT3CompositeScore calculates the composite T3 trust score
-func (k Keeper) CalculateT3CompositeScore(ctx context.Context, lctID string) (math.LegacyDec, error) {
-	tensor, found := k.GetRelationshipTensor(ctx, lctID)
+// checkTensorLct checks that lctID names a live LCT between two components,
+// which lctmanager only pairs once both are registered. Nothing is checked
+// when no lctmanager keeper is wired in.
+func (k Keeper) checkTensorLct(ctx context.Context, lctID string) error {
+	lctmanagerKeeper := k.lctmanagerKeeper()
+	if lctmanagerKeeper == nil {
+		return nil
+	}
+	lct, found := lctmanagerKeeper.GetLinkedContextToken(ctx, lctID)
 	if !found {
-		return math.LegacyZeroDec(), fmt.Errorf("tensor not found for LCT: %s", lctID)
+		return errorsmod.Wrap(types.ErrLctNotFound, lctID)
 	}
+	if lct.ComponentAId == "" || lct.ComponentBId == "" {
+		return errorsmod.Wrapf(types.ErrInvalidLct, "LCT %s does not name both of its components", lctID)
+	}
+	if lct.PairingStatus == lctmanagertypes.StatusTerminated {
+		return errorsmod.Wrapf(types.ErrInvalidLct, "LCT %s is terminated", lctID)
+	}
+	return nil
+}
 
-	// T3 Formula: (Talent × 0.3) + (Training × 0.4) + (Temperament × 0.3)
-	talent, err := math.LegacyNewDecFromStr(tensor.TalentScore)
+// CreateRelationshipTensor stores a new tensor for an LCT with the given
+// named dimensions, created by creator. An LCT holds at most one tensor.
+func (k Keeper) CreateRelationshipTensor(ctx context.Context, creator, lctID, tensorType, operationalContext string, dimensions []types.TensorDimension) (types.RelationshipTrustTensor, error) {
+	dimensions, err := types.ValidateDimensions(dimensions)
 	if err != nil {
-		return math.LegacyZeroDec(), fmt.Errorf("invalid talent score: %w", err)
+		return types.RelationshipTrustTensor{}, err
+	}
+	if len(dimensions) == 0 {
+		return types.RelationshipTrustTensor{}, errorsmod.Wrap(types.ErrInvalidDimension, "a tensor needs at least one dimension")
+	}
+	if err := k.checkTensorLct(ctx, lctID); err != nil {
+		return types.RelationshipTrustTensor{}, err
 	}
 
-	training, err := math.LegacyNewDecFromStr(tensor.TrainingScore)
+	has, err := k.RelationshipTensors.Has(ctx, lctID)
 	if err != nil {
-		return math.LegacyZeroDec(), fmt.Errorf("invalid training score: %w", err)
+		return types.RelationshipTrustTensor{}, err
+	}
+	if has {
+		return types.RelationshipTrustTensor{}, errorsmod.Wrapf(types.ErrTensorExists, "LCT %s", lctID)
 	}
 
-	temperament, err := math.LegacyNewDecFromStr(tensor.TemperamentScore)
-	if err != nil {
-		return math.LegacyZeroDec(), fmt.Errorf("invalid temperament score: %w", err)
+	now := sdk.UnwrapSDKContext(ctx).BlockTime().Unix()
+	tensor := types.RelationshipTrustTensor{
+		TensorId:        fmt.Sprintf("tensor-%s", lctID),
+		LctId:           lctID,
+		TensorType:      tensorType,
+		Context:         operationalContext,
+		CreatedAt:       now,
+		UpdatedAt:       now,
+		Version:         1,
+		ContextModifier: "1.0",
+		Creator:         creator,
+	}
+	for _, d := range dimensions {
+		tensor.SetDimension(d.Name, d.Score)
 	}
 
-	// Weighted calculation
-	talentWeight := math.LegacyNewDecWithPrec(30, 2)      // 0.30
-	trainingWeight := math.LegacyNewDecWithPrec(40, 2)    // 0.40
-	temperamentWeight := math.LegacyNewDecWithPrec(30, 2) // 0.30
+	if err := k.SetRelationshipTensor(ctx, lctID, tensor); err != nil {
+		return types.RelationshipTrustTensor{}, fmt.Errorf("failed to store tensor: %w", err)
+	}
+	return tensor, nil
+}
+
+// CalculateT3CompositeScore calculates the composite trust score of the
+// tensor recorded for an LCT as the weighted aggregate of its dimensions
+func (k Keeper) CalculateT3CompositeScore(ctx context.Context, lctID string) (math.LegacyDec, error) {
+	tensor, found := k.GetRelationshipTensor(ctx, lctID)
+	if !found {
+		return math.LegacyZeroDec(), fmt.Errorf("tensor not found for LCT: %s", lctID)
+	}
 
-	composite := talent.Mul(talentWeight).
-		Add(training.Mul(trainingWeight)).
-		Add(temperament.Mul(temperamentWeight))
+	params, err := k.paramsOrDefault(ctx)
+	if err != nil {
+		return math.LegacyZeroDec(), err
+	}
+	composite, err := types.WeightedScore(tensor.DimensionBreakdown(), params)
+	if err != nil {
+		return math.LegacyZeroDec(), err
+	}
 
 	// Apply context modifier if exists
 	if tensor.ContextModifier != "" {
@@ -105,7 +161,8 @@ func (k Keeper) CalculateV3CompositeScore(ctx context.Context, operationID strin
 	return composite, nil
 }
 
-// UpdateTensorScore updates a tensor dimension with evidence
+// UpdateTensorScore updates a tensor dimension with evidence and records the
+// update in the tensor's score history, with evidence as its context
 func (k Keeper) UpdateTensorScore(ctx context.Context, tensorID, dimension string, newScore math.LegacyDec, evidence string) error {
 	tensor, found := k.GetRelationshipTensor(ctx, tensorID)
 	if !found {
@@ -132,9 +189,12 @@ func (k Keeper) UpdateTensorScore(ctx context.Context, tensorID, dimension strin
 	}
 
 	// Update the tensor
-	k.setDimensionScore(ctx, tensorID, dimension, finalScore, evidence)
+	tensor, err := k.setDimensionScore(ctx, tensorID, tensor, dimension, finalScore)
+	if err != nil {
+		return err
+	}
 
-	return nil
+	return k.appendHistoryEntry(ctx, tensor, dimension, finalScore, evidence)
 }
 
 // calculateLearningRate decreases as evidence count increases
@@ -157,47 +217,30 @@ func (k Keeper) calculateLearningRate(evidenceCount int64) math.LegacyDec {
 
 // getDimensionScore extracts the score for a specific dimension
 func (k Keeper) getDimensionScore(tensor types.RelationshipTrustTensor, dimension string) math.LegacyDec {
-	switch dimension {
-	case "talent":
-		score, _ := math.LegacyNewDecFromStr(tensor.TalentScore)
-		return score
-	case "training":
-		score, _ := math.LegacyNewDecFromStr(tensor.TrainingScore)
-		return score
-	case "temperament":
-		score, _ := math.LegacyNewDecFromStr(tensor.TemperamentScore)
-		return score
-	default:
+	value, found := tensor.DimensionScore(dimension)
+	if !found {
 		return math.LegacyZeroDec()
 	}
+	score, _ := math.LegacyNewDecFromStr(value)
+	return score
 }
 
-// setDimensionScore updates the score for a specific dimension
-func (k Keeper) setDimensionScore(ctx context.Context, tensorID, dimension string, score math.LegacyDec, evidence string) {
-	tensor, found := k.GetRelationshipTensor(ctx, tensorID)
-	if !found {
-		return
-	}
-
+// setDimensionScore updates the score for a specific dimension and stores the
+// updated tensor
+func (k Keeper) setDimensionScore(ctx context.Context, tensorID string, tensor types.RelationshipTrustTensor, dimension string, score math.LegacyDec) (types.RelationshipTrustTensor, error) {
 	// Update the appropriate dimension
-	switch dimension {
-	case "talent":
-		tensor.TalentScore = score.String()
-	case "training":
-		tensor.TrainingScore = score.String()
-	case "temperament":
-		tensor.TemperamentScore = score.String()
-	default:
-		return
-	}
+	tensor.SetDimension(dimension, score.String())
 
 	// Update metadata
 	tensor.EvidenceCount++
-	tensor.UpdatedAt = time.Now().Unix()
+	tensor.UpdatedAt = sdk.UnwrapSDKContext(ctx).BlockTime().Unix()
 	tensor.Version++
 
 	// Store the updated tensor
-	k.SetRelationshipTensor(ctx, tensorID, tensor)
+	if err := k.SetRelationshipTensor(ctx, tensorID, tensor); err != nil {
+		return types.RelationshipTrustTensor{}, fmt.Errorf("failed to store tensor: %w", err)
+	}
+	return tensor, nil
 }
 
 // GetRelationshipTensor retrieves a relationship tensor by LCT ID
@@ -223,9 +266,57 @@ func (k Keeper) GetRelationshipTensor(ctx context.Context, lctID string) (types.
 	return tensor, true
 }
 
-// SetRelationshipTensor stores a relationship tensor
+// SetRelationshipTensor stores a relationship tensor and indexes it by tensor ID
 func (k Keeper) SetRelationshipTensor(ctx context.Context, lctID string, tensor types.RelationshipTrustTensor) error {
-	return k.RelationshipTensors.Set(ctx, lctID, tensor)
+	previous, err := k.RelationshipTensors.Get(ctx, lctID)
+	switch {
+	case err == nil && previous.TensorId != tensor.TensorId:
+		if err := k.TensorIDIndex.Remove(ctx, previous.TensorId); err != nil {
+			return fmt.Errorf("failed to unindex tensor %s: %w", previous.TensorId, err)
+		}
+	case err != nil && !errors.Is(err, collections.ErrNotFound):
+		return err
+	}
+
+	if err := k.RelationshipTensors.Set(ctx, lctID, tensor); err != nil {
+		return err
+	}
+	if tensor.TensorId == "" {
+		return nil
+	}
+	return k.TensorIDIndex.Set(ctx, tensor.TensorId, lctID)
+}
+
+// GetTensorByID retrieves a relationship tensor by its tensor ID
+func (k Keeper) GetTensorByID(ctx context.Context, tensorID string) (types.RelationshipTrustTensor, bool, error) {
+	lctID, err := k.TensorIDIndex.Get(ctx, tensorID)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.RelationshipTrustTensor{}, false, nil
+	}
+	if err != nil {
+		return types.RelationshipTrustTensor{}, false, err
+	}
+
+	tensor, err := k.RelationshipTensors.Get(ctx, lctID)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.RelationshipTrustTensor{}, false, nil
+	}
+	if err != nil {
+		return types.RelationshipTrustTensor{}, false, err
+	}
+	return tensor, true, nil
+}
+
+// ListRelationshipTensorsPaginated retrieves one page of relationship tensors, ordered by LCT ID
+func (k Keeper) ListRelationshipTensorsPaginated(ctx context.Context, pageReq *query.PageRequest) ([]types.RelationshipTrustTensor, *query.PageResponse, error) {
+	tensors, pageRes, err := query.CollectionPaginate(ctx, k.RelationshipTensors, pageReq,
+		func(_ string, tensor types.RelationshipTrustTensor) (types.RelationshipTrustTensor, error) {
+			return tensor, nil
+		})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to paginate relationship tensors: %w", err)
+	}
+	return tensors, pageRes, nil
 }
 
 // GetOperationV3Tensor retrieves a V3 tensor by operation ID
@@ -34,10 +34,22 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 				{
 					RpcMethod:      "GetTensorHistory",
 					Use:            "get-tensor-history [tensor-id]",
-					Short:          "Query get-tensor-history",
+					Short:          "Query the score history of a trust tensor, optionally between --from and --to",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "tensor_id"}},
 				},
 
+				{
+					RpcMethod:      "GetTrustTensor",
+					Use:            "get-trust-tensor [tensor-id]",
+					Short:          "Query a relationship trust tensor by ID",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "tensor_id"}},
+				},
+				{
+					RpcMethod: "ListTrustTensors",
+					Use:       "list-trust-tensors",
+					Short:     "Query a page of relationship trust tensors",
+				},
+
 				// this line is used by ignite scaffolding # autocli/query
 			},
 		},
@@ -9,6 +9,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
+	lctmanagertypes "racecar-web/x/lctmanager/types"
 	"racecar-web/x/trusttensor/keeper"
 	"racecar-web/x/trusttensor/types"
 )
@@ -22,6 +23,7 @@ func init() {
 	appconfig.Register(
 		&types.Module{},
 		appconfig.Provide(ProvideModule),
+		appconfig.Invoke(InvokeSetLctmanagerKeeper),
 	)
 }
 
@@ -56,9 +58,16 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 		in.AddressCodec,
 		authority,
 		in.BankKeeper,
-		nil, // LctmanagerKeeper - removed to break circular dependency
+		nil, // LctmanagerKeeper - set by InvokeSetLctmanagerKeeper to break the circular dependency
 	)
 	m := NewAppModule(in.Cdc, k, in.AuthKeeper, in.BankKeeper)
 
 	return ModuleOutputs{TrusttensorKeeper: k, Module: m}
 }
+
+// InvokeSetLctmanagerKeeper wires the lctmanager keeper into the trust tensor
+// keeper once both are built. The lctmanager keeper depends on this module
+// through componentregistry, so it cannot be a ModuleInputs field.
+func InvokeSetLctmanagerKeeper(k keeper.Keeper, lctmanagerKeeper lctmanagertypes.LctmanagerKeeper) {
+	k.SetLctmanagerKeeper(lctmanagerKeeper)
+}
@@ -8,5 +8,11 @@ import (
 
 // x/trusttensor module sentinel errors
 var (
-	ErrInvalidSigner = errors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
+	ErrInvalidSigner    = errors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
+	ErrTensorNotFound   = errors.Register(ModuleName, 1101, "trust tensor not found")
+	ErrTensorExists     = errors.Register(ModuleName, 1102, "trust tensor already exists")
+	ErrInvalidDimension = errors.Register(ModuleName, 1103, "invalid tensor dimension")
+	ErrUnauthorized     = errors.Register(ModuleName, 1104, "not authorized to update the trust tensor")
+	ErrLctNotFound      = errors.Register(ModuleName, 1105, "LCT relationship not found")
+	ErrInvalidLct       = errors.Register(ModuleName, 1106, "LCT relationship cannot hold a trust tensor")
 )
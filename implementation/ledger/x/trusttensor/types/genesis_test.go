@@ -24,6 +24,18 @@ func TestGenesisState_Validate(t *testing.T) {
 			genState: &types.GenesisState{},
 			valid:    true,
 		},
+		{
+			desc: "negative dimension weight",
+			genState: &types.GenesisState{Params: types.NewParams(
+				[]types.DimensionWeight{{Dimension: "reliability", Weight: "-0.5"}}, types.DefaultDimension, types.DefaultMaxHistoryLength)},
+			valid: false,
+		},
+		{
+			desc: "duplicate dimension weight",
+			genState: &types.GenesisState{Params: types.NewParams(
+				[]types.DimensionWeight{{Dimension: "safety", Weight: "0.5"}, {Dimension: "safety", Weight: "0.2"}}, types.DefaultDimension, types.DefaultMaxHistoryLength)},
+			valid: false,
+		},
 	}
 	for _, tc := range tests {
 		t.Run(tc.desc, func(t *testing.T) {
@@ -22,4 +22,6 @@ var (
 	RelationshipTrustTensorKey = collections.NewPrefix(1)
 	ValueTensorKey             = collections.NewPrefix(2)
 	TensorEntryKey             = collections.NewPrefix(3)
+	TensorHistoryKey           = collections.NewPrefix(4)
+	TensorIDIndexKey           = collections.NewPrefix(5)
 )
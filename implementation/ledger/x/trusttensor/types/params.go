@@ -1,17 +1,92 @@
 package types
 
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+)
+
+// DefaultDimension receives the score of a tensor created from a single
+// initial score
+const DefaultDimension = "trust"
+
+// DefaultMaxHistoryLength is the number of score updates kept per tensor
+// when max_history_length is not set
+const DefaultMaxHistoryLength uint64 = 100
+
+// Dimension names of the T3 tensor
+const (
+	DimensionTalent      = "talent"
+	DimensionTraining    = "training"
+	DimensionTemperament = "temperament"
+)
+
+// DefaultDimensionWeights are the T3 weights: (Talent × 0.3) + (Training × 0.4) + (Temperament × 0.3)
+func DefaultDimensionWeights() []DimensionWeight {
+	return []DimensionWeight{
+		{Dimension: DimensionTalent, Weight: "0.30"},
+		{Dimension: DimensionTraining, Weight: "0.40"},
+		{Dimension: DimensionTemperament, Weight: "0.30"},
+	}
+}
+
 // NewParams creates a new Params instance.
-func NewParams() Params {
-	return Params{}
+func NewParams(dimensionWeights []DimensionWeight, defaultDimension string, maxHistoryLength uint64) Params {
+	return Params{
+		DimensionWeights: dimensionWeights,
+		DefaultDimension: defaultDimension,
+		MaxHistoryLength: maxHistoryLength,
+	}
 }
 
 // DefaultParams returns a default set of parameters.
 func DefaultParams() Params {
-	return NewParams()
+	return NewParams(DefaultDimensionWeights(), DefaultDimension, DefaultMaxHistoryLength)
 }
 
 // Validate validates the set of params.
 func (p Params) Validate() error {
+	seen := make(map[string]bool, len(p.DimensionWeights))
+	for _, w := range p.DimensionWeights {
+		if w.Dimension == "" {
+			return fmt.Errorf("dimension weight has no dimension name")
+		}
+		if seen[w.Dimension] {
+			return fmt.Errorf("duplicate weight for dimension %s", w.Dimension)
+		}
+		seen[w.Dimension] = true
+
+		weight, err := math.LegacyNewDecFromStr(w.Weight)
+		if err != nil {
+			return fmt.Errorf("invalid weight %q for dimension %s: %w", w.Weight, w.Dimension, err)
+		}
+		if weight.IsNegative() {
+			return fmt.Errorf("weight for dimension %s cannot be negative: %s", w.Dimension, w.Weight)
+		}
+	}
 
 	return nil
 }
+
+// Weight returns the weight of a dimension in the composite score, 1 for a
+// dimension without a configured weight
+func (p Params) Weight(dimension string) math.LegacyDec {
+	for _, w := range p.DimensionWeights {
+		if w.Dimension == dimension {
+			weight, err := math.LegacyNewDecFromStr(w.Weight)
+			if err != nil {
+				return math.LegacyZeroDec()
+			}
+			return weight
+		}
+	}
+	return math.LegacyOneDec()
+}
+
+// HistoryLength returns the number of score updates kept per tensor
+func (p Params) HistoryLength() uint64 {
+	if p.MaxHistoryLength == 0 {
+		return DefaultMaxHistoryLength
+	}
+	return p.MaxHistoryLength
+}
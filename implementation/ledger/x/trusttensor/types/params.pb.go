@@ -26,6 +26,15 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Params defines the parameters for the module.
 type Params struct {
+	// dimension_weights weigh each named dimension in the composite score. The
+	// weights are relative; dimensions without a weight count with weight 1.
+	DimensionWeights []DimensionWeight `protobuf:"bytes,1,rep,name=dimension_weights,json=dimensionWeights,proto3" json:"dimension_weights"`
+	// default_dimension receives the score of a tensor created with a single
+	// initial score instead of named dimensions
+	DefaultDimension string `protobuf:"bytes,2,opt,name=default_dimension,json=defaultDimension,proto3" json:"default_dimension,omitempty"`
+	// max_history_length caps the score history kept per tensor; the oldest
+	// entries are evicted first. 0 keeps the default length.
+	MaxHistoryLength uint64 `protobuf:"varint,3,opt,name=max_history_length,json=maxHistoryLength,proto3" json:"max_history_length,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -61,8 +70,83 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+func (m *Params) GetDimensionWeights() []DimensionWeight {
+	if m != nil {
+		return m.DimensionWeights
+	}
+	return nil
+}
+
+func (m *Params) GetDefaultDimension() string {
+	if m != nil {
+		return m.DefaultDimension
+	}
+	return ""
+}
+
+func (m *Params) GetMaxHistoryLength() uint64 {
+	if m != nil {
+		return m.MaxHistoryLength
+	}
+	return 0
+}
+
+// DimensionWeight is the weight of one tensor dimension in the composite score
+type DimensionWeight struct {
+	Dimension string `protobuf:"bytes,1,opt,name=dimension,proto3" json:"dimension,omitempty"`
+	Weight    string `protobuf:"bytes,2,opt,name=weight,proto3" json:"weight,omitempty"`
+}
+
+func (m *DimensionWeight) Reset()         { *m = DimensionWeight{} }
+func (m *DimensionWeight) String() string { return proto.CompactTextString(m) }
+func (*DimensionWeight) ProtoMessage()    {}
+func (*DimensionWeight) Descriptor() ([]byte, []int) {
+	return fileDescriptor_30c11feea12376e6, []int{1}
+}
+func (m *DimensionWeight) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DimensionWeight) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DimensionWeight.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DimensionWeight) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DimensionWeight.Merge(m, src)
+}
+func (m *DimensionWeight) XXX_Size() int {
+	return m.Size()
+}
+func (m *DimensionWeight) XXX_DiscardUnknown() {
+	xxx_messageInfo_DimensionWeight.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DimensionWeight proto.InternalMessageInfo
+
+func (m *DimensionWeight) GetDimension() string {
+	if m != nil {
+		return m.Dimension
+	}
+	return ""
+}
+
+func (m *DimensionWeight) GetWeight() string {
+	if m != nil {
+		return m.Weight
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "racecarweb.trusttensor.v1.Params")
+	proto.RegisterType((*DimensionWeight)(nil), "racecarweb.trusttensor.v1.DimensionWeight")
 }
 
 func init() {
@@ -70,18 +154,27 @@ func init() {
 }
 
 var fileDescriptor_30c11feea12376e6 = []byte{
-	// 168 bytes of a gzipped FileDescriptorProto
+	// 319 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x2b, 0x4a, 0x4c, 0x4e,
 	0x4d, 0x4e, 0x2c, 0x2a, 0x4f, 0x4d, 0xd2, 0x2f, 0x29, 0x2a, 0x2d, 0x2e, 0x29, 0x49, 0xcd, 0x2b,
 	0xce, 0x2f, 0xd2, 0x2f, 0x33, 0xd4, 0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca,
 	0x2f, 0xc9, 0x17, 0x92, 0x44, 0xa8, 0xd3, 0x43, 0x52, 0xa7, 0x57, 0x66, 0x28, 0x25, 0x98, 0x98,
 	0x9b, 0x99, 0x97, 0xaf, 0x0f, 0x26, 0x21, 0xaa, 0xa5, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x4c,
-	0x7d, 0x10, 0x0b, 0x22, 0xaa, 0x64, 0xc4, 0xc5, 0x16, 0x00, 0x36, 0xd3, 0x4a, 0xe3, 0xc5, 0x02,
-	0x79, 0xc6, 0xae, 0xe7, 0x1b, 0xb4, 0xe4, 0x91, 0xac, 0xaf, 0x40, 0x71, 0x00, 0x44, 0xa5, 0x93,
-	0xe5, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1,
-	0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0xc1, 0xb4, 0xea, 0x62, 0xea,
-	0x2d, 0xa9, 0x2c, 0x48, 0x2d, 0x4e, 0x62, 0x03, 0xdb, 0x6a, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff,
-	0x18, 0xdc, 0x4b, 0xbd, 0xe3, 0x00, 0x00, 0x00,
+	0x7d, 0x10, 0x0b, 0x22, 0xaa, 0xf4, 0x86, 0x91, 0x8b, 0x2d, 0x00, 0x6c, 0xa8, 0x50, 0x2c, 0x97,
+	0x60, 0x4a, 0x66, 0x6e, 0x6a, 0x5e, 0x71, 0x66, 0x7e, 0x5e, 0x7c, 0x79, 0x6a, 0x66, 0x7a, 0x46,
+	0x49, 0xb1, 0x04, 0xa3, 0x02, 0xb3, 0x06, 0xb7, 0x91, 0x96, 0x1e, 0x4e, 0xab, 0xf4, 0x5c, 0x60,
+	0x7a, 0xc2, 0xc1, 0x5a, 0x9c, 0x58, 0x4e, 0xdc, 0x93, 0x67, 0x08, 0x12, 0x48, 0x41, 0x15, 0x2e,
+	0x16, 0xd2, 0xe6, 0x12, 0x4c, 0x49, 0x4d, 0x4b, 0x2c, 0xcd, 0x29, 0x89, 0x87, 0xcb, 0x49, 0x30,
+	0x29, 0x30, 0x6a, 0x70, 0x06, 0x09, 0x40, 0x25, 0xe0, 0x46, 0x09, 0xe9, 0x70, 0x09, 0xe5, 0x26,
+	0x56, 0xc4, 0x67, 0x64, 0x16, 0x97, 0xe4, 0x17, 0x55, 0xc6, 0xe7, 0xa4, 0xe6, 0xa5, 0x97, 0x64,
+	0x48, 0x30, 0x2b, 0x30, 0x6a, 0xb0, 0x04, 0x09, 0xe4, 0x26, 0x56, 0x78, 0x40, 0x24, 0x7c, 0xc0,
+	0xe2, 0x56, 0x1a, 0x2f, 0x16, 0xc8, 0x33, 0x76, 0x3d, 0xdf, 0xa0, 0x25, 0x8f, 0x14, 0x72, 0x15,
+	0x28, 0x61, 0x07, 0xf1, 0xa3, 0x92, 0x2f, 0x17, 0x3f, 0x9a, 0x7b, 0x85, 0x64, 0xb8, 0x38, 0x11,
+	0xee, 0x61, 0x04, 0xbb, 0x07, 0x21, 0x20, 0x24, 0xc6, 0xc5, 0x06, 0x09, 0x0a, 0xa8, 0x53, 0xa1,
+	0x3c, 0x2b, 0x16, 0x90, 0x95, 0x4e, 0x96, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8,
+	0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7,
+	0x10, 0x05, 0x73, 0x89, 0x2e, 0xa6, 0x53, 0x4a, 0x2a, 0x0b, 0x52, 0x8b, 0x93, 0xd8, 0xc0, 0xe1,
+	0x6f, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0xff, 0x2f, 0x39, 0x75, 0xed, 0x01, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -103,6 +196,47 @@ func (this *Params) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
+	if len(this.DimensionWeights) != len(that1.DimensionWeights) {
+		return false
+	}
+	for i := range this.DimensionWeights {
+		if !this.DimensionWeights[i].Equal(&that1.DimensionWeights[i]) {
+			return false
+		}
+	}
+	if this.DefaultDimension != that1.DefaultDimension {
+		return false
+	}
+	if this.MaxHistoryLength != that1.MaxHistoryLength {
+		return false
+	}
+	return true
+}
+func (this *DimensionWeight) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DimensionWeight)
+	if !ok {
+		that2, ok := that.(DimensionWeight)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Dimension != that1.Dimension {
+		return false
+	}
+	if this.Weight != that1.Weight {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -125,6 +259,69 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxHistoryLength != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxHistoryLength))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.DefaultDimension) > 0 {
+		i -= len(m.DefaultDimension)
+		copy(dAtA[i:], m.DefaultDimension)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.DefaultDimension)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DimensionWeights) > 0 {
+		for iNdEx := len(m.DimensionWeights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DimensionWeights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DimensionWeight) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DimensionWeight) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DimensionWeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Weight) > 0 {
+		i -= len(m.Weight)
+		copy(dAtA[i:], m.Weight)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Weight)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Dimension) > 0 {
+		i -= len(m.Dimension)
+		copy(dAtA[i:], m.Dimension)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Dimension)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -145,6 +342,36 @@ func (m *Params) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if len(m.DimensionWeights) > 0 {
+		for _, e := range m.DimensionWeights {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	l = len(m.DefaultDimension)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.MaxHistoryLength != 0 {
+		n += 1 + sovParams(uint64(m.MaxHistoryLength))
+	}
+	return n
+}
+
+func (m *DimensionWeight) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Dimension)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = len(m.Weight)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
 	return n
 }
 
@@ -183,6 +410,205 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DimensionWeights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DimensionWeights = append(m.DimensionWeights, DimensionWeight{})
+			if err := m.DimensionWeights[len(m.DimensionWeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultDimension", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DefaultDimension = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxHistoryLength", wireType)
+			}
+			m.MaxHistoryLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxHistoryLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DimensionWeight) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DimensionWeight: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DimensionWeight: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dimension", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Dimension = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Weight = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -6,7 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
-	_ "github.com/cosmos/cosmos-sdk/types/query"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
@@ -325,6 +325,8 @@ func (m *QueryCalculateRelationshipTrustResponse) GetFactors() string {
 // QueryGetTensorHistoryRequest defines the QueryGetTensorHistoryRequest message.
 type QueryGetTensorHistoryRequest struct {
 	TensorId string `protobuf:"bytes,1,opt,name=tensor_id,json=tensorId,proto3" json:"tensor_id,omitempty"`
+	From     int64  `protobuf:"varint,2,opt,name=from,proto3" json:"from,omitempty"`
+	To       int64  `protobuf:"varint,3,opt,name=to,proto3" json:"to,omitempty"`
 }
 
 func (m *QueryGetTensorHistoryRequest) Reset()         { *m = QueryGetTensorHistoryRequest{} }
@@ -367,9 +369,23 @@ func (m *QueryGetTensorHistoryRequest) GetTensorId() string {
 	return ""
 }
 
+func (m *QueryGetTensorHistoryRequest) GetFrom() int64 {
+	if m != nil {
+		return m.From
+	}
+	return 0
+}
+
+func (m *QueryGetTensorHistoryRequest) GetTo() int64 {
+	if m != nil {
+		return m.To
+	}
+	return 0
+}
+
 // QueryGetTensorHistoryResponse defines the QueryGetTensorHistoryResponse message.
 type QueryGetTensorHistoryResponse struct {
-	TensorEntries string `protobuf:"bytes,1,opt,name=tensor_entries,json=tensorEntries,proto3" json:"tensor_entries,omitempty"`
+	Entries []TensorHistoryEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries"`
 }
 
 func (m *QueryGetTensorHistoryResponse) Reset()         { *m = QueryGetTensorHistoryResponse{} }
@@ -405,13 +421,219 @@ func (m *QueryGetTensorHistoryResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryGetTensorHistoryResponse proto.InternalMessageInfo
 
-func (m *QueryGetTensorHistoryResponse) GetTensorEntries() string {
+func (m *QueryGetTensorHistoryResponse) GetEntries() []TensorHistoryEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+// QueryGetTrustTensorRequest defines the QueryGetTrustTensorRequest message.
+type QueryGetTrustTensorRequest struct {
+	TensorId string `protobuf:"bytes,1,opt,name=tensor_id,json=tensorId,proto3" json:"tensor_id,omitempty"`
+}
+
+func (m *QueryGetTrustTensorRequest) Reset()         { *m = QueryGetTrustTensorRequest{} }
+func (m *QueryGetTrustTensorRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetTrustTensorRequest) ProtoMessage()    {}
+func (*QueryGetTrustTensorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c82e7cd245405b3, []int{8}
+}
+func (m *QueryGetTrustTensorRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetTrustTensorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetTrustTensorRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetTrustTensorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetTrustTensorRequest.Merge(m, src)
+}
+func (m *QueryGetTrustTensorRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetTrustTensorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetTrustTensorRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetTrustTensorRequest proto.InternalMessageInfo
+
+func (m *QueryGetTrustTensorRequest) GetTensorId() string {
+	if m != nil {
+		return m.TensorId
+	}
+	return ""
+}
+
+// QueryGetTrustTensorResponse defines the QueryGetTrustTensorResponse message.
+type QueryGetTrustTensorResponse struct {
+	Tensor RelationshipTrustTensor `protobuf:"bytes,1,opt,name=tensor,proto3" json:"tensor"`
+	// composite_score is the weighted aggregate of the tensor dimensions
+	CompositeScore string `protobuf:"bytes,2,opt,name=composite_score,json=compositeScore,proto3" json:"composite_score,omitempty"`
+	// dimensions is the full breakdown the composite score was computed from
+	Dimensions []TensorDimension `protobuf:"bytes,3,rep,name=dimensions,proto3" json:"dimensions"`
+}
+
+func (m *QueryGetTrustTensorResponse) Reset()         { *m = QueryGetTrustTensorResponse{} }
+func (m *QueryGetTrustTensorResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetTrustTensorResponse) ProtoMessage()    {}
+func (*QueryGetTrustTensorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c82e7cd245405b3, []int{9}
+}
+func (m *QueryGetTrustTensorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetTrustTensorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetTrustTensorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetTrustTensorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetTrustTensorResponse.Merge(m, src)
+}
+func (m *QueryGetTrustTensorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetTrustTensorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetTrustTensorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetTrustTensorResponse proto.InternalMessageInfo
+
+func (m *QueryGetTrustTensorResponse) GetTensor() RelationshipTrustTensor {
+	if m != nil {
+		return m.Tensor
+	}
+	return RelationshipTrustTensor{}
+}
+
+func (m *QueryGetTrustTensorResponse) GetCompositeScore() string {
 	if m != nil {
-		return m.TensorEntries
+		return m.CompositeScore
 	}
 	return ""
 }
 
+func (m *QueryGetTrustTensorResponse) GetDimensions() []TensorDimension {
+	if m != nil {
+		return m.Dimensions
+	}
+	return nil
+}
+
+// QueryListTrustTensorsRequest defines the QueryListTrustTensorsRequest message.
+type QueryListTrustTensorsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryListTrustTensorsRequest) Reset()         { *m = QueryListTrustTensorsRequest{} }
+func (m *QueryListTrustTensorsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryListTrustTensorsRequest) ProtoMessage()    {}
+func (*QueryListTrustTensorsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c82e7cd245405b3, []int{10}
+}
+func (m *QueryListTrustTensorsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryListTrustTensorsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryListTrustTensorsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryListTrustTensorsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListTrustTensorsRequest.Merge(m, src)
+}
+func (m *QueryListTrustTensorsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryListTrustTensorsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListTrustTensorsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryListTrustTensorsRequest proto.InternalMessageInfo
+
+func (m *QueryListTrustTensorsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryListTrustTensorsResponse defines the QueryListTrustTensorsResponse message.
+type QueryListTrustTensorsResponse struct {
+	Tensors    []RelationshipTrustTensor `protobuf:"bytes,1,rep,name=tensors,proto3" json:"tensors"`
+	Pagination *query.PageResponse       `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryListTrustTensorsResponse) Reset()         { *m = QueryListTrustTensorsResponse{} }
+func (m *QueryListTrustTensorsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryListTrustTensorsResponse) ProtoMessage()    {}
+func (*QueryListTrustTensorsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c82e7cd245405b3, []int{11}
+}
+func (m *QueryListTrustTensorsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryListTrustTensorsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryListTrustTensorsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryListTrustTensorsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListTrustTensorsResponse.Merge(m, src)
+}
+func (m *QueryListTrustTensorsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryListTrustTensorsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListTrustTensorsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryListTrustTensorsResponse proto.InternalMessageInfo
+
+func (m *QueryListTrustTensorsResponse) GetTensors() []RelationshipTrustTensor {
+	if m != nil {
+		return m.Tensors
+	}
+	return nil
+}
+
+func (m *QueryListTrustTensorsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "racecarweb.trusttensor.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "racecarweb.trusttensor.v1.QueryParamsResponse")
@@ -421,6 +643,10 @@ func init() {
 	proto.RegisterType((*QueryCalculateRelationshipTrustResponse)(nil), "racecarweb.trusttensor.v1.QueryCalculateRelationshipTrustResponse")
 	proto.RegisterType((*QueryGetTensorHistoryRequest)(nil), "racecarweb.trusttensor.v1.QueryGetTensorHistoryRequest")
 	proto.RegisterType((*QueryGetTensorHistoryResponse)(nil), "racecarweb.trusttensor.v1.QueryGetTensorHistoryResponse")
+	proto.RegisterType((*QueryGetTrustTensorRequest)(nil), "racecarweb.trusttensor.v1.QueryGetTrustTensorRequest")
+	proto.RegisterType((*QueryGetTrustTensorResponse)(nil), "racecarweb.trusttensor.v1.QueryGetTrustTensorResponse")
+	proto.RegisterType((*QueryListTrustTensorsRequest)(nil), "racecarweb.trusttensor.v1.QueryListTrustTensorsRequest")
+	proto.RegisterType((*QueryListTrustTensorsResponse)(nil), "racecarweb.trusttensor.v1.QueryListTrustTensorsResponse")
 }
 
 func init() {
@@ -428,48 +654,65 @@ func init() {
 }
 
 var fileDescriptor_4c82e7cd245405b3 = []byte{
-	// 654 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0xcd, 0x6e, 0xd3, 0x4c,
-	0x14, 0x8d, 0x2b, 0x35, 0xdf, 0xd7, 0xa9, 0x40, 0x30, 0xb4, 0xa2, 0x35, 0xc5, 0xa5, 0x16, 0x2d,
-	0xa8, 0x52, 0x3d, 0x4a, 0x59, 0x00, 0xa5, 0x2c, 0x08, 0xbf, 0xe5, 0x47, 0x02, 0xb7, 0x1b, 0xe8,
-	0xc2, 0x4c, 0x9c, 0xc1, 0xb5, 0xe4, 0x78, 0xdc, 0x99, 0x49, 0x68, 0x54, 0x65, 0xc3, 0x13, 0x20,
-	0xf5, 0x25, 0x58, 0xf2, 0x18, 0x65, 0x45, 0x25, 0x36, 0xac, 0x10, 0x4a, 0x90, 0xe0, 0x11, 0x58,
-	0x22, 0xcf, 0x4c, 0x8a, 0xd3, 0x36, 0x71, 0x81, 0x4d, 0x64, 0xdf, 0xb9, 0xe7, 0xdc, 0x73, 0x3c,
-	0xe7, 0x2a, 0x60, 0x96, 0x61, 0x9f, 0xf8, 0x98, 0xbd, 0x26, 0x15, 0x24, 0x58, 0x9d, 0x0b, 0x41,
-	0x62, 0x4e, 0x19, 0x6a, 0x94, 0xd0, 0x66, 0x9d, 0xb0, 0xa6, 0x93, 0x30, 0x2a, 0x28, 0x9c, 0xfc,
-	0xdd, 0xe6, 0x64, 0xda, 0x9c, 0x46, 0xc9, 0x3c, 0x8d, 0x6b, 0x61, 0x4c, 0x91, 0xfc, 0x55, 0xdd,
-	0xe6, 0xbc, 0x4f, 0x79, 0x8d, 0x72, 0x54, 0xc1, 0x9c, 0x28, 0x1a, 0xd4, 0x28, 0x55, 0x88, 0xc0,
-	0x25, 0x94, 0xe0, 0x20, 0x8c, 0xb1, 0x08, 0x69, 0xac, 0x7b, 0xc7, 0x02, 0x1a, 0x50, 0xf9, 0x88,
-	0xd2, 0x27, 0x5d, 0x9d, 0x0a, 0x28, 0x0d, 0x22, 0x82, 0x70, 0x12, 0x22, 0x1c, 0xc7, 0x54, 0x48,
-	0x08, 0xd7, 0xa7, 0x73, 0xfd, 0x45, 0x27, 0x98, 0xe1, 0x9a, 0xee, 0xb3, 0xc7, 0x00, 0x7c, 0x96,
-	0x4e, 0x7f, 0x2a, 0x8b, 0x2e, 0xd9, 0xac, 0x13, 0x2e, 0xec, 0x75, 0x70, 0xa6, 0xa7, 0xca, 0x13,
-	0x1a, 0x73, 0x02, 0xef, 0x80, 0xa2, 0x02, 0x4f, 0x18, 0x17, 0x8c, 0xcb, 0xa3, 0x8b, 0x33, 0x4e,
-	0x5f, 0xcf, 0x8e, 0x82, 0x96, 0x47, 0x76, 0xbf, 0x4c, 0x17, 0xde, 0x7d, 0x7f, 0x3f, 0x6f, 0xb8,
-	0x1a, 0x6b, 0xaf, 0x83, 0x19, 0x49, 0x7e, 0x9f, 0x08, 0x97, 0x44, 0x4a, 0xf5, 0x46, 0x98, 0xac,
-	0x49, 0xa8, 0x56, 0x00, 0xc7, 0x41, 0x31, 0xf2, 0x85, 0x17, 0x56, 0xe5, 0xa8, 0x11, 0x77, 0x38,
-	0xf2, 0xc5, 0x4a, 0x15, 0x4e, 0x83, 0x51, 0x35, 0xc2, 0x13, 0xcd, 0x84, 0x4c, 0x0c, 0xc9, 0x33,
-	0xa0, 0x4a, 0x6b, 0xcd, 0x84, 0xd8, 0x2f, 0x81, 0x3d, 0x88, 0x5c, 0x1b, 0x59, 0x02, 0x93, 0x2c,
-	0x73, 0xea, 0x49, 0xed, 0x9e, 0xa2, 0xd1, 0x03, 0xcf, 0x66, 0x1b, 0xd6, 0xd2, 0x73, 0xc5, 0x61,
-	0x3f, 0x07, 0x73, 0x72, 0xc2, 0x6d, 0x1c, 0xf9, 0xf5, 0x08, 0x0b, 0xe2, 0x1e, 0x6c, 0xcc, 0xf1,
-	0x30, 0x01, 0xfe, 0xf3, 0x69, 0x2c, 0xc8, 0x96, 0xd0, 0xfa, 0xbb, 0xaf, 0x76, 0x15, 0x5c, 0xca,
-	0xa5, 0xd6, 0x0e, 0xd2, 0x0f, 0x21, 0x45, 0x73, 0x9f, 0x32, 0xa2, 0x07, 0x00, 0x59, 0x5a, 0x4d,
-	0x2b, 0xe9, 0x94, 0x57, 0xd8, 0x17, 0x94, 0xf1, 0xee, 0x14, 0xfd, 0x6a, 0xdf, 0x00, 0x53, 0xdd,
-	0x4f, 0xa4, 0x2c, 0x3d, 0x08, 0xb9, 0xa0, 0xac, 0xd9, 0x95, 0x7d, 0x0e, 0x8c, 0xe8, 0x6f, 0xbc,
-	0xaf, 0xfc, 0x7f, 0x55, 0x58, 0xa9, 0xda, 0xf7, 0xc0, 0xf9, 0x3e, 0x60, 0x2d, 0x6c, 0x16, 0x9c,
-	0xd4, 0x68, 0x12, 0x0b, 0x16, 0x12, 0xae, 0x29, 0x4e, 0xa8, 0xea, 0x5d, 0x55, 0x5c, 0xfc, 0x58,
-	0x04, 0xc3, 0x92, 0x08, 0xee, 0x18, 0xa0, 0xa8, 0xc2, 0x02, 0x17, 0x06, 0xe4, 0xe9, 0x70, 0x4a,
-	0x4d, 0xe7, 0xb8, 0xed, 0x4a, 0x9a, 0x3d, 0xff, 0xe6, 0xd3, 0xb7, 0x9d, 0xa1, 0x8b, 0xd0, 0x46,
-	0x1a, 0xb7, 0xd0, 0x77, 0x3b, 0xe0, 0x0f, 0x03, 0x8c, 0x1f, 0x99, 0x21, 0xb8, 0x9c, 0x37, 0x75,
-	0x50, 0xae, 0xcd, 0x9b, 0x7f, 0x89, 0xd6, 0x16, 0x5c, 0x69, 0xe1, 0x31, 0x7c, 0x38, 0xc8, 0x42,
-	0x40, 0x84, 0xd7, 0x1b, 0x6f, 0x75, 0xb4, 0xad, 0xd2, 0xd8, 0x42, 0xdb, 0x99, 0x1d, 0x6a, 0xc1,
-	0x9f, 0x06, 0x30, 0xfb, 0x27, 0x0e, 0xde, 0xca, 0x53, 0x9c, 0xbb, 0x08, 0x66, 0xf9, 0x5f, 0x28,
-	0xb4, 0xf3, 0x55, 0xe9, 0xfc, 0x09, 0x7c, 0x34, 0xc8, 0xb9, 0xdf, 0xe5, 0xf1, 0x0e, 0xaf, 0x77,
-	0xc6, 0xbe, 0xde, 0xb7, 0x16, 0xfc, 0x60, 0x80, 0x53, 0x07, 0x93, 0x0c, 0xaf, 0x1e, 0xe3, 0x8a,
-	0x8e, 0x5a, 0x1c, 0xf3, 0xda, 0x9f, 0x03, 0xb5, 0xb9, 0xb2, 0x34, 0xb7, 0x0c, 0x97, 0xf2, 0xae,
-	0x55, 0x5f, 0xdc, 0x86, 0xc2, 0xef, 0x5f, 0x64, 0x58, 0x6d, 0x95, 0xaf, 0xef, 0xb6, 0x2d, 0x63,
-	0xaf, 0x6d, 0x19, 0x5f, 0xdb, 0x96, 0xf1, 0xb6, 0x63, 0x15, 0xf6, 0x3a, 0x56, 0xe1, 0x73, 0xc7,
-	0x2a, 0xbc, 0x98, 0xce, 0x92, 0x6e, 0xf5, 0xd0, 0xa6, 0x09, 0xe0, 0x95, 0xa2, 0xfc, 0x2f, 0xb8,
-	0xf2, 0x2b, 0x00, 0x00, 0xff, 0xff, 0x21, 0x70, 0x6e, 0x03, 0xea, 0x06, 0x00, 0x00,
+	// 922 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xcf, 0x3a, 0xad, 0x43, 0x5e, 0xa4, 0x50, 0x86, 0x56, 0xb8, 0xdb, 0xe2, 0xd0, 0x81, 0x26,
+	0x55, 0x50, 0x77, 0x15, 0x23, 0xa0, 0x89, 0x8a, 0x04, 0xa6, 0x50, 0x0a, 0xad, 0x64, 0xb6, 0xb9,
+	0x40, 0x0f, 0x66, 0xbd, 0x9e, 0xb8, 0x2b, 0xd9, 0x3b, 0xdb, 0x9d, 0x71, 0xa8, 0x55, 0xf9, 0xc2,
+	0x27, 0x40, 0xea, 0x97, 0xe0, 0x88, 0x38, 0x73, 0xe1, 0x16, 0x6e, 0x95, 0xb8, 0x70, 0x42, 0x28,
+	0x41, 0x02, 0xf1, 0x09, 0x38, 0xa2, 0x9d, 0x79, 0xeb, 0xec, 0xc6, 0xde, 0x5d, 0x37, 0xbd, 0x58,
+	0xe3, 0x37, 0xef, 0xcf, 0xef, 0xf7, 0xde, 0xdb, 0xdf, 0x2e, 0x5c, 0x8d, 0x5c, 0x8f, 0x79, 0x6e,
+	0xf4, 0x2d, 0xeb, 0xd8, 0x32, 0x1a, 0x0a, 0x29, 0x59, 0x20, 0x78, 0x64, 0xef, 0x6f, 0xd9, 0x8f,
+	0x86, 0x2c, 0x1a, 0x59, 0x61, 0xc4, 0x25, 0x27, 0x17, 0x8f, 0xdd, 0xac, 0x94, 0x9b, 0xb5, 0xbf,
+	0x65, 0xbe, 0xe2, 0x0e, 0xfc, 0x80, 0xdb, 0xea, 0x57, 0x7b, 0x9b, 0x9b, 0x1e, 0x17, 0x03, 0x2e,
+	0xec, 0x8e, 0x2b, 0x98, 0x4e, 0x63, 0xef, 0x6f, 0x75, 0x98, 0x74, 0xb7, 0xec, 0xd0, 0xed, 0xf9,
+	0x81, 0x2b, 0x7d, 0x1e, 0xa0, 0xef, 0xf9, 0x1e, 0xef, 0x71, 0x75, 0xb4, 0xe3, 0x13, 0x5a, 0x2f,
+	0xf7, 0x38, 0xef, 0xf5, 0x99, 0xed, 0x86, 0xbe, 0xed, 0x06, 0x01, 0x97, 0x2a, 0x44, 0xe0, 0xed,
+	0x7a, 0x3e, 0xe8, 0xd0, 0x8d, 0xdc, 0x41, 0xe2, 0xb7, 0x9d, 0xef, 0x17, 0xb1, 0xbe, 0x4e, 0xf9,
+	0xd0, 0x0f, 0xdb, 0xea, 0xae, 0x8d, 0x94, 0x74, 0xa8, 0x95, 0x1f, 0xaa, 0x4f, 0xed, 0x87, 0xbe,
+	0x90, 0x3c, 0x69, 0x10, 0x3d, 0x0f, 0xe4, 0xcb, 0x98, 0x68, 0x4b, 0xd5, 0x77, 0xd8, 0xa3, 0x21,
+	0x13, 0x92, 0x3e, 0x80, 0x57, 0x33, 0x56, 0x11, 0xf2, 0x40, 0x30, 0x72, 0x0b, 0xaa, 0x1a, 0x67,
+	0xcd, 0x78, 0xc3, 0xb8, 0xb6, 0xd2, 0xb8, 0x62, 0xe5, 0xb6, 0xd7, 0xd2, 0xa1, 0xcd, 0xe5, 0x83,
+	0x3f, 0xd6, 0x16, 0x7e, 0xf8, 0xfb, 0xc7, 0x4d, 0xc3, 0xc1, 0x58, 0xfa, 0x00, 0xae, 0xa8, 0xe4,
+	0xb7, 0x99, 0x74, 0x52, 0x6c, 0x76, 0x55, 0x28, 0x22, 0x20, 0x17, 0xa0, 0xda, 0xf7, 0x64, 0xdb,
+	0xef, 0xaa, 0x52, 0xcb, 0xce, 0xd9, 0xbe, 0x27, 0xef, 0x74, 0xc9, 0x1a, 0xac, 0x20, 0x0d, 0x39,
+	0x0a, 0x59, 0xad, 0xa2, 0xee, 0x40, 0x9b, 0x76, 0x47, 0x21, 0xa3, 0xdf, 0x00, 0x2d, 0x4a, 0x8e,
+	0x44, 0x76, 0xe0, 0x62, 0x6e, 0x23, 0xb1, 0xe0, 0x6b, 0x69, 0x87, 0xdd, 0xf8, 0x5e, 0xe7, 0xa0,
+	0x5f, 0xc1, 0xba, 0xaa, 0xf0, 0xb1, 0xdb, 0xf7, 0x86, 0x7d, 0x57, 0x32, 0xe7, 0xa4, 0x63, 0x09,
+	0x87, 0x1a, 0x2c, 0x79, 0x3c, 0x90, 0xec, 0xb1, 0x44, 0xfc, 0xc9, 0x5f, 0xda, 0x85, 0x8d, 0xd2,
+	0xd4, 0xc8, 0x20, 0x6e, 0x84, 0x02, 0x2d, 0x3c, 0x1e, 0x31, 0x2c, 0x00, 0xca, 0x74, 0x3f, 0xb6,
+	0xc4, 0x55, 0xf6, 0x5c, 0x4f, 0xf2, 0x48, 0x24, 0x55, 0xf0, 0x2f, 0x6d, 0xc3, 0xe5, 0xa4, 0x45,
+	0x9a, 0xd2, 0x67, 0x7a, 0x23, 0x12, 0xd8, 0x97, 0x60, 0x19, 0x7b, 0x3c, 0x41, 0xfe, 0x92, 0x36,
+	0xdc, 0xe9, 0x12, 0x02, 0x67, 0xf6, 0x22, 0x3e, 0x50, 0x39, 0x17, 0x1d, 0x75, 0x26, 0xab, 0x50,
+	0x91, 0xbc, 0xb6, 0xa8, 0x2c, 0x15, 0xc9, 0x69, 0x00, 0xaf, 0xe7, 0x14, 0x40, 0xf0, 0xf7, 0x60,
+	0x89, 0x05, 0x32, 0xf2, 0x59, 0xbc, 0x48, 0x8b, 0xd7, 0x56, 0x1a, 0xd7, 0x0b, 0x16, 0x29, 0x93,
+	0xe2, 0x93, 0x40, 0x46, 0xa3, 0xe6, 0x99, 0x78, 0xa9, 0x9c, 0x24, 0x07, 0xdd, 0x06, 0x73, 0x52,
+	0xef, 0x78, 0x50, 0xf3, 0xd0, 0xa1, 0xff, 0x1a, 0x70, 0x69, 0x66, 0x2c, 0x22, 0x6d, 0x41, 0x35,
+	0xb5, 0x15, 0x2b, 0x8d, 0x46, 0x01, 0x50, 0x67, 0xf6, 0xc2, 0x20, 0x5a, 0xcc, 0x43, 0x36, 0xe0,
+	0x65, 0x8f, 0x0f, 0x42, 0x2e, 0x7c, 0xc9, 0x70, 0x78, 0x7a, 0x3e, 0xab, 0x13, 0xb3, 0x1e, 0x60,
+	0x0b, 0xa0, 0xeb, 0x0f, 0x58, 0x20, 0xe2, 0x94, 0xb5, 0x45, 0xd5, 0xa7, 0xcd, 0xd2, 0x3e, 0xdd,
+	0x4a, 0x42, 0xb0, 0x6c, 0x2a, 0x07, 0xdd, 0xc3, 0xc1, 0xdf, 0xf5, 0x45, 0x9a, 0x6c, 0xf2, 0xd4,
+	0x93, 0x4f, 0x01, 0x8e, 0x65, 0x0e, 0x09, 0xaf, 0x5b, 0x5a, 0x13, 0xad, 0x58, 0x13, 0x2d, 0x2d,
+	0xad, 0xa8, 0x89, 0x56, 0xcb, 0xed, 0x31, 0x8c, 0x75, 0x52, 0x91, 0xf4, 0x67, 0x03, 0x17, 0x60,
+	0xba, 0x10, 0xb6, 0xd5, 0x81, 0x25, 0x0d, 0x3c, 0x59, 0x80, 0xd3, 0xf7, 0x35, 0x49, 0x44, 0x6e,
+	0x67, 0xd0, 0x57, 0x14, 0xfa, 0x8d, 0x52, 0xf4, 0x1a, 0x50, 0x1a, 0x7e, 0xe3, 0x60, 0x19, 0xce,
+	0x2a, 0xf8, 0xe4, 0xa9, 0x01, 0x55, 0xad, 0x63, 0xa4, 0x68, 0x43, 0xa7, 0x05, 0xd4, 0xb4, 0xe6,
+	0x75, 0xd7, 0xf5, 0xe9, 0xe6, 0x77, 0xbf, 0xfd, 0xf5, 0xb4, 0xf2, 0x16, 0xa1, 0x36, 0xc6, 0x5d,
+	0xcf, 0x7d, 0x47, 0x90, 0x7f, 0x0c, 0xb8, 0x30, 0x53, 0xde, 0xc8, 0xcd, 0xb2, 0xaa, 0x45, 0x92,
+	0x6b, 0x7e, 0x70, 0xca, 0x68, 0xa4, 0xe0, 0x28, 0x0a, 0x77, 0xc9, 0xe7, 0x45, 0x14, 0x7a, 0x4c,
+	0xb6, 0xb3, 0xca, 0xab, 0xaf, 0x9e, 0x68, 0xa1, 0x1c, 0xdb, 0x4f, 0x52, 0xf2, 0x3e, 0x26, 0xff,
+	0x19, 0x60, 0xe6, 0x8b, 0x21, 0xf9, 0xa8, 0x0c, 0x71, 0xa9, 0x46, 0x9b, 0xcd, 0x17, 0x49, 0x81,
+	0xcc, 0xef, 0x2b, 0xe6, 0xf7, 0xc8, 0x17, 0x45, 0xcc, 0xbd, 0x24, 0x4f, 0x7b, 0xfa, 0xcd, 0x93,
+	0xa2, 0x8f, 0xaf, 0x82, 0x31, 0xf9, 0xd5, 0x80, 0x73, 0x27, 0x05, 0x94, 0xbc, 0x3f, 0xc7, 0x88,
+	0x66, 0x69, 0xba, 0x79, 0xe3, 0xf9, 0x03, 0x91, 0x5c, 0x53, 0x91, 0xbb, 0x49, 0x76, 0xca, 0xc6,
+	0x9a, 0xfd, 0xbc, 0x98, 0x0c, 0xd2, 0xef, 0x8e, 0xc9, 0x2f, 0x06, 0xac, 0x66, 0x05, 0x96, 0xbc,
+	0x3b, 0x0f, 0xa0, 0x29, 0x31, 0x37, 0xdf, 0x7b, 0xde, 0x30, 0x64, 0xf1, 0xa1, 0x62, 0xb1, 0x43,
+	0x6e, 0x94, 0xb2, 0x48, 0x7d, 0x09, 0x64, 0x38, 0xfc, 0x64, 0xc0, 0xb9, 0x93, 0x7a, 0x56, 0x3e,
+	0x8f, 0x1c, 0xa9, 0x2d, 0x9f, 0x47, 0x9e, 0x74, 0xd2, 0xb7, 0x15, 0x93, 0xab, 0xe4, 0xcd, 0x22,
+	0x26, 0xa8, 0x89, 0xcd, 0xed, 0x83, 0xc3, 0xba, 0xf1, 0xec, 0xb0, 0x6e, 0xfc, 0x79, 0x58, 0x37,
+	0xbe, 0x3f, 0xaa, 0x2f, 0x3c, 0x3b, 0xaa, 0x2f, 0xfc, 0x7e, 0x54, 0x5f, 0xf8, 0x7a, 0x2d, 0x1d,
+	0xfd, 0x38, 0x13, 0x1f, 0x3f, 0x7a, 0xa2, 0x53, 0x55, 0xdf, 0x87, 0xef, 0xfc, 0x1f, 0x00, 0x00,
+	0xff, 0xff, 0x5a, 0x7d, 0xa3, 0x08, 0x69, 0x0b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -490,8 +733,12 @@ type QueryClient interface {
 	GetRelationshipTensor(ctx context.Context, in *QueryGetRelationshipTensorRequest, opts ...grpc.CallOption) (*QueryGetRelationshipTensorResponse, error)
 	// CalculateRelationshipTrust Queries a list of CalculateRelationshipTrust items.
 	CalculateRelationshipTrust(ctx context.Context, in *QueryCalculateRelationshipTrustRequest, opts ...grpc.CallOption) (*QueryCalculateRelationshipTrustResponse, error)
-	// GetTensorHistory Queries a list of GetTensorHistory items.
+	// GetTensorHistory Queries the recorded score updates of a tensor, oldest first.
 	GetTensorHistory(ctx context.Context, in *QueryGetTensorHistoryRequest, opts ...grpc.CallOption) (*QueryGetTensorHistoryResponse, error)
+	// GetTrustTensor Queries a relationship trust tensor by its ID.
+	GetTrustTensor(ctx context.Context, in *QueryGetTrustTensorRequest, opts ...grpc.CallOption) (*QueryGetTrustTensorResponse, error)
+	// ListTrustTensors Queries a page of relationship trust tensors.
+	ListTrustTensors(ctx context.Context, in *QueryListTrustTensorsRequest, opts ...grpc.CallOption) (*QueryListTrustTensorsResponse, error)
 }
 
 type queryClient struct {
@@ -538,6 +785,24 @@ func (c *queryClient) GetTensorHistory(ctx context.Context, in *QueryGetTensorHi
 	return out, nil
 }
 
+func (c *queryClient) GetTrustTensor(ctx context.Context, in *QueryGetTrustTensorRequest, opts ...grpc.CallOption) (*QueryGetTrustTensorResponse, error) {
+	out := new(QueryGetTrustTensorResponse)
+	err := c.cc.Invoke(ctx, "/racecarweb.trusttensor.v1.Query/GetTrustTensor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListTrustTensors(ctx context.Context, in *QueryListTrustTensorsRequest, opts ...grpc.CallOption) (*QueryListTrustTensorsResponse, error) {
+	out := new(QueryListTrustTensorsResponse)
+	err := c.cc.Invoke(ctx, "/racecarweb.trusttensor.v1.Query/ListTrustTensors", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Parameters queries the parameters of the module.
@@ -546,8 +811,12 @@ type QueryServer interface {
 	GetRelationshipTensor(context.Context, *QueryGetRelationshipTensorRequest) (*QueryGetRelationshipTensorResponse, error)
 	// CalculateRelationshipTrust Queries a list of CalculateRelationshipTrust items.
 	CalculateRelationshipTrust(context.Context, *QueryCalculateRelationshipTrustRequest) (*QueryCalculateRelationshipTrustResponse, error)
-	// GetTensorHistory Queries a list of GetTensorHistory items.
+	// GetTensorHistory Queries the recorded score updates of a tensor, oldest first.
 	GetTensorHistory(context.Context, *QueryGetTensorHistoryRequest) (*QueryGetTensorHistoryResponse, error)
+	// GetTrustTensor Queries a relationship trust tensor by its ID.
+	GetTrustTensor(context.Context, *QueryGetTrustTensorRequest) (*QueryGetTrustTensorResponse, error)
+	// ListTrustTensors Queries a page of relationship trust tensors.
+	ListTrustTensors(context.Context, *QueryListTrustTensorsRequest) (*QueryListTrustTensorsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -566,6 +835,12 @@ func (*UnimplementedQueryServer) CalculateRelationshipTrust(ctx context.Context,
 func (*UnimplementedQueryServer) GetTensorHistory(ctx context.Context, req *QueryGetTensorHistoryRequest) (*QueryGetTensorHistoryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTensorHistory not implemented")
 }
+func (*UnimplementedQueryServer) GetTrustTensor(ctx context.Context, req *QueryGetTrustTensorRequest) (*QueryGetTrustTensorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTrustTensor not implemented")
+}
+func (*UnimplementedQueryServer) ListTrustTensors(ctx context.Context, req *QueryListTrustTensorsRequest) (*QueryListTrustTensorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTrustTensors not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -643,6 +918,42 @@ func _Query_GetTensorHistory_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_GetTrustTensor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetTrustTensorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetTrustTensor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/racecarweb.trusttensor.v1.Query/GetTrustTensor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetTrustTensor(ctx, req.(*QueryGetTrustTensorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListTrustTensors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListTrustTensorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListTrustTensors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/racecarweb.trusttensor.v1.Query/ListTrustTensors",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListTrustTensors(ctx, req.(*QueryListTrustTensorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "racecarweb.trusttensor.v1.Query",
@@ -664,6 +975,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetTensorHistory",
 			Handler:    _Query_GetTensorHistory_Handler,
 		},
+		{
+			MethodName: "GetTrustTensor",
+			Handler:    _Query_GetTrustTensor_Handler,
+		},
+		{
+			MethodName: "ListTrustTensors",
+			Handler:    _Query_ListTrustTensors_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "racecarweb/trusttensor/v1/query.proto",
@@ -886,6 +1205,16 @@ func (m *QueryGetTensorHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, e
 	_ = i
 	var l int
 	_ = l
+	if m.To != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.To))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.From != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.From))
+		i--
+		dAtA[i] = 0x10
+	}
 	if len(m.TensorId) > 0 {
 		i -= len(m.TensorId)
 		copy(dAtA[i:], m.TensorId)
@@ -916,81 +1245,256 @@ func (m *QueryGetTensorHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	_ = i
 	var l int
 	_ = l
-	if len(m.TensorEntries) > 0 {
-		i -= len(m.TensorEntries)
-		copy(dAtA[i:], m.TensorEntries)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.TensorEntries)))
-		i--
-		dAtA[i] = 0xa
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetTrustTensorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryGetTrustTensorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetRelationshipTensorRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryGetTrustTensorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.LctId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.TensorType)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.TensorId) > 0 {
+		i -= len(m.TensorId)
+		copy(dAtA[i:], m.TensorId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TensorId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetRelationshipTensorResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.RelationshipTrustTensor)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryGetTrustTensorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryCalculateRelationshipTrustRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryGetTrustTensorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetTrustTensorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Dimensions) > 0 {
+		for iNdEx := len(m.Dimensions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Dimensions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.CompositeScore) > 0 {
+		i -= len(m.CompositeScore)
+		copy(dAtA[i:], m.CompositeScore)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CompositeScore)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Tensor.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListTrustTensorsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListTrustTensorsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListTrustTensorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListTrustTensorsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListTrustTensorsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListTrustTensorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Tensors) > 0 {
+		for iNdEx := len(m.Tensors) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tensors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetRelationshipTensorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.LctId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.TensorType)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetRelationshipTensorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RelationshipTrustTensor)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCalculateRelationshipTrustRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
 	var l int
 	_ = l
 	l = len(m.LctId)
@@ -1031,6 +1535,12 @@ func (m *QueryGetTensorHistoryRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	if m.From != 0 {
+		n += 1 + sovQuery(uint64(m.From))
+	}
+	if m.To != 0 {
+		n += 1 + sovQuery(uint64(m.To))
+	}
 	return n
 }
 
@@ -1040,10 +1550,78 @@ func (m *QueryGetTensorHistoryResponse) Size() (n int) {
 	}
 	var l int
 	_ = l
-	l = len(m.TensorEntries)
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryGetTrustTensorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TensorId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetTrustTensorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Tensor.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.CompositeScore)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	if len(m.Dimensions) > 0 {
+		for _, e := range m.Dimensions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryListTrustTensorsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListTrustTensorsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Tensors) > 0 {
+		for _, e := range m.Tensors {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -1134,9 +1712,320 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetRelationshipTensorRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetRelationshipTensorRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetRelationshipTensorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LctId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LctId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TensorType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TensorType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetRelationshipTensorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetRelationshipTensorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetRelationshipTensorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelationshipTrustTensor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RelationshipTrustTensor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCalculateRelationshipTrustRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCalculateRelationshipTrustRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCalculateRelationshipTrustRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LctId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LctId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Context", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1146,24 +2035,23 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Context = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1186,7 +2074,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetRelationshipTensorRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCalculateRelationshipTrustResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1209,15 +2097,15 @@ func (m *QueryGetRelationshipTensorRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetRelationshipTensorRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCalculateRelationshipTrustResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetRelationshipTensorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCalculateRelationshipTrustResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LctId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TrustScore", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1245,11 +2133,11 @@ func (m *QueryGetRelationshipTensorRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LctId = string(dAtA[iNdEx:postIndex])
+			m.TrustScore = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TensorType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Factors", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1277,7 +2165,7 @@ func (m *QueryGetRelationshipTensorRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TensorType = string(dAtA[iNdEx:postIndex])
+			m.Factors = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1300,7 +2188,7 @@ func (m *QueryGetRelationshipTensorRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetRelationshipTensorResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetTensorHistoryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1323,15 +2211,15 @@ func (m *QueryGetRelationshipTensorResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetRelationshipTensorResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetTensorHistoryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetRelationshipTensorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetTensorHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RelationshipTrustTensor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TensorId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1359,8 +2247,46 @@ func (m *QueryGetRelationshipTensorResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RelationshipTrustTensor = string(dAtA[iNdEx:postIndex])
+			m.TensorId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			m.From = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.From |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			m.To = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.To |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1382,7 +2308,7 @@ func (m *QueryGetRelationshipTensorResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCalculateRelationshipTrustRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetTensorHistoryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1405,17 +2331,17 @@ func (m *QueryCalculateRelationshipTrustRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCalculateRelationshipTrustRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetTensorHistoryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCalculateRelationshipTrustRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetTensorHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LctId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1425,27 +2351,79 @@ func (m *QueryCalculateRelationshipTrustRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LctId = string(dAtA[iNdEx:postIndex])
+			m.Entries = append(m.Entries, TensorHistoryEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetTrustTensorRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetTrustTensorRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetTrustTensorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Context", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TensorId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1473,7 +2451,7 @@ func (m *QueryCalculateRelationshipTrustRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Context = string(dAtA[iNdEx:postIndex])
+			m.TensorId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1496,7 +2474,7 @@ func (m *QueryCalculateRelationshipTrustRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCalculateRelationshipTrustResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetTrustTensorResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1519,17 +2497,17 @@ func (m *QueryCalculateRelationshipTrustResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCalculateRelationshipTrustResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetTrustTensorResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCalculateRelationshipTrustResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetTrustTensorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TrustScore", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tensor", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1539,27 +2517,28 @@ func (m *QueryCalculateRelationshipTrustResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TrustScore = string(dAtA[iNdEx:postIndex])
+			if err := m.Tensor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Factors", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CompositeScore", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1587,7 +2566,41 @@ func (m *QueryCalculateRelationshipTrustResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Factors = string(dAtA[iNdEx:postIndex])
+			m.CompositeScore = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dimensions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Dimensions = append(m.Dimensions, TensorDimension{})
+			if err := m.Dimensions[len(m.Dimensions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1610,7 +2623,7 @@ func (m *QueryCalculateRelationshipTrustResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetTensorHistoryRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryListTrustTensorsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1633,17 +2646,17 @@ func (m *QueryGetTensorHistoryRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetTensorHistoryRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryListTrustTensorsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetTensorHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryListTrustTensorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TensorId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1653,23 +2666,27 @@ func (m *QueryGetTensorHistoryRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TensorId = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1692,7 +2709,7 @@ func (m *QueryGetTensorHistoryRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetTensorHistoryResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryListTrustTensorsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1715,17 +2732,17 @@ func (m *QueryGetTensorHistoryResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetTensorHistoryResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryListTrustTensorsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetTensorHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryListTrustTensorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TensorEntries", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tensors", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1735,23 +2752,61 @@ func (m *QueryGetTensorHistoryResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tensors = append(m.Tensors, RelationshipTrustTensor{})
+			if err := m.Tensors[len(m.Tensors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TensorEntries = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
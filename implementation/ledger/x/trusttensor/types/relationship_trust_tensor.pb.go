@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
 	math "math"
@@ -36,6 +37,10 @@ type RelationshipTrustTensor struct {
 	Version          int64  `protobuf:"varint,10,opt,name=version,proto3" json:"version,omitempty"`
 	EvidenceCount    int64  `protobuf:"varint,11,opt,name=evidence_count,json=evidenceCount,proto3" json:"evidence_count,omitempty"`
 	ContextModifier  string `protobuf:"bytes,12,opt,name=context_modifier,json=contextModifier,proto3" json:"context_modifier,omitempty"`
+	// dimensions holds the named scores of the tensor, sorted by name. Tensors
+	// stored before named dimensions existed only carry the three T3 scores.
+	Dimensions []TensorDimension `protobuf:"bytes,13,rep,name=dimensions,proto3" json:"dimensions"`
+	Creator    string            `protobuf:"bytes,14,opt,name=creator,proto3" json:"creator,omitempty"`
 }
 
 func (m *RelationshipTrustTensor) Reset()         { *m = RelationshipTrustTensor{} }
@@ -155,8 +160,77 @@ func (m *RelationshipTrustTensor) GetContextModifier() string {
 	return ""
 }
 
+func (m *RelationshipTrustTensor) GetDimensions() []TensorDimension {
+	if m != nil {
+		return m.Dimensions
+	}
+	return nil
+}
+
+func (m *RelationshipTrustTensor) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+// TensorDimension is one named dimension of a trust tensor, such as talent or
+// reliability
+type TensorDimension struct {
+	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Score string `protobuf:"bytes,2,opt,name=score,proto3" json:"score,omitempty"`
+}
+
+func (m *TensorDimension) Reset()         { *m = TensorDimension{} }
+func (m *TensorDimension) String() string { return proto.CompactTextString(m) }
+func (*TensorDimension) ProtoMessage()    {}
+func (*TensorDimension) Descriptor() ([]byte, []int) {
+	return fileDescriptor_18b2112c939445e1, []int{1}
+}
+func (m *TensorDimension) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TensorDimension) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TensorDimension.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TensorDimension) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TensorDimension.Merge(m, src)
+}
+func (m *TensorDimension) XXX_Size() int {
+	return m.Size()
+}
+func (m *TensorDimension) XXX_DiscardUnknown() {
+	xxx_messageInfo_TensorDimension.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TensorDimension proto.InternalMessageInfo
+
+func (m *TensorDimension) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *TensorDimension) GetScore() string {
+	if m != nil {
+		return m.Score
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*RelationshipTrustTensor)(nil), "racecarweb.trusttensor.v1.RelationshipTrustTensor")
+	proto.RegisterType((*TensorDimension)(nil), "racecarweb.trusttensor.v1.TensorDimension")
 }
 
 func init() {
@@ -164,31 +238,36 @@ func init() {
 }
 
 var fileDescriptor_18b2112c939445e1 = []byte{
-	// 370 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x92, 0xcd, 0xae, 0x12, 0x31,
-	0x14, 0x80, 0x19, 0x91, 0x9f, 0x29, 0xa0, 0xd8, 0xc4, 0x58, 0x63, 0x1c, 0xd0, 0xc4, 0x04, 0x63,
-	0x84, 0x10, 0x57, 0x2c, 0xd1, 0x15, 0x0b, 0x37, 0xc8, 0xca, 0xcd, 0xa4, 0x74, 0x8e, 0xda, 0x64,
-	0x68, 0x27, 0x9d, 0xc3, 0x08, 0x6f, 0xe1, 0x6b, 0xf8, 0x26, 0x2e, 0x59, 0xba, 0xbc, 0x81, 0x17,
-	0xb9, 0xe9, 0xcf, 0x04, 0xee, 0x72, 0xbe, 0xef, 0x3b, 0x4d, 0x27, 0x3d, 0x64, 0x61, 0xb8, 0x00,
-	0xc1, 0xcd, 0x6f, 0xd8, 0xce, 0xd0, 0xec, 0x4b, 0x44, 0x50, 0xa5, 0x36, 0xb3, 0x6a, 0x3e, 0x33,
-	0x90, 0x73, 0x94, 0x5a, 0x95, 0xbf, 0x64, 0x91, 0x3a, 0x97, 0x7a, 0x39, 0x2d, 0x8c, 0x46, 0x4d,
-	0x5f, 0x5e, 0x47, 0xa7, 0x37, 0xa3, 0xd3, 0x6a, 0xfe, 0xf6, 0x6f, 0x93, 0xbc, 0x58, 0xdf, 0x8c,
-	0x6f, 0xac, 0xde, 0x38, 0x4d, 0x5f, 0x91, 0xd8, 0x87, 0xa9, 0xcc, 0x58, 0x34, 0x8e, 0x26, 0xf1,
-	0xba, 0xeb, 0xc1, 0x2a, 0xa3, 0xcf, 0x49, 0x3b, 0x17, 0x68, 0xcd, 0x23, 0x67, 0x5a, 0xb9, 0xc0,
-	0x55, 0x46, 0x47, 0xa4, 0x17, 0x66, 0xf0, 0x58, 0x00, 0x6b, 0x3a, 0x47, 0x3c, 0xda, 0x1c, 0x0b,
-	0xa0, 0x6f, 0x48, 0x1f, 0x79, 0x0e, 0x0a, 0xd3, 0x52, 0x68, 0x03, 0xec, 0xb1, 0x2b, 0x7a, 0x9e,
-	0x7d, 0xb3, 0x88, 0xbe, 0x23, 0x4f, 0xd0, 0x70, 0xa9, 0xa4, 0xfa, 0x19, 0xa2, 0x96, 0x8b, 0x06,
-	0x35, 0xf5, 0xd9, 0x07, 0xf2, 0x0c, 0x61, 0x57, 0x80, 0xe1, 0xbb, 0xeb, 0x71, 0x6d, 0x57, 0x0e,
-	0x6f, 0x84, 0x8f, 0x19, 0xe9, 0x08, 0xad, 0x10, 0x0e, 0xc8, 0x3a, 0x2e, 0xa9, 0x3f, 0xe9, 0x6b,
-	0x42, 0x84, 0x01, 0x8e, 0x90, 0xa5, 0x1c, 0x59, 0x77, 0x1c, 0x4d, 0x9a, 0xeb, 0x38, 0x90, 0xa5,
-	0xd3, 0xfb, 0x22, 0xab, 0x75, 0xec, 0x75, 0x20, 0x4b, 0xb4, 0xe7, 0x56, 0x60, 0x4a, 0xa9, 0x15,
-	0x23, 0xce, 0xd5, 0x9f, 0xf6, 0x2f, 0xa0, 0x92, 0x19, 0x28, 0x01, 0xa9, 0xd0, 0x7b, 0x85, 0xac,
-	0xe7, 0x82, 0x41, 0x4d, 0xbf, 0x58, 0x48, 0xdf, 0x93, 0x61, 0xb8, 0x49, 0xba, 0xd3, 0x99, 0xfc,
-	0x21, 0xc1, 0xb0, 0xbe, 0xbb, 0xe1, 0xd3, 0xc0, 0xbf, 0x06, 0xfc, 0x79, 0xf1, 0xef, 0x9c, 0x44,
-	0xa7, 0x73, 0x12, 0xdd, 0x9d, 0x93, 0xe8, 0xcf, 0x25, 0x69, 0x9c, 0x2e, 0x49, 0xe3, 0xff, 0x25,
-	0x69, 0x7c, 0x1f, 0x85, 0x07, 0xfe, 0x68, 0x97, 0xe3, 0xf0, 0x60, 0x3d, 0xec, 0x33, 0x94, 0xdb,
-	0xb6, 0x5b, 0x84, 0x4f, 0xf7, 0x01, 0x00, 0x00, 0xff, 0xff, 0x4b, 0x42, 0x1c, 0xc9, 0x45, 0x02,
-	0x00, 0x00,
+	// 458 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0xcf, 0x6e, 0x13, 0x31,
+	0x10, 0xc6, 0xb3, 0xe4, 0x4f, 0x9b, 0x49, 0xd3, 0x16, 0xab, 0x08, 0x03, 0x62, 0x13, 0x2a, 0x21,
+	0x05, 0x10, 0x1b, 0x15, 0x4e, 0x15, 0xa7, 0x16, 0x2e, 0x3d, 0x20, 0xa1, 0x90, 0x13, 0x97, 0xd5,
+	0xd6, 0x3b, 0x04, 0x4b, 0x59, 0x7b, 0xe5, 0x9d, 0x84, 0xf6, 0x2d, 0xb8, 0xf0, 0x4e, 0x3d, 0xf6,
+	0xc8, 0x09, 0xa1, 0xe4, 0x45, 0x90, 0xff, 0xac, 0x12, 0x90, 0x7a, 0xf3, 0xfc, 0xbe, 0xef, 0x1b,
+	0xd9, 0xe3, 0x81, 0x53, 0x93, 0x09, 0x14, 0x99, 0xf9, 0x8e, 0x97, 0x63, 0x32, 0x8b, 0x8a, 0x08,
+	0x55, 0xa5, 0xcd, 0x78, 0x79, 0x32, 0x36, 0x38, 0xcf, 0x48, 0x6a, 0x55, 0x7d, 0x93, 0x65, 0xea,
+	0xb4, 0xd4, 0x8b, 0x49, 0x69, 0x34, 0x69, 0xf6, 0x68, 0x13, 0x4d, 0xb6, 0xa2, 0xc9, 0xf2, 0xe4,
+	0xf1, 0xd1, 0x4c, 0xcf, 0xb4, 0x73, 0x8d, 0xed, 0xc9, 0x07, 0x8e, 0x7f, 0xb6, 0xe0, 0xe1, 0x64,
+	0xab, 0xe9, 0xd4, 0x86, 0xa6, 0x2e, 0xc4, 0x9e, 0x40, 0xd7, 0xc7, 0x53, 0x99, 0xf3, 0x68, 0x18,
+	0x8d, 0xba, 0x93, 0x5d, 0x0f, 0x2e, 0x72, 0xf6, 0x00, 0x3a, 0x73, 0x41, 0x56, 0xb9, 0xe7, 0x94,
+	0xf6, 0x5c, 0xd0, 0x45, 0xce, 0x06, 0xd0, 0x0b, 0x19, 0xba, 0x2e, 0x91, 0x37, 0x9d, 0x06, 0x1e,
+	0x4d, 0xaf, 0x4b, 0x64, 0xcf, 0x60, 0x8f, 0xb2, 0x39, 0x2a, 0x4a, 0x2b, 0xa1, 0x0d, 0xf2, 0x96,
+	0x73, 0xf4, 0x3c, 0xfb, 0x6c, 0x11, 0x7b, 0x0e, 0xfb, 0x64, 0x32, 0xa9, 0xa4, 0x9a, 0x05, 0x53,
+	0xdb, 0x99, 0xfa, 0x35, 0xf5, 0xb6, 0x57, 0x70, 0x9f, 0xb0, 0x28, 0xd1, 0x64, 0xc5, 0xa6, 0x5d,
+	0xc7, 0x39, 0x0f, 0xb7, 0x04, 0x6f, 0xe6, 0xb0, 0x23, 0xb4, 0x22, 0xbc, 0x22, 0xbe, 0xe3, 0x2c,
+	0x75, 0xc9, 0x9e, 0x02, 0x08, 0x83, 0x19, 0x61, 0x9e, 0x66, 0xc4, 0x77, 0x87, 0xd1, 0xa8, 0x39,
+	0xe9, 0x06, 0x72, 0xe6, 0xe4, 0x45, 0x99, 0xd7, 0x72, 0xd7, 0xcb, 0x81, 0x9c, 0x91, 0xed, 0xbb,
+	0x44, 0x53, 0x49, 0xad, 0x38, 0x38, 0xad, 0x2e, 0xed, 0x2b, 0x70, 0x29, 0x73, 0x54, 0x02, 0x53,
+	0xa1, 0x17, 0x8a, 0x78, 0xcf, 0x19, 0xfa, 0x35, 0x7d, 0x6f, 0x21, 0x7b, 0x01, 0x87, 0xe1, 0x26,
+	0x69, 0xa1, 0x73, 0xf9, 0x55, 0xa2, 0xe1, 0x7b, 0xee, 0x86, 0x07, 0x81, 0x7f, 0x0c, 0x98, 0x7d,
+	0x02, 0xc8, 0x65, 0x81, 0xca, 0xb6, 0xaf, 0x78, 0x7f, 0xd8, 0x1c, 0xf5, 0xde, 0xbc, 0x4c, 0xee,
+	0xfc, 0xf1, 0xc4, 0x7f, 0xe3, 0x87, 0x3a, 0x72, 0xde, 0xba, 0xf9, 0x3d, 0x68, 0x4c, 0xb6, 0x7a,
+	0xb8, 0xa9, 0xd8, 0x97, 0x6a, 0xc3, 0xf7, 0xc3, 0x54, 0x7c, 0x79, 0xfc, 0x0e, 0x0e, 0xfe, 0x8b,
+	0x33, 0x06, 0x2d, 0x95, 0x15, 0x18, 0x36, 0xc1, 0x9d, 0xd9, 0x11, 0xb4, 0xfd, 0xdc, 0xc3, 0x12,
+	0xb8, 0xe2, 0xfc, 0xf4, 0x66, 0x15, 0x47, 0xb7, 0xab, 0x38, 0xfa, 0xb3, 0x8a, 0xa3, 0x1f, 0xeb,
+	0xb8, 0x71, 0xbb, 0x8e, 0x1b, 0xbf, 0xd6, 0x71, 0xe3, 0xcb, 0x20, 0xdc, 0xf6, 0xb5, 0xdd, 0xed,
+	0xab, 0x7f, 0xb6, 0xdb, 0xee, 0x4b, 0x75, 0xd9, 0x71, 0x6b, 0xf9, 0xf6, 0x6f, 0x00, 0x00, 0x00,
+	0xff, 0xff, 0x17, 0xff, 0x39, 0x8a, 0x04, 0x03, 0x00, 0x00,
 }
 
 func (m *RelationshipTrustTensor) Marshal() (dAtA []byte, err error) {
@@ -211,6 +290,27 @@ func (m *RelationshipTrustTensor) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintRelationshipTrustTensor(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.Dimensions) > 0 {
+		for iNdEx := len(m.Dimensions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Dimensions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRelationshipTrustTensor(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
 	if len(m.ContextModifier) > 0 {
 		i -= len(m.ContextModifier)
 		copy(dAtA[i:], m.ContextModifier)
@@ -290,6 +390,43 @@ func (m *RelationshipTrustTensor) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *TensorDimension) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TensorDimension) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TensorDimension) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Score) > 0 {
+		i -= len(m.Score)
+		copy(dAtA[i:], m.Score)
+		i = encodeVarintRelationshipTrustTensor(dAtA, i, uint64(len(m.Score)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintRelationshipTrustTensor(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintRelationshipTrustTensor(dAtA []byte, offset int, v uint64) int {
 	offset -= sovRelationshipTrustTensor(v)
 	base := offset
@@ -351,6 +488,33 @@ func (m *RelationshipTrustTensor) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovRelationshipTrustTensor(uint64(l))
 	}
+	if len(m.Dimensions) > 0 {
+		for _, e := range m.Dimensions {
+			l = e.Size()
+			n += 1 + l + sovRelationshipTrustTensor(uint64(l))
+		}
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovRelationshipTrustTensor(uint64(l))
+	}
+	return n
+}
+
+func (m *TensorDimension) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovRelationshipTrustTensor(uint64(l))
+	}
+	l = len(m.Score)
+	if l > 0 {
+		n += 1 + l + sovRelationshipTrustTensor(uint64(l))
+	}
 	return n
 }
 
@@ -721,6 +885,186 @@ func (m *RelationshipTrustTensor) Unmarshal(dAtA []byte) error {
 			}
 			m.ContextModifier = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dimensions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelationshipTrustTensor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRelationshipTrustTensor
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRelationshipTrustTensor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Dimensions = append(m.Dimensions, TensorDimension{})
+			if err := m.Dimensions[len(m.Dimensions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelationshipTrustTensor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRelationshipTrustTensor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRelationshipTrustTensor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRelationshipTrustTensor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRelationshipTrustTensor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TensorDimension) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRelationshipTrustTensor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TensorDimension: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TensorDimension: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelationshipTrustTensor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRelationshipTrustTensor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRelationshipTrustTensor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelationshipTrustTensor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRelationshipTrustTensor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRelationshipTrustTensor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Score = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRelationshipTrustTensor(dAtA[iNdEx:])
@@ -0,0 +1,118 @@
+package types
+
+import (
+	"fmt"
+	"sort"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+)
+
+// ValidateDimensions checks that every dimension is named once and scored
+// within [0, 1], and returns them sorted by name
+func ValidateDimensions(dimensions []TensorDimension) ([]TensorDimension, error) {
+	sorted := make([]TensorDimension, 0, len(dimensions))
+	seen := make(map[string]bool, len(dimensions))
+	for _, d := range dimensions {
+		if d.Name == "" {
+			return nil, errorsmod.Wrap(ErrInvalidDimension, "dimension name cannot be empty")
+		}
+		if seen[d.Name] {
+			return nil, errorsmod.Wrapf(ErrInvalidDimension, "dimension %s is given twice", d.Name)
+		}
+		seen[d.Name] = true
+
+		score, err := math.LegacyNewDecFromStr(d.Score)
+		if err != nil {
+			return nil, errorsmod.Wrapf(ErrInvalidDimension, "score %q of dimension %s is not a decimal", d.Score, d.Name)
+		}
+		if score.IsNegative() || score.GT(math.LegacyOneDec()) {
+			return nil, errorsmod.Wrapf(ErrInvalidDimension, "score %s of dimension %s must be between 0 and 1", d.Score, d.Name)
+		}
+		sorted = append(sorted, TensorDimension{Name: d.Name, Score: score.String()})
+	}
+
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Name < sorted[j].Name })
+	return sorted, nil
+}
+
+// DimensionBreakdown returns the named dimensions of the tensor. Tensors
+// stored before named dimensions existed report their T3 scores.
+func (t RelationshipTrustTensor) DimensionBreakdown() []TensorDimension {
+	if len(t.Dimensions) > 0 {
+		return t.Dimensions
+	}
+
+	var dimensions []TensorDimension
+	for _, d := range []TensorDimension{
+		{Name: DimensionTalent, Score: t.TalentScore},
+		{Name: DimensionTemperament, Score: t.TemperamentScore},
+		{Name: DimensionTraining, Score: t.TrainingScore},
+	} {
+		if d.Score != "" {
+			dimensions = append(dimensions, d)
+		}
+	}
+	return dimensions
+}
+
+// SetDimension sets the score of a named dimension, adding the dimension if
+// the tensor does not have it yet. T3 scores are mirrored into their legacy
+// fields so older readers keep seeing them.
+func (t *RelationshipTrustTensor) SetDimension(name, score string) {
+	dimensions := t.DimensionBreakdown()
+	updated := make([]TensorDimension, 0, len(dimensions)+1)
+	found := false
+	for _, d := range dimensions {
+		if d.Name == name {
+			d.Score = score
+			found = true
+		}
+		updated = append(updated, d)
+	}
+	if !found {
+		updated = append(updated, TensorDimension{Name: name, Score: score})
+		sort.Slice(updated, func(i, j int) bool { return updated[i].Name < updated[j].Name })
+	}
+	t.Dimensions = updated
+
+	switch name {
+	case DimensionTalent:
+		t.TalentScore = score
+	case DimensionTraining:
+		t.TrainingScore = score
+	case DimensionTemperament:
+		t.TemperamentScore = score
+	}
+}
+
+// DimensionScore returns the score of a named dimension
+func (t RelationshipTrustTensor) DimensionScore(name string) (string, bool) {
+	for _, d := range t.DimensionBreakdown() {
+		if d.Name == name {
+			return d.Score, true
+		}
+	}
+	return "", false
+}
+
+// WeightedScore aggregates the dimensions into one score using the weights in
+// params, normalised by the total weight of the dimensions present
+func WeightedScore(dimensions []TensorDimension, params Params) (math.LegacyDec, error) {
+	total := math.LegacyZeroDec()
+	totalWeight := math.LegacyZeroDec()
+	for _, d := range dimensions {
+		score, err := math.LegacyNewDecFromStr(d.Score)
+		if err != nil {
+			return math.LegacyZeroDec(), fmt.Errorf("invalid %s score: %w", d.Name, err)
+		}
+		weight := params.Weight(d.Name)
+		total = total.Add(score.Mul(weight))
+		totalWeight = totalWeight.Add(weight)
+	}
+
+	if totalWeight.IsZero() {
+		return math.LegacyZeroDec(), nil
+	}
+	return total.Quo(totalWeight), nil
+}
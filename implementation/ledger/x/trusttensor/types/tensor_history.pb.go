@@ -0,0 +1,652 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: racecarweb/trusttensor/v1/tensor_history.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// TensorHistoryEntry records one score update of a relationship trust tensor
+type TensorHistoryEntry struct {
+	TensorId  string `protobuf:"bytes,1,opt,name=tensor_id,json=tensorId,proto3" json:"tensor_id,omitempty"`
+	Sequence  uint64 `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Timestamp int64  `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// dimension is the dimension that was updated and score its new value
+	Dimension string `protobuf:"bytes,4,opt,name=dimension,proto3" json:"dimension,omitempty"`
+	Score     string `protobuf:"bytes,5,opt,name=score,proto3" json:"score,omitempty"`
+	// composite_score is the tensor's composite score after the update
+	CompositeScore string `protobuf:"bytes,6,opt,name=composite_score,json=compositeScore,proto3" json:"composite_score,omitempty"`
+	Context        string `protobuf:"bytes,7,opt,name=context,proto3" json:"context,omitempty"`
+	Actor          string `protobuf:"bytes,8,opt,name=actor,proto3" json:"actor,omitempty"`
+}
+
+func (m *TensorHistoryEntry) Reset()         { *m = TensorHistoryEntry{} }
+func (m *TensorHistoryEntry) String() string { return proto.CompactTextString(m) }
+func (*TensorHistoryEntry) ProtoMessage()    {}
+func (*TensorHistoryEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48a90be7f732dba4, []int{0}
+}
+func (m *TensorHistoryEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TensorHistoryEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TensorHistoryEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TensorHistoryEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TensorHistoryEntry.Merge(m, src)
+}
+func (m *TensorHistoryEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *TensorHistoryEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_TensorHistoryEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TensorHistoryEntry proto.InternalMessageInfo
+
+func (m *TensorHistoryEntry) GetTensorId() string {
+	if m != nil {
+		return m.TensorId
+	}
+	return ""
+}
+
+func (m *TensorHistoryEntry) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *TensorHistoryEntry) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *TensorHistoryEntry) GetDimension() string {
+	if m != nil {
+		return m.Dimension
+	}
+	return ""
+}
+
+func (m *TensorHistoryEntry) GetScore() string {
+	if m != nil {
+		return m.Score
+	}
+	return ""
+}
+
+func (m *TensorHistoryEntry) GetCompositeScore() string {
+	if m != nil {
+		return m.CompositeScore
+	}
+	return ""
+}
+
+func (m *TensorHistoryEntry) GetContext() string {
+	if m != nil {
+		return m.Context
+	}
+	return ""
+}
+
+func (m *TensorHistoryEntry) GetActor() string {
+	if m != nil {
+		return m.Actor
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*TensorHistoryEntry)(nil), "racecarweb.trusttensor.v1.TensorHistoryEntry")
+}
+
+func init() {
+	proto.RegisterFile("racecarweb/trusttensor/v1/tensor_history.proto", fileDescriptor_48a90be7f732dba4)
+}
+
+var fileDescriptor_48a90be7f732dba4 = []byte{
+	// 282 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x90, 0xbd, 0x4e, 0xfb, 0x30,
+	0x14, 0xc5, 0xeb, 0x7f, 0xbf, 0x3d, 0xfc, 0x91, 0x2c, 0x06, 0xf3, 0x21, 0x53, 0xb1, 0x90, 0x85,
+	0x44, 0x15, 0x13, 0x2b, 0x12, 0x12, 0xac, 0x81, 0x89, 0xa5, 0x4a, 0x9d, 0x2b, 0xe1, 0x21, 0x76,
+	0xb0, 0x6f, 0x4b, 0xf3, 0x16, 0x3c, 0x16, 0x63, 0x47, 0x46, 0x94, 0xbc, 0x04, 0x23, 0x8a, 0x5d,
+	0x1a, 0xd8, 0xee, 0xef, 0x9c, 0x73, 0xcf, 0x70, 0x68, 0x6c, 0x33, 0x09, 0x32, 0xb3, 0xaf, 0xb0,
+	0x4c, 0xd0, 0xae, 0x1c, 0x22, 0x68, 0x67, 0x6c, 0xb2, 0x9e, 0x27, 0xe1, 0x5a, 0x3c, 0x2b, 0x87,
+	0xc6, 0x56, 0x71, 0x69, 0x0d, 0x1a, 0x76, 0xd4, 0xe5, 0xe3, 0x5f, 0xf9, 0x78, 0x3d, 0x3f, 0xff,
+	0x22, 0x94, 0x3d, 0x7a, 0xba, 0x0b, 0x2f, 0xb7, 0x1a, 0x6d, 0xc5, 0x4e, 0xe8, 0x74, 0xd7, 0xa4,
+	0x72, 0x4e, 0x66, 0x24, 0x9a, 0xa6, 0x93, 0x20, 0xdc, 0xe7, 0xec, 0x98, 0x4e, 0x1c, 0xbc, 0xac,
+	0x40, 0x4b, 0xe0, 0xff, 0x66, 0x24, 0x1a, 0xa4, 0x7b, 0x66, 0xa7, 0x74, 0x8a, 0xaa, 0x00, 0x87,
+	0x59, 0x51, 0xf2, 0xfe, 0x8c, 0x44, 0xfd, 0xb4, 0x13, 0x5a, 0x37, 0x57, 0x05, 0x68, 0xa7, 0x8c,
+	0xe6, 0x03, 0x5f, 0xdb, 0x09, 0xec, 0x90, 0x0e, 0x9d, 0x34, 0x16, 0xf8, 0xd0, 0x3b, 0x01, 0xd8,
+	0x05, 0x3d, 0x90, 0xa6, 0x28, 0x8d, 0x53, 0x08, 0x8b, 0xe0, 0x8f, 0xbc, 0xff, 0x7f, 0x2f, 0x3f,
+	0xf8, 0x20, 0xa7, 0x63, 0x69, 0x34, 0xc2, 0x06, 0xf9, 0xd8, 0x07, 0x7e, 0xb0, 0x2d, 0xce, 0x24,
+	0x1a, 0xcb, 0x27, 0xa1, 0xd8, 0xc3, 0xcd, 0xf5, 0x7b, 0x2d, 0xc8, 0xb6, 0x16, 0xe4, 0xb3, 0x16,
+	0xe4, 0xad, 0x11, 0xbd, 0x6d, 0x23, 0x7a, 0x1f, 0x8d, 0xe8, 0x3d, 0x9d, 0xed, 0xf6, 0xba, 0x6c,
+	0x07, 0xde, 0xfc, 0x99, 0x18, 0xab, 0x12, 0xdc, 0x72, 0xe4, 0x77, 0xbd, 0xfa, 0x0e, 0x00, 0x00,
+	0xff, 0xff, 0x91, 0x8a, 0x05, 0x31, 0x89, 0x01, 0x00, 0x00,
+}
+
+func (m *TensorHistoryEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TensorHistoryEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TensorHistoryEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Actor) > 0 {
+		i -= len(m.Actor)
+		copy(dAtA[i:], m.Actor)
+		i = encodeVarintTensorHistory(dAtA, i, uint64(len(m.Actor)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Context) > 0 {
+		i -= len(m.Context)
+		copy(dAtA[i:], m.Context)
+		i = encodeVarintTensorHistory(dAtA, i, uint64(len(m.Context)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.CompositeScore) > 0 {
+		i -= len(m.CompositeScore)
+		copy(dAtA[i:], m.CompositeScore)
+		i = encodeVarintTensorHistory(dAtA, i, uint64(len(m.CompositeScore)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Score) > 0 {
+		i -= len(m.Score)
+		copy(dAtA[i:], m.Score)
+		i = encodeVarintTensorHistory(dAtA, i, uint64(len(m.Score)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Dimension) > 0 {
+		i -= len(m.Dimension)
+		copy(dAtA[i:], m.Dimension)
+		i = encodeVarintTensorHistory(dAtA, i, uint64(len(m.Dimension)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Timestamp != 0 {
+		i = encodeVarintTensorHistory(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintTensorHistory(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TensorId) > 0 {
+		i -= len(m.TensorId)
+		copy(dAtA[i:], m.TensorId)
+		i = encodeVarintTensorHistory(dAtA, i, uint64(len(m.TensorId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTensorHistory(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTensorHistory(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *TensorHistoryEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TensorId)
+	if l > 0 {
+		n += 1 + l + sovTensorHistory(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovTensorHistory(uint64(m.Sequence))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovTensorHistory(uint64(m.Timestamp))
+	}
+	l = len(m.Dimension)
+	if l > 0 {
+		n += 1 + l + sovTensorHistory(uint64(l))
+	}
+	l = len(m.Score)
+	if l > 0 {
+		n += 1 + l + sovTensorHistory(uint64(l))
+	}
+	l = len(m.CompositeScore)
+	if l > 0 {
+		n += 1 + l + sovTensorHistory(uint64(l))
+	}
+	l = len(m.Context)
+	if l > 0 {
+		n += 1 + l + sovTensorHistory(uint64(l))
+	}
+	l = len(m.Actor)
+	if l > 0 {
+		n += 1 + l + sovTensorHistory(uint64(l))
+	}
+	return n
+}
+
+func sovTensorHistory(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTensorHistory(x uint64) (n int) {
+	return sovTensorHistory(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *TensorHistoryEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTensorHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TensorHistoryEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TensorHistoryEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TensorId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTensorHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTensorHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTensorHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TensorId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTensorHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTensorHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dimension", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTensorHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTensorHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTensorHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Dimension = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTensorHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTensorHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTensorHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Score = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompositeScore", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTensorHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTensorHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTensorHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CompositeScore = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Context", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTensorHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTensorHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTensorHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Context = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Actor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTensorHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTensorHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTensorHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Actor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTensorHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTensorHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTensorHistory(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTensorHistory
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTensorHistory
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTensorHistory
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTensorHistory
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTensorHistory
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTensorHistory
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTensorHistory        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTensorHistory          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTensorHistory = fmt.Errorf("proto: unexpected end of group")
+)
@@ -130,6 +130,9 @@ type MsgCreateRelationshipTensor struct {
 	LctId      string `protobuf:"bytes,2,opt,name=lct_id,json=lctId,proto3" json:"lct_id,omitempty"`
 	TensorType string `protobuf:"bytes,3,opt,name=tensor_type,json=tensorType,proto3" json:"tensor_type,omitempty"`
 	Context    string `protobuf:"bytes,4,opt,name=context,proto3" json:"context,omitempty"`
+	// initial_score seeds params.default_dimension when no dimensions are given
+	InitialScore string            `protobuf:"bytes,5,opt,name=initial_score,json=initialScore,proto3" json:"initial_score,omitempty"`
+	Dimensions   []TensorDimension `protobuf:"bytes,6,rep,name=dimensions,proto3" json:"dimensions"`
 }
 
 func (m *MsgCreateRelationshipTensor) Reset()         { *m = MsgCreateRelationshipTensor{} }
@@ -193,6 +196,20 @@ func (m *MsgCreateRelationshipTensor) GetContext() string {
 	return ""
 }
 
+func (m *MsgCreateRelationshipTensor) GetInitialScore() string {
+	if m != nil {
+		return m.InitialScore
+	}
+	return ""
+}
+
+func (m *MsgCreateRelationshipTensor) GetDimensions() []TensorDimension {
+	if m != nil {
+		return m.Dimensions
+	}
+	return nil
+}
+
 // MsgCreateRelationshipTensorResponse defines the MsgCreateRelationshipTensorResponse message.
 type MsgCreateRelationshipTensorResponse struct {
 	TensorId string `protobuf:"bytes,1,opt,name=tensor_id,json=tensorId,proto3" json:"tensor_id,omitempty"`
@@ -498,50 +515,54 @@ func init() {
 }
 
 var fileDescriptor_587efd0e0e8cf3cb = []byte{
-	// 684 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0x4f, 0x6b, 0x13, 0x4f,
-	0x18, 0xce, 0xb6, 0x4d, 0xfa, 0xcb, 0xdb, 0xfc, 0xd0, 0x8e, 0x91, 0x6e, 0xb7, 0x75, 0xd3, 0xa6,
-	0x20, 0x25, 0xd0, 0x2c, 0x8d, 0x50, 0xb1, 0x82, 0xd0, 0xd8, 0x83, 0x05, 0x03, 0x92, 0x56, 0x04,
-	0x2f, 0x61, 0xba, 0x3b, 0x6e, 0x16, 0x92, 0x9d, 0x65, 0x66, 0x9a, 0xb6, 0x82, 0x20, 0x1e, 0xc5,
-	0x83, 0xf8, 0x29, 0x3c, 0x56, 0xf0, 0x43, 0xf4, 0xe0, 0xa1, 0x78, 0xf2, 0x24, 0xd2, 0x82, 0xfd,
-	0x0a, 0x1e, 0x25, 0x3b, 0xb3, 0xf9, 0xb3, 0x31, 0xb1, 0xed, 0xc1, 0x4b, 0x98, 0x79, 0xde, 0xf7,
-	0x7d, 0xde, 0xf7, 0x79, 0x32, 0x33, 0x0b, 0x79, 0x86, 0x6d, 0x62, 0x63, 0xb6, 0x4f, 0x76, 0x2d,
-	0xc1, 0xf6, 0xb8, 0x10, 0xc4, 0xe7, 0x94, 0x59, 0xad, 0x55, 0x4b, 0x1c, 0x14, 0x03, 0x46, 0x05,
-	0x45, 0xb3, 0xdd, 0x9c, 0x62, 0x4f, 0x4e, 0xb1, 0xb5, 0x6a, 0x4c, 0xe3, 0xa6, 0xe7, 0x53, 0x2b,
-	0xfc, 0x95, 0xd9, 0xc6, 0x8c, 0x4d, 0x79, 0x93, 0x72, 0xab, 0xc9, 0xdd, 0x36, 0x4b, 0x93, 0xbb,
-	0x2a, 0x30, 0x2b, 0x03, 0xb5, 0x70, 0x67, 0xc9, 0x8d, 0x0a, 0x65, 0x5d, 0xea, 0x52, 0x89, 0xb7,
-	0x57, 0x0a, 0xbd, 0x3d, 0x7c, 0xb6, 0x00, 0x33, 0xdc, 0x54, 0xd5, 0xf9, 0x2f, 0x1a, 0x5c, 0xab,
-	0x70, 0xf7, 0x69, 0xe0, 0x60, 0x41, 0x9e, 0x84, 0x11, 0xb4, 0x06, 0x69, 0xbc, 0x27, 0xea, 0x94,
-	0x79, 0xe2, 0x50, 0xd7, 0x16, 0xb4, 0xe5, 0x74, 0x59, 0xff, 0xfa, 0x79, 0x25, 0xab, 0xda, 0x6e,
-	0x38, 0x0e, 0x23, 0x9c, 0x6f, 0x0b, 0xe6, 0xf9, 0x6e, 0xb5, 0x9b, 0x8a, 0x36, 0x21, 0x25, 0xb9,
-	0xf5, 0xb1, 0x05, 0x6d, 0x79, 0xaa, 0xb4, 0x58, 0x1c, 0x2a, 0xbe, 0x28, 0x5b, 0x95, 0xd3, 0xc7,
-	0xdf, 0x73, 0x89, 0x8f, 0xe7, 0x47, 0x05, 0xad, 0xaa, 0x6a, 0xd7, 0xef, 0xbf, 0x39, 0x3f, 0x2a,
-	0x74, 0x59, 0xdf, 0x9e, 0x1f, 0x15, 0x96, 0x7b, 0xc4, 0x1c, 0xf4, 0xc9, 0x89, 0x8d, 0x9e, 0x9f,
-	0x85, 0x99, 0x18, 0x54, 0x25, 0x3c, 0xa0, 0x3e, 0x27, 0xf9, 0x4f, 0x1a, 0xcc, 0x55, 0xb8, 0xfb,
-	0x90, 0x11, 0x2c, 0x48, 0x95, 0x34, 0xb0, 0xf0, 0xa8, 0xcf, 0xeb, 0x5e, 0xb0, 0x13, 0x52, 0xa1,
-	0x12, 0x4c, 0xda, 0xed, 0x18, 0x65, 0x7f, 0xd5, 0x1c, 0x25, 0xa2, 0x9b, 0x90, 0x6a, 0xd8, 0xa2,
-	0xe6, 0x39, 0xa1, 0xe2, 0x74, 0x35, 0xd9, 0xb0, 0xc5, 0x96, 0x83, 0x72, 0x30, 0x25, 0xe7, 0xab,
-	0x89, 0xc3, 0x80, 0xe8, 0xe3, 0x61, 0x0c, 0x24, 0xb4, 0x73, 0x18, 0x10, 0xa4, 0xc3, 0xa4, 0x4d,
-	0x7d, 0x41, 0x0e, 0x84, 0x3e, 0x11, 0x06, 0xa3, 0xed, 0x7a, 0xa6, 0xad, 0x3e, 0xe2, 0xcf, 0x97,
-	0x61, 0x69, 0xc4, 0xc8, 0x91, 0x34, 0x34, 0x07, 0x69, 0xd5, 0xcf, 0x73, 0xe4, 0xf0, 0xd5, 0xff,
-	0x24, 0xb0, 0xe5, 0xe4, 0x7f, 0x6a, 0x90, 0xed, 0x78, 0x22, 0x0b, 0xb7, 0x6d, 0xca, 0xc8, 0x95,
-	0x04, 0xf7, 0x75, 0x1a, 0xeb, 0xef, 0x84, 0xe6, 0x21, 0xed, 0x78, 0x4d, 0xe2, 0x73, 0x8f, 0xfa,
-	0x4a, 0x74, 0x17, 0x40, 0x59, 0x48, 0xb6, 0x70, 0x63, 0x8f, 0x28, 0xc5, 0x72, 0xd3, 0xeb, 0x44,
-	0xb2, 0xcf, 0x09, 0xb4, 0x08, 0x99, 0x7d, 0x4f, 0xf8, 0x84, 0xf3, 0x9a, 0x83, 0x05, 0xd6, 0x53,
-	0x61, 0x78, 0x4a, 0x61, 0x9b, 0x58, 0xe0, 0x98, 0x59, 0x26, 0xcc, 0xff, 0x49, 0x67, 0xe7, 0x00,
-	0xfc, 0xd2, 0xe0, 0x46, 0x85, 0xbb, 0x1b, 0x8e, 0x23, 0xa3, 0xcf, 0x24, 0xd3, 0xbf, 0xf6, 0x21,
-	0x07, 0x91, 0x86, 0x5a, 0xc3, 0x8e, 0xfe, 0x7f, 0x50, 0xd0, 0x63, 0x5b, 0x20, 0x13, 0xc0, 0xa6,
-	0xfe, 0x0b, 0xcf, 0x21, 0xbe, 0x4d, 0x94, 0x2b, 0x3d, 0x08, 0x5a, 0x82, 0xff, 0x49, 0x4b, 0xae,
-	0x6b, 0x75, 0xcc, 0xeb, 0xca, 0x99, 0x4c, 0x04, 0x3e, 0xc2, 0xbc, 0x1e, 0xb3, 0xe6, 0x56, 0x78,
-	0xf4, 0xe3, 0xca, 0x23, 0x67, 0x4a, 0xef, 0x26, 0x60, 0xbc, 0xc2, 0x5d, 0xe4, 0x43, 0xa6, 0xef,
-	0x21, 0x28, 0x8c, 0xb8, 0xc0, 0xb1, 0x6b, 0x66, 0x94, 0x2e, 0x9e, 0xdb, 0x39, 0xb7, 0x1f, 0x34,
-	0xd0, 0x87, 0xde, 0xc7, 0xb5, 0xd1, 0x84, 0xc3, 0xea, 0x8c, 0x07, 0x57, 0xab, 0xeb, 0x0c, 0xf5,
-	0x0a, 0xa6, 0x07, 0xef, 0x8a, 0x75, 0x11, 0x75, 0x3d, 0x05, 0xc6, 0xdd, 0x4b, 0x16, 0x74, 0xda,
-	0xbf, 0x84, 0xeb, 0x03, 0x27, 0xb4, 0x38, 0x9a, 0x2c, 0x9e, 0x6f, 0xac, 0x5d, 0x2e, 0x3f, 0xea,
-	0x6d, 0x24, 0x5f, 0xb7, 0x5f, 0xe2, 0xf2, 0xbd, 0xe3, 0x53, 0x53, 0x3b, 0x39, 0x35, 0xb5, 0x1f,
-	0xa7, 0xa6, 0xf6, 0xfe, 0xcc, 0x4c, 0x9c, 0x9c, 0x99, 0x89, 0x6f, 0x67, 0x66, 0xe2, 0x79, 0x4e,
-	0xf1, 0xae, 0x0c, 0xbe, 0xc4, 0xed, 0x97, 0x8e, 0xef, 0xa6, 0xc2, 0xaf, 0xca, 0x9d, 0xdf, 0x01,
-	0x00, 0x00, 0xff, 0xff, 0x5a, 0xe0, 0xdc, 0xd0, 0x1b, 0x07, 0x00, 0x00,
+	// 739 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0x4f, 0x4b, 0x1b, 0x4f,
+	0x18, 0xce, 0xaa, 0x89, 0xbf, 0xbc, 0xc9, 0x8f, 0xd6, 0x69, 0x8a, 0x6b, 0xb4, 0x1b, 0x8d, 0x50,
+	0x42, 0xc0, 0x04, 0x53, 0xb0, 0x68, 0xa1, 0x60, 0xea, 0xa1, 0x42, 0x03, 0x12, 0x2d, 0x85, 0x5e,
+	0x96, 0x71, 0x77, 0xba, 0x59, 0x48, 0x76, 0x96, 0x9d, 0x31, 0x6a, 0xa1, 0x50, 0x7a, 0x2c, 0x3d,
+	0x94, 0x7e, 0x0a, 0x8f, 0x1e, 0xfa, 0x21, 0x3c, 0xf4, 0x20, 0x3d, 0xf5, 0x54, 0x8a, 0x42, 0xfd,
+	0x0a, 0x3d, 0x96, 0xdd, 0x99, 0x4d, 0x36, 0x6b, 0x93, 0xaa, 0x87, 0x5e, 0x96, 0x9d, 0xe7, 0xfd,
+	0xfb, 0x3c, 0xf3, 0xce, 0x0c, 0x14, 0x3d, 0x6c, 0x10, 0x03, 0x7b, 0xfb, 0x64, 0xb7, 0xca, 0xbd,
+	0x3d, 0xc6, 0x39, 0x71, 0x18, 0xf5, 0xaa, 0xdd, 0xe5, 0x2a, 0x3f, 0xa8, 0xb8, 0x1e, 0xe5, 0x14,
+	0xcd, 0xf4, 0x7d, 0x2a, 0x11, 0x9f, 0x4a, 0x77, 0x39, 0x3f, 0x85, 0x3b, 0xb6, 0x43, 0xab, 0xc1,
+	0x57, 0x78, 0xe7, 0xa7, 0x0d, 0xca, 0x3a, 0x94, 0x55, 0x3b, 0xcc, 0xf2, 0xb3, 0x74, 0x98, 0x25,
+	0x0d, 0x33, 0xc2, 0xa0, 0x07, 0xab, 0xaa, 0x58, 0x48, 0x53, 0xce, 0xa2, 0x16, 0x15, 0xb8, 0xff,
+	0x27, 0xd1, 0xfb, 0xc3, 0x7b, 0x73, 0xb1, 0x87, 0x3b, 0x61, 0xf4, 0xea, 0x70, 0x3f, 0x8f, 0xb4,
+	0x31, 0xb7, 0xa9, 0xc3, 0x5a, 0xb6, 0xab, 0x07, 0x36, 0x5d, 0x36, 0x1f, 0x84, 0x16, 0xbf, 0x28,
+	0x70, 0xab, 0xc1, 0xac, 0xe7, 0xae, 0x89, 0x39, 0xd9, 0x0a, 0x92, 0xa2, 0x15, 0x48, 0xe3, 0x3d,
+	0xde, 0xa2, 0x9e, 0xcd, 0x0f, 0x55, 0x65, 0x5e, 0x29, 0xa5, 0xeb, 0xea, 0xd7, 0xcf, 0x4b, 0x39,
+	0xd9, 0xf1, 0xba, 0x69, 0x7a, 0x84, 0xb1, 0x6d, 0xee, 0xd9, 0x8e, 0xd5, 0xec, 0xbb, 0xa2, 0x0d,
+	0x48, 0x89, 0xb6, 0xd4, 0xb1, 0x79, 0xa5, 0x94, 0xa9, 0x2d, 0x54, 0x86, 0xea, 0x56, 0x11, 0xa5,
+	0xea, 0xe9, 0x93, 0xef, 0x85, 0xc4, 0xd1, 0xc5, 0x71, 0x59, 0x69, 0xca, 0xd8, 0xb5, 0x47, 0xef,
+	0x2e, 0x8e, 0xcb, 0xfd, 0xac, 0xef, 0x2f, 0x8e, 0xcb, 0xa5, 0x08, 0xbf, 0x83, 0x01, 0x86, 0xb1,
+	0xd6, 0x8b, 0x33, 0x30, 0x1d, 0x83, 0x9a, 0x84, 0xb9, 0xd4, 0x61, 0xa4, 0x78, 0x34, 0x06, 0xb3,
+	0x0d, 0x66, 0x3d, 0xf1, 0x08, 0xe6, 0xa4, 0x19, 0x91, 0x65, 0x27, 0x48, 0x85, 0x6a, 0x30, 0x69,
+	0xf8, 0x36, 0xea, 0xfd, 0x95, 0x73, 0xe8, 0x88, 0xee, 0x42, 0xaa, 0x6d, 0x70, 0xdd, 0x36, 0x03,
+	0xc6, 0xe9, 0x66, 0xb2, 0x6d, 0xf0, 0x4d, 0x13, 0x15, 0x20, 0x23, 0xfa, 0xd3, 0xf9, 0xa1, 0x4b,
+	0xd4, 0xf1, 0xc0, 0x06, 0x02, 0xda, 0x39, 0x74, 0x09, 0x52, 0x61, 0xd2, 0xa0, 0x0e, 0x27, 0x07,
+	0x5c, 0x9d, 0x08, 0x8c, 0xe1, 0x12, 0x2d, 0xc2, 0xff, 0xb6, 0x63, 0x73, 0x1b, 0xb7, 0x75, 0x66,
+	0x50, 0x8f, 0xa8, 0xc9, 0xc0, 0x9e, 0x95, 0xe0, 0xb6, 0x8f, 0xa1, 0x2d, 0x00, 0xd3, 0xee, 0x10,
+	0x87, 0xf9, 0x0c, 0xd4, 0xd4, 0xfc, 0x78, 0x29, 0x53, 0x2b, 0x8f, 0x10, 0x5b, 0x30, 0xdc, 0x08,
+	0x43, 0xea, 0x13, 0xbe, 0xea, 0xcd, 0x48, 0x8e, 0xb5, 0xac, 0x2f, 0x7a, 0x48, 0xab, 0x58, 0x87,
+	0xc5, 0x11, 0x4a, 0x85, 0x8a, 0xa2, 0x59, 0x48, 0x4b, 0x9a, 0xb6, 0x29, 0x34, 0x6b, 0xfe, 0x27,
+	0x80, 0x4d, 0xb3, 0xf8, 0x53, 0x81, 0x5c, 0x6f, 0x2b, 0x44, 0xa0, 0x68, 0xfe, 0x26, 0x3a, 0x0f,
+	0x54, 0x1a, 0x1b, 0xac, 0x84, 0xe6, 0x20, 0xdd, 0x63, 0x22, 0xb5, 0xee, 0x03, 0x28, 0x07, 0xc9,
+	0x2e, 0x6e, 0xef, 0x11, 0x29, 0xb4, 0x58, 0x44, 0x37, 0x20, 0x39, 0xb8, 0x01, 0x0b, 0x90, 0xdd,
+	0xb7, 0xb9, 0x43, 0x18, 0xd3, 0x4d, 0xcc, 0xb1, 0x9a, 0x0a, 0xcc, 0x19, 0x89, 0x6d, 0x60, 0x8e,
+	0x63, 0x62, 0x69, 0x30, 0xf7, 0x27, 0x9e, 0xbd, 0xb9, 0xfb, 0xa5, 0xc0, 0x9d, 0x06, 0xb3, 0xd6,
+	0x4d, 0x53, 0x58, 0x5f, 0x88, 0x4c, 0xff, 0x5a, 0x87, 0x02, 0x84, 0x1c, 0xf4, 0xb6, 0x11, 0x8e,
+	0x1d, 0x48, 0xe8, 0x99, 0xc1, 0x91, 0x06, 0x60, 0x50, 0xe7, 0x95, 0x6d, 0x12, 0xc7, 0x08, 0xc7,
+	0x2e, 0x82, 0xf8, 0x93, 0x49, 0xba, 0xe2, 0x5f, 0x6f, 0x61, 0xd6, 0x92, 0xca, 0x64, 0x43, 0xf0,
+	0x29, 0x66, 0xad, 0x98, 0x34, 0xf7, 0x82, 0x13, 0x17, 0x67, 0x1e, 0x2a, 0x53, 0xfb, 0x30, 0x01,
+	0xe3, 0x0d, 0x66, 0x21, 0x07, 0xb2, 0x03, 0xf7, 0xcf, 0xa8, 0x51, 0x8e, 0x9d, 0xee, 0x7c, 0xed,
+	0xea, 0xbe, 0xbd, 0xb9, 0xfd, 0xa4, 0x80, 0x3a, 0xf4, 0x1a, 0x58, 0x19, 0x9d, 0x70, 0x58, 0x5c,
+	0xfe, 0xf1, 0xcd, 0xe2, 0x7a, 0x4d, 0xbd, 0x81, 0xa9, 0xcb, 0x67, 0xa5, 0x7a, 0x15, 0x76, 0x91,
+	0x80, 0xfc, 0xc3, 0x6b, 0x06, 0xf4, 0xca, 0xbf, 0x86, 0xdb, 0x97, 0x26, 0xb4, 0x32, 0x3a, 0x59,
+	0xdc, 0x3f, 0xbf, 0x72, 0x3d, 0xff, 0xb0, 0x76, 0x3e, 0xf9, 0xd6, 0x7f, 0x00, 0xea, 0xab, 0x27,
+	0x67, 0x9a, 0x72, 0x7a, 0xa6, 0x29, 0x3f, 0xce, 0x34, 0xe5, 0xe3, 0xb9, 0x96, 0x38, 0x3d, 0xd7,
+	0x12, 0xdf, 0xce, 0xb5, 0xc4, 0xcb, 0x82, 0xcc, 0xbb, 0x74, 0xf9, 0x01, 0xf0, 0x2f, 0x58, 0xb6,
+	0x9b, 0x0a, 0x1e, 0xb3, 0x07, 0xbf, 0x03, 0x00, 0x00, 0xff, 0xff, 0xfd, 0xe5, 0x4a, 0xf9, 0xcd,
+	0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -826,6 +847,27 @@ func (m *MsgCreateRelationshipTensor) MarshalToSizedBuffer(dAtA []byte) (int, er
 	_ = i
 	var l int
 	_ = l
+	if len(m.Dimensions) > 0 {
+		for iNdEx := len(m.Dimensions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Dimensions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.InitialScore) > 0 {
+		i -= len(m.InitialScore)
+		copy(dAtA[i:], m.InitialScore)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.InitialScore)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.Context) > 0 {
 		i -= len(m.Context)
 		copy(dAtA[i:], m.Context)
@@ -1120,6 +1162,16 @@ func (m *MsgCreateRelationshipTensor) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	l = len(m.InitialScore)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Dimensions) > 0 {
+		for _, e := range m.Dimensions {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1548,6 +1600,72 @@ func (m *MsgCreateRelationshipTensor) Unmarshal(dAtA []byte) error {
 			}
 			m.Context = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialScore", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InitialScore = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dimensions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Dimensions = append(m.Dimensions, TensorDimension{})
+			if err := m.Dimensions[len(m.Dimensions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])